@@ -0,0 +1,98 @@
+package breached
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"github.com/zitadel/logging"
+
+	"github.com/zitadel/zitadel/internal/breached"
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+const (
+	flagFile      = "file"
+	flagDirectory = "directory"
+)
+
+type Config struct {
+	Database database.Config
+}
+
+func New() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "breached-passwords",
+		Short: "manage the breached password corpus",
+	}
+	cmd.AddCommand(newLoad())
+	return cmd
+}
+
+func newLoad() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "load [-f file | -d directory]",
+		Short: "load breached password hashes into the database",
+		Long: `load breached password hashes into the database
+the hashes are the upper case hex encoded SHA-1 hashes of the passwords, optionally followed by the number of occurrences
+provide either a file containing a full hash per line (HASH:COUNT)
+or a directory containing a file per hash prefix (e.g. 5BAA6.txt), each line containing the suffix (SUFFIX:COUNT)
+the corpus is used if BreachedPasswords.Source of the SystemDefaults is set to "database"
+Requirements:
+- postgreSQL`,
+		Example: `load -f pwned-passwords-sha1-ordered-by-hash.txt
+load -d pwnedpasswords`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			filePath, _ := cmd.Flags().GetString(flagFile)
+			directory, _ := cmd.Flags().GetString(flagDirectory)
+			if (filePath == "") == (directory == "") {
+				return zerrors.ThrowInvalidArgument(nil, "BREACH-Cm1ld", "either file or directory must be provided")
+			}
+			config := new(Config)
+			if err := viper.Unmarshal(config); err != nil {
+				return err
+			}
+			db, err := database.Connect(config.Database, false)
+			if err != nil {
+				return err
+			}
+			defer db.Close()
+
+			if filePath != "" {
+				return loadFile(cmd, db, filePath, "")
+			}
+			files, err := filepath.Glob(filepath.Join(directory, "*.txt"))
+			if err != nil {
+				return zerrors.ThrowInternalf(err, "BREACH-Cm2ld", "failed to read directory: %s", directory)
+			}
+			for _, file := range files {
+				prefix := strings.TrimSuffix(filepath.Base(file), ".txt")
+				if err = loadFile(cmd, db, file, prefix); err != nil {
+					return err
+				}
+			}
+			return nil
+		},
+	}
+	cmd.PersistentFlags().StringP(flagFile, "f", "", "path to the file containing the full hashes")
+	cmd.PersistentFlags().StringP(flagDirectory, "d", "", "path to the directory containing the prefix files")
+	return cmd
+}
+
+func loadFile(cmd *cobra.Command, db *database.DB, fileName, prefix string) error {
+	file, err := os.Open(fileName)
+	if err != nil {
+		return zerrors.ThrowInternalf(err, "BREACH-Cm3ld", "failed to open file: %s", fileName)
+	}
+	defer file.Close()
+	count, err := breached.Load(cmd.Context(), db, file, prefix)
+	logging.WithFields("file", fileName, "count", count).OnError(err).Error("failed to load breached passwords")
+	if err != nil {
+		return err
+	}
+	logging.WithFields("file", fileName, "count", count).Info("breached passwords loaded")
+	return nil
+}
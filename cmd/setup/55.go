@@ -0,0 +1,27 @@
+package setup
+
+import (
+	"context"
+	_ "embed"
+
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/eventstore"
+)
+
+var (
+	//go:embed 55.sql
+	createBreachedPasswordsTable string
+)
+
+type BreachedPasswordsTable struct {
+	dbClient *database.DB
+}
+
+func (mig *BreachedPasswordsTable) Execute(ctx context.Context, _ eventstore.Event) error {
+	_, err := mig.dbClient.ExecContext(ctx, createBreachedPasswordsTable)
+	return err
+}
+
+func (mig *BreachedPasswordsTable) String() string {
+	return "55_breached_passwords_table"
+}
@@ -0,0 +1,7 @@
+CREATE TABLE IF NOT EXISTS system.breached_passwords (
+	prefix CHAR(5) NOT NULL
+	, suffix CHAR(35) NOT NULL
+	, occurrences INT8 NOT NULL DEFAULT 0
+
+	, PRIMARY KEY (prefix, suffix)
+);
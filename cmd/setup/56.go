@@ -0,0 +1,27 @@
+package setup
+
+import (
+	"context"
+	_ "embed"
+
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/eventstore"
+)
+
+var (
+	//go:embed 56.sql
+	createLoginThrottlingTables string
+)
+
+type LoginThrottlingTables struct {
+	dbClient *database.DB
+}
+
+func (mig *LoginThrottlingTables) Execute(ctx context.Context, _ eventstore.Event) error {
+	_, err := mig.dbClient.ExecContext(ctx, createLoginThrottlingTables)
+	return err
+}
+
+func (mig *LoginThrottlingTables) String() string {
+	return "56_login_throttling_tables"
+}
@@ -0,0 +1,15 @@
+CREATE TABLE IF NOT EXISTS system.login_failures (
+	key TEXT NOT NULL
+	, failed_at TIMESTAMPTZ NOT NULL
+	, expires_at TIMESTAMPTZ NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS login_failures_key_idx ON system.login_failures (key, failed_at);
+CREATE INDEX IF NOT EXISTS login_failures_expires_at_idx ON system.login_failures (expires_at);
+
+CREATE TABLE IF NOT EXISTS system.login_blocks (
+	key TEXT NOT NULL
+	, blocked_until TIMESTAMPTZ NOT NULL
+
+	, PRIMARY KEY (key)
+);
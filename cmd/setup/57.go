@@ -0,0 +1,27 @@
+package setup
+
+import (
+	"context"
+	_ "embed"
+
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/eventstore"
+)
+
+var (
+	//go:embed 57.sql
+	addMagicLinkVerificationToUserSessions string
+)
+
+type UserSessionsMagicLinkVerification struct {
+	dbClient *database.DB
+}
+
+func (mig *UserSessionsMagicLinkVerification) Execute(ctx context.Context, _ eventstore.Event) error {
+	_, err := mig.dbClient.ExecContext(ctx, addMagicLinkVerificationToUserSessions)
+	return err
+}
+
+func (mig *UserSessionsMagicLinkVerification) String() string {
+	return "57_user_sessions_magic_link_verification"
+}
@@ -0,0 +1,27 @@
+package setup
+
+import (
+	"context"
+	_ "embed"
+
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/eventstore"
+)
+
+var (
+	//go:embed 58.sql
+	addOIDCAppRequirePushedAuthRequest string
+)
+
+type Apps7OIDCConfigsRequirePushedAuthRequest struct {
+	dbClient *database.DB
+}
+
+func (mig *Apps7OIDCConfigsRequirePushedAuthRequest) Execute(ctx context.Context, _ eventstore.Event) error {
+	_, err := mig.dbClient.ExecContext(ctx, addOIDCAppRequirePushedAuthRequest)
+	return err
+}
+
+func (mig *Apps7OIDCConfigsRequirePushedAuthRequest) String() string {
+	return "58_apps7_oidc_configs_require_pushed_auth_request"
+}
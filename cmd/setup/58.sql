@@ -0,0 +1 @@
+ALTER TABLE IF EXISTS projections.apps7_oidc_configs ADD COLUMN IF NOT EXISTS require_pushed_auth_request BOOLEAN DEFAULT FALSE;
@@ -0,0 +1,27 @@
+package setup
+
+import (
+	"context"
+	_ "embed"
+
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/eventstore"
+)
+
+var (
+	//go:embed 59.sql
+	addOIDCAppRequireDPoP string
+)
+
+type Apps7OIDCConfigsRequireDPoP struct {
+	dbClient *database.DB
+}
+
+func (mig *Apps7OIDCConfigsRequireDPoP) Execute(ctx context.Context, _ eventstore.Event) error {
+	_, err := mig.dbClient.ExecContext(ctx, addOIDCAppRequireDPoP)
+	return err
+}
+
+func (mig *Apps7OIDCConfigsRequireDPoP) String() string {
+	return "59_apps7_oidc_configs_require_dpop"
+}
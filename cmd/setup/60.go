@@ -0,0 +1,27 @@
+package setup
+
+import (
+	"context"
+	_ "embed"
+
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/eventstore"
+)
+
+var (
+	//go:embed 60.sql
+	addOIDCAppBackChannelClientNotificationURI string
+)
+
+type Apps7OIDCConfigsBackChannelClientNotificationURI struct {
+	dbClient *database.DB
+}
+
+func (mig *Apps7OIDCConfigsBackChannelClientNotificationURI) Execute(ctx context.Context, _ eventstore.Event) error {
+	_, err := mig.dbClient.ExecContext(ctx, addOIDCAppBackChannelClientNotificationURI)
+	return err
+}
+
+func (mig *Apps7OIDCConfigsBackChannelClientNotificationURI) String() string {
+	return "60_apps7_oidc_configs_back_channel_client_notification_uri"
+}
@@ -0,0 +1,27 @@
+package setup
+
+import (
+	"context"
+	_ "embed"
+
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/eventstore"
+)
+
+var (
+	//go:embed 61.sql
+	createDPoPProofsTable string
+)
+
+type DPoPProofsTable struct {
+	dbClient *database.DB
+}
+
+func (mig *DPoPProofsTable) Execute(ctx context.Context, _ eventstore.Event) error {
+	_, err := mig.dbClient.ExecContext(ctx, createDPoPProofsTable)
+	return err
+}
+
+func (mig *DPoPProofsTable) String() string {
+	return "61_dpop_proofs_table"
+}
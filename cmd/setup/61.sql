@@ -0,0 +1,8 @@
+CREATE TABLE IF NOT EXISTS system.dpop_proofs (
+	key TEXT NOT NULL
+	, expires_at TIMESTAMPTZ NOT NULL
+
+	, PRIMARY KEY (key)
+);
+
+CREATE INDEX IF NOT EXISTS dpop_proofs_expires_at_idx ON system.dpop_proofs (expires_at);
@@ -102,54 +102,61 @@ func MustNewConfig(v *viper.Viper) *Config {
 }
 
 type Steps struct {
-	s1ProjectionTable                       *ProjectionTable
-	s2AssetsTable                           *AssetTable
-	FirstInstance                           *FirstInstance
-	s5LastFailed                            *LastFailed
-	s6OwnerRemoveColumns                    *OwnerRemoveColumns
-	s7LogstoreTables                        *LogstoreTables
-	s8AuthTokens                            *AuthTokenIndexes
-	CorrectCreationDate                     *CorrectCreationDate
-	s12AddOTPColumns                        *AddOTPColumns
-	s13FixQuotaProjection                   *FixQuotaConstraints
-	s14NewEventsTable                       *NewEventsTable
-	s15CurrentStates                        *CurrentProjectionState
-	s16UniqueConstraintsLower               *UniqueConstraintToLower
-	s17AddOffsetToUniqueConstraints         *AddOffsetToCurrentStates
-	s18AddLowerFieldsToLoginNames           *AddLowerFieldsToLoginNames
-	s19AddCurrentStatesIndex                *AddCurrentSequencesIndex
-	s20AddByUserSessionIndex                *AddByUserIndexToSession
-	s21AddBlockFieldToLimits                *AddBlockFieldToLimits
-	s22ActiveInstancesIndex                 *ActiveInstanceEvents
-	s23CorrectGlobalUniqueConstraints       *CorrectGlobalUniqueConstraints
-	s24AddActorToAuthTokens                 *AddActorToAuthTokens
-	s25User11AddLowerFieldsToVerifiedEmail  *User11AddLowerFieldsToVerifiedEmail
-	s26AuthUsers3                           *AuthUsers3
-	s27IDPTemplate6SAMLNameIDFormat         *IDPTemplate6SAMLNameIDFormat
-	s28AddFieldTable                        *AddFieldTable
-	s29FillFieldsForProjectGrant            *FillFieldsForProjectGrant
-	s30FillFieldsForOrgDomainVerified       *FillFieldsForOrgDomainVerified
-	s31AddAggregateIndexToFields            *AddAggregateIndexToFields
-	s32AddAuthSessionID                     *AddAuthSessionID
-	s33SMSConfigs3TwilioAddVerifyServiceSid *SMSConfigs3TwilioAddVerifyServiceSid
-	s34AddCacheSchema                       *AddCacheSchema
-	s35AddPositionToIndexEsWm               *AddPositionToIndexEsWm
-	s36FillV2Milestones                     *FillV3Milestones
-	s37Apps7OIDConfigsBackChannelLogoutURI  *Apps7OIDConfigsBackChannelLogoutURI
-	s38BackChannelLogoutNotificationStart   *BackChannelLogoutNotificationStart
-	s40InitPushFunc                         *InitPushFunc
-	s42Apps7OIDCConfigsLoginVersion         *Apps7OIDCConfigsLoginVersion
-	s43CreateFieldsDomainIndex              *CreateFieldsDomainIndex
-	s44ReplaceCurrentSequencesIndex         *ReplaceCurrentSequencesIndex
-	s45CorrectProjectOwners                 *CorrectProjectOwners
-	s46InitPermissionFunctions              *InitPermissionFunctions
-	s47FillMembershipFields                 *FillMembershipFields
-	s48Apps7SAMLConfigsLoginVersion         *Apps7SAMLConfigsLoginVersion
-	s49InitPermittedOrgsFunction            *InitPermittedOrgsFunction
-	s50IDPTemplate6UsePKCE                  *IDPTemplate6UsePKCE
-	s51IDPTemplate6RootCA                   *IDPTemplate6RootCA
-	s52IDPTemplate6LDAP2                    *IDPTemplate6LDAP2
-	s53InitPermittedOrgsFunction            *InitPermittedOrgsFunction53
+	s1ProjectionTable                                   *ProjectionTable
+	s2AssetsTable                                       *AssetTable
+	FirstInstance                                       *FirstInstance
+	s5LastFailed                                        *LastFailed
+	s6OwnerRemoveColumns                                *OwnerRemoveColumns
+	s7LogstoreTables                                    *LogstoreTables
+	s8AuthTokens                                        *AuthTokenIndexes
+	CorrectCreationDate                                 *CorrectCreationDate
+	s12AddOTPColumns                                    *AddOTPColumns
+	s13FixQuotaProjection                               *FixQuotaConstraints
+	s14NewEventsTable                                   *NewEventsTable
+	s15CurrentStates                                    *CurrentProjectionState
+	s16UniqueConstraintsLower                           *UniqueConstraintToLower
+	s17AddOffsetToUniqueConstraints                     *AddOffsetToCurrentStates
+	s18AddLowerFieldsToLoginNames                       *AddLowerFieldsToLoginNames
+	s19AddCurrentStatesIndex                            *AddCurrentSequencesIndex
+	s20AddByUserSessionIndex                            *AddByUserIndexToSession
+	s21AddBlockFieldToLimits                            *AddBlockFieldToLimits
+	s22ActiveInstancesIndex                             *ActiveInstanceEvents
+	s23CorrectGlobalUniqueConstraints                   *CorrectGlobalUniqueConstraints
+	s24AddActorToAuthTokens                             *AddActorToAuthTokens
+	s25User11AddLowerFieldsToVerifiedEmail              *User11AddLowerFieldsToVerifiedEmail
+	s26AuthUsers3                                       *AuthUsers3
+	s27IDPTemplate6SAMLNameIDFormat                     *IDPTemplate6SAMLNameIDFormat
+	s28AddFieldTable                                    *AddFieldTable
+	s29FillFieldsForProjectGrant                        *FillFieldsForProjectGrant
+	s30FillFieldsForOrgDomainVerified                   *FillFieldsForOrgDomainVerified
+	s31AddAggregateIndexToFields                        *AddAggregateIndexToFields
+	s32AddAuthSessionID                                 *AddAuthSessionID
+	s33SMSConfigs3TwilioAddVerifyServiceSid             *SMSConfigs3TwilioAddVerifyServiceSid
+	s34AddCacheSchema                                   *AddCacheSchema
+	s35AddPositionToIndexEsWm                           *AddPositionToIndexEsWm
+	s36FillV2Milestones                                 *FillV3Milestones
+	s37Apps7OIDConfigsBackChannelLogoutURI              *Apps7OIDConfigsBackChannelLogoutURI
+	s38BackChannelLogoutNotificationStart               *BackChannelLogoutNotificationStart
+	s40InitPushFunc                                     *InitPushFunc
+	s42Apps7OIDCConfigsLoginVersion                     *Apps7OIDCConfigsLoginVersion
+	s43CreateFieldsDomainIndex                          *CreateFieldsDomainIndex
+	s44ReplaceCurrentSequencesIndex                     *ReplaceCurrentSequencesIndex
+	s45CorrectProjectOwners                             *CorrectProjectOwners
+	s46InitPermissionFunctions                          *InitPermissionFunctions
+	s47FillMembershipFields                             *FillMembershipFields
+	s48Apps7SAMLConfigsLoginVersion                     *Apps7SAMLConfigsLoginVersion
+	s49InitPermittedOrgsFunction                        *InitPermittedOrgsFunction
+	s50IDPTemplate6UsePKCE                              *IDPTemplate6UsePKCE
+	s51IDPTemplate6RootCA                               *IDPTemplate6RootCA
+	s52IDPTemplate6LDAP2                                *IDPTemplate6LDAP2
+	s53InitPermittedOrgsFunction                        *InitPermittedOrgsFunction53
+	s55BreachedPasswordsTable                           *BreachedPasswordsTable
+	s56LoginThrottlingTables                            *LoginThrottlingTables
+	s57UserSessionsMagicLinkVerification                *UserSessionsMagicLinkVerification
+	s58Apps7OIDCConfigsRequirePushedAuthRequest         *Apps7OIDCConfigsRequirePushedAuthRequest
+	s59Apps7OIDCConfigsRequireDPoP                      *Apps7OIDCConfigsRequireDPoP
+	s60Apps7OIDCConfigsBackChannelClientNotificationURI *Apps7OIDCConfigsBackChannelClientNotificationURI
+	s61DPoPProofsTable                                  *DPoPProofsTable
 }
 
 func MustNewSteps(v *viper.Viper) *Steps {
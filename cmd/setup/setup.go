@@ -212,6 +212,13 @@ func Setup(ctx context.Context, config *Config, steps *Steps, masterKey string)
 	steps.s51IDPTemplate6RootCA = &IDPTemplate6RootCA{dbClient: dbClient}
 	steps.s52IDPTemplate6LDAP2 = &IDPTemplate6LDAP2{dbClient: dbClient}
 	steps.s53InitPermittedOrgsFunction = &InitPermittedOrgsFunction53{dbClient: dbClient}
+	steps.s55BreachedPasswordsTable = &BreachedPasswordsTable{dbClient: dbClient}
+	steps.s56LoginThrottlingTables = &LoginThrottlingTables{dbClient: dbClient}
+	steps.s57UserSessionsMagicLinkVerification = &UserSessionsMagicLinkVerification{dbClient: dbClient}
+	steps.s58Apps7OIDCConfigsRequirePushedAuthRequest = &Apps7OIDCConfigsRequirePushedAuthRequest{dbClient: dbClient}
+	steps.s59Apps7OIDCConfigsRequireDPoP = &Apps7OIDCConfigsRequireDPoP{dbClient: dbClient}
+	steps.s60Apps7OIDCConfigsBackChannelClientNotificationURI = &Apps7OIDCConfigsBackChannelClientNotificationURI{dbClient: dbClient}
+	steps.s61DPoPProofsTable = &DPoPProofsTable{dbClient: dbClient}
 
 	err = projection.Create(ctx, dbClient, eventstoreClient, config.Projections, nil, nil, nil)
 	logging.OnError(err).Fatal("unable to start projections")
@@ -254,6 +261,13 @@ func Setup(ctx context.Context, config *Config, steps *Steps, masterKey string)
 		steps.s51IDPTemplate6RootCA,
 		steps.s52IDPTemplate6LDAP2,
 		steps.s53InitPermittedOrgsFunction,
+		steps.s55BreachedPasswordsTable,
+		steps.s56LoginThrottlingTables,
+		steps.s57UserSessionsMagicLinkVerification,
+		steps.s58Apps7OIDCConfigsRequirePushedAuthRequest,
+		steps.s59Apps7OIDCConfigsRequireDPoP,
+		steps.s60Apps7OIDCConfigsBackChannelClientNotificationURI,
+		steps.s61DPoPProofsTable,
 	} {
 		setupErr = executeMigration(ctx, eventstoreClient, step, "migration failed")
 		if setupErr != nil {
@@ -12,6 +12,8 @@ import (
 	"github.com/zitadel/zitadel/internal/actions"
 	admin_es "github.com/zitadel/zitadel/internal/admin/repository/eventsourcing"
 	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/api/bounce"
+	session_v2 "github.com/zitadel/zitadel/internal/api/grpc/session/v2"
 	"github.com/zitadel/zitadel/internal/api/http/middleware"
 	"github.com/zitadel/zitadel/internal/api/oidc"
 	"github.com/zitadel/zitadel/internal/api/saml"
@@ -31,6 +33,7 @@ import (
 	"github.com/zitadel/zitadel/internal/id"
 	"github.com/zitadel/zitadel/internal/logstore"
 	"github.com/zitadel/zitadel/internal/notification/handlers"
+	"github.com/zitadel/zitadel/internal/provisioning"
 	"github.com/zitadel/zitadel/internal/query/projection"
 	static_config "github.com/zitadel/zitadel/internal/static/config"
 	metrics "github.com/zitadel/zitadel/internal/telemetry/metrics/config"
@@ -47,40 +50,45 @@ type Config struct {
 	TLS                 network.TLS
 	InstanceHostHeaders []string
 	PublicHostHeaders   []string
-	HTTP2HostHeader     string
-	HTTP1HostHeader     string
-	WebAuthNName        string
-	Database            database.Config
-	Caches              *connector.CachesConfig
-	Tracing             tracing.Config
-	Metrics             metrics.Config
-	Profiler            profiler.Config
-	Projections         projection.Config
-	Notifications       handlers.WorkerConfig
-	Executions          execution.WorkerConfig
-	Auth                auth_es.Config
-	Admin               admin_es.Config
-	UserAgentCookie     *middleware.UserAgentCookieConfig
-	OIDC                oidc.Config
-	SAML                saml.Config
-	SCIM                scim_config.Config
-	Login               login.Config
-	Console             console.Config
-	AssetStorage        static_config.AssetStorageConfig
-	InternalAuthZ       authz.Config
-	SystemAuthZ         authz.Config
-	SystemDefaults      systemdefaults.SystemDefaults
-	EncryptionKeys      *encryption.EncryptionKeyConfig
-	DefaultInstance     command.InstanceSetup
-	AuditLogRetention   time.Duration
-	SystemAPIUsers      map[string]*authz.SystemAPIUser
-	CustomerPortal      string
-	Machine             *id.Config
-	Actions             *actions.Config
-	Eventstore          *eventstore.Config
-	LogStore            *logstore.Configs
-	Quotas              *QuotasConfig
-	Telemetry           *handlers.TelemetryPusherConfig
+	// ClientCertificate describes how a reverse proxy passes the client certificate of the user.
+	ClientCertificate    session_v2.ClientCertificateConfig
+	HTTP2HostHeader      string
+	HTTP1HostHeader      string
+	WebAuthNName         string
+	WebAuthNMetadataPath string
+	Database             database.Config
+	Caches               *connector.CachesConfig
+	Tracing              tracing.Config
+	Metrics              metrics.Config
+	Profiler             profiler.Config
+	Projections          projection.Config
+	Notifications        handlers.WorkerConfig
+	EmailBounces         bounce.Config
+	Executions           execution.WorkerConfig
+	Provisioning         provisioning.WorkerConfig
+	Auth                 auth_es.Config
+	Admin                admin_es.Config
+	UserAgentCookie      *middleware.UserAgentCookieConfig
+	OIDC                 oidc.Config
+	SAML                 saml.Config
+	SCIM                 scim_config.Config
+	Login                login.Config
+	Console              console.Config
+	AssetStorage         static_config.AssetStorageConfig
+	InternalAuthZ        authz.Config
+	SystemAuthZ          authz.Config
+	SystemDefaults       systemdefaults.SystemDefaults
+	EncryptionKeys       *encryption.EncryptionKeyConfig
+	DefaultInstance      command.InstanceSetup
+	AuditLogRetention    time.Duration
+	SystemAPIUsers       map[string]*authz.SystemAPIUser
+	CustomerPortal       string
+	Machine              *id.Config
+	Actions              *actions.Config
+	Eventstore           *eventstore.Config
+	LogStore             *logstore.Configs
+	Quotas               *QuotasConfig
+	Telemetry            *handlers.TelemetryPusherConfig
 }
 
 type QuotasConfig struct {
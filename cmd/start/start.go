@@ -32,8 +32,10 @@ import (
 	"github.com/zitadel/zitadel/internal/actions"
 	admin_es "github.com/zitadel/zitadel/internal/admin/repository/eventsourcing"
 	"github.com/zitadel/zitadel/internal/api"
+	"github.com/zitadel/zitadel/internal/api/actionkeys"
 	"github.com/zitadel/zitadel/internal/api/assets"
 	internal_authz "github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/api/bounce"
 	action_v2_beta "github.com/zitadel/zitadel/internal/api/grpc/action/v2beta"
 	"github.com/zitadel/zitadel/internal/api/grpc/admin"
 	"github.com/zitadel/zitadel/internal/api/grpc/auth"
@@ -92,6 +94,7 @@ import (
 	"github.com/zitadel/zitadel/internal/logstore/record"
 	"github.com/zitadel/zitadel/internal/net"
 	"github.com/zitadel/zitadel/internal/notification"
+	"github.com/zitadel/zitadel/internal/provisioning"
 	"github.com/zitadel/zitadel/internal/query"
 	"github.com/zitadel/zitadel/internal/queue"
 	"github.com/zitadel/zitadel/internal/static"
@@ -216,9 +219,14 @@ func startZitadel(ctx context.Context, config *Config, masterKey string, server
 	if err != nil {
 		return fmt.Errorf("cannot start asset storage client: %w", err)
 	}
+	webAuthNMetadata, err := webauthn.LoadMetadata(config.WebAuthNMetadataPath)
+	if err != nil {
+		return fmt.Errorf("cannot load webauthn metadata: %w", err)
+	}
 	webAuthNConfig := &webauthn.Config{
 		DisplayName:    config.WebAuthNName,
 		ExternalSecure: config.ExternalSecure,
+		Metadata:       webAuthNMetadata,
 	}
 	commands, err := command.StartCommands(ctx,
 		eventstoreClient,
@@ -311,6 +319,17 @@ func startZitadel(ctx context.Context, config *Config, masterKey string, server
 		q,
 	)
 	execution.Start(ctx)
+	defer execution.CloseGRPCConns()
+
+	provisioning.Register(
+		ctx,
+		config.Projections.Customizations["provisioning"],
+		config.Provisioning,
+		commands,
+		queries,
+		q,
+	)
+	provisioning.Start(ctx)
 
 	if err = q.Start(ctx); err != nil {
 		return err
@@ -418,7 +437,13 @@ func startAPIs(
 		http_util.WithMaxAge(int(math.Floor(config.Quotas.Access.ExhaustedCookieMaxAge.Seconds()))),
 	)
 	limitingAccessInterceptor := middleware.NewAccessInterceptor(accessSvc, exhaustedCookieHandler, &config.Quotas.Access.AccessConfig)
-	apis, err := api.New(ctx, config.Port, router, queries, verifier, config.SystemAuthZ, config.InternalAuthZ, tlsConfig, config.ExternalDomain, append(config.InstanceHostHeaders, config.PublicHostHeaders...), limitingAccessInterceptor)
+	clientCertificate, err := session_v2.NewClientCertificateSource(config.ClientCertificate)
+	if err != nil {
+		return nil, fmt.Errorf("error creating client certificate source %w", err)
+	}
+	forwardedHeaders := append(config.InstanceHostHeaders, config.PublicHostHeaders...)
+	forwardedHeaders = append(forwardedHeaders, clientCertificate.Headers()...)
+	apis, err := api.New(ctx, config.Port, router, queries, verifier, config.SystemAuthZ, config.InternalAuthZ, tlsConfig, config.ExternalDomain, forwardedHeaders, limitingAccessInterceptor)
 	if err != nil {
 		return nil, fmt.Errorf("error creating api %w", err)
 	}
@@ -469,7 +494,7 @@ func startAPIs(
 	if err := apis.RegisterService(ctx, feature_v2beta.CreateServer(commands, queries)); err != nil {
 		return nil, err
 	}
-	if err := apis.RegisterService(ctx, session_v2.CreateServer(commands, queries, permissionCheck)); err != nil {
+	if err := apis.RegisterService(ctx, session_v2.CreateServer(commands, queries, permissionCheck, clientCertificate)); err != nil {
 		return nil, err
 	}
 	if err := apis.RegisterService(ctx, settings_v2.CreateServer(commands, queries)); err != nil {
@@ -504,6 +529,15 @@ func startAPIs(
 	apis.RegisterHandlerOnPrefix(assets.HandlerPrefix, assets.NewHandler(commands, verifier, config.SystemAuthZ, config.InternalAuthZ, id.SonyFlakeGenerator(), store, queries, middleware.CallDurationHandler, instanceInterceptor.Handler, assetsCache.Handler, limitingAccessInterceptor.Handle))
 
 	apis.RegisterHandlerOnPrefix(idp.HandlerPrefix, idp.NewHandler(commands, queries, keys.IDPConfig, instanceInterceptor.Handler))
+	apis.RegisterHandlerOnPrefix(actionkeys.HandlerPrefix, actionkeys.NewHandler(queries, instanceInterceptor.Handler))
+
+	if config.EmailBounces.Enabled {
+		bounceHandler, err := bounce.NewHandler(config.EmailBounces, commands, queries, instanceInterceptor.Handler)
+		if err != nil {
+			return nil, fmt.Errorf("unable to start email bounce webhook: %w", err)
+		}
+		apis.RegisterHandlerOnPrefix(bounce.HandlerPrefix, bounceHandler)
+	}
 
 	userAgentInterceptor, err := middleware.NewUserAgentHandler(config.UserAgentCookie, keys.UserAgentCookieKey, id.SonyFlakeGenerator(), config.ExternalSecure, login.EndpointResources, login.EndpointExternalLoginCallbackFormPost, login.EndpointSAMLACS)
 	if err != nil {
@@ -524,7 +558,7 @@ func startAPIs(
 	}
 	apis.RegisterHandlerOnPrefix(openapi.HandlerPrefix, openAPIHandler)
 
-	oidcServer, err := oidc.NewServer(ctx, config.OIDC, login.DefaultLoggedOutPath, config.ExternalSecure, commands, queries, authRepo, keys.OIDC, keys.OIDCKey, eventstore, dbClient, userAgentInterceptor, instanceInterceptor.Handler, limitingAccessInterceptor, config.Log.Slog(), config.SystemDefaults.SecretHasher)
+	oidcServer, err := oidc.NewServer(ctx, config.OIDC, login.DefaultLoggedOutPath, config.ExternalSecure, commands, queries, authRepo, keys.OIDC, keys.OIDCKey, eventstore, dbClient, userAgentInterceptor, instanceInterceptor.Handler, limitingAccessInterceptor, config.Log.Slog(), config.SystemDefaults.SecretHasher, cacheConnectors)
 	if err != nil {
 		return nil, fmt.Errorf("unable to start oidc provider: %w", err)
 	}
@@ -344,6 +344,8 @@ module.exports = {
             "guides/integrate/login-ui/external-login",
             "guides/integrate/login-ui/passkey",
             "guides/integrate/login-ui/mfa",
+            "guides/integrate/login-ui/client-certificate",
+            "guides/integrate/login-ui/magic-link",
             "guides/integrate/login-ui/select-account",
             "guides/integrate/login-ui/password-reset",
             "guides/integrate/login-ui/logout",
@@ -56,6 +56,7 @@ func AuthRequestFromDomain(c *actions.FieldConfig, request *domain.AuthRequest)
 		SelectedIdpConfigId:      request.SelectedIDPConfigID,
 		LinkingUsers:             externalUsersFromDomain(request.LinkingUsers),
 		PasswordVerified:         request.PasswordVerified,
+		MagicLinkVerified:        request.MagicLinkVerified,
 		MfasVerified:             request.MFAsVerified,
 		Audience:                 request.Audience,
 		AuthTime:                 request.AuthTime,
@@ -97,6 +98,7 @@ type authRequest struct {
 	SelectedIdpConfigId      string
 	LinkingUsers             []*externalUser
 	PasswordVerified         bool
+	MagicLinkVerified        bool
 	MfasVerified             []domain.MFAType
 	Audience                 []string
 	AuthTime                 time.Time
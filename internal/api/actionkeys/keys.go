@@ -0,0 +1,49 @@
+package actionkeys
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+
+	"github.com/go-jose/go-jose/v4"
+
+	http_utils "github.com/zitadel/zitadel/internal/api/http"
+)
+
+const (
+	HandlerPrefix = "/actions/keys"
+)
+
+type Queries interface {
+	GetTargetKeySet(ctx context.Context) (*jose.JSONWebKeySet, error)
+}
+
+type Handler struct {
+	queries Queries
+}
+
+// NewHandler returns the JSON Web Key Set containing the public keys of all targets of the instance
+// signing their payload with Ed25519, so receivers can verify the ZITADEL-JWS-Signature header.
+func NewHandler(
+	queries Queries,
+	instanceInterceptor func(next http.Handler) http.Handler,
+) http.Handler {
+	return instanceInterceptor(&Handler{queries: queries})
+}
+
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+	keySet, err := h.queries.GetTargetKeySet(r.Context())
+	if err != nil {
+		statusCode, _ := http_utils.ZitadelErrorToHTTPStatusCode(err)
+		http.Error(w, err.Error(), statusCode)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	if err = json.NewEncoder(w).Encode(keySet); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+	}
+}
@@ -0,0 +1,81 @@
+package actionkeys
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/go-jose/go-jose/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zitadel/zitadel/internal/zerrors"
+	"github.com/zitadel/zitadel/pkg/actions"
+)
+
+type testQueries struct {
+	keySet *jose.JSONWebKeySet
+	err    error
+}
+
+func (q *testQueries) GetTargetKeySet(context.Context) (*jose.JSONWebKeySet, error) {
+	return q.keySet, q.err
+}
+
+func TestHandler_ServeHTTP(t *testing.T) {
+	publicKey, _, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	keySet := &jose.JSONWebKeySet{Keys: []jose.JSONWebKey{
+		{Key: publicKey, KeyID: actions.JWSKeyID(publicKey), Algorithm: string(jose.EdDSA), Use: "sig"},
+	}}
+
+	tests := []struct {
+		name       string
+		method     string
+		queries    *testQueries
+		wantStatus int
+		wantKeySet *jose.JSONWebKeySet
+	}{
+		{
+			name:       "wrong method",
+			method:     http.MethodPost,
+			queries:    &testQueries{},
+			wantStatus: http.StatusMethodNotAllowed,
+		},
+		{
+			name:       "query error",
+			method:     http.MethodGet,
+			queries:    &testQueries{err: zerrors.ThrowInternal(nil, "id", "Errors.Internal")},
+			wantStatus: http.StatusInternalServerError,
+		},
+		{
+			name:       "ok",
+			method:     http.MethodGet,
+			queries:    &testQueries{keySet: keySet},
+			wantStatus: http.StatusOK,
+			wantKeySet: keySet,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			handler := NewHandler(tt.queries, func(next http.Handler) http.Handler { return next })
+			recorder := httptest.NewRecorder()
+			handler.ServeHTTP(recorder, httptest.NewRequest(tt.method, HandlerPrefix, nil))
+			assert.Equal(t, tt.wantStatus, recorder.Code)
+			if tt.wantKeySet == nil {
+				return
+			}
+			assert.Equal(t, "application/json", recorder.Header().Get("Content-Type"))
+			got := new(jose.JSONWebKeySet)
+			require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), got))
+			if assert.Len(t, got.Keys, 1) {
+				assert.Equal(t, tt.wantKeySet.Keys[0].KeyID, got.Keys[0].KeyID)
+				assert.Equal(t, publicKey, got.Keys[0].Key)
+			}
+		})
+	}
+}
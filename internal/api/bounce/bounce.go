@@ -0,0 +1,186 @@
+package bounce
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"io"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/zitadel/logging"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	http_utils "github.com/zitadel/zitadel/internal/api/http"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/query"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+const (
+	HandlerPrefix = "/notifications/email/bounces"
+
+	// webhookUserID is set as editor of the events pushed on reports of the email provider
+	webhookUserID = "NOTIFICATION"
+
+	maxBodySize    = 1 << 20
+	confirmTimeout = 10 * time.Second
+	paramToken     = "token"
+)
+
+// snsHostRegex matches the hosts Amazon SNS sends subscription confirmation URLs for
+var snsHostRegex = regexp.MustCompile(`^sns\.[a-z0-9-]+\.amazonaws\.com(\.cn)?$`)
+
+type Config struct {
+	// Enabled registers the webhook on [HandlerPrefix]
+	Enabled bool
+	// Secret is used to derive the token of each instance, see [InstanceToken].
+	// The token must be sent by the email provider as bearer token,
+	// as password of the basic authentication or as token query parameter.
+	Secret string
+}
+
+type Commands interface {
+	MarkHumanEmailUndeliverable(ctx context.Context, userID string, email domain.EmailAddress, reason domain.EmailUndeliverableReason, diagnostic string) (*domain.ObjectDetails, error)
+}
+
+type Queries interface {
+	SearchUsers(ctx context.Context, queries *query.UserSearchQueries, permissionCheck domain.PermissionCheck) (*query.Users, error)
+}
+
+type Handler struct {
+	commands   Commands
+	queries    Queries
+	secret     []byte
+	httpClient *http.Client
+}
+
+// NewHandler returns the webhook email providers report bounced and complained emails to.
+// The users with the reported email addresses will not receive any further emails
+// until their address is changed or verified.
+func NewHandler(
+	config Config,
+	commands Commands,
+	queries Queries,
+	instanceInterceptor func(next http.Handler) http.Handler,
+) (http.Handler, error) {
+	if config.Secret == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "BOUNC-Cfg1e", "email bounce webhook secret must be set")
+	}
+	h := &Handler{
+		commands:   commands,
+		queries:    queries,
+		secret:     []byte(config.Secret),
+		httpClient: &http.Client{Timeout: confirmTimeout},
+	}
+	return instanceInterceptor(h), nil
+}
+
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+	if !h.authorized(r) {
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+	body, err := io.ReadAll(io.LimitReader(r.Body, maxBodySize))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	parsed, err := parse(body)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	ctx := authz.SetCtxData(r.Context(), authz.CtxData{UserID: webhookUserID})
+	if parsed.subscribeURL != "" {
+		if err = h.confirmSubscription(ctx, parsed.subscribeURL); err != nil {
+			statusCode, _ := http_utils.ZitadelErrorToHTTPStatusCode(err)
+			http.Error(w, err.Error(), statusCode)
+			return
+		}
+	}
+	for _, report := range parsed.reports {
+		if err = h.markUndeliverable(ctx, report); err != nil {
+			statusCode, _ := http_utils.ZitadelErrorToHTTPStatusCode(err)
+			http.Error(w, err.Error(), statusCode)
+			return
+		}
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// authorized checks the token of the instance the request was sent to,
+// so a token only allows to report email addresses of its own instance.
+func (h *Handler) authorized(r *http.Request) bool {
+	instanceID := authz.GetInstance(r.Context()).InstanceID()
+	if instanceID == "" {
+		return false
+	}
+	token := r.URL.Query().Get(paramToken)
+	if _, password, ok := r.BasicAuth(); ok {
+		token = password
+	}
+	if bearer, ok := strings.CutPrefix(r.Header.Get(http_utils.Authorization), authz.BearerPrefix); ok {
+		token = bearer
+	}
+	return token != "" && hmac.Equal([]byte(token), []byte(instanceToken(h.secret, instanceID)))
+}
+
+// InstanceToken returns the token the email provider has to send for the instance,
+// which is the base64url encoded (without padding) HMAC-SHA256 of the instance id keyed with the configured secret.
+func InstanceToken(secret, instanceID string) string {
+	return instanceToken([]byte(secret), instanceID)
+}
+
+func instanceToken(secret []byte, instanceID string) string {
+	mac := hmac.New(sha256.New, secret)
+	mac.Write([]byte(instanceID))
+	return base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
+
+// markUndeliverable marks the email address of all users of the instance using the reported address
+func (h *Handler) markUndeliverable(ctx context.Context, report *report) error {
+	emailQuery, err := query.NewUserEmailSearchQuery(report.email, query.TextEqualsIgnoreCase)
+	if err != nil {
+		return err
+	}
+	users, err := h.queries.SearchUsers(ctx, &query.UserSearchQueries{Queries: []query.SearchQuery{emailQuery}}, nil)
+	if err != nil {
+		return err
+	}
+	for _, user := range users.Users {
+		_, err = h.commands.MarkHumanEmailUndeliverable(ctx, user.ID, domain.EmailAddress(report.email), report.reason, report.diagnostic)
+		logging.WithFields("instance", authz.GetInstance(ctx).InstanceID(), "user", user.ID).OnError(err).Warn("unable to mark email as undeliverable")
+	}
+	return nil
+}
+
+// confirmSubscription confirms the subscription of the webhook to an Amazon SNS topic.
+// Only URLs of Amazon SNS are called.
+func (h *Handler) confirmSubscription(ctx context.Context, subscribeURL string) error {
+	u, err := url.Parse(subscribeURL)
+	if err != nil || u.Scheme != "https" || !snsHostRegex.MatchString(u.Hostname()) {
+		return zerrors.ThrowInvalidArgument(err, "BOUNC-Sns2e", "Errors.EmailBounce.InvalidSubscribeURL")
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u.String(), nil)
+	if err != nil {
+		return zerrors.ThrowInternal(err, "BOUNC-Sns3e", "Errors.Internal")
+	}
+	resp, err := h.httpClient.Do(req)
+	if err != nil {
+		return zerrors.ThrowUnavailable(err, "BOUNC-Sns4e", "Errors.EmailBounce.SubscriptionConfirmationFailed")
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return zerrors.ThrowUnavailable(nil, "BOUNC-Sns5e", "Errors.EmailBounce.SubscriptionConfirmationFailed")
+	}
+	return nil
+}
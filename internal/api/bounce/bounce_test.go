@@ -0,0 +1,161 @@
+package bounce
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/query"
+)
+
+type marked struct {
+	userID string
+	email  domain.EmailAddress
+	reason domain.EmailUndeliverableReason
+}
+
+type testCommands struct {
+	marked []marked
+}
+
+func (c *testCommands) MarkHumanEmailUndeliverable(_ context.Context, userID string, email domain.EmailAddress, reason domain.EmailUndeliverableReason, _ string) (*domain.ObjectDetails, error) {
+	c.marked = append(c.marked, marked{userID: userID, email: email, reason: reason})
+	return &domain.ObjectDetails{}, nil
+}
+
+type testQueries struct {
+	users []*query.User
+}
+
+func (q *testQueries) SearchUsers(context.Context, *query.UserSearchQueries, domain.PermissionCheck) (*query.Users, error) {
+	return &query.Users{Users: q.users}, nil
+}
+
+func TestHandler_ServeHTTP(t *testing.T) {
+	const body = `{"type": "bounce", "email": "gigi@example.com"}`
+	token := InstanceToken("secret", "instance1")
+	tests := []struct {
+		name       string
+		noInstance bool
+		method     string
+		target     string
+		header     http.Header
+		body       string
+		wantStatus int
+		wantMarked []marked
+	}{
+		{
+			name:       "wrong method",
+			method:     http.MethodGet,
+			target:     HandlerPrefix + "?token=" + token,
+			wantStatus: http.StatusMethodNotAllowed,
+		},
+		{
+			name:       "missing secret",
+			method:     http.MethodPost,
+			target:     HandlerPrefix,
+			body:       body,
+			wantStatus: http.StatusUnauthorized,
+		},
+		{
+			name:       "wrong secret",
+			method:     http.MethodPost,
+			target:     HandlerPrefix + "?token=wrong",
+			body:       body,
+			wantStatus: http.StatusUnauthorized,
+		},
+		{
+			name:       "configured secret",
+			method:     http.MethodPost,
+			target:     HandlerPrefix + "?token=secret",
+			body:       body,
+			wantStatus: http.StatusUnauthorized,
+		},
+		{
+			name:       "token of other instance",
+			method:     http.MethodPost,
+			target:     HandlerPrefix + "?token=" + InstanceToken("secret", "instance2"),
+			body:       body,
+			wantStatus: http.StatusUnauthorized,
+		},
+		{
+			name:       "missing instance",
+			noInstance: true,
+			method:     http.MethodPost,
+			target:     HandlerPrefix + "?token=" + token,
+			body:       body,
+			wantStatus: http.StatusUnauthorized,
+		},
+		{
+			name:       "invalid body",
+			method:     http.MethodPost,
+			target:     HandlerPrefix + "?token=" + token,
+			body:       `{"type": "unknown"}`,
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "query token, marked",
+			method:     http.MethodPost,
+			target:     HandlerPrefix + "?token=" + token,
+			body:       body,
+			wantStatus: http.StatusNoContent,
+			wantMarked: []marked{
+				{userID: "user1", email: "gigi@example.com", reason: domain.EmailUndeliverableReasonBounce},
+				{userID: "user2", email: "gigi@example.com", reason: domain.EmailUndeliverableReasonBounce},
+			},
+		},
+		{
+			name:       "bearer token, marked",
+			method:     http.MethodPost,
+			target:     HandlerPrefix,
+			header:     http.Header{"Authorization": []string{"Bearer " + token}},
+			body:       body,
+			wantStatus: http.StatusNoContent,
+			wantMarked: []marked{
+				{userID: "user1", email: "gigi@example.com", reason: domain.EmailUndeliverableReasonBounce},
+				{userID: "user2", email: "gigi@example.com", reason: domain.EmailUndeliverableReasonBounce},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			commands := new(testCommands)
+			h := &Handler{
+				commands: commands,
+				queries: &testQueries{
+					users: []*query.User{{ID: "user1"}, {ID: "user2"}},
+				},
+				secret:     []byte("secret"),
+				httpClient: http.DefaultClient,
+			}
+			req := httptest.NewRequest(tt.method, tt.target, strings.NewReader(tt.body))
+			if !tt.noInstance {
+				req = req.WithContext(authz.WithInstanceID(req.Context(), "instance1"))
+			}
+			for key, values := range tt.header {
+				req.Header[key] = values
+			}
+			rec := httptest.NewRecorder()
+			h.ServeHTTP(rec, req)
+			assert.Equal(t, tt.wantStatus, rec.Code)
+			assert.Equal(t, tt.wantMarked, commands.marked)
+		})
+	}
+}
+
+func TestHandler_confirmSubscription_invalidURL(t *testing.T) {
+	h := &Handler{httpClient: http.DefaultClient}
+	for _, subscribeURL := range []string{
+		"http://sns.eu-central-1.amazonaws.com/?Action=ConfirmSubscription",
+		"https://example.com/?Action=ConfirmSubscription",
+		"https://sns.eu-central-1.amazonaws.com.example.com/",
+	} {
+		assert.Error(t, h.confirmSubscription(context.Background(), subscribeURL), subscribeURL)
+	}
+}
@@ -0,0 +1,224 @@
+package bounce
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// maxDiagnosticLength limits the diagnostic message of the provider stored on the event
+const maxDiagnosticLength = 500
+
+// report is a permanent bounce or a complaint for a single email address
+type report struct {
+	email      string
+	reason     domain.EmailUndeliverableReason
+	diagnostic string
+}
+
+// notification is the parsed body of a bounce webhook call
+type notification struct {
+	reports []*report
+	// subscribeURL is set if Amazon SNS requests to confirm the subscription of the webhook
+	subscribeURL string
+}
+
+// parse detects the format of the body and returns the reported email addresses.
+// The following formats are supported:
+//   - the generic format as single object or array: {"type": "bounce", "email": "...", "bounceType": "permanent", "diagnostic": "..."}
+//   - SendGrid event webhooks: [{"event": "bounce", "type": "bounce", "email": "...", "reason": "..."}]
+//   - Amazon SES notifications, either wrapped in an SNS message or delivered raw
+//
+// Transient (soft) bounces are ignored.
+func parse(body []byte) (*notification, error) {
+	body = bytes.TrimSpace(body)
+	if len(body) == 0 {
+		return nil, zerrors.ThrowInvalidArgument(nil, "BOUNC-Prs1e", "Errors.EmailBounce.Invalid")
+	}
+	if body[0] == '[' {
+		var objects []json.RawMessage
+		if err := json.Unmarshal(body, &objects); err != nil {
+			return nil, zerrors.ThrowInvalidArgument(err, "BOUNC-Prs2e", "Errors.EmailBounce.Invalid")
+		}
+		n := new(notification)
+		for _, object := range objects {
+			parsed, err := parseObject(object)
+			if err != nil {
+				return nil, err
+			}
+			n.reports = append(n.reports, parsed.reports...)
+		}
+		return n, nil
+	}
+	return parseObject(body)
+}
+
+func parseObject(object []byte) (*notification, error) {
+	var keys map[string]json.RawMessage
+	if err := json.Unmarshal(object, &keys); err != nil {
+		return nil, zerrors.ThrowInvalidArgument(err, "BOUNC-Prs3e", "Errors.EmailBounce.Invalid")
+	}
+	_, isSNS := keys["TopicArn"]
+	_, isSESNotification := keys["notificationType"]
+	_, isSESEvent := keys["eventType"]
+	_, isSendGrid := keys["event"]
+	switch {
+	case isSNS:
+		return parseSNS(object)
+	case isSESNotification, isSESEvent:
+		return parseSES(object)
+	case isSendGrid:
+		return parseSendGrid(object)
+	default:
+		return parseGeneric(object)
+	}
+}
+
+type genericReport struct {
+	Type       string `json:"type"`
+	Email      string `json:"email"`
+	BounceType string `json:"bounceType"`
+	Diagnostic string `json:"diagnostic"`
+}
+
+func parseGeneric(object []byte) (*notification, error) {
+	r := new(genericReport)
+	if err := json.Unmarshal(object, r); err != nil {
+		return nil, zerrors.ThrowInvalidArgument(err, "BOUNC-Gen1e", "Errors.EmailBounce.Invalid")
+	}
+	if r.Email == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "BOUNC-Gen2e", "Errors.EmailBounce.Invalid")
+	}
+	switch strings.ToLower(r.Type) {
+	case "bounce":
+		if r.BounceType != "" && !strings.EqualFold(r.BounceType, "permanent") {
+			return new(notification), nil
+		}
+		return reports(domain.EmailUndeliverableReasonBounce, r.Diagnostic, r.Email), nil
+	case "complaint":
+		return reports(domain.EmailUndeliverableReasonComplaint, r.Diagnostic, r.Email), nil
+	default:
+		return nil, zerrors.ThrowInvalidArgument(nil, "BOUNC-Gen3e", "Errors.EmailBounce.Invalid")
+	}
+}
+
+type sendGridEvent struct {
+	Event  string `json:"event"`
+	Type   string `json:"type"`
+	Email  string `json:"email"`
+	Reason string `json:"reason"`
+}
+
+func parseSendGrid(object []byte) (*notification, error) {
+	e := new(sendGridEvent)
+	if err := json.Unmarshal(object, e); err != nil {
+		return nil, zerrors.ThrowInvalidArgument(err, "BOUNC-Sgr1e", "Errors.EmailBounce.Invalid")
+	}
+	switch e.Event {
+	case "bounce":
+		// blocked messages are soft bounces
+		if e.Type == "blocked" {
+			return new(notification), nil
+		}
+		return reports(domain.EmailUndeliverableReasonBounce, e.Reason, e.Email), nil
+	case "spamreport":
+		return reports(domain.EmailUndeliverableReasonComplaint, e.Reason, e.Email), nil
+	default:
+		// other events like delivered, open or dropped are not relevant
+		return new(notification), nil
+	}
+}
+
+type snsMessage struct {
+	Type         string `json:"Type"`
+	Message      string `json:"Message"`
+	SubscribeURL string `json:"SubscribeURL"`
+}
+
+func parseSNS(object []byte) (*notification, error) {
+	m := new(snsMessage)
+	if err := json.Unmarshal(object, m); err != nil {
+		return nil, zerrors.ThrowInvalidArgument(err, "BOUNC-Sns1e", "Errors.EmailBounce.Invalid")
+	}
+	switch m.Type {
+	case "SubscriptionConfirmation":
+		return &notification{subscribeURL: m.SubscribeURL}, nil
+	case "Notification":
+		return parseSES([]byte(m.Message))
+	default:
+		return new(notification), nil
+	}
+}
+
+type sesNotification struct {
+	NotificationType string `json:"notificationType"`
+	EventType        string `json:"eventType"`
+	Bounce           *struct {
+		BounceType        string `json:"bounceType"`
+		BouncedRecipients []struct {
+			EmailAddress   string `json:"emailAddress"`
+			DiagnosticCode string `json:"diagnosticCode"`
+		} `json:"bouncedRecipients"`
+	} `json:"bounce"`
+	Complaint *struct {
+		ComplaintFeedbackType string `json:"complaintFeedbackType"`
+		ComplainedRecipients  []struct {
+			EmailAddress string `json:"emailAddress"`
+		} `json:"complainedRecipients"`
+	} `json:"complaint"`
+}
+
+func parseSES(object []byte) (*notification, error) {
+	n := new(sesNotification)
+	if err := json.Unmarshal(object, n); err != nil {
+		return nil, zerrors.ThrowInvalidArgument(err, "BOUNC-Ses1e", "Errors.EmailBounce.Invalid")
+	}
+	notificationType := n.NotificationType
+	if notificationType == "" {
+		notificationType = n.EventType
+	}
+	switch notificationType {
+	case "Bounce":
+		if n.Bounce == nil || n.Bounce.BounceType != "Permanent" {
+			return new(notification), nil
+		}
+		parsed := new(notification)
+		for _, recipient := range n.Bounce.BouncedRecipients {
+			parsed.reports = append(parsed.reports, reports(domain.EmailUndeliverableReasonBounce, recipient.DiagnosticCode, recipient.EmailAddress).reports...)
+		}
+		return parsed, nil
+	case "Complaint":
+		if n.Complaint == nil {
+			return new(notification), nil
+		}
+		emails := make([]string, len(n.Complaint.ComplainedRecipients))
+		for i, recipient := range n.Complaint.ComplainedRecipients {
+			emails[i] = recipient.EmailAddress
+		}
+		return reports(domain.EmailUndeliverableReasonComplaint, n.Complaint.ComplaintFeedbackType, emails...), nil
+	default:
+		return new(notification), nil
+	}
+}
+
+func reports(reason domain.EmailUndeliverableReason, diagnostic string, emails ...string) *notification {
+	if len(diagnostic) > maxDiagnosticLength {
+		diagnostic = strings.ToValidUTF8(diagnostic[:maxDiagnosticLength], "")
+	}
+	n := &notification{reports: make([]*report, 0, len(emails))}
+	for _, email := range emails {
+		email = strings.TrimSpace(email)
+		if email == "" {
+			continue
+		}
+		n.reports = append(n.reports, &report{
+			email:      email,
+			reason:     reason,
+			diagnostic: diagnostic,
+		})
+	}
+	return n
+}
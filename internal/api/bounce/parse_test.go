@@ -0,0 +1,110 @@
+package bounce
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+func Test_parse(t *testing.T) {
+	tests := []struct {
+		name    string
+		body    string
+		want    *notification
+		wantErr func(error) bool
+	}{
+		{
+			name:    "empty body, error",
+			body:    "",
+			wantErr: zerrors.IsErrorInvalidArgument,
+		},
+		{
+			name:    "invalid json, error",
+			body:    "{",
+			wantErr: zerrors.IsErrorInvalidArgument,
+		},
+		{
+			name:    "generic unknown type, error",
+			body:    `{"type": "delivered", "email": "gigi@example.com"}`,
+			wantErr: zerrors.IsErrorInvalidArgument,
+		},
+		{
+			name:    "generic missing email, error",
+			body:    `{"type": "bounce"}`,
+			wantErr: zerrors.IsErrorInvalidArgument,
+		},
+		{
+			name: "generic bounce",
+			body: `{"type": "bounce", "email": "gigi@example.com", "bounceType": "permanent", "diagnostic": "mailbox unknown"}`,
+			want: &notification{reports: []*report{
+				{email: "gigi@example.com", reason: domain.EmailUndeliverableReasonBounce, diagnostic: "mailbox unknown"},
+			}},
+		},
+		{
+			name: "generic transient bounce, ignored",
+			body: `{"type": "bounce", "email": "gigi@example.com", "bounceType": "transient"}`,
+			want: &notification{},
+		},
+		{
+			name: "generic list",
+			body: `[{"type": "complaint", "email": "gigi@example.com"}, {"type": "bounce", "email": "mini@example.com"}]`,
+			want: &notification{reports: []*report{
+				{email: "gigi@example.com", reason: domain.EmailUndeliverableReasonComplaint},
+				{email: "mini@example.com", reason: domain.EmailUndeliverableReasonBounce},
+			}},
+		},
+		{
+			name: "sendgrid events",
+			body: `[
+				{"event": "delivered", "email": "ok@example.com"},
+				{"event": "bounce", "type": "blocked", "email": "soft@example.com", "reason": "try again later"},
+				{"event": "bounce", "type": "bounce", "email": "gigi@example.com", "reason": "550 5.1.1 user unknown"},
+				{"event": "spamreport", "email": "mini@example.com"}
+			]`,
+			want: &notification{reports: []*report{
+				{email: "gigi@example.com", reason: domain.EmailUndeliverableReasonBounce, diagnostic: "550 5.1.1 user unknown"},
+				{email: "mini@example.com", reason: domain.EmailUndeliverableReasonComplaint},
+			}},
+		},
+		{
+			name: "ses raw bounce",
+			body: `{"notificationType": "Bounce", "bounce": {"bounceType": "Permanent", "bouncedRecipients": [{"emailAddress": "gigi@example.com", "diagnosticCode": "smtp; 550 user unknown"}]}}`,
+			want: &notification{reports: []*report{
+				{email: "gigi@example.com", reason: domain.EmailUndeliverableReasonBounce, diagnostic: "smtp; 550 user unknown"},
+			}},
+		},
+		{
+			name: "ses transient bounce, ignored",
+			body: `{"eventType": "Bounce", "bounce": {"bounceType": "Transient", "bouncedRecipients": [{"emailAddress": "gigi@example.com"}]}}`,
+			want: &notification{},
+		},
+		{
+			name: "ses complaint in sns message",
+			body: `{"Type": "Notification", "TopicArn": "arn:aws:sns:eu-central-1:123456789012:bounces", "Message": "{\"notificationType\": \"Complaint\", \"complaint\": {\"complaintFeedbackType\": \"abuse\", \"complainedRecipients\": [{\"emailAddress\": \"gigi@example.com\"}]}}"}`,
+			want: &notification{reports: []*report{
+				{email: "gigi@example.com", reason: domain.EmailUndeliverableReasonComplaint, diagnostic: "abuse"},
+			}},
+		},
+		{
+			name: "sns subscription confirmation",
+			body: `{"Type": "SubscriptionConfirmation", "TopicArn": "arn:aws:sns:eu-central-1:123456789012:bounces", "SubscribeURL": "https://sns.eu-central-1.amazonaws.com/?Action=ConfirmSubscription"}`,
+			want: &notification{subscribeURL: "https://sns.eu-central-1.amazonaws.com/?Action=ConfirmSubscription"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parse([]byte(tt.body))
+			if tt.wantErr != nil {
+				assert.True(t, tt.wantErr(err), "unexpected error: %v", err)
+				return
+			}
+			require.NoError(t, err)
+			assert.ElementsMatch(t, tt.want.reports, got.reports)
+			assert.Equal(t, tt.want.subscribeURL, got.subscribeURL)
+		})
+	}
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/brianvoe/gofakeit/v6"
 	"github.com/muhlemmer/gu"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"google.golang.org/protobuf/types/known/durationpb"
 
 	"github.com/zitadel/zitadel/internal/domain"
@@ -239,6 +240,12 @@ func assertCreateTargetResponse(t *testing.T, creationDate, changeDate time.Time
 	} else {
 		assert.Nil(t, actualResp.SigningKey)
 	}
+
+	if expectedNextSigningKey {
+		assert.NotEmpty(t, actualResp.GetNextSigningKey())
+	} else {
+		assert.Nil(t, actualResp.NextSigningKey)
+	}
 }
 
 func TestServer_UpdateTarget(t *testing.T) {
@@ -249,9 +256,10 @@ func TestServer_UpdateTarget(t *testing.T) {
 		req *action.UpdateTargetRequest
 	}
 	type want struct {
-		change     bool
-		changeDate bool
-		signingKey bool
+		change         bool
+		changeDate     bool
+		signingKey     bool
+		nextSigningKey bool
 	}
 	tests := []struct {
 		name    string
@@ -342,6 +350,78 @@ func TestServer_UpdateTarget(t *testing.T) {
 				signingKey: true,
 			},
 		},
+		{
+			name: "generate next signingkey, ok",
+			prepare: func(request *action.UpdateTargetRequest) {
+				targetID := instance.CreateTarget(isolatedIAMOwnerCTX, t, "", "https://example.com", domain.TargetTypeWebhook, false).GetId()
+				request.Id = targetID
+			},
+			args: args{
+				ctx: isolatedIAMOwnerCTX,
+				req: &action.UpdateTargetRequest{
+					GenerateNextSigningKey: true,
+				},
+			},
+			want: want{
+				change:         true,
+				changeDate:     true,
+				nextSigningKey: true,
+			},
+		},
+		{
+			name: "activate next signingkey, ok",
+			prepare: func(request *action.UpdateTargetRequest) {
+				targetID := instance.CreateTarget(isolatedIAMOwnerCTX, t, "", "https://example.com", domain.TargetTypeWebhook, false).GetId()
+				_, err := instance.Client.ActionV2beta.UpdateTarget(isolatedIAMOwnerCTX, &action.UpdateTargetRequest{
+					Id:                     targetID,
+					GenerateNextSigningKey: true,
+				})
+				require.NoError(t, err)
+				request.Id = targetID
+			},
+			args: args{
+				ctx: isolatedIAMOwnerCTX,
+				req: &action.UpdateTargetRequest{
+					ActivateNextSigningKey: true,
+				},
+			},
+			want: want{
+				change:     true,
+				changeDate: true,
+			},
+		},
+		{
+			name: "activate next signingkey, no next key",
+			prepare: func(request *action.UpdateTargetRequest) {
+				targetID := instance.CreateTarget(isolatedIAMOwnerCTX, t, "", "https://example.com", domain.TargetTypeWebhook, false).GetId()
+				request.Id = targetID
+			},
+			args: args{
+				ctx: isolatedIAMOwnerCTX,
+				req: &action.UpdateTargetRequest{
+					ActivateNextSigningKey: true,
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "change signing algorithm, ok",
+			prepare: func(request *action.UpdateTargetRequest) {
+				targetID := instance.CreateTarget(isolatedIAMOwnerCTX, t, "", "https://example.com", domain.TargetTypeWebhook, false).GetId()
+				request.Id = targetID
+			},
+			args: args{
+				ctx: isolatedIAMOwnerCTX,
+				req: &action.UpdateTargetRequest{
+					SigningAlgorithm: gu.Ptr(action.TargetSigningAlgorithm_TARGET_SIGNING_ALGORITHM_ED25519),
+				},
+			},
+			want: want{
+				change:     true,
+				changeDate: true,
+				signingKey: false,
+			},
+		},
 		{
 			name: "change type, ok",
 			prepare: func(request *action.UpdateTargetRequest) {
@@ -436,12 +516,12 @@ func TestServer_UpdateTarget(t *testing.T) {
 				changeDate = time.Now().UTC()
 			}
 			assert.NoError(t, err)
-			assertUpdateTargetResponse(t, creationDate, changeDate, tt.want.changeDate, tt.want.signingKey, got)
+			assertUpdateTargetResponse(t, creationDate, changeDate, tt.want.changeDate, tt.want.signingKey, tt.want.nextSigningKey, got)
 		})
 	}
 }
 
-func assertUpdateTargetResponse(t *testing.T, creationDate, changeDate time.Time, expectedChangeDate, expectedSigningKey bool, actualResp *action.UpdateTargetResponse) {
+func assertUpdateTargetResponse(t *testing.T, creationDate, changeDate time.Time, expectedChangeDate, expectedSigningKey, expectedNextSigningKey bool, actualResp *action.UpdateTargetResponse) {
 	if expectedChangeDate {
 		if !changeDate.IsZero() {
 			assert.WithinRange(t, actualResp.GetChangeDate().AsTime(), creationDate, changeDate)
@@ -457,6 +537,12 @@ func assertUpdateTargetResponse(t *testing.T, creationDate, changeDate time.Time
 	} else {
 		assert.Nil(t, actualResp.SigningKey)
 	}
+
+	if expectedNextSigningKey {
+		assert.NotEmpty(t, actualResp.GetNextSigningKey())
+	} else {
+		assert.Nil(t, actualResp.NextSigningKey)
+	}
 }
 
 func TestServer_DeleteTarget(t *testing.T) {
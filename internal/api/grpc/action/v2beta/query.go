@@ -81,11 +81,14 @@ func targetsToPb(targets []*query.Target) []*action.Target {
 
 func targetToPb(t *query.Target) *action.Target {
 	target := &action.Target{
-		Id:         t.ObjectDetails.ID,
-		Name:       t.Name,
-		Timeout:    durationpb.New(t.Timeout),
-		Endpoint:   t.Endpoint,
-		SigningKey: t.SigningKey,
+		Id:               t.ObjectDetails.ID,
+		Name:             t.Name,
+		Timeout:          durationpb.New(t.Timeout),
+		Endpoint:         t.Endpoint,
+		SigningKey:       t.SigningKey,
+		NextSigningKey:   t.NextSigningKey,
+		Transport:        targetTransportToPb(t.Transport),
+		SigningAlgorithm: targetSigningAlgorithmToPb(t.SigningAlgorithm),
 	}
 	switch t.TargetType {
 	case domain.TargetTypeWebhook:
@@ -104,9 +107,34 @@ func targetToPb(t *query.Target) *action.Target {
 	if !t.ObjectDetails.CreationDate.IsZero() {
 		target.CreationDate = timestamppb.New(t.ObjectDetails.CreationDate)
 	}
+	if t.NextSigningKeyCreationDate != nil {
+		target.NextSigningKeyCreationDate = timestamppb.New(*t.NextSigningKeyCreationDate)
+	}
 	return target
 }
 
+func targetTransportToPb(transport domain.TargetTransport) action.TargetTransport {
+	switch transport {
+	case domain.TargetTransportHTTP:
+		return action.TargetTransport_TARGET_TRANSPORT_HTTP
+	case domain.TargetTransportGRPC:
+		return action.TargetTransport_TARGET_TRANSPORT_GRPC
+	default:
+		return action.TargetTransport_TARGET_TRANSPORT_UNSPECIFIED
+	}
+}
+
+func targetSigningAlgorithmToPb(alg domain.TargetSigningAlgorithm) action.TargetSigningAlgorithm {
+	switch alg {
+	case domain.TargetSigningAlgorithmHMACSHA256:
+		return action.TargetSigningAlgorithm_TARGET_SIGNING_ALGORITHM_HMAC_SHA256
+	case domain.TargetSigningAlgorithmEd25519:
+		return action.TargetSigningAlgorithm_TARGET_SIGNING_ALGORITHM_ED25519
+	default:
+		return action.TargetSigningAlgorithm_TARGET_SIGNING_ALGORITHM_UNSPECIFIED
+	}
+}
+
 func (s *Server) ListTargetsRequestToModel(req *action.ListTargetsRequest) (*query.TargetSearchQueries, error) {
 	offset, limit, asc, err := filter.PaginationPbToQuery(s.systemDefaults, req.Pagination)
 	if err != nil {
@@ -43,8 +43,9 @@ func (s *Server) UpdateTarget(ctx context.Context, req *action.UpdateTargetReque
 		changeDate = timestamppb.New(changedAt)
 	}
 	return &action.UpdateTargetResponse{
-		ChangeDate: changeDate,
-		SigningKey: update.SigningKey,
+		ChangeDate:     changeDate,
+		SigningKey:     update.SigningKey,
+		NextSigningKey: update.NextSigningKey,
 	}, nil
 }
 
@@ -81,19 +82,15 @@ func createTargetToCommand(req *action.CreateTargetRequest) *command.AddTarget {
 	return &command.AddTarget{
 		Name:             req.GetName(),
 		TargetType:       targetType,
+		Transport:        targetTransportToDomain(req.GetTransport()),
 		Endpoint:         req.GetEndpoint(),
 		Timeout:          req.GetTimeout().AsDuration(),
 		InterruptOnError: interruptOnError,
+		SigningAlgorithm: targetSigningAlgorithmToDomain(req.GetSigningAlgorithm()),
 	}
 }
 
 func updateTargetToCommand(req *action.UpdateTargetRequest) *command.ChangeTarget {
-	expirationSigningKey := false
-	// TODO handle expiration, currently only immediate expiration is supported
-	if req.GetExpirationSigningKey() != nil {
-		expirationSigningKey = true
-	}
-
 	if req == nil {
 		return nil
 	}
@@ -101,9 +98,11 @@ func updateTargetToCommand(req *action.UpdateTargetRequest) *command.ChangeTarge
 		ObjectRoot: models.ObjectRoot{
 			AggregateID: req.GetId(),
 		},
-		Name:                 req.Name,
-		Endpoint:             req.Endpoint,
-		ExpirationSigningKey: expirationSigningKey,
+		Name:                   req.Name,
+		Endpoint:               req.Endpoint,
+		ExpirationSigningKey:   req.GetExpirationSigningKey() != nil,
+		GenerateNextSigningKey: req.GetGenerateNextSigningKey(),
+		ActivateNextSigningKey: req.GetActivateNextSigningKey(),
 	}
 	if req.TargetType != nil {
 		switch t := req.GetTargetType().(type) {
@@ -121,5 +120,35 @@ func updateTargetToCommand(req *action.UpdateTargetRequest) *command.ChangeTarge
 	if req.Timeout != nil {
 		target.Timeout = gu.Ptr(req.GetTimeout().AsDuration())
 	}
+	if req.Transport != nil {
+		target.Transport = gu.Ptr(targetTransportToDomain(req.GetTransport()))
+	}
+	if req.SigningAlgorithm != nil {
+		target.SigningAlgorithm = gu.Ptr(targetSigningAlgorithmToDomain(req.GetSigningAlgorithm()))
+	}
 	return target
 }
+
+func targetTransportToDomain(transport action.TargetTransport) domain.TargetTransport {
+	switch transport {
+	case action.TargetTransport_TARGET_TRANSPORT_GRPC:
+		return domain.TargetTransportGRPC
+	case action.TargetTransport_TARGET_TRANSPORT_UNSPECIFIED,
+		action.TargetTransport_TARGET_TRANSPORT_HTTP:
+		return domain.TargetTransportHTTP
+	default:
+		return domain.TargetTransportHTTP
+	}
+}
+
+func targetSigningAlgorithmToDomain(alg action.TargetSigningAlgorithm) domain.TargetSigningAlgorithm {
+	switch alg {
+	case action.TargetSigningAlgorithm_TARGET_SIGNING_ALGORITHM_ED25519:
+		return domain.TargetSigningAlgorithmEd25519
+	case action.TargetSigningAlgorithm_TARGET_SIGNING_ALGORITHM_UNSPECIFIED,
+		action.TargetSigningAlgorithm_TARGET_SIGNING_ALGORITHM_HMAC_SHA256:
+		return domain.TargetSigningAlgorithmHMACSHA256
+	default:
+		return domain.TargetSigningAlgorithmHMACSHA256
+	}
+}
@@ -88,6 +88,44 @@ func Test_createTargetToCommand(t *testing.T) {
 				InterruptOnError: true,
 			},
 		},
+		{
+			name: "all fields (grpc transport)",
+			args: args{&action.CreateTargetRequest{
+				Name:     "target 1",
+				Endpoint: "https://example.com:443",
+				TargetType: &action.CreateTargetRequest_RestCall{
+					RestCall: &action.RESTCall{},
+				},
+				Timeout:   durationpb.New(10 * time.Second),
+				Transport: action.TargetTransport_TARGET_TRANSPORT_GRPC,
+			}},
+			want: &command.AddTarget{
+				Name:       "target 1",
+				TargetType: domain.TargetTypeCall,
+				Transport:  domain.TargetTransportGRPC,
+				Endpoint:   "https://example.com:443",
+				Timeout:    10 * time.Second,
+			},
+		},
+		{
+			name: "all fields (ed25519 signing)",
+			args: args{&action.CreateTargetRequest{
+				Name:     "target 1",
+				Endpoint: "https://example.com/hooks/1",
+				TargetType: &action.CreateTargetRequest_RestWebhook{
+					RestWebhook: &action.RESTWebhook{},
+				},
+				Timeout:          durationpb.New(10 * time.Second),
+				SigningAlgorithm: action.TargetSigningAlgorithm_TARGET_SIGNING_ALGORITHM_ED25519,
+			}},
+			want: &command.AddTarget{
+				Name:             "target 1",
+				TargetType:       domain.TargetTypeWebhook,
+				Endpoint:         "https://example.com/hooks/1",
+				Timeout:          10 * time.Second,
+				SigningAlgorithm: domain.TargetSigningAlgorithmEd25519,
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -219,6 +257,51 @@ func Test_updateTargetToCommand(t *testing.T) {
 				InterruptOnError: gu.Ptr(true),
 			},
 		},
+		{
+			name: "transport",
+			args: args{&action.UpdateTargetRequest{
+				Transport: gu.Ptr(action.TargetTransport_TARGET_TRANSPORT_GRPC),
+			}},
+			want: &command.ChangeTarget{
+				Transport: gu.Ptr(domain.TargetTransportGRPC),
+			},
+		},
+		{
+			name: "signing key expiration",
+			args: args{&action.UpdateTargetRequest{
+				ExpirationSigningKey: durationpb.New(0),
+			}},
+			want: &command.ChangeTarget{
+				ExpirationSigningKey: true,
+			},
+		},
+		{
+			name: "signing algorithm",
+			args: args{&action.UpdateTargetRequest{
+				SigningAlgorithm: gu.Ptr(action.TargetSigningAlgorithm_TARGET_SIGNING_ALGORITHM_ED25519),
+			}},
+			want: &command.ChangeTarget{
+				SigningAlgorithm: gu.Ptr(domain.TargetSigningAlgorithmEd25519),
+			},
+		},
+		{
+			name: "generate next signing key",
+			args: args{&action.UpdateTargetRequest{
+				GenerateNextSigningKey: true,
+			}},
+			want: &command.ChangeTarget{
+				GenerateNextSigningKey: true,
+			},
+		},
+		{
+			name: "activate next signing key",
+			args: args{&action.UpdateTargetRequest{
+				ActivateNextSigningKey: true,
+			}},
+			want: &command.ChangeTarget{
+				ActivateNextSigningKey: true,
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -451,6 +451,10 @@ func (s *Server) getLoginPolicy(ctx context.Context, orgID string, orgIDPs []str
 			MfaInitSkipLifetime:        mfaInitSkip,
 			SecondFactorCheckLifetime:  secondFactor,
 			MultiFactorCheckLifetime:   multiFactor,
+			ForceMfaOnHighRisk:         queriedLogin.ForceMFAOnHighRisk,
+			TrustedDeviceLifetime:      durationpb.New(time.Duration(queriedLogin.TrustedDeviceLifetime)),
+			AllowAccountRecovery:       queriedLogin.AllowAccountRecovery,
+			AllowMagicLink:             queriedLogin.AllowMagicLink,
 			SecondFactors:              secondFactors,
 			MultiFactors:               multiFactors,
 			Idps:                       idpLinks,
@@ -497,8 +501,13 @@ func (s *Server) getLockoutPolicy(ctx context.Context, orgID string) (_ *managem
 	}
 	if !queriedLockout.IsDefault {
 		return &management_pb.AddCustomLockoutPolicyRequest{
-			MaxPasswordAttempts: uint32(queriedLockout.MaxPasswordAttempts),
-			MaxOtpAttempts:      uint32(queriedLockout.MaxOTPAttempts),
+			MaxPasswordAttempts:   uint32(queriedLockout.MaxPasswordAttempts),
+			MaxOtpAttempts:        uint32(queriedLockout.MaxOTPAttempts),
+			MaxIpAttempts:         uint32(queriedLockout.MaxIPAttempts),
+			MaxClientAttempts:     uint32(queriedLockout.MaxClientAttempts),
+			ThrottleWindow:        durationpb.New(time.Duration(queriedLockout.ThrottleWindow)),
+			ThrottleDelay:         durationpb.New(time.Duration(queriedLockout.ThrottleDelay)),
+			ThrottleBlockDuration: durationpb.New(time.Duration(queriedLockout.ThrottleBlockDuration)),
 		}, nil
 	}
 	return nil, nil
@@ -514,11 +523,14 @@ func (s *Server) getPasswordComplexityPolicy(ctx context.Context, orgID string)
 	}
 	if !queriedPasswordComplexity.IsDefault {
 		return &management_pb.AddCustomPasswordComplexityPolicyRequest{
-			MinLength:    queriedPasswordComplexity.MinLength,
-			HasUppercase: queriedPasswordComplexity.HasUppercase,
-			HasLowercase: queriedPasswordComplexity.HasLowercase,
-			HasNumber:    queriedPasswordComplexity.HasNumber,
-			HasSymbol:    queriedPasswordComplexity.HasSymbol,
+			MinLength:           queriedPasswordComplexity.MinLength,
+			HasUppercase:        queriedPasswordComplexity.HasUppercase,
+			HasLowercase:        queriedPasswordComplexity.HasLowercase,
+			HasNumber:           queriedPasswordComplexity.HasNumber,
+			HasSymbol:           queriedPasswordComplexity.HasSymbol,
+			HistoryDepth:        queriedPasswordComplexity.HistoryDepth,
+			CheckBreached:       queriedPasswordComplexity.CheckBreached,
+			ForceChangeBreached: queriedPasswordComplexity.ForceChangeBreached,
 		}, nil
 	}
 	return nil, nil
@@ -7,7 +7,12 @@ import (
 
 func UpdateLockoutPolicyToDomain(p *admin.UpdateLockoutPolicyRequest) *domain.LockoutPolicy {
 	return &domain.LockoutPolicy{
-		MaxPasswordAttempts: uint64(p.MaxPasswordAttempts),
-		MaxOTPAttempts:      uint64(p.MaxOtpAttempts),
+		MaxPasswordAttempts:   uint64(p.MaxPasswordAttempts),
+		MaxOTPAttempts:        uint64(p.MaxOtpAttempts),
+		MaxIPAttempts:         uint64(p.MaxIpAttempts),
+		MaxClientAttempts:     uint64(p.MaxClientAttempts),
+		ThrottleWindow:        p.GetThrottleWindow().AsDuration(),
+		ThrottleDelay:         p.GetThrottleDelay().AsDuration(),
+		ThrottleBlockDuration: p.GetThrottleBlockDuration().AsDuration(),
 	}
 }
@@ -27,6 +27,10 @@ func updateLoginPolicyToCommand(p *admin_pb.UpdateLoginPolicyRequest) *command.C
 		MFAInitSkipLifetime:        p.MfaInitSkipLifetime.AsDuration(),
 		SecondFactorCheckLifetime:  p.SecondFactorCheckLifetime.AsDuration(),
 		MultiFactorCheckLifetime:   p.MultiFactorCheckLifetime.AsDuration(),
+		ForceMFAOnHighRisk:         p.ForceMfaOnHighRisk,
+		TrustedDeviceLifetime:      p.TrustedDeviceLifetime.AsDuration(),
+		AllowAccountRecovery:       p.AllowAccountRecovery,
+		AllowMagicLink:             p.AllowMagicLink,
 	}
 }
 
@@ -0,0 +1,43 @@
+package admin
+
+import (
+	"context"
+
+	object_pb "github.com/zitadel/zitadel/internal/api/grpc/object"
+	"github.com/zitadel/zitadel/pkg/grpc/admin"
+)
+
+func (s *Server) ListNotificationDeliveries(ctx context.Context, req *admin.ListNotificationDeliveriesRequest) (*admin.ListNotificationDeliveriesResponse, error) {
+	queries, err := listNotificationDeliveriesToModel(req)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := s.query.SearchNotificationDeliveries(ctx, queries)
+	if err != nil {
+		return nil, err
+	}
+	return &admin.ListNotificationDeliveriesResponse{
+		Result:  notificationDeliveriesToPb(resp.Deliveries),
+		Details: object_pb.ToListDetails(resp.Count, resp.Sequence, resp.LastRun),
+	}, nil
+}
+
+func (s *Server) GetNotificationDelivery(ctx context.Context, req *admin.GetNotificationDeliveryRequest) (*admin.GetNotificationDeliveryResponse, error) {
+	delivery, err := s.query.NotificationDeliveryByID(ctx, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+	return &admin.GetNotificationDeliveryResponse{
+		Delivery: notificationDeliveryToPb(delivery),
+	}, nil
+}
+
+func (s *Server) RetryNotificationDelivery(ctx context.Context, req *admin.RetryNotificationDeliveryRequest) (*admin.RetryNotificationDeliveryResponse, error) {
+	details, err := s.command.RetryNotificationDelivery(ctx, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+	return &admin.RetryNotificationDeliveryResponse{
+		Details: object_pb.DomainToChangeDetailsPb(details),
+	}, nil
+}
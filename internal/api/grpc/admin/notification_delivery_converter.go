@@ -0,0 +1,150 @@
+package admin
+
+import (
+	"github.com/zitadel/zitadel/internal/api/grpc/object"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/query"
+	"github.com/zitadel/zitadel/internal/zerrors"
+	admin_pb "github.com/zitadel/zitadel/pkg/grpc/admin"
+	notification_pb "github.com/zitadel/zitadel/pkg/grpc/notification"
+)
+
+func listNotificationDeliveriesToModel(req *admin_pb.ListNotificationDeliveriesRequest) (*query.NotificationDeliverySearchQueries, error) {
+	offset, limit, asc := object.ListQueryToModel(req.Query)
+	queries, err := notificationDeliveryQueriesToModel(req.GetQueries())
+	if err != nil {
+		return nil, err
+	}
+	return &query.NotificationDeliverySearchQueries{
+		SearchRequest: query.SearchRequest{
+			Offset:        offset,
+			Limit:         limit,
+			Asc:           asc,
+			SortingColumn: notificationDeliveryFieldNameToSortingColumn(req.SortingColumn),
+		},
+		Queries: queries,
+	}, nil
+}
+
+func notificationDeliveryQueriesToModel(queries []*notification_pb.DeliveryQuery) (q []query.SearchQuery, err error) {
+	q = make([]query.SearchQuery, len(queries))
+	for i, query := range queries {
+		q[i], err = notificationDeliveryQueryToModel(query)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return q, nil
+}
+
+func notificationDeliveryQueryToModel(deliveryQuery *notification_pb.DeliveryQuery) (query.SearchQuery, error) {
+	switch q := deliveryQuery.Query.(type) {
+	case *notification_pb.DeliveryQuery_StateQuery:
+		return query.NewNotificationDeliveryStateSearchQuery(notificationDeliveryStateToDomain(q.StateQuery.GetState()))
+	case *notification_pb.DeliveryQuery_ChannelQuery:
+		return query.NewNotificationDeliveryChannelSearchQuery(notificationDeliveryChannelToDomain(q.ChannelQuery.GetChannel()))
+	case *notification_pb.DeliveryQuery_UserIdQuery:
+		return query.NewNotificationDeliveryUserIDSearchQuery(q.UserIdQuery.GetUserId())
+	case *notification_pb.DeliveryQuery_RecipientQuery:
+		return query.NewNotificationDeliveryRecipientSearchQuery(q.RecipientQuery.GetRecipient(), object.TextMethodToQuery(q.RecipientQuery.GetMethod()))
+	case *notification_pb.DeliveryQuery_MessageTypeQuery:
+		return query.NewNotificationDeliveryMessageTypeSearchQuery(q.MessageTypeQuery.GetMessageType())
+	default:
+		return nil, zerrors.ThrowInvalidArgument(nil, "ADMIN-Ndl4q", "List.Query.Invalid")
+	}
+}
+
+func notificationDeliveryFieldNameToSortingColumn(field notification_pb.DeliveryFieldName) query.Column {
+	switch field {
+	case notification_pb.DeliveryFieldName_DELIVERY_FIELD_NAME_CHANGE_DATE:
+		return query.NotificationDeliveryColumnChangeDate
+	case notification_pb.DeliveryFieldName_DELIVERY_FIELD_NAME_STATE:
+		return query.NotificationDeliveryColumnState
+	case notification_pb.DeliveryFieldName_DELIVERY_FIELD_NAME_CREATION_DATE,
+		notification_pb.DeliveryFieldName_DELIVERY_FIELD_NAME_UNSPECIFIED:
+		return query.NotificationDeliveryColumnCreationDate
+	default:
+		return query.NotificationDeliveryColumnCreationDate
+	}
+}
+
+func notificationDeliveriesToPb(deliveries []*query.NotificationDelivery) []*notification_pb.Delivery {
+	resp := make([]*notification_pb.Delivery, len(deliveries))
+	for i, delivery := range deliveries {
+		resp[i] = notificationDeliveryToPb(delivery)
+	}
+	return resp
+}
+
+func notificationDeliveryToPb(delivery *query.NotificationDelivery) *notification_pb.Delivery {
+	return &notification_pb.Delivery{
+		Id:               delivery.ID,
+		Details:          object.ToViewDetailsPb(delivery.Sequence, delivery.CreationDate, delivery.EventDate, delivery.ResourceOwner),
+		UserId:           delivery.UserID,
+		TriggerEventType: string(delivery.TriggerEventType),
+		MessageType:      delivery.MessageType,
+		Channel:          notificationDeliveryChannelToPb(delivery.Channel),
+		Recipient:        delivery.Recipient,
+		ProviderId:       delivery.ProviderID,
+		Attempts:         delivery.Attempts,
+		State:            notificationDeliveryStateToPb(delivery.State),
+		LastError:        delivery.LastError,
+	}
+}
+
+func notificationDeliveryStateToPb(state domain.NotificationDeliveryState) notification_pb.DeliveryState {
+	switch state {
+	case domain.NotificationDeliveryStateRetrying:
+		return notification_pb.DeliveryState_DELIVERY_STATE_RETRYING
+	case domain.NotificationDeliveryStateSucceeded:
+		return notification_pb.DeliveryState_DELIVERY_STATE_SUCCEEDED
+	case domain.NotificationDeliveryStateFailed:
+		return notification_pb.DeliveryState_DELIVERY_STATE_FAILED
+	case domain.NotificationDeliveryStateRetryRequested:
+		return notification_pb.DeliveryState_DELIVERY_STATE_RETRY_REQUESTED
+	case domain.NotificationDeliveryStateUnspecified:
+		return notification_pb.DeliveryState_DELIVERY_STATE_UNSPECIFIED
+	default:
+		return notification_pb.DeliveryState_DELIVERY_STATE_UNSPECIFIED
+	}
+}
+
+func notificationDeliveryStateToDomain(state notification_pb.DeliveryState) domain.NotificationDeliveryState {
+	switch state {
+	case notification_pb.DeliveryState_DELIVERY_STATE_RETRYING:
+		return domain.NotificationDeliveryStateRetrying
+	case notification_pb.DeliveryState_DELIVERY_STATE_SUCCEEDED:
+		return domain.NotificationDeliveryStateSucceeded
+	case notification_pb.DeliveryState_DELIVERY_STATE_FAILED:
+		return domain.NotificationDeliveryStateFailed
+	case notification_pb.DeliveryState_DELIVERY_STATE_RETRY_REQUESTED:
+		return domain.NotificationDeliveryStateRetryRequested
+	case notification_pb.DeliveryState_DELIVERY_STATE_UNSPECIFIED:
+		return domain.NotificationDeliveryStateUnspecified
+	default:
+		return domain.NotificationDeliveryStateUnspecified
+	}
+}
+
+func notificationDeliveryChannelToPb(channel domain.NotificationType) notification_pb.DeliveryChannel {
+	switch channel {
+	case domain.NotificationTypeEmail:
+		return notification_pb.DeliveryChannel_DELIVERY_CHANNEL_EMAIL
+	case domain.NotificationTypeSms:
+		return notification_pb.DeliveryChannel_DELIVERY_CHANNEL_SMS
+	default:
+		return notification_pb.DeliveryChannel_DELIVERY_CHANNEL_UNSPECIFIED
+	}
+}
+
+func notificationDeliveryChannelToDomain(channel notification_pb.DeliveryChannel) domain.NotificationType {
+	switch channel {
+	case notification_pb.DeliveryChannel_DELIVERY_CHANNEL_SMS:
+		return domain.NotificationTypeSms
+	case notification_pb.DeliveryChannel_DELIVERY_CHANNEL_EMAIL,
+		notification_pb.DeliveryChannel_DELIVERY_CHANNEL_UNSPECIFIED:
+		return domain.NotificationTypeEmail
+	default:
+		return domain.NotificationTypeEmail
+	}
+}
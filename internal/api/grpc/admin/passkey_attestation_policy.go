@@ -0,0 +1,27 @@
+package admin
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/api/grpc/object"
+	policy_grpc "github.com/zitadel/zitadel/internal/api/grpc/policy"
+	admin_pb "github.com/zitadel/zitadel/pkg/grpc/admin"
+)
+
+func (s *Server) GetPasskeyAttestationPolicy(ctx context.Context, _ *admin_pb.GetPasskeyAttestationPolicyRequest) (*admin_pb.GetPasskeyAttestationPolicyResponse, error) {
+	policy, err := s.query.DefaultPasskeyAttestationPolicy(ctx, true)
+	if err != nil {
+		return nil, err
+	}
+	return &admin_pb.GetPasskeyAttestationPolicyResponse{Policy: policy_grpc.ModelPasskeyAttestationPolicyToPb(policy)}, nil
+}
+
+func (s *Server) SetPasskeyAttestationPolicy(ctx context.Context, req *admin_pb.SetPasskeyAttestationPolicyRequest) (*admin_pb.SetPasskeyAttestationPolicyResponse, error) {
+	result, err := s.command.SetDefaultPasskeyAttestationPolicy(ctx, policy_grpc.PasskeyAttestationPolicyToDomain(req))
+	if err != nil {
+		return nil, err
+	}
+	return &admin_pb.SetPasskeyAttestationPolicyResponse{
+		Details: object.DomainToChangeDetailsPb(result),
+	}, nil
+}
@@ -86,6 +86,37 @@ func (s *Server) UpdateSMSProviderHTTP(ctx context.Context, req *admin_pb.Update
 	}, nil
 }
 
+func (s *Server) AddSMSProviderHTTPTemplate(ctx context.Context, req *admin_pb.AddSMSProviderHTTPTemplateRequest) (*admin_pb.AddSMSProviderHTTPTemplateResponse, error) {
+	smsConfig := addSMSConfigHTTPTemplateToConfig(ctx, req)
+	if err := s.command.AddSMSConfigHTTPTemplate(ctx, smsConfig); err != nil {
+		return nil, err
+	}
+	return &admin_pb.AddSMSProviderHTTPTemplateResponse{
+		Details: object.DomainToAddDetailsPb(smsConfig.Details),
+		Id:      smsConfig.ID,
+	}, nil
+}
+
+func (s *Server) UpdateSMSProviderHTTPTemplate(ctx context.Context, req *admin_pb.UpdateSMSProviderHTTPTemplateRequest) (*admin_pb.UpdateSMSProviderHTTPTemplateResponse, error) {
+	smsConfig := updateSMSConfigHTTPTemplateToConfig(ctx, req)
+	if err := s.command.ChangeSMSConfigHTTPTemplate(ctx, smsConfig); err != nil {
+		return nil, err
+	}
+	return &admin_pb.UpdateSMSProviderHTTPTemplateResponse{
+		Details: object.DomainToChangeDetailsPb(smsConfig.Details),
+	}, nil
+}
+
+func (s *Server) UpdateSMSProviderHTTPTemplateHeaders(ctx context.Context, req *admin_pb.UpdateSMSProviderHTTPTemplateHeadersRequest) (*admin_pb.UpdateSMSProviderHTTPTemplateHeadersResponse, error) {
+	result, err := s.command.ChangeSMSConfigHTTPTemplateHeaders(ctx, authz.GetInstance(ctx).InstanceID(), req.Id, req.Headers)
+	if err != nil {
+		return nil, err
+	}
+	return &admin_pb.UpdateSMSProviderHTTPTemplateHeadersResponse{
+		Details: object.DomainToChangeDetailsPb(result),
+	}, nil
+}
+
 func (s *Server) ActivateSMSProvider(ctx context.Context, req *admin_pb.ActivateSMSProviderRequest) (*admin_pb.ActivateSMSProviderResponse, error) {
 	result, err := s.command.ActivateSMSConfig(ctx, authz.GetInstance(ctx).InstanceID(), req.Id)
 	if err != nil {
@@ -50,6 +50,9 @@ func SMSConfigToPb(config *query.SMSConfig) settings_pb.SMSConfig {
 	if config.HTTPConfig != nil {
 		return HTTPConfigToPb(config.HTTPConfig)
 	}
+	if config.HTTPTemplateConfig != nil {
+		return HTTPTemplateConfigToPb(config.HTTPTemplateConfig)
+	}
 	return nil
 }
 
@@ -61,6 +64,23 @@ func HTTPConfigToPb(http *query.HTTP) *settings_pb.SMSProvider_Http {
 	}
 }
 
+func HTTPTemplateConfigToPb(http *query.HTTPTemplate) *settings_pb.SMSProvider_HttpTemplate {
+	successStatusCodes := make([]uint32, len(http.SuccessStatusCodes))
+	for i, code := range http.SuccessStatusCodes {
+		successStatusCodes[i] = uint32(code)
+	}
+	return &settings_pb.SMSProvider_HttpTemplate{
+		HttpTemplate: &settings_pb.HTTPTemplateConfig{
+			Method:             http.Method,
+			Url:                http.URL,
+			Body:               http.Body,
+			SenderNumber:       http.SenderNumber,
+			SuccessStatusCodes: successStatusCodes,
+			SuccessBodyPattern: http.SuccessBodyPattern,
+		},
+	}
+}
+
 func TwilioConfigToPb(twilio *query.Twilio) *settings_pb.SMSProvider_Twilio {
 	return &settings_pb.SMSProvider_Twilio{
 		Twilio: &settings_pb.TwilioConfig{
@@ -120,3 +140,40 @@ func updateSMSConfigHTTPToConfig(ctx context.Context, req *admin_pb.UpdateSMSPro
 		Endpoint:      gu.Ptr(req.Endpoint),
 	}
 }
+
+func addSMSConfigHTTPTemplateToConfig(ctx context.Context, req *admin_pb.AddSMSProviderHTTPTemplateRequest) *command.AddSMSHTTPTemplate {
+	return &command.AddSMSHTTPTemplate{
+		ResourceOwner:      authz.GetInstance(ctx).InstanceID(),
+		Description:        req.GetDescription(),
+		Method:             req.GetMethod(),
+		URL:                req.GetUrl(),
+		Headers:            req.GetHeaders(),
+		Body:               req.GetBody(),
+		SenderNumber:       req.GetSenderNumber(),
+		SuccessStatusCodes: successStatusCodesToModel(req.GetSuccessStatusCodes()),
+		SuccessBodyPattern: req.GetSuccessBodyPattern(),
+	}
+}
+
+func updateSMSConfigHTTPTemplateToConfig(ctx context.Context, req *admin_pb.UpdateSMSProviderHTTPTemplateRequest) *command.ChangeSMSHTTPTemplate {
+	return &command.ChangeSMSHTTPTemplate{
+		ResourceOwner:      authz.GetInstance(ctx).InstanceID(),
+		ID:                 req.Id,
+		Description:        gu.Ptr(req.Description),
+		Method:             gu.Ptr(req.Method),
+		URL:                gu.Ptr(req.Url),
+		Body:               gu.Ptr(req.Body),
+		SenderNumber:       gu.Ptr(req.SenderNumber),
+		SuccessStatusCodes: successStatusCodesToModel(req.SuccessStatusCodes),
+		SuccessBodyPattern: gu.Ptr(req.SuccessBodyPattern),
+	}
+}
+
+// successStatusCodesToModel always returns a non nil slice, so an empty list resets the status codes on update
+func successStatusCodesToModel(codes []uint32) []int {
+	successStatusCodes := make([]int, len(codes))
+	for i, code := range codes {
+		successStatusCodes[i] = int(code)
+	}
+	return successStatusCodes
+}
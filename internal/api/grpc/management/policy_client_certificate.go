@@ -0,0 +1,38 @@
+package management
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/api/grpc/object"
+	policy_grpc "github.com/zitadel/zitadel/internal/api/grpc/policy"
+	mgmt_pb "github.com/zitadel/zitadel/pkg/grpc/management"
+)
+
+func (s *Server) GetClientCertificatePolicy(ctx context.Context, _ *mgmt_pb.GetClientCertificatePolicyRequest) (*mgmt_pb.GetClientCertificatePolicyResponse, error) {
+	policy, err := s.query.ClientCertificatePolicyByOrg(ctx, true, authz.GetCtxData(ctx).OrgID)
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.GetClientCertificatePolicyResponse{Policy: policy_grpc.ModelClientCertificatePolicyToPb(policy)}, nil
+}
+
+func (s *Server) SetClientCertificatePolicy(ctx context.Context, req *mgmt_pb.SetClientCertificatePolicyRequest) (*mgmt_pb.SetClientCertificatePolicyResponse, error) {
+	result, err := s.command.SetOrgClientCertificatePolicy(ctx, authz.GetCtxData(ctx).OrgID, policy_grpc.ClientCertificatePolicyToDomain(req))
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.SetClientCertificatePolicyResponse{
+		Details: object.DomainToChangeDetailsPb(result),
+	}, nil
+}
+
+func (s *Server) RemoveClientCertificatePolicy(ctx context.Context, _ *mgmt_pb.RemoveClientCertificatePolicyRequest) (*mgmt_pb.RemoveClientCertificatePolicyResponse, error) {
+	result, err := s.command.RemoveOrgClientCertificatePolicy(ctx, authz.GetCtxData(ctx).OrgID)
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.RemoveClientCertificatePolicyResponse{
+		Details: object.DomainToChangeDetailsPb(result),
+	}, nil
+}
@@ -7,14 +7,24 @@ import (
 
 func AddLockoutPolicyToDomain(p *mgmt.AddCustomLockoutPolicyRequest) *domain.LockoutPolicy {
 	return &domain.LockoutPolicy{
-		MaxPasswordAttempts: uint64(p.MaxPasswordAttempts),
-		MaxOTPAttempts:      uint64(p.MaxOtpAttempts),
+		MaxPasswordAttempts:   uint64(p.MaxPasswordAttempts),
+		MaxOTPAttempts:        uint64(p.MaxOtpAttempts),
+		MaxIPAttempts:         uint64(p.MaxIpAttempts),
+		MaxClientAttempts:     uint64(p.MaxClientAttempts),
+		ThrottleWindow:        p.GetThrottleWindow().AsDuration(),
+		ThrottleDelay:         p.GetThrottleDelay().AsDuration(),
+		ThrottleBlockDuration: p.GetThrottleBlockDuration().AsDuration(),
 	}
 }
 
 func UpdateLockoutPolicyToDomain(p *mgmt.UpdateCustomLockoutPolicyRequest) *domain.LockoutPolicy {
 	return &domain.LockoutPolicy{
-		MaxPasswordAttempts: uint64(p.MaxPasswordAttempts),
-		MaxOTPAttempts:      uint64(p.MaxOtpAttempts),
+		MaxPasswordAttempts:   uint64(p.MaxPasswordAttempts),
+		MaxOTPAttempts:        uint64(p.MaxOtpAttempts),
+		MaxIPAttempts:         uint64(p.MaxIpAttempts),
+		MaxClientAttempts:     uint64(p.MaxClientAttempts),
+		ThrottleWindow:        p.GetThrottleWindow().AsDuration(),
+		ThrottleDelay:         p.GetThrottleDelay().AsDuration(),
+		ThrottleBlockDuration: p.GetThrottleBlockDuration().AsDuration(),
 	}
 }
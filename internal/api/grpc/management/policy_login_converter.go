@@ -31,6 +31,10 @@ func AddLoginPolicyToCommand(p *mgmt_pb.AddCustomLoginPolicyRequest) *command.Ad
 		IDPProviders:               addLoginPolicyIDPsToCommand(p.Idps),
 		DisableLoginWithEmail:      p.DisableLoginWithEmail,
 		DisableLoginWithPhone:      p.DisableLoginWithPhone,
+		ForceMFAOnHighRisk:         p.ForceMfaOnHighRisk,
+		TrustedDeviceLifetime:      p.TrustedDeviceLifetime.AsDuration(),
+		AllowAccountRecovery:       p.AllowAccountRecovery,
+		AllowMagicLink:             p.AllowMagicLink,
 	}
 }
 func addLoginPolicyIDPsToCommand(idps []*mgmt_pb.AddCustomLoginPolicyRequest_IDP) []*command.AddLoginPolicyIDP {
@@ -63,6 +67,10 @@ func updateLoginPolicyToCommand(p *mgmt_pb.UpdateCustomLoginPolicyRequest) *comm
 		MFAInitSkipLifetime:        p.MfaInitSkipLifetime.AsDuration(),
 		SecondFactorCheckLifetime:  p.SecondFactorCheckLifetime.AsDuration(),
 		MultiFactorCheckLifetime:   p.MultiFactorCheckLifetime.AsDuration(),
+		ForceMFAOnHighRisk:         p.ForceMfaOnHighRisk,
+		TrustedDeviceLifetime:      p.TrustedDeviceLifetime.AsDuration(),
+		AllowAccountRecovery:       p.AllowAccountRecovery,
+		AllowMagicLink:             p.AllowMagicLink,
 	}
 }
 
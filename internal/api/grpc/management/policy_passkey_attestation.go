@@ -0,0 +1,38 @@
+package management
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/api/grpc/object"
+	policy_grpc "github.com/zitadel/zitadel/internal/api/grpc/policy"
+	mgmt_pb "github.com/zitadel/zitadel/pkg/grpc/management"
+)
+
+func (s *Server) GetPasskeyAttestationPolicy(ctx context.Context, _ *mgmt_pb.GetPasskeyAttestationPolicyRequest) (*mgmt_pb.GetPasskeyAttestationPolicyResponse, error) {
+	policy, err := s.query.PasskeyAttestationPolicyByOrg(ctx, true, authz.GetCtxData(ctx).OrgID)
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.GetPasskeyAttestationPolicyResponse{Policy: policy_grpc.ModelPasskeyAttestationPolicyToPb(policy)}, nil
+}
+
+func (s *Server) SetCustomPasskeyAttestationPolicy(ctx context.Context, req *mgmt_pb.SetCustomPasskeyAttestationPolicyRequest) (*mgmt_pb.SetCustomPasskeyAttestationPolicyResponse, error) {
+	result, err := s.command.SetOrgPasskeyAttestationPolicy(ctx, authz.GetCtxData(ctx).OrgID, policy_grpc.PasskeyAttestationPolicyToDomain(req))
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.SetCustomPasskeyAttestationPolicyResponse{
+		Details: object.DomainToChangeDetailsPb(result),
+	}, nil
+}
+
+func (s *Server) ResetPasskeyAttestationPolicyToDefault(ctx context.Context, _ *mgmt_pb.ResetPasskeyAttestationPolicyToDefaultRequest) (*mgmt_pb.ResetPasskeyAttestationPolicyToDefaultResponse, error) {
+	result, err := s.command.RemoveOrgPasskeyAttestationPolicy(ctx, authz.GetCtxData(ctx).OrgID)
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.ResetPasskeyAttestationPolicyToDefaultResponse{
+		Details: object.DomainToChangeDetailsPb(result),
+	}, nil
+}
@@ -329,6 +329,63 @@ func (s *Server) RemoveProjectRole(ctx context.Context, req *mgmt_pb.RemoveProje
 	}, nil
 }
 
+func (s *Server) ListProjectAuthorizationDetailsTypes(ctx context.Context, req *mgmt_pb.ListProjectAuthorizationDetailsTypesRequest) (*mgmt_pb.ListProjectAuthorizationDetailsTypesResponse, error) {
+	queries, err := listProjectAuthorizationDetailsTypesRequestToModel(req, authz.GetCtxData(ctx).OrgID)
+	if err != nil {
+		return nil, err
+	}
+	types, err := s.query.SearchProjectAuthorizationDetailsTypes(ctx, true, queries)
+	if err != nil {
+		return nil, err
+	}
+	result, err := project_grpc.AuthorizationDetailsTypesToPb(types.Types)
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.ListProjectAuthorizationDetailsTypesResponse{
+		Result:  result,
+		Details: object_grpc.ToListDetails(types.Count, types.Sequence, types.LastRun),
+	}, nil
+}
+
+func (s *Server) AddProjectAuthorizationDetailsType(ctx context.Context, req *mgmt_pb.AddProjectAuthorizationDetailsTypeRequest) (*mgmt_pb.AddProjectAuthorizationDetailsTypeResponse, error) {
+	detailsType, err := AddProjectAuthorizationDetailsTypeRequestToCommand(req, authz.GetCtxData(ctx).OrgID)
+	if err != nil {
+		return nil, err
+	}
+	details, err := s.command.AddProjectAuthorizationDetailsType(ctx, detailsType)
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.AddProjectAuthorizationDetailsTypeResponse{
+		Details: object_grpc.DomainToAddDetailsPb(details),
+	}, nil
+}
+
+func (s *Server) UpdateProjectAuthorizationDetailsType(ctx context.Context, req *mgmt_pb.UpdateProjectAuthorizationDetailsTypeRequest) (*mgmt_pb.UpdateProjectAuthorizationDetailsTypeResponse, error) {
+	detailsType, err := UpdateProjectAuthorizationDetailsTypeRequestToCommand(req, authz.GetCtxData(ctx).OrgID)
+	if err != nil {
+		return nil, err
+	}
+	details, err := s.command.ChangeProjectAuthorizationDetailsType(ctx, detailsType)
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.UpdateProjectAuthorizationDetailsTypeResponse{
+		Details: object_grpc.DomainToChangeDetailsPb(details),
+	}, nil
+}
+
+func (s *Server) RemoveProjectAuthorizationDetailsType(ctx context.Context, req *mgmt_pb.RemoveProjectAuthorizationDetailsTypeRequest) (*mgmt_pb.RemoveProjectAuthorizationDetailsTypeResponse, error) {
+	details, err := s.command.RemoveProjectAuthorizationDetailsType(ctx, req.ProjectId, req.Type, authz.GetCtxData(ctx).OrgID)
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.RemoveProjectAuthorizationDetailsTypeResponse{
+		Details: object_grpc.DomainToChangeDetailsPb(details),
+	}, nil
+}
+
 func (s *Server) ListProjectMemberRoles(ctx context.Context, _ *mgmt_pb.ListProjectMemberRolesRequest) (*mgmt_pb.ListProjectMemberRolesResponse, error) {
 	roles, err := s.query.GetProjectMemberRoles(ctx)
 	if err != nil {
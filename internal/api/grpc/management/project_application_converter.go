@@ -8,6 +8,7 @@ import (
 	authn_grpc "github.com/zitadel/zitadel/internal/api/grpc/authn"
 	"github.com/zitadel/zitadel/internal/api/grpc/object"
 	app_grpc "github.com/zitadel/zitadel/internal/api/grpc/project"
+	"github.com/zitadel/zitadel/internal/command"
 	"github.com/zitadel/zitadel/internal/domain"
 	"github.com/zitadel/zitadel/internal/eventstore/v1/models"
 	"github.com/zitadel/zitadel/internal/query"
@@ -45,25 +46,28 @@ func AddOIDCAppRequestToDomain(req *mgmt_pb.AddOIDCAppRequest) (*domain.OIDCApp,
 		ObjectRoot: models.ObjectRoot{
 			AggregateID: req.ProjectId,
 		},
-		AppName:                  req.Name,
-		OIDCVersion:              app_grpc.OIDCVersionToDomain(req.Version),
-		RedirectUris:             req.RedirectUris,
-		ResponseTypes:            app_grpc.OIDCResponseTypesToDomain(req.ResponseTypes),
-		GrantTypes:               app_grpc.OIDCGrantTypesToDomain(req.GrantTypes),
-		ApplicationType:          app_grpc.OIDCApplicationTypeToDomain(req.AppType),
-		AuthMethodType:           app_grpc.OIDCAuthMethodTypeToDomain(req.AuthMethodType),
-		PostLogoutRedirectUris:   req.PostLogoutRedirectUris,
-		DevMode:                  req.DevMode,
-		AccessTokenType:          app_grpc.OIDCTokenTypeToDomain(req.AccessTokenType),
-		AccessTokenRoleAssertion: req.AccessTokenRoleAssertion,
-		IDTokenRoleAssertion:     req.IdTokenRoleAssertion,
-		IDTokenUserinfoAssertion: req.IdTokenUserinfoAssertion,
-		ClockSkew:                req.ClockSkew.AsDuration(),
-		AdditionalOrigins:        req.AdditionalOrigins,
-		SkipNativeAppSuccessPage: req.SkipNativeAppSuccessPage,
-		BackChannelLogoutURI:     req.GetBackChannelLogoutUri(),
-		LoginVersion:             loginVersion,
-		LoginBaseURI:             loginBaseURI,
+		AppName:                          req.Name,
+		OIDCVersion:                      app_grpc.OIDCVersionToDomain(req.Version),
+		RedirectUris:                     req.RedirectUris,
+		ResponseTypes:                    app_grpc.OIDCResponseTypesToDomain(req.ResponseTypes),
+		GrantTypes:                       app_grpc.OIDCGrantTypesToDomain(req.GrantTypes),
+		ApplicationType:                  app_grpc.OIDCApplicationTypeToDomain(req.AppType),
+		AuthMethodType:                   app_grpc.OIDCAuthMethodTypeToDomain(req.AuthMethodType),
+		PostLogoutRedirectUris:           req.PostLogoutRedirectUris,
+		DevMode:                          req.DevMode,
+		AccessTokenType:                  app_grpc.OIDCTokenTypeToDomain(req.AccessTokenType),
+		AccessTokenRoleAssertion:         req.AccessTokenRoleAssertion,
+		IDTokenRoleAssertion:             req.IdTokenRoleAssertion,
+		IDTokenUserinfoAssertion:         req.IdTokenUserinfoAssertion,
+		ClockSkew:                        req.ClockSkew.AsDuration(),
+		AdditionalOrigins:                req.AdditionalOrigins,
+		SkipNativeAppSuccessPage:         req.SkipNativeAppSuccessPage,
+		BackChannelLogoutURI:             req.GetBackChannelLogoutUri(),
+		LoginVersion:                     loginVersion,
+		LoginBaseURI:                     loginBaseURI,
+		RequirePushedAuthRequest:         req.GetRequirePushedAuthRequest(),
+		RequireDPoP:                      req.GetRequireDpop(),
+		BackChannelClientNotificationURI: req.GetBackChannelClientNotificationUri(),
 	}, nil
 }
 
@@ -110,24 +114,27 @@ func UpdateOIDCAppConfigRequestToDomain(app *mgmt_pb.UpdateOIDCAppConfigRequest)
 		ObjectRoot: models.ObjectRoot{
 			AggregateID: app.ProjectId,
 		},
-		AppID:                    app.AppId,
-		RedirectUris:             app.RedirectUris,
-		ResponseTypes:            app_grpc.OIDCResponseTypesToDomain(app.ResponseTypes),
-		GrantTypes:               app_grpc.OIDCGrantTypesToDomain(app.GrantTypes),
-		ApplicationType:          app_grpc.OIDCApplicationTypeToDomain(app.AppType),
-		AuthMethodType:           app_grpc.OIDCAuthMethodTypeToDomain(app.AuthMethodType),
-		PostLogoutRedirectUris:   app.PostLogoutRedirectUris,
-		DevMode:                  app.DevMode,
-		AccessTokenType:          app_grpc.OIDCTokenTypeToDomain(app.AccessTokenType),
-		AccessTokenRoleAssertion: app.AccessTokenRoleAssertion,
-		IDTokenRoleAssertion:     app.IdTokenRoleAssertion,
-		IDTokenUserinfoAssertion: app.IdTokenUserinfoAssertion,
-		ClockSkew:                app.ClockSkew.AsDuration(),
-		AdditionalOrigins:        app.AdditionalOrigins,
-		SkipNativeAppSuccessPage: app.SkipNativeAppSuccessPage,
-		BackChannelLogoutURI:     app.BackChannelLogoutUri,
-		LoginVersion:             loginVersion,
-		LoginBaseURI:             loginBaseURI,
+		AppID:                            app.AppId,
+		RedirectUris:                     app.RedirectUris,
+		ResponseTypes:                    app_grpc.OIDCResponseTypesToDomain(app.ResponseTypes),
+		GrantTypes:                       app_grpc.OIDCGrantTypesToDomain(app.GrantTypes),
+		ApplicationType:                  app_grpc.OIDCApplicationTypeToDomain(app.AppType),
+		AuthMethodType:                   app_grpc.OIDCAuthMethodTypeToDomain(app.AuthMethodType),
+		PostLogoutRedirectUris:           app.PostLogoutRedirectUris,
+		DevMode:                          app.DevMode,
+		AccessTokenType:                  app_grpc.OIDCTokenTypeToDomain(app.AccessTokenType),
+		AccessTokenRoleAssertion:         app.AccessTokenRoleAssertion,
+		IDTokenRoleAssertion:             app.IdTokenRoleAssertion,
+		IDTokenUserinfoAssertion:         app.IdTokenUserinfoAssertion,
+		ClockSkew:                        app.ClockSkew.AsDuration(),
+		AdditionalOrigins:                app.AdditionalOrigins,
+		SkipNativeAppSuccessPage:         app.SkipNativeAppSuccessPage,
+		BackChannelLogoutURI:             app.BackChannelLogoutUri,
+		LoginVersion:                     loginVersion,
+		LoginBaseURI:                     loginBaseURI,
+		RequirePushedAuthRequest:         app.GetRequirePushedAuthRequest(),
+		RequireDPoP:                      app.GetRequireDpop(),
+		BackChannelClientNotificationURI: app.GetBackChannelClientNotificationUri(),
 	}, nil
 }
 
@@ -174,6 +181,20 @@ func AddAPIClientKeyRequestToDomain(key *mgmt_pb.AddAppKeyRequest) *domain.Appli
 	}
 }
 
+func AddProjectInitialAccessTokenRequestToCommand(req *mgmt_pb.AddProjectInitialAccessTokenRequest, resourceOwner string) *command.ProjectInitialAccessToken {
+	expirationDate := time.Time{}
+	if req.ExpirationDate != nil {
+		expirationDate = req.ExpirationDate.AsTime()
+	}
+	return &command.ProjectInitialAccessToken{
+		ObjectRoot: models.ObjectRoot{
+			AggregateID:   req.ProjectId,
+			ResourceOwner: resourceOwner,
+		},
+		ExpirationDate: expirationDate,
+	}
+}
+
 func ListAPIClientKeysRequestToQuery(ctx context.Context, req *mgmt_pb.ListAppKeysRequest) (*query.AuthNKeySearchQueries, error) {
 	resourcOwner, err := query.NewAuthNKeyResourceOwnerQuery(authz.GetCtxData(ctx).OrgID)
 	if err != nil {
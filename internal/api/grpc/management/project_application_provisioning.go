@@ -0,0 +1,61 @@
+package management
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	object_grpc "github.com/zitadel/zitadel/internal/api/grpc/object"
+	"github.com/zitadel/zitadel/internal/command"
+	mgmt_pb "github.com/zitadel/zitadel/pkg/grpc/management"
+)
+
+func (s *Server) GetAppProvisioningTarget(ctx context.Context, req *mgmt_pb.GetAppProvisioningTargetRequest) (*mgmt_pb.GetAppProvisioningTargetResponse, error) {
+	target, err := s.query.ProvisioningTargetByID(ctx, req.ProjectId, req.AppId)
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.GetAppProvisioningTargetResponse{
+		Target: ProvisioningTargetToPb(target),
+	}, nil
+}
+
+func (s *Server) SetAppProvisioningTarget(ctx context.Context, req *mgmt_pb.SetAppProvisioningTargetRequest) (*mgmt_pb.SetAppProvisioningTargetResponse, error) {
+	details, err := s.command.SetProvisioningTarget(ctx, &command.SetProvisioningTarget{
+		ProjectID:     req.ProjectId,
+		AppID:         req.AppId,
+		Endpoint:      req.Endpoint,
+		Token:         req.Token,
+		AllowInsecure: req.AllowInsecure,
+	}, authz.GetCtxData(ctx).OrgID)
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.SetAppProvisioningTargetResponse{
+		Details: object_grpc.DomainToChangeDetailsPb(details),
+	}, nil
+}
+
+func (s *Server) RemoveAppProvisioningTarget(ctx context.Context, req *mgmt_pb.RemoveAppProvisioningTargetRequest) (*mgmt_pb.RemoveAppProvisioningTargetResponse, error) {
+	details, err := s.command.RemoveProvisioningTarget(ctx, req.ProjectId, req.AppId, authz.GetCtxData(ctx).OrgID)
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.RemoveAppProvisioningTargetResponse{
+		Details: object_grpc.DomainToChangeDetailsPb(details),
+	}, nil
+}
+
+func (s *Server) ListAppProvisioningFailedSyncs(ctx context.Context, req *mgmt_pb.ListAppProvisioningFailedSyncsRequest) (*mgmt_pb.ListAppProvisioningFailedSyncsResponse, error) {
+	queries, err := ListAppProvisioningFailedSyncsRequestToQuery(req)
+	if err != nil {
+		return nil, err
+	}
+	users, err := s.query.SearchProvisioningTargetUsers(ctx, req.ProjectId, req.AppId, queries)
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.ListAppProvisioningFailedSyncsResponse{
+		Result:  ProvisioningUserSyncsToPb(users.Users),
+		Details: object_grpc.ToListDetails(users.Count, users.Sequence, users.LastRun),
+	}, nil
+}
@@ -0,0 +1,65 @@
+package management
+
+import (
+	"github.com/zitadel/zitadel/internal/api/grpc/object"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/query"
+	app_pb "github.com/zitadel/zitadel/pkg/grpc/app"
+	mgmt_pb "github.com/zitadel/zitadel/pkg/grpc/management"
+)
+
+func ProvisioningTargetToPb(target *query.ProvisioningTarget) *app_pb.ProvisioningTarget {
+	return &app_pb.ProvisioningTarget{
+		Details:  object.ToViewDetailsPb(target.Sequence, target.CreationDate, target.EventDate, target.ResourceOwner),
+		Endpoint: target.Endpoint,
+	}
+}
+
+func ListAppProvisioningFailedSyncsRequestToQuery(req *mgmt_pb.ListAppProvisioningFailedSyncsRequest) (*query.ProvisioningTargetUserSearchQueries, error) {
+	stateQuery, err := query.NewProvisioningTargetUserSyncStateSearchQuery(domain.ProvisioningSyncStateFailed)
+	if err != nil {
+		return nil, err
+	}
+	offset, limit, asc := object.ListQueryToModel(req.Query)
+	return &query.ProvisioningTargetUserSearchQueries{
+		SearchRequest: query.SearchRequest{
+			Offset:        offset,
+			Limit:         limit,
+			Asc:           asc,
+			SortingColumn: query.ProvisioningTargetUserColumnChangeDate,
+		},
+		Queries: []query.SearchQuery{stateQuery},
+	}, nil
+}
+
+func ProvisioningUserSyncsToPb(users []*query.ProvisioningTargetUser) []*app_pb.ProvisioningUserSync {
+	result := make([]*app_pb.ProvisioningUserSync, len(users))
+	for i, user := range users {
+		result[i] = ProvisioningUserSyncToPb(user)
+	}
+	return result
+}
+
+func ProvisioningUserSyncToPb(user *query.ProvisioningTargetUser) *app_pb.ProvisioningUserSync {
+	return &app_pb.ProvisioningUserSync{
+		Details:          object.ChangeToDetailsPb(user.Sequence, user.ChangeDate, user.ResourceOwner),
+		UserId:           user.UserID,
+		RemoteId:         user.RemoteID,
+		State:            provisioningSyncStateToPb(user.SyncState),
+		Error:            user.Error,
+		TriggerEventType: string(user.TriggerEventType),
+	}
+}
+
+func provisioningSyncStateToPb(state domain.ProvisioningSyncState) app_pb.ProvisioningSyncState {
+	switch state {
+	case domain.ProvisioningSyncStateSucceeded:
+		return app_pb.ProvisioningSyncState_PROVISIONING_SYNC_STATE_SUCCEEDED
+	case domain.ProvisioningSyncStateFailed:
+		return app_pb.ProvisioningSyncState_PROVISIONING_SYNC_STATE_FAILED
+	case domain.ProvisioningSyncStateUnspecified:
+		return app_pb.ProvisioningSyncState_PROVISIONING_SYNC_STATE_UNSPECIFIED
+	default:
+		return app_pb.ProvisioningSyncState_PROVISIONING_SYNC_STATE_UNSPECIFIED
+	}
+}
@@ -7,9 +7,11 @@ import (
 	member_grpc "github.com/zitadel/zitadel/internal/api/grpc/member"
 	"github.com/zitadel/zitadel/internal/api/grpc/object"
 	proj_grpc "github.com/zitadel/zitadel/internal/api/grpc/project"
+	"github.com/zitadel/zitadel/internal/command"
 	"github.com/zitadel/zitadel/internal/domain"
 	"github.com/zitadel/zitadel/internal/eventstore/v1/models"
 	"github.com/zitadel/zitadel/internal/query"
+	"github.com/zitadel/zitadel/internal/zerrors"
 	mgmt_pb "github.com/zitadel/zitadel/pkg/grpc/management"
 	proj_pb "github.com/zitadel/zitadel/pkg/grpc/project"
 )
@@ -59,6 +61,56 @@ func AddProjectRoleRequestToDomain(req *mgmt_pb.AddProjectRoleRequest) *domain.P
 	}
 }
 
+func listProjectAuthorizationDetailsTypesRequestToModel(req *mgmt_pb.ListProjectAuthorizationDetailsTypesRequest, resourceOwner string) (*query.ProjectAuthorizationDetailsTypeSearchQueries, error) {
+	offset, limit, asc := object.ListQueryToModel(req.Query)
+	projectIDQuery, err := query.NewProjectAuthorizationDetailsTypeProjectIDSearchQuery(req.ProjectId)
+	if err != nil {
+		return nil, err
+	}
+	resourceOwnerQuery, err := query.NewProjectAuthorizationDetailsTypeResourceOwnerSearchQuery(resourceOwner)
+	if err != nil {
+		return nil, err
+	}
+	return &query.ProjectAuthorizationDetailsTypeSearchQueries{
+		SearchRequest: query.SearchRequest{
+			Offset: offset,
+			Limit:  limit,
+			Asc:    asc,
+		},
+		Queries: []query.SearchQuery{projectIDQuery, resourceOwnerQuery},
+	}, nil
+}
+
+func AddProjectAuthorizationDetailsTypeRequestToCommand(req *mgmt_pb.AddProjectAuthorizationDetailsTypeRequest, resourceOwner string) (*command.ProjectAuthorizationDetailsType, error) {
+	schema, err := req.GetSchema().MarshalJSON()
+	if err != nil {
+		return nil, zerrors.ThrowInvalidArgument(err, "MANAG-Rar1s", "Errors.Project.AuthorizationDetailsType.SchemaInvalid")
+	}
+	return &command.ProjectAuthorizationDetailsType{
+		ObjectRoot: models.ObjectRoot{
+			AggregateID:   req.ProjectId,
+			ResourceOwner: resourceOwner,
+		},
+		Type:   req.Type,
+		Schema: schema,
+	}, nil
+}
+
+func UpdateProjectAuthorizationDetailsTypeRequestToCommand(req *mgmt_pb.UpdateProjectAuthorizationDetailsTypeRequest, resourceOwner string) (*command.ProjectAuthorizationDetailsType, error) {
+	schema, err := req.GetSchema().MarshalJSON()
+	if err != nil {
+		return nil, zerrors.ThrowInvalidArgument(err, "MANAG-Rar2s", "Errors.Project.AuthorizationDetailsType.SchemaInvalid")
+	}
+	return &command.ProjectAuthorizationDetailsType{
+		ObjectRoot: models.ObjectRoot{
+			AggregateID:   req.ProjectId,
+			ResourceOwner: resourceOwner,
+		},
+		Type:   req.Type,
+		Schema: schema,
+	}, nil
+}
+
 func BulkAddProjectRolesRequestToDomain(req *mgmt_pb.BulkAddProjectRolesRequest) []*domain.ProjectRole {
 	roles := make([]*domain.ProjectRole, len(req.Roles))
 	for i, role := range req.Roles {
@@ -722,6 +722,41 @@ func (s *Server) RemoveHumanPasswordless(ctx context.Context, req *mgmt_pb.Remov
 	}, nil
 }
 
+func (s *Server) ListAccountRecoveryRequests(ctx context.Context, req *mgmt_pb.ListAccountRecoveryRequestsRequest) (*mgmt_pb.ListAccountRecoveryRequestsResponse, error) {
+	queries, err := ListAccountRecoveryRequestsRequestToQuery(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	result, err := s.query.SearchAccountRecoveryRequests(ctx, queries)
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.ListAccountRecoveryRequestsResponse{
+		Result:  user_grpc.AccountRecoveryRequestsToPb(result.AccountRecoveryRequests),
+		Details: obj_grpc.ToListDetails(result.Count, result.Sequence, result.LastRun),
+	}, nil
+}
+
+func (s *Server) ApproveAccountRecovery(ctx context.Context, req *mgmt_pb.ApproveAccountRecoveryRequest) (*mgmt_pb.ApproveAccountRecoveryResponse, error) {
+	approved, err := s.command.ApproveAccountRecovery(ctx, req.UserId, authz.GetCtxData(ctx).OrgID, false, "")
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.ApproveAccountRecoveryResponse{
+		Details: obj_grpc.DomainToChangeDetailsPb(approved.ObjectDetails),
+	}, nil
+}
+
+func (s *Server) RejectAccountRecovery(ctx context.Context, req *mgmt_pb.RejectAccountRecoveryRequest) (*mgmt_pb.RejectAccountRecoveryResponse, error) {
+	objectDetails, err := s.command.RejectAccountRecovery(ctx, req.UserId, authz.GetCtxData(ctx).OrgID, req.Reason)
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.RejectAccountRecoveryResponse{
+		Details: obj_grpc.DomainToChangeDetailsPb(objectDetails),
+	}, nil
+}
+
 func (s *Server) UpdateMachine(ctx context.Context, req *mgmt_pb.UpdateMachineRequest) (*mgmt_pb.UpdateMachineResponse, error) {
 	machine := UpdateMachineRequestToCommand(req, authz.GetCtxData(ctx).OrgID)
 	objectDetails, err := s.command.ChangeMachine(ctx, machine)
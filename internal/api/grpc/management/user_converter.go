@@ -308,6 +308,30 @@ func ListPersonalAccessTokensRequestToQuery(ctx context.Context, req *mgmt_pb.Li
 
 }
 
+func ListAccountRecoveryRequestsRequestToQuery(ctx context.Context, req *mgmt_pb.ListAccountRecoveryRequestsRequest) (*query.AccountRecoveryRequestSearchQueries, error) {
+	resourceOwner, err := query.NewAccountRecoveryRequestResourceOwnerSearchQuery(authz.GetCtxData(ctx).OrgID)
+	if err != nil {
+		return nil, err
+	}
+	queries := []query.SearchQuery{resourceOwner}
+	if state := user_grpc.AccountRecoveryStateToDomain(req.State); state != domain.AccountRecoveryStateUnspecified {
+		stateQuery, err := query.NewAccountRecoveryRequestStateSearchQuery(state)
+		if err != nil {
+			return nil, err
+		}
+		queries = append(queries, stateQuery)
+	}
+	offset, limit, asc := object.ListQueryToModel(req.Query)
+	return &query.AccountRecoveryRequestSearchQueries{
+		SearchRequest: query.SearchRequest{
+			Offset: offset,
+			Limit:  limit,
+			Asc:    asc,
+		},
+		Queries: queries,
+	}, nil
+}
+
 func RemoveHumanLinkedIDPRequestToDomain(ctx context.Context, req *mgmt_pb.RemoveHumanLinkedIDPRequest) *domain.UserIDPLink {
 	return &domain.UserIDPLink{
 		ObjectRoot: models.ObjectRoot{
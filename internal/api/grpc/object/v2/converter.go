@@ -95,8 +95,9 @@ func AuthMethodToPb(mfa *query.AuthMethod) *user_pb.AuthFactor {
 	case domain.UserAuthMethodTypeU2F:
 		factor.Type = &user_pb.AuthFactor_U2F{
 			U2F: &user_pb.AuthFactorU2F{
-				Id:   mfa.TokenID,
-				Name: mfa.Name,
+				Id:                 mfa.TokenID,
+				Name:               mfa.Name,
+				AuthenticatorModel: mfa.AuthenticatorModel,
 			},
 		}
 	case domain.UserAuthMethodTypeOTPSMS:
@@ -113,6 +114,10 @@ func AuthMethodToPb(mfa *query.AuthMethod) *user_pb.AuthFactor {
 	case domain.UserAuthMethodTypeIDP:
 	case domain.UserAuthMethodTypeOTP:
 	case domain.UserAuthMethodTypePrivateKey:
+	case domain.UserAuthMethodTypeTrustedDevice:
+	case domain.UserAuthMethodTypeRecoveryCode:
+	case domain.UserAuthMethodTypeClientCertificate:
+	case domain.UserAuthMethodTypeMagicLink:
 	}
 	return factor
 }
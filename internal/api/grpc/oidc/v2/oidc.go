@@ -3,15 +3,18 @@ package oidc
 import (
 	"context"
 	"encoding/base64"
+	"encoding/json"
 
 	"github.com/zitadel/logging"
 	"github.com/zitadel/oidc/v3/pkg/op"
 	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/structpb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/zitadel/zitadel/internal/api/grpc/object/v2"
 	http_utils "github.com/zitadel/zitadel/internal/api/http"
 	"github.com/zitadel/zitadel/internal/api/oidc"
+	"github.com/zitadel/zitadel/internal/command"
 	"github.com/zitadel/zitadel/internal/domain"
 	"github.com/zitadel/zitadel/internal/query"
 	"github.com/zitadel/zitadel/internal/zerrors"
@@ -24,8 +27,12 @@ func (s *Server) GetAuthRequest(ctx context.Context, req *oidc_pb.GetAuthRequest
 		logging.WithError(err).Error("query authRequest by ID")
 		return nil, err
 	}
+	pba, err := authRequestToPb(authRequest)
+	if err != nil {
+		return nil, err
+	}
 	return &oidc_pb.GetAuthRequestResponse{
-		AuthRequest: authRequestToPb(authRequest),
+		AuthRequest: pba,
 	}, nil
 }
 
@@ -77,7 +84,47 @@ func (s *Server) AuthorizeOrDenyDeviceAuthorization(ctx context.Context, req *oi
 	return &oidc_pb.AuthorizeOrDenyDeviceAuthorizationResponse{}, nil
 }
 
-func authRequestToPb(a *query.AuthRequest) *oidc_pb.AuthRequest {
+func (s *Server) ListBackchannelAuthenticationRequests(ctx context.Context, req *oidc_pb.ListBackchannelAuthenticationRequestsRequest) (*oidc_pb.ListBackchannelAuthenticationRequestsResponse, error) {
+	requests, err := s.query.PendingBackChannelAuthRequestsByUserID(ctx, req.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+	return &oidc_pb.ListBackchannelAuthenticationRequestsResponse{
+		BackchannelAuthenticationRequests: backChannelAuthRequestsToPb(requests.BackChannelAuthRequests),
+	}, nil
+}
+
+func backChannelAuthRequestsToPb(requests []*query.BackChannelAuthRequest) []*oidc_pb.BackchannelAuthenticationRequest {
+	pb := make([]*oidc_pb.BackchannelAuthenticationRequest, len(requests))
+	for i, request := range requests {
+		pb[i] = &oidc_pb.BackchannelAuthenticationRequest{
+			Id:             request.ID,
+			CreationDate:   timestamppb.New(request.CreationDate),
+			ClientId:       request.ClientID,
+			Scope:          request.Scopes,
+			BindingMessage: request.BindingMessage,
+			ExpirationDate: timestamppb.New(request.Expiration),
+			AppName:        request.AppName,
+			ProjectName:    request.ProjectName,
+		}
+	}
+	return pb
+}
+
+func (s *Server) AuthorizeOrDenyBackchannelAuthentication(ctx context.Context, req *oidc_pb.AuthorizeOrDenyBackchannelAuthenticationRequest) (_ *oidc_pb.AuthorizeOrDenyBackchannelAuthenticationResponse, err error) {
+	switch req.GetDecision().(type) {
+	case *oidc_pb.AuthorizeOrDenyBackchannelAuthenticationRequest_Session:
+		_, err = s.command.ApproveBackChannelAuthWithSession(ctx, req.GetAuthReqId(), req.GetSession().GetSessionId(), req.GetSession().GetSessionToken())
+	case *oidc_pb.AuthorizeOrDenyBackchannelAuthenticationRequest_Deny:
+		_, err = s.command.DenyBackChannelAuth(ctx, req.GetAuthReqId())
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &oidc_pb.AuthorizeOrDenyBackchannelAuthenticationResponse{}, nil
+}
+
+func authRequestToPb(a *query.AuthRequest) (_ *oidc_pb.AuthRequest, err error) {
 	pba := &oidc_pb.AuthRequest{
 		Id:           a.ID,
 		CreationDate: timestamppb.New(a.CreationDate),
@@ -92,7 +139,42 @@ func authRequestToPb(a *query.AuthRequest) *oidc_pb.AuthRequest {
 	if a.MaxAge != nil {
 		pba.MaxAge = durationpb.New(*a.MaxAge)
 	}
-	return pba
+	pba.AuthorizationDetails, err = authorizationDetailsToPb(a.AuthorizationDetails)
+	if err != nil {
+		return nil, err
+	}
+	return pba, nil
+}
+
+func authorizationDetailsToPb(details domain.AuthorizationDetails) ([]*structpb.Struct, error) {
+	if len(details) == 0 {
+		return nil, nil
+	}
+	out := make([]*structpb.Struct, len(details))
+	for i, detail := range details {
+		data, err := json.Marshal(detail)
+		if err != nil {
+			return nil, zerrors.ThrowInternal(err, "OIDCv2-Rar1m", "Errors.Internal")
+		}
+		out[i] = new(structpb.Struct)
+		if err = out[i].UnmarshalJSON(data); err != nil {
+			return nil, zerrors.ThrowInternal(err, "OIDCv2-Rar2u", "Errors.Internal")
+		}
+	}
+	return out, nil
+}
+
+func authorizationDetailsDecisionToCommand(decision *oidc_pb.AuthorizationDetailsDecision) *command.AuthorizationDetailsDecision {
+	if decision == nil {
+		return nil
+	}
+	granted := make(domain.AuthorizationDetails, len(decision.GetGranted()))
+	for i, detail := range decision.GetGranted() {
+		granted[i] = detail.AsMap()
+	}
+	return &command.AuthorizationDetailsDecision{
+		Granted: granted,
+	}
 }
 
 func promptsToPb(promps []domain.Prompt) []oidc_pb.Prompt {
@@ -153,7 +235,7 @@ func (s *Server) failAuthRequest(ctx context.Context, authRequestID string, ae *
 }
 
 func (s *Server) linkSessionToAuthRequest(ctx context.Context, authRequestID string, session *oidc_pb.Session) (*oidc_pb.CreateCallbackResponse, error) {
-	details, aar, err := s.command.LinkSessionToAuthRequest(ctx, authRequestID, session.GetSessionId(), session.GetSessionToken(), true, s.checkPermission)
+	details, aar, err := s.command.LinkSessionToAuthRequest(ctx, authRequestID, session.GetSessionId(), session.GetSessionToken(), true, s.checkPermission, authorizationDetailsDecisionToCommand(session.GetAuthorizationDetailsDecision()))
 	if err != nil {
 		return nil, err
 	}
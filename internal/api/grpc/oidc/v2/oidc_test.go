@@ -1,15 +1,19 @@
 package oidc
 
 import (
+	"encoding/json"
 	"testing"
 	"time"
 
 	"github.com/muhlemmer/gu"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/structpb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
+	"github.com/zitadel/zitadel/internal/command"
 	"github.com/zitadel/zitadel/internal/domain"
 	"github.com/zitadel/zitadel/internal/query"
 	oidc_pb "github.com/zitadel/zitadel/pkg/grpc/oidc/v2"
@@ -36,6 +40,9 @@ func Test_authRequestToPb(t *testing.T) {
 		LoginHint:  gu.Ptr("foo@bar.com"),
 		MaxAge:     gu.Ptr(time.Minute),
 		HintUserID: gu.Ptr("userID"),
+		AuthorizationDetails: domain.AuthorizationDetails{
+			{"type": "payment_initiation", "amount": json.Number("10.5")},
+		},
 	}
 	want := &oidc_pb.AuthRequest{
 		Id:           "authID",
@@ -56,13 +63,98 @@ func Test_authRequestToPb(t *testing.T) {
 		LoginHint:  gu.Ptr("foo@bar.com"),
 		MaxAge:     durationpb.New(time.Minute),
 		HintUserId: gu.Ptr("userID"),
+		AuthorizationDetails: []*structpb.Struct{
+			{Fields: map[string]*structpb.Value{
+				"type":   structpb.NewStringValue("payment_initiation"),
+				"amount": structpb.NewNumberValue(10.5),
+			}},
+		},
 	}
-	got := authRequestToPb(arg)
+	got, err := authRequestToPb(arg)
+	require.NoError(t, err)
 	if !proto.Equal(want, got) {
 		t.Errorf("authRequestToPb() =\n%v\nwant\n%v\n", got, want)
 	}
 }
 
+func Test_backChannelAuthRequestsToPb(t *testing.T) {
+	now := time.Now()
+	arg := []*query.BackChannelAuthRequest{
+		{
+			ID:             "authReqID",
+			CreationDate:   now,
+			ClientID:       "clientID",
+			UserID:         "userID",
+			Scopes:         []string{"openid", "profile"},
+			BindingMessage: "123",
+			Expiration:     now.Add(time.Minute),
+			AppName:        "app",
+			ProjectName:    "project",
+		},
+	}
+	want := []*oidc_pb.BackchannelAuthenticationRequest{
+		{
+			Id:             "authReqID",
+			CreationDate:   timestamppb.New(now),
+			ClientId:       "clientID",
+			Scope:          []string{"openid", "profile"},
+			BindingMessage: "123",
+			ExpirationDate: timestamppb.New(now.Add(time.Minute)),
+			AppName:        "app",
+			ProjectName:    "project",
+		},
+	}
+	got := backChannelAuthRequestsToPb(arg)
+	require.Len(t, got, len(want))
+	for i := range want {
+		if !proto.Equal(want[i], got[i]) {
+			t.Errorf("backChannelAuthRequestsToPb()[%d] =\n%v\nwant\n%v\n", i, got[i], want[i])
+		}
+	}
+}
+
+func Test_authorizationDetailsDecisionToCommand(t *testing.T) {
+	tests := []struct {
+		name     string
+		decision *oidc_pb.AuthorizationDetailsDecision
+		want     *command.AuthorizationDetailsDecision
+	}{
+		{
+			name: "no decision",
+			want: nil,
+		},
+		{
+			name:     "nothing granted",
+			decision: &oidc_pb.AuthorizationDetailsDecision{},
+			want: &command.AuthorizationDetailsDecision{
+				Granted: domain.AuthorizationDetails{},
+			},
+		},
+		{
+			name: "granted",
+			decision: &oidc_pb.AuthorizationDetailsDecision{
+				Granted: []*structpb.Struct{
+					{Fields: map[string]*structpb.Value{
+						"type":   structpb.NewStringValue("payment_initiation"),
+						"amount": structpb.NewNumberValue(10.5),
+					}},
+				},
+			},
+			want: &command.AuthorizationDetailsDecision{
+				Granted: domain.AuthorizationDetails{
+					{"type": "payment_initiation", "amount": 10.5},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := authorizationDetailsDecisionToCommand(tt.decision)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
 func Test_errorReasonToOIDC(t *testing.T) {
 	tests := []struct {
 		reason oidc_pb.ErrorReason
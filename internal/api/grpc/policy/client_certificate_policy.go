@@ -0,0 +1,59 @@
+package policy
+
+import (
+	"github.com/zitadel/zitadel/internal/api/grpc/object"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/query"
+	policy_pb "github.com/zitadel/zitadel/pkg/grpc/policy"
+)
+
+func ModelClientCertificatePolicyToPb(policy *query.ClientCertificatePolicy) *policy_pb.ClientCertificatePolicy {
+	return &policy_pb.ClientCertificatePolicy{
+		CaCertificates: policy.CACertificates,
+		Crls:           policy.CRLs,
+		UserMapping:    ClientCertificateUserMappingToPb(policy.UserMapping),
+		Details: object.ToViewDetailsPb(
+			policy.Sequence,
+			policy.CreationDate,
+			policy.ChangeDate,
+			policy.ResourceOwner,
+		),
+	}
+}
+
+func ClientCertificateUserMappingToPb(mapping domain.ClientCertificateUserMapping) policy_pb.ClientCertificateUserMapping {
+	switch mapping {
+	case domain.ClientCertificateUserMappingSANEmail:
+		return policy_pb.ClientCertificateUserMapping_CLIENT_CERTIFICATE_USER_MAPPING_SAN_EMAIL
+	case domain.ClientCertificateUserMappingSANUPN:
+		return policy_pb.ClientCertificateUserMapping_CLIENT_CERTIFICATE_USER_MAPPING_SAN_UPN
+	default:
+		return policy_pb.ClientCertificateUserMapping_CLIENT_CERTIFICATE_USER_MAPPING_SUBJECT_COMMON_NAME
+	}
+}
+
+func ClientCertificateUserMappingToDomain(mapping policy_pb.ClientCertificateUserMapping) domain.ClientCertificateUserMapping {
+	switch mapping {
+	case policy_pb.ClientCertificateUserMapping_CLIENT_CERTIFICATE_USER_MAPPING_SAN_EMAIL:
+		return domain.ClientCertificateUserMappingSANEmail
+	case policy_pb.ClientCertificateUserMapping_CLIENT_CERTIFICATE_USER_MAPPING_SAN_UPN:
+		return domain.ClientCertificateUserMappingSANUPN
+	default:
+		return domain.ClientCertificateUserMappingSubjectCommonName
+	}
+}
+
+// clientCertificatePolicyRequest is implemented by the management set request.
+type clientCertificatePolicyRequest interface {
+	GetCaCertificates() []string
+	GetCrls() []string
+	GetUserMapping() policy_pb.ClientCertificateUserMapping
+}
+
+func ClientCertificatePolicyToDomain(req clientCertificatePolicyRequest) *domain.ClientCertificatePolicy {
+	return &domain.ClientCertificatePolicy{
+		CACertificates: req.GetCaCertificates(),
+		CRLs:           req.GetCrls(),
+		UserMapping:    ClientCertificateUserMappingToDomain(req.GetUserMapping()),
+	}
+}
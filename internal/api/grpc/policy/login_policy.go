@@ -33,6 +33,10 @@ func ModelLoginPolicyToPb(policy *query.LoginPolicy) *policy_pb.LoginPolicy {
 		MfaInitSkipLifetime:        durationpb.New(time.Duration(policy.MFAInitSkipLifetime)),
 		SecondFactorCheckLifetime:  durationpb.New(time.Duration(policy.SecondFactorCheckLifetime)),
 		MultiFactorCheckLifetime:   durationpb.New(time.Duration(policy.MultiFactorCheckLifetime)),
+		ForceMfaOnHighRisk:         policy.ForceMFAOnHighRisk,
+		TrustedDeviceLifetime:      durationpb.New(time.Duration(policy.TrustedDeviceLifetime)),
+		AllowAccountRecovery:       policy.AllowAccountRecovery,
+		AllowMagicLink:             policy.AllowMagicLink,
 		SecondFactors:              ModelSecondFactorTypesToPb(policy.SecondFactors),
 		MultiFactors:               ModelMultiFactorTypesToPb(policy.MultiFactors),
 		Idps:                       idp_grpc.IDPLoginPolicyLinksToPb(policy.IDPLinks),
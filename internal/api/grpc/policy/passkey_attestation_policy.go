@@ -0,0 +1,70 @@
+package policy
+
+import (
+	"github.com/zitadel/zitadel/internal/api/grpc/object"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/query"
+	policy_pb "github.com/zitadel/zitadel/pkg/grpc/policy"
+)
+
+func ModelPasskeyAttestationPolicyToPb(policy *query.PasskeyAttestationPolicy) *policy_pb.PasskeyAttestationPolicy {
+	return &policy_pb.PasskeyAttestationPolicy{
+		IsDefault:        policy.IsDefault,
+		Conveyance:       AttestationConveyanceToPb(policy.Conveyance),
+		RootCertificates: policy.RootCertificates,
+		UseMetadata:      policy.UseMetadata,
+		AllowedAaguids:   policy.AllowedAAGUIDs,
+		DeniedAaguids:    policy.DeniedAAGUIDs,
+		Details: object.ToViewDetailsPb(
+			policy.Sequence,
+			policy.CreationDate,
+			policy.ChangeDate,
+			policy.ResourceOwner,
+		),
+	}
+}
+
+func AttestationConveyanceToPb(conveyance domain.AttestationConveyance) policy_pb.AttestationConveyance {
+	switch conveyance {
+	case domain.AttestationConveyanceIndirect:
+		return policy_pb.AttestationConveyance_ATTESTATION_CONVEYANCE_INDIRECT
+	case domain.AttestationConveyanceDirect:
+		return policy_pb.AttestationConveyance_ATTESTATION_CONVEYANCE_DIRECT
+	case domain.AttestationConveyanceEnterprise:
+		return policy_pb.AttestationConveyance_ATTESTATION_CONVEYANCE_ENTERPRISE
+	default:
+		return policy_pb.AttestationConveyance_ATTESTATION_CONVEYANCE_NONE
+	}
+}
+
+func AttestationConveyanceToDomain(conveyance policy_pb.AttestationConveyance) domain.AttestationConveyance {
+	switch conveyance {
+	case policy_pb.AttestationConveyance_ATTESTATION_CONVEYANCE_INDIRECT:
+		return domain.AttestationConveyanceIndirect
+	case policy_pb.AttestationConveyance_ATTESTATION_CONVEYANCE_DIRECT:
+		return domain.AttestationConveyanceDirect
+	case policy_pb.AttestationConveyance_ATTESTATION_CONVEYANCE_ENTERPRISE:
+		return domain.AttestationConveyanceEnterprise
+	default:
+		return domain.AttestationConveyanceNone
+	}
+}
+
+// passkeyAttestationPolicyRequest is implemented by the admin and management set requests.
+type passkeyAttestationPolicyRequest interface {
+	GetConveyance() policy_pb.AttestationConveyance
+	GetRootCertificates() []string
+	GetUseMetadata() bool
+	GetAllowedAaguids() []string
+	GetDeniedAaguids() []string
+}
+
+func PasskeyAttestationPolicyToDomain(req passkeyAttestationPolicyRequest) *domain.PasskeyAttestationPolicy {
+	return &domain.PasskeyAttestationPolicy{
+		Conveyance:       AttestationConveyanceToDomain(req.GetConveyance()),
+		RootCertificates: req.GetRootCertificates(),
+		UseMetadata:      req.GetUseMetadata(),
+		AllowedAAGUIDs:   req.GetAllowedAaguids(),
+		DeniedAAGUIDs:    req.GetDeniedAaguids(),
+	}
+}
@@ -1,6 +1,10 @@
 package policy
 
 import (
+	"time"
+
+	"google.golang.org/protobuf/types/known/durationpb"
+
 	"github.com/zitadel/zitadel/internal/api/grpc/object"
 	"github.com/zitadel/zitadel/internal/query"
 	policy_pb "github.com/zitadel/zitadel/pkg/grpc/policy"
@@ -8,9 +12,14 @@ import (
 
 func ModelLockoutPolicyToPb(policy *query.LockoutPolicy) *policy_pb.LockoutPolicy {
 	return &policy_pb.LockoutPolicy{
-		IsDefault:           policy.IsDefault,
-		MaxPasswordAttempts: policy.MaxPasswordAttempts,
-		MaxOtpAttempts:      policy.MaxOTPAttempts,
+		IsDefault:             policy.IsDefault,
+		MaxPasswordAttempts:   policy.MaxPasswordAttempts,
+		MaxOtpAttempts:        policy.MaxOTPAttempts,
+		MaxIpAttempts:         policy.MaxIPAttempts,
+		MaxClientAttempts:     policy.MaxClientAttempts,
+		ThrottleWindow:        durationpb.New(time.Duration(policy.ThrottleWindow)),
+		ThrottleDelay:         durationpb.New(time.Duration(policy.ThrottleDelay)),
+		ThrottleBlockDuration: durationpb.New(time.Duration(policy.ThrottleBlockDuration)),
 		Details: object.ToViewDetailsPb(
 			policy.Sequence,
 			policy.CreationDate,
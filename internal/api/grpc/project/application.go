@@ -44,27 +44,30 @@ func AppConfigToPb(app *query.App) app_pb.AppConfig {
 func AppOIDCConfigToPb(app *query.OIDCApp) *app_pb.App_OidcConfig {
 	return &app_pb.App_OidcConfig{
 		OidcConfig: &app_pb.OIDCConfig{
-			RedirectUris:             app.RedirectURIs,
-			ResponseTypes:            OIDCResponseTypesFromModel(app.ResponseTypes),
-			GrantTypes:               OIDCGrantTypesFromModel(app.GrantTypes),
-			AppType:                  OIDCApplicationTypeToPb(app.AppType),
-			ClientId:                 app.ClientID,
-			AuthMethodType:           OIDCAuthMethodTypeToPb(app.AuthMethodType),
-			PostLogoutRedirectUris:   app.PostLogoutRedirectURIs,
-			Version:                  OIDCVersionToPb(domain.OIDCVersion(app.Version)),
-			NoneCompliant:            len(app.ComplianceProblems) != 0,
-			ComplianceProblems:       ComplianceProblemsToLocalizedMessages(app.ComplianceProblems),
-			DevMode:                  app.IsDevMode,
-			AccessTokenType:          oidcTokenTypeToPb(app.AccessTokenType),
-			AccessTokenRoleAssertion: app.AssertAccessTokenRole,
-			IdTokenRoleAssertion:     app.AssertIDTokenRole,
-			IdTokenUserinfoAssertion: app.AssertIDTokenUserinfo,
-			ClockSkew:                durationpb.New(app.ClockSkew),
-			AdditionalOrigins:        app.AdditionalOrigins,
-			AllowedOrigins:           app.AllowedOrigins,
-			SkipNativeAppSuccessPage: app.SkipNativeAppSuccessPage,
-			BackChannelLogoutUri:     app.BackChannelLogoutURI,
-			LoginVersion:             loginVersionToPb(app.LoginVersion, app.LoginBaseURI),
+			RedirectUris:                     app.RedirectURIs,
+			ResponseTypes:                    OIDCResponseTypesFromModel(app.ResponseTypes),
+			GrantTypes:                       OIDCGrantTypesFromModel(app.GrantTypes),
+			AppType:                          OIDCApplicationTypeToPb(app.AppType),
+			ClientId:                         app.ClientID,
+			AuthMethodType:                   OIDCAuthMethodTypeToPb(app.AuthMethodType),
+			PostLogoutRedirectUris:           app.PostLogoutRedirectURIs,
+			Version:                          OIDCVersionToPb(domain.OIDCVersion(app.Version)),
+			NoneCompliant:                    len(app.ComplianceProblems) != 0,
+			ComplianceProblems:               ComplianceProblemsToLocalizedMessages(app.ComplianceProblems),
+			DevMode:                          app.IsDevMode,
+			AccessTokenType:                  oidcTokenTypeToPb(app.AccessTokenType),
+			AccessTokenRoleAssertion:         app.AssertAccessTokenRole,
+			IdTokenRoleAssertion:             app.AssertIDTokenRole,
+			IdTokenUserinfoAssertion:         app.AssertIDTokenUserinfo,
+			ClockSkew:                        durationpb.New(app.ClockSkew),
+			AdditionalOrigins:                app.AdditionalOrigins,
+			AllowedOrigins:                   app.AllowedOrigins,
+			SkipNativeAppSuccessPage:         app.SkipNativeAppSuccessPage,
+			BackChannelLogoutUri:             app.BackChannelLogoutURI,
+			LoginVersion:                     loginVersionToPb(app.LoginVersion, app.LoginBaseURI),
+			RequirePushedAuthRequest:         app.RequirePushedAuthRequest,
+			RequireDpop:                      app.RequireDPoP,
+			BackChannelClientNotificationUri: app.BackChannelClientNotificationURI,
 		},
 	}
 }
@@ -158,6 +161,8 @@ func OIDCGrantTypesFromModel(grantTypes []domain.OIDCGrantType) []app_pb.OIDCGra
 			oidcGrantTypes[i] = app_pb.OIDCGrantType_OIDC_GRANT_TYPE_DEVICE_CODE
 		case domain.OIDCGrantTypeTokenExchange:
 			oidcGrantTypes[i] = app_pb.OIDCGrantType_OIDC_GRANT_TYPE_TOKEN_EXCHANGE
+		case domain.OIDCGrantTypeCIBA:
+			oidcGrantTypes[i] = app_pb.OIDCGrantType_OIDC_GRANT_TYPE_CIBA
 		}
 	}
 	return oidcGrantTypes
@@ -180,6 +185,8 @@ func OIDCGrantTypesToDomain(grantTypes []app_pb.OIDCGrantType) []domain.OIDCGran
 			oidcGrantTypes[i] = domain.OIDCGrantTypeDeviceCode
 		case app_pb.OIDCGrantType_OIDC_GRANT_TYPE_TOKEN_EXCHANGE:
 			oidcGrantTypes[i] = domain.OIDCGrantTypeTokenExchange
+		case app_pb.OIDCGrantType_OIDC_GRANT_TYPE_CIBA:
+			oidcGrantTypes[i] = domain.OIDCGrantTypeCIBA
 		}
 	}
 	return oidcGrantTypes
@@ -1,6 +1,8 @@
 package project
 
 import (
+	"google.golang.org/protobuf/types/known/structpb"
+
 	"github.com/zitadel/zitadel/internal/api/grpc/object"
 	"github.com/zitadel/zitadel/internal/domain"
 	"github.com/zitadel/zitadel/internal/query"
@@ -141,6 +143,27 @@ func RoleViewsToPb(roles []*query.ProjectRole) []*proj_pb.Role {
 	return o
 }
 
+func AuthorizationDetailsTypesToPb(types []*query.ProjectAuthorizationDetailsType) ([]*proj_pb.AuthorizationDetailsType, error) {
+	o := make([]*proj_pb.AuthorizationDetailsType, len(types))
+	for i, detailsType := range types {
+		schema := new(structpb.Struct)
+		if err := schema.UnmarshalJSON(detailsType.Schema); err != nil {
+			return nil, zerrors.ThrowInternal(err, "PROJECT-Rar1s", "Errors.Internal")
+		}
+		o[i] = &proj_pb.AuthorizationDetailsType{
+			Type:   detailsType.Type,
+			Schema: schema,
+			Details: object.ToViewDetailsPb(
+				detailsType.Sequence,
+				detailsType.CreationDate,
+				detailsType.ChangeDate,
+				detailsType.ResourceOwner,
+			),
+		}
+	}
+	return o, nil
+}
+
 func RoleViewToPb(role *query.ProjectRole) *proj_pb.Role {
 	return &proj_pb.Role{
 		Key:         role.Key,
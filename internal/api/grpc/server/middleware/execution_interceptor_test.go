@@ -26,6 +26,7 @@ type mockExecutionTarget struct {
 	ExecutionID      string
 	TargetID         string
 	TargetType       domain.TargetType
+	Transport        domain.TargetTransport
 	Endpoint         string
 	Timeout          time.Duration
 	InterruptOnError bool
@@ -44,6 +45,9 @@ func (e *mockExecutionTarget) GetEndpoint() string {
 func (e *mockExecutionTarget) GetTargetType() domain.TargetType {
 	return e.TargetType
 }
+func (e *mockExecutionTarget) GetTransport() domain.TargetTransport {
+	return e.Transport
+}
 func (e *mockExecutionTarget) GetTimeout() time.Duration {
 	return e.Timeout
 }
@@ -53,8 +57,14 @@ func (e *mockExecutionTarget) GetTargetID() string {
 func (e *mockExecutionTarget) GetExecutionID() string {
 	return e.ExecutionID
 }
-func (e *mockExecutionTarget) GetSigningKey() string {
-	return e.SigningKey
+func (e *mockExecutionTarget) GetSigningAlgorithm() domain.TargetSigningAlgorithm {
+	return domain.TargetSigningAlgorithmHMACSHA256
+}
+func (e *mockExecutionTarget) GetSigningKeys() []string {
+	if e.SigningKey == "" {
+		return nil
+	}
+	return []string{e.SigningKey}
 }
 
 func newMockContentRequest(content string) proto.Message {
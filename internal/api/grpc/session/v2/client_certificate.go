@@ -0,0 +1,170 @@
+package session
+
+import (
+	"context"
+	"crypto/x509"
+	"fmt"
+	"net/netip"
+	"net/url"
+	"strings"
+
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/query"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// ClientCertificateConfig describes how a TLS terminating reverse proxy passes the verified client certificate of the user.
+type ClientCertificateConfig struct {
+	// Header in which the proxy passes the URL encoded PEM client certificate (chain).
+	Header string
+	// VerifyHeader in which the proxy passes the result of the certificate verification, which must be SUCCESS.
+	VerifyHeader string
+	// TrustedProxies are the IP addresses or CIDRs of the proxies, which are allowed to pass the headers.
+	TrustedProxies []string
+}
+
+// ClientCertificateSource reads the client certificate passed by a trusted reverse proxy.
+// The certificate of a TLS connection to ZITADEL belongs to the caller of the API (e.g. the login UI)
+// and is therefore never used as credential of the user.
+type ClientCertificateSource struct {
+	header         string
+	verifyHeader   string
+	trustedProxies []netip.Prefix
+}
+
+// NewClientCertificateSource returns nil if no client certificate header is configured.
+func NewClientCertificateSource(config ClientCertificateConfig) (*ClientCertificateSource, error) {
+	if config.Header == "" {
+		return nil, nil
+	}
+	if config.VerifyHeader == "" || len(config.TrustedProxies) == 0 {
+		return nil, fmt.Errorf("client certificate header requires a verify header and trusted proxies")
+	}
+	source := &ClientCertificateSource{
+		header:         strings.ToLower(config.Header),
+		verifyHeader:   strings.ToLower(config.VerifyHeader),
+		trustedProxies: make([]netip.Prefix, len(config.TrustedProxies)),
+	}
+	for i, proxy := range config.TrustedProxies {
+		prefix, err := netip.ParsePrefix(proxy)
+		if err != nil {
+			addr, addrErr := netip.ParseAddr(proxy)
+			if addrErr != nil {
+				return nil, fmt.Errorf("invalid trusted proxy %q: %w", proxy, err)
+			}
+			prefix = netip.PrefixFrom(addr, addr.BitLen())
+		}
+		source.trustedProxies[i] = prefix.Masked()
+	}
+	return source, nil
+}
+
+// Headers returns the headers, which have to be forwarded by the gateway.
+func (c *ClientCertificateSource) Headers() []string {
+	if c == nil {
+		return nil
+	}
+	return []string{c.header, c.verifyHeader}
+}
+
+// chain returns the client certificate (chain) passed by a trusted proxy, which verified it on TLS termination.
+// Requests of any other source are treated as if no certificate was presented.
+func (c *ClientCertificateSource) chain(ctx context.Context) ([]*x509.Certificate, error) {
+	if c == nil || !c.isTrustedProxy(ctx) {
+		return nil, nil
+	}
+	values := metadata.ValueFromIncomingContext(ctx, c.header)
+	if len(values) == 0 || values[0] == "" {
+		return nil, nil
+	}
+	if verify := metadata.ValueFromIncomingContext(ctx, c.verifyHeader); len(verify) == 0 || verify[0] != "SUCCESS" {
+		return nil, zerrors.ThrowPermissionDenied(nil, "SESSION-Cc5Vf", "Errors.Session.ClientCertificate.Invalid")
+	}
+	bundle, err := url.QueryUnescape(values[0])
+	if err != nil {
+		return nil, zerrors.ThrowInvalidArgument(err, "SESSION-Cc1Ue", "Errors.Session.ClientCertificate.Invalid")
+	}
+	chain, err := domain.ParseCertificates(bundle)
+	if err != nil {
+		return nil, zerrors.ThrowInvalidArgument(err, "SESSION-Cc2Pa", "Errors.Session.ClientCertificate.Invalid")
+	}
+	return chain, nil
+}
+
+// isTrustedProxy checks the address of the caller against the trusted proxies.
+// REST calls are passed by the gateway over the loopback interface,
+// in which case the remote address of the HTTP request appended to x-forwarded-for by the gateway is used.
+func (c *ClientCertificateSource) isTrustedProxy(ctx context.Context) bool {
+	p, ok := peer.FromContext(ctx)
+	if !ok || p.Addr == nil {
+		return false
+	}
+	addrPort, err := netip.ParseAddrPort(p.Addr.String())
+	if err != nil {
+		return false
+	}
+	addr := addrPort.Addr().Unmap()
+	if addr.IsLoopback() {
+		if forwarded := metadata.ValueFromIncomingContext(ctx, "x-forwarded-for"); len(forwarded) > 0 {
+			hops := strings.Split(forwarded[len(forwarded)-1], ",")
+			if addr, err = netip.ParseAddr(strings.TrimSpace(hops[len(hops)-1])); err != nil {
+				return false
+			}
+			addr = addr.Unmap()
+		}
+	}
+	for _, prefix := range c.trustedProxies {
+		if prefix.Contains(addr) {
+			return true
+		}
+	}
+	return false
+}
+
+// userByClientCertificate identifies the user of the organization based on its client certificate policy.
+func userByClientCertificate(orgID string, chain []*x509.Certificate) userSearch {
+	return userSearchByClientCertificate{orgID: orgID, chain: chain}
+}
+
+type userSearchByClientCertificate struct {
+	orgID string
+	chain []*x509.Certificate
+}
+
+func (u userSearchByClientCertificate) search(ctx context.Context, q *query.Queries) (*query.User, error) {
+	if len(u.chain) == 0 {
+		return nil, zerrors.ThrowInvalidArgument(nil, "SESSION-Cc3Mi", "Errors.Session.ClientCertificate.Missing")
+	}
+	policy, err := q.ClientCertificatePolicyByOrg(ctx, false, u.orgID)
+	if err != nil {
+		if zerrors.IsNotFound(err) {
+			return nil, zerrors.ThrowPreconditionFailed(err, "SESSION-Cc4Nc", "Errors.Session.ClientCertificate.NotConfigured")
+		}
+		return nil, err
+	}
+	identifier, err := policy.ToDomain().UserIdentifier(u.chain[0])
+	if err != nil {
+		return nil, err
+	}
+	var identifierQuery query.SearchQuery
+	if policy.UserMapping == domain.ClientCertificateUserMappingSANEmail {
+		identifierQuery, err = query.NewUserVerifiedEmailSearchQuery(identifier)
+	} else {
+		identifierQuery, err = query.NewUserUsernameSearchQuery(identifier, query.TextEqualsIgnoreCase)
+	}
+	if err != nil {
+		return nil, err
+	}
+	ownerQuery, err := query.NewUserResourceOwnerSearchQuery(u.orgID, query.TextEquals)
+	if err != nil {
+		return nil, err
+	}
+	user, err := q.GetNotifyUser(ctx, true, identifierQuery, ownerQuery)
+	if err != nil {
+		return nil, err
+	}
+	return q.GetUserByID(ctx, false, user.ID)
+}
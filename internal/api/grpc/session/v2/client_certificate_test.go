@@ -0,0 +1,176 @@
+package session
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net"
+	"net/netip"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+func TestNewClientCertificateSource(t *testing.T) {
+	tests := []struct {
+		name    string
+		config  ClientCertificateConfig
+		want    *ClientCertificateSource
+		wantErr bool
+	}{
+		{
+			name: "no header",
+		},
+		{
+			name: "missing verify header",
+			config: ClientCertificateConfig{
+				Header:         "X-SSL-Client-Cert",
+				TrustedProxies: []string{"10.0.0.1"},
+			},
+			wantErr: true,
+		},
+		{
+			name: "missing trusted proxies",
+			config: ClientCertificateConfig{
+				Header:       "X-SSL-Client-Cert",
+				VerifyHeader: "X-SSL-Client-Verify",
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalid trusted proxy",
+			config: ClientCertificateConfig{
+				Header:         "X-SSL-Client-Cert",
+				VerifyHeader:   "X-SSL-Client-Verify",
+				TrustedProxies: []string{"proxy"},
+			},
+			wantErr: true,
+		},
+		{
+			name: "ok",
+			config: ClientCertificateConfig{
+				Header:         "X-SSL-Client-Cert",
+				VerifyHeader:   "X-SSL-Client-Verify",
+				TrustedProxies: []string{"10.0.0.1", "10.1.0.0/16"},
+			},
+			want: &ClientCertificateSource{
+				header:         "x-ssl-client-cert",
+				verifyHeader:   "x-ssl-client-verify",
+				trustedProxies: []netip.Prefix{netip.MustParsePrefix("10.0.0.1/32"), netip.MustParsePrefix("10.1.0.0/16")},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NewClientCertificateSource(tt.config)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestClientCertificateSource_chain(t *testing.T) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	der, err := x509.CreateCertificate(rand.Reader, &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "user"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+	}, &x509.Certificate{SerialNumber: big.NewInt(1), Subject: pkix.Name{CommonName: "user"}}, key.Public(), key)
+	require.NoError(t, err)
+	certificate := url.QueryEscape(string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})))
+
+	source, err := NewClientCertificateSource(ClientCertificateConfig{
+		Header:         "x-ssl-client-cert",
+		VerifyHeader:   "x-ssl-client-verify",
+		TrustedProxies: []string{"10.0.0.0/24"},
+	})
+	require.NoError(t, err)
+
+	newContext := func(remoteAddr string, kv ...string) context.Context {
+		ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(kv...))
+		addr, err := net.ResolveTCPAddr("tcp", remoteAddr)
+		require.NoError(t, err)
+		return peer.NewContext(ctx, &peer.Peer{Addr: addr})
+	}
+
+	tests := []struct {
+		name      string
+		source    *ClientCertificateSource
+		ctx       context.Context
+		wantChain bool
+		wantErr   error
+	}{
+		{
+			name:   "not configured",
+			source: nil,
+			ctx:    newContext("10.0.0.1:443", "x-ssl-client-cert", certificate, "x-ssl-client-verify", "SUCCESS"),
+		},
+		{
+			name:   "untrusted caller",
+			source: source,
+			ctx:    newContext("192.168.0.1:443", "x-ssl-client-cert", certificate, "x-ssl-client-verify", "SUCCESS"),
+		},
+		{
+			name:   "untrusted caller through gateway",
+			source: source,
+			ctx:    newContext("127.0.0.1:8080", "x-ssl-client-cert", certificate, "x-ssl-client-verify", "SUCCESS", "x-forwarded-for", "10.0.0.1, 192.168.0.1"),
+		},
+		{
+			name:   "no certificate",
+			source: source,
+			ctx:    newContext("10.0.0.1:443"),
+		},
+		{
+			name:    "not verified by proxy",
+			source:  source,
+			ctx:     newContext("10.0.0.1:443", "x-ssl-client-cert", certificate, "x-ssl-client-verify", "FAILED:unable to verify"),
+			wantErr: zerrors.ThrowPermissionDenied(nil, "SESSION-Cc5Vf", "Errors.Session.ClientCertificate.Invalid"),
+		},
+		{
+			name:      "trusted caller",
+			source:    source,
+			ctx:       newContext("10.0.0.1:443", "x-ssl-client-cert", certificate, "x-ssl-client-verify", "SUCCESS"),
+			wantChain: true,
+		},
+		{
+			name:      "trusted caller through gateway",
+			source:    source,
+			ctx:       newContext("127.0.0.1:8080", "x-ssl-client-cert", certificate, "x-ssl-client-verify", "SUCCESS", "x-forwarded-for", "10.0.0.2"),
+			wantChain: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			chain, err := tt.source.chain(tt.ctx)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			if !tt.wantChain {
+				assert.Empty(t, chain)
+				return
+			}
+			require.Len(t, chain, 1)
+			assert.Equal(t, der, chain[0].Raw)
+		})
+	}
+}
@@ -152,6 +152,37 @@ func sessionToPb(s *query.Session) *session.Session {
 		Metadata:       s.Metadata,
 		UserAgent:      userAgentToPb(s.UserAgent),
 		ExpirationDate: expirationToPb(s.Expiration),
+		Risk:           riskToPb(s.Risk),
+	}
+}
+
+func riskToPb(risk query.SessionRisk) *session.Risk {
+	if risk.EvaluatedAt.IsZero() {
+		return nil
+	}
+	return &session.Risk{
+		EvaluatedAt:      timestamppb.New(risk.EvaluatedAt),
+		Level:            riskLevelToPb(risk.Level),
+		NewDevice:        risk.NewDevice,
+		NewCountry:       risk.NewCountry,
+		ImpossibleTravel: risk.ImpossibleTravel,
+		Country:          risk.Country,
+		StepUpRequired:   risk.StepUpRequired,
+	}
+}
+
+func riskLevelToPb(level domain.SessionRiskLevel) session.RiskLevel {
+	switch level {
+	case domain.SessionRiskLevelLow:
+		return session.RiskLevel_RISK_LEVEL_LOW
+	case domain.SessionRiskLevelMedium:
+		return session.RiskLevel_RISK_LEVEL_MEDIUM
+	case domain.SessionRiskLevelHigh:
+		return session.RiskLevel_RISK_LEVEL_HIGH
+	case domain.SessionRiskLevelUnspecified:
+		return session.RiskLevel_RISK_LEVEL_UNSPECIFIED
+	default:
+		return session.RiskLevel_RISK_LEVEL_UNSPECIFIED
 	}
 }
 
@@ -192,13 +223,17 @@ func factorsToPb(s *query.Session) *session.Factors {
 		return nil
 	}
 	return &session.Factors{
-		User:     user,
-		Password: passwordFactorToPb(s.PasswordFactor),
-		WebAuthN: webAuthNFactorToPb(s.WebAuthNFactor),
-		Intent:   intentFactorToPb(s.IntentFactor),
-		Totp:     totpFactorToPb(s.TOTPFactor),
-		OtpSms:   otpFactorToPb(s.OTPSMSFactor),
-		OtpEmail: otpFactorToPb(s.OTPEmailFactor),
+		User:              user,
+		Password:          passwordFactorToPb(s.PasswordFactor),
+		WebAuthN:          webAuthNFactorToPb(s.WebAuthNFactor),
+		Intent:            intentFactorToPb(s.IntentFactor),
+		Totp:              totpFactorToPb(s.TOTPFactor),
+		OtpSms:            otpFactorToPb(s.OTPSMSFactor),
+		OtpEmail:          otpFactorToPb(s.OTPEmailFactor),
+		TrustedDevice:     trustedDeviceFactorToPb(s.TrustedDeviceFactor),
+		RecoveryCode:      recoveryCodeFactorToPb(s.RecoveryCodeFactor),
+		ClientCertificate: clientCertificateFactorToPb(s.ClientCertificateFactor),
+		MagicLink:         magicLinkFactorToPb(s.MagicLinkFactor),
 	}
 }
 
@@ -248,6 +283,42 @@ func otpFactorToPb(factor query.SessionOTPFactor) *session.OTPFactor {
 	}
 }
 
+func trustedDeviceFactorToPb(factor query.SessionTrustedDeviceFactor) *session.TrustedDeviceFactor {
+	if factor.TrustedDeviceCheckedAt.IsZero() {
+		return nil
+	}
+	return &session.TrustedDeviceFactor{
+		VerifiedAt: timestamppb.New(factor.TrustedDeviceCheckedAt),
+	}
+}
+
+func recoveryCodeFactorToPb(factor query.SessionRecoveryCodeFactor) *session.RecoveryCodeFactor {
+	if factor.RecoveryCodeCheckedAt.IsZero() {
+		return nil
+	}
+	return &session.RecoveryCodeFactor{
+		VerifiedAt: timestamppb.New(factor.RecoveryCodeCheckedAt),
+	}
+}
+
+func clientCertificateFactorToPb(factor query.SessionClientCertificateFactor) *session.ClientCertificateFactor {
+	if factor.ClientCertificateCheckedAt.IsZero() {
+		return nil
+	}
+	return &session.ClientCertificateFactor{
+		VerifiedAt: timestamppb.New(factor.ClientCertificateCheckedAt),
+	}
+}
+
+func magicLinkFactorToPb(factor query.SessionMagicLinkFactor) *session.MagicLinkFactor {
+	if factor.MagicLinkCheckedAt.IsZero() {
+		return nil
+	}
+	return &session.MagicLinkFactor{
+		VerifiedAt: timestamppb.New(factor.MagicLinkCheckedAt),
+	}
+}
+
 func userFactorToPb(factor query.SessionUserFactor) *session.UserFactor {
 	if factor.UserID == "" || factor.UserCheckedAt.IsZero() {
 		return nil
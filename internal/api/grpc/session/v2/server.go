@@ -19,6 +19,8 @@ type Server struct {
 	query   *query.Queries
 
 	checkPermission domain.PermissionCheck
+	// clientCertificate is nil if no reverse proxy passes client certificates
+	clientCertificate *ClientCertificateSource
 }
 
 type Config struct{}
@@ -27,11 +29,13 @@ func CreateServer(
 	command *command.Commands,
 	query *query.Queries,
 	checkPermission domain.PermissionCheck,
+	clientCertificate *ClientCertificateSource,
 ) *Server {
 	return &Server{
-		command:         command,
-		query:           query,
-		checkPermission: checkPermission,
+		command:           command,
+		query:             query,
+		checkPermission:   checkPermission,
+		clientCertificate: clientCertificate,
 	}
 }
 
@@ -2,6 +2,7 @@ package session
 
 import (
 	"context"
+	"crypto/x509"
 	"net"
 	"net/http"
 	"time"
@@ -110,6 +111,17 @@ func (s *Server) checksToCommand(ctx context.Context, checks *session.Checks) ([
 	if err != nil {
 		return nil, err
 	}
+	var clientCertificateChain []*x509.Certificate
+	if certificate := checks.GetClientCertificate(); certificate != nil {
+		clientCertificateChain, err = s.clientCertificate.chain(ctx)
+		if err != nil {
+			return nil, err
+		}
+		// without a user check, the user is identified by the certificate based on the policy of the organization
+		if checkUser == nil && certificate.OrganizationId != nil {
+			checkUser = userByClientCertificate(certificate.GetOrganizationId(), clientCertificateChain)
+		}
+	}
 	sessionChecks := make([]command.SessionCommand, 0, 7)
 	if checkUser != nil {
 		user, err := checkUser.search(ctx, s.query)
@@ -144,6 +156,18 @@ func (s *Server) checksToCommand(ctx context.Context, checks *session.Checks) ([
 	if otp := checks.GetOtpEmail(); otp != nil {
 		sessionChecks = append(sessionChecks, command.CheckOTPEmail(otp.GetCode()))
 	}
+	if device := checks.GetTrustedDevice(); device != nil {
+		sessionChecks = append(sessionChecks, command.CheckTrustedDevice(device.GetId(), device.GetToken()))
+	}
+	if recoveryCode := checks.GetRecoveryCode(); recoveryCode != nil {
+		sessionChecks = append(sessionChecks, command.CheckRecoveryCode(recoveryCode.GetCode()))
+	}
+	if checks.GetClientCertificate() != nil {
+		sessionChecks = append(sessionChecks, command.CheckClientCertificate(clientCertificateChain))
+	}
+	if magicLink := checks.GetMagicLink(); magicLink != nil {
+		sessionChecks = append(sessionChecks, command.CheckMagicLink(magicLink.GetCode(), magicLink.GetAuthRequestId(), magicLink.GetUserAgentId()))
+	}
 	return sessionChecks, nil
 }
 
@@ -170,6 +194,14 @@ func (s *Server) challengesToCommand(challenges *session.RequestChallenges, cmds
 		resp.OtpEmail = challenge
 		cmds = append(cmds, cmd)
 	}
+	if req := challenges.GetMagicLink(); req != nil {
+		challenge, cmd, err := s.createMagicLinkChallengeCommand(req)
+		if err != nil {
+			return nil, nil, err
+		}
+		resp.MagicLink = challenge
+		cmds = append(cmds, cmd)
+	}
 	return resp, cmds, nil
 }
 
@@ -224,6 +256,22 @@ func (s *Server) createOTPEmailChallengeCommand(req *session.RequestChallenges_O
 	}
 }
 
+func (s *Server) createMagicLinkChallengeCommand(req *session.RequestChallenges_MagicLink) (*string, command.SessionCommand, error) {
+	switch t := req.GetDeliveryType().(type) {
+	case *session.RequestChallenges_MagicLink_SendLink_:
+		cmd, err := s.command.CreateMagicLinkChallengeURLTemplate(t.SendLink.GetUrlTemplate(), req.GetAuthRequestId())
+		if err != nil {
+			return nil, nil, err
+		}
+		return nil, cmd, nil
+	case *session.RequestChallenges_MagicLink_ReturnCode_:
+		challenge := new(string)
+		return challenge, s.command.CreateMagicLinkChallengeReturnCode(req.GetAuthRequestId(), challenge), nil
+	default:
+		return nil, nil, zerrors.ThrowUnimplementedf(nil, "SESSION-Ml4dt", "delivery_type oneOf %T in MagicLinkChallenge not implemented", t)
+	}
+}
+
 func userCheck(user *session.CheckUser) (userSearch, error) {
 	if user == nil {
 		return nil, nil
@@ -63,6 +63,14 @@ func Test_sessionsToPb(t *testing.T) {
 				DisplayName:   "donald duck",
 				ResourceOwner: "org1",
 			},
+			Risk: query.SessionRisk{
+				EvaluatedAt:    past,
+				Level:          domain.SessionRiskLevelHigh,
+				NewDevice:      true,
+				NewCountry:     true,
+				Country:        "CH",
+				StepUpRequired: true,
+			},
 			Metadata: map[string][]byte{"hello": []byte("world")},
 		},
 		{ // password factor
@@ -160,6 +168,14 @@ func Test_sessionsToPb(t *testing.T) {
 					OrganizationId: "org1",
 				},
 			},
+			Risk: &session.Risk{
+				EvaluatedAt:    timestamppb.New(past),
+				Level:          session.RiskLevel_RISK_LEVEL_HIGH,
+				NewDevice:      true,
+				NewCountry:     true,
+				Country:        "CH",
+				StepUpRequired: true,
+			},
 			Metadata: map[string][]byte{"hello": []byte("world")},
 		},
 		{ // password factor
@@ -41,6 +41,10 @@ func loginSettingsToPb(current *query.LoginPolicy) *settings.LoginSettings {
 		MfaInitSkipLifetime:        durationpb.New(time.Duration(current.MFAInitSkipLifetime)),
 		SecondFactorCheckLifetime:  durationpb.New(time.Duration(current.SecondFactorCheckLifetime)),
 		MultiFactorCheckLifetime:   durationpb.New(time.Duration(current.MultiFactorCheckLifetime)),
+		ForceMfaOnHighRisk:         current.ForceMFAOnHighRisk,
+		TrustedDeviceLifetime:      durationpb.New(time.Duration(current.TrustedDeviceLifetime)),
+		AllowAccountRecovery:       current.AllowAccountRecovery,
+		AllowMagicLink:             current.AllowMagicLink,
 		SecondFactors:              second,
 		MultiFactors:               multi,
 		ResourceOwnerType:          isDefaultToResourceOwnerTypePb(current.IsDefault),
@@ -95,12 +99,15 @@ func multiFactorTypeToPb(typ domain.MultiFactorType) settings.MultiFactorType {
 
 func passwordComplexitySettingsToPb(current *query.PasswordComplexityPolicy) *settings.PasswordComplexitySettings {
 	return &settings.PasswordComplexitySettings{
-		MinLength:         current.MinLength,
-		RequiresUppercase: current.HasUppercase,
-		RequiresLowercase: current.HasLowercase,
-		RequiresNumber:    current.HasNumber,
-		RequiresSymbol:    current.HasSymbol,
-		ResourceOwnerType: isDefaultToResourceOwnerTypePb(current.IsDefault),
+		MinLength:           current.MinLength,
+		RequiresUppercase:   current.HasUppercase,
+		RequiresLowercase:   current.HasLowercase,
+		RequiresNumber:      current.HasNumber,
+		RequiresSymbol:      current.HasSymbol,
+		ResourceOwnerType:   isDefaultToResourceOwnerTypePb(current.IsDefault),
+		HistoryDepth:        current.HistoryDepth,
+		CheckBreached:       current.CheckBreached,
+		ForceChangeBreached: current.ForceChangeBreached,
 	}
 }
 
@@ -172,9 +179,14 @@ func legalAndSupportSettingsToPb(current *query.PrivacyPolicy) *settings.LegalAn
 
 func lockoutSettingsToPb(current *query.LockoutPolicy) *settings.LockoutSettings {
 	return &settings.LockoutSettings{
-		MaxPasswordAttempts: current.MaxPasswordAttempts,
-		MaxOtpAttempts:      current.MaxOTPAttempts,
-		ResourceOwnerType:   isDefaultToResourceOwnerTypePb(current.IsDefault),
+		MaxPasswordAttempts:   current.MaxPasswordAttempts,
+		MaxOtpAttempts:        current.MaxOTPAttempts,
+		ResourceOwnerType:     isDefaultToResourceOwnerTypePb(current.IsDefault),
+		MaxIpAttempts:         current.MaxIPAttempts,
+		MaxClientAttempts:     current.MaxClientAttempts,
+		ThrottleWindow:        durationpb.New(time.Duration(current.ThrottleWindow)),
+		ThrottleDelay:         durationpb.New(time.Duration(current.ThrottleDelay)),
+		ThrottleBlockDuration: durationpb.New(time.Duration(current.ThrottleBlockDuration)),
 	}
 }
 
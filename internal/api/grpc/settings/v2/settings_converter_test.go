@@ -41,6 +41,10 @@ func Test_loginSettingsToPb(t *testing.T) {
 		MFAInitSkipLifetime:        database.Duration(time.Millisecond),
 		SecondFactorCheckLifetime:  database.Duration(time.Microsecond),
 		MultiFactorCheckLifetime:   database.Duration(time.Nanosecond),
+		ForceMFAOnHighRisk:         true,
+		TrustedDeviceLifetime:      database.Duration(time.Hour * 24),
+		AllowAccountRecovery:       true,
+		AllowMagicLink:             true,
 		SecondFactors: []domain.SecondFactorType{
 			domain.SecondFactorTypeTOTP,
 			domain.SecondFactorTypeU2F,
@@ -71,6 +75,10 @@ func Test_loginSettingsToPb(t *testing.T) {
 		MfaInitSkipLifetime:        durationpb.New(time.Millisecond),
 		SecondFactorCheckLifetime:  durationpb.New(time.Microsecond),
 		MultiFactorCheckLifetime:   durationpb.New(time.Nanosecond),
+		ForceMfaOnHighRisk:         true,
+		TrustedDeviceLifetime:      durationpb.New(time.Hour * 24),
+		AllowAccountRecovery:       true,
+		AllowMagicLink:             true,
 		SecondFactors: []settings.SecondFactorType{
 			settings.SecondFactorType_SECOND_FACTOR_TYPE_OTP,
 			settings.SecondFactorType_SECOND_FACTOR_TYPE_U2F,
@@ -216,20 +224,26 @@ func Test_multiFactorTypeToPb(t *testing.T) {
 
 func Test_passwordComplexitySettingsToPb(t *testing.T) {
 	arg := &query.PasswordComplexityPolicy{
-		MinLength:    12,
-		HasUppercase: true,
-		HasLowercase: true,
-		HasNumber:    true,
-		HasSymbol:    true,
-		IsDefault:    true,
+		MinLength:           12,
+		HasUppercase:        true,
+		HasLowercase:        true,
+		HasNumber:           true,
+		HasSymbol:           true,
+		HistoryDepth:        4,
+		CheckBreached:       true,
+		ForceChangeBreached: true,
+		IsDefault:           true,
 	}
 	want := &settings.PasswordComplexitySettings{
-		MinLength:         12,
-		RequiresUppercase: true,
-		RequiresLowercase: true,
-		RequiresNumber:    true,
-		RequiresSymbol:    true,
-		ResourceOwnerType: settings.ResourceOwnerType_RESOURCE_OWNER_TYPE_INSTANCE,
+		MinLength:           12,
+		RequiresUppercase:   true,
+		RequiresLowercase:   true,
+		RequiresNumber:      true,
+		RequiresSymbol:      true,
+		ResourceOwnerType:   settings.ResourceOwnerType_RESOURCE_OWNER_TYPE_INSTANCE,
+		HistoryDepth:        4,
+		CheckBreached:       true,
+		ForceChangeBreached: true,
 	}
 
 	got := passwordComplexitySettingsToPb(arg)
@@ -0,0 +1,59 @@
+package user
+
+import (
+	"github.com/zitadel/zitadel/internal/api/grpc/object"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/query"
+	"github.com/zitadel/zitadel/pkg/grpc/user"
+)
+
+func AccountRecoveryRequestsToPb(requests []*query.AccountRecoveryRequest) []*user.AccountRecoveryRequest {
+	r := make([]*user.AccountRecoveryRequest, len(requests))
+	for i, request := range requests {
+		r[i] = AccountRecoveryRequestToPb(request)
+	}
+	return r
+}
+
+func AccountRecoveryRequestToPb(request *query.AccountRecoveryRequest) *user.AccountRecoveryRequest {
+	return &user.AccountRecoveryRequest{
+		UserId:  request.UserID,
+		Details: object.ToViewDetailsPb(request.Sequence, request.CreationDate, request.ChangeDate, request.ResourceOwner),
+		State:   AccountRecoveryStateToPb(request.State),
+		Reason:  request.Reason,
+	}
+}
+
+func AccountRecoveryStateToPb(state domain.AccountRecoveryState) user.AccountRecoveryState {
+	switch state {
+	case domain.AccountRecoveryStateRequested:
+		return user.AccountRecoveryState_ACCOUNT_RECOVERY_STATE_REQUESTED
+	case domain.AccountRecoveryStateApproved:
+		return user.AccountRecoveryState_ACCOUNT_RECOVERY_STATE_APPROVED
+	case domain.AccountRecoveryStateRejected:
+		return user.AccountRecoveryState_ACCOUNT_RECOVERY_STATE_REJECTED
+	case domain.AccountRecoveryStateCompleted:
+		return user.AccountRecoveryState_ACCOUNT_RECOVERY_STATE_COMPLETED
+	case domain.AccountRecoveryStateUnspecified:
+		return user.AccountRecoveryState_ACCOUNT_RECOVERY_STATE_UNSPECIFIED
+	default:
+		return user.AccountRecoveryState_ACCOUNT_RECOVERY_STATE_UNSPECIFIED
+	}
+}
+
+func AccountRecoveryStateToDomain(state user.AccountRecoveryState) domain.AccountRecoveryState {
+	switch state {
+	case user.AccountRecoveryState_ACCOUNT_RECOVERY_STATE_REQUESTED:
+		return domain.AccountRecoveryStateRequested
+	case user.AccountRecoveryState_ACCOUNT_RECOVERY_STATE_APPROVED:
+		return domain.AccountRecoveryStateApproved
+	case user.AccountRecoveryState_ACCOUNT_RECOVERY_STATE_REJECTED:
+		return domain.AccountRecoveryStateRejected
+	case user.AccountRecoveryState_ACCOUNT_RECOVERY_STATE_COMPLETED:
+		return domain.AccountRecoveryStateCompleted
+	case user.AccountRecoveryState_ACCOUNT_RECOVERY_STATE_UNSPECIFIED:
+		return domain.AccountRecoveryStateUnspecified
+	default:
+		return domain.AccountRecoveryStateUnspecified
+	}
+}
@@ -0,0 +1,72 @@
+package user
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/api/grpc/object/v2"
+	"github.com/zitadel/zitadel/internal/command"
+	"github.com/zitadel/zitadel/internal/zerrors"
+	"github.com/zitadel/zitadel/pkg/grpc/user/v2"
+)
+
+func (s *Server) SetRecoveryEmail(ctx context.Context, req *user.SetRecoveryEmailRequest) (resp *user.SetRecoveryEmailResponse, err error) {
+	var set *command.RecoveryEmailSet
+
+	switch v := req.GetVerification().(type) {
+	case *user.SetRecoveryEmailRequest_SendCode:
+		set, err = s.command.SetRecoveryEmail(ctx, req.GetUserId(), "", req.GetEmail(), false, v.SendCode.GetUrlTemplate())
+	case *user.SetRecoveryEmailRequest_ReturnCode:
+		set, err = s.command.SetRecoveryEmail(ctx, req.GetUserId(), "", req.GetEmail(), true, "")
+	case nil:
+		set, err = s.command.SetRecoveryEmail(ctx, req.GetUserId(), "", req.GetEmail(), false, "")
+	default:
+		err = zerrors.ThrowUnimplementedf(nil, "USERv2-Ar7ve", "verification oneOf %T in method SetRecoveryEmail not implemented", v)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &user.SetRecoveryEmailResponse{
+		Details:          object.DomainToDetailsPb(set.ObjectDetails),
+		VerificationCode: set.PlainCode,
+	}, nil
+}
+
+func (s *Server) VerifyRecoveryEmail(ctx context.Context, req *user.VerifyRecoveryEmailRequest) (*user.VerifyRecoveryEmailResponse, error) {
+	objectDetails, err := s.command.VerifyRecoveryEmail(ctx, req.GetUserId(), "", req.GetVerificationCode())
+	if err != nil {
+		return nil, err
+	}
+	return &user.VerifyRecoveryEmailResponse{
+		Details: object.DomainToDetailsPb(objectDetails),
+	}, nil
+}
+
+func (s *Server) RemoveRecoveryEmail(ctx context.Context, req *user.RemoveRecoveryEmailRequest) (*user.RemoveRecoveryEmailResponse, error) {
+	objectDetails, err := s.command.RemoveRecoveryEmail(ctx, req.GetUserId(), "")
+	if err != nil {
+		return nil, err
+	}
+	return &user.RemoveRecoveryEmailResponse{
+		Details: object.DomainToDetailsPb(objectDetails),
+	}, nil
+}
+
+func (s *Server) RequestAccountRecovery(ctx context.Context, req *user.RequestAccountRecoveryRequest) (*user.RequestAccountRecoveryResponse, error) {
+	objectDetails, err := s.command.RequestAccountRecovery(ctx, req.GetUserId(), req.GetReason())
+	if err != nil {
+		return nil, err
+	}
+	return &user.RequestAccountRecoveryResponse{
+		Details: object.DomainToDetailsPb(objectDetails),
+	}, nil
+}
+
+func (s *Server) CompleteAccountRecovery(ctx context.Context, req *user.CompleteAccountRecoveryRequest) (*user.CompleteAccountRecoveryResponse, error) {
+	objectDetails, err := s.command.CompleteAccountRecovery(ctx, req.GetUserId(), "", req.GetVerificationCode(), req.GetNewPassword(), "")
+	if err != nil {
+		return nil, err
+	}
+	return &user.CompleteAccountRecoveryResponse{
+		Details: object.DomainToDetailsPb(objectDetails),
+	}, nil
+}
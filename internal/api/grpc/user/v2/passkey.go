@@ -162,9 +162,10 @@ func authMethodsToPasskeyPb(methods *query.AuthMethods) []*user.Passkey {
 
 func authMethodToPasskeyPb(token *query.AuthMethod) *user.Passkey {
 	return &user.Passkey{
-		Id:    token.TokenID,
-		State: mfaStateToPb(token.State),
-		Name:  token.Name,
+		Id:                 token.TokenID,
+		State:              mfaStateToPb(token.State),
+		Name:               token.Name,
+		AuthenticatorModel: token.AuthenticatorModel,
 	}
 }
 
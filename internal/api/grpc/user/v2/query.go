@@ -18,6 +18,13 @@ func (s *Server) GetUserByID(ctx context.Context, req *user.GetUserByIDRequest)
 	if err != nil {
 		return nil, err
 	}
+	pbUser := userToPb(resp, s.assetAPIPrefix(ctx))
+	if human := pbUser.GetHuman(); human != nil {
+		human.Email.Undeliverable, err = s.emailUndeliverable(ctx, resp.ID)
+		if err != nil {
+			return nil, err
+		}
+	}
 	return &user.GetUserByIDResponse{
 		Details: object.DomainToDetailsPb(&domain.ObjectDetails{
 			Sequence:      resp.Sequence,
@@ -25,7 +32,23 @@ func (s *Server) GetUserByID(ctx context.Context, req *user.GetUserByIDRequest)
 			EventDate:     resp.ChangeDate,
 			ResourceOwner: resp.ResourceOwner,
 		}),
-		User: userToPb(resp, s.assetAPIPrefix(ctx)),
+		User: pbUser,
+	}, nil
+}
+
+// emailUndeliverable returns the report of the email provider, if the email address of the user is undeliverable
+func (s *Server) emailUndeliverable(ctx context.Context, userID string) (*user.EmailUndeliverable, error) {
+	suppression, err := s.query.EmailSuppressionByUserID(ctx, userID)
+	if zerrors.IsNotFound(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &user.EmailUndeliverable{
+		Reason:     emailUndeliverableReasonToPb(suppression.Reason),
+		Diagnostic: suppression.Diagnostic,
+		ReportDate: timestamppb.New(suppression.ChangeDate),
 	}, nil
 }
 
@@ -115,6 +138,19 @@ func humanToPb(userQ *query.Human, assetPrefix, owner string) *user.HumanUser {
 	}
 }
 
+func emailUndeliverableReasonToPb(reason domain.EmailUndeliverableReason) user.EmailUndeliverableReason {
+	switch reason {
+	case domain.EmailUndeliverableReasonBounce:
+		return user.EmailUndeliverableReason_EMAIL_UNDELIVERABLE_REASON_BOUNCE
+	case domain.EmailUndeliverableReasonComplaint:
+		return user.EmailUndeliverableReason_EMAIL_UNDELIVERABLE_REASON_COMPLAINT
+	case domain.EmailUndeliverableReasonUnspecified:
+		return user.EmailUndeliverableReason_EMAIL_UNDELIVERABLE_REASON_UNSPECIFIED
+	default:
+		return user.EmailUndeliverableReason_EMAIL_UNDELIVERABLE_REASON_UNSPECIFIED
+	}
+}
+
 func machineToPb(userQ *query.Machine) *user.MachineUser {
 	return &user.MachineUser{
 		Name:            userQ.Name,
@@ -0,0 +1,67 @@
+package user
+
+import (
+	"context"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/zitadel/zitadel/internal/api/grpc/object/v2"
+	"github.com/zitadel/zitadel/internal/query"
+	"github.com/zitadel/zitadel/pkg/grpc/user/v2"
+)
+
+func (s *Server) AddTrustedDevice(ctx context.Context, req *user.AddTrustedDeviceRequest) (*user.AddTrustedDeviceResponse, error) {
+	device, err := s.command.AddTrustedDevice(ctx, req.GetUserId(), "", req.GetName(), req.GetUserAgentId())
+	if err != nil {
+		return nil, err
+	}
+	return &user.AddTrustedDeviceResponse{
+		Details:         object.DomainToDetailsPb(device.ObjectDetails),
+		TrustedDeviceId: device.ID,
+		Token:           device.Token,
+		ExpirationDate:  timestamppb.New(device.Expiration),
+	}, nil
+}
+
+func (s *Server) ListTrustedDevices(ctx context.Context, req *user.ListTrustedDevicesRequest) (*user.ListTrustedDevicesResponse, error) {
+	devices, err := s.query.SearchTrustedDevicesOfUser(ctx, req.GetUserId(), s.checkPermission)
+	if err != nil {
+		return nil, err
+	}
+	return &user.ListTrustedDevicesResponse{
+		Details: object.ToListDetails(devices.SearchResponse),
+		Result:  trustedDevicesToPb(devices.TrustedDevices),
+	}, nil
+}
+
+func trustedDevicesToPb(devices []*query.TrustedDevice) []*user.TrustedDevice {
+	t := make([]*user.TrustedDevice, len(devices))
+	for i, device := range devices {
+		t[i] = trustedDeviceToPb(device)
+	}
+	return t
+}
+
+func trustedDeviceToPb(device *query.TrustedDevice) *user.TrustedDevice {
+	pb := &user.TrustedDevice{
+		Id:             device.ID,
+		Name:           device.Name,
+		UserAgentId:    device.UserAgentID,
+		CreationDate:   timestamppb.New(device.CreationDate),
+		ExpirationDate: timestamppb.New(device.Expiration),
+	}
+	if !device.LastUsed.IsZero() {
+		pb.LastUsed = timestamppb.New(device.LastUsed)
+	}
+	return pb
+}
+
+func (s *Server) RemoveTrustedDevice(ctx context.Context, req *user.RemoveTrustedDeviceRequest) (*user.RemoveTrustedDeviceResponse, error) {
+	objectDetails, err := s.command.RemoveTrustedDevice(ctx, req.GetUserId(), "", req.GetTrustedDeviceId())
+	if err != nil {
+		return nil, err
+	}
+	return &user.RemoveTrustedDeviceResponse{
+		Details: object.DomainToDetailsPb(objectDetails),
+	}, nil
+}
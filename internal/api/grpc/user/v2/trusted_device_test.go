@@ -0,0 +1,64 @@
+package user
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/zitadel/zitadel/internal/query"
+	"github.com/zitadel/zitadel/pkg/grpc/user/v2"
+)
+
+func Test_trustedDeviceToPb(t *testing.T) {
+	now := time.Now()
+	tests := []struct {
+		name   string
+		device *query.TrustedDevice
+		want   *user.TrustedDevice
+	}{
+		{
+			name: "never used",
+			device: &query.TrustedDevice{
+				ID:           "id",
+				CreationDate: now,
+				Name:         "name",
+				UserAgentID:  "userAgentID",
+				Expiration:   now.Add(time.Hour),
+			},
+			want: &user.TrustedDevice{
+				Id:             "id",
+				Name:           "name",
+				UserAgentId:    "userAgentID",
+				CreationDate:   timestamppb.New(now),
+				ExpirationDate: timestamppb.New(now.Add(time.Hour)),
+			},
+		},
+		{
+			name: "used",
+			device: &query.TrustedDevice{
+				ID:           "id",
+				CreationDate: now,
+				Name:         "name",
+				UserAgentID:  "userAgentID",
+				LastUsed:     now.Add(time.Minute),
+				Expiration:   now.Add(time.Hour),
+			},
+			want: &user.TrustedDevice{
+				Id:             "id",
+				Name:           "name",
+				UserAgentId:    "userAgentID",
+				CreationDate:   timestamppb.New(now),
+				LastUsed:       timestamppb.New(now.Add(time.Minute)),
+				ExpirationDate: timestamppb.New(now.Add(time.Hour)),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := trustedDeviceToPb(tt.device)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
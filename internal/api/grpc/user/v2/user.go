@@ -266,7 +266,7 @@ func (s *Server) DeleteUser(ctx context.Context, req *user.DeleteUserRequest) (_
 	if err != nil {
 		return nil, err
 	}
-	details, err := s.command.RemoveUserV2(ctx, req.UserId, "", memberships, grants...)
+	details, err := s.command.RemoveUserV2(ctx, req.UserId, "", nil, memberships, grants...)
 	if err != nil {
 		return nil, err
 	}
@@ -353,8 +353,9 @@ func (s *Server) ListAuthenticationMethodTypes(ctx context.Context, req *user.Li
 		return nil, err
 	}
 	return &user.ListAuthenticationMethodTypesResponse{
-		Details:         object.ToListDetails(authMethods.SearchResponse),
-		AuthMethodTypes: authMethodTypesToPb(authMethods.AuthMethodTypes),
+		Details:                object.ToListDetails(authMethods.SearchResponse),
+		AuthMethodTypes:        authMethodTypesToPb(authMethods.AuthMethodTypes),
+		RecoveryCodesRemaining: authMethods.RecoveryCodesRemaining,
 	}, nil
 }
 
@@ -415,6 +416,8 @@ func authMethodTypeToPb(methodType domain.UserAuthMethodType) user.Authenticatio
 		return user.AuthenticationMethodType_AUTHENTICATION_METHOD_TYPE_OTP_SMS
 	case domain.UserAuthMethodTypeOTPEmail:
 		return user.AuthenticationMethodType_AUTHENTICATION_METHOD_TYPE_OTP_EMAIL
+	case domain.UserAuthMethodTypeRecoveryCode:
+		return user.AuthenticationMethodType_AUTHENTICATION_METHOD_TYPE_RECOVERY_CODE
 	case domain.UserAuthMethodTypeUnspecified:
 		return user.AuthenticationMethodType_AUTHENTICATION_METHOD_TYPE_UNSPECIFIED
 	default:
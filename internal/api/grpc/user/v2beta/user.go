@@ -279,7 +279,7 @@ func (s *Server) DeleteUser(ctx context.Context, req *user.DeleteUserRequest) (_
 	if err != nil {
 		return nil, err
 	}
-	details, err := s.command.RemoveUserV2(ctx, req.UserId, "", memberships, grants...)
+	details, err := s.command.RemoveUserV2(ctx, req.UserId, "", nil, memberships, grants...)
 	if err != nil {
 		return nil, err
 	}
@@ -628,7 +628,7 @@ func authMethodTypeToPb(methodType domain.UserAuthMethodType) user.Authenticatio
 		return user.AuthenticationMethodType_AUTHENTICATION_METHOD_TYPE_OTP_SMS
 	case domain.UserAuthMethodTypeOTPEmail:
 		return user.AuthenticationMethodType_AUTHENTICATION_METHOD_TYPE_OTP_EMAIL
-	case domain.UserAuthMethodTypeUnspecified, domain.UserAuthMethodTypeOTP, domain.UserAuthMethodTypePrivateKey:
+	case domain.UserAuthMethodTypeUnspecified, domain.UserAuthMethodTypeOTP, domain.UserAuthMethodTypePrivateKey, domain.UserAuthMethodTypeTrustedDevice, domain.UserAuthMethodTypeRecoveryCode, domain.UserAuthMethodTypeClientCertificate, domain.UserAuthMethodTypeMagicLink:
 		// Handle all remaining cases so the linter succeeds
 		return user.AuthenticationMethodType_AUTHENTICATION_METHOD_TYPE_UNSPECIFIED
 	default:
@@ -31,6 +31,7 @@ const (
 	XGrpcWeb         = "x-grpc-web"
 	XRequestedWith   = "x-requested-with"
 	XRobotsTag       = "x-robots-tag"
+	IfMatch          = "If-Match"
 	IfNoneMatch      = "If-None-Match"
 	LastModified     = "Last-Modified"
 	Etag             = "Etag"
@@ -35,6 +35,9 @@ type accessToken struct {
 	tokenExpiration   time.Time
 	isPAT             bool
 	actor             *domain.TokenActor
+	dpopJKT           string
+
+	authorizationDetails domain.AuthorizationDetails
 }
 
 var ErrInvalidTokenFormat = errors.New("invalid token format")
@@ -113,20 +116,19 @@ func accessTokenV2(tokenID, subject string, token *query.OIDCSessionAccessTokenR
 		tokenCreation:     token.AccessTokenCreation,
 		tokenExpiration:   token.AccessTokenExpiration,
 		actor:             token.Actor,
+		dpopJKT:           token.DPoPJKT,
+
+		authorizationDetails: token.AuthorizationDetails,
 	}
 }
 
 func (s *Server) assertClientScopesForPAT(ctx context.Context, token *accessToken, clientID, projectID string) error {
 	token.audience = append(token.audience, clientID, projectID)
-	projectIDQuery, err := query.NewProjectRoleProjectIDSearchQuery(projectID)
-	if err != nil {
-		return zerrors.ThrowInternal(err, "OIDC-Cyc78", "Errors.Internal")
-	}
-	roles, err := s.query.SearchProjectRoles(ctx, authz.GetFeatures(ctx).TriggerIntrospectionProjections, &query.ProjectRoleSearchQueries{Queries: []query.SearchQuery{projectIDQuery}})
+	roles, err := s.query.ProjectRolesByProjectID(ctx, authz.GetFeatures(ctx).TriggerIntrospectionProjections, projectID)
 	if err != nil {
 		return err
 	}
-	for _, role := range roles.ProjectRoles {
+	for _, role := range roles {
 		token.scope = append(token.scope, ScopeProjectRolePrefix+role.Key)
 	}
 	return nil
@@ -18,6 +18,8 @@ const (
 	OTP = "otp"
 	// UserPresence states that the end users presence has been verified (e.g. passkey and u2f)
 	UserPresence = "user"
+	// SmartCard states that a client certificate (e.g. of a PIV / CAC smart card) has been verified
+	SmartCard = "sc"
 )
 
 // AuthMethodTypesToAMR maps zitadel auth method types to Authentication Method Reference Values
@@ -44,11 +46,17 @@ func AuthMethodTypesToAMR(methodTypes []domain.UserAuthMethodType) []string {
 		case domain.UserAuthMethodTypeOTP,
 			domain.UserAuthMethodTypeTOTP,
 			domain.UserAuthMethodTypeOTPSMS,
-			domain.UserAuthMethodTypeOTPEmail:
+			domain.UserAuthMethodTypeOTPEmail,
+			domain.UserAuthMethodTypeRecoveryCode,
+			domain.UserAuthMethodTypeMagicLink:
 			// a user could use multiple (t)otp, which is a factor, but still will be returned as a single `otp` entry
 			otp++
 			factors++
-		case domain.UserAuthMethodTypeIDP:
+		case domain.UserAuthMethodTypeClientCertificate:
+			amr = append(amr, SmartCard)
+			factors++
+		case domain.UserAuthMethodTypeIDP,
+			domain.UserAuthMethodTypeTrustedDevice:
 			// no AMR value according to specification
 			factors++
 		case domain.UserAuthMethodTypeUnspecified:
@@ -66,6 +66,20 @@ func TestAMR(t *testing.T) {
 			},
 			[]string{OTP},
 		},
+		{
+			"client certificate checked",
+			args{
+				[]domain.UserAuthMethodType{domain.UserAuthMethodTypeClientCertificate},
+			},
+			[]string{SmartCard},
+		},
+		{
+			"client certificate and password checked",
+			args{
+				[]domain.UserAuthMethodType{domain.UserAuthMethodTypePassword, domain.UserAuthMethodTypeClientCertificate},
+			},
+			[]string{PWD, SmartCard, MFA},
+		},
 		{
 			"multiple (t)otp checked",
 			args{
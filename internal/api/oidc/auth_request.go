@@ -112,6 +112,8 @@ func (o *OPStorage) createAuthRequestLoginClient(ctx context.Context, req *oidc.
 		UILocales:        UILocalesToBusiness(req.UILocales),
 		MaxAge:           MaxAgeToBusiness(req.MaxAge),
 		Issuer:           o.contextToIssuer(ctx),
+
+		AuthorizationDetails: authorizationDetailsFromContext(ctx),
 	}
 	if req.LoginHint != "" {
 		authRequest.LoginHint = &req.LoginHint
@@ -455,15 +457,11 @@ func (o *OPStorage) assertProjectRoleScopes(ctx context.Context, clientID string
 	if !project.ProjectRoleAssertion {
 		return scopes, nil
 	}
-	projectIDQuery, err := query.NewProjectRoleProjectIDSearchQuery(project.ID)
-	if err != nil {
-		return nil, zerrors.ThrowInternal(err, "OIDC-Cyc78", "Errors.Internal")
-	}
-	roles, err := o.query.SearchProjectRoles(ctx, true, &query.ProjectRoleSearchQueries{Queries: []query.SearchQuery{projectIDQuery}})
+	roles, err := o.query.ProjectRolesByProjectID(ctx, true, project.ID)
 	if err != nil {
 		return nil, err
 	}
-	for _, role := range roles.ProjectRoles {
+	for _, role := range roles {
 		scopes = append(scopes, ScopeProjectRolePrefix+role.Key)
 	}
 	return scopes, nil
@@ -478,15 +476,11 @@ func (o *OPStorage) assertProjectRoleScopesByProject(ctx context.Context, projec
 	if !project.ProjectRoleAssertion {
 		return scopes, nil
 	}
-	projectIDQuery, err := query.NewProjectRoleProjectIDSearchQuery(project.ID)
-	if err != nil {
-		return nil, zerrors.ThrowInternal(err, "OIDC-Cyc78", "Errors.Internal")
-	}
-	roles, err := o.query.SearchProjectRoles(ctx, true, &query.ProjectRoleSearchQueries{Queries: []query.SearchQuery{projectIDQuery}})
+	roles, err := o.query.ProjectRolesByProjectID(ctx, true, project.ID)
 	if err != nil {
 		return nil, err
 	}
-	for _, role := range roles.ProjectRoles {
+	for _, role := range roles {
 		scopes = append(scopes, ScopeProjectRolePrefix+role.Key)
 	}
 	return scopes, nil
@@ -494,15 +488,11 @@ func (o *OPStorage) assertProjectRoleScopesByProject(ctx context.Context, projec
 
 func (o *OPStorage) assertClientScopesForPAT(ctx context.Context, token *model.TokenView, clientID, projectID string) error {
 	token.Audience = append(token.Audience, clientID)
-	projectIDQuery, err := query.NewProjectRoleProjectIDSearchQuery(projectID)
-	if err != nil {
-		return zerrors.ThrowInternal(err, "OIDC-Cyc78", "Errors.Internal")
-	}
-	roles, err := o.query.SearchProjectRoles(ctx, true, &query.ProjectRoleSearchQueries{Queries: []query.SearchQuery{projectIDQuery}})
+	roles, err := o.query.ProjectRolesByProjectID(ctx, true, projectID)
 	if err != nil {
 		return err
 	}
-	for _, role := range roles.ProjectRoles {
+	for _, role := range roles {
 		token.Scopes = append(token.Scopes, ScopeProjectRolePrefix+role.Key)
 	}
 	return nil
@@ -566,6 +556,7 @@ func (s *Server) CreateTokenCallbackURL(ctx context.Context, req op.AuthRequest)
 		implicitFlowComplianceChecker(),
 		slices.Contains(client.GrantTypes(), oidc.GrantTypeRefreshToken),
 		client.client.BackChannelLogoutURI,
+		"", // access tokens of the implicit flow can't be DPoP bound
 	)
 	if err != nil {
 		return "", err
@@ -605,6 +596,9 @@ func (s *Server) authorizeCallbackHandler(w http.ResponseWriter, r *http.Request
 		if err != nil {
 			return nil, err
 		}
+		if authReq.AuthorizationDetailsConsent == domain.AuthorizationDetailsConsentDenied {
+			return authReq, oidc.ErrAccessDenied().WithDescription("The user denied the requested authorization details.")
+		}
 		if !authReq.Done() {
 			return authReq, oidc.ErrInteractionRequired().WithDescription("Unfortunately, the user may be not logged in and/or additional interaction is required.")
 		}
@@ -665,6 +659,8 @@ func (s *Server) authResponseToken(authReq *AuthRequest, authorizer op.Authorize
 		slices.Contains(scope, oidc.ScopeOfflineAccess),
 		authReq.SessionID,
 		authReq.oidc().ResponseType,
+		"", // access tokens of the implicit flow can't be DPoP bound
+		authReq.oidc().AuthorizationDetails,
 	)
 	if err != nil {
 		op.AuthRequestError(w, r, authReq, err, authorizer)
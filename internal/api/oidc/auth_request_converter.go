@@ -3,6 +3,7 @@ package oidc
 import (
 	"context"
 	"net"
+	"slices"
 	"strings"
 	"time"
 
@@ -44,6 +45,10 @@ func (a *AuthRequest) GetAMR() []string {
 			}
 		}
 	}
+	// the single-use code of the magic link is considered an otp
+	if a.MagicLinkVerified && !slices.Contains(list, OTP) {
+		list = append(list, OTP)
+	}
 	return list
 }
 
@@ -125,6 +130,8 @@ func CreateAuthRequestToBusiness(ctx context.Context, authReq *oidc.AuthRequest,
 			ResponseMode:  ResponseModeToBusiness(authReq.ResponseMode),
 			Nonce:         authReq.Nonce,
 			CodeChallenge: CodeChallengeToBusiness(authReq.CodeChallenge, authReq.CodeChallengeMethod),
+
+			AuthorizationDetails: authorizationDetailsFromContext(ctx),
 		},
 	}
 }
@@ -287,7 +294,8 @@ func AMRFromMFAType(mfaType domain.MFAType) string {
 	switch mfaType {
 	case domain.MFATypeTOTP,
 		domain.MFATypeOTPSMS,
-		domain.MFATypeOTPEmail:
+		domain.MFATypeOTPEmail,
+		domain.MFATypeRecoveryCode:
 		return OTP
 	case domain.MFATypeU2F,
 		domain.MFATypeU2FUserVerification:
@@ -0,0 +1,71 @@
+package oidc
+
+import (
+	"context"
+	"net/url"
+
+	"github.com/zitadel/oidc/v3/pkg/oidc"
+	"github.com/zitadel/oidc/v3/pkg/op"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+)
+
+const (
+	authorizationDetailsParam = "authorization_details"
+	// authorizationDetailsClaim asserts the granted authorization details in access tokens and introspection responses (RFC 9396, section 9).
+	authorizationDetailsClaim = "authorization_details"
+	// invalidAuthorizationDetails is returned if the requested authorization details are unknown or invalid (RFC 9396, section 5).
+	invalidAuthorizationDetails = "invalid_authorization_details"
+)
+
+type authorizationDetailsCtxKey struct{}
+
+// withAuthorizationDetails passes the validated authorization details of the authorization endpoint
+// to the creation of the auth request in the storage.
+func withAuthorizationDetails(ctx context.Context, details domain.AuthorizationDetails) context.Context {
+	if len(details) == 0 {
+		return ctx
+	}
+	return context.WithValue(ctx, authorizationDetailsCtxKey{}, details)
+}
+
+func authorizationDetailsFromContext(ctx context.Context) domain.AuthorizationDetails {
+	details, _ := ctx.Value(authorizationDetailsCtxKey{}).(domain.AuthorizationDetails)
+	return details
+}
+
+// requestedAuthorizationDetails parses the authorization_details parameter (RFC 9396)
+// and validates each authorization detail against the schema of its type registered on the project of the client.
+func (s *Server) requestedAuthorizationDetails(ctx context.Context, client op.Client, form url.Values) (_ domain.AuthorizationDetails, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	details, err := domain.ParseAuthorizationDetails(form.Get(authorizationDetailsParam))
+	if err != nil {
+		return nil, invalidAuthorizationDetailsError(ctx, err)
+	}
+	if len(details) == 0 {
+		return nil, nil
+	}
+	var projectID string
+	if c, ok := client.(*Client); ok {
+		projectID = c.client.ProjectID
+	}
+	types, err := s.query.ProjectAuthorizationDetailsTypesByProjectID(ctx, projectID)
+	if err != nil {
+		return nil, err
+	}
+	if err = details.Validate(types.Schemas()); err != nil {
+		return nil, invalidAuthorizationDetailsError(ctx, err)
+	}
+	return details, nil
+}
+
+func invalidAuthorizationDetailsError(ctx context.Context, parent error) error {
+	return (&oidc.Error{
+		ErrorType:   invalidAuthorizationDetails,
+		Description: "the authorization details are unknown or do not conform to the registered type",
+	}).WithParent(parent).WithReturnParentToClient(authz.GetFeatures(ctx).DebugOIDCParentError)
+}
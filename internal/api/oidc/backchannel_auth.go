@@ -0,0 +1,314 @@
+package oidc
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	httphelper "github.com/zitadel/oidc/v3/pkg/http"
+	"github.com/zitadel/oidc/v3/pkg/oidc"
+	"github.com/zitadel/oidc/v3/pkg/op"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/command"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/query"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+const (
+	// GrantTypeCIBA is the grant type of the client initiated backchannel authentication (CIBA),
+	// used by the client to request the tokens on the token endpoint.
+	GrantTypeCIBA oidc.GrantType = "urn:openid:params:grant-type:ciba"
+
+	BackChannelAuthDefaultLifetime     = 5 * time.Minute
+	BackChannelAuthDefaultPollInterval = 5 * time.Second
+
+	backChannelTokenDeliveryModePoll = "poll"
+	backChannelTokenDeliveryModePing = "ping"
+
+	// unknownUserID is returned if the user can not be identified by the provided hint (CIBA core, section 13).
+	unknownUserID = "unknown_user_id"
+)
+
+type BackChannelAuthConfig struct {
+	Lifetime     time.Duration
+	PollInterval time.Duration
+}
+
+// lifetime returns the configured lifetime or the default.
+// Safe to call when c is nil.
+func (c *BackChannelAuthConfig) lifetime() time.Duration {
+	if c == nil || c.Lifetime == 0 {
+		return BackChannelAuthDefaultLifetime
+	}
+	return c.Lifetime
+}
+
+// pollInterval returns the configured poll interval or the default.
+// Safe to call when c is nil.
+func (c *BackChannelAuthConfig) pollInterval() time.Duration {
+	if c == nil || c.PollInterval == 0 {
+		return BackChannelAuthDefaultPollInterval
+	}
+	return c.PollInterval
+}
+
+// BackChannelAuthResponse is the response of the backchannel authentication endpoint (CIBA core, section 7.3).
+type BackChannelAuthResponse struct {
+	AuthReqID string `json:"auth_req_id"`
+	ExpiresIn uint64 `json:"expires_in"`
+	Interval  uint64 `json:"interval,omitempty"`
+}
+
+// backChannelAuthHandler implements the backchannel authentication endpoint
+// of the client initiated backchannel authentication (CIBA) in poll and ping mode.
+// The client authenticates the same way as on the token endpoint and provides a hint of the user to authenticate.
+// The pending request is then approved or denied by the user through the session API
+// and the client receives the tokens by the CIBA grant on the token endpoint.
+func (s *Server) backChannelAuthHandler(w http.ResponseWriter, r *http.Request) {
+	resp, err := s.backChannelAuth(r)
+	if err != nil {
+		op.WriteError(w, r, oidcError(err), s.getLogger(r.Context()))
+		return
+	}
+	httphelper.MarshalJSON(w, resp)
+}
+
+func (s *Server) backChannelAuth(r *http.Request) (_ *BackChannelAuthResponse, err error) {
+	ctx, span := tracing.NewSpan(r.Context())
+	defer func() { span.EndWithError(err) }()
+
+	if r.Method != http.MethodPost {
+		return nil, oidc.ErrInvalidRequest().WithDescription("backchannel authentication requests must use the POST method")
+	}
+	if err = r.ParseForm(); err != nil {
+		return nil, oidc.ErrInvalidRequest().WithDescription("error parsing form").WithParent(err)
+	}
+	opClient, err := s.VerifyClient(ctx, &op.Request[op.ClientCredentials]{
+		Method: r.Method,
+		URL:    r.URL,
+		Header: r.Header,
+		Form:   r.PostForm,
+		Data:   clientCredentialsFromRequest(r),
+	})
+	if err != nil {
+		return nil, err
+	}
+	client, ok := opClient.(*Client)
+	if !ok {
+		return nil, zerrors.ThrowInternal(nil, "OIDC-Cba1c", "Error.Internal")
+	}
+	if err = checkBackChannelAuthClient(client); err != nil {
+		return nil, err
+	}
+	scopes := strings.Fields(r.PostForm.Get("scope"))
+	if !slices.Contains(scopes, oidc.ScopeOpenID) {
+		return nil, oidc.ErrInvalidRequest().WithDescription("the openid scope is required")
+	}
+	user, err := s.backChannelAuthUser(ctx, r.PostForm.Get("login_hint"), r.PostForm.Get("id_token_hint"), r.PostForm.Get("login_hint_token"))
+	if err != nil {
+		return nil, err
+	}
+	lifetime, err := backChannelAuthLifetime(r.PostForm.Get("requested_expiry"), s.backChannelAuthLifetime)
+	if err != nil {
+		return nil, err
+	}
+	notificationToken := r.PostForm.Get("client_notification_token")
+	if client.client.BackChannelClientNotificationURI != "" && notificationToken == "" {
+		return nil, oidc.ErrInvalidRequest().WithDescription("client_notification_token is required in ping mode")
+	}
+	scopes, audience, err := s.storage.createAuthRequestScopeAndAudience(ctx, client.GetID(), scopes)
+	if err != nil {
+		return nil, err
+	}
+	id, expires, err := s.command.AddBackChannelAuth(ctx, &command.BackChannelAuthRequest{
+		ClientID:                client.GetID(),
+		UserID:                  user.ID,
+		UserOrgID:               user.ResourceOwner,
+		Scopes:                  scopes,
+		Audience:                audience,
+		BindingMessage:          r.PostForm.Get("binding_message"),
+		Lifetime:                lifetime,
+		ClientNotificationURI:   client.client.BackChannelClientNotificationURI,
+		ClientNotificationToken: notificationToken,
+		NeedRefreshToken:        slices.Contains(scopes, oidc.ScopeOfflineAccess),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &BackChannelAuthResponse{
+		AuthReqID: id,
+		ExpiresIn: uint64(time.Until(expires).Round(time.Second) / time.Second),
+		Interval:  uint64(s.backChannelAuthPollInterval / time.Second),
+	}, nil
+}
+
+// checkBackChannelAuthClient checks if the client is allowed to use the CIBA grant.
+// As the user is not involved in the request, only confidential clients are allowed.
+func checkBackChannelAuthClient(client op.Client) error {
+	if !slices.Contains(client.GrantTypes(), GrantTypeCIBA) {
+		return oidc.ErrUnauthorizedClient().WithDescription("the client is not allowed to use the CIBA grant")
+	}
+	if client.AuthMethod() == oidc.AuthMethodNone {
+		return oidc.ErrUnauthorizedClient().WithDescription("the CIBA grant requires an authenticated client")
+	}
+	return nil
+}
+
+// backChannelAuthLifetime returns the lifetime of the request,
+// which might be shortened by the client using the requested_expiry parameter.
+func backChannelAuthLifetime(requestedExpiry string, lifetime time.Duration) (time.Duration, error) {
+	if requestedExpiry == "" {
+		return lifetime, nil
+	}
+	seconds, err := strconv.ParseUint(requestedExpiry, 10, 32)
+	if err != nil || seconds == 0 {
+		return 0, oidc.ErrInvalidRequest().WithDescription("requested_expiry must be a positive integer")
+	}
+	if requested := time.Duration(seconds) * time.Second; requested < lifetime {
+		return requested, nil
+	}
+	return lifetime, nil
+}
+
+// backChannelAuthUser resolves the user of the request by exactly one of the provided hints.
+// The login_hint is resolved as login name of the user and the id_token_hint by its subject.
+func (s *Server) backChannelAuthUser(ctx context.Context, loginHint, idTokenHint, loginHintToken string) (_ *query.User, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	var hints int
+	for _, hint := range []string{loginHint, idTokenHint, loginHintToken} {
+		if hint != "" {
+			hints++
+		}
+	}
+	if hints != 1 {
+		return nil, oidc.ErrInvalidRequest().WithDescription("exactly one of login_hint, id_token_hint or login_hint_token must be provided")
+	}
+	var user *query.User
+	switch {
+	case loginHintToken != "":
+		return nil, oidc.ErrInvalidRequest().WithDescription("login_hint_token is not supported")
+	case idTokenHint != "":
+		// previously issued id_tokens are accepted even if they are expired
+		claims, err := op.VerifyIDTokenHint[*oidc.IDTokenClaims](ctx, idTokenHint, op.NewIDTokenHintVerifier(op.IssuerFromContext(ctx), s.idTokenHintKeySet))
+		if err != nil && !errors.As(err, new(op.IDTokenHintExpiredError)) {
+			return nil, oidc.ErrInvalidRequest().WithParent(err).WithDescription("id_token_hint is invalid")
+		}
+		user, err = s.query.GetUserByID(ctx, false, claims.GetSubject())
+		if err != nil {
+			return nil, errUnknownUserID(ctx, err)
+		}
+	default:
+		user, err = s.query.GetUserByLoginName(ctx, true, loginHint)
+		if err != nil {
+			return nil, errUnknownUserID(ctx, err)
+		}
+	}
+	if user.State != domain.UserStateActive || user.Human == nil {
+		return nil, errUnknownUserID(ctx, nil)
+	}
+	return user, nil
+}
+
+func errUnknownUserID(ctx context.Context, parent error) *oidc.Error {
+	return (&oidc.Error{
+		ErrorType:   unknownUserID,
+		Description: "the user could not be identified by the provided hint",
+	}).WithParent(parent).WithReturnParentToClient(authz.GetFeatures(ctx).DebugOIDCParentError)
+}
+
+// backChannelAuthTokenInterceptor serves the CIBA grant on the token endpoint,
+// as the grant type is not (yet) supported by the library.
+// All other requests are passed to the next handler.
+func (s *Server) backChannelAuthTokenInterceptor(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != s.Endpoints().Token.Relative() {
+			next.ServeHTTP(w, r)
+			return
+		}
+		if err := r.ParseForm(); err != nil || oidc.GrantType(r.PostForm.Get("grant_type")) != GrantTypeCIBA {
+			next.ServeHTTP(w, r)
+			return
+		}
+		resp, err := s.backChannelAuthToken(r)
+		if err != nil {
+			op.WriteError(w, r, err, s.getLogger(r.Context()))
+			return
+		}
+		httphelper.MarshalJSON(w, resp)
+	})
+}
+
+func (s *Server) backChannelAuthToken(r *http.Request) (_ *oidc.AccessTokenResponse, err error) {
+	ctx, span := tracing.NewSpan(r.Context())
+	defer func() {
+		span.EndWithError(err)
+		err = oidcError(err)
+	}()
+
+	authReqID := r.PostForm.Get("auth_req_id")
+	if authReqID == "" {
+		return nil, oidc.ErrInvalidRequest().WithDescription("auth_req_id missing")
+	}
+	opClient, err := s.VerifyClient(ctx, &op.Request[op.ClientCredentials]{
+		Method: r.Method,
+		URL:    r.URL,
+		Header: r.Header,
+		Form:   r.PostForm,
+		Data:   clientCredentialsFromRequest(r),
+	})
+	if err != nil {
+		return nil, err
+	}
+	client, ok := opClient.(*Client)
+	if !ok {
+		return nil, zerrors.ThrowInternal(nil, "OIDC-Cba2c", "Error.Internal")
+	}
+	if err = checkBackChannelAuthClient(client); err != nil {
+		return nil, err
+	}
+	dpopJKT, err := s.dpopKeyThumbprint(ctx, r.Header, client)
+	if err != nil {
+		return nil, err
+	}
+	session, err := s.command.CreateOIDCSessionFromBackChannelAuth(ctx, authReqID, client.GetID(), client.client.BackChannelLogoutURI, dpopJKT)
+	if err == nil {
+		return s.accessTokenResponseFromSession(ctx, client, session, "", client.client.ProjectID, client.client.ProjectRoleAssertion, client.client.AccessTokenRoleAssertion, client.client.IDTokenRoleAssertion, client.client.IDTokenUserinfoAssertion)
+	}
+	return nil, backChannelAuthTokenError(ctx, err)
+}
+
+// backChannelAuthTokenError maps the state of the request to the errors of the token endpoint (CIBA core, section 11).
+func backChannelAuthTokenError(ctx context.Context, err error) error {
+	if errors.Is(err, context.DeadlineExceeded) {
+		return oidc.ErrSlowDown().WithParent(err).WithReturnParentToClient(authz.GetFeatures(ctx).DebugOIDCParentError)
+	}
+	var target command.BackChannelAuthStateError
+	if errors.As(err, &target) {
+		switch domain.BackChannelAuthState(target) {
+		case domain.BackChannelAuthStateInitiated:
+			return oidc.ErrAuthorizationPending()
+		case domain.BackChannelAuthStateExpired:
+			return oidc.ErrExpiredDeviceCode().WithDescription("The \"auth_req_id\" has expired.")
+		case domain.BackChannelAuthStateDenied:
+			return oidc.ErrAccessDenied()
+		case domain.BackChannelAuthStateUndefined, domain.BackChannelAuthStateApproved, domain.BackChannelAuthStateDone:
+		}
+	}
+	return oidc.ErrInvalidGrant().WithParent(err).WithReturnParentToClient(authz.GetFeatures(ctx).DebugOIDCParentError)
+}
+
+func backChannelAuthEndpoint(endpointConfig *EndpointConfig) *op.Endpoint {
+	if endpointConfig == nil || endpointConfig.BackChannelAuth == nil {
+		return op.NewEndpoint("/oauth/v2/bc-authorize")
+	}
+	return op.NewEndpointWithURL(endpointConfig.BackChannelAuth.Path, endpointConfig.BackChannelAuth.URL)
+}
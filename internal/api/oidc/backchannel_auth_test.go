@@ -0,0 +1,109 @@
+package oidc
+
+import (
+	"context"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/zitadel/oidc/v3/pkg/oidc"
+
+	"github.com/zitadel/zitadel/internal/command"
+	"github.com/zitadel/zitadel/internal/domain"
+)
+
+func Test_backChannelAuthLifetime(t *testing.T) {
+	tests := []struct {
+		name            string
+		requestedExpiry string
+		want            time.Duration
+		wantErrorType   string
+	}{
+		{
+			name: "default",
+			want: 5 * time.Minute,
+		},
+		{
+			name:            "shorter",
+			requestedExpiry: "120",
+			want:            2 * time.Minute,
+		},
+		{
+			name:            "longer, capped",
+			requestedExpiry: "3600",
+			want:            5 * time.Minute,
+		},
+		{
+			name:            "zero",
+			requestedExpiry: "0",
+			wantErrorType:   string(oidc.InvalidRequest),
+		},
+		{
+			name:            "invalid",
+			requestedExpiry: "soon",
+			wantErrorType:   string(oidc.InvalidRequest),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := backChannelAuthLifetime(tt.requestedExpiry, 5*time.Minute)
+			if tt.wantErrorType != "" {
+				var target *oidc.Error
+				require.ErrorAs(t, err, &target)
+				assert.EqualValues(t, tt.wantErrorType, target.ErrorType)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_backChannelAuthTokenError(t *testing.T) {
+	tests := []struct {
+		name          string
+		err           error
+		wantErrorType string
+	}{
+		{
+			name:          "pending",
+			err:           command.BackChannelAuthStateError(domain.BackChannelAuthStateInitiated),
+			wantErrorType: string(oidc.AuthorizationPending),
+		},
+		{
+			name:          "expired",
+			err:           command.BackChannelAuthStateError(domain.BackChannelAuthStateExpired),
+			wantErrorType: string(oidc.ExpiredToken),
+		},
+		{
+			name:          "denied",
+			err:           command.BackChannelAuthStateError(domain.BackChannelAuthStateDenied),
+			wantErrorType: string(oidc.AccessDenied),
+		},
+		{
+			name:          "done",
+			err:           command.BackChannelAuthStateError(domain.BackChannelAuthStateDone),
+			wantErrorType: string(oidc.InvalidGrant),
+		},
+		{
+			name:          "timeout",
+			err:           context.DeadlineExceeded,
+			wantErrorType: string(oidc.SlowDown),
+		},
+		{
+			name:          "other",
+			err:           io.ErrClosedPipe,
+			wantErrorType: string(oidc.InvalidGrant),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := backChannelAuthTokenError(context.Background(), tt.err)
+			var target *oidc.Error
+			require.ErrorAs(t, err, &target)
+			assert.EqualValues(t, tt.wantErrorType, target.ErrorType)
+		})
+	}
+}
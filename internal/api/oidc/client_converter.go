@@ -213,6 +213,8 @@ func grantTypeToOIDC(grantType domain.OIDCGrantType) oidc.GrantType {
 		return oidc.GrantTypeDeviceCode
 	case domain.OIDCGrantTypeTokenExchange:
 		return oidc.GrantTypeTokenExchange
+	case domain.OIDCGrantTypeCIBA:
+		return GrantTypeCIBA
 	default:
 		return oidc.GrantTypeCode
 	}
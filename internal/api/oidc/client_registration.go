@@ -0,0 +1,334 @@
+package oidc
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	httphelper "github.com/zitadel/oidc/v3/pkg/http"
+	"github.com/zitadel/oidc/v3/pkg/oidc"
+	"github.com/zitadel/oidc/v3/pkg/op"
+
+	http_utils "github.com/zitadel/zitadel/internal/api/http"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+const (
+	clientRegistrationErrorInvalidRedirectURI    = "invalid_redirect_uri"
+	clientRegistrationErrorInvalidClientMetadata = "invalid_client_metadata"
+	clientRegistrationErrorInvalidToken          = "invalid_token"
+
+	applicationTypeWeb    = "web"
+	applicationTypeNative = "native"
+
+	clientIDParam = "client_id"
+)
+
+// ClientMetadata is the metadata of a client, which can be registered dynamically (RFC 7591, section 2).
+// Other metadata is ignored, settings not covered by the metadata keep their defaults
+// and can be changed through the management API.
+type ClientMetadata struct {
+	RedirectURIs            []string `json:"redirect_uris,omitempty"`
+	TokenEndpointAuthMethod string   `json:"token_endpoint_auth_method,omitempty"`
+	GrantTypes              []string `json:"grant_types,omitempty"`
+	ResponseTypes           []string `json:"response_types,omitempty"`
+	ClientName              string   `json:"client_name,omitempty"`
+	ApplicationType         string   `json:"application_type,omitempty"`
+	PostLogoutRedirectURIs  []string `json:"post_logout_redirect_uris,omitempty"`
+}
+
+// ClientInformation is the response of the client registration endpoint (RFC 7591, section 3.2.1)
+// and the client configuration endpoint (RFC 7592, section 3).
+type ClientInformation struct {
+	ClientID                string  `json:"client_id"`
+	ClientSecret            string  `json:"client_secret,omitempty"`
+	ClientIDIssuedAt        int64   `json:"client_id_issued_at,omitempty"`
+	ClientSecretExpiresAt   *uint64 `json:"client_secret_expires_at,omitempty"`
+	RegistrationAccessToken string  `json:"registration_access_token,omitempty"`
+	RegistrationClientURI   string  `json:"registration_client_uri"`
+	ClientMetadata
+}
+
+func errInvalidClientMetadata() *oidc.Error {
+	return &oidc.Error{
+		ErrorType: clientRegistrationErrorInvalidClientMetadata,
+	}
+}
+
+func errInvalidRedirectURI() *oidc.Error {
+	return &oidc.Error{
+		ErrorType: clientRegistrationErrorInvalidRedirectURI,
+	}
+}
+
+// clientRegistrationHandler implements the client registration endpoint (RFC 7591, section 3).
+// The request must be authorized by an initial access token of the project the client will be registered in.
+func (s *Server) clientRegistrationHandler(w http.ResponseWriter, r *http.Request) {
+	ctx, span := tracing.NewSpan(r.Context())
+	var err error
+	defer func() { span.EndWithError(err) }()
+
+	if r.Method != http.MethodPost {
+		err = oidc.ErrInvalidRequest().WithDescription("client registration requests must use the POST method")
+		s.writeClientRegistrationError(w, r, err)
+		return
+	}
+	metadata, err := clientMetadataFromRequest(r)
+	if err != nil {
+		s.writeClientRegistrationError(w, r, err)
+		return
+	}
+	app, err := clientMetadataToOIDCApp(metadata)
+	if err != nil {
+		s.writeClientRegistrationError(w, r, err)
+		return
+	}
+	app, registrationAccessToken, err := s.command.RegisterOIDCApplication(ctx, bearerToken(r), app)
+	if err != nil {
+		s.writeClientRegistrationError(w, r, err)
+		return
+	}
+	info := s.clientInformation(r, app)
+	info.ClientIDIssuedAt = time.Now().Unix()
+	info.RegistrationAccessToken = registrationAccessToken
+	if app.ClientSecretString != "" {
+		info.ClientSecret = app.ClientSecretString
+		// client secrets of ZITADEL don't expire
+		info.ClientSecretExpiresAt = new(uint64)
+	}
+	httphelper.MarshalJSONWithStatus(w, info, http.StatusCreated)
+}
+
+// clientConfigurationHandler implements the client configuration endpoint (RFC 7592, section 2).
+// The request must be authorized by the registration access token issued on the registration of the client.
+func (s *Server) clientConfigurationHandler(w http.ResponseWriter, r *http.Request) {
+	ctx, span := tracing.NewSpan(r.Context())
+	var err error
+	defer func() { span.EndWithError(err) }()
+
+	clientID := chi.URLParam(r, clientIDParam)
+	var app *domain.OIDCApp
+	switch r.Method {
+	case http.MethodGet:
+		app, err = s.command.GetRegisteredOIDCApplication(ctx, clientID, bearerToken(r))
+	case http.MethodPut:
+		var metadata *ClientMetadata
+		if metadata, err = clientMetadataFromRequest(r); err != nil {
+			break
+		}
+		if app, err = clientMetadataToOIDCApp(metadata); err != nil {
+			break
+		}
+		app, err = s.command.UpdateRegisteredOIDCApplication(ctx, clientID, bearerToken(r), app)
+	case http.MethodDelete:
+		if _, err = s.command.DeleteRegisteredOIDCApplication(ctx, clientID, bearerToken(r)); err == nil {
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+	default:
+		err = oidc.ErrInvalidRequest().WithDescription("method %s is not allowed on the client configuration endpoint", r.Method)
+	}
+	if err != nil {
+		s.writeClientRegistrationError(w, r, err)
+		return
+	}
+	httphelper.MarshalJSON(w, s.clientInformation(r, app))
+}
+
+func (s *Server) clientInformation(r *http.Request, app *domain.OIDCApp) *ClientInformation {
+	issuer := op.IssuerFromContext(r.Context())
+	return &ClientInformation{
+		ClientID:              app.ClientID,
+		RegistrationClientURI: s.registrationEndpoint.Absolute(issuer) + "/" + url.PathEscape(app.ClientID),
+		ClientMetadata:        oidcAppToClientMetadata(app),
+	}
+}
+
+// writeClientRegistrationError maps the error to the responses defined in RFC 7591, section 3.2.2 and RFC 7592, section 2.
+// Invalid or missing tokens result in a 401 response (RFC 6750, section 3.1).
+func (s *Server) writeClientRegistrationError(w http.ResponseWriter, r *http.Request, err error) {
+	switch {
+	case zerrors.IsUnauthenticated(err):
+		w.Header().Set("WWW-Authenticate", `Bearer error="`+clientRegistrationErrorInvalidToken+`"`)
+		err = op.NewStatusError(&oidc.Error{
+			ErrorType:   clientRegistrationErrorInvalidToken,
+			Description: "the access token is invalid or expired",
+			Parent:      err,
+		}, http.StatusUnauthorized)
+	case zerrors.IsErrorInvalidArgument(err):
+		var zErr *zerrors.ZitadelError
+		errors.As(err, &zErr)
+		err = errInvalidClientMetadata().WithDescription(zErr.GetMessage()).WithParent(err)
+	default:
+		err = oidcError(err)
+	}
+	op.WriteError(w, r, err, s.getLogger(r.Context()))
+}
+
+func clientMetadataFromRequest(r *http.Request) (*ClientMetadata, error) {
+	if !strings.HasPrefix(r.Header.Get(http_utils.ContentType), "application/json") {
+		return nil, oidc.ErrInvalidRequest().WithDescription("client metadata must be sent as application/json")
+	}
+	metadata := new(ClientMetadata)
+	if err := json.NewDecoder(r.Body).Decode(metadata); err != nil {
+		return nil, errInvalidClientMetadata().WithDescription("malformed client metadata").WithParent(err)
+	}
+	return metadata, nil
+}
+
+func bearerToken(r *http.Request) string {
+	token, _ := strings.CutPrefix(r.Header.Get(http_utils.Authorization), oidc.PrefixBearer)
+	return token
+}
+
+// clientMetadataToOIDCApp maps the client metadata to the configuration of an OIDC application,
+// applying the defaults of RFC 7591, section 2 and OpenID Connect Dynamic Client Registration 1.0, section 2.
+func clientMetadataToOIDCApp(metadata *ClientMetadata) (*domain.OIDCApp, error) {
+	app := &domain.OIDCApp{
+		AppName:                metadata.ClientName,
+		RedirectUris:           metadata.RedirectURIs,
+		PostLogoutRedirectUris: metadata.PostLogoutRedirectURIs,
+		OIDCVersion:            domain.OIDCVersionV1,
+		AccessTokenType:        domain.OIDCTokenTypeBearer,
+	}
+	var err error
+	if app.ResponseTypes, err = responseTypesFromClientMetadata(metadata.ResponseTypes); err != nil {
+		return nil, err
+	}
+	if app.GrantTypes, err = grantTypesFromClientMetadata(metadata.GrantTypes); err != nil {
+		return nil, err
+	}
+	if app.ApplicationType, err = applicationTypeFromClientMetadata(metadata.ApplicationType); err != nil {
+		return nil, err
+	}
+	if app.AuthMethodType, err = authMethodFromClientMetadata(metadata.TokenEndpointAuthMethod); err != nil {
+		return nil, err
+	}
+	if err = validateClientMetadataRedirectURIs(app); err != nil {
+		return nil, err
+	}
+	return app, nil
+}
+
+func responseTypesFromClientMetadata(responseTypes []string) ([]domain.OIDCResponseType, error) {
+	if len(responseTypes) == 0 {
+		return []domain.OIDCResponseType{domain.OIDCResponseTypeCode}, nil
+	}
+	types := make([]domain.OIDCResponseType, len(responseTypes))
+	for i, responseType := range responseTypes {
+		switch oidc.ResponseType(responseType) {
+		case oidc.ResponseTypeCode:
+			types[i] = domain.OIDCResponseTypeCode
+		case oidc.ResponseTypeIDToken:
+			types[i] = domain.OIDCResponseTypeIDTokenToken
+		case oidc.ResponseTypeIDTokenOnly:
+			types[i] = domain.OIDCResponseTypeIDToken
+		default:
+			return nil, errInvalidClientMetadata().WithDescription("response_type %s is not supported", responseType)
+		}
+	}
+	return types, nil
+}
+
+func grantTypesFromClientMetadata(grantTypes []string) ([]domain.OIDCGrantType, error) {
+	if len(grantTypes) == 0 {
+		return []domain.OIDCGrantType{domain.OIDCGrantTypeAuthorizationCode}, nil
+	}
+	types := make([]domain.OIDCGrantType, len(grantTypes))
+	for i, grantType := range grantTypes {
+		switch oidc.GrantType(grantType) {
+		case oidc.GrantTypeCode:
+			types[i] = domain.OIDCGrantTypeAuthorizationCode
+		case oidc.GrantTypeImplicit:
+			types[i] = domain.OIDCGrantTypeImplicit
+		case oidc.GrantTypeRefreshToken:
+			types[i] = domain.OIDCGrantTypeRefreshToken
+		case oidc.GrantTypeDeviceCode:
+			types[i] = domain.OIDCGrantTypeDeviceCode
+		case oidc.GrantTypeTokenExchange:
+			types[i] = domain.OIDCGrantTypeTokenExchange
+		default:
+			return nil, errInvalidClientMetadata().WithDescription("grant_type %s is not supported", grantType)
+		}
+	}
+	return types, nil
+}
+
+func applicationTypeFromClientMetadata(applicationType string) (domain.OIDCApplicationType, error) {
+	switch applicationType {
+	case "", applicationTypeWeb:
+		return domain.OIDCApplicationTypeWeb, nil
+	case applicationTypeNative:
+		return domain.OIDCApplicationTypeNative, nil
+	default:
+		return 0, errInvalidClientMetadata().WithDescription("application_type %s is not supported", applicationType)
+	}
+}
+
+// authMethodFromClientMetadata maps the token endpoint authentication method.
+// private_key_jwt is not supported, as the keys of the application can't be registered.
+func authMethodFromClientMetadata(authMethod string) (domain.OIDCAuthMethodType, error) {
+	switch oidc.AuthMethod(authMethod) {
+	case "", oidc.AuthMethodBasic:
+		return domain.OIDCAuthMethodTypeBasic, nil
+	case oidc.AuthMethodPost:
+		return domain.OIDCAuthMethodTypePost, nil
+	case oidc.AuthMethodNone:
+		return domain.OIDCAuthMethodTypeNone, nil
+	default:
+		return 0, errInvalidClientMetadata().WithDescription("token_endpoint_auth_method %s is not supported", authMethod)
+	}
+}
+
+// validateClientMetadataRedirectURIs checks that redirect URIs are provided for redirect based flows
+// and that all redirect URIs are absolute and don't contain a fragment (RFC 6749, section 3.1.2).
+func validateClientMetadataRedirectURIs(app *domain.OIDCApp) error {
+	redirectBased := false
+	for _, grantType := range app.GrantTypes {
+		if grantType == domain.OIDCGrantTypeAuthorizationCode || grantType == domain.OIDCGrantTypeImplicit {
+			redirectBased = true
+		}
+	}
+	if redirectBased && len(app.RedirectUris) == 0 {
+		return errInvalidRedirectURI().WithDescription("redirect_uris are required for the authorization_code and implicit grant types")
+	}
+	for _, uris := range [][]string{app.RedirectUris, app.PostLogoutRedirectUris} {
+		for _, uri := range uris {
+			parsed, err := url.Parse(uri)
+			if err != nil || !parsed.IsAbs() || parsed.Fragment != "" {
+				return errInvalidRedirectURI().WithDescription("%s is not a valid redirect uri", uri)
+			}
+		}
+	}
+	return nil
+}
+
+func oidcAppToClientMetadata(app *domain.OIDCApp) ClientMetadata {
+	responseTypes := make([]string, len(app.ResponseTypes))
+	for i, responseType := range responseTypesToOIDC(app.ResponseTypes) {
+		responseTypes[i] = string(responseType)
+	}
+	grantTypes := make([]string, len(app.GrantTypes))
+	for i, grantType := range grantTypesToOIDC(app.GrantTypes) {
+		grantTypes[i] = string(grantType)
+	}
+	applicationType := applicationTypeWeb
+	if app.ApplicationType == domain.OIDCApplicationTypeNative {
+		applicationType = applicationTypeNative
+	}
+	return ClientMetadata{
+		RedirectURIs:            app.RedirectUris,
+		TokenEndpointAuthMethod: string(authMethodToOIDC(app.AuthMethodType)),
+		GrantTypes:              grantTypes,
+		ResponseTypes:           responseTypes,
+		ClientName:              app.AppName,
+		ApplicationType:         applicationType,
+		PostLogoutRedirectURIs:  app.PostLogoutRedirectUris,
+	}
+}
@@ -0,0 +1,158 @@
+package oidc
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/zitadel/oidc/v3/pkg/oidc"
+
+	"github.com/zitadel/zitadel/internal/domain"
+)
+
+func Test_clientMetadataToOIDCApp(t *testing.T) {
+	tests := []struct {
+		name          string
+		metadata      *ClientMetadata
+		want          *domain.OIDCApp
+		wantErrorType string
+	}{
+		{
+			name: "defaults",
+			metadata: &ClientMetadata{
+				RedirectURIs: []string{"https://example.com/callback"},
+			},
+			want: &domain.OIDCApp{
+				RedirectUris:    []string{"https://example.com/callback"},
+				ResponseTypes:   []domain.OIDCResponseType{domain.OIDCResponseTypeCode},
+				GrantTypes:      []domain.OIDCGrantType{domain.OIDCGrantTypeAuthorizationCode},
+				ApplicationType: domain.OIDCApplicationTypeWeb,
+				AuthMethodType:  domain.OIDCAuthMethodTypeBasic,
+				OIDCVersion:     domain.OIDCVersionV1,
+				AccessTokenType: domain.OIDCTokenTypeBearer,
+			},
+		},
+		{
+			name: "native public client",
+			metadata: &ClientMetadata{
+				ClientName:              "app",
+				RedirectURIs:            []string{"com.example.app:/callback"},
+				PostLogoutRedirectURIs:  []string{"com.example.app:/logout"},
+				ResponseTypes:           []string{"code"},
+				GrantTypes:              []string{"authorization_code", "refresh_token"},
+				ApplicationType:         "native",
+				TokenEndpointAuthMethod: "none",
+			},
+			want: &domain.OIDCApp{
+				AppName:                "app",
+				RedirectUris:           []string{"com.example.app:/callback"},
+				PostLogoutRedirectUris: []string{"com.example.app:/logout"},
+				ResponseTypes:          []domain.OIDCResponseType{domain.OIDCResponseTypeCode},
+				GrantTypes:             []domain.OIDCGrantType{domain.OIDCGrantTypeAuthorizationCode, domain.OIDCGrantTypeRefreshToken},
+				ApplicationType:        domain.OIDCApplicationTypeNative,
+				AuthMethodType:         domain.OIDCAuthMethodTypeNone,
+				OIDCVersion:            domain.OIDCVersionV1,
+				AccessTokenType:        domain.OIDCTokenTypeBearer,
+			},
+		},
+		{
+			name: "device code without redirect uris",
+			metadata: &ClientMetadata{
+				GrantTypes:              []string{string(oidc.GrantTypeDeviceCode)},
+				TokenEndpointAuthMethod: "none",
+			},
+			want: &domain.OIDCApp{
+				ResponseTypes:   []domain.OIDCResponseType{domain.OIDCResponseTypeCode},
+				GrantTypes:      []domain.OIDCGrantType{domain.OIDCGrantTypeDeviceCode},
+				ApplicationType: domain.OIDCApplicationTypeWeb,
+				AuthMethodType:  domain.OIDCAuthMethodTypeNone,
+				OIDCVersion:     domain.OIDCVersionV1,
+				AccessTokenType: domain.OIDCTokenTypeBearer,
+			},
+		},
+		{
+			name: "unsupported response type",
+			metadata: &ClientMetadata{
+				RedirectURIs:  []string{"https://example.com/callback"},
+				ResponseTypes: []string{"token"},
+			},
+			wantErrorType: clientRegistrationErrorInvalidClientMetadata,
+		},
+		{
+			name: "unsupported grant type",
+			metadata: &ClientMetadata{
+				GrantTypes: []string{string(oidc.GrantTypeClientCredentials)},
+			},
+			wantErrorType: clientRegistrationErrorInvalidClientMetadata,
+		},
+		{
+			name: "unsupported application type",
+			metadata: &ClientMetadata{
+				RedirectURIs:    []string{"https://example.com/callback"},
+				ApplicationType: "user_agent",
+			},
+			wantErrorType: clientRegistrationErrorInvalidClientMetadata,
+		},
+		{
+			name: "private key jwt not supported",
+			metadata: &ClientMetadata{
+				RedirectURIs:            []string{"https://example.com/callback"},
+				TokenEndpointAuthMethod: string(oidc.AuthMethodPrivateKeyJWT),
+			},
+			wantErrorType: clientRegistrationErrorInvalidClientMetadata,
+		},
+		{
+			name:          "missing redirect uris",
+			metadata:      &ClientMetadata{},
+			wantErrorType: clientRegistrationErrorInvalidRedirectURI,
+		},
+		{
+			name: "relative redirect uri",
+			metadata: &ClientMetadata{
+				RedirectURIs: []string{"/callback"},
+			},
+			wantErrorType: clientRegistrationErrorInvalidRedirectURI,
+		},
+		{
+			name: "redirect uri with fragment",
+			metadata: &ClientMetadata{
+				RedirectURIs: []string{"https://example.com/callback#fragment"},
+			},
+			wantErrorType: clientRegistrationErrorInvalidRedirectURI,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := clientMetadataToOIDCApp(tt.metadata)
+			if tt.wantErrorType != "" {
+				var target *oidc.Error
+				require.ErrorAs(t, err, &target)
+				assert.EqualValues(t, tt.wantErrorType, target.ErrorType)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_oidcAppToClientMetadata(t *testing.T) {
+	got := oidcAppToClientMetadata(&domain.OIDCApp{
+		AppName:                "app",
+		RedirectUris:           []string{"https://example.com/callback"},
+		PostLogoutRedirectUris: []string{"https://example.com/logout"},
+		ResponseTypes:          []domain.OIDCResponseType{domain.OIDCResponseTypeCode, domain.OIDCResponseTypeIDTokenToken},
+		GrantTypes:             []domain.OIDCGrantType{domain.OIDCGrantTypeAuthorizationCode, domain.OIDCGrantTypeImplicit},
+		ApplicationType:        domain.OIDCApplicationTypeNative,
+		AuthMethodType:         domain.OIDCAuthMethodTypePost,
+	})
+	assert.Equal(t, ClientMetadata{
+		RedirectURIs:            []string{"https://example.com/callback"},
+		TokenEndpointAuthMethod: "client_secret_post",
+		GrantTypes:              []string{"authorization_code", "implicit"},
+		ResponseTypes:           []string{"code", "id_token token"},
+		ClientName:              "app",
+		ApplicationType:         "native",
+		PostLogoutRedirectURIs:  []string{"https://example.com/logout"},
+	}, got)
+}
@@ -0,0 +1,187 @@
+package oidc
+
+import (
+	"context"
+	"crypto"
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/go-jose/go-jose/v4"
+	"github.com/zitadel/oidc/v3/pkg/oidc"
+	"github.com/zitadel/oidc/v3/pkg/op"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+)
+
+const (
+	// DPoPHeader is the HTTP header carrying the DPoP proof (RFC 9449, section 4.1).
+	DPoPHeader = "DPoP"
+	// TokenTypeDPoP is returned as token_type for DPoP bound access tokens (RFC 9449, section 5).
+	TokenTypeDPoP = "DPoP"
+
+	dpopProofType            = "dpop+jwt"
+	dpopErrorInvalidProof    = "invalid_dpop_proof"
+	dpopConfirmationClaim    = "cnf"
+	dpopConfirmationKeyClaim = "jkt"
+)
+
+// dpopSigningAlgs are the asymmetric algorithms accepted for DPoP proofs.
+// Symmetric algorithms and "none" must not be used (RFC 9449, section 4.3).
+var dpopSigningAlgs = []jose.SignatureAlgorithm{
+	jose.RS256, jose.RS384, jose.RS512,
+	jose.PS256, jose.PS384, jose.PS512,
+	jose.ES256, jose.ES384, jose.ES512,
+	jose.EdDSA,
+}
+
+func dpopSigningAlgValues() []string {
+	algs := make([]string, len(dpopSigningAlgs))
+	for i, alg := range dpopSigningAlgs {
+		algs[i] = string(alg)
+	}
+	return algs
+}
+
+func errInvalidDPoPProof() *oidc.Error {
+	return &oidc.Error{
+		ErrorType: dpopErrorInvalidProof,
+	}
+}
+
+type dpopProofClaims struct {
+	JWTID      string    `json:"jti"`
+	HTTPMethod string    `json:"htm"`
+	HTTPURI    string    `json:"htu"`
+	IssuedAt   oidc.Time `json:"iat"`
+}
+
+func dpopProofKey(instanceID, jwtID string) string {
+	return instanceID + "-" + jwtID
+}
+
+// dpopKeyThumbprint verifies the DPoP proof sent to the token endpoint (RFC 9449, section 4.3)
+// and returns the JWK SHA-256 thumbprint (RFC 7638) of the proof's public key the issued tokens will be bound to.
+// If no proof was sent, an empty thumbprint is returned,
+// unless the client requires DPoP bound tokens.
+func (s *Server) dpopKeyThumbprint(ctx context.Context, header http.Header, client op.Client) (jkt string, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	proofs := header.Values(DPoPHeader)
+	if len(proofs) == 0 {
+		if requireDPoP(client) {
+			return "", errInvalidDPoPProof().WithDescription("the client requires a DPoP proof")
+		}
+		return "", nil
+	}
+	if len(proofs) > 1 {
+		return "", errInvalidDPoPProof().WithDescription("only one DPoP proof must be provided")
+	}
+	jwk, claims, err := parseDPoPProof(proofs[0])
+	if err != nil {
+		return "", err
+	}
+	if err = s.checkDPoPProofClaims(ctx, claims, time.Now()); err != nil {
+		return "", err
+	}
+	thumbprint, err := jwk.Thumbprint(crypto.SHA256)
+	if err != nil {
+		return "", errInvalidDPoPProof().WithDescription("unable to compute the thumbprint of the DPoP proof key").WithParent(err)
+	}
+	return base64.RawURLEncoding.EncodeToString(thumbprint), nil
+}
+
+// parseDPoPProof checks the header of the proof and verifies its signature
+// using the public key in the header.
+func parseDPoPProof(proof string) (*jose.JSONWebKey, *dpopProofClaims, error) {
+	jws, err := jose.ParseSigned(proof, dpopSigningAlgs)
+	if err != nil {
+		return nil, nil, errInvalidDPoPProof().WithDescription("malformed DPoP proof").WithParent(err)
+	}
+	if len(jws.Signatures) != 1 {
+		return nil, nil, errInvalidDPoPProof().WithDescription("DPoP proof must have exactly one signature")
+	}
+	header := jws.Signatures[0].Protected
+	if typ, _ := header.ExtraHeaders[jose.HeaderType].(string); typ != dpopProofType {
+		return nil, nil, errInvalidDPoPProof().WithDescription("DPoP proof must be of type %s", dpopProofType)
+	}
+	jwk := header.JSONWebKey
+	if jwk == nil || !jwk.Valid() || !jwk.IsPublic() {
+		return nil, nil, errInvalidDPoPProof().WithDescription("DPoP proof must contain a valid public key")
+	}
+	payload, err := jws.Verify(jwk)
+	if err != nil {
+		return nil, nil, errInvalidDPoPProof().WithDescription("invalid DPoP proof signature").WithParent(err)
+	}
+	claims := new(dpopProofClaims)
+	if err = json.Unmarshal(payload, claims); err != nil {
+		return nil, nil, errInvalidDPoPProof().WithDescription("malformed DPoP proof claims").WithParent(err)
+	}
+	return jwk, claims, nil
+}
+
+// checkDPoPProofClaims checks that the proof was created for the token endpoint of the instance,
+// was issued recently and was not used before.
+func (s *Server) checkDPoPProofClaims(ctx context.Context, claims *dpopProofClaims, now time.Time) error {
+	if claims.JWTID == "" {
+		return errInvalidDPoPProof().WithDescription("DPoP proof must contain a jti")
+	}
+	if claims.HTTPMethod != http.MethodPost {
+		return errInvalidDPoPProof().WithDescription("htm of the DPoP proof does not match")
+	}
+	htu, err := url.Parse(claims.HTTPURI)
+	if err != nil {
+		return errInvalidDPoPProof().WithDescription("htu of the DPoP proof does not match").WithParent(err)
+	}
+	// query and fragment are ignored (RFC 9449, section 4.3)
+	htu.RawQuery, htu.Fragment = "", ""
+	if htu.String() != s.Endpoints().Token.Absolute(op.IssuerFromContext(ctx)) {
+		return errInvalidDPoPProof().WithDescription("htu of the DPoP proof does not match")
+	}
+	issuedAt := claims.IssuedAt.AsTime()
+	if issuedAt.Before(now.Add(-s.dpopProofLifetime)) || issuedAt.After(now.Add(s.dpopProofLifetime)) {
+		return errInvalidDPoPProof().WithDescription("DPoP proof is expired or not yet valid")
+	}
+	// the proof is accepted until its iat is older than the lifetime,
+	// so it must be remembered until then
+	key := dpopProofKey(authz.GetInstance(ctx).InstanceID(), claims.JWTID)
+	unused, err := s.dpopProofs.Use(ctx, key, now, issuedAt.Add(s.dpopProofLifetime))
+	if err != nil {
+		return err
+	}
+	if !unused {
+		return errInvalidDPoPProof().WithDescription("DPoP proof was already used")
+	}
+	return nil
+}
+
+func requireDPoP(client op.Client) bool {
+	c, ok := client.(*Client)
+	return ok && c.client.RequireDPoP
+}
+
+// dpopNotSupported returns an error for grants which do not support DPoP bound tokens,
+// if the client requires them.
+func dpopNotSupported(client op.Client) error {
+	if requireDPoP(client) {
+		return errInvalidDPoPProof().WithDescription("DPoP bound tokens are not supported for this grant type")
+	}
+	return nil
+}
+
+// dpopConfirmation returns the confirmation claim (RFC 7800) of a DPoP bound token.
+func dpopConfirmation(jkt string) map[string]any {
+	return map[string]any{dpopConfirmationKeyClaim: jkt}
+}
+
+// tokenType returns the token_type of an access token bound to the passed thumbprint.
+func tokenType(jkt string) string {
+	if jkt != "" {
+		return TokenTypeDPoP
+	}
+	return oidc.BearerToken
+}
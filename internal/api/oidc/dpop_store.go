@@ -0,0 +1,97 @@
+package oidc
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+
+	"github.com/zitadel/zitadel/internal/cache"
+	redis_connector "github.com/zitadel/zitadel/internal/cache/connector/redis"
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// DPoPProofStore remembers the used DPoP proofs to detect replayed proofs (RFC 9449, section 11.1).
+type DPoPProofStore interface {
+	// Use marks the key of a proof as used until expiresAt.
+	// It returns false if the key is already used,
+	// the check and the mark are a single atomic operation, so concurrent uses of a key only succeed once.
+	Use(ctx context.Context, key string, now, expiresAt time.Time) (bool, error)
+}
+
+type DPoPProofStoreType string
+
+const (
+	DPoPProofStorePostgres DPoPProofStoreType = "postgres"
+	DPoPProofStoreRedis    DPoPProofStoreType = "redis"
+)
+
+// newDPoPProofStore returns the configured [DPoPProofStore], defaults to postgres.
+// The store is shared by all replicas, so a proof can't be replayed on another replica.
+func newDPoPProofStore(typ DPoPProofStoreType, client *database.DB, redisConnector *redis_connector.Connector) (DPoPProofStore, error) {
+	switch typ {
+	case "", DPoPProofStorePostgres:
+		return NewDPoPProofPostgresStore(client), nil
+	case DPoPProofStoreRedis:
+		if redisConnector == nil {
+			return nil, zerrors.ThrowInvalidArgument(nil, "OIDC-Dpo2r", "redis store requires the redis cache connector")
+		}
+		return NewDPoPProofRedisStore(redisConnector, redisConnector.Config.DBOffset+int(cache.PurposeDPoPProof)), nil
+	default:
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "OIDC-Dpo3s", "unknown dpop proof store %q", typ)
+	}
+}
+
+// useDPoPProofStmt removes the expired proofs of all other keys and inserts the key.
+// An expired proof of the same key is replaced, no row is returned if the key is already used.
+const useDPoPProofStmt = "WITH expired AS (DELETE FROM system.dpop_proofs WHERE expires_at <= $3 AND key <> $1)" +
+	" INSERT INTO system.dpop_proofs (key, expires_at) VALUES ($1, $2)" +
+	" ON CONFLICT (key) DO UPDATE SET expires_at = EXCLUDED.expires_at WHERE system.dpop_proofs.expires_at <= $3" +
+	" RETURNING key"
+
+type dpopProofPostgresStore struct {
+	client *database.DB
+}
+
+// NewDPoPProofPostgresStore returns a [DPoPProofStore] using the system.dpop_proofs table.
+func NewDPoPProofPostgresStore(client *database.DB) DPoPProofStore {
+	return &dpopProofPostgresStore{client: client}
+}
+
+func (s *dpopProofPostgresStore) Use(ctx context.Context, key string, now, expiresAt time.Time) (bool, error) {
+	err := s.client.QueryRowContext(ctx, func(row *sql.Row) error {
+		return row.Scan(&key)
+	}, useDPoPProofStmt, key, expiresAt, now)
+	if errors.Is(err, sql.ErrNoRows) {
+		return false, nil
+	}
+	if err != nil {
+		return false, zerrors.ThrowInternal(err, "OIDC-Dpo4p", "unable to store dpop proof")
+	}
+	return true, nil
+}
+
+type dpopProofRedisStore struct {
+	client *redis.Client
+}
+
+// NewDPoPProofRedisStore returns a [DPoPProofStore] using a string per key, which expires with the proof.
+// The keys are stored in the DB namespace db, on the server of the connector.
+func NewDPoPProofRedisStore(connector *redis_connector.Connector, db int) DPoPProofStore {
+	options := *connector.Options()
+	options.DB = db
+	return &dpopProofRedisStore{client: redis.NewClient(&options)}
+}
+
+func (s *dpopProofRedisStore) Use(ctx context.Context, key string, now, expiresAt time.Time) (bool, error) {
+	// a zero expiration would keep the key forever
+	expiration := max(expiresAt.Sub(now), time.Millisecond)
+	ok, err := s.client.SetNX(ctx, key, expiresAt.UnixMilli(), expiration).Result()
+	if err != nil {
+		return false, zerrors.ThrowInternal(err, "OIDC-Dpo5r", "unable to store dpop proof")
+	}
+	return ok, nil
+}
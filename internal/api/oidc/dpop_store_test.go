@@ -0,0 +1,106 @@
+package oidc
+
+import (
+	"context"
+	"database/sql/driver"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zitadel/zitadel/internal/cache/connector/redis"
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/database/mock"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+type memoryDPoPProofStore struct {
+	proofs map[string]time.Time
+}
+
+func newMemoryDPoPProofStore() *memoryDPoPProofStore {
+	return &memoryDPoPProofStore{
+		proofs: make(map[string]time.Time),
+	}
+}
+
+func (s *memoryDPoPProofStore) Use(_ context.Context, key string, now, expiresAt time.Time) (bool, error) {
+	if used, ok := s.proofs[key]; ok && used.After(now) {
+		return false, nil
+	}
+	s.proofs[key] = expiresAt
+	return true, nil
+}
+
+func TestDPoPProofPostgresStore(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	key := dpopProofKey("instance", "jti")
+
+	t.Run("unused", func(t *testing.T) {
+		m := mock.NewSQLMock(t,
+			mock.ExpectQuery(useDPoPProofStmt,
+				mock.WithQueryArgs(key, now.Add(time.Minute), now),
+				mock.WithQueryResult([]string{"key"}, [][]driver.Value{{key}}),
+			),
+		)
+		defer m.Assert(t)
+		unused, err := NewDPoPProofPostgresStore(&database.DB{DB: m.DB}).Use(ctx, key, now, now.Add(time.Minute))
+		require.NoError(t, err)
+		assert.True(t, unused)
+	})
+	t.Run("used", func(t *testing.T) {
+		m := mock.NewSQLMock(t,
+			mock.ExpectQuery(useDPoPProofStmt,
+				mock.WithQueryArgs(key, now.Add(time.Minute), now),
+				mock.WithQueryResult([]string{"key"}, [][]driver.Value{}),
+			),
+		)
+		defer m.Assert(t)
+		unused, err := NewDPoPProofPostgresStore(&database.DB{DB: m.DB}).Use(ctx, key, now, now.Add(time.Minute))
+		require.NoError(t, err)
+		assert.False(t, unused)
+	})
+	t.Run("error", func(t *testing.T) {
+		m := mock.NewSQLMock(t,
+			mock.ExpectQuery(useDPoPProofStmt,
+				mock.WithQueryArgs(key, now.Add(time.Minute), now),
+				mock.WithQueryErr(zerrors.ThrowInternal(nil, "id", "message")),
+			),
+		)
+		defer m.Assert(t)
+		_, err := NewDPoPProofPostgresStore(&database.DB{DB: m.DB}).Use(ctx, key, now, now.Add(time.Minute))
+		assert.True(t, zerrors.IsInternal(err))
+	})
+}
+
+func TestDPoPProofRedisStore(t *testing.T) {
+	ctx := context.Background()
+	server := miniredis.RunT(t)
+	connector := redis.NewConnector(redis.Config{
+		Enabled:          true,
+		Network:          "tcp",
+		Addr:             server.Addr(),
+		DisableIndentity: true,
+	})
+	t.Cleanup(func() {
+		connector.Close()
+	})
+	store := NewDPoPProofRedisStore(connector, 10)
+	key := dpopProofKey("instance", "jti")
+	now := time.Now()
+
+	unused, err := store.Use(ctx, key, now, now.Add(time.Minute))
+	require.NoError(t, err)
+	assert.True(t, unused)
+	unused, err = store.Use(ctx, key, now, now.Add(time.Minute))
+	require.NoError(t, err)
+	assert.False(t, unused, "proof must only be used once")
+
+	server.FastForward(time.Minute)
+	unused, err = store.Use(ctx, key, now, now.Add(time.Minute))
+	require.NoError(t, err)
+	assert.True(t, unused, "expired proof must be removed")
+}
@@ -0,0 +1,205 @@
+package oidc
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/go-jose/go-jose/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/zitadel/oidc/v3/pkg/oidc"
+	"github.com/zitadel/oidc/v3/pkg/op"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+)
+
+func signDPoPProof(t *testing.T, key any, alg jose.SignatureAlgorithm, options *jose.SignerOptions, claims any) string {
+	signer, err := jose.NewSigner(jose.SigningKey{Algorithm: alg, Key: key}, options)
+	require.NoError(t, err)
+	payload, err := json.Marshal(claims)
+	require.NoError(t, err)
+	jws, err := signer.Sign(payload)
+	require.NoError(t, err)
+	proof, err := jws.CompactSerialize()
+	require.NoError(t, err)
+	return proof
+}
+
+func Test_parseDPoPProof(t *testing.T) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	claims := &dpopProofClaims{
+		JWTID:      "jti",
+		HTTPMethod: "POST",
+		HTTPURI:    "https://issuer.com/oauth/v2/token",
+		IssuedAt:   oidc.FromTime(time.Now()),
+	}
+	tests := []struct {
+		name    string
+		proof   string
+		want    *dpopProofClaims
+		wantErr bool
+	}{
+		{
+			name:    "malformed",
+			proof:   "foo",
+			wantErr: true,
+		},
+		{
+			name:    "symmetric algorithm",
+			proof:   signDPoPProof(t, []byte("01234567890123456789012345678901"), jose.HS256, (&jose.SignerOptions{}).WithType(dpopProofType), claims),
+			wantErr: true,
+		},
+		{
+			name:    "wrong type",
+			proof:   signDPoPProof(t, key, jose.ES256, (&jose.SignerOptions{EmbedJWK: true}).WithType("JWT"), claims),
+			wantErr: true,
+		},
+		{
+			name:    "missing key",
+			proof:   signDPoPProof(t, key, jose.ES256, (&jose.SignerOptions{}).WithType(dpopProofType), claims),
+			wantErr: true,
+		},
+		{
+			name:  "valid",
+			proof: signDPoPProof(t, key, jose.ES256, (&jose.SignerOptions{EmbedJWK: true}).WithType(dpopProofType), claims),
+			want:  claims,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			jwk, got, err := parseDPoPProof(tt.proof)
+			if tt.wantErr {
+				var target *oidc.Error
+				require.ErrorAs(t, err, &target)
+				assert.EqualValues(t, dpopErrorInvalidProof, target.ErrorType)
+				return
+			}
+			require.NoError(t, err)
+			assert.True(t, jwk.IsPublic())
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestServer_checkDPoPProofClaims(t *testing.T) {
+	now := time.Now()
+	ctx := op.ContextWithIssuer(authz.WithInstanceID(context.Background(), "instanceID"), "https://issuer.com")
+	tests := []struct {
+		name    string
+		claims  []*dpopProofClaims
+		wantErr bool
+	}{
+		{
+			name: "missing jti",
+			claims: []*dpopProofClaims{{
+				HTTPMethod: "POST",
+				HTTPURI:    "https://issuer.com/oauth/v2/token",
+				IssuedAt:   oidc.FromTime(now),
+			}},
+			wantErr: true,
+		},
+		{
+			name: "wrong method",
+			claims: []*dpopProofClaims{{
+				JWTID:      "jti",
+				HTTPMethod: "GET",
+				HTTPURI:    "https://issuer.com/oauth/v2/token",
+				IssuedAt:   oidc.FromTime(now),
+			}},
+			wantErr: true,
+		},
+		{
+			name: "wrong uri",
+			claims: []*dpopProofClaims{{
+				JWTID:      "jti",
+				HTTPMethod: "POST",
+				HTTPURI:    "https://issuer.com/oidc/v1/userinfo",
+				IssuedAt:   oidc.FromTime(now),
+			}},
+			wantErr: true,
+		},
+		{
+			name: "expired",
+			claims: []*dpopProofClaims{{
+				JWTID:      "jti",
+				HTTPMethod: "POST",
+				HTTPURI:    "https://issuer.com/oauth/v2/token",
+				IssuedAt:   oidc.FromTime(now.Add(-2 * time.Minute)),
+			}},
+			wantErr: true,
+		},
+		{
+			name: "issued in the future",
+			claims: []*dpopProofClaims{{
+				JWTID:      "jti",
+				HTTPMethod: "POST",
+				HTTPURI:    "https://issuer.com/oauth/v2/token",
+				IssuedAt:   oidc.FromTime(now.Add(2 * time.Minute)),
+			}},
+			wantErr: true,
+		},
+		{
+			name: "replayed",
+			claims: []*dpopProofClaims{
+				{
+					JWTID:      "jti",
+					HTTPMethod: "POST",
+					HTTPURI:    "https://issuer.com/oauth/v2/token",
+					IssuedAt:   oidc.FromTime(now),
+				},
+				{
+					JWTID:      "jti",
+					HTTPMethod: "POST",
+					HTTPURI:    "https://issuer.com/oauth/v2/token",
+					IssuedAt:   oidc.FromTime(now),
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "valid, query ignored",
+			claims: []*dpopProofClaims{
+				{
+					JWTID:      "jti1",
+					HTTPMethod: "POST",
+					HTTPURI:    "https://issuer.com/oauth/v2/token?foo=bar",
+					IssuedAt:   oidc.FromTime(now),
+				},
+				{
+					JWTID:      "jti2",
+					HTTPMethod: "POST",
+					HTTPURI:    "https://issuer.com/oauth/v2/token",
+					IssuedAt:   oidc.FromTime(now),
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := &Server{
+				LegacyServer:      op.NewLegacyServer(nil, op.Endpoints{Token: op.NewEndpoint("/oauth/v2/token")}),
+				dpopProofLifetime: time.Minute,
+				dpopProofs:        newMemoryDPoPProofStore(),
+			}
+			var err error
+			for _, claims := range tt.claims {
+				if err = s.checkDPoPProofClaims(ctx, claims, now); err != nil {
+					break
+				}
+			}
+			if tt.wantErr {
+				var target *oidc.Error
+				require.ErrorAs(t, err, &target)
+				assert.EqualValues(t, dpopErrorInvalidProof, target.ErrorType)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -120,7 +120,7 @@ func (s *Server) Introspect(ctx context.Context, r *op.Request[op.IntrospectionR
 		Active:                          true,
 		Scope:                           token.scope,
 		ClientID:                        token.clientID,
-		TokenType:                       oidc.BearerToken,
+		TokenType:                       tokenType(token.dpopJKT),
 		Expiration:                      oidc.FromTime(token.tokenExpiration),
 		IssuedAt:                        oidc.FromTime(token.tokenCreation),
 		AuthTime:                        oidc.FromTime(token.authTime),
@@ -132,6 +132,15 @@ func (s *Server) Introspect(ctx context.Context, r *op.Request[op.IntrospectionR
 		Actor:                           actorDomainToClaims(token.actor),
 	}
 	introspectionResp.SetUserInfo(userInfo)
+	if introspectionResp.Claims == nil && (token.dpopJKT != "" || len(token.authorizationDetails) > 0) {
+		introspectionResp.Claims = make(map[string]any, 2)
+	}
+	if token.dpopJKT != "" {
+		introspectionResp.Claims[dpopConfirmationClaim] = dpopConfirmation(token.dpopJKT)
+	}
+	if len(token.authorizationDetails) > 0 {
+		introspectionResp.Claims[authorizationDetailsClaim] = token.authorizationDetails
+	}
 	return op.NewResponse(introspectionResp), nil
 }
 
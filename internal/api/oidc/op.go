@@ -7,6 +7,8 @@ import (
 	"net/http"
 	"time"
 
+	"github.com/go-chi/chi/v5"
+	"github.com/rs/cors"
 	"github.com/zitadel/oidc/v3/pkg/oidc"
 	"github.com/zitadel/oidc/v3/pkg/op"
 
@@ -15,6 +17,7 @@ import (
 	"github.com/zitadel/zitadel/internal/api/http/middleware"
 	"github.com/zitadel/zitadel/internal/api/ui/login"
 	"github.com/zitadel/zitadel/internal/auth/repository"
+	"github.com/zitadel/zitadel/internal/cache/connector"
 	"github.com/zitadel/zitadel/internal/command"
 	"github.com/zitadel/zitadel/internal/crypto"
 	"github.com/zitadel/zitadel/internal/database"
@@ -42,6 +45,10 @@ type Config struct {
 	DefaultLogoutURLV2                string
 	PublicKeyCacheMaxAge              time.Duration
 	DefaultBackChannelLogoutLifetime  time.Duration
+	PushedAuthRequestLifetime         time.Duration
+	DPoPProofLifetime                 time.Duration
+	DPoPProofStore                    DPoPProofStoreType
+	BackChannelAuth                   *BackChannelAuthConfig
 }
 
 type EndpointConfig struct {
@@ -53,6 +60,12 @@ type EndpointConfig struct {
 	EndSession    *Endpoint
 	Keys          *Endpoint
 	DeviceAuth    *Endpoint
+	// PushedAuthRequest is the endpoint for pushed authorization requests (RFC 9126)
+	PushedAuthRequest *Endpoint
+	// Registration is the endpoint for the dynamic client registration (RFC 7591, RFC 7592)
+	Registration *Endpoint
+	// BackChannelAuth is the endpoint for the client initiated backchannel authentication (CIBA)
+	BackChannelAuth *Endpoint
 }
 
 type Endpoint struct {
@@ -115,6 +128,7 @@ func NewServer(
 	accessHandler *middleware.AccessInterceptor,
 	fallbackLogger *slog.Logger,
 	hashConfig crypto.HashConfig,
+	cacheConnectors connector.Connectors,
 ) (*Server, error) {
 	opConfig, err := createOPConfig(config, defaultLogoutRedirectURI, cryptoKey)
 	if err != nil {
@@ -142,33 +156,52 @@ func NewServer(
 	if err != nil {
 		return nil, zerrors.ThrowInternal(err, "OIDC-Aij4e", "cannot create secret hasher")
 	}
+	dpopProofs, err := newDPoPProofStore(config.DPoPProofStore, projections, cacheConnectors.Redis)
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "OIDC-Dpo1c", "cannot create dpop proof store")
+	}
 	server := &Server{
 		LegacyServer: op.NewLegacyServer(&Provider{
 			Provider:          provider,
 			accessTokenKeySet: accessTokenKeySet,
 			idTokenHintKeySet: idTokenHintKeySet,
 		}, endpoints(config.CustomEndpoints)),
-		repo:                       repo,
-		query:                      query,
-		command:                    command,
-		accessTokenKeySet:          accessTokenKeySet,
-		idTokenHintKeySet:          idTokenHintKeySet,
-		defaultLoginURL:            fmt.Sprintf("%s%s?%s=", login.HandlerPrefix, login.EndpointLogin, login.QueryAuthRequestID),
-		defaultLoginURLV2:          config.DefaultLoginURLV2,
-		defaultLogoutURLV2:         config.DefaultLogoutURLV2,
-		defaultAccessTokenLifetime: config.DefaultAccessTokenLifetime,
-		defaultIdTokenLifetime:     config.DefaultIdTokenLifetime,
-		jwksCacheControlMaxAge:     config.JWKSCacheControlMaxAge,
-		fallbackLogger:             fallbackLogger,
-		hasher:                     hasher,
-		encAlg:                     encryptionAlg,
-		opCrypto:                   op.NewAESCrypto(opConfig.CryptoKey),
-		assetAPIPrefix:             assets.AssetAPI(),
+		repo:                        repo,
+		query:                       query,
+		command:                     command,
+		storage:                     storage,
+		accessTokenKeySet:           accessTokenKeySet,
+		idTokenHintKeySet:           idTokenHintKeySet,
+		defaultLoginURL:             fmt.Sprintf("%s%s?%s=", login.HandlerPrefix, login.EndpointLogin, login.QueryAuthRequestID),
+		defaultLoginURLV2:           config.DefaultLoginURLV2,
+		defaultLogoutURLV2:          config.DefaultLogoutURLV2,
+		defaultAccessTokenLifetime:  config.DefaultAccessTokenLifetime,
+		defaultIdTokenLifetime:      config.DefaultIdTokenLifetime,
+		jwksCacheControlMaxAge:      config.JWKSCacheControlMaxAge,
+		pushedAuthRequestEndpoint:   pushedAuthRequestEndpoint(config.CustomEndpoints),
+		pushedAuthRequestLifetime:   config.PushedAuthRequestLifetime,
+		registrationEndpoint:        registrationEndpoint(config.CustomEndpoints),
+		backChannelAuthEndpoint:     backChannelAuthEndpoint(config.CustomEndpoints),
+		backChannelAuthLifetime:     config.BackChannelAuth.lifetime(),
+		backChannelAuthPollInterval: config.BackChannelAuth.pollInterval(),
+		dpopProofLifetime:           config.DPoPProofLifetime,
+		dpopProofs:                  dpopProofs,
+		fallbackLogger:              fallbackLogger,
+		hasher:                      hasher,
+		encAlg:                      encryptionAlg,
+		opCrypto:                    op.NewAESCrypto(opConfig.CryptoKey),
+		assetAPIPrefix:              assets.AssetAPI(),
 	}
 	metricTypes := []metrics.MetricType{metrics.MetricTypeRequestCount, metrics.MetricTypeStatusCode, metrics.MetricTypeTotalCount}
-	server.Handler = op.RegisterLegacyServer(server,
-		server.authorizeCallbackHandler,
+	// the server is registered like [op.RegisterLegacyServer] does,
+	// but with the additional routes of the pushed authorization request, client registration
+	// and backchannel authentication endpoints, which are not (yet) supported by the library.
+	// The CIBA grant is served on the token endpoint by a middleware for the same reason.
+	// The routes must be set after all middlewares are registered.
+	server.Handler = op.RegisterServer(server,
+		server.Endpoints(),
 		op.WithFallbackLogger(fallbackLogger),
+		op.WithServerCORSOptions(&corsOptions),
 		op.WithHTTPMiddleware(
 			middleware.MetricsHandler(metricTypes),
 			middleware.TelemetryHandler(),
@@ -178,11 +211,48 @@ func NewServer(
 			http_utils.CopyHeadersToContext,
 			accessHandler.HandleWithPublicAuthPathPrefixes(publicAuthPathPrefixes(config.CustomEndpoints)),
 			middleware.ActivityHandler,
-		))
+			op.NewIssuerInterceptor(server.Provider().IssuerFromRequest).Handler,
+			server.backChannelAuthTokenInterceptor,
+		),
+		op.WithSetRouter(func(r chi.Router) {
+			r.HandleFunc(server.Endpoints().Authorization.Relative()+"/callback", server.authorizeCallbackHandler)
+			r.HandleFunc(server.pushedAuthRequestEndpoint.Relative(), server.pushedAuthRequestHandler)
+			r.HandleFunc(server.registrationEndpoint.Relative(), server.clientRegistrationHandler)
+			r.HandleFunc(server.registrationEndpoint.Relative()+"/{"+clientIDParam+"}", server.clientConfigurationHandler)
+			r.HandleFunc(server.backChannelAuthEndpoint.Relative(), server.backChannelAuthHandler)
+		}),
+	)
 
 	return server, nil
 }
 
+// corsOptions are the default CORS options of the library,
+// extended by the DPoP header to allow browser based clients to send DPoP proofs (RFC 9449).
+var corsOptions = cors.Options{
+	AllowCredentials: true,
+	AllowedHeaders: []string{
+		http_utils.Origin,
+		http_utils.Accept,
+		http_utils.AcceptLanguage,
+		http_utils.Authorization,
+		http_utils.ContentType,
+		http_utils.XRequestedWith,
+		DPoPHeader,
+	},
+	AllowedMethods: []string{
+		http.MethodGet,
+		http.MethodHead,
+		http.MethodPost,
+	},
+	ExposedHeaders: []string{
+		http_utils.Location,
+		http_utils.ContentLength,
+	},
+	AllowOriginFunc: func(_ string) bool {
+		return true
+	},
+}
+
 func ContextToIssuer(ctx context.Context) string {
 	return http_utils.DomainContext(ctx).Origin()
 }
@@ -0,0 +1,168 @@
+package oidc
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/gorilla/schema"
+	httphelper "github.com/zitadel/oidc/v3/pkg/http"
+	"github.com/zitadel/oidc/v3/pkg/oidc"
+	"github.com/zitadel/oidc/v3/pkg/op"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+)
+
+const (
+	// RequestURIPrefix is the prefix of the request_uri returned by the pushed authorization request endpoint,
+	// as recommended in RFC 9126, section 2.2.
+	RequestURIPrefix = "urn:ietf:params:oauth:request_uri:"
+
+	requestURIParam = "request_uri"
+)
+
+var pushedAuthRequestDecoder = func() *schema.Decoder {
+	decoder := schema.NewDecoder()
+	decoder.IgnoreUnknownKeys(true)
+	return decoder
+}()
+
+// PushedAuthRequestResponse is the response of the pushed authorization request endpoint (RFC 9126, section 2.2).
+type PushedAuthRequestResponse struct {
+	RequestURI string `json:"request_uri"`
+	ExpiresIn  uint64 `json:"expires_in"`
+}
+
+// pushedAuthRequestHandler implements the pushed authorization request endpoint (RFC 9126).
+// The client authenticates the same way as on the token endpoint and pushes the parameters of the authorization request.
+// The stored parameters can then be referenced by the returned request_uri on the authorization endpoint.
+func (s *Server) pushedAuthRequestHandler(w http.ResponseWriter, r *http.Request) {
+	resp, err := s.pushedAuthRequest(r)
+	if err != nil {
+		op.WriteError(w, r, oidcError(err), s.getLogger(r.Context()))
+		return
+	}
+	httphelper.MarshalJSONWithStatus(w, resp, http.StatusCreated)
+}
+
+func (s *Server) pushedAuthRequest(r *http.Request) (_ *PushedAuthRequestResponse, err error) {
+	ctx, span := tracing.NewSpan(r.Context())
+	defer func() { span.EndWithError(err) }()
+
+	if r.Method != http.MethodPost {
+		return nil, oidc.ErrInvalidRequest().WithDescription("pushed authorization requests must use the POST method")
+	}
+	if err = r.ParseForm(); err != nil {
+		return nil, oidc.ErrInvalidRequest().WithDescription("error parsing form").WithParent(err)
+	}
+	client, err := s.VerifyClient(ctx, &op.Request[op.ClientCredentials]{
+		Method: r.Method,
+		URL:    r.URL,
+		Header: r.Header,
+		Form:   r.PostForm,
+		Data:   clientCredentialsFromRequest(r),
+	})
+	if err != nil {
+		return nil, err
+	}
+	parameters, err := pushedAuthRequestParameters(r.PostForm, client)
+	if err != nil {
+		return nil, err
+	}
+	if _, err = s.requestedAuthorizationDetails(ctx, client, r.PostForm); err != nil {
+		return nil, err
+	}
+	id, expiration, err := s.command.AddPushedAuthRequest(ctx, client.GetID(), parameters, s.pushedAuthRequestLifetime)
+	if err != nil {
+		return nil, err
+	}
+	return &PushedAuthRequestResponse{
+		RequestURI: RequestURIPrefix + id,
+		ExpiresIn:  uint64(time.Until(expiration).Round(time.Second) / time.Second),
+	}, nil
+}
+
+func clientCredentialsFromRequest(r *http.Request) *op.ClientCredentials {
+	cc := &op.ClientCredentials{
+		ClientID:            r.PostForm.Get("client_id"),
+		ClientSecret:        r.PostForm.Get("client_secret"),
+		ClientAssertion:     r.PostForm.Get("client_assertion"),
+		ClientAssertionType: r.PostForm.Get("client_assertion_type"),
+	}
+	if clientID, clientSecret, ok := r.BasicAuth(); ok {
+		if id, err := url.QueryUnescape(clientID); err == nil {
+			cc.ClientID = id
+		}
+		if secret, err := url.QueryUnescape(clientSecret); err == nil {
+			cc.ClientSecret = secret
+		}
+	}
+	return cc
+}
+
+// pushedAuthRequestParameters validates the pushed parameters against the authenticated client
+// and returns them without the client authentication parameters.
+func pushedAuthRequestParameters(form url.Values, client op.Client) (map[string][]string, error) {
+	if form.Has(requestURIParam) {
+		return nil, oidc.ErrInvalidRequest().WithDescription("request_uri must not be provided in a pushed authorization request")
+	}
+	authReq := new(oidc.AuthRequest)
+	if err := pushedAuthRequestDecoder.Decode(authReq, form); err != nil {
+		return nil, oidc.ErrInvalidRequest().WithDescription("error decoding form").WithParent(err)
+	}
+	if authReq.ClientID != "" && authReq.ClientID != client.GetID() {
+		return nil, oidc.ErrInvalidRequest().WithDescription("client_id does not match the authenticated client")
+	}
+	if err := op.ValidateAuthReqResponseType(client, authReq.ResponseType); err != nil {
+		return nil, err
+	}
+	if err := op.ValidateAuthReqRedirectURI(client, authReq.RedirectURI, authReq.ResponseType); err != nil {
+		return nil, err
+	}
+	parameters := make(map[string][]string, len(form))
+	for key, values := range form {
+		switch key {
+		case "client_secret", "client_assertion", "client_assertion_type":
+			continue
+		}
+		parameters[key] = values
+	}
+	parameters["client_id"] = []string{client.GetID()}
+	return parameters, nil
+}
+
+// resolvePushedAuthRequest replaces the parameters of the authorization request
+// with the ones pushed by the client, if the request references them by a request_uri.
+// Clients requiring pushed authorization requests must always provide a request_uri.
+func (s *Server) resolvePushedAuthRequest(ctx context.Context, r *op.Request[oidc.AuthRequest]) (pushed bool, err error) {
+	requestURI := r.Form.Get(requestURIParam)
+	if requestURI == "" {
+		return false, nil
+	}
+	id, ok := strings.CutPrefix(requestURI, RequestURIPrefix)
+	if !ok || id == "" {
+		return false, oidc.ErrInvalidRequest().WithDescription("invalid request_uri")
+	}
+	if r.Data.ClientID == "" {
+		return false, oidc.ErrInvalidRequest().WithParent(op.ErrAuthReqMissingClientID).WithDescription(op.ErrAuthReqMissingClientID.Error())
+	}
+	parameters, err := s.command.UsePushedAuthRequest(ctx, id, r.Data.ClientID)
+	if err != nil {
+		return false, oidc.ErrInvalidRequest().WithParent(err).WithReturnParentToClient(authz.GetFeatures(ctx).DebugOIDCParentError).WithDescription("request_uri is invalid or expired")
+	}
+	authReq := new(oidc.AuthRequest)
+	if err = pushedAuthRequestDecoder.Decode(authReq, parameters); err != nil {
+		return false, oidc.ErrInvalidRequest().WithDescription("error decoding pushed authorization request").WithParent(err)
+	}
+	r.Data = authReq
+	r.Form = parameters
+	return true, nil
+}
+
+func requirePushedAuthRequest(client op.Client) bool {
+	c, ok := client.(*Client)
+	return ok && c.client.RequirePushedAuthRequest
+}
@@ -26,6 +26,7 @@ type Server struct {
 	repo              repository.Repository
 	query             *query.Queries
 	command           *command.Commands
+	storage           *OPStorage
 	accessTokenKeySet *oidcKeySet
 	idTokenHintKeySet *oidcKeySet
 
@@ -36,6 +37,17 @@ type Server struct {
 	defaultIdTokenLifetime     time.Duration
 	jwksCacheControlMaxAge     time.Duration
 
+	pushedAuthRequestEndpoint *op.Endpoint
+	pushedAuthRequestLifetime time.Duration
+	registrationEndpoint      *op.Endpoint
+
+	backChannelAuthEndpoint     *op.Endpoint
+	backChannelAuthLifetime     time.Duration
+	backChannelAuthPollInterval time.Duration
+
+	dpopProofLifetime time.Duration
+	dpopProofs        DPoPProofStore
+
 	fallbackLogger      *slog.Logger
 	hasher              *crypto.Hasher
 	signingKeyAlgorithm string
@@ -88,6 +100,20 @@ func endpoints(endpointConfig *EndpointConfig) op.Endpoints {
 	return endpoints
 }
 
+func pushedAuthRequestEndpoint(endpointConfig *EndpointConfig) *op.Endpoint {
+	if endpointConfig == nil || endpointConfig.PushedAuthRequest == nil {
+		return op.NewEndpoint("/oauth/v2/par")
+	}
+	return op.NewEndpointWithURL(endpointConfig.PushedAuthRequest.Path, endpointConfig.PushedAuthRequest.URL)
+}
+
+func registrationEndpoint(endpointConfig *EndpointConfig) *op.Endpoint {
+	if endpointConfig == nil || endpointConfig.Registration == nil {
+		return op.NewEndpoint("/oauth/v2/register")
+	}
+	return op.NewEndpointWithURL(endpointConfig.Registration.Path, endpointConfig.Registration.URL)
+}
+
 func (s *Server) getLogger(ctx context.Context) *slog.Logger {
 	if logger, ok := logging.FromContext(ctx); ok {
 		return logger
@@ -134,14 +160,34 @@ func (s *Server) VerifyAuthRequest(ctx context.Context, r *op.Request[oidc.AuthR
 	ctx, span := tracing.NewSpan(ctx)
 	defer func() { span.EndWithError(err) }()
 
-	return s.LegacyServer.VerifyAuthRequest(ctx, r)
+	pushed, err := s.resolvePushedAuthRequest(ctx, r)
+	if err != nil {
+		return nil, err
+	}
+	cr, err := s.LegacyServer.VerifyAuthRequest(ctx, r)
+	if err != nil {
+		return nil, err
+	}
+	if !pushed && requirePushedAuthRequest(cr.Client) {
+		return nil, oidc.ErrInvalidRequest().WithDescription("client requires pushed authorization requests")
+	}
+	// access tokens of the implicit flow are returned by the authorization endpoint and can't be bound using DPoP
+	if cr.Data.ResponseType == oidc.ResponseTypeIDToken && requireDPoP(cr.Client) {
+		return nil, oidc.ErrUnauthorizedClient().WithDescription("client requires DPoP bound access tokens, which are not supported by the implicit flow")
+	}
+	return cr, nil
 }
 
 func (s *Server) Authorize(ctx context.Context, r *op.ClientRequest[oidc.AuthRequest]) (_ *op.Redirect, err error) {
 	ctx, span := tracing.NewSpan(ctx)
 	defer func() { span.EndWithError(err) }()
 
-	return s.LegacyServer.Authorize(ctx, r)
+	// the form contains the pushed parameters, if the request was resolved from a request_uri
+	authorizationDetails, err := s.requestedAuthorizationDetails(ctx, r.Client, r.Form)
+	if err != nil {
+		return op.TryErrorRedirect(ctx, r.Data, err, s.Provider().Encoder(), s.Provider().Logger())
+	}
+	return s.LegacyServer.Authorize(withAuthorizationDetails(ctx, authorizationDetails), r)
 }
 
 func (s *Server) DeviceAuthorization(ctx context.Context, r *op.ClientRequest[oidc.DeviceAuthorizationRequest]) (_ *op.Response, err error) {
@@ -165,11 +211,26 @@ func (s *Server) EndSession(ctx context.Context, r *op.Request[oidc.EndSessionRe
 	return s.LegacyServer.EndSession(ctx, r)
 }
 
-func (s *Server) createDiscoveryConfig(ctx context.Context, supportedUILocales oidc.Locales) *oidc.DiscoveryConfiguration {
+// DiscoveryConfiguration extends the [oidc.DiscoveryConfiguration]
+// with the metadata of the pushed authorization request endpoint (RFC 9126, section 5)
+// and the algorithms supported for DPoP proofs (RFC 9449, section 5.1).
+// require_pushed_authorization_requests is not advertised,
+// as pushed authorization requests are only required per client.
+type DiscoveryConfiguration struct {
+	*oidc.DiscoveryConfiguration
+	PushedAuthorizationRequestEndpoint string   `json:"pushed_authorization_request_endpoint,omitempty"`
+	DPoPSigningAlgValuesSupported      []string `json:"dpop_signing_alg_values_supported,omitempty"`
+	// metadata of the client initiated backchannel authentication (CIBA core, section 4)
+	BackChannelAuthenticationEndpoint      string   `json:"backchannel_authentication_endpoint,omitempty"`
+	BackChannelTokenDeliveryModesSupported []string `json:"backchannel_token_delivery_modes_supported,omitempty"`
+	BackChannelUserCodeParameterSupported  bool     `json:"backchannel_user_code_parameter_supported,omitempty"`
+}
+
+func (s *Server) createDiscoveryConfig(ctx context.Context, supportedUILocales oidc.Locales) *DiscoveryConfiguration {
 	issuer := op.IssuerFromContext(ctx)
 	backChannelLogoutSupported := authz.GetInstance(ctx).Features().EnableBackChannelLogout
 
-	return &oidc.DiscoveryConfiguration{
+	config := &oidc.DiscoveryConfiguration{
 		Issuer:                      issuer,
 		AuthorizationEndpoint:       s.Endpoints().Authorization.Absolute(issuer),
 		TokenEndpoint:               s.Endpoints().Token.Absolute(issuer),
@@ -203,6 +264,22 @@ func (s *Server) createDiscoveryConfig(ctx context.Context, supportedUILocales o
 		BackChannelLogoutSupported:                         backChannelLogoutSupported,
 		BackChannelLogoutSessionSupported:                  backChannelLogoutSupported,
 	}
+	if s.registrationEndpoint != nil {
+		config.RegistrationEndpoint = s.registrationEndpoint.Absolute(issuer)
+	}
+	discovery := &DiscoveryConfiguration{
+		DiscoveryConfiguration:        config,
+		DPoPSigningAlgValuesSupported: dpopSigningAlgValues(),
+	}
+	if s.pushedAuthRequestEndpoint != nil {
+		discovery.PushedAuthorizationRequestEndpoint = s.pushedAuthRequestEndpoint.Absolute(issuer)
+	}
+	if s.backChannelAuthEndpoint != nil {
+		config.GrantTypesSupported = append(config.GrantTypesSupported, GrantTypeCIBA)
+		discovery.BackChannelAuthenticationEndpoint = s.backChannelAuthEndpoint.Absolute(issuer)
+		discovery.BackChannelTokenDeliveryModesSupported = []string{backChannelTokenDeliveryModePoll, backChannelTokenDeliveryModePing}
+	}
+	return discovery
 }
 
 func response(resp any, err error) (*op.Response, error) {
@@ -15,8 +15,11 @@ import (
 
 func TestServer_createDiscoveryConfig(t *testing.T) {
 	type fields struct {
-		LegacyServer        *op.LegacyServer
-		signingKeyAlgorithm string
+		LegacyServer              *op.LegacyServer
+		signingKeyAlgorithm       string
+		pushedAuthRequestEndpoint *op.Endpoint
+		registrationEndpoint      *op.Endpoint
+		backChannelAuthEndpoint   *op.Endpoint
 	}
 	type args struct {
 		ctx                context.Context
@@ -26,7 +29,7 @@ func TestServer_createDiscoveryConfig(t *testing.T) {
 		name   string
 		fields fields
 		args   args
-		want   *oidc.DiscoveryConfiguration
+		want   *DiscoveryConfiguration
 	}{
 		{
 			"config",
@@ -57,58 +60,67 @@ func TestServer_createDiscoveryConfig(t *testing.T) {
 						DeviceAuthorization: op.NewEndpoint("device"),
 					},
 				),
-				signingKeyAlgorithm: "RS256",
+				signingKeyAlgorithm:       "RS256",
+				pushedAuthRequestEndpoint: op.NewEndpoint("par"),
+				registrationEndpoint:      op.NewEndpoint("register"),
+				backChannelAuthEndpoint:   op.NewEndpoint("bc-authorize"),
 			},
 			args{
 				ctx:                op.ContextWithIssuer(context.Background(), "https://issuer.com"),
 				supportedUILocales: []language.Tag{language.English, language.German},
 			},
-			&oidc.DiscoveryConfiguration{
-				Issuer:                                             "https://issuer.com",
-				AuthorizationEndpoint:                              "https://issuer.com/auth",
-				TokenEndpoint:                                      "https://issuer.com/token",
-				IntrospectionEndpoint:                              "https://issuer.com/introspect",
-				UserinfoEndpoint:                                   "https://issuer.com/userinfo",
-				RevocationEndpoint:                                 "https://issuer.com/revoke",
-				EndSessionEndpoint:                                 "https://issuer.com/logout",
-				DeviceAuthorizationEndpoint:                        "https://issuer.com/device",
-				CheckSessionIframe:                                 "",
-				JwksURI:                                            "https://issuer.com/keys",
-				RegistrationEndpoint:                               "",
-				ScopesSupported:                                    []string{oidc.ScopeOpenID, oidc.ScopeProfile, oidc.ScopeEmail, oidc.ScopePhone, oidc.ScopeAddress, oidc.ScopeOfflineAccess},
-				ResponseTypesSupported:                             []string{string(oidc.ResponseTypeCode), string(oidc.ResponseTypeIDTokenOnly), string(oidc.ResponseTypeIDToken)},
-				ResponseModesSupported:                             []string{string(oidc.ResponseModeQuery), string(oidc.ResponseModeFragment), string(oidc.ResponseModeFormPost)},
-				GrantTypesSupported:                                []oidc.GrantType{oidc.GrantTypeCode, oidc.GrantTypeImplicit, oidc.GrantTypeRefreshToken, oidc.GrantTypeBearer},
-				ACRValuesSupported:                                 nil,
-				SubjectTypesSupported:                              []string{"public"},
-				IDTokenSigningAlgValuesSupported:                   []string{"RS256"},
-				IDTokenEncryptionAlgValuesSupported:                nil,
-				IDTokenEncryptionEncValuesSupported:                nil,
-				UserinfoSigningAlgValuesSupported:                  nil,
-				UserinfoEncryptionAlgValuesSupported:               nil,
-				UserinfoEncryptionEncValuesSupported:               nil,
-				RequestObjectSigningAlgValuesSupported:             []string{"RS256"},
-				RequestObjectEncryptionAlgValuesSupported:          nil,
-				RequestObjectEncryptionEncValuesSupported:          nil,
-				TokenEndpointAuthMethodsSupported:                  []oidc.AuthMethod{oidc.AuthMethodNone, oidc.AuthMethodBasic, oidc.AuthMethodPost, oidc.AuthMethodPrivateKeyJWT},
-				TokenEndpointAuthSigningAlgValuesSupported:         []string{"RS256"},
-				RevocationEndpointAuthMethodsSupported:             []oidc.AuthMethod{oidc.AuthMethodNone, oidc.AuthMethodBasic, oidc.AuthMethodPost, oidc.AuthMethodPrivateKeyJWT},
-				RevocationEndpointAuthSigningAlgValuesSupported:    []string{"RS256"},
-				IntrospectionEndpointAuthMethodsSupported:          []oidc.AuthMethod{oidc.AuthMethodBasic, oidc.AuthMethodPrivateKeyJWT},
-				IntrospectionEndpointAuthSigningAlgValuesSupported: []string{"RS256"},
-				DisplayValuesSupported:                             nil,
-				ClaimTypesSupported:                                nil,
-				ClaimsSupported:                                    []string{"sub", "aud", "exp", "iat", "iss", "auth_time", "nonce", "acr", "amr", "c_hash", "at_hash", "act", "scopes", "client_id", "azp", "preferred_username", "name", "family_name", "given_name", "locale", "email", "email_verified", "phone_number", "phone_number_verified"},
-				ClaimsParameterSupported:                           false,
-				CodeChallengeMethodsSupported:                      []oidc.CodeChallengeMethod{"S256"},
-				ServiceDocumentation:                               "",
-				ClaimsLocalesSupported:                             nil,
-				UILocalesSupported:                                 []language.Tag{language.English, language.German},
-				RequestParameterSupported:                          true,
-				RequestURIParameterSupported:                       false,
-				RequireRequestURIRegistration:                      false,
-				OPPolicyURI:                                        "",
-				OPTermsOfServiceURI:                                "",
+			&DiscoveryConfiguration{
+				DiscoveryConfiguration: &oidc.DiscoveryConfiguration{
+					Issuer:                                             "https://issuer.com",
+					AuthorizationEndpoint:                              "https://issuer.com/auth",
+					TokenEndpoint:                                      "https://issuer.com/token",
+					IntrospectionEndpoint:                              "https://issuer.com/introspect",
+					UserinfoEndpoint:                                   "https://issuer.com/userinfo",
+					RevocationEndpoint:                                 "https://issuer.com/revoke",
+					EndSessionEndpoint:                                 "https://issuer.com/logout",
+					DeviceAuthorizationEndpoint:                        "https://issuer.com/device",
+					CheckSessionIframe:                                 "",
+					JwksURI:                                            "https://issuer.com/keys",
+					RegistrationEndpoint:                               "https://issuer.com/register",
+					ScopesSupported:                                    []string{oidc.ScopeOpenID, oidc.ScopeProfile, oidc.ScopeEmail, oidc.ScopePhone, oidc.ScopeAddress, oidc.ScopeOfflineAccess},
+					ResponseTypesSupported:                             []string{string(oidc.ResponseTypeCode), string(oidc.ResponseTypeIDTokenOnly), string(oidc.ResponseTypeIDToken)},
+					ResponseModesSupported:                             []string{string(oidc.ResponseModeQuery), string(oidc.ResponseModeFragment), string(oidc.ResponseModeFormPost)},
+					GrantTypesSupported:                                []oidc.GrantType{oidc.GrantTypeCode, oidc.GrantTypeImplicit, oidc.GrantTypeRefreshToken, oidc.GrantTypeBearer, GrantTypeCIBA},
+					ACRValuesSupported:                                 nil,
+					SubjectTypesSupported:                              []string{"public"},
+					IDTokenSigningAlgValuesSupported:                   []string{"RS256"},
+					IDTokenEncryptionAlgValuesSupported:                nil,
+					IDTokenEncryptionEncValuesSupported:                nil,
+					UserinfoSigningAlgValuesSupported:                  nil,
+					UserinfoEncryptionAlgValuesSupported:               nil,
+					UserinfoEncryptionEncValuesSupported:               nil,
+					RequestObjectSigningAlgValuesSupported:             []string{"RS256"},
+					RequestObjectEncryptionAlgValuesSupported:          nil,
+					RequestObjectEncryptionEncValuesSupported:          nil,
+					TokenEndpointAuthMethodsSupported:                  []oidc.AuthMethod{oidc.AuthMethodNone, oidc.AuthMethodBasic, oidc.AuthMethodPost, oidc.AuthMethodPrivateKeyJWT},
+					TokenEndpointAuthSigningAlgValuesSupported:         []string{"RS256"},
+					RevocationEndpointAuthMethodsSupported:             []oidc.AuthMethod{oidc.AuthMethodNone, oidc.AuthMethodBasic, oidc.AuthMethodPost, oidc.AuthMethodPrivateKeyJWT},
+					RevocationEndpointAuthSigningAlgValuesSupported:    []string{"RS256"},
+					IntrospectionEndpointAuthMethodsSupported:          []oidc.AuthMethod{oidc.AuthMethodBasic, oidc.AuthMethodPrivateKeyJWT},
+					IntrospectionEndpointAuthSigningAlgValuesSupported: []string{"RS256"},
+					DisplayValuesSupported:                             nil,
+					ClaimTypesSupported:                                nil,
+					ClaimsSupported:                                    []string{"sub", "aud", "exp", "iat", "iss", "auth_time", "nonce", "acr", "amr", "c_hash", "at_hash", "act", "scopes", "client_id", "azp", "preferred_username", "name", "family_name", "given_name", "locale", "email", "email_verified", "phone_number", "phone_number_verified"},
+					ClaimsParameterSupported:                           false,
+					CodeChallengeMethodsSupported:                      []oidc.CodeChallengeMethod{"S256"},
+					ServiceDocumentation:                               "",
+					ClaimsLocalesSupported:                             nil,
+					UILocalesSupported:                                 []language.Tag{language.English, language.German},
+					RequestParameterSupported:                          true,
+					RequestURIParameterSupported:                       false,
+					RequireRequestURIRegistration:                      false,
+					OPPolicyURI:                                        "",
+					OPTermsOfServiceURI:                                "",
+				},
+				PushedAuthorizationRequestEndpoint:     "https://issuer.com/par",
+				DPoPSigningAlgValuesSupported:          []string{"RS256", "RS384", "RS512", "PS256", "PS384", "PS512", "ES256", "ES384", "ES512", "EdDSA"},
+				BackChannelAuthenticationEndpoint:      "https://issuer.com/bc-authorize",
+				BackChannelTokenDeliveryModesSupported: []string{"poll", "ping"},
 			},
 		},
 		{
@@ -149,60 +161,66 @@ func TestServer_createDiscoveryConfig(t *testing.T) {
 				),
 				supportedUILocales: []language.Tag{language.English, language.German},
 			},
-			&oidc.DiscoveryConfiguration{
-				Issuer:                                             "https://issuer.com",
-				AuthorizationEndpoint:                              "https://issuer.com/auth",
-				TokenEndpoint:                                      "https://issuer.com/token",
-				IntrospectionEndpoint:                              "https://issuer.com/introspect",
-				UserinfoEndpoint:                                   "https://issuer.com/userinfo",
-				RevocationEndpoint:                                 "https://issuer.com/revoke",
-				EndSessionEndpoint:                                 "https://issuer.com/logout",
-				DeviceAuthorizationEndpoint:                        "https://issuer.com/device",
-				CheckSessionIframe:                                 "",
-				JwksURI:                                            "https://issuer.com/keys",
-				RegistrationEndpoint:                               "",
-				ScopesSupported:                                    []string{oidc.ScopeOpenID, oidc.ScopeProfile, oidc.ScopeEmail, oidc.ScopePhone, oidc.ScopeAddress, oidc.ScopeOfflineAccess},
-				ResponseTypesSupported:                             []string{string(oidc.ResponseTypeCode), string(oidc.ResponseTypeIDTokenOnly), string(oidc.ResponseTypeIDToken)},
-				ResponseModesSupported:                             []string{string(oidc.ResponseModeQuery), string(oidc.ResponseModeFragment), string(oidc.ResponseModeFormPost)},
-				GrantTypesSupported:                                []oidc.GrantType{oidc.GrantTypeCode, oidc.GrantTypeImplicit, oidc.GrantTypeRefreshToken, oidc.GrantTypeBearer},
-				ACRValuesSupported:                                 nil,
-				SubjectTypesSupported:                              []string{"public"},
-				IDTokenSigningAlgValuesSupported:                   supportedWebKeyAlgs,
-				IDTokenEncryptionAlgValuesSupported:                nil,
-				IDTokenEncryptionEncValuesSupported:                nil,
-				UserinfoSigningAlgValuesSupported:                  nil,
-				UserinfoEncryptionAlgValuesSupported:               nil,
-				UserinfoEncryptionEncValuesSupported:               nil,
-				RequestObjectSigningAlgValuesSupported:             []string{"RS256"},
-				RequestObjectEncryptionAlgValuesSupported:          nil,
-				RequestObjectEncryptionEncValuesSupported:          nil,
-				TokenEndpointAuthMethodsSupported:                  []oidc.AuthMethod{oidc.AuthMethodNone, oidc.AuthMethodBasic, oidc.AuthMethodPost, oidc.AuthMethodPrivateKeyJWT},
-				TokenEndpointAuthSigningAlgValuesSupported:         []string{"RS256"},
-				RevocationEndpointAuthMethodsSupported:             []oidc.AuthMethod{oidc.AuthMethodNone, oidc.AuthMethodBasic, oidc.AuthMethodPost, oidc.AuthMethodPrivateKeyJWT},
-				RevocationEndpointAuthSigningAlgValuesSupported:    []string{"RS256"},
-				IntrospectionEndpointAuthMethodsSupported:          []oidc.AuthMethod{oidc.AuthMethodBasic, oidc.AuthMethodPrivateKeyJWT},
-				IntrospectionEndpointAuthSigningAlgValuesSupported: []string{"RS256"},
-				DisplayValuesSupported:                             nil,
-				ClaimTypesSupported:                                nil,
-				ClaimsSupported:                                    []string{"sub", "aud", "exp", "iat", "iss", "auth_time", "nonce", "acr", "amr", "c_hash", "at_hash", "act", "scopes", "client_id", "azp", "preferred_username", "name", "family_name", "given_name", "locale", "email", "email_verified", "phone_number", "phone_number_verified"},
-				ClaimsParameterSupported:                           false,
-				CodeChallengeMethodsSupported:                      []oidc.CodeChallengeMethod{"S256"},
-				ServiceDocumentation:                               "",
-				ClaimsLocalesSupported:                             nil,
-				UILocalesSupported:                                 []language.Tag{language.English, language.German},
-				RequestParameterSupported:                          true,
-				RequestURIParameterSupported:                       false,
-				RequireRequestURIRegistration:                      false,
-				OPPolicyURI:                                        "",
-				OPTermsOfServiceURI:                                "",
+			&DiscoveryConfiguration{
+				DiscoveryConfiguration: &oidc.DiscoveryConfiguration{
+					Issuer:                                             "https://issuer.com",
+					AuthorizationEndpoint:                              "https://issuer.com/auth",
+					TokenEndpoint:                                      "https://issuer.com/token",
+					IntrospectionEndpoint:                              "https://issuer.com/introspect",
+					UserinfoEndpoint:                                   "https://issuer.com/userinfo",
+					RevocationEndpoint:                                 "https://issuer.com/revoke",
+					EndSessionEndpoint:                                 "https://issuer.com/logout",
+					DeviceAuthorizationEndpoint:                        "https://issuer.com/device",
+					CheckSessionIframe:                                 "",
+					JwksURI:                                            "https://issuer.com/keys",
+					RegistrationEndpoint:                               "",
+					ScopesSupported:                                    []string{oidc.ScopeOpenID, oidc.ScopeProfile, oidc.ScopeEmail, oidc.ScopePhone, oidc.ScopeAddress, oidc.ScopeOfflineAccess},
+					ResponseTypesSupported:                             []string{string(oidc.ResponseTypeCode), string(oidc.ResponseTypeIDTokenOnly), string(oidc.ResponseTypeIDToken)},
+					ResponseModesSupported:                             []string{string(oidc.ResponseModeQuery), string(oidc.ResponseModeFragment), string(oidc.ResponseModeFormPost)},
+					GrantTypesSupported:                                []oidc.GrantType{oidc.GrantTypeCode, oidc.GrantTypeImplicit, oidc.GrantTypeRefreshToken, oidc.GrantTypeBearer},
+					ACRValuesSupported:                                 nil,
+					SubjectTypesSupported:                              []string{"public"},
+					IDTokenSigningAlgValuesSupported:                   supportedWebKeyAlgs,
+					IDTokenEncryptionAlgValuesSupported:                nil,
+					IDTokenEncryptionEncValuesSupported:                nil,
+					UserinfoSigningAlgValuesSupported:                  nil,
+					UserinfoEncryptionAlgValuesSupported:               nil,
+					UserinfoEncryptionEncValuesSupported:               nil,
+					RequestObjectSigningAlgValuesSupported:             []string{"RS256"},
+					RequestObjectEncryptionAlgValuesSupported:          nil,
+					RequestObjectEncryptionEncValuesSupported:          nil,
+					TokenEndpointAuthMethodsSupported:                  []oidc.AuthMethod{oidc.AuthMethodNone, oidc.AuthMethodBasic, oidc.AuthMethodPost, oidc.AuthMethodPrivateKeyJWT},
+					TokenEndpointAuthSigningAlgValuesSupported:         []string{"RS256"},
+					RevocationEndpointAuthMethodsSupported:             []oidc.AuthMethod{oidc.AuthMethodNone, oidc.AuthMethodBasic, oidc.AuthMethodPost, oidc.AuthMethodPrivateKeyJWT},
+					RevocationEndpointAuthSigningAlgValuesSupported:    []string{"RS256"},
+					IntrospectionEndpointAuthMethodsSupported:          []oidc.AuthMethod{oidc.AuthMethodBasic, oidc.AuthMethodPrivateKeyJWT},
+					IntrospectionEndpointAuthSigningAlgValuesSupported: []string{"RS256"},
+					DisplayValuesSupported:                             nil,
+					ClaimTypesSupported:                                nil,
+					ClaimsSupported:                                    []string{"sub", "aud", "exp", "iat", "iss", "auth_time", "nonce", "acr", "amr", "c_hash", "at_hash", "act", "scopes", "client_id", "azp", "preferred_username", "name", "family_name", "given_name", "locale", "email", "email_verified", "phone_number", "phone_number_verified"},
+					ClaimsParameterSupported:                           false,
+					CodeChallengeMethodsSupported:                      []oidc.CodeChallengeMethod{"S256"},
+					ServiceDocumentation:                               "",
+					ClaimsLocalesSupported:                             nil,
+					UILocalesSupported:                                 []language.Tag{language.English, language.German},
+					RequestParameterSupported:                          true,
+					RequestURIParameterSupported:                       false,
+					RequireRequestURIRegistration:                      false,
+					OPPolicyURI:                                        "",
+					OPTermsOfServiceURI:                                "",
+				},
+				DPoPSigningAlgValuesSupported: []string{"RS256", "RS384", "RS512", "PS256", "PS384", "PS512", "ES256", "ES384", "ES512", "EdDSA"},
 			},
 		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			s := &Server{
-				LegacyServer:        tt.fields.LegacyServer,
-				signingKeyAlgorithm: tt.fields.signingKeyAlgorithm,
+				LegacyServer:              tt.fields.LegacyServer,
+				signingKeyAlgorithm:       tt.fields.signingKeyAlgorithm,
+				pushedAuthRequestEndpoint: tt.fields.pushedAuthRequestEndpoint,
+				registrationEndpoint:      tt.fields.registrationEndpoint,
+				backChannelAuthEndpoint:   tt.fields.backChannelAuthEndpoint,
 			}
 			assert.Equalf(t, tt.want, s.createDiscoveryConfig(tt.args.ctx, tt.args.supportedUILocales), "createDiscoveryConfig(%v)", tt.args.ctx)
 		})
@@ -3,6 +3,7 @@ package oidc
 import (
 	"context"
 	"encoding/base64"
+	"maps"
 	"slices"
 	"sync"
 	"time"
@@ -36,7 +37,7 @@ func (s *Server) accessTokenResponseFromSession(ctx context.Context, client op.C
 	getSigner := s.getSignerOnce()
 
 	resp := &oidc.AccessTokenResponse{
-		TokenType:    oidc.BearerToken,
+		TokenType:    tokenType(session.DPoPJKT),
 		RefreshToken: session.RefreshToken,
 		ExpiresIn:    timeToOIDCExpiresIn(session.Expiration),
 		State:        state,
@@ -202,6 +203,19 @@ func (s *Server) createJWT(ctx context.Context, client op.Client, session *comma
 	)
 	claims.Actor = actorDomainToClaims(session.Actor)
 	claims.Claims = userInfo.Claims
+	if session.DPoPJKT != "" || len(session.AuthorizationDetails) > 0 {
+		// copy the claims, so the session specific claims are not added to the userinfo used for other tokens
+		claims.Claims = maps.Clone(userInfo.Claims)
+		if claims.Claims == nil {
+			claims.Claims = make(map[string]any, 2)
+		}
+	}
+	if session.DPoPJKT != "" {
+		claims.Claims[dpopConfirmationClaim] = dpopConfirmation(session.DPoPJKT)
+	}
+	if len(session.AuthorizationDetails) > 0 {
+		claims.Claims[authorizationDetailsClaim] = session.AuthorizationDetails
+	}
 
 	return crypto.Sign(claims, signer)
 }
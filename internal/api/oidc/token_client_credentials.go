@@ -31,6 +31,10 @@ func (s *Server) ClientCredentialsExchange(ctx context.Context, r *op.ClientRequ
 		return nil, err
 	}
 
+	dpopJKT, err := s.dpopKeyThumbprint(ctx, r.Header, client)
+	if err != nil {
+		return nil, err
+	}
 	session, err := s.command.CreateOIDCSession(ctx,
 		client.userID,
 		client.resourceOwner,
@@ -48,6 +52,8 @@ func (s *Server) ClientCredentialsExchange(ctx context.Context, r *op.ClientRequ
 		false,
 		"",
 		domain.OIDCResponseTypeUnspecified,
+		dpopJKT,
+		nil,
 	)
 	if err != nil {
 		return nil, err
@@ -27,6 +27,10 @@ func (s *Server) CodeExchange(ctx context.Context, r *op.ClientRequest[oidc.Acce
 		return nil, zerrors.ThrowInternal(nil, "OIDC-Ae2ph", "Error.Internal")
 	}
 
+	dpopJKT, err := s.dpopKeyThumbprint(ctx, r.Header, client)
+	if err != nil {
+		return nil, err
+	}
 	plainCode, err := s.decryptCode(ctx, r.Data.Code)
 	if err != nil {
 		return nil, zerrors.ThrowInvalidArgument(err, "OIDC-ahLi2", "Errors.User.Code.Invalid")
@@ -42,9 +46,10 @@ func (s *Server) CodeExchange(ctx context.Context, r *op.ClientRequest[oidc.Acce
 			codeExchangeComplianceChecker(client, r.Data),
 			slices.Contains(client.GrantTypes(), oidc.GrantTypeRefreshToken),
 			client.client.BackChannelLogoutURI,
+			dpopJKT,
 		)
 	} else {
-		session, err = s.codeExchangeV1(ctx, client, r.Data, r.Data.Code)
+		session, err = s.codeExchangeV1(ctx, client, r.Data, r.Data.Code, dpopJKT)
 	}
 	if err != nil {
 		return nil, err
@@ -53,7 +58,7 @@ func (s *Server) CodeExchange(ctx context.Context, r *op.ClientRequest[oidc.Acce
 }
 
 // codeExchangeV1 creates a v2 token from a v1 auth request.
-func (s *Server) codeExchangeV1(ctx context.Context, client *Client, req *oidc.AccessTokenRequest, code string) (session *command.OIDCSession, err error) {
+func (s *Server) codeExchangeV1(ctx context.Context, client *Client, req *oidc.AccessTokenRequest, code, dpopJKT string) (session *command.OIDCSession, err error) {
 	ctx, span := tracing.NewSpan(ctx)
 	defer func() { span.EndWithError(err) }()
 
@@ -89,6 +94,8 @@ func (s *Server) codeExchangeV1(ctx context.Context, client *Client, req *oidc.A
 		slices.Contains(scope, oidc.ScopeOfflineAccess),
 		authReq.SessionID,
 		authReq.oidc().ResponseType,
+		dpopJKT,
+		authReq.oidc().AuthorizationDetails,
 	)
 	if err != nil {
 		return nil, err
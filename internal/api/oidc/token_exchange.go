@@ -2,6 +2,7 @@ package oidc
 
 import (
 	"context"
+	"net/url"
 	"slices"
 	"time"
 
@@ -52,6 +53,9 @@ func (s *Server) tokenExchange(ctx context.Context, r *op.ClientRequest[oidc.Tok
 		// not supposed to happen, but just preventing a panic if it does.
 		return nil, zerrors.ThrowInternal(nil, "OIDC-eShi5", "Error.Internal")
 	}
+	if err = dpopNotSupported(client); err != nil {
+		return nil, err
+	}
 
 	subjectToken, err := s.verifyExchangeToken(ctx, client, r.Data.SubjectToken, r.Data.SubjectTokenType, oidc.AllTokenTypes...)
 	if err != nil {
@@ -81,8 +85,12 @@ func (s *Server) tokenExchange(ctx context.Context, r *op.ClientRequest[oidc.Tok
 	if err != nil {
 		return nil, err
 	}
+	authorizationDetails, err := s.validateTokenExchangeAuthorizationDetails(ctx, client, r.Form, subjectToken.authorizationDetails)
+	if err != nil {
+		return nil, err
+	}
 
-	resp, err := s.createExchangeTokens(ctx, r.Data.RequestedTokenType, client, subjectToken, actorToken, audience, scopes)
+	resp, err := s.createExchangeTokens(ctx, r.Data.RequestedTokenType, client, subjectToken, actorToken, audience, scopes, authorizationDetails)
 	if err != nil {
 		return nil, err
 	}
@@ -212,12 +220,29 @@ func validateTokenExchangeAudience(requestedAudience, subjectAudience, actorAudi
 	return requestedAudience, nil
 }
 
+// validateTokenExchangeAuthorizationDetails returns the authorization details of the subject token, if none are requested.
+// Requested authorization details must be valid for the client and already granted to the subject token,
+// so the exchanged token can only be restricted.
+func (s *Server) validateTokenExchangeAuthorizationDetails(ctx context.Context, client *Client, form url.Values, subjectDetails domain.AuthorizationDetails) (domain.AuthorizationDetails, error) {
+	requested, err := s.requestedAuthorizationDetails(ctx, client, form)
+	if err != nil {
+		return nil, err
+	}
+	if len(requested) == 0 {
+		return subjectDetails, nil
+	}
+	if !requested.IsSubsetOf(subjectDetails) {
+		return nil, invalidAuthorizationDetailsError(ctx, zerrors.ThrowInvalidArgument(nil, "OIDC-Rar1x", "Errors.AuthorizationDetails.NotGranted"))
+	}
+	return requested, nil
+}
+
 // createExchangeTokens prepares the final tokens to be returned to the client.
 // The subjectToken is used to set the new token's subject and resource owner.
 // The actorToken is used to set the new token's auth time AMR and actor.
 // Both tokens may point to the same object (subjectToken) in case of a regular Token Exchange.
 // When the subject and actor Tokens point to different objects, the new tokens will be for impersonation / delegation.
-func (s *Server) createExchangeTokens(ctx context.Context, tokenType oidc.TokenType, client *Client, subjectToken, actorToken *exchangeToken, audience, scopes []string) (_ *oidc.TokenExchangeResponse, err error) {
+func (s *Server) createExchangeTokens(ctx context.Context, tokenType oidc.TokenType, client *Client, subjectToken, actorToken *exchangeToken, audience, scopes []string, authorizationDetails domain.AuthorizationDetails) (_ *oidc.TokenExchangeResponse, err error) {
 	getUserInfo := s.getUserInfo(subjectToken.userID, client.client.ProjectID, client.client.ProjectRoleAssertion, client.IDTokenUserinfoClaimsAssertion(), scopes)
 	getSigner := s.getSignerOnce()
 
@@ -235,12 +260,12 @@ func (s *Server) createExchangeTokens(ctx context.Context, tokenType oidc.TokenT
 	var sessionID string
 	switch tokenType {
 	case oidc.AccessTokenType, "":
-		resp.AccessToken, resp.RefreshToken, sessionID, resp.ExpiresIn, err = s.createExchangeAccessToken(ctx, client, subjectToken.userID, subjectToken.resourceOwner, audience, scopes, actorToken.authMethods, actorToken.authTime, subjectToken.preferredLanguage, reason, actor)
+		resp.AccessToken, resp.RefreshToken, sessionID, resp.ExpiresIn, err = s.createExchangeAccessToken(ctx, client, subjectToken.userID, subjectToken.resourceOwner, audience, scopes, actorToken.authMethods, actorToken.authTime, subjectToken.preferredLanguage, reason, actor, authorizationDetails)
 		resp.TokenType = oidc.BearerToken
 		resp.IssuedTokenType = oidc.AccessTokenType
 
 	case oidc.JWTTokenType:
-		resp.AccessToken, resp.RefreshToken, resp.ExpiresIn, err = s.createExchangeJWT(ctx, client, getUserInfo, client.client.AccessTokenRoleAssertion, getSigner, subjectToken.userID, subjectToken.resourceOwner, audience, scopes, actorToken.authMethods, actorToken.authTime, subjectToken.preferredLanguage, reason, actor)
+		resp.AccessToken, resp.RefreshToken, resp.ExpiresIn, err = s.createExchangeJWT(ctx, client, getUserInfo, client.client.AccessTokenRoleAssertion, getSigner, subjectToken.userID, subjectToken.resourceOwner, audience, scopes, actorToken.authMethods, actorToken.authTime, subjectToken.preferredLanguage, reason, actor, authorizationDetails)
 		resp.TokenType = oidc.BearerToken
 		resp.IssuedTokenType = oidc.JWTTokenType
 
@@ -280,6 +305,7 @@ func (s *Server) createExchangeAccessToken(
 	preferredLanguage *language.Tag,
 	reason domain.TokenReason,
 	actor *domain.TokenActor,
+	authorizationDetails domain.AuthorizationDetails,
 ) (accessToken, refreshToken, sessionID string, exp uint64, err error) {
 	ctx, span := tracing.NewSpan(ctx)
 	defer func() { span.EndWithError(err) }()
@@ -301,6 +327,8 @@ func (s *Server) createExchangeAccessToken(
 		slices.Contains(scope, oidc.ScopeOfflineAccess),
 		"",
 		domain.OIDCResponseTypeUnspecified,
+		"", // DPoP bound tokens are not supported for token exchange
+		authorizationDetails,
 	)
 	if err != nil {
 		return "", "", "", 0, err
@@ -327,6 +355,7 @@ func (s *Server) createExchangeJWT(
 	preferredLanguage *language.Tag,
 	reason domain.TokenReason,
 	actor *domain.TokenActor,
+	authorizationDetails domain.AuthorizationDetails,
 ) (accessToken string, refreshToken string, exp uint64, err error) {
 	ctx, span := tracing.NewSpan(ctx)
 	defer func() { span.EndWithError(err) }()
@@ -348,6 +377,8 @@ func (s *Server) createExchangeJWT(
 		slices.Contains(scope, oidc.ScopeOfflineAccess),
 		"",
 		domain.OIDCResponseTypeUnspecified,
+		"", // DPoP bound tokens are not supported for token exchange
+		authorizationDetails,
 	)
 	if err != nil {
 		return "", "", 0, err
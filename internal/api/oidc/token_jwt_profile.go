@@ -41,6 +41,10 @@ func (s *Server) JWTProfile(ctx context.Context, r *op.Request[oidc.JWTProfileGr
 		return nil, err
 	}
 
+	dpopJKT, err := s.dpopKeyThumbprint(ctx, r.Header, client)
+	if err != nil {
+		return nil, err
+	}
 	session, err := s.command.CreateOIDCSession(ctx,
 		client.userID,
 		client.resourceOwner,
@@ -58,6 +62,8 @@ func (s *Server) JWTProfile(ctx context.Context, r *op.Request[oidc.JWTProfileGr
 		false,
 		"",
 		domain.OIDCResponseTypeUnspecified,
+		dpopJKT,
+		nil,
 	)
 	if err != nil {
 		return nil, err
@@ -26,12 +26,16 @@ func (s *Server) RefreshToken(ctx context.Context, r *op.ClientRequest[oidc.Refr
 		return nil, zerrors.ThrowInternal(nil, "OIDC-ga0EP", "Error.Internal")
 	}
 
-	session, err := s.command.ExchangeOIDCSessionRefreshAndAccessToken(ctx, r.Data.RefreshToken, r.Data.Scopes, refreshTokenComplianceChecker())
+	dpopJKT, err := s.dpopKeyThumbprint(ctx, r.Header, client)
+	if err != nil {
+		return nil, err
+	}
+	session, err := s.command.ExchangeOIDCSessionRefreshAndAccessToken(ctx, r.Data.RefreshToken, r.Data.Scopes, refreshTokenComplianceChecker(dpopJKT))
 	if err == nil {
 		return response(s.accessTokenResponseFromSession(ctx, client, session, "", client.client.ProjectID, client.client.ProjectRoleAssertion, client.client.AccessTokenRoleAssertion, client.client.IDTokenRoleAssertion, client.client.IDTokenUserinfoAssertion))
 	} else if errors.Is(err, zerrors.ThrowPreconditionFailed(nil, "OIDCS-JOI23", "Errors.OIDCSession.RefreshTokenInvalid")) {
 		// We try again for v1 tokens when we encountered specific parsing error
-		return s.refreshTokenV1(ctx, client, r)
+		return s.refreshTokenV1(ctx, client, r, dpopJKT)
 	}
 	return nil, err
 }
@@ -41,7 +45,7 @@ func (s *Server) RefreshToken(ctx context.Context, r *op.ClientRequest[oidc.Refr
 // This "upgrades" existing v1 sessions to v2 session without requiring users to re-login.
 //
 // This function can be removed when we retire the v1 token repo.
-func (s *Server) refreshTokenV1(ctx context.Context, client *Client, r *op.ClientRequest[oidc.RefreshTokenRequest]) (_ *op.Response, err error) {
+func (s *Server) refreshTokenV1(ctx context.Context, client *Client, r *op.ClientRequest[oidc.RefreshTokenRequest], dpopJKT string) (_ *op.Response, err error) {
 	refreshToken, err := s.repo.RefreshTokenByToken(ctx, r.Data.RefreshToken)
 	if err != nil {
 		return nil, err
@@ -70,6 +74,8 @@ func (s *Server) refreshTokenV1(ctx context.Context, client *Client, r *op.Clien
 		true,
 		"",
 		domain.OIDCResponseTypeUnspecified,
+		dpopJKT,
+		nil, // authorization details are not supported by v1 refresh tokens
 	)
 	if err != nil {
 		return nil, err
@@ -85,8 +91,12 @@ func (s *Server) refreshTokenV1(ctx context.Context, client *Client, r *op.Clien
 }
 
 // refreshTokenComplianceChecker validates that the requested scope is a subset of the original auth request scope.
-func refreshTokenComplianceChecker() command.RefreshTokenComplianceChecker {
+// If the session is bound to a DPoP key, the refresh token must be presented with a proof of the same key (RFC 9449, section 5).
+func refreshTokenComplianceChecker(dpopJKT string) command.RefreshTokenComplianceChecker {
 	return func(_ context.Context, model *command.OIDCSessionWriteModel, requestedScope []string) ([]string, error) {
+		if model.DPoPJKT != "" && model.DPoPJKT != dpopJKT {
+			return nil, errInvalidDPoPProof().WithDescription("the refresh token is bound to another DPoP key")
+		}
 		return validateRefreshTokenScopes(model.Scope, requestedScope)
 	}
 }
@@ -28,6 +28,27 @@ var AuthMapping = authz.MethodMapping{
 	"DELETE:/scim/v2/" + http.OrgIdInPathVariable + "/Users/{id}": {
 		Permission: domain.PermissionUserDelete,
 	},
+	"POST:/scim/v2/" + http.OrgIdInPathVariable + "/Groups": {
+		Permission: domain.PermissionProjectWrite,
+	},
+	"POST:/scim/v2/" + http.OrgIdInPathVariable + "/Groups/.search": {
+		Permission: domain.PermissionProjectRead,
+	},
+	"GET:/scim/v2/" + http.OrgIdInPathVariable + "/Groups": {
+		Permission: domain.PermissionProjectRead,
+	},
+	"GET:/scim/v2/" + http.OrgIdInPathVariable + "/Groups/{id}": {
+		Permission: domain.PermissionProjectRead,
+	},
+	"PUT:/scim/v2/" + http.OrgIdInPathVariable + "/Groups/{id}": {
+		Permission: domain.PermissionProjectWrite,
+	},
+	"PATCH:/scim/v2/" + http.OrgIdInPathVariable + "/Groups/{id}": {
+		Permission: domain.PermissionProjectWrite,
+	},
+	"DELETE:/scim/v2/" + http.OrgIdInPathVariable + "/Groups/{id}": {
+		Permission: domain.PermissionProjectDelete,
+	},
 	"POST:/scim/v2/" + http.OrgIdInPathVariable + "/Bulk": {
 		Permission: "authenticated",
 	},
@@ -0,0 +1,193 @@
+//go:build integration
+
+package integration_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+
+	"github.com/zitadel/zitadel/internal/api/scim/resources"
+	"github.com/zitadel/zitadel/internal/api/scim/schemas"
+	"github.com/zitadel/zitadel/internal/integration"
+	"github.com/zitadel/zitadel/internal/integration/scim"
+	"github.com/zitadel/zitadel/pkg/grpc/management"
+)
+
+func TestCreateGroup(t *testing.T) {
+	member := Instance.CreateHumanUser(CTX)
+	tests := []struct {
+		name        string
+		ctx         context.Context
+		orgID       string
+		body        []byte
+		wantErr     bool
+		errorStatus int
+	}{
+		{
+			name:        "not authenticated",
+			ctx:         context.Background(),
+			body:        groupJson(gofakeit.AppName()),
+			wantErr:     true,
+			errorStatus: http.StatusUnauthorized,
+		},
+		{
+			name:        "no permissions",
+			ctx:         Instance.WithAuthorization(CTX, integration.UserTypeNoPermission),
+			body:        groupJson(gofakeit.AppName()),
+			wantErr:     true,
+			errorStatus: http.StatusNotFound,
+		},
+		{
+			name:        "missing display name",
+			body:        groupJson(""),
+			wantErr:     true,
+			errorStatus: http.StatusBadRequest,
+		},
+		{
+			name:        "unknown member",
+			body:        groupJson(gofakeit.AppName(), "foobar"),
+			wantErr:     true,
+			errorStatus: http.StatusBadRequest,
+		},
+		{
+			name: "without members",
+			body: groupJson(gofakeit.AppName()),
+		},
+		{
+			name: "with member",
+			body: groupJson(gofakeit.AppName(), member.GetUserId()),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := tt.ctx
+			if ctx == nil {
+				ctx = CTX
+			}
+
+			orgID := tt.orgID
+			if orgID == "" {
+				orgID = Instance.DefaultOrg.Id
+			}
+
+			createdGroup, err := Instance.Client.SCIM.Groups.Create(ctx, orgID, tt.body)
+			if tt.wantErr {
+				statusCode := tt.errorStatus
+				if statusCode == 0 {
+					statusCode = http.StatusBadRequest
+				}
+				scim.RequireScimError(t, statusCode, err)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.NotEmpty(t, createdGroup.ID)
+			assert.EqualValues(t, []schemas.ScimSchemaType{schemas.IdGroup}, createdGroup.Resource.Schemas)
+			assert.Equal(t, schemas.GroupResourceType, createdGroup.Resource.Meta.ResourceType)
+
+			retryDuration, tick := integration.WaitForAndTickWithMaxDuration(CTX, time.Minute)
+			require.EventuallyWithT(t, func(ttt *assert.CollectT) {
+				fetchedGroup, err := Instance.Client.SCIM.Groups.Get(CTX, orgID, createdGroup.ID)
+				require.NoError(ttt, err)
+				assert.Equal(ttt, createdGroup.DisplayName, fetchedGroup.DisplayName)
+				assert.Equal(ttt, memberIDs(createdGroup.Members), memberIDs(fetchedGroup.Members))
+			}, retryDuration, tick)
+
+			err = Instance.Client.SCIM.Groups.Delete(CTX, orgID, createdGroup.ID)
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestUpdateGroup_members(t *testing.T) {
+	member1 := Instance.CreateHumanUser(CTX)
+	member2 := Instance.CreateHumanUser(CTX)
+	group, err := Instance.Client.SCIM.Groups.Create(CTX, Instance.DefaultOrg.Id, groupJson(gofakeit.AppName(), member1.GetUserId()))
+	require.NoError(t, err)
+
+	defer func() {
+		err = Instance.Client.SCIM.Groups.Delete(CTX, Instance.DefaultOrg.Id, group.ID)
+		require.NoError(t, err)
+	}()
+
+	// add the second member, remove the first one
+	err = Instance.Client.SCIM.Groups.Update(CTX, Instance.DefaultOrg.Id, group.ID, []byte(fmt.Sprintf(`{
+		"schemas": ["urn:ietf:params:scim:api:messages:2.0:PatchOp"],
+		"Operations": [
+			{
+				"op": "add",
+				"path": "members",
+				"value": [{"value": "%s"}]
+			},
+			{
+				"op": "remove",
+				"path": "members[value eq \"%s\"]"
+			}
+		]
+	}`, member2.GetUserId(), member1.GetUserId())))
+	require.NoError(t, err)
+
+	retryDuration, tick := integration.WaitForAndTickWithMaxDuration(CTX, time.Minute)
+	require.EventuallyWithT(t, func(tt *assert.CollectT) {
+		fetchedGroup, err := Instance.Client.SCIM.Groups.Get(CTX, Instance.DefaultOrg.Id, group.ID)
+		require.NoError(tt, err)
+		assert.Equal(tt, []string{member2.GetUserId()}, memberIDs(fetchedGroup.Members))
+
+		filter := fmt.Sprintf(`members eq "%s"`, member2.GetUserId())
+		groups, err := Instance.Client.SCIM.Groups.List(CTX, Instance.DefaultOrg.Id, &scim.ListRequest{Filter: &filter})
+		require.NoError(tt, err)
+		require.Len(tt, groups.Resources, 1)
+		assert.Equal(tt, group.ID, groups.Resources[0].ID)
+	}, retryDuration, tick)
+}
+
+func TestDeleteGroup_ensureReallyDeleted(t *testing.T) {
+	member := Instance.CreateHumanUser(CTX)
+	group, err := Instance.Client.SCIM.Groups.Create(CTX, Instance.DefaultOrg.Id, groupJson(gofakeit.AppName(), member.GetUserId()))
+	require.NoError(t, err)
+
+	err = Instance.Client.SCIM.Groups.Delete(CTX, Instance.DefaultOrg.Id, group.ID)
+	require.NoError(t, err)
+
+	// ensure it is really deleted => try to delete again => should 404
+	err = Instance.Client.SCIM.Groups.Delete(CTX, Instance.DefaultOrg.Id, group.ID)
+	scim.RequireScimError(t, http.StatusNotFound, err)
+
+	retryDuration, tick := integration.WaitForAndTickWithMaxDuration(CTX, time.Minute)
+	require.EventuallyWithT(t, func(tt *assert.CollectT) {
+		_, err = Instance.Client.Mgmt.GetProjectByID(CTX, &management.GetProjectByIDRequest{Id: group.ID})
+		integration.AssertGrpcStatus(tt, codes.NotFound, err)
+	}, retryDuration, tick)
+}
+
+func groupJson(displayName string, memberIDs ...string) []byte {
+	members := ""
+	for i, id := range memberIDs {
+		if i > 0 {
+			members += ","
+		}
+		members += fmt.Sprintf(`{"value": "%s"}`, id)
+	}
+
+	return []byte(fmt.Sprintf(`{
+		"schemas": ["urn:ietf:params:scim:schemas:core:2.0:Group"],
+		"displayName": "%s",
+		"members": [%s]
+	}`, displayName, members))
+}
+
+func memberIDs(members []*resources.ScimGroupMember) []string {
+	ids := make([]string, len(members))
+	for i, member := range members {
+		ids[i] = member.Value
+	}
+	return ids
+}
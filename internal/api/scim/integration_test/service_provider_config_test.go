@@ -25,8 +25,14 @@ var (
 	//go:embed testdata/service_provider_config_expected_resource_type_user.json
 	expectedResourceTypeUserJson []byte
 
+	//go:embed testdata/service_provider_config_expected_resource_type_group.json
+	expectedResourceTypeGroupJson []byte
+
 	//go:embed testdata/service_provider_config_expected_user_schema.json
 	expectedUserSchemaJson []byte
+
+	//go:embed testdata/service_provider_config_expected_group_schema.json
+	expectedGroupSchemaJson []byte
 )
 
 func TestServiceProviderConfig(t *testing.T) {
@@ -53,6 +59,11 @@ func TestResourceType(t *testing.T) {
 			resourceName: "User",
 			want:         expectedResourceTypeUserJson,
 		},
+		{
+			name:         "group",
+			resourceName: "Group",
+			want:         expectedResourceTypeGroupJson,
+		},
 		{
 			name:         "not found",
 			resourceName: "foobar",
@@ -91,6 +102,11 @@ func TestSchema(t *testing.T) {
 			id:   "urn:ietf:params:scim:schemas:core:2.0:User",
 			want: expectedUserSchemaJson,
 		},
+		{
+			name: "group",
+			id:   "urn:ietf:params:scim:schemas:core:2.0:Group",
+			want: expectedGroupSchemaJson,
+		},
 		{
 			name:    "not found",
 			id:      "foobar",
@@ -115,8 +131,8 @@ func assertJsonEqual(t *testing.T, expected, actual []byte) {
 	t.Helper()
 
 	// replace dynamic data json
-	expectedJson := strings.Replace(string(expected), "{domain}", Instance.Domain, 1)
-	expectedJson = strings.Replace(expectedJson, "{orgId}", Instance.DefaultOrg.Id, 1)
+	expectedJson := strings.ReplaceAll(string(expected), "{domain}", Instance.Domain)
+	expectedJson = strings.ReplaceAll(expectedJson, "{orgId}", Instance.DefaultOrg.Id)
 	assert.Equal(t, normalizeJson(t, []byte(expectedJson)), normalizeJson(t, actual))
 }
 
@@ -344,3 +344,24 @@ func TestReplaceUser_scopedExternalID(t *testing.T) {
 
 	removeProvisioningDomain(t, Instance.Users.Get(integration.UserTypeOrgOwner).ID)
 }
+
+func TestReplaceUser_ifMatch(t *testing.T) {
+	createdUser, err := Instance.Client.SCIM.Users.Create(CTX, Instance.DefaultOrg.Id, fullUserJson)
+	require.NoError(t, err)
+
+	// outdated version
+	_, err = Instance.Client.SCIM.Users.ReplaceIfMatch(CTX, Instance.DefaultOrg.Id, createdUser.ID, `W/"0"`, minimalUserJson)
+	scim.RequireScimError(t, http.StatusPreconditionFailed, err)
+
+	retryDuration, tick := integration.WaitForAndTickWithMaxDuration(CTX, time.Minute)
+	require.EventuallyWithT(t, func(tt *assert.CollectT) {
+		fetchedUser, err := Instance.Client.SCIM.Users.Get(CTX, Instance.DefaultOrg.Id, createdUser.ID)
+		require.NoError(tt, err)
+
+		_, err = Instance.Client.SCIM.Users.ReplaceIfMatch(CTX, Instance.DefaultOrg.Id, createdUser.ID, fetchedUser.Resource.Meta.Version, minimalUserJson)
+		require.NoError(tt, err)
+	}, retryDuration, tick)
+
+	_, err = Instance.Client.UserV2.DeleteUser(CTX, &user.DeleteUserRequest{UserId: createdUser.ID})
+	require.NoError(t, err)
+}
@@ -30,6 +30,8 @@ const (
 	KeyAddresses                Key = KeyPrefix + "addresses"
 	KeyEntitlements             Key = KeyPrefix + "entitlements"
 	KeyRoles                    Key = KeyPrefix + "roles"
+	KeyEmailType                Key = KeyPrefix + "emails.type"
+	KeyPhoneNumberType          Key = KeyPrefix + "phoneNumbers.type"
 )
 
 var (
@@ -47,6 +49,8 @@ var (
 		KeyAddresses,
 		KeyEntitlements,
 		KeyRoles,
+		KeyEmailType,
+		KeyPhoneNumberType,
 	}
 
 	AttributePathToMetadataKeys = map[string][]Key{
@@ -64,6 +68,10 @@ var (
 		"addresses":            {KeyAddresses},
 		"entitlements":         {KeyEntitlements},
 		"roles":                {KeyRoles},
+		"emails":               {KeyEmailType},
+		"emails.type":          {KeyEmailType},
+		"phonenumbers":         {KeyPhoneNumberType},
+		"phonenumbers.type":    {KeyPhoneNumberType},
 	}
 )
 
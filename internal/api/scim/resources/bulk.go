@@ -31,10 +31,11 @@ type BulkRequest struct {
 }
 
 type BulkRequestOperation struct {
-	Method string          `json:"method"`
-	BulkID string          `json:"bulkId"`
-	Path   string          `json:"path"`
-	Data   json.RawMessage `json:"data"`
+	Method  string          `json:"method"`
+	BulkID  string          `json:"bulkId"`
+	Path    string          `json:"path"`
+	Version string          `json:"version"`
+	Data    json.RawMessage `json:"data"`
 }
 
 type BulkResponse struct {
@@ -161,6 +162,14 @@ func (h *BulkHandler) processOperation(ctx context.Context, op *BulkRequestOpera
 		return opResp
 	}
 
+	if op.Method != http.MethodPost {
+		// the version of an operation is handled the same way as an If-Match header
+		ctx, err = resourceHandler.CheckPreconditions(ctx, resourceID, NewIfMatchPreconditions(op.Version))
+		if err != nil {
+			return opResp
+		}
+	}
+
 	switch op.Method {
 	case http.MethodPatch:
 		statusCode = http.StatusNoContent
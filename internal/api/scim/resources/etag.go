@@ -0,0 +1,152 @@
+package resources
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"strings"
+
+	zhttp "github.com/zitadel/zitadel/internal/api/http"
+	"github.com/zitadel/zitadel/internal/api/scim/serrors"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+const etagWildcard = "*"
+
+// Preconditions holds the entity tags of the conditional request headers
+// If-Match and If-None-Match (RFC 7232, RFC 7644 section 3.14).
+type Preconditions struct {
+	IfMatch     []string
+	IfNoneMatch []string
+}
+
+// buildETag builds the weak entity tag of a resource based on its sequence.
+// The entity tag is used as meta.version of the resource.
+func buildETag(sequence uint64) string {
+	return `W/"` + strconv.FormatUint(sequence, 10) + `"`
+}
+
+// parseETagSequence returns the sequence of an entity tag built by [buildETag].
+func parseETagSequence(etag string) (uint64, bool) {
+	etag = strings.TrimPrefix(etag, "W/")
+	if len(etag) < 2 || etag[0] != '"' || etag[len(etag)-1] != '"' {
+		return 0, false
+	}
+	sequence, err := strconv.ParseUint(etag[1:len(etag)-1], 10, 64)
+	return sequence, err == nil
+}
+
+type expectedVersionCtxKey struct{}
+
+// withExpectedVersion stores the version of the resource the If-Match precondition was verified against.
+// The handlers pass it to the commands, which reject the change if the resource was modified in the meantime.
+func withExpectedVersion(ctx context.Context, version string) context.Context {
+	return context.WithValue(ctx, expectedVersionCtxKey{}, version)
+}
+
+// expectedVersion returns the version stored by [withExpectedVersion].
+func expectedVersion(ctx context.Context) (string, bool) {
+	version, ok := ctx.Value(expectedVersionCtxKey{}).(string)
+	return version, ok
+}
+
+type triggerProjectionsCtxKey struct{}
+
+// withTriggeredProjections marks the resource to be read for the check of the preconditions.
+// The handlers trigger the projections before reading it,
+// so the preconditions aren't checked against an outdated version of the resource.
+func withTriggeredProjections(ctx context.Context) context.Context {
+	return context.WithValue(ctx, triggerProjectionsCtxKey{}, true)
+}
+
+// shouldTriggerProjections returns true if the context was marked by [withTriggeredProjections].
+func shouldTriggerProjections(ctx context.Context) bool {
+	trigger, _ := ctx.Value(triggerProjectionsCtxKey{}).(bool)
+	return trigger
+}
+
+// checkExpectedVersion verifies that the resource still has the version
+// the If-Match precondition was verified against.
+func checkExpectedVersion(ctx context.Context, version string) error {
+	if expected, ok := expectedVersion(ctx); ok && expected != version {
+		return serrors.ThrowPreconditionFailed(zerrors.ThrowPreconditionFailed(nil, "SCIM-ETG3", "The resource was modified in the meantime"))
+	}
+	return nil
+}
+
+// mapConcurrentModification maps the rejection of a change to a resource
+// which was modified after its version was verified to a precondition failed error.
+func mapConcurrentModification(err error) error {
+	if eventstore.IsConcurrentModification(err) {
+		return serrors.ThrowPreconditionFailed(err)
+	}
+	return err
+}
+
+// PreconditionsFromHeader reads the conditional request headers.
+func PreconditionsFromHeader(header http.Header) *Preconditions {
+	return &Preconditions{
+		IfMatch:     parseETags(header.Values(zhttp.IfMatch)),
+		IfNoneMatch: parseETags(header.Values(zhttp.IfNoneMatch)),
+	}
+}
+
+// NewIfMatchPreconditions creates preconditions requiring the resource to be in the provided version,
+// an empty version results in no preconditions.
+func NewIfMatchPreconditions(version string) *Preconditions {
+	return &Preconditions{
+		IfMatch: parseETags([]string{version}),
+	}
+}
+
+func parseETags(values []string) []string {
+	var etags []string
+	for _, value := range values {
+		for _, etag := range strings.Split(value, ",") {
+			etag = strings.TrimSpace(etag)
+			if etag != "" {
+				etags = append(etags, etag)
+			}
+		}
+	}
+	return etags
+}
+
+func (p *Preconditions) IsZero() bool {
+	return p == nil || len(p.IfMatch) == 0 && len(p.IfNoneMatch) == 0
+}
+
+// Check verifies the preconditions against the current version of the resource.
+func (p *Preconditions) Check(version string) error {
+	if p.IsZero() {
+		return nil
+	}
+
+	if len(p.IfMatch) > 0 && !etagsMatch(p.IfMatch, version) {
+		return serrors.ThrowPreconditionFailed(zerrors.ThrowPreconditionFailed(nil, "SCIM-ETG1", "The resource does not match the provided If-Match version"))
+	}
+
+	if p.MatchesIfNoneMatch(version) {
+		return serrors.ThrowPreconditionFailed(zerrors.ThrowPreconditionFailed(nil, "SCIM-ETG2", "The resource matches the provided If-None-Match version"))
+	}
+
+	return nil
+}
+
+// MatchesIfNoneMatch returns true if the version is matched by the If-None-Match header,
+// for a GET request this results in a 304 Not Modified response.
+func (p *Preconditions) MatchesIfNoneMatch(version string) bool {
+	return p != nil && len(p.IfNoneMatch) > 0 && etagsMatch(p.IfNoneMatch, version)
+}
+
+// etagsMatch compares the entity tags using the weak comparison function (RFC 7232 section 2.3.2).
+func etagsMatch(etags []string, version string) bool {
+	version = strings.TrimPrefix(version, "W/")
+	for _, etag := range etags {
+		if etag == etagWildcard || strings.TrimPrefix(etag, "W/") == version {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,189 @@
+package resources
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zitadel/zitadel/internal/api/scim/schemas"
+)
+
+func TestPreconditionsFromHeader(t *testing.T) {
+	header := http.Header{}
+	header.Add("If-Match", `W/"1", "2"`)
+	header.Add("If-Match", `W/"3"`)
+	header.Set("If-None-Match", "*")
+
+	assert.Equal(t, &Preconditions{
+		IfMatch:     []string{`W/"1"`, `"2"`, `W/"3"`},
+		IfNoneMatch: []string{"*"},
+	}, PreconditionsFromHeader(header))
+}
+
+func TestPreconditions_Check(t *testing.T) {
+	tests := []struct {
+		name          string
+		preconditions *Preconditions
+		version       string
+		wantErr       bool
+	}{
+		{
+			name:    "no preconditions",
+			version: buildETag(1),
+		},
+		{
+			name:          "if-match matches",
+			preconditions: &Preconditions{IfMatch: []string{`W/"1"`}},
+			version:       buildETag(1),
+		},
+		{
+			name:          "if-match matches weak comparison",
+			preconditions: &Preconditions{IfMatch: []string{`"2"`, `"1"`}},
+			version:       buildETag(1),
+		},
+		{
+			name:          "if-match wildcard",
+			preconditions: &Preconditions{IfMatch: []string{"*"}},
+			version:       buildETag(1),
+		},
+		{
+			name:          "if-match mismatch",
+			preconditions: &Preconditions{IfMatch: []string{`W/"2"`}},
+			version:       buildETag(1),
+			wantErr:       true,
+		},
+		{
+			name:          "if-none-match mismatch",
+			preconditions: &Preconditions{IfNoneMatch: []string{`W/"2"`}},
+			version:       buildETag(1),
+		},
+		{
+			name:          "if-none-match matches",
+			preconditions: &Preconditions{IfNoneMatch: []string{`W/"1"`}},
+			version:       buildETag(1),
+			wantErr:       true,
+		},
+		{
+			name:          "if-none-match wildcard",
+			preconditions: &Preconditions{IfNoneMatch: []string{"*"}},
+			version:       buildETag(1),
+			wantErr:       true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.preconditions.Check(tt.version)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+		})
+	}
+}
+
+func Test_parseETagSequence(t *testing.T) {
+	tests := []struct {
+		name   string
+		etag   string
+		want   uint64
+		wantOk bool
+	}{
+		{
+			name:   "weak etag",
+			etag:   buildETag(42),
+			want:   42,
+			wantOk: true,
+		},
+		{
+			name:   "strong etag",
+			etag:   `"42"`,
+			want:   42,
+			wantOk: true,
+		},
+		{
+			name: "wildcard",
+			etag: "*",
+		},
+		{
+			name: "not a sequence",
+			etag: `W/"abc"`,
+		},
+		{
+			name: "unquoted",
+			etag: "42",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := parseETagSequence(tt.etag)
+			assert.Equal(t, tt.wantOk, ok)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+type versionedUsersHandler struct {
+	ResourceHandler[*ScimUser]
+	version   string
+	triggered bool
+}
+
+func (h *versionedUsersHandler) Get(ctx context.Context, _ string) (*ScimUser, error) {
+	h.triggered = shouldTriggerProjections(ctx)
+	return &ScimUser{Resource: &schemas.Resource{Meta: &schemas.ResourceMeta{Version: h.version}}}, nil
+}
+
+func TestResourceHandlerAdapter_CheckPreconditions(t *testing.T) {
+	tests := []struct {
+		name                string
+		preconditions       *Preconditions
+		wantErr             bool
+		wantTriggered       bool
+		wantExpectedVersion bool
+	}{
+		{
+			name:          "no preconditions",
+			preconditions: &Preconditions{},
+		},
+		{
+			name:                "if match",
+			preconditions:       &Preconditions{IfMatch: []string{buildETag(1)}},
+			wantTriggered:       true,
+			wantExpectedVersion: true,
+		},
+		{
+			name:          "if match, outdated",
+			preconditions: &Preconditions{IfMatch: []string{buildETag(0)}},
+			wantErr:       true,
+			wantTriggered: true,
+		},
+		{
+			name:          "if none match",
+			preconditions: &Preconditions{IfNoneMatch: []string{buildETag(0)}},
+			wantTriggered: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			handler := &versionedUsersHandler{version: buildETag(1)}
+			ctx, err := NewResourceHandlerAdapter[*ScimUser](handler).CheckPreconditions(context.Background(), "id", tt.preconditions)
+			if tt.wantErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+			assert.Equal(t, tt.wantTriggered, handler.triggered)
+			assert.False(t, shouldTriggerProjections(ctx))
+			version, ok := expectedVersion(ctx)
+			assert.Equal(t, tt.wantExpectedVersion, ok)
+			if tt.wantExpectedVersion {
+				assert.Equal(t, handler.version, version)
+			}
+		})
+	}
+}
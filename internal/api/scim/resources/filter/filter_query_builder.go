@@ -31,6 +31,8 @@ type queryBuilder struct {
 type MappedQueryBuilderFunc func(ctx context.Context, compareValue *CompValue, op *CompareOp) (query.SearchQuery, error)
 
 type QueryFieldInfo struct {
+	// Column is used to filter and sort by the field,
+	// for FieldTypeCustom it is optional and only used for sorting.
 	Column           query.Column
 	FieldType        FieldType
 	CaseInsensitive  bool
@@ -57,6 +59,37 @@ func (m FieldPathMapping) Resolve(path string) (*QueryFieldInfo, error) {
 	return info, nil
 }
 
+// ResolveSortBy resolves the sortBy attribute path of a list request to the column to sort by.
+// The attribute path can be prefixed by the urn of the schema (e.g. urn:ietf:params:scim:schemas:core:2.0:User:name.familyName),
+// for multi-valued attributes the primary value is used (e.g. emails sorts by emails.value).
+func (m FieldPathMapping) ResolveSortBy(schema schemas.ScimSchemaType, sortBy string) (*QueryFieldInfo, error) {
+	path, err := ParsePath(sortBy)
+	if err != nil {
+		return nil, err
+	}
+
+	if path == nil || path.AttrPath == nil {
+		logging.WithFields("sortBy", sortBy).Info("scim: invalid sortBy attribute")
+		return nil, serrors.ThrowInvalidValue(zerrors.ThrowInvalidArgument(nil, "SCIM-SRT2", "SortBy has to be an attribute path"))
+	}
+
+	if err = path.AttrPath.validateSchema(schema); err != nil {
+		return nil, err
+	}
+
+	info, err := m.Resolve(path.AttrPath.FieldPath())
+	if err != nil {
+		return nil, err
+	}
+
+	if info.Column.IsZero() {
+		logging.WithFields("sortBy", sortBy).Info("scim: sortBy attribute is not sortable")
+		return nil, serrors.ThrowInvalidValue(zerrors.ThrowInvalidArgumentf(nil, "SCIM-SRT3", "SortBy attribute %s is not sortable", sortBy))
+	}
+
+	return info, nil
+}
+
 func (f *Filter) BuildQuery(ctx context.Context, schema schemas.ScimSchemaType, fieldPathColumnMapping FieldPathMapping) (query.SearchQuery, error) {
 	builder := &queryBuilder{
 		ctx:              ctx,
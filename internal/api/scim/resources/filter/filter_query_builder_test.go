@@ -41,6 +41,13 @@ var fieldPathColumnMapping = FieldPathMapping{
 		Column:    query.HumanEmailCol,
 		FieldType: FieldTypeString,
 	},
+	// a mapped sub attribute of a list
+	"emails.type": {
+		FieldType: FieldTypeCustom,
+		BuildMappedQuery: func(ctx context.Context, compareValue *CompValue, op *CompareOp) (query.SearchQuery, error) {
+			return query.NewTextQuery(query.UserMetadataKeyCol, *compareValue.StringValue, query.TextEquals)
+		},
+	},
 	// pseudo field to test number queries
 	"age": {
 		Column:    query.HumanGenderCol,
@@ -60,6 +67,13 @@ var fieldPathColumnMapping = FieldPathMapping{
 			return query.NewTextQuery(query.UserUsernameCol, "fooBar", query.TextContains)
 		},
 	},
+	// mapped field without a column
+	"groups": {
+		FieldType: FieldTypeCustom,
+		BuildMappedQuery: func(ctx context.Context, compareValue *CompValue, op *CompareOp) (query.SearchQuery, error) {
+			return query.NewTextQuery(query.UserUsernameCol, "fooBar", query.TextContains)
+		},
+	},
 }
 
 func TestFilter_BuildQuery(t *testing.T) {
@@ -317,6 +331,30 @@ func TestFilter_BuildQuery(t *testing.T) {
 			filter: `active eq true`,
 			want:   test.Must(query.NewTextQuery(query.UserUsernameCol, "fooBar", query.TextContains)),
 		},
+		{
+			name:   "value path filter with mapped sub attribute",
+			filter: `emails[type eq "work" and value co "@example.com"]`,
+			want: test.Must(query.NewAndQuery(
+				test.Must(query.NewTextQuery(query.UserMetadataKeyCol, "work", query.TextEquals)),
+				test.Must(query.NewTextQuery(query.HumanEmailCol, "@example.com", query.TextContains)),
+			)),
+		},
+		{
+			name:   "value path filter with mapped sub attribute and urn",
+			filter: `urn:ietf:params:scim:schemas:core:2.0:User:emails[type eq "work" or type eq "home"] and userName eq "hans"`,
+			want: test.Must(query.NewAndQuery(
+				test.Must(query.NewOrQuery(
+					test.Must(query.NewTextQuery(query.UserMetadataKeyCol, "work", query.TextEquals)),
+					test.Must(query.NewTextQuery(query.UserMetadataKeyCol, "home", query.TextEquals)),
+				)),
+				test.Must(query.NewTextQuery(query.UserUsernameCol, "hans", query.TextEqualsIgnoreCase)),
+			)),
+		},
+		{
+			name:    "value path filter with unknown sub attribute",
+			filter:  `emails[display eq "work" and value co "@example.com"]`,
+			wantErr: true,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -496,3 +534,75 @@ func Test_queryBuilder_reduceAttrPaths(t *testing.T) {
 		})
 	}
 }
+
+func TestFieldPathMapping_ResolveSortBy(t *testing.T) {
+	tests := []struct {
+		name    string
+		sortBy  string
+		want    query.Column
+		wantErr bool
+	}{
+		{
+			name:   "simple attribute",
+			sortBy: "userName",
+			want:   query.UserUsernameCol,
+		},
+		{
+			name:   "sub attribute",
+			sortBy: "name.familyName",
+			want:   query.HumanLastNameCol,
+		},
+		{
+			name:   "multi-valued attribute",
+			sortBy: "emails",
+			want:   query.HumanEmailCol,
+		},
+		{
+			name:   "with urn",
+			sortBy: "urn:ietf:params:scim:schemas:core:2.0:User:emails.value",
+			want:   query.HumanEmailCol,
+		},
+		{
+			name:   "mapped field with column",
+			sortBy: "active",
+			want:   query.UserStateCol,
+		},
+		{
+			name:    "mapped field without column",
+			sortBy:  "groups",
+			wantErr: true,
+		},
+		{
+			name:    "unknown urn",
+			sortBy:  "urn:ietf:params:scim:schemas:core:2.0:UserFoo:userName",
+			wantErr: true,
+		},
+		{
+			name:    "unknown attribute",
+			sortBy:  "foobar",
+			wantErr: true,
+		},
+		{
+			name:    "value path",
+			sortBy:  `emails[value eq "foo"]`,
+			wantErr: true,
+		},
+		{
+			name:    "invalid",
+			sortBy:  "emails..value",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := fieldPathColumnMapping.ResolveSortBy(schemas.IdUser, tt.sortBy)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got.Column)
+		})
+	}
+}
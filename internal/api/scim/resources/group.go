@@ -0,0 +1,476 @@
+package resources
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/api/scim/metadata"
+	"github.com/zitadel/zitadel/internal/api/scim/resources/filter"
+	"github.com/zitadel/zitadel/internal/api/scim/resources/patch"
+	scim_schemas "github.com/zitadel/zitadel/internal/api/scim/schemas"
+	"github.com/zitadel/zitadel/internal/api/scim/serrors"
+	"github.com/zitadel/zitadel/internal/command"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore/v1/models"
+	"github.com/zitadel/zitadel/internal/query"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// GroupsHandler maps scim groups onto projects of the organization.
+// The members of a group are the users with a user grant on the project.
+type GroupsHandler struct {
+	command         *command.Commands
+	query           *query.Queries
+	filterEvaluator *filter.Evaluator
+	schema          *scim_schemas.ResourceSchema
+}
+
+type ScimGroup struct {
+	*scim_schemas.Resource `scim:"ignoreInSchema"`
+	ID                     string             `json:"id" scim:"ignoreInSchema"`
+	DisplayName            string             `json:"displayName,omitempty" scim:"required,unique,caseInsensitive"`
+	Members                []*ScimGroupMember `json:"members,omitempty"`
+
+	version *groupVersion `scim:"ignoreInSchema"`
+}
+
+type ScimGroupMember struct {
+	Value   string `json:"value" scim:"required"`
+	Ref     string `json:"$ref,omitempty"`
+	Display string `json:"display,omitempty"`
+	Type    string `json:"type,omitempty"`
+}
+
+const scimGroupMemberTypeUser = "User"
+
+func NewGroupsHandler(
+	command *command.Commands,
+	query *query.Queries,
+) ResourceHandler[*ScimGroup] {
+	return &GroupsHandler{
+		command,
+		query,
+		filter.NewEvaluator(scim_schemas.IdGroup),
+		scim_schemas.BuildSchema(scim_schemas.SchemaBuilderArgs{
+			ID:           scim_schemas.IdGroup,
+			Name:         scim_schemas.GroupResourceType,
+			EndpointName: scim_schemas.GroupsResourceType,
+			Description:  "Group",
+			Resource:     new(ScimGroup),
+		}),
+	}
+}
+
+func (g *ScimGroup) GetResource() *scim_schemas.Resource {
+	return g.Resource
+}
+
+func (g *ScimGroup) GetSchemas() []scim_schemas.ScimSchemaType {
+	if g.Resource == nil {
+		return nil
+	}
+
+	return g.Resource.Schemas
+}
+
+func (h *GroupsHandler) Schema() *scim_schemas.ResourceSchema {
+	return h.schema
+}
+
+func (h *GroupsHandler) NewResource() *ScimGroup {
+	return new(ScimGroup)
+}
+
+func (h *GroupsHandler) Create(ctx context.Context, group *ScimGroup) (*ScimGroup, error) {
+	if err := prepareGroup(ctx, group); err != nil {
+		return nil, err
+	}
+
+	// the project and the user grants of the members are added in one push,
+	// so no project is left behind if a member cannot be granted.
+	addedUserIDs, _ := diffGroupMembers(nil, group.Members)
+	orgID := authz.GetCtxData(ctx).OrgID
+	project, grants, err := h.command.AddProjectWithUserGrants(ctx, &domain.Project{Name: group.DisplayName}, orgID, addedUserIDs...)
+	if err != nil {
+		return nil, err
+	}
+
+	version := &groupVersion{
+		projectSequence: project.Sequence,
+		grantSequences:  make(map[string]uint64, len(grants)),
+	}
+	details := applyAddedUserGrants(version, grants)
+
+	group.ID = project.AggregateID
+	group.Resource = buildResource(ctx, h, mergeGroupDetails(projectToObjectDetails(project), details))
+	group.Resource.Meta.Version = version.etag()
+	group.Members = h.mapMembers(ctx, group.Members)
+	group.version = version
+	return group, nil
+}
+
+func (h *GroupsHandler) Replace(ctx context.Context, id string, group *ScimGroup) (*ScimGroup, error) {
+	if err := prepareGroup(ctx, group); err != nil {
+		return nil, err
+	}
+
+	existing, err := h.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = checkExpectedVersion(ctx, existing.Resource.Meta.Version); err != nil {
+		return nil, err
+	}
+
+	details, err := h.applyGroupChanges(ctx, existing, group)
+	if err != nil {
+		return nil, err
+	}
+
+	group.ID = id
+	group.Resource = existing.Resource
+	group.Members = h.mapMembers(ctx, group.Members)
+	group.version = existing.version
+	updateResourceMeta(group.Resource, details, group.version)
+	return group, nil
+}
+
+func (h *GroupsHandler) Update(ctx context.Context, id string, operations patch.OperationCollection) error {
+	existing, err := h.Get(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	if err = checkExpectedVersion(ctx, existing.Resource.Meta.Version); err != nil {
+		return err
+	}
+
+	// the patches are applied on a copy,
+	// the existing group is used to compute the required changes.
+	group := &ScimGroup{
+		Resource:    existing.Resource,
+		ID:          existing.ID,
+		DisplayName: existing.DisplayName,
+		Members:     make([]*ScimGroupMember, len(existing.Members)),
+	}
+	for i, member := range existing.Members {
+		m := *member
+		group.Members[i] = &m
+	}
+
+	if err = operations.Apply(&groupPatcher{handler: h}, group); err != nil {
+		return err
+	}
+
+	// ensure the identity of the group is not modified
+	group.ID = id
+	if err = prepareGroup(ctx, group); err != nil {
+		return err
+	}
+
+	_, err = h.applyGroupChanges(ctx, existing, group)
+	return err
+}
+
+func (h *GroupsHandler) Delete(ctx context.Context, id string) error {
+	orgID := authz.GetCtxData(ctx).OrgID
+	project, err := h.getProject(ctx, id, orgID)
+	if err != nil {
+		return err
+	}
+
+	grants, err := h.queryProjectUserGrants(ctx, orgID, id)
+	if err != nil {
+		return err
+	}
+
+	if err = checkExpectedVersion(ctx, newGroupVersion(project, grants).etag()); err != nil {
+		return err
+	}
+
+	_, err = h.command.RemoveProject(ctx, id, orgID, userGrantsToIDs(grants)...)
+	return err
+}
+
+func (h *GroupsHandler) Get(ctx context.Context, id string) (*ScimGroup, error) {
+	orgID := authz.GetCtxData(ctx).OrgID
+	project, err := h.getProject(ctx, id, orgID)
+	if err != nil {
+		return nil, err
+	}
+
+	grants, err := h.queryProjectUserGrants(ctx, orgID, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return h.mapToScimGroup(ctx, project, grants), nil
+}
+
+func (h *GroupsHandler) List(ctx context.Context, request *ListRequest) (*ListResponse[*ScimGroup], error) {
+	q, err := h.buildListQuery(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	projects, err := h.query.SearchProjects(ctx, q)
+	if err != nil {
+		return nil, err
+	}
+
+	if request.Count == 0 {
+		return NewListResponse(projects.SearchResponse.Count, q.SearchRequest, make([]*ScimGroup, 0)), nil
+	}
+
+	projectIDs := make([]string, len(projects.Projects))
+	for i, project := range projects.Projects {
+		projectIDs[i] = project.ID
+	}
+
+	grants, err := h.queryProjectUserGrants(ctx, authz.GetCtxData(ctx).OrgID, projectIDs...)
+	if err != nil {
+		return nil, err
+	}
+
+	grantsByProjectID := make(map[string][]*query.UserGrant, len(projects.Projects))
+	for _, grant := range grants {
+		grantsByProjectID[grant.ProjectID] = append(grantsByProjectID[grant.ProjectID], grant)
+	}
+
+	groups := make([]*ScimGroup, len(projects.Projects))
+	for i, project := range projects.Projects {
+		groups[i] = h.mapToScimGroup(ctx, project, grantsByProjectID[project.ID])
+	}
+
+	return NewListResponse(projects.SearchResponse.Count, q.SearchRequest, groups), nil
+}
+
+func (h *GroupsHandler) getProject(ctx context.Context, id, orgID string) (*query.Project, error) {
+	project, err := h.query.ProjectByID(ctx, shouldTriggerProjections(ctx), id)
+	if err != nil {
+		return nil, err
+	}
+
+	// the scim service is always limited to one organization
+	if project.ResourceOwner != orgID {
+		return nil, zerrors.ThrowNotFound(nil, "SCIM-GRP01", "Errors.Project.NotFound")
+	}
+
+	return project, nil
+}
+
+// queryProjectUserGrants queries the user grants of the provided projects
+// which are owned by the organization (grants of granted projects are ignored).
+func (h *GroupsHandler) queryProjectUserGrants(ctx context.Context, orgID string, projectIDs ...string) ([]*query.UserGrant, error) {
+	if len(projectIDs) == 0 {
+		return nil, nil
+	}
+
+	projectIDsQuery, err := query.NewUserGrantProjectIDsSearchQuery(projectIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceOwnerQuery, err := query.NewUserGrantResourceOwnerSearchQuery(orgID)
+	if err != nil {
+		return nil, err
+	}
+
+	grants, err := h.query.UserGrants(ctx, &query.UserGrantsQueries{
+		Queries: []query.SearchQuery{projectIDsQuery, resourceOwnerQuery},
+	}, true)
+	if err != nil {
+		return nil, err
+	}
+
+	return grants.UserGrants, nil
+}
+
+// applyGroupChanges applies the differences between the existing and the new group.
+// The project is only changed if the display name differs,
+// members are added or removed by creating or removing their user grants.
+// The added members are validated and granted first, so an invalid member leaves the group unchanged.
+func (h *GroupsHandler) applyGroupChanges(ctx context.Context, existing, group *ScimGroup) (*domain.ObjectDetails, error) {
+	orgID := authz.GetCtxData(ctx).OrgID
+	addedUserIDs, removedUserIDs := diffGroupMembers(existing.Members, group.Members)
+	details, err := h.addMembers(ctx, existing.ID, orgID, addedUserIDs, existing.version)
+	if err != nil {
+		return nil, err
+	}
+
+	if existing.DisplayName != group.DisplayName {
+		project, err := h.query.ProjectByID(ctx, false, existing.ID)
+		if err != nil {
+			return nil, err
+		}
+
+		changedProject, err := h.command.ChangeProject(ctx, &domain.Project{
+			ObjectRoot:             models.ObjectRoot{AggregateID: project.ID},
+			Name:                   group.DisplayName,
+			ProjectRoleAssertion:   project.ProjectRoleAssertion,
+			ProjectRoleCheck:       project.ProjectRoleCheck,
+			HasProjectCheck:        project.HasProjectCheck,
+			PrivateLabelingSetting: project.PrivateLabelingSetting,
+		}, orgID)
+		if err != nil {
+			return nil, err
+		}
+
+		details = mergeGroupDetails(details, projectToObjectDetails(changedProject))
+		existing.version.projectSequence = changedProject.Sequence
+	}
+
+	removeDetails, err := h.removeMembers(ctx, existing.ID, orgID, removedUserIDs, existing.version)
+	if err != nil {
+		return nil, err
+	}
+
+	return mergeGroupDetails(details, removeDetails), nil
+}
+
+// addMembers adds the user grants of the new members in one push
+// and applies them to the version of the group.
+func (h *GroupsHandler) addMembers(ctx context.Context, projectID, orgID string, userIDs []string, version *groupVersion) (*domain.ObjectDetails, error) {
+	if len(userIDs) == 0 {
+		return nil, nil
+	}
+
+	userGrants := make([]*domain.UserGrant, len(userIDs))
+	for i, userID := range userIDs {
+		userGrants[i] = &domain.UserGrant{
+			UserID:    userID,
+			ProjectID: projectID,
+		}
+	}
+
+	grants, err := h.command.AddUserGrants(ctx, userGrants, orgID)
+	if err != nil {
+		return nil, err
+	}
+
+	return applyAddedUserGrants(version, grants), nil
+}
+
+// removeMembers removes the user grants of the members no longer part of the group
+// and removes them from the version of the group.
+func (h *GroupsHandler) removeMembers(ctx context.Context, projectID, orgID string, removedUserIDs map[string]struct{}, version *groupVersion) (*domain.ObjectDetails, error) {
+	if len(removedUserIDs) == 0 {
+		return nil, nil
+	}
+
+	grants, err := h.queryProjectUserGrants(ctx, orgID, projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	var details *domain.ObjectDetails
+	for _, grant := range grants {
+		if _, ok := removedUserIDs[grant.UserID]; !ok {
+			continue
+		}
+
+		removeDetails, err := h.command.RemoveUserGrant(ctx, grant.ID, orgID)
+		if err != nil {
+			return nil, err
+		}
+
+		delete(version.grantSequences, grant.ID)
+		details = mergeGroupDetails(details, removeDetails)
+	}
+
+	return details, nil
+}
+
+// applyAddedUserGrants applies the added user grants to the version of the group
+// and returns the details of the latest change.
+func applyAddedUserGrants(version *groupVersion, grants []*domain.UserGrant) *domain.ObjectDetails {
+	var details *domain.ObjectDetails
+	for _, grant := range grants {
+		version.grantSequences[grant.AggregateID] = grant.Sequence
+		details = mergeGroupDetails(details, &domain.ObjectDetails{
+			Sequence:      grant.Sequence,
+			EventDate:     grant.ChangeDate,
+			ResourceOwner: grant.ResourceOwner,
+		})
+	}
+	return details
+}
+
+// diffGroupMembers returns the user ids of the added members (in the order provided)
+// and the user ids of the removed members.
+func diffGroupMembers(existingMembers, members []*ScimGroupMember) (added []string, removed map[string]struct{}) {
+	existingUserIDs := make(map[string]struct{}, len(existingMembers))
+	for _, member := range existingMembers {
+		existingUserIDs[member.Value] = struct{}{}
+	}
+
+	userIDs := make(map[string]struct{}, len(members))
+	for _, member := range members {
+		if _, ok := userIDs[member.Value]; ok {
+			continue
+		}
+
+		userIDs[member.Value] = struct{}{}
+		if _, ok := existingUserIDs[member.Value]; !ok {
+			added = append(added, member.Value)
+		}
+	}
+
+	removed = make(map[string]struct{})
+	for userID := range existingUserIDs {
+		if _, ok := userIDs[userID]; !ok {
+			removed[userID] = struct{}{}
+		}
+	}
+
+	return added, removed
+}
+
+// prepareGroup validates the group and resolves bulkIDs of members created in the same bulk request.
+func prepareGroup(ctx context.Context, group *ScimGroup) error {
+	if group.DisplayName == "" {
+		return serrors.ThrowInvalidValue(zerrors.ThrowInvalidArgument(nil, "SCIM-GRP02", "Errors.Project.Invalid"))
+	}
+
+	for _, member := range group.Members {
+		if member == nil || member.Value == "" {
+			return serrors.ThrowInvalidValue(zerrors.ThrowInvalidArgument(nil, "SCIM-GRP03", "Errors.UserGrant.Invalid"))
+		}
+
+		if member.Type != "" && member.Type != scimGroupMemberTypeUser {
+			return serrors.ThrowInvalidValue(zerrors.ThrowInvalidArgumentf(nil, "SCIM-GRP04", "Group member type %s is not supported", member.Type))
+		}
+
+		var err error
+		member.Value, err = metadata.ResolveScimBulkIDIfNeeded(ctx, member.Value)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+type groupPatcher struct {
+	handler *GroupsHandler
+}
+
+func (p *groupPatcher) FilterEvaluator() *filter.Evaluator {
+	return p.handler.filterEvaluator
+}
+
+// Added is a no-op, changes are detected by comparing the patched group with the existing one.
+func (p *groupPatcher) Added([]string) error {
+	return nil
+}
+
+// Replaced is a no-op, changes are detected by comparing the patched group with the existing one.
+func (p *groupPatcher) Replaced([]string) error {
+	return nil
+}
+
+// Removed is a no-op, changes are detected by comparing the patched group with the existing one.
+func (p *groupPatcher) Removed([]string) error {
+	return nil
+}
@@ -0,0 +1,136 @@
+package resources
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"maps"
+	"slices"
+	"strconv"
+
+	"github.com/muhlemmer/gu"
+
+	"github.com/zitadel/zitadel/internal/api/scim/schemas"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/query"
+)
+
+func (h *GroupsHandler) mapToScimGroup(ctx context.Context, project *query.Project, grants []*query.UserGrant) *ScimGroup {
+	members := make([]*ScimGroupMember, len(grants))
+	for i, grant := range grants {
+		members[i] = &ScimGroupMember{
+			Value:   grant.UserID,
+			Ref:     schemas.BuildLocationForResource(ctx, schemas.UsersResourceType, grant.UserID),
+			Display: grant.DisplayName,
+			Type:    scimGroupMemberTypeUser,
+		}
+	}
+
+	version := newGroupVersion(project, grants)
+	return &ScimGroup{
+		Resource:    h.buildResourceForQuery(ctx, project, grants, version),
+		ID:          project.ID,
+		DisplayName: project.Name,
+		Members:     members,
+		version:     version,
+	}
+}
+
+// groupVersion is the version of a group, which consists of the project and the user grants of its members.
+// The sequences of these aggregates are independent of each other,
+// e.g. the latest sequence decreases if the latest user grant is removed,
+// therefore the entity tag is a hash over the sequence of the project and the ids and sequences of the user grants.
+type groupVersion struct {
+	projectSequence uint64
+	grantSequences  map[string]uint64
+}
+
+func newGroupVersion(project *query.Project, grants []*query.UserGrant) *groupVersion {
+	version := &groupVersion{
+		projectSequence: project.Sequence,
+		grantSequences:  make(map[string]uint64, len(grants)),
+	}
+	for _, grant := range grants {
+		version.grantSequences[grant.ID] = grant.Sequence
+	}
+	return version
+}
+
+func (v *groupVersion) etag() string {
+	hash := sha256.New()
+	hash.Write(strconv.AppendUint(nil, v.projectSequence, 10))
+	for _, grantID := range slices.Sorted(maps.Keys(v.grantSequences)) {
+		hash.Write([]byte(";" + grantID + ":" + strconv.FormatUint(v.grantSequences[grantID], 10)))
+	}
+	return `W/"` + hex.EncodeToString(hash.Sum(nil)[:16]) + `"`
+}
+
+// mapMembers sets the read only attributes of the provided members.
+func (h *GroupsHandler) mapMembers(ctx context.Context, members []*ScimGroupMember) []*ScimGroupMember {
+	for _, member := range members {
+		member.Ref = schemas.BuildLocationForResource(ctx, schemas.UsersResourceType, member.Value)
+		member.Type = scimGroupMemberTypeUser
+	}
+
+	return members
+}
+
+// buildResourceForQuery builds the resource of a group.
+// The group is modified whenever the project or one of its user grants is changed,
+// therefore the latest change of all of them is used as last modification date.
+func (h *GroupsHandler) buildResourceForQuery(ctx context.Context, project *query.Project, grants []*query.UserGrant, version *groupVersion) *schemas.Resource {
+	changeDate := project.ChangeDate
+	for _, grant := range grants {
+		if grant.ChangeDate.After(changeDate) {
+			changeDate = grant.ChangeDate
+		}
+	}
+
+	return &schemas.Resource{
+		ID:      project.ID,
+		Schemas: []schemas.ScimSchemaType{schemas.IdGroup},
+		Meta: &schemas.ResourceMeta{
+			ResourceType: schemas.GroupResourceType,
+			Created:      gu.Ptr(project.CreationDate.UTC()),
+			LastModified: gu.Ptr(changeDate.UTC()),
+			Version:      version.etag(),
+			Location:     schemas.BuildLocationForResource(ctx, h.schema.PluralName, project.ID),
+		},
+	}
+}
+
+func projectToObjectDetails(project *domain.Project) *domain.ObjectDetails {
+	return &domain.ObjectDetails{
+		ID:            project.AggregateID,
+		Sequence:      project.Sequence,
+		EventDate:     project.ChangeDate,
+		CreationDate:  project.CreationDate,
+		ResourceOwner: project.ResourceOwner,
+	}
+}
+
+// mergeGroupDetails merges the details of multiple commands executed for one group,
+// the identity and creation date of the first details are kept, the latest change is used.
+func mergeGroupDetails(details, other *domain.ObjectDetails) *domain.ObjectDetails {
+	if details == nil {
+		return other
+	}
+
+	if other == nil || other.EventDate.Before(details.EventDate) {
+		return details
+	}
+
+	merged := *details
+	merged.Sequence = other.Sequence
+	merged.EventDate = other.EventDate
+	return &merged
+}
+
+func updateResourceMeta(resource *schemas.Resource, details *domain.ObjectDetails, version *groupVersion) {
+	resource.Meta.Version = version.etag()
+	if details == nil {
+		return
+	}
+
+	resource.Meta.LastModified = gu.Ptr(details.EventDate.UTC())
+}
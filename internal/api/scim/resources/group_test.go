@@ -0,0 +1,73 @@
+package resources
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/zitadel/zitadel/internal/query"
+)
+
+func Test_diffGroupMembers(t *testing.T) {
+	tests := []struct {
+		name        string
+		existing    []*ScimGroupMember
+		members     []*ScimGroupMember
+		wantAdded   []string
+		wantRemoved map[string]struct{}
+	}{
+		{
+			name:        "no changes",
+			existing:    []*ScimGroupMember{{Value: "1"}, {Value: "2"}},
+			members:     []*ScimGroupMember{{Value: "2"}, {Value: "1"}},
+			wantRemoved: map[string]struct{}{},
+		},
+		{
+			name:        "added",
+			existing:    []*ScimGroupMember{{Value: "1"}},
+			members:     []*ScimGroupMember{{Value: "1"}, {Value: "2"}, {Value: "3"}},
+			wantAdded:   []string{"2", "3"},
+			wantRemoved: map[string]struct{}{},
+		},
+		{
+			name:        "duplicates added once",
+			members:     []*ScimGroupMember{{Value: "1"}, {Value: "1"}},
+			wantAdded:   []string{"1"},
+			wantRemoved: map[string]struct{}{},
+		},
+		{
+			name:        "removed",
+			existing:    []*ScimGroupMember{{Value: "1"}, {Value: "2"}},
+			members:     []*ScimGroupMember{{Value: "2"}},
+			wantRemoved: map[string]struct{}{"1": {}},
+		},
+		{
+			name:        "added and removed",
+			existing:    []*ScimGroupMember{{Value: "1"}},
+			members:     []*ScimGroupMember{{Value: "2"}},
+			wantAdded:   []string{"2"},
+			wantRemoved: map[string]struct{}{"1": {}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			added, removed := diffGroupMembers(tt.existing, tt.members)
+			assert.Equal(t, tt.wantAdded, added)
+			assert.Equal(t, tt.wantRemoved, removed)
+		})
+	}
+}
+
+func Test_groupVersion_etag(t *testing.T) {
+	project := &query.Project{Sequence: 1}
+	grant1 := &query.UserGrant{ID: "grant1", Sequence: 1}
+	grant2 := &query.UserGrant{ID: "grant2", Sequence: 1}
+	grant3 := &query.UserGrant{ID: "grant3", Sequence: 1}
+
+	version := newGroupVersion(project, []*query.UserGrant{grant1, grant2}).etag()
+	assert.Equal(t, version, newGroupVersion(project, []*query.UserGrant{grant2, grant1}).etag(), "order of the grants")
+	assert.NotEqual(t, version, newGroupVersion(&query.Project{Sequence: 2}, []*query.UserGrant{grant1, grant2}).etag(), "project changed")
+	assert.NotEqual(t, version, newGroupVersion(project, []*query.UserGrant{grant1, {ID: "grant2", Sequence: 2}}).etag(), "grant changed")
+	assert.NotEqual(t, version, newGroupVersion(project, []*query.UserGrant{grant1}).etag(), "latest grant removed")
+	assert.NotEqual(t, version, newGroupVersion(project, []*query.UserGrant{grant1, grant3}).etag(), "grant replaced")
+}
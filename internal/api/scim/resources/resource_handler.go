@@ -2,7 +2,6 @@ package resources
 
 import (
 	"context"
-	"strconv"
 
 	"github.com/muhlemmer/gu"
 
@@ -46,7 +45,7 @@ func buildResource[T ResourceHolder](ctx context.Context, handler ResourceHandle
 			ResourceType: schema.Name,
 			Created:      &created,
 			LastModified: gu.Ptr(details.EventDate.UTC()),
-			Version:      strconv.FormatUint(details.Sequence, 10),
+			Version:      buildETag(details.Sequence),
 			Location:     schemas.BuildLocationForResource(ctx, schema.PluralName, details.ID),
 		},
 	}
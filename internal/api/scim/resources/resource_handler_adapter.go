@@ -25,6 +25,7 @@ type RawResourceHandlerAdapter interface {
 	Replace(ctx context.Context, resourceID string, data io.ReadCloser) (ResourceHolder, error)
 	Update(ctx context.Context, resourceID string, data io.ReadCloser) error
 	Delete(ctx context.Context, resourceID string) error
+	CheckPreconditions(ctx context.Context, resourceID string, preconditions *Preconditions) (context.Context, error)
 }
 
 type ResourceHandlerAdapter[T ResourceHolder] struct {
@@ -55,7 +56,13 @@ func (adapter *ResourceHandlerAdapter[T]) Create(ctx context.Context, data io.Re
 }
 
 func (adapter *ResourceHandlerAdapter[T]) ReplaceFromHttp(r *http.Request) (ResourceHolder, error) {
-	return adapter.Replace(r.Context(), mux.Vars(r)["id"], r.Body)
+	id := mux.Vars(r)["id"]
+	ctx, err := adapter.CheckPreconditions(r.Context(), id, PreconditionsFromHeader(r.Header))
+	if err != nil {
+		return nil, err
+	}
+
+	return adapter.Replace(ctx, id, r.Body)
 }
 
 func (adapter *ResourceHandlerAdapter[T]) Replace(ctx context.Context, resourceID string, data io.ReadCloser) (ResourceHolder, error) {
@@ -64,11 +71,18 @@ func (adapter *ResourceHandlerAdapter[T]) Replace(ctx context.Context, resourceI
 		return entity, err
 	}
 
-	return adapter.handler.Replace(ctx, resourceID, entity)
+	resource, err := adapter.handler.Replace(ctx, resourceID, entity)
+	return resource, mapConcurrentModification(err)
 }
 
 func (adapter *ResourceHandlerAdapter[T]) UpdateFromHttp(r *http.Request) error {
-	return adapter.Update(r.Context(), mux.Vars(r)["id"], r.Body)
+	id := mux.Vars(r)["id"]
+	ctx, err := adapter.CheckPreconditions(r.Context(), id, PreconditionsFromHeader(r.Header))
+	if err != nil {
+		return err
+	}
+
+	return adapter.Update(ctx, id, r.Body)
 }
 
 func (adapter *ResourceHandlerAdapter[T]) Update(ctx context.Context, resourceID string, data io.ReadCloser) error {
@@ -85,15 +99,47 @@ func (adapter *ResourceHandlerAdapter[T]) Update(ctx context.Context, resourceID
 		return nil
 	}
 
-	return adapter.handler.Update(ctx, resourceID, request.Operations)
+	return mapConcurrentModification(adapter.handler.Update(ctx, resourceID, request.Operations))
 }
 
 func (adapter *ResourceHandlerAdapter[T]) DeleteFromHttp(r *http.Request) error {
-	return adapter.Delete(r.Context(), mux.Vars(r)["id"])
+	id := mux.Vars(r)["id"]
+	ctx, err := adapter.CheckPreconditions(r.Context(), id, PreconditionsFromHeader(r.Header))
+	if err != nil {
+		return err
+	}
+
+	return adapter.Delete(ctx, id)
 }
 
 func (adapter *ResourceHandlerAdapter[T]) Delete(ctx context.Context, resourceID string) error {
-	return adapter.handler.Delete(ctx, resourceID)
+	return mapConcurrentModification(adapter.handler.Delete(ctx, resourceID))
+}
+
+// CheckPreconditions verifies the conditional request headers against the current version of the resource.
+// If no preconditions are provided, the resource is not loaded,
+// otherwise the projections are triggered to compare against the latest version of the resource.
+// If an If-Match precondition is met, the verified version is added to the returned context,
+// so that the change is rejected if the resource is modified before it is persisted.
+func (adapter *ResourceHandlerAdapter[T]) CheckPreconditions(ctx context.Context, resourceID string, preconditions *Preconditions) (context.Context, error) {
+	if preconditions.IsZero() {
+		return ctx, nil
+	}
+
+	resource, err := adapter.handler.Get(withTriggeredProjections(ctx), resourceID)
+	if err != nil {
+		return ctx, err
+	}
+
+	version := resource.GetResource().Meta.Version
+	if err = preconditions.Check(version); err != nil {
+		return ctx, err
+	}
+
+	if len(preconditions.IfMatch) > 0 {
+		ctx = withExpectedVersion(ctx, version)
+	}
+	return ctx, nil
 }
 
 func (adapter *ResourceHandlerAdapter[T]) ListFromHttp(r *http.Request) (*ListResponse[T], error) {
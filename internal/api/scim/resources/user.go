@@ -13,7 +13,9 @@ import (
 	"github.com/zitadel/zitadel/internal/command"
 	"github.com/zitadel/zitadel/internal/crypto"
 	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
 	"github.com/zitadel/zitadel/internal/query"
+	"github.com/zitadel/zitadel/internal/query/projection"
 	"github.com/zitadel/zitadel/internal/zerrors"
 )
 
@@ -89,11 +91,13 @@ type ScimIms struct {
 
 type ScimEmail struct {
 	Value   string `json:"value" scim:"required"`
+	Type    string `json:"type,omitempty"`
 	Primary bool   `json:"primary"`
 }
 
 type ScimPhoneNumber struct {
 	Value   string `json:"value"`
+	Type    string `json:"type,omitempty"`
 	Primary bool   `json:"primary"`
 }
 
@@ -139,6 +143,38 @@ func (u *ScimUser) GetSchemas() []scim_schemas.ScimSchemaType {
 	return u.Resource.Schemas
 }
 
+// primaryEmail returns the primary email or the first one if none is marked as primary,
+// as ZITADEL only stores one email.
+func (u *ScimUser) primaryEmail() *ScimEmail {
+	for _, email := range u.Emails {
+		if email.Primary {
+			return email
+		}
+	}
+
+	if len(u.Emails) > 0 {
+		return u.Emails[0]
+	}
+
+	return nil
+}
+
+// primaryPhoneNumber returns the primary phone number or the first one if none is marked as primary,
+// as ZITADEL only stores one phone number.
+func (u *ScimUser) primaryPhoneNumber() *ScimPhoneNumber {
+	for _, phone := range u.PhoneNumbers {
+		if phone.Primary {
+			return phone
+		}
+	}
+
+	if len(u.PhoneNumbers) > 0 {
+		return u.PhoneNumbers[0]
+	}
+
+	return nil
+}
+
 func (h *UsersHandler) Schema() *scim_schemas.ResourceSchema {
 	return h.schema
 }
@@ -170,6 +206,7 @@ func (h *UsersHandler) Replace(ctx context.Context, id string, user *ScimUser) (
 		return nil, err
 	}
 
+	changeHuman.ExpectedSequence = expectedUserSequence(ctx)
 	err = h.command.ChangeUserHuman(ctx, changeHuman, h.userCodeAlg)
 	if err != nil {
 		return nil, err
@@ -195,6 +232,7 @@ func (h *UsersHandler) Update(ctx context.Context, id string, operations patch.O
 	// ensure the identity of the user is not modified
 	changeHuman.ID = id
 	changeHuman.ResourceOwner = orgID
+	changeHuman.ExpectedSequence = expectedUserSequence(ctx)
 	return h.command.ChangeUserHuman(ctx, changeHuman, h.userCodeAlg)
 }
 
@@ -204,12 +242,32 @@ func (h *UsersHandler) Delete(ctx context.Context, id string) error {
 		return err
 	}
 
-	_, err = h.command.RemoveUserV2(ctx, id, authz.GetCtxData(ctx).OrgID, memberships, grants...)
+	_, err = h.command.RemoveUserV2(ctx, id, authz.GetCtxData(ctx).OrgID, expectedUserSequence(ctx), memberships, grants...)
 	return err
 }
 
+// expectedUserSequence returns the sequence of the user the If-Match precondition was verified against.
+// The version of a user is the sequence of the users projection,
+// therefore only the events updating the projected sequence are checked.
+func expectedUserSequence(ctx context.Context) *eventstore.ExpectedSequence {
+	version, ok := expectedVersion(ctx)
+	if !ok {
+		return nil
+	}
+
+	sequence, ok := parseETagSequence(version)
+	if !ok {
+		return nil
+	}
+
+	return &eventstore.ExpectedSequence{
+		Sequence:   sequence,
+		EventTypes: projection.UserSequenceEventTypes,
+	}
+}
+
 func (h *UsersHandler) Get(ctx context.Context, id string) (*ScimUser, error) {
-	user, err := h.query.GetUserByIDWithResourceOwner(ctx, false, id, authz.GetCtxData(ctx).OrgID)
+	user, err := h.query.GetUserByIDWithResourceOwner(ctx, shouldTriggerProjections(ctx), id, authz.GetCtxData(ctx).OrgID)
 	if err != nil {
 		return nil, err
 	}
@@ -2,7 +2,6 @@ package resources
 
 import (
 	"context"
-	"strconv"
 	"time"
 
 	"github.com/muhlemmer/gu"
@@ -204,6 +203,7 @@ func (h *UsersHandler) mapAddCommandToScimUser(ctx context.Context, user *ScimUs
 		user.PhoneNumbers = []*ScimPhoneNumber{
 			{
 				Value:   string(addHuman.Phone.Number),
+				Type:    phoneNumberType(user),
 				Primary: true,
 			},
 		}
@@ -213,6 +213,7 @@ func (h *UsersHandler) mapAddCommandToScimUser(ctx context.Context, user *ScimUs
 		user.Emails = []*ScimEmail{
 			{
 				Value:   string(addHuman.Email.Address),
+				Type:    emailType(user),
 				Primary: true,
 			},
 		}
@@ -231,6 +232,7 @@ func (h *UsersHandler) mapChangeCommandToScimUser(ctx context.Context, user *Sci
 		user.PhoneNumbers = []*ScimPhoneNumber{
 			{
 				Value:   string(changeHuman.Phone.Number),
+				Type:    phoneNumberType(user),
 				Primary: true,
 			},
 		}
@@ -240,12 +242,27 @@ func (h *UsersHandler) mapChangeCommandToScimUser(ctx context.Context, user *Sci
 		user.Emails = []*ScimEmail{
 			{
 				Value:   string(changeHuman.Email.Address),
+				Type:    emailType(user),
 				Primary: true,
 			},
 		}
 	}
 }
 
+func emailType(user *ScimUser) string {
+	if email := user.primaryEmail(); email != nil {
+		return email.Type
+	}
+	return ""
+}
+
+func phoneNumberType(user *ScimUser) string {
+	if phone := user.primaryPhoneNumber(); phone != nil {
+		return phone.Type
+	}
+	return ""
+}
+
 func (h *UsersHandler) mapToScimUsers(ctx context.Context, users []*query.User, md map[string]map[metadata.ScopedKey][]byte) []*ScimUser {
 	result := make([]*ScimUser, len(users))
 	for i, user := range users {
@@ -346,6 +363,12 @@ func (h *UsersHandler) mapAndValidateMetadata(ctx context.Context, user *ScimUse
 	user.Name.MiddleName = extractScalarMetadata(ctx, md, metadata.KeyMiddleName)
 	user.Name.HonorificPrefix = extractScalarMetadata(ctx, md, metadata.KeyHonorificPrefix)
 	user.Name.HonorificSuffix = extractScalarMetadata(ctx, md, metadata.KeyHonorificSuffix)
+	if email := user.primaryEmail(); email != nil {
+		email.Type = extractScalarMetadata(ctx, md, metadata.KeyEmailType)
+	}
+	if phone := user.primaryPhoneNumber(); phone != nil {
+		phone.Type = extractScalarMetadata(ctx, md, metadata.KeyPhoneNumberType)
+	}
 
 	if user.Locale != "" {
 		_, err := language.Parse(user.Locale)
@@ -392,7 +415,7 @@ func (h *UsersHandler) buildResourceForQuery(ctx context.Context, user *query.Us
 			ResourceType: schemas.UserResourceType,
 			Created:      gu.Ptr(user.CreationDate.UTC()),
 			LastModified: gu.Ptr(user.ChangeDate.UTC()),
-			Version:      strconv.FormatUint(user.Sequence, 10),
+			Version:      buildETag(user.Sequence),
 			Location:     schemas.BuildLocationForResource(ctx, h.schema.PluralName, user.ID),
 		},
 	}
@@ -405,7 +428,7 @@ func (h *UsersHandler) buildResourceForWriteModel(ctx context.Context, user *com
 			ResourceType: schemas.UserResourceType,
 			Created:      gu.Ptr(user.CreationDate.UTC()),
 			LastModified: gu.Ptr(user.ChangeDate.UTC()),
-			Version:      strconv.FormatUint(user.ProcessedSequence, 10),
+			Version:      buildETag(user.ProcessedSequence),
 			Location:     schemas.BuildLocationForResource(ctx, h.schema.PluralName, user.AggregateID),
 		},
 	}
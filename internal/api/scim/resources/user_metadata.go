@@ -154,6 +154,8 @@ func getValueForMetadataKey(user *ScimUser, key metadata.Key) ([]byte, error) {
 		metadata.KeyHonorificSuffix,
 		metadata.KeyMiddleName,
 		metadata.KeyExternalId,
+		metadata.KeyEmailType,
+		metadata.KeyPhoneNumberType,
 		metadata.KeyProvisioningDomain:
 		valueStr := value.(string)
 		if valueStr == "" {
@@ -223,6 +225,10 @@ func getRawValueForMetadataKey(user *ScimUser, key metadata.Key) interface{} {
 		return user.Locale
 	case metadata.KeyTimezone:
 		return user.Timezone
+	case metadata.KeyEmailType:
+		return emailType(user)
+	case metadata.KeyPhoneNumberType:
+		return phoneNumberType(user)
 	case metadata.KeyProvisioningDomain:
 		break
 	}
@@ -41,6 +41,22 @@ var fieldPathColumnMapping = filter.FieldPathMapping{
 		Column:    query.HumanFirstNameCol,
 		FieldType: filter.FieldTypeString,
 	},
+	"name.formatted": {
+		Column:    query.HumanDisplayNameCol,
+		FieldType: filter.FieldTypeString,
+	},
+	"displayname": {
+		Column:    query.HumanDisplayNameCol,
+		FieldType: filter.FieldTypeString,
+	},
+	"nickname": {
+		Column:    query.HumanNickNameCol,
+		FieldType: filter.FieldTypeString,
+	},
+	"preferredlanguage": {
+		Column:    query.HumanPreferredLanguageCol,
+		FieldType: filter.FieldTypeString,
+	},
 	"emails": {
 		Column:    query.HumanEmailCol,
 		FieldType: filter.FieldTypeString,
@@ -49,7 +65,34 @@ var fieldPathColumnMapping = filter.FieldPathMapping{
 		Column:    query.HumanEmailCol,
 		FieldType: filter.FieldTypeString,
 	},
+	"emails.type": {
+		FieldType:        filter.FieldTypeCustom,
+		BuildMappedQuery: newMetadataQueryBuilder(metadata.KeyEmailType),
+	},
+	"emails.primary": {
+		Column:           query.HumanEmailCol,
+		FieldType:        filter.FieldTypeCustom,
+		BuildMappedQuery: newPrimaryQueryBuilder(query.HumanEmailCol),
+	},
+	"phonenumbers": {
+		Column:    query.HumanPhoneCol,
+		FieldType: filter.FieldTypeString,
+	},
+	"phonenumbers.value": {
+		Column:    query.HumanPhoneCol,
+		FieldType: filter.FieldTypeString,
+	},
+	"phonenumbers.type": {
+		FieldType:        filter.FieldTypeCustom,
+		BuildMappedQuery: newMetadataQueryBuilder(metadata.KeyPhoneNumberType),
+	},
+	"phonenumbers.primary": {
+		Column:           query.HumanPhoneCol,
+		FieldType:        filter.FieldTypeCustom,
+		BuildMappedQuery: newPrimaryQueryBuilder(query.HumanPhoneCol),
+	},
 	"active": {
+		Column:           query.UserStateCol,
 		FieldType:        filter.FieldTypeCustom,
 		BuildMappedQuery: buildActiveUserStateQuery,
 	},
@@ -60,7 +103,7 @@ var fieldPathColumnMapping = filter.FieldPathMapping{
 }
 
 func (h *UsersHandler) buildListQuery(ctx context.Context, request *ListRequest) (*query.UserSearchQueries, error) {
-	searchRequest, err := request.toSearchRequest(query.UserIDCol, fieldPathColumnMapping)
+	searchRequest, err := request.toSearchRequest(h.schema.ID, query.UserIDCol, fieldPathColumnMapping)
 	if err != nil {
 		return nil, err
 	}
@@ -123,6 +166,29 @@ func buildMetadataQuery(ctx context.Context, key metadata.Key, value *filter.Com
 	return query.NewUserMetadataExistsQuery(scopedKey, []byte(*value.StringValue), query.TextEquals, comparisonOperator)
 }
 
+// newPrimaryQueryBuilder builds the query for the primary attribute of multi-valued attributes (e.g. emails[primary eq true]).
+// ZITADEL only stores one value per multi-valued attribute which is always the primary one,
+// therefore a value is primary if it is set.
+func newPrimaryQueryBuilder(col query.Column) filter.MappedQueryBuilderFunc {
+	return func(_ context.Context, compareValue *filter.CompValue, op *filter.CompareOp) (query.SearchQuery, error) {
+		if !op.Equal && !op.NotEqual {
+			return nil, serrors.ThrowInvalidFilter(zerrors.ThrowInvalidArgument(nil, "SCIM-PRIM1", "invalid filter expression: primary unsupported comparison operator"))
+		}
+
+		if !compareValue.BooleanTrue && !compareValue.BooleanFalse {
+			return nil, serrors.ThrowInvalidFilter(zerrors.ThrowInvalidArgument(nil, "SCIM-PRIM2", "invalid filter expression: primary unsupported comparison value"))
+		}
+
+		primary := compareValue.BooleanTrue && op.Equal || compareValue.BooleanFalse && op.NotEqual
+		if primary {
+			return query.NewNotNullQuery(col)
+		}
+
+		// there are no non-primary values
+		return query.NewIsNullQuery(query.UserIDCol)
+	}
+}
+
 func buildActiveUserStateQuery(_ context.Context, compareValue *filter.CompValue, op *filter.CompareOp) (query.SearchQuery, error) {
 	if !op.Equal && !op.NotEqual {
 		return nil, serrors.ThrowInvalidFilter(zerrors.ThrowInvalidArgument(nil, "SCIM-MGdg", "invalid filter expression: active unsupported comparison operator"))
@@ -11,6 +11,7 @@ import (
 
 	"github.com/zitadel/zitadel/internal/api/scim/metadata"
 	"github.com/zitadel/zitadel/internal/api/scim/resources/filter"
+	"github.com/zitadel/zitadel/internal/api/scim/schemas"
 	"github.com/zitadel/zitadel/internal/domain"
 	"github.com/zitadel/zitadel/internal/query"
 	"github.com/zitadel/zitadel/internal/test"
@@ -142,3 +143,93 @@ func Test_buildActiveUserStateQuery(t *testing.T) {
 		})
 	}
 }
+
+func Test_newPrimaryQueryBuilder(t *testing.T) {
+	tests := []struct {
+		name         string
+		compareValue *filter.CompValue
+		compOp       *filter.CompareOp
+		want         query.SearchQuery
+		wantErr      bool
+	}{
+		{
+			name:         "eq true",
+			compareValue: &filter.CompValue{BooleanTrue: true},
+			compOp:       &filter.CompareOp{Equal: true},
+			want:         test.Must(query.NewNotNullQuery(query.HumanEmailCol)),
+		},
+		{
+			name:         "ne false",
+			compareValue: &filter.CompValue{BooleanFalse: true},
+			compOp:       &filter.CompareOp{NotEqual: true},
+			want:         test.Must(query.NewNotNullQuery(query.HumanEmailCol)),
+		},
+		{
+			name:         "eq false",
+			compareValue: &filter.CompValue{BooleanFalse: true},
+			compOp:       &filter.CompareOp{Equal: true},
+			want:         test.Must(query.NewIsNullQuery(query.UserIDCol)),
+		},
+		{
+			name:         "invalid operator",
+			compareValue: &filter.CompValue{BooleanTrue: true},
+			compOp:       &filter.CompareOp{StartsWith: true},
+			wantErr:      true,
+		},
+		{
+			name:         "invalid comp value",
+			compareValue: &filter.CompValue{StringValue: gu.Ptr("foo")},
+			compOp:       &filter.CompareOp{Equal: true},
+			wantErr:      true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := newPrimaryQueryBuilder(query.HumanEmailCol)(context.Background(), tt.compareValue, tt.compOp)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_fieldPathColumnMapping_valuePath(t *testing.T) {
+	tests := []struct {
+		name   string
+		filter string
+		want   query.SearchQuery
+	}{
+		{
+			name:   "email type and value",
+			filter: `emails[type eq "work" and value co "@example.com"]`,
+			want: test.Must(query.NewAndQuery(
+				test.Must(query.NewUserMetadataExistsQuery(string(metadata.KeyEmailType), []byte("work"), query.TextEquals, query.BytesEquals)),
+				test.Must(query.NewTextQuery(query.HumanEmailCol, "@example.com", query.TextContains)),
+			)),
+		},
+		{
+			name:   "phone number type and primary",
+			filter: `phoneNumbers[type ne "mobile" and primary eq true]`,
+			want: test.Must(query.NewAndQuery(
+				test.Must(query.NewUserMetadataExistsQuery(string(metadata.KeyPhoneNumberType), []byte("mobile"), query.TextEquals, query.BytesNotEquals)),
+				test.Must(query.NewNotNullQuery(query.HumanPhoneCol)),
+			)),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f, err := filter.ParseFilter(tt.filter)
+			require.NoError(t, err)
+
+			got, err := f.BuildQuery(context.Background(), schemas.IdUser, fieldPathColumnMapping)
+			require.NoError(t, err)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("BuildQuery() got = %#v, want %#v", got, tt.want)
+			}
+		})
+	}
+}
@@ -129,6 +129,13 @@ func ThrowPayloadTooLarge(parent error) error {
 	}
 }
 
+func ThrowPreconditionFailed(parent error) error {
+	return &wrappedScimError{
+		Parent: parent,
+		Status: http.StatusPreconditionFailed,
+	}
+}
+
 func IsScimOrZitadelError(err error) bool {
 	return IsScimError(err) || zerrors.IsZitadelError(err)
 }
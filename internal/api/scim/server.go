@@ -47,10 +47,13 @@ func buildHandler(
 	usersHandler := sresources.NewResourceHandlerAdapter(sresources.NewUsersHandler(command, query, userCodeAlg, cfg))
 	mapResource(router, middleware, usersHandler)
 
-	bulkHandler := sresources.NewBulkHandler(cfg.Bulk, usersHandler)
+	groupsHandler := sresources.NewResourceHandlerAdapter(sresources.NewGroupsHandler(command, query))
+	mapResource(router, middleware, groupsHandler)
+
+	bulkHandler := sresources.NewBulkHandler(cfg.Bulk, usersHandler, groupsHandler)
 	router.Handle("/"+zhttp.OrgIdInPathVariable+"/Bulk", middleware(handleJsonResponse(bulkHandler.BulkFromHttp))).Methods(http.MethodPost)
 
-	serviceProviderHandler := newServiceProviderHandler(cfg, usersHandler)
+	serviceProviderHandler := newServiceProviderHandler(cfg, usersHandler, groupsHandler)
 	router.Handle("/"+zhttp.OrgIdInPathVariable+"/ServiceProviderConfig", middleware(handleJsonResponse(serviceProviderHandler.GetConfig))).Methods(http.MethodGet)
 	router.Handle("/"+zhttp.OrgIdInPathVariable+"/ResourceTypes", middleware(handleJsonResponse(serviceProviderHandler.ListResourceTypes))).Methods(http.MethodGet)
 	router.Handle("/"+zhttp.OrgIdInPathVariable+"/ResourceTypes/{name}", middleware(handleResourceResponse(serviceProviderHandler.GetResourceType))).Methods(http.MethodGet)
@@ -104,6 +107,9 @@ func handleResourceCreatedResponse[T sresources.ResourceHolder](next func(*http.
 
 		resource := entity.GetResource()
 		w.Header().Set(zhttp.Location, resource.Meta.Location)
+		if resource.Meta.Version != "" {
+			w.Header().Set(zhttp.Etag, resource.Meta.Version)
+		}
 		w.WriteHeader(http.StatusCreated)
 
 		err = json.NewEncoder(w).Encode(entity)
@@ -121,6 +127,14 @@ func handleResourceResponse[T sresources.ResourceHolder](next func(*http.Request
 
 		resource := entity.GetResource()
 		w.Header().Set(zhttp.ContentLocation, resource.Meta.Location)
+		if resource.Meta.Version != "" {
+			w.Header().Set(zhttp.Etag, resource.Meta.Version)
+		}
+
+		if r.Method == http.MethodGet && sresources.PreconditionsFromHeader(r.Header).MatchesIfNoneMatch(resource.Meta.Version) {
+			w.WriteHeader(http.StatusNotModified)
+			return nil
+		}
 
 		err = json.NewEncoder(w).Encode(entity)
 		logging.OnError(err).Warn("scim json response encoding failed")
@@ -105,7 +105,7 @@ func (h *serviceProviderHandler) GetConfig(r *http.Request) (*serviceProviderCon
 			Supported: true,
 		},
 		ETag: serviceProviderConfigSupported{
-			Supported: false,
+			Supported: true,
 		},
 		AuthenticationSchemes: h.config.AuthenticationSchemes,
 	}, nil
@@ -0,0 +1,218 @@
+package login
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+
+	http_mw "github.com/zitadel/zitadel/internal/api/http/middleware"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+const (
+	tmplAccountRecovery               = "accountrecovery"
+	tmplAccountRecoveryRequested      = "accountrecoveryrequested"
+	tmplAccountRecoveryDone           = "accountrecoverydone"
+	tmplRecoveryEmailVerification     = "recoveryemailverification"
+	tmplRecoveryEmailVerificationDone = "recoveryemailverificationdone"
+)
+
+type accountRecoveryFormData struct {
+	Code            string `schema:"code"`
+	Password        string `schema:"password"`
+	PasswordConfirm string `schema:"passwordconfirm"`
+	UserID          string `schema:"userID"`
+	OrgID           string `schema:"orgID"`
+}
+
+type accountRecoveryData struct {
+	baseData
+	profileData
+	Code         string
+	UserID       string
+	MinLength    uint64
+	HasUppercase string
+	HasLowercase string
+	HasNumber    string
+	HasSymbol    string
+}
+
+type recoveryEmailVerificationFormData struct {
+	Code   string `schema:"code"`
+	UserID string `schema:"userID"`
+	OrgID  string `schema:"orgID"`
+}
+
+type recoveryEmailVerificationData struct {
+	baseData
+	profileData
+	Code   string
+	UserID string
+}
+
+func AccountRecoveryLink(origin, userID, code, orgID string) string {
+	v := url.Values{}
+	v.Set(queryUserID, userID)
+	v.Set(queryCode, code)
+	v.Set(queryOrgID, orgID)
+	return externalLink(origin) + EndpointAccountRecovery + "?" + v.Encode()
+}
+
+func AccountRecoveryLinkTemplate(origin, userID, orgID string) string {
+	return fmt.Sprintf("%s%s?%s=%s&%s=%s&%s=%s",
+		externalLink(origin), EndpointAccountRecovery,
+		queryUserID, userID,
+		queryCode, "{{.Code}}",
+		queryOrgID, orgID)
+}
+
+func RecoveryEmailVerificationLink(origin, userID, code, orgID string) string {
+	v := url.Values{}
+	v.Set(queryUserID, userID)
+	v.Set(queryCode, code)
+	v.Set(queryOrgID, orgID)
+	return externalLink(origin) + EndpointRecoveryEmailVerification + "?" + v.Encode()
+}
+
+func RecoveryEmailVerificationLinkTemplate(origin, userID, orgID string) string {
+	return fmt.Sprintf("%s%s?%s=%s&%s=%s&%s=%s",
+		externalLink(origin), EndpointRecoveryEmailVerification,
+		queryUserID, userID,
+		queryCode, "{{.Code}}",
+		queryOrgID, orgID)
+}
+
+// handleAccountRecoveryRequest creates an account recovery request for the user of the auth request,
+// which has to be approved by an administrator of the organization.
+func (l *Login) handleAccountRecoveryRequest(w http.ResponseWriter, r *http.Request) {
+	authReq, err := l.ensureAuthRequest(r)
+	if err != nil {
+		l.renderError(w, r, authReq, err)
+		return
+	}
+	if authReq.LoginPolicy == nil || !authReq.LoginPolicy.AllowAccountRecovery {
+		l.renderError(w, r, authReq, zerrors.ThrowPreconditionFailed(nil, "LOGIN-Ar8dq", "Errors.User.AccountRecovery.Disabled"))
+		return
+	}
+	user, err := l.query.GetUserByLoginName(setContext(r.Context(), authReq.UserOrgID), true, authReq.LoginName)
+	if err != nil {
+		if authReq.LoginPolicy.IgnoreUnknownUsernames && zerrors.IsNotFound(err) {
+			err = nil
+		}
+		l.renderAccountRecoveryRequested(w, r, authReq, err)
+		return
+	}
+	_, err = l.command.RequestAccountRecovery(setContext(r.Context(), authReq.UserOrgID), user.ID, "")
+	l.renderAccountRecoveryRequested(w, r, authReq, err)
+}
+
+func (l *Login) handleAccountRecovery(w http.ResponseWriter, r *http.Request) {
+	userID := r.FormValue(queryUserID)
+	code := r.FormValue(queryCode)
+	orgID := r.FormValue(queryOrgID)
+	l.renderAccountRecovery(w, r, userID, orgID, code, nil)
+}
+
+func (l *Login) handleAccountRecoveryCheck(w http.ResponseWriter, r *http.Request) {
+	data := new(accountRecoveryFormData)
+	if err := l.parser.Parse(r, data); err != nil {
+		l.renderError(w, r, nil, err)
+		return
+	}
+	if data.Password != data.PasswordConfirm {
+		err := zerrors.ThrowInvalidArgument(nil, "LOGIN-Ar9pc", "Errors.User.Password.ConfirmationWrong")
+		l.renderAccountRecovery(w, r, data.UserID, data.OrgID, data.Code, err)
+		return
+	}
+	userAgentID, _ := http_mw.UserAgentIDFromCtx(r.Context())
+	_, err := l.command.CompleteAccountRecovery(setContext(r.Context(), data.OrgID), data.UserID, data.OrgID, data.Code, data.Password, userAgentID)
+	if err != nil {
+		l.renderAccountRecovery(w, r, data.UserID, data.OrgID, "", err)
+		return
+	}
+	l.renderAccountRecoveryDone(w, r, data.OrgID)
+}
+
+func (l *Login) handleRecoveryEmailVerification(w http.ResponseWriter, r *http.Request) {
+	userID := r.FormValue(queryUserID)
+	code := r.FormValue(queryCode)
+	orgID := r.FormValue(queryOrgID)
+	if userID != "" && code != "" {
+		l.checkRecoveryEmailCode(w, r, userID, orgID, code)
+		return
+	}
+	l.renderRecoveryEmailVerification(w, r, userID, orgID, code, nil)
+}
+
+func (l *Login) handleRecoveryEmailVerificationCheck(w http.ResponseWriter, r *http.Request) {
+	data := new(recoveryEmailVerificationFormData)
+	if err := l.parser.Parse(r, data); err != nil {
+		l.renderError(w, r, nil, err)
+		return
+	}
+	l.checkRecoveryEmailCode(w, r, data.UserID, data.OrgID, data.Code)
+}
+
+func (l *Login) checkRecoveryEmailCode(w http.ResponseWriter, r *http.Request, userID, orgID, code string) {
+	_, err := l.command.VerifyRecoveryEmail(setContext(r.Context(), orgID), userID, orgID, code)
+	if err != nil {
+		l.renderRecoveryEmailVerification(w, r, userID, orgID, "", err)
+		return
+	}
+	translator := l.getTranslator(r.Context(), nil)
+	data := l.getBaseData(r, nil, translator, "RecoveryEmailVerificationDone.Title", "RecoveryEmailVerificationDone.Description", nil)
+	l.customTexts(r.Context(), translator, orgID)
+	l.renderer.RenderTemplate(w, r, translator, l.renderer.Templates[tmplRecoveryEmailVerificationDone], data, nil)
+}
+
+func (l *Login) renderAccountRecoveryRequested(w http.ResponseWriter, r *http.Request, authReq *domain.AuthRequest, err error) {
+	translator := l.getTranslator(r.Context(), authReq)
+	data := l.getUserData(r, authReq, translator, "AccountRecoveryRequested.Title", "AccountRecoveryRequested.Description", err)
+	l.renderer.RenderTemplate(w, r, translator, l.renderer.Templates[tmplAccountRecoveryRequested], data, nil)
+}
+
+func (l *Login) renderAccountRecovery(w http.ResponseWriter, r *http.Request, userID, orgID, code string, err error) {
+	translator := l.getTranslator(r.Context(), nil)
+	data := accountRecoveryData{
+		baseData: l.getBaseData(r, nil, translator, "AccountRecovery.Title", "AccountRecovery.Description", err),
+		UserID:   userID,
+		Code:     code,
+	}
+	policy := l.getPasswordComplexityPolicyByUserID(r, userID)
+	if policy != nil {
+		data.MinLength = policy.MinLength
+		if policy.HasUppercase {
+			data.HasUppercase = UpperCaseRegex
+		}
+		if policy.HasLowercase {
+			data.HasLowercase = LowerCaseRegex
+		}
+		if policy.HasSymbol {
+			data.HasSymbol = SymbolRegex
+		}
+		if policy.HasNumber {
+			data.HasNumber = NumberRegex
+		}
+	}
+	l.customTexts(r.Context(), translator, orgID)
+	l.renderer.RenderTemplate(w, r, translator, l.renderer.Templates[tmplAccountRecovery], data, nil)
+}
+
+func (l *Login) renderAccountRecoveryDone(w http.ResponseWriter, r *http.Request, orgID string) {
+	translator := l.getTranslator(r.Context(), nil)
+	data := l.getBaseData(r, nil, translator, "AccountRecoveryDone.Title", "AccountRecoveryDone.Description", nil)
+	l.customTexts(r.Context(), translator, orgID)
+	l.renderer.RenderTemplate(w, r, translator, l.renderer.Templates[tmplAccountRecoveryDone], data, nil)
+}
+
+func (l *Login) renderRecoveryEmailVerification(w http.ResponseWriter, r *http.Request, userID, orgID, code string, err error) {
+	translator := l.getTranslator(r.Context(), nil)
+	data := recoveryEmailVerificationData{
+		baseData: l.getBaseData(r, nil, translator, "RecoveryEmailVerification.Title", "RecoveryEmailVerification.Description", err),
+		UserID:   userID,
+		Code:     code,
+	}
+	l.customTexts(r.Context(), translator, orgID)
+	l.renderer.RenderTemplate(w, r, translator, l.renderer.Templates[tmplRecoveryEmailVerification], data, nil)
+}
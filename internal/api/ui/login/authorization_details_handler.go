@@ -0,0 +1,63 @@
+package login
+
+import (
+	"encoding/json"
+	"net/http"
+
+	http_mw "github.com/zitadel/zitadel/internal/api/http/middleware"
+	"github.com/zitadel/zitadel/internal/domain"
+)
+
+const (
+	tmplAuthorizationDetailsConsent = "authorizationdetailsconsent"
+)
+
+type authorizationDetailsConsentData struct {
+	userData
+	AuthorizationDetails []authorizationDetailData
+}
+
+type authorizationDetailData struct {
+	Type    string
+	Details string
+}
+
+type authorizationDetailsConsentFormData struct {
+	Granted bool `schema:"granted"`
+}
+
+func (l *Login) handleAuthorizationDetailsConsent(w http.ResponseWriter, r *http.Request) {
+	data := new(authorizationDetailsConsentFormData)
+	authReq, err := l.ensureAuthRequestAndParseData(r, data)
+	if err != nil {
+		l.renderError(w, r, authReq, err)
+		return
+	}
+	userAgentID, _ := http_mw.UserAgentIDFromCtx(r.Context())
+	err = l.authRepo.ConsentAuthorizationDetails(r.Context(), authReq.ID, userAgentID, data.Granted)
+	if err != nil {
+		l.renderError(w, r, authReq, err)
+		return
+	}
+	l.renderNextStep(w, r, authReq)
+}
+
+func (l *Login) renderAuthorizationDetailsConsent(w http.ResponseWriter, r *http.Request, authReq *domain.AuthRequest, step *domain.AuthorizationDetailsConsentStep, err error) {
+	translator := l.getTranslator(r.Context(), authReq)
+	data := &authorizationDetailsConsentData{
+		userData:             l.getUserData(r, authReq, translator, "AuthorizationDetailsConsent.Title", "AuthorizationDetailsConsent.Description", err),
+		AuthorizationDetails: make([]authorizationDetailData, len(step.AuthorizationDetails)),
+	}
+	for i, detail := range step.AuthorizationDetails {
+		details, err := json.MarshalIndent(detail, "", "  ")
+		if err != nil {
+			l.renderError(w, r, authReq, err)
+			return
+		}
+		data.AuthorizationDetails[i] = authorizationDetailData{
+			Type:    detail.Type(),
+			Details: string(details),
+		}
+	}
+	l.renderer.RenderTemplate(w, r, translator, l.renderer.Templates[tmplAuthorizationDetailsConsent], data, nil)
+}
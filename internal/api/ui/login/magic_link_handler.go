@@ -0,0 +1,81 @@
+package login
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+const (
+	tmplMagicLinkSent = "magiclinksent"
+)
+
+func MagicLinkLink(origin, userID, code, orgID, authRequestID string) string {
+	v := url.Values{}
+	v.Set(queryUserID, userID)
+	v.Set(queryCode, code)
+	v.Set(queryOrgID, orgID)
+	v.Set(QueryAuthRequestID, authRequestID)
+	return externalLink(origin) + EndpointMagicLink + "?" + v.Encode()
+}
+
+func MagicLinkLinkTemplate(origin, userID, orgID, authRequestID string) string {
+	return fmt.Sprintf("%s%s?%s=%s&%s=%s&%s=%s&%s=%s",
+		externalLink(origin), EndpointMagicLink,
+		queryUserID, userID,
+		queryCode, "{{.Code}}",
+		queryOrgID, orgID,
+		QueryAuthRequestID, authRequestID)
+}
+
+// handleMagicLinkRequest sends a login link to the verified email of the user of the auth request.
+// The link is bound to the auth request and the user agent of the browser.
+func (l *Login) handleMagicLinkRequest(w http.ResponseWriter, r *http.Request) {
+	authReq, err := l.ensureAuthRequest(r)
+	if err != nil {
+		l.renderError(w, r, authReq, err)
+		return
+	}
+	if authReq.LoginPolicy == nil || !authReq.LoginPolicy.AllowMagicLink {
+		l.renderError(w, r, authReq, zerrors.ThrowPreconditionFailed(nil, "LOGIN-Ml1Di", "Errors.User.MagicLink.Disabled"))
+		return
+	}
+	if authReq.UserID == "" {
+		l.renderError(w, r, authReq, zerrors.ThrowPreconditionFailed(nil, "LOGIN-Ml2Us", "Errors.User.NotFound"))
+		return
+	}
+	_, err = l.command.RequestMagicLink(setContext(r.Context(), authReq.UserOrgID), authReq.UserID, authReq.UserOrgID, authReq)
+	l.renderMagicLinkSent(w, r, authReq, err)
+}
+
+// handleMagicLink checks the code of a login link.
+// The auth request is loaded with the user agent of the browser, so the link only works where it was requested.
+func (l *Login) handleMagicLink(w http.ResponseWriter, r *http.Request) {
+	authReq, err := l.ensureAuthRequest(r)
+	if err != nil {
+		l.renderError(w, r, authReq, err)
+		return
+	}
+	userID := r.FormValue(queryUserID)
+	orgID := r.FormValue(queryOrgID)
+	code := r.FormValue(queryCode)
+	if authReq.UserID != userID {
+		l.renderError(w, r, authReq, zerrors.ThrowPreconditionFailed(nil, "LOGIN-Ml3Bm", "Errors.User.MagicLink.BindingMismatch"))
+		return
+	}
+	err = l.command.HumanCheckMagicLink(setContext(r.Context(), orgID), userID, orgID, code, authReq)
+	if err != nil {
+		l.renderError(w, r, authReq, err)
+		return
+	}
+	l.renderNextStep(w, r, authReq)
+}
+
+func (l *Login) renderMagicLinkSent(w http.ResponseWriter, r *http.Request, authReq *domain.AuthRequest, err error) {
+	translator := l.getTranslator(r.Context(), authReq)
+	data := l.getUserData(r, authReq, translator, "MagicLinkSent.Title", "MagicLinkSent.Description", err)
+	l.renderer.RenderTemplate(w, r, translator, l.renderer.Templates[tmplMagicLinkSent], data, nil)
+}
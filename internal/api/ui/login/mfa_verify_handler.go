@@ -8,7 +8,8 @@ import (
 )
 
 const (
-	tmplMFAVerify = "mfaverify"
+	tmplMFAVerify             = "mfaverify"
+	tmplMFAVerifyRecoveryCode = "mfaverifyrecoverycode"
 )
 
 type mfaVerifyFormData struct {
@@ -33,21 +34,30 @@ func (l *Login) handleMFAVerify(w http.ResponseWriter, r *http.Request) {
 		l.renderMFAVerifySelected(w, r, authReq, step, data.SelectedProvider, nil)
 		return
 	}
-	if data.MFAType == domain.MFATypeTOTP {
-		userAgentID, _ := http_mw.UserAgentIDFromCtx(r.Context())
+	var method authMethod
+	userAgentID, _ := http_mw.UserAgentIDFromCtx(r.Context())
+	switch data.MFAType {
+	case domain.MFATypeTOTP:
+		method = authMethodOTP
 		err = l.authRepo.VerifyMFAOTP(setContext(r.Context(), authReq.UserOrgID), authReq.ID, authReq.UserID, authReq.UserOrgID, data.Code, userAgentID, domain.BrowserInfoFromRequest(r))
+	case domain.MFATypeRecoveryCode:
+		method = authMethodRecoveryCode
+		err = l.authRepo.VerifyMFARecoveryCode(setContext(r.Context(), authReq.UserOrgID), authReq.UserID, authReq.UserOrgID, data.Code, authReq.ID, userAgentID, domain.BrowserInfoFromRequest(r))
+	default:
+		l.renderNextStep(w, r, authReq)
+		return
+	}
 
-		metadata, actionErr := l.runPostInternalAuthenticationActions(authReq, r, authMethodOTP, err)
-		if err == nil && actionErr == nil && len(metadata) > 0 {
-			_, err = l.command.BulkSetUserMetadata(r.Context(), authReq.UserID, authReq.UserOrgID, metadata...)
-		} else if actionErr != nil && err == nil {
-			err = actionErr
-		}
+	metadata, actionErr := l.runPostInternalAuthenticationActions(authReq, r, method, err)
+	if err == nil && actionErr == nil && len(metadata) > 0 {
+		_, err = l.command.BulkSetUserMetadata(r.Context(), authReq.UserID, authReq.UserOrgID, metadata...)
+	} else if actionErr != nil && err == nil {
+		err = actionErr
+	}
 
-		if err != nil {
-			l.renderMFAVerifySelected(w, r, authReq, step, domain.MFATypeTOTP, err)
-			return
-		}
+	if err != nil {
+		l.renderMFAVerifySelected(w, r, authReq, step, data.MFAType, err)
+		return
 	}
 	l.renderNextStep(w, r, authReq)
 }
@@ -80,6 +90,13 @@ func (l *Login) renderMFAVerifySelected(w http.ResponseWriter, r *http.Request,
 		data.SelectedMFAProvider = domain.MFATypeTOTP
 		data.Title = translator.LocalizeWithoutArgs("VerifyMFAOTP.Title")
 		data.Description = translator.LocalizeWithoutArgs("VerifyMFAOTP.Description")
+	case domain.MFATypeRecoveryCode:
+		data.MFAProviders = removeSelectedProviderFromList(verificationStep.MFAProviders, domain.MFATypeRecoveryCode)
+		data.SelectedMFAProvider = domain.MFATypeRecoveryCode
+		data.Title = translator.LocalizeWithoutArgs("VerifyMFARecoveryCode.Title")
+		data.Description = translator.LocalizeWithoutArgs("VerifyMFARecoveryCode.Description")
+		l.renderer.RenderTemplate(w, r, translator, l.renderer.Templates[tmplMFAVerifyRecoveryCode], data, nil)
+		return
 	case domain.MFATypeOTPSMS:
 		l.handleOTPVerification(w, r, authReq, verificationStep.MFAProviders, domain.MFATypeOTPSMS, nil)
 		return
@@ -52,7 +52,8 @@ func (l *Login) handleOTPVerification(w http.ResponseWriter, r *http.Request, au
 		// another type should never be passed, but just making sure
 	case domain.MFATypeU2F,
 		domain.MFATypeTOTP,
-		domain.MFATypeU2FUserVerification:
+		domain.MFATypeU2FUserVerification,
+		domain.MFATypeRecoveryCode:
 		l.renderError(w, r, authReq, err)
 		return
 	}
@@ -106,7 +107,8 @@ func (l *Login) handleOTPVerificationCheck(w http.ResponseWriter, r *http.Reques
 		// another type should never be passed, but just making sure
 	case domain.MFATypeU2F,
 		domain.MFATypeTOTP,
-		domain.MFATypeU2FUserVerification:
+		domain.MFATypeU2FUserVerification,
+		domain.MFATypeRecoveryCode:
 		l.renderOTPVerification(w, r, authReq, step.MFAProviders, formData.SelectedProvider, err)
 		return
 	}
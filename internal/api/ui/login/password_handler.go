@@ -24,6 +24,12 @@ func (l *Login) renderPassword(w http.ResponseWriter, r *http.Request, authReq *
 			}
 			return true
 		},
+		"showAccountRecovery": func() bool {
+			return authReq.LoginPolicy != nil && authReq.LoginPolicy.AllowAccountRecovery
+		},
+		"showMagicLink": func() bool {
+			return authReq.LoginPolicy != nil && authReq.LoginPolicy.AllowMagicLink
+		},
 	}
 	l.renderer.RenderTemplate(w, r, translator, l.renderer.Templates[tmplPassword], data, funcs)
 }
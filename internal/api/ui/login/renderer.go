@@ -46,45 +46,53 @@ func CreateRenderer(pathPrefix string, staticStorage static.Storage, cookieName
 		staticStorage: staticStorage,
 	}
 	tmplMapping := map[string]string{
-		tmplError:                        "error.html",
-		tmplSuccess:                      "success.html",
-		tmplLogin:                        "login.html",
-		tmplUserSelection:                "select_user.html",
-		tmplPassword:                     "password.html",
-		tmplPasswordlessVerification:     "passwordless.html",
-		tmplPasswordlessRegistration:     "passwordless_registration.html",
-		tmplPasswordlessRegistrationDone: "passwordless_registration_done.html",
-		tmplPasswordlessPrompt:           "passwordless_prompt.html",
-		tmplMFAVerify:                    "mfa_verify_totp.html",
-		tmplMFAPrompt:                    "mfa_prompt.html",
-		tmplMFAInitVerify:                "mfa_init_otp.html",
-		tmplMFASMSInit:                   "mfa_init_otp_sms.html",
-		tmplOTPVerification:              "mfa_verify_otp.html",
-		tmplMFAU2FInit:                   "mfa_init_u2f.html",
-		tmplU2FVerification:              "mfa_verification_u2f.html",
-		tmplMFAInitDone:                  "mfa_init_done.html",
-		tmplMailVerification:             "mail_verification.html",
-		tmplMailVerified:                 "mail_verified.html",
-		tmplInitPassword:                 "init_password.html",
-		tmplInitPasswordDone:             "init_password_done.html",
-		tmplInitUser:                     "init_user.html",
-		tmplInitUserDone:                 "init_user_done.html",
-		tmplInviteUser:                   "invite_user.html",
-		tmplPasswordResetDone:            "password_reset_done.html",
-		tmplChangePassword:               "change_password.html",
-		tmplChangePasswordDone:           "change_password_done.html",
-		tmplRegisterOption:               "register_option.html",
-		tmplRegister:                     "register.html",
-		tmplLogoutDone:                   "logout_done.html",
-		tmplRegisterOrg:                  "register_org.html",
-		tmplChangeUsername:               "change_username.html",
-		tmplChangeUsernameDone:           "change_username_done.html",
-		tmplLinkUsersDone:                "link_users_done.html",
-		tmplExternalNotFoundOption:       "external_not_found_option.html",
-		tmplLoginSuccess:                 "login_success.html",
-		tmplLDAPLogin:                    "ldap_login.html",
-		tmplDeviceAuthUserCode:           "device_usercode.html",
-		tmplDeviceAuthAction:             "device_action.html",
+		tmplError:                         "error.html",
+		tmplSuccess:                       "success.html",
+		tmplLogin:                         "login.html",
+		tmplUserSelection:                 "select_user.html",
+		tmplPassword:                      "password.html",
+		tmplPasswordlessVerification:      "passwordless.html",
+		tmplPasswordlessRegistration:      "passwordless_registration.html",
+		tmplPasswordlessRegistrationDone:  "passwordless_registration_done.html",
+		tmplPasswordlessPrompt:            "passwordless_prompt.html",
+		tmplMFAVerify:                     "mfa_verify_totp.html",
+		tmplMFAVerifyRecoveryCode:         "mfa_verify_recovery_code.html",
+		tmplMFAPrompt:                     "mfa_prompt.html",
+		tmplMFAInitVerify:                 "mfa_init_otp.html",
+		tmplMFASMSInit:                    "mfa_init_otp_sms.html",
+		tmplOTPVerification:               "mfa_verify_otp.html",
+		tmplMFAU2FInit:                    "mfa_init_u2f.html",
+		tmplU2FVerification:               "mfa_verification_u2f.html",
+		tmplMFAInitDone:                   "mfa_init_done.html",
+		tmplMailVerification:              "mail_verification.html",
+		tmplMailVerified:                  "mail_verified.html",
+		tmplRecoveryEmailVerification:     "recovery_email_verification.html",
+		tmplRecoveryEmailVerificationDone: "recovery_email_verification_done.html",
+		tmplAccountRecovery:               "account_recovery.html",
+		tmplAccountRecoveryRequested:      "account_recovery_requested.html",
+		tmplAccountRecoveryDone:           "account_recovery_done.html",
+		tmplMagicLinkSent:                 "magic_link_sent.html",
+		tmplInitPassword:                  "init_password.html",
+		tmplInitPasswordDone:              "init_password_done.html",
+		tmplInitUser:                      "init_user.html",
+		tmplInitUserDone:                  "init_user_done.html",
+		tmplInviteUser:                    "invite_user.html",
+		tmplPasswordResetDone:             "password_reset_done.html",
+		tmplChangePassword:                "change_password.html",
+		tmplChangePasswordDone:            "change_password_done.html",
+		tmplRegisterOption:                "register_option.html",
+		tmplRegister:                      "register.html",
+		tmplLogoutDone:                    "logout_done.html",
+		tmplRegisterOrg:                   "register_org.html",
+		tmplChangeUsername:                "change_username.html",
+		tmplChangeUsernameDone:            "change_username_done.html",
+		tmplLinkUsersDone:                 "link_users_done.html",
+		tmplExternalNotFoundOption:        "external_not_found_option.html",
+		tmplLoginSuccess:                  "login_success.html",
+		tmplLDAPLogin:                     "ldap_login.html",
+		tmplDeviceAuthUserCode:            "device_usercode.html",
+		tmplDeviceAuthAction:              "device_action.html",
+		tmplAuthorizationDetailsConsent:   "authorization_details_consent.html",
 	}
 	funcs := map[string]interface{}{
 		"resourceUrl": func(file string) string {
@@ -189,6 +197,18 @@ func CreateRenderer(pathPrefix string, staticStorage static.Storage, cookieName
 		"mailVerificationUrl": func() string {
 			return path.Join(r.pathPrefix, EndpointMailVerification)
 		},
+		"recoveryEmailVerificationUrl": func() string {
+			return path.Join(r.pathPrefix, EndpointRecoveryEmailVerification)
+		},
+		"accountRecoveryUrl": func() string {
+			return path.Join(r.pathPrefix, EndpointAccountRecovery)
+		},
+		"accountRecoveryRequestUrl": func(id string) string {
+			return path.Join(r.pathPrefix, fmt.Sprintf("%s?%s=%s", EndpointAccountRecoveryRequest, QueryAuthRequestID, id))
+		},
+		"magicLinkRequestUrl": func(id string) string {
+			return path.Join(r.pathPrefix, fmt.Sprintf("%s?%s=%s", EndpointMagicLinkRequest, QueryAuthRequestID, id))
+		},
 		"initPasswordUrl": func() string {
 			return path.Join(r.pathPrefix, EndpointInitPassword)
 		},
@@ -228,6 +248,12 @@ func CreateRenderer(pathPrefix string, staticStorage static.Storage, cookieName
 		"showPasswordReset": func() bool {
 			return true
 		},
+		"showAccountRecovery": func() bool {
+			return false
+		},
+		"showMagicLink": func() bool {
+			return false
+		},
 		"hasExternalLogin": func() bool {
 			return false
 		},
@@ -243,6 +269,9 @@ func CreateRenderer(pathPrefix string, staticStorage static.Storage, cookieName
 		"linkingUserPromptUrl": func() string {
 			return path.Join(r.pathPrefix, EndpointLinkingUserPrompt)
 		},
+		"authorizationDetailsConsentUrl": func() string {
+			return path.Join(r.pathPrefix, EndpointAuthorizationDetailsConsent)
+		},
 	}
 	var err error
 	r.Renderer, err = renderer.NewRenderer(
@@ -336,6 +365,8 @@ func (l *Login) chooseNextStep(w http.ResponseWriter, r *http.Request, authReq *
 		l.renderInternalError(w, r, authReq, zerrors.ThrowPreconditionFailed(nil, "APP-m92d", "Errors.User.ProjectRequired"))
 	case *domain.VerifyInviteStep:
 		l.renderInviteUser(w, r, authReq, "", "", "", "", nil)
+	case *domain.AuthorizationDetailsConsentStep:
+		l.renderAuthorizationDetailsConsent(w, r, authReq, step, err)
 	default:
 		l.renderInternalError(w, r, authReq, zerrors.ThrowInternal(nil, "APP-ds3QF", "step no possible"))
 	}
@@ -40,6 +40,11 @@ const (
 	EndpointU2FVerification               = "/mfa/u2f/verify"
 	EndpointMailVerification              = "/mail/verification"
 	EndpointMailVerified                  = "/mail/verified"
+	EndpointRecoveryEmailVerification     = "/mail/recovery/verification"
+	EndpointAccountRecovery               = "/account/recovery"
+	EndpointAccountRecoveryRequest        = "/account/recovery/request"
+	EndpointMagicLink                     = "/magiclink"
+	EndpointMagicLinkRequest              = "/magiclink/request"
 	EndpointRegisterOption                = "/register/option"
 	EndpointRegister                      = "/register"
 	EndpointExternalRegister              = "/register/externalidp"
@@ -48,6 +53,7 @@ const (
 	EndpointLogoutDone                    = "/logout/done"
 	EndpointLoginSuccess                  = "/login/success"
 	EndpointExternalNotFoundOption        = "/externaluser/option"
+	EndpointAuthorizationDetailsConsent   = "/authorizationdetails/consent"
 
 	EndpointResources        = "/resources"
 	EndpointDynamicResources = "/resources/dynamic"
@@ -108,6 +114,13 @@ func CreateRouter(login *Login, interceptors ...mux.MiddlewareFunc) *mux.Router
 	router.HandleFunc(EndpointU2FVerification, login.handleU2FVerification).Methods(http.MethodPost)
 	router.HandleFunc(EndpointMailVerification, login.handleMailVerification).Methods(http.MethodGet)
 	router.HandleFunc(EndpointMailVerification, login.handleMailVerificationCheck).Methods(http.MethodPost)
+	router.HandleFunc(EndpointRecoveryEmailVerification, login.handleRecoveryEmailVerification).Methods(http.MethodGet)
+	router.HandleFunc(EndpointRecoveryEmailVerification, login.handleRecoveryEmailVerificationCheck).Methods(http.MethodPost)
+	router.HandleFunc(EndpointAccountRecovery, login.handleAccountRecovery).Methods(http.MethodGet)
+	router.HandleFunc(EndpointAccountRecovery, login.handleAccountRecoveryCheck).Methods(http.MethodPost)
+	router.HandleFunc(EndpointAccountRecoveryRequest, login.handleAccountRecoveryRequest).Methods(http.MethodGet)
+	router.HandleFunc(EndpointMagicLink, login.handleMagicLink).Methods(http.MethodGet)
+	router.HandleFunc(EndpointMagicLinkRequest, login.handleMagicLinkRequest).Methods(http.MethodGet)
 	router.HandleFunc(EndpointChangePassword, login.handleChangePassword).Methods(http.MethodPost)
 	router.HandleFunc(EndpointRegisterOption, login.handleRegisterOption).Methods(http.MethodGet)
 	router.HandleFunc(EndpointRegisterOption, login.handleRegisterOptionCheck).Methods(http.MethodPost)
@@ -122,6 +135,7 @@ func CreateRouter(login *Login, interceptors ...mux.MiddlewareFunc) *mux.Router
 	router.HandleFunc(EndpointRegisterOrg, login.handleRegisterOrg).Methods(http.MethodGet)
 	router.HandleFunc(EndpointRegisterOrg, login.handleRegisterOrgCheck).Methods(http.MethodPost)
 	router.HandleFunc(EndpointLoginSuccess, login.handleLoginSuccess).Methods(http.MethodGet)
+	router.HandleFunc(EndpointAuthorizationDetailsConsent, login.handleAuthorizationDetailsConsent).Methods(http.MethodPost)
 	router.HandleFunc(EndpointLDAPLogin, login.handleLDAP).Methods(http.MethodGet)
 	router.HandleFunc(EndpointLDAPCallback, login.handleLDAPCallback).Methods(http.MethodPost)
 	router.SkipClean(true).Handle("", http.RedirectHandler(HandlerPrefix+"/", http.StatusMovedPermanently))
@@ -28,6 +28,7 @@ type AuthRequestRepository interface {
 	VerifyMFAOTPSMS(ctx context.Context, userID, resourceOwner, code, authRequestID, userAgentID string, info *domain.BrowserInfo) error
 	SendMFAOTPEmail(ctx context.Context, userID, resourceOwner, authRequestID, userAgentID string) error
 	VerifyMFAOTPEmail(ctx context.Context, userID, resourceOwner, code, authRequestID, userAgentID string, info *domain.BrowserInfo) error
+	VerifyMFARecoveryCode(ctx context.Context, userID, resourceOwner, code, authRequestID, userAgentID string, info *domain.BrowserInfo) error
 	BeginMFAU2FLogin(ctx context.Context, userID, resourceOwner, authRequestID, userAgentID string) (*domain.WebAuthNLogin, error)
 	VerifyMFAU2F(ctx context.Context, userID, resourceOwner, authRequestID, userAgentID string, credentialData []byte, info *domain.BrowserInfo) error
 	BeginPasswordlessSetup(ctx context.Context, userID, resourceOwner string, preferredPlatformType domain.AuthenticatorAttachment) (login *domain.WebAuthNToken, err error)
@@ -42,4 +43,5 @@ type AuthRequestRepository interface {
 	ResetLinkingUsers(ctx context.Context, authReqID, userAgentID string) error
 	ResetSelectedIDP(ctx context.Context, authReqID, userAgentID string) error
 	RequestLocalAuth(ctx context.Context, authReqID, userAgentID string) error
+	ConsentAuthorizationDetails(ctx context.Context, authReqID, userAgentID string, granted bool) error
 }
@@ -449,6 +449,16 @@ func (repo *AuthRequestRepo) VerifyMFAOTPEmail(ctx context.Context, userID, reso
 	return repo.Command.HumanCheckOTPEmail(ctx, userID, code, resourceOwner, request.WithCurrentInfo(info))
 }
 
+func (repo *AuthRequestRepo) VerifyMFARecoveryCode(ctx context.Context, userID, resourceOwner, code, authRequestID, userAgentID string, info *domain.BrowserInfo) (err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+	request, err := repo.getAuthRequestEnsureUser(ctx, authRequestID, userAgentID, userID)
+	if err != nil {
+		return err
+	}
+	return repo.Command.HumanCheckRecoveryCode(ctx, userID, code, resourceOwner, request.WithCurrentInfo(info))
+}
+
 func (repo *AuthRequestRepo) BeginMFAU2FLogin(ctx context.Context, userID, resourceOwner, authRequestID, userAgentID string) (login *domain.WebAuthNLogin, err error) {
 	ctx, span := tracing.NewSpan(ctx)
 	defer func() { span.EndWithError(err) }()
@@ -572,6 +582,23 @@ func (repo *AuthRequestRepo) RequestLocalAuth(ctx context.Context, authReqID, us
 	return repo.AuthRequests.UpdateAuthRequest(ctx, request)
 }
 
+// ConsentAuthorizationDetails stores the decision of the user on the authorization details requested by the client.
+// A denied consent will end the auth request with an access_denied error on the callback.
+func (repo *AuthRequestRepo) ConsentAuthorizationDetails(ctx context.Context, authReqID, userAgentID string, granted bool) error {
+	request, err := repo.getAuthRequest(ctx, authReqID, userAgentID)
+	if err != nil {
+		return err
+	}
+	if len(request.RequestedAuthorizationDetails()) == 0 {
+		return zerrors.ThrowPreconditionFailed(nil, "EVENT-Rar1c", "Errors.AuthorizationDetails.NotRequested")
+	}
+	request.AuthorizationDetailsConsent = domain.AuthorizationDetailsConsentDenied
+	if granted {
+		request.AuthorizationDetailsConsent = domain.AuthorizationDetailsConsentGranted
+	}
+	return repo.AuthRequests.UpdateAuthRequest(ctx, request)
+}
+
 func (repo *AuthRequestRepo) AutoRegisterExternalUser(ctx context.Context, registerUser *domain.Human, externalIDP *domain.UserIDPLink, orgMemberRoles []string, authReqID, userAgentID, resourceOwner string, metadatas []*domain.Metadata, info *domain.BrowserInfo) (err error) {
 	ctx, span := tracing.NewSpan(ctx)
 	defer func() { span.EndWithError(err) }()
@@ -981,6 +1008,10 @@ func queryLoginPolicyToDomain(policy *query.LoginPolicy) *domain.LoginPolicy {
 		MultiFactorCheckLifetime:   time.Duration(policy.MultiFactorCheckLifetime),
 		DisableLoginWithEmail:      policy.DisableLoginWithEmail,
 		DisableLoginWithPhone:      policy.DisableLoginWithPhone,
+		ForceMFAOnHighRisk:         policy.ForceMFAOnHighRisk,
+		TrustedDeviceLifetime:      time.Duration(policy.TrustedDeviceLifetime),
+		AllowAccountRecovery:       policy.AllowAccountRecovery,
+		AllowMagicLink:             policy.AllowMagicLink,
 	}
 }
 
@@ -1129,7 +1160,16 @@ func (repo *AuthRequestRepo) nextSteps(ctx context.Context, request *domain.Auth
 	if request.LinkingUsers != nil && len(request.LinkingUsers) != 0 {
 		return append(steps, &domain.LinkUsersStep{}), nil
 	}
-	//PLANNED: consent step
+	if authorizationDetails := request.RequestedAuthorizationDetails(); len(authorizationDetails) > 0 {
+		switch request.AuthorizationDetailsConsent {
+		case domain.AuthorizationDetailsConsentPending:
+			return append(steps, &domain.AuthorizationDetailsConsentStep{AuthorizationDetails: authorizationDetails}), nil
+		case domain.AuthorizationDetailsConsentDenied:
+			// the callback will return an access_denied error to the client
+			return append(steps, &domain.RedirectToCallbackStep{}), nil
+		case domain.AuthorizationDetailsConsentGranted:
+		}
+	}
 
 	missing, err := projectRequired(ctx, request, repo.ProjectProvider)
 	if err != nil {
@@ -1312,6 +1352,12 @@ func (repo *AuthRequestRepo) firstFactorChecked(ctx context.Context, request *do
 		return &domain.InitPasswordStep{}
 	}
 
+	// a magic link is checked against the same lifetime as the password, since it replaces the password check
+	if request.LoginPolicy.AllowMagicLink && checkVerificationTimeMaxAge(userSession.MagicLinkVerification, request.LoginPolicy.PasswordCheckLifetime, request) {
+		request.MagicLinkVerified = true
+		request.AuthTime = userSession.MagicLinkVerification
+		return nil
+	}
 	if checkVerificationTimeMaxAge(userSession.PasswordVerification, request.LoginPolicy.PasswordCheckLifetime, request) {
 		request.PasswordVerified = true
 		request.AuthTime = userSession.PasswordVerification
@@ -1631,6 +1677,8 @@ var (
 		user_repo.HumanPasswordlessTokenCheckFailedType,
 		user_repo.HumanU2FTokenCheckSucceededType,
 		user_repo.HumanU2FTokenCheckFailedType,
+		user_repo.HumanMagicLinkCheckSucceededType,
+		user_repo.HumanMagicLinkCheckFailedType,
 		user_repo.UserRemovedType,
 	}
 )
@@ -1679,7 +1727,9 @@ func userSessionByIDs(ctx context.Context, provider userSessionViewProvider, eve
 			user_repo.HumanPasswordlessTokenCheckSucceededType,
 			user_repo.HumanPasswordlessTokenCheckFailedType,
 			user_repo.HumanU2FTokenCheckSucceededType,
-			user_repo.HumanU2FTokenCheckFailedType:
+			user_repo.HumanU2FTokenCheckFailedType,
+			user_repo.HumanMagicLinkCheckSucceededType,
+			user_repo.HumanMagicLinkCheckFailedType:
 			userAgentID, err := user_view_model.UserAgentIDFromEvent(event)
 			if err != nil {
 				logging.WithFields("traceID", tracing.TraceIDFromCtx(ctx)).WithError(err).Debug("error getting event data")
@@ -111,6 +111,14 @@ func (s *UserSession) Reducers() []handler.AggregateReducer {
 					Event:  user.HumanU2FTokenCheckFailedType,
 					Reduce: s.Reduce,
 				},
+				{
+					Event:  user.HumanMagicLinkCheckSucceededType,
+					Reduce: s.Reduce,
+				},
+				{
+					Event:  user.HumanMagicLinkCheckFailedType,
+					Reduce: s.Reduce,
+				},
 				{
 					Event:  user.HumanPasswordlessTokenCheckSucceededType,
 					Reduce: s.Reduce,
@@ -252,6 +260,22 @@ func (u *UserSession) Reduce(event eventstore.Event) (_ *handler.Statement, err
 			return nil, err
 		}
 		return handler.NewUpsertStatement(event, columns[0:3], columns), nil
+	case user.HumanMagicLinkCheckSucceededType:
+		columns, err := u.sessionColumnsActivate(event,
+			handler.NewCol(view_model.UserSessionKeyMagicLinkVerification, event.CreatedAt()),
+		)
+		if err != nil {
+			return nil, err
+		}
+		return handler.NewUpsertStatement(event, columns[0:3], columns), nil
+	case user.HumanMagicLinkCheckFailedType:
+		columns, err := u.sessionColumnsActivate(event,
+			handler.NewCol(view_model.UserSessionKeyMagicLinkVerification, time.Time{}),
+		)
+		if err != nil {
+			return nil, err
+		}
+		return handler.NewUpsertStatement(event, columns[0:3], columns), nil
 	case user.UserV1MFAOTPCheckSucceededType,
 		user.HumanMFAOTPCheckSucceededType:
 		columns, err := u.sessionColumnsActivate(event,
@@ -282,6 +306,7 @@ func (u *UserSession) Reduce(event eventstore.Event) (_ *handler.Statement, err
 			handler.NewCol(view_model.UserSessionKeyMultiFactorVerification, time.Time{}),
 			handler.NewCol(view_model.UserSessionKeyMultiFactorVerificationType, domain.MFALevelNotSetUp),
 			handler.NewCol(view_model.UserSessionKeyExternalLoginVerification, time.Time{}),
+			handler.NewCol(view_model.UserSessionKeyMagicLinkVerification, time.Time{}),
 			handler.NewCol(view_model.UserSessionKeyState, domain.UserSessionStateTerminated),
 		)
 		if err != nil {
@@ -356,6 +381,7 @@ func (u *UserSession) Reduce(event eventstore.Event) (_ *handler.Statement, err
 				handler.NewCol(view_model.UserSessionKeyMultiFactorVerification, time.Time{}),
 				handler.NewCol(view_model.UserSessionKeyMultiFactorVerificationType, domain.MFALevelNotSetUp),
 				handler.NewCol(view_model.UserSessionKeyExternalLoginVerification, time.Time{}),
+				handler.NewCol(view_model.UserSessionKeyMagicLinkVerification, time.Time{}),
 				handler.NewCol(view_model.UserSessionKeyState, domain.UserSessionStateTerminated),
 				handler.NewCol(view_model.UserSessionKeyChangeDate, event.CreatedAt()),
 				handler.NewCol(view_model.UserSessionKeySequence, event.Sequence()),
@@ -231,6 +231,18 @@ func authMethodsFromSession(session *query.Session) []domain.UserAuthMethodType
 	if !session.OTPEmailFactor.OTPCheckedAt.IsZero() {
 		types = append(types, domain.UserAuthMethodTypeOTPEmail)
 	}
+	if !session.TrustedDeviceFactor.TrustedDeviceCheckedAt.IsZero() {
+		types = append(types, domain.UserAuthMethodTypeTrustedDevice)
+	}
+	if !session.RecoveryCodeFactor.RecoveryCodeCheckedAt.IsZero() {
+		types = append(types, domain.UserAuthMethodTypeRecoveryCode)
+	}
+	if !session.ClientCertificateFactor.ClientCertificateCheckedAt.IsZero() {
+		types = append(types, domain.UserAuthMethodTypeClientCertificate)
+	}
+	if !session.MagicLinkFactor.MagicLinkCheckedAt.IsZero() {
+		types = append(types, domain.UserAuthMethodTypeMagicLink)
+	}
 	return types
 }
 
@@ -0,0 +1,59 @@
+package eventstore
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/query"
+)
+
+func Test_authMethodsFromSession(t *testing.T) {
+	checkedAt := time.Now()
+	tests := []struct {
+		name    string
+		session *query.Session
+		want    []domain.UserAuthMethodType
+		wantMFA bool
+	}{
+		{
+			name:    "no factors",
+			session: &query.Session{},
+			want:    []domain.UserAuthMethodType{},
+		},
+		{
+			name: "password",
+			session: &query.Session{
+				PasswordFactor: query.SessionPasswordFactor{PasswordCheckedAt: checkedAt},
+			},
+			want: []domain.UserAuthMethodType{domain.UserAuthMethodTypePassword},
+		},
+		{
+			name: "password and trusted device",
+			session: &query.Session{
+				PasswordFactor:      query.SessionPasswordFactor{PasswordCheckedAt: checkedAt},
+				TrustedDeviceFactor: query.SessionTrustedDeviceFactor{TrustedDeviceCheckedAt: checkedAt},
+			},
+			want:    []domain.UserAuthMethodType{domain.UserAuthMethodTypePassword, domain.UserAuthMethodTypeTrustedDevice},
+			wantMFA: true,
+		},
+		{
+			name: "password and totp",
+			session: &query.Session{
+				PasswordFactor: query.SessionPasswordFactor{PasswordCheckedAt: checkedAt},
+				TOTPFactor:     query.SessionTOTPFactor{TOTPCheckedAt: checkedAt},
+			},
+			want:    []domain.UserAuthMethodType{domain.UserAuthMethodTypePassword, domain.UserAuthMethodTypeTOTP},
+			wantMFA: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := authMethodsFromSession(tt.session)
+			assert.Equal(t, tt.want, got)
+			assert.Equal(t, tt.wantMFA, domain.HasMFA(got))
+		})
+	}
+}
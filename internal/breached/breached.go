@@ -0,0 +1,72 @@
+// Package breached checks passwords against a local corpus of breached passwords.
+//
+// The corpus is partitioned the same way as the k-anonymity range API of "Have I Been Pwned":
+// the upper case hex encoded SHA-1 hash of a password is split into a prefix of 5 and a suffix of 35 characters.
+// Only the passwords sharing the prefix have to be looked at, which allows to keep the corpus on the file system
+// or in the database without the need to call an external service.
+package breached
+
+import (
+	"context"
+	"crypto/sha1"
+	"encoding/hex"
+	"strings"
+
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+const (
+	prefixLength = 5
+	hashLength   = sha1.Size * 2
+)
+
+// Checker checks if a password is part of the breached password corpus.
+type Checker interface {
+	IsBreached(ctx context.Context, password string) (bool, error)
+}
+
+type Source string
+
+const (
+	// SourceNone disables the check.
+	SourceNone Source = ""
+	// SourceFiles reads the corpus from a directory containing a file per prefix (e.g. `5BAA6.txt`),
+	// each line consisting of the suffix and the number of occurrences (`1E4C9B93F3F0682250B6CF8331B7EE68FD8:9545824`).
+	SourceFiles Source = "files"
+	// SourceDatabase reads the corpus from the database, loaded by `zitadel breached-passwords load`.
+	SourceDatabase Source = "database"
+)
+
+type Config struct {
+	// Source of the corpus, the check is disabled if not set.
+	Source Source
+	Files  FilesConfig
+}
+
+type FilesConfig struct {
+	// Path of the directory containing the prefix files.
+	Path string
+}
+
+// NewChecker returns the [Checker] for the configured source.
+// If no source is configured, nil is returned.
+func (c *Config) NewChecker(client *database.DB) (Checker, error) {
+	switch c.Source {
+	case SourceNone:
+		return nil, nil
+	case SourceFiles:
+		return NewFileChecker(c.Files.Path)
+	case SourceDatabase:
+		return NewDatabaseChecker(client), nil
+	default:
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "BREACH-Sr1ce", "unknown source %q", c.Source)
+	}
+}
+
+// Hash returns the prefix and suffix of the upper case hex encoded SHA-1 hash of the password.
+func Hash(password string) (prefix, suffix string) {
+	sum := sha1.Sum([]byte(password)) //nolint:gosec // SHA-1 is used by the corpus, not for hashing secrets
+	hash := strings.ToUpper(hex.EncodeToString(sum[:]))
+	return hash[:prefixLength], hash[prefixLength:]
+}
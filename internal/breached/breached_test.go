@@ -0,0 +1,263 @@
+package breached
+
+import (
+	"context"
+	"database/sql/driver"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/database/mock"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+func TestHash(t *testing.T) {
+	prefix, suffix := Hash("password")
+	assert.Equal(t, "5BAA6", prefix)
+	assert.Equal(t, "1E4C9B93F3F0682250B6CF8331B7EE68FD8", suffix)
+}
+
+func TestConfig_NewChecker(t *testing.T) {
+	tests := []struct {
+		name    string
+		config  Config
+		wantNil bool
+		wantErr func(error) bool
+	}{
+		{
+			name:    "disabled",
+			config:  Config{},
+			wantNil: true,
+		},
+		{
+			name:   "database",
+			config: Config{Source: SourceDatabase},
+		},
+		{
+			name:   "files",
+			config: Config{Source: SourceFiles, Files: FilesConfig{Path: t.TempDir()}},
+		},
+		{
+			name:    "files, missing path",
+			config:  Config{Source: SourceFiles, Files: FilesConfig{Path: filepath.Join(t.TempDir(), "missing")}},
+			wantErr: zerrors.IsErrorInvalidArgument,
+		},
+		{
+			name:    "unknown source",
+			config:  Config{Source: "unknown"},
+			wantErr: zerrors.IsErrorInvalidArgument,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.config.NewChecker(new(database.DB))
+			if tt.wantErr != nil {
+				assert.True(t, tt.wantErr(err), "unexpected error: %v", err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantNil, got == nil)
+		})
+	}
+}
+
+func TestFileChecker_IsBreached(t *testing.T) {
+	path := t.TempDir()
+	err := os.WriteFile(filepath.Join(path, "5BAA6.txt"), []byte("003D68EB55068C33ACE09247EE4C639306B:3\r\n1E4C9B93F3F0682250B6CF8331B7EE68FD8:9545824\r\n"), 0o600)
+	require.NoError(t, err)
+	err = os.WriteFile(filepath.Join(path, "BAA67.txt"), []byte("0018A45C4D1DEF81644B54AB7F969B88D65:1\r\n"), 0o600)
+	require.NoError(t, err)
+	checker, err := NewFileChecker(path)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name     string
+		password string
+		want     bool
+	}{
+		{
+			name:     "breached",
+			password: "password",
+			want:     true,
+		},
+		{
+			name:     "hash not in prefix file, not breached",
+			password: "Sup3r-S3cr3t!",
+		},
+		{
+			name:     "missing prefix file, not breached",
+			password: "password1",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := checker.IsBreached(context.Background(), tt.password)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestDatabaseChecker_IsBreached(t *testing.T) {
+	tests := []struct {
+		name    string
+		mock    func(*testing.T) *mock.SQLMock
+		want    bool
+		wantErr func(error) bool
+	}{
+		{
+			name: "query error",
+			mock: func(t *testing.T) *mock.SQLMock {
+				return mock.NewSQLMock(t,
+					mock.ExpectQuery(isBreachedStmt,
+						mock.WithQueryArgs("5BAA6", "1E4C9B93F3F0682250B6CF8331B7EE68FD8"),
+						mock.WithQueryErr(zerrors.ThrowInternal(nil, "id", "message")),
+					),
+				)
+			},
+			wantErr: zerrors.IsInternal,
+		},
+		{
+			name: "not breached",
+			mock: func(t *testing.T) *mock.SQLMock {
+				return mock.NewSQLMock(t,
+					mock.ExpectQuery(isBreachedStmt,
+						mock.WithQueryArgs("5BAA6", "1E4C9B93F3F0682250B6CF8331B7EE68FD8"),
+						mock.WithQueryResult([]string{"exists"}, [][]driver.Value{{false}}),
+					),
+				)
+			},
+		},
+		{
+			name: "breached",
+			mock: func(t *testing.T) *mock.SQLMock {
+				return mock.NewSQLMock(t,
+					mock.ExpectQuery(isBreachedStmt,
+						mock.WithQueryArgs("5BAA6", "1E4C9B93F3F0682250B6CF8331B7EE68FD8"),
+						mock.WithQueryResult([]string{"exists"}, [][]driver.Value{{true}}),
+					),
+				)
+			},
+			want: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mock := tt.mock(t)
+			defer mock.Assert(t)
+			got, err := NewDatabaseChecker(&database.DB{DB: mock.DB}).IsBreached(context.Background(), "password")
+			if tt.wantErr != nil {
+				assert.True(t, tt.wantErr(err), "unexpected error: %v", err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestLoad(t *testing.T) {
+	tests := []struct {
+		name      string
+		input     string
+		prefix    string
+		mock      func(*testing.T) *mock.SQLMock
+		wantCount int
+		wantErr   func(error) bool
+	}{
+		{
+			name:  "invalid hash",
+			input: "5BAA61E4C9B93F3F0682250B6CF8331B7EE68FD:1",
+			mock: func(t *testing.T) *mock.SQLMock {
+				return mock.NewSQLMock(t)
+			},
+			wantErr: zerrors.IsErrorInvalidArgument,
+		},
+		{
+			name:  "invalid count",
+			input: "5BAA61E4C9B93F3F0682250B6CF8331B7EE68FD8:many",
+			mock: func(t *testing.T) *mock.SQLMock {
+				return mock.NewSQLMock(t)
+			},
+			wantErr: zerrors.IsErrorInvalidArgument,
+		},
+		{
+			name:  "empty",
+			input: "\n",
+			mock: func(t *testing.T) *mock.SQLMock {
+				return mock.NewSQLMock(t)
+			},
+		},
+		{
+			name:  "full hashes",
+			input: "5baa61e4c9b93f3f0682250b6cf8331b7ee68fd8:9545824\r\n7C4A8D09CA3762AF61E59520943DC26494F8941B\n",
+			mock: func(t *testing.T) *mock.SQLMock {
+				return mock.NewSQLMock(t,
+					mock.ExcpectExec(insertStmt+"($1, $2, $3), ($4, $5, $6)"+onConflictStmt,
+						mock.WithExecArgs("5BAA6", "1E4C9B93F3F0682250B6CF8331B7EE68FD8", int64(9545824), "7C4A8", "D09CA3762AF61E59520943DC26494F8941B", int64(0)),
+						mock.WithExecRowsAffected(2),
+					),
+				)
+			},
+			wantCount: 2,
+		},
+		{
+			name:  "duplicate hashes",
+			input: "5BAA61E4C9B93F3F0682250B6CF8331B7EE68FD8:1\n7C4A8D09CA3762AF61E59520943DC26494F8941B:2\n5baa61e4c9b93f3f0682250b6cf8331b7ee68fd8:3\n",
+			mock: func(t *testing.T) *mock.SQLMock {
+				return mock.NewSQLMock(t,
+					mock.ExcpectExec(insertStmt+"($1, $2, $3), ($4, $5, $6)"+onConflictStmt,
+						mock.WithExecArgs("5BAA6", "1E4C9B93F3F0682250B6CF8331B7EE68FD8", int64(3), "7C4A8", "D09CA3762AF61E59520943DC26494F8941B", int64(2)),
+						mock.WithExecRowsAffected(2),
+					),
+				)
+			},
+			wantCount: 3,
+		},
+		{
+			name:   "prefix file",
+			input:  "1E4C9B93F3F0682250B6CF8331B7EE68FD8:9545824\n",
+			prefix: "5baa6",
+			mock: func(t *testing.T) *mock.SQLMock {
+				return mock.NewSQLMock(t,
+					mock.ExcpectExec(insertStmt+"($1, $2, $3)"+onConflictStmt,
+						mock.WithExecArgs("5BAA6", "1E4C9B93F3F0682250B6CF8331B7EE68FD8", int64(9545824)),
+						mock.WithExecRowsAffected(1),
+					),
+				)
+			},
+			wantCount: 1,
+		},
+		{
+			name:  "insert error",
+			input: "5BAA61E4C9B93F3F0682250B6CF8331B7EE68FD8:9545824\n",
+			mock: func(t *testing.T) *mock.SQLMock {
+				return mock.NewSQLMock(t,
+					mock.ExcpectExec(insertStmt+"($1, $2, $3)"+onConflictStmt,
+						mock.WithExecArgs("5BAA6", "1E4C9B93F3F0682250B6CF8331B7EE68FD8", int64(9545824)),
+						mock.WithExecErr(zerrors.ThrowInternal(nil, "id", "message")),
+					),
+				)
+			},
+			wantErr: zerrors.IsInternal,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mock := tt.mock(t)
+			defer mock.Assert(t)
+			count, err := Load(context.Background(), &database.DB{DB: mock.DB}, strings.NewReader(tt.input), tt.prefix)
+			if tt.wantErr != nil {
+				assert.True(t, tt.wantErr(err), "unexpected error: %v", err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantCount, count)
+		})
+	}
+}
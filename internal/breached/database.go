@@ -0,0 +1,142 @@
+package breached
+
+import (
+	"bufio"
+	"context"
+	"database/sql"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+const (
+	isBreachedStmt = "SELECT EXISTS (SELECT 1 FROM system.breached_passwords WHERE prefix = $1 AND suffix = $2)"
+	insertStmt     = "INSERT INTO system.breached_passwords (prefix, suffix, occurrences) VALUES "
+	onConflictStmt = " ON CONFLICT (prefix, suffix) DO UPDATE SET occurrences = EXCLUDED.occurrences"
+
+	// loadBatchSize is the amount of hashes inserted per statement
+	loadBatchSize = 1000
+)
+
+type databaseChecker struct {
+	client *database.DB
+}
+
+// NewDatabaseChecker returns a [Checker] reading the corpus from the system.breached_passwords table.
+func NewDatabaseChecker(client *database.DB) Checker {
+	return &databaseChecker{client: client}
+}
+
+func (c *databaseChecker) IsBreached(ctx context.Context, password string) (breached bool, err error) {
+	prefix, suffix := Hash(password)
+	err = c.client.QueryRowContext(ctx, func(row *sql.Row) error {
+		return row.Scan(&breached)
+	}, isBreachedStmt, prefix, suffix)
+	if err != nil {
+		return false, zerrors.ThrowInternal(err, "BREACH-Db1ch", "unable to query breached password corpus")
+	}
+	return breached, nil
+}
+
+type entry struct {
+	prefix, suffix string
+	occurrences    int64
+}
+
+// Load inserts the hashes read from r into the system.breached_passwords table and returns the amount of hashes read.
+// If prefix is empty, each line must contain the full hash (`HASH:COUNT`),
+// otherwise the lines contain the suffix only, as in the prefix files (`SUFFIX:COUNT`).
+// The count is optional, existing hashes are updated.
+func Load(ctx context.Context, client *database.DB, r io.Reader, prefix string) (count int, err error) {
+	batch := make([]entry, 0, loadBatchSize)
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" {
+			continue
+		}
+		e, err := parseLine(line, prefix)
+		if err != nil {
+			return count, err
+		}
+		batch = append(batch, e)
+		if len(batch) < loadBatchSize {
+			continue
+		}
+		if err = insertBatch(ctx, client, batch); err != nil {
+			return count, err
+		}
+		count += len(batch)
+		batch = batch[:0]
+	}
+	if err = scanner.Err(); err != nil {
+		return count, zerrors.ThrowInternal(err, "BREACH-Lo1ad", "unable to read breached password corpus")
+	}
+	if err = insertBatch(ctx, client, batch); err != nil {
+		return count, err
+	}
+	return count + len(batch), nil
+}
+
+func parseLine(line, prefix string) (e entry, err error) {
+	hash, occurrences, hasOccurrences := strings.Cut(line, ":")
+	hash = strings.ToUpper(hash)
+	if prefix != "" {
+		hash = strings.ToUpper(prefix) + hash
+	}
+	if len(hash) != hashLength || strings.Trim(hash, "0123456789ABCDEF") != "" {
+		return e, zerrors.ThrowInvalidArgumentf(nil, "BREACH-Lo2ad", "invalid hash in line %q", line)
+	}
+	e.prefix, e.suffix = hash[:prefixLength], hash[prefixLength:]
+	if !hasOccurrences {
+		return e, nil
+	}
+	e.occurrences, err = strconv.ParseInt(occurrences, 10, 64)
+	if err != nil {
+		return e, zerrors.ThrowInvalidArgumentf(err, "BREACH-Lo3ad", "invalid count in line %q", line)
+	}
+	return e, nil
+}
+
+// insertBatch inserts the entries of the batch in one statement.
+// Duplicate hashes are removed beforehand, as an upsert can't update the same row twice,
+// the occurrences of the last duplicate are used.
+func insertBatch(ctx context.Context, client *database.DB, batch []entry) error {
+	batch = dedupeBatch(batch)
+	if len(batch) == 0 {
+		return nil
+	}
+	var stmt strings.Builder
+	stmt.WriteString(insertStmt)
+	args := make([]any, 0, len(batch)*3)
+	for i, e := range batch {
+		if i > 0 {
+			stmt.WriteString(", ")
+		}
+		stmt.WriteString("($" + strconv.Itoa(len(args)+1) + ", $" + strconv.Itoa(len(args)+2) + ", $" + strconv.Itoa(len(args)+3) + ")")
+		args = append(args, e.prefix, e.suffix, e.occurrences)
+	}
+	stmt.WriteString(onConflictStmt)
+	_, err := client.ExecContext(ctx, stmt.String(), args...)
+	if err != nil {
+		return zerrors.ThrowInternal(err, "BREACH-Lo4ad", "unable to insert breached passwords")
+	}
+	return nil
+}
+
+func dedupeBatch(batch []entry) []entry {
+	positions := make(map[string]int, len(batch))
+	deduped := make([]entry, 0, len(batch))
+	for _, e := range batch {
+		if i, ok := positions[e.prefix+e.suffix]; ok {
+			deduped[i] = e
+			continue
+		}
+		positions[e.prefix+e.suffix] = len(deduped)
+		deduped = append(deduped, e)
+	}
+	return deduped
+}
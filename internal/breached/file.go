@@ -0,0 +1,54 @@
+package breached
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+type fileChecker struct {
+	path string
+}
+
+// NewFileChecker returns a [Checker] reading the corpus from the prefix files in the directory at path.
+// A missing prefix file is treated as no password with this prefix being breached.
+func NewFileChecker(path string) (Checker, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, zerrors.ThrowInvalidArgument(err, "BREACH-Fi1es", "breached password corpus not found")
+	}
+	if !info.IsDir() {
+		return nil, zerrors.ThrowInvalidArgument(nil, "BREACH-Fi2es", "breached password corpus must be a directory")
+	}
+	return &fileChecker{path: path}, nil
+}
+
+func (c *fileChecker) IsBreached(_ context.Context, password string) (bool, error) {
+	prefix, suffix := Hash(password)
+	file, err := os.Open(filepath.Join(c.path, prefix+".txt"))
+	if errors.Is(err, fs.ErrNotExist) {
+		return false, nil
+	}
+	if err != nil {
+		return false, zerrors.ThrowInternal(err, "BREACH-Fi3es", "unable to open breached password corpus")
+	}
+	defer file.Close()
+
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		lineSuffix, _, _ := strings.Cut(strings.TrimSpace(scanner.Text()), ":")
+		if strings.EqualFold(lineSuffix, suffix) {
+			return true, nil
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return false, zerrors.ThrowInternal(err, "BREACH-Fi4es", "unable to read breached password corpus")
+	}
+	return false, nil
+}
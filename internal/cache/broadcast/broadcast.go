@@ -0,0 +1,200 @@
+// Package broadcast distributes cache invalidations to all ZITADEL servers.
+// It allows the use of local caches, like the memory connector, in deployments with multiple servers.
+package broadcast
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/zitadel/logging"
+
+	"github.com/zitadel/zitadel/internal/cache"
+)
+
+// maxPayloadSize is the maximum size of a NOTIFY payload, which must be shorter than 8000 bytes.
+const maxPayloadSize = 7999
+
+type Config struct {
+	Enabled bool
+	// Channel used for LISTEN / NOTIFY.
+	Channel string
+	// ReconnectInterval is the time waited before the listener reconnects after a failure.
+	ReconnectInterval time.Duration
+}
+
+type action string
+
+const (
+	actionInvalidate action = "invalidate"
+	actionDelete     action = "delete"
+	actionTruncate   action = "truncate"
+)
+
+// message is the payload sent to the other servers.
+type message struct {
+	Origin  string   `json:"origin"`
+	Purpose string   `json:"purpose"`
+	Action  action   `json:"action"`
+	Index   int      `json:"index,omitempty"`
+	Keys    []string `json:"keys,omitempty"`
+}
+
+// receiver applies the messages of other servers to the local cache.
+type receiver interface {
+	receive(ctx context.Context, msg *message)
+	truncate(ctx context.Context)
+}
+
+type publishFunc func(ctx context.Context, payload string) error
+
+// Bus sends the invalidations of the local caches to the other servers
+// and applies the invalidations received from them.
+type Bus struct {
+	// origin identifies the messages of this server, which are ignored when received.
+	origin  string
+	config  Config
+	pool    *pgxpool.Pool
+	publish publishFunc
+
+	mutex     sync.RWMutex
+	receivers map[cache.Purpose]receiver
+	listen    sync.Once
+}
+
+// NewBus returns a bus using Postgres LISTEN / NOTIFY.
+// If the config is not enabled, nil is returned.
+func NewBus(config Config, pool *pgxpool.Pool) *Bus {
+	if !config.Enabled {
+		return nil
+	}
+	b := newBus(config, nil)
+	b.pool = pool
+	b.publish = b.notify
+	return b
+}
+
+func newBus(config Config, publish publishFunc) *Bus {
+	return &Bus{
+		origin:    uuid.NewString(),
+		config:    config,
+		publish:   publish,
+		receivers: make(map[cache.Purpose]receiver),
+	}
+}
+
+func (b *Bus) register(background context.Context, purpose cache.Purpose, r receiver) {
+	b.mutex.Lock()
+	b.receivers[purpose] = r
+	b.mutex.Unlock()
+
+	if b.pool != nil {
+		b.listen.Do(func() {
+			go b.listenLoop(background)
+		})
+	}
+}
+
+// send publishes the action to the other servers.
+// The keys are split into multiple messages if they exceed the maximum payload size.
+func (b *Bus) send(ctx context.Context, purpose cache.Purpose, act action, index int, keys []string) {
+	msg := &message{
+		Origin:  b.origin,
+		Purpose: purpose.String(),
+		Action:  act,
+		Index:   index,
+	}
+	chunks, err := chunkKeys(msg, keys)
+	if err != nil {
+		logging.WithError(err).WithField("purpose", purpose).Error("cache broadcast: marshal message")
+		return
+	}
+	for _, chunk := range chunks {
+		msg.Keys = chunk
+		payload, err := json.Marshal(msg)
+		if err != nil {
+			logging.WithError(err).WithField("purpose", purpose).Error("cache broadcast: marshal message")
+			return
+		}
+		err = b.publish(ctx, string(payload))
+		logging.OnError(err).WithField("purpose", purpose).Warn("cache broadcast: publish message")
+	}
+}
+
+// chunkKeys splits the keys, so that each chunk fits into the payload of a single message.
+// The sizes are measured on the marshalled message and keys, as escaping might enlarge the keys.
+// At least one chunk is returned, so actions without keys are sent as well.
+func chunkKeys(msg *message, keys []string) ([][]string, error) {
+	withoutKeys := *msg
+	withoutKeys.Keys = nil
+	encoded, err := json.Marshal(&withoutKeys)
+	if err != nil {
+		return nil, err
+	}
+	// the keys property is added before the closing brace
+	overhead := len(encoded) + len(`,"keys":[]`)
+
+	chunks := make([][]string, 0, 1)
+	var (
+		chunk []string
+		size  = overhead
+	)
+	for _, key := range keys {
+		encoded, err = json.Marshal(key)
+		if err != nil {
+			return nil, err
+		}
+		keySize := len(encoded)
+		if len(chunk) > 0 {
+			// separator
+			keySize++
+		}
+		if len(chunk) > 0 && size+keySize > maxPayloadSize {
+			chunks = append(chunks, chunk)
+			chunk = nil
+			size = overhead
+			keySize = len(encoded)
+		}
+		chunk = append(chunk, key)
+		size += keySize
+	}
+	return append(chunks, chunk), nil
+}
+
+// handle decodes the payload and passes the message to the receiver of the purpose.
+// Messages sent by this server are ignored, as they were already applied to the local cache.
+func (b *Bus) handle(ctx context.Context, payload string) {
+	msg := new(message)
+	if err := json.Unmarshal([]byte(payload), msg); err != nil {
+		logging.WithError(err).Warn("cache broadcast: unmarshal message")
+		return
+	}
+	if msg.Origin == b.origin {
+		return
+	}
+	purpose, err := cache.PurposeString(msg.Purpose)
+	if err != nil {
+		logging.WithError(err).Debug("cache broadcast: unknown purpose")
+		return
+	}
+	b.mutex.RLock()
+	r, ok := b.receivers[purpose]
+	b.mutex.RUnlock()
+	if !ok {
+		return
+	}
+	r.receive(ctx, msg)
+}
+
+// truncateAll truncates all local caches.
+// It is used if messages might have been missed.
+func (b *Bus) truncateAll(ctx context.Context) {
+	b.mutex.RLock()
+	defer b.mutex.RUnlock()
+	for _, r := range b.receivers {
+		r.truncate(ctx)
+	}
+}
@@ -0,0 +1,87 @@
+package broadcast
+
+import (
+	"context"
+
+	"github.com/zitadel/logging"
+
+	"github.com/zitadel/zitadel/internal/cache"
+)
+
+// broadcastCache sends the invalidations of the local cache to the other servers.
+type broadcastCache[I ~int, K ~string, V cache.Entry[I, K]] struct {
+	cache.PrunerCache[I, K, V]
+	purpose cache.Purpose
+	bus     *Bus
+}
+
+// NewCache wraps the local cache, so that Invalidate, Delete and Truncate
+// are applied to the caches of the same purpose on all servers.
+func NewCache[I ~int, K ~string, V cache.Entry[I, K]](background context.Context, local cache.PrunerCache[I, K, V], purpose cache.Purpose, bus *Bus) cache.PrunerCache[I, K, V] {
+	c := &broadcastCache[I, K, V]{
+		PrunerCache: local,
+		purpose:     purpose,
+		bus:         bus,
+	}
+	bus.register(background, purpose, c)
+	return c
+}
+
+func (c *broadcastCache[I, K, V]) Invalidate(ctx context.Context, index I, keys ...K) error {
+	if err := c.PrunerCache.Invalidate(ctx, index, keys...); err != nil {
+		return err
+	}
+	c.bus.send(ctx, c.purpose, actionInvalidate, int(index), toStrings(keys))
+	return nil
+}
+
+func (c *broadcastCache[I, K, V]) Delete(ctx context.Context, index I, keys ...K) error {
+	if err := c.PrunerCache.Delete(ctx, index, keys...); err != nil {
+		return err
+	}
+	c.bus.send(ctx, c.purpose, actionDelete, int(index), toStrings(keys))
+	return nil
+}
+
+func (c *broadcastCache[I, K, V]) Truncate(ctx context.Context) error {
+	if err := c.PrunerCache.Truncate(ctx); err != nil {
+		return err
+	}
+	c.bus.send(ctx, c.purpose, actionTruncate, 0, nil)
+	return nil
+}
+
+// receive applies the message of another server to the local cache only.
+func (c *broadcastCache[I, K, V]) receive(ctx context.Context, msg *message) {
+	var err error
+	switch msg.Action {
+	case actionInvalidate:
+		err = c.PrunerCache.Invalidate(ctx, I(msg.Index), fromStrings[K](msg.Keys)...)
+	case actionDelete:
+		err = c.PrunerCache.Delete(ctx, I(msg.Index), fromStrings[K](msg.Keys)...)
+	case actionTruncate:
+		err = c.PrunerCache.Truncate(ctx)
+	}
+	logging.OnError(err).WithField("purpose", c.purpose).WithField("action", msg.Action).Warn("cache broadcast: apply message")
+}
+
+func (c *broadcastCache[I, K, V]) truncate(ctx context.Context) {
+	err := c.PrunerCache.Truncate(ctx)
+	logging.OnError(err).WithField("purpose", c.purpose).Warn("cache broadcast: truncate")
+}
+
+func toStrings[K ~string](keys []K) []string {
+	out := make([]string, len(keys))
+	for i, key := range keys {
+		out[i] = string(key)
+	}
+	return out
+}
+
+func fromStrings[K ~string](keys []string) []K {
+	out := make([]K, len(keys))
+	for i, key := range keys {
+		out[i] = K(key)
+	}
+	return out
+}
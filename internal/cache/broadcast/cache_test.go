@@ -0,0 +1,168 @@
+package broadcast
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zitadel/zitadel/internal/cache"
+)
+
+type testIndex int
+
+const (
+	testIndexID testIndex = iota + 1
+)
+
+type testObject struct {
+	id string
+}
+
+func (o *testObject) Keys(index testIndex) []string {
+	if index == testIndexID {
+		return []string{o.id}
+	}
+	return nil
+}
+
+// recordingCache records the calls which change the cache.
+type recordingCache struct {
+	cache.PrunerCache[testIndex, string, *testObject]
+
+	mutex       sync.Mutex
+	invalidated []string
+	deleted     []string
+	truncated   int
+}
+
+func (c *recordingCache) Invalidate(_ context.Context, _ testIndex, keys ...string) error {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	c.invalidated = append(c.invalidated, keys...)
+	return nil
+}
+
+func (c *recordingCache) Delete(_ context.Context, _ testIndex, keys ...string) error {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	c.deleted = append(c.deleted, keys...)
+	return nil
+}
+
+func (c *recordingCache) Truncate(context.Context) error {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	c.truncated++
+	return nil
+}
+
+// connectedBuses returns two buses, which deliver the published messages to each other and to themselves,
+// the same way as NOTIFY does.
+func connectedBuses() (*Bus, *Bus) {
+	var buses []*Bus
+	publish := func(ctx context.Context, payload string) error {
+		for _, b := range buses {
+			b.handle(ctx, payload)
+		}
+		return nil
+	}
+	buses = append(buses, newBus(Config{}, publish), newBus(Config{}, publish))
+	return buses[0], buses[1]
+}
+
+func TestBroadcastCache(t *testing.T) {
+	ctx := context.Background()
+	busA, busB := connectedBuses()
+
+	localA, localB := new(recordingCache), new(recordingCache)
+	cacheA := NewCache[testIndex, string, *testObject](ctx, localA, cache.PurposeOrganization, busA)
+	NewCache[testIndex, string, *testObject](ctx, localB, cache.PurposeOrganization, busB)
+	// other purposes must not receive the messages
+	otherB := new(recordingCache)
+	NewCache[testIndex, string, *testObject](ctx, otherB, cache.PurposeAuthzInstance, busB)
+
+	require.NoError(t, cacheA.Invalidate(ctx, testIndexID, "id1", "id2"))
+	require.NoError(t, cacheA.Delete(ctx, testIndexID, "id3"))
+	require.NoError(t, cacheA.Truncate(ctx))
+
+	// local cache is changed once and not again by its own message
+	assert.Equal(t, []string{"id1", "id2"}, localA.invalidated)
+	assert.Equal(t, []string{"id3"}, localA.deleted)
+	assert.Equal(t, 1, localA.truncated)
+
+	assert.Equal(t, []string{"id1", "id2"}, localB.invalidated)
+	assert.Equal(t, []string{"id3"}, localB.deleted)
+	assert.Equal(t, 1, localB.truncated)
+
+	assert.Empty(t, otherB.invalidated)
+	assert.Empty(t, otherB.deleted)
+	assert.Zero(t, otherB.truncated)
+}
+
+func TestBus_truncateAll(t *testing.T) {
+	ctx := context.Background()
+	bus := newBus(Config{}, func(context.Context, string) error { return nil })
+	org, instance := new(recordingCache), new(recordingCache)
+	NewCache[testIndex, string, *testObject](ctx, org, cache.PurposeOrganization, bus)
+	NewCache[testIndex, string, *testObject](ctx, instance, cache.PurposeAuthzInstance, bus)
+
+	bus.truncateAll(ctx)
+	assert.Equal(t, 1, org.truncated)
+	assert.Equal(t, 1, instance.truncated)
+}
+
+func Test_chunkKeys(t *testing.T) {
+	newMessage := func() *message {
+		return &message{
+			Origin:  "a6f3c1ba-0b8e-4d6a-9c1e-4f3c8c1f1d2e",
+			Purpose: cache.PurposeIntrospectionClient.String(),
+			Action:  actionInvalidate,
+			Index:   1,
+		}
+	}
+	t.Run("no keys", func(t *testing.T) {
+		chunks, err := chunkKeys(newMessage(), nil)
+		require.NoError(t, err)
+		assert.Equal(t, [][]string{nil}, chunks)
+	})
+	tests := []struct {
+		name string
+		key  string
+	}{
+		{
+			name: "plain keys",
+			key:  strings.Repeat("k", 30),
+		},
+		{
+			name: "escaped keys",
+			key:  strings.Repeat(`<"\>`, 30),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			keys := make([]string, 1000)
+			for i := range keys {
+				keys[i] = tt.key
+			}
+			msg := newMessage()
+			chunks, err := chunkKeys(msg, keys)
+			require.NoError(t, err)
+			require.Greater(t, len(chunks), 1)
+
+			var count int
+			for _, chunk := range chunks {
+				count += len(chunk)
+				msg.Keys = chunk
+				payload, err := json.Marshal(msg)
+				require.NoError(t, err)
+				assert.Less(t, len(payload), 8000)
+			}
+			assert.Equal(t, len(keys), count)
+		})
+	}
+}
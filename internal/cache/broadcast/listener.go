@@ -0,0 +1,65 @@
+package broadcast
+
+import (
+	"context"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/zitadel/logging"
+)
+
+const defaultReconnectInterval = 5 * time.Second
+
+func (b *Bus) notify(ctx context.Context, payload string) error {
+	_, err := b.pool.Exec(ctx, "SELECT pg_notify($1, $2)", b.config.Channel, payload)
+	return err
+}
+
+// listenLoop listens for the messages of the other servers until the context is done.
+// After a failure the local caches are truncated,
+// as messages might have been missed until the listener is connected again.
+func (b *Bus) listenLoop(ctx context.Context) {
+	interval := b.config.ReconnectInterval
+	if interval <= 0 {
+		interval = defaultReconnectInterval
+	}
+	for {
+		err := b.listenConn(ctx)
+		if ctx.Err() != nil {
+			return
+		}
+		logging.WithError(err).Warn("cache broadcast: listener failed")
+		b.truncateAll(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(interval):
+		}
+	}
+}
+
+// listenConn takes a connection out of the pool and waits for notifications on it.
+func (b *Bus) listenConn(ctx context.Context) error {
+	poolConn, err := b.pool.Acquire(ctx)
+	if err != nil {
+		return err
+	}
+	// the connection is in listening state and must not be reused by the pool
+	conn := poolConn.Hijack()
+	defer func() {
+		err := conn.Close(context.Background())
+		logging.OnError(err).Debug("cache broadcast: close listener connection")
+	}()
+
+	if _, err = conn.Exec(ctx, "LISTEN "+pgx.Identifier{b.config.Channel}.Sanitize()); err != nil {
+		return err
+	}
+	for {
+		notification, err := conn.WaitForNotification(ctx)
+		if err != nil {
+			return err
+		}
+		b.handle(ctx, notification.Payload)
+	}
+}
@@ -0,0 +1,100 @@
+package broadcast
+
+import (
+	"context"
+	"slices"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zitadel/zitadel/internal/cache"
+)
+
+// listeningBuses returns two buses connected through NOTIFY on the channel,
+// with a cache of the organization purpose registered on each.
+// It returns as soon as both buses receive the messages of each other.
+func listeningBuses(t *testing.T, channel string) (cacheA cache.Cache[testIndex, string, *testObject], localA, localB *recordingCache) {
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	config := Config{
+		Enabled:           true,
+		Channel:           channel,
+		ReconnectInterval: 100 * time.Millisecond,
+	}
+	busA, busB := NewBus(config, testPool), NewBus(config, testPool)
+	localA, localB = new(recordingCache), new(recordingCache)
+	cacheA = NewCache[testIndex, string, *testObject](ctx, localA, cache.PurposeOrganization, busA)
+	cacheB := NewCache[testIndex, string, *testObject](ctx, localB, cache.PurposeOrganization, busB)
+
+	// the listeners are started in the background, messages sent before are missed.
+	require.EventuallyWithT(t, func(collect *assert.CollectT) {
+		require.NoError(collect, cacheA.Truncate(ctx))
+		require.NoError(collect, cacheB.Truncate(ctx))
+		assert.Greater(collect, localA.truncations(), 1)
+		assert.Greater(collect, localB.truncations(), 1)
+	}, 10*time.Second, 100*time.Millisecond)
+	return cacheA, localA, localB
+}
+
+func TestBus_notify(t *testing.T) {
+	ctx := context.Background()
+	cacheA, localA, localB := listeningBuses(t, "zitadel_cache_notify")
+
+	// the keys are escaped in the payload and need multiple messages
+	keys := make([]string, 1000)
+	for i := range keys {
+		keys[i] = strings.Repeat(`<"\>`, 10) + strconv.Itoa(i)
+	}
+	require.NoError(t, cacheA.Invalidate(ctx, testIndexID, keys...))
+	require.NoError(t, cacheA.Delete(ctx, testIndexID, "id1"))
+
+	assert.EventuallyWithT(t, func(collect *assert.CollectT) {
+		assert.Equal(collect, keys, localB.invalidatedKeys())
+		assert.Equal(collect, []string{"id1"}, localB.deletedKeys())
+	}, 10*time.Second, 100*time.Millisecond)
+	// the own messages are not applied again
+	assert.Equal(t, keys, localA.invalidatedKeys())
+	assert.Equal(t, []string{"id1"}, localA.deletedKeys())
+}
+
+func TestBus_listenLoop_reconnect(t *testing.T) {
+	ctx := context.Background()
+	cacheA, _, localB := listeningBuses(t, "zitadel_cache_reconnect")
+	truncated := localB.truncations()
+
+	// messages might be missed while the listeners are disconnected, so the local caches are truncated
+	_, err := testPool.Exec(ctx, "SELECT pg_terminate_backend(pid) FROM pg_stat_activity WHERE query LIKE 'LISTEN %'")
+	require.NoError(t, err)
+	assert.EventuallyWithT(t, func(collect *assert.CollectT) {
+		assert.Greater(collect, localB.truncations(), truncated)
+	}, 10*time.Second, 100*time.Millisecond)
+
+	// the messages are received again after reconnecting
+	assert.EventuallyWithT(t, func(collect *assert.CollectT) {
+		require.NoError(collect, cacheA.Delete(ctx, testIndexID, "id1"))
+		assert.Contains(collect, localB.deletedKeys(), "id1")
+	}, 10*time.Second, 100*time.Millisecond)
+}
+
+func (c *recordingCache) invalidatedKeys() []string {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	return slices.Clone(c.invalidated)
+}
+
+func (c *recordingCache) deletedKeys() []string {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	return slices.Clone(c.deleted)
+}
+
+func (c *recordingCache) truncations() int {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	return c.truncated
+}
@@ -0,0 +1,31 @@
+package broadcast
+
+import (
+	"context"
+	"os"
+	"testing"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/zitadel/logging"
+
+	"github.com/zitadel/zitadel/internal/database/postgres"
+)
+
+var testPool *pgxpool.Pool
+
+func TestMain(m *testing.M) {
+	os.Exit(func() int {
+		config, cleanup := postgres.StartEmbedded()
+		defer cleanup()
+
+		var err error
+		testPool, err = pgxpool.New(context.Background(), config.GetConnectionURL())
+		logging.OnError(err).Fatal("unable to create db pool")
+		defer testPool.Close()
+
+		err = testPool.Ping(context.Background())
+		logging.OnError(err).Fatal("unable to ping db")
+
+		return m.Run()
+	}())
+}
@@ -18,6 +18,16 @@ const (
 	PurposeMilestones
 	PurposeOrganization
 	PurposeIdPFormCallback
+	PurposeUser
+	PurposeSession
+	PurposeIntrospectionClient
+	PurposeProjectRoles
+	// PurposeLoginThrottling isn't a cache,
+	// it reserves the DB namespace of the redis store of the login throttling.
+	PurposeLoginThrottling
+	// PurposeDPoPProof isn't a cache,
+	// it reserves the DB namespace of the redis store of the used DPoP proofs.
+	PurposeDPoPProof
 )
 
 // Cache stores objects with a value of type `V`.
@@ -3,14 +3,17 @@ package connector
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	"github.com/zitadel/zitadel/internal/cache"
+	"github.com/zitadel/zitadel/internal/cache/broadcast"
 	"github.com/zitadel/zitadel/internal/cache/connector/gomap"
 	"github.com/zitadel/zitadel/internal/cache/connector/noop"
 	"github.com/zitadel/zitadel/internal/cache/connector/pg"
 	"github.com/zitadel/zitadel/internal/cache/connector/redis"
 	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/database/dialect"
 )
 
 type CachesConfig struct {
@@ -19,31 +22,53 @@ type CachesConfig struct {
 		Postgres pg.Config
 		Redis    redis.Config
 	}
-	Instance         *cache.Config
-	Milestones       *cache.Config
-	Organization     *cache.Config
-	IdPFormCallbacks *cache.Config
+	Instance            *cache.Config
+	Milestones          *cache.Config
+	Organization        *cache.Config
+	IdPFormCallbacks    *cache.Config
+	User                *cache.Config
+	Session             *cache.Config
+	IntrospectionClient *cache.Config
+	ProjectRoles        *cache.Config
 }
 
 type Connectors struct {
-	Config   CachesConfig
-	Memory   *gomap.Connector
-	Postgres *pg.Connector
-	Redis    *redis.Connector
+	Config    CachesConfig
+	Memory    *gomap.Connector
+	Postgres  *pg.Connector
+	Redis     *redis.Connector
+	Broadcast *broadcast.Bus
 }
 
 func StartConnectors(conf *CachesConfig, client *database.DB) (Connectors, error) {
 	if conf == nil {
 		return Connectors{}, nil
 	}
+	bus, err := startBroadcast(conf.Connectors.Memory, client)
+	if err != nil {
+		return Connectors{}, err
+	}
 	return Connectors{
-		Config:   *conf,
-		Memory:   gomap.NewConnector(conf.Connectors.Memory),
-		Postgres: pg.NewConnector(conf.Connectors.Postgres, client),
-		Redis:    redis.NewConnector(conf.Connectors.Redis),
+		Config:    *conf,
+		Memory:    gomap.NewConnector(conf.Connectors.Memory),
+		Postgres:  pg.NewConnector(conf.Connectors.Postgres, client),
+		Redis:     redis.NewConnector(conf.Connectors.Redis),
+		Broadcast: bus,
 	}, nil
 }
 
+// startBroadcast returns the bus for the invalidation of the memory caches on all servers.
+// LISTEN / NOTIFY is only supported by PostgreSQL.
+func startBroadcast(conf gomap.Config, client *database.DB) (*broadcast.Bus, error) {
+	if !conf.Enabled || !conf.Broadcast.Enabled {
+		return nil, nil
+	}
+	if client.Type() != dialect.DatabaseTypePostgres {
+		return nil, errors.New("cache broadcast is only supported on postgres")
+	}
+	return broadcast.NewBus(conf.Broadcast, client.Pool), nil
+}
+
 func StartCache[I ~int, K ~string, V cache.Entry[I, K]](background context.Context, indices []I, purpose cache.Purpose, conf *cache.Config, connectors Connectors) (cache.Cache[I, K, V], error) {
 	if conf == nil || conf.Connector == cache.ConnectorUnspecified {
 		return noop.NewCache[I, K, V](), nil
@@ -51,6 +76,9 @@ func StartCache[I ~int, K ~string, V cache.Entry[I, K]](background context.Conte
 	if conf.Connector == cache.ConnectorMemory && connectors.Memory != nil {
 		c := gomap.NewCache[I, K, V](background, indices, *conf)
 		connectors.Memory.Config.StartAutoPrune(background, c, purpose)
+		if connectors.Broadcast != nil {
+			return broadcast.NewCache[I, K, V](background, c, purpose, connectors.Broadcast), nil
+		}
 		return c, nil
 	}
 	if conf.Connector == cache.ConnectorPostgres && connectors.Postgres != nil {
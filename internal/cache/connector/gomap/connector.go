@@ -2,11 +2,14 @@ package gomap
 
 import (
 	"github.com/zitadel/zitadel/internal/cache"
+	"github.com/zitadel/zitadel/internal/cache/broadcast"
 )
 
 type Config struct {
 	Enabled   bool
 	AutoPrune cache.AutoPruneConfig
+	// Broadcast distributes invalidations to the memory caches of all servers.
+	Broadcast broadcast.Config
 }
 
 type Connector struct {
@@ -7,11 +7,11 @@ import (
 	"strings"
 )
 
-const _PurposeName = "unspecifiedauthz_instancemilestonesorganizationid_p_form_callback"
+const _PurposeName = "unspecifiedauthz_instancemilestonesorganizationid_p_form_callbackusersessionintrospection_clientproject_roleslogin_throttlingd_po_p_proof"
 
-var _PurposeIndex = [...]uint8{0, 11, 25, 35, 47, 65}
+var _PurposeIndex = [...]uint8{0, 11, 25, 35, 47, 65, 69, 76, 96, 109, 125, 137}
 
-const _PurposeLowerName = "unspecifiedauthz_instancemilestonesorganizationid_p_form_callback"
+const _PurposeLowerName = "unspecifiedauthz_instancemilestonesorganizationid_p_form_callbackusersessionintrospection_clientproject_roleslogin_throttlingd_po_p_proof"
 
 func (i Purpose) String() string {
 	if i < 0 || i >= Purpose(len(_PurposeIndex)-1) {
@@ -29,21 +29,39 @@ func _PurposeNoOp() {
 	_ = x[PurposeMilestones-(2)]
 	_ = x[PurposeOrganization-(3)]
 	_ = x[PurposeIdPFormCallback-(4)]
+	_ = x[PurposeUser-(5)]
+	_ = x[PurposeSession-(6)]
+	_ = x[PurposeIntrospectionClient-(7)]
+	_ = x[PurposeProjectRoles-(8)]
+	_ = x[PurposeLoginThrottling-(9)]
+	_ = x[PurposeDPoPProof-(10)]
 }
 
-var _PurposeValues = []Purpose{PurposeUnspecified, PurposeAuthzInstance, PurposeMilestones, PurposeOrganization, PurposeIdPFormCallback}
+var _PurposeValues = []Purpose{PurposeUnspecified, PurposeAuthzInstance, PurposeMilestones, PurposeOrganization, PurposeIdPFormCallback, PurposeUser, PurposeSession, PurposeIntrospectionClient, PurposeProjectRoles, PurposeLoginThrottling, PurposeDPoPProof}
 
 var _PurposeNameToValueMap = map[string]Purpose{
-	_PurposeName[0:11]:       PurposeUnspecified,
-	_PurposeLowerName[0:11]:  PurposeUnspecified,
-	_PurposeName[11:25]:      PurposeAuthzInstance,
-	_PurposeLowerName[11:25]: PurposeAuthzInstance,
-	_PurposeName[25:35]:      PurposeMilestones,
-	_PurposeLowerName[25:35]: PurposeMilestones,
-	_PurposeName[35:47]:      PurposeOrganization,
-	_PurposeLowerName[35:47]: PurposeOrganization,
-	_PurposeName[47:65]:      PurposeIdPFormCallback,
-	_PurposeLowerName[47:65]: PurposeIdPFormCallback,
+	_PurposeName[0:11]:         PurposeUnspecified,
+	_PurposeLowerName[0:11]:    PurposeUnspecified,
+	_PurposeName[11:25]:        PurposeAuthzInstance,
+	_PurposeLowerName[11:25]:   PurposeAuthzInstance,
+	_PurposeName[25:35]:        PurposeMilestones,
+	_PurposeLowerName[25:35]:   PurposeMilestones,
+	_PurposeName[35:47]:        PurposeOrganization,
+	_PurposeLowerName[35:47]:   PurposeOrganization,
+	_PurposeName[47:65]:        PurposeIdPFormCallback,
+	_PurposeLowerName[47:65]:   PurposeIdPFormCallback,
+	_PurposeName[65:69]:        PurposeUser,
+	_PurposeLowerName[65:69]:   PurposeUser,
+	_PurposeName[69:76]:        PurposeSession,
+	_PurposeLowerName[69:76]:   PurposeSession,
+	_PurposeName[76:96]:        PurposeIntrospectionClient,
+	_PurposeLowerName[76:96]:   PurposeIntrospectionClient,
+	_PurposeName[96:109]:       PurposeProjectRoles,
+	_PurposeLowerName[96:109]:  PurposeProjectRoles,
+	_PurposeName[109:125]:      PurposeLoginThrottling,
+	_PurposeLowerName[109:125]: PurposeLoginThrottling,
+	_PurposeName[125:137]:      PurposeDPoPProof,
+	_PurposeLowerName[125:137]: PurposeDPoPProof,
 }
 
 var _PurposeNames = []string{
@@ -52,6 +70,12 @@ var _PurposeNames = []string{
 	_PurposeName[25:35],
 	_PurposeName[35:47],
 	_PurposeName[47:65],
+	_PurposeName[65:69],
+	_PurposeName[69:76],
+	_PurposeName[76:96],
+	_PurposeName[96:109],
+	_PurposeName[109:125],
+	_PurposeName[125:137],
 }
 
 // PurposeString retrieves an enum value from the enum constants string name.
@@ -171,6 +171,7 @@ func TestCommands_SetExecutionRequest(t *testing.T) {
 								target.NewAggregate("target", "instance"),
 								"name",
 								domain.TargetTypeWebhook,
+								domain.TargetTransportHTTP,
 								"https://example.com",
 								time.Second,
 								true,
@@ -180,6 +181,8 @@ func TestCommands_SetExecutionRequest(t *testing.T) {
 									KeyID:      "id",
 									Crypted:    []byte("12345678"),
 								},
+								domain.TargetSigningAlgorithmHMACSHA256,
+								nil,
 							),
 						),
 					),
@@ -226,6 +229,7 @@ func TestCommands_SetExecutionRequest(t *testing.T) {
 								target.NewAggregate("target", "instance"),
 								"name",
 								domain.TargetTypeWebhook,
+								domain.TargetTransportHTTP,
 								"https://example.com",
 								time.Second,
 								true,
@@ -235,6 +239,8 @@ func TestCommands_SetExecutionRequest(t *testing.T) {
 									KeyID:      "id",
 									Crypted:    []byte("12345678"),
 								},
+								domain.TargetSigningAlgorithmHMACSHA256,
+								nil,
 							),
 						),
 					),
@@ -281,6 +287,7 @@ func TestCommands_SetExecutionRequest(t *testing.T) {
 								target.NewAggregate("target", "instance"),
 								"name",
 								domain.TargetTypeWebhook,
+								domain.TargetTransportHTTP,
 								"https://example.com",
 								time.Second,
 								true,
@@ -290,6 +297,8 @@ func TestCommands_SetExecutionRequest(t *testing.T) {
 									KeyID:      "id",
 									Crypted:    []byte("12345678"),
 								},
+								domain.TargetSigningAlgorithmHMACSHA256,
+								nil,
 							),
 						),
 					),
@@ -853,6 +862,7 @@ func TestCommands_SetExecutionResponse(t *testing.T) {
 							target.NewAggregate("target", "instance"),
 							"name",
 							domain.TargetTypeWebhook,
+							domain.TargetTransportHTTP,
 							"https://example.com",
 							time.Second,
 							true,
@@ -862,6 +872,8 @@ func TestCommands_SetExecutionResponse(t *testing.T) {
 								KeyID:      "id",
 								Crypted:    []byte("12345678"),
 							},
+							domain.TargetSigningAlgorithmHMACSHA256,
+							nil,
 						),
 					),
 					expectPushFailed(
@@ -953,6 +965,7 @@ func TestCommands_SetExecutionResponse(t *testing.T) {
 								target.NewAggregate("target", "instance"),
 								"name",
 								domain.TargetTypeWebhook,
+								domain.TargetTransportHTTP,
 								"https://example.com",
 								time.Second,
 								true,
@@ -962,6 +975,8 @@ func TestCommands_SetExecutionResponse(t *testing.T) {
 									KeyID:      "id",
 									Crypted:    []byte("12345678"),
 								},
+								domain.TargetSigningAlgorithmHMACSHA256,
+								nil,
 							),
 						),
 					),
@@ -2,15 +2,19 @@ package command
 
 import (
 	"context"
+	"crypto/rand"
 	"net/url"
 	"time"
 
+	"github.com/go-jose/go-jose/v4"
+
 	"github.com/zitadel/zitadel/internal/command/preparation"
 	"github.com/zitadel/zitadel/internal/crypto"
 	"github.com/zitadel/zitadel/internal/domain"
 	"github.com/zitadel/zitadel/internal/eventstore/v1/models"
 	"github.com/zitadel/zitadel/internal/repository/target"
 	"github.com/zitadel/zitadel/internal/zerrors"
+	"github.com/zitadel/zitadel/pkg/actions"
 )
 
 type AddTarget struct {
@@ -18,10 +22,13 @@ type AddTarget struct {
 
 	Name             string
 	TargetType       domain.TargetType
+	Transport        domain.TargetTransport
 	Endpoint         string
 	Timeout          time.Duration
 	InterruptOnError bool
+	SigningAlgorithm domain.TargetSigningAlgorithm
 
+	// SigningKey is only returned for HMAC-SHA256, Ed25519 signatures are verified with the public keys.
 	SigningKey string
 }
 
@@ -36,7 +43,25 @@ func (a *AddTarget) IsValid() error {
 	if err != nil || a.Endpoint == "" {
 		return zerrors.ThrowInvalidArgument(err, "COMMAND-1r2k6qo6wg", "Errors.Target.InvalidURL")
 	}
+	if !a.SigningAlgorithm.Valid() {
+		return zerrors.ThrowInvalidArgument(nil, "COMMAND-Sgn1x", "Errors.Target.InvalidSigningAlgorithm")
+	}
+	return validateTargetTransport(a.Transport, a.Endpoint)
+}
 
+// validateTargetTransport checks if the endpoint can be used with the transport.
+// gRPC targets are dialed on the host of the endpoint, where the scheme defines if TLS is used.
+func validateTargetTransport(transport domain.TargetTransport, endpoint string) error {
+	if !transport.Valid() {
+		return zerrors.ThrowInvalidArgument(nil, "COMMAND-Trp1x", "Errors.Target.InvalidTransport")
+	}
+	if transport != domain.TargetTransportGRPC {
+		return nil
+	}
+	u, err := url.Parse(endpoint)
+	if err != nil || u.Host == "" || (u.Scheme != "http" && u.Scheme != "https") {
+		return zerrors.ThrowInvalidArgument(err, "COMMAND-Trp2x", "Errors.Target.InvalidURL")
+	}
 	return nil
 }
 
@@ -62,20 +87,23 @@ func (c *Commands) AddTarget(ctx context.Context, add *AddTarget, resourceOwner
 	if wm.State.Exists() {
 		return time.Time{}, zerrors.ThrowAlreadyExists(nil, "INSTANCE-9axkz0jvzm", "Errors.Target.AlreadyExists")
 	}
-	code, err := c.newSigningKey(ctx, c.eventstore.Filter, c.targetEncryption) //nolint
+	signingKey, err := c.newTargetSigningKey(ctx, add.SigningAlgorithm)
 	if err != nil {
 		return time.Time{}, err
 	}
-	add.SigningKey = code.PlainCode()
+	add.SigningKey = signingKey.plain
 	pushedEvents, err := c.eventstore.Push(ctx, target.NewAddedEvent(
 		ctx,
 		TargetAggregateFromWriteModel(&wm.WriteModel),
 		add.Name,
 		add.TargetType,
+		add.Transport,
 		add.Endpoint,
 		add.Timeout,
 		add.InterruptOnError,
-		code.Crypted,
+		signingKey.crypted,
+		add.SigningAlgorithm,
+		signingKey.publicKey,
 	))
 	if err != nil {
 		return time.Time{}, err
@@ -91,12 +119,23 @@ type ChangeTarget struct {
 
 	Name             *string
 	TargetType       *domain.TargetType
+	Transport        *domain.TargetTransport
 	Endpoint         *string
 	Timeout          *time.Duration
 	InterruptOnError *bool
+	SigningAlgorithm *domain.TargetSigningAlgorithm
 
+	// ExpirationSigningKey generates a new signing key, which replaces the current key immediately.
 	ExpirationSigningKey bool
-	SigningKey           *string
+	// GenerateNextSigningKey generates the next signing key.
+	// Until it's activated, the payload is signed with the current and the next key,
+	// so the receivers can install the next key before the rotation.
+	GenerateNextSigningKey bool
+	// ActivateNextSigningKey replaces the current signing key with the next key.
+	ActivateNextSigningKey bool
+
+	SigningKey     *string
+	NextSigningKey *string
 }
 
 func (a *ChangeTarget) IsValid() error {
@@ -115,9 +154,24 @@ func (a *ChangeTarget) IsValid() error {
 			return zerrors.ThrowInvalidArgument(err, "COMMAND-jsbaera7b6", "Errors.Target.InvalidURL")
 		}
 	}
+	if a.SigningAlgorithm != nil && !a.SigningAlgorithm.Valid() {
+		return zerrors.ThrowInvalidArgument(nil, "COMMAND-Sgn2x", "Errors.Target.InvalidSigningAlgorithm")
+	}
+	if a.signingKeyChanges() > 1 {
+		return zerrors.ThrowInvalidArgument(nil, "COMMAND-Sgn4x", "Errors.Target.InvalidSigningKeyRotation")
+	}
 	return nil
 }
 
+func (a *ChangeTarget) signingKeyChanges() (changes int) {
+	for _, change := range []bool{a.ExpirationSigningKey, a.GenerateNextSigningKey, a.ActivateNextSigningKey} {
+		if change {
+			changes++
+		}
+	}
+	return changes
+}
+
 func (c *Commands) ChangeTarget(ctx context.Context, change *ChangeTarget, resourceOwner string) (time.Time, error) {
 	if resourceOwner == "" {
 		return time.Time{}, zerrors.ThrowInvalidArgument(nil, "COMMAND-zqibgg0wwh", "Errors.IDMissing")
@@ -132,15 +186,22 @@ func (c *Commands) ChangeTarget(ctx context.Context, change *ChangeTarget, resou
 	if !existing.State.Exists() {
 		return time.Time{}, zerrors.ThrowNotFound(nil, "COMMAND-xj14f2cccn", "Errors.Target.NotFound")
 	}
-
-	var changedSigningKey *crypto.CryptoValue
-	if change.ExpirationSigningKey {
-		code, err := c.newSigningKey(ctx, c.eventstore.Filter, c.targetEncryption) //nolint
-		if err != nil {
+	if change.Transport != nil || change.Endpoint != nil {
+		transport, endpoint := existing.Transport, existing.Endpoint
+		if change.Transport != nil {
+			transport = *change.Transport
+		}
+		if change.Endpoint != nil {
+			endpoint = *change.Endpoint
+		}
+		if err := validateTargetTransport(transport, endpoint); err != nil {
 			return time.Time{}, err
 		}
-		changedSigningKey = code.Crypted
-		change.SigningKey = &code.Plain
+	}
+
+	signingKeys, err := c.changeTargetSigningKeys(ctx, existing, change)
+	if err != nil {
+		return time.Time{}, err
 	}
 
 	changedEvent := existing.NewChangedEvent(
@@ -148,10 +209,12 @@ func (c *Commands) ChangeTarget(ctx context.Context, change *ChangeTarget, resou
 		TargetAggregateFromWriteModel(&existing.WriteModel),
 		change.Name,
 		change.TargetType,
+		change.Transport,
 		change.Endpoint,
 		change.Timeout,
 		change.InterruptOnError,
-		changedSigningKey,
+		change.SigningAlgorithm,
+		signingKeys,
 	)
 	if changedEvent == nil {
 		return existing.WriteModel.ChangeDate, nil
@@ -210,6 +273,90 @@ func (c *Commands) getTargetWriteModelByID(ctx context.Context, id string, resou
 	return wm, nil
 }
 
+type targetSigningKey struct {
+	crypted   *crypto.CryptoValue
+	publicKey []byte
+	// plain is only set for HMAC-SHA256, as the private key of Ed25519 is never returned.
+	plain string
+}
+
+func (c *Commands) newTargetSigningKey(ctx context.Context, alg domain.TargetSigningAlgorithm) (*targetSigningKey, error) {
+	if alg == domain.TargetSigningAlgorithmEd25519 {
+		publicKey, privateKey, err := c.targetKeyGenerator(rand.Reader)
+		if err != nil {
+			return nil, zerrors.ThrowInternal(err, "COMMAND-Sgn3x", "Errors.Internal")
+		}
+		crypted, err := crypto.EncryptJSON(&jose.JSONWebKey{
+			Key:       privateKey,
+			KeyID:     actions.JWSKeyID(publicKey),
+			Algorithm: string(jose.EdDSA),
+			Use:       crypto.KeyUsageSigning.String(),
+		}, c.targetEncryption)
+		if err != nil {
+			return nil, err
+		}
+		return &targetSigningKey{crypted: crypted, publicKey: publicKey}, nil
+	}
+	code, err := c.newSigningKey(ctx, c.eventstore.Filter, c.targetEncryption) //nolint
+	if err != nil {
+		return nil, err
+	}
+	return &targetSigningKey{crypted: code.Crypted, plain: code.PlainCode()}, nil
+}
+
+// targetSigningKeys contains the new current or next signing key of a target.
+type targetSigningKeys struct {
+	current *targetSigningKey
+	next    *targetSigningKey
+}
+
+// changeTargetSigningKeys returns the signing keys changed by the request:
+//   - a change of the algorithm or ExpirationSigningKey replace the current key immediately
+//   - GenerateNextSigningKey generates the next key, which replaces an existing next key
+//   - ActivateNextSigningKey replaces the current key with the next key
+//
+// A new current key always discards the next key.
+func (c *Commands) changeTargetSigningKeys(ctx context.Context, existing *TargetWriteModel, change *ChangeTarget) (*targetSigningKeys, error) {
+	algorithmChanged := change.SigningAlgorithm != nil && *change.SigningAlgorithm != existing.SigningAlgorithm
+	if algorithmChanged && change.signingKeyChanges() > 0 {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Sgn5x", "Errors.Target.InvalidSigningKeyRotation")
+	}
+	switch {
+	case algorithmChanged, change.ExpirationSigningKey:
+		alg := existing.SigningAlgorithm
+		if algorithmChanged {
+			alg = *change.SigningAlgorithm
+		}
+		signingKey, err := c.newTargetSigningKey(ctx, alg)
+		if err != nil {
+			return nil, err
+		}
+		if signingKey.plain != "" {
+			change.SigningKey = &signingKey.plain
+		}
+		return &targetSigningKeys{current: signingKey}, nil
+	case change.GenerateNextSigningKey:
+		signingKey, err := c.newTargetSigningKey(ctx, existing.SigningAlgorithm)
+		if err != nil {
+			return nil, err
+		}
+		if signingKey.plain != "" {
+			change.NextSigningKey = &signingKey.plain
+		}
+		return &targetSigningKeys{next: signingKey}, nil
+	case change.ActivateNextSigningKey:
+		if existing.NextSigningKey == nil {
+			return nil, zerrors.ThrowPreconditionFailed(nil, "COMMAND-Sgn6x", "Errors.Target.NextSigningKeyMissing")
+		}
+		return &targetSigningKeys{current: &targetSigningKey{
+			crypted:   existing.NextSigningKey,
+			publicKey: existing.NextPublicKey,
+		}}, nil
+	default:
+		return nil, nil
+	}
+}
+
 func (c *Commands) newSigningKey(ctx context.Context, filter preparation.FilterToQueryReducer, alg crypto.EncryptionAlgorithm) (*EncryptedCode, error) {
 	return c.newEncryptedCodeWithDefault(ctx, filter, domain.SecretGeneratorTypeSigningKey, alg, c.defaultSecretGenerators.SigningKey)
 }
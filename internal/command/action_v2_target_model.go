@@ -16,10 +16,15 @@ type TargetWriteModel struct {
 
 	Name             string
 	TargetType       domain.TargetType
+	Transport        domain.TargetTransport
 	Endpoint         string
 	Timeout          time.Duration
 	InterruptOnError bool
 	SigningKey       *crypto.CryptoValue
+	SigningAlgorithm domain.TargetSigningAlgorithm
+	PublicKey        []byte
+	NextSigningKey   *crypto.CryptoValue
+	NextPublicKey    []byte
 
 	State domain.TargetState
 }
@@ -40,10 +45,13 @@ func (wm *TargetWriteModel) Reduce() error {
 		case *target.AddedEvent:
 			wm.Name = e.Name
 			wm.TargetType = e.TargetType
+			wm.Transport = e.Transport
 			wm.Endpoint = e.Endpoint
 			wm.Timeout = e.Timeout
 			wm.State = domain.TargetActive
 			wm.SigningKey = e.SigningKey
+			wm.SigningAlgorithm = e.SigningAlgorithm
+			wm.PublicKey = e.PublicKey
 		case *target.ChangedEvent:
 			if e.Name != nil {
 				wm.Name = *e.Name
@@ -51,6 +59,9 @@ func (wm *TargetWriteModel) Reduce() error {
 			if e.TargetType != nil {
 				wm.TargetType = *e.TargetType
 			}
+			if e.Transport != nil {
+				wm.Transport = *e.Transport
+			}
 			if e.Endpoint != nil {
 				wm.Endpoint = *e.Endpoint
 			}
@@ -62,6 +73,16 @@ func (wm *TargetWriteModel) Reduce() error {
 			}
 			if e.SigningKey != nil {
 				wm.SigningKey = e.SigningKey
+				wm.PublicKey = e.PublicKey
+				wm.NextSigningKey = nil
+				wm.NextPublicKey = nil
+			}
+			if e.NextSigningKey != nil {
+				wm.NextSigningKey = e.NextSigningKey
+				wm.NextPublicKey = e.NextPublicKey
+			}
+			if e.SigningAlgorithm != nil {
+				wm.SigningAlgorithm = *e.SigningAlgorithm
 			}
 		case *target.RemovedEvent:
 			wm.State = domain.TargetRemoved
@@ -87,10 +108,12 @@ func (wm *TargetWriteModel) NewChangedEvent(
 	agg *eventstore.Aggregate,
 	name *string,
 	targetType *domain.TargetType,
+	transport *domain.TargetTransport,
 	endpoint *string,
 	timeout *time.Duration,
 	interruptOnError *bool,
-	signingKey *crypto.CryptoValue,
+	signingAlgorithm *domain.TargetSigningAlgorithm,
+	signingKeys *targetSigningKeys,
 ) *target.ChangedEvent {
 	changes := make([]target.Changes, 0)
 	if name != nil && wm.Name != *name {
@@ -99,6 +122,9 @@ func (wm *TargetWriteModel) NewChangedEvent(
 	if targetType != nil && wm.TargetType != *targetType {
 		changes = append(changes, target.ChangeTargetType(*targetType))
 	}
+	if transport != nil && wm.Transport != *transport {
+		changes = append(changes, target.ChangeTransport(*transport))
+	}
 	if endpoint != nil && wm.Endpoint != *endpoint {
 		changes = append(changes, target.ChangeEndpoint(*endpoint))
 	}
@@ -108,9 +134,15 @@ func (wm *TargetWriteModel) NewChangedEvent(
 	if interruptOnError != nil && wm.InterruptOnError != *interruptOnError {
 		changes = append(changes, target.ChangeInterruptOnError(*interruptOnError))
 	}
+	if signingAlgorithm != nil && wm.SigningAlgorithm != *signingAlgorithm {
+		changes = append(changes, target.ChangeSigningAlgorithm(*signingAlgorithm))
+	}
 	// if signingkey is set, update it as it is encrypted
-	if signingKey != nil {
-		changes = append(changes, target.ChangeSigningKey(signingKey))
+	if signingKeys != nil && signingKeys.current != nil {
+		changes = append(changes, target.ChangeSigningKey(signingKeys.current.crypted), target.ChangePublicKey(signingKeys.current.publicKey))
+	}
+	if signingKeys != nil && signingKeys.next != nil {
+		changes = append(changes, target.ChangeNextSigningKey(signingKeys.next.crypted, signingKeys.next.publicKey))
 	}
 	if len(changes) == 0 {
 		return nil
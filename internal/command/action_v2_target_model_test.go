@@ -18,6 +18,7 @@ func targetAddEvent(aggID, resourceOwner string) *target.AddedEvent {
 		target.NewAggregate(aggID, resourceOwner),
 		"name",
 		domain.TargetTypeWebhook,
+		domain.TargetTransportHTTP,
 		"https://example.com",
 		time.Second,
 		false,
@@ -27,6 +28,8 @@ func targetAddEvent(aggID, resourceOwner string) *target.AddedEvent {
 			KeyID:      "id",
 			Crypted:    []byte("12345678"),
 		},
+		domain.TargetSigningAlgorithmHMACSHA256,
+		nil,
 	)
 }
 
@@ -2,11 +2,16 @@ package command
 
 import (
 	"context"
+	"crypto/ed25519"
+	"encoding/json"
+	"io"
 	"testing"
 	"time"
 
+	"github.com/go-jose/go-jose/v4"
 	"github.com/muhlemmer/gu"
 	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
 
 	"github.com/zitadel/zitadel/internal/crypto"
 	"github.com/zitadel/zitadel/internal/domain"
@@ -16,6 +21,7 @@ import (
 	"github.com/zitadel/zitadel/internal/id/mock"
 	"github.com/zitadel/zitadel/internal/repository/target"
 	"github.com/zitadel/zitadel/internal/zerrors"
+	"github.com/zitadel/zitadel/pkg/actions"
 )
 
 func TestCommands_AddTarget(t *testing.T) {
@@ -24,6 +30,8 @@ func TestCommands_AddTarget(t *testing.T) {
 		idGenerator                 id.Generator
 		newEncryptedCodeWithDefault encryptedCodeWithDefaultFunc
 		defaultSecretGenerators     *SecretGenerators
+		targetKeyGenerator          func(io.Reader) (ed25519.PublicKey, ed25519.PrivateKey, error)
+		targetEncryption            crypto.EncryptionAlgorithm
 	}
 	type args struct {
 		ctx           context.Context
@@ -120,6 +128,44 @@ func TestCommands_AddTarget(t *testing.T) {
 				err: zerrors.IsErrorInvalidArgument,
 			},
 		},
+		{
+			"invalid transport, error",
+			fields{
+				eventstore: expectEventstore(),
+			},
+			args{
+				ctx: context.Background(),
+				add: &AddTarget{
+					Name:      "name",
+					Timeout:   time.Second,
+					Endpoint:  "https://example.com",
+					Transport: 99,
+				},
+				resourceOwner: "instance",
+			},
+			res{
+				err: zerrors.IsErrorInvalidArgument,
+			},
+		},
+		{
+			"grpc transport without host, error",
+			fields{
+				eventstore: expectEventstore(),
+			},
+			args{
+				ctx: context.Background(),
+				add: &AddTarget{
+					Name:      "name",
+					Timeout:   time.Second,
+					Endpoint:  "example.com:443",
+					Transport: domain.TargetTransportGRPC,
+				},
+				resourceOwner: "instance",
+			},
+			res{
+				err: zerrors.IsErrorInvalidArgument,
+			},
+		},
 		{
 			"unique constraint failed, error",
 			fields{
@@ -131,6 +177,7 @@ func TestCommands_AddTarget(t *testing.T) {
 							target.NewAggregate("id1", "instance"),
 							"name",
 							domain.TargetTypeWebhook,
+							domain.TargetTransportHTTP,
 							"https://example.com",
 							time.Second,
 							false,
@@ -140,6 +187,8 @@ func TestCommands_AddTarget(t *testing.T) {
 								KeyID:      "id",
 								Crypted:    []byte("12345678"),
 							},
+							domain.TargetSigningAlgorithmHMACSHA256,
+							nil,
 						),
 					),
 				),
@@ -246,6 +295,92 @@ func TestCommands_AddTarget(t *testing.T) {
 				id: "id1",
 			},
 		},
+		{
+			"push grpc ok",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(),
+					expectPush(
+						func() eventstore.Command {
+							event := targetAddEvent("id1", "instance")
+							event.Transport = domain.TargetTransportGRPC
+							return event
+						}(),
+					),
+				),
+				idGenerator:                 mock.ExpectID(t, "id1"),
+				newEncryptedCodeWithDefault: mockEncryptedCodeWithDefault("12345678", time.Hour),
+				defaultSecretGenerators:     &SecretGenerators{},
+			},
+			args{
+				ctx: context.Background(),
+				add: &AddTarget{
+					Name:       "name",
+					TargetType: domain.TargetTypeWebhook,
+					Transport:  domain.TargetTransportGRPC,
+					Timeout:    time.Second,
+					Endpoint:   "https://example.com",
+				},
+				resourceOwner: "instance",
+			},
+			res{
+				id: "id1",
+			},
+		},
+		{
+			"invalid signing algorithm, error",
+			fields{
+				eventstore: expectEventstore(),
+			},
+			args{
+				ctx: context.Background(),
+				add: &AddTarget{
+					Name:             "name",
+					TargetType:       domain.TargetTypeWebhook,
+					Timeout:          time.Second,
+					Endpoint:         "https://example.com",
+					SigningAlgorithm: 99,
+				},
+				resourceOwner: "instance",
+			},
+			res{
+				err: zerrors.IsErrorInvalidArgument,
+			},
+		},
+		{
+			"push ed25519 ok",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(),
+					expectPush(
+						func() eventstore.Command {
+							event := targetAddEvent("id1", "instance")
+							event.SigningAlgorithm = domain.TargetSigningAlgorithmEd25519
+							event.SigningKey.Crypted = testTargetPrivateJWK
+							event.PublicKey = testTargetPublicKey
+							return event
+						}(),
+					),
+				),
+				idGenerator:        mock.ExpectID(t, "id1"),
+				targetKeyGenerator: mockTargetKeyGenerator,
+				targetEncryption:   crypto.CreateMockEncryptionAlg(gomock.NewController(t)),
+			},
+			args{
+				ctx: context.Background(),
+				add: &AddTarget{
+					Name:             "name",
+					TargetType:       domain.TargetTypeWebhook,
+					Timeout:          time.Second,
+					Endpoint:         "https://example.com",
+					SigningAlgorithm: domain.TargetSigningAlgorithmEd25519,
+				},
+				resourceOwner: "instance",
+			},
+			res{
+				id: "id1",
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -254,6 +389,8 @@ func TestCommands_AddTarget(t *testing.T) {
 				idGenerator:                 tt.fields.idGenerator,
 				newEncryptedCodeWithDefault: tt.fields.newEncryptedCodeWithDefault,
 				defaultSecretGenerators:     tt.fields.defaultSecretGenerators,
+				targetKeyGenerator:          tt.fields.targetKeyGenerator,
+				targetEncryption:            tt.fields.targetEncryption,
 			}
 			_, err := c.AddTarget(tt.args.ctx, tt.args.add, tt.args.resourceOwner)
 			if tt.res.err == nil {
@@ -274,6 +411,8 @@ func TestCommands_ChangeTarget(t *testing.T) {
 		eventstore                  func(t *testing.T) *eventstore.Eventstore
 		newEncryptedCodeWithDefault encryptedCodeWithDefaultFunc
 		defaultSecretGenerators     *SecretGenerators
+		targetKeyGenerator          func(io.Reader) (ed25519.PublicKey, ed25519.PrivateKey, error)
+		targetEncryption            crypto.EncryptionAlgorithm
 	}
 	type args struct {
 		ctx           context.Context
@@ -281,7 +420,8 @@ func TestCommands_ChangeTarget(t *testing.T) {
 		resourceOwner string
 	}
 	type res struct {
-		err func(error) bool
+		nextSigningKey *string
+		err            func(error) bool
 	}
 	tests := []struct {
 		name   string
@@ -538,6 +678,318 @@ func TestCommands_ChangeTarget(t *testing.T) {
 			},
 			res{},
 		},
+		{
+			"grpc transport with invalid endpoint, error",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							func() eventstore.Command {
+								event := targetAddEvent("id1", "instance")
+								event.Endpoint = "example.com:443"
+								return event
+							}(),
+						),
+					),
+				),
+			},
+			args{
+				ctx: context.Background(),
+				change: &ChangeTarget{
+					ObjectRoot: models.ObjectRoot{
+						AggregateID: "id1",
+					},
+					Transport: gu.Ptr(domain.TargetTransportGRPC),
+				},
+				resourceOwner: "instance",
+			},
+			res{
+				err: zerrors.IsErrorInvalidArgument,
+			},
+		},
+		{
+			"push transport ok",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							targetAddEvent("id1", "instance"),
+						),
+					),
+					expectPush(
+						target.NewChangedEvent(context.Background(),
+							target.NewAggregate("id1", "instance"),
+							[]target.Changes{
+								target.ChangeTransport(domain.TargetTransportGRPC),
+							},
+						),
+					),
+				),
+			},
+			args{
+				ctx: context.Background(),
+				change: &ChangeTarget{
+					ObjectRoot: models.ObjectRoot{
+						AggregateID: "id1",
+					},
+					Transport: gu.Ptr(domain.TargetTransportGRPC),
+				},
+				resourceOwner: "instance",
+			},
+			res{},
+		},
+		{
+			"multiple signing key changes, error",
+			fields{
+				eventstore: expectEventstore(),
+			},
+			args{
+				ctx: context.Background(),
+				change: &ChangeTarget{
+					ObjectRoot: models.ObjectRoot{
+						AggregateID: "id1",
+					},
+					GenerateNextSigningKey: true,
+					ActivateNextSigningKey: true,
+				},
+				resourceOwner: "instance",
+			},
+			res{
+				err: zerrors.IsErrorInvalidArgument,
+			},
+		},
+		{
+			"signing algorithm change with signing key change, error",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							targetAddEvent("id1", "instance"),
+						),
+					),
+				),
+			},
+			args{
+				ctx: context.Background(),
+				change: &ChangeTarget{
+					ObjectRoot: models.ObjectRoot{
+						AggregateID: "id1",
+					},
+					SigningAlgorithm:       gu.Ptr(domain.TargetSigningAlgorithmEd25519),
+					GenerateNextSigningKey: true,
+				},
+				resourceOwner: "instance",
+			},
+			res{
+				err: zerrors.IsErrorInvalidArgument,
+			},
+		},
+		{
+			"push next signing key ok",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							targetAddEvent("id1", "instance"),
+						),
+					),
+					expectPush(
+						target.NewChangedEvent(context.Background(),
+							target.NewAggregate("id1", "instance"),
+							[]target.Changes{
+								target.ChangeNextSigningKey(&crypto.CryptoValue{
+									CryptoType: crypto.TypeEncryption,
+									Algorithm:  "enc",
+									KeyID:      "id",
+									Crypted:    []byte("87654321"),
+								}, nil),
+							},
+						),
+					),
+				),
+				newEncryptedCodeWithDefault: mockEncryptedCodeWithDefault("87654321", time.Hour),
+				defaultSecretGenerators:     &SecretGenerators{},
+			},
+			args{
+				ctx: context.Background(),
+				change: &ChangeTarget{
+					ObjectRoot: models.ObjectRoot{
+						AggregateID: "id1",
+					},
+					GenerateNextSigningKey: true,
+				},
+				resourceOwner: "instance",
+			},
+			res{
+				nextSigningKey: gu.Ptr("87654321"),
+			},
+		},
+		{
+			"activate next signing key without next key, precondition error",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							targetAddEvent("id1", "instance"),
+						),
+					),
+				),
+			},
+			args{
+				ctx: context.Background(),
+				change: &ChangeTarget{
+					ObjectRoot: models.ObjectRoot{
+						AggregateID: "id1",
+					},
+					ActivateNextSigningKey: true,
+				},
+				resourceOwner: "instance",
+			},
+			res{
+				err: zerrors.IsPreconditionFailed,
+			},
+		},
+		{
+			"push activate next signing key ok",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							targetAddEvent("id1", "instance"),
+						),
+						eventFromEventPusher(
+							target.NewChangedEvent(context.Background(),
+								target.NewAggregate("id1", "instance"),
+								[]target.Changes{
+									target.ChangeNextSigningKey(&crypto.CryptoValue{
+										CryptoType: crypto.TypeEncryption,
+										Algorithm:  "enc",
+										KeyID:      "id",
+										Crypted:    []byte("87654321"),
+									}, nil),
+								},
+							),
+						),
+					),
+					expectPush(
+						target.NewChangedEvent(context.Background(),
+							target.NewAggregate("id1", "instance"),
+							[]target.Changes{
+								target.ChangeSigningKey(&crypto.CryptoValue{
+									CryptoType: crypto.TypeEncryption,
+									Algorithm:  "enc",
+									KeyID:      "id",
+									Crypted:    []byte("87654321"),
+								}),
+								target.ChangePublicKey(nil),
+							},
+						),
+					),
+				),
+			},
+			args{
+				ctx: context.Background(),
+				change: &ChangeTarget{
+					ObjectRoot: models.ObjectRoot{
+						AggregateID: "id1",
+					},
+					ActivateNextSigningKey: true,
+				},
+				resourceOwner: "instance",
+			},
+			res{},
+		},
+		{
+			"activate next signing key after expiration, precondition error",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							targetAddEvent("id1", "instance"),
+						),
+						eventFromEventPusher(
+							target.NewChangedEvent(context.Background(),
+								target.NewAggregate("id1", "instance"),
+								[]target.Changes{
+									target.ChangeNextSigningKey(&crypto.CryptoValue{
+										CryptoType: crypto.TypeEncryption,
+										Algorithm:  "enc",
+										KeyID:      "id",
+										Crypted:    []byte("87654321"),
+									}, nil),
+								},
+							),
+						),
+						eventFromEventPusher(
+							target.NewChangedEvent(context.Background(),
+								target.NewAggregate("id1", "instance"),
+								[]target.Changes{
+									target.ChangeSigningKey(&crypto.CryptoValue{
+										CryptoType: crypto.TypeEncryption,
+										Algorithm:  "enc",
+										KeyID:      "id",
+										Crypted:    []byte("11111111"),
+									}),
+								},
+							),
+						),
+					),
+				),
+			},
+			args{
+				ctx: context.Background(),
+				change: &ChangeTarget{
+					ObjectRoot: models.ObjectRoot{
+						AggregateID: "id1",
+					},
+					ActivateNextSigningKey: true,
+				},
+				resourceOwner: "instance",
+			},
+			res{
+				err: zerrors.IsPreconditionFailed,
+			},
+		},
+		{
+			"push signing algorithm ed25519 ok",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							targetAddEvent("id1", "instance"),
+						),
+					),
+					expectPush(
+						target.NewChangedEvent(context.Background(),
+							target.NewAggregate("id1", "instance"),
+							[]target.Changes{
+								target.ChangeSigningAlgorithm(domain.TargetSigningAlgorithmEd25519),
+								target.ChangeSigningKey(&crypto.CryptoValue{
+									CryptoType: crypto.TypeEncryption,
+									Algorithm:  "enc",
+									KeyID:      "id",
+									Crypted:    testTargetPrivateJWK,
+								}),
+								target.ChangePublicKey(testTargetPublicKey),
+							},
+						),
+					),
+				),
+				targetKeyGenerator: mockTargetKeyGenerator,
+				targetEncryption:   crypto.CreateMockEncryptionAlg(gomock.NewController(t)),
+			},
+			args{
+				ctx: context.Background(),
+				change: &ChangeTarget{
+					ObjectRoot: models.ObjectRoot{
+						AggregateID: "id1",
+					},
+					SigningAlgorithm: gu.Ptr(domain.TargetSigningAlgorithmEd25519),
+				},
+				resourceOwner: "instance",
+			},
+			res{},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -545,6 +997,8 @@ func TestCommands_ChangeTarget(t *testing.T) {
 				eventstore:                  tt.fields.eventstore(t),
 				newEncryptedCodeWithDefault: tt.fields.newEncryptedCodeWithDefault,
 				defaultSecretGenerators:     tt.fields.defaultSecretGenerators,
+				targetKeyGenerator:          tt.fields.targetKeyGenerator,
+				targetEncryption:            tt.fields.targetEncryption,
 			}
 			_, err := c.ChangeTarget(tt.args.ctx, tt.args.change, tt.args.resourceOwner)
 			if tt.res.err == nil {
@@ -553,6 +1007,9 @@ func TestCommands_ChangeTarget(t *testing.T) {
 			if tt.res.err != nil && !tt.res.err(err) {
 				t.Errorf("got wrong err: %v ", err)
 			}
+			if tt.res.err == nil {
+				assert.Equal(t, tt.res.nextSigningKey, tt.args.change.NextSigningKey)
+			}
 		})
 	}
 }
@@ -667,3 +1124,21 @@ func TestCommands_DeleteTarget(t *testing.T) {
 		})
 	}
 }
+
+var (
+	testTargetPrivateKey = ed25519.NewKeyFromSeed(make([]byte, ed25519.SeedSize))
+	testTargetPublicKey  = []byte(testTargetPrivateKey.Public().(ed25519.PublicKey))
+	testTargetPrivateJWK = func() []byte {
+		jwk, _ := json.Marshal(&jose.JSONWebKey{
+			Key:       testTargetPrivateKey,
+			KeyID:     actions.JWSKeyID(ed25519.PublicKey(testTargetPublicKey)),
+			Algorithm: string(jose.EdDSA),
+			Use:       crypto.KeyUsageSigning.String(),
+		})
+		return jwk
+	}()
+)
+
+func mockTargetKeyGenerator(io.Reader) (ed25519.PublicKey, ed25519.PrivateKey, error) {
+	return ed25519.PublicKey(testTargetPublicKey), testTargetPrivateKey, nil
+}
@@ -30,6 +30,8 @@ type AuthRequest struct {
 	HintUserID       *string
 	NeedRefreshToken bool
 	Issuer           string
+	// AuthorizationDetails requested by the client (RFC 9396)
+	AuthorizationDetails domain.AuthorizationDetails
 }
 
 type CurrentAuthRequest struct {
@@ -40,6 +42,12 @@ type CurrentAuthRequest struct {
 	AuthTime    time.Time
 }
 
+// AuthorizationDetailsDecision is the decision of the user on the authorization details requested by the client (RFC 9396).
+// The granted authorization details must be a subset of the requested ones, an empty list denies all of them.
+type AuthorizationDetailsDecision struct {
+	Granted domain.AuthorizationDetails
+}
+
 const IDPrefixV2 = "V2_"
 
 func (c *Commands) AddAuthRequest(ctx context.Context, authRequest *AuthRequest) (_ *CurrentAuthRequest, err error) {
@@ -75,6 +83,7 @@ func (c *Commands) AddAuthRequest(ctx context.Context, authRequest *AuthRequest)
 		authRequest.HintUserID,
 		authRequest.NeedRefreshToken,
 		authRequest.Issuer,
+		authRequest.AuthorizationDetails,
 	))
 	if err != nil {
 		return nil, err
@@ -82,7 +91,7 @@ func (c *Commands) AddAuthRequest(ctx context.Context, authRequest *AuthRequest)
 	return authRequestWriteModelToCurrentAuthRequest(writeModel), nil
 }
 
-func (c *Commands) LinkSessionToAuthRequest(ctx context.Context, id, sessionID, sessionToken string, checkLoginClient bool, projectPermissionCheck domain.ProjectPermissionCheck) (*domain.ObjectDetails, *CurrentAuthRequest, error) {
+func (c *Commands) LinkSessionToAuthRequest(ctx context.Context, id, sessionID, sessionToken string, checkLoginClient bool, projectPermissionCheck domain.ProjectPermissionCheck, authorizationDetailsDecision *AuthorizationDetailsDecision) (*domain.ObjectDetails, *CurrentAuthRequest, error) {
 	writeModel, err := c.getAuthRequestWriteModel(ctx, id)
 	if err != nil {
 		return nil, nil, err
@@ -98,6 +107,10 @@ func (c *Commands) LinkSessionToAuthRequest(ctx context.Context, id, sessionID,
 			return nil, nil, err
 		}
 	}
+	grantedAuthorizationDetails, err := writeModel.grantedAuthorizationDetails(authorizationDetailsDecision)
+	if err != nil {
+		return nil, nil, err
+	}
 
 	sessionWriteModel := NewSessionWriteModel(sessionID, authz.GetInstance(ctx).InstanceID())
 	err = c.eventstore.FilterToQueryReducer(ctx, sessionWriteModel)
@@ -110,6 +123,9 @@ func (c *Commands) LinkSessionToAuthRequest(ctx context.Context, id, sessionID,
 	if err := c.sessionTokenVerifier(ctx, sessionToken, sessionWriteModel.AggregateID, sessionWriteModel.TokenID); err != nil {
 		return nil, nil, err
 	}
+	if err = sessionWriteModel.CheckStepUp(); err != nil {
+		return nil, nil, err
+	}
 
 	if projectPermissionCheck != nil {
 		if err := projectPermissionCheck(ctx, writeModel.ClientID, sessionWriteModel.UserID); err != nil {
@@ -123,6 +139,7 @@ func (c *Commands) LinkSessionToAuthRequest(ctx context.Context, id, sessionID,
 		sessionWriteModel.UserID,
 		sessionWriteModel.AuthenticationTime(),
 		sessionWriteModel.AuthMethodTypes(),
+		grantedAuthorizationDetails,
 	)); err != nil {
 		return nil, nil, err
 	}
@@ -183,6 +200,8 @@ func authRequestWriteModelToCurrentAuthRequest(writeModel *AuthRequestWriteModel
 			LoginHint:     writeModel.LoginHint,
 			HintUserID:    writeModel.HintUserID,
 			Issuer:        writeModel.Issuer,
+
+			AuthorizationDetails: writeModel.AuthorizationDetails,
 		},
 		SessionID:   writeModel.SessionID,
 		UserID:      writeModel.UserID,
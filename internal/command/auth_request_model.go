@@ -37,6 +37,10 @@ type AuthRequestWriteModel struct {
 	AuthRequestState domain.AuthRequestState
 	NeedRefreshToken bool
 	Issuer           string
+
+	AuthorizationDetails domain.AuthorizationDetails
+	// GrantedAuthorizationDetails are the subset of the AuthorizationDetails granted by the user
+	GrantedAuthorizationDetails domain.AuthorizationDetails
 }
 
 func NewAuthRequestWriteModel(ctx context.Context, id string) *AuthRequestWriteModel {
@@ -70,11 +74,13 @@ func (m *AuthRequestWriteModel) Reduce() error {
 			m.AuthRequestState = domain.AuthRequestStateAdded
 			m.NeedRefreshToken = e.NeedRefreshToken
 			m.Issuer = e.Issuer
+			m.AuthorizationDetails = e.AuthorizationDetails
 		case *authrequest.SessionLinkedEvent:
 			m.SessionID = e.SessionID
 			m.UserID = e.UserID
 			m.AuthTime = e.AuthTime
 			m.AuthMethods = e.AuthMethods
+			m.GrantedAuthorizationDetails = e.AuthorizationDetails
 		case *authrequest.CodeAddedEvent:
 			m.AuthRequestState = domain.AuthRequestStateCodeAdded
 		case *authrequest.FailedEvent:
@@ -97,6 +103,25 @@ func (m *AuthRequestWriteModel) Query() *eventstore.SearchQueryBuilder {
 		Builder()
 }
 
+// grantedAuthorizationDetails returns the authorization details granted by the user.
+// If the client requested authorization details, the decision of the user is required
+// and may only grant requested authorization details.
+func (m *AuthRequestWriteModel) grantedAuthorizationDetails(decision *AuthorizationDetailsDecision) (domain.AuthorizationDetails, error) {
+	if decision == nil {
+		if len(m.AuthorizationDetails) > 0 {
+			return nil, zerrors.ThrowPreconditionFailed(nil, "AUTHR-Rar9d", "Errors.AuthorizationDetails.DecisionMissing")
+		}
+		return nil, nil
+	}
+	if !decision.Granted.IsSubsetOf(m.AuthorizationDetails) {
+		return nil, zerrors.ThrowInvalidArgument(nil, "AUTHR-Rar0g", "Errors.AuthorizationDetails.GrantedNotRequested")
+	}
+	if len(decision.Granted) == 0 {
+		return nil, nil
+	}
+	return decision.Granted, nil
+}
+
 // CheckAuthenticated checks that the auth request exists, a session must have been linked
 // and in case of a Code Flow the code must have been exchanged
 func (m *AuthRequestWriteModel) CheckAuthenticated() error {
@@ -0,0 +1,84 @@
+package command
+
+import (
+	"context"
+	"time"
+
+	"github.com/zitadel/zitadel/internal/repository/authrequest"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// AddPushedAuthRequest stores the parameters of an authorization request pushed by an authenticated client (RFC 9126).
+// The returned id is used by the client as request_uri in the following authorization request
+// and is valid until the returned expiration.
+func (c *Commands) AddPushedAuthRequest(ctx context.Context, clientID string, parameters map[string][]string, lifetime time.Duration) (id string, expiration time.Time, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if clientID == "" {
+		return "", time.Time{}, zerrors.ThrowInvalidArgument(nil, "COMMAND-Par2c", "Errors.AuthRequest.Pushed.ClientIDMissing")
+	}
+	if lifetime <= 0 {
+		return "", time.Time{}, zerrors.ThrowInvalidArgument(nil, "COMMAND-Par3l", "Errors.AuthRequest.Pushed.LifetimeInvalid")
+	}
+	id, err = c.idGenerator.Next()
+	if err != nil {
+		return "", time.Time{}, err
+	}
+	writeModel, err := c.getPushedAuthRequestWriteModel(ctx, id)
+	if err != nil {
+		return "", time.Time{}, err
+	}
+	if writeModel.ClientID != "" {
+		return "", time.Time{}, zerrors.ThrowPreconditionFailed(nil, "COMMAND-Par4e", "Errors.AuthRequest.AlreadyExisting")
+	}
+	err = c.pushAppendAndReduce(ctx, writeModel, authrequest.NewPushedAddedEvent(
+		ctx,
+		writeModel.aggregate,
+		clientID,
+		parameters,
+		lifetime,
+	))
+	if err != nil {
+		return "", time.Time{}, err
+	}
+	return id, writeModel.Expiration, nil
+}
+
+// UsePushedAuthRequest returns the parameters of a previously pushed authorization request.
+// The request can only be used once, before its expiration and by the client which pushed it.
+func (c *Commands) UsePushedAuthRequest(ctx context.Context, id, clientID string) (_ map[string][]string, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if id == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Par5i", "Errors.AuthRequest.Pushed.NotExisting")
+	}
+	writeModel, err := c.getPushedAuthRequestWriteModel(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if !writeModel.Exists() {
+		return nil, zerrors.ThrowNotFound(nil, "COMMAND-Par6n", "Errors.AuthRequest.Pushed.NotExisting")
+	}
+	if writeModel.ClientID != clientID {
+		return nil, zerrors.ThrowPreconditionFailed(nil, "COMMAND-Par7m", "Errors.AuthRequest.Pushed.ClientMismatch")
+	}
+	if time.Now().After(writeModel.Expiration) {
+		return nil, zerrors.ThrowPreconditionFailed(nil, "COMMAND-Par8x", "Errors.AuthRequest.Pushed.Expired")
+	}
+	if err = c.pushAppendAndReduce(ctx, writeModel, authrequest.NewPushedUsedEvent(ctx, writeModel.aggregate)); err != nil {
+		return nil, err
+	}
+	return writeModel.Parameters, nil
+}
+
+func (c *Commands) getPushedAuthRequestWriteModel(ctx context.Context, id string) (writeModel *PushedAuthRequestWriteModel, err error) {
+	writeModel = NewPushedAuthRequestWriteModel(ctx, id)
+	err = c.eventstore.FilterToQueryReducer(ctx, writeModel)
+	if err != nil {
+		return nil, err
+	}
+	return writeModel, nil
+}
@@ -0,0 +1,61 @@
+package command
+
+import (
+	"context"
+	"time"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/authrequest"
+)
+
+type PushedAuthRequestWriteModel struct {
+	eventstore.WriteModel
+	aggregate *eventstore.Aggregate
+
+	ClientID   string
+	Parameters map[string][]string
+	Expiration time.Time
+	Used       bool
+}
+
+func NewPushedAuthRequestWriteModel(ctx context.Context, id string) *PushedAuthRequestWriteModel {
+	return &PushedAuthRequestWriteModel{
+		WriteModel: eventstore.WriteModel{
+			AggregateID: id,
+		},
+		aggregate: &authrequest.NewAggregate(id, authz.GetInstance(ctx).InstanceID()).Aggregate,
+	}
+}
+
+func (m *PushedAuthRequestWriteModel) Reduce() error {
+	for _, event := range m.Events {
+		switch e := event.(type) {
+		case *authrequest.PushedAddedEvent:
+			m.ClientID = e.ClientID
+			m.Parameters = e.Parameters
+			m.Expiration = e.CreationDate().Add(e.Lifetime)
+		case *authrequest.PushedUsedEvent:
+			m.Used = true
+		}
+	}
+
+	return m.WriteModel.Reduce()
+}
+
+func (m *PushedAuthRequestWriteModel) Query() *eventstore.SearchQueryBuilder {
+	return eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		AddQuery().
+		AggregateTypes(authrequest.AggregateType).
+		AggregateIDs(m.AggregateID).
+		EventTypes(
+			authrequest.PushedAddedType,
+			authrequest.PushedUsedType,
+		).
+		Builder()
+}
+
+// Exists returns true if the pushed auth request was stored and was not used yet.
+func (m *PushedAuthRequestWriteModel) Exists() bool {
+	return m.ClientID != "" && !m.Used
+}
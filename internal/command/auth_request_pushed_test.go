@@ -0,0 +1,252 @@
+package command
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/id"
+	"github.com/zitadel/zitadel/internal/id/mock"
+	"github.com/zitadel/zitadel/internal/repository/authrequest"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+func TestCommands_AddPushedAuthRequest(t *testing.T) {
+	mockCtx := authz.NewMockContext("instanceID", "orgID", "loginClient")
+	parameters := map[string][]string{
+		"client_id":     {"clientID"},
+		"redirect_uri":  {"https://example.com/callback"},
+		"response_type": {"code"},
+		"scope":         {"openid"},
+	}
+	type fields struct {
+		eventstore  func(*testing.T) *eventstore.Eventstore
+		idGenerator id.Generator
+	}
+	type args struct {
+		clientID string
+		lifetime time.Duration
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		wantID  string
+		wantErr error
+	}{
+		{
+			name: "missing client id",
+			fields: fields{
+				eventstore: expectEventstore(),
+			},
+			args: args{
+				lifetime: time.Minute,
+			},
+			wantErr: zerrors.ThrowInvalidArgument(nil, "COMMAND-Par2c", "Errors.AuthRequest.Pushed.ClientIDMissing"),
+		},
+		{
+			name: "invalid lifetime",
+			fields: fields{
+				eventstore: expectEventstore(),
+			},
+			args: args{
+				clientID: "clientID",
+			},
+			wantErr: zerrors.ThrowInvalidArgument(nil, "COMMAND-Par3l", "Errors.AuthRequest.Pushed.LifetimeInvalid"),
+		},
+		{
+			name: "already exists",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							authrequest.NewPushedAddedEvent(mockCtx, &authrequest.NewAggregate("id", "instanceID").Aggregate,
+								"clientID",
+								parameters,
+								time.Minute,
+							),
+						),
+					),
+				),
+				idGenerator: mock.NewIDGeneratorExpectIDs(t, "id"),
+			},
+			args: args{
+				clientID: "clientID",
+				lifetime: time.Minute,
+			},
+			wantErr: zerrors.ThrowPreconditionFailed(nil, "COMMAND-Par4e", "Errors.AuthRequest.AlreadyExisting"),
+		},
+		{
+			name: "added",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(),
+					expectPush(
+						authrequest.NewPushedAddedEvent(mockCtx, &authrequest.NewAggregate("id", "instanceID").Aggregate,
+							"clientID",
+							parameters,
+							time.Minute,
+						),
+					),
+				),
+				idGenerator: mock.NewIDGeneratorExpectIDs(t, "id"),
+			},
+			args: args{
+				clientID: "clientID",
+				lifetime: time.Minute,
+			},
+			wantID: "id",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore:  tt.fields.eventstore(t),
+				idGenerator: tt.fields.idGenerator,
+			}
+			gotID, _, err := c.AddPushedAuthRequest(mockCtx, tt.args.clientID, parameters, tt.args.lifetime)
+			assert.ErrorIs(t, err, tt.wantErr)
+			assert.Equal(t, tt.wantID, gotID)
+		})
+	}
+}
+
+func TestCommands_UsePushedAuthRequest(t *testing.T) {
+	mockCtx := authz.NewMockContext("instanceID", "orgID", "loginClient")
+	aggregate := &authrequest.NewAggregate("id", "instanceID").Aggregate
+	parameters := map[string][]string{
+		"client_id":     {"clientID"},
+		"redirect_uri":  {"https://example.com/callback"},
+		"response_type": {"code"},
+		"scope":         {"openid"},
+	}
+	type args struct {
+		id       string
+		clientID string
+	}
+	tests := []struct {
+		name       string
+		eventstore func(*testing.T) *eventstore.Eventstore
+		args       args
+		want       map[string][]string
+		wantErr    error
+	}{
+		{
+			name:       "missing id",
+			eventstore: expectEventstore(),
+			args: args{
+				clientID: "clientID",
+			},
+			wantErr: zerrors.ThrowInvalidArgument(nil, "COMMAND-Par5i", "Errors.AuthRequest.Pushed.NotExisting"),
+		},
+		{
+			name: "not existing",
+			eventstore: expectEventstore(
+				expectFilter(),
+			),
+			args: args{
+				id:       "id",
+				clientID: "clientID",
+			},
+			wantErr: zerrors.ThrowNotFound(nil, "COMMAND-Par6n", "Errors.AuthRequest.Pushed.NotExisting"),
+		},
+		{
+			name: "already used",
+			eventstore: expectEventstore(
+				expectFilter(
+					eventFromEventPusherWithCreationDateNow(
+						authrequest.NewPushedAddedEvent(mockCtx, aggregate, "clientID", parameters, time.Minute),
+					),
+					eventFromEventPusher(
+						authrequest.NewPushedUsedEvent(mockCtx, aggregate),
+					),
+				),
+			),
+			args: args{
+				id:       "id",
+				clientID: "clientID",
+			},
+			wantErr: zerrors.ThrowNotFound(nil, "COMMAND-Par6n", "Errors.AuthRequest.Pushed.NotExisting"),
+		},
+		{
+			name: "other client",
+			eventstore: expectEventstore(
+				expectFilter(
+					eventFromEventPusherWithCreationDateNow(
+						authrequest.NewPushedAddedEvent(mockCtx, aggregate, "clientID", parameters, time.Minute),
+					),
+				),
+			),
+			args: args{
+				id:       "id",
+				clientID: "otherClientID",
+			},
+			wantErr: zerrors.ThrowPreconditionFailed(nil, "COMMAND-Par7m", "Errors.AuthRequest.Pushed.ClientMismatch"),
+		},
+		{
+			name: "expired",
+			eventstore: expectEventstore(
+				expectFilter(
+					eventFromEventPusher(
+						authrequest.NewPushedAddedEvent(mockCtx, aggregate, "clientID", parameters, time.Minute),
+					),
+				),
+			),
+			args: args{
+				id:       "id",
+				clientID: "clientID",
+			},
+			wantErr: zerrors.ThrowPreconditionFailed(nil, "COMMAND-Par8x", "Errors.AuthRequest.Pushed.Expired"),
+		},
+		{
+			name: "used",
+			eventstore: expectEventstore(
+				expectFilter(
+					eventFromEventPusherWithCreationDateNow(
+						authrequest.NewPushedAddedEvent(mockCtx, aggregate, "clientID", parameters, time.Minute),
+					),
+				),
+				expectPush(
+					authrequest.NewPushedUsedEvent(mockCtx, aggregate),
+				),
+			),
+			args: args{
+				id:       "id",
+				clientID: "clientID",
+			},
+			want: parameters,
+		},
+		{
+			name: "used concurrently",
+			eventstore: expectEventstore(
+				expectFilter(
+					eventFromEventPusherWithCreationDateNow(
+						authrequest.NewPushedAddedEvent(mockCtx, aggregate, "clientID", parameters, time.Minute),
+					),
+				),
+				expectPushFailed(
+					zerrors.ThrowAlreadyExists(nil, "id", "Errors.AuthRequest.Pushed.NotExisting"),
+					authrequest.NewPushedUsedEvent(mockCtx, aggregate),
+				),
+			),
+			args: args{
+				id:       "id",
+				clientID: "clientID",
+			},
+			wantErr: zerrors.ThrowAlreadyExists(nil, "id", "Errors.AuthRequest.Pushed.NotExisting"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore: tt.eventstore(t),
+			}
+			got, err := c.UsePushedAuthRequest(mockCtx, tt.args.id, tt.args.clientID)
+			assert.ErrorIs(t, err, tt.wantErr)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
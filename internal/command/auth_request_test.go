@@ -63,6 +63,7 @@ func TestCommands_AddAuthRequest(t *testing.T) {
 								nil,
 								false,
 								"issuer",
+								nil,
 							),
 						),
 					),
@@ -103,6 +104,7 @@ func TestCommands_AddAuthRequest(t *testing.T) {
 							gu.Ptr("hintUserID"),
 							false,
 							"issuer",
+							nil,
 						),
 					),
 				),
@@ -186,6 +188,7 @@ func TestCommands_LinkSessionToAuthRequest(t *testing.T) {
 		sessionToken     string
 		checkLoginClient bool
 		permissionCheck  domain.ProjectPermissionCheck
+		decision         *AuthorizationDetailsDecision
 	}
 	type res struct {
 		details *domain.ObjectDetails
@@ -239,6 +242,7 @@ func TestCommands_LinkSessionToAuthRequest(t *testing.T) {
 								nil,
 								true,
 								"issuer",
+								nil,
 							),
 						),
 						eventFromEventPusher(
@@ -282,6 +286,7 @@ func TestCommands_LinkSessionToAuthRequest(t *testing.T) {
 								nil,
 								true,
 								"issuer",
+								nil,
 							),
 						),
 					),
@@ -324,6 +329,7 @@ func TestCommands_LinkSessionToAuthRequest(t *testing.T) {
 								nil,
 								true,
 								"issuer",
+								nil,
 							),
 						),
 					),
@@ -364,6 +370,7 @@ func TestCommands_LinkSessionToAuthRequest(t *testing.T) {
 								nil,
 								true,
 								"issuer",
+								nil,
 							),
 						),
 					),
@@ -427,6 +434,7 @@ func TestCommands_LinkSessionToAuthRequest(t *testing.T) {
 								nil,
 								true,
 								"issuer",
+								nil,
 							),
 						),
 					),
@@ -479,6 +487,7 @@ func TestCommands_LinkSessionToAuthRequest(t *testing.T) {
 								nil,
 								true,
 								"issuer",
+								nil,
 							),
 						),
 					),
@@ -512,6 +521,7 @@ func TestCommands_LinkSessionToAuthRequest(t *testing.T) {
 							"userID",
 							testNow,
 							[]domain.UserAuthMethodType{domain.UserAuthMethodTypePassword},
+							nil,
 						),
 					),
 				),
@@ -545,6 +555,206 @@ func TestCommands_LinkSessionToAuthRequest(t *testing.T) {
 				},
 			},
 		},
+		{
+			"authorization details requested, decision missing",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							authrequest.NewAddedEvent(mockCtx, &authrequest.NewAggregate("V2_id", "instanceID").Aggregate,
+								"loginClient",
+								"clientID",
+								"redirectURI",
+								"state",
+								"nonce",
+								[]string{"openid"},
+								[]string{"audience"},
+								domain.OIDCResponseTypeCode,
+								domain.OIDCResponseModeQuery,
+								nil,
+								nil,
+								nil,
+								nil,
+								nil,
+								nil,
+								true,
+								"issuer",
+								domain.AuthorizationDetails{
+									{"type": "payment_initiation", "amount": 10},
+									{"type": "account_information"},
+								},
+							),
+						),
+					),
+				),
+				tokenVerifier: newMockTokenVerifierValid(),
+			},
+			args{
+				ctx:          mockCtx,
+				id:           "V2_id",
+				sessionID:    "sessionID",
+				sessionToken: "token",
+			},
+			res{
+				wantErr: zerrors.ThrowPreconditionFailed(nil, "AUTHR-Rar9d", "Errors.AuthorizationDetails.DecisionMissing"),
+			},
+		},
+		{
+			"authorization details granted, not requested",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							authrequest.NewAddedEvent(mockCtx, &authrequest.NewAggregate("V2_id", "instanceID").Aggregate,
+								"loginClient",
+								"clientID",
+								"redirectURI",
+								"state",
+								"nonce",
+								[]string{"openid"},
+								[]string{"audience"},
+								domain.OIDCResponseTypeCode,
+								domain.OIDCResponseModeQuery,
+								nil,
+								nil,
+								nil,
+								nil,
+								nil,
+								nil,
+								true,
+								"issuer",
+								domain.AuthorizationDetails{
+									{"type": "payment_initiation", "amount": 10},
+									{"type": "account_information"},
+								},
+							),
+						),
+					),
+				),
+				tokenVerifier: newMockTokenVerifierValid(),
+			},
+			args{
+				ctx:          mockCtx,
+				id:           "V2_id",
+				sessionID:    "sessionID",
+				sessionToken: "token",
+				decision: &AuthorizationDetailsDecision{
+					Granted: domain.AuthorizationDetails{
+						{"type": "payment_initiation", "amount": 100},
+					},
+				},
+			},
+			res{
+				wantErr: zerrors.ThrowInvalidArgument(nil, "AUTHR-Rar0g", "Errors.AuthorizationDetails.GrantedNotRequested"),
+			},
+		},
+		{
+			"authorization details partially granted",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							authrequest.NewAddedEvent(mockCtx, &authrequest.NewAggregate("V2_id", "instanceID").Aggregate,
+								"loginClient",
+								"clientID",
+								"redirectURI",
+								"state",
+								"nonce",
+								[]string{"openid"},
+								[]string{"audience"},
+								domain.OIDCResponseTypeCode,
+								domain.OIDCResponseModeQuery,
+								nil,
+								nil,
+								nil,
+								nil,
+								nil,
+								nil,
+								true,
+								"issuer",
+								domain.AuthorizationDetails{
+									{"type": "payment_initiation", "amount": 10},
+									{"type": "account_information"},
+								},
+							),
+						),
+					),
+					expectFilter(
+						eventFromEventPusher(
+							session.NewAddedEvent(mockCtx,
+								&session.NewAggregate("sessionID", "instance1").Aggregate,
+								&domain.UserAgent{
+									FingerprintID: gu.Ptr("fp1"),
+									IP:            net.ParseIP("1.2.3.4"),
+									Description:   gu.Ptr("firefox"),
+									Header:        http.Header{"foo": []string{"bar"}},
+								},
+							)),
+						eventFromEventPusher(
+							session.NewUserCheckedEvent(mockCtx, &session.NewAggregate("sessionID", "instance1").Aggregate,
+								"userID", "org1", testNow, &language.Afrikaans),
+						),
+						eventFromEventPusher(
+							session.NewPasswordCheckedEvent(mockCtx, &session.NewAggregate("sessionID", "instance1").Aggregate,
+								testNow),
+						),
+						eventFromEventPusherWithCreationDateNow(
+							session.NewLifetimeSetEvent(mockCtx, &session.NewAggregate("sessionID", "instance1").Aggregate,
+								2*time.Minute),
+						),
+					),
+					expectPush(
+						authrequest.NewSessionLinkedEvent(mockCtx, &authrequest.NewAggregate("V2_id", "instanceID").Aggregate,
+							"sessionID",
+							"userID",
+							testNow,
+							[]domain.UserAuthMethodType{domain.UserAuthMethodTypePassword},
+							domain.AuthorizationDetails{
+								{"type": "payment_initiation", "amount": 10.0},
+							},
+						),
+					),
+				),
+				tokenVerifier: newMockTokenVerifierValid(),
+			},
+			args{
+				ctx:          mockCtx,
+				id:           "V2_id",
+				sessionID:    "sessionID",
+				sessionToken: "token",
+				decision: &AuthorizationDetailsDecision{
+					Granted: domain.AuthorizationDetails{
+						{"type": "payment_initiation", "amount": 10.0},
+					},
+				},
+			},
+			res{
+				details: &domain.ObjectDetails{ResourceOwner: "instanceID"},
+				authReq: &CurrentAuthRequest{
+					AuthRequest: &AuthRequest{
+						ID:           "V2_id",
+						LoginClient:  "loginClient",
+						ClientID:     "clientID",
+						RedirectURI:  "redirectURI",
+						State:        "state",
+						Nonce:        "nonce",
+						Scope:        []string{"openid"},
+						Audience:     []string{"audience"},
+						ResponseType: domain.OIDCResponseTypeCode,
+						ResponseMode: domain.OIDCResponseModeQuery,
+						Issuer:       "issuer",
+
+						AuthorizationDetails: domain.AuthorizationDetails{
+							{"type": "payment_initiation", "amount": float64(10)},
+							{"type": "account_information"},
+						},
+					},
+					SessionID:   "sessionID",
+					UserID:      "userID",
+					AuthMethods: []domain.UserAuthMethodType{domain.UserAuthMethodTypePassword},
+				},
+			},
+		},
 		{
 			"linked with login client check",
 			fields{
@@ -569,6 +779,7 @@ func TestCommands_LinkSessionToAuthRequest(t *testing.T) {
 								nil,
 								true,
 								"issuer",
+								nil,
 							),
 						),
 					),
@@ -602,6 +813,7 @@ func TestCommands_LinkSessionToAuthRequest(t *testing.T) {
 							"userID",
 							testNow,
 							[]domain.UserAuthMethodType{domain.UserAuthMethodTypePassword},
+							nil,
 						),
 					),
 				),
@@ -660,6 +872,7 @@ func TestCommands_LinkSessionToAuthRequest(t *testing.T) {
 								nil,
 								true,
 								"issuer",
+								nil,
 							),
 						),
 					),
@@ -693,6 +906,7 @@ func TestCommands_LinkSessionToAuthRequest(t *testing.T) {
 							"userID",
 							testNow,
 							[]domain.UserAuthMethodType{domain.UserAuthMethodTypePassword},
+							nil,
 						),
 					),
 				),
@@ -752,6 +966,7 @@ func TestCommands_LinkSessionToAuthRequest(t *testing.T) {
 								nil,
 								true,
 								"issuer",
+								nil,
 							),
 						),
 					),
@@ -785,6 +1000,7 @@ func TestCommands_LinkSessionToAuthRequest(t *testing.T) {
 							"userID",
 							testNow,
 							[]domain.UserAuthMethodType{domain.UserAuthMethodTypePassword},
+							nil,
 						),
 					),
 				),
@@ -845,6 +1061,7 @@ func TestCommands_LinkSessionToAuthRequest(t *testing.T) {
 								nil,
 								true,
 								"issuer",
+								nil,
 							),
 						),
 					),
@@ -896,7 +1113,7 @@ func TestCommands_LinkSessionToAuthRequest(t *testing.T) {
 				sessionTokenVerifier: tt.fields.tokenVerifier,
 				checkPermission:      tt.fields.checkPermission,
 			}
-			details, got, err := c.LinkSessionToAuthRequest(tt.args.ctx, tt.args.id, tt.args.sessionID, tt.args.sessionToken, tt.args.checkLoginClient, tt.args.permissionCheck)
+			details, got, err := c.LinkSessionToAuthRequest(tt.args.ctx, tt.args.id, tt.args.sessionID, tt.args.sessionToken, tt.args.checkLoginClient, tt.args.permissionCheck, tt.args.decision)
 			require.ErrorIs(t, err, tt.res.wantErr)
 			assertObjectDetails(t, tt.res.details, details)
 			if err == nil {
@@ -969,6 +1186,7 @@ func TestCommands_FailAuthRequest(t *testing.T) {
 								nil,
 								true,
 								"issuer",
+								nil,
 							),
 						),
 					),
@@ -1071,6 +1289,7 @@ func TestCommands_AddAuthRequestCode(t *testing.T) {
 								gu.Ptr("hintUserID"),
 								true,
 								"issuer",
+								nil,
 							),
 						),
 					),
@@ -1110,6 +1329,7 @@ func TestCommands_AddAuthRequestCode(t *testing.T) {
 								gu.Ptr("hintUserID"),
 								true,
 								"issuer",
+								nil,
 							),
 						),
 						eventFromEventPusher(
@@ -1118,6 +1338,7 @@ func TestCommands_AddAuthRequestCode(t *testing.T) {
 								"userID",
 								testNow,
 								[]domain.UserAuthMethodType{domain.UserAuthMethodTypePassword},
+								nil,
 							),
 						),
 					),
@@ -0,0 +1,256 @@
+package command
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/crypto"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/backchannelauth"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// BackChannelAuthRequest contains the validated parameters of a
+// client initiated backchannel authentication (CIBA) request.
+type BackChannelAuthRequest struct {
+	ClientID       string
+	UserID         string
+	UserOrgID      string
+	Scopes         []string
+	Audience       []string
+	BindingMessage string
+	Lifetime       time.Duration
+	// ClientNotificationURI is set for clients using the ping mode.
+	// The ClientNotificationToken is then required.
+	ClientNotificationURI   string
+	ClientNotificationToken string
+	NeedRefreshToken        bool
+}
+
+// AddBackChannelAuth creates a new pending backchannel authentication request for the hinted user.
+// The returned id is used by the client as auth_req_id to poll the token endpoint
+// and by the user facing application to approve or deny the request.
+func (c *Commands) AddBackChannelAuth(ctx context.Context, req *BackChannelAuthRequest) (id string, expires time.Time, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if req.ClientID == "" || req.UserID == "" || req.Lifetime <= 0 {
+		return "", time.Time{}, zerrors.ThrowInvalidArgument(nil, "COMMAND-Cba1i", "Errors.BackChannelAuth.Invalid")
+	}
+	deliveryMode := domain.BackChannelTokenDeliveryModePoll
+	var notificationToken *crypto.CryptoValue
+	if req.ClientNotificationURI != "" {
+		if req.ClientNotificationToken == "" {
+			return "", time.Time{}, zerrors.ThrowInvalidArgument(nil, "COMMAND-Cba2t", "Errors.BackChannelAuth.NotificationTokenMissing")
+		}
+		notificationToken, err = crypto.Encrypt([]byte(req.ClientNotificationToken), c.userEncryption)
+		if err != nil {
+			return "", time.Time{}, err
+		}
+		deliveryMode = domain.BackChannelTokenDeliveryModePing
+	}
+	id, err = c.idGenerator.Next()
+	if err != nil {
+		return "", time.Time{}, err
+	}
+	model := NewBackChannelAuthWriteModel(id, authz.GetInstance(ctx).InstanceID())
+	err = c.pushAppendAndReduce(ctx, model, backchannelauth.NewAddedEvent(
+		ctx,
+		model.aggregate,
+		req.ClientID,
+		req.UserID,
+		req.UserOrgID,
+		req.Scopes,
+		req.Audience,
+		req.BindingMessage,
+		req.Lifetime,
+		deliveryMode,
+		req.ClientNotificationURI,
+		notificationToken,
+		req.NeedRefreshToken,
+	))
+	if err != nil {
+		return "", time.Time{}, err
+	}
+	return id, model.Expires, nil
+}
+
+// ApproveBackChannelAuthWithSession approves a pending backchannel authentication request
+// with a session of the hinted user, e.g. after the user confirmed the request on their device.
+func (c *Commands) ApproveBackChannelAuthWithSession(
+	ctx context.Context,
+	id,
+	sessionID,
+	sessionToken string,
+) (*domain.ObjectDetails, error) {
+	model, err := c.getBackChannelAuthWriteModel(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if err = model.checkPending(ctx, c); err != nil {
+		return nil, err
+	}
+	if err := c.checkPermission(ctx, domain.PermissionSessionLink, model.ResourceOwner, ""); err != nil {
+		return nil, err
+	}
+
+	sessionWriteModel := NewSessionWriteModel(sessionID, authz.GetInstance(ctx).InstanceID())
+	err = c.eventstore.FilterToQueryReducer(ctx, sessionWriteModel)
+	if err != nil {
+		return nil, err
+	}
+	if err = sessionWriteModel.CheckIsActive(); err != nil {
+		return nil, err
+	}
+	if err := c.sessionTokenVerifier(ctx, sessionToken, sessionWriteModel.AggregateID, sessionWriteModel.TokenID); err != nil {
+		return nil, err
+	}
+	if err = sessionWriteModel.CheckStepUp(); err != nil {
+		return nil, err
+	}
+	if sessionWriteModel.UserID != model.UserID {
+		return nil, zerrors.ThrowPreconditionFailed(nil, "COMMAND-Cba3u", "Errors.BackChannelAuth.UserMismatch")
+	}
+
+	err = c.pushAppendAndReduce(ctx, model, backchannelauth.NewApprovedEvent(
+		ctx,
+		model.aggregate,
+		sessionWriteModel.AuthMethodTypes(),
+		sessionWriteModel.AuthenticationTime(),
+		sessionWriteModel.PreferredLanguage,
+		sessionWriteModel.UserAgent,
+		sessionID,
+	))
+	if err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&model.WriteModel), nil
+}
+
+// DenyBackChannelAuth denies a pending backchannel authentication request.
+func (c *Commands) DenyBackChannelAuth(ctx context.Context, id string) (*domain.ObjectDetails, error) {
+	model, err := c.getBackChannelAuthWriteModel(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if err = model.checkPending(ctx, c); err != nil {
+		return nil, err
+	}
+	if err := c.checkPermission(ctx, domain.PermissionSessionLink, model.ResourceOwner, ""); err != nil {
+		return nil, err
+	}
+	err = c.pushAppendAndReduce(ctx, model, backchannelauth.NewCanceledEvent(ctx, model.aggregate, domain.BackChannelAuthCanceledDenied))
+	if err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&model.WriteModel), nil
+}
+
+func (c *Commands) getBackChannelAuthWriteModel(ctx context.Context, id string) (*BackChannelAuthWriteModel, error) {
+	model := NewBackChannelAuthWriteModel(id, authz.GetInstance(ctx).InstanceID())
+	err := c.eventstore.FilterToQueryReducer(ctx, model)
+	if err != nil {
+		return nil, err
+	}
+	return model, nil
+}
+
+// checkPending returns an error if the request does not exist or was already handled.
+// An expired request is canceled asynchronously.
+func (m *BackChannelAuthWriteModel) checkPending(ctx context.Context, c *Commands) error {
+	if !m.State.Exists() {
+		return zerrors.ThrowNotFound(nil, "COMMAND-Cba4n", "Errors.BackChannelAuth.NotFound")
+	}
+	if m.State != domain.BackChannelAuthStateInitiated {
+		return zerrors.ThrowPreconditionFailed(nil, "COMMAND-Cba5h", "Errors.BackChannelAuth.AlreadyHandled")
+	}
+	if m.Expires.Before(time.Now()) {
+		c.asyncPush(ctx, backchannelauth.NewCanceledEvent(ctx, m.aggregate, domain.BackChannelAuthCanceledExpired))
+		return zerrors.ThrowPreconditionFailed(nil, "COMMAND-Cba6x", "Errors.BackChannelAuth.AlreadyHandled")
+	}
+	return nil
+}
+
+type BackChannelAuthStateError domain.BackChannelAuthState
+
+func (e BackChannelAuthStateError) Error() string {
+	return fmt.Sprintf("backchannel auth state not approved: %s", domain.BackChannelAuthState(e).String())
+}
+
+// CreateOIDCSessionFromBackChannelAuth creates a new OIDC session if the backchannel authentication
+// was approved by the user.
+// A [BackChannelAuthStateError] is returned if the request was not approved,
+// containing a [domain.BackChannelAuthState] which can be used to inform the client about the state.
+//
+// Same as for the device authorization, an explicit state takes precedence over expiry.
+func (c *Commands) CreateOIDCSessionFromBackChannelAuth(ctx context.Context, id, clientID, backChannelLogoutURI, dpopJKT string) (_ *OIDCSession, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	model, err := c.getBackChannelAuthWriteModel(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if model.State.Exists() && model.ClientID != clientID {
+		return nil, zerrors.ThrowPreconditionFailed(nil, "COMMAND-Cba7c", "Errors.BackChannelAuth.ClientMismatch")
+	}
+
+	switch model.State {
+	case domain.BackChannelAuthStateApproved:
+		break
+	case domain.BackChannelAuthStateUndefined:
+		return nil, zerrors.ThrowNotFound(nil, "COMMAND-Cba8n", "Errors.BackChannelAuth.NotFound")
+
+	case domain.BackChannelAuthStateInitiated:
+		if model.Expires.Before(time.Now()) {
+			c.asyncPush(ctx, backchannelauth.NewCanceledEvent(ctx, model.aggregate, domain.BackChannelAuthCanceledExpired))
+			return nil, BackChannelAuthStateError(domain.BackChannelAuthStateExpired)
+		}
+		fallthrough
+	case domain.BackChannelAuthStateDenied, domain.BackChannelAuthStateExpired, domain.BackChannelAuthStateDone:
+		fallthrough
+	default:
+		return nil, BackChannelAuthStateError(model.State)
+	}
+
+	cmd, err := c.newOIDCSessionAddEvents(ctx, model.UserID, model.UserOrgID)
+	if err != nil {
+		return nil, err
+	}
+
+	cmd.AddSession(ctx,
+		model.UserID,
+		model.UserOrgID,
+		model.SessionID,
+		model.ClientID,
+		model.Audience,
+		model.Scopes,
+		model.UserAuthMethods,
+		model.AuthTime,
+		"",
+		model.PreferredLanguage,
+		model.UserAgent,
+		dpopJKT,
+		nil,
+	)
+	cmd.RegisterLogout(ctx, model.SessionID, model.UserID, model.ClientID, backChannelLogoutURI)
+	if err = cmd.AddAccessToken(ctx, model.Scopes, model.UserID, model.UserOrgID, domain.TokenReasonAuthRequest, nil); err != nil {
+		return nil, err
+	}
+
+	if model.NeedRefreshToken {
+		if err = cmd.AddRefreshToken(ctx, model.UserID); err != nil {
+			return nil, err
+		}
+	}
+	cmd.BackChannelAuthRequestDone(ctx, model.aggregate)
+	return cmd.PushEvents(ctx)
+}
+
+func (cmd *OIDCSessionEvents) BackChannelAuthRequestDone(ctx context.Context, aggregate *eventstore.Aggregate) {
+	cmd.events = append(cmd.events, backchannelauth.NewDoneEvent(ctx, aggregate))
+}
@@ -0,0 +1,616 @@
+package command
+
+import (
+	"context"
+	"io"
+	"net"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/muhlemmer/gu"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"golang.org/x/text/language"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/crypto"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/id"
+	"github.com/zitadel/zitadel/internal/id/mock"
+	"github.com/zitadel/zitadel/internal/repository/backchannelauth"
+	"github.com/zitadel/zitadel/internal/repository/oidcsession"
+	"github.com/zitadel/zitadel/internal/repository/session"
+	"github.com/zitadel/zitadel/internal/repository/user"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+func backChannelAuthAddedEvent(ctx context.Context, lifetime time.Duration) *backchannelauth.AddedEvent {
+	return backchannelauth.NewAddedEvent(
+		ctx,
+		backchannelauth.NewAggregate("id", "instance1"),
+		"clientID", "userID", "org1",
+		[]string{"openid", "offline_access"},
+		[]string{"audience"},
+		"binding",
+		lifetime,
+		domain.BackChannelTokenDeliveryModePoll,
+		"",
+		nil,
+		false,
+	)
+}
+
+func TestCommands_AddBackChannelAuth(t *testing.T) {
+	ctx := authz.WithInstanceID(context.Background(), "instance1")
+
+	type fields struct {
+		eventstore     func(*testing.T) *eventstore.Eventstore
+		idGenerator    id.Generator
+		userEncryption crypto.EncryptionAlgorithm
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		req     *BackChannelAuthRequest
+		wantID  string
+		wantErr error
+	}{
+		{
+			name: "missing user, error",
+			fields: fields{
+				eventstore: expectEventstore(),
+			},
+			req: &BackChannelAuthRequest{
+				ClientID: "clientID",
+				Lifetime: time.Minute,
+			},
+			wantErr: zerrors.ThrowInvalidArgument(nil, "COMMAND-Cba1i", "Errors.BackChannelAuth.Invalid"),
+		},
+		{
+			name: "ping without notification token, error",
+			fields: fields{
+				eventstore: expectEventstore(),
+			},
+			req: &BackChannelAuthRequest{
+				ClientID:              "clientID",
+				UserID:                "userID",
+				Lifetime:              time.Minute,
+				ClientNotificationURI: "https://example.com/cb",
+			},
+			wantErr: zerrors.ThrowInvalidArgument(nil, "COMMAND-Cba2t", "Errors.BackChannelAuth.NotificationTokenMissing"),
+		},
+		{
+			name: "push error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectPushFailed(io.ErrClosedPipe,
+						backChannelAuthAddedEvent(ctx, time.Minute),
+					),
+				),
+				idGenerator: mock.NewIDGeneratorExpectIDs(t, "id"),
+			},
+			req: &BackChannelAuthRequest{
+				ClientID:       "clientID",
+				UserID:         "userID",
+				UserOrgID:      "org1",
+				Scopes:         []string{"openid", "offline_access"},
+				Audience:       []string{"audience"},
+				BindingMessage: "binding",
+				Lifetime:       time.Minute,
+			},
+			wantErr: io.ErrClosedPipe,
+		},
+		{
+			name: "poll mode, ok",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectPush(
+						backChannelAuthAddedEvent(ctx, time.Minute),
+					),
+				),
+				idGenerator: mock.NewIDGeneratorExpectIDs(t, "id"),
+			},
+			req: &BackChannelAuthRequest{
+				ClientID:       "clientID",
+				UserID:         "userID",
+				UserOrgID:      "org1",
+				Scopes:         []string{"openid", "offline_access"},
+				Audience:       []string{"audience"},
+				BindingMessage: "binding",
+				Lifetime:       time.Minute,
+			},
+			wantID: "id",
+		},
+		{
+			name: "ping mode, ok",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectPush(
+						backchannelauth.NewAddedEvent(
+							ctx,
+							backchannelauth.NewAggregate("id", "instance1"),
+							"clientID", "userID", "org1",
+							[]string{"openid"},
+							[]string{"audience"},
+							"",
+							time.Minute,
+							domain.BackChannelTokenDeliveryModePing,
+							"https://example.com/cb",
+							&crypto.CryptoValue{
+								CryptoType: crypto.TypeEncryption,
+								Algorithm:  "enc",
+								KeyID:      "id",
+								Crypted:    []byte("notificationToken"),
+							},
+							false,
+						),
+					),
+				),
+				idGenerator:    mock.NewIDGeneratorExpectIDs(t, "id"),
+				userEncryption: crypto.CreateMockEncryptionAlg(gomock.NewController(t)),
+			},
+			req: &BackChannelAuthRequest{
+				ClientID:                "clientID",
+				UserID:                  "userID",
+				UserOrgID:               "org1",
+				Scopes:                  []string{"openid"},
+				Audience:                []string{"audience"},
+				Lifetime:                time.Minute,
+				ClientNotificationURI:   "https://example.com/cb",
+				ClientNotificationToken: "notificationToken",
+			},
+			wantID: "id",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore:     tt.fields.eventstore(t),
+				idGenerator:    tt.fields.idGenerator,
+				userEncryption: tt.fields.userEncryption,
+			}
+			gotID, _, err := c.AddBackChannelAuth(ctx, tt.req)
+			require.ErrorIs(t, err, tt.wantErr)
+			assert.Equal(t, tt.wantID, gotID)
+		})
+	}
+}
+
+func TestCommands_ApproveBackChannelAuthWithSession(t *testing.T) {
+	ctx := authz.WithInstanceID(context.Background(), "instance1")
+	userAgent := &domain.UserAgent{
+		FingerprintID: gu.Ptr("fp1"),
+		IP:            net.ParseIP("1.2.3.4"),
+		Description:   gu.Ptr("firefox"),
+		Header:        http.Header{"foo": []string{"bar"}},
+	}
+
+	type fields struct {
+		eventstore      func(*testing.T) *eventstore.Eventstore
+		tokenVerifier   func(ctx context.Context, sessionToken, sessionID, tokenID string) (err error)
+		checkPermission domain.PermissionCheck
+	}
+	tests := []struct {
+		name        string
+		fields      fields
+		wantDetails *domain.ObjectDetails
+		wantErr     error
+	}{
+		{
+			name: "not found, error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(),
+				),
+			},
+			wantErr: zerrors.ThrowNotFound(nil, "COMMAND-Cba4n", "Errors.BackChannelAuth.NotFound"),
+		},
+		{
+			name: "already denied, error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusherWithCreationDateNow(backChannelAuthAddedEvent(ctx, time.Minute)),
+						eventFromEventPusher(backchannelauth.NewCanceledEvent(ctx,
+							backchannelauth.NewAggregate("id", "instance1"),
+							domain.BackChannelAuthCanceledDenied,
+						)),
+					),
+				),
+			},
+			wantErr: zerrors.ThrowPreconditionFailed(nil, "COMMAND-Cba5h", "Errors.BackChannelAuth.AlreadyHandled"),
+		},
+		{
+			name: "expired, error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(backChannelAuthAddedEvent(ctx, time.Minute)),
+					),
+					expectPushSlow(time.Second, backchannelauth.NewCanceledEvent(ctx,
+						backchannelauth.NewAggregate("id", "instance1"),
+						domain.BackChannelAuthCanceledExpired,
+					)),
+				),
+			},
+			wantErr: zerrors.ThrowPreconditionFailed(nil, "COMMAND-Cba6x", "Errors.BackChannelAuth.AlreadyHandled"),
+		},
+		{
+			name: "missing permission, error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusherWithCreationDateNow(backChannelAuthAddedEvent(ctx, time.Minute)),
+					),
+				),
+				checkPermission: newMockPermissionCheckNotAllowed(),
+			},
+			wantErr: zerrors.ThrowPermissionDenied(nil, "AUTHZ-HKJD33", "Errors.PermissionDenied"),
+		},
+		{
+			name: "session of other user, error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusherWithCreationDateNow(backChannelAuthAddedEvent(ctx, time.Minute)),
+					),
+					expectFilter(
+						eventFromEventPusher(
+							session.NewAddedEvent(ctx, &session.NewAggregate("sessionID", "instance1").Aggregate, userAgent),
+						),
+						eventFromEventPusher(
+							session.NewUserCheckedEvent(ctx, &session.NewAggregate("sessionID", "instance1").Aggregate,
+								"otherUserID", "org1", testNow, &language.Afrikaans),
+						),
+						eventFromEventPusher(
+							session.NewPasswordCheckedEvent(ctx, &session.NewAggregate("sessionID", "instance1").Aggregate,
+								testNow),
+						),
+					),
+				),
+				tokenVerifier:   newMockTokenVerifierValid(),
+				checkPermission: newMockPermissionCheckAllowed(),
+			},
+			wantErr: zerrors.ThrowPreconditionFailed(nil, "COMMAND-Cba3u", "Errors.BackChannelAuth.UserMismatch"),
+		},
+		{
+			name: "approved",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusherWithCreationDateNow(backChannelAuthAddedEvent(ctx, time.Minute)),
+					),
+					expectFilter(
+						eventFromEventPusher(
+							session.NewAddedEvent(ctx, &session.NewAggregate("sessionID", "instance1").Aggregate, userAgent),
+						),
+						eventFromEventPusher(
+							session.NewUserCheckedEvent(ctx, &session.NewAggregate("sessionID", "instance1").Aggregate,
+								"userID", "org1", testNow, &language.Afrikaans),
+						),
+						eventFromEventPusher(
+							session.NewPasswordCheckedEvent(ctx, &session.NewAggregate("sessionID", "instance1").Aggregate,
+								testNow),
+						),
+					),
+					expectPush(
+						backchannelauth.NewApprovedEvent(ctx,
+							backchannelauth.NewAggregate("id", "instance1"),
+							[]domain.UserAuthMethodType{domain.UserAuthMethodTypePassword},
+							testNow, &language.Afrikaans, userAgent,
+							"sessionID",
+						),
+					),
+				),
+				tokenVerifier:   newMockTokenVerifierValid(),
+				checkPermission: newMockPermissionCheckAllowed(),
+			},
+			wantDetails: &domain.ObjectDetails{
+				ResourceOwner: "instance1",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore:           tt.fields.eventstore(t),
+				sessionTokenVerifier: tt.fields.tokenVerifier,
+				checkPermission:      tt.fields.checkPermission,
+			}
+			gotDetails, err := c.ApproveBackChannelAuthWithSession(ctx, "id", "sessionID", "sessionToken")
+			c.jobs.Wait()
+			require.ErrorIs(t, err, tt.wantErr)
+			assertObjectDetails(t, tt.wantDetails, gotDetails)
+		})
+	}
+}
+
+func TestCommands_DenyBackChannelAuth(t *testing.T) {
+	ctx := authz.WithInstanceID(context.Background(), "instance1")
+
+	type fields struct {
+		eventstore      func(*testing.T) *eventstore.Eventstore
+		checkPermission domain.PermissionCheck
+	}
+	tests := []struct {
+		name        string
+		fields      fields
+		wantDetails *domain.ObjectDetails
+		wantErr     error
+	}{
+		{
+			name: "not found, error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(),
+				),
+			},
+			wantErr: zerrors.ThrowNotFound(nil, "COMMAND-Cba4n", "Errors.BackChannelAuth.NotFound"),
+		},
+		{
+			name: "missing permission, error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusherWithCreationDateNow(backChannelAuthAddedEvent(ctx, time.Minute)),
+					),
+				),
+				checkPermission: newMockPermissionCheckNotAllowed(),
+			},
+			wantErr: zerrors.ThrowPermissionDenied(nil, "AUTHZ-HKJD33", "Errors.PermissionDenied"),
+		},
+		{
+			name: "denied",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusherWithCreationDateNow(backChannelAuthAddedEvent(ctx, time.Minute)),
+					),
+					expectPush(
+						backchannelauth.NewCanceledEvent(ctx,
+							backchannelauth.NewAggregate("id", "instance1"),
+							domain.BackChannelAuthCanceledDenied,
+						),
+					),
+				),
+				checkPermission: newMockPermissionCheckAllowed(),
+			},
+			wantDetails: &domain.ObjectDetails{
+				ResourceOwner: "instance1",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore:      tt.fields.eventstore(t),
+				checkPermission: tt.fields.checkPermission,
+			}
+			gotDetails, err := c.DenyBackChannelAuth(ctx, "id")
+			require.ErrorIs(t, err, tt.wantErr)
+			assertObjectDetails(t, tt.wantDetails, gotDetails)
+		})
+	}
+}
+
+func TestCommands_CreateOIDCSessionFromBackChannelAuth(t *testing.T) {
+	ctx := authz.WithInstanceID(context.Background(), "instance1")
+	userAgent := &domain.UserAgent{
+		FingerprintID: gu.Ptr("fp1"),
+		IP:            net.ParseIP("1.2.3.4"),
+		Description:   gu.Ptr("firefox"),
+		Header:        http.Header{"foo": []string{"bar"}},
+	}
+
+	type fields struct {
+		eventstore                      func(*testing.T) *eventstore.Eventstore
+		idGenerator                     id.Generator
+		defaultAccessTokenLifetime      time.Duration
+		defaultRefreshTokenLifetime     time.Duration
+		defaultRefreshTokenIdleLifetime time.Duration
+		keyAlgorithm                    crypto.EncryptionAlgorithm
+	}
+	type args struct {
+		id       string
+		clientID string
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		want    *OIDCSession
+		wantErr error
+	}{
+		{
+			name: "filter error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilterError(io.ErrClosedPipe),
+				),
+			},
+			args:    args{"id", "clientID"},
+			wantErr: io.ErrClosedPipe,
+		},
+		{
+			name: "not found",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(),
+				),
+			},
+			args:    args{"id", "clientID"},
+			wantErr: zerrors.ThrowNotFound(nil, "COMMAND-Cba8n", "Errors.BackChannelAuth.NotFound"),
+		},
+		{
+			name: "other client",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusherWithCreationDateNow(backChannelAuthAddedEvent(ctx, time.Minute)),
+					),
+				),
+			},
+			args:    args{"id", "otherClientID"},
+			wantErr: zerrors.ThrowPreconditionFailed(nil, "COMMAND-Cba7c", "Errors.BackChannelAuth.ClientMismatch"),
+		},
+		{
+			name: "not yet approved",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusherWithCreationDateNow(backChannelAuthAddedEvent(ctx, time.Minute)),
+					),
+				),
+			},
+			args:    args{"id", "clientID"},
+			wantErr: BackChannelAuthStateError(domain.BackChannelAuthStateInitiated),
+		},
+		{
+			name: "expired",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(backChannelAuthAddedEvent(ctx, time.Minute)),
+					),
+					expectPushSlow(time.Second, backchannelauth.NewCanceledEvent(ctx,
+						backchannelauth.NewAggregate("id", "instance1"),
+						domain.BackChannelAuthCanceledExpired,
+					)),
+				),
+			},
+			args:    args{"id", "clientID"},
+			wantErr: BackChannelAuthStateError(domain.BackChannelAuthStateExpired),
+		},
+		{
+			name: "denied",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(backChannelAuthAddedEvent(ctx, time.Minute)),
+						eventFromEventPusher(backchannelauth.NewCanceledEvent(ctx,
+							backchannelauth.NewAggregate("id", "instance1"),
+							domain.BackChannelAuthCanceledDenied,
+						)),
+					),
+				),
+			},
+			args:    args{"id", "clientID"},
+			wantErr: BackChannelAuthStateError(domain.BackChannelAuthStateDenied),
+		},
+		{
+			name: "already done",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(backChannelAuthAddedEvent(ctx, time.Minute)),
+						eventFromEventPusher(backchannelauth.NewApprovedEvent(ctx,
+							backchannelauth.NewAggregate("id", "instance1"),
+							[]domain.UserAuthMethodType{domain.UserAuthMethodTypePassword},
+							testNow, &language.Afrikaans, userAgent,
+							"sessionID",
+						)),
+						eventFromEventPusher(backchannelauth.NewDoneEvent(ctx,
+							backchannelauth.NewAggregate("id", "instance1"),
+						)),
+					),
+				),
+			},
+			args:    args{"id", "clientID"},
+			wantErr: BackChannelAuthStateError(domain.BackChannelAuthStateDone),
+		},
+		{
+			name: "approved, success",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(backChannelAuthAddedEvent(ctx, time.Minute)),
+						eventFromEventPusher(backchannelauth.NewApprovedEvent(ctx,
+							backchannelauth.NewAggregate("id", "instance1"),
+							[]domain.UserAuthMethodType{domain.UserAuthMethodTypePassword},
+							testNow, &language.Afrikaans, userAgent,
+							"sessionID",
+						)),
+					),
+					expectFilter(
+						user.NewHumanAddedEvent(
+							ctx,
+							&user.NewAggregate("userID", "org1").Aggregate,
+							"username",
+							"firstname",
+							"lastname",
+							"nickname",
+							"displayname",
+							language.English,
+							domain.GenderUnspecified,
+							"email",
+							false,
+						),
+					),
+					expectFilter(), // token lifetime
+					expectPush(
+						oidcsession.NewAddedEvent(context.Background(), &oidcsession.NewAggregate("V2_oidcSessionID", "org1").Aggregate,
+							"userID", "org1", "sessionID", "clientID", []string{"audience"}, []string{"openid", "offline_access"},
+							[]domain.UserAuthMethodType{domain.UserAuthMethodTypePassword}, testNow, "", &language.Afrikaans, userAgent,
+							"",
+							nil,
+						),
+						oidcsession.NewAccessTokenAddedEvent(context.Background(),
+							&oidcsession.NewAggregate("V2_oidcSessionID", "org1").Aggregate,
+							"at_accessTokenID", []string{"openid", "offline_access"}, time.Hour, domain.TokenReasonAuthRequest, nil,
+						),
+						user.NewUserTokenV2AddedEvent(context.Background(), &user.NewAggregate("userID", "org1").Aggregate, "at_accessTokenID"),
+						backchannelauth.NewDoneEvent(ctx,
+							backchannelauth.NewAggregate("id", "instance1"),
+						),
+					),
+				),
+				idGenerator:                     mock.NewIDGeneratorExpectIDs(t, "oidcSessionID", "accessTokenID"),
+				defaultAccessTokenLifetime:      time.Hour,
+				defaultRefreshTokenLifetime:     7 * 24 * time.Hour,
+				defaultRefreshTokenIdleLifetime: 24 * time.Hour,
+				keyAlgorithm:                    crypto.CreateMockEncryptionAlg(gomock.NewController(t)),
+			},
+			args: args{"id", "clientID"},
+			want: &OIDCSession{
+				TokenID:           "V2_oidcSessionID-at_accessTokenID",
+				ClientID:          "clientID",
+				UserID:            "userID",
+				Audience:          []string{"audience"},
+				Expiration:        time.Time{}.Add(time.Hour),
+				Scope:             []string{"openid", "offline_access"},
+				AuthMethods:       []domain.UserAuthMethodType{domain.UserAuthMethodTypePassword},
+				AuthTime:          testNow,
+				PreferredLanguage: &language.Afrikaans,
+				UserAgent:         userAgent,
+				Reason:            domain.TokenReasonAuthRequest,
+				SessionID:         "sessionID",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore:                      tt.fields.eventstore(t),
+				idGenerator:                     tt.fields.idGenerator,
+				defaultAccessTokenLifetime:      tt.fields.defaultAccessTokenLifetime,
+				defaultRefreshTokenLifetime:     tt.fields.defaultRefreshTokenLifetime,
+				defaultRefreshTokenIdleLifetime: tt.fields.defaultRefreshTokenIdleLifetime,
+				keyAlgorithm:                    tt.fields.keyAlgorithm,
+			}
+			got, err := c.CreateOIDCSessionFromBackChannelAuth(ctx, tt.args.id, tt.args.clientID, "", "")
+			c.jobs.Wait()
+
+			require.ErrorIs(t, err, tt.wantErr)
+
+			if got != nil {
+				assert.WithinRange(t, got.AuthTime, tt.want.AuthTime.Add(-time.Second), tt.want.AuthTime.Add(time.Second))
+				got.AuthTime = time.Time{}
+				tt.want.AuthTime = time.Time{}
+			}
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -2,11 +2,13 @@ package command
 
 import (
 	"context"
+	"crypto/ed25519"
 	"crypto/rand"
 	"crypto/x509"
 	"crypto/x509/pkix"
 	"encoding/pem"
 	"fmt"
+	"io"
 	"math/big"
 	"net/http"
 	"slices"
@@ -20,6 +22,7 @@ import (
 
 	"github.com/zitadel/zitadel/internal/api/authz"
 	api_http "github.com/zitadel/zitadel/internal/api/http"
+	"github.com/zitadel/zitadel/internal/breached"
 	"github.com/zitadel/zitadel/internal/cache/connector"
 	"github.com/zitadel/zitadel/internal/command/preparation"
 	sd "github.com/zitadel/zitadel/internal/config/systemdefaults"
@@ -28,8 +31,10 @@ import (
 	"github.com/zitadel/zitadel/internal/eventstore"
 	"github.com/zitadel/zitadel/internal/id"
 	"github.com/zitadel/zitadel/internal/notification/senders"
+	"github.com/zitadel/zitadel/internal/risk"
 	"github.com/zitadel/zitadel/internal/static"
 	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+	"github.com/zitadel/zitadel/internal/throttle"
 	webauthn_helper "github.com/zitadel/zitadel/internal/webauthn"
 	"github.com/zitadel/zitadel/internal/zerrors"
 )
@@ -43,6 +48,7 @@ type Commands struct {
 	newEncryptedCode            encrypedCodeFunc
 	newEncryptedCodeWithDefault encryptedCodeWithDefaultFunc
 	newHashedSecret             hashedSecretFunc
+	newRecoveryCodes            recoveryCodesFunc
 
 	eventstore     *eventstore.Eventstore
 	static         static.Storage
@@ -59,6 +65,9 @@ type Commands struct {
 	targetEncryption                crypto.EncryptionAlgorithm
 	userPasswordHasher              *crypto.Hasher
 	secretHasher                    *crypto.Hasher
+	breachedPasswords               breached.Checker
+	loginThrottler                  *throttle.Throttler
+	riskEvaluator                   *risk.Evaluator
 	machineKeySize                  int
 	applicationKeySize              int
 	domainVerificationAlg           crypto.EncryptionAlgorithm
@@ -85,6 +94,7 @@ type Commands struct {
 
 	samlCertificateAndKeyGenerator func(id string) ([]byte, []byte, error)
 	webKeyGenerator                func(keyID string, alg crypto.EncryptionAlgorithm, genConfig crypto.WebKeyConfig) (encryptedPrivate *crypto.CryptoValue, public *jose.JSONWebKey, err error)
+	targetKeyGenerator             func(rand io.Reader) (ed25519.PublicKey, ed25519.PrivateKey, error)
 
 	GrpcMethodExisting     func(method string) bool
 	GrpcServiceExisting    func(method string) bool
@@ -136,6 +146,18 @@ func StartCommands(
 	if err != nil {
 		return nil, fmt.Errorf("password hasher: %w", err)
 	}
+	breachedPasswords, err := defaults.BreachedPasswords.NewChecker(es.Client())
+	if err != nil {
+		return nil, fmt.Errorf("breached passwords: %w", err)
+	}
+	loginThrottler, err := defaults.LoginThrottling.NewThrottler(ctx, es.Client(), cacheConnectors.Redis)
+	if err != nil {
+		return nil, fmt.Errorf("login throttling: %w", err)
+	}
+	riskEvaluator, err := defaults.SessionRisk.NewEvaluator()
+	if err != nil {
+		return nil, fmt.Errorf("session risk: %w", err)
+	}
 	caches, err := startCaches(ctx, cacheConnectors)
 	if err != nil {
 		return nil, fmt.Errorf("caches: %w", err)
@@ -161,6 +183,9 @@ func StartCommands(
 		targetEncryption:                targetEncryption,
 		userPasswordHasher:              userPasswordHasher,
 		secretHasher:                    secretHasher,
+		breachedPasswords:               breachedPasswords,
+		loginThrottler:                  loginThrottler,
+		riskEvaluator:                   riskEvaluator,
 		machineKeySize:                  int(defaults.SecretGenerators.MachineKeySize),
 		applicationKeySize:              int(defaults.SecretGenerators.ApplicationKeySize),
 		domainVerificationAlg:           domainVerificationEncryption,
@@ -173,6 +198,7 @@ func StartCommands(
 		checkPermission:                 permissionCheck,
 		newEncryptedCode:                newEncryptedCode,
 		newEncryptedCodeWithDefault:     newEncryptedCodeWithDefaultConfig,
+		newRecoveryCodes:                newRecoveryCodesWithHasher(secretHasher),
 		sessionTokenCreator:             sessionTokenCreator(idGenerator, sessionAlg),
 		sessionTokenVerifier:            sessionTokenVerifier,
 		defaultAccessTokenLifetime:      defaultAccessTokenLifetime,
@@ -181,6 +207,7 @@ func StartCommands(
 		defaultSecretGenerators:         defaultSecretGenerators,
 		samlCertificateAndKeyGenerator:  samlCertificateAndKeyGenerator(defaults.KeyConfig.CertificateSize, defaults.KeyConfig.CertificateLifetime),
 		webKeyGenerator:                 crypto.GenerateEncryptedWebKey,
+		targetKeyGenerator:              ed25519.GenerateKey,
 		EventExisting: func(value string) bool {
 			return slices.Contains(es.EventTypes(), value)
 		},
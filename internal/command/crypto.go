@@ -85,6 +85,29 @@ func newHashedSecretWithDefault(hasher *crypto.Hasher, defaultConfig *crypto.Gen
 	}
 }
 
+type recoveryCodesFunc func(count int) (encodedHashes, plain []string, err error)
+
+var recoveryCodeGeneratorConfig = crypto.GeneratorConfig{
+	Length:              10,
+	IncludeLowerLetters: true,
+	IncludeDigits:       true,
+}
+
+func newRecoveryCodesWithHasher(hasher *crypto.Hasher) recoveryCodesFunc {
+	return func(count int) (encodedHashes, plain []string, err error) {
+		generator := crypto.NewHashGenerator(recoveryCodeGeneratorConfig, hasher)
+		encodedHashes = make([]string, count)
+		plain = make([]string, count)
+		for i := 0; i < count; i++ {
+			encodedHashes[i], plain[i], err = generator.NewCode()
+			if err != nil {
+				return nil, nil, err
+			}
+		}
+		return encodedHashes, plain, nil
+	}
+}
+
 func cryptoGeneratorConfig(ctx context.Context, filter preparation.FilterToQueryReducer, typ domain.SecretGeneratorType) (*crypto.GeneratorConfig, error) {
 	return cryptoGeneratorConfigWithDefault(ctx, filter, typ, emptyConfig)
 }
@@ -1021,6 +1021,8 @@ func TestCommands_CreateOIDCSessionFromDeviceAuth(t *testing.T) {
 								Description:   gu.Ptr("firefox"),
 								Header:        http.Header{"foo": []string{"bar"}},
 							},
+							"",
+							nil,
 						),
 						oidcsession.NewAccessTokenAddedEvent(context.Background(),
 							&oidcsession.NewAggregate("V2_oidcSessionID", "org1").Aggregate,
@@ -1119,6 +1121,8 @@ func TestCommands_CreateOIDCSessionFromDeviceAuth(t *testing.T) {
 								Description:   gu.Ptr("firefox"),
 								Header:        http.Header{"foo": []string{"bar"}},
 							},
+							"",
+							nil,
 						),
 						sessionlogout.NewBackChannelLogoutRegisteredEvent(context.Background(),
 							&sessionlogout.NewAggregate("sessionID", "instance1").Aggregate,
@@ -1226,6 +1230,8 @@ func TestCommands_CreateOIDCSessionFromDeviceAuth(t *testing.T) {
 								Description:   gu.Ptr("firefox"),
 								Header:        http.Header{"foo": []string{"bar"}},
 							},
+							"",
+							nil,
 						),
 						oidcsession.NewAccessTokenAddedEvent(context.Background(),
 							&oidcsession.NewAggregate("V2_oidcSessionID", "org1").Aggregate,
@@ -1283,7 +1289,7 @@ func TestCommands_CreateOIDCSessionFromDeviceAuth(t *testing.T) {
 				defaultRefreshTokenIdleLifetime: tt.fields.defaultRefreshTokenIdleLifetime,
 				keyAlgorithm:                    tt.fields.keyAlgorithm,
 			}
-			got, err := c.CreateOIDCSessionFromDeviceAuth(tt.args.ctx, tt.args.deviceCode, tt.args.backChannelLogoutURI)
+			got, err := c.CreateOIDCSessionFromDeviceAuth(tt.args.ctx, tt.args.deviceCode, tt.args.backChannelLogoutURI, "")
 			c.jobs.Wait()
 
 			require.ErrorIs(t, err, tt.wantErr)
@@ -51,11 +51,14 @@ type InstanceSetup struct {
 		}
 	}
 	PasswordComplexityPolicy struct {
-		MinLength    uint64
-		HasLowercase bool
-		HasUppercase bool
-		HasNumber    bool
-		HasSymbol    bool
+		MinLength           uint64
+		HasLowercase        bool
+		HasUppercase        bool
+		HasNumber           bool
+		HasSymbol           bool
+		HistoryDepth        uint64
+		CheckBreached       bool
+		ForceChangeBreached bool
 	}
 	PasswordAgePolicy struct {
 		ExpireWarnDays uint64
@@ -84,6 +87,10 @@ type InstanceSetup struct {
 		MfaInitSkipLifetime        time.Duration
 		SecondFactorCheckLifetime  time.Duration
 		MultiFactorCheckLifetime   time.Duration
+		ForceMFAOnHighRisk         bool
+		TrustedDeviceLifetime      time.Duration
+		AllowAccountRecovery       bool
+		AllowMagicLink             bool
 	}
 	NotificationPolicy struct {
 		PasswordChange bool
@@ -115,6 +122,11 @@ type InstanceSetup struct {
 		MaxPasswordAttempts      uint64
 		MaxOTPAttempts           uint64
 		ShouldShowLockoutFailure bool
+		MaxIPAttempts            uint64
+		MaxClientAttempts        uint64
+		ThrottleWindow           time.Duration
+		ThrottleDelay            time.Duration
+		ThrottleBlockDuration    time.Duration
 	}
 	EmailTemplate          []byte
 	MessageTexts           []*domain.CustomMessageText
@@ -159,6 +171,7 @@ type SecretGenerators struct {
 	OTPEmail                 *crypto.GeneratorConfig
 	InviteCode               *crypto.GeneratorConfig
 	SigningKey               *crypto.GeneratorConfig
+	MagicLinkCode            *crypto.GeneratorConfig
 }
 
 type ZitadelConfig struct {
@@ -324,6 +337,9 @@ func setupInstanceElements(instanceAgg *instance.Aggregate, setup *InstanceSetup
 			setup.PasswordComplexityPolicy.HasUppercase,
 			setup.PasswordComplexityPolicy.HasNumber,
 			setup.PasswordComplexityPolicy.HasSymbol,
+			setup.PasswordComplexityPolicy.HistoryDepth,
+			setup.PasswordComplexityPolicy.CheckBreached,
+			setup.PasswordComplexityPolicy.ForceChangeBreached,
 		),
 		prepareAddDefaultPasswordAgePolicy(
 			instanceAgg,
@@ -355,6 +371,10 @@ func setupInstanceElements(instanceAgg *instance.Aggregate, setup *InstanceSetup
 			setup.LoginPolicy.MfaInitSkipLifetime,
 			setup.LoginPolicy.SecondFactorCheckLifetime,
 			setup.LoginPolicy.MultiFactorCheckLifetime,
+			setup.LoginPolicy.ForceMFAOnHighRisk,
+			setup.LoginPolicy.TrustedDeviceLifetime,
+			setup.LoginPolicy.AllowAccountRecovery,
+			setup.LoginPolicy.AllowMagicLink,
 		),
 		prepareAddSecondFactorToDefaultLoginPolicy(instanceAgg, domain.SecondFactorTypeTOTP),
 		prepareAddSecondFactorToDefaultLoginPolicy(instanceAgg, domain.SecondFactorTypeU2F),
@@ -366,7 +386,17 @@ func setupInstanceElements(instanceAgg *instance.Aggregate, setup *InstanceSetup
 
 		prepareAddDefaultPrivacyPolicy(instanceAgg, setup.PrivacyPolicy.TOSLink, setup.PrivacyPolicy.PrivacyLink, setup.PrivacyPolicy.HelpLink, setup.PrivacyPolicy.SupportEmail, setup.PrivacyPolicy.DocsLink, setup.PrivacyPolicy.CustomLink, setup.PrivacyPolicy.CustomLinkText),
 		prepareAddDefaultNotificationPolicy(instanceAgg, setup.NotificationPolicy.PasswordChange),
-		prepareAddDefaultLockoutPolicy(instanceAgg, setup.LockoutPolicy.MaxPasswordAttempts, setup.LockoutPolicy.MaxOTPAttempts, setup.LockoutPolicy.ShouldShowLockoutFailure),
+		prepareAddDefaultLockoutPolicy(
+			instanceAgg,
+			setup.LockoutPolicy.MaxPasswordAttempts,
+			setup.LockoutPolicy.MaxOTPAttempts,
+			setup.LockoutPolicy.ShouldShowLockoutFailure,
+			setup.LockoutPolicy.MaxIPAttempts,
+			setup.LockoutPolicy.MaxClientAttempts,
+			setup.LockoutPolicy.ThrottleWindow,
+			setup.LockoutPolicy.ThrottleDelay,
+			setup.LockoutPolicy.ThrottleBlockDuration,
+		),
 
 		prepareAddDefaultLabelPolicy(
 			instanceAgg,
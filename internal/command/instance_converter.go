@@ -42,6 +42,10 @@ func writeModelToLoginPolicy(wm *LoginPolicyWriteModel) *domain.LoginPolicy {
 		MFAInitSkipLifetime:        wm.MFAInitSkipLifetime,
 		SecondFactorCheckLifetime:  wm.SecondFactorCheckLifetime,
 		MultiFactorCheckLifetime:   wm.MultiFactorCheckLifetime,
+		ForceMFAOnHighRisk:         wm.ForceMFAOnHighRisk,
+		TrustedDeviceLifetime:      wm.TrustedDeviceLifetime,
+		AllowAccountRecovery:       wm.AllowAccountRecovery,
+		AllowMagicLink:             wm.AllowMagicLink,
 	}
 }
 
@@ -96,21 +100,29 @@ func writeModelToPasswordAgePolicy(wm *PasswordAgePolicyWriteModel) *domain.Pass
 
 func writeModelToPasswordComplexityPolicy(wm *PasswordComplexityPolicyWriteModel) *domain.PasswordComplexityPolicy {
 	return &domain.PasswordComplexityPolicy{
-		ObjectRoot:   writeModelToObjectRoot(wm.WriteModel),
-		MinLength:    wm.MinLength,
-		HasLowercase: wm.HasLowercase,
-		HasUppercase: wm.HasUppercase,
-		HasNumber:    wm.HasNumber,
-		HasSymbol:    wm.HasSymbol,
+		ObjectRoot:          writeModelToObjectRoot(wm.WriteModel),
+		MinLength:           wm.MinLength,
+		HasLowercase:        wm.HasLowercase,
+		HasUppercase:        wm.HasUppercase,
+		HasNumber:           wm.HasNumber,
+		HasSymbol:           wm.HasSymbol,
+		HistoryDepth:        wm.HistoryDepth,
+		CheckBreached:       wm.CheckBreached,
+		ForceChangeBreached: wm.ForceChangeBreached,
 	}
 }
 
 func writeModelToLockoutPolicy(wm *LockoutPolicyWriteModel) *domain.LockoutPolicy {
 	return &domain.LockoutPolicy{
-		ObjectRoot:          writeModelToObjectRoot(wm.WriteModel),
-		MaxPasswordAttempts: wm.MaxPasswordAttempts,
-		MaxOTPAttempts:      wm.MaxOTPAttempts,
-		ShowLockOutFailures: wm.ShowLockOutFailures,
+		ObjectRoot:            writeModelToObjectRoot(wm.WriteModel),
+		MaxPasswordAttempts:   wm.MaxPasswordAttempts,
+		MaxOTPAttempts:        wm.MaxOTPAttempts,
+		ShowLockOutFailures:   wm.ShowLockOutFailures,
+		MaxIPAttempts:         wm.MaxIPAttempts,
+		MaxClientAttempts:     wm.MaxClientAttempts,
+		ThrottleWindow:        wm.ThrottleWindow,
+		ThrottleDelay:         wm.ThrottleDelay,
+		ThrottleBlockDuration: wm.ThrottleBlockDuration,
 	}
 }
 
@@ -242,7 +242,11 @@ func prepareChangeDefaultLoginPolicy(a *instance.Aggregate, policy *ChangeLoginP
 				policy.ExternalLoginCheckLifetime,
 				policy.MFAInitSkipLifetime,
 				policy.SecondFactorCheckLifetime,
-				policy.MultiFactorCheckLifetime)
+				policy.MultiFactorCheckLifetime,
+				policy.ForceMFAOnHighRisk,
+				policy.TrustedDeviceLifetime,
+				policy.AllowAccountRecovery,
+				policy.AllowMagicLink)
 			if !hasChanged {
 				return nil, zerrors.ThrowPreconditionFailed(nil, "INSTANCE-5M9vdd", "Errors.IAM.LoginPolicy.NotChanged")
 			}
@@ -270,6 +274,10 @@ func prepareAddDefaultLoginPolicy(
 	mfaInitSkipLifetime time.Duration,
 	secondFactorCheckLifetime time.Duration,
 	multiFactorCheckLifetime time.Duration,
+	forceMFAOnHighRisk bool,
+	trustedDeviceLifetime time.Duration,
+	allowAccountRecovery bool,
+	allowMagicLink bool,
 ) preparation.Validation {
 	return func() (preparation.CreateCommands, error) {
 		return func(ctx context.Context, filter preparation.FilterToQueryReducer) ([]eventstore.Command, error) {
@@ -304,6 +312,10 @@ func prepareAddDefaultLoginPolicy(
 					mfaInitSkipLifetime,
 					secondFactorCheckLifetime,
 					multiFactorCheckLifetime,
+					forceMFAOnHighRisk,
+					trustedDeviceLifetime,
+					allowAccountRecovery,
+					allowMagicLink,
 				),
 			}, nil
 		}, nil
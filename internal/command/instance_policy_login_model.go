@@ -77,6 +77,10 @@ func (wm *InstanceLoginPolicyWriteModel) NewChangedEvent(
 	mfaInitSkipLifetime,
 	secondFactorCheckLifetime,
 	multiFactorCheckLifetime time.Duration,
+	forceMFAOnHighRisk bool,
+	trustedDeviceLifetime time.Duration,
+	allowAccountRecovery bool,
+	allowMagicLink bool,
 ) (*instance.LoginPolicyChangedEvent, bool) {
 
 	changes := make([]policy.LoginPolicyChanges, 0)
@@ -131,6 +135,18 @@ func (wm *InstanceLoginPolicyWriteModel) NewChangedEvent(
 	if wm.DisableLoginWithPhone != disableLoginWithPhone {
 		changes = append(changes, policy.ChangeDisableLoginWithPhone(disableLoginWithPhone))
 	}
+	if wm.ForceMFAOnHighRisk != forceMFAOnHighRisk {
+		changes = append(changes, policy.ChangeForceMFAOnHighRisk(forceMFAOnHighRisk))
+	}
+	if wm.TrustedDeviceLifetime != trustedDeviceLifetime {
+		changes = append(changes, policy.ChangeTrustedDeviceLifetime(trustedDeviceLifetime))
+	}
+	if wm.AllowAccountRecovery != allowAccountRecovery {
+		changes = append(changes, policy.ChangeAllowAccountRecovery(allowAccountRecovery))
+	}
+	if wm.AllowMagicLink != allowMagicLink {
+		changes = append(changes, policy.ChangeAllowMagicLink(allowMagicLink))
+	}
 	if len(changes) == 0 {
 		return nil, false
 	}
@@ -79,6 +79,10 @@ func TestCommandSide_ChangeDefaultLoginPolicy(t *testing.T) {
 								time.Hour*3,
 								time.Hour*4,
 								time.Hour*5,
+								false,
+								0,
+								false,
+								false,
 							),
 						),
 					),
@@ -137,6 +141,10 @@ func TestCommandSide_ChangeDefaultLoginPolicy(t *testing.T) {
 								time.Hour*3,
 								time.Hour*4,
 								time.Hour*5,
+								false,
+								0,
+								false,
+								false,
 							),
 						),
 					),
@@ -287,6 +295,10 @@ func TestCommandSide_AddIDPProviderDefaultLoginPolicy(t *testing.T) {
 								time.Hour*3,
 								time.Hour*4,
 								time.Hour*5,
+								false,
+								0,
+								false,
+								false,
 							),
 						),
 					),
@@ -329,6 +341,10 @@ func TestCommandSide_AddIDPProviderDefaultLoginPolicy(t *testing.T) {
 								time.Hour*3,
 								time.Hour*4,
 								time.Hour*5,
+								false,
+								0,
+								false,
+								false,
 							),
 						),
 					),
@@ -391,6 +407,10 @@ func TestCommandSide_AddIDPProviderDefaultLoginPolicy(t *testing.T) {
 								time.Hour*3,
 								time.Hour*4,
 								time.Hour*5,
+								false,
+								0,
+								false,
+								false,
 							),
 						),
 					),
@@ -529,6 +549,10 @@ func TestCommandSide_RemoveIDPProviderDefaultLoginPolicy(t *testing.T) {
 								time.Hour*3,
 								time.Hour*4,
 								time.Hour*5,
+								false,
+								0,
+								false,
+								false,
 							),
 						),
 					),
@@ -571,6 +595,10 @@ func TestCommandSide_RemoveIDPProviderDefaultLoginPolicy(t *testing.T) {
 								time.Hour*3,
 								time.Hour*4,
 								time.Hour*5,
+								false,
+								0,
+								false,
+								false,
 							),
 						),
 					),
@@ -626,6 +654,10 @@ func TestCommandSide_RemoveIDPProviderDefaultLoginPolicy(t *testing.T) {
 								time.Hour*3,
 								time.Hour*4,
 								time.Hour*5,
+								false,
+								0,
+								false,
+								false,
 							),
 						),
 					),
@@ -682,6 +714,10 @@ func TestCommandSide_RemoveIDPProviderDefaultLoginPolicy(t *testing.T) {
 								time.Hour*3,
 								time.Hour*4,
 								time.Hour*5,
+								false,
+								0,
+								false,
+								false,
 							),
 						),
 					),
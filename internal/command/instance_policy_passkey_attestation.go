@@ -0,0 +1,102 @@
+package command
+
+import (
+	"context"
+	"crypto/x509"
+	"encoding/pem"
+	"regexp"
+	"strings"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/command/preparation"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/instance"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+var aaguidRegexp = regexp.MustCompile(`^[0-9a-f]{8}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{12}$`)
+
+func (c *Commands) SetDefaultPasskeyAttestationPolicy(ctx context.Context, policy *domain.PasskeyAttestationPolicy) (*domain.ObjectDetails, error) {
+	instanceAgg := instance.NewAggregate(authz.GetInstance(ctx).InstanceID())
+	cmds, err := preparation.PrepareCommands(ctx, c.eventstore.Filter, prepareSetDefaultPasskeyAttestationPolicy(instanceAgg, policy))
+	if err != nil {
+		return nil, err
+	}
+	pushedEvents, err := c.eventstore.Push(ctx, cmds...)
+	if err != nil {
+		return nil, err
+	}
+	return pushedEventsToObjectDetails(pushedEvents), nil
+}
+
+func prepareSetDefaultPasskeyAttestationPolicy(a *instance.Aggregate, policy *domain.PasskeyAttestationPolicy) preparation.Validation {
+	return func() (preparation.CreateCommands, error) {
+		if err := validatePasskeyAttestationPolicy(policy); err != nil {
+			return nil, err
+		}
+		return func(ctx context.Context, filter preparation.FilterToQueryReducer) ([]eventstore.Command, error) {
+			writeModel := NewInstancePasskeyAttestationPolicyWriteModel(ctx)
+			events, err := filter(ctx, writeModel.Query())
+			if err != nil {
+				return nil, err
+			}
+			writeModel.AppendEvents(events...)
+			if err = writeModel.Reduce(); err != nil {
+				return nil, err
+			}
+			event, err := writeModel.NewSetEvent(ctx, &a.Aggregate, policy)
+			if err != nil {
+				return nil, err
+			}
+			return []eventstore.Command{event}, nil
+		}, nil
+	}
+}
+
+// validatePasskeyAttestationPolicy checks the policy and normalizes the AAGUIDs to lower case.
+// AAGUID lists and trust anchors are only meaningful with a verified attestation,
+// as the AAGUID is otherwise reported by the authenticator itself (or zeroed by the browser).
+// Requiring an attestation without any trust anchor would accept any (self-signed) certificate.
+func validatePasskeyAttestationPolicy(policy *domain.PasskeyAttestationPolicy) error {
+	if policy == nil || !policy.Conveyance.Valid() {
+		return zerrors.ThrowInvalidArgument(nil, "COMMAND-Pa1Cv", "Errors.Policy.PasskeyAttestation.Invalid.Conveyance")
+	}
+	var err error
+	if policy.AllowedAAGUIDs, err = normalizeAAGUIDs(policy.AllowedAAGUIDs); err != nil {
+		return err
+	}
+	if policy.DeniedAAGUIDs, err = normalizeAAGUIDs(policy.DeniedAAGUIDs); err != nil {
+		return err
+	}
+	for _, certificate := range policy.RootCertificates {
+		block, _ := pem.Decode([]byte(certificate))
+		if block == nil || block.Type != "CERTIFICATE" {
+			return zerrors.ThrowInvalidArgument(nil, "COMMAND-Pa3Rc", "Errors.Policy.PasskeyAttestation.Invalid.RootCertificate")
+		}
+		if _, err := x509.ParseCertificate(block.Bytes); err != nil {
+			return zerrors.ThrowInvalidArgument(err, "COMMAND-Pa4Rc", "Errors.Policy.PasskeyAttestation.Invalid.RootCertificate")
+		}
+	}
+	if (len(policy.AllowedAAGUIDs) > 0 || len(policy.DeniedAAGUIDs) > 0 || len(policy.RootCertificates) > 0 || policy.UseMetadata) && !policy.RequiresAttestation() {
+		return zerrors.ThrowInvalidArgument(nil, "COMMAND-Pa5At", "Errors.Policy.PasskeyAttestation.Invalid.AttestationRequired")
+	}
+	if policy.RequiresAttestation() && len(policy.RootCertificates) == 0 && !policy.UseMetadata {
+		return zerrors.ThrowInvalidArgument(nil, "COMMAND-Pa6Ta", "Errors.Policy.PasskeyAttestation.Invalid.TrustAnchorRequired")
+	}
+	return nil
+}
+
+func normalizeAAGUIDs(aaguids []string) ([]string, error) {
+	if len(aaguids) == 0 {
+		return nil, nil
+	}
+	normalized := make([]string, len(aaguids))
+	for i, aaguid := range aaguids {
+		normalized[i] = strings.ToLower(strings.TrimSpace(aaguid))
+		if !aaguidRegexp.MatchString(normalized[i]) {
+			return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Pa2Ag", "Errors.Policy.PasskeyAttestation.Invalid.AAGUID")
+		}
+	}
+	return normalized, nil
+}
@@ -0,0 +1,55 @@
+package command
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/instance"
+)
+
+type InstancePasskeyAttestationPolicyWriteModel struct {
+	PasskeyAttestationPolicyWriteModel
+}
+
+func NewInstancePasskeyAttestationPolicyWriteModel(ctx context.Context) *InstancePasskeyAttestationPolicyWriteModel {
+	return &InstancePasskeyAttestationPolicyWriteModel{
+		PasskeyAttestationPolicyWriteModel{
+			WriteModel: eventstore.WriteModel{
+				AggregateID:   authz.GetInstance(ctx).InstanceID(),
+				ResourceOwner: authz.GetInstance(ctx).InstanceID(),
+			},
+		},
+	}
+}
+
+func (wm *InstancePasskeyAttestationPolicyWriteModel) AppendEvents(events ...eventstore.Event) {
+	for _, event := range events {
+		if e, ok := event.(*instance.PasskeyAttestationPolicySetEvent); ok {
+			wm.PasskeyAttestationPolicyWriteModel.AppendEvents(&e.PasskeyAttestationPolicySetEvent)
+		}
+	}
+}
+
+func (wm *InstancePasskeyAttestationPolicyWriteModel) Reduce() error {
+	return wm.PasskeyAttestationPolicyWriteModel.Reduce()
+}
+
+func (wm *InstancePasskeyAttestationPolicyWriteModel) Query() *eventstore.SearchQueryBuilder {
+	return eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		ResourceOwner(wm.ResourceOwner).
+		AddQuery().
+		AggregateTypes(instance.AggregateType).
+		AggregateIDs(wm.PasskeyAttestationPolicyWriteModel.AggregateID).
+		EventTypes(instance.PasskeyAttestationPolicySetEventType).
+		Builder()
+}
+
+func (wm *InstancePasskeyAttestationPolicyWriteModel) NewSetEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	policy *domain.PasskeyAttestationPolicy,
+) (*instance.PasskeyAttestationPolicySetEvent, error) {
+	return instance.NewPasskeyAttestationPolicySetEvent(ctx, aggregate, wm.changes(policy))
+}
@@ -0,0 +1,95 @@
+package command
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/instance"
+	"github.com/zitadel/zitadel/internal/repository/policy"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+func TestCommandSide_SetDefaultPasskeyAttestationPolicy(t *testing.T) {
+	ctx := authz.WithInstanceID(context.Background(), "INSTANCE")
+	newSetEvent := func(t *testing.T, changes ...policy.PasskeyAttestationPolicyChanges) *instance.PasskeyAttestationPolicySetEvent {
+		event, err := instance.NewPasskeyAttestationPolicySetEvent(ctx, &instance.NewAggregate("INSTANCE").Aggregate, changes)
+		require.NoError(t, err)
+		return event
+	}
+	type fields struct {
+		eventstore func(t *testing.T) *eventstore.Eventstore
+	}
+	type res struct {
+		want *domain.ObjectDetails
+		err  func(error) bool
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		policy *domain.PasskeyAttestationPolicy
+		res    res
+	}{
+		{
+			name: "metadata without attestation, invalid argument error",
+			fields: fields{
+				eventstore: expectEventstore(),
+			},
+			policy: &domain.PasskeyAttestationPolicy{
+				Conveyance:  domain.AttestationConveyanceNone,
+				UseMetadata: true,
+			},
+			res: res{
+				err: zerrors.IsErrorInvalidArgument,
+			},
+		},
+		{
+			name: "set policy, ok",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(),
+					expectPush(
+						newSetEvent(t,
+							policy.ChangePasskeyAttestationConveyance(domain.AttestationConveyanceEnterprise),
+							policy.ChangePasskeyAttestationRootCertificates(nil),
+							policy.ChangePasskeyAttestationUseMetadata(true),
+							policy.ChangePasskeyAttestationAllowedAAGUIDs(nil),
+							policy.ChangePasskeyAttestationDeniedAAGUIDs(nil),
+						),
+					),
+				),
+			},
+			policy: &domain.PasskeyAttestationPolicy{
+				Conveyance:  domain.AttestationConveyanceEnterprise,
+				UseMetadata: true,
+			},
+			res: res{
+				want: &domain.ObjectDetails{
+					ResourceOwner: "INSTANCE",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &Commands{
+				eventstore: tt.fields.eventstore(t),
+			}
+			got, err := r.SetDefaultPasskeyAttestationPolicy(ctx, tt.policy)
+			if tt.res.err == nil {
+				assert.NoError(t, err)
+			}
+			if tt.res.err != nil && !tt.res.err(err) {
+				t.Errorf("got wrong err: %v ", err)
+			}
+			if tt.res.err == nil {
+				assertObjectDetails(t, tt.res.want, got)
+			}
+		})
+	}
+}
@@ -12,9 +12,12 @@ import (
 	"github.com/zitadel/zitadel/internal/zerrors"
 )
 
-func (c *Commands) AddDefaultPasswordComplexityPolicy(ctx context.Context, minLength uint64, hasLowercase, hasUppercase, hasNumber, hasSymbol bool) (*domain.ObjectDetails, error) {
+func (c *Commands) AddDefaultPasswordComplexityPolicy(ctx context.Context, minLength uint64, hasLowercase, hasUppercase, hasNumber, hasSymbol bool, historyDepth uint64, checkBreached, forceChangeBreached bool) (*domain.ObjectDetails, error) {
+	if err := c.checkBreachedPasswordsConfigured(checkBreached, forceChangeBreached); err != nil {
+		return nil, err
+	}
 	instanceAgg := instance.NewAggregate(authz.GetInstance(ctx).InstanceID())
-	cmds, err := preparation.PrepareCommands(ctx, c.eventstore.Filter, prepareAddDefaultPasswordComplexityPolicy(instanceAgg, minLength, hasLowercase, hasUppercase, hasNumber, hasSymbol))
+	cmds, err := preparation.PrepareCommands(ctx, c.eventstore.Filter, prepareAddDefaultPasswordComplexityPolicy(instanceAgg, minLength, hasLowercase, hasUppercase, hasNumber, hasSymbol, historyDepth, checkBreached, forceChangeBreached))
 	if err != nil {
 		return nil, err
 	}
@@ -29,6 +32,9 @@ func (c *Commands) ChangeDefaultPasswordComplexityPolicy(ctx context.Context, po
 	if err := policy.IsValid(); err != nil {
 		return nil, err
 	}
+	if err := c.checkBreachedPasswordsConfigured(policy.CheckBreached, policy.ForceChangeBreached); err != nil {
+		return nil, err
+	}
 
 	existingPolicy, err := c.defaultPasswordComplexityPolicyWriteModelByID(ctx)
 	if err != nil {
@@ -39,7 +45,7 @@ func (c *Commands) ChangeDefaultPasswordComplexityPolicy(ctx context.Context, po
 	}
 
 	instanceAgg := InstanceAggregateFromWriteModel(&existingPolicy.PasswordComplexityPolicyWriteModel.WriteModel)
-	changedEvent, hasChanged := existingPolicy.NewChangedEvent(ctx, instanceAgg, policy.MinLength, policy.HasLowercase, policy.HasUppercase, policy.HasNumber, policy.HasSymbol)
+	changedEvent, hasChanged := existingPolicy.NewChangedEvent(ctx, instanceAgg, policy.MinLength, policy.HasLowercase, policy.HasUppercase, policy.HasNumber, policy.HasSymbol, policy.HistoryDepth, policy.CheckBreached, policy.ForceChangeBreached)
 	if !hasChanged {
 		return nil, zerrors.ThrowPreconditionFailed(nil, "INSTANCE-9jlsf", "Errors.IAM.PasswordComplexityPolicy.NotChanged")
 	}
@@ -61,11 +67,17 @@ func prepareAddDefaultPasswordComplexityPolicy(
 	hasUppercase,
 	hasNumber,
 	hasSymbol bool,
+	historyDepth uint64,
+	checkBreached,
+	forceChangeBreached bool,
 ) preparation.Validation {
 	return func() (preparation.CreateCommands, error) {
 		if minLength == 0 || minLength > 72 {
 			return nil, zerrors.ThrowInvalidArgument(nil, "INSTANCE-Lsp0e", "Errors.Instance.PasswordComplexityPolicy.MinLengthNotAllowed")
 		}
+		if historyDepth > domain.PasswordHistoryMaxDepth {
+			return nil, zerrors.ThrowInvalidArgument(nil, "INSTANCE-Hst0e", "Errors.User.PasswordComplexityPolicy.HistoryDepthNotAllowed")
+		}
 		return func(ctx context.Context, filter preparation.FilterToQueryReducer) ([]eventstore.Command, error) {
 			writeModel := NewInstancePasswordComplexityPolicyWriteModel(ctx)
 			events, err := filter(ctx, writeModel.Query())
@@ -86,6 +98,9 @@ func prepareAddDefaultPasswordComplexityPolicy(
 					hasUppercase,
 					hasNumber,
 					hasSymbol,
+					historyDepth,
+					checkBreached,
+					forceChangeBreached,
 				),
 			}, nil
 		}, nil
@@ -59,6 +59,9 @@ func (wm *InstancePasswordComplexityPolicyWriteModel) NewChangedEvent(
 	hasUppercase,
 	hasNumber,
 	hasSymbol bool,
+	historyDepth uint64,
+	checkBreached,
+	forceChangeBreached bool,
 ) (*instance.PasswordComplexityPolicyChangedEvent, bool) {
 
 	changes := make([]policy.PasswordComplexityPolicyChanges, 0)
@@ -77,6 +80,15 @@ func (wm *InstancePasswordComplexityPolicyWriteModel) NewChangedEvent(
 	if wm.HasSymbol != hasSymbol {
 		changes = append(changes, policy.ChangeHasSymbol(hasSymbol))
 	}
+	if wm.HistoryDepth != historyDepth {
+		changes = append(changes, policy.ChangeHistoryDepth(historyDepth))
+	}
+	if wm.CheckBreached != checkBreached {
+		changes = append(changes, policy.ChangeCheckBreached(checkBreached))
+	}
+	if wm.ForceChangeBreached != forceChangeBreached {
+		changes = append(changes, policy.ChangeForceChangeBreached(forceChangeBreached))
+	}
 	if len(changes) == 0 {
 		return nil, false
 	}
@@ -26,6 +26,7 @@ func TestCommandSide_AddDefaultPasswordComplexityPolicy(t *testing.T) {
 		hasUppercase bool
 		hasNumber    bool
 		hasSymbol    bool
+		historyDepth uint64
 	}
 	type res struct {
 		want *domain.ObjectDetails
@@ -67,6 +68,9 @@ func TestCommandSide_AddDefaultPasswordComplexityPolicy(t *testing.T) {
 								&instance.NewAggregate("INSTANCE").Aggregate,
 								8,
 								true, true, true, true,
+								0,
+								false,
+								false,
 							),
 						),
 					),
@@ -95,6 +99,9 @@ func TestCommandSide_AddDefaultPasswordComplexityPolicy(t *testing.T) {
 							&instance.NewAggregate("INSTANCE").Aggregate,
 							8,
 							true, true, true, true,
+							0,
+							false,
+							false,
 						),
 					),
 				),
@@ -119,7 +126,7 @@ func TestCommandSide_AddDefaultPasswordComplexityPolicy(t *testing.T) {
 			r := &Commands{
 				eventstore: tt.fields.eventstore,
 			}
-			got, err := r.AddDefaultPasswordComplexityPolicy(tt.args.ctx, tt.args.minLength, tt.args.hasLowercase, tt.args.hasUppercase, tt.args.hasNumber, tt.args.hasSymbol)
+			got, err := r.AddDefaultPasswordComplexityPolicy(tt.args.ctx, tt.args.minLength, tt.args.hasLowercase, tt.args.hasUppercase, tt.args.hasNumber, tt.args.hasSymbol, tt.args.historyDepth, false, false)
 			if tt.res.err == nil {
 				assert.NoError(t, err)
 			}
@@ -172,6 +179,28 @@ func TestCommandSide_ChangeDefaultPasswordComplexityPolicy(t *testing.T) {
 				err: zerrors.IsErrorInvalidArgument,
 			},
 		},
+		{
+			name: "breached password check without corpus, precondition error",
+			fields: fields{
+				eventstore: eventstoreExpect(
+					t,
+				),
+			},
+			args: args{
+				ctx: context.Background(),
+				policy: &domain.PasswordComplexityPolicy{
+					MinLength:     8,
+					HasUppercase:  true,
+					HasLowercase:  true,
+					HasNumber:     true,
+					HasSymbol:     true,
+					CheckBreached: true,
+				},
+			},
+			res: res{
+				err: zerrors.IsPreconditionFailed,
+			},
+		},
 		{
 			name: "password complexity policy not existing, not found error",
 			fields: fields{
@@ -205,6 +234,9 @@ func TestCommandSide_ChangeDefaultPasswordComplexityPolicy(t *testing.T) {
 								&instance.NewAggregate("INSTANCE").Aggregate,
 								8,
 								true, true, true, true,
+								0,
+								false,
+								false,
 							),
 						),
 					),
@@ -235,6 +267,9 @@ func TestCommandSide_ChangeDefaultPasswordComplexityPolicy(t *testing.T) {
 								&instance.NewAggregate("INSTANCE").Aggregate,
 								8,
 								true, true, true, true,
+								0,
+								false,
+								false,
 							),
 						),
 					),
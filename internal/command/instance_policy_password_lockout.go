@@ -2,6 +2,7 @@ package command
 
 import (
 	"context"
+	"time"
 
 	"github.com/zitadel/zitadel/internal/api/authz"
 	"github.com/zitadel/zitadel/internal/command/preparation"
@@ -12,7 +13,17 @@ import (
 	"github.com/zitadel/zitadel/internal/zerrors"
 )
 
-func (c *Commands) AddDefaultLockoutPolicy(ctx context.Context, maxPasswordAttempts, maxOTPAttempts uint64, showLockoutFailure bool) (*domain.ObjectDetails, error) {
+func (c *Commands) AddDefaultLockoutPolicy(
+	ctx context.Context,
+	maxPasswordAttempts,
+	maxOTPAttempts uint64,
+	showLockoutFailure bool,
+	maxIPAttempts,
+	maxClientAttempts uint64,
+	throttleWindow,
+	throttleDelay,
+	throttleBlockDuration time.Duration,
+) (*domain.ObjectDetails, error) {
 	instanceAgg := instance.NewAggregate(authz.GetInstance(ctx).InstanceID())
 	//nolint:staticcheck
 	cmds, err := preparation.PrepareCommands(ctx, c.eventstore.Filter, prepareAddDefaultLockoutPolicy(
@@ -20,6 +31,11 @@ func (c *Commands) AddDefaultLockoutPolicy(ctx context.Context, maxPasswordAttem
 		maxPasswordAttempts,
 		maxOTPAttempts,
 		showLockoutFailure,
+		maxIPAttempts,
+		maxClientAttempts,
+		throttleWindow,
+		throttleDelay,
+		throttleBlockDuration,
 	))
 	if err != nil {
 		return nil, err
@@ -47,6 +63,11 @@ func (c *Commands) ChangeDefaultLockoutPolicy(ctx context.Context, policy *domai
 		policy.MaxPasswordAttempts,
 		policy.MaxOTPAttempts,
 		policy.ShowLockOutFailures,
+		policy.MaxIPAttempts,
+		policy.MaxClientAttempts,
+		policy.ThrottleWindow,
+		policy.ThrottleDelay,
+		policy.ThrottleBlockDuration,
 	)
 	if !hasChanged {
 		return nil, zerrors.ThrowPreconditionFailed(nil, "INSTANCE-0psjF", "Errors.IAM.LockoutPolicy.NotChanged")
@@ -80,6 +101,11 @@ func prepareAddDefaultLockoutPolicy(
 	maxPasswordAttempts,
 	maxOTPAttempts uint64,
 	showLockoutFailure bool,
+	maxIPAttempts,
+	maxClientAttempts uint64,
+	throttleWindow,
+	throttleDelay,
+	throttleBlockDuration time.Duration,
 ) preparation.Validation {
 	return func() (preparation.CreateCommands, error) {
 		return func(ctx context.Context, filter preparation.FilterToQueryReducer) ([]eventstore.Command, error) {
@@ -96,7 +122,18 @@ func prepareAddDefaultLockoutPolicy(
 				return nil, zerrors.ThrowAlreadyExists(nil, "INSTANCE-0olDf", "Errors.Instance.LockoutPolicy.AlreadyExists")
 			}
 			return []eventstore.Command{
-				instance.NewLockoutPolicyAddedEvent(ctx, &a.Aggregate, maxPasswordAttempts, maxOTPAttempts, showLockoutFailure),
+				instance.NewLockoutPolicyAddedEvent(
+					ctx,
+					&a.Aggregate,
+					maxPasswordAttempts,
+					maxOTPAttempts,
+					showLockoutFailure,
+					maxIPAttempts,
+					maxClientAttempts,
+					throttleWindow,
+					throttleDelay,
+					throttleBlockDuration,
+				),
 			}, nil
 		}, nil
 	}
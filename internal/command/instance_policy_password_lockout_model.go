@@ -2,6 +2,7 @@ package command
 
 import (
 	"context"
+	"time"
 
 	"github.com/zitadel/zitadel/internal/api/authz"
 	"github.com/zitadel/zitadel/internal/eventstore"
@@ -56,7 +57,13 @@ func (wm *InstanceLockoutPolicyWriteModel) NewChangedEvent(
 	aggregate *eventstore.Aggregate,
 	maxPasswordAttempts,
 	maxOTPAttempts uint64,
-	showLockoutFailure bool) (*instance.LockoutPolicyChangedEvent, bool) {
+	showLockoutFailure bool,
+	maxIPAttempts,
+	maxClientAttempts uint64,
+	throttleWindow,
+	throttleDelay,
+	throttleBlockDuration time.Duration,
+) (*instance.LockoutPolicyChangedEvent, bool) {
 	changes := make([]policy.LockoutPolicyChanges, 0)
 	if wm.MaxPasswordAttempts != maxPasswordAttempts {
 		changes = append(changes, policy.ChangeMaxPasswordAttempts(maxPasswordAttempts))
@@ -67,6 +74,21 @@ func (wm *InstanceLockoutPolicyWriteModel) NewChangedEvent(
 	if wm.ShowLockOutFailures != showLockoutFailure {
 		changes = append(changes, policy.ChangeShowLockOutFailures(showLockoutFailure))
 	}
+	if wm.MaxIPAttempts != maxIPAttempts {
+		changes = append(changes, policy.ChangeMaxIPAttempts(maxIPAttempts))
+	}
+	if wm.MaxClientAttempts != maxClientAttempts {
+		changes = append(changes, policy.ChangeMaxClientAttempts(maxClientAttempts))
+	}
+	if wm.ThrottleWindow != throttleWindow {
+		changes = append(changes, policy.ChangeThrottleWindow(throttleWindow))
+	}
+	if wm.ThrottleDelay != throttleDelay {
+		changes = append(changes, policy.ChangeThrottleDelay(throttleDelay))
+	}
+	if wm.ThrottleBlockDuration != throttleBlockDuration {
+		changes = append(changes, policy.ChangeThrottleBlockDuration(throttleBlockDuration))
+	}
 	if len(changes) == 0 {
 		return nil, false
 	}
@@ -47,6 +47,11 @@ func TestCommandSide_AddDefaultLockoutPolicy(t *testing.T) {
 								10,
 								10,
 								true,
+								0,
+								0,
+								0,
+								0,
+								0,
 							),
 						),
 					),
@@ -73,6 +78,11 @@ func TestCommandSide_AddDefaultLockoutPolicy(t *testing.T) {
 							10,
 							10,
 							true,
+							0,
+							0,
+							0,
+							0,
+							0,
 						),
 					),
 				),
@@ -95,7 +105,7 @@ func TestCommandSide_AddDefaultLockoutPolicy(t *testing.T) {
 			r := &Commands{
 				eventstore: tt.fields.eventstore,
 			}
-			got, err := r.AddDefaultLockoutPolicy(tt.args.ctx, tt.args.maxPasswordAttempts, tt.args.maxOTPAttempts, tt.args.showLockOutFailures)
+			got, err := r.AddDefaultLockoutPolicy(tt.args.ctx, tt.args.maxPasswordAttempts, tt.args.maxOTPAttempts, tt.args.showLockOutFailures, 0, 0, 0, 0, 0)
 			if tt.res.err == nil {
 				assert.NoError(t, err)
 			}
@@ -159,6 +169,11 @@ func TestCommandSide_ChangeDefaultLockoutPolicy(t *testing.T) {
 								10,
 								10,
 								true,
+								0,
+								0,
+								0,
+								0,
+								0,
 							),
 						),
 					),
@@ -188,6 +203,11 @@ func TestCommandSide_ChangeDefaultLockoutPolicy(t *testing.T) {
 								10,
 								10,
 								true,
+								0,
+								0,
+								0,
+								0,
+								0,
 							),
 						),
 					),
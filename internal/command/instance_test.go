@@ -125,6 +125,9 @@ func oidcAppEvents(ctx context.Context, orgID, projectID, id, name, clientID str
 			"",
 			domain.LoginVersionUnspecified,
 			"",
+			false,
+			false,
+			"",
 		),
 	}
 }
@@ -201,16 +204,16 @@ func instancePoliciesFilters(instanceID string) []expect {
 func instancePoliciesEvents(ctx context.Context, instanceID string) []eventstore.Command {
 	instanceAgg := instance.NewAggregate(instanceID)
 	return []eventstore.Command{
-		instance.NewPasswordComplexityPolicyAddedEvent(ctx, &instanceAgg.Aggregate, 8, true, true, true, true),
+		instance.NewPasswordComplexityPolicyAddedEvent(ctx, &instanceAgg.Aggregate, 8, true, true, true, true, 0, false, false),
 		instance.NewPasswordAgePolicyAddedEvent(ctx, &instanceAgg.Aggregate, 0, 0),
 		instance.NewDomainPolicyAddedEvent(ctx, &instanceAgg.Aggregate, false, false, false),
-		instance.NewLoginPolicyAddedEvent(ctx, &instanceAgg.Aggregate, true, true, true, false, false, false, false, true, false, false, domain.PasswordlessTypeAllowed, "", 240*time.Hour, 240*time.Hour, 720*time.Hour, 18*time.Hour, 12*time.Hour),
+		instance.NewLoginPolicyAddedEvent(ctx, &instanceAgg.Aggregate, true, true, true, false, false, false, false, true, false, false, domain.PasswordlessTypeAllowed, "", 240*time.Hour, 240*time.Hour, 720*time.Hour, 18*time.Hour, 12*time.Hour, false, 0, false, false),
 		instance.NewLoginPolicySecondFactorAddedEvent(ctx, &instanceAgg.Aggregate, domain.SecondFactorTypeTOTP),
 		instance.NewLoginPolicySecondFactorAddedEvent(ctx, &instanceAgg.Aggregate, domain.SecondFactorTypeU2F),
 		instance.NewLoginPolicyMultiFactorAddedEvent(ctx, &instanceAgg.Aggregate, domain.MultiFactorTypeU2FWithPIN),
 		instance.NewPrivacyPolicyAddedEvent(ctx, &instanceAgg.Aggregate, "", "", "", "", "", "", ""),
 		instance.NewNotificationPolicyAddedEvent(ctx, &instanceAgg.Aggregate, true),
-		instance.NewLockoutPolicyAddedEvent(ctx, &instanceAgg.Aggregate, 0, 0, true),
+		instance.NewLockoutPolicyAddedEvent(ctx, &instanceAgg.Aggregate, 0, 0, true, 0, 0, 0, 0, 0),
 		instance.NewLabelPolicyAddedEvent(ctx, &instanceAgg.Aggregate, "#5469d4", "#fafafa", "#cd3d56", "#000000", "#2073c4", "#111827", "#ff3b5b", "#ffffff", false, false, false, domain.LabelPolicyThemeAuto),
 		instance.NewLabelPolicyActivatedEvent(ctx, &instanceAgg.Aggregate),
 	}
@@ -219,12 +222,15 @@ func instancePoliciesEvents(ctx context.Context, instanceID string) []eventstore
 func instanceSetupPoliciesConfig() *InstanceSetup {
 	return &InstanceSetup{
 		PasswordComplexityPolicy: struct {
-			MinLength    uint64
-			HasLowercase bool
-			HasUppercase bool
-			HasNumber    bool
-			HasSymbol    bool
-		}{8, true, true, true, true},
+			MinLength           uint64
+			HasLowercase        bool
+			HasUppercase        bool
+			HasNumber           bool
+			HasSymbol           bool
+			HistoryDepth        uint64
+			CheckBreached       bool
+			ForceChangeBreached bool
+		}{8, true, true, true, true, 0, false, false},
 		PasswordAgePolicy: struct {
 			ExpireWarnDays uint64
 			MaxAgeDays     uint64
@@ -252,7 +258,11 @@ func instanceSetupPoliciesConfig() *InstanceSetup {
 			MfaInitSkipLifetime        time.Duration
 			SecondFactorCheckLifetime  time.Duration
 			MultiFactorCheckLifetime   time.Duration
-		}{true, true, true, false, false, false, false, true, false, false, domain.PasswordlessTypeAllowed, "", 240 * time.Hour, 240 * time.Hour, 720 * time.Hour, 18 * time.Hour, 12 * time.Hour},
+			ForceMFAOnHighRisk         bool
+			TrustedDeviceLifetime      time.Duration
+			AllowAccountRecovery       bool
+			AllowMagicLink             bool
+		}{true, true, true, false, false, false, false, true, false, false, domain.PasswordlessTypeAllowed, "", 240 * time.Hour, 240 * time.Hour, 720 * time.Hour, 18 * time.Hour, 12 * time.Hour, false, 0, false, false},
 		NotificationPolicy: struct {
 			PasswordChange bool
 		}{true},
@@ -283,7 +293,12 @@ func instanceSetupPoliciesConfig() *InstanceSetup {
 			MaxPasswordAttempts      uint64
 			MaxOTPAttempts           uint64
 			ShouldShowLockoutFailure bool
-		}{0, 0, true},
+			MaxIPAttempts            uint64
+			MaxClientAttempts        uint64
+			ThrottleWindow           time.Duration
+			ThrottleDelay            time.Duration
+			ThrottleBlockDuration    time.Duration
+		}{0, 0, true, 0, 0, 0, 0, 0},
 	}
 }
 
@@ -440,6 +455,9 @@ func generatedDomainFilters(instanceID, orgID, projectID, appID, generatedDomain
 				"",
 				domain.LoginVersionUnspecified,
 				"",
+				false,
+				false,
+				"",
 			),
 		),
 		expectFilter(
@@ -477,6 +495,9 @@ func humanFilters(orgID string) []expect {
 				false,
 				false,
 				false,
+				0,
+				false,
+				false,
 			),
 		),
 	}
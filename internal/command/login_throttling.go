@@ -0,0 +1,61 @@
+package command
+
+import (
+	"context"
+
+	"github.com/zitadel/logging"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/throttle"
+)
+
+// checkLoginThrottling returns an error if the password and OTP checks of the user from the calling client
+// are throttled by the lockout policy of the user's organization.
+// The policy is returned for recording the result of the check, it's only queried if a throttler is configured.
+func checkLoginThrottling(
+	ctx context.Context,
+	throttler *throttle.Throttler,
+	userID, resourceOwner string,
+	queryReducer func(ctx context.Context, r eventstore.QueryReducer) error,
+) (*domain.LockoutPolicy, error) {
+	if throttler == nil {
+		return nil, nil
+	}
+	policy, err := getLockoutPolicy(ctx, resourceOwner, queryReducer)
+	if err != nil {
+		return nil, err
+	}
+	return policy, throttler.Check(ctx, policy, authz.GetInstance(ctx).InstanceID(), userID, throttle.ClientFromCtx(ctx))
+}
+
+// loginThrottlingFailed records a failed check of the user from the calling client.
+func loginThrottlingFailed(ctx context.Context, throttler *throttle.Throttler, policy *domain.LockoutPolicy, userID string) {
+	throttler.Failed(ctx, policy, authz.GetInstance(ctx).InstanceID(), userID, throttle.ClientFromCtx(ctx))
+}
+
+// loginThrottlingSucceeded resets the failed checks of the user from the calling client.
+func loginThrottlingSucceeded(ctx context.Context, throttler *throttle.Throttler, policy *domain.LockoutPolicy, userID string) {
+	throttler.Succeeded(ctx, policy, authz.GetInstance(ctx).InstanceID(), userID, throttle.ClientFromCtx(ctx))
+}
+
+// checkClientLoginThrottling returns an error if the checks from the IP address of the calling client are blocked.
+// It's called before the user is queried, so checks of unknown users are throttled as well.
+func checkClientLoginThrottling(ctx context.Context, throttler *throttle.Throttler) error {
+	return throttler.CheckClient(ctx, authz.GetInstance(ctx).InstanceID(), throttle.ClientFromCtx(ctx))
+}
+
+// clientLoginThrottlingFailed records a failed check of an unknown user from the IP address of the calling client,
+// using the default lockout policy of the instance, as the organization of the user is unknown.
+func clientLoginThrottlingFailed(ctx context.Context, throttler *throttle.Throttler, queryReducer func(ctx context.Context, r eventstore.QueryReducer) error) {
+	if throttler == nil {
+		return
+	}
+	wm, err := defaultLockoutPolicyWriteModelByID(ctx, queryReducer)
+	if err != nil {
+		logging.WithError(err).Error("unable to get default lockout policy")
+		return
+	}
+	throttler.FailedClient(ctx, writeModelToLockoutPolicy(&wm.LockoutPolicyWriteModel), authz.GetInstance(ctx).InstanceID(), throttle.ClientFromCtx(ctx))
+}
@@ -3,6 +3,7 @@ package command
 import (
 	"context"
 	"database/sql"
+	"slices"
 	"strings"
 	"testing"
 	"time"
@@ -295,3 +296,10 @@ func mockPasswordHasher(x string) *crypto.Hasher {
 		Prefixes: []string{"$plain$"},
 	}
 }
+
+// mockBreachedPasswords is a breached password corpus containing the listed passwords
+type mockBreachedPasswords []string
+
+func (m mockBreachedPasswords) IsBreached(_ context.Context, password string) (bool, error) {
+	return slices.Contains(m, password), nil
+}
@@ -0,0 +1,58 @@
+package command
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/notification"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// NotificationDeliverySucceeded records the successful delivery of the notification.
+func (c *Commands) NotificationDeliverySucceeded(ctx context.Context, agg *eventstore.Aggregate, info *notification.DeliveryInfo) error {
+	_, err := c.eventstore.Push(ctx, notification.NewDeliverySucceededEvent(ctx, agg, info))
+	return err
+}
+
+// NotificationDeliveryAttemptFailed records a failed attempt to deliver the notification, which will be retried.
+func (c *Commands) NotificationDeliveryAttemptFailed(ctx context.Context, agg *eventstore.Aggregate, info *notification.DeliveryInfo, deliveryErr error) error {
+	_, err := c.eventstore.Push(ctx, notification.NewDeliveryAttemptFailedEvent(ctx, agg, info, deliveryErr))
+	return err
+}
+
+// NotificationDeliveryFailed records that the notification was cancelled or could not be delivered in any attempt.
+func (c *Commands) NotificationDeliveryFailed(ctx context.Context, agg *eventstore.Aggregate, info *notification.DeliveryInfo, deliveryErr error) error {
+	_, err := c.eventstore.Push(ctx, notification.NewDeliveryFailedEvent(ctx, agg, info, deliveryErr))
+	return err
+}
+
+// RetryNotificationDelivery requests a new delivery of a failed notification of the instance.
+// The notification is queued again by the notification handler.
+func (c *Commands) RetryNotificationDelivery(ctx context.Context, id string) (*domain.ObjectDetails, error) {
+	if id == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Ndl1x", "Errors.IDMissing")
+	}
+	wm := NewNotificationDeliveryWriteModel(id)
+	if err := c.eventstore.FilterToQueryReducer(ctx, wm); err != nil {
+		return nil, err
+	}
+	if wm.State == domain.NotificationDeliveryStateUnspecified {
+		return nil, zerrors.ThrowNotFound(nil, "COMMAND-Ndl2x", "Errors.Notification.Delivery.NotFound")
+	}
+	if wm.State != domain.NotificationDeliveryStateFailed {
+		return nil, zerrors.ThrowPreconditionFailed(nil, "COMMAND-Ndl3x", "Errors.Notification.Delivery.NotFailed")
+	}
+	request := *wm.Request
+	request.NotificationID = id
+	agg := eventstore.AggregateFromWriteModelCtx(ctx, &wm.WriteModel, notification.AggregateType, notification.AggregateVersion)
+	// the retry is only requested once, even if it's requested concurrently
+	retry := eventstore.ExpectSequence(
+		notification.NewDeliveryRetryRequestedEvent(ctx, agg, &request),
+		eventstore.ExpectWriteModelSequence(&wm.WriteModel, wm.Query()),
+	)
+	if err := c.pushAppendAndReduce(ctx, wm, retry); err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&wm.WriteModel), nil
+}
@@ -0,0 +1,55 @@
+package command
+
+import (
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/notification"
+)
+
+type NotificationDeliveryWriteModel struct {
+	eventstore.WriteModel
+
+	Request *notification.Request
+	State   domain.NotificationDeliveryState
+}
+
+func NewNotificationDeliveryWriteModel(id string) *NotificationDeliveryWriteModel {
+	return &NotificationDeliveryWriteModel{
+		WriteModel: eventstore.WriteModel{
+			AggregateID: id,
+		},
+	}
+}
+
+func (wm *NotificationDeliveryWriteModel) Reduce() error {
+	for _, event := range wm.Events {
+		switch e := event.(type) {
+		case *notification.DeliverySucceededEvent:
+			wm.Request = e.Request
+			wm.State = domain.NotificationDeliveryStateSucceeded
+		case *notification.DeliveryAttemptFailedEvent:
+			wm.Request = e.Request
+			wm.State = domain.NotificationDeliveryStateRetrying
+		case *notification.DeliveryFailedEvent:
+			wm.Request = e.Request
+			wm.State = domain.NotificationDeliveryStateFailed
+		case *notification.DeliveryRetryRequestedEvent:
+			wm.State = domain.NotificationDeliveryStateRetryRequested
+		}
+	}
+	return wm.WriteModel.Reduce()
+}
+
+func (wm *NotificationDeliveryWriteModel) Query() *eventstore.SearchQueryBuilder {
+	return eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		AddQuery().
+		AggregateTypes(notification.AggregateType).
+		AggregateIDs(wm.AggregateID).
+		EventTypes(
+			notification.DeliverySucceededEventType,
+			notification.DeliveryAttemptFailedEventType,
+			notification.DeliveryFailedEventType,
+			notification.DeliveryRetryRequestedEventType,
+		).
+		Builder()
+}
@@ -0,0 +1,192 @@
+package command
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/notification"
+	"github.com/zitadel/zitadel/internal/repository/user"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+func TestCommands_RetryNotificationDelivery(t *testing.T) {
+	request := &notification.Request{
+		Aggregate:         &user.NewAggregate("user1", "org1").Aggregate,
+		UserID:            "user1",
+		UserResourceOwner: "org1",
+		EventType:         user.HumanEmailCodeAddedType,
+		MessageType:       domain.VerifyEmailMessageType,
+		NotificationType:  domain.NotificationTypeEmail,
+	}
+	info := &notification.DeliveryInfo{
+		Request:    request,
+		Recipient:  "user@example.com",
+		ProviderID: "provider1",
+		Attempt:    3,
+	}
+	retried := *request
+	retried.NotificationID = "notification1"
+	expectedDeliverySequence := &eventstore.ExpectedSequence{
+		EventTypes: []eventstore.EventType{
+			notification.DeliverySucceededEventType,
+			notification.DeliveryAttemptFailedEventType,
+			notification.DeliveryFailedEventType,
+			notification.DeliveryRetryRequestedEventType,
+		},
+	}
+
+	type fields struct {
+		eventstore func(t *testing.T) *eventstore.Eventstore
+	}
+	type args struct {
+		id string
+	}
+	type res struct {
+		err func(error) bool
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			name: "missing id, invalid argument error",
+			fields: fields{
+				eventstore: expectEventstore(),
+			},
+			args: args{},
+			res: res{
+				err: zerrors.IsErrorInvalidArgument,
+			},
+		},
+		{
+			name: "not existing, not found error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(),
+				),
+			},
+			args: args{
+				id: "notification1",
+			},
+			res: res{
+				err: zerrors.IsNotFound,
+			},
+		},
+		{
+			name: "still retrying, precondition failed error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(notification.NewDeliveryAttemptFailedEvent(context.Background(),
+							notification.NewAggregate("notification1", "org1", "instance1"),
+							info,
+							errors.New("connection refused"),
+						)),
+					),
+				),
+			},
+			args: args{
+				id: "notification1",
+			},
+			res: res{
+				err: zerrors.IsPreconditionFailed,
+			},
+		},
+		{
+			name: "succeeded, precondition failed error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(notification.NewDeliverySucceededEvent(context.Background(),
+							notification.NewAggregate("notification1", "org1", "instance1"),
+							info,
+						)),
+					),
+				),
+			},
+			args: args{
+				id: "notification1",
+			},
+			res: res{
+				err: zerrors.IsPreconditionFailed,
+			},
+		},
+		{
+			name: "failed, ok",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(notification.NewDeliveryFailedEvent(context.Background(),
+							notification.NewAggregate("notification1", "org1", "instance1"),
+							info,
+							errors.New("connection refused"),
+						)),
+					),
+					expectPush(
+						eventstore.ExpectSequence(
+							notification.NewDeliveryRetryRequestedEvent(context.Background(),
+								notification.NewAggregate("notification1", "org1", "instance1"),
+								&retried,
+							),
+							expectedDeliverySequence,
+						),
+					),
+				),
+			},
+			args: args{
+				id: "notification1",
+			},
+		},
+		{
+			name: "failed, retried concurrently, precondition failed error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(notification.NewDeliveryFailedEvent(context.Background(),
+							notification.NewAggregate("notification1", "org1", "instance1"),
+							info,
+							errors.New("connection refused"),
+						)),
+					),
+					expectPushFailed(
+						zerrors.ThrowPreconditionFailed(nil, "V3-Xs8mC", "Errors.ConcurrentModification"),
+						eventstore.ExpectSequence(
+							notification.NewDeliveryRetryRequestedEvent(context.Background(),
+								notification.NewAggregate("notification1", "org1", "instance1"),
+								&retried,
+							),
+							expectedDeliverySequence,
+						),
+					),
+				),
+			},
+			args: args{
+				id: "notification1",
+			},
+			res: res{
+				err: eventstore.IsConcurrentModification,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore: tt.fields.eventstore(t),
+			}
+			_, err := c.RetryNotificationDelivery(context.Background(), tt.args.id)
+			if tt.res.err == nil {
+				assert.NoError(t, err)
+			}
+			if tt.res.err != nil && !tt.res.err(err) {
+				t.Errorf("got wrong err: %v ", err)
+			}
+		})
+	}
+}
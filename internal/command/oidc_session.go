@@ -48,6 +48,9 @@ type OIDCSession struct {
 	Reason            domain.TokenReason
 	Actor             *domain.TokenActor
 	RefreshToken      string
+	DPoPJKT           string
+
+	AuthorizationDetails domain.AuthorizationDetails
 }
 
 type AuthRequestComplianceChecker func(context.Context, *AuthRequestWriteModel) error
@@ -61,6 +64,7 @@ func (c *Commands) CreateOIDCSessionFromAuthRequest(
 	complianceCheck AuthRequestComplianceChecker,
 	needRefreshToken bool,
 	backChannelLogoutURI string,
+	dpopJKT string,
 ) (session *OIDCSession, state string, err error) {
 	ctx, span := tracing.NewSpan(ctx)
 	defer func() { span.EndWithError(err) }()
@@ -113,6 +117,8 @@ func (c *Commands) CreateOIDCSessionFromAuthRequest(
 		authReqModel.Nonce,
 		sessionModel.PreferredLanguage,
 		sessionModel.UserAgent,
+		dpopJKT,
+		authReqModel.GrantedAuthorizationDetails,
 	)
 	cmd.RegisterLogout(ctx, sessionModel.AggregateID, sessionModel.UserID, authReqModel.ClientID, backChannelLogoutURI)
 
@@ -155,6 +161,8 @@ func (c *Commands) CreateOIDCSession(ctx context.Context,
 	needRefreshToken bool,
 	sessionID string,
 	responseType domain.OIDCResponseType,
+	dpopJKT string,
+	authorizationDetails domain.AuthorizationDetails,
 ) (session *OIDCSession, err error) {
 	ctx, span := tracing.NewSpan(ctx)
 	defer func() { span.EndWithError(err) }()
@@ -170,7 +178,7 @@ func (c *Commands) CreateOIDCSession(ctx context.Context,
 		cmd.UserImpersonated(ctx, userID, resourceOwner, clientID, actor)
 	}
 
-	cmd.AddSession(ctx, userID, resourceOwner, sessionID, clientID, audience, scope, authMethods, authTime, nonce, preferredLanguage, userAgent)
+	cmd.AddSession(ctx, userID, resourceOwner, sessionID, clientID, audience, scope, authMethods, authTime, nonce, preferredLanguage, userAgent, dpopJKT, authorizationDetails)
 	cmd.RegisterLogout(ctx, sessionID, userID, clientID, backChannelLogoutURI)
 	if responseType != domain.OIDCResponseTypeIDToken {
 		if err = cmd.AddAccessToken(ctx, scope, userID, resourceOwner, reason, actor); err != nil {
@@ -413,6 +421,8 @@ func (c *OIDCSessionEvents) AddSession(
 	nonce string,
 	preferredLanguage *language.Tag,
 	userAgent *domain.UserAgent,
+	dpopJKT string,
+	authorizationDetails domain.AuthorizationDetails,
 ) {
 	c.events = append(c.events, oidcsession.NewAddedEvent(
 		ctx,
@@ -428,6 +438,8 @@ func (c *OIDCSessionEvents) AddSession(
 		nonce,
 		preferredLanguage,
 		userAgent,
+		dpopJKT,
+		authorizationDetails,
 	))
 }
 
@@ -539,6 +551,9 @@ func (c *OIDCSessionEvents) PushEvents(ctx context.Context) (*OIDCSession, error
 		Reason:            c.oidcSessionWriteModel.AccessTokenReason,
 		Actor:             c.oidcSessionWriteModel.AccessTokenActor,
 		RefreshToken:      c.refreshToken,
+		DPoPJKT:           c.oidcSessionWriteModel.DPoPJKT,
+
+		AuthorizationDetails: c.oidcSessionWriteModel.AuthorizationDetails,
 	}
 	if c.accessTokenID != "" {
 		// prefix the returned id with the oidcSessionID so that we can retrieve it later on
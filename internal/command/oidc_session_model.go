@@ -35,6 +35,8 @@ type OIDCSessionWriteModel struct {
 	RefreshToken               string
 	RefreshTokenExpiration     time.Time
 	RefreshTokenIdleExpiration time.Time
+	DPoPJKT                    string
+	AuthorizationDetails       domain.AuthorizationDetails
 
 	aggregate *eventstore.Aggregate
 }
@@ -101,6 +103,8 @@ func (wm *OIDCSessionWriteModel) reduceAdded(e *oidcsession.AddedEvent) {
 	wm.Nonce = e.Nonce
 	wm.PreferredLanguage = e.PreferredLanguage
 	wm.UserAgent = e.UserAgent
+	wm.DPoPJKT = e.DPoPJKT
+	wm.AuthorizationDetails = e.AuthorizationDetails
 	wm.State = domain.OIDCSessionStateActive
 	// the write model might be initialized without resource owner,
 	// so update the aggregate
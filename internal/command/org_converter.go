@@ -24,12 +24,15 @@ func orgWriteModelToDomainPolicy(wm *OrgDomainPolicyWriteModel) *domain.DomainPo
 
 func orgWriteModelToPasswordComplexityPolicy(wm *OrgPasswordComplexityPolicyWriteModel) *domain.PasswordComplexityPolicy {
 	return &domain.PasswordComplexityPolicy{
-		ObjectRoot:   writeModelToObjectRoot(wm.PasswordComplexityPolicyWriteModel.WriteModel),
-		MinLength:    wm.MinLength,
-		HasLowercase: wm.HasLowercase,
-		HasUppercase: wm.HasUppercase,
-		HasNumber:    wm.HasNumber,
-		HasSymbol:    wm.HasSymbol,
+		ObjectRoot:          writeModelToObjectRoot(wm.PasswordComplexityPolicyWriteModel.WriteModel),
+		MinLength:           wm.MinLength,
+		HasLowercase:        wm.HasLowercase,
+		HasUppercase:        wm.HasUppercase,
+		HasNumber:           wm.HasNumber,
+		HasSymbol:           wm.HasSymbol,
+		HistoryDepth:        wm.HistoryDepth,
+		CheckBreached:       wm.CheckBreached,
+		ForceChangeBreached: wm.ForceChangeBreached,
 	}
 }
 
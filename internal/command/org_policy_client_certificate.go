@@ -0,0 +1,96 @@
+package command
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/command/preparation"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/org"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+func (c *Commands) SetOrgClientCertificatePolicy(ctx context.Context, resourceOwner string, policy *domain.ClientCertificatePolicy) (*domain.ObjectDetails, error) {
+	if resourceOwner == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "Org-Cc1Ro", "Errors.ResourceOwnerMissing")
+	}
+	orgAgg := org.NewAggregate(resourceOwner)
+	cmds, err := preparation.PrepareCommands(ctx, c.eventstore.Filter, prepareSetOrgClientCertificatePolicy(orgAgg, policy))
+	if err != nil {
+		return nil, err
+	}
+	pushedEvents, err := c.eventstore.Push(ctx, cmds...)
+	if err != nil {
+		return nil, err
+	}
+	return pushedEventsToObjectDetails(pushedEvents), nil
+}
+
+func prepareSetOrgClientCertificatePolicy(a *org.Aggregate, policy *domain.ClientCertificatePolicy) preparation.Validation {
+	return func() (preparation.CreateCommands, error) {
+		if err := validateClientCertificatePolicy(policy); err != nil {
+			return nil, err
+		}
+		return func(ctx context.Context, filter preparation.FilterToQueryReducer) ([]eventstore.Command, error) {
+			writeModel := NewOrgClientCertificatePolicyWriteModel(a.Aggregate.ID)
+			events, err := filter(ctx, writeModel.Query())
+			if err != nil {
+				return nil, err
+			}
+			writeModel.AppendEvents(events...)
+			if err = writeModel.Reduce(); err != nil {
+				return nil, err
+			}
+			event, err := writeModel.NewSetEvent(ctx, &a.Aggregate, policy)
+			if err != nil {
+				return nil, err
+			}
+			return []eventstore.Command{event}, nil
+		}, nil
+	}
+}
+
+// validateClientCertificatePolicy checks that at least one CA certificate is provided
+// and all CA certificates and CRLs can be parsed, so a client certificate check will not fail on an invalid policy.
+func validateClientCertificatePolicy(policy *domain.ClientCertificatePolicy) error {
+	if policy == nil || !policy.UserMapping.Valid() {
+		return zerrors.ThrowInvalidArgument(nil, "COMMAND-Cc1Um", "Errors.Policy.ClientCertificate.Invalid.UserMapping")
+	}
+	if len(policy.CACertificates) == 0 {
+		return zerrors.ThrowInvalidArgument(nil, "COMMAND-Cc2Cm", "Errors.Policy.ClientCertificate.Invalid.CACertificateMissing")
+	}
+	for _, bundle := range policy.CACertificates {
+		certificates, err := domain.ParseCertificates(bundle)
+		if err != nil || len(certificates) == 0 {
+			return zerrors.ThrowInvalidArgument(err, "COMMAND-Cc3Ca", "Errors.Policy.ClientCertificate.Invalid.CACertificate")
+		}
+	}
+	for _, bundle := range policy.CRLs {
+		lists, err := domain.ParseRevocationLists(bundle)
+		if err != nil || len(lists) == 0 {
+			return zerrors.ThrowInvalidArgument(err, "COMMAND-Cc4Cr", "Errors.Policy.ClientCertificate.Invalid.CRL")
+		}
+	}
+	return nil
+}
+
+func (c *Commands) RemoveOrgClientCertificatePolicy(ctx context.Context, resourceOwner string) (*domain.ObjectDetails, error) {
+	if resourceOwner == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "Org-Cc2Ro", "Errors.ResourceOwnerMissing")
+	}
+	writeModel := NewOrgClientCertificatePolicyWriteModel(resourceOwner)
+	if err := c.eventstore.FilterToQueryReducer(ctx, writeModel); err != nil {
+		return nil, err
+	}
+	if !writeModel.State.Exists() {
+		return nil, zerrors.ThrowNotFound(nil, "Org-Cc3Nf", "Errors.Org.ClientCertificatePolicy.NotFound")
+	}
+	pushedEvents, err := c.eventstore.Push(ctx, org.NewClientCertificatePolicyRemovedEvent(ctx, &org.NewAggregate(resourceOwner).Aggregate))
+	if err != nil {
+		return nil, err
+	}
+	if err = AppendAndReduce(writeModel, pushedEvents...); err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&writeModel.WriteModel), nil
+}
@@ -0,0 +1,95 @@
+package command
+
+import (
+	"context"
+	"slices"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/org"
+	"github.com/zitadel/zitadel/internal/repository/policy"
+)
+
+type OrgClientCertificatePolicyWriteModel struct {
+	eventstore.WriteModel
+
+	CACertificates []string
+	CRLs           []string
+	UserMapping    domain.ClientCertificateUserMapping
+	State          domain.PolicyState
+}
+
+func NewOrgClientCertificatePolicyWriteModel(orgID string) *OrgClientCertificatePolicyWriteModel {
+	return &OrgClientCertificatePolicyWriteModel{
+		WriteModel: eventstore.WriteModel{
+			AggregateID:   orgID,
+			ResourceOwner: orgID,
+		},
+	}
+}
+
+func (wm *OrgClientCertificatePolicyWriteModel) Reduce() error {
+	for _, event := range wm.Events {
+		switch e := event.(type) {
+		case *org.ClientCertificatePolicySetEvent:
+			wm.State = domain.PolicyStateActive
+			if e.CACertificates != nil {
+				wm.CACertificates = *e.CACertificates
+			}
+			if e.CRLs != nil {
+				wm.CRLs = *e.CRLs
+			}
+			if e.UserMapping != nil {
+				wm.UserMapping = *e.UserMapping
+			}
+		case *org.ClientCertificatePolicyRemovedEvent:
+			wm.CACertificates = nil
+			wm.CRLs = nil
+			wm.UserMapping = domain.ClientCertificateUserMappingSubjectCommonName
+			wm.State = domain.PolicyStateRemoved
+		}
+	}
+	return wm.WriteModel.Reduce()
+}
+
+func (wm *OrgClientCertificatePolicyWriteModel) Query() *eventstore.SearchQueryBuilder {
+	return eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		ResourceOwner(wm.ResourceOwner).
+		AddQuery().
+		AggregateTypes(org.AggregateType).
+		AggregateIDs(wm.AggregateID).
+		EventTypes(
+			org.ClientCertificatePolicySetEventType,
+			org.ClientCertificatePolicyRemovedEventType,
+		).
+		Builder()
+}
+
+func (wm *OrgClientCertificatePolicyWriteModel) NewSetEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	policyToSet *domain.ClientCertificatePolicy,
+) (*org.ClientCertificatePolicySetEvent, error) {
+	changes := make([]policy.ClientCertificatePolicyChanges, 0, 3)
+	// a removed policy must be set completely, so the projection does not keep any old values
+	force := !wm.State.Exists()
+	if force || !slices.Equal(wm.CACertificates, policyToSet.CACertificates) {
+		changes = append(changes, policy.ChangeClientCertificateCACertificates(policyToSet.CACertificates))
+	}
+	if force || !slices.Equal(wm.CRLs, policyToSet.CRLs) {
+		changes = append(changes, policy.ChangeClientCertificateCRLs(policyToSet.CRLs))
+	}
+	if force || wm.UserMapping != policyToSet.UserMapping {
+		changes = append(changes, policy.ChangeClientCertificateUserMapping(policyToSet.UserMapping))
+	}
+	return org.NewClientCertificatePolicySetEvent(ctx, aggregate, changes)
+}
+
+func writeModelToClientCertificatePolicy(wm *OrgClientCertificatePolicyWriteModel) *domain.ClientCertificatePolicy {
+	return &domain.ClientCertificatePolicy{
+		ObjectRoot:     writeModelToObjectRoot(wm.WriteModel),
+		CACertificates: wm.CACertificates,
+		CRLs:           wm.CRLs,
+		UserMapping:    wm.UserMapping,
+	}
+}
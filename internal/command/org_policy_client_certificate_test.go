@@ -0,0 +1,297 @@
+package command
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/org"
+	"github.com/zitadel/zitadel/internal/repository/policy"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+func newOrgClientCertificatePolicySetEvent(t *testing.T, changes ...policy.ClientCertificatePolicyChanges) *org.ClientCertificatePolicySetEvent {
+	event, err := org.NewClientCertificatePolicySetEvent(context.Background(), &org.NewAggregate("org1").Aggregate, changes)
+	require.NoError(t, err)
+	return event
+}
+
+func TestCommandSide_SetOrgClientCertificatePolicy(t *testing.T) {
+	caPEM, _ := newTestClientCertificates(t)
+	otherCAPEM, _ := newTestClientCertificates(t)
+	type fields struct {
+		eventstore func(t *testing.T) *eventstore.Eventstore
+	}
+	type args struct {
+		resourceOwner string
+		policy        *domain.ClientCertificatePolicy
+	}
+	type res struct {
+		want *domain.ObjectDetails
+		err  func(error) bool
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			name: "missing resource owner, invalid argument error",
+			fields: fields{
+				eventstore: expectEventstore(),
+			},
+			args: args{
+				policy: &domain.ClientCertificatePolicy{CACertificates: []string{caPEM}},
+			},
+			res: res{
+				err: zerrors.IsErrorInvalidArgument,
+			},
+		},
+		{
+			name: "invalid user mapping, invalid argument error",
+			fields: fields{
+				eventstore: expectEventstore(),
+			},
+			args: args{
+				resourceOwner: "org1",
+				policy: &domain.ClientCertificatePolicy{
+					CACertificates: []string{caPEM},
+					UserMapping:    domain.ClientCertificateUserMapping(99),
+				},
+			},
+			res: res{
+				err: zerrors.IsErrorInvalidArgument,
+			},
+		},
+		{
+			name: "missing ca certificate, invalid argument error",
+			fields: fields{
+				eventstore: expectEventstore(),
+			},
+			args: args{
+				resourceOwner: "org1",
+				policy:        &domain.ClientCertificatePolicy{},
+			},
+			res: res{
+				err: zerrors.IsErrorInvalidArgument,
+			},
+		},
+		{
+			name: "invalid ca certificate, invalid argument error",
+			fields: fields{
+				eventstore: expectEventstore(),
+			},
+			args: args{
+				resourceOwner: "org1",
+				policy: &domain.ClientCertificatePolicy{
+					CACertificates: []string{"certificate"},
+				},
+			},
+			res: res{
+				err: zerrors.IsErrorInvalidArgument,
+			},
+		},
+		{
+			name: "invalid crl, invalid argument error",
+			fields: fields{
+				eventstore: expectEventstore(),
+			},
+			args: args{
+				resourceOwner: "org1",
+				policy: &domain.ClientCertificatePolicy{
+					CACertificates: []string{caPEM},
+					CRLs:           []string{caPEM},
+				},
+			},
+			res: res{
+				err: zerrors.IsErrorInvalidArgument,
+			},
+		},
+		{
+			name: "set new policy, ok",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(),
+					expectPush(
+						newOrgClientCertificatePolicySetEvent(t,
+							policy.ChangeClientCertificateCACertificates([]string{caPEM}),
+							policy.ChangeClientCertificateCRLs(nil),
+							policy.ChangeClientCertificateUserMapping(domain.ClientCertificateUserMappingSANUPN),
+						),
+					),
+				),
+			},
+			args: args{
+				resourceOwner: "org1",
+				policy: &domain.ClientCertificatePolicy{
+					CACertificates: []string{caPEM},
+					UserMapping:    domain.ClientCertificateUserMappingSANUPN,
+				},
+			},
+			res: res{
+				want: &domain.ObjectDetails{
+					ResourceOwner: "org1",
+				},
+			},
+		},
+		{
+			name: "change existing policy, ok",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							newOrgClientCertificatePolicySetEvent(t,
+								policy.ChangeClientCertificateCACertificates([]string{caPEM}),
+								policy.ChangeClientCertificateCRLs(nil),
+								policy.ChangeClientCertificateUserMapping(domain.ClientCertificateUserMappingSANUPN),
+							),
+						),
+					),
+					expectPush(
+						newOrgClientCertificatePolicySetEvent(t,
+							policy.ChangeClientCertificateCACertificates([]string{caPEM, otherCAPEM}),
+						),
+					),
+				),
+			},
+			args: args{
+				resourceOwner: "org1",
+				policy: &domain.ClientCertificatePolicy{
+					CACertificates: []string{caPEM, otherCAPEM},
+					UserMapping:    domain.ClientCertificateUserMappingSANUPN,
+				},
+			},
+			res: res{
+				want: &domain.ObjectDetails{
+					ResourceOwner: "org1",
+				},
+			},
+		},
+		{
+			name: "no changes, precondition error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							newOrgClientCertificatePolicySetEvent(t,
+								policy.ChangeClientCertificateCACertificates([]string{caPEM}),
+								policy.ChangeClientCertificateCRLs(nil),
+								policy.ChangeClientCertificateUserMapping(domain.ClientCertificateUserMappingSubjectCommonName),
+							),
+						),
+					),
+				),
+			},
+			args: args{
+				resourceOwner: "org1",
+				policy: &domain.ClientCertificatePolicy{
+					CACertificates: []string{caPEM},
+				},
+			},
+			res: res{
+				err: zerrors.IsPreconditionFailed,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &Commands{
+				eventstore: tt.fields.eventstore(t),
+			}
+			got, err := r.SetOrgClientCertificatePolicy(context.Background(), tt.args.resourceOwner, tt.args.policy)
+			if tt.res.err == nil {
+				assert.NoError(t, err)
+			}
+			if tt.res.err != nil && !tt.res.err(err) {
+				t.Errorf("got wrong err: %v ", err)
+			}
+			if tt.res.err == nil {
+				assertObjectDetails(t, tt.res.want, got)
+			}
+		})
+	}
+}
+
+func TestCommandSide_RemoveOrgClientCertificatePolicy(t *testing.T) {
+	caPEM, _ := newTestClientCertificates(t)
+	type fields struct {
+		eventstore func(t *testing.T) *eventstore.Eventstore
+	}
+	type res struct {
+		want *domain.ObjectDetails
+		err  func(error) bool
+	}
+	tests := []struct {
+		name          string
+		fields        fields
+		resourceOwner string
+		res           res
+	}{
+		{
+			name: "missing resource owner, invalid argument error",
+			fields: fields{
+				eventstore: expectEventstore(),
+			},
+			res: res{
+				err: zerrors.IsErrorInvalidArgument,
+			},
+		},
+		{
+			name: "policy not existing, not found error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(),
+				),
+			},
+			resourceOwner: "org1",
+			res: res{
+				err: zerrors.IsNotFound,
+			},
+		},
+		{
+			name: "remove, ok",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							newOrgClientCertificatePolicySetEvent(t,
+								policy.ChangeClientCertificateCACertificates([]string{caPEM}),
+							),
+						),
+					),
+					expectPush(
+						org.NewClientCertificatePolicyRemovedEvent(context.Background(), &org.NewAggregate("org1").Aggregate),
+					),
+				),
+			},
+			resourceOwner: "org1",
+			res: res{
+				want: &domain.ObjectDetails{
+					ResourceOwner: "org1",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &Commands{
+				eventstore: tt.fields.eventstore(t),
+			}
+			got, err := r.RemoveOrgClientCertificatePolicy(context.Background(), tt.resourceOwner)
+			if tt.res.err == nil {
+				assert.NoError(t, err)
+			}
+			if tt.res.err != nil && !tt.res.err(err) {
+				t.Errorf("got wrong err: %v ", err)
+			}
+			if tt.res.err == nil {
+				assertObjectDetails(t, tt.res.want, got)
+			}
+		})
+	}
+}
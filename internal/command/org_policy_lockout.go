@@ -32,6 +32,11 @@ func (c *Commands) AddLockoutPolicy(ctx context.Context, resourceOwner string, p
 		policy.MaxPasswordAttempts,
 		policy.MaxOTPAttempts,
 		policy.ShowLockOutFailures,
+		policy.MaxIPAttempts,
+		policy.MaxClientAttempts,
+		policy.ThrottleWindow,
+		policy.ThrottleDelay,
+		policy.ThrottleBlockDuration,
 	))
 	if err != nil {
 		return nil, err
@@ -56,7 +61,18 @@ func (c *Commands) ChangeLockoutPolicy(ctx context.Context, resourceOwner string
 	}
 
 	orgAgg := OrgAggregateFromWriteModel(&existingPolicy.LockoutPolicyWriteModel.WriteModel)
-	changedEvent, hasChanged := existingPolicy.NewChangedEvent(ctx, orgAgg, policy.MaxPasswordAttempts, policy.MaxOTPAttempts, policy.ShowLockOutFailures)
+	changedEvent, hasChanged := existingPolicy.NewChangedEvent(
+		ctx,
+		orgAgg,
+		policy.MaxPasswordAttempts,
+		policy.MaxOTPAttempts,
+		policy.ShowLockOutFailures,
+		policy.MaxIPAttempts,
+		policy.MaxClientAttempts,
+		policy.ThrottleWindow,
+		policy.ThrottleDelay,
+		policy.ThrottleBlockDuration,
+	)
 	if !hasChanged {
 		return nil, zerrors.ThrowPreconditionFailed(nil, "ORG-0JFSr", "Errors.Org.LockoutPolicy.NotChanged")
 	}
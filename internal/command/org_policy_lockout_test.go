@@ -64,6 +64,11 @@ func TestCommandSide_AddPasswordLockoutPolicy(t *testing.T) {
 								10,
 								10,
 								true,
+								0,
+								0,
+								0,
+								0,
+								0,
 							),
 						),
 					),
@@ -94,6 +99,11 @@ func TestCommandSide_AddPasswordLockoutPolicy(t *testing.T) {
 							10,
 							10,
 							true,
+							0,
+							0,
+							0,
+							0,
+							0,
 						),
 					),
 				),
@@ -210,6 +220,11 @@ func TestCommandSide_ChangePasswordLockoutPolicy(t *testing.T) {
 								10,
 								10,
 								true,
+								0,
+								0,
+								0,
+								0,
+								0,
 							),
 						),
 					),
@@ -240,6 +255,11 @@ func TestCommandSide_ChangePasswordLockoutPolicy(t *testing.T) {
 								10,
 								10,
 								true,
+								0,
+								0,
+								0,
+								0,
+								0,
 							),
 						),
 					),
@@ -349,6 +369,11 @@ func TestCommandSide_RemovePasswordLockoutPolicy(t *testing.T) {
 								10,
 								10,
 								true,
+								0,
+								0,
+								0,
+								0,
+								0,
 							),
 						),
 					),
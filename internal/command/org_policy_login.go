@@ -36,6 +36,10 @@ type AddLoginPolicy struct {
 	MultiFactorCheckLifetime   time.Duration
 	DisableLoginWithEmail      bool
 	DisableLoginWithPhone      bool
+	ForceMFAOnHighRisk         bool
+	TrustedDeviceLifetime      time.Duration
+	AllowAccountRecovery       bool
+	AllowMagicLink             bool
 }
 
 type AddLoginPolicyIDP struct {
@@ -61,6 +65,10 @@ type ChangeLoginPolicy struct {
 	MultiFactorCheckLifetime   time.Duration
 	DisableLoginWithEmail      bool
 	DisableLoginWithPhone      bool
+	ForceMFAOnHighRisk         bool
+	TrustedDeviceLifetime      time.Duration
+	AllowAccountRecovery       bool
+	AllowMagicLink             bool
 }
 
 func (c *Commands) AddLoginPolicy(ctx context.Context, resourceOwner string, policy *AddLoginPolicy) (_ *domain.ObjectDetails, err error) {
@@ -446,6 +454,10 @@ func prepareAddLoginPolicy(a *org.Aggregate, policy *AddLoginPolicy) preparation
 				policy.MFAInitSkipLifetime,
 				policy.SecondFactorCheckLifetime,
 				policy.MultiFactorCheckLifetime,
+				policy.ForceMFAOnHighRisk,
+				policy.TrustedDeviceLifetime,
+				policy.AllowAccountRecovery,
+				policy.AllowMagicLink,
 			))
 			for _, factor := range policy.SecondFactors {
 				cmds = append(cmds, org.NewLoginPolicySecondFactorAddedEvent(ctx, &a.Aggregate, factor))
@@ -491,7 +503,11 @@ func prepareChangeLoginPolicy(a *org.Aggregate, policy *ChangeLoginPolicy) prepa
 				policy.ExternalLoginCheckLifetime,
 				policy.MFAInitSkipLifetime,
 				policy.SecondFactorCheckLifetime,
-				policy.MultiFactorCheckLifetime)
+				policy.MultiFactorCheckLifetime,
+				policy.ForceMFAOnHighRisk,
+				policy.TrustedDeviceLifetime,
+				policy.AllowAccountRecovery,
+				policy.AllowMagicLink)
 			if !hasChanged {
 				return nil, zerrors.ThrowPreconditionFailed(nil, "Org-5M9vdd", "Errors.Org.LoginPolicy.NotChanged")
 			}
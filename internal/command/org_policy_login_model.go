@@ -79,6 +79,10 @@ func (wm *OrgLoginPolicyWriteModel) NewChangedEvent(
 	mfaInitSkipLifetime,
 	secondFactorCheckLifetime,
 	multiFactorCheckLifetime time.Duration,
+	forceMFAOnHighRisk bool,
+	trustedDeviceLifetime time.Duration,
+	allowAccountRecovery bool,
+	allowMagicLink bool,
 ) (*org.LoginPolicyChangedEvent, bool) {
 
 	changes := make([]policy.LoginPolicyChanges, 0)
@@ -133,6 +137,18 @@ func (wm *OrgLoginPolicyWriteModel) NewChangedEvent(
 	if wm.DisableLoginWithPhone != disableLoginWithPhone {
 		changes = append(changes, policy.ChangeDisableLoginWithPhone(disableLoginWithPhone))
 	}
+	if wm.ForceMFAOnHighRisk != forceMFAOnHighRisk {
+		changes = append(changes, policy.ChangeForceMFAOnHighRisk(forceMFAOnHighRisk))
+	}
+	if wm.TrustedDeviceLifetime != trustedDeviceLifetime {
+		changes = append(changes, policy.ChangeTrustedDeviceLifetime(trustedDeviceLifetime))
+	}
+	if wm.AllowAccountRecovery != allowAccountRecovery {
+		changes = append(changes, policy.ChangeAllowAccountRecovery(allowAccountRecovery))
+	}
+	if wm.AllowMagicLink != allowMagicLink {
+		changes = append(changes, policy.ChangeAllowMagicLink(allowMagicLink))
+	}
 	if len(changes) == 0 {
 		return nil, false
 	}
@@ -69,6 +69,10 @@ func TestCommandSide_AddLoginPolicy(t *testing.T) {
 								time.Hour*3,
 								time.Hour*4,
 								time.Hour*5,
+								false,
+								0,
+								false,
+								false,
 							),
 						),
 					),
@@ -124,6 +128,10 @@ func TestCommandSide_AddLoginPolicy(t *testing.T) {
 							time.Hour*3,
 							time.Hour*4,
 							time.Hour*5,
+							false,
+							0,
+							false,
+							false,
 						),
 					),
 				),
@@ -218,6 +226,10 @@ func TestCommandSide_AddLoginPolicy(t *testing.T) {
 							time.Hour*3,
 							time.Hour*4,
 							time.Hour*5,
+							false,
+							0,
+							false,
+							false,
 						),
 						org.NewLoginPolicySecondFactorAddedEvent(context.Background(),
 							&org.NewAggregate("org1").Aggregate,
@@ -343,6 +355,10 @@ func TestCommandSide_AddLoginPolicy(t *testing.T) {
 							time.Hour*3,
 							time.Hour*4,
 							time.Hour*5,
+							false,
+							0,
+							false,
+							false,
 						),
 						org.NewIdentityProviderAddedEvent(context.Background(),
 							&org.NewAggregate("org1").Aggregate,
@@ -425,6 +441,10 @@ func TestCommandSide_AddLoginPolicy(t *testing.T) {
 							time.Hour*3,
 							time.Hour*4,
 							time.Hour*5,
+							false,
+							0,
+							false,
+							false,
 						),
 						org.NewIdentityProviderAddedEvent(context.Background(),
 							&org.NewAggregate("org1").Aggregate,
@@ -563,6 +583,10 @@ func TestCommandSide_ChangeLoginPolicy(t *testing.T) {
 								time.Hour*3,
 								time.Hour*4,
 								time.Hour*5,
+								false,
+								0,
+								false,
+								false,
 							),
 						),
 					),
@@ -621,6 +645,10 @@ func TestCommandSide_ChangeLoginPolicy(t *testing.T) {
 								time.Hour*3,
 								time.Hour*4,
 								time.Hour*5,
+								false,
+								0,
+								false,
+								false,
 							),
 						),
 					),
@@ -770,6 +798,10 @@ func TestCommandSide_RemoveLoginPolicy(t *testing.T) {
 								time.Hour*3,
 								time.Hour*4,
 								time.Hour*5,
+								false,
+								0,
+								false,
+								false,
 							),
 						),
 					),
@@ -910,6 +942,10 @@ func TestCommandSide_AddIDPProviderLoginPolicy(t *testing.T) {
 								time.Hour*3,
 								time.Hour*4,
 								time.Hour*5,
+								false,
+								0,
+								false,
+								false,
 							),
 						),
 					),
@@ -955,6 +991,10 @@ func TestCommandSide_AddIDPProviderLoginPolicy(t *testing.T) {
 								time.Hour*3,
 								time.Hour*4,
 								time.Hour*5,
+								false,
+								0,
+								false,
+								false,
 							),
 						),
 					),
@@ -1020,6 +1060,10 @@ func TestCommandSide_AddIDPProviderLoginPolicy(t *testing.T) {
 								time.Hour*3,
 								time.Hour*4,
 								time.Hour*5,
+								false,
+								0,
+								false,
+								false,
 							),
 						),
 					),
@@ -1183,6 +1227,10 @@ func TestCommandSide_RemoveIDPProviderLoginPolicy(t *testing.T) {
 								time.Hour*3,
 								time.Hour*4,
 								time.Hour*5,
+								false,
+								0,
+								false,
+								false,
 							),
 						),
 					),
@@ -1228,6 +1276,10 @@ func TestCommandSide_RemoveIDPProviderLoginPolicy(t *testing.T) {
 								time.Hour*3,
 								time.Hour*4,
 								time.Hour*5,
+								false,
+								0,
+								false,
+								false,
 							),
 						),
 					),
@@ -1285,6 +1337,10 @@ func TestCommandSide_RemoveIDPProviderLoginPolicy(t *testing.T) {
 								time.Hour*3,
 								time.Hour*4,
 								time.Hour*5,
+								false,
+								0,
+								false,
+								false,
 							),
 						),
 					),
@@ -1345,6 +1401,10 @@ func TestCommandSide_RemoveIDPProviderLoginPolicy(t *testing.T) {
 								time.Hour*3,
 								time.Hour*4,
 								time.Hour*5,
+								false,
+								0,
+								false,
+								false,
 							),
 						),
 					),
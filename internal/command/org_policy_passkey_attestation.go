@@ -0,0 +1,98 @@
+package command
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/command/preparation"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/org"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+func (c *Commands) SetOrgPasskeyAttestationPolicy(ctx context.Context, resourceOwner string, policy *domain.PasskeyAttestationPolicy) (*domain.ObjectDetails, error) {
+	if resourceOwner == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "Org-Pa6Ro", "Errors.ResourceOwnerMissing")
+	}
+	orgAgg := org.NewAggregate(resourceOwner)
+	cmds, err := preparation.PrepareCommands(ctx, c.eventstore.Filter, prepareSetOrgPasskeyAttestationPolicy(orgAgg, policy))
+	if err != nil {
+		return nil, err
+	}
+	pushedEvents, err := c.eventstore.Push(ctx, cmds...)
+	if err != nil {
+		return nil, err
+	}
+	return pushedEventsToObjectDetails(pushedEvents), nil
+}
+
+func prepareSetOrgPasskeyAttestationPolicy(a *org.Aggregate, policy *domain.PasskeyAttestationPolicy) preparation.Validation {
+	return func() (preparation.CreateCommands, error) {
+		if err := validatePasskeyAttestationPolicy(policy); err != nil {
+			return nil, err
+		}
+		return func(ctx context.Context, filter preparation.FilterToQueryReducer) ([]eventstore.Command, error) {
+			writeModel := NewOrgPasskeyAttestationPolicyWriteModel(a.Aggregate.ID)
+			events, err := filter(ctx, writeModel.Query())
+			if err != nil {
+				return nil, err
+			}
+			writeModel.AppendEvents(events...)
+			if err = writeModel.Reduce(); err != nil {
+				return nil, err
+			}
+			event, err := writeModel.NewSetEvent(ctx, &a.Aggregate, policy)
+			if err != nil {
+				return nil, err
+			}
+			return []eventstore.Command{event}, nil
+		}, nil
+	}
+}
+
+func (c *Commands) RemoveOrgPasskeyAttestationPolicy(ctx context.Context, resourceOwner string) (*domain.ObjectDetails, error) {
+	if resourceOwner == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "Org-Pa7Ro", "Errors.ResourceOwnerMissing")
+	}
+	writeModel := NewOrgPasskeyAttestationPolicyWriteModel(resourceOwner)
+	if err := c.eventstore.FilterToQueryReducer(ctx, writeModel); err != nil {
+		return nil, err
+	}
+	if !writeModel.State.Exists() {
+		return nil, zerrors.ThrowNotFound(nil, "Org-Pa8Nf", "Errors.Org.PasskeyAttestationPolicy.NotFound")
+	}
+	pushedEvents, err := c.eventstore.Push(ctx, org.NewPasskeyAttestationPolicyRemovedEvent(ctx, &org.NewAggregate(resourceOwner).Aggregate))
+	if err != nil {
+		return nil, err
+	}
+	if err = AppendAndReduce(writeModel, pushedEvents...); err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&writeModel.WriteModel), nil
+}
+
+// passkeyAttestationPolicy returns the policy of the organization or the default of the instance.
+// If neither is set, nil is returned and any authenticator is accepted.
+func (c *Commands) passkeyAttestationPolicy(ctx context.Context, orgID string) (_ *domain.PasskeyAttestationPolicy, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	orgWriteModel := NewOrgPasskeyAttestationPolicyWriteModel(orgID)
+	if err = c.eventstore.FilterToQueryReducer(ctx, orgWriteModel); err != nil {
+		return nil, err
+	}
+	if orgWriteModel.State.Exists() {
+		return writeModelToPasskeyAttestationPolicy(&orgWriteModel.PasskeyAttestationPolicyWriteModel), nil
+	}
+	instanceWriteModel := NewInstancePasskeyAttestationPolicyWriteModel(ctx)
+	if err = c.eventstore.FilterToQueryReducer(ctx, instanceWriteModel); err != nil {
+		return nil, err
+	}
+	if !instanceWriteModel.State.Exists() {
+		return nil, nil
+	}
+	policy := writeModelToPasskeyAttestationPolicy(&instanceWriteModel.PasskeyAttestationPolicyWriteModel)
+	policy.IsDefault = true
+	return policy, nil
+}
@@ -0,0 +1,60 @@
+package command
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/org"
+)
+
+type OrgPasskeyAttestationPolicyWriteModel struct {
+	PasskeyAttestationPolicyWriteModel
+}
+
+func NewOrgPasskeyAttestationPolicyWriteModel(orgID string) *OrgPasskeyAttestationPolicyWriteModel {
+	return &OrgPasskeyAttestationPolicyWriteModel{
+		PasskeyAttestationPolicyWriteModel{
+			WriteModel: eventstore.WriteModel{
+				AggregateID:   orgID,
+				ResourceOwner: orgID,
+			},
+		},
+	}
+}
+
+func (wm *OrgPasskeyAttestationPolicyWriteModel) AppendEvents(events ...eventstore.Event) {
+	for _, event := range events {
+		switch e := event.(type) {
+		case *org.PasskeyAttestationPolicySetEvent:
+			wm.PasskeyAttestationPolicyWriteModel.AppendEvents(&e.PasskeyAttestationPolicySetEvent)
+		case *org.PasskeyAttestationPolicyRemovedEvent:
+			wm.PasskeyAttestationPolicyWriteModel.AppendEvents(&e.PasskeyAttestationPolicyRemovedEvent)
+		}
+	}
+}
+
+func (wm *OrgPasskeyAttestationPolicyWriteModel) Reduce() error {
+	return wm.PasskeyAttestationPolicyWriteModel.Reduce()
+}
+
+func (wm *OrgPasskeyAttestationPolicyWriteModel) Query() *eventstore.SearchQueryBuilder {
+	return eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		ResourceOwner(wm.ResourceOwner).
+		AddQuery().
+		AggregateTypes(org.AggregateType).
+		AggregateIDs(wm.PasskeyAttestationPolicyWriteModel.AggregateID).
+		EventTypes(
+			org.PasskeyAttestationPolicySetEventType,
+			org.PasskeyAttestationPolicyRemovedEventType,
+		).
+		Builder()
+}
+
+func (wm *OrgPasskeyAttestationPolicyWriteModel) NewSetEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	policy *domain.PasskeyAttestationPolicy,
+) (*org.PasskeyAttestationPolicySetEvent, error) {
+	return org.NewPasskeyAttestationPolicySetEvent(ctx, aggregate, wm.changes(policy))
+}
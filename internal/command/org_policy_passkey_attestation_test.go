@@ -0,0 +1,423 @@
+package command
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/instance"
+	"github.com/zitadel/zitadel/internal/repository/org"
+	"github.com/zitadel/zitadel/internal/repository/policy"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+func newOrgPasskeyAttestationPolicySetEvent(t *testing.T, changes ...policy.PasskeyAttestationPolicyChanges) *org.PasskeyAttestationPolicySetEvent {
+	event, err := org.NewPasskeyAttestationPolicySetEvent(context.Background(), &org.NewAggregate("org1").Aggregate, changes)
+	require.NoError(t, err)
+	return event
+}
+
+func TestCommandSide_SetOrgPasskeyAttestationPolicy(t *testing.T) {
+	type fields struct {
+		eventstore func(t *testing.T) *eventstore.Eventstore
+	}
+	type args struct {
+		resourceOwner string
+		policy        *domain.PasskeyAttestationPolicy
+	}
+	type res struct {
+		want *domain.ObjectDetails
+		err  func(error) bool
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			name: "missing resource owner, invalid argument error",
+			fields: fields{
+				eventstore: expectEventstore(),
+			},
+			args: args{
+				policy: &domain.PasskeyAttestationPolicy{Conveyance: domain.AttestationConveyanceNone},
+			},
+			res: res{
+				err: zerrors.IsErrorInvalidArgument,
+			},
+		},
+		{
+			name: "invalid conveyance, invalid argument error",
+			fields: fields{
+				eventstore: expectEventstore(),
+			},
+			args: args{
+				resourceOwner: "org1",
+				policy:        &domain.PasskeyAttestationPolicy{Conveyance: domain.AttestationConveyance(99)},
+			},
+			res: res{
+				err: zerrors.IsErrorInvalidArgument,
+			},
+		},
+		{
+			name: "invalid aaguid, invalid argument error",
+			fields: fields{
+				eventstore: expectEventstore(),
+			},
+			args: args{
+				resourceOwner: "org1",
+				policy: &domain.PasskeyAttestationPolicy{
+					Conveyance:    domain.AttestationConveyanceNone,
+					DeniedAAGUIDs: []string{"not-an-aaguid"},
+				},
+			},
+			res: res{
+				err: zerrors.IsErrorInvalidArgument,
+			},
+		},
+		{
+			name: "invalid root certificate, invalid argument error",
+			fields: fields{
+				eventstore: expectEventstore(),
+			},
+			args: args{
+				resourceOwner: "org1",
+				policy: &domain.PasskeyAttestationPolicy{
+					Conveyance:       domain.AttestationConveyanceDirect,
+					RootCertificates: []string{"certificate"},
+				},
+			},
+			res: res{
+				err: zerrors.IsErrorInvalidArgument,
+			},
+		},
+		{
+			name: "allow list without attestation, invalid argument error",
+			fields: fields{
+				eventstore: expectEventstore(),
+			},
+			args: args{
+				resourceOwner: "org1",
+				policy: &domain.PasskeyAttestationPolicy{
+					Conveyance:     domain.AttestationConveyanceIndirect,
+					AllowedAAGUIDs: []string{"cb69481e-8ff7-4039-93ec-0a2729a154a8"},
+				},
+			},
+			res: res{
+				err: zerrors.IsErrorInvalidArgument,
+			},
+		},
+		{
+			name: "attestation without trust anchor, invalid argument error",
+			fields: fields{
+				eventstore: expectEventstore(),
+			},
+			args: args{
+				resourceOwner: "org1",
+				policy: &domain.PasskeyAttestationPolicy{
+					Conveyance:     domain.AttestationConveyanceDirect,
+					AllowedAAGUIDs: []string{"cb69481e-8ff7-4039-93ec-0a2729a154a8"},
+				},
+			},
+			res: res{
+				err: zerrors.IsErrorInvalidArgument,
+			},
+		},
+		{
+			name: "deny list without attestation, invalid argument error",
+			fields: fields{
+				eventstore: expectEventstore(),
+			},
+			args: args{
+				resourceOwner: "org1",
+				policy: &domain.PasskeyAttestationPolicy{
+					Conveyance:    domain.AttestationConveyanceNone,
+					DeniedAAGUIDs: []string{"cb69481e-8ff7-4039-93ec-0a2729a154a8"},
+				},
+			},
+			res: res{
+				err: zerrors.IsErrorInvalidArgument,
+			},
+		},
+		{
+			name: "set new policy, ok",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(),
+					expectPush(
+						newOrgPasskeyAttestationPolicySetEvent(t,
+							policy.ChangePasskeyAttestationConveyance(domain.AttestationConveyanceDirect),
+							policy.ChangePasskeyAttestationRootCertificates(nil),
+							policy.ChangePasskeyAttestationUseMetadata(true),
+							policy.ChangePasskeyAttestationAllowedAAGUIDs([]string{"cb69481e-8ff7-4039-93ec-0a2729a154a8"}),
+							policy.ChangePasskeyAttestationDeniedAAGUIDs(nil),
+						),
+					),
+				),
+			},
+			args: args{
+				resourceOwner: "org1",
+				policy: &domain.PasskeyAttestationPolicy{
+					Conveyance:     domain.AttestationConveyanceDirect,
+					UseMetadata:    true,
+					AllowedAAGUIDs: []string{"CB69481E-8FF7-4039-93EC-0A2729A154A8"},
+				},
+			},
+			res: res{
+				want: &domain.ObjectDetails{
+					ResourceOwner: "org1",
+				},
+			},
+		},
+		{
+			name: "change existing policy, ok",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							newOrgPasskeyAttestationPolicySetEvent(t,
+								policy.ChangePasskeyAttestationConveyance(domain.AttestationConveyanceNone),
+								policy.ChangePasskeyAttestationRootCertificates(nil),
+								policy.ChangePasskeyAttestationUseMetadata(false),
+								policy.ChangePasskeyAttestationAllowedAAGUIDs(nil),
+								policy.ChangePasskeyAttestationDeniedAAGUIDs(nil),
+							),
+						),
+					),
+					expectPush(
+						newOrgPasskeyAttestationPolicySetEvent(t,
+							policy.ChangePasskeyAttestationConveyance(domain.AttestationConveyanceDirect),
+							policy.ChangePasskeyAttestationUseMetadata(true),
+							policy.ChangePasskeyAttestationDeniedAAGUIDs([]string{"cb69481e-8ff7-4039-93ec-0a2729a154a8"}),
+						),
+					),
+				),
+			},
+			args: args{
+				resourceOwner: "org1",
+				policy: &domain.PasskeyAttestationPolicy{
+					Conveyance:    domain.AttestationConveyanceDirect,
+					UseMetadata:   true,
+					DeniedAAGUIDs: []string{"cb69481e-8ff7-4039-93ec-0a2729a154a8"},
+				},
+			},
+			res: res{
+				want: &domain.ObjectDetails{
+					ResourceOwner: "org1",
+				},
+			},
+		},
+		{
+			name: "no changes, precondition error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							newOrgPasskeyAttestationPolicySetEvent(t,
+								policy.ChangePasskeyAttestationConveyance(domain.AttestationConveyanceNone),
+								policy.ChangePasskeyAttestationRootCertificates(nil),
+								policy.ChangePasskeyAttestationUseMetadata(false),
+								policy.ChangePasskeyAttestationAllowedAAGUIDs(nil),
+								policy.ChangePasskeyAttestationDeniedAAGUIDs(nil),
+							),
+						),
+					),
+				),
+			},
+			args: args{
+				resourceOwner: "org1",
+				policy: &domain.PasskeyAttestationPolicy{
+					Conveyance: domain.AttestationConveyanceNone,
+				},
+			},
+			res: res{
+				err: zerrors.IsPreconditionFailed,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &Commands{
+				eventstore: tt.fields.eventstore(t),
+			}
+			got, err := r.SetOrgPasskeyAttestationPolicy(context.Background(), tt.args.resourceOwner, tt.args.policy)
+			if tt.res.err == nil {
+				assert.NoError(t, err)
+			}
+			if tt.res.err != nil && !tt.res.err(err) {
+				t.Errorf("got wrong err: %v ", err)
+			}
+			if tt.res.err == nil {
+				assertObjectDetails(t, tt.res.want, got)
+			}
+		})
+	}
+}
+
+func TestCommandSide_RemoveOrgPasskeyAttestationPolicy(t *testing.T) {
+	type fields struct {
+		eventstore func(t *testing.T) *eventstore.Eventstore
+	}
+	type res struct {
+		want *domain.ObjectDetails
+		err  func(error) bool
+	}
+	tests := []struct {
+		name          string
+		fields        fields
+		resourceOwner string
+		res           res
+	}{
+		{
+			name: "missing resource owner, invalid argument error",
+			fields: fields{
+				eventstore: expectEventstore(),
+			},
+			res: res{
+				err: zerrors.IsErrorInvalidArgument,
+			},
+		},
+		{
+			name: "policy not existing, not found error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(),
+				),
+			},
+			resourceOwner: "org1",
+			res: res{
+				err: zerrors.IsNotFound,
+			},
+		},
+		{
+			name: "remove, ok",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							newOrgPasskeyAttestationPolicySetEvent(t,
+								policy.ChangePasskeyAttestationConveyance(domain.AttestationConveyanceNone),
+							),
+						),
+					),
+					expectPush(
+						org.NewPasskeyAttestationPolicyRemovedEvent(context.Background(), &org.NewAggregate("org1").Aggregate),
+					),
+				),
+			},
+			resourceOwner: "org1",
+			res: res{
+				want: &domain.ObjectDetails{
+					ResourceOwner: "org1",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &Commands{
+				eventstore: tt.fields.eventstore(t),
+			}
+			got, err := r.RemoveOrgPasskeyAttestationPolicy(context.Background(), tt.resourceOwner)
+			if tt.res.err == nil {
+				assert.NoError(t, err)
+			}
+			if tt.res.err != nil && !tt.res.err(err) {
+				t.Errorf("got wrong err: %v ", err)
+			}
+			if tt.res.err == nil {
+				assertObjectDetails(t, tt.res.want, got)
+			}
+		})
+	}
+}
+
+func TestCommands_passkeyAttestationPolicy(t *testing.T) {
+	ctx := authz.WithInstanceID(context.Background(), "instance1")
+	tests := []struct {
+		name       string
+		eventstore func(t *testing.T) *eventstore.Eventstore
+		want       *domain.PasskeyAttestationPolicy
+	}{
+		{
+			name: "no policy",
+			eventstore: expectEventstore(
+				expectFilter(),
+				expectFilter(),
+			),
+			want: nil,
+		},
+		{
+			name: "org policy",
+			eventstore: expectEventstore(
+				expectFilter(
+					eventFromEventPusher(
+						newOrgPasskeyAttestationPolicySetEvent(t,
+							policy.ChangePasskeyAttestationConveyance(domain.AttestationConveyanceNone),
+							policy.ChangePasskeyAttestationDeniedAAGUIDs([]string{"cb69481e-8ff7-4039-93ec-0a2729a154a8"}),
+						),
+					),
+				),
+			),
+			want: &domain.PasskeyAttestationPolicy{
+				Conveyance:    domain.AttestationConveyanceNone,
+				DeniedAAGUIDs: []string{"cb69481e-8ff7-4039-93ec-0a2729a154a8"},
+			},
+		},
+		{
+			name: "removed org policy, default policy",
+			eventstore: expectEventstore(
+				expectFilter(
+					eventFromEventPusher(
+						newOrgPasskeyAttestationPolicySetEvent(t,
+							policy.ChangePasskeyAttestationConveyance(domain.AttestationConveyanceNone),
+						),
+					),
+					eventFromEventPusher(
+						org.NewPasskeyAttestationPolicyRemovedEvent(ctx, &org.NewAggregate("org1").Aggregate),
+					),
+				),
+				expectFilter(
+					eventFromEventPusher(
+						func() eventstore.Command {
+							event, err := instance.NewPasskeyAttestationPolicySetEvent(ctx, &instance.NewAggregate("instance1").Aggregate,
+								[]policy.PasskeyAttestationPolicyChanges{
+									policy.ChangePasskeyAttestationConveyance(domain.AttestationConveyanceDirect),
+									policy.ChangePasskeyAttestationUseMetadata(true),
+								},
+							)
+							require.NoError(t, err)
+							return event
+						}(),
+					),
+				),
+			),
+			want: &domain.PasskeyAttestationPolicy{
+				Conveyance:  domain.AttestationConveyanceDirect,
+				UseMetadata: true,
+				IsDefault:   true,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore: tt.eventstore(t),
+			}
+			got, err := c.passkeyAttestationPolicy(ctx, "org1")
+			require.NoError(t, err)
+			if tt.want == nil {
+				assert.Nil(t, got)
+				return
+			}
+			got.ObjectRoot = tt.want.ObjectRoot
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
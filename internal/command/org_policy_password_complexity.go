@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
 	"github.com/zitadel/zitadel/internal/repository/org"
 	"github.com/zitadel/zitadel/internal/telemetry/tracing"
 	"github.com/zitadel/zitadel/internal/zerrors"
@@ -23,6 +24,24 @@ func (c *Commands) getOrgPasswordComplexityPolicy(ctx context.Context, orgID str
 	return c.getDefaultPasswordComplexityPolicy(ctx)
 }
 
+// getPasswordComplexityPolicy returns the password complexity policy of the organization or the default of the instance.
+func getPasswordComplexityPolicy(ctx context.Context, orgID string, queryReducer func(ctx context.Context, r eventstore.QueryReducer) error) (*domain.PasswordComplexityPolicy, error) {
+	orgWm := NewOrgPasswordComplexityPolicyWriteModel(orgID)
+	if err := queryReducer(ctx, orgWm); err != nil {
+		return nil, err
+	}
+	if orgWm.State == domain.PolicyStateActive {
+		return orgWriteModelToPasswordComplexityPolicy(orgWm), nil
+	}
+	instanceWm := NewInstancePasswordComplexityPolicyWriteModel(ctx)
+	if err := queryReducer(ctx, instanceWm); err != nil {
+		return nil, err
+	}
+	policy := writeModelToPasswordComplexityPolicy(&instanceWm.PasswordComplexityPolicyWriteModel)
+	policy.Default = true
+	return policy, nil
+}
+
 func (c *Commands) orgPasswordComplexityPolicyWriteModelByID(ctx context.Context, orgID string) (*OrgPasswordComplexityPolicyWriteModel, error) {
 	policy := NewOrgPasswordComplexityPolicyWriteModel(orgID)
 	err := c.eventstore.FilterToQueryReducer(ctx, policy)
@@ -42,6 +61,9 @@ func (c *Commands) AddPasswordComplexityPolicy(ctx context.Context, resourceOwne
 	if err := policy.IsValid(); err != nil {
 		return nil, err
 	}
+	if err := c.checkBreachedPasswordsConfigured(policy.CheckBreached, policy.ForceChangeBreached); err != nil {
+		return nil, err
+	}
 	addedPolicy := NewOrgPasswordComplexityPolicyWriteModel(resourceOwner)
 	err = c.eventstore.FilterToQueryReducer(ctx, addedPolicy)
 	if err != nil {
@@ -61,7 +83,10 @@ func (c *Commands) AddPasswordComplexityPolicy(ctx context.Context, resourceOwne
 			policy.HasLowercase,
 			policy.HasUppercase,
 			policy.HasNumber,
-			policy.HasSymbol))
+			policy.HasSymbol,
+			policy.HistoryDepth,
+			policy.CheckBreached,
+			policy.ForceChangeBreached))
 	if err != nil {
 		return nil, err
 	}
@@ -79,6 +104,9 @@ func (c *Commands) ChangePasswordComplexityPolicy(ctx context.Context, resourceO
 	if err := policy.IsValid(); err != nil {
 		return nil, err
 	}
+	if err := c.checkBreachedPasswordsConfigured(policy.CheckBreached, policy.ForceChangeBreached); err != nil {
+		return nil, err
+	}
 
 	existingPolicy := NewOrgPasswordComplexityPolicyWriteModel(resourceOwner)
 	err := c.eventstore.FilterToQueryReducer(ctx, existingPolicy)
@@ -90,7 +118,7 @@ func (c *Commands) ChangePasswordComplexityPolicy(ctx context.Context, resourceO
 	}
 
 	orgAgg := OrgAggregateFromWriteModel(&existingPolicy.PasswordComplexityPolicyWriteModel.WriteModel)
-	changedEvent, hasChanged := existingPolicy.NewChangedEvent(ctx, orgAgg, policy.MinLength, policy.HasLowercase, policy.HasUppercase, policy.HasNumber, policy.HasSymbol)
+	changedEvent, hasChanged := existingPolicy.NewChangedEvent(ctx, orgAgg, policy.MinLength, policy.HasLowercase, policy.HasUppercase, policy.HasNumber, policy.HasSymbol, policy.HistoryDepth, policy.CheckBreached, policy.ForceChangeBreached)
 	if !hasChanged {
 		return nil, zerrors.ThrowPreconditionFailed(nil, "Org-DAs21", "Errors.Org.PasswordComplexityPolicy.NotChanged")
 	}
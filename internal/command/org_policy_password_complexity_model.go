@@ -60,6 +60,9 @@ func (wm *OrgPasswordComplexityPolicyWriteModel) NewChangedEvent(
 	hasUppercase,
 	hasNumber,
 	hasSymbol bool,
+	historyDepth uint64,
+	checkBreached,
+	forceChangeBreached bool,
 ) (*org.PasswordComplexityPolicyChangedEvent, bool) {
 
 	changes := make([]policy.PasswordComplexityPolicyChanges, 0)
@@ -78,6 +81,15 @@ func (wm *OrgPasswordComplexityPolicyWriteModel) NewChangedEvent(
 	if wm.HasSymbol != hasSymbol {
 		changes = append(changes, policy.ChangeHasSymbol(hasSymbol))
 	}
+	if wm.HistoryDepth != historyDepth {
+		changes = append(changes, policy.ChangeHistoryDepth(historyDepth))
+	}
+	if wm.CheckBreached != checkBreached {
+		changes = append(changes, policy.ChangeCheckBreached(checkBreached))
+	}
+	if wm.ForceChangeBreached != forceChangeBreached {
+		changes = append(changes, policy.ChangeForceChangeBreached(forceChangeBreached))
+	}
 	if len(changes) == 0 {
 		return nil, false
 	}
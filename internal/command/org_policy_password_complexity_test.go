@@ -65,6 +65,9 @@ func TestCommandSide_AddPasswordComplexityPolicy(t *testing.T) {
 								&org.NewAggregate("org1").Aggregate,
 								8,
 								true, true, true, true,
+								0,
+								false,
+								false,
 							),
 						),
 					),
@@ -96,6 +99,9 @@ func TestCommandSide_AddPasswordComplexityPolicy(t *testing.T) {
 							&org.NewAggregate("org1").Aggregate,
 							8,
 							true, true, true, true,
+							0,
+							false,
+							false,
 						),
 					),
 				),
@@ -219,6 +225,9 @@ func TestCommandSide_ChangePasswordComplexityPolicy(t *testing.T) {
 								&org.NewAggregate("org1").Aggregate,
 								8,
 								true, true, true, true,
+								0,
+								false,
+								false,
 							),
 						),
 					),
@@ -250,11 +259,14 @@ func TestCommandSide_ChangePasswordComplexityPolicy(t *testing.T) {
 								&org.NewAggregate("org1").Aggregate,
 								8,
 								true, true, true, true,
+								0,
+								false,
+								false,
 							),
 						),
 					),
 					expectPush(
-						newPasswordComplexityPolicyChangedEvent(context.Background(), "org1", 10, false, false, false, false),
+						newPasswordComplexityPolicyChangedEvent(context.Background(), "org1", 10, false, false, false, false, 4),
 					),
 				),
 			},
@@ -267,6 +279,7 @@ func TestCommandSide_ChangePasswordComplexityPolicy(t *testing.T) {
 					HasLowercase: false,
 					HasNumber:    false,
 					HasSymbol:    false,
+					HistoryDepth: 4,
 				},
 			},
 			res: res{
@@ -280,6 +293,7 @@ func TestCommandSide_ChangePasswordComplexityPolicy(t *testing.T) {
 					HasLowercase: false,
 					HasNumber:    false,
 					HasSymbol:    false,
+					HistoryDepth: 4,
 				},
 			},
 		},
@@ -362,6 +376,9 @@ func TestCommandSide_RemovePasswordComplexityPolicy(t *testing.T) {
 								&org.NewAggregate("org1").Aggregate,
 								8,
 								true, true, true, true,
+								0,
+								false,
+								false,
 							),
 						),
 					),
@@ -401,7 +418,7 @@ func TestCommandSide_RemovePasswordComplexityPolicy(t *testing.T) {
 	}
 }
 
-func newPasswordComplexityPolicyChangedEvent(ctx context.Context, orgID string, minLength uint64, hasUpper, hasLower, hasNumber, hasSymbol bool) *org.PasswordComplexityPolicyChangedEvent {
+func newPasswordComplexityPolicyChangedEvent(ctx context.Context, orgID string, minLength uint64, hasUpper, hasLower, hasNumber, hasSymbol bool, historyDepth uint64) *org.PasswordComplexityPolicyChangedEvent {
 	event, _ := org.NewPasswordComplexityPolicyChangedEvent(ctx,
 		&org.NewAggregate(orgID).Aggregate,
 		[]policy.PasswordComplexityPolicyChanges{
@@ -410,6 +427,7 @@ func newPasswordComplexityPolicyChangedEvent(ctx context.Context, orgID string,
 			policy.ChangeHasLowercase(hasLower),
 			policy.ChangeHasSymbol(hasNumber),
 			policy.ChangeHasNumber(hasSymbol),
+			policy.ChangeHistoryDepth(historyDepth),
 		},
 	)
 	return event
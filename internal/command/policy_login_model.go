@@ -28,6 +28,10 @@ type LoginPolicyWriteModel struct {
 	MFAInitSkipLifetime        time.Duration
 	SecondFactorCheckLifetime  time.Duration
 	MultiFactorCheckLifetime   time.Duration
+	ForceMFAOnHighRisk         bool
+	TrustedDeviceLifetime      time.Duration
+	AllowAccountRecovery       bool
+	AllowMagicLink             bool
 	State                      domain.PolicyState
 }
 
@@ -52,6 +56,10 @@ func (wm *LoginPolicyWriteModel) Reduce() error {
 			wm.MFAInitSkipLifetime = e.MFAInitSkipLifetime
 			wm.SecondFactorCheckLifetime = e.SecondFactorCheckLifetime
 			wm.MultiFactorCheckLifetime = e.MultiFactorCheckLifetime
+			wm.ForceMFAOnHighRisk = e.ForceMFAOnHighRisk
+			wm.TrustedDeviceLifetime = e.TrustedDeviceLifetime
+			wm.AllowAccountRecovery = e.AllowAccountRecovery
+			wm.AllowMagicLink = e.AllowMagicLink
 			wm.State = domain.PolicyStateActive
 		case *policy.LoginPolicyChangedEvent:
 			if e.AllowRegister != nil {
@@ -105,6 +113,18 @@ func (wm *LoginPolicyWriteModel) Reduce() error {
 			if e.DisableLoginWithPhone != nil {
 				wm.DisableLoginWithPhone = *e.DisableLoginWithPhone
 			}
+			if e.ForceMFAOnHighRisk != nil {
+				wm.ForceMFAOnHighRisk = *e.ForceMFAOnHighRisk
+			}
+			if e.TrustedDeviceLifetime != nil {
+				wm.TrustedDeviceLifetime = *e.TrustedDeviceLifetime
+			}
+			if e.AllowAccountRecovery != nil {
+				wm.AllowAccountRecovery = *e.AllowAccountRecovery
+			}
+			if e.AllowMagicLink != nil {
+				wm.AllowMagicLink = *e.AllowMagicLink
+			}
 		case *policy.LoginPolicyRemovedEvent:
 			wm.State = domain.PolicyStateRemoved
 		}
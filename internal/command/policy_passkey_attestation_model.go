@@ -0,0 +1,85 @@
+package command
+
+import (
+	"slices"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/policy"
+)
+
+type PasskeyAttestationPolicyWriteModel struct {
+	eventstore.WriteModel
+
+	Conveyance       domain.AttestationConveyance
+	RootCertificates []string
+	UseMetadata      bool
+	AllowedAAGUIDs   []string
+	DeniedAAGUIDs    []string
+	State            domain.PolicyState
+}
+
+func (wm *PasskeyAttestationPolicyWriteModel) Reduce() error {
+	for _, event := range wm.Events {
+		switch e := event.(type) {
+		case *policy.PasskeyAttestationPolicySetEvent:
+			wm.State = domain.PolicyStateActive
+			if e.Conveyance != nil {
+				wm.Conveyance = *e.Conveyance
+			}
+			if e.RootCertificates != nil {
+				wm.RootCertificates = *e.RootCertificates
+			}
+			if e.UseMetadata != nil {
+				wm.UseMetadata = *e.UseMetadata
+			}
+			if e.AllowedAAGUIDs != nil {
+				wm.AllowedAAGUIDs = *e.AllowedAAGUIDs
+			}
+			if e.DeniedAAGUIDs != nil {
+				wm.DeniedAAGUIDs = *e.DeniedAAGUIDs
+			}
+		case *policy.PasskeyAttestationPolicyRemovedEvent:
+			wm.Conveyance = domain.AttestationConveyanceNone
+			wm.RootCertificates = nil
+			wm.UseMetadata = false
+			wm.AllowedAAGUIDs = nil
+			wm.DeniedAAGUIDs = nil
+			wm.State = domain.PolicyStateRemoved
+		}
+	}
+	return wm.WriteModel.Reduce()
+}
+
+func (wm *PasskeyAttestationPolicyWriteModel) changes(policyToSet *domain.PasskeyAttestationPolicy) []policy.PasskeyAttestationPolicyChanges {
+	changes := make([]policy.PasskeyAttestationPolicyChanges, 0, 5)
+	// a removed policy must be set completely, so the projection does not keep any old values
+	force := !wm.State.Exists()
+	if force || wm.Conveyance != policyToSet.Conveyance {
+		changes = append(changes, policy.ChangePasskeyAttestationConveyance(policyToSet.Conveyance))
+	}
+	if force || !slices.Equal(wm.RootCertificates, policyToSet.RootCertificates) {
+		changes = append(changes, policy.ChangePasskeyAttestationRootCertificates(policyToSet.RootCertificates))
+	}
+	if force || wm.UseMetadata != policyToSet.UseMetadata {
+		changes = append(changes, policy.ChangePasskeyAttestationUseMetadata(policyToSet.UseMetadata))
+	}
+	if force || !slices.Equal(wm.AllowedAAGUIDs, policyToSet.AllowedAAGUIDs) {
+		changes = append(changes, policy.ChangePasskeyAttestationAllowedAAGUIDs(policyToSet.AllowedAAGUIDs))
+	}
+	if force || !slices.Equal(wm.DeniedAAGUIDs, policyToSet.DeniedAAGUIDs) {
+		changes = append(changes, policy.ChangePasskeyAttestationDeniedAAGUIDs(policyToSet.DeniedAAGUIDs))
+	}
+	return changes
+}
+
+func writeModelToPasskeyAttestationPolicy(wm *PasskeyAttestationPolicyWriteModel) *domain.PasskeyAttestationPolicy {
+	return &domain.PasskeyAttestationPolicy{
+		ObjectRoot:       writeModelToObjectRoot(wm.WriteModel),
+		Conveyance:       wm.Conveyance,
+		RootCertificates: wm.RootCertificates,
+		UseMetadata:      wm.UseMetadata,
+		AllowedAAGUIDs:   wm.AllowedAAGUIDs,
+		DeniedAAGUIDs:    wm.DeniedAAGUIDs,
+	}
+}
@@ -19,12 +19,15 @@ var (
 type PasswordComplexityPolicyWriteModel struct {
 	eventstore.WriteModel
 
-	MinLength    uint64
-	HasLowercase bool
-	HasUppercase bool
-	HasNumber    bool
-	HasSymbol    bool
-	State        domain.PolicyState
+	MinLength           uint64
+	HasLowercase        bool
+	HasUppercase        bool
+	HasNumber           bool
+	HasSymbol           bool
+	HistoryDepth        uint64
+	CheckBreached       bool
+	ForceChangeBreached bool
+	State               domain.PolicyState
 }
 
 func (wm *PasswordComplexityPolicyWriteModel) Reduce() error {
@@ -36,6 +39,9 @@ func (wm *PasswordComplexityPolicyWriteModel) Reduce() error {
 			wm.HasUppercase = e.HasUppercase
 			wm.HasNumber = e.HasNumber
 			wm.HasSymbol = e.HasSymbol
+			wm.HistoryDepth = e.HistoryDepth
+			wm.CheckBreached = e.CheckBreached
+			wm.ForceChangeBreached = e.ForceChangeBreached
 			wm.State = domain.PolicyStateActive
 		case *policy.PasswordComplexityPolicyChangedEvent:
 			if e.MinLength != nil {
@@ -53,6 +59,15 @@ func (wm *PasswordComplexityPolicyWriteModel) Reduce() error {
 			if e.HasSymbol != nil {
 				wm.HasSymbol = *e.HasSymbol
 			}
+			if e.HistoryDepth != nil {
+				wm.HistoryDepth = *e.HistoryDepth
+			}
+			if e.CheckBreached != nil {
+				wm.CheckBreached = *e.CheckBreached
+			}
+			if e.ForceChangeBreached != nil {
+				wm.ForceChangeBreached = *e.ForceChangeBreached
+			}
 		case *policy.PasswordComplexityPolicyRemovedEvent:
 			wm.State = domain.PolicyStateRemoved
 		}
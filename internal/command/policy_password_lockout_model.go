@@ -1,6 +1,8 @@
 package command
 
 import (
+	"time"
+
 	"github.com/zitadel/zitadel/internal/domain"
 	"github.com/zitadel/zitadel/internal/eventstore"
 	"github.com/zitadel/zitadel/internal/repository/policy"
@@ -9,10 +11,15 @@ import (
 type LockoutPolicyWriteModel struct {
 	eventstore.WriteModel
 
-	MaxPasswordAttempts uint64
-	MaxOTPAttempts      uint64
-	ShowLockOutFailures bool
-	State               domain.PolicyState
+	MaxPasswordAttempts   uint64
+	MaxOTPAttempts        uint64
+	ShowLockOutFailures   bool
+	MaxIPAttempts         uint64
+	MaxClientAttempts     uint64
+	ThrottleWindow        time.Duration
+	ThrottleDelay         time.Duration
+	ThrottleBlockDuration time.Duration
+	State                 domain.PolicyState
 }
 
 func (wm *LockoutPolicyWriteModel) Reduce() error {
@@ -22,6 +29,11 @@ func (wm *LockoutPolicyWriteModel) Reduce() error {
 			wm.MaxPasswordAttempts = e.MaxPasswordAttempts
 			wm.MaxOTPAttempts = e.MaxOTPAttempts
 			wm.ShowLockOutFailures = e.ShowLockOutFailures
+			wm.MaxIPAttempts = e.MaxIPAttempts
+			wm.MaxClientAttempts = e.MaxClientAttempts
+			wm.ThrottleWindow = e.ThrottleWindow
+			wm.ThrottleDelay = e.ThrottleDelay
+			wm.ThrottleBlockDuration = e.ThrottleBlockDuration
 			wm.State = domain.PolicyStateActive
 		case *policy.LockoutPolicyChangedEvent:
 			if e.MaxPasswordAttempts != nil {
@@ -33,6 +45,21 @@ func (wm *LockoutPolicyWriteModel) Reduce() error {
 			if e.ShowLockOutFailures != nil {
 				wm.ShowLockOutFailures = *e.ShowLockOutFailures
 			}
+			if e.MaxIPAttempts != nil {
+				wm.MaxIPAttempts = *e.MaxIPAttempts
+			}
+			if e.MaxClientAttempts != nil {
+				wm.MaxClientAttempts = *e.MaxClientAttempts
+			}
+			if e.ThrottleWindow != nil {
+				wm.ThrottleWindow = *e.ThrottleWindow
+			}
+			if e.ThrottleDelay != nil {
+				wm.ThrottleDelay = *e.ThrottleDelay
+			}
+			if e.ThrottleBlockDuration != nil {
+				wm.ThrottleBlockDuration = *e.ThrottleBlockDuration
+			}
 		case *policy.LockoutPolicyRemovedEvent:
 			wm.State = domain.PolicyStateRemoved
 		}
@@ -13,6 +13,7 @@ import (
 	"github.com/zitadel/zitadel/internal/feature"
 	"github.com/zitadel/zitadel/internal/query/projection"
 	"github.com/zitadel/zitadel/internal/repository/project"
+	"github.com/zitadel/zitadel/internal/repository/usergrant"
 	"github.com/zitadel/zitadel/internal/telemetry/tracing"
 	"github.com/zitadel/zitadel/internal/zerrors"
 )
@@ -58,6 +59,85 @@ func (c *Commands) AddProject(ctx context.Context, project *domain.Project, reso
 	return project, nil
 }
 
+// AddProjectWithUserGrants adds the project together with a user grant without roles for each of the users in one push,
+// so the project is not added if any of the users doesn't exist.
+func (c *Commands) AddProjectWithUserGrants(ctx context.Context, projectAdd *domain.Project, resourceOwner string, userIDs ...string) (_ *domain.Project, _ []*domain.UserGrant, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+	if !projectAdd.IsValid() {
+		return nil, nil, zerrors.ThrowInvalidArgument(nil, "PROJECT-Ug1pv", "Errors.Project.Invalid")
+	}
+	if resourceOwner == "" {
+		return nil, nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Ug2ro", "Errors.ResourceOwnerMissing")
+	}
+
+	projectAdd.AggregateID, err = c.idGenerator.Next()
+	if err != nil {
+		return nil, nil, err
+	}
+	projectWriteModel, err := c.getProjectWriteModelByID(ctx, projectAdd.AggregateID, resourceOwner)
+	if err != nil {
+		return nil, nil, err
+	}
+	if isProjectStateExists(projectWriteModel.State) {
+		return nil, nil, zerrors.ThrowAlreadyExists(nil, "COMMAND-Ug3ae", "Errors.Project.AlreadyExisting")
+	}
+
+	events := []eventstore.Command{
+		project.NewProjectAddedEvent(
+			ctx,
+			//nolint: contextcheck
+			ProjectAggregateFromWriteModel(&projectWriteModel.WriteModel),
+			projectAdd.Name,
+			projectAdd.ProjectRoleAssertion,
+			projectAdd.ProjectRoleCheck,
+			projectAdd.HasProjectCheck,
+			projectAdd.PrivateLabelingSetting),
+	}
+	grantWriteModels := make([]*UserGrantWriteModel, len(userIDs))
+	for i, userID := range userIDs {
+		if err = c.checkUserExists(ctx, userID, ""); err != nil {
+			return nil, nil, err
+		}
+		var grantID string
+		grantID, err = c.idGenerator.Next()
+		if err != nil {
+			return nil, nil, err
+		}
+		grantWriteModels[i] = NewUserGrantWriteModel(grantID, resourceOwner)
+		events = append(events, usergrant.NewUserGrantAddedEvent(
+			ctx,
+			UserGrantAggregateFromWriteModel(&grantWriteModels[i].WriteModel),
+			userID,
+			projectAdd.AggregateID,
+			"",
+			nil,
+		))
+	}
+	postCommit, err := c.projectCreatedMilestone(ctx, &events)
+	if err != nil {
+		return nil, nil, err
+	}
+	pushedEvents, err := c.eventstore.Push(ctx, events...)
+	if err != nil {
+		return nil, nil, err
+	}
+	postCommit(ctx)
+
+	// the events are pushed in order: the project, the user grants and the milestone
+	if err = AppendAndReduce(projectWriteModel, pushedEvents[0]); err != nil {
+		return nil, nil, err
+	}
+	userGrants := make([]*domain.UserGrant, len(grantWriteModels))
+	for i, writeModel := range grantWriteModels {
+		if err = AppendAndReduce(writeModel, pushedEvents[i+1]); err != nil {
+			return nil, nil, err
+		}
+		userGrants[i] = userGrantWriteModelToUserGrant(writeModel)
+	}
+	return projectWriteModelToProject(projectWriteModel), userGrants, nil
+}
+
 func (c *Commands) addProjectWithID(ctx context.Context, projectAdd *domain.Project, resourceOwner, projectID string) (_ *domain.Project, err error) {
 	projectAdd.AggregateID = projectID
 	projectWriteModel, err := c.getProjectWriteModelByID(ctx, projectAdd.AggregateID, resourceOwner)
@@ -16,24 +16,27 @@ import (
 
 type addOIDCApp struct {
 	AddApp
-	Version                     domain.OIDCVersion
-	RedirectUris                []string
-	ResponseTypes               []domain.OIDCResponseType
-	GrantTypes                  []domain.OIDCGrantType
-	ApplicationType             domain.OIDCApplicationType
-	AuthMethodType              domain.OIDCAuthMethodType
-	PostLogoutRedirectUris      []string
-	DevMode                     bool
-	AccessTokenType             domain.OIDCTokenType
-	AccessTokenRoleAssertion    bool
-	IDTokenRoleAssertion        bool
-	IDTokenUserinfoAssertion    bool
-	ClockSkew                   time.Duration
-	AdditionalOrigins           []string
-	SkipSuccessPageForNativeApp bool
-	BackChannelLogoutURI        string
-	LoginVersion                domain.LoginVersion
-	LoginBaseURI                string
+	Version                          domain.OIDCVersion
+	RedirectUris                     []string
+	ResponseTypes                    []domain.OIDCResponseType
+	GrantTypes                       []domain.OIDCGrantType
+	ApplicationType                  domain.OIDCApplicationType
+	AuthMethodType                   domain.OIDCAuthMethodType
+	PostLogoutRedirectUris           []string
+	DevMode                          bool
+	AccessTokenType                  domain.OIDCTokenType
+	AccessTokenRoleAssertion         bool
+	IDTokenRoleAssertion             bool
+	IDTokenUserinfoAssertion         bool
+	ClockSkew                        time.Duration
+	AdditionalOrigins                []string
+	SkipSuccessPageForNativeApp      bool
+	BackChannelLogoutURI             string
+	LoginVersion                     domain.LoginVersion
+	LoginBaseURI                     string
+	RequirePushedAuthRequest         bool
+	RequireDPoP                      bool
+	BackChannelClientNotificationURI string
 
 	ClientID          string
 	ClientSecret      string
@@ -114,6 +117,9 @@ func (c *Commands) AddOIDCAppCommand(app *addOIDCApp) preparation.Validation {
 					app.BackChannelLogoutURI,
 					app.LoginVersion,
 					app.LoginBaseURI,
+					app.RequirePushedAuthRequest,
+					app.RequireDPoP,
+					app.BackChannelClientNotificationURI,
 				),
 			}, nil
 		}, nil
@@ -157,7 +163,7 @@ func (c *Commands) AddOIDCApplication(ctx context.Context, oidcApp *domain.OIDCA
 	return c.addOIDCApplicationWithID(ctx, oidcApp, resourceOwner, appID)
 }
 
-func (c *Commands) addOIDCApplicationWithID(ctx context.Context, oidcApp *domain.OIDCApp, resourceOwner string, appID string) (_ *domain.OIDCApp, err error) {
+func (c *Commands) addOIDCApplicationWithID(ctx context.Context, oidcApp *domain.OIDCApp, resourceOwner string, appID string, additionalEvents ...eventstore.Command) (_ *domain.OIDCApp, err error) {
 	ctx, span := tracing.NewSpan(ctx)
 	defer func() { span.EndWithError(err) }()
 
@@ -204,7 +210,11 @@ func (c *Commands) addOIDCApplicationWithID(ctx context.Context, oidcApp *domain
 		strings.TrimSpace(oidcApp.BackChannelLogoutURI),
 		oidcApp.LoginVersion,
 		strings.TrimSpace(oidcApp.LoginBaseURI),
+		oidcApp.RequirePushedAuthRequest,
+		oidcApp.RequireDPoP,
+		strings.TrimSpace(oidcApp.BackChannelClientNotificationURI),
 	))
+	events = append(events, additionalEvents...)
 
 	addedApplication.AppID = oidcApp.AppID
 	postCommit, err := c.applicationCreatedMilestone(ctx, &events)
@@ -264,6 +274,9 @@ func (c *Commands) ChangeOIDCApplication(ctx context.Context, oidc *domain.OIDCA
 		strings.TrimSpace(oidc.BackChannelLogoutURI),
 		oidc.LoginVersion,
 		strings.TrimSpace(oidc.LoginBaseURI),
+		oidc.RequirePushedAuthRequest,
+		oidc.RequireDPoP,
+		strings.TrimSpace(oidc.BackChannelClientNotificationURI),
 	)
 	if err != nil {
 		return nil, err
@@ -14,32 +14,36 @@ import (
 type OIDCApplicationWriteModel struct {
 	eventstore.WriteModel
 
-	AppID                    string
-	AppName                  string
-	ClientID                 string
-	HashedSecret             string
-	ClientSecretString       string
-	RedirectUris             []string
-	ResponseTypes            []domain.OIDCResponseType
-	GrantTypes               []domain.OIDCGrantType
-	ApplicationType          domain.OIDCApplicationType
-	AuthMethodType           domain.OIDCAuthMethodType
-	PostLogoutRedirectUris   []string
-	OIDCVersion              domain.OIDCVersion
-	Compliance               *domain.Compliance
-	DevMode                  bool
-	AccessTokenType          domain.OIDCTokenType
-	AccessTokenRoleAssertion bool
-	IDTokenRoleAssertion     bool
-	IDTokenUserinfoAssertion bool
-	ClockSkew                time.Duration
-	State                    domain.AppState
-	AdditionalOrigins        []string
-	SkipNativeAppSuccessPage bool
-	BackChannelLogoutURI     string
-	LoginVersion             domain.LoginVersion
-	LoginBaseURI             string
-	oidc                     bool
+	AppID                            string
+	AppName                          string
+	ClientID                         string
+	HashedSecret                     string
+	ClientSecretString               string
+	RedirectUris                     []string
+	ResponseTypes                    []domain.OIDCResponseType
+	GrantTypes                       []domain.OIDCGrantType
+	ApplicationType                  domain.OIDCApplicationType
+	AuthMethodType                   domain.OIDCAuthMethodType
+	PostLogoutRedirectUris           []string
+	OIDCVersion                      domain.OIDCVersion
+	Compliance                       *domain.Compliance
+	DevMode                          bool
+	AccessTokenType                  domain.OIDCTokenType
+	AccessTokenRoleAssertion         bool
+	IDTokenRoleAssertion             bool
+	IDTokenUserinfoAssertion         bool
+	ClockSkew                        time.Duration
+	State                            domain.AppState
+	AdditionalOrigins                []string
+	SkipNativeAppSuccessPage         bool
+	BackChannelLogoutURI             string
+	LoginVersion                     domain.LoginVersion
+	LoginBaseURI                     string
+	RequirePushedAuthRequest         bool
+	RequireDPoP                      bool
+	BackChannelClientNotificationURI string
+	HashedRegistrationAccessToken    string
+	oidc                             bool
 }
 
 func NewOIDCApplicationWriteModelWithAppID(projectID, appID, resourceOwner string) *OIDCApplicationWriteModel {
@@ -103,6 +107,11 @@ func (wm *OIDCApplicationWriteModel) AppendEvents(events ...eventstore.Event) {
 				continue
 			}
 			wm.WriteModel.AppendEvents(e)
+		case *project.OIDCConfigRegisteredEvent:
+			if e.AppID != wm.AppID {
+				continue
+			}
+			wm.WriteModel.AppendEvents(e)
 		case *project.OIDCConfigSecretHashUpdatedEvent:
 			if e.AppID != wm.AppID {
 				continue
@@ -142,6 +151,8 @@ func (wm *OIDCApplicationWriteModel) Reduce() error {
 			wm.HashedSecret = crypto.SecretOrEncodedHash(e.ClientSecret, e.HashedSecret)
 		case *project.OIDCConfigSecretHashUpdatedEvent:
 			wm.HashedSecret = e.HashedSecret
+		case *project.OIDCConfigRegisteredEvent:
+			wm.HashedRegistrationAccessToken = e.HashedRegistrationAccessToken
 		case *project.ProjectRemovedEvent:
 			wm.State = domain.AppStateRemoved
 		}
@@ -171,6 +182,9 @@ func (wm *OIDCApplicationWriteModel) appendAddOIDCEvent(e *project.OIDCConfigAdd
 	wm.BackChannelLogoutURI = e.BackChannelLogoutURI
 	wm.LoginVersion = e.LoginVersion
 	wm.LoginBaseURI = e.LoginBaseURI
+	wm.RequirePushedAuthRequest = e.RequirePushedAuthRequest
+	wm.RequireDPoP = e.RequireDPoP
+	wm.BackChannelClientNotificationURI = e.BackChannelClientNotificationURI
 }
 
 func (wm *OIDCApplicationWriteModel) appendChangeOIDCEvent(e *project.OIDCConfigChangedEvent) {
@@ -228,6 +242,15 @@ func (wm *OIDCApplicationWriteModel) appendChangeOIDCEvent(e *project.OIDCConfig
 	if e.LoginBaseURI != nil {
 		wm.LoginBaseURI = *e.LoginBaseURI
 	}
+	if e.RequirePushedAuthRequest != nil {
+		wm.RequirePushedAuthRequest = *e.RequirePushedAuthRequest
+	}
+	if e.RequireDPoP != nil {
+		wm.RequireDPoP = *e.RequireDPoP
+	}
+	if e.BackChannelClientNotificationURI != nil {
+		wm.BackChannelClientNotificationURI = *e.BackChannelClientNotificationURI
+	}
 }
 
 func (wm *OIDCApplicationWriteModel) Query() *eventstore.SearchQueryBuilder {
@@ -246,6 +269,7 @@ func (wm *OIDCApplicationWriteModel) Query() *eventstore.SearchQueryBuilder {
 			project.OIDCConfigChangedType,
 			project.OIDCConfigSecretChangedType,
 			project.OIDCConfigSecretHashUpdatedType,
+			project.OIDCConfigRegisteredType,
 			project.ProjectRemovedType,
 		).Builder()
 }
@@ -272,6 +296,9 @@ func (wm *OIDCApplicationWriteModel) NewChangedEvent(
 	backChannelLogoutURI string,
 	loginVersion domain.LoginVersion,
 	loginBaseURI string,
+	requirePushedAuthRequest bool,
+	requireDPoP bool,
+	backChannelClientNotificationURI string,
 ) (*project.OIDCConfigChangedEvent, bool, error) {
 	changes := make([]project.OIDCConfigChanges, 0)
 	var err error
@@ -330,6 +357,15 @@ func (wm *OIDCApplicationWriteModel) NewChangedEvent(
 	if wm.LoginBaseURI != loginBaseURI {
 		changes = append(changes, project.ChangeOIDCLoginBaseURI(loginBaseURI))
 	}
+	if wm.RequirePushedAuthRequest != requirePushedAuthRequest {
+		changes = append(changes, project.ChangeRequirePushedAuthRequest(requirePushedAuthRequest))
+	}
+	if wm.RequireDPoP != requireDPoP {
+		changes = append(changes, project.ChangeRequireDPoP(requireDPoP))
+	}
+	if wm.BackChannelClientNotificationURI != backChannelClientNotificationURI {
+		changes = append(changes, project.ChangeBackChannelClientNotificationURI(backChannelClientNotificationURI))
+	}
 
 	if len(changes) == 0 {
 		return nil, false, nil
@@ -0,0 +1,173 @@
+package command
+
+import (
+	"context"
+	"strings"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/project"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// RegisterOIDCApplication adds the OIDC application to the project of the initial access token (RFC 7591).
+// Besides the application it returns a registration access token,
+// which allows the client to read, update and delete its own configuration (RFC 7592).
+func (c *Commands) RegisterOIDCApplication(ctx context.Context, initialAccessToken string, oidcApp *domain.OIDCApp) (_ *domain.OIDCApp, registrationAccessToken string, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	tokenWriteModel, err := c.checkInitialAccessToken(ctx, initialAccessToken)
+	if err != nil {
+		return nil, "", err
+	}
+	if oidcApp == nil || !oidcApp.IsValid() {
+		return nil, "", zerrors.ThrowInvalidArgument(nil, "COMMAND-Dcr1i", "Errors.Project.App.OIDCConfigInvalid")
+	}
+	oidcApp.AggregateID = tokenWriteModel.AggregateID
+	appID, err := c.idGenerator.Next()
+	if err != nil {
+		return nil, "", err
+	}
+	// the name is optional in the registration request,
+	// but must be unique inside the project
+	if oidcApp.AppName = strings.TrimSpace(oidcApp.AppName); oidcApp.AppName == "" {
+		oidcApp.AppName = appID
+	}
+	hashedToken, plain, err := c.newHashedSecret(ctx, c.eventstore.Filter) //nolint:staticcheck
+	if err != nil {
+		return nil, "", err
+	}
+	projectAgg := ProjectAggregateFromWriteModel(&tokenWriteModel.WriteModel)
+	app, err := c.addOIDCApplicationWithID(ctx, oidcApp, tokenWriteModel.ResourceOwner, appID,
+		project.NewOIDCConfigRegisteredEvent(ctx, projectAgg, appID, tokenWriteModel.TokenID, hashedToken),
+	)
+	if err != nil {
+		return nil, "", err
+	}
+	return app, registrationTokenValue(tokenWriteModel.AggregateID, appID, plain), nil
+}
+
+// GetRegisteredOIDCApplication returns the configuration of a dynamically registered application (RFC 7592, section 2.1).
+func (c *Commands) GetRegisteredOIDCApplication(ctx context.Context, clientID, registrationAccessToken string) (_ *domain.OIDCApp, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	writeModel, err := c.checkRegistrationAccessToken(ctx, clientID, registrationAccessToken)
+	if err != nil {
+		return nil, err
+	}
+	app := oidcWriteModelToOIDCConfig(writeModel)
+	app.FillCompliance()
+	return app, nil
+}
+
+// UpdateRegisteredOIDCApplication replaces the registered metadata of a dynamically registered application (RFC 7592, section 2.2).
+// Settings which can't be registered, e.g. the token type or role assertions, are kept.
+// The authentication method can't be changed, as the client secret would need to be created or removed.
+func (c *Commands) UpdateRegisteredOIDCApplication(ctx context.Context, clientID, registrationAccessToken string, oidcApp *domain.OIDCApp) (_ *domain.OIDCApp, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	writeModel, err := c.checkRegistrationAccessToken(ctx, clientID, registrationAccessToken)
+	if err != nil {
+		return nil, err
+	}
+	if oidcApp.AuthMethodType != writeModel.AuthMethodType {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Dcr2a", "Errors.Project.App.Registration.AuthMethodImmutable")
+	}
+	changed := oidcWriteModelToOIDCConfig(writeModel)
+	if name := strings.TrimSpace(oidcApp.AppName); name != "" {
+		changed.AppName = name
+	}
+	changed.RedirectUris = trimStringSliceWhiteSpaces(oidcApp.RedirectUris)
+	changed.PostLogoutRedirectUris = trimStringSliceWhiteSpaces(oidcApp.PostLogoutRedirectUris)
+	changed.ResponseTypes = oidcApp.ResponseTypes
+	changed.GrantTypes = oidcApp.GrantTypes
+	changed.ApplicationType = oidcApp.ApplicationType
+	if !changed.IsValid() {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Dcr3i", "Errors.Project.App.OIDCConfigInvalid")
+	}
+
+	projectAgg := ProjectAggregateFromWriteModel(&writeModel.WriteModel)
+	events := make([]eventstore.Command, 0, 2)
+	if changed.AppName != writeModel.AppName {
+		events = append(events, project.NewApplicationChangedEvent(ctx, projectAgg, writeModel.AppID, writeModel.AppName, changed.AppName))
+	}
+	changedEvent, hasChanged, err := writeModel.NewChangedEvent(
+		ctx,
+		projectAgg,
+		writeModel.AppID,
+		changed.RedirectUris,
+		changed.PostLogoutRedirectUris,
+		changed.ResponseTypes,
+		changed.GrantTypes,
+		changed.ApplicationType,
+		changed.AuthMethodType,
+		changed.OIDCVersion,
+		changed.AccessTokenType,
+		changed.DevMode,
+		changed.AccessTokenRoleAssertion,
+		changed.IDTokenRoleAssertion,
+		changed.IDTokenUserinfoAssertion,
+		changed.ClockSkew,
+		changed.AdditionalOrigins,
+		changed.SkipNativeAppSuccessPage,
+		changed.BackChannelLogoutURI,
+		changed.LoginVersion,
+		changed.LoginBaseURI,
+		changed.RequirePushedAuthRequest,
+		changed.RequireDPoP,
+		changed.BackChannelClientNotificationURI,
+	)
+	if err != nil {
+		return nil, err
+	}
+	if hasChanged {
+		events = append(events, changedEvent)
+	}
+	// an update without changes is not an error, the current configuration is returned
+	if err = c.pushAppendAndReduce(ctx, writeModel, events...); err != nil {
+		return nil, err
+	}
+	app := oidcWriteModelToOIDCConfig(writeModel)
+	app.FillCompliance()
+	return app, nil
+}
+
+// DeleteRegisteredOIDCApplication removes a dynamically registered application (RFC 7592, section 2.3).
+func (c *Commands) DeleteRegisteredOIDCApplication(ctx context.Context, clientID, registrationAccessToken string) (_ *domain.ObjectDetails, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	writeModel, err := c.checkRegistrationAccessToken(ctx, clientID, registrationAccessToken)
+	if err != nil {
+		return nil, err
+	}
+	return c.RemoveApplication(ctx, writeModel.AggregateID, writeModel.AppID, writeModel.ResourceOwner)
+}
+
+// checkRegistrationAccessToken verifies the registration access token of the client
+// and returns the write model of the application.
+func (c *Commands) checkRegistrationAccessToken(ctx context.Context, clientID, registrationAccessToken string) (_ *OIDCApplicationWriteModel, err error) {
+	projectID, appID, secret, ok := parseRegistrationToken(registrationAccessToken)
+	if !ok {
+		return nil, zerrors.ThrowUnauthenticated(nil, "COMMAND-Dcr4i", "Errors.Project.App.Registration.AccessTokenInvalid")
+	}
+	writeModel, err := c.getOIDCAppWriteModel(ctx, projectID, appID, "")
+	if err != nil {
+		return nil, err
+	}
+	if !writeModel.State.Exists() || !writeModel.IsOIDC() ||
+		writeModel.ClientID != clientID || writeModel.HashedRegistrationAccessToken == "" {
+		return nil, zerrors.ThrowUnauthenticated(nil, "COMMAND-Dcr5n", "Errors.Project.App.Registration.AccessTokenInvalid")
+	}
+	_, spanHashComparison := tracing.NewNamedSpan(ctx, "passwap.Verify")
+	_, err = c.secretHasher.Verify(writeModel.HashedRegistrationAccessToken, secret)
+	spanHashComparison.EndWithError(err)
+	if err != nil {
+		return nil, zerrors.ThrowUnauthenticated(err, "COMMAND-Dcr6v", "Errors.Project.App.Registration.AccessTokenInvalid")
+	}
+	return writeModel, nil
+}
@@ -0,0 +1,497 @@
+package command
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/eventstore/v1/models"
+	"github.com/zitadel/zitadel/internal/id"
+	id_mock "github.com/zitadel/zitadel/internal/id/mock"
+	"github.com/zitadel/zitadel/internal/repository/project"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+func registeredOIDCAppEvents(ctx context.Context, agg *eventstore.Aggregate, hashedToken string) []eventstore.Event {
+	return []eventstore.Event{
+		eventFromEventPusher(
+			project.NewApplicationAddedEvent(ctx, agg, "app1", "app"),
+		),
+		eventFromEventPusher(
+			project.NewOIDCConfigAddedEvent(ctx,
+				agg,
+				domain.OIDCVersionV1,
+				"app1",
+				"client1",
+				"",
+				[]string{"https://test.ch"},
+				[]domain.OIDCResponseType{domain.OIDCResponseTypeCode},
+				[]domain.OIDCGrantType{domain.OIDCGrantTypeAuthorizationCode},
+				domain.OIDCApplicationTypeWeb,
+				domain.OIDCAuthMethodTypeNone,
+				nil,
+				false,
+				domain.OIDCTokenTypeBearer,
+				false,
+				false,
+				false,
+				0,
+				nil,
+				false,
+				"",
+				domain.LoginVersionUnspecified,
+				"",
+				false,
+				false,
+				"",
+			),
+		),
+		eventFromEventPusher(
+			project.NewOIDCConfigRegisteredEvent(ctx, agg, "app1", "token1", hashedToken),
+		),
+	}
+}
+
+func TestCommands_RegisterOIDCApplication(t *testing.T) {
+	ctx := authz.WithInstanceID(context.Background(), "instanceID")
+	agg := &project.NewAggregate("project1", "org1").Aggregate
+	hasher := mockPasswordHasher("x")
+	hashedToken, err := hasher.Hash("secret")
+	if err != nil {
+		t.Fatal(err)
+	}
+	validApp := func() *domain.OIDCApp {
+		return &domain.OIDCApp{
+			AppName:         "app",
+			RedirectUris:    []string{"https://test.ch"},
+			ResponseTypes:   []domain.OIDCResponseType{domain.OIDCResponseTypeCode},
+			GrantTypes:      []domain.OIDCGrantType{domain.OIDCGrantTypeAuthorizationCode},
+			ApplicationType: domain.OIDCApplicationTypeWeb,
+			AuthMethodType:  domain.OIDCAuthMethodTypeBasic,
+		}
+	}
+	type fields struct {
+		eventstore  func(*testing.T) *eventstore.Eventstore
+		idGenerator id.Generator
+	}
+	type args struct {
+		token string
+		app   *domain.OIDCApp
+	}
+	tests := []struct {
+		name      string
+		fields    fields
+		args      args
+		want      *domain.OIDCApp
+		wantToken string
+		wantErr   error
+	}{
+		{
+			name: "malformed initial access token",
+			fields: fields{
+				eventstore: expectEventstore(),
+			},
+			args: args{
+				token: "malformed",
+				app:   validApp(),
+			},
+			wantErr: zerrors.ThrowUnauthenticated(nil, "COMMAND-Iat6i", "Errors.Project.InitialAccessToken.Invalid"),
+		},
+		{
+			name: "initial access token removed",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							project.NewProjectAddedEvent(ctx, agg, "project", true, true, true, domain.PrivateLabelingSettingUnspecified),
+						),
+						eventFromEventPusher(
+							project.NewInitialAccessTokenAddedEvent(ctx, agg, "token1", hashedToken, time.Now().Add(time.Hour)),
+						),
+						eventFromEventPusher(
+							project.NewInitialAccessTokenRemovedEvent(ctx, agg, "token1"),
+						),
+					),
+				),
+			},
+			args: args{
+				token: registrationTokenValue("project1", "token1", "secret"),
+				app:   validApp(),
+			},
+			wantErr: zerrors.ThrowUnauthenticated(nil, "COMMAND-Iat7x", "Errors.Project.InitialAccessToken.Invalid"),
+		},
+		{
+			name: "initial access token expired",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							project.NewProjectAddedEvent(ctx, agg, "project", true, true, true, domain.PrivateLabelingSettingUnspecified),
+						),
+						eventFromEventPusher(
+							project.NewInitialAccessTokenAddedEvent(ctx, agg, "token1", hashedToken, time.Now().Add(-time.Hour)),
+						),
+					),
+				),
+			},
+			args: args{
+				token: registrationTokenValue("project1", "token1", "secret"),
+				app:   validApp(),
+			},
+			wantErr: zerrors.ThrowUnauthenticated(nil, "COMMAND-Iat7x", "Errors.Project.InitialAccessToken.Invalid"),
+		},
+		{
+			name: "wrong secret",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							project.NewProjectAddedEvent(ctx, agg, "project", true, true, true, domain.PrivateLabelingSettingUnspecified),
+						),
+						eventFromEventPusher(
+							project.NewInitialAccessTokenAddedEvent(ctx, agg, "token1", hashedToken, time.Now().Add(time.Hour)),
+						),
+					),
+				),
+			},
+			args: args{
+				token: registrationTokenValue("project1", "token1", "wrong"),
+				app:   validApp(),
+			},
+			wantErr: zerrors.ThrowUnauthenticated(nil, "COMMAND-Iat8v", "Errors.Project.InitialAccessToken.Invalid"),
+		},
+		{
+			name: "invalid config",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							project.NewProjectAddedEvent(ctx, agg, "project", true, true, true, domain.PrivateLabelingSettingUnspecified),
+						),
+						eventFromEventPusher(
+							project.NewInitialAccessTokenAddedEvent(ctx, agg, "token1", hashedToken, time.Now().Add(time.Hour)),
+						),
+					),
+				),
+			},
+			args: args{
+				token: registrationTokenValue("project1", "token1", "secret"),
+				app: &domain.OIDCApp{
+					RedirectUris:  []string{"https://test.ch"},
+					ResponseTypes: []domain.OIDCResponseType{domain.OIDCResponseTypeCode},
+					GrantTypes:    []domain.OIDCGrantType{domain.OIDCGrantTypeImplicit},
+				},
+			},
+			wantErr: zerrors.ThrowInvalidArgument(nil, "COMMAND-Dcr1i", "Errors.Project.App.OIDCConfigInvalid"),
+		},
+		{
+			name: "registered",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							project.NewProjectAddedEvent(ctx, agg, "project", true, true, true, domain.PrivateLabelingSettingUnspecified),
+						),
+						eventFromEventPusher(
+							project.NewInitialAccessTokenAddedEvent(ctx, agg, "token1", hashedToken, time.Now().Add(time.Hour)),
+						),
+					),
+					expectPush(
+						project.NewApplicationAddedEvent(ctx, agg, "app1", "app"),
+						project.NewOIDCConfigAddedEvent(ctx,
+							agg,
+							domain.OIDCVersionV1,
+							"app1",
+							"client1",
+							"secret",
+							[]string{"https://test.ch"},
+							[]domain.OIDCResponseType{domain.OIDCResponseTypeCode},
+							[]domain.OIDCGrantType{domain.OIDCGrantTypeAuthorizationCode},
+							domain.OIDCApplicationTypeWeb,
+							domain.OIDCAuthMethodTypeBasic,
+							nil,
+							false,
+							domain.OIDCTokenTypeBearer,
+							false,
+							false,
+							false,
+							0,
+							nil,
+							false,
+							"",
+							domain.LoginVersionUnspecified,
+							"",
+							false,
+							false,
+							"",
+						),
+						project.NewOIDCConfigRegisteredEvent(ctx, agg, "app1", "token1", "secret"),
+					),
+				),
+				idGenerator: id_mock.NewIDGeneratorExpectIDs(t, "app1", "client1"),
+			},
+			args: args{
+				token: registrationTokenValue("project1", "token1", "secret"),
+				app:   validApp(),
+			},
+			want: &domain.OIDCApp{
+				ObjectRoot: models.ObjectRoot{
+					AggregateID:   "project1",
+					ResourceOwner: "org1",
+				},
+				AppID:              "app1",
+				AppName:            "app",
+				ClientID:           "client1",
+				ClientSecretString: "secret",
+				RedirectUris:       []string{"https://test.ch"},
+				ResponseTypes:      []domain.OIDCResponseType{domain.OIDCResponseTypeCode},
+				GrantTypes:         []domain.OIDCGrantType{domain.OIDCGrantTypeAuthorizationCode},
+				ApplicationType:    domain.OIDCApplicationTypeWeb,
+				AuthMethodType:     domain.OIDCAuthMethodTypeBasic,
+				State:              domain.AppStateActive,
+				Compliance:         &domain.Compliance{},
+			},
+			wantToken: registrationTokenValue("project1", "app1", "secret"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore:      tt.fields.eventstore(t),
+				idGenerator:     tt.fields.idGenerator,
+				newHashedSecret: mockHashedSecret("secret"),
+				secretHasher:    hasher,
+			}
+			c.setMilestonesCompletedForTest("instanceID")
+			got, gotToken, err := c.RegisterOIDCApplication(ctx, tt.args.token, tt.args.app)
+			assert.ErrorIs(t, err, tt.wantErr)
+			assert.Equal(t, tt.want, got)
+			assert.Equal(t, tt.wantToken, gotToken)
+		})
+	}
+}
+
+func TestCommands_UpdateRegisteredOIDCApplication(t *testing.T) {
+	ctx := context.Background()
+	agg := &project.NewAggregate("project1", "org1").Aggregate
+	hasher := mockPasswordHasher("x")
+	hashedToken, err := hasher.Hash("secret")
+	if err != nil {
+		t.Fatal(err)
+	}
+	token := registrationTokenValue("project1", "app1", "secret")
+	type args struct {
+		clientID string
+		token    string
+		app      *domain.OIDCApp
+	}
+	tests := []struct {
+		name       string
+		eventstore func(*testing.T) *eventstore.Eventstore
+		args       args
+		want       *domain.OIDCApp
+		wantErr    error
+	}{
+		{
+			name:       "malformed registration access token",
+			eventstore: expectEventstore(),
+			args: args{
+				clientID: "client1",
+				token:    "malformed",
+				app:      &domain.OIDCApp{},
+			},
+			wantErr: zerrors.ThrowUnauthenticated(nil, "COMMAND-Dcr4i", "Errors.Project.App.Registration.AccessTokenInvalid"),
+		},
+		{
+			name: "not registered dynamically",
+			eventstore: expectEventstore(
+				expectFilter(registeredOIDCAppEvents(ctx, agg, hashedToken)[:2]...),
+			),
+			args: args{
+				clientID: "client1",
+				token:    token,
+				app:      &domain.OIDCApp{},
+			},
+			wantErr: zerrors.ThrowUnauthenticated(nil, "COMMAND-Dcr5n", "Errors.Project.App.Registration.AccessTokenInvalid"),
+		},
+		{
+			name: "other client",
+			eventstore: expectEventstore(
+				expectFilter(registeredOIDCAppEvents(ctx, agg, hashedToken)...),
+			),
+			args: args{
+				clientID: "client2",
+				token:    token,
+				app:      &domain.OIDCApp{},
+			},
+			wantErr: zerrors.ThrowUnauthenticated(nil, "COMMAND-Dcr5n", "Errors.Project.App.Registration.AccessTokenInvalid"),
+		},
+		{
+			name: "wrong secret",
+			eventstore: expectEventstore(
+				expectFilter(registeredOIDCAppEvents(ctx, agg, hashedToken)...),
+			),
+			args: args{
+				clientID: "client1",
+				token:    registrationTokenValue("project1", "app1", "wrong"),
+				app:      &domain.OIDCApp{},
+			},
+			wantErr: zerrors.ThrowUnauthenticated(nil, "COMMAND-Dcr6v", "Errors.Project.App.Registration.AccessTokenInvalid"),
+		},
+		{
+			name: "auth method changed",
+			eventstore: expectEventstore(
+				expectFilter(registeredOIDCAppEvents(ctx, agg, hashedToken)...),
+			),
+			args: args{
+				clientID: "client1",
+				token:    token,
+				app: &domain.OIDCApp{
+					AuthMethodType: domain.OIDCAuthMethodTypeBasic,
+				},
+			},
+			wantErr: zerrors.ThrowInvalidArgument(nil, "COMMAND-Dcr2a", "Errors.Project.App.Registration.AuthMethodImmutable"),
+		},
+		{
+			name: "no changes",
+			eventstore: expectEventstore(
+				expectFilter(registeredOIDCAppEvents(ctx, agg, hashedToken)...),
+			),
+			args: args{
+				clientID: "client1",
+				token:    token,
+				app: &domain.OIDCApp{
+					RedirectUris:    []string{"https://test.ch"},
+					ResponseTypes:   []domain.OIDCResponseType{domain.OIDCResponseTypeCode},
+					GrantTypes:      []domain.OIDCGrantType{domain.OIDCGrantTypeAuthorizationCode},
+					ApplicationType: domain.OIDCApplicationTypeWeb,
+					AuthMethodType:  domain.OIDCAuthMethodTypeNone,
+				},
+			},
+			want: &domain.OIDCApp{
+				ObjectRoot: models.ObjectRoot{
+					AggregateID:   "project1",
+					ResourceOwner: "org1",
+				},
+				AppID:           "app1",
+				AppName:         "app",
+				ClientID:        "client1",
+				RedirectUris:    []string{"https://test.ch"},
+				ResponseTypes:   []domain.OIDCResponseType{domain.OIDCResponseTypeCode},
+				GrantTypes:      []domain.OIDCGrantType{domain.OIDCGrantTypeAuthorizationCode},
+				ApplicationType: domain.OIDCApplicationTypeWeb,
+				AuthMethodType:  domain.OIDCAuthMethodTypeNone,
+				AccessTokenType: domain.OIDCTokenTypeBearer,
+				State:           domain.AppStateActive,
+				Compliance:      &domain.Compliance{},
+			},
+		},
+		{
+			name: "changed",
+			eventstore: expectEventstore(
+				expectFilter(registeredOIDCAppEvents(ctx, agg, hashedToken)...),
+				expectPush(
+					project.NewApplicationChangedEvent(ctx, agg, "app1", "app", "new name"),
+					func() eventstore.Command {
+						event, _ := project.NewOIDCConfigChangedEvent(ctx, agg, "app1",
+							[]project.OIDCConfigChanges{project.ChangeRedirectURIs([]string{"https://test.ch/new"})},
+						)
+						return event
+					}(),
+				),
+			),
+			args: args{
+				clientID: "client1",
+				token:    token,
+				app: &domain.OIDCApp{
+					AppName:         "new name",
+					RedirectUris:    []string{"https://test.ch/new"},
+					ResponseTypes:   []domain.OIDCResponseType{domain.OIDCResponseTypeCode},
+					GrantTypes:      []domain.OIDCGrantType{domain.OIDCGrantTypeAuthorizationCode},
+					ApplicationType: domain.OIDCApplicationTypeWeb,
+					AuthMethodType:  domain.OIDCAuthMethodTypeNone,
+				},
+			},
+			want: &domain.OIDCApp{
+				ObjectRoot: models.ObjectRoot{
+					AggregateID:   "project1",
+					ResourceOwner: "org1",
+				},
+				AppID:           "app1",
+				AppName:         "new name",
+				ClientID:        "client1",
+				RedirectUris:    []string{"https://test.ch/new"},
+				ResponseTypes:   []domain.OIDCResponseType{domain.OIDCResponseTypeCode},
+				GrantTypes:      []domain.OIDCGrantType{domain.OIDCGrantTypeAuthorizationCode},
+				ApplicationType: domain.OIDCApplicationTypeWeb,
+				AuthMethodType:  domain.OIDCAuthMethodTypeNone,
+				AccessTokenType: domain.OIDCTokenTypeBearer,
+				State:           domain.AppStateActive,
+				Compliance:      &domain.Compliance{},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore:   tt.eventstore(t),
+				secretHasher: hasher,
+			}
+			got, err := c.UpdateRegisteredOIDCApplication(ctx, tt.args.clientID, tt.args.token, tt.args.app)
+			assert.ErrorIs(t, err, tt.wantErr)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestCommands_DeleteRegisteredOIDCApplication(t *testing.T) {
+	ctx := context.Background()
+	agg := &project.NewAggregate("project1", "org1").Aggregate
+	hasher := mockPasswordHasher("x")
+	hashedToken, err := hasher.Hash("secret")
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		name       string
+		eventstore func(*testing.T) *eventstore.Eventstore
+		token      string
+		wantErr    error
+	}{
+		{
+			name: "wrong secret",
+			eventstore: expectEventstore(
+				expectFilter(registeredOIDCAppEvents(ctx, agg, hashedToken)...),
+			),
+			token:   registrationTokenValue("project1", "app1", "wrong"),
+			wantErr: zerrors.ThrowUnauthenticated(nil, "COMMAND-Dcr6v", "Errors.Project.App.Registration.AccessTokenInvalid"),
+		},
+		{
+			name: "removed",
+			eventstore: expectEventstore(
+				expectFilter(registeredOIDCAppEvents(ctx, agg, hashedToken)...),
+				expectFilter(registeredOIDCAppEvents(ctx, agg, hashedToken)[:1]...),
+				expectFilter(),
+				expectPush(
+					project.NewApplicationRemovedEvent(ctx, agg, "app1", "app", ""),
+				),
+			),
+			token: registrationTokenValue("project1", "app1", "secret"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore:   tt.eventstore(t),
+				secretHasher: hasher,
+			}
+			_, err := c.DeleteRegisteredOIDCApplication(ctx, "client1", tt.token)
+			assert.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+}
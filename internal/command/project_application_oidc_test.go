@@ -178,6 +178,9 @@ func TestAddOIDCApp(t *testing.T) {
 						"",
 						domain.LoginVersionUnspecified,
 						"",
+						false,
+						false,
+						"",
 					),
 				},
 			},
@@ -246,6 +249,9 @@ func TestAddOIDCApp(t *testing.T) {
 						"",
 						domain.LoginVersionUnspecified,
 						"",
+						false,
+						false,
+						"",
 					),
 				},
 			},
@@ -314,6 +320,9 @@ func TestAddOIDCApp(t *testing.T) {
 						"",
 						domain.LoginVersionUnspecified,
 						"",
+						false,
+						false,
+						"",
 					),
 				},
 			},
@@ -382,6 +391,9 @@ func TestAddOIDCApp(t *testing.T) {
 						"",
 						domain.LoginVersionUnspecified,
 						"",
+						false,
+						false,
+						"",
 					),
 				},
 			},
@@ -531,6 +543,9 @@ func TestCommandSide_AddOIDCApplication(t *testing.T) {
 							"https://test.ch/backchannel",
 							domain.LoginVersion2,
 							"https://login.test.ch",
+							false,
+							false,
+							"",
 						),
 					),
 				),
@@ -638,6 +653,9 @@ func TestCommandSide_AddOIDCApplication(t *testing.T) {
 							"https://test.ch/backchannel",
 							domain.LoginVersion2,
 							"https://login.test.ch",
+							false,
+							false,
+							"",
 						),
 					),
 				),
@@ -872,6 +890,9 @@ func TestCommandSide_ChangeOIDCApplication(t *testing.T) {
 								"https://test.ch/backchannel",
 								domain.LoginVersion2,
 								"https://login.test.ch",
+								false,
+								false,
+								"",
 							),
 						),
 					),
@@ -946,6 +967,9 @@ func TestCommandSide_ChangeOIDCApplication(t *testing.T) {
 								"https://test.ch/backchannel",
 								domain.LoginVersion2,
 								"https://login.test.ch",
+								false,
+								false,
+								"",
 							),
 						),
 					),
@@ -1020,6 +1044,9 @@ func TestCommandSide_ChangeOIDCApplication(t *testing.T) {
 								"https://test.ch/backchannel",
 								domain.LoginVersion1,
 								"",
+								false,
+								false,
+								"",
 							),
 						),
 					),
@@ -1214,6 +1241,9 @@ func TestCommandSide_ChangeOIDCApplicationSecret(t *testing.T) {
 								"",
 								domain.LoginVersionUnspecified,
 								"",
+								false,
+								false,
+								"",
 							),
 						),
 					),
@@ -1378,6 +1408,9 @@ func TestCommands_VerifyOIDCClientSecret(t *testing.T) {
 							"",
 							domain.LoginVersionUnspecified,
 							"",
+							false,
+							false,
+							"",
 						),
 					),
 				),
@@ -1416,6 +1449,9 @@ func TestCommands_VerifyOIDCClientSecret(t *testing.T) {
 							"",
 							domain.LoginVersionUnspecified,
 							"",
+							false,
+							false,
+							"",
 						),
 					),
 				),
@@ -1453,6 +1489,9 @@ func TestCommands_VerifyOIDCClientSecret(t *testing.T) {
 							"",
 							domain.LoginVersionUnspecified,
 							"",
+							false,
+							false,
+							"",
 						),
 					),
 				),
@@ -0,0 +1,133 @@
+package command
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	domain_schema "github.com/zitadel/zitadel/internal/domain/schema"
+	"github.com/zitadel/zitadel/internal/eventstore/v1/models"
+	"github.com/zitadel/zitadel/internal/repository/project"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// ProjectAuthorizationDetailsType is a type of authorization details (RFC 9396),
+// which can be requested by the applications of the project.
+// The requested authorization details of the type are validated against the JSON schema.
+type ProjectAuthorizationDetailsType struct {
+	models.ObjectRoot
+
+	Type   string
+	Schema json.RawMessage
+}
+
+func (t *ProjectAuthorizationDetailsType) IsValid() error {
+	if t.AggregateID == "" {
+		return zerrors.ThrowInvalidArgument(nil, "COMMAND-Rar1p", "Errors.Project.ProjectIDMissing")
+	}
+	if t.Type == "" {
+		return zerrors.ThrowInvalidArgument(nil, "COMMAND-Rar2t", "Errors.Project.AuthorizationDetailsType.TypeMissing")
+	}
+	if _, err := domain_schema.NewAuthorizationDetailsSchema(bytes.NewReader(t.Schema)); err != nil {
+		return err
+	}
+	return nil
+}
+
+// AddProjectAuthorizationDetailsType registers a new type of authorization details on the project.
+func (c *Commands) AddProjectAuthorizationDetailsType(ctx context.Context, detailsType *ProjectAuthorizationDetailsType) (_ *domain.ObjectDetails, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if err = detailsType.IsValid(); err != nil {
+		return nil, err
+	}
+	writeModel, err := c.getProjectAuthorizationDetailsTypeWriteModel(ctx, detailsType.AggregateID, detailsType.Type, detailsType.ResourceOwner)
+	if err != nil {
+		return nil, err
+	}
+	if !writeModel.projectExists {
+		return nil, zerrors.ThrowPreconditionFailed(nil, "COMMAND-Rar3n", "Errors.Project.NotFound")
+	}
+	if writeModel.typeExists {
+		return nil, zerrors.ThrowAlreadyExists(nil, "COMMAND-Rar4e", "Errors.Project.AuthorizationDetailsType.AlreadyExisting")
+	}
+	err = c.pushAppendAndReduce(ctx, writeModel, project.NewAuthorizationDetailsTypeAddedEvent(
+		ctx,
+		ProjectAggregateFromWriteModel(&writeModel.WriteModel),
+		detailsType.Type,
+		detailsType.Schema,
+	))
+	if err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&writeModel.WriteModel), nil
+}
+
+// ChangeProjectAuthorizationDetailsType replaces the schema of a registered type of authorization details.
+// Already issued tokens are not affected.
+func (c *Commands) ChangeProjectAuthorizationDetailsType(ctx context.Context, detailsType *ProjectAuthorizationDetailsType) (_ *domain.ObjectDetails, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if err = detailsType.IsValid(); err != nil {
+		return nil, err
+	}
+	writeModel, err := c.getProjectAuthorizationDetailsTypeWriteModel(ctx, detailsType.AggregateID, detailsType.Type, detailsType.ResourceOwner)
+	if err != nil {
+		return nil, err
+	}
+	if !writeModel.Exists() {
+		return nil, zerrors.ThrowNotFound(nil, "COMMAND-Rar5n", "Errors.Project.AuthorizationDetailsType.NotExisting")
+	}
+	if bytes.Equal(writeModel.Schema, detailsType.Schema) {
+		return nil, zerrors.ThrowPreconditionFailed(nil, "COMMAND-Rar6c", "Errors.Project.AuthorizationDetailsType.NotChanged")
+	}
+	err = c.pushAppendAndReduce(ctx, writeModel, project.NewAuthorizationDetailsTypeChangedEvent(
+		ctx,
+		ProjectAggregateFromWriteModel(&writeModel.WriteModel),
+		detailsType.Type,
+		detailsType.Schema,
+	))
+	if err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&writeModel.WriteModel), nil
+}
+
+// RemoveProjectAuthorizationDetailsType removes a registered type of authorization details from the project.
+// Applications of the project can no longer request authorization details of the type.
+func (c *Commands) RemoveProjectAuthorizationDetailsType(ctx context.Context, projectID, detailsType, resourceOwner string) (_ *domain.ObjectDetails, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if projectID == "" || detailsType == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Rar7i", "Errors.IDMissing")
+	}
+	writeModel, err := c.getProjectAuthorizationDetailsTypeWriteModel(ctx, projectID, detailsType, resourceOwner)
+	if err != nil {
+		return nil, err
+	}
+	if !writeModel.Exists() {
+		return nil, zerrors.ThrowNotFound(nil, "COMMAND-Rar8n", "Errors.Project.AuthorizationDetailsType.NotExisting")
+	}
+	err = c.pushAppendAndReduce(ctx, writeModel, project.NewAuthorizationDetailsTypeRemovedEvent(
+		ctx,
+		ProjectAggregateFromWriteModel(&writeModel.WriteModel),
+		detailsType,
+	))
+	if err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&writeModel.WriteModel), nil
+}
+
+func (c *Commands) getProjectAuthorizationDetailsTypeWriteModel(ctx context.Context, projectID, detailsType, resourceOwner string) (_ *ProjectAuthorizationDetailsTypeWriteModel, err error) {
+	writeModel := NewProjectAuthorizationDetailsTypeWriteModel(projectID, detailsType, resourceOwner)
+	if err = c.eventstore.FilterToQueryReducer(ctx, writeModel); err != nil {
+		return nil, err
+	}
+	return writeModel, nil
+}
@@ -0,0 +1,94 @@
+package command
+
+import (
+	"encoding/json"
+
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/project"
+)
+
+type ProjectAuthorizationDetailsTypeWriteModel struct {
+	eventstore.WriteModel
+
+	Type   string
+	Schema json.RawMessage
+
+	projectExists bool
+	typeExists    bool
+}
+
+func NewProjectAuthorizationDetailsTypeWriteModel(projectID, detailsType, resourceOwner string) *ProjectAuthorizationDetailsTypeWriteModel {
+	return &ProjectAuthorizationDetailsTypeWriteModel{
+		WriteModel: eventstore.WriteModel{
+			AggregateID:   projectID,
+			ResourceOwner: resourceOwner,
+		},
+		Type: detailsType,
+	}
+}
+
+func (wm *ProjectAuthorizationDetailsTypeWriteModel) AppendEvents(events ...eventstore.Event) {
+	for _, event := range events {
+		switch e := event.(type) {
+		case *project.AuthorizationDetailsTypeAddedEvent:
+			if e.DetailsType != wm.Type {
+				continue
+			}
+			wm.WriteModel.AppendEvents(e)
+		case *project.AuthorizationDetailsTypeChangedEvent:
+			if e.DetailsType != wm.Type {
+				continue
+			}
+			wm.WriteModel.AppendEvents(e)
+		case *project.AuthorizationDetailsTypeRemovedEvent:
+			if e.DetailsType != wm.Type {
+				continue
+			}
+			wm.WriteModel.AppendEvents(e)
+		case *project.ProjectAddedEvent,
+			*project.ProjectRemovedEvent:
+			wm.WriteModel.AppendEvents(e)
+		}
+	}
+}
+
+func (wm *ProjectAuthorizationDetailsTypeWriteModel) Reduce() error {
+	for _, event := range wm.Events {
+		switch e := event.(type) {
+		case *project.ProjectAddedEvent:
+			wm.projectExists = true
+		case *project.ProjectRemovedEvent:
+			wm.projectExists = false
+		case *project.AuthorizationDetailsTypeAddedEvent:
+			wm.Schema = e.Schema
+			wm.typeExists = true
+		case *project.AuthorizationDetailsTypeChangedEvent:
+			wm.Schema = e.Schema
+		case *project.AuthorizationDetailsTypeRemovedEvent:
+			wm.Schema = nil
+			wm.typeExists = false
+		}
+	}
+	return wm.WriteModel.Reduce()
+}
+
+func (wm *ProjectAuthorizationDetailsTypeWriteModel) Query() *eventstore.SearchQueryBuilder {
+	return eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		ResourceOwner(wm.ResourceOwner).
+		AddQuery().
+		AggregateTypes(project.AggregateType).
+		AggregateIDs(wm.AggregateID).
+		EventTypes(
+			project.ProjectAddedType,
+			project.ProjectRemovedType,
+			project.AuthorizationDetailsTypeAddedType,
+			project.AuthorizationDetailsTypeChangedType,
+			project.AuthorizationDetailsTypeRemovedType,
+		).
+		Builder()
+}
+
+// Exists returns true if the type was added and neither the type nor its project were removed.
+func (wm *ProjectAuthorizationDetailsTypeWriteModel) Exists() bool {
+	return wm.projectExists && wm.typeExists
+}
@@ -0,0 +1,276 @@
+package command
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/eventstore/v1/models"
+	"github.com/zitadel/zitadel/internal/repository/project"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+var (
+	testPaymentSchema        = json.RawMessage(`{"type":"object","properties":{"amount":{"type":"number"}}}`)
+	testPaymentSchemaChanged = json.RawMessage(`{"type":"object","properties":{"amount":{"type":"number","maximum":100}}}`)
+)
+
+func TestCommands_AddProjectAuthorizationDetailsType(t *testing.T) {
+	ctx := context.Background()
+	agg := &project.NewAggregate("project1", "org1").Aggregate
+	tests := []struct {
+		name        string
+		eventstore  func(*testing.T) *eventstore.Eventstore
+		detailsType *ProjectAuthorizationDetailsType
+		wantErr     error
+	}{
+		{
+			name:        "missing project id",
+			eventstore:  expectEventstore(),
+			detailsType: &ProjectAuthorizationDetailsType{},
+			wantErr:     zerrors.ThrowInvalidArgument(nil, "COMMAND-Rar1p", "Errors.Project.ProjectIDMissing"),
+		},
+		{
+			name:       "missing type",
+			eventstore: expectEventstore(),
+			detailsType: &ProjectAuthorizationDetailsType{
+				ObjectRoot: models.ObjectRoot{AggregateID: "project1", ResourceOwner: "org1"},
+				Schema:     testPaymentSchema,
+			},
+			wantErr: zerrors.ThrowInvalidArgument(nil, "COMMAND-Rar2t", "Errors.Project.AuthorizationDetailsType.TypeMissing"),
+		},
+		{
+			name:       "invalid schema",
+			eventstore: expectEventstore(),
+			detailsType: &ProjectAuthorizationDetailsType{
+				ObjectRoot: models.ObjectRoot{AggregateID: "project1", ResourceOwner: "org1"},
+				Type:       "payment",
+				Schema:     json.RawMessage(`{"type":"unknown"}`),
+			},
+			wantErr: zerrors.ThrowInvalidArgument(nil, "SCHEMA-Rar3c", "Errors.Project.AuthorizationDetailsType.SchemaInvalid"),
+		},
+		{
+			name:       "external reference",
+			eventstore: expectEventstore(),
+			detailsType: &ProjectAuthorizationDetailsType{
+				ObjectRoot: models.ObjectRoot{AggregateID: "project1", ResourceOwner: "org1"},
+				Type:       "payment",
+				Schema:     json.RawMessage(`{"$ref":"file:///etc/passwd"}`),
+			},
+			wantErr: zerrors.ThrowInvalidArgument(nil, "SCHEMA-Rar3c", "Errors.Project.AuthorizationDetailsType.SchemaInvalid"),
+		},
+		{
+			name: "project not existing",
+			eventstore: expectEventstore(
+				expectFilter(),
+			),
+			detailsType: &ProjectAuthorizationDetailsType{
+				ObjectRoot: models.ObjectRoot{AggregateID: "project1", ResourceOwner: "org1"},
+				Type:       "payment",
+				Schema:     testPaymentSchema,
+			},
+			wantErr: zerrors.ThrowPreconditionFailed(nil, "COMMAND-Rar3n", "Errors.Project.NotFound"),
+		},
+		{
+			name: "already existing",
+			eventstore: expectEventstore(
+				expectFilter(
+					eventFromEventPusher(
+						project.NewProjectAddedEvent(ctx, agg, "project", true, true, true, domain.PrivateLabelingSettingUnspecified),
+					),
+					eventFromEventPusher(
+						project.NewAuthorizationDetailsTypeAddedEvent(ctx, agg, "payment", testPaymentSchema),
+					),
+				),
+			),
+			detailsType: &ProjectAuthorizationDetailsType{
+				ObjectRoot: models.ObjectRoot{AggregateID: "project1", ResourceOwner: "org1"},
+				Type:       "payment",
+				Schema:     testPaymentSchema,
+			},
+			wantErr: zerrors.ThrowAlreadyExists(nil, "COMMAND-Rar4e", "Errors.Project.AuthorizationDetailsType.AlreadyExisting"),
+		},
+		{
+			name: "added",
+			eventstore: expectEventstore(
+				expectFilter(
+					eventFromEventPusher(
+						project.NewProjectAddedEvent(ctx, agg, "project", true, true, true, domain.PrivateLabelingSettingUnspecified),
+					),
+					eventFromEventPusher(
+						project.NewAuthorizationDetailsTypeAddedEvent(ctx, agg, "account_information", testPaymentSchema),
+					),
+				),
+				expectPush(
+					project.NewAuthorizationDetailsTypeAddedEvent(ctx, agg, "payment", testPaymentSchema),
+				),
+			),
+			detailsType: &ProjectAuthorizationDetailsType{
+				ObjectRoot: models.ObjectRoot{AggregateID: "project1", ResourceOwner: "org1"},
+				Type:       "payment",
+				Schema:     testPaymentSchema,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore: tt.eventstore(t),
+			}
+			_, err := c.AddProjectAuthorizationDetailsType(ctx, tt.detailsType)
+			assert.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+}
+
+func TestCommands_ChangeProjectAuthorizationDetailsType(t *testing.T) {
+	ctx := context.Background()
+	agg := &project.NewAggregate("project1", "org1").Aggregate
+	tests := []struct {
+		name        string
+		eventstore  func(*testing.T) *eventstore.Eventstore
+		detailsType *ProjectAuthorizationDetailsType
+		wantErr     error
+	}{
+		{
+			name:       "invalid schema",
+			eventstore: expectEventstore(),
+			detailsType: &ProjectAuthorizationDetailsType{
+				ObjectRoot: models.ObjectRoot{AggregateID: "project1", ResourceOwner: "org1"},
+				Type:       "payment",
+				Schema:     json.RawMessage(`{`),
+			},
+			wantErr: zerrors.ThrowInvalidArgument(nil, "SCHEMA-Rar2r", "Errors.Project.AuthorizationDetailsType.SchemaInvalid"),
+		},
+		{
+			name: "not existing",
+			eventstore: expectEventstore(
+				expectFilter(
+					eventFromEventPusher(
+						project.NewProjectAddedEvent(ctx, agg, "project", true, true, true, domain.PrivateLabelingSettingUnspecified),
+					),
+				),
+			),
+			detailsType: &ProjectAuthorizationDetailsType{
+				ObjectRoot: models.ObjectRoot{AggregateID: "project1", ResourceOwner: "org1"},
+				Type:       "payment",
+				Schema:     testPaymentSchema,
+			},
+			wantErr: zerrors.ThrowNotFound(nil, "COMMAND-Rar5n", "Errors.Project.AuthorizationDetailsType.NotExisting"),
+		},
+		{
+			name: "not changed",
+			eventstore: expectEventstore(
+				expectFilter(
+					eventFromEventPusher(
+						project.NewProjectAddedEvent(ctx, agg, "project", true, true, true, domain.PrivateLabelingSettingUnspecified),
+					),
+					eventFromEventPusher(
+						project.NewAuthorizationDetailsTypeAddedEvent(ctx, agg, "payment", testPaymentSchema),
+					),
+				),
+			),
+			detailsType: &ProjectAuthorizationDetailsType{
+				ObjectRoot: models.ObjectRoot{AggregateID: "project1", ResourceOwner: "org1"},
+				Type:       "payment",
+				Schema:     testPaymentSchema,
+			},
+			wantErr: zerrors.ThrowPreconditionFailed(nil, "COMMAND-Rar6c", "Errors.Project.AuthorizationDetailsType.NotChanged"),
+		},
+		{
+			name: "changed",
+			eventstore: expectEventstore(
+				expectFilter(
+					eventFromEventPusher(
+						project.NewProjectAddedEvent(ctx, agg, "project", true, true, true, domain.PrivateLabelingSettingUnspecified),
+					),
+					eventFromEventPusher(
+						project.NewAuthorizationDetailsTypeAddedEvent(ctx, agg, "payment", testPaymentSchema),
+					),
+				),
+				expectPush(
+					project.NewAuthorizationDetailsTypeChangedEvent(ctx, agg, "payment", testPaymentSchemaChanged),
+				),
+			),
+			detailsType: &ProjectAuthorizationDetailsType{
+				ObjectRoot: models.ObjectRoot{AggregateID: "project1", ResourceOwner: "org1"},
+				Type:       "payment",
+				Schema:     testPaymentSchemaChanged,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore: tt.eventstore(t),
+			}
+			_, err := c.ChangeProjectAuthorizationDetailsType(ctx, tt.detailsType)
+			assert.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+}
+
+func TestCommands_RemoveProjectAuthorizationDetailsType(t *testing.T) {
+	ctx := context.Background()
+	agg := &project.NewAggregate("project1", "org1").Aggregate
+	tests := []struct {
+		name        string
+		eventstore  func(*testing.T) *eventstore.Eventstore
+		detailsType string
+		wantErr     error
+	}{
+		{
+			name:       "missing type",
+			eventstore: expectEventstore(),
+			wantErr:    zerrors.ThrowInvalidArgument(nil, "COMMAND-Rar7i", "Errors.IDMissing"),
+		},
+		{
+			name: "removed before",
+			eventstore: expectEventstore(
+				expectFilter(
+					eventFromEventPusher(
+						project.NewProjectAddedEvent(ctx, agg, "project", true, true, true, domain.PrivateLabelingSettingUnspecified),
+					),
+					eventFromEventPusher(
+						project.NewAuthorizationDetailsTypeAddedEvent(ctx, agg, "payment", testPaymentSchema),
+					),
+					eventFromEventPusher(
+						project.NewAuthorizationDetailsTypeRemovedEvent(ctx, agg, "payment"),
+					),
+				),
+			),
+			detailsType: "payment",
+			wantErr:     zerrors.ThrowNotFound(nil, "COMMAND-Rar8n", "Errors.Project.AuthorizationDetailsType.NotExisting"),
+		},
+		{
+			name: "removed",
+			eventstore: expectEventstore(
+				expectFilter(
+					eventFromEventPusher(
+						project.NewProjectAddedEvent(ctx, agg, "project", true, true, true, domain.PrivateLabelingSettingUnspecified),
+					),
+					eventFromEventPusher(
+						project.NewAuthorizationDetailsTypeAddedEvent(ctx, agg, "payment", testPaymentSchema),
+					),
+				),
+				expectPush(
+					project.NewAuthorizationDetailsTypeRemovedEvent(ctx, agg, "payment"),
+				),
+			),
+			detailsType: "payment",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore: tt.eventstore(t),
+			}
+			_, err := c.RemoveProjectAuthorizationDetailsType(ctx, "project1", tt.detailsType, "org1")
+			assert.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+}
@@ -0,0 +1,149 @@
+package command
+
+import (
+	"context"
+	"encoding/base64"
+	"strings"
+	"time"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore/v1/models"
+	"github.com/zitadel/zitadel/internal/repository/project"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// ProjectInitialAccessToken allows the dynamic registration (RFC 7591) of OIDC applications in a project.
+type ProjectInitialAccessToken struct {
+	models.ObjectRoot
+
+	TokenID        string
+	ExpirationDate time.Time
+
+	// Token is the plain initial access token, which is only returned on creation.
+	Token string
+}
+
+// AddProjectInitialAccessToken creates a new initial access token for the project.
+// The plain token is set on the passed [ProjectInitialAccessToken] and can't be retrieved again.
+func (c *Commands) AddProjectInitialAccessToken(ctx context.Context, token *ProjectInitialAccessToken) (_ *domain.ObjectDetails, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if token.AggregateID == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Iat1p", "Errors.Project.ProjectIDMissing")
+	}
+	token.ExpirationDate, err = domain.ValidateExpirationDate(token.ExpirationDate)
+	if err != nil {
+		return nil, err
+	}
+	token.TokenID, err = c.idGenerator.Next()
+	if err != nil {
+		return nil, err
+	}
+	writeModel, err := c.getProjectInitialAccessTokenWriteModel(ctx, token.AggregateID, token.TokenID, token.ResourceOwner)
+	if err != nil {
+		return nil, err
+	}
+	if !writeModel.projectExists {
+		return nil, zerrors.ThrowPreconditionFailed(nil, "COMMAND-Iat2n", "Errors.Project.NotFound")
+	}
+	if writeModel.tokenExists {
+		return nil, zerrors.ThrowAlreadyExists(nil, "COMMAND-Iat3e", "Errors.Project.InitialAccessToken.AlreadyExisting")
+	}
+	hashedToken, plain, err := c.newHashedSecret(ctx, c.eventstore.Filter) //nolint:staticcheck
+	if err != nil {
+		return nil, err
+	}
+	err = c.pushAppendAndReduce(ctx, writeModel, project.NewInitialAccessTokenAddedEvent(
+		ctx,
+		ProjectAggregateFromWriteModel(&writeModel.WriteModel),
+		token.TokenID,
+		hashedToken,
+		token.ExpirationDate,
+	))
+	if err != nil {
+		return nil, err
+	}
+	token.Token = registrationTokenValue(writeModel.AggregateID, writeModel.TokenID, plain)
+	return writeModelToObjectDetails(&writeModel.WriteModel), nil
+}
+
+// RemoveProjectInitialAccessToken removes the initial access token from the project.
+// Applications registered with the token are not affected.
+func (c *Commands) RemoveProjectInitialAccessToken(ctx context.Context, projectID, tokenID, resourceOwner string) (_ *domain.ObjectDetails, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if projectID == "" || tokenID == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Iat4i", "Errors.IDMissing")
+	}
+	writeModel, err := c.getProjectInitialAccessTokenWriteModel(ctx, projectID, tokenID, resourceOwner)
+	if err != nil {
+		return nil, err
+	}
+	if !writeModel.Exists() {
+		return nil, zerrors.ThrowNotFound(nil, "COMMAND-Iat5n", "Errors.Project.InitialAccessToken.NotExisting")
+	}
+	err = c.pushAppendAndReduce(ctx, writeModel, project.NewInitialAccessTokenRemovedEvent(
+		ctx,
+		ProjectAggregateFromWriteModel(&writeModel.WriteModel),
+		tokenID,
+	))
+	if err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&writeModel.WriteModel), nil
+}
+
+// checkInitialAccessToken verifies the plain initial access token
+// and returns the write model of the token, which contains the project and its resource owner.
+func (c *Commands) checkInitialAccessToken(ctx context.Context, token string) (_ *ProjectInitialAccessTokenWriteModel, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	projectID, tokenID, secret, ok := parseRegistrationToken(token)
+	if !ok {
+		return nil, zerrors.ThrowUnauthenticated(nil, "COMMAND-Iat6i", "Errors.Project.InitialAccessToken.Invalid")
+	}
+	writeModel, err := c.getProjectInitialAccessTokenWriteModel(ctx, projectID, tokenID, "")
+	if err != nil {
+		return nil, err
+	}
+	if !writeModel.Exists() || time.Now().After(writeModel.ExpirationDate) {
+		return nil, zerrors.ThrowUnauthenticated(nil, "COMMAND-Iat7x", "Errors.Project.InitialAccessToken.Invalid")
+	}
+	_, spanHashComparison := tracing.NewNamedSpan(ctx, "passwap.Verify")
+	_, err = c.secretHasher.Verify(writeModel.HashedToken, secret)
+	spanHashComparison.EndWithError(err)
+	if err != nil {
+		return nil, zerrors.ThrowUnauthenticated(err, "COMMAND-Iat8v", "Errors.Project.InitialAccessToken.Invalid")
+	}
+	return writeModel, nil
+}
+
+func (c *Commands) getProjectInitialAccessTokenWriteModel(ctx context.Context, projectID, tokenID, resourceOwner string) (_ *ProjectInitialAccessTokenWriteModel, err error) {
+	writeModel := NewProjectInitialAccessTokenWriteModel(projectID, tokenID, resourceOwner)
+	if err = c.eventstore.FilterToQueryReducer(ctx, writeModel); err != nil {
+		return nil, err
+	}
+	return writeModel, nil
+}
+
+// registrationTokenValue creates the plain value of initial and registration access tokens,
+// which contains the ids needed to look up the hashed secret.
+func registrationTokenValue(aggregateID, id, secret string) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(aggregateID + ":" + id + ":" + secret))
+}
+
+func parseRegistrationToken(token string) (aggregateID, id, secret string, ok bool) {
+	decoded, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return "", "", "", false
+	}
+	parts := strings.SplitN(string(decoded), ":", 3)
+	if len(parts) != 3 || parts[0] == "" || parts[1] == "" || parts[2] == "" {
+		return "", "", "", false
+	}
+	return parts[0], parts[1], parts[2], true
+}
@@ -0,0 +1,87 @@
+package command
+
+import (
+	"time"
+
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/project"
+)
+
+type ProjectInitialAccessTokenWriteModel struct {
+	eventstore.WriteModel
+
+	TokenID        string
+	HashedToken    string
+	ExpirationDate time.Time
+
+	projectExists bool
+	tokenExists   bool
+}
+
+func NewProjectInitialAccessTokenWriteModel(projectID, tokenID, resourceOwner string) *ProjectInitialAccessTokenWriteModel {
+	return &ProjectInitialAccessTokenWriteModel{
+		WriteModel: eventstore.WriteModel{
+			AggregateID:   projectID,
+			ResourceOwner: resourceOwner,
+		},
+		TokenID: tokenID,
+	}
+}
+
+func (wm *ProjectInitialAccessTokenWriteModel) AppendEvents(events ...eventstore.Event) {
+	for _, event := range events {
+		switch e := event.(type) {
+		case *project.InitialAccessTokenAddedEvent:
+			if e.TokenID != wm.TokenID {
+				continue
+			}
+			wm.WriteModel.AppendEvents(e)
+		case *project.InitialAccessTokenRemovedEvent:
+			if e.TokenID != wm.TokenID {
+				continue
+			}
+			wm.WriteModel.AppendEvents(e)
+		case *project.ProjectAddedEvent,
+			*project.ProjectRemovedEvent:
+			wm.WriteModel.AppendEvents(e)
+		}
+	}
+}
+
+func (wm *ProjectInitialAccessTokenWriteModel) Reduce() error {
+	for _, event := range wm.Events {
+		switch e := event.(type) {
+		case *project.ProjectAddedEvent:
+			wm.projectExists = true
+		case *project.ProjectRemovedEvent:
+			wm.projectExists = false
+		case *project.InitialAccessTokenAddedEvent:
+			wm.HashedToken = e.HashedToken
+			wm.ExpirationDate = e.ExpirationDate
+			wm.tokenExists = true
+		case *project.InitialAccessTokenRemovedEvent:
+			wm.tokenExists = false
+		}
+	}
+	return wm.WriteModel.Reduce()
+}
+
+func (wm *ProjectInitialAccessTokenWriteModel) Query() *eventstore.SearchQueryBuilder {
+	return eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		ResourceOwner(wm.ResourceOwner).
+		AddQuery().
+		AggregateTypes(project.AggregateType).
+		AggregateIDs(wm.AggregateID).
+		EventTypes(
+			project.ProjectAddedType,
+			project.ProjectRemovedType,
+			project.InitialAccessTokenAddedType,
+			project.InitialAccessTokenRemovedType,
+		).
+		Builder()
+}
+
+// Exists returns true if the token was added and neither the token nor its project were removed.
+func (wm *ProjectInitialAccessTokenWriteModel) Exists() bool {
+	return wm.projectExists && wm.tokenExists
+}
@@ -0,0 +1,191 @@
+package command
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/eventstore/v1/models"
+	"github.com/zitadel/zitadel/internal/id"
+	id_mock "github.com/zitadel/zitadel/internal/id/mock"
+	"github.com/zitadel/zitadel/internal/repository/project"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+func TestCommands_AddProjectInitialAccessToken(t *testing.T) {
+	ctx := context.Background()
+	agg := &project.NewAggregate("project1", "org1").Aggregate
+	expiration := time.Now().Add(time.Hour)
+	type fields struct {
+		eventstore  func(*testing.T) *eventstore.Eventstore
+		idGenerator id.Generator
+	}
+	tests := []struct {
+		name      string
+		fields    fields
+		token     *ProjectInitialAccessToken
+		wantToken string
+		wantErr   error
+	}{
+		{
+			name: "missing project id",
+			fields: fields{
+				eventstore: expectEventstore(),
+			},
+			token:   &ProjectInitialAccessToken{},
+			wantErr: zerrors.ThrowInvalidArgument(nil, "COMMAND-Iat1p", "Errors.Project.ProjectIDMissing"),
+		},
+		{
+			name: "expiration in the past",
+			fields: fields{
+				eventstore: expectEventstore(),
+			},
+			token: &ProjectInitialAccessToken{
+				ObjectRoot:     models.ObjectRoot{AggregateID: "project1", ResourceOwner: "org1"},
+				ExpirationDate: time.Now().Add(-time.Hour),
+			},
+			wantErr: zerrors.ThrowInvalidArgument(nil, "DOMAIN-dv3t5", "Errors.AuthNKey.ExpireBeforeNow"),
+		},
+		{
+			name: "project not existing",
+			fields: fields{
+				eventstore:  expectEventstore(expectFilter()),
+				idGenerator: id_mock.NewIDGeneratorExpectIDs(t, "token1"),
+			},
+			token: &ProjectInitialAccessToken{
+				ObjectRoot:     models.ObjectRoot{AggregateID: "project1", ResourceOwner: "org1"},
+				ExpirationDate: expiration,
+			},
+			wantErr: zerrors.ThrowPreconditionFailed(nil, "COMMAND-Iat2n", "Errors.Project.NotFound"),
+		},
+		{
+			name: "added",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							project.NewProjectAddedEvent(ctx, agg, "project", true, true, true, domain.PrivateLabelingSettingUnspecified),
+						),
+					),
+					expectPush(
+						project.NewInitialAccessTokenAddedEvent(ctx, agg, "token1", "secret", expiration),
+					),
+				),
+				idGenerator: id_mock.NewIDGeneratorExpectIDs(t, "token1"),
+			},
+			token: &ProjectInitialAccessToken{
+				ObjectRoot:     models.ObjectRoot{AggregateID: "project1", ResourceOwner: "org1"},
+				ExpirationDate: expiration,
+			},
+			wantToken: registrationTokenValue("project1", "token1", "secret"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore:      tt.fields.eventstore(t),
+				idGenerator:     tt.fields.idGenerator,
+				newHashedSecret: mockHashedSecret("secret"),
+			}
+			_, err := c.AddProjectInitialAccessToken(ctx, tt.token)
+			assert.ErrorIs(t, err, tt.wantErr)
+			assert.Equal(t, tt.wantToken, tt.token.Token)
+		})
+	}
+}
+
+func TestCommands_RemoveProjectInitialAccessToken(t *testing.T) {
+	ctx := context.Background()
+	agg := &project.NewAggregate("project1", "org1").Aggregate
+	tests := []struct {
+		name       string
+		eventstore func(*testing.T) *eventstore.Eventstore
+		tokenID    string
+		wantErr    error
+	}{
+		{
+			name:       "missing id",
+			eventstore: expectEventstore(),
+			wantErr:    zerrors.ThrowInvalidArgument(nil, "COMMAND-Iat4i", "Errors.IDMissing"),
+		},
+		{
+			name: "not existing",
+			eventstore: expectEventstore(
+				expectFilter(
+					eventFromEventPusher(
+						project.NewProjectAddedEvent(ctx, agg, "project", true, true, true, domain.PrivateLabelingSettingUnspecified),
+					),
+				),
+			),
+			tokenID: "token1",
+			wantErr: zerrors.ThrowNotFound(nil, "COMMAND-Iat5n", "Errors.Project.InitialAccessToken.NotExisting"),
+		},
+		{
+			name: "removed",
+			eventstore: expectEventstore(
+				expectFilter(
+					eventFromEventPusher(
+						project.NewProjectAddedEvent(ctx, agg, "project", true, true, true, domain.PrivateLabelingSettingUnspecified),
+					),
+					eventFromEventPusher(
+						project.NewInitialAccessTokenAddedEvent(ctx, agg, "token1", "secret", time.Now().Add(time.Hour)),
+					),
+				),
+				expectPush(
+					project.NewInitialAccessTokenRemovedEvent(ctx, agg, "token1"),
+				),
+			),
+			tokenID: "token1",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore: tt.eventstore(t),
+			}
+			_, err := c.RemoveProjectInitialAccessToken(ctx, "project1", tt.tokenID, "org1")
+			assert.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+}
+
+func Test_parseRegistrationToken(t *testing.T) {
+	tests := []struct {
+		name            string
+		token           string
+		wantAggregateID string
+		wantID          string
+		wantSecret      string
+		wantOK          bool
+	}{
+		{
+			name:  "not base64",
+			token: "%%%",
+		},
+		{
+			name:  "missing parts",
+			token: registrationTokenValue("project1", "", "secret"),
+		},
+		{
+			name:            "valid",
+			token:           registrationTokenValue("project1", "token1", "sec:ret"),
+			wantAggregateID: "project1",
+			wantID:          "token1",
+			wantSecret:      "sec:ret",
+			wantOK:          true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			aggregateID, id, secret, ok := parseRegistrationToken(tt.token)
+			assert.Equal(t, tt.wantAggregateID, aggregateID)
+			assert.Equal(t, tt.wantID, id)
+			assert.Equal(t, tt.wantSecret, secret)
+			assert.Equal(t, tt.wantOK, ok)
+		})
+	}
+}
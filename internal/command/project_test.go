@@ -5,6 +5,7 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"golang.org/x/text/language"
 
 	"github.com/zitadel/zitadel/internal/api/authz"
 	"github.com/zitadel/zitadel/internal/domain"
@@ -13,6 +14,8 @@ import (
 	"github.com/zitadel/zitadel/internal/id"
 	id_mock "github.com/zitadel/zitadel/internal/id/mock"
 	"github.com/zitadel/zitadel/internal/repository/project"
+	"github.com/zitadel/zitadel/internal/repository/user"
+	"github.com/zitadel/zitadel/internal/repository/usergrant"
 	"github.com/zitadel/zitadel/internal/zerrors"
 )
 
@@ -201,6 +204,150 @@ func TestCommandSide_AddProject(t *testing.T) {
 	}
 }
 
+func TestCommandSide_AddProjectWithUserGrants(t *testing.T) {
+	type fields struct {
+		eventstore  func(t *testing.T) *eventstore.Eventstore
+		idGenerator id.Generator
+	}
+	type args struct {
+		ctx           context.Context
+		project       *domain.Project
+		resourceOwner string
+		userIDs       []string
+	}
+	type res struct {
+		want       *domain.Project
+		wantGrants []*domain.UserGrant
+		err        func(error) bool
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			name: "invalid project, error",
+			fields: fields{
+				eventstore: expectEventstore(),
+			},
+			args: args{
+				ctx:           authz.WithInstanceID(context.Background(), "instanceID"),
+				project:       &domain.Project{},
+				resourceOwner: "org1",
+			},
+			res: res{
+				err: zerrors.IsErrorInvalidArgument,
+			},
+		},
+		{
+			name: "user not existing, precondition error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(),
+					expectFilter(),
+				),
+				idGenerator: id_mock.NewIDGeneratorExpectIDs(t, "project1"),
+			},
+			args: args{
+				ctx:           authz.WithInstanceID(context.Background(), "instanceID"),
+				project:       &domain.Project{Name: "project"},
+				resourceOwner: "org1",
+				userIDs:       []string{"user1"},
+			},
+			res: res{
+				err: zerrors.IsPreconditionFailed,
+			},
+		},
+		{
+			name: "project with user grants, ok",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(),
+					expectFilter(
+						eventFromEventPusher(
+							user.NewHumanAddedEvent(context.Background(),
+								&user.NewAggregate("user1", "org1").Aggregate,
+								"username1",
+								"firstname1",
+								"lastname1",
+								"nickname1",
+								"displayname1",
+								language.German,
+								domain.GenderMale,
+								"email1",
+								true,
+							),
+						),
+					),
+					expectPush(
+						project.NewProjectAddedEvent(
+							context.Background(),
+							&project.NewAggregate("project1", "org1").Aggregate,
+							"project", false, false, false,
+							domain.PrivateLabelingSettingUnspecified,
+						),
+						usergrant.NewUserGrantAddedEvent(context.Background(),
+							&usergrant.NewAggregate("usergrant1", "org1").Aggregate,
+							"user1",
+							"project1",
+							"",
+							nil,
+						),
+					),
+				),
+				idGenerator: id_mock.NewIDGeneratorExpectIDs(t, "project1", "usergrant1"),
+			},
+			args: args{
+				ctx:           authz.WithInstanceID(context.Background(), "instanceID"),
+				project:       &domain.Project{Name: "project"},
+				resourceOwner: "org1",
+				userIDs:       []string{"user1"},
+			},
+			res: res{
+				want: &domain.Project{
+					ObjectRoot: models.ObjectRoot{
+						ResourceOwner: "org1",
+						AggregateID:   "project1",
+					},
+					Name: "project",
+				},
+				wantGrants: []*domain.UserGrant{
+					{
+						ObjectRoot: models.ObjectRoot{
+							AggregateID:   "usergrant1",
+							ResourceOwner: "org1",
+						},
+						UserID:    "user1",
+						ProjectID: "project1",
+						State:     domain.UserGrantStateActive,
+					},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore:  tt.fields.eventstore(t),
+				idGenerator: tt.fields.idGenerator,
+			}
+			c.setMilestonesCompletedForTest("instanceID")
+			got, gotGrants, err := c.AddProjectWithUserGrants(tt.args.ctx, tt.args.project, tt.args.resourceOwner, tt.args.userIDs...)
+			if tt.res.err == nil {
+				assert.NoError(t, err)
+			}
+			if tt.res.err != nil && !tt.res.err(err) {
+				t.Errorf("got wrong err: %v ", err)
+			}
+			if tt.res.err == nil {
+				assert.Equal(t, tt.res.want, got)
+				assert.Equal(t, tt.res.wantGrants, gotGrants)
+			}
+		})
+	}
+}
+
 func TestCommandSide_ChangeProject(t *testing.T) {
 	type fields struct {
 		eventstore *eventstore.Eventstore
@@ -0,0 +1,142 @@
+package command
+
+import (
+	"context"
+	"net/url"
+
+	"github.com/zitadel/zitadel/internal/crypto"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/provisioning"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// SetProvisioningTarget configures the SCIM server users of the project of an application are provisioned to.
+type SetProvisioningTarget struct {
+	ProjectID string
+	AppID     string
+	// Endpoint is the base url of the SCIM v2 server (e.g. https://example.com/scim/v2)
+	Endpoint string
+	// Token is used as bearer token to authenticate at the SCIM server.
+	// If the target already exists and the token is empty, the existing token is kept.
+	Token string
+	// AllowInsecure allows an http endpoint, the token and the users are then sent unencrypted.
+	AllowInsecure bool
+}
+
+func (s *SetProvisioningTarget) IsValid() error {
+	if s.ProjectID == "" || s.AppID == "" {
+		return zerrors.ThrowInvalidArgument(nil, "COMMAND-Prv1x", "Errors.IDMissing")
+	}
+	endpoint, err := url.Parse(s.Endpoint)
+	if err != nil || endpoint.Host == "" || (endpoint.Scheme != "http" && endpoint.Scheme != "https") {
+		return zerrors.ThrowInvalidArgument(err, "COMMAND-Prv2x", "Errors.ProvisioningTarget.InvalidEndpoint")
+	}
+	if endpoint.Scheme == "http" && !s.AllowInsecure {
+		return zerrors.ThrowInvalidArgument(nil, "COMMAND-Prv8x", "Errors.ProvisioningTarget.InsecureEndpoint")
+	}
+	return nil
+}
+
+func (c *Commands) SetProvisioningTarget(ctx context.Context, set *SetProvisioningTarget, resourceOwner string) (*domain.ObjectDetails, error) {
+	if resourceOwner == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Prv3x", "Errors.IDMissing")
+	}
+	if err := set.IsValid(); err != nil {
+		return nil, err
+	}
+
+	app, err := c.getApplicationWriteModel(ctx, set.ProjectID, set.AppID, resourceOwner)
+	if err != nil {
+		return nil, err
+	}
+	if app.State == domain.AppStateUnspecified || app.State == domain.AppStateRemoved {
+		return nil, zerrors.ThrowNotFound(nil, "COMMAND-Prv4x", "Errors.Project.App.NotExisting")
+	}
+
+	existing, err := c.getProvisioningTargetWriteModel(ctx, set.AppID, resourceOwner)
+	if err != nil {
+		return nil, err
+	}
+
+	var token *crypto.CryptoValue
+	if set.Token != "" {
+		token, err = crypto.Encrypt([]byte(set.Token), c.targetEncryption)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	agg := ProvisioningTargetAggregateFromWriteModel(ctx, &existing.WriteModel)
+	if !existing.State.Exists() {
+		if token == nil {
+			return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Prv5x", "Errors.ProvisioningTarget.TokenMissing")
+		}
+		if err = c.pushAppendAndReduce(ctx, existing, provisioning.NewAddedEvent(ctx, agg, set.ProjectID, set.Endpoint, token)); err != nil {
+			return nil, err
+		}
+		return writeModelToObjectDetails(&existing.WriteModel), nil
+	}
+
+	changedEvent := existing.NewChangedEvent(ctx, agg, set.Endpoint, token)
+	if changedEvent == nil {
+		return writeModelToObjectDetails(&existing.WriteModel), nil
+	}
+	if err = c.pushAppendAndReduce(ctx, existing, changedEvent); err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&existing.WriteModel), nil
+}
+
+func (c *Commands) RemoveProvisioningTarget(ctx context.Context, projectID, appID, resourceOwner string) (*domain.ObjectDetails, error) {
+	if projectID == "" || appID == "" || resourceOwner == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Prv6x", "Errors.IDMissing")
+	}
+
+	existing, err := c.getProvisioningTargetWriteModel(ctx, appID, resourceOwner)
+	if err != nil {
+		return nil, err
+	}
+	if !existing.State.Exists() || existing.ProjectID != projectID {
+		return nil, zerrors.ThrowNotFound(nil, "COMMAND-Prv7x", "Errors.ProvisioningTarget.NotFound")
+	}
+
+	if err = c.pushAppendAndReduce(ctx, existing, provisioning.NewRemovedEvent(ctx, ProvisioningTargetAggregateFromWriteModel(ctx, &existing.WriteModel))); err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&existing.WriteModel), nil
+}
+
+// ProvisioningUserCreationStarted reserves the creation of the user on the provisioning target.
+// An already exists error is returned if another synchronization is creating the user.
+func (c *Commands) ProvisioningUserCreationStarted(ctx context.Context, target *eventstore.Aggregate, userID string) error {
+	_, err := c.eventstore.Push(ctx, provisioning.NewUserCreationStartedEvent(ctx, target, userID))
+	return err
+}
+
+// ProvisioningUserCreationCanceled releases the creation of the user, if it could not be created on the provisioning target.
+func (c *Commands) ProvisioningUserCreationCanceled(ctx context.Context, target *eventstore.Aggregate, userID string) error {
+	_, err := c.eventstore.Push(ctx, provisioning.NewUserCreationCanceledEvent(ctx, target, userID))
+	return err
+}
+
+// ProvisioningUserSynced records the successful synchronization of a user to the provisioning target.
+// The remoteID is the id of the user on the target and empty if the user was deprovisioned.
+func (c *Commands) ProvisioningUserSynced(ctx context.Context, target *eventstore.Aggregate, userID, remoteID string, triggerEventType eventstore.EventType) error {
+	_, err := c.eventstore.Push(ctx, provisioning.NewUserSyncSucceededEvent(ctx, target, userID, remoteID, triggerEventType))
+	return err
+}
+
+// ProvisioningUserSyncFailed records that a user could not be synchronized to the provisioning target.
+func (c *Commands) ProvisioningUserSyncFailed(ctx context.Context, target *eventstore.Aggregate, userID string, triggerEventType eventstore.EventType, syncErr error) error {
+	_, err := c.eventstore.Push(ctx, provisioning.NewUserSyncFailedEvent(ctx, target, userID, triggerEventType, syncErr))
+	return err
+}
+
+func (c *Commands) getProvisioningTargetWriteModel(ctx context.Context, appID, resourceOwner string) (*ProvisioningTargetWriteModel, error) {
+	wm := NewProvisioningTargetWriteModel(appID, resourceOwner)
+	if err := c.eventstore.FilterToQueryReducer(ctx, wm); err != nil {
+		return nil, err
+	}
+	return wm, nil
+}
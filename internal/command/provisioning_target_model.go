@@ -0,0 +1,87 @@
+package command
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/crypto"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/provisioning"
+)
+
+type ProvisioningTargetWriteModel struct {
+	eventstore.WriteModel
+
+	ProjectID string
+	Endpoint  string
+	Token     *crypto.CryptoValue
+
+	State domain.ProvisioningTargetState
+}
+
+func NewProvisioningTargetWriteModel(appID, resourceOwner string) *ProvisioningTargetWriteModel {
+	return &ProvisioningTargetWriteModel{
+		WriteModel: eventstore.WriteModel{
+			AggregateID:   appID,
+			ResourceOwner: resourceOwner,
+		},
+	}
+}
+
+func (wm *ProvisioningTargetWriteModel) Reduce() error {
+	for _, event := range wm.Events {
+		switch e := event.(type) {
+		case *provisioning.AddedEvent:
+			wm.ProjectID = e.ProjectID
+			wm.Endpoint = e.Endpoint
+			wm.Token = e.Token
+			wm.State = domain.ProvisioningTargetActive
+		case *provisioning.ChangedEvent:
+			if e.Endpoint != nil {
+				wm.Endpoint = *e.Endpoint
+			}
+			if e.Token != nil {
+				wm.Token = e.Token
+			}
+		case *provisioning.RemovedEvent:
+			wm.State = domain.ProvisioningTargetRemoved
+		}
+	}
+	return wm.WriteModel.Reduce()
+}
+
+func (wm *ProvisioningTargetWriteModel) Query() *eventstore.SearchQueryBuilder {
+	return eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		ResourceOwner(wm.ResourceOwner).
+		AddQuery().
+		AggregateTypes(provisioning.AggregateType).
+		AggregateIDs(wm.AggregateID).
+		EventTypes(provisioning.AddedEventType,
+			provisioning.ChangedEventType,
+			provisioning.RemovedEventType).
+		Builder()
+}
+
+func (wm *ProvisioningTargetWriteModel) NewChangedEvent(
+	ctx context.Context,
+	agg *eventstore.Aggregate,
+	endpoint string,
+	token *crypto.CryptoValue,
+) *provisioning.ChangedEvent {
+	changes := make([]provisioning.Changes, 0, 2)
+	if wm.Endpoint != endpoint {
+		changes = append(changes, provisioning.ChangeEndpoint(endpoint))
+	}
+	// the token is encrypted and therefore always changed if provided
+	if token != nil {
+		changes = append(changes, provisioning.ChangeToken(token))
+	}
+	if len(changes) == 0 {
+		return nil
+	}
+	return provisioning.NewChangedEvent(ctx, agg, changes)
+}
+
+func ProvisioningTargetAggregateFromWriteModel(ctx context.Context, wm *eventstore.WriteModel) *eventstore.Aggregate {
+	return eventstore.AggregateFromWriteModelCtx(ctx, wm, provisioning.AggregateType, provisioning.AggregateVersion)
+}
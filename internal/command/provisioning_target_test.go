@@ -0,0 +1,382 @@
+package command
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/zitadel/zitadel/internal/crypto"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/project"
+	"github.com/zitadel/zitadel/internal/repository/provisioning"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+func TestCommands_SetProvisioningTarget(t *testing.T) {
+	token := &crypto.CryptoValue{
+		CryptoType: crypto.TypeEncryption,
+		Algorithm:  "enc",
+		KeyID:      "id",
+		Crypted:    []byte("token"),
+	}
+	type fields struct {
+		eventstore func(t *testing.T) *eventstore.Eventstore
+	}
+	type args struct {
+		set           *SetProvisioningTarget
+		resourceOwner string
+	}
+	type res struct {
+		want *domain.ObjectDetails
+		err  func(error) bool
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			name: "missing resource owner, invalid argument error",
+			fields: fields{
+				eventstore: expectEventstore(),
+			},
+			args: args{
+				set: &SetProvisioningTarget{ProjectID: "project1", AppID: "app1", Endpoint: "https://example.com/scim/v2", Token: "token"},
+			},
+			res: res{
+				err: zerrors.IsErrorInvalidArgument,
+			},
+		},
+		{
+			name: "invalid endpoint, invalid argument error",
+			fields: fields{
+				eventstore: expectEventstore(),
+			},
+			args: args{
+				set:           &SetProvisioningTarget{ProjectID: "project1", AppID: "app1", Endpoint: "example.com", Token: "token"},
+				resourceOwner: "org1",
+			},
+			res: res{
+				err: zerrors.IsErrorInvalidArgument,
+			},
+		},
+		{
+			name: "http endpoint, invalid argument error",
+			fields: fields{
+				eventstore: expectEventstore(),
+			},
+			args: args{
+				set:           &SetProvisioningTarget{ProjectID: "project1", AppID: "app1", Endpoint: "http://example.com/scim/v2", Token: "token"},
+				resourceOwner: "org1",
+			},
+			res: res{
+				err: zerrors.IsErrorInvalidArgument,
+			},
+		},
+		{
+			name: "app not existing, not found error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(),
+				),
+			},
+			args: args{
+				set:           &SetProvisioningTarget{ProjectID: "project1", AppID: "app1", Endpoint: "https://example.com/scim/v2", Token: "token"},
+				resourceOwner: "org1",
+			},
+			res: res{
+				err: zerrors.IsNotFound,
+			},
+		},
+		{
+			name: "add without token, invalid argument error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(project.NewApplicationAddedEvent(context.Background(),
+							&project.NewAggregate("project1", "org1").Aggregate,
+							"app1",
+							"app",
+						)),
+					),
+					expectFilter(),
+				),
+			},
+			args: args{
+				set:           &SetProvisioningTarget{ProjectID: "project1", AppID: "app1", Endpoint: "https://example.com/scim/v2"},
+				resourceOwner: "org1",
+			},
+			res: res{
+				err: zerrors.IsErrorInvalidArgument,
+			},
+		},
+		{
+			name: "add, ok",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(project.NewApplicationAddedEvent(context.Background(),
+							&project.NewAggregate("project1", "org1").Aggregate,
+							"app1",
+							"app",
+						)),
+					),
+					expectFilter(),
+					expectPush(
+						provisioning.NewAddedEvent(context.Background(),
+							provisioning.NewAggregate("app1", "org1", ""),
+							"project1",
+							"https://example.com/scim/v2",
+							token,
+						),
+					),
+				),
+			},
+			args: args{
+				set:           &SetProvisioningTarget{ProjectID: "project1", AppID: "app1", Endpoint: "https://example.com/scim/v2", Token: "token"},
+				resourceOwner: "org1",
+			},
+			res: res{
+				want: &domain.ObjectDetails{
+					ResourceOwner: "org1",
+				},
+			},
+		},
+		{
+			name: "add http endpoint, insecure allowed, ok",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(project.NewApplicationAddedEvent(context.Background(),
+							&project.NewAggregate("project1", "org1").Aggregate,
+							"app1",
+							"app",
+						)),
+					),
+					expectFilter(),
+					expectPush(
+						provisioning.NewAddedEvent(context.Background(),
+							provisioning.NewAggregate("app1", "org1", ""),
+							"project1",
+							"http://localhost:8080/scim/v2",
+							token,
+						),
+					),
+				),
+			},
+			args: args{
+				set:           &SetProvisioningTarget{ProjectID: "project1", AppID: "app1", Endpoint: "http://localhost:8080/scim/v2", Token: "token", AllowInsecure: true},
+				resourceOwner: "org1",
+			},
+			res: res{
+				want: &domain.ObjectDetails{
+					ResourceOwner: "org1",
+				},
+			},
+		},
+		{
+			name: "change endpoint, ok",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(project.NewApplicationAddedEvent(context.Background(),
+							&project.NewAggregate("project1", "org1").Aggregate,
+							"app1",
+							"app",
+						)),
+					),
+					expectFilter(
+						eventFromEventPusher(provisioning.NewAddedEvent(context.Background(),
+							provisioning.NewAggregate("app1", "org1", ""),
+							"project1",
+							"https://example.com/scim/v2",
+							token,
+						)),
+					),
+					expectPush(
+						provisioning.NewChangedEvent(context.Background(),
+							provisioning.NewAggregate("app1", "org1", ""),
+							[]provisioning.Changes{
+								provisioning.ChangeEndpoint("https://example.org/scim/v2"),
+							},
+						),
+					),
+				),
+			},
+			args: args{
+				set:           &SetProvisioningTarget{ProjectID: "project1", AppID: "app1", Endpoint: "https://example.org/scim/v2"},
+				resourceOwner: "org1",
+			},
+			res: res{
+				want: &domain.ObjectDetails{
+					ResourceOwner: "org1",
+				},
+			},
+		},
+		{
+			name: "no changes, ok",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(project.NewApplicationAddedEvent(context.Background(),
+							&project.NewAggregate("project1", "org1").Aggregate,
+							"app1",
+							"app",
+						)),
+					),
+					expectFilter(
+						eventFromEventPusher(provisioning.NewAddedEvent(context.Background(),
+							provisioning.NewAggregate("app1", "org1", ""),
+							"project1",
+							"https://example.com/scim/v2",
+							token,
+						)),
+					),
+				),
+			},
+			args: args{
+				set:           &SetProvisioningTarget{ProjectID: "project1", AppID: "app1", Endpoint: "https://example.com/scim/v2"},
+				resourceOwner: "org1",
+			},
+			res: res{
+				want: &domain.ObjectDetails{
+					ResourceOwner: "org1",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore:       tt.fields.eventstore(t),
+				targetEncryption: crypto.CreateMockEncryptionAlg(gomock.NewController(t)),
+			}
+			got, err := c.SetProvisioningTarget(context.Background(), tt.args.set, tt.args.resourceOwner)
+			if tt.res.err == nil {
+				assert.NoError(t, err)
+			}
+			if tt.res.err != nil && !tt.res.err(err) {
+				t.Errorf("got wrong err: %v ", err)
+			}
+			if tt.res.err == nil {
+				assertObjectDetails(t, tt.res.want, got)
+			}
+		})
+	}
+}
+
+func TestCommands_RemoveProvisioningTarget(t *testing.T) {
+	type fields struct {
+		eventstore func(t *testing.T) *eventstore.Eventstore
+	}
+	type args struct {
+		projectID     string
+		appID         string
+		resourceOwner string
+	}
+	type res struct {
+		err func(error) bool
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			name: "missing id, invalid argument error",
+			fields: fields{
+				eventstore: expectEventstore(),
+			},
+			args: args{
+				projectID:     "project1",
+				resourceOwner: "org1",
+			},
+			res: res{
+				err: zerrors.IsErrorInvalidArgument,
+			},
+		},
+		{
+			name: "not existing, not found error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(),
+				),
+			},
+			args: args{
+				projectID:     "project1",
+				appID:         "app1",
+				resourceOwner: "org1",
+			},
+			res: res{
+				err: zerrors.IsNotFound,
+			},
+		},
+		{
+			name: "other project, not found error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(provisioning.NewAddedEvent(context.Background(),
+							provisioning.NewAggregate("app1", "org1", ""),
+							"project2",
+							"https://example.com/scim/v2",
+							nil,
+						)),
+					),
+				),
+			},
+			args: args{
+				projectID:     "project1",
+				appID:         "app1",
+				resourceOwner: "org1",
+			},
+			res: res{
+				err: zerrors.IsNotFound,
+			},
+		},
+		{
+			name: "remove, ok",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(provisioning.NewAddedEvent(context.Background(),
+							provisioning.NewAggregate("app1", "org1", ""),
+							"project1",
+							"https://example.com/scim/v2",
+							nil,
+						)),
+					),
+					expectPush(
+						provisioning.NewRemovedEvent(context.Background(),
+							provisioning.NewAggregate("app1", "org1", ""),
+						),
+					),
+				),
+			},
+			args: args{
+				projectID:     "project1",
+				appID:         "app1",
+				resourceOwner: "org1",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore: tt.fields.eventstore(t),
+			}
+			_, err := c.RemoveProvisioningTarget(context.Background(), tt.args.projectID, tt.args.appID, tt.args.resourceOwner)
+			if tt.res.err == nil {
+				assert.NoError(t, err)
+			}
+			if tt.res.err != nil && !tt.res.err(err) {
+				t.Errorf("got wrong err: %v ", err)
+			}
+		})
+	}
+}
@@ -92,6 +92,9 @@ func (c *Commands) LinkSessionToSAMLRequest(ctx context.Context, id, sessionID,
 	if err := c.sessionTokenVerifier(ctx, sessionToken, sessionWriteModel.AggregateID, sessionWriteModel.TokenID); err != nil {
 		return nil, nil, err
 	}
+	if err = sessionWriteModel.CheckStepUp(); err != nil {
+		return nil, nil, err
+	}
 
 	if projectPermissionCheck != nil {
 		if err := projectPermissionCheck(ctx, writeModel.Issuer, sessionWriteModel.UserID); err != nil {
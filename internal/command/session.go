@@ -5,6 +5,7 @@ import (
 	"context"
 	"encoding/base64"
 	"fmt"
+	"slices"
 	"time"
 
 	"github.com/zitadel/logging"
@@ -12,6 +13,7 @@ import (
 
 	"github.com/zitadel/zitadel/internal/activity"
 	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/breached"
 	"github.com/zitadel/zitadel/internal/crypto"
 	"github.com/zitadel/zitadel/internal/domain"
 	"github.com/zitadel/zitadel/internal/eventstore"
@@ -20,6 +22,8 @@ import (
 	"github.com/zitadel/zitadel/internal/repository/idpintent"
 	"github.com/zitadel/zitadel/internal/repository/session"
 	"github.com/zitadel/zitadel/internal/repository/user"
+	"github.com/zitadel/zitadel/internal/risk"
+	"github.com/zitadel/zitadel/internal/throttle"
 	"github.com/zitadel/zitadel/internal/zerrors"
 )
 
@@ -34,6 +38,11 @@ type SessionCommands struct {
 	eventCommands     []eventstore.Command
 
 	hasher               *crypto.Hasher
+	secretHasher         *crypto.Hasher
+	breachedPasswords    breached.Checker
+	loginThrottler       *throttle.Throttler
+	riskEvaluator        *risk.Evaluator
+	riskOrigin           *risk.Observation
 	intentAlg            crypto.EncryptionAlgorithm
 	totpAlg              crypto.EncryptionAlgorithm
 	otpAlg               crypto.EncryptionAlgorithm
@@ -41,6 +50,7 @@ type SessionCommands struct {
 	createPhoneCode      encryptedCodeGeneratorWithDefaultFunc
 	createToken          func(sessionID string) (id string, token string, err error)
 	getCodeVerifier      func(ctx context.Context, id string) (senders.CodeGenerator, error)
+	getLoginPolicy       func(ctx context.Context, orgID string) (*domain.LoginPolicy, error)
 	now                  func() time.Time
 	maxIdPIntentLifetime time.Duration
 }
@@ -51,6 +61,10 @@ func (c *Commands) NewSessionCommands(cmds []SessionCommand, session *SessionWri
 		sessionWriteModel:    session,
 		eventstore:           c.eventstore,
 		hasher:               c.userPasswordHasher,
+		secretHasher:         c.secretHasher,
+		breachedPasswords:    c.breachedPasswords,
+		loginThrottler:       c.loginThrottler,
+		riskEvaluator:        c.riskEvaluator,
 		intentAlg:            c.idpConfigEncryption,
 		totpAlg:              c.multifactors.OTP.CryptoMFA,
 		otpAlg:               c.userEncryption,
@@ -58,6 +72,7 @@ func (c *Commands) NewSessionCommands(cmds []SessionCommand, session *SessionWri
 		createPhoneCode:      c.newPhoneCode,
 		createToken:          c.sessionTokenCreator,
 		getCodeVerifier:      c.phoneCodeVerifierFromConfig,
+		getLoginPolicy:       c.getOrgLoginPolicy,
 		now:                  time.Now,
 		maxIdPIntentLifetime: c.maxIdPIntentLifetime,
 	}
@@ -76,7 +91,7 @@ func CheckUser(id string, resourceOwner string, preferredLanguage *language.Tag)
 // CheckPassword defines a password check to be executed for a session update
 func CheckPassword(password string) SessionCommand {
 	return func(ctx context.Context, cmd *SessionCommands) ([]eventstore.Command, error) {
-		commands, err := checkPassword(ctx, cmd.sessionWriteModel.UserID, password, cmd.eventstore, cmd.hasher, nil)
+		commands, err := checkPassword(ctx, cmd.sessionWriteModel.UserID, password, cmd.eventstore, cmd.hasher, cmd.breachedPasswords, cmd.loginThrottler, nil)
 		if err != nil {
 			return commands, err
 		}
@@ -134,6 +149,7 @@ func CheckTOTP(code string) SessionCommand {
 			code,
 			cmd.eventstore.FilterToQueryReducer,
 			cmd.totpAlg,
+			cmd.loginThrottler,
 			nil,
 		)
 		if err != nil {
@@ -158,6 +174,8 @@ func (s *SessionCommands) Exec(ctx context.Context) ([]eventstore.Command, error
 
 func (s *SessionCommands) Start(ctx context.Context, userAgent *domain.UserAgent) {
 	s.eventCommands = append(s.eventCommands, session.NewAddedEvent(ctx, s.sessionWriteModel.aggregate, userAgent))
+	// set the user agent so the risk evaluation can use it
+	s.sessionWriteModel.UserAgent = userAgent
 }
 
 func (s *SessionCommands) UserChecked(ctx context.Context, userID, resourceOwner string, checkedAt time.Time, preferredLanguage *language.Tag) error {
@@ -257,6 +275,71 @@ func (s *SessionCommands) SetLifetime(ctx context.Context, lifetime time.Duratio
 	return nil
 }
 
+// EvaluateRisk compares the origin of the session to the recent sessions of the user, once the user is checked.
+// If the risk is high and the login policy of the user's organisation requires it, a second factor must be checked
+// before the session can be used for an auth request.
+func (s *SessionCommands) EvaluateRisk(ctx context.Context) error {
+	if s.riskEvaluator == nil || s.sessionWriteModel.UserID == "" || !s.sessionWriteModel.RiskEvaluatedAt.IsZero() {
+		return nil
+	}
+	history := newSessionRiskHistoryWriteModel(s.sessionWriteModel.UserID, s.riskEvaluator.RecentSessions())
+	if err := s.eventstore.FilterToQueryReducer(ctx, history); err != nil {
+		return err
+	}
+	observation := s.riskEvaluator.Observe(s.sessionWriteModel.UserAgent, s.now())
+	sessionRisk := s.riskEvaluator.Evaluate(observation, history.Observations)
+	var stepUpRequired bool
+	if sessionRisk.Level == domain.SessionRiskLevelHigh {
+		policy, err := s.getLoginPolicy(ctx, s.sessionWriteModel.UserResourceOwner)
+		if err != nil {
+			return err
+		}
+		stepUpRequired = policy.ForceMFAOnHighRisk
+	}
+	s.eventCommands = append(s.eventCommands, session.NewRiskEvaluatedEvent(ctx, s.sessionWriteModel.aggregate,
+		s.sessionWriteModel.UserID, observation.At, sessionRisk, stepUpRequired, observation.DeviceID, observation.Location),
+	)
+	s.riskOrigin = &observation
+	return nil
+}
+
+// VerifyRiskOrigin adds the origin of the session to the risk history of the user,
+// once the user authenticated with a password, passkey or identity provider.
+func (s *SessionCommands) VerifyRiskOrigin(ctx context.Context) {
+	if s.riskEvaluator == nil || s.sessionWriteModel.RiskOriginVerified {
+		return
+	}
+	origin := s.riskOrigin
+	if origin == nil {
+		origin = s.sessionWriteModel.RiskOrigin
+	}
+	if origin == nil || !s.firstFactorChecked() {
+		return
+	}
+	s.eventCommands = append(s.eventCommands, session.NewRiskOriginVerifiedEvent(ctx, s.sessionWriteModel.aggregate,
+		s.sessionWriteModel.UserID, origin.At, origin.DeviceID, origin.Country, origin.Location),
+	)
+}
+
+// firstFactorChecked returns true if a password, passkey or identity provider check
+// succeeded on the session or is part of the current commands.
+func (s *SessionCommands) firstFactorChecked() bool {
+	wm := s.sessionWriteModel
+	if !wm.PasswordCheckedAt.IsZero() || !wm.IntentCheckedAt.IsZero() || (!wm.WebAuthNCheckedAt.IsZero() && wm.WebAuthNUserVerified) {
+		return true
+	}
+	return slices.ContainsFunc(s.eventCommands, func(cmd eventstore.Command) bool {
+		switch e := cmd.(type) {
+		case *session.PasswordCheckedEvent, *session.IntentCheckedEvent:
+			return true
+		case *session.WebAuthNCheckedEvent:
+			return e.UserVerified
+		default:
+			return false
+		}
+	})
+}
+
 func (s *SessionCommands) gethumanWriteModel(ctx context.Context) (*HumanWriteModel, error) {
 	if s.sessionWriteModel.UserID == "" {
 		return nil, zerrors.ThrowPreconditionFailed(nil, "COMMAND-eeR2e", "Errors.User.UserIDMissing")
@@ -360,6 +443,10 @@ func (c *Commands) updateSession(ctx context.Context, checks *SessionCommands, m
 	if err != nil {
 		return nil, err
 	}
+	if err = checks.EvaluateRisk(ctx); err != nil {
+		return nil, err
+	}
+	checks.VerifyRiskOrigin(ctx)
 	sessionToken, cmds, err := checks.commands(ctx)
 	if err != nil {
 		return nil, err
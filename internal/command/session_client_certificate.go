@@ -0,0 +1,74 @@
+package command
+
+import (
+	"context"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/hex"
+	"strings"
+	"time"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/session"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// CheckClientCertificate defines a check of a X.509 client certificate to be executed for a session update.
+// The first certificate of the chain is the client certificate, the others are optional intermediates.
+// The certificate has to be valid according to the [domain.ClientCertificatePolicy] of the user's organization
+// and must identify the user of the session.
+func CheckClientCertificate(chain []*x509.Certificate) SessionCommand {
+	return func(ctx context.Context, cmd *SessionCommands) ([]eventstore.Command, error) {
+		if len(chain) == 0 {
+			return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Cc5Mi", "Errors.Session.ClientCertificate.Missing")
+		}
+		human, err := cmd.gethumanWriteModel(ctx)
+		if err != nil {
+			return nil, err
+		}
+		policyWriteModel := NewOrgClientCertificatePolicyWriteModel(cmd.sessionWriteModel.UserResourceOwner)
+		if err = cmd.eventstore.FilterToQueryReducer(ctx, policyWriteModel); err != nil {
+			return nil, err
+		}
+		if !policyWriteModel.State.Exists() {
+			return nil, zerrors.ThrowPreconditionFailed(nil, "COMMAND-Cc6Nc", "Errors.Session.ClientCertificate.NotConfigured")
+		}
+		policy := writeModelToClientCertificatePolicy(policyWriteModel)
+		if err = policy.VerifyClientCertificate(chain, cmd.now()); err != nil {
+			return nil, err
+		}
+		identifier, err := policy.UserIdentifier(chain[0])
+		if err != nil {
+			return nil, err
+		}
+		if !clientCertificateIdentifiesUser(policy.UserMapping, identifier, human) {
+			return nil, zerrors.ThrowPreconditionFailed(nil, "COMMAND-Cc7Ou", "Errors.Session.ClientCertificate.OtherUser")
+		}
+		cmd.ClientCertificateChecked(ctx, cmd.now(), chain[0])
+		return nil, nil
+	}
+}
+
+func clientCertificateIdentifiesUser(mapping domain.ClientCertificateUserMapping, identifier string, human *HumanWriteModel) bool {
+	switch mapping {
+	case domain.ClientCertificateUserMappingSubjectCommonName,
+		domain.ClientCertificateUserMappingSANUPN:
+		return strings.EqualFold(identifier, human.UserName)
+	case domain.ClientCertificateUserMappingSANEmail:
+		return human.IsEmailVerified && strings.EqualFold(identifier, string(human.Email))
+	default:
+		return false
+	}
+}
+
+func (s *SessionCommands) ClientCertificateChecked(ctx context.Context, checkedAt time.Time, certificate *x509.Certificate) {
+	fingerprint := sha256.Sum256(certificate.Raw)
+	s.eventCommands = append(s.eventCommands, session.NewClientCertificateCheckedEvent(ctx, s.sessionWriteModel.aggregate,
+		checkedAt,
+		hex.EncodeToString(fingerprint[:]),
+		certificate.Subject.String(),
+		certificate.Issuer.String(),
+		certificate.SerialNumber.String(),
+	))
+}
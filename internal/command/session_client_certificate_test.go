@@ -0,0 +1,295 @@
+package command
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/hex"
+	"encoding/pem"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/text/language"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/org"
+	"github.com/zitadel/zitadel/internal/repository/policy"
+	"github.com/zitadel/zitadel/internal/repository/session"
+	"github.com/zitadel/zitadel/internal/repository/user"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// newTestClientCertificates returns the PEM encoded certificate of a new CA and a client certificate issued by it for every common name.
+func newTestClientCertificates(t *testing.T, commonNames ...string) (string, []*x509.Certificate) {
+	caKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	caTemplate := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "ca"},
+		NotBefore:             testNow.Add(-time.Hour),
+		NotAfter:              testNow.Add(time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageCertSign,
+	}
+	caDER, err := x509.CreateCertificate(rand.Reader, caTemplate, caTemplate, caKey.Public(), caKey)
+	require.NoError(t, err)
+	ca, err := x509.ParseCertificate(caDER)
+	require.NoError(t, err)
+
+	certificates := make([]*x509.Certificate, len(commonNames))
+	for i, commonName := range commonNames {
+		key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		require.NoError(t, err)
+		der, err := x509.CreateCertificate(rand.Reader, &x509.Certificate{
+			SerialNumber: big.NewInt(int64(i + 2)),
+			Subject:      pkix.Name{CommonName: commonName},
+			NotBefore:    testNow.Add(-time.Hour),
+			NotAfter:     testNow.Add(time.Hour),
+			ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+		}, ca, key.Public(), caKey)
+		require.NoError(t, err)
+		certificates[i], err = x509.ParseCertificate(der)
+		require.NoError(t, err)
+	}
+	return string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: caDER})), certificates
+}
+
+func TestCheckClientCertificate(t *testing.T) {
+	caPEM, certificates := newTestClientCertificates(t, "username", "other")
+	certificate, otherUserCertificate := certificates[0], certificates[1]
+	otherCAPEM, _ := newTestClientCertificates(t)
+	fingerprint := sha256.Sum256(certificate.Raw)
+	userAgg := &user.NewAggregate("userID", "org1").Aggregate
+	humanAddedEvent := func() eventstore.Event {
+		return eventFromEventPusher(
+			user.NewHumanAddedEvent(context.Background(), userAgg,
+				"username", "", "", "", "", language.English,
+				domain.GenderUnspecified, "email@test.ch", true,
+			),
+		)
+	}
+	policySetEvent := func(caPEM string) eventstore.Event {
+		event, err := org.NewClientCertificatePolicySetEvent(context.Background(), &org.NewAggregate("org1").Aggregate,
+			[]policy.ClientCertificatePolicyChanges{
+				policy.ChangeClientCertificateCACertificates([]string{caPEM}),
+				policy.ChangeClientCertificateUserMapping(domain.ClientCertificateUserMappingSubjectCommonName),
+			},
+		)
+		require.NoError(t, err)
+		return eventFromEventPusher(event)
+	}
+
+	type fields struct {
+		eventstore func(*testing.T) *eventstore.Eventstore
+		userID     string
+	}
+	type args struct {
+		chain []*x509.Certificate
+	}
+	type res struct {
+		err      error
+		commands []eventstore.Command
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			name: "missing certificate",
+			fields: fields{
+				eventstore: expectEventstore(),
+				userID:     "userID",
+			},
+			args: args{},
+			res: res{
+				err: zerrors.ThrowInvalidArgument(nil, "COMMAND-Cc5Mi", "Errors.Session.ClientCertificate.Missing"),
+			},
+		},
+		{
+			name: "missing userID",
+			fields: fields{
+				eventstore: expectEventstore(),
+			},
+			args: args{
+				chain: []*x509.Certificate{certificate},
+			},
+			res: res{
+				err: zerrors.ThrowPreconditionFailed(nil, "COMMAND-eeR2e", "Errors.User.UserIDMissing"),
+			},
+		},
+		{
+			name: "policy not configured",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(humanAddedEvent()),
+					expectFilter(),
+				),
+				userID: "userID",
+			},
+			args: args{
+				chain: []*x509.Certificate{certificate},
+			},
+			res: res{
+				err: zerrors.ThrowPreconditionFailed(nil, "COMMAND-Cc6Nc", "Errors.Session.ClientCertificate.NotConfigured"),
+			},
+		},
+		{
+			name: "untrusted certificate",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(humanAddedEvent()),
+					expectFilter(policySetEvent(otherCAPEM)),
+				),
+				userID: "userID",
+			},
+			args: args{
+				chain: []*x509.Certificate{certificate},
+			},
+			res: res{
+				err: zerrors.ThrowPreconditionFailed(nil, "DOMAIN-Cc3Ve", "Errors.Session.ClientCertificate.Invalid"),
+			},
+		},
+		{
+			name: "certificate of other user",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(humanAddedEvent()),
+					expectFilter(policySetEvent(caPEM)),
+				),
+				userID: "userID",
+			},
+			args: args{
+				chain: []*x509.Certificate{otherUserCertificate},
+			},
+			res: res{
+				err: zerrors.ThrowPreconditionFailed(nil, "COMMAND-Cc7Ou", "Errors.Session.ClientCertificate.OtherUser"),
+			},
+		},
+		{
+			name: "certificate checked",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(humanAddedEvent()),
+					expectFilter(policySetEvent(caPEM)),
+				),
+				userID: "userID",
+			},
+			args: args{
+				chain: []*x509.Certificate{certificate},
+			},
+			res: res{
+				commands: []eventstore.Command{
+					session.NewClientCertificateCheckedEvent(context.Background(), &session.NewAggregate("sessionID", "instanceID").Aggregate,
+						testNow,
+						hex.EncodeToString(fingerprint[:]),
+						"CN=username",
+						"CN=ca",
+						"2",
+					),
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cmd := CheckClientCertificate(tt.args.chain)
+
+			sessionModel := &SessionWriteModel{
+				UserID:            tt.fields.userID,
+				UserResourceOwner: "org1",
+				UserCheckedAt:     testNow,
+				State:             domain.SessionStateActive,
+				aggregate:         &session.NewAggregate("sessionID", "instanceID").Aggregate,
+			}
+			cmds := &SessionCommands{
+				sessionCommands:   []SessionCommand{cmd},
+				sessionWriteModel: sessionModel,
+				eventstore:        tt.fields.eventstore(t),
+				now: func() time.Time {
+					return testNow
+				},
+			}
+
+			gotCmds, err := cmd(context.Background(), cmds)
+			assert.ErrorIs(t, err, tt.res.err)
+			assert.Nil(t, gotCmds)
+			assert.Equal(t, tt.res.commands, cmds.eventCommands)
+		})
+	}
+}
+
+func Test_clientCertificateIdentifiesUser(t *testing.T) {
+	human := &HumanWriteModel{
+		UserName:        "Username",
+		Email:           "email@test.ch",
+		IsEmailVerified: true,
+	}
+	tests := []struct {
+		name       string
+		mapping    domain.ClientCertificateUserMapping
+		identifier string
+		human      *HumanWriteModel
+		want       bool
+	}{
+		{
+			name:       "common name, username",
+			mapping:    domain.ClientCertificateUserMappingSubjectCommonName,
+			identifier: "username",
+			human:      human,
+			want:       true,
+		},
+		{
+			name:       "common name, other username",
+			mapping:    domain.ClientCertificateUserMappingSubjectCommonName,
+			identifier: "other",
+			human:      human,
+			want:       false,
+		},
+		{
+			name:       "upn, username",
+			mapping:    domain.ClientCertificateUserMappingSANUPN,
+			identifier: "USERNAME",
+			human:      human,
+			want:       true,
+		},
+		{
+			name:       "email, verified email",
+			mapping:    domain.ClientCertificateUserMappingSANEmail,
+			identifier: "Email@test.ch",
+			human:      human,
+			want:       true,
+		},
+		{
+			name:       "email, not verified",
+			mapping:    domain.ClientCertificateUserMappingSANEmail,
+			identifier: "email@test.ch",
+			human: &HumanWriteModel{
+				Email: "email@test.ch",
+			},
+			want: false,
+		},
+		{
+			name:       "email, username",
+			mapping:    domain.ClientCertificateUserMappingSANEmail,
+			identifier: "Username",
+			human:      human,
+			want:       false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, clientCertificateIdentifiesUser(tt.mapping, tt.identifier, tt.human))
+		})
+	}
+}
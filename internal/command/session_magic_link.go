@@ -0,0 +1,129 @@
+package command
+
+import (
+	"context"
+	"io"
+	"time"
+
+	"golang.org/x/text/language"
+
+	"github.com/zitadel/zitadel/internal/crypto"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/session"
+	"github.com/zitadel/zitadel/internal/repository/user"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// CreateMagicLinkChallengeURLTemplate creates a magic link challenge, which is sent by email.
+// Since the link has to lead to the login UI checking the session, the url template is required.
+func (c *Commands) CreateMagicLinkChallengeURLTemplate(urlTmpl, authRequestID string) (SessionCommand, error) {
+	if urlTmpl == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Mlh0Ut", "Errors.Session.MagicLink.URLTemplateMissing")
+	}
+	if err := domain.RenderMagicLinkURLTemplate(io.Discard, urlTmpl, "code", "userID", "loginName", "displayName", "sessionID", "authRequestID", language.English); err != nil {
+		return nil, err
+	}
+	return c.createMagicLinkChallenge(false, urlTmpl, authRequestID, nil), nil
+}
+
+func (c *Commands) CreateMagicLinkChallengeReturnCode(authRequestID string, dst *string) SessionCommand {
+	return c.createMagicLinkChallenge(true, "", authRequestID, dst)
+}
+
+// createMagicLinkChallenge creates a single-use code, which is sent to the verified email of the user (or returned).
+// The code is bound to the provided auth request and the user agent (fingerprint) of the session
+// and can only be checked with both of them.
+func (c *Commands) createMagicLinkChallenge(returnCode bool, urlTmpl, authRequestID string, dst *string) SessionCommand {
+	return func(ctx context.Context, cmd *SessionCommands) ([]eventstore.Command, error) {
+		human, err := cmd.gethumanWriteModel(ctx)
+		if err != nil {
+			return nil, err
+		}
+		policy, err := cmd.getLoginPolicy(ctx, cmd.sessionWriteModel.UserResourceOwner)
+		if err != nil {
+			return nil, err
+		}
+		if !policy.AllowMagicLink {
+			return nil, zerrors.ThrowPreconditionFailed(nil, "COMMAND-Ml1Di", "Errors.User.MagicLink.Disabled")
+		}
+		if !human.IsEmailVerified {
+			return nil, zerrors.ThrowPreconditionFailed(nil, "COMMAND-Ml2Ev", "Errors.User.MagicLink.EmailNotVerified")
+		}
+		userAgentID := sessionUserAgentID(cmd.sessionWriteModel)
+		if userAgentID == "" {
+			return nil, zerrors.ThrowPreconditionFailed(nil, "COMMAND-Ml3Ua", "Errors.Session.MagicLink.UserAgentMissing")
+		}
+		code, err := cmd.createCode(ctx, cmd.eventstore.Filter, domain.SecretGeneratorTypeMagicLinkCode, cmd.otpAlg, c.defaultSecretGenerators.MagicLinkCode) //nolint:staticcheck
+		if err != nil {
+			return nil, err
+		}
+		if returnCode {
+			*dst = code.Plain
+		}
+		cmd.MagicLinkChallenged(ctx, code.Crypted, code.Expiry, returnCode, urlTmpl, authRequestID, userAgentID)
+		return nil, nil
+	}
+}
+
+func sessionUserAgentID(wm *SessionWriteModel) string {
+	if wm.UserAgent == nil || wm.UserAgent.FingerprintID == nil {
+		return ""
+	}
+	return *wm.UserAgent.FingerprintID
+}
+
+func (c *Commands) MagicLinkSent(ctx context.Context, sessionID, resourceOwner string) error {
+	sessionWriteModel := NewSessionWriteModel(sessionID, resourceOwner)
+	err := c.eventstore.FilterToQueryReducer(ctx, sessionWriteModel)
+	if err != nil {
+		return err
+	}
+	if sessionWriteModel.MagicLinkChallenge == nil {
+		return zerrors.ThrowPreconditionFailed(nil, "COMMAND-Ml4Nf", "Errors.User.Code.NotFound")
+	}
+	return c.pushAppendAndReduce(ctx, sessionWriteModel,
+		session.NewMagicLinkSentEvent(ctx, &session.NewAggregate(sessionID, sessionWriteModel.ResourceOwner).Aggregate),
+	)
+}
+
+// CheckMagicLink defines a check of the code of a magic link to be executed for a session update.
+// The link has to be opened for the same auth request and on the same user agent (fingerprint) it was requested.
+// The code can only be used once, since the challenge is removed on success as well as on failure.
+func CheckMagicLink(code, authRequestID, userAgentID string) SessionCommand {
+	return func(ctx context.Context, cmd *SessionCommands) ([]eventstore.Command, error) {
+		if cmd.sessionWriteModel.UserID == "" {
+			return nil, zerrors.ThrowPreconditionFailed(nil, "COMMAND-Ml5Ui", "Errors.User.UserIDMissing")
+		}
+		challenge := cmd.sessionWriteModel.MagicLinkChallenge
+		if challenge == nil {
+			return nil, zerrors.ThrowPreconditionFailed(nil, "COMMAND-Ml6Nf", "Errors.User.Code.NotFound")
+		}
+		if challenge.AuthRequestID != authRequestID || challenge.UserAgentID != userAgentID {
+			return cmd.magicLinkCheckFailed(ctx), zerrors.ThrowPreconditionFailed(nil, "COMMAND-Ml7Bm", "Errors.User.MagicLink.BindingMismatch")
+		}
+		if err := crypto.VerifyCode(challenge.CreationDate, challenge.Expiry, challenge.Code, code, cmd.otpAlg); err != nil {
+			return cmd.magicLinkCheckFailed(ctx), err
+		}
+		cmd.MagicLinkChecked(ctx, cmd.now())
+		return nil, nil
+	}
+}
+
+// magicLinkCheckFailed returns the commands for a failed check,
+// which also invalidate the challenge of the session.
+func (s *SessionCommands) magicLinkCheckFailed(ctx context.Context) []eventstore.Command {
+	userAgg := &user.NewAggregate(s.sessionWriteModel.UserID, s.sessionWriteModel.UserResourceOwner).Aggregate
+	return []eventstore.Command{
+		user.NewHumanMagicLinkCheckFailedEvent(ctx, userAgg, nil),
+		session.NewMagicLinkCheckFailedEvent(ctx, s.sessionWriteModel.aggregate),
+	}
+}
+
+func (s *SessionCommands) MagicLinkChallenged(ctx context.Context, code *crypto.CryptoValue, expiry time.Duration, returnCode bool, urlTmpl, authRequestID, userAgentID string) {
+	s.eventCommands = append(s.eventCommands, session.NewMagicLinkChallengedEvent(ctx, s.sessionWriteModel.aggregate, code, expiry, returnCode, urlTmpl, authRequestID, userAgentID))
+}
+
+func (s *SessionCommands) MagicLinkChecked(ctx context.Context, checkedAt time.Time) {
+	s.eventCommands = append(s.eventCommands, session.NewMagicLinkCheckedEvent(ctx, s.sessionWriteModel.aggregate, checkedAt))
+}
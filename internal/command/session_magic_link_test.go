@@ -0,0 +1,234 @@
+package command
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/zitadel/zitadel/internal/crypto"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/session"
+	"github.com/zitadel/zitadel/internal/repository/user"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+func TestCommands_CreateMagicLinkChallengeURLTemplate(t *testing.T) {
+	tests := []struct {
+		name    string
+		urlTmpl string
+		wantErr error
+	}{
+		{
+			name:    "missing template",
+			urlTmpl: "",
+			wantErr: zerrors.ThrowInvalidArgument(nil, "COMMAND-Mlh0Ut", "Errors.Session.MagicLink.URLTemplateMissing"),
+		},
+		{
+			name:    "invalid template",
+			urlTmpl: "https://example.com/magiclink?code={{.Code}",
+			wantErr: zerrors.ThrowInvalidArgument(nil, "DOMAIN-oGh5e", "Errors.User.InvalidURLTemplate"),
+		},
+		{
+			name:    "template, ok",
+			urlTmpl: "https://example.com/magiclink?session={{.SessionID}}&code={{.Code}}",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := new(Commands)
+			cmd, err := c.CreateMagicLinkChallengeURLTemplate(tt.urlTmpl, "authRequestID")
+			require.ErrorIs(t, err, tt.wantErr)
+			if tt.wantErr == nil {
+				assert.NotNil(t, cmd)
+			}
+		})
+	}
+}
+
+func TestCheckMagicLink(t *testing.T) {
+	challenge := func(creationDate time.Time) *MagicLinkChallengeModel {
+		return &MagicLinkChallengeModel{
+			Code: &crypto.CryptoValue{
+				CryptoType: crypto.TypeEncryption,
+				Algorithm:  "enc",
+				KeyID:      "id",
+				Crypted:    []byte("code"),
+			},
+			Expiry:        5 * time.Minute,
+			CreationDate:  creationDate,
+			AuthRequestID: "authRequestID",
+			UserAgentID:   "userAgentID",
+		}
+	}
+	type fields struct {
+		userID    string
+		challenge *MagicLinkChallengeModel
+	}
+	type args struct {
+		code          string
+		authRequestID string
+		userAgentID   string
+	}
+	type res struct {
+		err           error
+		commands      []eventstore.Command
+		errorCommands []eventstore.Command
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			name: "missing userID",
+			args: args{
+				code: "code",
+			},
+			res: res{
+				err: zerrors.ThrowPreconditionFailed(nil, "COMMAND-Ml5Ui", "Errors.User.UserIDMissing"),
+			},
+		},
+		{
+			name: "missing challenge",
+			fields: fields{
+				userID: "userID",
+			},
+			args: args{
+				code: "code",
+			},
+			res: res{
+				err: zerrors.ThrowPreconditionFailed(nil, "COMMAND-Ml6Nf", "Errors.User.Code.NotFound"),
+			},
+		},
+		{
+			name: "other auth request",
+			fields: fields{
+				userID:    "userID",
+				challenge: challenge(testNow),
+			},
+			args: args{
+				code:          "code",
+				authRequestID: "otherAuthRequestID",
+				userAgentID:   "userAgentID",
+			},
+			res: res{
+				err: zerrors.ThrowPreconditionFailed(nil, "COMMAND-Ml7Bm", "Errors.User.MagicLink.BindingMismatch"),
+				errorCommands: []eventstore.Command{
+					user.NewHumanMagicLinkCheckFailedEvent(context.Background(), &user.NewAggregate("userID", "org1").Aggregate, nil),
+					session.NewMagicLinkCheckFailedEvent(context.Background(), &session.NewAggregate("sessionID", "instanceID").Aggregate),
+				},
+			},
+		},
+		{
+			name: "other user agent",
+			fields: fields{
+				userID:    "userID",
+				challenge: challenge(testNow),
+			},
+			args: args{
+				code:          "code",
+				authRequestID: "authRequestID",
+				userAgentID:   "otherUserAgentID",
+			},
+			res: res{
+				err: zerrors.ThrowPreconditionFailed(nil, "COMMAND-Ml7Bm", "Errors.User.MagicLink.BindingMismatch"),
+				errorCommands: []eventstore.Command{
+					user.NewHumanMagicLinkCheckFailedEvent(context.Background(), &user.NewAggregate("userID", "org1").Aggregate, nil),
+					session.NewMagicLinkCheckFailedEvent(context.Background(), &session.NewAggregate("sessionID", "instanceID").Aggregate),
+				},
+			},
+		},
+		{
+			name: "expired code",
+			fields: fields{
+				userID:    "userID",
+				challenge: challenge(testNow.Add(-10 * time.Minute)),
+			},
+			args: args{
+				code:          "code",
+				authRequestID: "authRequestID",
+				userAgentID:   "userAgentID",
+			},
+			res: res{
+				err: zerrors.ThrowPreconditionFailed(nil, "CODE-QvUQ4P", "Errors.User.Code.Expired"),
+				errorCommands: []eventstore.Command{
+					user.NewHumanMagicLinkCheckFailedEvent(context.Background(), &user.NewAggregate("userID", "org1").Aggregate, nil),
+					session.NewMagicLinkCheckFailedEvent(context.Background(), &session.NewAggregate("sessionID", "instanceID").Aggregate),
+				},
+			},
+		},
+		{
+			name: "check ok",
+			fields: fields{
+				userID:    "userID",
+				challenge: challenge(time.Now()),
+			},
+			args: args{
+				code:          "code",
+				authRequestID: "authRequestID",
+				userAgentID:   "userAgentID",
+			},
+			res: res{
+				commands: []eventstore.Command{
+					session.NewMagicLinkCheckedEvent(context.Background(), &session.NewAggregate("sessionID", "instanceID").Aggregate,
+						testNow,
+					),
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cmd := CheckMagicLink(tt.args.code, tt.args.authRequestID, tt.args.userAgentID)
+
+			sessionModel := &SessionWriteModel{
+				UserID:             tt.fields.userID,
+				UserResourceOwner:  "org1",
+				UserCheckedAt:      testNow,
+				State:              domain.SessionStateActive,
+				MagicLinkChallenge: tt.fields.challenge,
+				aggregate:          &session.NewAggregate("sessionID", "instanceID").Aggregate,
+			}
+			cmds := &SessionCommands{
+				sessionCommands:   []SessionCommand{cmd},
+				sessionWriteModel: sessionModel,
+				otpAlg:            crypto.CreateMockEncryptionAlg(gomock.NewController(t)),
+				now: func() time.Time {
+					return testNow
+				},
+			}
+
+			gotCmds, err := cmd(context.Background(), cmds)
+			assert.ErrorIs(t, err, tt.res.err)
+			assert.Equal(t, tt.res.errorCommands, gotCmds)
+			assert.Equal(t, tt.res.commands, cmds.eventCommands)
+		})
+	}
+}
+
+func TestSessionWriteModel_reduceMagicLinkCheckFailed(t *testing.T) {
+	ctx := context.Background()
+	sessionAgg := &session.NewAggregate("sessionID", "instanceID").Aggregate
+	wm := NewSessionWriteModel("sessionID", "instanceID")
+	wm.AppendEvents(
+		eventFromEventPusher(session.NewMagicLinkChallengedEvent(ctx, sessionAgg,
+			&crypto.CryptoValue{
+				CryptoType: crypto.TypeEncryption,
+				Algorithm:  "enc",
+				KeyID:      "id",
+				Crypted:    []byte("code"),
+			},
+			5*time.Minute, false, "", "authRequestID", "userAgentID",
+		)),
+		eventFromEventPusher(session.NewMagicLinkCheckFailedEvent(ctx, sessionAgg)),
+	)
+	require.NoError(t, wm.Reduce())
+	assert.Nil(t, wm.MagicLinkChallenge)
+	assert.True(t, wm.MagicLinkCheckedAt.IsZero())
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/zitadel/zitadel/internal/domain"
 	"github.com/zitadel/zitadel/internal/eventstore"
 	"github.com/zitadel/zitadel/internal/repository/session"
+	"github.com/zitadel/zitadel/internal/risk"
 	"github.com/zitadel/zitadel/internal/zerrors"
 )
 
@@ -27,6 +28,14 @@ type OTPCode struct {
 	VerificationID string
 }
 
+type MagicLinkChallengeModel struct {
+	Code          *crypto.CryptoValue
+	Expiry        time.Duration
+	CreationDate  time.Time
+	AuthRequestID string
+	UserAgentID   string
+}
+
 func (p *WebAuthNChallengeModel) WebAuthNLogin(human *domain.Human, credentialAssertionData []byte) *domain.WebAuthNLogin {
 	return &domain.WebAuthNLogin{
 		ObjectRoot:              human.ObjectRoot,
@@ -41,26 +50,37 @@ func (p *WebAuthNChallengeModel) WebAuthNLogin(human *domain.Human, credentialAs
 type SessionWriteModel struct {
 	eventstore.WriteModel
 
-	TokenID              string
-	UserID               string
-	UserResourceOwner    string
-	PreferredLanguage    *language.Tag
-	UserCheckedAt        time.Time
-	PasswordCheckedAt    time.Time
-	IntentCheckedAt      time.Time
-	WebAuthNCheckedAt    time.Time
-	TOTPCheckedAt        time.Time
-	OTPSMSCheckedAt      time.Time
-	OTPEmailCheckedAt    time.Time
-	WebAuthNUserVerified bool
-	Metadata             map[string][]byte
-	State                domain.SessionState
-	UserAgent            *domain.UserAgent
-	Expiration           time.Time
+	TokenID                    string
+	UserID                     string
+	UserResourceOwner          string
+	PreferredLanguage          *language.Tag
+	UserCheckedAt              time.Time
+	PasswordCheckedAt          time.Time
+	IntentCheckedAt            time.Time
+	WebAuthNCheckedAt          time.Time
+	TOTPCheckedAt              time.Time
+	OTPSMSCheckedAt            time.Time
+	OTPEmailCheckedAt          time.Time
+	TrustedDeviceCheckedAt     time.Time
+	RecoveryCodeCheckedAt      time.Time
+	ClientCertificateCheckedAt time.Time
+	MagicLinkCheckedAt         time.Time
+	WebAuthNUserVerified       bool
+	Metadata                   map[string][]byte
+	State                      domain.SessionState
+	UserAgent                  *domain.UserAgent
+	Expiration                 time.Time
+	Risk                       *domain.SessionRisk
+	RiskEvaluatedAt            time.Time
+	StepUpRequired             bool
+	// RiskOrigin is the origin of the session observed by the risk evaluation.
+	RiskOrigin         *risk.Observation
+	RiskOriginVerified bool
 
 	WebAuthNChallenge     *WebAuthNChallengeModel
 	OTPSMSCodeChallenge   *OTPCode
 	OTPEmailCodeChallenge *OTPCode
+	MagicLinkChallenge    *MagicLinkChallengeModel
 
 	aggregate *eventstore.Aggregate
 }
@@ -102,10 +122,26 @@ func (wm *SessionWriteModel) Reduce() error {
 			wm.reduceOTPEmailChallenged(e)
 		case *session.OTPEmailCheckedEvent:
 			wm.reduceOTPEmailChecked(e)
+		case *session.TrustedDeviceCheckedEvent:
+			wm.reduceTrustedDeviceChecked(e)
+		case *session.RecoveryCodeCheckedEvent:
+			wm.reduceRecoveryCodeChecked(e)
+		case *session.ClientCertificateCheckedEvent:
+			wm.reduceClientCertificateChecked(e)
+		case *session.MagicLinkChallengedEvent:
+			wm.reduceMagicLinkChallenged(e)
+		case *session.MagicLinkCheckedEvent:
+			wm.reduceMagicLinkChecked(e)
+		case *session.MagicLinkCheckFailedEvent:
+			wm.reduceMagicLinkCheckFailed()
 		case *session.TokenSetEvent:
 			wm.reduceTokenSet(e)
 		case *session.LifetimeSetEvent:
 			wm.reduceLifetimeSet(e)
+		case *session.RiskEvaluatedEvent:
+			wm.reduceRiskEvaluated(e)
+		case *session.RiskOriginVerifiedEvent:
+			wm.RiskOriginVerified = true
 		case *session.TerminateEvent:
 			wm.reduceTerminate()
 		}
@@ -131,9 +167,17 @@ func (wm *SessionWriteModel) Query() *eventstore.SearchQueryBuilder {
 			session.OTPSMSCheckedType,
 			session.OTPEmailChallengedType,
 			session.OTPEmailCheckedType,
+			session.TrustedDeviceCheckedType,
+			session.RecoveryCodeCheckedType,
+			session.ClientCertificateCheckedType,
+			session.MagicLinkChallengedType,
+			session.MagicLinkCheckedType,
+			session.MagicLinkCheckFailedType,
 			session.TokenSetType,
 			session.MetadataSetType,
 			session.LifetimeSetType,
+			session.RiskEvaluatedType,
+			session.RiskOriginVerifiedType,
 			session.TerminateType,
 		).
 		Builder()
@@ -215,6 +259,38 @@ func (wm *SessionWriteModel) reduceOTPEmailChecked(e *session.OTPEmailCheckedEve
 	wm.OTPEmailCheckedAt = e.CheckedAt
 }
 
+func (wm *SessionWriteModel) reduceTrustedDeviceChecked(e *session.TrustedDeviceCheckedEvent) {
+	wm.TrustedDeviceCheckedAt = e.CheckedAt
+}
+
+func (wm *SessionWriteModel) reduceRecoveryCodeChecked(e *session.RecoveryCodeCheckedEvent) {
+	wm.RecoveryCodeCheckedAt = e.CheckedAt
+}
+
+func (wm *SessionWriteModel) reduceClientCertificateChecked(e *session.ClientCertificateCheckedEvent) {
+	wm.ClientCertificateCheckedAt = e.CheckedAt
+}
+
+func (wm *SessionWriteModel) reduceMagicLinkChallenged(e *session.MagicLinkChallengedEvent) {
+	wm.MagicLinkChallenge = &MagicLinkChallengeModel{
+		Code:          e.Code,
+		Expiry:        e.Expiry,
+		CreationDate:  e.CreationDate(),
+		AuthRequestID: e.AuthRequestID,
+		UserAgentID:   e.UserAgentID,
+	}
+}
+
+func (wm *SessionWriteModel) reduceMagicLinkChecked(e *session.MagicLinkCheckedEvent) {
+	wm.MagicLinkChallenge = nil
+	wm.MagicLinkCheckedAt = e.CheckedAt
+}
+
+// reduceMagicLinkCheckFailed removes the challenge, so the link cannot be used again after a failed check.
+func (wm *SessionWriteModel) reduceMagicLinkCheckFailed() {
+	wm.MagicLinkChallenge = nil
+}
+
 func (wm *SessionWriteModel) reduceTokenSet(e *session.TokenSetEvent) {
 	wm.TokenID = e.TokenID
 }
@@ -223,6 +299,24 @@ func (wm *SessionWriteModel) reduceLifetimeSet(e *session.LifetimeSetEvent) {
 	wm.Expiration = e.CreationDate().Add(e.Lifetime)
 }
 
+func (wm *SessionWriteModel) reduceRiskEvaluated(e *session.RiskEvaluatedEvent) {
+	wm.Risk = &domain.SessionRisk{
+		Level:            e.Level,
+		NewDevice:        e.NewDevice,
+		NewCountry:       e.NewCountry,
+		ImpossibleTravel: e.ImpossibleTravel,
+		Country:          e.Country,
+	}
+	wm.RiskEvaluatedAt = e.EvaluatedAt
+	wm.StepUpRequired = e.StepUpRequired
+	wm.RiskOrigin = &risk.Observation{
+		DeviceID: e.DeviceID,
+		Country:  e.Country,
+		Location: e.Location,
+		At:       e.EvaluatedAt,
+	}
+}
+
 func (wm *SessionWriteModel) reduceTerminate() {
 	wm.State = domain.SessionStateTerminated
 }
@@ -237,6 +331,10 @@ func (wm *SessionWriteModel) AuthenticationTime() time.Time {
 		wm.IntentCheckedAt,
 		wm.OTPSMSCheckedAt,
 		wm.OTPEmailCheckedAt,
+		wm.TrustedDeviceCheckedAt,
+		wm.RecoveryCodeCheckedAt,
+		wm.ClientCertificateCheckedAt,
+		wm.MagicLinkCheckedAt,
 	} {
 		if check.After(authTime) {
 			authTime = check
@@ -270,6 +368,18 @@ func (wm *SessionWriteModel) AuthMethodTypes() []domain.UserAuthMethodType {
 	if !wm.OTPEmailCheckedAt.IsZero() {
 		types = append(types, domain.UserAuthMethodTypeOTPEmail)
 	}
+	if !wm.TrustedDeviceCheckedAt.IsZero() {
+		types = append(types, domain.UserAuthMethodTypeTrustedDevice)
+	}
+	if !wm.RecoveryCodeCheckedAt.IsZero() {
+		types = append(types, domain.UserAuthMethodTypeRecoveryCode)
+	}
+	if !wm.ClientCertificateCheckedAt.IsZero() {
+		types = append(types, domain.UserAuthMethodTypeClientCertificate)
+	}
+	if !wm.MagicLinkCheckedAt.IsZero() {
+		types = append(types, domain.UserAuthMethodTypeMagicLink)
+	}
 	return types
 }
 
@@ -285,6 +395,27 @@ func (wm *SessionWriteModel) CheckNotInvalidated() error {
 	return nil
 }
 
+// CheckStepUp checks that a second factor (OTP or WebAuthN) was checked,
+// if the risk evaluation of the session required it.
+func (wm *SessionWriteModel) CheckStepUp() error {
+	if !wm.StepUpRequired {
+		return nil
+	}
+	for _, check := range []time.Time{
+		wm.WebAuthNCheckedAt,
+		wm.TOTPCheckedAt,
+		wm.OTPSMSCheckedAt,
+		wm.OTPEmailCheckedAt,
+		wm.RecoveryCodeCheckedAt,
+		wm.ClientCertificateCheckedAt,
+	} {
+		if !check.IsZero() {
+			return nil
+		}
+	}
+	return zerrors.ThrowPreconditionFailed(nil, "COMMAND-Ri5kU", "Errors.Session.StepUpRequired")
+}
+
 // CheckIsActive checks that the session was not invalidated ([CheckNotInvalidated]) and actually already exists.
 func (wm *SessionWriteModel) CheckIsActive() error {
 	if wm.State == domain.SessionStateUnspecified {
@@ -7,6 +7,7 @@ import (
 	"github.com/stretchr/testify/assert"
 
 	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/zerrors"
 )
 
 func TestSessionWriteModel_AuthMethodTypes(t *testing.T) {
@@ -73,3 +74,52 @@ func TestSessionWriteModel_AuthMethodTypes(t *testing.T) {
 		})
 	}
 }
+
+func TestSessionWriteModel_CheckStepUp(t *testing.T) {
+	tests := []struct {
+		name  string
+		model *SessionWriteModel
+		err   error
+	}{
+		{
+			name:  "not required",
+			model: &SessionWriteModel{PasswordCheckedAt: testNow},
+		},
+		{
+			name: "required, password only",
+			model: &SessionWriteModel{
+				StepUpRequired:    true,
+				PasswordCheckedAt: testNow,
+			},
+			err: zerrors.ThrowPreconditionFailed(nil, "COMMAND-Ri5kU", "Errors.Session.StepUpRequired"),
+		},
+		{
+			name: "required, totp checked",
+			model: &SessionWriteModel{
+				StepUpRequired:    true,
+				PasswordCheckedAt: testNow,
+				TOTPCheckedAt:     testNow,
+			},
+		},
+		{
+			name: "required, webauthn checked",
+			model: &SessionWriteModel{
+				StepUpRequired:    true,
+				WebAuthNCheckedAt: testNow,
+			},
+		},
+		{
+			name: "required, otp email checked",
+			model: &SessionWriteModel{
+				StepUpRequired:    true,
+				PasswordCheckedAt: testNow,
+				OTPEmailCheckedAt: testNow,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.ErrorIs(t, tt.model.CheckStepUp(), tt.err)
+		})
+	}
+}
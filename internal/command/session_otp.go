@@ -141,6 +141,7 @@ func CheckOTPSMS(code string) SessionCommand {
 			cmd.eventstore.FilterToQueryReducer,
 			cmd.otpAlg,
 			cmd.getCodeVerifier,
+			cmd.loginThrottler,
 			succeededEvent,
 			failedEvent,
 		)
@@ -181,6 +182,7 @@ func CheckOTPEmail(code string) SessionCommand {
 			cmd.eventstore.FilterToQueryReducer,
 			cmd.otpAlg,
 			nil, // email currently always uses local code checks
+			cmd.loginThrottler,
 			succeededEvent,
 			failedEvent,
 		)
@@ -849,6 +849,11 @@ func TestCheckOTPSMS(t *testing.T) {
 						eventFromEventPusher(
 							org.NewLockoutPolicyAddedEvent(context.Background(), &org.NewAggregate("org1").Aggregate,
 								0, 0, false,
+								0,
+								0,
+								0,
+								0,
+								0,
 							),
 						),
 					),
@@ -888,6 +893,11 @@ func TestCheckOTPSMS(t *testing.T) {
 						eventFromEventPusher(
 							org.NewLockoutPolicyAddedEvent(context.Background(), &org.NewAggregate("org1").Aggregate,
 								0, 1, false,
+								0,
+								0,
+								0,
+								0,
+								0,
 							),
 						),
 					),
@@ -1137,6 +1147,11 @@ func TestCheckOTPEmail(t *testing.T) {
 						eventFromEventPusher(
 							org.NewLockoutPolicyAddedEvent(context.Background(), &org.NewAggregate("org1").Aggregate,
 								0, 0, false,
+								0,
+								0,
+								0,
+								0,
+								0,
 							),
 						),
 					),
@@ -1176,6 +1191,11 @@ func TestCheckOTPEmail(t *testing.T) {
 						eventFromEventPusher(
 							org.NewLockoutPolicyAddedEvent(context.Background(), &org.NewAggregate("org1").Aggregate,
 								0, 1, false,
+								0,
+								0,
+								0,
+								0,
+								0,
 							),
 						),
 					),
@@ -0,0 +1,53 @@
+package command
+
+import (
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/session"
+	"github.com/zitadel/zitadel/internal/risk"
+)
+
+// sessionRiskHistoryWriteModel contains the verified origins of the recent sessions of a user,
+// ordered from the latest to the oldest.
+// Sessions where only the user was checked are not part of the history.
+type sessionRiskHistoryWriteModel struct {
+	eventstore.WriteModel
+
+	userID         string
+	recentSessions uint64
+
+	Observations []risk.Observation
+}
+
+func newSessionRiskHistoryWriteModel(userID string, recentSessions uint64) *sessionRiskHistoryWriteModel {
+	return &sessionRiskHistoryWriteModel{
+		userID:         userID,
+		recentSessions: recentSessions,
+	}
+}
+
+func (wm *sessionRiskHistoryWriteModel) Reduce() error {
+	for _, event := range wm.Events {
+		e, ok := event.(*session.RiskOriginVerifiedEvent)
+		if !ok {
+			continue
+		}
+		wm.Observations = append(wm.Observations, risk.Observation{
+			DeviceID: e.DeviceID,
+			Country:  e.Country,
+			Location: e.Location,
+			At:       e.ObservedAt,
+		})
+	}
+	return wm.WriteModel.Reduce()
+}
+
+func (wm *sessionRiskHistoryWriteModel) Query() *eventstore.SearchQueryBuilder {
+	return eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		OrderDesc().
+		Limit(wm.recentSessions).
+		AddQuery().
+		AggregateTypes(session.AggregateType).
+		EventTypes(session.RiskOriginVerifiedType).
+		EventData(map[string]interface{}{"userID": wm.userID}).
+		Builder()
+}
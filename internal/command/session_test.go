@@ -25,6 +25,7 @@ import (
 	"github.com/zitadel/zitadel/internal/repository/org"
 	"github.com/zitadel/zitadel/internal/repository/session"
 	"github.com/zitadel/zitadel/internal/repository/user"
+	"github.com/zitadel/zitadel/internal/risk"
 	"github.com/zitadel/zitadel/internal/zerrors"
 )
 
@@ -542,7 +543,7 @@ func TestCommands_updateSession(t *testing.T) {
 					),
 					expectFilter(), // recheck
 					expectFilter(
-						org.NewLockoutPolicyAddedEvent(context.Background(), &org.NewAggregate("org1").Aggregate, 0, 0, false),
+						org.NewLockoutPolicyAddedEvent(context.Background(), &org.NewAggregate("org1").Aggregate, 0, 0, false, 0, 0, 0, 0, 0),
 					),
 					expectPush(
 						user.NewHumanPasswordCheckFailedEvent(context.Background(), &user.NewAggregate("userID", "org1").Aggregate, nil),
@@ -1118,7 +1119,7 @@ func TestCheckTOTP(t *testing.T) {
 					),
 					expectFilter(), // recheck
 					expectFilter(
-						eventFromEventPusher(org.NewLockoutPolicyAddedEvent(ctx, orgAgg, 0, 0, false)),
+						eventFromEventPusher(org.NewLockoutPolicyAddedEvent(ctx, orgAgg, 0, 0, false, 0, 0, 0, 0, 0)),
 					),
 				),
 			},
@@ -1147,7 +1148,7 @@ func TestCheckTOTP(t *testing.T) {
 					),
 					expectFilter(), // recheck
 					expectFilter(
-						eventFromEventPusher(org.NewLockoutPolicyAddedEvent(ctx, orgAgg, 1, 1, false)),
+						eventFromEventPusher(org.NewLockoutPolicyAddedEvent(ctx, orgAgg, 1, 1, false, 0, 0, 0, 0, 0)),
 					),
 				),
 			},
@@ -1579,3 +1580,290 @@ func TestCommands_TerminateSession(t *testing.T) {
 		})
 	}
 }
+
+type testRiskLocator string
+
+func (l testRiskLocator) Locate(net.IP) (string, *domain.GeoLocation) {
+	return string(l), nil
+}
+
+func TestSessionCommands_EvaluateRisk(t *testing.T) {
+	sessionAgg := &session.NewAggregate("sessionID", "instance1").Aggregate
+	userAgent := &domain.UserAgent{
+		FingerprintID: gu.Ptr("device"),
+		IP:            net.IPv4(192, 0, 2, 1),
+	}
+	type fields struct {
+		eventstore      func(*testing.T) *eventstore.Eventstore
+		riskEvaluator   *risk.Evaluator
+		getLoginPolicy  func(ctx context.Context, orgID string) (*domain.LoginPolicy, error)
+		userID          string
+		riskEvaluatedAt time.Time
+	}
+	type res struct {
+		err      error
+		commands []eventstore.Command
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		res    res
+	}{
+		{
+			name: "evaluation disabled",
+			fields: fields{
+				eventstore: expectEventstore(),
+				userID:     "userID",
+			},
+		},
+		{
+			name: "no user",
+			fields: fields{
+				eventstore:    expectEventstore(),
+				riskEvaluator: risk.NewEvaluator(testRiskLocator("CH"), 10, 1000),
+			},
+		},
+		{
+			name: "already evaluated",
+			fields: fields{
+				eventstore:      expectEventstore(),
+				riskEvaluator:   risk.NewEvaluator(testRiskLocator("CH"), 10, 1000),
+				userID:          "userID",
+				riskEvaluatedAt: testNow,
+			},
+		},
+		{
+			name: "filter error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilterError(zerrors.ThrowInternal(nil, "id", "filter failed")),
+				),
+				riskEvaluator: risk.NewEvaluator(testRiskLocator("CH"), 10, 1000),
+				userID:        "userID",
+			},
+			res: res{
+				err: zerrors.ThrowInternal(nil, "id", "filter failed"),
+			},
+		},
+		{
+			name: "first session, low risk",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(),
+				),
+				riskEvaluator: risk.NewEvaluator(testRiskLocator("CH"), 10, 1000),
+				userID:        "userID",
+			},
+			res: res{
+				commands: []eventstore.Command{
+					session.NewRiskEvaluatedEvent(context.Background(), sessionAgg, "userID", testNow,
+						&domain.SessionRisk{Level: domain.SessionRiskLevelLow, Country: "CH"},
+						false, "device", nil,
+					),
+				},
+			},
+		},
+		{
+			name: "high risk, step up not forced",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(session.NewRiskOriginVerifiedEvent(context.Background(), &session.NewAggregate("otherSession", "instance1").Aggregate, "userID", testNow.Add(-time.Hour),
+							"other", "US", nil,
+						)),
+					),
+				),
+				riskEvaluator: risk.NewEvaluator(testRiskLocator("CH"), 10, 1000),
+				getLoginPolicy: func(ctx context.Context, orgID string) (*domain.LoginPolicy, error) {
+					return &domain.LoginPolicy{}, nil
+				},
+				userID: "userID",
+			},
+			res: res{
+				commands: []eventstore.Command{
+					session.NewRiskEvaluatedEvent(context.Background(), sessionAgg, "userID", testNow,
+						&domain.SessionRisk{Level: domain.SessionRiskLevelHigh, NewDevice: true, NewCountry: true, Country: "CH"},
+						false, "device", nil,
+					),
+				},
+			},
+		},
+		{
+			name: "high risk, step up forced",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(session.NewRiskOriginVerifiedEvent(context.Background(), &session.NewAggregate("otherSession", "instance1").Aggregate, "userID", testNow.Add(-time.Hour),
+							"other", "US", nil,
+						)),
+					),
+				),
+				riskEvaluator: risk.NewEvaluator(testRiskLocator("CH"), 10, 1000),
+				getLoginPolicy: func(ctx context.Context, orgID string) (*domain.LoginPolicy, error) {
+					assert.Equal(t, "org1", orgID)
+					return &domain.LoginPolicy{ForceMFAOnHighRisk: true}, nil
+				},
+				userID: "userID",
+			},
+			res: res{
+				commands: []eventstore.Command{
+					session.NewRiskEvaluatedEvent(context.Background(), sessionAgg, "userID", testNow,
+						&domain.SessionRisk{Level: domain.SessionRiskLevelHigh, NewDevice: true, NewCountry: true, Country: "CH"},
+						true, "device", nil,
+					),
+				},
+			},
+		},
+		{
+			name: "high risk, login policy error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(session.NewRiskOriginVerifiedEvent(context.Background(), &session.NewAggregate("otherSession", "instance1").Aggregate, "userID", testNow.Add(-time.Hour),
+							"other", "US", nil,
+						)),
+					),
+				),
+				riskEvaluator: risk.NewEvaluator(testRiskLocator("CH"), 10, 1000),
+				getLoginPolicy: func(ctx context.Context, orgID string) (*domain.LoginPolicy, error) {
+					return nil, zerrors.ThrowInternal(nil, "id", "policy failed")
+				},
+				userID: "userID",
+			},
+			res: res{
+				err: zerrors.ThrowInternal(nil, "id", "policy failed"),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cmds := &SessionCommands{
+				sessionWriteModel: &SessionWriteModel{
+					UserID:            tt.fields.userID,
+					UserResourceOwner: "org1",
+					UserAgent:         userAgent,
+					RiskEvaluatedAt:   tt.fields.riskEvaluatedAt,
+					aggregate:         sessionAgg,
+				},
+				eventstore:     tt.fields.eventstore(t),
+				riskEvaluator:  tt.fields.riskEvaluator,
+				getLoginPolicy: tt.fields.getLoginPolicy,
+				now: func() time.Time {
+					return testNow
+				},
+			}
+			err := cmds.EvaluateRisk(context.Background())
+			assert.ErrorIs(t, err, tt.res.err)
+			assert.Equal(t, tt.res.commands, cmds.eventCommands)
+		})
+	}
+}
+
+func TestSessionCommands_VerifyRiskOrigin(t *testing.T) {
+	sessionAgg := &session.NewAggregate("sessionID", "instance1").Aggregate
+	origin := &risk.Observation{DeviceID: "device", Country: "CH", At: testNow}
+	type fields struct {
+		riskEvaluator *risk.Evaluator
+		writeModel    *SessionWriteModel
+		riskOrigin    *risk.Observation
+		eventCommands []eventstore.Command
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		want   []eventstore.Command
+	}{
+		{
+			name: "evaluation disabled",
+			fields: fields{
+				writeModel: &SessionWriteModel{UserID: "userID", PasswordCheckedAt: testNow, RiskOrigin: origin},
+			},
+		},
+		{
+			name: "not evaluated",
+			fields: fields{
+				riskEvaluator: risk.NewEvaluator(testRiskLocator("CH"), 10, 1000),
+				writeModel:    &SessionWriteModel{UserID: "userID", PasswordCheckedAt: testNow},
+			},
+		},
+		{
+			name: "only user checked",
+			fields: fields{
+				riskEvaluator: risk.NewEvaluator(testRiskLocator("CH"), 10, 1000),
+				writeModel:    &SessionWriteModel{UserID: "userID", UserCheckedAt: testNow},
+				riskOrigin:    origin,
+				eventCommands: []eventstore.Command{
+					session.NewUserCheckedEvent(context.Background(), sessionAgg, "userID", "org1", testNow, nil),
+				},
+			},
+			want: []eventstore.Command{
+				session.NewUserCheckedEvent(context.Background(), sessionAgg, "userID", "org1", testNow, nil),
+			},
+		},
+		{
+			name: "second factor only",
+			fields: fields{
+				riskEvaluator: risk.NewEvaluator(testRiskLocator("CH"), 10, 1000),
+				writeModel:    &SessionWriteModel{UserID: "userID", TOTPCheckedAt: testNow, WebAuthNCheckedAt: testNow, RiskOrigin: origin},
+			},
+		},
+		{
+			name: "already verified",
+			fields: fields{
+				riskEvaluator: risk.NewEvaluator(testRiskLocator("CH"), 10, 1000),
+				writeModel:    &SessionWriteModel{UserID: "userID", PasswordCheckedAt: testNow, RiskOrigin: origin, RiskOriginVerified: true},
+			},
+		},
+		{
+			name: "password checked before",
+			fields: fields{
+				riskEvaluator: risk.NewEvaluator(testRiskLocator("CH"), 10, 1000),
+				writeModel:    &SessionWriteModel{UserID: "userID", PasswordCheckedAt: testNow, RiskOrigin: origin, aggregate: sessionAgg},
+			},
+			want: []eventstore.Command{
+				session.NewRiskOriginVerifiedEvent(context.Background(), sessionAgg, "userID", testNow, "device", "CH", nil),
+			},
+		},
+		{
+			name: "passkey checked with evaluation",
+			fields: fields{
+				riskEvaluator: risk.NewEvaluator(testRiskLocator("CH"), 10, 1000),
+				writeModel:    &SessionWriteModel{UserID: "userID", aggregate: sessionAgg},
+				riskOrigin:    origin,
+				eventCommands: []eventstore.Command{
+					session.NewWebAuthNCheckedEvent(context.Background(), sessionAgg, testNow, true),
+				},
+			},
+			want: []eventstore.Command{
+				session.NewWebAuthNCheckedEvent(context.Background(), sessionAgg, testNow, true),
+				session.NewRiskOriginVerifiedEvent(context.Background(), sessionAgg, "userID", testNow, "device", "CH", nil),
+			},
+		},
+		{
+			name: "intent checked",
+			fields: fields{
+				riskEvaluator: risk.NewEvaluator(testRiskLocator("CH"), 10, 1000),
+				writeModel:    &SessionWriteModel{UserID: "userID", RiskOrigin: origin, aggregate: sessionAgg},
+				eventCommands: []eventstore.Command{
+					session.NewIntentCheckedEvent(context.Background(), sessionAgg, testNow),
+				},
+			},
+			want: []eventstore.Command{
+				session.NewIntentCheckedEvent(context.Background(), sessionAgg, testNow),
+				session.NewRiskOriginVerifiedEvent(context.Background(), sessionAgg, "userID", testNow, "device", "CH", nil),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cmds := &SessionCommands{
+				sessionWriteModel: tt.fields.writeModel,
+				riskEvaluator:     tt.fields.riskEvaluator,
+				riskOrigin:        tt.fields.riskOrigin,
+				eventCommands:     tt.fields.eventCommands,
+			}
+			cmds.VerifyRiskOrigin(context.Background())
+			assert.Equal(t, tt.want, cmds.eventCommands)
+		})
+	}
+}
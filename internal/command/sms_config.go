@@ -5,6 +5,7 @@ import (
 
 	"github.com/zitadel/zitadel/internal/crypto"
 	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/notification/channels/httptemplate"
 	"github.com/zitadel/zitadel/internal/repository/instance"
 	"github.com/zitadel/zitadel/internal/zerrors"
 )
@@ -235,6 +236,206 @@ func (c *Commands) ChangeSMSConfigHTTP(ctx context.Context, config *ChangeSMSHTT
 	return nil
 }
 
+type AddSMSHTTPTemplate struct {
+	Details       *domain.ObjectDetails
+	ResourceOwner string
+	ID            string
+
+	Description        string
+	Method             string
+	URL                string
+	Headers            map[string]string
+	Body               string
+	SenderNumber       string
+	SuccessStatusCodes []int
+	SuccessBodyPattern string
+}
+
+func (c *Commands) AddSMSConfigHTTPTemplate(ctx context.Context, config *AddSMSHTTPTemplate) (err error) {
+	if config.ResourceOwner == "" {
+		return zerrors.ThrowInvalidArgument(nil, "COMMAND-Vb6aLr9Kx2", "Errors.ResourceOwnerMissing")
+	}
+	templateConfig := &httptemplate.Config{
+		Method:             config.Method,
+		URL:                config.URL,
+		Headers:            config.Headers,
+		Body:               config.Body,
+		SenderNumber:       config.SenderNumber,
+		SuccessStatusCodes: config.SuccessStatusCodes,
+		SuccessBodyPattern: config.SuccessBodyPattern,
+	}
+	if err := templateConfig.Validate(); err != nil {
+		return err
+	}
+	if config.ID == "" {
+		config.ID, err = c.idGenerator.Next()
+		if err != nil {
+			return err
+		}
+	}
+	smsConfigWriteModel, err := c.getSMSConfig(ctx, config.ResourceOwner, config.ID)
+	if err != nil {
+		return err
+	}
+
+	var headers *crypto.CryptoValue
+	if len(config.Headers) > 0 {
+		headers, err = crypto.EncryptJSON(config.Headers, c.smsEncryption)
+		if err != nil {
+			return err
+		}
+	}
+	err = c.pushAppendAndReduce(ctx,
+		smsConfigWriteModel,
+		instance.NewSMSConfigHTTPTemplateAddedEvent(
+			ctx,
+			InstanceAggregateFromWriteModel(&smsConfigWriteModel.WriteModel),
+			config.ID,
+			config.Description,
+			config.Method,
+			config.URL,
+			headers,
+			config.Body,
+			config.SenderNumber,
+			config.SuccessStatusCodes,
+			config.SuccessBodyPattern,
+		),
+	)
+	if err != nil {
+		return err
+	}
+	config.Details = writeModelToObjectDetails(&smsConfigWriteModel.WriteModel)
+	return nil
+}
+
+type ChangeSMSHTTPTemplate struct {
+	Details       *domain.ObjectDetails
+	ResourceOwner string
+	ID            string
+
+	Description  *string
+	Method       *string
+	URL          *string
+	Body         *string
+	SenderNumber *string
+	// SuccessStatusCodes are only changed if not nil
+	SuccessStatusCodes []int
+	SuccessBodyPattern *string
+}
+
+func (c *Commands) ChangeSMSConfigHTTPTemplate(ctx context.Context, config *ChangeSMSHTTPTemplate) (err error) {
+	if config.ResourceOwner == "" {
+		return zerrors.ThrowInvalidArgument(nil, "COMMAND-Wc7bMs0Ly3", "Errors.ResourceOwnerMissing")
+	}
+	if config.ID == "" {
+		return zerrors.ThrowInvalidArgument(nil, "COMMAND-Xd8cNt1Mz4", "Errors.IDMissing")
+	}
+	smsConfigWriteModel, err := c.getSMSConfig(ctx, config.ResourceOwner, config.ID)
+	if err != nil {
+		return err
+	}
+	if !smsConfigWriteModel.State.Exists() || smsConfigWriteModel.HTTPTemplate == nil {
+		return zerrors.ThrowNotFound(nil, "COMMAND-Ye9dOu2Na5", "Errors.SMSConfig.NotFound")
+	}
+	if err := config.validate(smsConfigWriteModel.HTTPTemplate); err != nil {
+		return err
+	}
+	changedEvent, hasChanged, err := smsConfigWriteModel.NewHTTPTemplateChangedEvent(
+		ctx,
+		InstanceAggregateFromWriteModel(&smsConfigWriteModel.WriteModel),
+		config.ID,
+		config.Description,
+		config.Method,
+		config.URL,
+		config.Body,
+		config.SenderNumber,
+		config.SuccessStatusCodes,
+		config.SuccessBodyPattern,
+	)
+	if err != nil {
+		return err
+	}
+	if !hasChanged {
+		config.Details = writeModelToObjectDetails(&smsConfigWriteModel.WriteModel)
+		return nil
+	}
+	err = c.pushAppendAndReduce(ctx, smsConfigWriteModel, changedEvent)
+	if err != nil {
+		return err
+	}
+	config.Details = writeModelToObjectDetails(&smsConfigWriteModel.WriteModel)
+	return nil
+}
+
+// validate checks the templates resulting from applying the changes to the existing configuration.
+// The headers are not changed and therefore not decrypted for the validation.
+func (config *ChangeSMSHTTPTemplate) validate(existing *HTTPTemplateConfig) error {
+	templateConfig := &httptemplate.Config{
+		Method:             existing.Method,
+		URL:                existing.URL,
+		Body:               existing.Body,
+		SenderNumber:       existing.SenderNumber,
+		SuccessStatusCodes: existing.SuccessStatusCodes,
+		SuccessBodyPattern: existing.SuccessBodyPattern,
+	}
+	if config.Method != nil {
+		templateConfig.Method = *config.Method
+	}
+	if config.URL != nil {
+		templateConfig.URL = *config.URL
+	}
+	if config.Body != nil {
+		templateConfig.Body = *config.Body
+	}
+	if config.SuccessStatusCodes != nil {
+		templateConfig.SuccessStatusCodes = config.SuccessStatusCodes
+	}
+	if config.SuccessBodyPattern != nil {
+		templateConfig.SuccessBodyPattern = *config.SuccessBodyPattern
+	}
+	return templateConfig.Validate()
+}
+
+func (c *Commands) ChangeSMSConfigHTTPTemplateHeaders(ctx context.Context, resourceOwner, id string, headers map[string]string) (*domain.ObjectDetails, error) {
+	if resourceOwner == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Zf0ePv3Ob6", "Errors.ResourceOwnerMissing")
+	}
+	if id == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Ag1fQw4Pc7", "Errors.IDMissing")
+	}
+	if err := httptemplate.ValidateHeaders(headers); err != nil {
+		return nil, err
+	}
+
+	smsConfigWriteModel, err := c.getSMSConfig(ctx, resourceOwner, id)
+	if err != nil {
+		return nil, err
+	}
+	if !smsConfigWriteModel.State.Exists() || smsConfigWriteModel.HTTPTemplate == nil {
+		return nil, zerrors.ThrowNotFound(nil, "COMMAND-Bh2gRx5Qd8", "Errors.SMSConfig.NotFound")
+	}
+	var encryptedHeaders *crypto.CryptoValue
+	if len(headers) > 0 {
+		encryptedHeaders, err = crypto.EncryptJSON(headers, c.smsEncryption)
+		if err != nil {
+			return nil, err
+		}
+	}
+	err = c.pushAppendAndReduce(ctx,
+		smsConfigWriteModel,
+		instance.NewSMSConfigHTTPTemplateHeadersChangedEvent(
+			ctx,
+			InstanceAggregateFromWriteModel(&smsConfigWriteModel.WriteModel),
+			id,
+			encryptedHeaders,
+		),
+	)
+	if err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&smsConfigWriteModel.WriteModel), nil
+}
+
 func (c *Commands) ActivateSMSConfig(ctx context.Context, resourceOwner, id string) (*domain.ObjectDetails, error) {
 	if resourceOwner == "" {
 		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-EFgoOg997V", "Errors.ResourceOwnerMissing")
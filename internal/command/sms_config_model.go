@@ -2,6 +2,7 @@ package command
 
 import (
 	"context"
+	"slices"
 
 	"github.com/zitadel/zitadel/internal/crypto"
 	"github.com/zitadel/zitadel/internal/domain"
@@ -12,11 +13,12 @@ import (
 type IAMSMSConfigWriteModel struct {
 	eventstore.WriteModel
 
-	ID          string
-	Description string
-	Twilio      *TwilioConfig
-	HTTP        *HTTPConfig
-	State       domain.SMSConfigState
+	ID           string
+	Description  string
+	Twilio       *TwilioConfig
+	HTTP         *HTTPConfig
+	HTTPTemplate *HTTPTemplateConfig
+	State        domain.SMSConfigState
 }
 
 type TwilioConfig struct {
@@ -30,6 +32,16 @@ type HTTPConfig struct {
 	Endpoint string
 }
 
+type HTTPTemplateConfig struct {
+	Method             string
+	URL                string
+	Headers            *crypto.CryptoValue
+	Body               string
+	SenderNumber       string
+	SuccessStatusCodes []int
+	SuccessBodyPattern string
+}
+
 func NewIAMSMSConfigWriteModel(instanceID, id string) *IAMSMSConfigWriteModel {
 	return &IAMSMSConfigWriteModel{
 		WriteModel: eventstore.WriteModel{
@@ -96,6 +108,51 @@ func (wm *IAMSMSConfigWriteModel) Reduce() error {
 			if e.Endpoint != nil {
 				wm.HTTP.Endpoint = *e.Endpoint
 			}
+		case *instance.SMSConfigHTTPTemplateAddedEvent:
+			if wm.ID != e.ID {
+				continue
+			}
+			wm.HTTPTemplate = &HTTPTemplateConfig{
+				Method:             e.Method,
+				URL:                e.URL,
+				Headers:            e.Headers,
+				Body:               e.Body,
+				SenderNumber:       e.SenderNumber,
+				SuccessStatusCodes: e.SuccessStatusCodes,
+				SuccessBodyPattern: e.SuccessBodyPattern,
+			}
+			wm.Description = e.Description
+			wm.State = domain.SMSConfigStateInactive
+		case *instance.SMSConfigHTTPTemplateChangedEvent:
+			if wm.ID != e.ID {
+				continue
+			}
+			if e.Description != nil {
+				wm.Description = *e.Description
+			}
+			if e.Method != nil {
+				wm.HTTPTemplate.Method = *e.Method
+			}
+			if e.URL != nil {
+				wm.HTTPTemplate.URL = *e.URL
+			}
+			if e.Body != nil {
+				wm.HTTPTemplate.Body = *e.Body
+			}
+			if e.SenderNumber != nil {
+				wm.HTTPTemplate.SenderNumber = *e.SenderNumber
+			}
+			if e.SuccessStatusCodes != nil {
+				wm.HTTPTemplate.SuccessStatusCodes = *e.SuccessStatusCodes
+			}
+			if e.SuccessBodyPattern != nil {
+				wm.HTTPTemplate.SuccessBodyPattern = *e.SuccessBodyPattern
+			}
+		case *instance.SMSConfigHTTPTemplateHeadersChangedEvent:
+			if wm.ID != e.ID {
+				continue
+			}
+			wm.HTTPTemplate.Headers = e.Headers
 		case *instance.SMSConfigTwilioActivatedEvent:
 			if wm.ID != e.ID {
 				wm.State = domain.SMSConfigStateInactive
@@ -113,6 +170,7 @@ func (wm *IAMSMSConfigWriteModel) Reduce() error {
 			}
 			wm.Twilio = nil
 			wm.HTTP = nil
+			wm.HTTPTemplate = nil
 			wm.State = domain.SMSConfigStateRemoved
 		case *instance.SMSConfigActivatedEvent:
 			if wm.ID != e.ID {
@@ -131,6 +189,7 @@ func (wm *IAMSMSConfigWriteModel) Reduce() error {
 			}
 			wm.Twilio = nil
 			wm.HTTP = nil
+			wm.HTTPTemplate = nil
 			wm.State = domain.SMSConfigStateRemoved
 		}
 	}
@@ -149,6 +208,9 @@ func (wm *IAMSMSConfigWriteModel) Query() *eventstore.SearchQueryBuilder {
 			instance.SMSConfigTwilioTokenChangedEventType,
 			instance.SMSConfigHTTPAddedEventType,
 			instance.SMSConfigHTTPChangedEventType,
+			instance.SMSConfigHTTPTemplateAddedEventType,
+			instance.SMSConfigHTTPTemplateChangedEventType,
+			instance.SMSConfigHTTPTemplateHeadersChangedEventType,
 			instance.SMSConfigTwilioActivatedEventType,
 			instance.SMSConfigTwilioDeactivatedEventType,
 			instance.SMSConfigTwilioRemovedEventType,
@@ -214,6 +276,46 @@ func (wm *IAMSMSConfigWriteModel) NewHTTPChangedEvent(ctx context.Context, aggre
 	return changeEvent, true, nil
 }
 
+func (wm *IAMSMSConfigWriteModel) NewHTTPTemplateChangedEvent(ctx context.Context, aggregate *eventstore.Aggregate, id string, description, method, url, body, senderNumber *string, successStatusCodes []int, successBodyPattern *string) (*instance.SMSConfigHTTPTemplateChangedEvent, bool, error) {
+	changes := make([]instance.SMSConfigHTTPTemplateChanges, 0)
+	var err error
+
+	if wm.HTTPTemplate == nil {
+		return nil, false, nil
+	}
+
+	if description != nil && wm.Description != *description {
+		changes = append(changes, instance.ChangeSMSConfigHTTPTemplateDescription(*description))
+	}
+	if method != nil && wm.HTTPTemplate.Method != *method {
+		changes = append(changes, instance.ChangeSMSConfigHTTPTemplateMethod(*method))
+	}
+	if url != nil && wm.HTTPTemplate.URL != *url {
+		changes = append(changes, instance.ChangeSMSConfigHTTPTemplateURL(*url))
+	}
+	if body != nil && wm.HTTPTemplate.Body != *body {
+		changes = append(changes, instance.ChangeSMSConfigHTTPTemplateBody(*body))
+	}
+	if senderNumber != nil && wm.HTTPTemplate.SenderNumber != *senderNumber {
+		changes = append(changes, instance.ChangeSMSConfigHTTPTemplateSenderNumber(*senderNumber))
+	}
+	if successStatusCodes != nil && !slices.Equal(wm.HTTPTemplate.SuccessStatusCodes, successStatusCodes) {
+		changes = append(changes, instance.ChangeSMSConfigHTTPTemplateSuccessStatusCodes(successStatusCodes))
+	}
+	if successBodyPattern != nil && wm.HTTPTemplate.SuccessBodyPattern != *successBodyPattern {
+		changes = append(changes, instance.ChangeSMSConfigHTTPTemplateSuccessBodyPattern(*successBodyPattern))
+	}
+
+	if len(changes) == 0 {
+		return nil, false, nil
+	}
+	changeEvent, err := instance.NewSMSConfigHTTPTemplateChangedEvent(ctx, aggregate, id, changes)
+	if err != nil {
+		return nil, false, err
+	}
+	return changeEvent, true, nil
+}
+
 type IAMSMSLastActivatedConfigWriteModel struct {
 	eventstore.WriteModel
 
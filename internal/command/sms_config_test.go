@@ -553,6 +553,416 @@ func TestCommandSide_ChangeSMSConfigHTTP(t *testing.T) {
 	}
 }
 
+func TestCommandSide_AddSMSConfigHTTPTemplate(t *testing.T) {
+	type fields struct {
+		eventstore  func(t *testing.T) *eventstore.Eventstore
+		idGenerator id.Generator
+		alg         crypto.EncryptionAlgorithm
+	}
+	type args struct {
+		ctx  context.Context
+		http *AddSMSHTTPTemplate
+	}
+	type res struct {
+		want *domain.ObjectDetails
+		err  func(error) bool
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			name: "add sms config http template, resource owner missing",
+			fields: fields{
+				eventstore: expectEventstore(),
+			},
+			args: args{
+				ctx:  context.Background(),
+				http: &AddSMSHTTPTemplate{},
+			},
+			res: res{
+				err: func(err error) bool {
+					return errors.Is(err, zerrors.ThrowInvalidArgument(nil, "COMMAND-Vb6aLr9Kx2", "Errors.ResourceOwnerMissing"))
+				},
+			},
+		},
+		{
+			name: "add sms config http template, invalid template",
+			fields: fields{
+				eventstore: expectEventstore(),
+			},
+			args: args{
+				ctx: context.Background(),
+				http: &AddSMSHTTPTemplate{
+					ResourceOwner: "INSTANCE",
+					URL:           "https://gateway.example.com",
+					Body:          "{{.Content",
+				},
+			},
+			res: res{
+				err: zerrors.IsErrorInvalidArgument,
+			},
+		},
+		{
+			name: "add sms config http template, ok",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(),
+					expectPush(
+						instance.NewSMSConfigHTTPTemplateAddedEvent(
+							context.Background(),
+							&instance.NewAggregate("INSTANCE").Aggregate,
+							"providerid",
+							"description",
+							"POST",
+							"https://gateway.example.com",
+							&crypto.CryptoValue{
+								CryptoType: crypto.TypeEncryption,
+								Algorithm:  "enc",
+								KeyID:      "id",
+								Crypted:    []byte(`{"X-Api-Key":"key"}`),
+							},
+							"text={{urlquery .Content}}",
+							"sender",
+							[]int{200},
+							"sent",
+						),
+					),
+				),
+				idGenerator: id_mock.NewIDGeneratorExpectIDs(t, "providerid"),
+				alg:         crypto.CreateMockEncryptionAlg(gomock.NewController(t)),
+			},
+			args: args{
+				ctx: context.Background(),
+				http: &AddSMSHTTPTemplate{
+					ResourceOwner:      "INSTANCE",
+					Description:        "description",
+					Method:             "POST",
+					URL:                "https://gateway.example.com",
+					Headers:            map[string]string{"X-Api-Key": "key"},
+					Body:               "text={{urlquery .Content}}",
+					SenderNumber:       "sender",
+					SuccessStatusCodes: []int{200},
+					SuccessBodyPattern: "sent",
+				},
+			},
+			res: res{
+				want: &domain.ObjectDetails{
+					ResourceOwner: "INSTANCE",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &Commands{
+				eventstore:    tt.fields.eventstore(t),
+				idGenerator:   tt.fields.idGenerator,
+				smsEncryption: tt.fields.alg,
+			}
+			err := r.AddSMSConfigHTTPTemplate(tt.args.ctx, tt.args.http)
+			if tt.res.err == nil {
+				assert.NoError(t, err)
+			}
+			if tt.res.err != nil && !tt.res.err(err) {
+				t.Errorf("got wrong err: %v ", err)
+			}
+			if tt.res.err == nil {
+				assertObjectDetails(t, tt.res.want, tt.args.http.Details)
+			}
+		})
+	}
+}
+
+func TestCommandSide_ChangeSMSConfigHTTPTemplate(t *testing.T) {
+	type fields struct {
+		eventstore func(*testing.T) *eventstore.Eventstore
+	}
+	type args struct {
+		ctx  context.Context
+		http *ChangeSMSHTTPTemplate
+	}
+	type res struct {
+		want *domain.ObjectDetails
+		err  func(error) bool
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			name: "resourceowner empty, precondition error",
+			fields: fields{
+				eventstore: expectEventstore(),
+			},
+			args: args{
+				ctx:  context.Background(),
+				http: &ChangeSMSHTTPTemplate{},
+			},
+			res: res{
+				err: func(err error) bool {
+					return errors.Is(err, zerrors.ThrowInvalidArgument(nil, "COMMAND-Wc7bMs0Ly3", "Errors.ResourceOwnerMissing"))
+				},
+			},
+		},
+		{
+			name: "id empty, precondition error",
+			fields: fields{
+				eventstore: expectEventstore(),
+			},
+			args: args{
+				ctx: context.Background(),
+				http: &ChangeSMSHTTPTemplate{
+					ResourceOwner: "INSTANCE",
+				},
+			},
+			res: res{
+				err: func(err error) bool {
+					return errors.Is(err, zerrors.ThrowInvalidArgument(nil, "COMMAND-Xd8cNt1Mz4", "Errors.IDMissing"))
+				},
+			},
+		},
+		{
+			name: "sms not existing, not found error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(),
+				),
+			},
+			args: args{
+				ctx: context.Background(),
+				http: &ChangeSMSHTTPTemplate{
+					ResourceOwner: "INSTANCE",
+					ID:            "id",
+				},
+			},
+			res: res{
+				err: func(err error) bool {
+					return errors.Is(err, zerrors.ThrowNotFound(nil, "COMMAND-Ye9dOu2Na5", "Errors.SMSConfig.NotFound"))
+				},
+			},
+		},
+		{
+			name: "invalid pattern, invalid argument error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							newSMSConfigHTTPTemplateAddedEvent(context.Background(), "providerid"),
+						),
+					),
+				),
+			},
+			args: args{
+				ctx: context.Background(),
+				http: &ChangeSMSHTTPTemplate{
+					ResourceOwner:      "INSTANCE",
+					ID:                 "providerid",
+					SuccessBodyPattern: gu.Ptr("("),
+				},
+			},
+			res: res{
+				err: zerrors.IsErrorInvalidArgument,
+			},
+		},
+		{
+			name: "no changes",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							newSMSConfigHTTPTemplateAddedEvent(context.Background(), "providerid"),
+						),
+					),
+				),
+			},
+			args: args{
+				ctx: context.Background(),
+				http: &ChangeSMSHTTPTemplate{
+					ResourceOwner:      "INSTANCE",
+					ID:                 "providerid",
+					URL:                gu.Ptr("https://gateway.example.com"),
+					SuccessStatusCodes: []int{200},
+				},
+			},
+			res: res{
+				want: &domain.ObjectDetails{
+					ResourceOwner: "INSTANCE",
+				},
+			},
+		},
+		{
+			name: "sms config http template change, ok",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							newSMSConfigHTTPTemplateAddedEvent(context.Background(), "providerid"),
+						),
+					),
+					expectPush(
+						newSMSConfigHTTPTemplateChangedEvent(
+							context.Background(),
+							"providerid",
+							instance.ChangeSMSConfigHTTPTemplateDescription("description2"),
+							instance.ChangeSMSConfigHTTPTemplateURL("https://gateway2.example.com"),
+							instance.ChangeSMSConfigHTTPTemplateSuccessStatusCodes([]int{}),
+						),
+					),
+				),
+			},
+			args: args{
+				ctx: context.Background(),
+				http: &ChangeSMSHTTPTemplate{
+					ResourceOwner:      "INSTANCE",
+					ID:                 "providerid",
+					Description:        gu.Ptr("description2"),
+					URL:                gu.Ptr("https://gateway2.example.com"),
+					SuccessStatusCodes: []int{},
+				},
+			},
+			res: res{
+				want: &domain.ObjectDetails{
+					ResourceOwner: "INSTANCE",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &Commands{
+				eventstore: tt.fields.eventstore(t),
+			}
+			err := r.ChangeSMSConfigHTTPTemplate(tt.args.ctx, tt.args.http)
+			if tt.res.err == nil {
+				assert.NoError(t, err)
+			}
+			if tt.res.err != nil && !tt.res.err(err) {
+				t.Errorf("got wrong err: %v ", err)
+			}
+			if tt.res.err == nil {
+				assertObjectDetails(t, tt.res.want, tt.args.http.Details)
+			}
+		})
+	}
+}
+
+func TestCommandSide_ChangeSMSConfigHTTPTemplateHeaders(t *testing.T) {
+	type fields struct {
+		eventstore func(*testing.T) *eventstore.Eventstore
+		alg        crypto.EncryptionAlgorithm
+	}
+	type args struct {
+		ctx           context.Context
+		resourceOwner string
+		id            string
+		headers       map[string]string
+	}
+	type res struct {
+		want *domain.ObjectDetails
+		err  func(error) bool
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			name: "invalid header template, invalid argument error",
+			fields: fields{
+				eventstore: expectEventstore(),
+			},
+			args: args{
+				ctx:           context.Background(),
+				resourceOwner: "INSTANCE",
+				id:            "providerid",
+				headers:       map[string]string{"X-Api-Key": "{{"},
+			},
+			res: res{
+				err: zerrors.IsErrorInvalidArgument,
+			},
+		},
+		{
+			name: "sms not existing, not found error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(),
+				),
+			},
+			args: args{
+				ctx:           context.Background(),
+				resourceOwner: "INSTANCE",
+				id:            "providerid",
+			},
+			res: res{
+				err: func(err error) bool {
+					return errors.Is(err, zerrors.ThrowNotFound(nil, "COMMAND-Bh2gRx5Qd8", "Errors.SMSConfig.NotFound"))
+				},
+			},
+		},
+		{
+			name: "headers changed, ok",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							newSMSConfigHTTPTemplateAddedEvent(context.Background(), "providerid"),
+						),
+					),
+					expectPush(
+						instance.NewSMSConfigHTTPTemplateHeadersChangedEvent(
+							context.Background(),
+							&instance.NewAggregate("INSTANCE").Aggregate,
+							"providerid",
+							&crypto.CryptoValue{
+								CryptoType: crypto.TypeEncryption,
+								Algorithm:  "enc",
+								KeyID:      "id",
+								Crypted:    []byte(`{"X-Api-Key":"key2"}`),
+							},
+						),
+					),
+				),
+				alg: crypto.CreateMockEncryptionAlg(gomock.NewController(t)),
+			},
+			args: args{
+				ctx:           context.Background(),
+				resourceOwner: "INSTANCE",
+				id:            "providerid",
+				headers:       map[string]string{"X-Api-Key": "key2"},
+			},
+			res: res{
+				want: &domain.ObjectDetails{
+					ResourceOwner: "INSTANCE",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &Commands{
+				eventstore:    tt.fields.eventstore(t),
+				smsEncryption: tt.fields.alg,
+			}
+			got, err := r.ChangeSMSConfigHTTPTemplateHeaders(tt.args.ctx, tt.args.resourceOwner, tt.args.id, tt.args.headers)
+			if tt.res.err == nil {
+				assert.NoError(t, err)
+			}
+			if tt.res.err != nil && !tt.res.err(err) {
+				t.Errorf("got wrong err: %v ", err)
+			}
+			if tt.res.err == nil {
+				assertObjectDetails(t, tt.res.want, got)
+			}
+		})
+	}
+}
+
 func TestCommandSide_ActivateSMSConfig(t *testing.T) {
 	type fields struct {
 		eventstore func(*testing.T) *eventstore.Eventstore
@@ -1153,3 +1563,27 @@ func newSMSConfigHTTPChangedEvent(ctx context.Context, id, endpoint, description
 	)
 	return event
 }
+
+func newSMSConfigHTTPTemplateAddedEvent(ctx context.Context, id string) *instance.SMSConfigHTTPTemplateAddedEvent {
+	return instance.NewSMSConfigHTTPTemplateAddedEvent(ctx,
+		&instance.NewAggregate("INSTANCE").Aggregate,
+		id,
+		"description",
+		"POST",
+		"https://gateway.example.com",
+		nil,
+		"text={{urlquery .Content}}",
+		"sender",
+		[]int{200},
+		"",
+	)
+}
+
+func newSMSConfigHTTPTemplateChangedEvent(ctx context.Context, id string, changes ...instance.SMSConfigHTTPTemplateChanges) *instance.SMSConfigHTTPTemplateChangedEvent {
+	event, _ := instance.NewSMSConfigHTTPTemplateChangedEvent(ctx,
+		&instance.NewAggregate("INSTANCE").Aggregate,
+		id,
+		changes,
+	)
+	return event
+}
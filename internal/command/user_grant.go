@@ -35,6 +35,35 @@ func (c *Commands) AddUserGrant(ctx context.Context, usergrant *domain.UserGrant
 	return userGrantWriteModelToUserGrant(addedUserGrant), nil
 }
 
+// AddUserGrants checks the preconditions of all user grants before they are added in one push,
+// so either all or none of the user grants are added.
+func (c *Commands) AddUserGrants(ctx context.Context, userGrants []*domain.UserGrant, resourceOwner string) (_ []*domain.UserGrant, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	cmds := make([]eventstore.Command, len(userGrants))
+	writeModels := make([]*UserGrantWriteModel, len(userGrants))
+	for i, userGrant := range userGrants {
+		cmds[i], writeModels[i], err = c.addUserGrant(ctx, userGrant, resourceOwner)
+		if err != nil {
+			return nil, err
+		}
+	}
+	pushedEvents, err := c.eventstore.Push(ctx, cmds...)
+	if err != nil {
+		return nil, err
+	}
+
+	addedUserGrants := make([]*domain.UserGrant, len(writeModels))
+	for i, writeModel := range writeModels {
+		if err = AppendAndReduce(writeModel, pushedEvents[i]); err != nil {
+			return nil, err
+		}
+		addedUserGrants[i] = userGrantWriteModelToUserGrant(writeModel)
+	}
+	return addedUserGrants, nil
+}
+
 func (c *Commands) addUserGrant(ctx context.Context, userGrant *domain.UserGrant, resourceOwner string) (command eventstore.Command, _ *UserGrantWriteModel, err error) {
 	if !userGrant.IsValid() {
 		return nil, nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-kVfMa", "Errors.UserGrant.Invalid")
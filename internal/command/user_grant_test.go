@@ -570,6 +570,202 @@ func TestCommandSide_AddUserGrant(t *testing.T) {
 	}
 }
 
+func TestCommandSide_AddUserGrants(t *testing.T) {
+	type fields struct {
+		eventstore  func(t *testing.T) *eventstore.Eventstore
+		idGenerator id.Generator
+	}
+	type args struct {
+		ctx           context.Context
+		userGrants    []*domain.UserGrant
+		resourceOwner string
+	}
+	type res struct {
+		want []*domain.UserGrant
+		err  func(error) bool
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			name: "second user not existing, precondition error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							user.NewHumanAddedEvent(context.Background(),
+								&user.NewAggregate("user1", "org1").Aggregate,
+								"username1",
+								"firstname1",
+								"lastname1",
+								"nickname1",
+								"displayname1",
+								language.German,
+								domain.GenderMale,
+								"email1",
+								true,
+							),
+						),
+						eventFromEventPusher(
+							project.NewProjectAddedEvent(context.Background(),
+								&project.NewAggregate("project1", "org1").Aggregate,
+								"projectname1", true, true, true,
+								domain.PrivateLabelingSettingUnspecified,
+							),
+						),
+					),
+					expectFilter(),
+				),
+				idGenerator: id_mock.NewIDGeneratorExpectIDs(t, "usergrant1"),
+			},
+			args: args{
+				ctx: authz.NewMockContextWithPermissions("", "", "", []string{domain.RoleProjectOwner}),
+				userGrants: []*domain.UserGrant{
+					{
+						UserID:    "user1",
+						ProjectID: "project1",
+					},
+					{
+						UserID:    "user2",
+						ProjectID: "project1",
+					},
+				},
+				resourceOwner: "org1",
+			},
+			res: res{
+				err: zerrors.IsPreconditionFailed,
+			},
+		},
+		{
+			name: "usergrants for project, ok",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							user.NewHumanAddedEvent(context.Background(),
+								&user.NewAggregate("user1", "org1").Aggregate,
+								"username1",
+								"firstname1",
+								"lastname1",
+								"nickname1",
+								"displayname1",
+								language.German,
+								domain.GenderMale,
+								"email1",
+								true,
+							),
+						),
+						eventFromEventPusher(
+							project.NewProjectAddedEvent(context.Background(),
+								&project.NewAggregate("project1", "org1").Aggregate,
+								"projectname1", true, true, true,
+								domain.PrivateLabelingSettingUnspecified,
+							),
+						),
+					),
+					expectFilter(
+						eventFromEventPusher(
+							user.NewHumanAddedEvent(context.Background(),
+								&user.NewAggregate("user2", "org1").Aggregate,
+								"username2",
+								"firstname2",
+								"lastname2",
+								"nickname2",
+								"displayname2",
+								language.German,
+								domain.GenderMale,
+								"email2",
+								true,
+							),
+						),
+						eventFromEventPusher(
+							project.NewProjectAddedEvent(context.Background(),
+								&project.NewAggregate("project1", "org1").Aggregate,
+								"projectname1", true, true, true,
+								domain.PrivateLabelingSettingUnspecified,
+							),
+						),
+					),
+					expectPush(
+						usergrant.NewUserGrantAddedEvent(context.Background(),
+							&usergrant.NewAggregate("usergrant1", "org1").Aggregate,
+							"user1",
+							"project1",
+							"",
+							nil,
+						),
+						usergrant.NewUserGrantAddedEvent(context.Background(),
+							&usergrant.NewAggregate("usergrant2", "org1").Aggregate,
+							"user2",
+							"project1",
+							"",
+							nil,
+						),
+					),
+				),
+				idGenerator: id_mock.NewIDGeneratorExpectIDs(t, "usergrant1", "usergrant2"),
+			},
+			args: args{
+				ctx: authz.NewMockContextWithPermissions("", "", "", []string{domain.RoleProjectOwner}),
+				userGrants: []*domain.UserGrant{
+					{
+						UserID:    "user1",
+						ProjectID: "project1",
+					},
+					{
+						UserID:    "user2",
+						ProjectID: "project1",
+					},
+				},
+				resourceOwner: "org1",
+			},
+			res: res{
+				want: []*domain.UserGrant{
+					{
+						ObjectRoot: models.ObjectRoot{
+							AggregateID:   "usergrant1",
+							ResourceOwner: "org1",
+						},
+						UserID:    "user1",
+						ProjectID: "project1",
+						State:     domain.UserGrantStateActive,
+					},
+					{
+						ObjectRoot: models.ObjectRoot{
+							AggregateID:   "usergrant2",
+							ResourceOwner: "org1",
+						},
+						UserID:    "user2",
+						ProjectID: "project1",
+						State:     domain.UserGrantStateActive,
+					},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &Commands{
+				eventstore:  tt.fields.eventstore(t),
+				idGenerator: tt.fields.idGenerator,
+			}
+			got, err := r.AddUserGrants(tt.args.ctx, tt.args.userGrants, tt.args.resourceOwner)
+			if tt.res.err == nil {
+				assert.NoError(t, err)
+			}
+			if tt.res.err != nil && !tt.res.err(err) {
+				t.Errorf("got wrong err: %v ", err)
+			}
+			if tt.res.err == nil {
+				assert.Equal(t, tt.res.want, got)
+			}
+		})
+	}
+}
+
 func TestCommandSide_ChangeUserGrant(t *testing.T) {
 	type fields struct {
 		eventstore *eventstore.Eventstore
@@ -0,0 +1,374 @@
+package command
+
+import (
+	"context"
+	"io"
+	"time"
+
+	"github.com/zitadel/logging"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/crypto"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/user"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// accountRecoveryMaxCheckAttempts limits the wrong codes, which can be provided for an approved recovery,
+// before the user has to request a new recovery.
+const accountRecoveryMaxCheckAttempts = 5
+
+type RecoveryEmailSet struct {
+	*domain.ObjectDetails
+	PlainCode *string
+}
+
+type AccountRecoveryApproved struct {
+	*domain.ObjectDetails
+	PlainCode *string
+}
+
+// SetRecoveryEmail sets the secondary email address of a human user, which is used for account recovery,
+// generates a verification code and triggers a notification to the new address.
+// If returnCode is set, no notification is sent and the plain code is returned instead.
+// urlTmpl allows changing the target URL of the notification and must be a valid [tmpl.Template], if used.
+func (c *Commands) SetRecoveryEmail(ctx context.Context, userID, resourceOwner, email string, returnCode bool, urlTmpl string) (_ *RecoveryEmailSet, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if userID == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Ar1um", "Errors.User.UserIDMissing")
+	}
+	address := domain.EmailAddress(email).Normalize()
+	if err := address.Validate(); err != nil {
+		return nil, err
+	}
+	if urlTmpl != "" {
+		if err := domain.RenderConfirmURLTemplate(io.Discard, urlTmpl, userID, "code", "orgID"); err != nil {
+			return nil, err
+		}
+	}
+	existingUser, err := c.accountRecoveryUser(ctx, userID, resourceOwner)
+	if err != nil {
+		return nil, err
+	}
+	if err := c.checkPermissionUpdateUser(ctx, existingUser.ResourceOwner, existingUser.AggregateID); err != nil {
+		return nil, err
+	}
+	writeModel, err := c.accountRecoveryWriteModelByID(ctx, existingUser.AggregateID, existingUser.ResourceOwner)
+	if err != nil {
+		return nil, err
+	}
+	if writeModel.RecoveryEmail == address && writeModel.RecoveryEmailVerified {
+		return nil, zerrors.ThrowPreconditionFailed(nil, "COMMAND-Ar2nc", "Errors.User.RecoveryEmail.NotChanged")
+	}
+	code, err := c.newEncryptedCode(ctx, c.eventstore.Filter, domain.SecretGeneratorTypeVerifyEmailCode, c.userEncryption) //nolint:staticcheck
+	if err != nil {
+		return nil, err
+	}
+	userAgg := UserAggregateFromWriteModel(&existingUser.WriteModel)
+	err = c.pushAppendAndReduce(ctx, writeModel,
+		user.NewHumanRecoveryEmailChangedEvent(ctx, userAgg, address),
+		user.NewHumanRecoveryEmailCodeAddedEvent(ctx, userAgg, address, code.Crypted, code.Expiry, urlTmpl, returnCode),
+	)
+	if err != nil {
+		return nil, err
+	}
+	set := &RecoveryEmailSet{
+		ObjectDetails: writeModelToObjectDetails(&writeModel.WriteModel),
+	}
+	if returnCode {
+		set.PlainCode = &code.Plain
+	}
+	return set, nil
+}
+
+// VerifyRecoveryEmail verifies the recovery email of a user with the code sent to it.
+// A verified recovery email is required to request an account recovery.
+func (c *Commands) VerifyRecoveryEmail(ctx context.Context, userID, resourceOwner, code string) (_ *domain.ObjectDetails, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if userID == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Ar3um", "Errors.User.UserIDMissing")
+	}
+	if code == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Ar4ce", "Errors.User.Code.Empty")
+	}
+	writeModel, err := c.accountRecoveryWriteModelByID(ctx, userID, resourceOwner)
+	if err != nil {
+		return nil, err
+	}
+	if writeModel.RecoveryEmail == "" || writeModel.RecoveryEmailCode == nil {
+		return nil, zerrors.ThrowNotFound(nil, "COMMAND-Ar5nf", "Errors.User.Code.NotFound")
+	}
+	userAgg := UserAggregateFromWriteModel(&writeModel.WriteModel)
+	err = crypto.VerifyCode(writeModel.RecoveryEmailCodeDate, writeModel.RecoveryEmailCodeExpiry, writeModel.RecoveryEmailCode, code, c.userEncryption)
+	if err != nil {
+		_, err = c.eventstore.Push(ctx, user.NewHumanRecoveryEmailVerificationFailedEvent(ctx, userAgg))
+		logging.WithFields("userID", userAgg.ID).OnError(err).Error("NewHumanRecoveryEmailVerificationFailedEvent push failed")
+		return nil, zerrors.ThrowInvalidArgument(err, "COMMAND-Ar6ci", "Errors.User.Code.Invalid")
+	}
+	if err = c.pushAppendAndReduce(ctx, writeModel, user.NewHumanRecoveryEmailVerifiedEvent(ctx, userAgg)); err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&writeModel.WriteModel), nil
+}
+
+// RemoveRecoveryEmail removes the recovery email of a user, which disables the account recovery for the user.
+func (c *Commands) RemoveRecoveryEmail(ctx context.Context, userID, resourceOwner string) (_ *domain.ObjectDetails, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if userID == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Ar7um", "Errors.User.UserIDMissing")
+	}
+	writeModel, err := c.accountRecoveryWriteModelByID(ctx, userID, resourceOwner)
+	if err != nil {
+		return nil, err
+	}
+	if writeModel.RecoveryEmail == "" {
+		return nil, zerrors.ThrowNotFound(nil, "COMMAND-Ar8nf", "Errors.User.RecoveryEmail.NotFound")
+	}
+	if err := c.checkPermissionUpdateUser(ctx, writeModel.ResourceOwner, writeModel.AggregateID); err != nil {
+		return nil, err
+	}
+	err = c.pushAppendAndReduce(ctx, writeModel, user.NewHumanRecoveryEmailRemovedEvent(ctx, UserAggregateFromWriteModel(&writeModel.WriteModel)))
+	if err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&writeModel.WriteModel), nil
+}
+
+// RequestAccountRecovery creates a recovery request for a user, who lost access to all their authenticators.
+// The request does not require authentication, but the login policy must allow account recovery
+// and the user must have a verified recovery email. The request has to be approved by an administrator.
+func (c *Commands) RequestAccountRecovery(ctx context.Context, userID, reason string) (_ *domain.ObjectDetails, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if userID == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Ar9um", "Errors.User.UserIDMissing")
+	}
+	existingUser, err := c.accountRecoveryUser(ctx, userID, "")
+	if err != nil {
+		return nil, err
+	}
+	if err := c.checkAccountRecoveryAllowed(ctx, existingUser.ResourceOwner); err != nil {
+		return nil, err
+	}
+	writeModel, err := c.accountRecoveryWriteModelByID(ctx, existingUser.AggregateID, existingUser.ResourceOwner)
+	if err != nil {
+		return nil, err
+	}
+	if !writeModel.RecoveryEmailVerified {
+		return nil, zerrors.ThrowPreconditionFailed(nil, "COMMAND-Ar0nv", "Errors.User.RecoveryEmail.NotVerified")
+	}
+	if writeModel.IsPending(time.Now()) {
+		return nil, zerrors.ThrowPreconditionFailed(nil, "COMMAND-Ar1pe", "Errors.User.AccountRecovery.AlreadyPending")
+	}
+	err = c.pushAppendAndReduce(ctx, writeModel, user.NewHumanAccountRecoveryRequestedEvent(ctx, UserAggregateFromWriteModel(&writeModel.WriteModel), reason))
+	if err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&writeModel.WriteModel), nil
+}
+
+// ApproveAccountRecovery approves a pending recovery request of a user and issues a one-time code,
+// which is sent to the verified recovery email and allows the user to set a new password.
+// Users cannot approve their own request.
+// If returnCode is set, no notification is sent and the plain code is returned instead.
+// urlTmpl allows changing the target URL of the notification and must be a valid [tmpl.Template], if used.
+func (c *Commands) ApproveAccountRecovery(ctx context.Context, userID, resourceOwner string, returnCode bool, urlTmpl string) (_ *AccountRecoveryApproved, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if urlTmpl != "" {
+		if err := domain.RenderConfirmURLTemplate(io.Discard, urlTmpl, userID, "code", "orgID"); err != nil {
+			return nil, err
+		}
+	}
+	writeModel, err := c.pendingAccountRecovery(ctx, userID, resourceOwner)
+	if err != nil {
+		return nil, err
+	}
+	if err := c.checkAccountRecoveryAllowed(ctx, writeModel.ResourceOwner); err != nil {
+		return nil, err
+	}
+	if !writeModel.RecoveryEmailVerified {
+		return nil, zerrors.ThrowPreconditionFailed(nil, "COMMAND-Ar2nv", "Errors.User.RecoveryEmail.NotVerified")
+	}
+	code, err := c.newEncryptedCode(ctx, c.eventstore.Filter, domain.SecretGeneratorTypePasswordResetCode, c.userEncryption) //nolint:staticcheck
+	if err != nil {
+		return nil, err
+	}
+	err = c.pushAppendAndReduce(ctx, writeModel, user.NewHumanAccountRecoveryApprovedEvent(
+		ctx,
+		UserAggregateFromWriteModel(&writeModel.WriteModel),
+		writeModel.RecoveryEmail,
+		code.Crypted,
+		code.Expiry,
+		urlTmpl,
+		returnCode,
+	))
+	if err != nil {
+		return nil, err
+	}
+	approved := &AccountRecoveryApproved{
+		ObjectDetails: writeModelToObjectDetails(&writeModel.WriteModel),
+	}
+	if returnCode {
+		approved.PlainCode = &code.Plain
+	}
+	return approved, nil
+}
+
+// RejectAccountRecovery rejects a pending recovery request of a user.
+// Users cannot reject their own request.
+func (c *Commands) RejectAccountRecovery(ctx context.Context, userID, resourceOwner, reason string) (_ *domain.ObjectDetails, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	writeModel, err := c.pendingAccountRecovery(ctx, userID, resourceOwner)
+	if err != nil {
+		return nil, err
+	}
+	err = c.pushAppendAndReduce(ctx, writeModel, user.NewHumanAccountRecoveryRejectedEvent(ctx, UserAggregateFromWriteModel(&writeModel.WriteModel), reason))
+	if err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&writeModel.WriteModel), nil
+}
+
+// CompleteAccountRecovery sets the new password of a user with the code of an approved recovery
+// and removes all second factors and passkeys of the user, so they can be registered again.
+func (c *Commands) CompleteAccountRecovery(ctx context.Context, userID, resourceOwner, code, password, userAgentID string) (_ *domain.ObjectDetails, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if userID == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Ac1um", "Errors.User.UserIDMissing")
+	}
+	if code == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Ac2ce", "Errors.User.Code.Empty")
+	}
+	if password == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Ac3pw", "Errors.User.Password.Empty")
+	}
+	writeModel, err := c.accountRecoveryWriteModelByID(ctx, userID, resourceOwner)
+	if err != nil {
+		return nil, err
+	}
+	if writeModel.State != domain.AccountRecoveryStateApproved || writeModel.CheckFailedCount >= accountRecoveryMaxCheckAttempts {
+		return nil, zerrors.ThrowPreconditionFailed(nil, "COMMAND-Ac4na", "Errors.User.AccountRecovery.NotApproved")
+	}
+	userAgg := UserAggregateFromWriteModel(&writeModel.WriteModel)
+	err = crypto.VerifyCode(writeModel.CodeCreationDate, writeModel.CodeExpiry, writeModel.Code, code, c.userEncryption)
+	if err != nil {
+		_, err = c.eventstore.Push(ctx, user.NewHumanAccountRecoveryCheckFailedEvent(ctx, userAgg))
+		logging.WithFields("userID", userAgg.ID).OnError(err).Error("NewHumanAccountRecoveryCheckFailedEvent push failed")
+		return nil, zerrors.ThrowInvalidArgument(err, "COMMAND-Ac5ci", "Errors.User.Code.Invalid")
+	}
+	passwordWriteModel, err := c.passwordWriteModel(ctx, userID, writeModel.ResourceOwner)
+	if err != nil {
+		return nil, err
+	}
+	passwordCommand, err := c.setPasswordCommand(ctx, userAgg, passwordWriteModel.UserState, passwordWriteModel.PasswordHistory(), password, "", userAgentID, false, nil)
+	if err != nil {
+		return nil, err
+	}
+	secondFactors := NewHumanSecondFactorsWriteModel(userID, writeModel.ResourceOwner)
+	if err = c.eventstore.FilterToQueryReducer(ctx, secondFactors); err != nil {
+		return nil, err
+	}
+	cmds := append([]eventstore.Command{passwordCommand}, secondFactors.RemoveCommands(ctx, userAgg)...)
+	cmds = append(cmds, user.NewHumanAccountRecoveryCompletedEvent(ctx, userAgg))
+	if err = c.pushAppendAndReduce(ctx, writeModel, cmds...); err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&writeModel.WriteModel), nil
+}
+
+func (c *Commands) RecoveryEmailCodeSent(ctx context.Context, orgID, userID string) (err error) {
+	if userID == "" {
+		return zerrors.ThrowInvalidArgument(nil, "COMMAND-Ar8um", "Errors.User.UserIDMissing")
+	}
+	writeModel, err := c.accountRecoveryWriteModelByID(ctx, userID, orgID)
+	if err != nil {
+		return err
+	}
+	_, err = c.eventstore.Push(ctx, user.NewHumanRecoveryEmailCodeSentEvent(ctx, UserAggregateFromWriteModel(&writeModel.WriteModel)))
+	return err
+}
+
+func (c *Commands) AccountRecoveryCodeSent(ctx context.Context, orgID, userID string) (err error) {
+	if userID == "" {
+		return zerrors.ThrowInvalidArgument(nil, "COMMAND-Ac6um", "Errors.User.UserIDMissing")
+	}
+	writeModel, err := c.accountRecoveryWriteModelByID(ctx, userID, orgID)
+	if err != nil {
+		return err
+	}
+	_, err = c.eventstore.Push(ctx, user.NewHumanAccountRecoveryCodeSentEvent(ctx, UserAggregateFromWriteModel(&writeModel.WriteModel)))
+	return err
+}
+
+// pendingAccountRecovery returns the recovery of a user, which is waiting for a decision of an administrator.
+func (c *Commands) pendingAccountRecovery(ctx context.Context, userID, resourceOwner string) (*HumanAccountRecoveryWriteModel, error) {
+	if userID == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Ar0um", "Errors.User.UserIDMissing")
+	}
+	if userID == authz.GetCtxData(ctx).UserID {
+		return nil, zerrors.ThrowPermissionDenied(nil, "COMMAND-Ar1se", "Errors.User.AccountRecovery.SelfApproval")
+	}
+	writeModel, err := c.accountRecoveryWriteModelByID(ctx, userID, resourceOwner)
+	if err != nil {
+		return nil, err
+	}
+	if writeModel.State != domain.AccountRecoveryStateRequested {
+		return nil, zerrors.ThrowNotFound(nil, "COMMAND-Ar2nr", "Errors.User.AccountRecovery.NotRequested")
+	}
+	if err := c.checkPermission(ctx, domain.PermissionUserWrite, writeModel.ResourceOwner, userID); err != nil {
+		return nil, err
+	}
+	return writeModel, nil
+}
+
+func (c *Commands) accountRecoveryUser(ctx context.Context, userID, resourceOwner string) (*UserWriteModel, error) {
+	existingUser, err := userWriteModelByID(ctx, c.eventstore.Filter, userID, resourceOwner)
+	if err != nil {
+		return nil, err
+	}
+	if !isUserStateExists(existingUser.UserState) {
+		return nil, zerrors.ThrowNotFound(nil, "COMMAND-Ar3nf", "Errors.User.NotFound")
+	}
+	if existingUser.UserType != domain.UserTypeHuman {
+		return nil, zerrors.ThrowPreconditionFailed(nil, "COMMAND-Ar4hu", "Errors.User.NotHuman")
+	}
+	return existingUser, nil
+}
+
+func (c *Commands) checkAccountRecoveryAllowed(ctx context.Context, resourceOwner string) error {
+	policy, err := c.getOrgLoginPolicy(ctx, resourceOwner)
+	if err != nil {
+		return err
+	}
+	if !policy.AllowAccountRecovery {
+		return zerrors.ThrowPreconditionFailed(nil, "COMMAND-Ar5di", "Errors.User.AccountRecovery.Disabled")
+	}
+	return nil
+}
+
+func (c *Commands) accountRecoveryWriteModelByID(ctx context.Context, userID, resourceOwner string) (writeModel *HumanAccountRecoveryWriteModel, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	writeModel = NewHumanAccountRecoveryWriteModel(userID, resourceOwner)
+	err = c.eventstore.FilterToQueryReducer(ctx, writeModel)
+	if err != nil {
+		return nil, err
+	}
+	return writeModel, nil
+}
@@ -0,0 +1,247 @@
+package command
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	"github.com/zitadel/zitadel/internal/crypto"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/user"
+)
+
+type HumanAccountRecoveryWriteModel struct {
+	eventstore.WriteModel
+
+	RecoveryEmail           domain.EmailAddress
+	RecoveryEmailVerified   bool
+	RecoveryEmailCode       *crypto.CryptoValue
+	RecoveryEmailCodeDate   time.Time
+	RecoveryEmailCodeExpiry time.Duration
+
+	State            domain.AccountRecoveryState
+	Code             *crypto.CryptoValue
+	CodeCreationDate time.Time
+	CodeExpiry       time.Duration
+	CheckFailedCount uint64
+}
+
+func NewHumanAccountRecoveryWriteModel(userID, resourceOwner string) *HumanAccountRecoveryWriteModel {
+	return &HumanAccountRecoveryWriteModel{
+		WriteModel: eventstore.WriteModel{
+			AggregateID:   userID,
+			ResourceOwner: resourceOwner,
+		},
+	}
+}
+
+func (wm *HumanAccountRecoveryWriteModel) Reduce() error {
+	for _, event := range wm.Events {
+		switch e := event.(type) {
+		case *user.HumanRecoveryEmailChangedEvent:
+			wm.RecoveryEmail = e.EmailAddress
+			wm.RecoveryEmailVerified = false
+			wm.RecoveryEmailCode = nil
+		case *user.HumanRecoveryEmailCodeAddedEvent:
+			wm.RecoveryEmailCode = e.Code
+			wm.RecoveryEmailCodeDate = e.CreationDate()
+			wm.RecoveryEmailCodeExpiry = e.Expiry
+		case *user.HumanRecoveryEmailVerifiedEvent:
+			wm.RecoveryEmailVerified = true
+			wm.RecoveryEmailCode = nil
+		case *user.HumanRecoveryEmailRemovedEvent:
+			wm.RecoveryEmail = ""
+			wm.RecoveryEmailVerified = false
+			wm.RecoveryEmailCode = nil
+		case *user.HumanAccountRecoveryRequestedEvent:
+			wm.State = domain.AccountRecoveryStateRequested
+			wm.Code = nil
+		case *user.HumanAccountRecoveryApprovedEvent:
+			wm.State = domain.AccountRecoveryStateApproved
+			wm.Code = e.Code
+			wm.CodeCreationDate = e.CreationDate()
+			wm.CodeExpiry = e.Expiry
+			wm.CheckFailedCount = 0
+		case *user.HumanAccountRecoveryCheckFailedEvent:
+			wm.CheckFailedCount++
+		case *user.HumanAccountRecoveryRejectedEvent:
+			wm.State = domain.AccountRecoveryStateRejected
+			wm.Code = nil
+		case *user.HumanAccountRecoveryCompletedEvent:
+			wm.State = domain.AccountRecoveryStateCompleted
+			wm.Code = nil
+		case *user.UserRemovedEvent:
+			wm.RecoveryEmail = ""
+			wm.RecoveryEmailVerified = false
+			wm.RecoveryEmailCode = nil
+			wm.State = domain.AccountRecoveryStateUnspecified
+			wm.Code = nil
+		}
+	}
+	return wm.WriteModel.Reduce()
+}
+
+func (wm *HumanAccountRecoveryWriteModel) Query() *eventstore.SearchQueryBuilder {
+	query := eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		AddQuery().
+		AggregateTypes(user.AggregateType).
+		AggregateIDs(wm.AggregateID).
+		EventTypes(
+			user.HumanRecoveryEmailChangedType,
+			user.HumanRecoveryEmailCodeAddedType,
+			user.HumanRecoveryEmailVerifiedType,
+			user.HumanRecoveryEmailRemovedType,
+			user.HumanAccountRecoveryRequestedType,
+			user.HumanAccountRecoveryApprovedType,
+			user.HumanAccountRecoveryCheckFailedType,
+			user.HumanAccountRecoveryRejectedType,
+			user.HumanAccountRecoveryCompletedType,
+			user.UserRemovedType,
+		).
+		Builder()
+
+	if wm.ResourceOwner != "" {
+		query.ResourceOwner(wm.ResourceOwner)
+	}
+	return query
+}
+
+// IsPending returns if there is an open request waiting for approval
+// or an approval, which can still be completed.
+func (wm *HumanAccountRecoveryWriteModel) IsPending(now time.Time) bool {
+	switch wm.State {
+	case domain.AccountRecoveryStateRequested:
+		return true
+	case domain.AccountRecoveryStateApproved:
+		return wm.CheckFailedCount < accountRecoveryMaxCheckAttempts &&
+			now.Before(wm.CodeCreationDate.Add(wm.CodeExpiry))
+	default:
+		return false
+	}
+}
+
+// HumanSecondFactorsWriteModel collects all second factors and passkeys of a user,
+// so they can be removed at once when the account is recovered.
+type HumanSecondFactorsWriteModel struct {
+	eventstore.WriteModel
+
+	OTPAdded           bool
+	OTPSMSAdded        bool
+	OTPEmailAdded      bool
+	RecoveryCodesAdded bool
+	U2FTokenIDs        []string
+	PasswordlessIDs    []string
+	TrustedDeviceIDs   []string
+}
+
+func NewHumanSecondFactorsWriteModel(userID, resourceOwner string) *HumanSecondFactorsWriteModel {
+	return &HumanSecondFactorsWriteModel{
+		WriteModel: eventstore.WriteModel{
+			AggregateID:   userID,
+			ResourceOwner: resourceOwner,
+		},
+	}
+}
+
+func (wm *HumanSecondFactorsWriteModel) Reduce() error {
+	for _, event := range wm.Events {
+		switch e := event.(type) {
+		case *user.HumanOTPAddedEvent:
+			wm.OTPAdded = true
+		case *user.HumanOTPRemovedEvent:
+			wm.OTPAdded = false
+		case *user.HumanOTPSMSAddedEvent:
+			wm.OTPSMSAdded = true
+		case *user.HumanOTPSMSRemovedEvent, *user.HumanPhoneRemovedEvent:
+			wm.OTPSMSAdded = false
+		case *user.HumanOTPEmailAddedEvent:
+			wm.OTPEmailAdded = true
+		case *user.HumanOTPEmailRemovedEvent:
+			wm.OTPEmailAdded = false
+		case *user.HumanRecoveryCodesGeneratedEvent:
+			wm.RecoveryCodesAdded = true
+		case *user.HumanRecoveryCodesRemovedEvent:
+			wm.RecoveryCodesAdded = false
+		case *user.HumanU2FAddedEvent:
+			wm.U2FTokenIDs = append(wm.U2FTokenIDs, e.WebAuthNTokenID)
+		case *user.HumanU2FRemovedEvent:
+			wm.U2FTokenIDs = slices.DeleteFunc(wm.U2FTokenIDs, func(id string) bool { return id == e.WebAuthNTokenID })
+		case *user.HumanPasswordlessAddedEvent:
+			wm.PasswordlessIDs = append(wm.PasswordlessIDs, e.WebAuthNTokenID)
+		case *user.HumanPasswordlessRemovedEvent:
+			wm.PasswordlessIDs = slices.DeleteFunc(wm.PasswordlessIDs, func(id string) bool { return id == e.WebAuthNTokenID })
+		case *user.HumanTrustedDeviceAddedEvent:
+			wm.TrustedDeviceIDs = append(wm.TrustedDeviceIDs, e.DeviceID)
+		case *user.HumanTrustedDeviceRemovedEvent:
+			wm.TrustedDeviceIDs = slices.DeleteFunc(wm.TrustedDeviceIDs, func(id string) bool { return id == e.DeviceID })
+		case *user.UserRemovedEvent:
+			wm.OTPAdded = false
+			wm.OTPSMSAdded = false
+			wm.OTPEmailAdded = false
+			wm.RecoveryCodesAdded = false
+			wm.U2FTokenIDs = nil
+			wm.PasswordlessIDs = nil
+			wm.TrustedDeviceIDs = nil
+		}
+	}
+	return wm.WriteModel.Reduce()
+}
+
+func (wm *HumanSecondFactorsWriteModel) Query() *eventstore.SearchQueryBuilder {
+	query := eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		AddQuery().
+		AggregateTypes(user.AggregateType).
+		AggregateIDs(wm.AggregateID).
+		EventTypes(
+			user.HumanMFAOTPAddedType,
+			user.HumanMFAOTPRemovedType,
+			user.HumanOTPSMSAddedType,
+			user.HumanOTPSMSRemovedType,
+			user.HumanPhoneRemovedType,
+			user.HumanOTPEmailAddedType,
+			user.HumanOTPEmailRemovedType,
+			user.HumanRecoveryCodesGeneratedType,
+			user.HumanRecoveryCodesRemovedType,
+			user.HumanU2FTokenAddedType,
+			user.HumanU2FTokenRemovedType,
+			user.HumanPasswordlessTokenAddedType,
+			user.HumanPasswordlessTokenRemovedType,
+			user.HumanTrustedDeviceAddedType,
+			user.HumanTrustedDeviceRemovedType,
+			user.UserRemovedType,
+		).
+		Builder()
+
+	if wm.ResourceOwner != "" {
+		query.ResourceOwner(wm.ResourceOwner)
+	}
+	return query
+}
+
+// RemoveCommands returns the events to remove all second factors and passkeys of the user.
+func (wm *HumanSecondFactorsWriteModel) RemoveCommands(ctx context.Context, agg *eventstore.Aggregate) []eventstore.Command {
+	cmds := make([]eventstore.Command, 0, 4+len(wm.U2FTokenIDs)+len(wm.PasswordlessIDs)+len(wm.TrustedDeviceIDs))
+	if wm.OTPAdded {
+		cmds = append(cmds, user.NewHumanOTPRemovedEvent(ctx, agg))
+	}
+	if wm.OTPSMSAdded {
+		cmds = append(cmds, user.NewHumanOTPSMSRemovedEvent(ctx, agg))
+	}
+	if wm.OTPEmailAdded {
+		cmds = append(cmds, user.NewHumanOTPEmailRemovedEvent(ctx, agg))
+	}
+	if wm.RecoveryCodesAdded {
+		cmds = append(cmds, user.NewHumanRecoveryCodesRemovedEvent(ctx, agg))
+	}
+	for _, id := range wm.U2FTokenIDs {
+		cmds = append(cmds, user.NewHumanU2FRemovedEvent(ctx, agg, id))
+	}
+	for _, id := range wm.PasswordlessIDs {
+		cmds = append(cmds, user.NewHumanPasswordlessRemovedEvent(ctx, agg, id))
+	}
+	for _, id := range wm.TrustedDeviceIDs {
+		cmds = append(cmds, user.NewHumanTrustedDeviceRemovedEvent(ctx, agg, id))
+	}
+	return cmds
+}
@@ -0,0 +1,549 @@
+package command
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/muhlemmer/gu"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/crypto"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/org"
+	"github.com/zitadel/zitadel/internal/repository/user"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+var accountRecoveryTestCode = &crypto.CryptoValue{
+	CryptoType: crypto.TypeEncryption,
+	Algorithm:  "enc",
+	KeyID:      "id",
+	Crypted:    []byte("code"),
+}
+
+func accountRecoveryTestLoginPolicyAddedEvent(allowAccountRecovery bool) eventstore.Command {
+	return org.NewLoginPolicyAddedEvent(context.Background(),
+		&org.NewAggregate("org1").Aggregate,
+		true,
+		true,
+		true,
+		true,
+		true,
+		true,
+		true,
+		true,
+		false,
+		false,
+		domain.PasswordlessTypeAllowed,
+		"",
+		time.Hour*1,
+		time.Hour*2,
+		time.Hour*3,
+		time.Hour*4,
+		time.Hour*5,
+		false,
+		0,
+		allowAccountRecovery,
+		false,
+	)
+}
+
+func accountRecoveryTestVerifiedEmailEvents() []eventstore.Event {
+	userAgg := &user.NewAggregate("user1", "org1").Aggregate
+	return []eventstore.Event{
+		eventFromEventPusher(user.NewHumanRecoveryEmailChangedEvent(context.Background(), userAgg, "recovery@test.ch")),
+		eventFromEventPusher(user.NewHumanRecoveryEmailVerifiedEvent(context.Background(), userAgg)),
+	}
+}
+
+func TestCommands_SetRecoveryEmail(t *testing.T) {
+	type fields struct {
+		eventstore      func(*testing.T) *eventstore.Eventstore
+		checkPermission domain.PermissionCheck
+	}
+	type args struct {
+		userID string
+		email  string
+	}
+	type res struct {
+		want *RecoveryEmailSet
+		err  error
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			name: "missing user id",
+			fields: fields{
+				eventstore: expectEventstore(),
+			},
+			res: res{
+				err: zerrors.ThrowInvalidArgument(nil, "COMMAND-Ar1um", "Errors.User.UserIDMissing"),
+			},
+		},
+		{
+			name: "invalid email",
+			fields: fields{
+				eventstore: expectEventstore(),
+			},
+			args: args{
+				userID: "user1",
+				email:  "recovery",
+			},
+			res: res{
+				err: zerrors.ThrowInvalidArgument(nil, "EMAIL-599BI", "Errors.User.Email.Invalid"),
+			},
+		},
+		{
+			name: "user not found",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(),
+				),
+			},
+			args: args{
+				userID: "user1",
+				email:  "recovery@test.ch",
+			},
+			res: res{
+				err: zerrors.ThrowNotFound(nil, "COMMAND-Ar3nf", "Errors.User.NotFound"),
+			},
+		},
+		{
+			name: "already verified",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(trustedDeviceTestHumanAddedEvent()),
+					),
+					expectFilter(accountRecoveryTestVerifiedEmailEvents()...),
+				),
+				checkPermission: newMockPermissionCheckAllowed(),
+			},
+			args: args{
+				userID: "user1",
+				email:  "recovery@test.ch",
+			},
+			res: res{
+				err: zerrors.ThrowPreconditionFailed(nil, "COMMAND-Ar2nc", "Errors.User.RecoveryEmail.NotChanged"),
+			},
+		},
+		{
+			name: "set, ok",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(trustedDeviceTestHumanAddedEvent()),
+					),
+					expectFilter(),
+					expectPush(
+						user.NewHumanRecoveryEmailChangedEvent(context.Background(),
+							&user.NewAggregate("user1", "org1").Aggregate,
+							"recovery@test.ch",
+						),
+						user.NewHumanRecoveryEmailCodeAddedEvent(context.Background(),
+							&user.NewAggregate("user1", "org1").Aggregate,
+							"recovery@test.ch",
+							accountRecoveryTestCode,
+							time.Hour,
+							"",
+							true,
+						),
+					),
+				),
+				checkPermission: newMockPermissionCheckAllowed(),
+			},
+			args: args{
+				userID: "user1",
+				email:  " recovery@test.ch ",
+			},
+			res: res{
+				want: &RecoveryEmailSet{
+					ObjectDetails: &domain.ObjectDetails{
+						ResourceOwner: "org1",
+					},
+					PlainCode: gu.Ptr("code"),
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore:       tt.fields.eventstore(t),
+				checkPermission:  tt.fields.checkPermission,
+				newEncryptedCode: mockEncryptedCode("code", time.Hour),
+			}
+			got, err := c.SetRecoveryEmail(context.Background(), tt.args.userID, "org1", tt.args.email, true, "")
+			assert.ErrorIs(t, err, tt.res.err)
+			if tt.res.want != nil {
+				assertObjectDetails(t, tt.res.want.ObjectDetails, got.ObjectDetails)
+				assert.Equal(t, tt.res.want.PlainCode, got.PlainCode)
+			}
+		})
+	}
+}
+
+func TestCommands_VerifyRecoveryEmail(t *testing.T) {
+	userAgg := &user.NewAggregate("user1", "org1").Aggregate
+	tests := []struct {
+		name       string
+		eventstore func(*testing.T) *eventstore.Eventstore
+		code       string
+		want       *domain.ObjectDetails
+		err        error
+	}{
+		{
+			name:       "missing code",
+			eventstore: expectEventstore(),
+			err:        zerrors.ThrowInvalidArgument(nil, "COMMAND-Ar4ce", "Errors.User.Code.Empty"),
+		},
+		{
+			name: "no code",
+			eventstore: expectEventstore(
+				expectFilter(accountRecoveryTestVerifiedEmailEvents()...),
+			),
+			code: "code",
+			err:  zerrors.ThrowNotFound(nil, "COMMAND-Ar5nf", "Errors.User.Code.NotFound"),
+		},
+		{
+			name: "invalid code",
+			eventstore: expectEventstore(
+				expectFilter(
+					eventFromEventPusher(user.NewHumanRecoveryEmailChangedEvent(context.Background(), userAgg, "recovery@test.ch")),
+					eventFromEventPusherWithCreationDateNow(user.NewHumanRecoveryEmailCodeAddedEvent(context.Background(), userAgg, "recovery@test.ch", accountRecoveryTestCode, time.Hour, "", false)),
+				),
+				expectPush(
+					user.NewHumanRecoveryEmailVerificationFailedEvent(context.Background(), userAgg),
+				),
+			),
+			code: "wrong",
+			err:  zerrors.ThrowInvalidArgument(nil, "COMMAND-Ar6ci", "Errors.User.Code.Invalid"),
+		},
+		{
+			name: "verify, ok",
+			eventstore: expectEventstore(
+				expectFilter(
+					eventFromEventPusher(user.NewHumanRecoveryEmailChangedEvent(context.Background(), userAgg, "recovery@test.ch")),
+					eventFromEventPusherWithCreationDateNow(user.NewHumanRecoveryEmailCodeAddedEvent(context.Background(), userAgg, "recovery@test.ch", accountRecoveryTestCode, time.Hour, "", false)),
+				),
+				expectPush(
+					user.NewHumanRecoveryEmailVerifiedEvent(context.Background(), userAgg),
+				),
+			),
+			code: "code",
+			want: &domain.ObjectDetails{
+				ResourceOwner: "org1",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore:     tt.eventstore(t),
+				userEncryption: crypto.CreateMockEncryptionAlg(gomock.NewController(t)),
+			}
+			got, err := c.VerifyRecoveryEmail(context.Background(), "user1", "org1", tt.code)
+			assert.ErrorIs(t, err, tt.err)
+			if tt.want != nil {
+				assertObjectDetails(t, tt.want, got)
+			}
+		})
+	}
+}
+
+func TestCommands_RequestAccountRecovery(t *testing.T) {
+	userAgg := &user.NewAggregate("user1", "org1").Aggregate
+	tests := []struct {
+		name       string
+		eventstore func(*testing.T) *eventstore.Eventstore
+		want       *domain.ObjectDetails
+		err        error
+	}{
+		{
+			name: "recovery disabled",
+			eventstore: expectEventstore(
+				expectFilter(
+					eventFromEventPusher(trustedDeviceTestHumanAddedEvent()),
+				),
+				expectFilter(
+					eventFromEventPusher(accountRecoveryTestLoginPolicyAddedEvent(false)),
+				),
+			),
+			err: zerrors.ThrowPreconditionFailed(nil, "COMMAND-Ar5di", "Errors.User.AccountRecovery.Disabled"),
+		},
+		{
+			name: "recovery email not verified",
+			eventstore: expectEventstore(
+				expectFilter(
+					eventFromEventPusher(trustedDeviceTestHumanAddedEvent()),
+				),
+				expectFilter(
+					eventFromEventPusher(accountRecoveryTestLoginPolicyAddedEvent(true)),
+				),
+				expectFilter(
+					eventFromEventPusher(user.NewHumanRecoveryEmailChangedEvent(context.Background(), userAgg, "recovery@test.ch")),
+				),
+			),
+			err: zerrors.ThrowPreconditionFailed(nil, "COMMAND-Ar0nv", "Errors.User.RecoveryEmail.NotVerified"),
+		},
+		{
+			name: "already pending",
+			eventstore: expectEventstore(
+				expectFilter(
+					eventFromEventPusher(trustedDeviceTestHumanAddedEvent()),
+				),
+				expectFilter(
+					eventFromEventPusher(accountRecoveryTestLoginPolicyAddedEvent(true)),
+				),
+				expectFilter(append(accountRecoveryTestVerifiedEmailEvents(),
+					eventFromEventPusher(user.NewHumanAccountRecoveryRequestedEvent(context.Background(), userAgg, "")),
+				)...),
+			),
+			err: zerrors.ThrowPreconditionFailed(nil, "COMMAND-Ar1pe", "Errors.User.AccountRecovery.AlreadyPending"),
+		},
+		{
+			name: "request after rejection, ok",
+			eventstore: expectEventstore(
+				expectFilter(
+					eventFromEventPusher(trustedDeviceTestHumanAddedEvent()),
+				),
+				expectFilter(
+					eventFromEventPusher(accountRecoveryTestLoginPolicyAddedEvent(true)),
+				),
+				expectFilter(append(accountRecoveryTestVerifiedEmailEvents(),
+					eventFromEventPusher(user.NewHumanAccountRecoveryRequestedEvent(context.Background(), userAgg, "")),
+					eventFromEventPusher(user.NewHumanAccountRecoveryRejectedEvent(context.Background(), userAgg, "unknown")),
+				)...),
+				expectPush(
+					user.NewHumanAccountRecoveryRequestedEvent(context.Background(), userAgg, "lost my phone"),
+				),
+			),
+			want: &domain.ObjectDetails{
+				ResourceOwner: "org1",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore: tt.eventstore(t),
+			}
+			got, err := c.RequestAccountRecovery(context.Background(), "user1", "lost my phone")
+			assert.ErrorIs(t, err, tt.err)
+			if tt.want != nil {
+				assertObjectDetails(t, tt.want, got)
+			}
+		})
+	}
+}
+
+func TestCommands_ApproveAccountRecovery(t *testing.T) {
+	userAgg := &user.NewAggregate("user1", "org1").Aggregate
+	tests := []struct {
+		name            string
+		ctx             context.Context
+		eventstore      func(*testing.T) *eventstore.Eventstore
+		checkPermission domain.PermissionCheck
+		want            *AccountRecoveryApproved
+		err             error
+	}{
+		{
+			name:       "self approval",
+			ctx:        authz.NewMockContext("instance1", "org1", "user1"),
+			eventstore: expectEventstore(),
+			err:        zerrors.ThrowPermissionDenied(nil, "COMMAND-Ar1se", "Errors.User.AccountRecovery.SelfApproval"),
+		},
+		{
+			name: "not requested",
+			ctx:  authz.NewMockContext("instance1", "org1", "admin1"),
+			eventstore: expectEventstore(
+				expectFilter(accountRecoveryTestVerifiedEmailEvents()...),
+			),
+			err: zerrors.ThrowNotFound(nil, "COMMAND-Ar2nr", "Errors.User.AccountRecovery.NotRequested"),
+		},
+		{
+			name: "no permission",
+			ctx:  authz.NewMockContext("instance1", "org1", "admin1"),
+			eventstore: expectEventstore(
+				expectFilter(append(accountRecoveryTestVerifiedEmailEvents(),
+					eventFromEventPusher(user.NewHumanAccountRecoveryRequestedEvent(context.Background(), userAgg, "")),
+				)...),
+			),
+			checkPermission: newMockPermissionCheckNotAllowed(),
+			err:             zerrors.ThrowPermissionDenied(nil, "AUTHZ-HKJD33", "Errors.PermissionDenied"),
+		},
+		{
+			name: "approve, ok",
+			ctx:  authz.NewMockContext("instance1", "org1", "admin1"),
+			eventstore: expectEventstore(
+				expectFilter(append(accountRecoveryTestVerifiedEmailEvents(),
+					eventFromEventPusher(user.NewHumanAccountRecoveryRequestedEvent(context.Background(), userAgg, "")),
+				)...),
+				expectFilter(
+					eventFromEventPusher(accountRecoveryTestLoginPolicyAddedEvent(true)),
+				),
+				expectPush(
+					user.NewHumanAccountRecoveryApprovedEvent(authz.NewMockContext("instance1", "org1", "admin1"), userAgg, "recovery@test.ch", accountRecoveryTestCode, time.Hour, "", true),
+				),
+			),
+			checkPermission: newMockPermissionCheckAllowed(),
+			want: &AccountRecoveryApproved{
+				ObjectDetails: &domain.ObjectDetails{
+					ResourceOwner: "org1",
+				},
+				PlainCode: gu.Ptr("code"),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore:       tt.eventstore(t),
+				checkPermission:  tt.checkPermission,
+				newEncryptedCode: mockEncryptedCode("code", time.Hour),
+			}
+			got, err := c.ApproveAccountRecovery(tt.ctx, "user1", "org1", true, "")
+			assert.ErrorIs(t, err, tt.err)
+			if tt.want != nil {
+				assertObjectDetails(t, tt.want.ObjectDetails, got.ObjectDetails)
+				assert.Equal(t, tt.want.PlainCode, got.PlainCode)
+			}
+		})
+	}
+}
+
+func TestCommands_RejectAccountRecovery(t *testing.T) {
+	ctx := authz.NewMockContext("instance1", "org1", "admin1")
+	userAgg := &user.NewAggregate("user1", "org1").Aggregate
+	c := &Commands{
+		eventstore: expectEventstore(
+			expectFilter(append(accountRecoveryTestVerifiedEmailEvents(),
+				eventFromEventPusher(user.NewHumanAccountRecoveryRequestedEvent(context.Background(), userAgg, "")),
+			)...),
+			expectPush(
+				user.NewHumanAccountRecoveryRejectedEvent(ctx, userAgg, "identity not confirmed"),
+			),
+		)(t),
+		checkPermission: newMockPermissionCheckAllowed(),
+	}
+	got, err := c.RejectAccountRecovery(ctx, "user1", "org1", "identity not confirmed")
+	assert.NoError(t, err)
+	assertObjectDetails(t, &domain.ObjectDetails{ResourceOwner: "org1"}, got)
+}
+
+func TestCommands_CompleteAccountRecovery(t *testing.T) {
+	userAgg := &user.NewAggregate("user1", "org1").Aggregate
+	approvedEvents := func() []eventstore.Event {
+		return append(accountRecoveryTestVerifiedEmailEvents(),
+			eventFromEventPusher(user.NewHumanAccountRecoveryRequestedEvent(context.Background(), userAgg, "")),
+			eventFromEventPusherWithCreationDateNow(user.NewHumanAccountRecoveryApprovedEvent(context.Background(), userAgg, "recovery@test.ch", accountRecoveryTestCode, time.Hour, "", false)),
+		)
+	}
+	tests := []struct {
+		name       string
+		eventstore func(*testing.T) *eventstore.Eventstore
+		code       string
+		want       *domain.ObjectDetails
+		err        error
+	}{
+		{
+			name: "not approved",
+			eventstore: expectEventstore(
+				expectFilter(append(accountRecoveryTestVerifiedEmailEvents(),
+					eventFromEventPusher(user.NewHumanAccountRecoveryRequestedEvent(context.Background(), userAgg, "")),
+				)...),
+			),
+			code: "code",
+			err:  zerrors.ThrowPreconditionFailed(nil, "COMMAND-Ac4na", "Errors.User.AccountRecovery.NotApproved"),
+		},
+		{
+			name: "too many failed attempts",
+			eventstore: expectEventstore(
+				expectFilter(append(approvedEvents(),
+					eventFromEventPusher(user.NewHumanAccountRecoveryCheckFailedEvent(context.Background(), userAgg)),
+					eventFromEventPusher(user.NewHumanAccountRecoveryCheckFailedEvent(context.Background(), userAgg)),
+					eventFromEventPusher(user.NewHumanAccountRecoveryCheckFailedEvent(context.Background(), userAgg)),
+					eventFromEventPusher(user.NewHumanAccountRecoveryCheckFailedEvent(context.Background(), userAgg)),
+					eventFromEventPusher(user.NewHumanAccountRecoveryCheckFailedEvent(context.Background(), userAgg)),
+				)...),
+			),
+			code: "code",
+			err:  zerrors.ThrowPreconditionFailed(nil, "COMMAND-Ac4na", "Errors.User.AccountRecovery.NotApproved"),
+		},
+		{
+			name: "invalid code",
+			eventstore: expectEventstore(
+				expectFilter(approvedEvents()...),
+				expectPush(
+					user.NewHumanAccountRecoveryCheckFailedEvent(context.Background(), userAgg),
+				),
+			),
+			code: "wrong",
+			err:  zerrors.ThrowInvalidArgument(nil, "COMMAND-Ac5ci", "Errors.User.Code.Invalid"),
+		},
+		{
+			name: "complete, password set and second factors removed",
+			eventstore: expectEventstore(
+				expectFilter(approvedEvents()...),
+				expectFilter(
+					eventFromEventPusher(trustedDeviceTestHumanAddedEvent()),
+				),
+				expectFilter(
+					eventFromEventPusher(
+						org.NewPasswordComplexityPolicyAddedEvent(context.Background(),
+							&org.NewAggregate("org1").Aggregate,
+							1,
+							false,
+							false,
+							false,
+							false,
+							0,
+							false,
+							false,
+						),
+					),
+				),
+				expectFilter(
+					eventFromEventPusher(user.NewHumanOTPAddedEvent(context.Background(), userAgg, nil)),
+					eventFromEventPusher(user.NewHumanU2FAddedEvent(context.Background(), userAgg, "u2f1", "challenge", "rpID")),
+					eventFromEventPusher(user.NewHumanU2FAddedEvent(context.Background(), userAgg, "u2f2", "challenge", "rpID")),
+					eventFromEventPusher(user.NewHumanU2FRemovedEvent(context.Background(), userAgg, "u2f1")),
+					eventFromEventPusher(user.NewHumanTrustedDeviceAddedEvent(context.Background(), userAgg, "device1", "browser", "agent1", "hash", time.Hour)),
+				),
+				expectPush(
+					user.NewHumanPasswordChangedEvent(context.Background(), userAgg, "$plain$x$password", false, "agent1"),
+					user.NewHumanOTPRemovedEvent(context.Background(), userAgg),
+					user.NewHumanU2FRemovedEvent(context.Background(), userAgg, "u2f2"),
+					user.NewHumanTrustedDeviceRemovedEvent(context.Background(), userAgg, "device1"),
+					user.NewHumanAccountRecoveryCompletedEvent(context.Background(), userAgg),
+				),
+			),
+			code: "code",
+			want: &domain.ObjectDetails{
+				ResourceOwner: "org1",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore:         tt.eventstore(t),
+				userEncryption:     crypto.CreateMockEncryptionAlg(gomock.NewController(t)),
+				userPasswordHasher: mockPasswordHasher("x"),
+			}
+			got, err := c.CompleteAccountRecovery(context.Background(), "user1", "org1", tt.code, "password", "agent1")
+			assert.ErrorIs(t, err, tt.err)
+			if tt.want != nil {
+				assertObjectDetails(t, tt.want, got)
+			}
+		})
+	}
+}
@@ -2,6 +2,7 @@ package command
 
 import (
 	"context"
+	"strings"
 
 	"github.com/zitadel/logging"
 
@@ -91,7 +92,7 @@ func (c *Commands) VerifyHumanEmail(ctx context.Context, userID, code, resourceo
 		user.NewHumanEmailVerifiedEvent(ctx, userAgg),
 	}
 	if optionalPassword != "" {
-		passwordCommand, err := c.setPasswordCommand(ctx, userAgg, domain.UserStateActive, optionalPassword, "", optionalUserAgentID, false, nil)
+		passwordCommand, err := c.setPasswordCommand(ctx, userAgg, domain.UserStateActive, nil, optionalPassword, "", optionalUserAgentID, false, nil)
 		if err != nil {
 			return nil, err
 		}
@@ -161,6 +162,33 @@ func (c *Commands) HumanEmailVerificationCodeSent(ctx context.Context, orgID, us
 	return err
 }
 
+// MarkHumanEmailUndeliverable marks the current email address of the user as undeliverable,
+// e.g. because the email provider reported a permanent bounce or a spam complaint.
+// Reports for an address the user no longer uses are ignored.
+func (c *Commands) MarkHumanEmailUndeliverable(ctx context.Context, userID string, email domain.EmailAddress, reason domain.EmailUndeliverableReason, diagnostic string) (*domain.ObjectDetails, error) {
+	if userID == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Bnc1x", "Errors.IDMissing")
+	}
+	if !reason.Valid() {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Bnc2x", "Errors.User.Email.UndeliverableReasonInvalid")
+	}
+	existingEmail, err := c.emailWriteModel(ctx, userID, "")
+	if err != nil {
+		return nil, err
+	}
+	if existingEmail.UserState == domain.UserStateUnspecified || existingEmail.UserState == domain.UserStateDeleted {
+		return nil, zerrors.ThrowNotFound(nil, "COMMAND-Bnc3x", "Errors.User.Email.NotFound")
+	}
+	if existingEmail.Undeliverable || !strings.EqualFold(string(existingEmail.Email), string(email)) {
+		return writeModelToObjectDetails(&existingEmail.WriteModel), nil
+	}
+	userAgg := UserAggregateFromWriteModel(&existingEmail.WriteModel)
+	if err = c.pushAppendAndReduce(ctx, existingEmail, user.NewHumanEmailUndeliverableEvent(ctx, userAgg, existingEmail.Email, reason, diagnostic)); err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&existingEmail.WriteModel), nil
+}
+
 func (c *Commands) emailWriteModel(ctx context.Context, userID, resourceOwner string) (writeModel *HumanEmailWriteModel, err error) {
 	ctx, span := tracing.NewSpan(ctx)
 	defer func() { span.EndWithError(err) }()
@@ -21,6 +21,9 @@ type HumanEmailWriteModel struct {
 	CodeExpiry       time.Duration
 	AuthRequestID    string
 
+	// Undeliverable is set if the email provider reported the current email address as undeliverable
+	Undeliverable bool
+
 	UserState domain.UserState
 }
 
@@ -49,6 +52,7 @@ func (wm *HumanEmailWriteModel) Reduce() error {
 		case *user.HumanEmailChangedEvent:
 			wm.Email = e.EmailAddress
 			wm.IsEmailVerified = false
+			wm.Undeliverable = false
 			wm.Code = nil
 		case *user.HumanEmailCodeAddedEvent:
 			wm.Code = e.Code
@@ -57,7 +61,10 @@ func (wm *HumanEmailWriteModel) Reduce() error {
 			wm.AuthRequestID = e.AuthRequestID
 		case *user.HumanEmailVerifiedEvent:
 			wm.IsEmailVerified = true
+			wm.Undeliverable = false
 			wm.Code = nil
+		case *user.HumanEmailUndeliverableEvent:
+			wm.Undeliverable = true
 		case *user.UserRemovedEvent:
 			wm.UserState = domain.UserStateDeleted
 		}
@@ -84,6 +91,7 @@ func (wm *HumanEmailWriteModel) Query() *eventstore.SearchQueryBuilder {
 			user.HumanEmailCodeAddedType,
 			user.UserV1EmailVerifiedType,
 			user.HumanEmailVerifiedType,
+			user.HumanEmailUndeliverableType,
 			user.UserRemovedType).
 		Builder()
 
@@ -633,6 +633,9 @@ func TestCommandSide_VerifyHumanEmail(t *testing.T) {
 								false,
 								false,
 								false,
+								0,
+								false,
+								false,
 							),
 						),
 					),
@@ -1063,3 +1066,190 @@ func TestCommandSide_EmailVerificationCodeSent(t *testing.T) {
 		})
 	}
 }
+
+func TestCommandSide_MarkHumanEmailUndeliverable(t *testing.T) {
+	type fields struct {
+		eventstore func(*testing.T) *eventstore.Eventstore
+	}
+	type args struct {
+		ctx        context.Context
+		userID     string
+		email      domain.EmailAddress
+		reason     domain.EmailUndeliverableReason
+		diagnostic string
+	}
+	type res struct {
+		want *domain.ObjectDetails
+		err  func(error) bool
+	}
+	humanAddedEvent := func() eventstore.Command {
+		return user.NewHumanAddedEvent(context.Background(),
+			&user.NewAggregate("user1", "org1").Aggregate,
+			"username",
+			"firstname",
+			"lastname",
+			"nickname",
+			"displayname",
+			language.German,
+			domain.GenderUnspecified,
+			"email@test.ch",
+			true,
+		)
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			name: "userid missing, invalid argument error",
+			fields: fields{
+				eventstore: expectEventstore(),
+			},
+			args: args{
+				ctx:    context.Background(),
+				email:  "email@test.ch",
+				reason: domain.EmailUndeliverableReasonBounce,
+			},
+			res: res{
+				err: zerrors.IsErrorInvalidArgument,
+			},
+		},
+		{
+			name: "reason invalid, invalid argument error",
+			fields: fields{
+				eventstore: expectEventstore(),
+			},
+			args: args{
+				ctx:    context.Background(),
+				userID: "user1",
+				email:  "email@test.ch",
+				reason: domain.EmailUndeliverableReasonUnspecified,
+			},
+			res: res{
+				err: zerrors.IsErrorInvalidArgument,
+			},
+		},
+		{
+			name: "user not existing, not found error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(),
+				),
+			},
+			args: args{
+				ctx:    context.Background(),
+				userID: "user1",
+				email:  "email@test.ch",
+				reason: domain.EmailUndeliverableReasonBounce,
+			},
+			res: res{
+				err: zerrors.IsNotFound,
+			},
+		},
+		{
+			name: "email changed in the meantime, ignored",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(humanAddedEvent()),
+						eventFromEventPusher(
+							user.NewHumanEmailChangedEvent(context.Background(),
+								&user.NewAggregate("user1", "org1").Aggregate,
+								"email2@test.ch",
+							),
+						),
+					),
+				),
+			},
+			args: args{
+				ctx:    context.Background(),
+				userID: "user1",
+				email:  "email@test.ch",
+				reason: domain.EmailUndeliverableReasonBounce,
+			},
+			res: res{
+				want: &domain.ObjectDetails{
+					ResourceOwner: "org1",
+				},
+			},
+		},
+		{
+			name: "already undeliverable, ignored",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(humanAddedEvent()),
+						eventFromEventPusher(
+							user.NewHumanEmailUndeliverableEvent(context.Background(),
+								&user.NewAggregate("user1", "org1").Aggregate,
+								"email@test.ch",
+								domain.EmailUndeliverableReasonComplaint,
+								"",
+							),
+						),
+					),
+				),
+			},
+			args: args{
+				ctx:    context.Background(),
+				userID: "user1",
+				email:  "email@test.ch",
+				reason: domain.EmailUndeliverableReasonBounce,
+			},
+			res: res{
+				want: &domain.ObjectDetails{
+					ResourceOwner: "org1",
+				},
+			},
+		},
+		{
+			name: "mark undeliverable, ok",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(humanAddedEvent()),
+					),
+					expectPush(
+						user.NewHumanEmailUndeliverableEvent(context.Background(),
+							&user.NewAggregate("user1", "org1").Aggregate,
+							"email@test.ch",
+							domain.EmailUndeliverableReasonBounce,
+							"550 5.1.1 user unknown",
+						),
+					),
+				),
+			},
+			args: args{
+				ctx:        context.Background(),
+				userID:     "user1",
+				email:      "Email@Test.ch",
+				reason:     domain.EmailUndeliverableReasonBounce,
+				diagnostic: "550 5.1.1 user unknown",
+			},
+			res: res{
+				want: &domain.ObjectDetails{
+					ResourceOwner: "org1",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &Commands{
+				eventstore: tt.fields.eventstore(t),
+			}
+			got, err := r.MarkHumanEmailUndeliverable(tt.args.ctx, tt.args.userID, tt.args.email, tt.args.reason, tt.args.diagnostic)
+			if tt.res.err == nil {
+				assert.NoError(t, err)
+			}
+			if tt.res.err != nil && !tt.res.err(err) {
+				t.Errorf("got wrong err: %v ", err)
+			}
+			if tt.res.err == nil {
+				assertObjectDetails(t, tt.res.want, got)
+			}
+		})
+	}
+}
@@ -653,6 +653,9 @@ func TestCommandSide_VerifyInitCode(t *testing.T) {
 								false,
 								false,
 								false,
+								0,
+								false,
+								false,
 							),
 						),
 					),
@@ -729,6 +732,9 @@ func TestCommandSide_VerifyInitCode(t *testing.T) {
 								false,
 								false,
 								false,
+								0,
+								false,
+								false,
 							),
 						),
 					),
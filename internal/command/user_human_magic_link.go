@@ -0,0 +1,112 @@
+package command
+
+import (
+	"context"
+
+	"github.com/zitadel/logging"
+
+	"github.com/zitadel/zitadel/internal/crypto"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/repository/user"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// RequestMagicLink creates a single-use code, which is sent to the verified email of the user as login link.
+// The link is bound to the auth request and the user agent, which requested it.
+func (c *Commands) RequestMagicLink(ctx context.Context, userID, resourceOwner string, authRequest *domain.AuthRequest) (_ *domain.ObjectDetails, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if userID == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Ml8Ui", "Errors.User.UserIDMissing")
+	}
+	if authRequest == nil || authRequest.ID == "" || authRequest.AgentID == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Ml9Ar", "Errors.AuthRequest.NotExisting")
+	}
+	writeModel, err := c.humanMagicLinkWriteModel(ctx, userID, resourceOwner)
+	if err != nil {
+		return nil, err
+	}
+	policy, err := c.getOrgLoginPolicy(ctx, writeModel.ResourceOwner)
+	if err != nil {
+		return nil, err
+	}
+	if !policy.AllowMagicLink {
+		return nil, zerrors.ThrowPreconditionFailed(nil, "COMMAND-Ml0Di", "Errors.User.MagicLink.Disabled")
+	}
+	if !writeModel.IsEmailVerified {
+		return nil, zerrors.ThrowPreconditionFailed(nil, "COMMAND-Mla2Ev", "Errors.User.MagicLink.EmailNotVerified")
+	}
+	code, err := c.newEncryptedCodeWithDefault(ctx, c.eventstore.Filter, domain.SecretGeneratorTypeMagicLinkCode, c.userEncryption, c.defaultSecretGenerators.MagicLinkCode) //nolint:staticcheck
+	if err != nil {
+		return nil, err
+	}
+	err = c.pushAppendAndReduce(ctx, writeModel,
+		user.NewHumanMagicLinkCodeAddedEvent(ctx, UserAggregateFromWriteModel(&writeModel.WriteModel), code.Crypted, code.Expiry, authRequestDomainToAuthRequestInfo(authRequest)),
+	)
+	if err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&writeModel.WriteModel), nil
+}
+
+// HumanMagicLinkCodeSent marks the login link as sent to the user.
+func (c *Commands) HumanMagicLinkCodeSent(ctx context.Context, userID, resourceOwner string) (err error) {
+	writeModel, err := c.humanMagicLinkWriteModel(ctx, userID, resourceOwner)
+	if err != nil {
+		return err
+	}
+	if writeModel.Code == nil {
+		return zerrors.ThrowPreconditionFailed(nil, "COMMAND-Mlb4Nf", "Errors.User.Code.NotFound")
+	}
+	_, err = c.eventstore.Push(ctx, user.NewHumanMagicLinkCodeSentEvent(ctx, UserAggregateFromWriteModel(&writeModel.WriteModel)))
+	return err
+}
+
+// HumanCheckMagicLink checks the code of a login link.
+// The link must be opened for the same auth request and on the same user agent it was requested with.
+// Any check, successful or not, invalidates the code.
+func (c *Commands) HumanCheckMagicLink(ctx context.Context, userID, resourceOwner, code string, authRequest *domain.AuthRequest) (err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if code == "" {
+		return zerrors.ThrowInvalidArgument(nil, "COMMAND-Mlc5Co", "Errors.User.Code.Empty")
+	}
+	if authRequest == nil {
+		return zerrors.ThrowInvalidArgument(nil, "COMMAND-Mld6Ar", "Errors.AuthRequest.NotExisting")
+	}
+	writeModel, err := c.humanMagicLinkWriteModel(ctx, userID, resourceOwner)
+	if err != nil {
+		return err
+	}
+	if writeModel.Code == nil {
+		return zerrors.ThrowPreconditionFailed(nil, "COMMAND-Mle7Nf", "Errors.User.Code.NotFound")
+	}
+	userAgg := UserAggregateFromWriteModel(&writeModel.WriteModel)
+	info := authRequestDomainToAuthRequestInfo(authRequest)
+	if writeModel.AuthRequestID != authRequest.ID || writeModel.UserAgentID != authRequest.AgentID {
+		err = zerrors.ThrowPreconditionFailed(nil, "COMMAND-Mlf8Bm", "Errors.User.MagicLink.BindingMismatch")
+	} else {
+		err = crypto.VerifyCode(writeModel.CodeCreationDate, writeModel.CodeExpiry, writeModel.Code, code, c.userEncryption)
+	}
+	if err != nil {
+		_, pushErr := c.eventstore.Push(ctx, user.NewHumanMagicLinkCheckFailedEvent(ctx, userAgg, info))
+		logging.WithFields("userID", userID).OnError(pushErr).Error("magic link failure check push failed")
+		return err
+	}
+	_, err = c.eventstore.Push(ctx, user.NewHumanMagicLinkCheckSucceededEvent(ctx, userAgg, info))
+	return err
+}
+
+func (c *Commands) humanMagicLinkWriteModel(ctx context.Context, userID, resourceOwner string) (*HumanMagicLinkWriteModel, error) {
+	writeModel := NewHumanMagicLinkWriteModel(userID, resourceOwner)
+	if err := c.eventstore.FilterToQueryReducer(ctx, writeModel); err != nil {
+		return nil, err
+	}
+	if writeModel.UserState != domain.UserStateActive {
+		return nil, zerrors.ThrowPreconditionFailed(nil, "COMMAND-Mlg9Us", "Errors.User.NotFound")
+	}
+	return writeModel, nil
+}
@@ -0,0 +1,121 @@
+package command
+
+import (
+	"time"
+
+	"github.com/zitadel/zitadel/internal/crypto"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/user"
+)
+
+type HumanMagicLinkWriteModel struct {
+	eventstore.WriteModel
+
+	Email           domain.EmailAddress
+	IsEmailVerified bool
+
+	Code             *crypto.CryptoValue
+	CodeCreationDate time.Time
+	CodeExpiry       time.Duration
+	AuthRequestID    string
+	UserAgentID      string
+
+	UserState domain.UserState
+}
+
+func NewHumanMagicLinkWriteModel(userID, resourceOwner string) *HumanMagicLinkWriteModel {
+	return &HumanMagicLinkWriteModel{
+		WriteModel: eventstore.WriteModel{
+			AggregateID:   userID,
+			ResourceOwner: resourceOwner,
+		},
+	}
+}
+
+func (wm *HumanMagicLinkWriteModel) Reduce() error {
+	for _, event := range wm.Events {
+		switch e := event.(type) {
+		case *user.HumanAddedEvent:
+			wm.Email = e.EmailAddress
+			wm.UserState = domain.UserStateActive
+		case *user.HumanRegisteredEvent:
+			wm.Email = e.EmailAddress
+			wm.UserState = domain.UserStateActive
+		case *user.HumanInitialCodeAddedEvent:
+			wm.UserState = domain.UserStateInitial
+		case *user.HumanInitializedCheckSucceededEvent:
+			wm.UserState = domain.UserStateActive
+		case *user.HumanEmailChangedEvent:
+			wm.Email = e.EmailAddress
+			wm.IsEmailVerified = false
+			wm.resetCode()
+		case *user.HumanEmailVerifiedEvent:
+			wm.IsEmailVerified = true
+		case *user.HumanMagicLinkCodeAddedEvent:
+			wm.Code = e.Code
+			wm.CodeCreationDate = e.CreationDate()
+			wm.CodeExpiry = e.Expiry
+			wm.AuthRequestID = ""
+			wm.UserAgentID = ""
+			if e.AuthRequestInfo != nil {
+				wm.AuthRequestID = e.AuthRequestInfo.ID
+				wm.UserAgentID = e.AuthRequestInfo.UserAgentID
+			}
+		case *user.HumanMagicLinkCheckSucceededEvent,
+			*user.HumanMagicLinkCheckFailedEvent:
+			// a link can only be used once, regardless of the outcome
+			wm.resetCode()
+		case *user.UserLockedEvent:
+			wm.UserState = domain.UserStateLocked
+		case *user.UserUnlockedEvent:
+			wm.UserState = domain.UserStateActive
+		case *user.UserDeactivatedEvent:
+			wm.UserState = domain.UserStateInactive
+		case *user.UserReactivatedEvent:
+			wm.UserState = domain.UserStateActive
+		case *user.UserRemovedEvent:
+			wm.UserState = domain.UserStateDeleted
+		}
+	}
+	return wm.WriteModel.Reduce()
+}
+
+func (wm *HumanMagicLinkWriteModel) resetCode() {
+	wm.Code = nil
+	wm.AuthRequestID = ""
+	wm.UserAgentID = ""
+}
+
+func (wm *HumanMagicLinkWriteModel) Query() *eventstore.SearchQueryBuilder {
+	query := eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		AddQuery().
+		AggregateTypes(user.AggregateType).
+		AggregateIDs(wm.AggregateID).
+		EventTypes(user.UserV1AddedType,
+			user.HumanAddedType,
+			user.UserV1RegisteredType,
+			user.HumanRegisteredType,
+			user.UserV1InitialCodeAddedType,
+			user.HumanInitialCodeAddedType,
+			user.UserV1InitializedCheckSucceededType,
+			user.HumanInitializedCheckSucceededType,
+			user.UserV1EmailChangedType,
+			user.HumanEmailChangedType,
+			user.UserV1EmailVerifiedType,
+			user.HumanEmailVerifiedType,
+			user.HumanMagicLinkCodeAddedType,
+			user.HumanMagicLinkCheckSucceededType,
+			user.HumanMagicLinkCheckFailedType,
+			user.UserLockedType,
+			user.UserUnlockedType,
+			user.UserDeactivatedType,
+			user.UserReactivatedType,
+			user.UserRemovedType).
+		Builder()
+
+	if wm.ResourceOwner != "" {
+		query.ResourceOwner(wm.ResourceOwner)
+	}
+	return query
+}
@@ -0,0 +1,269 @@
+package command
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/zitadel/zitadel/internal/crypto"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/org"
+	"github.com/zitadel/zitadel/internal/repository/user"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+var magicLinkTestCode = &crypto.CryptoValue{
+	CryptoType: crypto.TypeEncryption,
+	Algorithm:  "enc",
+	KeyID:      "id",
+	Crypted:    []byte("code"),
+}
+
+func magicLinkTestLoginPolicyAddedEvent(allowMagicLink bool) eventstore.Command {
+	return org.NewLoginPolicyAddedEvent(context.Background(),
+		&org.NewAggregate("org1").Aggregate,
+		true,
+		true,
+		true,
+		true,
+		true,
+		true,
+		true,
+		true,
+		false,
+		false,
+		domain.PasswordlessTypeAllowed,
+		"",
+		time.Hour*1,
+		time.Hour*2,
+		time.Hour*3,
+		time.Hour*4,
+		time.Hour*5,
+		false,
+		0,
+		false,
+		allowMagicLink,
+	)
+}
+
+func magicLinkTestAuthRequest() *domain.AuthRequest {
+	return &domain.AuthRequest{
+		ID:      "authRequestID",
+		AgentID: "userAgentID",
+	}
+}
+
+func TestCommands_RequestMagicLink(t *testing.T) {
+	userAgg := &user.NewAggregate("user1", "org1").Aggregate
+	tests := []struct {
+		name        string
+		eventstore  func(*testing.T) *eventstore.Eventstore
+		userID      string
+		authRequest *domain.AuthRequest
+		want        *domain.ObjectDetails
+		err         error
+	}{
+		{
+			name:        "missing user id",
+			eventstore:  expectEventstore(),
+			authRequest: magicLinkTestAuthRequest(),
+			err:         zerrors.ThrowInvalidArgument(nil, "COMMAND-Ml8Ui", "Errors.User.UserIDMissing"),
+		},
+		{
+			name:       "missing auth request",
+			eventstore: expectEventstore(),
+			userID:     "user1",
+			err:        zerrors.ThrowInvalidArgument(nil, "COMMAND-Ml9Ar", "Errors.AuthRequest.NotExisting"),
+		},
+		{
+			name: "user not found",
+			eventstore: expectEventstore(
+				expectFilter(),
+			),
+			userID:      "user1",
+			authRequest: magicLinkTestAuthRequest(),
+			err:         zerrors.ThrowPreconditionFailed(nil, "COMMAND-Mlg9Us", "Errors.User.NotFound"),
+		},
+		{
+			name: "magic link disabled",
+			eventstore: expectEventstore(
+				expectFilter(
+					eventFromEventPusher(trustedDeviceTestHumanAddedEvent()),
+					eventFromEventPusher(user.NewHumanEmailVerifiedEvent(context.Background(), userAgg)),
+				),
+				expectFilter(
+					eventFromEventPusher(magicLinkTestLoginPolicyAddedEvent(false)),
+				),
+			),
+			userID:      "user1",
+			authRequest: magicLinkTestAuthRequest(),
+			err:         zerrors.ThrowPreconditionFailed(nil, "COMMAND-Ml0Di", "Errors.User.MagicLink.Disabled"),
+		},
+		{
+			name: "email not verified",
+			eventstore: expectEventstore(
+				expectFilter(
+					eventFromEventPusher(trustedDeviceTestHumanAddedEvent()),
+				),
+				expectFilter(
+					eventFromEventPusher(magicLinkTestLoginPolicyAddedEvent(true)),
+				),
+			),
+			userID:      "user1",
+			authRequest: magicLinkTestAuthRequest(),
+			err:         zerrors.ThrowPreconditionFailed(nil, "COMMAND-Mla2Ev", "Errors.User.MagicLink.EmailNotVerified"),
+		},
+		{
+			name: "request, ok",
+			eventstore: expectEventstore(
+				expectFilter(
+					eventFromEventPusher(trustedDeviceTestHumanAddedEvent()),
+					eventFromEventPusher(user.NewHumanEmailVerifiedEvent(context.Background(), userAgg)),
+				),
+				expectFilter(
+					eventFromEventPusher(magicLinkTestLoginPolicyAddedEvent(true)),
+				),
+				expectPush(
+					user.NewHumanMagicLinkCodeAddedEvent(context.Background(), userAgg,
+						magicLinkTestCode,
+						time.Minute*10,
+						&user.AuthRequestInfo{
+							ID:          "authRequestID",
+							UserAgentID: "userAgentID",
+						},
+					),
+				),
+			),
+			userID:      "user1",
+			authRequest: magicLinkTestAuthRequest(),
+			want: &domain.ObjectDetails{
+				ResourceOwner: "org1",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore:                  tt.eventstore(t),
+				newEncryptedCodeWithDefault: mockEncryptedCodeWithDefault("code", time.Minute*10),
+				defaultSecretGenerators:     &SecretGenerators{},
+			}
+			got, err := c.RequestMagicLink(context.Background(), tt.userID, "org1", tt.authRequest)
+			assert.ErrorIs(t, err, tt.err)
+			if tt.want != nil {
+				assertObjectDetails(t, tt.want, got)
+			}
+		})
+	}
+}
+
+func TestCommands_HumanCheckMagicLink(t *testing.T) {
+	userAgg := &user.NewAggregate("user1", "org1").Aggregate
+	authRequestInfo := &user.AuthRequestInfo{
+		ID:          "authRequestID",
+		UserAgentID: "userAgentID",
+	}
+	tests := []struct {
+		name        string
+		eventstore  func(*testing.T) *eventstore.Eventstore
+		code        string
+		authRequest *domain.AuthRequest
+		err         error
+	}{
+		{
+			name:        "missing code",
+			eventstore:  expectEventstore(),
+			authRequest: magicLinkTestAuthRequest(),
+			err:         zerrors.ThrowInvalidArgument(nil, "COMMAND-Mlc5Co", "Errors.User.Code.Empty"),
+		},
+		{
+			name: "no code requested",
+			eventstore: expectEventstore(
+				expectFilter(
+					eventFromEventPusher(trustedDeviceTestHumanAddedEvent()),
+					eventFromEventPusher(user.NewHumanEmailVerifiedEvent(context.Background(), userAgg)),
+				),
+			),
+			code:        "code",
+			authRequest: magicLinkTestAuthRequest(),
+			err:         zerrors.ThrowPreconditionFailed(nil, "COMMAND-Mle7Nf", "Errors.User.Code.NotFound"),
+		},
+		{
+			name: "code already used",
+			eventstore: expectEventstore(
+				expectFilter(
+					eventFromEventPusher(trustedDeviceTestHumanAddedEvent()),
+					eventFromEventPusherWithCreationDateNow(user.NewHumanMagicLinkCodeAddedEvent(context.Background(), userAgg, magicLinkTestCode, time.Minute*10, authRequestInfo)),
+					eventFromEventPusher(user.NewHumanMagicLinkCheckSucceededEvent(context.Background(), userAgg, authRequestInfo)),
+				),
+			),
+			code:        "code",
+			authRequest: magicLinkTestAuthRequest(),
+			err:         zerrors.ThrowPreconditionFailed(nil, "COMMAND-Mle7Nf", "Errors.User.Code.NotFound"),
+		},
+		{
+			name: "other user agent",
+			eventstore: expectEventstore(
+				expectFilter(
+					eventFromEventPusher(trustedDeviceTestHumanAddedEvent()),
+					eventFromEventPusherWithCreationDateNow(user.NewHumanMagicLinkCodeAddedEvent(context.Background(), userAgg, magicLinkTestCode, time.Minute*10, authRequestInfo)),
+				),
+				expectPush(
+					user.NewHumanMagicLinkCheckFailedEvent(context.Background(), userAgg, &user.AuthRequestInfo{
+						ID:          "authRequestID",
+						UserAgentID: "otherUserAgentID",
+					}),
+				),
+			),
+			code: "code",
+			authRequest: &domain.AuthRequest{
+				ID:      "authRequestID",
+				AgentID: "otherUserAgentID",
+			},
+			err: zerrors.ThrowPreconditionFailed(nil, "COMMAND-Mlf8Bm", "Errors.User.MagicLink.BindingMismatch"),
+		},
+		{
+			name: "expired code",
+			eventstore: expectEventstore(
+				expectFilter(
+					eventFromEventPusher(trustedDeviceTestHumanAddedEvent()),
+					eventFromEventPusher(user.NewHumanMagicLinkCodeAddedEvent(context.Background(), userAgg, magicLinkTestCode, time.Minute*10, authRequestInfo)),
+				),
+				expectPush(
+					user.NewHumanMagicLinkCheckFailedEvent(context.Background(), userAgg, authRequestInfo),
+				),
+			),
+			code:        "code",
+			authRequest: magicLinkTestAuthRequest(),
+			err:         zerrors.ThrowPreconditionFailed(nil, "CODE-QvUQ4P", "Errors.User.Code.Expired"),
+		},
+		{
+			name: "check, ok",
+			eventstore: expectEventstore(
+				expectFilter(
+					eventFromEventPusher(trustedDeviceTestHumanAddedEvent()),
+					eventFromEventPusherWithCreationDateNow(user.NewHumanMagicLinkCodeAddedEvent(context.Background(), userAgg, magicLinkTestCode, time.Minute*10, authRequestInfo)),
+				),
+				expectPush(
+					user.NewHumanMagicLinkCheckSucceededEvent(context.Background(), userAgg, authRequestInfo),
+				),
+			),
+			code:        "code",
+			authRequest: magicLinkTestAuthRequest(),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore:     tt.eventstore(t),
+				userEncryption: crypto.CreateMockEncryptionAlg(gomock.NewController(t)),
+			}
+			err := c.HumanCheckMagicLink(context.Background(), "user1", "org1", tt.code, tt.authRequest)
+			assert.ErrorIs(t, err, tt.err)
+		})
+	}
+}
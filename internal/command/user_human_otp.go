@@ -15,6 +15,7 @@ import (
 	"github.com/zitadel/zitadel/internal/notification/senders"
 	"github.com/zitadel/zitadel/internal/repository/user"
 	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+	"github.com/zitadel/zitadel/internal/throttle"
 	"github.com/zitadel/zitadel/internal/zerrors"
 )
 
@@ -169,6 +170,7 @@ func (c *Commands) HumanCheckMFATOTP(ctx context.Context, userID, code, resource
 		code,
 		c.eventstore.FilterToQueryReducer,
 		c.multifactors.OTP.CryptoMFA,
+		c.loginThrottler,
 		authRequestDomainToAuthRequestInfo(authRequest),
 	)
 
@@ -182,6 +184,7 @@ func checkTOTP(
 	userID, resourceOwner, code string,
 	queryReducer func(ctx context.Context, r eventstore.QueryReducer) error,
 	alg crypto.EncryptionAlgorithm,
+	throttler *throttle.Throttler,
 	optionalAuthRequestInfo *user.AuthRequestInfo,
 ) ([]eventstore.Command, error) {
 	if userID == "" {
@@ -195,6 +198,10 @@ func checkTOTP(
 	if existingOTP.State != domain.MFAStateReady {
 		return nil, zerrors.ThrowPreconditionFailed(nil, "COMMAND-3Mif9s", "Errors.User.MFA.OTP.NotReady")
 	}
+	lockoutPolicy, err := checkLoginThrottling(ctx, throttler, userID, existingOTP.ResourceOwner, queryReducer)
+	if err != nil {
+		return nil, err
+	}
 	userAgg := UserAggregateFromWriteModel(&existingOTP.WriteModel)
 	verifyErr := domain.VerifyTOTP(code, existingOTP.Secret, alg)
 
@@ -209,16 +216,20 @@ func checkTOTP(
 
 	// the OTP check succeeded and the user was not locked in the meantime
 	if verifyErr == nil {
+		loginThrottlingSucceeded(ctx, throttler, lockoutPolicy, userID)
 		return []eventstore.Command{user.NewHumanOTPCheckSucceededEvent(ctx, userAgg, optionalAuthRequestInfo)}, nil
 	}
 
 	// the OTP check failed, therefore check if the limit was reached and the user must additionally be locked
 	commands := make([]eventstore.Command, 0, 2)
 	commands = append(commands, user.NewHumanOTPCheckFailedEvent(ctx, userAgg, optionalAuthRequestInfo))
-	lockoutPolicy, err := getLockoutPolicy(ctx, existingOTP.ResourceOwner, queryReducer)
-	if err != nil {
-		return nil, err
+	if lockoutPolicy == nil {
+		lockoutPolicy, err = getLockoutPolicy(ctx, existingOTP.ResourceOwner, queryReducer)
+		if err != nil {
+			return nil, err
+		}
 	}
+	loginThrottlingFailed(ctx, throttler, lockoutPolicy, userID)
 	if lockoutPolicy.MaxOTPAttempts > 0 && existingOTP.CheckFailedCount+1 >= lockoutPolicy.MaxOTPAttempts {
 		commands = append(commands, user.NewUserLockedEvent(ctx, userAgg))
 	}
@@ -372,6 +383,7 @@ func (c *Commands) HumanCheckOTPSMS(ctx context.Context, userID, code, resourceO
 		c.eventstore.FilterToQueryReducer,
 		c.userEncryption,
 		c.phoneCodeVerifier,
+		c.loginThrottler,
 		succeededEvent,
 		failedEvent,
 	)
@@ -506,6 +518,7 @@ func (c *Commands) HumanCheckOTPEmail(ctx context.Context, userID, code, resourc
 		c.eventstore.FilterToQueryReducer,
 		c.userEncryption,
 		nil, // email currently always uses local code checks
+		c.loginThrottler,
 		succeededEvent,
 		failedEvent,
 	)
@@ -575,6 +588,7 @@ func checkOTP(
 	queryReducer func(ctx context.Context, r eventstore.QueryReducer) error,
 	alg crypto.EncryptionAlgorithm,
 	getCodeVerifier func(ctx context.Context, id string) (senders.CodeGenerator, error),
+	throttler *throttle.Throttler,
 	checkSucceededEvent, checkFailedEvent func(ctx context.Context, aggregate *eventstore.Aggregate, info *user.AuthRequestInfo) eventstore.Command,
 ) ([]eventstore.Command, error) {
 	if userID == "" {
@@ -593,6 +607,10 @@ func checkOTP(
 	if existingOTP.Code() == nil && existingOTP.GeneratorID() == "" {
 		return nil, zerrors.ThrowPreconditionFailed(nil, "COMMAND-S34gh", "Errors.User.Code.NotFound")
 	}
+	lockoutPolicy, err := checkLoginThrottling(ctx, throttler, userID, existingOTP.ResourceOwner(), queryReducer)
+	if err != nil {
+		return nil, err
+	}
 	userAgg := &user.NewAggregate(userID, existingOTP.ResourceOwner()).Aggregate
 	verifyErr := verifyCode(
 		ctx,
@@ -616,14 +634,19 @@ func checkOTP(
 
 	// the OTP check succeeded and the user was not locked in the meantime
 	if verifyErr == nil {
+		loginThrottlingSucceeded(ctx, throttler, lockoutPolicy, userID)
 		return []eventstore.Command{checkSucceededEvent(ctx, userAgg, authRequestDomainToAuthRequestInfo(authRequest))}, nil
 	}
 
 	// the OTP check failed, therefore check if the limit was reached and the user must additionally be locked
 	commands := make([]eventstore.Command, 0, 2)
 	commands = append(commands, checkFailedEvent(ctx, userAgg, authRequestDomainToAuthRequestInfo(authRequest)))
-	lockoutPolicy, lockoutErr := getLockoutPolicy(ctx, existingOTP.ResourceOwner(), queryReducer)
-	logging.OnError(lockoutErr).Error("unable to get lockout policy")
+	if lockoutPolicy == nil {
+		var lockoutErr error
+		lockoutPolicy, lockoutErr = getLockoutPolicy(ctx, existingOTP.ResourceOwner(), queryReducer)
+		logging.OnError(lockoutErr).Error("unable to get lockout policy")
+	}
+	loginThrottlingFailed(ctx, throttler, lockoutPolicy, userID)
 	if lockoutPolicy != nil && lockoutPolicy.MaxOTPAttempts > 0 && existingOTP.CheckFailedCount()+1 >= lockoutPolicy.MaxOTPAttempts {
 		commands = append(commands, user.NewUserLockedEvent(ctx, userAgg))
 	}
@@ -2069,6 +2069,11 @@ func TestCommandSide_HumanCheckOTPSMS(t *testing.T) {
 							org.NewLockoutPolicyAddedEvent(ctx,
 								&org.NewAggregate("orgID").Aggregate,
 								3, 3, true,
+								0,
+								0,
+								0,
+								0,
+								0,
 							),
 						),
 					),
@@ -2147,6 +2152,11 @@ func TestCommandSide_HumanCheckOTPSMS(t *testing.T) {
 							org.NewLockoutPolicyAddedEvent(ctx,
 								&org.NewAggregate("orgID").Aggregate,
 								1, 1, true,
+								0,
+								0,
+								0,
+								0,
+								0,
 							),
 						),
 					),
@@ -3243,6 +3253,11 @@ func TestCommandSide_HumanCheckOTPEmail(t *testing.T) {
 							org.NewLockoutPolicyAddedEvent(ctx,
 								&org.NewAggregate("orgID").Aggregate,
 								3, 3, true,
+								0,
+								0,
+								0,
+								0,
+								0,
 							),
 						),
 					),
@@ -3320,6 +3335,11 @@ func TestCommandSide_HumanCheckOTPEmail(t *testing.T) {
 							org.NewLockoutPolicyAddedEvent(ctx,
 								&org.NewAggregate("orgID").Aggregate,
 								1, 1, true,
+								0,
+								0,
+								0,
+								0,
+								0,
 							),
 						),
 					),
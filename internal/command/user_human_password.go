@@ -9,6 +9,7 @@ import (
 	"github.com/zitadel/logging"
 	"github.com/zitadel/passwap"
 
+	"github.com/zitadel/zitadel/internal/breached"
 	commandErrors "github.com/zitadel/zitadel/internal/command/errors"
 	"github.com/zitadel/zitadel/internal/crypto"
 	"github.com/zitadel/zitadel/internal/domain"
@@ -16,6 +17,7 @@ import (
 	"github.com/zitadel/zitadel/internal/notification/senders"
 	"github.com/zitadel/zitadel/internal/repository/user"
 	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+	"github.com/zitadel/zitadel/internal/throttle"
 	"github.com/zitadel/zitadel/internal/zerrors"
 )
 
@@ -169,7 +171,7 @@ func (c *Commands) setPassword(
 	verificationCheck setPasswordVerification,
 ) (*domain.ObjectDetails, error) {
 	agg := user.NewAggregate(wm.AggregateID, wm.ResourceOwner)
-	command, err := c.setPasswordCommand(ctx, &agg.Aggregate, wm.UserState, password, encodedPassword, userAgentID, changeRequired, verificationCheck)
+	command, err := c.setPasswordCommand(ctx, &agg.Aggregate, wm.UserState, wm.PasswordHistory(), password, encodedPassword, userAgentID, changeRequired, verificationCheck)
 	if err != nil {
 		return nil, err
 	}
@@ -183,9 +185,10 @@ func (c *Commands) setPassword(
 // setPasswordCommand creates the command / intent for changing a user's password.
 // It will check the user's [domain.UserState] to be existing and not initial,
 // if the caller is allowed to change the password (permission, by code or by providing the current password),
-// and it will ensure the new password (if provided as plain) corresponds to the password complexity policy.
+// and it will ensure the new password (if provided as plain) corresponds to the password complexity policy,
+// including that it does not match one of the passwords in the passwordHistory (hashes, newest first).
 // If not already encoded, the new password will be hashed.
-func (c *Commands) setPasswordCommand(ctx context.Context, agg *eventstore.Aggregate, userState domain.UserState, password, encodedPassword, userAgentID string, changeRequired bool, verificationCheck setPasswordVerification) (_ eventstore.Command, err error) {
+func (c *Commands) setPasswordCommand(ctx context.Context, agg *eventstore.Aggregate, userState domain.UserState, passwordHistory []string, password, encodedPassword, userAgentID string, changeRequired bool, verificationCheck setPasswordVerification) (_ eventstore.Command, err error) {
 	if !isUserStateExists(userState) {
 		return nil, zerrors.ThrowPreconditionFailed(nil, "COMMAND-G8dh3", "Errors.User.Password.NotFound")
 	}
@@ -205,7 +208,7 @@ func (c *Commands) setPasswordCommand(ctx context.Context, agg *eventstore.Aggre
 	// If password is provided, let's check if is compliant with the policy.
 	// If only a encodedPassword is passed, we can skip this.
 	if password != "" {
-		if err = c.checkPasswordComplexity(ctx, password, agg.ResourceOwner); err != nil {
+		if err = c.checkPasswordComplexity(ctx, password, agg.ResourceOwner, passwordHistory); err != nil {
 			return nil, err
 		}
 	}
@@ -236,7 +239,7 @@ func (c *Commands) verifyAndUpdatePassword(ctx context.Context, encodedHash, old
 }
 
 // checkPasswordComplexity checks uf the given password can be used to be the password of a user
-func (c *Commands) checkPasswordComplexity(ctx context.Context, newPassword string, resourceOwner string) (err error) {
+func (c *Commands) checkPasswordComplexity(ctx context.Context, newPassword string, resourceOwner string, passwordHistory []string) (err error) {
 	ctx, span := tracing.NewSpan(ctx)
 	defer func() { span.EndWithError(err) }()
 
@@ -248,6 +251,59 @@ func (c *Commands) checkPasswordComplexity(ctx context.Context, newPassword stri
 	if err := policy.Check(newPassword); err != nil {
 		return err
 	}
+	if err := c.checkPasswordHistory(ctx, newPassword, policy.HistoryDepth, passwordHistory); err != nil {
+		return err
+	}
+	return c.checkPasswordBreached(ctx, newPassword, policy.CheckBreached)
+}
+
+func (c *Commands) checkPasswordBreached(ctx context.Context, newPassword string, checkBreached bool) (err error) {
+	if !checkBreached {
+		return nil
+	}
+	// the corpus might have been removed from the configuration after the policy was enabled,
+	// in which case the password can't be checked and is therefore not accepted.
+	if c.breachedPasswords == nil {
+		return zerrors.ThrowPreconditionFailed(nil, "COMMAND-Brch3", "Errors.User.PasswordComplexityPolicy.BreachedNotConfigured")
+	}
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	breached, err := c.breachedPasswords.IsBreached(ctx, newPassword)
+	if err != nil {
+		return err
+	}
+	if breached {
+		return zerrors.ThrowInvalidArgument(nil, "COMMAND-Brch1", "Errors.User.PasswordComplexityPolicy.Breached")
+	}
+	return nil
+}
+
+// checkBreachedPasswordsConfigured prevents enabling the breached password options of a password complexity policy without configured corpus.
+func (c *Commands) checkBreachedPasswordsConfigured(checkBreached, forceChangeBreached bool) error {
+	if (checkBreached || forceChangeBreached) && c.breachedPasswords == nil {
+		return zerrors.ThrowPreconditionFailed(nil, "COMMAND-Brch2", "Errors.User.PasswordComplexityPolicy.BreachedNotConfigured")
+	}
+	return nil
+}
+
+// checkPasswordHistory ensures the new password does not match any of the last passwords up to the history depth of the policy.
+func (c *Commands) checkPasswordHistory(ctx context.Context, newPassword string, historyDepth uint64, passwordHistory []string) (err error) {
+	if historyDepth == 0 || len(passwordHistory) == 0 {
+		return nil
+	}
+	_, spanPasswap := tracing.NewNamedSpan(ctx, "passwap.Verify")
+	defer func() { spanPasswap.EndWithError(err) }()
+
+	if uint64(len(passwordHistory)) > historyDepth {
+		passwordHistory = passwordHistory[:historyDepth]
+	}
+	for _, encodedHash := range passwordHistory {
+		// any error (mismatch or an unsupported hash) means the password was not used before
+		if _, verifyErr := c.userPasswordHasher.Verify(encodedHash, newPassword); verifyErr == nil {
+			return zerrors.ThrowInvalidArgument(nil, "COMMAND-Hst1p", "Errors.User.PasswordComplexityPolicy.Reused")
+		}
+	}
 	return nil
 }
 
@@ -344,7 +400,7 @@ func (c *Commands) HumanCheckPassword(ctx context.Context, orgID, userID, passwo
 	if !loginPolicy.AllowUsernamePassword {
 		return zerrors.ThrowPreconditionFailed(err, "COMMAND-Dft32", "Errors.Org.LoginPolicy.UsernamePasswordNotAllowed")
 	}
-	commands, err := checkPassword(ctx, userID, password, c.eventstore, c.userPasswordHasher, authRequestDomainToAuthRequestInfo(authRequest))
+	commands, err := checkPassword(ctx, userID, password, c.eventstore, c.userPasswordHasher, c.breachedPasswords, c.loginThrottler, authRequestDomainToAuthRequestInfo(authRequest))
 	if len(commands) == 0 {
 		return err
 	}
@@ -353,18 +409,26 @@ func (c *Commands) HumanCheckPassword(ctx context.Context, orgID, userID, passwo
 	return err
 }
 
-func checkPassword(ctx context.Context, userID, password string, es *eventstore.Eventstore, hasher *crypto.Hasher, optionalAuthRequestInfo *user.AuthRequestInfo) ([]eventstore.Command, error) {
+func checkPassword(ctx context.Context, userID, password string, es *eventstore.Eventstore, hasher *crypto.Hasher, breachedPasswords breached.Checker, throttler *throttle.Throttler, optionalAuthRequestInfo *user.AuthRequestInfo) ([]eventstore.Command, error) {
 	if userID == "" {
 		return nil, zerrors.ThrowPreconditionFailed(nil, "COMMAND-Sfw3f", "Errors.User.UserIDMissing")
 	}
+	if err := checkClientLoginThrottling(ctx, throttler); err != nil {
+		return nil, err
+	}
 	wm := NewHumanPasswordWriteModel(userID, "")
 	err := es.FilterToQueryReducer(ctx, wm)
 	if err != nil {
 		return nil, err
 	}
 	if !wm.UserState.Exists() {
+		clientLoginThrottlingFailed(ctx, throttler, es.FilterToQueryReducer)
 		return nil, zerrors.ThrowPreconditionFailed(nil, "COMMAND-3n77z", "Errors.User.NotFound")
 	}
+	lockoutPolicy, err := checkLoginThrottling(ctx, throttler, userID, wm.ResourceOwner, es.FilterToQueryReducer)
+	if err != nil {
+		return nil, err
+	}
 	if wm.UserState == domain.UserStateLocked {
 		wrongPasswordError := &commandErrors.WrongPasswordError{
 			FailedAttempts: int32(wm.PasswordCheckFailedCount),
@@ -372,6 +436,7 @@ func checkPassword(ctx context.Context, userID, password string, es *eventstore.
 		return nil, zerrors.ThrowPreconditionFailed(wrongPasswordError, "COMMAND-JLK35", "Errors.User.Locked")
 	}
 	if wm.EncodedHash == "" {
+		loginThrottlingFailed(ctx, throttler, lockoutPolicy, userID)
 		return nil, zerrors.ThrowPreconditionFailed(nil, "COMMAND-3nJ4t", "Errors.User.Password.NotSet")
 	}
 
@@ -395,23 +460,51 @@ func checkPassword(ctx context.Context, userID, password string, es *eventstore.
 	}
 
 	if err == nil {
+		loginThrottlingSucceeded(ctx, throttler, lockoutPolicy, userID)
 		commands = append(commands, user.NewHumanPasswordCheckSucceededEvent(ctx, userAgg, optionalAuthRequestInfo))
 		if updated != "" {
 			commands = append(commands, user.NewHumanPasswordHashUpdatedEvent(ctx, userAgg, updated))
 		}
+		if !wm.SecretChangeRequired && isPasswordBreached(ctx, wm.ResourceOwner, password, es, breachedPasswords) {
+			commands = append(commands, user.NewHumanPasswordBreachedEvent(ctx, userAgg))
+		}
 		return commands, nil
 	}
 
 	commands = append(commands, user.NewHumanPasswordCheckFailedEvent(ctx, userAgg, optionalAuthRequestInfo))
 
-	lockoutPolicy, lockoutErr := getLockoutPolicy(ctx, wm.ResourceOwner, es.FilterToQueryReducer)
-	logging.OnError(lockoutErr).Error("unable to get lockout policy")
+	if lockoutPolicy == nil {
+		var lockoutErr error
+		lockoutPolicy, lockoutErr = getLockoutPolicy(ctx, wm.ResourceOwner, es.FilterToQueryReducer)
+		logging.OnError(lockoutErr).Error("unable to get lockout policy")
+	}
+	loginThrottlingFailed(ctx, throttler, lockoutPolicy, userID)
 	if lockoutPolicy != nil && lockoutPolicy.MaxPasswordAttempts > 0 && wm.PasswordCheckFailedCount+1 >= lockoutPolicy.MaxPasswordAttempts {
 		commands = append(commands, user.NewUserLockedEvent(ctx, userAgg))
 	}
 	return commands, err
 }
 
+// isPasswordBreached checks the password against the breached password corpus,
+// if the password complexity policy requires a change of breached passwords.
+// Errors are only logged, so they don't prevent the user from logging in.
+func isPasswordBreached(ctx context.Context, resourceOwner, password string, es *eventstore.Eventstore, breachedPasswords breached.Checker) bool {
+	if breachedPasswords == nil {
+		return false
+	}
+	policy, err := getPasswordComplexityPolicy(ctx, resourceOwner, es.FilterToQueryReducer)
+	if err != nil {
+		logging.WithError(err).Error("unable to get password complexity policy")
+		return false
+	}
+	if !policy.ForceChangeBreached {
+		return false
+	}
+	isBreached, err := breachedPasswords.IsBreached(ctx, password)
+	logging.OnError(err).Error("unable to check password against breached password corpus")
+	return isBreached
+}
+
 func (c *Commands) passwordWriteModel(ctx context.Context, userID, resourceOwner string) (writeModel *HumanPasswordWriteModel, err error) {
 	ctx, span := tracing.NewSpan(ctx)
 	defer func() { span.EndWithError(err) }()
@@ -14,6 +14,8 @@ type HumanPasswordWriteModel struct {
 
 	EncodedHash          string
 	SecretChangeRequired bool
+	// PreviousEncodedHashes contains the hashes of the replaced passwords, newest first.
+	PreviousEncodedHashes []string
 
 	Code                     *crypto.CryptoValue
 	CodeCreationDate         time.Time
@@ -50,6 +52,7 @@ func (wm *HumanPasswordWriteModel) Reduce() error {
 		case *user.HumanInitializedCheckSucceededEvent:
 			wm.UserState = domain.UserStateActive
 		case *user.HumanPasswordChangedEvent:
+			wm.PreviousEncodedHashes = appendPasswordHistory(wm.PreviousEncodedHashes, wm.EncodedHash)
 			wm.EncodedHash = crypto.SecretOrEncodedHash(e.Secret, e.EncodedHash)
 			wm.SecretChangeRequired = e.ChangeRequired
 			wm.Code = nil
@@ -81,6 +84,8 @@ func (wm *HumanPasswordWriteModel) Reduce() error {
 			wm.UserState = domain.UserStateDeleted
 		case *user.HumanPasswordHashUpdatedEvent:
 			wm.EncodedHash = e.EncodedHash
+		case *user.HumanPasswordBreachedEvent:
+			wm.SecretChangeRequired = true
 		}
 	}
 	return wm.WriteModel.Reduce()
@@ -102,6 +107,7 @@ func (wm *HumanPasswordWriteModel) Query() *eventstore.SearchQueryBuilder {
 			user.HumanPasswordCheckFailedType,
 			user.HumanPasswordCheckSucceededType,
 			user.HumanPasswordHashUpdatedType,
+			user.HumanPasswordBreachedType,
 			user.UserRemovedType,
 			user.UserLockedType,
 			user.UserUnlockedType,
@@ -126,3 +132,28 @@ func (wm *HumanPasswordWriteModel) Query() *eventstore.SearchQueryBuilder {
 	}
 	return query
 }
+
+// PasswordHistory returns the hash of the current password followed by the hashes of the previous ones.
+func (wm *HumanPasswordWriteModel) PasswordHistory() []string {
+	return passwordHistory(wm.EncodedHash, wm.PreviousEncodedHashes)
+}
+
+// appendPasswordHistory adds the hash of a replaced password in front of the previous ones.
+// The history is limited to the maximum depth, which can be checked by the password complexity policy.
+func appendPasswordHistory(previous []string, replaced string) []string {
+	if replaced == "" {
+		return previous
+	}
+	previous = append([]string{replaced}, previous...)
+	if len(previous) >= domain.PasswordHistoryMaxDepth {
+		previous = previous[:domain.PasswordHistoryMaxDepth-1]
+	}
+	return previous
+}
+
+func passwordHistory(current string, previous []string) []string {
+	if current == "" {
+		return previous
+	}
+	return append([]string{current}, previous...)
+}
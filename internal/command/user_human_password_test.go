@@ -12,6 +12,7 @@ import (
 	"go.uber.org/mock/gomock"
 	"golang.org/x/text/language"
 
+	"github.com/zitadel/zitadel/internal/breached"
 	"github.com/zitadel/zitadel/internal/crypto"
 	"github.com/zitadel/zitadel/internal/domain"
 	"github.com/zitadel/zitadel/internal/eventstore"
@@ -27,6 +28,7 @@ func TestCommandSide_SetOneTimePassword(t *testing.T) {
 		eventstore         func(*testing.T) *eventstore.Eventstore
 		userPasswordHasher *crypto.Hasher
 		checkPermission    domain.PermissionCheck
+		breachedPasswords  breached.Checker
 	}
 	type args struct {
 		ctx           context.Context
@@ -150,6 +152,9 @@ func TestCommandSide_SetOneTimePassword(t *testing.T) {
 								false,
 								false,
 								false,
+								0,
+								false,
+								false,
 							),
 						),
 					),
@@ -212,6 +217,161 @@ func TestCommandSide_SetOneTimePassword(t *testing.T) {
 								false,
 								false,
 								false,
+								0,
+								false,
+								false,
+							),
+						),
+					),
+					expectPush(
+						user.NewHumanPasswordChangedEvent(context.Background(),
+							&user.NewAggregate("user1", "org1").Aggregate,
+							"$plain$x$password",
+							false,
+							"",
+						),
+					),
+				),
+				userPasswordHasher: mockPasswordHasher("x"),
+				checkPermission:    newMockPermissionCheckAllowed(),
+			},
+			args: args{
+				ctx:           context.Background(),
+				userID:        "user1",
+				resourceOwner: "org1",
+				password:      "password",
+				oneTime:       false,
+			},
+			res: res{
+				want: &domain.ObjectDetails{
+					ResourceOwner: "org1",
+				},
+			},
+		},
+		{
+			name: "password in history, invalid argument error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							user.NewHumanAddedEvent(context.Background(),
+								&user.NewAggregate("user1", "org1").Aggregate,
+								"username",
+								"firstname",
+								"lastname",
+								"nickname",
+								"displayname",
+								language.German,
+								domain.GenderUnspecified,
+								"email@test.ch",
+								true,
+							),
+						),
+						eventFromEventPusher(
+							user.NewHumanEmailVerifiedEvent(context.Background(),
+								&user.NewAggregate("user1", "org1").Aggregate,
+							),
+						),
+						eventFromEventPusher(
+							user.NewHumanPasswordChangedEvent(context.Background(),
+								&user.NewAggregate("user1", "org1").Aggregate,
+								"$plain$x$password",
+								false,
+								"",
+							),
+						),
+						eventFromEventPusher(
+							user.NewHumanPasswordChangedEvent(context.Background(),
+								&user.NewAggregate("user1", "org1").Aggregate,
+								"$plain$x$password2",
+								false,
+								"",
+							),
+						),
+					),
+					expectFilter(
+						eventFromEventPusher(
+							org.NewPasswordComplexityPolicyAddedEvent(context.Background(),
+								&user.NewAggregate("user1", "org1").Aggregate,
+								1,
+								false,
+								false,
+								false,
+								false,
+								2,
+								false,
+								false,
+							),
+						),
+					),
+				),
+				userPasswordHasher: mockPasswordHasher("x"),
+				checkPermission:    newMockPermissionCheckAllowed(),
+			},
+			args: args{
+				ctx:           context.Background(),
+				userID:        "user1",
+				resourceOwner: "org1",
+				password:      "password",
+				oneTime:       false,
+			},
+			res: res{
+				err: zerrors.IsErrorInvalidArgument,
+			},
+		},
+		{
+			name: "password outside history depth, ok",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							user.NewHumanAddedEvent(context.Background(),
+								&user.NewAggregate("user1", "org1").Aggregate,
+								"username",
+								"firstname",
+								"lastname",
+								"nickname",
+								"displayname",
+								language.German,
+								domain.GenderUnspecified,
+								"email@test.ch",
+								true,
+							),
+						),
+						eventFromEventPusher(
+							user.NewHumanEmailVerifiedEvent(context.Background(),
+								&user.NewAggregate("user1", "org1").Aggregate,
+							),
+						),
+						eventFromEventPusher(
+							user.NewHumanPasswordChangedEvent(context.Background(),
+								&user.NewAggregate("user1", "org1").Aggregate,
+								"$plain$x$password",
+								false,
+								"",
+							),
+						),
+						eventFromEventPusher(
+							user.NewHumanPasswordChangedEvent(context.Background(),
+								&user.NewAggregate("user1", "org1").Aggregate,
+								"$plain$x$password2",
+								false,
+								"",
+							),
+						),
+					),
+					expectFilter(
+						eventFromEventPusher(
+							org.NewPasswordComplexityPolicyAddedEvent(context.Background(),
+								&user.NewAggregate("user1", "org1").Aggregate,
+								1,
+								false,
+								false,
+								false,
+								false,
+								1,
+								false,
+								false,
 							),
 						),
 					),
@@ -240,6 +400,183 @@ func TestCommandSide_SetOneTimePassword(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "password breached, invalid argument error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							user.NewHumanAddedEvent(context.Background(),
+								&user.NewAggregate("user1", "org1").Aggregate,
+								"username",
+								"firstname",
+								"lastname",
+								"nickname",
+								"displayname",
+								language.German,
+								domain.GenderUnspecified,
+								"email@test.ch",
+								true,
+							),
+						),
+						eventFromEventPusher(
+							user.NewHumanEmailVerifiedEvent(context.Background(),
+								&user.NewAggregate("user1", "org1").Aggregate,
+							),
+						),
+					),
+					expectFilter(
+						eventFromEventPusher(
+							org.NewPasswordComplexityPolicyAddedEvent(context.Background(),
+								&user.NewAggregate("user1", "org1").Aggregate,
+								1,
+								false,
+								false,
+								false,
+								false,
+								0,
+								true,
+								false,
+							),
+						),
+					),
+				),
+				userPasswordHasher: mockPasswordHasher("x"),
+				checkPermission:    newMockPermissionCheckAllowed(),
+				breachedPasswords:  mockBreachedPasswords{"password"},
+			},
+			args: args{
+				ctx:           context.Background(),
+				userID:        "user1",
+				resourceOwner: "org1",
+				password:      "password",
+				oneTime:       false,
+			},
+			res: res{
+				err: zerrors.IsErrorInvalidArgument,
+			},
+		},
+		{
+			name: "breached password check without corpus, precondition error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							user.NewHumanAddedEvent(context.Background(),
+								&user.NewAggregate("user1", "org1").Aggregate,
+								"username",
+								"firstname",
+								"lastname",
+								"nickname",
+								"displayname",
+								language.German,
+								domain.GenderUnspecified,
+								"email@test.ch",
+								true,
+							),
+						),
+						eventFromEventPusher(
+							user.NewHumanEmailVerifiedEvent(context.Background(),
+								&user.NewAggregate("user1", "org1").Aggregate,
+							),
+						),
+					),
+					expectFilter(
+						eventFromEventPusher(
+							org.NewPasswordComplexityPolicyAddedEvent(context.Background(),
+								&user.NewAggregate("user1", "org1").Aggregate,
+								1,
+								false,
+								false,
+								false,
+								false,
+								0,
+								true,
+								false,
+							),
+						),
+					),
+				),
+				userPasswordHasher: mockPasswordHasher("x"),
+				checkPermission:    newMockPermissionCheckAllowed(),
+			},
+			args: args{
+				ctx:           context.Background(),
+				userID:        "user1",
+				resourceOwner: "org1",
+				password:      "password",
+				oneTime:       false,
+			},
+			res: res{
+				err: zerrors.IsPreconditionFailed,
+			},
+		},
+		{
+			name: "password not breached, ok",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							user.NewHumanAddedEvent(context.Background(),
+								&user.NewAggregate("user1", "org1").Aggregate,
+								"username",
+								"firstname",
+								"lastname",
+								"nickname",
+								"displayname",
+								language.German,
+								domain.GenderUnspecified,
+								"email@test.ch",
+								true,
+							),
+						),
+						eventFromEventPusher(
+							user.NewHumanEmailVerifiedEvent(context.Background(),
+								&user.NewAggregate("user1", "org1").Aggregate,
+							),
+						),
+					),
+					expectFilter(
+						eventFromEventPusher(
+							org.NewPasswordComplexityPolicyAddedEvent(context.Background(),
+								&user.NewAggregate("user1", "org1").Aggregate,
+								1,
+								false,
+								false,
+								false,
+								false,
+								0,
+								true,
+								false,
+							),
+						),
+					),
+					expectPush(
+						user.NewHumanPasswordChangedEvent(context.Background(),
+							&user.NewAggregate("user1", "org1").Aggregate,
+							"$plain$x$password",
+							false,
+							"",
+						),
+					),
+				),
+				userPasswordHasher: mockPasswordHasher("x"),
+				checkPermission:    newMockPermissionCheckAllowed(),
+				breachedPasswords:  mockBreachedPasswords{"password1"},
+			},
+			args: args{
+				ctx:           context.Background(),
+				userID:        "user1",
+				resourceOwner: "org1",
+				password:      "password",
+				oneTime:       false,
+			},
+			res: res{
+				want: &domain.ObjectDetails{
+					ResourceOwner: "org1",
+				},
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -247,6 +584,7 @@ func TestCommandSide_SetOneTimePassword(t *testing.T) {
 				eventstore:         tt.fields.eventstore(t),
 				userPasswordHasher: tt.fields.userPasswordHasher,
 				checkPermission:    tt.fields.checkPermission,
+				breachedPasswords:  tt.fields.breachedPasswords,
 			}
 			got, err := r.SetPassword(tt.args.ctx, tt.args.resourceOwner, tt.args.userID, tt.args.password, tt.args.oneTime)
 			if tt.res.err == nil {
@@ -463,6 +801,9 @@ func TestCommandSide_SetPasswordWithVerifyCode(t *testing.T) {
 								false,
 								false,
 								false,
+								0,
+								false,
+								false,
 							),
 						),
 					),
@@ -540,6 +881,9 @@ func TestCommandSide_SetPasswordWithVerifyCode(t *testing.T) {
 								false,
 								false,
 								false,
+								0,
+								false,
+								false,
 							),
 						),
 					),
@@ -618,6 +962,9 @@ func TestCommandSide_SetPasswordWithVerifyCode(t *testing.T) {
 								false,
 								false,
 								false,
+								0,
+								false,
+								false,
 							),
 						),
 					),
@@ -701,6 +1048,9 @@ func TestCommandSide_SetPasswordWithVerifyCode(t *testing.T) {
 								false,
 								false,
 								false,
+								0,
+								false,
+								false,
 							),
 						),
 					),
@@ -924,6 +1274,9 @@ func TestCommandSide_ChangePassword(t *testing.T) {
 							true,
 							true,
 							true,
+							0,
+							false,
+							false,
 						),
 					),
 				),
@@ -1026,6 +1379,9 @@ func TestCommandSide_ChangePassword(t *testing.T) {
 							false,
 							false,
 							false,
+							0,
+							false,
+							false,
 						),
 					),
 				),
@@ -1094,6 +1450,9 @@ func TestCommandSide_ChangePassword(t *testing.T) {
 							false,
 							false,
 							false,
+							0,
+							false,
+							false,
 						),
 					),
 				),
@@ -1163,6 +1522,9 @@ func TestCommandSide_ChangePassword(t *testing.T) {
 							false,
 							false,
 							false,
+							0,
+							false,
+							false,
 						),
 					),
 				),
@@ -1597,6 +1959,7 @@ func TestCommandSide_CheckPassword(t *testing.T) {
 	type fields struct {
 		eventstore         func(*testing.T) *eventstore.Eventstore
 		userPasswordHasher *crypto.Hasher
+		breachedPasswords  breached.Checker
 	}
 	type args struct {
 		ctx           context.Context
@@ -1685,6 +2048,10 @@ func TestCommandSide_CheckPassword(t *testing.T) {
 								time.Hour*3,
 								time.Hour*4,
 								time.Hour*5,
+								false,
+								0,
+								false,
+								false,
 							),
 						),
 					),
@@ -1725,6 +2092,10 @@ func TestCommandSide_CheckPassword(t *testing.T) {
 								time.Hour*3,
 								time.Hour*4,
 								time.Hour*5,
+								false,
+								0,
+								false,
+								false,
 							),
 						),
 					),
@@ -1766,6 +2137,10 @@ func TestCommandSide_CheckPassword(t *testing.T) {
 								time.Hour*3,
 								time.Hour*4,
 								time.Hour*5,
+								false,
+								0,
+								false,
+								false,
 							),
 						),
 					),
@@ -1827,6 +2202,10 @@ func TestCommandSide_CheckPassword(t *testing.T) {
 								time.Hour*3,
 								time.Hour*4,
 								time.Hour*5,
+								false,
+								0,
+								false,
+								false,
 							),
 						),
 					),
@@ -1884,6 +2263,10 @@ func TestCommandSide_CheckPassword(t *testing.T) {
 								time.Hour*3,
 								time.Hour*4,
 								time.Hour*5,
+								false,
+								0,
+								false,
+								false,
 							),
 						),
 					),
@@ -1920,6 +2303,11 @@ func TestCommandSide_CheckPassword(t *testing.T) {
 							org.NewLockoutPolicyAddedEvent(context.Background(),
 								&org.NewAggregate("org1").Aggregate,
 								0, 0, false,
+								0,
+								0,
+								0,
+								0,
+								0,
 							)),
 					),
 					expectPush(
@@ -1973,6 +2361,10 @@ func TestCommandSide_CheckPassword(t *testing.T) {
 								time.Hour*3,
 								time.Hour*4,
 								time.Hour*5,
+								false,
+								0,
+								false,
+								false,
 							),
 						),
 					),
@@ -2010,6 +2402,11 @@ func TestCommandSide_CheckPassword(t *testing.T) {
 							org.NewLockoutPolicyAddedEvent(context.Background(),
 								&org.NewAggregate("org1").Aggregate,
 								1, 1, false,
+								0,
+								0,
+								0,
+								0,
+								0,
 							)),
 					),
 					expectPush(
@@ -2066,6 +2463,10 @@ func TestCommandSide_CheckPassword(t *testing.T) {
 								time.Hour*3,
 								time.Hour*4,
 								time.Hour*5,
+								false,
+								0,
+								false,
+								false,
 							),
 						),
 					),
@@ -2146,6 +2547,10 @@ func TestCommandSide_CheckPassword(t *testing.T) {
 								time.Hour*3,
 								time.Hour*4,
 								time.Hour*5,
+								false,
+								0,
+								false,
+								false,
 							),
 						),
 					),
@@ -2206,6 +2611,109 @@ func TestCommandSide_CheckPassword(t *testing.T) {
 			},
 			res: res{},
 		},
+		{
+			name: "check password, ok, breached password change required",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							org.NewLoginPolicyAddedEvent(context.Background(),
+								&org.NewAggregate("org1").Aggregate,
+								true,
+								false,
+								false,
+								false,
+								false,
+								false,
+								false,
+								false,
+								false,
+								false,
+								domain.PasswordlessTypeNotAllowed,
+								"",
+								time.Hour*1,
+								time.Hour*2,
+								time.Hour*3,
+								time.Hour*4,
+								time.Hour*5,
+								false,
+								0,
+								false,
+								false,
+							),
+						),
+					),
+					expectFilter(
+						eventFromEventPusher(
+							user.NewHumanAddedEvent(context.Background(),
+								&user.NewAggregate("user1", "org1").Aggregate,
+								"username",
+								"firstname",
+								"lastname",
+								"nickname",
+								"displayname",
+								language.German,
+								domain.GenderUnspecified,
+								"email@test.ch",
+								true,
+							),
+						),
+						eventFromEventPusher(
+							user.NewHumanEmailVerifiedEvent(context.Background(),
+								&user.NewAggregate("user1", "org1").Aggregate,
+							),
+						),
+						eventFromEventPusher(
+							user.NewHumanPasswordChangedEvent(context.Background(),
+								&user.NewAggregate("user1", "org1").Aggregate,
+								"$plain$x$password",
+								false,
+								"")),
+					),
+					expectFilter(),
+					expectFilter(
+						eventFromEventPusher(
+							org.NewPasswordComplexityPolicyAddedEvent(context.Background(),
+								&org.NewAggregate("org1").Aggregate,
+								1,
+								false,
+								false,
+								false,
+								false,
+								0,
+								false,
+								true,
+							),
+						),
+					),
+					expectPush(
+						user.NewHumanPasswordCheckSucceededEvent(context.Background(),
+							&user.NewAggregate("user1", "org1").Aggregate,
+							&user.AuthRequestInfo{
+								ID:          "request1",
+								UserAgentID: "agent1",
+							},
+						),
+						user.NewHumanPasswordBreachedEvent(context.Background(),
+							&user.NewAggregate("user1", "org1").Aggregate,
+						),
+					),
+				),
+				userPasswordHasher: mockPasswordHasher("x"),
+				breachedPasswords:  mockBreachedPasswords{"password"},
+			},
+			args: args{
+				ctx:           context.Background(),
+				userID:        "user1",
+				resourceOwner: "org1",
+				password:      "password",
+				authReq: &domain.AuthRequest{
+					ID:      "request1",
+					AgentID: "agent1",
+				},
+			},
+			res: res{},
+		},
 		{
 			name: "check password ok, locked in the mean time",
 			fields: fields{
@@ -2231,6 +2739,10 @@ func TestCommandSide_CheckPassword(t *testing.T) {
 								time.Hour*3,
 								time.Hour*4,
 								time.Hour*5,
+								false,
+								0,
+								false,
+								false,
 							),
 						),
 					),
@@ -2310,6 +2822,10 @@ func TestCommandSide_CheckPassword(t *testing.T) {
 								time.Hour*3,
 								time.Hour*4,
 								time.Hour*5,
+								false,
+								0,
+								false,
+								false,
 							),
 						),
 					),
@@ -2385,6 +2901,7 @@ func TestCommandSide_CheckPassword(t *testing.T) {
 			r := &Commands{
 				eventstore:         tt.fields.eventstore(t),
 				userPasswordHasher: tt.fields.userPasswordHasher,
+				breachedPasswords:  tt.fields.breachedPasswords,
 			}
 			err := r.HumanCheckPassword(tt.args.ctx, tt.args.resourceOwner, tt.args.userID, tt.args.password, tt.args.authReq)
 			if tt.res.err == nil {
@@ -0,0 +1,223 @@
+package command
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/zitadel/logging"
+
+	"github.com/zitadel/zitadel/internal/crypto"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/session"
+	"github.com/zitadel/zitadel/internal/repository/user"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+	"github.com/zitadel/zitadel/internal/throttle"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+const recoveryCodesCount = 10
+
+type RecoveryCodesGenerated struct {
+	*domain.ObjectDetails
+	Codes []string
+}
+
+// GenerateRecoveryCodes generates a new batch of single-use recovery codes for a human user.
+// Any remaining codes of a previous batch are invalidated.
+// The plain codes are only returned once and must be stored by the user.
+func (c *Commands) GenerateRecoveryCodes(ctx context.Context, userID, resourceOwner string) (_ *RecoveryCodesGenerated, err error) {
+	if userID == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Rc1um", "Errors.User.UserIDMissing")
+	}
+	existingUser, err := userWriteModelByID(ctx, c.eventstore.Filter, userID, resourceOwner)
+	if err != nil {
+		return nil, err
+	}
+	if !isUserStateExists(existingUser.UserState) {
+		return nil, zerrors.ThrowNotFound(nil, "COMMAND-Rc2nf", "Errors.User.NotFound")
+	}
+	if existingUser.UserType != domain.UserTypeHuman {
+		return nil, zerrors.ThrowPreconditionFailed(nil, "COMMAND-Rc3hu", "Errors.User.NotHuman")
+	}
+	if err := c.checkPermissionUpdateUserCredentials(ctx, existingUser.ResourceOwner, existingUser.AggregateID); err != nil {
+		return nil, err
+	}
+	encodedHashes, codes, err := c.newRecoveryCodes(recoveryCodesCount)
+	if err != nil {
+		return nil, err
+	}
+	writeModel := NewHumanRecoveryCodesWriteModel(existingUser.AggregateID, existingUser.ResourceOwner)
+	err = c.pushAppendAndReduce(ctx, writeModel, user.NewHumanRecoveryCodesGeneratedEvent(
+		ctx,
+		UserAggregateFromWriteModel(&existingUser.WriteModel),
+		encodedHashes,
+	))
+	if err != nil {
+		return nil, err
+	}
+	return &RecoveryCodesGenerated{
+		ObjectDetails: writeModelToObjectDetails(&writeModel.WriteModel),
+		Codes:         codes,
+	}, nil
+}
+
+// RemoveRecoveryCodes invalidates all remaining recovery codes of a user.
+func (c *Commands) RemoveRecoveryCodes(ctx context.Context, userID, resourceOwner string) (*domain.ObjectDetails, error) {
+	if userID == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Rc4um", "Errors.User.UserIDMissing")
+	}
+	existingCodes, err := c.recoveryCodesWriteModelByID(ctx, userID, resourceOwner)
+	if err != nil {
+		return nil, err
+	}
+	if existingCodes.State != domain.MFAStateReady {
+		return nil, zerrors.ThrowNotFound(nil, "COMMAND-Rc5ne", "Errors.User.MFA.RecoveryCodes.NotExisting")
+	}
+	if err := c.checkPermissionUpdateUserCredentials(ctx, existingCodes.ResourceOwner, userID); err != nil {
+		return nil, err
+	}
+	err = c.pushAppendAndReduce(ctx, existingCodes, user.NewHumanRecoveryCodesRemovedEvent(
+		ctx,
+		UserAggregateFromWriteModel(&existingCodes.WriteModel),
+	))
+	if err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&existingCodes.WriteModel), nil
+}
+
+// HumanCheckRecoveryCode checks a recovery code during the login (auth request) and consumes it on success.
+func (c *Commands) HumanCheckRecoveryCode(ctx context.Context, userID, code, resourceOwner string, authRequest *domain.AuthRequest) error {
+	commands, err := checkRecoveryCode(
+		ctx,
+		userID,
+		resourceOwner,
+		code,
+		c.eventstore.FilterToQueryReducer,
+		c.secretHasher,
+		c.loginThrottler,
+		authRequestDomainToAuthRequestInfo(authRequest),
+	)
+	if len(commands) == 0 {
+		return err
+	}
+	_, pushErr := c.eventstore.Push(ctx, commands...)
+	if err == nil {
+		// the code is only consumed if the push succeeded,
+		// e.g. it fails on the unique constraint if the code was consumed concurrently
+		return pushErr
+	}
+	logging.WithFields("userID", userID).OnError(pushErr).Error("recovery code check push failed")
+	return err
+}
+
+func (c *Commands) recoveryCodesWriteModelByID(ctx context.Context, userID, resourceOwner string) (writeModel *HumanRecoveryCodesWriteModel, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	writeModel = NewHumanRecoveryCodesWriteModel(userID, resourceOwner)
+	err = c.eventstore.FilterToQueryReducer(ctx, writeModel)
+	if err != nil {
+		return nil, err
+	}
+	return writeModel, nil
+}
+
+func checkRecoveryCode(
+	ctx context.Context,
+	userID, resourceOwner, code string,
+	queryReducer func(ctx context.Context, r eventstore.QueryReducer) error,
+	hasher *crypto.Hasher,
+	throttler *throttle.Throttler,
+	optionalAuthRequestInfo *user.AuthRequestInfo,
+) ([]eventstore.Command, error) {
+	if userID == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Rc6um", "Errors.User.UserIDMissing")
+	}
+	code = strings.ToLower(strings.TrimSpace(code))
+	if code == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Rc7ce", "Errors.User.Code.Empty")
+	}
+	existingCodes := NewHumanRecoveryCodesWriteModel(userID, resourceOwner)
+	err := queryReducer(ctx, existingCodes)
+	if err != nil {
+		return nil, err
+	}
+	if existingCodes.State != domain.MFAStateReady || existingCodes.Remaining() == 0 {
+		return nil, zerrors.ThrowPreconditionFailed(nil, "COMMAND-Rc8nr", "Errors.User.MFA.RecoveryCodes.NotReady")
+	}
+	lockoutPolicy, err := checkLoginThrottling(ctx, throttler, userID, existingCodes.ResourceOwner, queryReducer)
+	if err != nil {
+		return nil, err
+	}
+	userAgg := UserAggregateFromWriteModel(&existingCodes.WriteModel)
+	codeIndex := -1
+	for i, encodedHash := range existingCodes.CodeHashes {
+		if encodedHash == "" {
+			continue
+		}
+		if _, err := hasher.Verify(encodedHash, code); err == nil {
+			codeIndex = i
+			break
+		}
+	}
+
+	// recheck for additional events (consumed codes, failed checks or locks)
+	recheckErr := queryReducer(ctx, existingCodes)
+	if recheckErr != nil {
+		return nil, recheckErr
+	}
+	if existingCodes.UserLocked {
+		return nil, zerrors.ThrowPreconditionFailed(nil, "COMMAND-Rc9lo", "Errors.User.Locked")
+	}
+
+	// the code is valid, was not consumed and the user was not locked in the meantime
+	if codeIndex >= 0 && existingCodes.CodeHashes[codeIndex] != "" {
+		loginThrottlingSucceeded(ctx, throttler, lockoutPolicy, userID)
+		return []eventstore.Command{user.NewHumanRecoveryCodeCheckSucceededEvent(ctx, userAgg, codeIndex, existingCodes.CodeHashes[codeIndex], optionalAuthRequestInfo)}, nil
+	}
+
+	// the check failed, therefore check if the limit was reached and the user must additionally be locked
+	commands := make([]eventstore.Command, 0, 2)
+	commands = append(commands, user.NewHumanRecoveryCodeCheckFailedEvent(ctx, userAgg, optionalAuthRequestInfo))
+	if lockoutPolicy == nil {
+		lockoutPolicy, err = getLockoutPolicy(ctx, existingCodes.ResourceOwner, queryReducer)
+		if err != nil {
+			return nil, err
+		}
+	}
+	loginThrottlingFailed(ctx, throttler, lockoutPolicy, userID)
+	if lockoutPolicy.MaxOTPAttempts > 0 && existingCodes.CheckFailedCount+1 >= lockoutPolicy.MaxOTPAttempts {
+		commands = append(commands, user.NewUserLockedEvent(ctx, userAgg))
+	}
+	return commands, zerrors.ThrowInvalidArgument(nil, "COMMAND-Rc0in", "Errors.User.MFA.RecoveryCodes.Invalid")
+}
+
+// CheckRecoveryCode defines a check of a recovery code to be executed for a session update.
+// A successful check consumes the code.
+func CheckRecoveryCode(code string) SessionCommand {
+	return func(ctx context.Context, cmd *SessionCommands) (_ []eventstore.Command, err error) {
+		commands, err := checkRecoveryCode(
+			ctx,
+			cmd.sessionWriteModel.UserID,
+			"",
+			code,
+			cmd.eventstore.FilterToQueryReducer,
+			cmd.secretHasher,
+			cmd.loginThrottler,
+			nil,
+		)
+		if err != nil {
+			return commands, err
+		}
+		cmd.eventCommands = append(cmd.eventCommands, commands...)
+		cmd.RecoveryCodeChecked(ctx, cmd.now())
+		return nil, nil
+	}
+}
+
+func (s *SessionCommands) RecoveryCodeChecked(ctx context.Context, checkedAt time.Time) {
+	s.eventCommands = append(s.eventCommands, session.NewRecoveryCodeCheckedEvent(ctx, s.sessionWriteModel.aggregate, checkedAt))
+}
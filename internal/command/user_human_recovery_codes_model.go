@@ -0,0 +1,88 @@
+package command
+
+import (
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/user"
+)
+
+type HumanRecoveryCodesWriteModel struct {
+	eventstore.WriteModel
+
+	State domain.MFAState
+	// CodeHashes contains the hashes of the current batch, consumed codes are emptied to keep the indexes stable
+	CodeHashes       []string
+	CheckFailedCount uint64
+	UserLocked       bool
+}
+
+func NewHumanRecoveryCodesWriteModel(userID, resourceOwner string) *HumanRecoveryCodesWriteModel {
+	return &HumanRecoveryCodesWriteModel{
+		WriteModel: eventstore.WriteModel{
+			AggregateID:   userID,
+			ResourceOwner: resourceOwner,
+		},
+	}
+}
+
+func (wm *HumanRecoveryCodesWriteModel) Reduce() error {
+	for _, event := range wm.Events {
+		switch e := event.(type) {
+		case *user.HumanRecoveryCodesGeneratedEvent:
+			wm.CodeHashes = make([]string, len(e.CodeHashes))
+			copy(wm.CodeHashes, e.CodeHashes)
+			wm.State = domain.MFAStateReady
+			wm.CheckFailedCount = 0
+		case *user.HumanRecoveryCodeCheckSucceededEvent:
+			if e.CodeIndex >= 0 && e.CodeIndex < len(wm.CodeHashes) {
+				wm.CodeHashes[e.CodeIndex] = ""
+			}
+			wm.CheckFailedCount = 0
+		case *user.HumanRecoveryCodeCheckFailedEvent:
+			wm.CheckFailedCount++
+		case *user.UserLockedEvent:
+			wm.UserLocked = true
+		case *user.UserUnlockedEvent:
+			wm.CheckFailedCount = 0
+			wm.UserLocked = false
+		case *user.HumanRecoveryCodesRemovedEvent:
+			wm.State = domain.MFAStateRemoved
+			wm.CodeHashes = nil
+		case *user.UserRemovedEvent:
+			wm.State = domain.MFAStateRemoved
+			wm.CodeHashes = nil
+		}
+	}
+	return wm.WriteModel.Reduce()
+}
+
+func (wm *HumanRecoveryCodesWriteModel) Query() *eventstore.SearchQueryBuilder {
+	query := eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		AddQuery().
+		AggregateTypes(user.AggregateType).
+		AggregateIDs(wm.AggregateID).
+		EventTypes(user.HumanRecoveryCodesGeneratedType,
+			user.HumanRecoveryCodesRemovedType,
+			user.HumanRecoveryCodeCheckSucceededType,
+			user.HumanRecoveryCodeCheckFailedType,
+			user.UserLockedType,
+			user.UserUnlockedType,
+			user.UserRemovedType).
+		Builder()
+
+	if wm.ResourceOwner != "" {
+		query.ResourceOwner(wm.ResourceOwner)
+	}
+	return query
+}
+
+// Remaining returns the number of codes of the current batch, which were not consumed yet.
+func (wm *HumanRecoveryCodesWriteModel) Remaining() int {
+	var remaining int
+	for _, hash := range wm.CodeHashes {
+		if hash != "" {
+			remaining++
+		}
+	}
+	return remaining
+}
@@ -0,0 +1,499 @@
+package command
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/org"
+	"github.com/zitadel/zitadel/internal/repository/session"
+	"github.com/zitadel/zitadel/internal/repository/user"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+func mockRecoveryCodes(hashes, codes []string) recoveryCodesFunc {
+	return func(count int) ([]string, []string, error) {
+		return hashes, codes, nil
+	}
+}
+
+func TestCommands_GenerateRecoveryCodes(t *testing.T) {
+	type fields struct {
+		eventstore      func(*testing.T) *eventstore.Eventstore
+		checkPermission domain.PermissionCheck
+	}
+	type args struct {
+		userID string
+	}
+	type res struct {
+		want *RecoveryCodesGenerated
+		err  error
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			name: "missing user id",
+			fields: fields{
+				eventstore: expectEventstore(),
+			},
+			res: res{
+				err: zerrors.ThrowInvalidArgument(nil, "COMMAND-Rc1um", "Errors.User.UserIDMissing"),
+			},
+		},
+		{
+			name: "user not found",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(),
+				),
+			},
+			args: args{
+				userID: "user1",
+			},
+			res: res{
+				err: zerrors.ThrowNotFound(nil, "COMMAND-Rc2nf", "Errors.User.NotFound"),
+			},
+		},
+		{
+			name: "no permission",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(trustedDeviceTestHumanAddedEvent()),
+					),
+				),
+				checkPermission: newMockPermissionCheckNotAllowed(),
+			},
+			args: args{
+				userID: "user1",
+			},
+			res: res{
+				err: zerrors.ThrowPermissionDenied(nil, "AUTHZ-HKJD33", "Errors.PermissionDenied"),
+			},
+		},
+		{
+			name: "generate ok",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(trustedDeviceTestHumanAddedEvent()),
+					),
+					expectPush(
+						user.NewHumanRecoveryCodesGeneratedEvent(context.Background(),
+							&user.NewAggregate("user1", "org1").Aggregate,
+							[]string{"hash1", "hash2"},
+						),
+					),
+				),
+				checkPermission: newMockPermissionCheckAllowed(),
+			},
+			args: args{
+				userID: "user1",
+			},
+			res: res{
+				want: &RecoveryCodesGenerated{
+					ObjectDetails: &domain.ObjectDetails{
+						ResourceOwner: "org1",
+					},
+					Codes: []string{"code1", "code2"},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore:       tt.fields.eventstore(t),
+				checkPermission:  tt.fields.checkPermission,
+				newRecoveryCodes: mockRecoveryCodes([]string{"hash1", "hash2"}, []string{"code1", "code2"}),
+			}
+			got, err := c.GenerateRecoveryCodes(context.Background(), tt.args.userID, "org1")
+			assert.ErrorIs(t, err, tt.res.err)
+			if tt.res.want != nil {
+				assertObjectDetails(t, tt.res.want.ObjectDetails, got.ObjectDetails)
+				assert.Equal(t, tt.res.want.Codes, got.Codes)
+			}
+		})
+	}
+}
+
+func TestCommands_RemoveRecoveryCodes(t *testing.T) {
+	type fields struct {
+		eventstore      func(*testing.T) *eventstore.Eventstore
+		checkPermission domain.PermissionCheck
+	}
+	type args struct {
+		userID string
+	}
+	type res struct {
+		want *domain.ObjectDetails
+		err  error
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			name: "missing user id",
+			fields: fields{
+				eventstore: expectEventstore(),
+			},
+			res: res{
+				err: zerrors.ThrowInvalidArgument(nil, "COMMAND-Rc4um", "Errors.User.UserIDMissing"),
+			},
+		},
+		{
+			name: "not existing",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(),
+				),
+			},
+			args: args{
+				userID: "user1",
+			},
+			res: res{
+				err: zerrors.ThrowNotFound(nil, "COMMAND-Rc5ne", "Errors.User.MFA.RecoveryCodes.NotExisting"),
+			},
+		},
+		{
+			name: "no permission",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(user.NewHumanRecoveryCodesGeneratedEvent(context.Background(),
+							&user.NewAggregate("user1", "org1").Aggregate,
+							[]string{"hash1", "hash2"},
+						)),
+					),
+				),
+				checkPermission: newMockPermissionCheckNotAllowed(),
+			},
+			args: args{
+				userID: "user1",
+			},
+			res: res{
+				err: zerrors.ThrowPermissionDenied(nil, "AUTHZ-HKJD33", "Errors.PermissionDenied"),
+			},
+		},
+		{
+			name: "remove ok",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(user.NewHumanRecoveryCodesGeneratedEvent(context.Background(),
+							&user.NewAggregate("user1", "org1").Aggregate,
+							[]string{"hash1", "hash2"},
+						)),
+					),
+					expectPush(
+						user.NewHumanRecoveryCodesRemovedEvent(context.Background(),
+							&user.NewAggregate("user1", "org1").Aggregate,
+						),
+					),
+				),
+				checkPermission: newMockPermissionCheckAllowed(),
+			},
+			args: args{
+				userID: "user1",
+			},
+			res: res{
+				want: &domain.ObjectDetails{
+					ResourceOwner: "org1",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore:      tt.fields.eventstore(t),
+				checkPermission: tt.fields.checkPermission,
+			}
+			got, err := c.RemoveRecoveryCodes(context.Background(), tt.args.userID, "org1")
+			assert.ErrorIs(t, err, tt.res.err)
+			if tt.res.want != nil {
+				assertObjectDetails(t, tt.res.want, got)
+			}
+		})
+	}
+}
+
+func TestCommands_HumanCheckRecoveryCode(t *testing.T) {
+	ctx := context.Background()
+	userAgg := &user.NewAggregate("user1", "org1").Aggregate
+	authReq := &domain.AuthRequest{
+		ID:      "authRequestID",
+		AgentID: "userAgentID",
+		BrowserInfo: &domain.BrowserInfo{
+			UserAgent:      "user-agent",
+			AcceptLanguage: "en",
+			RemoteIP:       nil,
+		},
+	}
+	codesGenerated := func() eventstore.Command {
+		return user.NewHumanRecoveryCodesGeneratedEvent(ctx, userAgg,
+			[]string{"$plain$x$code1", "$plain$x$code2"},
+		)
+	}
+	type fields struct {
+		eventstore func(*testing.T) *eventstore.Eventstore
+	}
+	type args struct {
+		code string
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		err    error
+	}{
+		{
+			name: "missing code",
+			fields: fields{
+				eventstore: expectEventstore(),
+			},
+			err: zerrors.ThrowInvalidArgument(nil, "COMMAND-Rc7ce", "Errors.User.Code.Empty"),
+		},
+		{
+			name: "no recovery codes",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(),
+				),
+			},
+			args: args{
+				code: "code1",
+			},
+			err: zerrors.ThrowPreconditionFailed(nil, "COMMAND-Rc8nr", "Errors.User.MFA.RecoveryCodes.NotReady"),
+		},
+		{
+			name: "all codes consumed",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(codesGenerated()),
+						eventFromEventPusher(user.NewHumanRecoveryCodeCheckSucceededEvent(ctx, userAgg, 0, "$plain$x$code1", nil)),
+						eventFromEventPusher(user.NewHumanRecoveryCodeCheckSucceededEvent(ctx, userAgg, 1, "$plain$x$code2", nil)),
+					),
+				),
+			},
+			args: args{
+				code: "code1",
+			},
+			err: zerrors.ThrowPreconditionFailed(nil, "COMMAND-Rc8nr", "Errors.User.MFA.RecoveryCodes.NotReady"),
+		},
+		{
+			name: "consumed code, failed",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(codesGenerated()),
+						eventFromEventPusher(user.NewHumanRecoveryCodeCheckSucceededEvent(ctx, userAgg, 0, "$plain$x$code1", nil)),
+					),
+					expectFilter(), // recheck
+					expectFilter(
+						eventFromEventPusher(
+							org.NewLockoutPolicyAddedEvent(ctx,
+								&org.NewAggregate("org1").Aggregate,
+								0, 0, false,
+								0,
+								0,
+								0,
+								0,
+								0,
+							),
+						),
+					),
+					expectPush(
+						user.NewHumanRecoveryCodeCheckFailedEvent(ctx, userAgg, authRequestDomainToAuthRequestInfo(authReq)),
+					),
+				),
+			},
+			args: args{
+				code: "code1",
+			},
+			err: zerrors.ThrowInvalidArgument(nil, "COMMAND-Rc0in", "Errors.User.MFA.RecoveryCodes.Invalid"),
+		},
+		{
+			name: "wrong code, locked",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(codesGenerated()),
+					),
+					expectFilter(), // recheck
+					expectFilter(
+						eventFromEventPusher(
+							org.NewLockoutPolicyAddedEvent(ctx,
+								&org.NewAggregate("org1").Aggregate,
+								1, 1, false,
+								0,
+								0,
+								0,
+								0,
+								0,
+							),
+						),
+					),
+					expectPush(
+						user.NewHumanRecoveryCodeCheckFailedEvent(ctx, userAgg, authRequestDomainToAuthRequestInfo(authReq)),
+						user.NewUserLockedEvent(ctx, userAgg),
+					),
+				),
+			},
+			args: args{
+				code: "wrong",
+			},
+			err: zerrors.ThrowInvalidArgument(nil, "COMMAND-Rc0in", "Errors.User.MFA.RecoveryCodes.Invalid"),
+		},
+		{
+			name: "check ok",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(codesGenerated()),
+						eventFromEventPusher(user.NewHumanRecoveryCodeCheckSucceededEvent(ctx, userAgg, 0, "$plain$x$code1", nil)),
+					),
+					expectFilter(), // recheck
+					expectPush(
+						user.NewHumanRecoveryCodeCheckSucceededEvent(ctx, userAgg, 1, "$plain$x$code2", authRequestDomainToAuthRequestInfo(authReq)),
+					),
+				),
+			},
+			args: args{
+				code: " Code2 ",
+			},
+		},
+		{
+			name: "check ok, consumed concurrently",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(codesGenerated()),
+					),
+					expectFilter(), // recheck
+					expectPushFailed(
+						zerrors.ThrowAlreadyExists(nil, "id", "Errors.User.MFA.RecoveryCodes.Invalid"),
+						user.NewHumanRecoveryCodeCheckSucceededEvent(ctx, userAgg, 0, "$plain$x$code1", authRequestDomainToAuthRequestInfo(authReq)),
+					),
+				),
+			},
+			args: args{
+				code: "code1",
+			},
+			err: zerrors.ThrowAlreadyExists(nil, "id", "Errors.User.MFA.RecoveryCodes.Invalid"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore:   tt.fields.eventstore(t),
+				secretHasher: mockPasswordHasher("x"),
+			}
+			err := c.HumanCheckRecoveryCode(ctx, "user1", tt.args.code, "org1", authReq)
+			assert.ErrorIs(t, err, tt.err)
+		})
+	}
+}
+
+func TestCheckRecoveryCode(t *testing.T) {
+	ctx := context.Background()
+	sessionAgg := &session.NewAggregate("sessionID", "instance1").Aggregate
+	userAgg := &user.NewAggregate("user1", "org1").Aggregate
+	type fields struct {
+		eventstore func(*testing.T) *eventstore.Eventstore
+		userID     string
+	}
+	type res struct {
+		err           error
+		errCommands   []eventstore.Command
+		eventCommands []eventstore.Command
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		code   string
+		res    res
+	}{
+		{
+			name: "missing user",
+			fields: fields{
+				eventstore: expectEventstore(),
+			},
+			code: "code1",
+			res: res{
+				err: zerrors.ThrowInvalidArgument(nil, "COMMAND-Rc6um", "Errors.User.UserIDMissing"),
+			},
+		},
+		{
+			name: "wrong code",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(user.NewHumanRecoveryCodesGeneratedEvent(ctx, userAgg, []string{"$plain$x$code1"})),
+					),
+					expectFilter(), // recheck
+					expectFilter(), // org lockout policy
+					expectFilter(), // instance lockout policy
+				),
+				userID: "user1",
+			},
+			code: "wrong",
+			res: res{
+				err: zerrors.ThrowInvalidArgument(nil, "COMMAND-Rc0in", "Errors.User.MFA.RecoveryCodes.Invalid"),
+				errCommands: []eventstore.Command{
+					user.NewHumanRecoveryCodeCheckFailedEvent(ctx, userAgg, nil),
+				},
+			},
+		},
+		{
+			name: "check ok",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(user.NewHumanRecoveryCodesGeneratedEvent(ctx, userAgg, []string{"$plain$x$code1"})),
+					),
+					expectFilter(), // recheck
+				),
+				userID: "user1",
+			},
+			code: "code1",
+			res: res{
+				eventCommands: []eventstore.Command{
+					user.NewHumanRecoveryCodeCheckSucceededEvent(ctx, userAgg, 0, "$plain$x$code1", nil),
+					session.NewRecoveryCodeCheckedEvent(ctx, sessionAgg, testNow),
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cmd := &SessionCommands{
+				sessionWriteModel: &SessionWriteModel{
+					UserID:    tt.fields.userID,
+					aggregate: sessionAgg,
+				},
+				eventstore:   tt.fields.eventstore(t),
+				secretHasher: mockPasswordHasher("x"),
+				now: func() time.Time {
+					return testNow
+				},
+			}
+			gotCmds, err := CheckRecoveryCode(tt.code)(ctx, cmd)
+			assert.ErrorIs(t, err, tt.res.err)
+			assert.Equal(t, tt.res.errCommands, gotCmds)
+			assert.Equal(t, tt.res.eventCommands, cmd.eventCommands)
+		})
+	}
+}
@@ -446,6 +446,9 @@ func TestCommandSide_AddHuman(t *testing.T) {
 								false,
 								false,
 								false,
+								0,
+								false,
+								false,
 							),
 						),
 					),
@@ -516,6 +519,9 @@ func TestCommandSide_AddHuman(t *testing.T) {
 								false,
 								false,
 								false,
+								0,
+								false,
+								false,
 							),
 						),
 					),
@@ -589,6 +595,9 @@ func TestCommandSide_AddHuman(t *testing.T) {
 								false,
 								false,
 								false,
+								0,
+								false,
+								false,
 							),
 						),
 					),
@@ -663,6 +672,9 @@ func TestCommandSide_AddHuman(t *testing.T) {
 								false,
 								false,
 								false,
+								0,
+								false,
+								false,
 							),
 						),
 					),
@@ -723,6 +735,9 @@ func TestCommandSide_AddHuman(t *testing.T) {
 								false,
 								false,
 								false,
+								0,
+								false,
+								false,
 							),
 						),
 					),
@@ -786,6 +801,9 @@ func TestCommandSide_AddHuman(t *testing.T) {
 								false,
 								false,
 								false,
+								0,
+								false,
+								false,
 							),
 						),
 					),
@@ -849,6 +867,9 @@ func TestCommandSide_AddHuman(t *testing.T) {
 								false,
 								false,
 								false,
+								0,
+								false,
+								false,
 							),
 						),
 					),
@@ -972,6 +993,9 @@ func TestCommandSide_AddHuman(t *testing.T) {
 								false,
 								false,
 								false,
+								0,
+								false,
+								false,
 							),
 						),
 					),
@@ -1051,6 +1075,9 @@ func TestCommandSide_AddHuman(t *testing.T) {
 								false,
 								false,
 								false,
+								0,
+								false,
+								false,
 							),
 						),
 					),
@@ -1224,6 +1251,9 @@ func TestCommandSide_AddHuman(t *testing.T) {
 								false,
 								false,
 								false,
+								0,
+								false,
+								false,
 							),
 						),
 					),
@@ -1564,6 +1594,9 @@ func TestCommandSide_ImportHuman(t *testing.T) {
 										false,
 										false,
 										false,
+										0,
+										false,
+										false,
 									),
 								),
 							),
@@ -1608,6 +1641,9 @@ func TestCommandSide_ImportHuman(t *testing.T) {
 										false,
 										false,
 										false,
+										0,
+										false,
+										false,
 									),
 								),
 							),
@@ -1694,6 +1730,9 @@ func TestCommandSide_ImportHuman(t *testing.T) {
 										false,
 										false,
 										false,
+										0,
+										false,
+										false,
 									),
 								),
 							),
@@ -1774,6 +1813,9 @@ func TestCommandSide_ImportHuman(t *testing.T) {
 										false,
 										false,
 										false,
+										0,
+										false,
+										false,
 									),
 								),
 							),
@@ -1873,6 +1915,9 @@ func TestCommandSide_ImportHuman(t *testing.T) {
 										false,
 										false,
 										false,
+										0,
+										false,
+										false,
 									),
 								),
 							),
@@ -1976,6 +2021,9 @@ func TestCommandSide_ImportHuman(t *testing.T) {
 										false,
 										false,
 										false,
+										0,
+										false,
+										false,
 									),
 								),
 							),
@@ -2111,6 +2159,9 @@ func TestCommandSide_ImportHuman(t *testing.T) {
 										false,
 										false,
 										false,
+										0,
+										false,
+										false,
 									),
 								),
 							),
@@ -2206,6 +2257,9 @@ func TestCommandSide_ImportHuman(t *testing.T) {
 										false,
 										false,
 										false,
+										0,
+										false,
+										false,
 									),
 								),
 							),
@@ -2291,6 +2345,9 @@ func TestCommandSide_ImportHuman(t *testing.T) {
 										false,
 										false,
 										false,
+										0,
+										false,
+										false,
 									),
 								),
 							),
@@ -2377,6 +2434,9 @@ func TestCommandSide_ImportHuman(t *testing.T) {
 										false,
 										false,
 										false,
+										0,
+										false,
+										false,
 									),
 								),
 							),
@@ -2522,6 +2582,9 @@ func TestCommandSide_ImportHuman(t *testing.T) {
 										false,
 										false,
 										false,
+										0,
+										false,
+										false,
 									),
 								),
 							),
@@ -2665,6 +2728,9 @@ func TestCommandSide_ImportHuman(t *testing.T) {
 										false,
 										false,
 										false,
+										0,
+										false,
+										false,
 									),
 								),
 							),
@@ -2837,6 +2903,9 @@ func TestCommandSide_ImportHuman(t *testing.T) {
 										false,
 										false,
 										false,
+										0,
+										false,
+										false,
 									),
 								),
 							),
@@ -3479,6 +3548,9 @@ func TestAddHumanCommand(t *testing.T) {
 									true,
 									true,
 									true,
+									0,
+									false,
+									false,
 								),
 							}, nil
 						}).
@@ -3532,6 +3604,9 @@ func TestAddHumanCommand(t *testing.T) {
 									false,
 									false,
 									false,
+									0,
+									false,
+									false,
 								),
 							}, nil
 						}).
@@ -3603,6 +3678,9 @@ func TestAddHumanCommand(t *testing.T) {
 									false,
 									false,
 									false,
+									0,
+									false,
+									false,
 								),
 							}, nil
 						}).
@@ -3675,6 +3753,9 @@ func TestAddHumanCommand(t *testing.T) {
 									false,
 									false,
 									false,
+									0,
+									false,
+									false,
 								),
 							}, nil
 						}).
@@ -3747,6 +3828,9 @@ func TestAddHumanCommand(t *testing.T) {
 									false,
 									false,
 									false,
+									0,
+									false,
+									false,
 								),
 							}, nil
 						}).
@@ -0,0 +1,164 @@
+package command
+
+import (
+	"context"
+	"time"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/session"
+	"github.com/zitadel/zitadel/internal/repository/user"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+type TrustedDeviceAdded struct {
+	*domain.ObjectDetails
+	ID         string
+	Token      string
+	Expiration time.Time
+}
+
+// AddTrustedDevice registers the user agent (browser) of a human user as trusted device.
+// The returned token has to be provided together with the ID in a session check ([CheckTrustedDevice])
+// to satisfy the multi-factor check for the lifetime of the login policy.
+func (c *Commands) AddTrustedDevice(ctx context.Context, userID, resourceOwner, name, userAgentID string) (_ *TrustedDeviceAdded, err error) {
+	if userID == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Td8sw", "Errors.User.UserIDMissing")
+	}
+	if userAgentID == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Td9ge", "Errors.User.TrustedDevice.UserAgentIDMissing")
+	}
+	existingUser, err := userWriteModelByID(ctx, c.eventstore.Filter, userID, resourceOwner)
+	if err != nil {
+		return nil, err
+	}
+	if !isUserStateExists(existingUser.UserState) {
+		return nil, zerrors.ThrowNotFound(nil, "COMMAND-Td2nf", "Errors.User.NotFound")
+	}
+	if existingUser.UserType != domain.UserTypeHuman {
+		return nil, zerrors.ThrowPreconditionFailed(nil, "COMMAND-Td3hu", "Errors.User.NotHuman")
+	}
+	if err := c.checkPermissionUpdateUser(ctx, existingUser.ResourceOwner, existingUser.AggregateID); err != nil {
+		return nil, err
+	}
+	policy, err := c.getOrgLoginPolicy(ctx, existingUser.ResourceOwner)
+	if err != nil {
+		return nil, err
+	}
+	if policy.TrustedDeviceLifetime <= 0 {
+		return nil, zerrors.ThrowPreconditionFailed(nil, "COMMAND-Td4di", "Errors.User.TrustedDevice.Disabled")
+	}
+	deviceID, err := c.idGenerator.Next()
+	if err != nil {
+		return nil, err
+	}
+	encodedHash, token, err := c.newHashedSecret(ctx, c.eventstore.Filter)
+	if err != nil {
+		return nil, err
+	}
+	writeModel := NewHumanTrustedDeviceWriteModel(existingUser.AggregateID, deviceID, existingUser.ResourceOwner)
+	err = c.pushAppendAndReduce(ctx, writeModel, user.NewHumanTrustedDeviceAddedEvent(
+		ctx,
+		UserAggregateFromWriteModel(&existingUser.WriteModel),
+		deviceID,
+		name,
+		userAgentID,
+		encodedHash,
+		policy.TrustedDeviceLifetime,
+	))
+	if err != nil {
+		return nil, err
+	}
+	return &TrustedDeviceAdded{
+		ObjectDetails: writeModelToObjectDetails(&writeModel.WriteModel),
+		ID:            deviceID,
+		Token:         token,
+		Expiration:    writeModel.AddedAt.Add(writeModel.Lifetime),
+	}, nil
+}
+
+// RemoveTrustedDevice revokes a trusted device, so it can no longer be used to satisfy the multi-factor check.
+func (c *Commands) RemoveTrustedDevice(ctx context.Context, userID, resourceOwner, deviceID string) (*domain.ObjectDetails, error) {
+	if userID == "" || deviceID == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Td5id", "Errors.IDMissing")
+	}
+	existingDevice, err := c.trustedDeviceWriteModelByID(ctx, userID, deviceID, resourceOwner)
+	if err != nil {
+		return nil, err
+	}
+	if !existingDevice.Exists() {
+		return nil, zerrors.ThrowNotFound(nil, "COMMAND-Td6nf", "Errors.User.TrustedDevice.NotFound")
+	}
+	if err := c.checkPermissionUpdateUser(ctx, existingDevice.ResourceOwner, existingDevice.AggregateID); err != nil {
+		return nil, err
+	}
+	err = c.pushAppendAndReduce(ctx, existingDevice, user.NewHumanTrustedDeviceRemovedEvent(
+		ctx,
+		UserAggregateFromWriteModel(&existingDevice.WriteModel),
+		deviceID,
+	))
+	if err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&existingDevice.WriteModel), nil
+}
+
+func (c *Commands) trustedDeviceWriteModelByID(ctx context.Context, userID, deviceID, resourceOwner string) (writeModel *HumanTrustedDeviceWriteModel, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	writeModel = NewHumanTrustedDeviceWriteModel(userID, deviceID, resourceOwner)
+	err = c.eventstore.FilterToQueryReducer(ctx, writeModel)
+	if err != nil {
+		return nil, err
+	}
+	return writeModel, nil
+}
+
+// CheckTrustedDevice defines a check of a trusted device to be executed for a session update.
+// The device must belong to the user of the session, be bound to the user agent of the session
+// and still be within the trusted device lifetime of the login policy.
+func CheckTrustedDevice(deviceID, token string) SessionCommand {
+	return func(ctx context.Context, cmd *SessionCommands) ([]eventstore.Command, error) {
+		if cmd.sessionWriteModel.UserID == "" {
+			return nil, zerrors.ThrowPreconditionFailed(nil, "COMMAND-Td7um", "Errors.User.UserIDMissing")
+		}
+		writeModel := NewHumanTrustedDeviceWriteModel(cmd.sessionWriteModel.UserID, deviceID, cmd.sessionWriteModel.UserResourceOwner)
+		if err := cmd.eventstore.FilterToQueryReducer(ctx, writeModel); err != nil {
+			return nil, err
+		}
+		if !writeModel.Exists() {
+			return nil, zerrors.ThrowNotFound(nil, "COMMAND-Td8nf", "Errors.User.TrustedDevice.NotFound")
+		}
+		userAgent := cmd.sessionWriteModel.UserAgent
+		if userAgent == nil || userAgent.FingerprintID == nil || *userAgent.FingerprintID != writeModel.UserAgentID {
+			return nil, zerrors.ThrowPreconditionFailed(nil, "COMMAND-Td9ua", "Errors.User.TrustedDevice.Invalid")
+		}
+		policy, err := cmd.getLoginPolicy(ctx, cmd.sessionWriteModel.UserResourceOwner)
+		if err != nil {
+			return nil, err
+		}
+		if policy.TrustedDeviceLifetime <= 0 {
+			return nil, zerrors.ThrowPreconditionFailed(nil, "COMMAND-Td0di", "Errors.User.TrustedDevice.Disabled")
+		}
+		// the lifetime might have been reduced since the device was added
+		lifetime := min(writeModel.Lifetime, policy.TrustedDeviceLifetime)
+		now := cmd.now()
+		if now.After(writeModel.AddedAt.Add(lifetime)) {
+			return nil, zerrors.ThrowPreconditionFailed(nil, "COMMAND-Td1ex", "Errors.User.TrustedDevice.Invalid")
+		}
+		if _, err := cmd.secretHasher.Verify(writeModel.TokenHash, token); err != nil {
+			return nil, zerrors.ThrowPreconditionFailed(err, "COMMAND-Td2to", "Errors.User.TrustedDevice.Invalid")
+		}
+		cmd.TrustedDeviceChecked(ctx, now, deviceID, UserAggregateFromWriteModel(&writeModel.WriteModel))
+		return nil, nil
+	}
+}
+
+func (s *SessionCommands) TrustedDeviceChecked(ctx context.Context, checkedAt time.Time, deviceID string, userAgg *eventstore.Aggregate) {
+	s.eventCommands = append(s.eventCommands,
+		session.NewTrustedDeviceCheckedEvent(ctx, s.sessionWriteModel.aggregate, checkedAt, deviceID),
+		user.NewHumanTrustedDeviceUsedEvent(ctx, userAgg, deviceID),
+	)
+}
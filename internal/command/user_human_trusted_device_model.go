@@ -0,0 +1,90 @@
+package command
+
+import (
+	"time"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/user"
+)
+
+type HumanTrustedDeviceWriteModel struct {
+	eventstore.WriteModel
+
+	DeviceID    string
+	Name        string
+	UserAgentID string
+	TokenHash   string
+	Lifetime    time.Duration
+	AddedAt     time.Time
+
+	State domain.TrustedDeviceState
+}
+
+func NewHumanTrustedDeviceWriteModel(userID, deviceID, resourceOwner string) *HumanTrustedDeviceWriteModel {
+	return &HumanTrustedDeviceWriteModel{
+		WriteModel: eventstore.WriteModel{
+			AggregateID:   userID,
+			ResourceOwner: resourceOwner,
+		},
+		DeviceID: deviceID,
+	}
+}
+
+func (wm *HumanTrustedDeviceWriteModel) AppendEvents(events ...eventstore.Event) {
+	for _, event := range events {
+		switch e := event.(type) {
+		case *user.HumanTrustedDeviceAddedEvent:
+			if wm.DeviceID != e.DeviceID {
+				continue
+			}
+			wm.WriteModel.AppendEvents(e)
+		case *user.HumanTrustedDeviceRemovedEvent:
+			if wm.DeviceID != e.DeviceID {
+				continue
+			}
+			wm.WriteModel.AppendEvents(e)
+		case *user.UserRemovedEvent:
+			wm.WriteModel.AppendEvents(e)
+		}
+	}
+}
+
+func (wm *HumanTrustedDeviceWriteModel) Reduce() error {
+	for _, event := range wm.Events {
+		switch e := event.(type) {
+		case *user.HumanTrustedDeviceAddedEvent:
+			wm.Name = e.Name
+			wm.UserAgentID = e.UserAgentID
+			wm.TokenHash = e.TokenHash
+			wm.Lifetime = e.Lifetime
+			wm.AddedAt = e.CreationDate()
+			wm.State = domain.TrustedDeviceStateActive
+		case *user.HumanTrustedDeviceRemovedEvent:
+			wm.State = domain.TrustedDeviceStateRemoved
+		case *user.UserRemovedEvent:
+			wm.State = domain.TrustedDeviceStateRemoved
+		}
+	}
+	return wm.WriteModel.Reduce()
+}
+
+func (wm *HumanTrustedDeviceWriteModel) Query() *eventstore.SearchQueryBuilder {
+	query := eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		AddQuery().
+		AggregateTypes(user.AggregateType).
+		AggregateIDs(wm.AggregateID).
+		EventTypes(
+			user.HumanTrustedDeviceAddedType,
+			user.HumanTrustedDeviceRemovedType,
+			user.UserRemovedType).
+		Builder()
+	if wm.ResourceOwner != "" {
+		query.ResourceOwner(wm.ResourceOwner)
+	}
+	return query
+}
+
+func (wm *HumanTrustedDeviceWriteModel) Exists() bool {
+	return wm.State == domain.TrustedDeviceStateActive
+}
@@ -0,0 +1,538 @@
+package command
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/muhlemmer/gu"
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/text/language"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/id"
+	"github.com/zitadel/zitadel/internal/id/mock"
+	"github.com/zitadel/zitadel/internal/repository/org"
+	"github.com/zitadel/zitadel/internal/repository/session"
+	"github.com/zitadel/zitadel/internal/repository/user"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+func trustedDeviceTestHumanAddedEvent() eventstore.Command {
+	return user.NewHumanAddedEvent(context.Background(),
+		&user.NewAggregate("user1", "org1").Aggregate,
+		"username",
+		"firstname",
+		"lastname",
+		"nickname",
+		"displayname",
+		language.German,
+		domain.GenderUnspecified,
+		"email@test.ch",
+		true,
+	)
+}
+
+func trustedDeviceTestLoginPolicyAddedEvent(trustedDeviceLifetime time.Duration) eventstore.Command {
+	return org.NewLoginPolicyAddedEvent(context.Background(),
+		&org.NewAggregate("org1").Aggregate,
+		true,
+		true,
+		true,
+		true,
+		true,
+		true,
+		true,
+		true,
+		false,
+		false,
+		domain.PasswordlessTypeAllowed,
+		"",
+		time.Hour*1,
+		time.Hour*2,
+		time.Hour*3,
+		time.Hour*4,
+		time.Hour*5,
+		false,
+		trustedDeviceLifetime,
+		false,
+		false,
+	)
+}
+
+func TestCommands_AddTrustedDevice(t *testing.T) {
+	type fields struct {
+		eventstore      func(*testing.T) *eventstore.Eventstore
+		checkPermission domain.PermissionCheck
+		idGenerator     id.Generator
+	}
+	type args struct {
+		userID      string
+		userAgentID string
+	}
+	type res struct {
+		want *TrustedDeviceAdded
+		err  error
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			name: "missing user id",
+			fields: fields{
+				eventstore: expectEventstore(),
+			},
+			args: args{
+				userAgentID: "agent1",
+			},
+			res: res{
+				err: zerrors.ThrowInvalidArgument(nil, "COMMAND-Td8sw", "Errors.User.UserIDMissing"),
+			},
+		},
+		{
+			name: "missing user agent id",
+			fields: fields{
+				eventstore: expectEventstore(),
+			},
+			args: args{
+				userID: "user1",
+			},
+			res: res{
+				err: zerrors.ThrowInvalidArgument(nil, "COMMAND-Td9ge", "Errors.User.TrustedDevice.UserAgentIDMissing"),
+			},
+		},
+		{
+			name: "user not found",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(),
+				),
+			},
+			args: args{
+				userID:      "user1",
+				userAgentID: "agent1",
+			},
+			res: res{
+				err: zerrors.ThrowNotFound(nil, "COMMAND-Td2nf", "Errors.User.NotFound"),
+			},
+		},
+		{
+			name: "no permission",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(trustedDeviceTestHumanAddedEvent()),
+					),
+				),
+				checkPermission: newMockPermissionCheckNotAllowed(),
+			},
+			args: args{
+				userID:      "user1",
+				userAgentID: "agent1",
+			},
+			res: res{
+				err: zerrors.ThrowPermissionDenied(nil, "AUTHZ-HKJD33", "Errors.PermissionDenied"),
+			},
+		},
+		{
+			name: "trusted devices disabled",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(trustedDeviceTestHumanAddedEvent()),
+					),
+					expectFilter(
+						eventFromEventPusher(trustedDeviceTestLoginPolicyAddedEvent(0)),
+					),
+				),
+				checkPermission: newMockPermissionCheckAllowed(),
+			},
+			args: args{
+				userID:      "user1",
+				userAgentID: "agent1",
+			},
+			res: res{
+				err: zerrors.ThrowPreconditionFailed(nil, "COMMAND-Td4di", "Errors.User.TrustedDevice.Disabled"),
+			},
+		},
+		{
+			name: "add ok",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(trustedDeviceTestHumanAddedEvent()),
+					),
+					expectFilter(
+						eventFromEventPusher(trustedDeviceTestLoginPolicyAddedEvent(time.Hour)),
+					),
+					expectPush(
+						user.NewHumanTrustedDeviceAddedEvent(context.Background(),
+							&user.NewAggregate("user1", "org1").Aggregate,
+							"device1",
+							"name",
+							"agent1",
+							"secret",
+							time.Hour,
+						),
+					),
+				),
+				checkPermission: newMockPermissionCheckAllowed(),
+				idGenerator:     mock.NewIDGeneratorExpectIDs(t, "device1"),
+			},
+			args: args{
+				userID:      "user1",
+				userAgentID: "agent1",
+			},
+			res: res{
+				want: &TrustedDeviceAdded{
+					ObjectDetails: &domain.ObjectDetails{
+						ResourceOwner: "org1",
+					},
+					ID:         "device1",
+					Token:      "secret",
+					Expiration: time.Time{}.Add(time.Hour),
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore:      tt.fields.eventstore(t),
+				checkPermission: tt.fields.checkPermission,
+				idGenerator:     tt.fields.idGenerator,
+				newHashedSecret: mockHashedSecret("secret"),
+			}
+			got, err := c.AddTrustedDevice(context.Background(), tt.args.userID, "org1", "name", tt.args.userAgentID)
+			assert.ErrorIs(t, err, tt.res.err)
+			if tt.res.want != nil {
+				assertObjectDetails(t, tt.res.want.ObjectDetails, got.ObjectDetails)
+				assert.Equal(t, tt.res.want.ID, got.ID)
+				assert.Equal(t, tt.res.want.Token, got.Token)
+				assert.Equal(t, tt.res.want.Expiration, got.Expiration)
+			}
+		})
+	}
+}
+
+func TestCommands_RemoveTrustedDevice(t *testing.T) {
+	type fields struct {
+		eventstore      func(*testing.T) *eventstore.Eventstore
+		checkPermission domain.PermissionCheck
+	}
+	type args struct {
+		userID   string
+		deviceID string
+	}
+	type res struct {
+		want *domain.ObjectDetails
+		err  error
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			name: "missing id",
+			fields: fields{
+				eventstore: expectEventstore(),
+			},
+			args: args{
+				userID: "user1",
+			},
+			res: res{
+				err: zerrors.ThrowInvalidArgument(nil, "COMMAND-Td5id", "Errors.IDMissing"),
+			},
+		},
+		{
+			name: "device not found",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(),
+				),
+			},
+			args: args{
+				userID:   "user1",
+				deviceID: "device1",
+			},
+			res: res{
+				err: zerrors.ThrowNotFound(nil, "COMMAND-Td6nf", "Errors.User.TrustedDevice.NotFound"),
+			},
+		},
+		{
+			name: "device already removed",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(user.NewHumanTrustedDeviceAddedEvent(context.Background(),
+							&user.NewAggregate("user1", "org1").Aggregate,
+							"device1", "name", "agent1", "secret", time.Hour,
+						)),
+						eventFromEventPusher(user.NewHumanTrustedDeviceRemovedEvent(context.Background(),
+							&user.NewAggregate("user1", "org1").Aggregate,
+							"device1",
+						)),
+					),
+				),
+			},
+			args: args{
+				userID:   "user1",
+				deviceID: "device1",
+			},
+			res: res{
+				err: zerrors.ThrowNotFound(nil, "COMMAND-Td6nf", "Errors.User.TrustedDevice.NotFound"),
+			},
+		},
+		{
+			name: "no permission",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(user.NewHumanTrustedDeviceAddedEvent(context.Background(),
+							&user.NewAggregate("user1", "org1").Aggregate,
+							"device1", "name", "agent1", "secret", time.Hour,
+						)),
+					),
+				),
+				checkPermission: newMockPermissionCheckNotAllowed(),
+			},
+			args: args{
+				userID:   "user1",
+				deviceID: "device1",
+			},
+			res: res{
+				err: zerrors.ThrowPermissionDenied(nil, "AUTHZ-HKJD33", "Errors.PermissionDenied"),
+			},
+		},
+		{
+			name: "remove ok",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(user.NewHumanTrustedDeviceAddedEvent(context.Background(),
+							&user.NewAggregate("user1", "org1").Aggregate,
+							"device1", "name", "agent1", "secret", time.Hour,
+						)),
+					),
+					expectPush(
+						user.NewHumanTrustedDeviceRemovedEvent(context.Background(),
+							&user.NewAggregate("user1", "org1").Aggregate,
+							"device1",
+						),
+					),
+				),
+				checkPermission: newMockPermissionCheckAllowed(),
+			},
+			args: args{
+				userID:   "user1",
+				deviceID: "device1",
+			},
+			res: res{
+				want: &domain.ObjectDetails{
+					ResourceOwner: "org1",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore:      tt.fields.eventstore(t),
+				checkPermission: tt.fields.checkPermission,
+			}
+			got, err := c.RemoveTrustedDevice(context.Background(), tt.args.userID, "org1", tt.args.deviceID)
+			assert.ErrorIs(t, err, tt.res.err)
+			if tt.res.want != nil {
+				assertObjectDetails(t, tt.res.want, got)
+			}
+		})
+	}
+}
+
+func TestCheckTrustedDevice(t *testing.T) {
+	sessionAgg := &session.NewAggregate("sessionID", "instance1").Aggregate
+	userAgg := &user.NewAggregate("user1", "org1").Aggregate
+	type fields struct {
+		eventstore     func(*testing.T) *eventstore.Eventstore
+		userID         string
+		userAgent      *domain.UserAgent
+		getLoginPolicy func(ctx context.Context, orgID string) (*domain.LoginPolicy, error)
+	}
+	type args struct {
+		token string
+	}
+	type res struct {
+		err      error
+		commands []eventstore.Command
+	}
+	enabledPolicy := func(ctx context.Context, orgID string) (*domain.LoginPolicy, error) {
+		return &domain.LoginPolicy{TrustedDeviceLifetime: time.Hour}, nil
+	}
+	deviceAdded := func() eventstore.Command {
+		return user.NewHumanTrustedDeviceAddedEvent(context.Background(), userAgg,
+			"device1", "name", "agent1", "$plain$x$token", time.Hour,
+		)
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			name: "missing user",
+			fields: fields{
+				eventstore: expectEventstore(),
+			},
+			args: args{
+				token: "token",
+			},
+			res: res{
+				err: zerrors.ThrowPreconditionFailed(nil, "COMMAND-Td7um", "Errors.User.UserIDMissing"),
+			},
+		},
+		{
+			name: "device not found",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(),
+				),
+				userID:    "user1",
+				userAgent: &domain.UserAgent{FingerprintID: gu.Ptr("agent1")},
+			},
+			args: args{
+				token: "token",
+			},
+			res: res{
+				err: zerrors.ThrowNotFound(nil, "COMMAND-Td8nf", "Errors.User.TrustedDevice.NotFound"),
+			},
+		},
+		{
+			name: "other user agent",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusherWithCreationDateNow(deviceAdded()),
+					),
+				),
+				userID:    "user1",
+				userAgent: &domain.UserAgent{FingerprintID: gu.Ptr("agent2")},
+			},
+			args: args{
+				token: "token",
+			},
+			res: res{
+				err: zerrors.ThrowPreconditionFailed(nil, "COMMAND-Td9ua", "Errors.User.TrustedDevice.Invalid"),
+			},
+		},
+		{
+			name: "trusted devices disabled",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusherWithCreationDateNow(deviceAdded()),
+					),
+				),
+				userID:    "user1",
+				userAgent: &domain.UserAgent{FingerprintID: gu.Ptr("agent1")},
+				getLoginPolicy: func(ctx context.Context, orgID string) (*domain.LoginPolicy, error) {
+					return &domain.LoginPolicy{}, nil
+				},
+			},
+			args: args{
+				token: "token",
+			},
+			res: res{
+				err: zerrors.ThrowPreconditionFailed(nil, "COMMAND-Td0di", "Errors.User.TrustedDevice.Disabled"),
+			},
+		},
+		{
+			name: "expired",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(deviceAdded()),
+					),
+				),
+				userID:         "user1",
+				userAgent:      &domain.UserAgent{FingerprintID: gu.Ptr("agent1")},
+				getLoginPolicy: enabledPolicy,
+			},
+			args: args{
+				token: "token",
+			},
+			res: res{
+				err: zerrors.ThrowPreconditionFailed(nil, "COMMAND-Td1ex", "Errors.User.TrustedDevice.Invalid"),
+			},
+		},
+		{
+			name: "invalid token",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusherWithCreationDateNow(deviceAdded()),
+					),
+				),
+				userID:         "user1",
+				userAgent:      &domain.UserAgent{FingerprintID: gu.Ptr("agent1")},
+				getLoginPolicy: enabledPolicy,
+			},
+			args: args{
+				token: "wrong",
+			},
+			res: res{
+				err: zerrors.ThrowPreconditionFailed(nil, "COMMAND-Td2to", "Errors.User.TrustedDevice.Invalid"),
+			},
+		},
+		{
+			name: "check ok",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusherWithCreationDateNow(deviceAdded()),
+					),
+				),
+				userID:         "user1",
+				userAgent:      &domain.UserAgent{FingerprintID: gu.Ptr("agent1")},
+				getLoginPolicy: enabledPolicy,
+			},
+			args: args{
+				token: "token",
+			},
+			res: res{
+				commands: []eventstore.Command{
+					session.NewTrustedDeviceCheckedEvent(context.Background(), sessionAgg, testNow, "device1"),
+					user.NewHumanTrustedDeviceUsedEvent(context.Background(), userAgg, "device1"),
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cmd := &SessionCommands{
+				sessionWriteModel: &SessionWriteModel{
+					UserID:            tt.fields.userID,
+					UserResourceOwner: "org1",
+					UserAgent:         tt.fields.userAgent,
+					aggregate:         sessionAgg,
+				},
+				eventstore:     tt.fields.eventstore(t),
+				getLoginPolicy: tt.fields.getLoginPolicy,
+				secretHasher:   mockPasswordHasher("x"),
+				now: func() time.Time {
+					return testNow
+				},
+			}
+			gotCmds, err := CheckTrustedDevice("device1", tt.args.token)(context.Background(), cmd)
+			assert.ErrorIs(t, err, tt.res.err)
+			assert.Empty(t, gotCmds)
+			assert.Equal(t, tt.res.commands, cmd.eventCommands)
+		})
+	}
+}
@@ -57,6 +57,9 @@ func Test_customPasswordComplexityPolicy(t *testing.T) {
 							true,
 							true,
 							true,
+							0,
+							false,
+							false,
 						),
 					}, nil
 				},
@@ -134,6 +137,9 @@ func Test_defaultPasswordComplexityPolicy(t *testing.T) {
 							true,
 							true,
 							true,
+							0,
+							false,
+							false,
 						),
 					}, nil
 				},
@@ -202,6 +208,9 @@ func Test_passwordComplexityPolicy(t *testing.T) {
 							true,
 							true,
 							true,
+							0,
+							false,
+							false,
 						),
 					}, nil
 				},
@@ -253,6 +262,9 @@ func Test_passwordComplexityPolicy(t *testing.T) {
 								true,
 								true,
 								true,
+								0,
+								false,
+								false,
 							),
 						}, nil
 					}).
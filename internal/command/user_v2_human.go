@@ -27,6 +27,9 @@ type ChangeHuman struct {
 
 	Password *Password
 
+	// ExpectedSequence rejects the change if the user was changed in the meantime
+	ExpectedSequence *eventstore.ExpectedSequence
+
 	// Details are set after a successful execution of the command
 	Details *domain.ObjectDetails
 
@@ -376,6 +379,7 @@ func (c *Commands) ChangeUserHuman(ctx context.Context, human *ChangeHuman, alg
 		human.Details = writeModelToObjectDetails(&existingHuman.WriteModel)
 		return nil
 	}
+	cmds[0] = eventstore.ExpectSequence(cmds[0], human.ExpectedSequence)
 	err = c.pushAppendAndReduce(ctx, existingHuman, cmds...)
 	if err != nil {
 		return err
@@ -481,6 +485,7 @@ func (c *Commands) changeUserPassword(ctx context.Context, cmds []eventstore.Com
 		ctx,
 		&wm.Aggregate().Aggregate,
 		wm.UserState,
+		wm.PasswordHistory(),
 		password.Password,
 		password.EncodedPasswordHash,
 		"",
@@ -425,6 +425,9 @@ func TestCommandSide_AddUserHuman(t *testing.T) {
 								false,
 								false,
 								false,
+								0,
+								false,
+								false,
 							),
 						),
 					),
@@ -555,6 +558,9 @@ func TestCommandSide_AddUserHuman(t *testing.T) {
 								false,
 								false,
 								false,
+								0,
+								false,
+								false,
 							),
 						),
 					),
@@ -629,6 +635,9 @@ func TestCommandSide_AddUserHuman(t *testing.T) {
 								false,
 								false,
 								false,
+								0,
+								false,
+								false,
 							),
 						),
 					),
@@ -704,6 +713,9 @@ func TestCommandSide_AddUserHuman(t *testing.T) {
 								false,
 								false,
 								false,
+								0,
+								false,
+								false,
 							),
 						),
 					),
@@ -768,6 +780,9 @@ func TestCommandSide_AddUserHuman(t *testing.T) {
 								false,
 								false,
 								false,
+								0,
+								false,
+								false,
 							),
 						),
 					),
@@ -832,6 +847,9 @@ func TestCommandSide_AddUserHuman(t *testing.T) {
 								false,
 								false,
 								false,
+								0,
+								false,
+								false,
 							),
 						),
 					),
@@ -957,6 +975,9 @@ func TestCommandSide_AddUserHuman(t *testing.T) {
 								false,
 								false,
 								false,
+								0,
+								false,
+								false,
 							),
 						),
 					),
@@ -1037,6 +1058,9 @@ func TestCommandSide_AddUserHuman(t *testing.T) {
 								false,
 								false,
 								false,
+								0,
+								false,
+								false,
 							),
 						),
 					),
@@ -1147,6 +1171,9 @@ func TestCommandSide_AddUserHuman(t *testing.T) {
 								false,
 								false,
 								false,
+								0,
+								false,
+								false,
 							),
 						),
 					),
@@ -1318,6 +1345,9 @@ func TestCommandSide_AddUserHuman(t *testing.T) {
 								false,
 								false,
 								false,
+								0,
+								false,
+								false,
 							),
 						),
 					),
@@ -2236,6 +2266,55 @@ func TestCommandSide_ChangeUserHuman(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "change human username, expected sequence, ok",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							newAddHumanEvent("$plain$x$password", true, true, "", language.English),
+						),
+					),
+					expectFilter(
+						eventFromEventPusher(
+							org.NewDomainPolicyAddedEvent(context.Background(),
+								&userAgg.Aggregate,
+								true,
+								true,
+								true,
+							),
+						),
+					),
+					expectPush(
+						eventstore.ExpectSequence(
+							user.NewUsernameChangedEvent(context.Background(),
+								&userAgg.Aggregate,
+								"username",
+								"changed",
+								true,
+							),
+							&eventstore.ExpectedSequence{Sequence: 1, EventTypes: []eventstore.EventType{user.UserUserNameChangedType}},
+						),
+					),
+				),
+				checkPermission: newMockPermissionCheckAllowed(),
+			},
+			args: args{
+				ctx:   context.Background(),
+				orgID: "org1",
+				human: &ChangeHuman{
+					Username:         gu.Ptr("changed"),
+					ExpectedSequence: &eventstore.ExpectedSequence{Sequence: 1, EventTypes: []eventstore.EventType{user.UserUserNameChangedType}},
+				},
+			},
+			res: res{
+				want: &domain.ObjectDetails{
+					Sequence:      0,
+					EventDate:     time.Time{},
+					ResourceOwner: "org1",
+				},
+			},
+		},
 		{
 			name: "change human username, no change",
 			fields: fields{
@@ -3029,6 +3108,9 @@ func TestCommandSide_ChangeUserHuman(t *testing.T) {
 								true,
 								false,
 								false,
+								0,
+								false,
+								false,
 							),
 						),
 					),
@@ -3133,6 +3215,9 @@ func TestCommandSide_ChangeUserHuman(t *testing.T) {
 								false,
 								false,
 								false,
+								0,
+								false,
+								false,
 							),
 						),
 					),
@@ -3189,6 +3274,9 @@ func TestCommandSide_ChangeUserHuman(t *testing.T) {
 								false,
 								false,
 								false,
+								0,
+								false,
+								false,
 							),
 						),
 					),
@@ -3297,6 +3385,9 @@ func TestCommandSide_ChangeUserHuman(t *testing.T) {
 								false,
 								false,
 								false,
+								0,
+								false,
+								false,
 							),
 						),
 					),
@@ -3423,6 +3514,9 @@ func TestCommandSide_ChangeUserHuman(t *testing.T) {
 								true,
 								true,
 								true,
+								0,
+								false,
+								false,
 							),
 						),
 					),
@@ -3547,6 +3641,9 @@ func TestCommandSide_ChangeUserHuman(t *testing.T) {
 								false,
 								false,
 								false,
+								0,
+								false,
+								false,
 							),
 						),
 					),
@@ -159,6 +159,7 @@ func (c *Commands) VerifyInviteCodeSetPassword(ctx context.Context, userID, code
 			ctx,
 			userAgg,
 			wm.UserState,
+			nil,
 			password,
 			"",
 			userAgentID,
@@ -1088,6 +1088,9 @@ func TestCommands_VerifyInviteCodeSetPassword(t *testing.T) {
 								true,
 								true,
 								true,
+								0,
+								false,
+								false,
 							),
 						),
 					),
@@ -1173,6 +1176,9 @@ func TestCommands_VerifyInviteCodeSetPassword(t *testing.T) {
 								true,
 								true,
 								true,
+								0,
+								false,
+								false,
 							),
 						),
 					),
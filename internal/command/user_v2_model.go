@@ -54,6 +54,9 @@ type UserV2WriteModel struct {
 	PasswordCodeGeneratorID    string
 	PasswordCodeVerificationID string
 
+	// PreviousPasswordEncodedHashes contains the hashes of the replaced passwords, newest first.
+	PreviousPasswordEncodedHashes []string
+
 	EmailWriteModel       bool
 	Email                 domain.EmailAddress
 	IsEmailVerified       bool
@@ -277,11 +280,14 @@ func (wm *UserV2WriteModel) Reduce() error {
 
 		case *user.HumanPasswordHashUpdatedEvent:
 			wm.PasswordEncodedHash = e.EncodedHash
+		case *user.HumanPasswordBreachedEvent:
+			wm.PasswordChangeRequired = true
 		case *user.HumanPasswordCheckFailedEvent:
 			wm.PasswordCheckFailedCount += 1
 		case *user.HumanPasswordCheckSucceededEvent:
 			wm.PasswordCheckFailedCount = 0
 		case *user.HumanPasswordChangedEvent:
+			wm.PreviousPasswordEncodedHashes = appendPasswordHistory(wm.PreviousPasswordEncodedHashes, wm.PasswordEncodedHash)
 			wm.PasswordEncodedHash = crypto.SecretOrEncodedHash(e.Secret, e.EncodedHash)
 			wm.PasswordChangeRequired = e.ChangeRequired
 			wm.EmptyPasswordCode()
@@ -378,6 +384,11 @@ func (wm *UserV2WriteModel) SetPasswordCodeSent(e *user.HumanPasswordCodeSentEve
 	wm.PasswordCodeVerificationID = e.GeneratorInfo.GetVerificationID()
 }
 
+// PasswordHistory returns the hash of the current password followed by the hashes of the previous ones.
+func (wm *UserV2WriteModel) PasswordHistory() []string {
+	return passwordHistory(wm.PasswordEncodedHash, wm.PreviousPasswordEncodedHashes)
+}
+
 func (wm *UserV2WriteModel) Query() *eventstore.SearchQueryBuilder {
 	// remove events are always processed
 	// and username is based for machine and human
@@ -462,6 +473,7 @@ func (wm *UserV2WriteModel) Query() *eventstore.SearchQueryBuilder {
 	if wm.PasswordWriteModel {
 		eventTypes = append(eventTypes,
 			user.HumanPasswordHashUpdatedType,
+			user.HumanPasswordBreachedType,
 
 			user.HumanPasswordChangedType,
 			user.UserV1PasswordChangedType,
@@ -1460,16 +1460,17 @@ func TestCommandSide_userHumanWriteModel_password(t *testing.T) {
 						ProcessedSequence: 0,
 						ResourceOwner:     "org1",
 					},
-					UserName:               "username",
-					FirstName:              "firstname",
-					LastName:               "lastname",
-					DisplayName:            "firstname lastname",
-					PreferredLanguage:      language.English,
-					PasswordEncodedHash:    "hash",
-					PasswordChangeRequired: false,
-					Email:                  "email@test.ch",
-					IsEmailVerified:        false,
-					UserState:              domain.UserStateActive,
+					UserName:                      "username",
+					FirstName:                     "firstname",
+					LastName:                      "lastname",
+					DisplayName:                   "firstname lastname",
+					PreferredLanguage:             language.English,
+					PasswordEncodedHash:           "hash",
+					PreviousPasswordEncodedHashes: []string{"$plain$x$password"},
+					PasswordChangeRequired:        false,
+					Email:                         "email@test.ch",
+					IsEmailVerified:               false,
+					UserState:                     domain.UserStateActive,
 				},
 			},
 		},
@@ -1586,16 +1587,17 @@ func TestCommandSide_userHumanWriteModel_password(t *testing.T) {
 						ProcessedSequence: 0,
 						ResourceOwner:     "org1",
 					},
-					UserName:               "username",
-					FirstName:              "firstname",
-					LastName:               "lastname",
-					DisplayName:            "firstname lastname",
-					PreferredLanguage:      language.English,
-					PasswordEncodedHash:    "hash",
-					PasswordChangeRequired: true,
-					Email:                  "email@test.ch",
-					IsEmailVerified:        false,
-					UserState:              domain.UserStateActive,
+					UserName:                      "username",
+					FirstName:                     "firstname",
+					LastName:                      "lastname",
+					DisplayName:                   "firstname lastname",
+					PreferredLanguage:             language.English,
+					PasswordEncodedHash:           "hash",
+					PreviousPasswordEncodedHashes: []string{"$plain$x$password"},
+					PasswordChangeRequired:        true,
+					Email:                         "email@test.ch",
+					IsEmailVerified:               false,
+					UserState:                     domain.UserStateActive,
 				},
 			},
 		},
@@ -109,6 +109,8 @@ func TestCommands_RegisterUserPasskey(t *testing.T) {
 							false, false, false,
 						),
 					)),
+					expectFilter(), // org passkey attestation policy
+					expectFilter(), // instance passkey attestation policy
 				),
 				idGenerator: id_mock.NewIDGeneratorExpectError(t, io.ErrClosedPipe),
 			},
@@ -377,6 +379,8 @@ func TestCommands_pushUserPasskey(t *testing.T) {
 				false, false, false,
 			),
 		)),
+		expectFilter(), // org passkey attestation policy
+		expectFilter(), // instance passkey attestation policy
 		expectFilter(eventFromEventPusher(
 			user.NewHumanWebAuthNAddedEvent(eventstore.NewBaseEventForPush(
 				ctx, &org.NewAggregate("org1").Aggregate, user.HumanPasswordlessTokenAddedType,
@@ -118,6 +118,8 @@ func TestCommands_RegisterUserU2F(t *testing.T) {
 							false, false, false,
 						),
 					)),
+					expectFilter(), // org passkey attestation policy
+					expectFilter(), // instance passkey attestation policy
 				),
 				idGenerator: id_mock.NewIDGeneratorExpectError(t, io.ErrClosedPipe),
 			},
@@ -179,6 +181,8 @@ func TestCommands_pushUserU2F(t *testing.T) {
 				false, false, false,
 			),
 		)),
+		expectFilter(), // org passkey attestation policy
+		expectFilter(), // instance passkey attestation policy
 		expectFilter(eventFromEventPusher(
 			user.NewHumanWebAuthNAddedEvent(eventstore.NewBaseEventForPush(
 				ctx, &org.NewAggregate("org1").Aggregate, user.HumanPasswordlessTokenAddedType,
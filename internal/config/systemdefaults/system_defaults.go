@@ -3,7 +3,10 @@ package systemdefaults
 import (
 	"time"
 
+	"github.com/zitadel/zitadel/internal/breached"
 	"github.com/zitadel/zitadel/internal/crypto"
+	"github.com/zitadel/zitadel/internal/risk"
+	"github.com/zitadel/zitadel/internal/throttle"
 )
 
 type SystemDefaults struct {
@@ -17,6 +20,9 @@ type SystemDefaults struct {
 	DefaultQueryLimit    uint64
 	MaxQueryLimit        uint64
 	MaxIdPIntentLifetime time.Duration
+	BreachedPasswords    breached.Config
+	LoginThrottling      throttle.Config
+	SessionRisk          risk.Config
 }
 
 type SecretGenerators struct {
@@ -24,30 +24,33 @@ const (
 type OIDCApp struct {
 	models.ObjectRoot
 
-	AppID                    string
-	AppName                  string
-	ClientID                 string
-	EncodedHash              string
-	ClientSecretString       string
-	RedirectUris             []string
-	ResponseTypes            []OIDCResponseType
-	GrantTypes               []OIDCGrantType
-	ApplicationType          OIDCApplicationType
-	AuthMethodType           OIDCAuthMethodType
-	PostLogoutRedirectUris   []string
-	OIDCVersion              OIDCVersion
-	Compliance               *Compliance
-	DevMode                  bool
-	AccessTokenType          OIDCTokenType
-	AccessTokenRoleAssertion bool
-	IDTokenRoleAssertion     bool
-	IDTokenUserinfoAssertion bool
-	ClockSkew                time.Duration
-	AdditionalOrigins        []string
-	SkipNativeAppSuccessPage bool
-	BackChannelLogoutURI     string
-	LoginVersion             LoginVersion
-	LoginBaseURI             string
+	AppID                            string
+	AppName                          string
+	ClientID                         string
+	EncodedHash                      string
+	ClientSecretString               string
+	RedirectUris                     []string
+	ResponseTypes                    []OIDCResponseType
+	GrantTypes                       []OIDCGrantType
+	ApplicationType                  OIDCApplicationType
+	AuthMethodType                   OIDCAuthMethodType
+	PostLogoutRedirectUris           []string
+	OIDCVersion                      OIDCVersion
+	Compliance                       *Compliance
+	DevMode                          bool
+	AccessTokenType                  OIDCTokenType
+	AccessTokenRoleAssertion         bool
+	IDTokenRoleAssertion             bool
+	IDTokenUserinfoAssertion         bool
+	ClockSkew                        time.Duration
+	AdditionalOrigins                []string
+	SkipNativeAppSuccessPage         bool
+	BackChannelLogoutURI             string
+	LoginVersion                     LoginVersion
+	LoginBaseURI                     string
+	RequirePushedAuthRequest         bool
+	RequireDPoP                      bool
+	BackChannelClientNotificationURI string
 
 	State AppState
 }
@@ -105,6 +108,7 @@ const (
 	OIDCGrantTypeRefreshToken
 	OIDCGrantTypeDeviceCode
 	OIDCGrantTypeTokenExchange
+	OIDCGrantTypeCIBA
 )
 
 type OIDCApplicationType int32
@@ -173,11 +177,14 @@ func RequiredOIDCGrantTypes(responseTypes []OIDCResponseType, grantTypesSet []OI
 	for _, r := range responseTypes {
 		switch r {
 		case OIDCResponseTypeCode:
-			// #5684 when "Device Code" is selected, "Authorization Code" is no longer a hard requirement
-			if !containsOIDCGrantType(grantTypesSet, OIDCGrantTypeDeviceCode) {
-				grantTypes = append(grantTypes, OIDCGrantTypeAuthorizationCode)
-			} else {
+			// #5684 when "Device Code" or "CIBA" is selected, "Authorization Code" is no longer a hard requirement
+			switch {
+			case containsOIDCGrantType(grantTypesSet, OIDCGrantTypeDeviceCode):
 				grantTypes = append(grantTypes, OIDCGrantTypeDeviceCode)
+			case containsOIDCGrantType(grantTypesSet, OIDCGrantTypeCIBA):
+				grantTypes = append(grantTypes, OIDCGrantTypeCIBA)
+			default:
+				grantTypes = append(grantTypes, OIDCGrantTypeAuthorizationCode)
 			}
 		case OIDCResponseTypeIDToken, OIDCResponseTypeIDTokenToken:
 			if !implicit {
@@ -203,6 +210,12 @@ func ContainsOIDCGrantTypes(shouldContain, list []OIDCGrantType) bool {
 	return true
 }
 
+// containsRedirectlessGrantType returns true if the grant types contain the device code or CIBA grant,
+// where the user authenticates on another device and no redirect is needed.
+func containsRedirectlessGrantType(grantTypes []OIDCGrantType) bool {
+	return containsOIDCGrantType(grantTypes, OIDCGrantTypeDeviceCode) || containsOIDCGrantType(grantTypes, OIDCGrantTypeCIBA)
+}
+
 func containsOIDCGrantType(grantTypes []OIDCGrantType, grantType OIDCGrantType) bool {
 	for _, gt := range grantTypes {
 		if gt == grantType {
@@ -241,7 +254,7 @@ func GetOIDCV1Compliance(appType OIDCApplicationType, grantTypes []OIDCGrantType
 }
 
 func checkGrantTypesCombination(compliance *Compliance, grantTypes []OIDCGrantType) {
-	if !containsOIDCGrantType(grantTypes, OIDCGrantTypeDeviceCode) && containsOIDCGrantType(grantTypes, OIDCGrantTypeRefreshToken) && !containsOIDCGrantType(grantTypes, OIDCGrantTypeAuthorizationCode) {
+	if !containsRedirectlessGrantType(grantTypes) && containsOIDCGrantType(grantTypes, OIDCGrantTypeRefreshToken) && !containsOIDCGrantType(grantTypes, OIDCGrantTypeAuthorizationCode) {
 		compliance.NoneCompliant = true
 		compliance.Problems = append(compliance.Problems, "Application.OIDC.V1.GrantType.Refresh.NoAuthCode")
 	}
@@ -249,7 +262,7 @@ func checkGrantTypesCombination(compliance *Compliance, grantTypes []OIDCGrantTy
 
 func checkRedirectURIs(compliance *Compliance, grantTypes []OIDCGrantType, appType OIDCApplicationType, redirectUris []string) {
 	// See #5684 for OIDCGrantTypeDeviceCode and redirectUris further explanation
-	if len(redirectUris) == 0 && (!containsOIDCGrantType(grantTypes, OIDCGrantTypeDeviceCode) || (containsOIDCGrantType(grantTypes, OIDCGrantTypeDeviceCode) && containsOIDCGrantType(grantTypes, OIDCGrantTypeAuthorizationCode))) {
+	if len(redirectUris) == 0 && (!containsRedirectlessGrantType(grantTypes) || containsOIDCGrantType(grantTypes, OIDCGrantTypeAuthorizationCode)) {
 		compliance.NoneCompliant = true
 		compliance.Problems = append([]string{"Application.OIDC.V1.NoRedirectUris"}, compliance.Problems...)
 	}
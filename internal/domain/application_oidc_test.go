@@ -241,6 +241,11 @@ func Test_checkGrantTypesCombination(t *testing.T) {
 			want:       &Compliance{},
 			grantTypes: []OIDCGrantType{OIDCGrantTypeDeviceCode, OIDCGrantTypeRefreshToken},
 		},
+		{
+			name:       "ciba and refresh token doesnt require OIDCGrantTypeAuthorizationCode",
+			want:       &Compliance{},
+			grantTypes: []OIDCGrantType{OIDCGrantTypeCIBA, OIDCGrantTypeRefreshToken},
+		},
 		{
 			name:       "refresh token and authorization code",
 			want:       &Compliance{},
@@ -284,6 +289,13 @@ func Test_checkRedirectURIs(t *testing.T) {
 			},
 			args: args{},
 		},
+		{
+			name: "no redirect uris, ciba",
+			want: &Compliance{},
+			args: args{
+				grantTypes: []OIDCGrantType{OIDCGrantTypeCIBA},
+			},
+		},
 		{
 			name: "implicit and authorization code",
 			want: &Compliance{
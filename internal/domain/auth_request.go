@@ -47,6 +47,7 @@ type AuthRequest struct {
 	LinkingUsers             []*ExternalUser
 	PossibleSteps            []NextStep `json:"-"`
 	PasswordVerified         bool
+	MagicLinkVerified        bool
 	IDPLoginChecked          bool
 	MFAsVerified             []MFAType
 	Audience                 []string
@@ -62,6 +63,8 @@ type AuthRequest struct {
 	OrgTranslations          []*CustomText
 	SAMLRequestID            string
 	RequestLocalAuth         bool
+	// AuthorizationDetailsConsent is the decision of the user on the requested authorization details
+	AuthorizationDetailsConsent AuthorizationDetailsConsentState
 	// orgID the policies were last loaded with
 	policyOrgID string
 	// SessionID is set to the computed sessionID of the login session table
@@ -77,10 +80,13 @@ func (a *AuthRequest) PolicyOrgID() string {
 }
 
 func (a *AuthRequest) AuthMethods() []UserAuthMethodType {
-	list := make([]UserAuthMethodType, 0, len(a.MFAsVerified)+2)
+	list := make([]UserAuthMethodType, 0, len(a.MFAsVerified)+3)
 	if a.PasswordVerified {
 		list = append(list, UserAuthMethodTypePassword)
 	}
+	if a.MagicLinkVerified {
+		list = append(list, UserAuthMethodTypeMagicLink)
+	}
 	if a.IDPLoginChecked {
 		list = append(list, UserAuthMethodTypeIDP)
 	}
@@ -140,6 +146,7 @@ const (
 	MFATypeU2FUserVerification
 	MFATypeOTPSMS
 	MFATypeOTPEmail
+	MFATypeRecoveryCode
 )
 
 func (m MFAType) UserAuthMethodType() UserAuthMethodType {
@@ -154,6 +161,8 @@ func (m MFAType) UserAuthMethodType() UserAuthMethodType {
 		return UserAuthMethodTypeOTPSMS
 	case MFATypeOTPEmail:
 		return UserAuthMethodTypeOTPEmail
+	case MFATypeRecoveryCode:
+		return UserAuthMethodTypeRecoveryCode
 	default:
 		return UserAuthMethodTypeUnspecified
 	}
@@ -259,6 +268,15 @@ func (a *AuthRequest) Done() bool {
 	return false
 }
 
+// RequestedAuthorizationDetails returns the authorization details requested by an OIDC client.
+func (a *AuthRequest) RequestedAuthorizationDetails() AuthorizationDetails {
+	oidcRequest, ok := a.Request.(*AuthRequestOIDC)
+	if !ok {
+		return nil
+	}
+	return oidcRequest.AuthorizationDetails
+}
+
 func (a *AuthRequest) PrivateLabelingOrgID(defaultID string) string {
 	if a.RequestedOrgID != "" {
 		return a.RequestedOrgID
@@ -274,10 +292,13 @@ func (a *AuthRequest) PrivateLabelingOrgID(defaultID string) string {
 }
 
 func (a *AuthRequest) UserAuthMethodTypes() []UserAuthMethodType {
-	list := make([]UserAuthMethodType, 0, len(a.MFAsVerified)+1)
+	list := make([]UserAuthMethodType, 0, len(a.MFAsVerified)+2)
 	if a.PasswordVerified {
 		list = append(list, UserAuthMethodTypePassword)
 	}
+	if a.MagicLinkVerified {
+		list = append(list, UserAuthMethodTypeMagicLink)
+	}
 	for _, mfa := range a.MFAsVerified {
 		list = append(list, mfa.UserAuthMethodType())
 	}
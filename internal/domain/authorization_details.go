@@ -0,0 +1,139 @@
+package domain
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io"
+	"slices"
+	"strings"
+
+	"github.com/zitadel/zitadel/internal/domain/schema"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+const (
+	// AuthorizationDetailTypeField is the field of an authorization detail which identifies its type.
+	AuthorizationDetailTypeField = "type"
+)
+
+// AuthorizationDetails are the fine-grained authorization requirements of a client,
+// passed in the authorization_details parameter of Rich Authorization Requests (RFC 9396).
+type AuthorizationDetails []AuthorizationDetail
+
+// AuthorizationDetail is a single object of the authorization_details parameter.
+// Besides the type field, the structure is defined by the JSON schema of the type registered on the project.
+type AuthorizationDetail map[string]any
+
+// Type returns the value of the type field or an empty string if it is missing.
+func (d AuthorizationDetail) Type() string {
+	t, _ := d[AuthorizationDetailTypeField].(string)
+	return t
+}
+
+// ParseAuthorizationDetails parses the JSON array of the authorization_details parameter.
+// An empty value returns no authorization details.
+func ParseAuthorizationDetails(value string) (AuthorizationDetails, error) {
+	if strings.TrimSpace(value) == "" {
+		return nil, nil
+	}
+	decoder := json.NewDecoder(strings.NewReader(value))
+	// numbers are kept as [json.Number], so the schema validation is not affected by float conversion
+	decoder.UseNumber()
+	var details AuthorizationDetails
+	if err := decoder.Decode(&details); err != nil {
+		return nil, zerrors.ThrowInvalidArgument(err, "DOMAIN-Rar1p", "Errors.AuthorizationDetails.Invalid")
+	}
+	if _, err := decoder.Token(); !errors.Is(err, io.EOF) {
+		return nil, zerrors.ThrowInvalidArgument(err, "DOMAIN-Rar2e", "Errors.AuthorizationDetails.Invalid")
+	}
+	for _, detail := range details {
+		if detail.Type() == "" {
+			return nil, zerrors.ThrowInvalidArgument(nil, "DOMAIN-Rar3t", "Errors.AuthorizationDetails.TypeMissing")
+		}
+	}
+	if len(details) == 0 {
+		return nil, nil
+	}
+	return details, nil
+}
+
+// Types returns the distinct types of the authorization details in the requested order.
+func (d AuthorizationDetails) Types() []string {
+	types := make([]string, 0, len(d))
+	for _, detail := range d {
+		if !slices.Contains(types, detail.Type()) {
+			types = append(types, detail.Type())
+		}
+	}
+	return types
+}
+
+// Validate checks every authorization detail against the JSON schema of its type.
+// The passed schemas are the ones registered on the project of the client, mapped by their type.
+func (d AuthorizationDetails) Validate(schemas map[string]json.RawMessage) error {
+	for _, detailType := range d.Types() {
+		detailSchema, ok := schemas[detailType]
+		if !ok {
+			return zerrors.ThrowInvalidArgument(nil, "DOMAIN-Rar4u", "Errors.AuthorizationDetails.TypeUnknown")
+		}
+		compiled, err := schema.NewAuthorizationDetailsSchema(bytes.NewReader(detailSchema))
+		if err != nil {
+			// the schema was validated on registration
+			return zerrors.ThrowInternal(err, "DOMAIN-Rar5c", "Errors.Internal")
+		}
+		for _, detail := range d {
+			if detail.Type() != detailType {
+				continue
+			}
+			if err = compiled.Validate(map[string]any(detail)); err != nil {
+				return zerrors.ThrowInvalidArgument(err, "DOMAIN-Rar6s", "Errors.AuthorizationDetails.Invalid")
+			}
+		}
+	}
+	return nil
+}
+
+// IsSubsetOf returns true if every authorization detail is part of the granted ones,
+// e.g. when a token exchange requests authorization details of the subject token.
+func (d AuthorizationDetails) IsSubsetOf(granted AuthorizationDetails) bool {
+	grantedDetails := make([]string, 0, len(granted))
+	for _, detail := range granted {
+		normalized, err := detail.normalize()
+		if err != nil {
+			return false
+		}
+		grantedDetails = append(grantedDetails, normalized)
+	}
+	for _, detail := range d {
+		normalized, err := detail.normalize()
+		if err != nil || !slices.Contains(grantedDetails, normalized) {
+			return false
+		}
+	}
+	return true
+}
+
+// normalize returns the JSON representation of the authorization detail with sorted keys and numbers as floats,
+// so parsed and stored authorization details can be compared.
+func (d AuthorizationDetail) normalize() (string, error) {
+	data, err := json.Marshal(d)
+	if err != nil {
+		return "", err
+	}
+	var normalized any
+	if err = json.Unmarshal(data, &normalized); err != nil {
+		return "", err
+	}
+	data, err = json.Marshal(normalized)
+	return string(data), err
+}
+
+// AuthorizationDetailsConsentState is the decision of the user on the authorization details requested by a client.
+type AuthorizationDetailsConsentState int32
+
+const (
+	AuthorizationDetailsConsentPending AuthorizationDetailsConsentState = iota
+	AuthorizationDetailsConsentGranted
+	AuthorizationDetailsConsentDenied
+)
@@ -0,0 +1,193 @@
+package domain
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+func TestParseAuthorizationDetails(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   string
+		want    AuthorizationDetails
+		wantErr error
+	}{
+		{
+			name:  "empty",
+			value: "",
+			want:  nil,
+		},
+		{
+			name:  "empty array",
+			value: "[]",
+			want:  nil,
+		},
+		{
+			name:    "invalid json",
+			value:   `[{"type":"payment"`,
+			wantErr: zerrors.ThrowInvalidArgument(nil, "DOMAIN-Rar1p", "Errors.AuthorizationDetails.Invalid"),
+		},
+		{
+			name:    "no array",
+			value:   `{"type":"payment"}`,
+			wantErr: zerrors.ThrowInvalidArgument(nil, "DOMAIN-Rar1p", "Errors.AuthorizationDetails.Invalid"),
+		},
+		{
+			name:    "trailing data",
+			value:   `[{"type":"payment"}] []`,
+			wantErr: zerrors.ThrowInvalidArgument(nil, "DOMAIN-Rar2e", "Errors.AuthorizationDetails.Invalid"),
+		},
+		{
+			name:    "type missing",
+			value:   `[{"type":"payment"},{"amount":"10"}]`,
+			wantErr: zerrors.ThrowInvalidArgument(nil, "DOMAIN-Rar3t", "Errors.AuthorizationDetails.TypeMissing"),
+		},
+		{
+			name:    "type no string",
+			value:   `[{"type":1}]`,
+			wantErr: zerrors.ThrowInvalidArgument(nil, "DOMAIN-Rar3t", "Errors.AuthorizationDetails.TypeMissing"),
+		},
+		{
+			name:  "ok",
+			value: `[{"type":"payment","amount":10.25,"locations":["https://example.com/payments"]}]`,
+			want: AuthorizationDetails{
+				{
+					"type":      "payment",
+					"amount":    json.Number("10.25"),
+					"locations": []any{"https://example.com/payments"},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseAuthorizationDetails(tt.value)
+			require.ErrorIs(t, err, tt.wantErr)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestAuthorizationDetails_Types(t *testing.T) {
+	details := AuthorizationDetails{
+		{"type": "payment"},
+		{"type": "account_information"},
+		{"type": "payment"},
+	}
+	assert.Equal(t, []string{"payment", "account_information"}, details.Types())
+}
+
+func TestAuthorizationDetails_Validate(t *testing.T) {
+	schemas := map[string]json.RawMessage{
+		"payment": json.RawMessage(`{
+			"type": "object",
+			"properties": {
+				"type": {"const": "payment"},
+				"amount": {"type": "number", "maximum": 100}
+			},
+			"required": ["type", "amount"],
+			"additionalProperties": false
+		}`),
+	}
+	tests := []struct {
+		name    string
+		details AuthorizationDetails
+		wantErr error
+	}{
+		{
+			name:    "no details",
+			details: nil,
+		},
+		{
+			name: "unknown type",
+			details: AuthorizationDetails{
+				{"type": "account_information"},
+			},
+			wantErr: zerrors.ThrowInvalidArgument(nil, "DOMAIN-Rar4u", "Errors.AuthorizationDetails.TypeUnknown"),
+		},
+		{
+			name: "schema mismatch",
+			details: AuthorizationDetails{
+				{"type": "payment", "amount": json.Number("10")},
+				{"type": "payment", "amount": json.Number("1000")},
+			},
+			wantErr: zerrors.ThrowInvalidArgument(nil, "DOMAIN-Rar6s", "Errors.AuthorizationDetails.Invalid"),
+		},
+		{
+			name: "additional property",
+			details: AuthorizationDetails{
+				{"type": "payment", "amount": json.Number("10"), "creditor": "zitadel"},
+			},
+			wantErr: zerrors.ThrowInvalidArgument(nil, "DOMAIN-Rar6s", "Errors.AuthorizationDetails.Invalid"),
+		},
+		{
+			name: "ok",
+			details: AuthorizationDetails{
+				{"type": "payment", "amount": json.Number("10")},
+				{"type": "payment", "amount": json.Number("99.99")},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.details.Validate(schemas)
+			require.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+}
+
+func TestAuthorizationDetails_IsSubsetOf(t *testing.T) {
+	granted := AuthorizationDetails{
+		{"type": "payment", "amount": float64(42), "currency": "CHF"},
+		{"type": "account_information", "accounts": []any{"CH93"}},
+	}
+	tests := []struct {
+		name    string
+		details AuthorizationDetails
+		want    bool
+	}{
+		{
+			name: "empty",
+			want: true,
+		},
+		{
+			name: "parsed number equals stored number",
+			details: AuthorizationDetails{
+				{"currency": "CHF", "amount": json.Number("42"), "type": "payment"},
+			},
+			want: true,
+		},
+		{
+			name: "all granted",
+			details: AuthorizationDetails{
+				{"type": "account_information", "accounts": []any{"CH93"}},
+				{"type": "payment", "amount": json.Number("42"), "currency": "CHF"},
+			},
+			want: true,
+		},
+		{
+			name: "different value",
+			details: AuthorizationDetails{
+				{"type": "payment", "amount": json.Number("43"), "currency": "CHF"},
+			},
+			want: false,
+		},
+		{
+			name: "not granted type",
+			details: AuthorizationDetails{
+				{"type": "payment_initiation"},
+			},
+			want: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.details.IsSubsetOf(granted))
+		})
+	}
+}
@@ -0,0 +1,81 @@
+package domain
+
+import (
+	"strconv"
+)
+
+// BackChannelAuthState describes the step the
+// client initiated backchannel authentication (CIBA) is in.
+type BackChannelAuthState uint
+
+const (
+	BackChannelAuthStateUndefined BackChannelAuthState = iota
+	BackChannelAuthStateInitiated
+	BackChannelAuthStateApproved
+	BackChannelAuthStateDenied
+	BackChannelAuthStateExpired
+	BackChannelAuthStateDone
+
+	backChannelAuthStateCount
+)
+
+// Exists returns true when not Undefined and
+// any status lower than backChannelAuthStateCount.
+func (s BackChannelAuthState) Exists() bool {
+	return s > BackChannelAuthStateUndefined && s < backChannelAuthStateCount
+}
+
+func (s BackChannelAuthState) String() string {
+	switch s {
+	case BackChannelAuthStateInitiated:
+		return "initiated"
+	case BackChannelAuthStateApproved:
+		return "approved"
+	case BackChannelAuthStateDenied:
+		return "denied"
+	case BackChannelAuthStateExpired:
+		return "expired"
+	case BackChannelAuthStateDone:
+		return "done"
+	case BackChannelAuthStateUndefined, backChannelAuthStateCount:
+		fallthrough
+	default:
+		return "undefined"
+	}
+}
+
+func (s BackChannelAuthState) GoString() string {
+	return strconv.Itoa(int(s))
+}
+
+// BackChannelAuthCanceled is a subset of BackChannelAuthState, allowed to
+// be used in the backchannelauth.CanceledEvent.
+type BackChannelAuthCanceled string
+
+const (
+	BackChannelAuthCanceledDenied  BackChannelAuthCanceled = "denied"
+	BackChannelAuthCanceledExpired BackChannelAuthCanceled = "expired"
+)
+
+func (c BackChannelAuthCanceled) State() BackChannelAuthState {
+	switch c {
+	case BackChannelAuthCanceledDenied:
+		return BackChannelAuthStateDenied
+	case BackChannelAuthCanceledExpired:
+		return BackChannelAuthStateExpired
+	default:
+		return BackChannelAuthStateUndefined
+	}
+}
+
+// BackChannelTokenDeliveryMode defines how the client is informed
+// about the result of a backchannel authentication request.
+type BackChannelTokenDeliveryMode int32
+
+const (
+	// BackChannelTokenDeliveryModePoll lets the client poll the token endpoint.
+	BackChannelTokenDeliveryModePoll BackChannelTokenDeliveryMode = iota
+	// BackChannelTokenDeliveryModePing calls the client notification endpoint
+	// once the request was handled, after which the client calls the token endpoint.
+	BackChannelTokenDeliveryModePing
+)
@@ -18,6 +18,7 @@ const (
 	PasswordlessRegistrationMessageType = "PasswordlessRegistration"
 	PasswordChangeMessageType           = "PasswordChange"
 	InviteUserMessageType               = "InviteUser"
+	MagicLinkMessageType                = "MagicLink"
 	MessageTitle                        = "Title"
 	MessagePreHeader                    = "PreHeader"
 	MessageSubject                      = "Subject"
@@ -63,5 +64,6 @@ func IsMessageTextType(textType string) bool {
 		textType == DomainClaimedMessageType ||
 		textType == PasswordlessRegistrationMessageType ||
 		textType == PasswordChangeMessageType ||
-		textType == InviteUserMessageType
+		textType == InviteUserMessageType ||
+		textType == MagicLinkMessageType
 }
@@ -0,0 +1,17 @@
+package domain
+
+type AccountRecoveryState int32
+
+const (
+	AccountRecoveryStateUnspecified AccountRecoveryState = iota
+	AccountRecoveryStateRequested
+	AccountRecoveryStateApproved
+	AccountRecoveryStateRejected
+	AccountRecoveryStateCompleted
+
+	accountRecoveryStateCount
+)
+
+func (s AccountRecoveryState) Valid() bool {
+	return s >= 0 && s < accountRecoveryStateCount
+}
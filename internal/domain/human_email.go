@@ -76,3 +76,20 @@ type ConfirmURLData struct {
 func RenderConfirmURLTemplate(w io.Writer, tmpl, userID, code, orgID string) error {
 	return renderURLTemplate(w, tmpl, &ConfirmURLData{userID, code, orgID})
 }
+
+// EmailUndeliverableReason is the reason why an email address was reported as undeliverable by the email provider
+type EmailUndeliverableReason int32
+
+const (
+	EmailUndeliverableReasonUnspecified EmailUndeliverableReason = iota
+	// EmailUndeliverableReasonBounce is set if a message to the address permanently bounced
+	EmailUndeliverableReasonBounce
+	// EmailUndeliverableReasonComplaint is set if the recipient marked a message as spam
+	EmailUndeliverableReasonComplaint
+
+	emailUndeliverableReasonCount
+)
+
+func (r EmailUndeliverableReason) Valid() bool {
+	return r > EmailUndeliverableReasonUnspecified && r < emailUndeliverableReasonCount
+}
@@ -0,0 +1,15 @@
+package domain
+
+type TrustedDeviceState int32
+
+const (
+	TrustedDeviceStateUnspecified TrustedDeviceState = iota
+	TrustedDeviceStateActive
+	TrustedDeviceStateRemoved
+
+	trustedDeviceStateCount
+)
+
+func (s TrustedDeviceState) Valid() bool {
+	return s >= 0 && s < trustedDeviceStateCount
+}
@@ -24,6 +24,7 @@ type WebAuthNToken struct {
 	SignCount              uint32
 	WebAuthNTokenName      string
 	RPID                   string
+	AuthenticatorModel     string
 }
 
 type WebAuthNLogin struct {
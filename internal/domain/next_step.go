@@ -30,6 +30,7 @@ const (
 	NextStepRedirectToExternalIDP
 	NextStepLoginSucceeded
 	NextStepVerifyInvite
+	NextStepAuthorizationDetailsConsent
 )
 
 type LoginStep struct{}
@@ -198,3 +199,11 @@ type VerifyInviteStep struct{}
 func (s *VerifyInviteStep) Type() NextStepType {
 	return NextStepVerifyInvite
 }
+
+type AuthorizationDetailsConsentStep struct {
+	AuthorizationDetails AuthorizationDetails
+}
+
+func (s *AuthorizationDetailsConsentStep) Type() NextStepType {
+	return NextStepAuthorizationDetailsConsent
+}
@@ -36,6 +36,26 @@ const (
 	notificationProviderTypeCount
 )
 
+// NotificationDeliveryState is the state of the delivery of a queued notification
+type NotificationDeliveryState int32
+
+const (
+	NotificationDeliveryStateUnspecified NotificationDeliveryState = iota
+	// NotificationDeliveryStateRetrying is set if an attempt failed and the delivery will be retried
+	NotificationDeliveryStateRetrying
+	NotificationDeliveryStateSucceeded
+	// NotificationDeliveryStateFailed is set if the notification was cancelled or all attempts failed
+	NotificationDeliveryStateFailed
+	// NotificationDeliveryStateRetryRequested is set if the delivery of a failed notification was manually retriggered
+	NotificationDeliveryStateRetryRequested
+
+	notificationDeliveryStateCount
+)
+
+func (s NotificationDeliveryState) Valid() bool {
+	return s >= 0 && s < notificationDeliveryStateCount
+}
+
 type NotificationArguments struct {
 	Origin          string        `json:"origin,omitempty"`
 	Domain          string        `json:"domain,omitempty"`
@@ -36,6 +36,9 @@ const (
 	PermissionSessionLink         = "session.link"
 	PermissionSessionDelete       = "session.delete"
 	PermissionOrgRead             = "org.read"
+	PermissionProjectWrite        = "project.write"
+	PermissionProjectRead         = "project.read"
+	PermissionProjectDelete       = "project.delete"
 	PermissionIDPRead             = "iam.idp.read"
 	PermissionOrgIDPRead          = "org.idp.read"
 )
@@ -0,0 +1,216 @@
+package domain
+
+import (
+	"bytes"
+	"crypto/x509"
+	"encoding/asn1"
+	"encoding/pem"
+	"time"
+
+	"github.com/zitadel/zitadel/internal/eventstore/v1/models"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+type ClientCertificateUserMapping int32
+
+const (
+	// ClientCertificateUserMappingSubjectCommonName maps the common name of the subject to the username.
+	ClientCertificateUserMappingSubjectCommonName ClientCertificateUserMapping = iota
+	// ClientCertificateUserMappingSANEmail maps the (first) email of the subject alternative names to the verified email.
+	ClientCertificateUserMappingSANEmail
+	// ClientCertificateUserMappingSANUPN maps the Microsoft user principal name of the subject alternative names to the username.
+	ClientCertificateUserMappingSANUPN
+
+	clientCertificateUserMappingCount
+)
+
+func (m ClientCertificateUserMapping) Valid() bool {
+	return m >= ClientCertificateUserMappingSubjectCommonName && m < clientCertificateUserMappingCount
+}
+
+var (
+	oidSubjectAltName     = asn1.ObjectIdentifier{2, 5, 29, 17}
+	oidUserPrincipalName  = asn1.ObjectIdentifier{1, 3, 6, 1, 4, 1, 311, 20, 2, 3}
+	pemTypeCertificate    = "CERTIFICATE"
+	pemTypeRevocationList = "X509 CRL"
+)
+
+// ClientCertificatePolicy defines which X.509 client certificates (e.g. of PIV / CAC smart cards)
+// are accepted to authenticate users of an organization and how they are mapped to a user.
+type ClientCertificatePolicy struct {
+	models.ObjectRoot
+
+	// CACertificates are PEM encoded (bundles of) certificates.
+	// Self-signed certificates are used as trust anchors, all others as intermediates.
+	CACertificates []string
+	// CRLs are PEM encoded certificate revocation lists issued by the CAs.
+	CRLs        []string
+	UserMapping ClientCertificateUserMapping
+}
+
+// ParseCertificates returns all certificates of the PEM encoded bundle.
+func ParseCertificates(bundle string) ([]*x509.Certificate, error) {
+	var certificates []*x509.Certificate
+	for block, rest := pem.Decode([]byte(bundle)); block != nil; block, rest = pem.Decode(rest) {
+		if block.Type != pemTypeCertificate {
+			continue
+		}
+		certificate, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			return nil, err
+		}
+		certificates = append(certificates, certificate)
+	}
+	return certificates, nil
+}
+
+// ParseRevocationLists returns all certificate revocation lists of the PEM encoded bundle.
+func ParseRevocationLists(bundle string) ([]*x509.RevocationList, error) {
+	var lists []*x509.RevocationList
+	for block, rest := pem.Decode([]byte(bundle)); block != nil; block, rest = pem.Decode(rest) {
+		if block.Type != pemTypeRevocationList {
+			continue
+		}
+		list, err := x509.ParseRevocationList(block.Bytes)
+		if err != nil {
+			return nil, err
+		}
+		lists = append(lists, list)
+	}
+	return lists, nil
+}
+
+// VerifyClientCertificate verifies that the first certificate of the chain (the others are used as intermediates)
+// chains to one of the CA certificates, is valid for client authentication
+// and neither it nor any of its issuers has been revoked by one of the CRLs.
+// A CRL is only used if it is signed by the issuer of the checked certificate; if it is past its next update, the certificate is rejected.
+func (p *ClientCertificatePolicy) VerifyClientCertificate(chain []*x509.Certificate, now time.Time) error {
+	if len(chain) == 0 {
+		return zerrors.ThrowInvalidArgument(nil, "DOMAIN-Cc1Mi", "Errors.Session.ClientCertificate.Missing")
+	}
+	opts := x509.VerifyOptions{
+		Roots:         x509.NewCertPool(),
+		Intermediates: x509.NewCertPool(),
+		CurrentTime:   now,
+		KeyUsages:     []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}
+	for _, bundle := range p.CACertificates {
+		certificates, err := ParseCertificates(bundle)
+		if err != nil {
+			return zerrors.ThrowInternal(err, "DOMAIN-Cc2Ca", "Errors.Policy.ClientCertificate.Invalid.CACertificate")
+		}
+		for _, certificate := range certificates {
+			if bytes.Equal(certificate.RawIssuer, certificate.RawSubject) {
+				opts.Roots.AddCert(certificate)
+				continue
+			}
+			opts.Intermediates.AddCert(certificate)
+		}
+	}
+	for _, intermediate := range chain[1:] {
+		opts.Intermediates.AddCert(intermediate)
+	}
+	chains, err := chain[0].Verify(opts)
+	if err != nil {
+		return zerrors.ThrowPreconditionFailed(err, "DOMAIN-Cc3Ve", "Errors.Session.ClientCertificate.Invalid")
+	}
+	var lists []*x509.RevocationList
+	for _, bundle := range p.CRLs {
+		parsed, err := ParseRevocationLists(bundle)
+		if err != nil {
+			return zerrors.ThrowInternal(err, "DOMAIN-Cc4Cr", "Errors.Policy.ClientCertificate.Invalid.CRL")
+		}
+		lists = append(lists, parsed...)
+	}
+	// the last certificate of the verified chain is the trust anchor, which cannot be revoked
+	verified := chains[0]
+	for i := 0; i < len(verified)-1; i++ {
+		if err = checkRevocation(verified[i], verified[i+1], lists, now); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func checkRevocation(certificate, issuer *x509.Certificate, lists []*x509.RevocationList, now time.Time) error {
+	for _, list := range lists {
+		if !bytes.Equal(list.RawIssuer, certificate.RawIssuer) || list.CheckSignatureFrom(issuer) != nil {
+			continue
+		}
+		if !list.NextUpdate.IsZero() && list.NextUpdate.Before(now) {
+			return zerrors.ThrowPreconditionFailed(nil, "DOMAIN-Cc5Ex", "Errors.Session.ClientCertificate.CRLExpired")
+		}
+		for _, entry := range list.RevokedCertificateEntries {
+			if entry.SerialNumber.Cmp(certificate.SerialNumber) == 0 {
+				return zerrors.ThrowPreconditionFailed(nil, "DOMAIN-Cc6Re", "Errors.Session.ClientCertificate.Revoked")
+			}
+		}
+	}
+	return nil
+}
+
+// UserIdentifier returns the value of the certificate, which identifies the user based on the [ClientCertificateUserMapping].
+func (p *ClientCertificatePolicy) UserIdentifier(certificate *x509.Certificate) (identifier string, err error) {
+	switch p.UserMapping {
+	case ClientCertificateUserMappingSubjectCommonName:
+		identifier = certificate.Subject.CommonName
+	case ClientCertificateUserMappingSANEmail:
+		if len(certificate.EmailAddresses) > 0 {
+			identifier = certificate.EmailAddresses[0]
+		}
+	case ClientCertificateUserMappingSANUPN:
+		identifier, err = userPrincipalName(certificate)
+		if err != nil {
+			return "", zerrors.ThrowPreconditionFailed(err, "DOMAIN-Cc7Up", "Errors.Session.ClientCertificate.NoUserIdentifier")
+		}
+	case clientCertificateUserMappingCount:
+		// count is not a valid mapping
+	}
+	if identifier == "" {
+		return "", zerrors.ThrowPreconditionFailed(nil, "DOMAIN-Cc8Id", "Errors.Session.ClientCertificate.NoUserIdentifier")
+	}
+	return identifier, nil
+}
+
+// otherName is the otherName (RFC 5280, 4.2.1.6) of the subject alternative names,
+// which is used by Microsoft to transport the user principal name.
+type otherName struct {
+	TypeID asn1.ObjectIdentifier
+	Value  asn1.RawValue `asn1:"explicit,tag:0"`
+}
+
+func userPrincipalName(certificate *x509.Certificate) (string, error) {
+	for _, extension := range certificate.Extensions {
+		if !extension.Id.Equal(oidSubjectAltName) {
+			continue
+		}
+		var names asn1.RawValue
+		if _, err := asn1.Unmarshal(extension.Value, &names); err != nil {
+			return "", err
+		}
+		for rest := names.Bytes; len(rest) > 0; {
+			var name asn1.RawValue
+			var err error
+			if rest, err = asn1.Unmarshal(rest, &name); err != nil {
+				return "", err
+			}
+			if name.Class != asn1.ClassContextSpecific || name.Tag != 0 {
+				continue
+			}
+			var other otherName
+			if _, err = asn1.UnmarshalWithParams(name.FullBytes, &other, "tag:0"); err != nil {
+				return "", err
+			}
+			if !other.TypeID.Equal(oidUserPrincipalName) {
+				continue
+			}
+			// the raw value of an explicitly tagged field still contains the inner (UTF8String) encoding
+			var upn string
+			if _, err = asn1.Unmarshal(other.Value.Bytes, &upn); err != nil {
+				return "", err
+			}
+			return upn, nil
+		}
+	}
+	return "", nil
+}
@@ -0,0 +1,274 @@
+package domain
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"encoding/pem"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+type testCertificateAuthority struct {
+	cert *x509.Certificate
+	key  crypto.Signer
+}
+
+func newTestCertificateAuthority(t *testing.T, commonName string, parent *testCertificateAuthority) *testCertificateAuthority {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(time.Now().UnixNano()),
+		Subject:               pkix.Name{CommonName: commonName},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageCertSign | x509.KeyUsageCRLSign,
+	}
+	parentCert, parentKey := template, crypto.Signer(key)
+	if parent != nil {
+		parentCert, parentKey = parent.cert, parent.key
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, parentCert, key.Public(), parentKey)
+	require.NoError(t, err)
+	cert, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+	return &testCertificateAuthority{cert: cert, key: key}
+}
+
+func (ca *testCertificateAuthority) issue(t *testing.T, serial int64, modify func(*x509.Certificate)) *x509.Certificate {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(serial),
+		Subject:      pkix.Name{CommonName: "john.doe"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}
+	if modify != nil {
+		modify(template)
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, ca.cert, key.Public(), ca.key)
+	require.NoError(t, err)
+	cert, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+	return cert
+}
+
+func (ca *testCertificateAuthority) revocationList(t *testing.T, nextUpdate time.Time, revoked ...int64) string {
+	entries := make([]x509.RevocationListEntry, len(revoked))
+	for i, serial := range revoked {
+		entries[i] = x509.RevocationListEntry{SerialNumber: big.NewInt(serial), RevocationTime: time.Now().Add(-time.Minute)}
+	}
+	der, err := x509.CreateRevocationList(rand.Reader, &x509.RevocationList{
+		Number:                    big.NewInt(1),
+		ThisUpdate:                time.Now().Add(-time.Hour),
+		NextUpdate:                nextUpdate,
+		RevokedCertificateEntries: entries,
+	}, ca.cert, ca.key)
+	require.NoError(t, err)
+	return string(pem.EncodeToMemory(&pem.Block{Type: pemTypeRevocationList, Bytes: der}))
+}
+
+func (ca *testCertificateAuthority) pem() string {
+	return string(pem.EncodeToMemory(&pem.Block{Type: pemTypeCertificate, Bytes: ca.cert.Raw}))
+}
+
+func userPrincipalNameExtension(t *testing.T, upn string) pkix.Extension {
+	value, err := asn1.Marshal(upn)
+	require.NoError(t, err)
+	other, err := asn1.MarshalWithParams(otherName{
+		TypeID: oidUserPrincipalName,
+		Value:  asn1.RawValue{Class: asn1.ClassContextSpecific, Tag: 0, IsCompound: true, Bytes: value},
+	}, "tag:0")
+	require.NoError(t, err)
+	names, err := asn1.Marshal(asn1.RawValue{Class: asn1.ClassUniversal, Tag: asn1.TagSequence, IsCompound: true, Bytes: other})
+	require.NoError(t, err)
+	return pkix.Extension{Id: oidSubjectAltName, Value: names}
+}
+
+func TestClientCertificatePolicy_VerifyClientCertificate(t *testing.T) {
+	root := newTestCertificateAuthority(t, "root", nil)
+	intermediate := newTestCertificateAuthority(t, "intermediate", root)
+	otherRoot := newTestCertificateAuthority(t, "other", nil)
+
+	type args struct {
+		chain []*x509.Certificate
+	}
+	tests := []struct {
+		name   string
+		policy *ClientCertificatePolicy
+		args   args
+		err    func(error) bool
+	}{
+		{
+			name:   "no certificate, error",
+			policy: &ClientCertificatePolicy{CACertificates: []string{root.pem()}},
+			args:   args{},
+			err:    zerrors.IsErrorInvalidArgument,
+		},
+		{
+			name:   "issued by root, ok",
+			policy: &ClientCertificatePolicy{CACertificates: []string{root.pem()}},
+			args:   args{chain: []*x509.Certificate{root.issue(t, 1, nil)}},
+		},
+		{
+			name:   "unknown root, error",
+			policy: &ClientCertificatePolicy{CACertificates: []string{otherRoot.pem()}},
+			args:   args{chain: []*x509.Certificate{root.issue(t, 1, nil)}},
+			err:    zerrors.IsPreconditionFailed,
+		},
+		{
+			name:   "no client authentication usage, error",
+			policy: &ClientCertificatePolicy{CACertificates: []string{root.pem()}},
+			args: args{chain: []*x509.Certificate{root.issue(t, 1, func(c *x509.Certificate) {
+				c.ExtKeyUsage = []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth}
+			})}},
+			err: zerrors.IsPreconditionFailed,
+		},
+		{
+			name:   "intermediate from bundle, ok",
+			policy: &ClientCertificatePolicy{CACertificates: []string{root.pem() + intermediate.pem()}},
+			args:   args{chain: []*x509.Certificate{intermediate.issue(t, 1, nil)}},
+		},
+		{
+			name:   "intermediate from chain, ok",
+			policy: &ClientCertificatePolicy{CACertificates: []string{root.pem()}},
+			args:   args{chain: []*x509.Certificate{intermediate.issue(t, 1, nil), intermediate.cert}},
+		},
+		{
+			name: "not revoked, ok",
+			policy: &ClientCertificatePolicy{
+				CACertificates: []string{root.pem()},
+				CRLs:           []string{root.revocationList(t, time.Now().Add(time.Hour), 2)},
+			},
+			args: args{chain: []*x509.Certificate{root.issue(t, 1, nil)}},
+		},
+		{
+			name: "revoked, error",
+			policy: &ClientCertificatePolicy{
+				CACertificates: []string{root.pem()},
+				CRLs:           []string{root.revocationList(t, time.Now().Add(time.Hour), 1)},
+			},
+			args: args{chain: []*x509.Certificate{root.issue(t, 1, nil)}},
+			err:  zerrors.IsPreconditionFailed,
+		},
+		{
+			name: "intermediate revoked, error",
+			policy: &ClientCertificatePolicy{
+				CACertificates: []string{root.pem() + intermediate.pem()},
+				CRLs:           []string{root.revocationList(t, time.Now().Add(time.Hour), intermediate.cert.SerialNumber.Int64())},
+			},
+			args: args{chain: []*x509.Certificate{intermediate.issue(t, 1, nil)}},
+			err:  zerrors.IsPreconditionFailed,
+		},
+		{
+			name: "revocation list of other issuer, ok",
+			policy: &ClientCertificatePolicy{
+				CACertificates: []string{root.pem()},
+				CRLs:           []string{otherRoot.revocationList(t, time.Now().Add(time.Hour), 1)},
+			},
+			args: args{chain: []*x509.Certificate{root.issue(t, 1, nil)}},
+		},
+		{
+			name: "revocation list expired, error",
+			policy: &ClientCertificatePolicy{
+				CACertificates: []string{root.pem()},
+				CRLs:           []string{root.revocationList(t, time.Now().Add(-time.Minute))},
+			},
+			args: args{chain: []*x509.Certificate{root.issue(t, 1, nil)}},
+			err:  zerrors.IsPreconditionFailed,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.policy.VerifyClientCertificate(tt.args.chain, time.Now())
+			if tt.err == nil {
+				assert.NoError(t, err)
+				return
+			}
+			assert.True(t, tt.err(err), "unexpected error: %v", err)
+		})
+	}
+}
+
+func TestClientCertificatePolicy_UserIdentifier(t *testing.T) {
+	ca := newTestCertificateAuthority(t, "root", nil)
+	tests := []struct {
+		name        string
+		mapping     ClientCertificateUserMapping
+		certificate *x509.Certificate
+		want        string
+		wantErr     bool
+	}{
+		{
+			name:        "common name",
+			mapping:     ClientCertificateUserMappingSubjectCommonName,
+			certificate: ca.issue(t, 1, nil),
+			want:        "john.doe",
+		},
+		{
+			name:    "common name missing, error",
+			mapping: ClientCertificateUserMappingSubjectCommonName,
+			certificate: ca.issue(t, 1, func(c *x509.Certificate) {
+				c.Subject = pkix.Name{Organization: []string{"org"}}
+			}),
+			wantErr: true,
+		},
+		{
+			name:    "email",
+			mapping: ClientCertificateUserMappingSANEmail,
+			certificate: ca.issue(t, 1, func(c *x509.Certificate) {
+				c.EmailAddresses = []string{"john.doe@example.com", "jd@example.com"}
+			}),
+			want: "john.doe@example.com",
+		},
+		{
+			name:        "email missing, error",
+			mapping:     ClientCertificateUserMappingSANEmail,
+			certificate: ca.issue(t, 1, nil),
+			wantErr:     true,
+		},
+		{
+			name:    "user principal name",
+			mapping: ClientCertificateUserMappingSANUPN,
+			certificate: ca.issue(t, 1, func(c *x509.Certificate) {
+				c.ExtraExtensions = []pkix.Extension{userPrincipalNameExtension(t, "1234567890@mil")}
+			}),
+			want: "1234567890@mil",
+		},
+		{
+			name:    "user principal name missing, error",
+			mapping: ClientCertificateUserMappingSANUPN,
+			certificate: ca.issue(t, 1, func(c *x509.Certificate) {
+				c.EmailAddresses = []string{"john.doe@example.com"}
+			}),
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			policy := &ClientCertificatePolicy{UserMapping: tt.mapping}
+			got, err := policy.UserIdentifier(tt.certificate)
+			if tt.wantErr {
+				assert.True(t, zerrors.IsPreconditionFailed(err), "unexpected error: %v", err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
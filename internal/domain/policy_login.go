@@ -31,6 +31,10 @@ type LoginPolicy struct {
 	MultiFactorCheckLifetime   time.Duration
 	DisableLoginWithEmail      bool
 	DisableLoginWithPhone      bool
+	ForceMFAOnHighRisk         bool
+	TrustedDeviceLifetime      time.Duration
+	AllowAccountRecovery       bool
+	AllowMagicLink             bool
 }
 
 func ValidateDefaultRedirectURI(rawURL string) bool {
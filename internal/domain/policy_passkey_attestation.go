@@ -0,0 +1,68 @@
+package domain
+
+import (
+	"slices"
+	"strings"
+
+	"github.com/zitadel/zitadel/internal/eventstore/v1/models"
+)
+
+type AttestationConveyance int32
+
+const (
+	AttestationConveyanceNone AttestationConveyance = iota
+	AttestationConveyanceIndirect
+	AttestationConveyanceDirect
+	AttestationConveyanceEnterprise
+
+	attestationConveyanceCount
+)
+
+func (c AttestationConveyance) Valid() bool {
+	return c >= AttestationConveyanceNone && c < attestationConveyanceCount
+}
+
+// PasskeyAttestationPolicy restricts which authenticators can be registered as passkey or U2F.
+// An empty policy (or no policy at all) accepts every authenticator.
+type PasskeyAttestationPolicy struct {
+	models.ObjectRoot
+
+	Conveyance AttestationConveyance
+	// RootCertificates are PEM encoded trust anchors for the attestation certificate chain.
+	RootCertificates []string
+	// UseMetadata requires the authenticator to be listed in the FIDO metadata (MDS) blob
+	// and uses its attestation roots as additional trust anchors.
+	UseMetadata    bool
+	AllowedAAGUIDs []string
+	DeniedAAGUIDs  []string
+
+	IsDefault bool
+}
+
+// ConveyancePreference returns the attestation conveyance to request from the authenticator.
+func (p *PasskeyAttestationPolicy) ConveyancePreference() AttestationConveyance {
+	if p == nil {
+		return AttestationConveyanceNone
+	}
+	return p.Conveyance
+}
+
+// RequiresAttestation returns true if the authenticator has to present a trusted attestation statement.
+func (p *PasskeyAttestationPolicy) RequiresAttestation() bool {
+	if p == nil {
+		return false
+	}
+	return p.Conveyance == AttestationConveyanceDirect || p.Conveyance == AttestationConveyanceEnterprise
+}
+
+// IsAAGUIDAllowed checks the (canonical, lower case) aaguid against the deny and allow lists.
+func (p *PasskeyAttestationPolicy) IsAAGUIDAllowed(aaguid string) bool {
+	if p == nil {
+		return true
+	}
+	aaguid = strings.ToLower(aaguid)
+	if slices.Contains(p.DeniedAAGUIDs, aaguid) {
+		return false
+	}
+	return len(p.AllowedAAGUIDs) == 0 || slices.Contains(p.AllowedAAGUIDs, aaguid)
+}
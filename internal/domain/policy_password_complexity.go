@@ -14,6 +14,9 @@ var (
 	hasSymbol          = regexp.MustCompile(`[^A-Za-z0-9]`).MatchString
 )
 
+// PasswordHistoryMaxDepth is the maximum number of previous passwords, which can be prevented from reuse.
+const PasswordHistoryMaxDepth = 24
+
 type PasswordComplexityPolicy struct {
 	models.ObjectRoot
 
@@ -22,6 +25,12 @@ type PasswordComplexityPolicy struct {
 	HasUppercase bool
 	HasNumber    bool
 	HasSymbol    bool
+	// HistoryDepth is the number of the last passwords (including the current), which cannot be reused.
+	HistoryDepth uint64
+	// CheckBreached rejects passwords found in the breached password corpus, when they are set.
+	CheckBreached bool
+	// ForceChangeBreached requires users to change their password on the next login, if it is found in the breached password corpus.
+	ForceChangeBreached bool
 
 	Default bool
 }
@@ -30,6 +39,9 @@ func (p *PasswordComplexityPolicy) IsValid() error {
 	if p.MinLength == 0 || p.MinLength > 72 {
 		return zerrors.ThrowInvalidArgument(nil, "MODEL-Lsp0e", "Errors.User.PasswordComplexityPolicy.MinLengthNotAllowed")
 	}
+	if p.HistoryDepth > PasswordHistoryMaxDepth {
+		return zerrors.ThrowInvalidArgument(nil, "MODEL-Hst0e", "Errors.User.PasswordComplexityPolicy.HistoryDepthNotAllowed")
+	}
 	return nil
 }
 
@@ -1,6 +1,8 @@
 package domain
 
 import (
+	"time"
+
 	"github.com/zitadel/zitadel/internal/eventstore/v1/models"
 )
 
@@ -11,4 +13,19 @@ type LockoutPolicy struct {
 	MaxPasswordAttempts uint64
 	MaxOTPAttempts      uint64
 	ShowLockOutFailures bool
+
+	// MaxIPAttempts is the amount of failed checks from a single IP address within the ThrottleWindow,
+	// after which further checks from that IP address are blocked for the ThrottleBlockDuration.
+	MaxIPAttempts uint64
+	// MaxClientAttempts is the amount of failed checks of a single user from the same client (IP address and user agent)
+	// within the ThrottleWindow, after which further checks of the user from that client are blocked for the ThrottleBlockDuration.
+	MaxClientAttempts     uint64
+	ThrottleWindow        time.Duration
+	ThrottleDelay         time.Duration
+	ThrottleBlockDuration time.Duration
+}
+
+// ThrottlingEnabled reports if failed checks are throttled by IP address or client.
+func (p *LockoutPolicy) ThrottlingEnabled() bool {
+	return p.ThrottleWindow > 0 && (p.MaxIPAttempts > 0 || p.MaxClientAttempts > 0 || p.ThrottleDelay > 0)
 }
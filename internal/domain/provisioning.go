@@ -0,0 +1,27 @@
+package domain
+
+type ProvisioningTargetState int32
+
+const (
+	ProvisioningTargetUnspecified ProvisioningTargetState = iota
+	ProvisioningTargetActive
+	ProvisioningTargetRemoved
+	provisioningTargetStateCount
+)
+
+func (s ProvisioningTargetState) Valid() bool {
+	return s >= 0 && s < provisioningTargetStateCount
+}
+
+func (s ProvisioningTargetState) Exists() bool {
+	return s != ProvisioningTargetUnspecified && s != ProvisioningTargetRemoved
+}
+
+// ProvisioningSyncState is the state of the last synchronization of a user to a provisioning target
+type ProvisioningSyncState int32
+
+const (
+	ProvisioningSyncStateUnspecified ProvisioningSyncState = iota
+	ProvisioningSyncStateSucceeded
+	ProvisioningSyncStateFailed
+)
@@ -33,6 +33,8 @@ type AuthRequestOIDC struct {
 	ResponseMode  OIDCResponseMode
 	Nonce         string
 	CodeChallenge *OIDCCodeChallenge
+	// AuthorizationDetails requested by the client (RFC 9396)
+	AuthorizationDetails AuthorizationDetails
 }
 
 func (a *AuthRequestOIDC) Type() AuthRequestType {
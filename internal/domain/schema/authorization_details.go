@@ -0,0 +1,31 @@
+package schema
+
+import (
+	"io"
+
+	"github.com/santhosh-tekuri/jsonschema/v5"
+
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+const (
+	authorizationDetailsSchemaURL = "authorization_details.json"
+)
+
+// NewAuthorizationDetailsSchema compiles the JSON schema of a type of authorization details (RFC 9396).
+// In contrast to [NewSchema], the zitadel extensions are not available
+// and references to external documents are not resolved.
+func NewAuthorizationDetailsSchema(r io.Reader) (*jsonschema.Schema, error) {
+	c := jsonschema.NewCompiler()
+	c.LoadURL = func(s string) (io.ReadCloser, error) {
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "SCHEMA-Rar1l", "loading %s is not allowed", s)
+	}
+	if err := c.AddResource(authorizationDetailsSchemaURL, r); err != nil {
+		return nil, zerrors.ThrowInvalidArgument(err, "SCHEMA-Rar2r", "Errors.Project.AuthorizationDetailsType.SchemaInvalid")
+	}
+	schema, err := c.Compile(authorizationDetailsSchemaURL)
+	if err != nil {
+		return nil, zerrors.ThrowInvalidArgument(err, "SCHEMA-Rar3c", "Errors.Project.AuthorizationDetailsType.SchemaInvalid")
+	}
+	return schema, nil
+}
@@ -14,6 +14,36 @@ const (
 	SessionStateTerminated
 )
 
+type SessionRiskLevel int32
+
+const (
+	SessionRiskLevelUnspecified SessionRiskLevel = iota
+	SessionRiskLevelLow
+	SessionRiskLevelMedium
+	SessionRiskLevelHigh
+)
+
+// SessionRisk is the result of the risk evaluation of a session,
+// comparing its origin to the recent sessions of the user.
+type SessionRisk struct {
+	Level SessionRiskLevel
+	// NewDevice is set if the device (fingerprint or user agent) was not used in the recent sessions.
+	NewDevice bool
+	// NewCountry is set if the country of the IP address was not used in the recent sessions.
+	NewCountry bool
+	// ImpossibleTravel is set if the distance to the location of the last session
+	// could not have been travelled in the elapsed time.
+	ImpossibleTravel bool
+	// Country is the ISO 3166-1 alpha-2 code of the country the IP address is located in, if known.
+	Country string
+}
+
+// GeoLocation is the location of an IP address in decimal degrees.
+type GeoLocation struct {
+	Latitude  float64 `json:"latitude"`
+	Longitude float64 `json:"longitude"`
+}
+
 type OTPEmailURLData struct {
 	Code              string
 	UserID            string
@@ -35,3 +65,27 @@ func RenderOTPEmailURLTemplate(w io.Writer, tmpl, code, userID, loginName, displ
 		SessionID:         sessionID,
 	})
 }
+
+type MagicLinkURLData struct {
+	Code              string
+	UserID            string
+	LoginName         string
+	DisplayName       string
+	PreferredLanguage language.Tag
+	SessionID         string
+	AuthRequestID     string
+}
+
+// RenderMagicLinkURLTemplate parses and renders tmpl.
+// code, userID, (preferred) loginName, displayName, sessionID, authRequestID and preferredLanguage are passed into the [MagicLinkURLData].
+func RenderMagicLinkURLTemplate(w io.Writer, tmpl, code, userID, loginName, displayName, sessionID, authRequestID string, preferredLanguage language.Tag) error {
+	return renderURLTemplate(w, tmpl, &MagicLinkURLData{
+		Code:              code,
+		UserID:            userID,
+		LoginName:         loginName,
+		DisplayName:       displayName,
+		PreferredLanguage: preferredLanguage,
+		SessionID:         sessionID,
+		AuthRequestID:     authRequestID,
+	})
+}
@@ -8,6 +8,37 @@ const (
 	TargetTypeAsync
 )
 
+// TargetTransport defines how the payload is delivered to the endpoint of a target.
+type TargetTransport uint
+
+const (
+	// TargetTransportHTTP sends the payload as JSON in a HTTP POST request.
+	TargetTransportHTTP TargetTransport = iota
+	// TargetTransportGRPC sends the payload to the zitadel.action.target.v1.TargetService.
+	TargetTransportGRPC
+	targetTransportCount
+)
+
+func (t TargetTransport) Valid() bool {
+	return t < targetTransportCount
+}
+
+// TargetSigningAlgorithm defines how the payload sent to a target is signed.
+type TargetSigningAlgorithm uint
+
+const (
+	// TargetSigningAlgorithmHMACSHA256 signs the payload with a shared secret, sent in the ZITADEL-Signature header.
+	TargetSigningAlgorithmHMACSHA256 TargetSigningAlgorithm = iota
+	// TargetSigningAlgorithmEd25519 signs the payload as JWS, sent in the ZITADEL-JWS-Signature header.
+	// The public keys are served as JWKS.
+	TargetSigningAlgorithmEd25519
+	targetSigningAlgorithmCount
+)
+
+func (a TargetSigningAlgorithm) Valid() bool {
+	return a < targetSigningAlgorithmCount
+}
+
 type TargetState int32
 
 const (
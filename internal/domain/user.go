@@ -44,6 +44,10 @@ const (
 	UserAuthMethodTypeOTPEmail
 	UserAuthMethodTypeOTP // generic OTP when parsing AMR from OIDC
 	UserAuthMethodTypePrivateKey
+	UserAuthMethodTypeTrustedDevice
+	UserAuthMethodTypeRecoveryCode
+	UserAuthMethodTypeClientCertificate
+	UserAuthMethodTypeMagicLink
 	userAuthMethodTypeCount
 )
 
@@ -63,7 +67,11 @@ func HasMFA(methods []UserAuthMethodType) bool {
 			UserAuthMethodTypeOTPEmail,
 			UserAuthMethodTypeIDP,
 			UserAuthMethodTypeOTP,
-			UserAuthMethodTypePrivateKey:
+			UserAuthMethodTypePrivateKey,
+			UserAuthMethodTypeTrustedDevice,
+			UserAuthMethodTypeRecoveryCode,
+			UserAuthMethodTypeClientCertificate,
+			UserAuthMethodTypeMagicLink:
 			factors++
 		case UserAuthMethodTypeUnspecified,
 			userAuthMethodTypeCount:
@@ -83,13 +91,17 @@ func Has2FA(methods []UserAuthMethodType) bool {
 			UserAuthMethodTypeTOTP,
 			UserAuthMethodTypeOTPSMS,
 			UserAuthMethodTypeOTPEmail,
-			UserAuthMethodTypeOTP:
+			UserAuthMethodTypeOTP,
+			UserAuthMethodTypeTrustedDevice,
+			UserAuthMethodTypeRecoveryCode,
+			UserAuthMethodTypeClientCertificate:
 			factors++
 		case UserAuthMethodTypeUnspecified,
 			UserAuthMethodTypePassword,
 			UserAuthMethodTypePasswordless,
 			UserAuthMethodTypeIDP,
 			UserAuthMethodTypePrivateKey,
+			UserAuthMethodTypeMagicLink,
 			userAuthMethodTypeCount:
 			// ignore
 		}
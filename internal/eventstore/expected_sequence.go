@@ -0,0 +1,65 @@
+package eventstore
+
+import (
+	"errors"
+
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// ExpectedSequence is the version of an aggregate a command is based on.
+// It is used for optimistic concurrency control, e.g. if a client provided
+// the version of a resource its changes are based on.
+type ExpectedSequence struct {
+	// Sequence is the latest sequence of the aggregate known to the caller
+	Sequence uint64
+	// EventTypes restrict the check to events of the given types,
+	// if empty all events of the aggregate are checked
+	EventTypes []EventType
+}
+
+// ExpectedSequenceCommand is a command which is only pushed
+// if no other event was pushed to its aggregate after the expected sequence.
+type ExpectedSequenceCommand struct {
+	Command
+	Expected *ExpectedSequence
+}
+
+// ExpectSequence wraps the command so that the push fails with a precondition failed error
+// if the aggregate of the command was changed after the expected sequence.
+// If expected is nil, the command is returned unchanged.
+func ExpectSequence(command Command, expected *ExpectedSequence) Command {
+	if expected == nil {
+		return command
+	}
+	return &ExpectedSequenceCommand{
+		Command:  command,
+		Expected: expected,
+	}
+}
+
+// ExpectWriteModelSequence returns the [ExpectedSequence] of the write model,
+// which is checked against the events filtered by the query of the write model.
+func ExpectWriteModelSequence(wm *WriteModel, query *SearchQueryBuilder) *ExpectedSequence {
+	return &ExpectedSequence{
+		Sequence:   wm.ProcessedSequence,
+		EventTypes: eventTypesOfQuery(query),
+	}
+}
+
+func eventTypesOfQuery(query *SearchQueryBuilder) []EventType {
+	var types []EventType
+	for _, q := range query.GetQueries() {
+		// a query without event types filters for all events
+		if len(q.GetEventTypes()) == 0 {
+			return nil
+		}
+		types = append(types, q.GetEventTypes()...)
+	}
+	return types
+}
+
+// IsConcurrentModification returns true if the push was rejected,
+// because the aggregate of an [ExpectedSequenceCommand] was changed after the expected sequence.
+func IsConcurrentModification(err error) bool {
+	return errors.Is(err, zerrors.ThrowPreconditionFailed(nil, "", "Errors.ConcurrentModification"))
+}
@@ -118,6 +118,12 @@ func (m *MockRepository) ExpectPush(expectedCommands []eventstore.Command, sleep
 				if !assert.ElementsMatch(m.MockPusher.ctrl.T, expectedCommand.UniqueConstraints(), commands[i].UniqueConstraints()) {
 					m.MockPusher.ctrl.T.Errorf("invalid command.UniqueConstraints [%d]: expected: %#v got: %#v", i, expectedCommand.UniqueConstraints(), commands[i].UniqueConstraints())
 				}
+				if expectedSequence, ok := expectedCommand.(*eventstore.ExpectedSequenceCommand); ok {
+					gotSequence, _ := commands[i].(*eventstore.ExpectedSequenceCommand)
+					if !assert.NotNil(m.MockPusher.ctrl.T, gotSequence) || !assert.Equal(m.MockPusher.ctrl.T, expectedSequence.Expected, gotSequence.Expected) {
+						m.MockPusher.ctrl.T.Errorf("invalid command.Expected [%d]: expected: %#v got: %#v", i, expectedSequence, commands[i])
+					}
+				}
 			}
 			events := make([]eventstore.Event, len(commands))
 			for i, command := range commands {
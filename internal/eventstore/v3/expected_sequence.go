@@ -0,0 +1,75 @@
+package eventstore
+
+import (
+	"context"
+	_ "embed"
+
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+//go:embed expected_sequence.sql
+var expectedSequenceStmt string
+
+// checkExpectedSequences verifies that no event was pushed to the aggregate of an [eventstore.ExpectedSequenceCommand]
+// between its expected sequence and the first event written by this push.
+// The check runs after the events were written, because concurrent pushes to the same aggregate
+// are serialized by the primary key of the events table.
+func checkExpectedSequences(ctx context.Context, tx database.Tx, commands []eventstore.Command, events []eventstore.Event) (err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	for i, command := range commands {
+		expected, ok := command.(*eventstore.ExpectedSequenceCommand)
+		if !ok {
+			continue
+		}
+		var changed bool
+		changed, err = aggregateChanged(ctx, tx, expected, firstPushedSequence(expected.Aggregate(), events[:i+1]))
+		if err != nil {
+			return err
+		}
+		if changed {
+			return zerrors.ThrowPreconditionFailed(nil, "V3-Xs8mC", "Errors.ConcurrentModification")
+		}
+	}
+	return nil
+}
+
+func aggregateChanged(ctx context.Context, tx database.Tx, command *eventstore.ExpectedSequenceCommand, pushedSequence uint64) (changed bool, err error) {
+	rows, err := tx.QueryContext(ctx, expectedSequenceStmt,
+		command.Aggregate().InstanceID,
+		command.Aggregate().Type,
+		command.Aggregate().ID,
+		command.Expected.Sequence,
+		pushedSequence,
+		database.TextArray[eventstore.EventType](command.Expected.EventTypes),
+	)
+	if err != nil {
+		return false, zerrors.ThrowInternal(err, "V3-Xs7pQ", "Errors.Internal")
+	}
+	defer rows.Close()
+	for rows.Next() {
+		if err = rows.Scan(&changed); err != nil {
+			return false, zerrors.ThrowInternal(err, "V3-Xs9nR", "Errors.Internal")
+		}
+	}
+	if err = rows.Err(); err != nil {
+		return false, zerrors.ThrowInternal(err, "V3-Xs0kT", "Errors.Internal")
+	}
+	return changed, nil
+}
+
+// firstPushedSequence returns the sequence of the first pushed event of the aggregate.
+func firstPushedSequence(aggregate *eventstore.Aggregate, events []eventstore.Event) uint64 {
+	for _, event := range events {
+		if event.Aggregate().InstanceID == aggregate.InstanceID &&
+			event.Aggregate().Type == aggregate.Type &&
+			event.Aggregate().ID == aggregate.ID {
+			return event.Sequence()
+		}
+	}
+	return 0
+}
@@ -0,0 +1,13 @@
+SELECT EXISTS (
+    SELECT 
+        1 
+    FROM 
+        eventstore.events2 
+    WHERE 
+        instance_id = $1
+        AND aggregate_type = $2
+        AND aggregate_id = $3
+        AND "sequence" > $4
+        AND "sequence" < $5
+        AND (CARDINALITY($6::TEXT[]) = 0 OR event_type = ANY($6::TEXT[]))
+);
@@ -0,0 +1,66 @@
+package eventstore
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/zitadel/zitadel/internal/eventstore"
+)
+
+func Test_firstPushedSequence(t *testing.T) {
+	type args struct {
+		aggregate *eventstore.Aggregate
+		events    []eventstore.Event
+	}
+	tests := []struct {
+		name string
+		args args
+		want uint64
+	}{
+		{
+			name: "no events",
+			args: args{
+				aggregate: mockAggregate("V3-Wq1aP"),
+			},
+			want: 0,
+		},
+		{
+			name: "other aggregate",
+			args: args{
+				aggregate: mockAggregate("V3-Wq1aP"),
+				events: []eventstore.Event{
+					mockEvent(mockAggregate("V3-other"), 4, nil),
+				},
+			},
+			want: 0,
+		},
+		{
+			name: "other instance",
+			args: args{
+				aggregate: mockAggregate("V3-Wq1aP"),
+				events: []eventstore.Event{
+					mockEvent(mockAggregateWithInstance("V3-Wq1aP", "other"), 4, nil),
+				},
+			},
+			want: 0,
+		},
+		{
+			name: "first event of aggregate",
+			args: args{
+				aggregate: mockAggregate("V3-Wq1aP"),
+				events: []eventstore.Event{
+					mockEvent(mockAggregate("V3-other"), 2, nil),
+					mockEvent(mockAggregate("V3-Wq1aP"), 6, nil),
+					mockEvent(mockAggregate("V3-Wq1aP"), 7, nil),
+				},
+			},
+			want: 6,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, firstPushedSequence(tt.args.aggregate, tt.args.events))
+		})
+	}
+}
@@ -67,6 +67,10 @@ func (es *Eventstore) writeCommands(ctx context.Context, client database.Context
 		return nil, err
 	}
 
+	if err = checkExpectedSequences(ctx, tx, commands, events); err != nil {
+		return nil, err
+	}
+
 	if err = handleUniqueConstraints(ctx, tx, commands); err != nil {
 		return nil, err
 	}
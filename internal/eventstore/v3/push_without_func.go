@@ -57,6 +57,10 @@ func (es *Eventstore) pushWithoutFunc(ctx context.Context, client database.Conte
 		return nil, err
 	}
 
+	if err = checkExpectedSequences(ctx, tx, commands, events); err != nil {
+		return nil, err
+	}
+
 	if err = handleUniqueConstraints(ctx, tx, commands); err != nil {
 		return nil, err
 	}
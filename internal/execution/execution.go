@@ -17,7 +17,6 @@ import (
 	"github.com/zitadel/zitadel/internal/repository/execution"
 	"github.com/zitadel/zitadel/internal/telemetry/tracing"
 	"github.com/zitadel/zitadel/internal/zerrors"
-	"github.com/zitadel/zitadel/pkg/actions"
 )
 
 type ContextInfo interface {
@@ -31,8 +30,11 @@ type Target interface {
 	IsInterruptOnError() bool
 	GetEndpoint() string
 	GetTargetType() domain.TargetType
+	GetTransport() domain.TargetTransport
 	GetTimeout() time.Duration
-	GetSigningKey() string
+	GetSigningAlgorithm() domain.TargetSigningAlgorithm
+	// GetSigningKeys returns the keys to sign the payload with, the current key first.
+	GetSigningKeys() []string
 }
 
 // CallTargets call a list of targets in order with handling of error and responses
@@ -74,16 +76,25 @@ func CallTarget(
 	ctx, span := tracing.NewSpan(ctx)
 	defer func() { span.EndWithError(err) }()
 
+	call, ok := transports[target.GetTransport()]
+	if !ok {
+		return nil, zerrors.ThrowInternal(nil, "EXEC-Trp1e", "Errors.Execution.Unknown")
+	}
+	signer, err := NewSigner(target.GetSigningAlgorithm(), target.GetSigningKeys()...)
+	if err != nil {
+		return nil, err
+	}
 	switch target.GetTargetType() {
 	// get request, ignore response and return request and error for handling in list of targets
 	case domain.TargetTypeWebhook:
-		return nil, webhook(ctx, target.GetEndpoint(), target.GetTimeout(), info.GetHTTPRequestBody(), target.GetSigningKey())
+		_, err = call(ctx, target, info.GetHTTPRequestBody(), signer)
+		return nil, err
 	// get request, return response and error
 	case domain.TargetTypeCall:
-		return Call(ctx, target.GetEndpoint(), target.GetTimeout(), info.GetHTTPRequestBody(), target.GetSigningKey())
+		return call(ctx, target, info.GetHTTPRequestBody(), signer)
 	case domain.TargetTypeAsync:
 		go func(ctx context.Context, target Target, info []byte) {
-			if _, err := Call(ctx, target.GetEndpoint(), target.GetTimeout(), info, target.GetSigningKey()); err != nil {
+			if _, err := call(ctx, target, info, signer); err != nil {
 				logging.WithFields("target", target.GetTargetID()).OnError(err).Info(err)
 			}
 		}(context.WithoutCancel(ctx), target, info.GetHTTPRequestBody())
@@ -93,14 +104,23 @@ func CallTarget(
 	}
 }
 
-// webhook call a webhook, ignore the response but return the errror
-func webhook(ctx context.Context, url string, timeout time.Duration, body []byte, signingKey string) error {
-	_, err := Call(ctx, url, timeout, body, signingKey)
-	return err
+// Transport delivers the payload to the endpoint of a target and returns the response payload.
+// The timeout of the target applies to the whole delivery and the payload is signed by the signer if set.
+type Transport func(ctx context.Context, target Target, body []byte, signer Signer) ([]byte, error)
+
+// transports maps the transport of a target to its implementation.
+// New transports (e.g. message brokers) only need to be added here.
+var transports = map[domain.TargetTransport]Transport{
+	domain.TargetTransportHTTP: callHTTP,
+	domain.TargetTransportGRPC: CallGRPC,
+}
+
+func callHTTP(ctx context.Context, target Target, body []byte, signer Signer) ([]byte, error) {
+	return Call(ctx, target.GetEndpoint(), target.GetTimeout(), body, signer)
 }
 
 // Call function to do a post HTTP request to a desired url with timeout
-func Call(ctx context.Context, url string, timeout time.Duration, body []byte, signingKey string) (_ []byte, err error) {
+func Call(ctx context.Context, url string, timeout time.Duration, body []byte, signer Signer) (_ []byte, err error) {
 	ctx, cancel := context.WithTimeout(ctx, timeout)
 	ctx, span := tracing.NewSpan(ctx)
 	defer func() {
@@ -113,8 +133,12 @@ func Call(ctx context.Context, url string, timeout time.Duration, body []byte, s
 		return nil, err
 	}
 	req.Header.Set("Content-Type", "application/json")
-	if signingKey != "" {
-		req.Header.Set(actions.SigningHeader, actions.ComputeSignatureHeader(time.Now(), body, signingKey))
+	if signer != nil {
+		name, value, err := signer(body)
+		if err != nil {
+			return nil, err
+		}
+		req.Header.Set(name, value)
 	}
 
 	client := http.DefaultClient
@@ -134,24 +158,29 @@ func HandleResponse(resp *http.Response) ([]byte, error) {
 	}
 	// Check for success between 200 and 299, redirect 300 to 399 is handled by the client, return error with statusCode >= 400
 	if resp.StatusCode >= 200 && resp.StatusCode <= 299 {
-		var errorBody ErrorBody
-		if err := json.Unmarshal(data, &errorBody); err != nil {
-			// if json unmarshal fails, body has no ErrorBody information, so will be taken as successful response
-			return data, nil
-		}
-		if errorBody.ForwardedStatusCode != 0 || errorBody.ForwardedErrorMessage != "" {
-			if errorBody.ForwardedStatusCode >= 400 && errorBody.ForwardedStatusCode < 500 {
-				return nil, zhttp.HTTPStatusCodeToZitadelError(nil, errorBody.ForwardedStatusCode, "EXEC-reUaUZCzCp", errorBody.ForwardedErrorMessage)
-			}
-			return nil, zerrors.ThrowPreconditionFailed(nil, "EXEC-bmhNhpcqpF", errorBody.ForwardedErrorMessage)
-		}
-		// no ErrorBody filled in response, so will be taken as successful response
-		return data, nil
+		return handleResponseBody(data)
 	}
 
 	return nil, zerrors.ThrowPreconditionFailed(nil, "EXEC-dra6yamk98", "Errors.Execution.Failed")
 }
 
+// handleResponseBody checks a successful response for a forwarded error
+func handleResponseBody(data []byte) ([]byte, error) {
+	var errorBody ErrorBody
+	if err := json.Unmarshal(data, &errorBody); err != nil {
+		// if json unmarshal fails, body has no ErrorBody information, so will be taken as successful response
+		return data, nil
+	}
+	if errorBody.ForwardedStatusCode != 0 || errorBody.ForwardedErrorMessage != "" {
+		if errorBody.ForwardedStatusCode >= 400 && errorBody.ForwardedStatusCode < 500 {
+			return nil, zhttp.HTTPStatusCodeToZitadelError(nil, errorBody.ForwardedStatusCode, "EXEC-reUaUZCzCp", errorBody.ForwardedErrorMessage)
+		}
+		return nil, zerrors.ThrowPreconditionFailed(nil, "EXEC-bmhNhpcqpF", errorBody.ForwardedErrorMessage)
+	}
+	// no ErrorBody filled in response, so will be taken as successful response
+	return data, nil
+}
+
 type ErrorBody struct {
 	ForwardedStatusCode   int    `json:"forwardedStatusCode,omitempty"`
 	ForwardedErrorMessage string `json:"forwardedErrorMessage,omitempty"`
@@ -3,6 +3,8 @@ package execution_test
 import (
 	"bytes"
 	"context"
+	"crypto/ed25519"
+	"crypto/rand"
 	"encoding/json"
 	"errors"
 	"io"
@@ -11,6 +13,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/go-jose/go-jose/v4"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"google.golang.org/protobuf/types/known/structpb"
@@ -128,6 +131,11 @@ func Test_Call(t *testing.T) {
 }
 
 func Test_CallTarget(t *testing.T) {
+	publicKey, privateKey, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	privateWebKey, err := json.Marshal(&jose.JSONWebKey{Key: privateKey, KeyID: actions.JWSKeyID(publicKey), Algorithm: string(jose.EdDSA), Use: "sig"})
+	require.NoError(t, err)
+
 	type args struct {
 		ctx    context.Context
 		info   *middleware.ContextInfoRequest
@@ -163,6 +171,27 @@ func Test_CallTarget(t *testing.T) {
 				wantErr: true,
 			},
 		},
+		{
+			"unknown transport, error",
+			args{
+				ctx:  context.Background(),
+				info: requestContextInfo1,
+				server: &callTestServer{
+					method:      http.MethodPost,
+					expectBody:  []byte("{\"request\":{\"content\":\"request1\"}}"),
+					respondBody: []byte("{\"content\":\"request2\"}"),
+					timeout:     time.Second,
+					statusCode:  http.StatusOK,
+				},
+				target: &mockTarget{
+					TargetType: domain.TargetTypeCall,
+					Transport:  4,
+				},
+			},
+			res{
+				wantErr: true,
+			},
+		},
 		{
 			"webhook, error",
 			args{
@@ -293,6 +322,79 @@ func Test_CallTarget(t *testing.T) {
 				body: []byte("{\"content\":\"request2\"}"),
 			},
 		},
+		{
+			"request response, signed with next key, ok",
+			args{
+				ctx:  context.Background(),
+				info: requestContextInfo1,
+				server: &callTestServer{
+					timeout:     time.Second,
+					method:      http.MethodPost,
+					expectBody:  []byte("{\"request\":{\"content\":\"request1\"}}"),
+					respondBody: []byte("{\"content\":\"request2\"}"),
+					statusCode:  http.StatusOK,
+					signingKey:  "next",
+				},
+				target: &mockTarget{
+					TargetType:     domain.TargetTypeCall,
+					Timeout:        time.Minute,
+					SigningKey:     "signingkey",
+					NextSigningKey: "next",
+				},
+			},
+			res{
+				body: []byte("{\"content\":\"request2\"}"),
+			},
+		},
+		{
+			"request response, signed ed25519, ok",
+			args{
+				ctx:  context.Background(),
+				info: requestContextInfo1,
+				server: &callTestServer{
+					timeout:     time.Second,
+					method:      http.MethodPost,
+					expectBody:  []byte("{\"request\":{\"content\":\"request1\"}}"),
+					respondBody: []byte("{\"content\":\"request2\"}"),
+					statusCode:  http.StatusOK,
+					keySet: &jose.JSONWebKeySet{Keys: []jose.JSONWebKey{
+						{Key: publicKey, KeyID: actions.JWSKeyID(publicKey), Algorithm: string(jose.EdDSA), Use: "sig"},
+					}},
+				},
+				target: &mockTarget{
+					TargetType:       domain.TargetTypeCall,
+					Timeout:          time.Minute,
+					SigningAlgorithm: domain.TargetSigningAlgorithmEd25519,
+					SigningKey:       string(privateWebKey),
+				},
+			},
+			res{
+				body: []byte("{\"content\":\"request2\"}"),
+			},
+		},
+		{
+			"request response, invalid ed25519 key, error",
+			args{
+				ctx:  context.Background(),
+				info: requestContextInfo1,
+				server: &callTestServer{
+					timeout:     time.Second,
+					method:      http.MethodPost,
+					expectBody:  []byte("{\"request\":{\"content\":\"request1\"}}"),
+					respondBody: []byte("{\"content\":\"request2\"}"),
+					statusCode:  http.StatusOK,
+				},
+				target: &mockTarget{
+					TargetType:       domain.TargetTypeCall,
+					Timeout:          time.Minute,
+					SigningAlgorithm: domain.TargetSigningAlgorithmEd25519,
+					SigningKey:       "signingkey",
+				},
+			},
+			res{
+				wantErr: true,
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -454,10 +556,14 @@ type mockTarget struct {
 	ExecutionID      string
 	TargetID         string
 	TargetType       domain.TargetType
+	Transport        domain.TargetTransport
 	Endpoint         string
 	Timeout          time.Duration
 	InterruptOnError bool
+	SigningAlgorithm domain.TargetSigningAlgorithm
 	SigningKey       string
+
+	NextSigningKey string
 }
 
 func (e *mockTarget) GetTargetID() string {
@@ -472,11 +578,24 @@ func (e *mockTarget) GetEndpoint() string {
 func (e *mockTarget) GetTargetType() domain.TargetType {
 	return e.TargetType
 }
+func (e *mockTarget) GetTransport() domain.TargetTransport {
+	return e.Transport
+}
 func (e *mockTarget) GetTimeout() time.Duration {
 	return e.Timeout
 }
-func (e *mockTarget) GetSigningKey() string {
-	return e.SigningKey
+func (e *mockTarget) GetSigningAlgorithm() domain.TargetSigningAlgorithm {
+	return e.SigningAlgorithm
+}
+func (e *mockTarget) GetSigningKeys() []string {
+	keys := make([]string, 0, 2)
+	if e.SigningKey != "" {
+		keys = append(keys, e.SigningKey)
+	}
+	if e.NextSigningKey != "" {
+		keys = append(keys, e.NextSigningKey)
+	}
+	return keys
 }
 
 type callTestServer struct {
@@ -486,6 +605,7 @@ type callTestServer struct {
 	statusCode  int
 	respondBody []byte
 	signingKey  string
+	keySet      *jose.JSONWebKeySet
 }
 
 func testServers(
@@ -517,7 +637,7 @@ func listen(
 	c *callTestServer,
 ) (url string, close func()) {
 	handler := func(w http.ResponseWriter, r *http.Request) {
-		checkRequest(t, r, c.method, c.expectBody, c.signingKey)
+		checkRequest(t, r, c.method, c.expectBody, c.signingKey, c.keySet)
 
 		if c.statusCode != http.StatusOK {
 			http.Error(w, "error", c.statusCode)
@@ -536,7 +656,7 @@ func listen(
 	return server.URL, server.Close
 }
 
-func checkRequest(t *testing.T, sent *http.Request, method string, expectedBody []byte, signingKey string) {
+func checkRequest(t *testing.T, sent *http.Request, method string, expectedBody []byte, signingKey string, keySet *jose.JSONWebKeySet) {
 	sentBody, err := io.ReadAll(sent.Body)
 	require.NoError(t, err)
 	require.Equal(t, expectedBody, sentBody)
@@ -544,11 +664,22 @@ func checkRequest(t *testing.T, sent *http.Request, method string, expectedBody
 	if signingKey != "" {
 		require.NoError(t, actions.ValidatePayload(sentBody, sent.Header.Get(actions.SigningHeader), signingKey))
 	}
+	if keySet != nil {
+		require.NoError(t, actions.ValidateJWSPayload(sentBody, sent.Header.Get(actions.JWSSigningHeader), keySet))
+	}
 }
 
 func testCall(ctx context.Context, timeout time.Duration, body []byte, signingKey string) func(string) ([]byte, error) {
 	return func(url string) ([]byte, error) {
-		return execution.Call(ctx, url, timeout, body, signingKey)
+		var signer execution.Signer
+		if signingKey != "" {
+			var err error
+			signer, err = execution.NewSigner(domain.TargetSigningAlgorithmHMACSHA256, signingKey)
+			if err != nil {
+				return nil, err
+			}
+		}
+		return execution.Call(ctx, url, timeout, body, signer)
 	}
 }
 
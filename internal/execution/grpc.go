@@ -0,0 +1,211 @@
+package execution
+
+import (
+	"context"
+	"net"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+	"github.com/zitadel/zitadel/internal/zerrors"
+	target_pb "github.com/zitadel/zitadel/pkg/grpc/action/target/v1"
+)
+
+// grpcConns holds a client connection per target, as connections are multiplexed and meant to be reused.
+var grpcConns = &grpcConnCache{conns: make(map[string]*grpcConn)}
+
+// grpcConnMaxIdle is the duration after which the connection of a target which was not called is closed,
+// e.g. because the target was removed or is no longer part of an execution.
+const grpcConnMaxIdle = 15 * time.Minute
+
+// CallGRPC function to call the TargetService of a gRPC target with timeout
+// The payload and signature are the same as for HTTP targets, the signature is sent as metadata.
+func CallGRPC(ctx context.Context, target Target, body []byte, signer Signer) (_ []byte, err error) {
+	ctx, cancel := context.WithTimeout(ctx, target.GetTimeout())
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() {
+		cancel()
+		span.EndWithError(err)
+	}()
+
+	conn, err := grpcConns.get(target.GetTargetID(), target.GetEndpoint())
+	if err != nil {
+		return nil, err
+	}
+	defer grpcConns.release(conn)
+	if signer != nil {
+		name, value, err := signer(body)
+		if err != nil {
+			return nil, err
+		}
+		ctx = metadata.AppendToOutgoingContext(ctx, strings.ToLower(name), value)
+	}
+	resp, err := target_pb.NewTargetServiceClient(conn.conn).Call(ctx, &target_pb.CallRequest{Payload: body})
+	if err != nil {
+		return nil, grpcStatusToZitadelError(err)
+	}
+	return handleResponseBody(resp.GetPayload())
+}
+
+// CloseGRPCConns closes the connections of all gRPC targets, e.g. on shutdown.
+func CloseGRPCConns() {
+	grpcConns.closeIdle(0)
+}
+
+// closeIdleGRPCConns periodically closes the connections of targets which were not called for [grpcConnMaxIdle].
+func closeIdleGRPCConns(ctx context.Context) {
+	ticker := time.NewTicker(grpcConnMaxIdle / 3)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			grpcConns.closeIdle(grpcConnMaxIdle)
+		}
+	}
+}
+
+type grpcConnCache struct {
+	mu    sync.Mutex
+	conns map[string]*grpcConn
+}
+
+type grpcConn struct {
+	endpoint string
+	conn     *grpc.ClientConn
+	lastUsed time.Time
+	// inUse is the number of calls currently using the connection
+	inUse int
+	// removed is set if the connection was removed from the cache,
+	// it is closed as soon as it is no longer in use.
+	removed bool
+}
+
+// get returns the connection of the target, which must be released after the call.
+// If the endpoint of the target changed, the connection to the previous endpoint is closed,
+// once the calls still using it are finished.
+func (c *grpcConnCache) get(targetID, endpoint string) (*grpcConn, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	cached, ok := c.conns[targetID]
+	if ok && cached.endpoint == endpoint {
+		cached.lastUsed = time.Now()
+		cached.inUse++
+		return cached, nil
+	}
+	conn, err := newGRPCConn(endpoint)
+	if err != nil {
+		return nil, err
+	}
+	if ok {
+		cached.remove()
+	}
+	cached = &grpcConn{
+		endpoint: endpoint,
+		conn:     conn,
+		lastUsed: time.Now(),
+		inUse:    1,
+	}
+	c.conns[targetID] = cached
+	return cached, nil
+}
+
+// release marks the call using the connection as finished
+// and closes the connection if it was removed from the cache in the meantime.
+func (c *grpcConnCache) release(cached *grpcConn) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	cached.inUse--
+	cached.lastUsed = time.Now()
+	if cached.removed && cached.inUse == 0 {
+		_ = cached.conn.Close()
+	}
+}
+
+// closeIdle removes the connections which were not used for maxIdle.
+// Connections still in use by a call are closed after the call is finished.
+func (c *grpcConnCache) closeIdle(maxIdle time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for targetID, cached := range c.conns {
+		if time.Since(cached.lastUsed) < maxIdle {
+			continue
+		}
+		cached.remove()
+		delete(c.conns, targetID)
+	}
+}
+
+// remove closes the connection if it is not in use, otherwise it's closed on the last [grpcConnCache.release].
+// The mutex of the cache must be held.
+func (g *grpcConn) remove() {
+	g.removed = true
+	if g.inUse == 0 {
+		_ = g.conn.Close()
+	}
+}
+
+func newGRPCConn(endpoint string) (*grpc.ClientConn, error) {
+	u, err := url.Parse(endpoint)
+	if err != nil || u.Host == "" {
+		return nil, zerrors.ThrowInternal(err, "EXEC-Grp1e", "Errors.Execution.Failed")
+	}
+	var (
+		creds credentials.TransportCredentials
+		port  string
+	)
+	switch u.Scheme {
+	case "https":
+		creds, port = credentials.NewClientTLSFromCert(nil, ""), "443"
+	case "http":
+		creds, port = insecure.NewCredentials(), "80"
+	default:
+		return nil, zerrors.ThrowInternal(nil, "EXEC-Grp2e", "Errors.Execution.Failed")
+	}
+	if u.Port() != "" {
+		port = u.Port()
+	}
+	conn, err := grpc.NewClient(net.JoinHostPort(u.Hostname(), port), grpc.WithTransportCredentials(creds))
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "EXEC-Grp3e", "Errors.Execution.Failed")
+	}
+	return conn, nil
+}
+
+// grpcStatusToZitadelError forwards client errors returned by the target, the same way as 4xx status codes of HTTP targets.
+func grpcStatusToZitadelError(err error) error {
+	s, ok := status.FromError(err)
+	if !ok {
+		return err
+	}
+	var errorFunc func(parent error, id, message string) error
+	switch s.Code() {
+	case codes.InvalidArgument:
+		errorFunc = zerrors.ThrowInvalidArgument
+	case codes.NotFound:
+		errorFunc = zerrors.ThrowNotFound
+	case codes.AlreadyExists:
+		errorFunc = zerrors.ThrowAlreadyExists
+	case codes.PermissionDenied:
+		errorFunc = zerrors.ThrowPermissionDenied
+	case codes.Unauthenticated:
+		errorFunc = zerrors.ThrowUnauthenticated
+	case codes.FailedPrecondition:
+		errorFunc = zerrors.ThrowPreconditionFailed
+	case codes.ResourceExhausted:
+		errorFunc = zerrors.ThrowResourceExhausted
+	default:
+		return zerrors.ThrowPreconditionFailed(err, "EXEC-Grp4e", "Errors.Execution.Failed")
+	}
+	return errorFunc(nil, "EXEC-Grp5e", s.Message())
+}
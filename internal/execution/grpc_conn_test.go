@@ -0,0 +1,50 @@
+package execution
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/connectivity"
+)
+
+func Test_grpcConnCache(t *testing.T) {
+	c := &grpcConnCache{conns: make(map[string]*grpcConn)}
+
+	first, err := c.get("target", "http://localhost:8080")
+	require.NoError(t, err)
+	c.release(first)
+	reused, err := c.get("target", "http://localhost:8080")
+	require.NoError(t, err)
+	assert.Same(t, first, reused)
+
+	// the endpoint of the target changed while a call is still using the previous connection
+	changed, err := c.get("target", "http://localhost:8081")
+	require.NoError(t, err)
+	c.release(changed)
+	assert.NotSame(t, first, changed)
+	assert.NotEqual(t, connectivity.Shutdown, first.conn.GetState())
+	c.release(reused)
+	assert.Equal(t, connectivity.Shutdown, first.conn.GetState())
+
+	other, err := c.get("other", "http://localhost:8082")
+	require.NoError(t, err)
+	c.release(other)
+	c.conns["other"].lastUsed = time.Now().Add(-time.Hour)
+
+	// the other target was not called, e.g. because it was removed
+	c.closeIdle(time.Minute)
+	assert.Equal(t, connectivity.Shutdown, other.conn.GetState())
+	assert.NotEqual(t, connectivity.Shutdown, changed.conn.GetState())
+	assert.Len(t, c.conns, 1)
+
+	// shutdown while a call is in progress
+	inUse, err := c.get("target", "http://localhost:8081")
+	require.NoError(t, err)
+	c.closeIdle(0)
+	assert.Empty(t, c.conns)
+	assert.NotEqual(t, connectivity.Shutdown, inUse.conn.GetState())
+	c.release(inUse)
+	assert.Equal(t, connectivity.Shutdown, changed.conn.GetState())
+}
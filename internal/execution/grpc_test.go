@@ -0,0 +1,210 @@
+package execution_test
+
+import (
+	"context"
+	"net"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/execution"
+	"github.com/zitadel/zitadel/internal/zerrors"
+	"github.com/zitadel/zitadel/pkg/actions"
+	target_pb "github.com/zitadel/zitadel/pkg/grpc/action/target/v1"
+)
+
+func Test_CallGRPC(t *testing.T) {
+	type args struct {
+		timeout    time.Duration
+		body       []byte
+		signingKey string
+	}
+	type res struct {
+		body []byte
+		err  func(error) bool
+	}
+	tests := []struct {
+		name   string
+		server *grpcTestServer
+		args   args
+		res    res
+	}{
+		{
+			"timeout",
+			&grpcTestServer{
+				expectBody:  []byte("{\"request\": \"values\"}"),
+				sleep:       2 * time.Second,
+				respondBody: []byte("{\"response\": \"values\"}"),
+			},
+			args{
+				timeout: time.Second,
+				body:    []byte("{\"request\": \"values\"}"),
+			},
+			res{
+				err: zerrors.IsPreconditionFailed,
+			},
+		},
+		{
+			"client error, forwarded",
+			&grpcTestServer{
+				expectBody: []byte("{\"request\": \"values\"}"),
+				respondErr: status.Error(codes.InvalidArgument, "invalid"),
+			},
+			args{
+				timeout: time.Minute,
+				body:    []byte("{\"request\": \"values\"}"),
+			},
+			res{
+				err: zerrors.IsErrorInvalidArgument,
+			},
+		},
+		{
+			"server error, failed",
+			&grpcTestServer{
+				expectBody: []byte("{\"request\": \"values\"}"),
+				respondErr: status.Error(codes.Internal, "internal"),
+			},
+			args{
+				timeout: time.Minute,
+				body:    []byte("{\"request\": \"values\"}"),
+			},
+			res{
+				err: zerrors.IsPreconditionFailed,
+			},
+		},
+		{
+			"forwarded error body",
+			&grpcTestServer{
+				expectBody:  []byte("{\"request\": \"values\"}"),
+				respondBody: testErrorBody(403, "forbidden"),
+			},
+			args{
+				timeout: time.Minute,
+				body:    []byte("{\"request\": \"values\"}"),
+			},
+			res{
+				err: zerrors.IsPermissionDenied,
+			},
+		},
+		{
+			"ok",
+			&grpcTestServer{
+				expectBody:  []byte("{\"request\": \"values\"}"),
+				respondBody: []byte("{\"response\": \"values\"}"),
+			},
+			args{
+				timeout: time.Minute,
+				body:    []byte("{\"request\": \"values\"}"),
+			},
+			res{
+				body: []byte("{\"response\": \"values\"}"),
+			},
+		},
+		{
+			"ok, signed",
+			&grpcTestServer{
+				expectBody:  []byte("{\"request\": \"values\"}"),
+				respondBody: []byte("{\"response\": \"values\"}"),
+				signingKey:  "signingkey",
+			},
+			args{
+				timeout:    time.Minute,
+				body:       []byte("{\"request\": \"values\"}"),
+				signingKey: "signingkey",
+			},
+			res{
+				body: []byte("{\"response\": \"values\"}"),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.server.t = t
+			endpoint := listenGRPC(t, tt.server)
+			var signer execution.Signer
+			if tt.args.signingKey != "" {
+				var err error
+				signer, err = execution.NewSigner(domain.TargetSigningAlgorithmHMACSHA256, tt.args.signingKey)
+				require.NoError(t, err)
+			}
+			target := &mockTarget{
+				TargetID:  tt.name,
+				Transport: domain.TargetTransportGRPC,
+				Endpoint:  endpoint,
+				Timeout:   tt.args.timeout,
+			}
+			respBody, err := execution.CallGRPC(context.Background(), target, tt.args.body, signer)
+			if tt.res.err != nil {
+				assert.True(t, tt.res.err(err), "unexpected error: %v", err)
+				assert.Nil(t, respBody)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.res.body, respBody)
+		})
+	}
+}
+
+func Test_CallTarget_GRPC(t *testing.T) {
+	server := &grpcTestServer{
+		t:           t,
+		expectBody:  []byte("{\"request\":{\"content\":\"request1\"}}"),
+		respondBody: []byte("{\"content\":\"request2\"}"),
+		signingKey:  "signingkey",
+	}
+	respBody, err := execution.CallTarget(context.Background(), &mockTarget{
+		TargetType: domain.TargetTypeCall,
+		Transport:  domain.TargetTransportGRPC,
+		Endpoint:   listenGRPC(t, server),
+		Timeout:    time.Minute,
+		SigningKey: "signingkey",
+	}, requestContextInfo1)
+	require.NoError(t, err)
+	assert.Equal(t, []byte("{\"content\":\"request2\"}"), respBody)
+}
+
+type grpcTestServer struct {
+	target_pb.UnimplementedTargetServiceServer
+	t           *testing.T
+	expectBody  []byte
+	sleep       time.Duration
+	respondBody []byte
+	respondErr  error
+	signingKey  string
+}
+
+func (s *grpcTestServer) Call(ctx context.Context, req *target_pb.CallRequest) (*target_pb.CallResponse, error) {
+	assert.Equal(s.t, s.expectBody, req.GetPayload())
+	if s.signingKey != "" {
+		md, _ := metadata.FromIncomingContext(ctx)
+		signature := md.Get(strings.ToLower(actions.SigningHeader))
+		if assert.Len(s.t, signature, 1) {
+			assert.NoError(s.t, actions.ValidatePayload(req.GetPayload(), signature[0], s.signingKey))
+		}
+	}
+	time.Sleep(s.sleep)
+	if s.respondErr != nil {
+		return nil, s.respondErr
+	}
+	return &target_pb.CallResponse{Payload: s.respondBody}, nil
+}
+
+func listenGRPC(t *testing.T, s *grpcTestServer) (endpoint string) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	server := grpc.NewServer()
+	target_pb.RegisterTargetServiceServer(server, s)
+	go func() {
+		_ = server.Serve(listener)
+	}()
+	t.Cleanup(server.Stop)
+	return "http://" + listener.Addr().String()
+}
@@ -33,4 +33,5 @@ func Start(ctx context.Context) {
 	for _, projection := range projections {
 		projection.Start(ctx)
 	}
+	go closeIdleGRPCConns(ctx)
 }
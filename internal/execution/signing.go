@@ -0,0 +1,53 @@
+package execution
+
+import (
+	"crypto/ed25519"
+	"encoding/json"
+	"time"
+
+	"github.com/go-jose/go-jose/v4"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/zerrors"
+	"github.com/zitadel/zitadel/pkg/actions"
+)
+
+// Signer returns the name and value of the header containing the signature of the payload.
+type Signer func(payload []byte) (name, value string, err error)
+
+// NewSigner returns the signer for the signing algorithm of a target.
+// The payload is signed with all provided keys, to allow receivers to verify it during a key rotation.
+// If no keys are provided, nil is returned and the payload is not signed.
+func NewSigner(algorithm domain.TargetSigningAlgorithm, keys ...string) (Signer, error) {
+	if len(keys) == 0 {
+		return nil, nil
+	}
+	switch algorithm {
+	case domain.TargetSigningAlgorithmHMACSHA256:
+		return func(payload []byte) (string, string, error) {
+			return actions.SigningHeader, actions.ComputeSignatureHeader(time.Now(), payload, keys...), nil
+		}, nil
+	case domain.TargetSigningAlgorithmEd25519:
+		privateKeys := make([]ed25519.PrivateKey, len(keys))
+		for i, key := range keys {
+			webKey := new(jose.JSONWebKey)
+			if err := json.Unmarshal([]byte(key), webKey); err != nil {
+				return nil, zerrors.ThrowInternal(err, "EXEC-Sgn1e", "Errors.Internal")
+			}
+			privateKey, ok := webKey.Key.(ed25519.PrivateKey)
+			if !ok {
+				return nil, zerrors.ThrowInternal(nil, "EXEC-Sgn2e", "Errors.Internal")
+			}
+			privateKeys[i] = privateKey
+		}
+		return func(payload []byte) (string, string, error) {
+			value, err := actions.ComputeJWSSignatureHeader(time.Now(), payload, privateKeys...)
+			if err != nil {
+				return "", "", zerrors.ThrowInternal(err, "EXEC-Sgn3e", "Errors.Internal")
+			}
+			return actions.JWSSigningHeader, value, nil
+		}, nil
+	default:
+		return nil, zerrors.ThrowInternal(nil, "EXEC-Sgn4e", "Errors.Execution.Unknown")
+	}
+}
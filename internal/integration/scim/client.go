@@ -24,6 +24,7 @@ type Client struct {
 	client  *http.Client
 	baseURL string
 	Users   *ResourceClient[resources.ScimUser]
+	Groups  *ResourceClient[resources.ScimGroup]
 }
 
 type ResourceClient[T any] struct {
@@ -122,6 +123,11 @@ func NewScimClient(target string) *Client {
 			baseURL:      target,
 			resourceName: "Users",
 		},
+		Groups: &ResourceClient[resources.ScimGroup]{
+			client:       client,
+			baseURL:      target,
+			resourceName: "Groups",
+		},
 	}
 }
 
@@ -164,6 +170,19 @@ func (c *ResourceClient[T]) Replace(ctx context.Context, orgID, id string, body
 	return c.doWithBody(ctx, http.MethodPut, orgID, id, bytes.NewReader(body))
 }
 
+// ReplaceIfMatch replaces the resource only if its current version matches the provided version.
+func (c *ResourceClient[T]) ReplaceIfMatch(ctx context.Context, orgID, id, version string, body []byte) (*T, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, c.buildResourceURL(orgID, id), bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Set(zhttp.ContentType, middleware.ContentTypeScim)
+	req.Header.Set(zhttp.IfMatch, version)
+	responseEntity := new(T)
+	return responseEntity, doReq(c.client, req, responseEntity)
+}
+
 func (c *ResourceClient[T]) Update(ctx context.Context, orgID, id string, body []byte) error {
 	req, err := http.NewRequestWithContext(ctx, http.MethodPatch, c.buildResourceURL(orgID, id), bytes.NewReader(body))
 	if err != nil {
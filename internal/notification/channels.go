@@ -73,6 +73,7 @@ func (c *channels) Email(ctx context.Context) (*senders.Chain, *email.Config, er
 		emailCfg,
 		c.q.GetFileSystemProvider,
 		c.q.GetLogProvider,
+		c.q.IsEmailSuppressed,
 		c.counters.success.email,
 		c.counters.failed.email,
 	)
@@ -0,0 +1,139 @@
+package httptemplate
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"slices"
+	"strings"
+	"text/template"
+	"time"
+
+	"github.com/zitadel/logging"
+
+	"github.com/zitadel/zitadel/internal/notification/channels"
+	"github.com/zitadel/zitadel/internal/notification/messages"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+const (
+	requestTimeout = 5 * time.Second
+	// maxResponseBodySize limits the part of the response body which is read to detect the success of the request
+	maxResponseBodySize = 64 << 10
+)
+
+// templateData is passed to the templates of the [Config].
+type templateData struct {
+	SenderPhoneNumber    string
+	RecipientPhoneNumber string
+	Content              string
+	EventType            string
+	InstanceID           string
+	UserID               string
+	JobID                string
+}
+
+func InitChannel(ctx context.Context, cfg Config) (channels.NotificationChannel, error) {
+	tmpl, err := cfg.parse()
+	if err != nil {
+		return nil, err
+	}
+
+	logging.Debug("successfully initialized http template sms channel")
+	return channels.HandleMessageFunc(func(message channels.Message) error {
+		msg, ok := message.(*messages.SMS)
+		if !ok {
+			return zerrors.ThrowInternal(nil, "HTTPT-Ms9pl", "message is not SMS")
+		}
+		requestCtx, cancel := context.WithTimeout(ctx, requestTimeout)
+		defer cancel()
+		req, err := tmpl.request(requestCtx, &templateData{
+			SenderPhoneNumber:    msg.SenderPhoneNumber,
+			RecipientPhoneNumber: msg.RecipientPhoneNumber,
+			Content:              msg.Content,
+			EventType:            string(msg.TriggeringEventType),
+			InstanceID:           msg.InstanceID,
+			UserID:               msg.UserID,
+			JobID:                msg.JobID,
+		})
+		if err != nil {
+			// the templates will fail for every retry as well
+			return channels.NewCancelError(err)
+		}
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			return zerrors.ThrowUnknown(err, "HTTPT-Rq9pl", "sms gateway not reachable")
+		}
+		defer func() {
+			err := resp.Body.Close()
+			logging.OnError(err).Debug("unable to close response body of sms gateway")
+		}()
+		body, err := io.ReadAll(io.LimitReader(resp.Body, maxResponseBodySize))
+		if err != nil {
+			return zerrors.ThrowUnknown(err, "HTTPT-Rb9pl", "unable to read response of sms gateway")
+		}
+		if err = tmpl.checkResponse(resp.StatusCode, body); err != nil {
+			// client errors will not be resolved by a retry, except rate limits
+			if resp.StatusCode >= 400 && resp.StatusCode < 500 && resp.StatusCode != http.StatusTooManyRequests {
+				logging.WithFields(
+					"status", resp.StatusCode,
+					"instanceID", msg.InstanceID,
+					"jobID", msg.JobID,
+					"userID", msg.UserID,
+				).Warn("sms gateway rejected message")
+				return channels.NewCancelError(err)
+			}
+			return err
+		}
+		logging.WithFields("method", req.Method, "status", resp.StatusCode).Debug("sms sent using http template")
+		return nil
+	}), nil
+}
+
+func (t *requestTemplate) request(ctx context.Context, data *templateData) (*http.Request, error) {
+	url, err := execute(t.url, data)
+	if err != nil {
+		return nil, err
+	}
+	body, err := execute(t.body, data)
+	if err != nil {
+		return nil, err
+	}
+	var reqBody io.Reader
+	if body != "" {
+		reqBody = strings.NewReader(body)
+	}
+	req, err := http.NewRequestWithContext(ctx, t.method, url, reqBody)
+	if err != nil {
+		return nil, zerrors.ThrowInvalidArgument(err, "HTTPT-Nr9pl", "Errors.SMSConfig.InvalidHTTPTemplate")
+	}
+	for name, header := range t.headers {
+		value, err := execute(header, data)
+		if err != nil {
+			return nil, err
+		}
+		req.Header.Set(name, value)
+	}
+	return req, nil
+}
+
+func (t *requestTemplate) checkResponse(statusCode int, body []byte) error {
+	if len(t.successStatusCodes) > 0 && !slices.Contains(t.successStatusCodes, statusCode) ||
+		len(t.successStatusCodes) == 0 && (statusCode < 200 || statusCode >= 300) {
+		return zerrors.ThrowUnknown(fmt.Errorf("sms gateway responded with status %d", statusCode), "HTTPT-St9pl", "sms gateway didn't return a success status")
+	}
+	if t.successBodyPattern != nil && !t.successBodyPattern.Match(body) {
+		return zerrors.ThrowUnknown(fmt.Errorf("response body of sms gateway does not match %q", t.successBodyPattern.String()), "HTTPT-Bd9pl", "sms gateway didn't return a success response")
+	}
+	return nil
+}
+
+func execute(tmpl *template.Template, data *templateData) (string, error) {
+	buf := new(bytes.Buffer)
+	if err := tmpl.Execute(buf, data); err != nil {
+		return "", zerrors.ThrowInvalidArgument(err, "HTTPT-Ex9pl", "Errors.SMSConfig.InvalidHTTPTemplate")
+	}
+	return buf.String(), nil
+}
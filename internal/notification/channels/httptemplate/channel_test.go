@@ -0,0 +1,253 @@
+package httptemplate
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zitadel/zitadel/internal/notification/channels"
+	"github.com/zitadel/zitadel/internal/notification/messages"
+)
+
+func TestConfig_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		config  Config
+		wantErr bool
+	}{
+		{
+			name:    "url missing",
+			config:  Config{},
+			wantErr: true,
+		},
+		{
+			name: "invalid template",
+			config: Config{
+				URL:  "https://gateway.example.com",
+				Body: "{{.Content",
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalid status code",
+			config: Config{
+				URL:                "https://gateway.example.com",
+				SuccessStatusCodes: []int{42},
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalid pattern",
+			config: Config{
+				URL:                "https://gateway.example.com",
+				SuccessBodyPattern: "(",
+			},
+			wantErr: true,
+		},
+		{
+			name: "ok",
+			config: Config{
+				Method:             http.MethodPost,
+				URL:                "https://gateway.example.com/{{.RecipientPhoneNumber | urlquery}}",
+				Headers:            map[string]string{"Authorization": "Bearer token"},
+				Body:               `{"text":{{json .Content}}}`,
+				SuccessStatusCodes: []int{http.StatusOK, http.StatusAccepted},
+				SuccessBodyPattern: `"status":\s*"sent"`,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.config.Validate()
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestInitChannel_HandleMessage(t *testing.T) {
+	type response struct {
+		status int
+		body   string
+	}
+	type want struct {
+		method  string
+		path    string
+		query   string
+		header  string
+		body    string
+		err     bool
+		cancel  bool
+		noCalls bool
+	}
+	tests := []struct {
+		name     string
+		config   Config
+		response response
+		message  channels.Message
+		want     want
+	}{
+		{
+			name: "not sms",
+			config: Config{
+				URL: "{{.URL}}",
+			},
+			message: &messages.JSON{},
+			want: want{
+				err:     true,
+				noCalls: true,
+			},
+		},
+		{
+			name: "form body, default success",
+			config: Config{
+				URL:     "{{.URL}}/send",
+				Headers: map[string]string{"Content-Type": "application/x-www-form-urlencoded", "X-Api-Key": "key-{{.InstanceID}}"},
+				Body:    "from={{urlquery .SenderPhoneNumber}}&to={{urlquery .RecipientPhoneNumber}}&text={{urlquery .Content}}",
+			},
+			response: response{status: http.StatusCreated},
+			message: &messages.SMS{
+				SenderPhoneNumber:    "+41000000000",
+				RecipientPhoneNumber: "+41791234567",
+				Content:              "your code is 123 & more",
+				InstanceID:           "instance",
+			},
+			want: want{
+				method: http.MethodPost,
+				path:   "/send",
+				header: "key-instance",
+				body:   "from=%2B41000000000&to=%2B41791234567&text=your+code+is+123+%26+more",
+			},
+		},
+		{
+			name: "json body, get with query, body pattern",
+			config: Config{
+				Method:             "get",
+				URL:                "{{.URL}}/send?to={{urlquery .RecipientPhoneNumber}}",
+				Headers:            map[string]string{"X-Api-Key": "key"},
+				Body:               `{"text":{{json .Content}}}`,
+				SuccessStatusCodes: []int{http.StatusOK},
+				SuccessBodyPattern: `"status":\s*"sent"`,
+			},
+			response: response{status: http.StatusOK, body: `{"status": "sent"}`},
+			message: &messages.SMS{
+				RecipientPhoneNumber: "+41791234567",
+				Content:              `say "hi"`,
+			},
+			want: want{
+				method: http.MethodGet,
+				path:   "/send",
+				query:  "to=%2B41791234567",
+				header: "key",
+				body:   `{"text":"say \"hi\""}`,
+			},
+		},
+		{
+			name: "body pattern not matched",
+			config: Config{
+				URL:                "{{.URL}}",
+				SuccessBodyPattern: `"status":\s*"sent"`,
+			},
+			response: response{status: http.StatusOK, body: `{"status": "failed"}`},
+			message:  &messages.SMS{},
+			want: want{
+				method: http.MethodPost,
+				path:   "/",
+				err:    true,
+			},
+		},
+		{
+			name: "unexpected status code",
+			config: Config{
+				URL:                "{{.URL}}",
+				SuccessStatusCodes: []int{http.StatusAccepted},
+			},
+			response: response{status: http.StatusOK},
+			message:  &messages.SMS{},
+			want: want{
+				method: http.MethodPost,
+				path:   "/",
+				err:    true,
+			},
+		},
+		{
+			name: "client error, cancelled",
+			config: Config{
+				URL: "{{.URL}}",
+			},
+			response: response{status: http.StatusBadRequest},
+			message:  &messages.SMS{},
+			want: want{
+				method: http.MethodPost,
+				path:   "/",
+				err:    true,
+				cancel: true,
+			},
+		},
+		{
+			name: "rate limited, retried",
+			config: Config{
+				URL: "{{.URL}}",
+			},
+			response: response{status: http.StatusTooManyRequests},
+			message:  &messages.SMS{},
+			want: want{
+				method: http.MethodPost,
+				path:   "/",
+				err:    true,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var calls int
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				calls++
+				body, err := io.ReadAll(r.Body)
+				require.NoError(t, err)
+				assert.Equal(t, tt.want.method, r.Method)
+				assert.Equal(t, tt.want.path, r.URL.Path)
+				assert.Equal(t, tt.want.query, r.URL.RawQuery)
+				assert.Equal(t, tt.want.header, r.Header.Get("X-Api-Key"))
+				assert.Equal(t, tt.want.body, string(body))
+				w.WriteHeader(tt.response.status)
+				_, err = w.Write([]byte(tt.response.body))
+				require.NoError(t, err)
+			}))
+			defer server.Close()
+
+			// the url of the test server is only known at runtime
+			tt.config.URL = replaceServerURL(tt.config.URL, server.URL)
+			channel, err := InitChannel(context.Background(), tt.config)
+			require.NoError(t, err)
+
+			err = channel.HandleMessage(tt.message)
+			if tt.want.noCalls {
+				assert.Zero(t, calls)
+			} else {
+				assert.Equal(t, 1, calls)
+			}
+			if !tt.want.err {
+				assert.NoError(t, err)
+				return
+			}
+			assert.Error(t, err)
+			var cancelErr *channels.CancelError
+			assert.Equal(t, tt.want.cancel, errors.As(err, &cancelErr))
+		})
+	}
+}
+
+func replaceServerURL(url, serverURL string) string {
+	return strings.Replace(url, "{{.URL}}", serverURL, 1)
+}
@@ -0,0 +1,106 @@
+package httptemplate
+
+import (
+	"encoding/json"
+	"net/http"
+	"regexp"
+	"strings"
+	"text/template"
+
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// Config describes the HTTP request sent to an SMS gateway.
+// The URL, the header values and the body are Go templates executed with the SMS to send, see [templateData].
+type Config struct {
+	Method       string
+	URL          string
+	Headers      map[string]string
+	Body         string
+	SenderNumber string
+	// SuccessStatusCodes are the status codes of a successful response, if empty any 2xx status code is accepted.
+	SuccessStatusCodes []int
+	// SuccessBodyPattern is a regular expression the response body must match, if empty the body is not checked.
+	SuccessBodyPattern string
+}
+
+// requestTemplate is the parsed representation of the [Config]
+type requestTemplate struct {
+	method             string
+	url                *template.Template
+	headers            map[string]*template.Template
+	body               *template.Template
+	successStatusCodes []int
+	successBodyPattern *regexp.Regexp
+}
+
+var templateFuncs = template.FuncMap{
+	// json encodes the value as JSON, strings are therefore quoted and escaped
+	"json": func(v any) (string, error) {
+		data, err := json.Marshal(v)
+		return string(data), err
+	},
+}
+
+// Validate checks if the templates and the success pattern can be parsed.
+func (c *Config) Validate() error {
+	_, err := c.parse()
+	return err
+}
+
+func (c *Config) parse() (_ *requestTemplate, err error) {
+	tmpl := &requestTemplate{
+		method:             strings.ToUpper(c.Method),
+		successStatusCodes: c.SuccessStatusCodes,
+	}
+	if tmpl.method == "" {
+		tmpl.method = http.MethodPost
+	}
+	if c.URL == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "HTTPT-Ur1mp", "Errors.SMSConfig.InvalidHTTPTemplate")
+	}
+	if tmpl.url, err = parseTemplate("url", c.URL); err != nil {
+		return nil, err
+	}
+	if tmpl.body, err = parseTemplate("body", c.Body); err != nil {
+		return nil, err
+	}
+	if tmpl.headers, err = parseHeaders(c.Headers); err != nil {
+		return nil, err
+	}
+	for _, code := range c.SuccessStatusCodes {
+		if code < 100 || code > 599 {
+			return nil, zerrors.ThrowInvalidArgument(nil, "HTTPT-Sc1mp", "Errors.SMSConfig.InvalidHTTPTemplate")
+		}
+	}
+	if c.SuccessBodyPattern != "" {
+		if tmpl.successBodyPattern, err = regexp.Compile(c.SuccessBodyPattern); err != nil {
+			return nil, zerrors.ThrowInvalidArgument(err, "HTTPT-Bp1mp", "Errors.SMSConfig.InvalidHTTPTemplate")
+		}
+	}
+	return tmpl, nil
+}
+
+// ValidateHeaders checks if the templates of the header values can be parsed.
+func ValidateHeaders(headers map[string]string) error {
+	_, err := parseHeaders(headers)
+	return err
+}
+
+func parseHeaders(headers map[string]string) (_ map[string]*template.Template, err error) {
+	templates := make(map[string]*template.Template, len(headers))
+	for name, value := range headers {
+		if templates[name], err = parseTemplate("header", value); err != nil {
+			return nil, err
+		}
+	}
+	return templates, nil
+}
+
+func parseTemplate(name, text string) (*template.Template, error) {
+	tmpl, err := template.New(name).Funcs(templateFuncs).Option("missingkey=error").Parse(text)
+	if err != nil {
+		return nil, zerrors.ThrowInvalidArgument(err, "HTTPT-Tp1mp", "Errors.SMSConfig.InvalidHTTPTemplate")
+	}
+	return tmpl, nil
+}
@@ -1,14 +1,16 @@
 package sms
 
 import (
+	"github.com/zitadel/zitadel/internal/notification/channels/httptemplate"
 	"github.com/zitadel/zitadel/internal/notification/channels/twilio"
 	"github.com/zitadel/zitadel/internal/notification/channels/webhook"
 )
 
 type Config struct {
-	ProviderConfig *Provider
-	TwilioConfig   *twilio.Config
-	WebhookConfig  *webhook.Config
+	ProviderConfig     *Provider
+	TwilioConfig       *twilio.Config
+	WebhookConfig      *webhook.Config
+	HTTPTemplateConfig *httptemplate.Config
 }
 
 type Provider struct {
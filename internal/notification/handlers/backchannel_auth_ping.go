@@ -0,0 +1,148 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/zitadel/zitadel/internal/crypto"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/eventstore/handler/v2"
+	"github.com/zitadel/zitadel/internal/notification/channels/webhook"
+	"github.com/zitadel/zitadel/internal/notification/types"
+	"github.com/zitadel/zitadel/internal/repository/backchannelauth"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+const (
+	BackChannelAuthPingNotificationsProjectionTable = "projections.notifications_back_channel_auth_ping"
+)
+
+// backChannelAuthPingNotifier informs clients using the ping mode of the
+// client initiated backchannel authentication (CIBA) as soon as the user
+// approved or denied the request.
+// Expired requests are not notified, as their expiration is only recorded when they're used.
+// Clients stop waiting for the notification after the returned expires_in.
+type backChannelAuthPingNotifier struct {
+	queries    *NotificationQueries
+	eventstore *eventstore.Eventstore
+	channels   types.ChannelChains
+}
+
+func NewBackChannelAuthPingNotifier(
+	ctx context.Context,
+	config handler.Config,
+	queries *NotificationQueries,
+	es *eventstore.Eventstore,
+	channels types.ChannelChains,
+) *handler.Handler {
+	return handler.NewHandler(ctx, &config, &backChannelAuthPingNotifier{
+		queries:    queries,
+		eventstore: es,
+		channels:   channels,
+	})
+}
+
+func (*backChannelAuthPingNotifier) Name() string {
+	return BackChannelAuthPingNotificationsProjectionTable
+}
+
+func (u *backChannelAuthPingNotifier) Reducers() []handler.AggregateReducer {
+	return []handler.AggregateReducer{
+		{
+			Aggregate: backchannelauth.AggregateType,
+			EventReducers: []handler.EventReducer{
+				{
+					Event:  backchannelauth.ApprovedEventType,
+					Reduce: u.reduceBackChannelAuthHandled,
+				},
+				{
+					Event:  backchannelauth.CanceledEventType,
+					Reduce: u.reduceBackChannelAuthHandled,
+				},
+			},
+		},
+	}
+}
+
+func (u *backChannelAuthPingNotifier) reduceBackChannelAuthHandled(event eventstore.Event) (*handler.Statement, error) {
+	switch e := event.(type) {
+	case *backchannelauth.ApprovedEvent:
+	case *backchannelauth.CanceledEvent:
+		if e.Reason == domain.BackChannelAuthCanceledExpired {
+			return handler.NewNoOpStatement(event), nil
+		}
+	default:
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "HANDL-Cba1p", "reduce.wrong.event.type %v", []eventstore.EventType{backchannelauth.ApprovedEventType, backchannelauth.CanceledEventType})
+	}
+
+	return handler.NewStatement(event, func(ex handler.Executer, projectionName string) error {
+		ctx, err := u.queries.HandlerContext(event.Aggregate())
+		if err != nil {
+			return err
+		}
+		request := &backChannelAuthPingRequest{id: event.Aggregate().ID}
+		if err = u.eventstore.FilterToQueryReducer(ctx, request); err != nil {
+			return err
+		}
+		if request.deliveryMode != domain.BackChannelTokenDeliveryModePing || request.notificationURI == "" {
+			return nil
+		}
+		token, err := crypto.DecryptString(request.notificationToken, u.queries.UserDataCrypto)
+		if err != nil {
+			return err
+		}
+		ctx, err = u.queries.Origin(ctx, event)
+		if err != nil {
+			return err
+		}
+		return types.SendJSON(
+			ctx,
+			webhook.Config{
+				CallURL: request.notificationURI,
+				Method:  http.MethodPost,
+				Headers: http.Header{"Authorization": {"Bearer " + token}},
+			},
+			u.channels,
+			&BackChannelAuthPingMessage{AuthReqID: request.id},
+			event.Type(),
+		).WithoutTemplate()
+	}), nil
+}
+
+// BackChannelAuthPingMessage is sent to the client notification endpoint (CIBA core, section 10.2).
+type BackChannelAuthPingMessage struct {
+	AuthReqID string `json:"auth_req_id"`
+}
+
+// backChannelAuthPingRequest reads the notification parameters of a backchannel authentication request.
+type backChannelAuthPingRequest struct {
+	id string
+
+	deliveryMode      domain.BackChannelTokenDeliveryMode
+	notificationURI   string
+	notificationToken *crypto.CryptoValue
+}
+
+func (r *backChannelAuthPingRequest) Reduce() error {
+	return nil
+}
+
+func (r *backChannelAuthPingRequest) AppendEvents(events ...eventstore.Event) {
+	for _, event := range events {
+		if e, ok := event.(*backchannelauth.AddedEvent); ok {
+			r.deliveryMode = e.DeliveryMode
+			r.notificationURI = e.ClientNotificationURI
+			r.notificationToken = e.ClientNotificationToken
+		}
+	}
+}
+
+func (r *backChannelAuthPingRequest) Query() *eventstore.SearchQueryBuilder {
+	return eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		AddQuery().
+		AggregateTypes(backchannelauth.AggregateType).
+		AggregateIDs(r.id).
+		EventTypes(backchannelauth.AddedEventType).
+		Builder()
+}
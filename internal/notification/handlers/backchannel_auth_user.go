@@ -0,0 +1,121 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/eventstore/handler/v2"
+	"github.com/zitadel/zitadel/internal/execution"
+	"github.com/zitadel/zitadel/internal/repository/backchannelauth"
+	exec_repo "github.com/zitadel/zitadel/internal/repository/execution"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+const (
+	BackChannelAuthUserNotificationsProjectionTable = "projections.notifications_back_channel_auth_user"
+)
+
+// backChannelAuthUserNotifier informs the user about a new client initiated backchannel authentication (CIBA) request,
+// by calling the targets of the backchannelauthnotification function execution.
+// The targets are responsible to reach the authentication device of the user, e.g. by a push notification,
+// which then approves or denies the request using the OIDC service.
+type backChannelAuthUserNotifier struct {
+	queries *NotificationQueries
+	now     func() time.Time
+}
+
+func NewBackChannelAuthUserNotifier(
+	ctx context.Context,
+	config handler.Config,
+	queries *NotificationQueries,
+) *handler.Handler {
+	return handler.NewHandler(ctx, &config, &backChannelAuthUserNotifier{
+		queries: queries,
+		now:     time.Now,
+	})
+}
+
+func (*backChannelAuthUserNotifier) Name() string {
+	return BackChannelAuthUserNotificationsProjectionTable
+}
+
+func (u *backChannelAuthUserNotifier) Reducers() []handler.AggregateReducer {
+	return []handler.AggregateReducer{
+		{
+			Aggregate: backchannelauth.AggregateType,
+			EventReducers: []handler.EventReducer{
+				{
+					Event:  backchannelauth.AddedEventType,
+					Reduce: u.reduceBackChannelAuthAdded,
+				},
+			},
+		},
+	}
+}
+
+func (u *backChannelAuthUserNotifier) reduceBackChannelAuthAdded(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*backchannelauth.AddedEvent)
+	if !ok {
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "HANDL-Cba2u", "reduce.wrong.event.type %s", backchannelauth.AddedEventType)
+	}
+
+	return handler.NewStatement(event, func(ex handler.Executer, projectionName string) error {
+		expiresAt := e.CreationDate().Add(e.Lifetime)
+		// the user can no longer approve the request, e.g. if the projection was reset
+		if !expiresAt.After(u.now()) {
+			return nil
+		}
+		ctx, err := u.queries.HandlerContext(event.Aggregate())
+		if err != nil {
+			return err
+		}
+		function := exec_repo.ID(domain.ExecutionTypeFunction, domain.ActionFunctionBackChannelAuthNotification.LocalizationKey())
+		targets, err := execution.QueryExecutionTargetsForFunction(ctx, u.queries, function)
+		if err != nil || len(targets) == 0 {
+			return err
+		}
+		_, err = execution.CallTargets(ctx, targets, &BackChannelAuthUserContextInfo{
+			Function:       function,
+			AuthReqID:      e.Aggregate().ID,
+			ClientID:       e.ClientID,
+			UserID:         e.UserID,
+			UserOrgID:      e.UserOrgID,
+			Scope:          e.Scopes,
+			BindingMessage: e.BindingMessage,
+			ExpiresAt:      expiresAt,
+		})
+		return err
+	}), nil
+}
+
+// BackChannelAuthUserContextInfo is sent to the targets of the backchannelauthnotification function execution.
+// The response of the targets is ignored.
+type BackChannelAuthUserContextInfo struct {
+	Function       string    `json:"function,omitempty"`
+	AuthReqID      string    `json:"auth_req_id,omitempty"`
+	ClientID       string    `json:"client_id,omitempty"`
+	UserID         string    `json:"user_id,omitempty"`
+	UserOrgID      string    `json:"user_org_id,omitempty"`
+	Scope          []string  `json:"scope,omitempty"`
+	BindingMessage string    `json:"binding_message,omitempty"`
+	ExpiresAt      time.Time `json:"expires_at,omitempty"`
+}
+
+func (c *BackChannelAuthUserContextInfo) GetHTTPRequestBody() []byte {
+	data, err := json.Marshal(c)
+	if err != nil {
+		return nil
+	}
+	return data
+}
+
+func (c *BackChannelAuthUserContextInfo) SetHTTPResponseBody([]byte) error {
+	return nil
+}
+
+func (c *BackChannelAuthUserContextInfo) GetContent() interface{} {
+	return nil
+}
@@ -6,6 +6,7 @@ import (
 
 	"github.com/zitadel/zitadel/internal/api/authz"
 	"github.com/zitadel/zitadel/internal/crypto"
+	"github.com/zitadel/zitadel/internal/notification/channels/httptemplate"
 	"github.com/zitadel/zitadel/internal/notification/channels/sms"
 	"github.com/zitadel/zitadel/internal/notification/channels/twilio"
 	"github.com/zitadel/zitadel/internal/notification/channels/webhook"
@@ -51,6 +52,26 @@ func (n *NotificationQueries) GetActiveSMSConfig(ctx context.Context) (*sms.Conf
 			},
 		}, nil
 	}
+	if config.HTTPTemplateConfig != nil {
+		var headers map[string]string
+		if config.HTTPTemplateConfig.Headers != nil {
+			if err := crypto.DecryptJSON(config.HTTPTemplateConfig.Headers, &headers, n.SMSTokenCrypto); err != nil {
+				return nil, err
+			}
+		}
+		return &sms.Config{
+			ProviderConfig: provider,
+			HTTPTemplateConfig: &httptemplate.Config{
+				Method:             config.HTTPTemplateConfig.Method,
+				URL:                config.HTTPTemplateConfig.URL,
+				Headers:            headers,
+				Body:               config.HTTPTemplateConfig.Body,
+				SenderNumber:       config.HTTPTemplateConfig.SenderNumber,
+				SuccessStatusCodes: config.HTTPTemplateConfig.SuccessStatusCodes,
+				SuccessBodyPattern: config.HTTPTemplateConfig.SuccessBodyPattern,
+			},
+		}, nil
+	}
 
 	return nil, zerrors.ThrowNotFound(nil, "HANDLER-8nfow", "Errors.SMS.Twilio.NotFound")
 }
@@ -13,8 +13,10 @@ import (
 	context "context"
 	reflect "reflect"
 
+	eventstore "github.com/zitadel/zitadel/internal/eventstore"
 	senders "github.com/zitadel/zitadel/internal/notification/senders"
 	milestone "github.com/zitadel/zitadel/internal/repository/milestone"
+	notification "github.com/zitadel/zitadel/internal/repository/notification"
 	quota "github.com/zitadel/zitadel/internal/repository/quota"
 	gomock "go.uber.org/mock/gomock"
 )
@@ -42,6 +44,20 @@ func (m *MockCommands) EXPECT() *MockCommandsMockRecorder {
 	return m.recorder
 }
 
+// AccountRecoveryCodeSent mocks base method.
+func (m *MockCommands) AccountRecoveryCodeSent(arg0 context.Context, arg1, arg2 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AccountRecoveryCodeSent", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AccountRecoveryCodeSent indicates an expected call of AccountRecoveryCodeSent.
+func (mr *MockCommandsMockRecorder) AccountRecoveryCodeSent(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AccountRecoveryCodeSent", reflect.TypeOf((*MockCommands)(nil).AccountRecoveryCodeSent), arg0, arg1, arg2)
+}
+
 // HumanEmailVerificationCodeSent mocks base method.
 func (m *MockCommands) HumanEmailVerificationCodeSent(arg0 context.Context, arg1, arg2 string) error {
 	m.ctrl.T.Helper()
@@ -70,6 +86,20 @@ func (mr *MockCommandsMockRecorder) HumanInitCodeSent(arg0, arg1, arg2 any) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HumanInitCodeSent", reflect.TypeOf((*MockCommands)(nil).HumanInitCodeSent), arg0, arg1, arg2)
 }
 
+// HumanMagicLinkCodeSent mocks base method.
+func (m *MockCommands) HumanMagicLinkCodeSent(arg0 context.Context, arg1, arg2 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HumanMagicLinkCodeSent", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// HumanMagicLinkCodeSent indicates an expected call of HumanMagicLinkCodeSent.
+func (mr *MockCommandsMockRecorder) HumanMagicLinkCodeSent(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HumanMagicLinkCodeSent", reflect.TypeOf((*MockCommands)(nil).HumanMagicLinkCodeSent), arg0, arg1, arg2)
+}
+
 // HumanOTPEmailCodeSent mocks base method.
 func (m *MockCommands) HumanOTPEmailCodeSent(arg0 context.Context, arg1, arg2 string) error {
 	m.ctrl.T.Helper()
@@ -140,6 +170,20 @@ func (mr *MockCommandsMockRecorder) InviteCodeSent(arg0, arg1, arg2 any) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InviteCodeSent", reflect.TypeOf((*MockCommands)(nil).InviteCodeSent), arg0, arg1, arg2)
 }
 
+// MagicLinkSent mocks base method.
+func (m *MockCommands) MagicLinkSent(arg0 context.Context, arg1, arg2 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MagicLinkSent", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MagicLinkSent indicates an expected call of MagicLinkSent.
+func (mr *MockCommandsMockRecorder) MagicLinkSent(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MagicLinkSent", reflect.TypeOf((*MockCommands)(nil).MagicLinkSent), arg0, arg1, arg2)
+}
+
 // MilestonePushed mocks base method.
 func (m *MockCommands) MilestonePushed(arg0 context.Context, arg1 string, arg2 milestone.Type, arg3 []string) error {
 	m.ctrl.T.Helper()
@@ -154,6 +198,48 @@ func (mr *MockCommandsMockRecorder) MilestonePushed(arg0, arg1, arg2, arg3 any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MilestonePushed", reflect.TypeOf((*MockCommands)(nil).MilestonePushed), arg0, arg1, arg2, arg3)
 }
 
+// NotificationDeliveryAttemptFailed mocks base method.
+func (m *MockCommands) NotificationDeliveryAttemptFailed(arg0 context.Context, arg1 *eventstore.Aggregate, arg2 *notification.DeliveryInfo, arg3 error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NotificationDeliveryAttemptFailed", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// NotificationDeliveryAttemptFailed indicates an expected call of NotificationDeliveryAttemptFailed.
+func (mr *MockCommandsMockRecorder) NotificationDeliveryAttemptFailed(arg0, arg1, arg2, arg3 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NotificationDeliveryAttemptFailed", reflect.TypeOf((*MockCommands)(nil).NotificationDeliveryAttemptFailed), arg0, arg1, arg2, arg3)
+}
+
+// NotificationDeliveryFailed mocks base method.
+func (m *MockCommands) NotificationDeliveryFailed(arg0 context.Context, arg1 *eventstore.Aggregate, arg2 *notification.DeliveryInfo, arg3 error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NotificationDeliveryFailed", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// NotificationDeliveryFailed indicates an expected call of NotificationDeliveryFailed.
+func (mr *MockCommandsMockRecorder) NotificationDeliveryFailed(arg0, arg1, arg2, arg3 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NotificationDeliveryFailed", reflect.TypeOf((*MockCommands)(nil).NotificationDeliveryFailed), arg0, arg1, arg2, arg3)
+}
+
+// NotificationDeliverySucceeded mocks base method.
+func (m *MockCommands) NotificationDeliverySucceeded(arg0 context.Context, arg1 *eventstore.Aggregate, arg2 *notification.DeliveryInfo) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NotificationDeliverySucceeded", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// NotificationDeliverySucceeded indicates an expected call of NotificationDeliverySucceeded.
+func (mr *MockCommandsMockRecorder) NotificationDeliverySucceeded(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NotificationDeliverySucceeded", reflect.TypeOf((*MockCommands)(nil).NotificationDeliverySucceeded), arg0, arg1, arg2)
+}
+
 // OTPEmailSent mocks base method.
 func (m *MockCommands) OTPEmailSent(arg0 context.Context, arg1, arg2 string) error {
 	m.ctrl.T.Helper()
@@ -210,6 +296,20 @@ func (mr *MockCommandsMockRecorder) PasswordCodeSent(arg0, arg1, arg2, arg3 any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PasswordCodeSent", reflect.TypeOf((*MockCommands)(nil).PasswordCodeSent), arg0, arg1, arg2, arg3)
 }
 
+// RecoveryEmailCodeSent mocks base method.
+func (m *MockCommands) RecoveryEmailCodeSent(arg0 context.Context, arg1, arg2 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecoveryEmailCodeSent", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecoveryEmailCodeSent indicates an expected call of RecoveryEmailCodeSent.
+func (mr *MockCommandsMockRecorder) RecoveryEmailCodeSent(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecoveryEmailCodeSent", reflect.TypeOf((*MockCommands)(nil).RecoveryEmailCodeSent), arg0, arg1, arg2)
+}
+
 // UsageNotificationSent mocks base method.
 func (m *MockCommands) UsageNotificationSent(arg0 context.Context, arg1 *quota.NotificationDueEvent) error {
 	m.ctrl.T.Helper()
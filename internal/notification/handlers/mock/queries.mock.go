@@ -178,6 +178,21 @@ func (mr *MockQueriesMockRecorder) InstanceByID(arg0, arg1 any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InstanceByID", reflect.TypeOf((*MockQueries)(nil).InstanceByID), arg0, arg1)
 }
 
+// IsEmailSuppressed mocks base method.
+func (m *MockQueries) IsEmailSuppressed(arg0 context.Context, arg1 string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsEmailSuppressed", arg0, arg1)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IsEmailSuppressed indicates an expected call of IsEmailSuppressed.
+func (mr *MockQueriesMockRecorder) IsEmailSuppressed(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsEmailSuppressed", reflect.TypeOf((*MockQueries)(nil).IsEmailSuppressed), arg0, arg1)
+}
+
 // MailTemplateByOrg mocks base method.
 func (m *MockQueries) MailTemplateByOrg(arg0 context.Context, arg1 string, arg2 bool) (*query.MailTemplate, error) {
 	m.ctrl.T.Helper()
@@ -297,3 +312,33 @@ func (mr *MockQueriesMockRecorder) SessionByID(arg0, arg1, arg2, arg3, arg4 any)
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SessionByID", reflect.TypeOf((*MockQueries)(nil).SessionByID), arg0, arg1, arg2, arg3, arg4)
 }
+
+// TargetsByExecutionID mocks base method.
+func (m *MockQueries) TargetsByExecutionID(arg0 context.Context, arg1 []string) ([]*query.ExecutionTarget, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TargetsByExecutionID", arg0, arg1)
+	ret0, _ := ret[0].([]*query.ExecutionTarget)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TargetsByExecutionID indicates an expected call of TargetsByExecutionID.
+func (mr *MockQueriesMockRecorder) TargetsByExecutionID(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TargetsByExecutionID", reflect.TypeOf((*MockQueries)(nil).TargetsByExecutionID), arg0, arg1)
+}
+
+// TargetsByExecutionIDs mocks base method.
+func (m *MockQueries) TargetsByExecutionIDs(arg0 context.Context, arg1, arg2 []string) ([]*query.ExecutionTarget, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TargetsByExecutionIDs", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]*query.ExecutionTarget)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TargetsByExecutionIDs indicates an expected call of TargetsByExecutionIDs.
+func (mr *MockQueriesMockRecorder) TargetsByExecutionIDs(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TargetsByExecutionIDs", reflect.TypeOf((*MockQueries)(nil).TargetsByExecutionIDs), arg0, arg1, arg2)
+}
@@ -16,6 +16,8 @@ import (
 	"github.com/zitadel/zitadel/internal/domain"
 	"github.com/zitadel/zitadel/internal/eventstore"
 	"github.com/zitadel/zitadel/internal/notification/channels"
+	"github.com/zitadel/zitadel/internal/notification/channels/email"
+	"github.com/zitadel/zitadel/internal/notification/channels/sms"
 	"github.com/zitadel/zitadel/internal/notification/senders"
 	"github.com/zitadel/zitadel/internal/notification/types"
 	"github.com/zitadel/zitadel/internal/query"
@@ -46,10 +48,13 @@ func (w *NotificationWorker) Timeout(*river.Job[*notification.Request]) time.Dur
 // Work implements [river.Worker].
 func (w *NotificationWorker) Work(ctx context.Context, job *river.Job[*notification.Request]) error {
 	ctx = ContextWithNotifier(ctx, job.Args.Aggregate)
+	delivery := newNotificationDelivery(job)
 
 	// if the notification is too old, we can directly cancel
 	if job.CreatedAt.Add(w.config.MaxTtl).Before(w.now()) {
-		return river.JobCancel(errors.New("notification is too old"))
+		err := errors.New("notification is too old")
+		w.deliveryFailed(ctx, delivery, err, true)
+		return river.JobCancel(err)
 	}
 
 	// We do not trigger the projection to reduce load on the database. By the time the notification is processed,
@@ -57,8 +62,13 @@ func (w *NotificationWorker) Work(ctx context.Context, job *river.Job[*notificat
 	// We are aware that the user can change during the time the notification is in the queue.
 	notifyUser, err := w.queries.GetNotifyUserByID(ctx, false, job.Args.UserID)
 	if err != nil {
+		w.deliveryFailed(ctx, delivery, err, job.Attempt >= job.MaxAttempts)
 		return err
 	}
+	if job.Args.Recipient != "" {
+		notifyUser = notifyUserWithRecipient(notifyUser, job.Args.Recipient)
+	}
+	delivery.info.Recipient = notificationRecipient(job.Args, notifyUser)
 
 	// The domain claimed event requires the domain as argument, but lacks the user when creating the request event.
 	// Since we set it into the request arguments, it will be passed into a potential retry event.
@@ -67,17 +77,107 @@ func (w *NotificationWorker) Work(ctx context.Context, job *river.Job[*notificat
 		job.Args.Args.Domain = notifyUser.LastEmail[index+1:]
 	}
 
-	err = w.sendNotificationQueue(ctx, job.Args, strconv.Itoa(int(job.ID)), notifyUser)
+	chains := &deliveryChannels{ChannelChains: w.channels}
+	err = w.sendNotificationQueue(ctx, chains, job.Args, strconv.Itoa(int(job.ID)), notifyUser)
+	delivery.info.ProviderID = chains.providerID
 	if err == nil {
+		err = w.commands.NotificationDeliverySucceeded(ctx, delivery.aggregate, delivery.info)
+		logging.WithFields("instanceID", delivery.aggregate.InstanceID, "notification", delivery.aggregate.ID).
+			OnError(err).Error("could not record notification delivery")
 		return nil
 	}
 	// if the error explicitly specifies, we cancel the notification
 	if errors.Is(err, &channels.CancelError{}) {
+		w.deliveryFailed(ctx, delivery, err, true)
 		return river.JobCancel(err)
 	}
+	w.deliveryFailed(ctx, delivery, err, job.Attempt >= job.MaxAttempts)
 	return err
 }
 
+// notificationDelivery is the record of the delivery of a notification job
+type notificationDelivery struct {
+	aggregate *eventstore.Aggregate
+	info      *notification.DeliveryInfo
+}
+
+// newNotificationDelivery creates the record of the delivery.
+// If the job was retriggered, the delivery is recorded on the existing notification, otherwise on the id of the job.
+func newNotificationDelivery(job *river.Job[*notification.Request]) *notificationDelivery {
+	id := job.Args.NotificationID
+	if id == "" {
+		id = strconv.FormatInt(job.ID, 10)
+	}
+	return &notificationDelivery{
+		aggregate: notification.NewAggregate(id, job.Args.UserResourceOwner, job.Args.Aggregate.InstanceID),
+		info: &notification.DeliveryInfo{
+			Request: job.Args,
+			Attempt: job.Attempt,
+		},
+	}
+}
+
+// deliveryFailed records the failed attempt, final is set if the job will not be retried.
+// Recording errors are only logged, as they must not influence the retries of the job.
+func (w *NotificationWorker) deliveryFailed(ctx context.Context, delivery *notificationDelivery, deliveryErr error, final bool) {
+	var err error
+	if final {
+		err = w.commands.NotificationDeliveryFailed(ctx, delivery.aggregate, delivery.info, deliveryErr)
+	} else {
+		err = w.commands.NotificationDeliveryAttemptFailed(ctx, delivery.aggregate, delivery.info, deliveryErr)
+	}
+	logging.WithFields("instanceID", delivery.aggregate.InstanceID, "notification", delivery.aggregate.ID).
+		OnError(err).Error("could not record notification delivery")
+}
+
+// notificationRecipient returns the email address or phone number the notification is sent to
+func notificationRecipient(request *notification.Request, user *query.NotifyUser) string {
+	switch request.NotificationType {
+	case domain.NotificationTypeEmail:
+		if request.UnverifiedNotificationChannel {
+			return user.LastEmail
+		}
+		return user.VerifiedEmail
+	case domain.NotificationTypeSms:
+		if request.UnverifiedNotificationChannel {
+			return user.LastPhone
+		}
+		return user.VerifiedPhone
+	}
+	return ""
+}
+
+// notifyUserWithRecipient returns a copy of the user, which sends email notifications
+// to the recipient (e.g. the recovery email) instead of the email of the user.
+func notifyUserWithRecipient(user *query.NotifyUser, recipient string) *query.NotifyUser {
+	recipientUser := *user
+	recipientUser.LastEmail = recipient
+	recipientUser.VerifiedEmail = recipient
+	return &recipientUser
+}
+
+// deliveryChannels remembers the provider of the channel used to deliver the notification
+type deliveryChannels struct {
+	types.ChannelChains
+	providerID string
+}
+
+func (c *deliveryChannels) Email(ctx context.Context) (*senders.Chain, *email.Config, error) {
+	chain, config, err := c.ChannelChains.Email(ctx)
+	if config != nil && config.ProviderConfig != nil {
+		c.providerID = config.ProviderConfig.ID
+	}
+	return chain, config, err
+}
+
+func (c *deliveryChannels) SMS(ctx context.Context) (*senders.Chain, *sms.Config, error) {
+	chain, config, err := c.ChannelChains.SMS(ctx)
+	if config != nil && config.ProviderConfig != nil {
+		c.providerID = config.ProviderConfig.ID
+	}
+	return chain, config, err
+}
+
 type WorkerConfig struct {
 	LegacyEnabled       bool
 	Workers             uint8
@@ -124,7 +224,7 @@ func (w *NotificationWorker) Register(workers *river.Workers, queues map[string]
 	}
 }
 
-func (w *NotificationWorker) sendNotificationQueue(ctx context.Context, request *notification.Request, jobID string, notifyUser *query.NotifyUser) error {
+func (w *NotificationWorker) sendNotificationQueue(ctx context.Context, chains types.ChannelChains, request *notification.Request, jobID string, notifyUser *query.NotifyUser) error {
 	// check early that a "sent" handler exists, otherwise we can cancel early
 	sentHandler, ok := sentHandlers[request.EventType]
 	if !ok {
@@ -163,9 +263,9 @@ func (w *NotificationWorker) sendNotificationQueue(ctx context.Context, request
 		if err != nil {
 			return err
 		}
-		notify = types.SendEmail(ctx, w.channels, string(template.Template), translator, notifyUser, colors, request.EventType)
+		notify = types.SendEmail(ctx, chains, string(template.Template), translator, notifyUser, colors, request.EventType)
 	case domain.NotificationTypeSms:
-		notify = types.SendSMS(ctx, w.channels, translator, notifyUser, colors, request.EventType, request.Aggregate.InstanceID, jobID, generatorInfo)
+		notify = types.SendSMS(ctx, chains, translator, notifyUser, colors, request.EventType, request.Aggregate.InstanceID, jobID, generatorInfo)
 	}
 
 	args := request.Args.ToMap()
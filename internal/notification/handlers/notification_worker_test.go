@@ -49,6 +49,7 @@ func Test_userNotifier_reduceNotificationRequested(t *testing.T) {
 		{
 			name: "too old",
 			test: func(ctrl *gomock.Controller, queries *mock.MockQueries, commands *mock.MockCommands) (f fieldsWorker, a argsWorker, w wantWorker) {
+				commands.EXPECT().NotificationDeliveryFailed(gomock.Any(), notification.NewAggregate("0", orgID, instanceID), gomock.Any(), gomock.Any()).Return(nil)
 				codeAlg, code := cryptoValue(t, ctrl, "testcode")
 				return fieldsWorker{
 						queries:  queries,
@@ -108,6 +109,12 @@ func Test_userNotifier_reduceNotificationRequested(t *testing.T) {
 				}
 				codeAlg, code := cryptoValue(t, ctrl, "testcode")
 				expectTemplateWithNotifyUserQueries(queries, givenTemplate)
+				commands.EXPECT().NotificationDeliverySucceeded(gomock.Any(), notification.NewAggregate("0", orgID, instanceID), gomock.Any()).
+					DoAndReturn(func(_ context.Context, _ *eventstore.Aggregate, info *notification.DeliveryInfo) error {
+						assert.Equal(t, lastEmail, info.Recipient)
+						assert.Equal(t, emailProviderID, info.ProviderID)
+						return nil
+					})
 				commands.EXPECT().InviteCodeSent(gomock.Any(), orgID, userID).Return(nil)
 				return fieldsWorker{
 						queries:  queries,
@@ -168,6 +175,12 @@ func Test_userNotifier_reduceNotificationRequested(t *testing.T) {
 				}
 				codeAlg, code := cryptoValue(t, ctrl, testCode)
 				expectTemplateWithNotifyUserQueriesSMS(queries)
+				commands.EXPECT().NotificationDeliverySucceeded(gomock.Any(), notification.NewAggregate("1", orgID, instanceID), gomock.Any()).
+					DoAndReturn(func(_ context.Context, _ *eventstore.Aggregate, info *notification.DeliveryInfo) error {
+						assert.Equal(t, verifiedPhone, info.Recipient)
+						assert.Equal(t, smsProviderID, info.ProviderID)
+						return nil
+					})
 				commands.EXPECT().OTPSMSSent(gomock.Any(), sessionID, instanceID, &senders.CodeGeneratorInfo{
 					ID:             smsProviderID,
 					VerificationID: verificationID,
@@ -227,6 +240,7 @@ func Test_userNotifier_reduceNotificationRequested(t *testing.T) {
 					TriggeringEventType: user.UserDomainClaimedType,
 				}
 				expectTemplateWithNotifyUserQueries(queries, givenTemplate)
+				commands.EXPECT().NotificationDeliverySucceeded(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
 				commands.EXPECT().UserDomainClaimedSent(gomock.Any(), orgID, userID).Return(nil)
 				return fieldsWorker{
 						queries:  queries,
@@ -283,6 +297,7 @@ func Test_userNotifier_reduceNotificationRequested(t *testing.T) {
 				w.err = func(tt assert.TestingT, err error, i ...interface{}) bool {
 					return errors.Is(err, sendError)
 				}
+				commands.EXPECT().NotificationDeliveryAttemptFailed(gomock.Any(), notification.NewAggregate("1", orgID, instanceID), gomock.Any(), sendError).Return(nil)
 				codeAlg, code := cryptoValue(t, ctrl, "testcode")
 				expectTemplateWithNotifyUserQueries(queries, givenTemplate)
 				return fieldsWorker{
@@ -298,8 +313,10 @@ func Test_userNotifier_reduceNotificationRequested(t *testing.T) {
 					argsWorker{
 						job: &river.Job[*notification.Request]{
 							JobRow: &rivertype.JobRow{
-								ID:        1,
-								CreatedAt: time.Now(),
+								ID:          1,
+								CreatedAt:   time.Now(),
+								Attempt:     1,
+								MaxAttempts: 3,
 							},
 							Args: &notification.Request{
 								Aggregate: &eventstore.Aggregate{
@@ -344,6 +361,7 @@ func Test_userNotifier_reduceNotificationRequested(t *testing.T) {
 					return err != nil
 				}
 
+				commands.EXPECT().NotificationDeliveryFailed(gomock.Any(), notification.NewAggregate("0", orgID, instanceID), gomock.Any(), sendError).Return(nil)
 				codeAlg, code := cryptoValue(t, ctrl, "testcode")
 				expectTemplateWithNotifyUserQueries(queries, givenTemplate)
 				return fieldsWorker{
@@ -359,7 +377,9 @@ func Test_userNotifier_reduceNotificationRequested(t *testing.T) {
 					argsWorker{
 						job: &river.Job[*notification.Request]{
 							JobRow: &rivertype.JobRow{
-								CreatedAt: time.Now(),
+								CreatedAt:   time.Now(),
+								Attempt:     3,
+								MaxAttempts: 3,
 							},
 							Args: &notification.Request{
 								Aggregate: &eventstore.Aggregate{
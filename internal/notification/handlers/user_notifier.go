@@ -55,6 +55,16 @@ func init() {
 			return commands.OTPEmailSent(ctx, id, orgID)
 		},
 	)
+	RegisterSentHandler(user.HumanMagicLinkCodeAddedType,
+		func(ctx context.Context, commands Commands, id, orgID string, generatorInfo *senders.CodeGeneratorInfo, args map[string]any) error {
+			return commands.HumanMagicLinkCodeSent(ctx, id, orgID)
+		},
+	)
+	RegisterSentHandler(session.MagicLinkChallengedType,
+		func(ctx context.Context, commands Commands, id, orgID string, generatorInfo *senders.CodeGeneratorInfo, args map[string]any) error {
+			return commands.MagicLinkSent(ctx, id, orgID)
+		},
+	)
 	RegisterSentHandler(user.UserDomainClaimedType,
 		func(ctx context.Context, commands Commands, id, orgID string, generatorInfo *senders.CodeGeneratorInfo, args map[string]any) error {
 			return commands.UserDomainClaimedSent(ctx, orgID, id)
@@ -80,6 +90,16 @@ func init() {
 			return commands.InviteCodeSent(ctx, orgID, id)
 		},
 	)
+	RegisterSentHandler(user.HumanRecoveryEmailCodeAddedType,
+		func(ctx context.Context, commands Commands, id, orgID string, _ *senders.CodeGeneratorInfo, args map[string]any) error {
+			return commands.RecoveryEmailCodeSent(ctx, orgID, id)
+		},
+	)
+	RegisterSentHandler(user.HumanAccountRecoveryApprovedType,
+		func(ctx context.Context, commands Commands, id, orgID string, _ *senders.CodeGeneratorInfo, args map[string]any) error {
+			return commands.AccountRecoveryCodeSent(ctx, orgID, id)
+		},
+	)
 }
 
 const (
@@ -180,6 +200,18 @@ func (u *userNotifier) Reducers() []handler.AggregateReducer {
 					Event:  user.HumanInviteCodeAddedType,
 					Reduce: u.reduceInviteCodeAdded,
 				},
+				{
+					Event:  user.HumanRecoveryEmailCodeAddedType,
+					Reduce: u.reduceRecoveryEmailCodeAdded,
+				},
+				{
+					Event:  user.HumanAccountRecoveryApprovedType,
+					Reduce: u.reduceAccountRecoveryApproved,
+				},
+				{
+					Event:  user.HumanMagicLinkCodeAddedType,
+					Reduce: u.reduceMagicLinkCodeAdded,
+				},
 			},
 		},
 		{
@@ -193,6 +225,19 @@ func (u *userNotifier) Reducers() []handler.AggregateReducer {
 					Event:  session.OTPEmailChallengedType,
 					Reduce: u.reduceSessionOTPEmailChallenged,
 				},
+				{
+					Event:  session.MagicLinkChallengedType,
+					Reduce: u.reduceSessionMagicLinkChallenged,
+				},
+			},
+		},
+		{
+			Aggregate: notification.AggregateType,
+			EventReducers: []handler.EventReducer{
+				{
+					Event:  notification.DeliveryRetryRequestedEventType,
+					Reduce: u.reduceNotificationDeliveryRetryRequested,
+				},
 			},
 		},
 	}
@@ -356,6 +401,113 @@ func (u *userNotifier) passwordCodeTemplate(origin string, e *user.HumanPassword
 	return login.InitPasswordLinkTemplate(origin, e.Aggregate().ID, e.Aggregate().ResourceOwner, e.AuthRequestID)
 }
 
+func (u *userNotifier) reduceRecoveryEmailCodeAdded(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*user.HumanRecoveryEmailCodeAddedEvent)
+	if !ok {
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "HANDL-Re3cv", "reduce.wrong.event.type %s", user.HumanRecoveryEmailCodeAddedType)
+	}
+	if e.CodeReturned {
+		return handler.NewNoOpStatement(e), nil
+	}
+
+	return handler.NewStatement(event, func(ex handler.Executer, projectionName string) error {
+		ctx := HandlerContext(event.Aggregate())
+		alreadyHandled, err := u.checkIfCodeAlreadyHandledOrExpired(ctx, event, e.Expiry, nil,
+			user.HumanRecoveryEmailCodeAddedType, user.HumanRecoveryEmailCodeSentType)
+		if err != nil {
+			return err
+		}
+		if alreadyHandled {
+			return nil
+		}
+		ctx, err = u.queries.Origin(ctx, e)
+		if err != nil {
+			return err
+		}
+		origin := http_util.DomainContext(ctx).Origin()
+		return u.queue.Insert(ctx,
+			&notification.Request{
+				Aggregate:                     e.Aggregate(),
+				UserID:                        e.Aggregate().ID,
+				UserResourceOwner:             e.Aggregate().ResourceOwner,
+				TriggeredAtOrigin:             origin,
+				EventType:                     e.EventType,
+				NotificationType:              domain.NotificationTypeEmail,
+				MessageType:                   domain.VerifyEmailMessageType,
+				Code:                          e.Code,
+				CodeExpiry:                    e.Expiry,
+				IsOTP:                         false,
+				UnverifiedNotificationChannel: true,
+				URLTemplate:                   u.recoveryEmailCodeTemplate(origin, e),
+				Recipient:                     string(e.EmailAddress),
+				Args:                          &domain.NotificationArguments{},
+			},
+			queue.WithQueueName(notification.QueueName),
+			queue.WithMaxAttempts(u.maxAttempts),
+		)
+	}), nil
+}
+
+func (u *userNotifier) recoveryEmailCodeTemplate(origin string, e *user.HumanRecoveryEmailCodeAddedEvent) string {
+	if e.URLTemplate != "" {
+		return e.URLTemplate
+	}
+	return login.RecoveryEmailVerificationLinkTemplate(origin, e.Aggregate().ID, e.Aggregate().ResourceOwner)
+}
+
+func (u *userNotifier) reduceAccountRecoveryApproved(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*user.HumanAccountRecoveryApprovedEvent)
+	if !ok {
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "HANDL-Ar4ap", "reduce.wrong.event.type %s", user.HumanAccountRecoveryApprovedType)
+	}
+	if e.CodeReturned {
+		return handler.NewNoOpStatement(e), nil
+	}
+
+	return handler.NewStatement(event, func(ex handler.Executer, projectionName string) error {
+		ctx := HandlerContext(event.Aggregate())
+		alreadyHandled, err := u.checkIfCodeAlreadyHandledOrExpired(ctx, event, e.Expiry, nil,
+			user.HumanAccountRecoveryApprovedType, user.HumanAccountRecoveryCodeSentType)
+		if err != nil {
+			return err
+		}
+		if alreadyHandled {
+			return nil
+		}
+		ctx, err = u.queries.Origin(ctx, e)
+		if err != nil {
+			return err
+		}
+		origin := http_util.DomainContext(ctx).Origin()
+		return u.queue.Insert(ctx,
+			&notification.Request{
+				Aggregate:         e.Aggregate(),
+				UserID:            e.Aggregate().ID,
+				UserResourceOwner: e.Aggregate().ResourceOwner,
+				TriggeredAtOrigin: origin,
+				EventType:         e.EventType,
+				NotificationType:  domain.NotificationTypeEmail,
+				MessageType:       domain.PasswordResetMessageType,
+				Code:              e.Code,
+				CodeExpiry:        e.Expiry,
+				IsOTP:             false,
+				URLTemplate:       u.accountRecoveryCodeTemplate(origin, e),
+				Recipient:         string(e.EmailAddress),
+				Args:              &domain.NotificationArguments{},
+			},
+			queue.WithQueueName(notification.QueueName),
+			queue.WithMaxAttempts(u.maxAttempts),
+		)
+	}), nil
+}
+
+func (u *userNotifier) accountRecoveryCodeTemplate(origin string, e *user.HumanAccountRecoveryApprovedEvent) string {
+	if e.URLTemplate != "" {
+		return e.URLTemplate
+	}
+	return login.AccountRecoveryLinkTemplate(origin, e.Aggregate().ID, e.Aggregate().ResourceOwner)
+}
+
 func (u *userNotifier) reduceOTPSMSCodeAdded(event eventstore.Event) (*handler.Statement, error) {
 	e, ok := event.(*user.HumanOTPSMSCodeAddedEvent)
 	if !ok {
@@ -559,6 +711,108 @@ func (u *userNotifier) otpEmailTemplate(origin string, e *session.OTPEmailChalle
 	return origin + u.otpEmailTmpl
 }
 
+func (u *userNotifier) reduceMagicLinkCodeAdded(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*user.HumanMagicLinkCodeAddedEvent)
+	if !ok {
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "HANDL-Ml2ca", "reduce.wrong.event.type %s", user.HumanMagicLinkCodeAddedType)
+	}
+
+	return handler.NewStatement(event, func(ex handler.Executer, projectionName string) error {
+		ctx := HandlerContext(event.Aggregate())
+		alreadyHandled, err := u.checkIfCodeAlreadyHandledOrExpired(ctx, event, e.Expiry, nil,
+			user.HumanMagicLinkCodeAddedType,
+			user.HumanMagicLinkCodeSentType)
+		if err != nil {
+			return err
+		}
+		if alreadyHandled {
+			return nil
+		}
+
+		ctx, err = u.queries.Origin(ctx, e)
+		if err != nil {
+			return err
+		}
+		origin := http_util.DomainContext(ctx).Origin()
+		var authRequestID string
+		if e.AuthRequestInfo != nil {
+			authRequestID = e.AuthRequestInfo.ID
+		}
+		args := otpArgs(ctx, e.Expiry)
+		args.AuthRequestID = authRequestID
+		return u.queue.Insert(ctx,
+			&notification.Request{
+				Aggregate:         e.Aggregate(),
+				UserID:            e.Aggregate().ID,
+				UserResourceOwner: e.Aggregate().ResourceOwner,
+				TriggeredAtOrigin: origin,
+				EventType:         e.EventType,
+				NotificationType:  domain.NotificationTypeEmail,
+				MessageType:       domain.MagicLinkMessageType,
+				Code:              e.Code,
+				CodeExpiry:        e.Expiry,
+				URLTemplate:       login.MagicLinkLinkTemplate(origin, e.Aggregate().ID, e.Aggregate().ResourceOwner, authRequestID),
+				Args:              args,
+			},
+			queue.WithQueueName(notification.QueueName),
+			queue.WithMaxAttempts(u.maxAttempts),
+		)
+	}), nil
+}
+
+func (u *userNotifier) reduceSessionMagicLinkChallenged(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*session.MagicLinkChallengedEvent)
+	if !ok {
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "HANDL-Ml3sc", "reduce.wrong.event.type %s", session.MagicLinkChallengedType)
+	}
+	if e.ReturnCode {
+		return handler.NewNoOpStatement(e), nil
+	}
+	return handler.NewStatement(event, func(ex handler.Executer, projectionName string) error {
+		ctx := HandlerContext(event.Aggregate())
+		alreadyHandled, err := u.checkIfCodeAlreadyHandledOrExpired(ctx, event, e.Expiry, nil,
+			session.MagicLinkChallengedType,
+			session.MagicLinkSentType)
+		if err != nil {
+			return err
+		}
+		if alreadyHandled {
+			return nil
+		}
+		s, err := u.queries.SessionByID(ctx, true, e.Aggregate().ID, "", nil)
+		if err != nil {
+			return err
+		}
+
+		ctx, err = u.queries.Origin(ctx, e)
+		if err != nil {
+			return err
+		}
+		origin := http_util.DomainContext(ctx).Origin()
+
+		args := otpArgs(ctx, e.Expiry)
+		args.SessionID = e.Aggregate().ID
+		args.AuthRequestID = e.AuthRequestID
+		return u.queue.Insert(ctx,
+			&notification.Request{
+				Aggregate:         e.Aggregate(),
+				UserID:            s.UserFactor.UserID,
+				UserResourceOwner: s.UserFactor.ResourceOwner,
+				TriggeredAtOrigin: origin,
+				EventType:         e.EventType,
+				NotificationType:  domain.NotificationTypeEmail,
+				MessageType:       domain.MagicLinkMessageType,
+				Code:              e.Code,
+				CodeExpiry:        e.Expiry,
+				URLTemplate:       e.URLTmpl,
+				Args:              args,
+			},
+			queue.WithQueueName(notification.QueueName),
+			queue.WithMaxAttempts(u.maxAttempts),
+		)
+	}), nil
+}
+
 func otpArgs(ctx context.Context, expiry time.Duration) *domain.NotificationArguments {
 	domainCtx := http_util.DomainContext(ctx)
 	return &domain.NotificationArguments{
@@ -820,6 +1074,23 @@ func (u *userNotifier) inviteCodeTemplate(origin string, e *user.HumanInviteCode
 	return login.InviteUserLinkTemplate(origin, e.Aggregate().ID, e.Aggregate().ResourceOwner, e.AuthRequestID)
 }
 
+// reduceNotificationDeliveryRetryRequested queues the request of a failed notification again.
+// The request contains the id of the notification, so that the new attempts are recorded on it.
+func (u *userNotifier) reduceNotificationDeliveryRetryRequested(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*notification.DeliveryRetryRequestedEvent)
+	if !ok {
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "HANDL-Ndr1q", "reduce.wrong.event.type %s", notification.DeliveryRetryRequestedEventType)
+	}
+	return handler.NewStatement(event, func(ex handler.Executer, projectionName string) error {
+		ctx := HandlerContext(event.Aggregate())
+		return u.queue.Insert(ctx,
+			e.Request,
+			queue.WithQueueName(notification.QueueName),
+			queue.WithMaxAttempts(u.maxAttempts),
+		)
+	}), nil
+}
+
 func (u *userNotifier) checkIfCodeAlreadyHandledOrExpired(ctx context.Context, event eventstore.Event, expiry time.Duration, data map[string]interface{}, eventTypes ...eventstore.EventType) (bool, error) {
 	if expiry > 0 && event.CreatedAt().Add(expiry).Before(time.Now().UTC()) {
 		return true, nil
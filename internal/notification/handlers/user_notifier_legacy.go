@@ -109,6 +109,18 @@ func (u *userNotifierLegacy) Reducers() []handler.AggregateReducer {
 					Event:  user.HumanInviteCodeAddedType,
 					Reduce: u.reduceInviteCodeAdded,
 				},
+				{
+					Event:  user.HumanRecoveryEmailCodeAddedType,
+					Reduce: u.reduceRecoveryEmailCodeAdded,
+				},
+				{
+					Event:  user.HumanAccountRecoveryApprovedType,
+					Reduce: u.reduceAccountRecoveryApproved,
+				},
+				{
+					Event:  user.HumanMagicLinkCodeAddedType,
+					Reduce: u.reduceMagicLinkCodeAdded,
+				},
 			},
 		},
 		{
@@ -122,6 +134,10 @@ func (u *userNotifierLegacy) Reducers() []handler.AggregateReducer {
 					Event:  session.OTPEmailChallengedType,
 					Reduce: u.reduceSessionOTPEmailChallenged,
 				},
+				{
+					Event:  session.MagicLinkChallengedType,
+					Reduce: u.reduceSessionMagicLinkChallenged,
+				},
 			},
 		},
 	}
@@ -312,6 +328,128 @@ func (u *userNotifierLegacy) reducePasswordCodeAdded(event eventstore.Event) (*h
 	}), nil
 }
 
+func (u *userNotifierLegacy) reduceRecoveryEmailCodeAdded(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*user.HumanRecoveryEmailCodeAddedEvent)
+	if !ok {
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "HANDL-Re4cv", "reduce.wrong.event.type %s", user.HumanRecoveryEmailCodeAddedType)
+	}
+	if e.CodeReturned {
+		return handler.NewNoOpStatement(e), nil
+	}
+
+	return handler.NewStatement(event, func(ex handler.Executer, projectionName string) error {
+		ctx := HandlerContext(event.Aggregate())
+		alreadyHandled, err := u.checkIfCodeAlreadyHandledOrExpired(ctx, event, e.Expiry, nil,
+			user.HumanRecoveryEmailCodeAddedType, user.HumanRecoveryEmailCodeSentType)
+		if err != nil {
+			return err
+		}
+		if alreadyHandled {
+			return nil
+		}
+		code, err := crypto.DecryptString(e.Code, u.queries.UserDataCrypto)
+		if err != nil {
+			return err
+		}
+		colors, err := u.queries.ActiveLabelPolicyByOrg(ctx, e.Aggregate().ResourceOwner, false)
+		if err != nil {
+			return err
+		}
+
+		template, err := u.queries.MailTemplateByOrg(ctx, e.Aggregate().ResourceOwner, false)
+		if err != nil {
+			return err
+		}
+
+		notifyUser, err := u.queries.GetNotifyUserByID(ctx, true, e.Aggregate().ID)
+		if err != nil {
+			return err
+		}
+		// the code must be sent to the recovery email and not to the primary email of the user
+		notifyUser = notifyUserWithRecipient(notifyUser, string(e.EmailAddress))
+		translator, err := u.queries.GetTranslatorWithOrgTexts(ctx, notifyUser.ResourceOwner, domain.VerifyEmailMessageType)
+		if err != nil {
+			return err
+		}
+
+		ctx, err = u.queries.Origin(ctx, e)
+		if err != nil {
+			return err
+		}
+		err = types.SendEmail(ctx, u.channels, string(template.Template), translator, notifyUser, colors, e.Type()).
+			SendRecoveryEmailVerificationCode(ctx, notifyUser, code, e.URLTemplate)
+		if err != nil {
+			if errors.Is(err, &channels.CancelError{}) {
+				// if the notification was canceled, we don't want to return the error, so there is no retry
+				return nil
+			}
+			return err
+		}
+		return u.commands.RecoveryEmailCodeSent(ctx, e.Aggregate().ResourceOwner, e.Aggregate().ID)
+	}), nil
+}
+
+func (u *userNotifierLegacy) reduceAccountRecoveryApproved(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*user.HumanAccountRecoveryApprovedEvent)
+	if !ok {
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "HANDL-Ar5ap", "reduce.wrong.event.type %s", user.HumanAccountRecoveryApprovedType)
+	}
+	if e.CodeReturned {
+		return handler.NewNoOpStatement(e), nil
+	}
+
+	return handler.NewStatement(event, func(ex handler.Executer, projectionName string) error {
+		ctx := HandlerContext(event.Aggregate())
+		alreadyHandled, err := u.checkIfCodeAlreadyHandledOrExpired(ctx, event, e.Expiry, nil,
+			user.HumanAccountRecoveryApprovedType, user.HumanAccountRecoveryCodeSentType)
+		if err != nil {
+			return err
+		}
+		if alreadyHandled {
+			return nil
+		}
+		code, err := crypto.DecryptString(e.Code, u.queries.UserDataCrypto)
+		if err != nil {
+			return err
+		}
+		colors, err := u.queries.ActiveLabelPolicyByOrg(ctx, e.Aggregate().ResourceOwner, false)
+		if err != nil {
+			return err
+		}
+
+		template, err := u.queries.MailTemplateByOrg(ctx, e.Aggregate().ResourceOwner, false)
+		if err != nil {
+			return err
+		}
+
+		notifyUser, err := u.queries.GetNotifyUserByID(ctx, true, e.Aggregate().ID)
+		if err != nil {
+			return err
+		}
+		// the recovery link must be sent to the recovery email, as the user lost access to the primary one
+		notifyUser = notifyUserWithRecipient(notifyUser, string(e.EmailAddress))
+		translator, err := u.queries.GetTranslatorWithOrgTexts(ctx, notifyUser.ResourceOwner, domain.PasswordResetMessageType)
+		if err != nil {
+			return err
+		}
+
+		ctx, err = u.queries.Origin(ctx, e)
+		if err != nil {
+			return err
+		}
+		err = types.SendEmail(ctx, u.channels, string(template.Template), translator, notifyUser, colors, e.Type()).
+			SendAccountRecoveryCode(ctx, notifyUser, code, e.URLTemplate)
+		if err != nil {
+			if errors.Is(err, &channels.CancelError{}) {
+				// if the notification was canceled, we don't want to return the error, so there is no retry
+				return nil
+			}
+			return err
+		}
+		return u.commands.AccountRecoveryCodeSent(ctx, e.Aggregate().ResourceOwner, e.Aggregate().ID)
+	}), nil
+}
+
 func (u *userNotifierLegacy) reduceOTPSMSCodeAdded(event eventstore.Event) (*handler.Statement, error) {
 	e, ok := event.(*user.HumanOTPSMSCodeAddedEvent)
 	if !ok {
@@ -538,6 +676,126 @@ func (u *userNotifierLegacy) reduceOTPEmail(
 	return handler.NewNoOpStatement(event), nil
 }
 
+func (u *userNotifierLegacy) reduceMagicLinkCodeAdded(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*user.HumanMagicLinkCodeAddedEvent)
+	if !ok {
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "HANDL-Ml2ca", "reduce.wrong.event.type %s", user.HumanMagicLinkCodeAddedType)
+	}
+	var authRequestID string
+	if e.AuthRequestInfo != nil {
+		authRequestID = e.AuthRequestInfo.ID
+	}
+	url := func(code, origin string, _ *query.NotifyUser) (string, error) {
+		return login.MagicLinkLink(origin, e.Aggregate().ID, code, e.Aggregate().ResourceOwner, authRequestID), nil
+	}
+	return u.reduceMagicLink(
+		e,
+		e.Code,
+		e.Expiry,
+		e.Aggregate().ID,
+		e.Aggregate().ResourceOwner,
+		url,
+		u.commands.HumanMagicLinkCodeSent,
+		user.HumanMagicLinkCodeAddedType,
+		user.HumanMagicLinkCodeSentType,
+	)
+}
+
+func (u *userNotifierLegacy) reduceSessionMagicLinkChallenged(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*session.MagicLinkChallengedEvent)
+	if !ok {
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "HANDL-Ml3sc", "reduce.wrong.event.type %s", session.MagicLinkChallengedType)
+	}
+	if e.ReturnCode {
+		return handler.NewNoOpStatement(e), nil
+	}
+	ctx := HandlerContext(event.Aggregate())
+	s, err := u.queries.SessionByID(ctx, true, e.Aggregate().ID, "", nil)
+	if err != nil {
+		return nil, err
+	}
+	url := func(code, origin string, user *query.NotifyUser) (string, error) {
+		var buf strings.Builder
+		if err := domain.RenderMagicLinkURLTemplate(&buf, e.URLTmpl, code, user.ID, user.PreferredLoginName, user.DisplayName, e.Aggregate().ID, e.AuthRequestID, user.PreferredLanguage); err != nil {
+			return "", err
+		}
+		return buf.String(), nil
+	}
+	return u.reduceMagicLink(
+		e,
+		e.Code,
+		e.Expiry,
+		s.UserFactor.UserID,
+		s.UserFactor.ResourceOwner,
+		url,
+		u.commands.MagicLinkSent,
+		session.MagicLinkChallengedType,
+		session.MagicLinkSentType,
+	)
+}
+
+func (u *userNotifierLegacy) reduceMagicLink(
+	event eventstore.Event,
+	code *crypto.CryptoValue,
+	expiry time.Duration,
+	userID,
+	resourceOwner string,
+	urlTmpl func(code, origin string, user *query.NotifyUser) (string, error),
+	sentCommand func(ctx context.Context, id string, resourceOwner string) (err error),
+	eventTypes ...eventstore.EventType,
+) (*handler.Statement, error) {
+	ctx := HandlerContext(event.Aggregate())
+	alreadyHandled, err := u.checkIfCodeAlreadyHandledOrExpired(ctx, event, expiry, nil, eventTypes...)
+	if err != nil {
+		return nil, err
+	}
+	if alreadyHandled {
+		return handler.NewNoOpStatement(event), nil
+	}
+	plainCode, err := crypto.DecryptString(code, u.queries.UserDataCrypto)
+	if err != nil {
+		return nil, err
+	}
+	colors, err := u.queries.ActiveLabelPolicyByOrg(ctx, resourceOwner, false)
+	if err != nil {
+		return nil, err
+	}
+	template, err := u.queries.MailTemplateByOrg(ctx, resourceOwner, false)
+	if err != nil {
+		return nil, err
+	}
+	notifyUser, err := u.queries.GetNotifyUserByID(ctx, true, userID)
+	if err != nil {
+		return nil, err
+	}
+	translator, err := u.queries.GetTranslatorWithOrgTexts(ctx, resourceOwner, domain.MagicLinkMessageType)
+	if err != nil {
+		return nil, err
+	}
+	ctx, err = u.queries.Origin(ctx, event)
+	if err != nil {
+		return nil, err
+	}
+	url, err := urlTmpl(plainCode, http_util.DomainContext(ctx).Origin(), notifyUser)
+	if err != nil {
+		return nil, err
+	}
+	notify := types.SendEmail(ctx, u.channels, string(template.Template), translator, notifyUser, colors, event.Type())
+	err = notify.SendMagicLink(ctx, url, plainCode, expiry)
+	if err != nil {
+		if errors.Is(err, &channels.CancelError{}) {
+			// if the notification was canceled, we don't want to return the error, so there is no retry
+			return handler.NewNoOpStatement(event), nil
+		}
+		return nil, err
+	}
+	err = sentCommand(ctx, event.Aggregate().ID, event.Aggregate().ResourceOwner)
+	if err != nil {
+		return nil, err
+	}
+	return handler.NewNoOpStatement(event), nil
+}
+
 func (u *userNotifierLegacy) reduceDomainClaimed(event eventstore.Event) (*handler.Statement, error) {
 	e, ok := event.(*user.DomainClaimedEvent)
 	if !ok {
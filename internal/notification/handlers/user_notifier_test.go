@@ -1896,6 +1896,50 @@ func Test_userNotifier_reduceInviteCodeAdded(t *testing.T) {
 	}
 }
 
+func Test_userNotifier_reduceNotificationDeliveryRetryRequested(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	queries := mock.NewMockQueries(ctrl)
+	q := mock.NewMockQueue(ctrl)
+	_, code := cryptoValue(t, ctrl, "testcode")
+	request := &notification.Request{
+		NotificationID: notificationID,
+		Aggregate: &eventstore.Aggregate{
+			ID:            userID,
+			InstanceID:    instanceID,
+			ResourceOwner: orgID,
+		},
+		UserID:                        userID,
+		UserResourceOwner:             orgID,
+		TriggeredAtOrigin:             eventOrigin,
+		Code:                          code,
+		CodeExpiry:                    time.Hour,
+		EventType:                     user.HumanEmailCodeAddedType,
+		NotificationType:              domain.NotificationTypeEmail,
+		MessageType:                   domain.VerifyEmailMessageType,
+		UnverifiedNotificationChannel: true,
+	}
+	q.EXPECT().Insert(gomock.Any(), request, gomock.Any(), gomock.Any()).Return(nil)
+
+	stmt, err := newUserNotifier(t, ctrl, queries, fields{
+		queries: queries,
+		queue:   q,
+		es: eventstore.NewEventstore(&eventstore.Config{
+			Querier: es_repo_mock.NewRepo(t).MockQuerier,
+		}),
+	}).reduceNotificationDeliveryRetryRequested(&notification.DeliveryRetryRequestedEvent{
+		BaseEvent: *eventstore.BaseEventFromRepo(&repository.Event{
+			InstanceID:    instanceID,
+			AggregateID:   notificationID,
+			ResourceOwner: sql.NullString{String: orgID},
+			CreationDate:  time.Now().UTC(),
+			Typ:           notification.DeliveryRetryRequestedEventType,
+		}),
+		Request: request,
+	})
+	assert.NoError(t, err)
+	assert.NoError(t, stmt.Execute(nil, ""))
+}
+
 type fields struct {
 	queries        *mock.MockQueries
 	queue          *mock.MockQueue
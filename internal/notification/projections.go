@@ -57,6 +57,18 @@ func Register(
 		c,
 		tokenLifetime,
 	))
+	projections = append(projections, handlers.NewBackChannelAuthPingNotifier(
+		ctx,
+		projection.ApplyCustomConfig(backChannelLogoutHandlerCustomConfig),
+		q,
+		es,
+		c,
+	))
+	projections = append(projections, handlers.NewBackChannelAuthUserNotifier(
+		ctx,
+		projection.ApplyCustomConfig(backChannelLogoutHandlerCustomConfig),
+		q,
+	))
 	if telemetryCfg.Enabled {
 		projections = append(projections, handlers.NewTelemetryPusher(ctx, telemetryCfg, projection.ApplyCustomConfig(telemetryHandlerCustomConfig), commands, q, c))
 	}
@@ -22,6 +22,7 @@ func EmailChannels(
 	emailConfig *email.Config,
 	getFileSystemProvider func(ctx context.Context) (*fs.Config, error),
 	getLogProvider func(ctx context.Context) (*log.Config, error),
+	isEmailSuppressed IsEmailSuppressed,
 	successMetricName,
 	failureMetricName string,
 ) (chain *Chain, err error) {
@@ -64,5 +65,9 @@ func EmailChannels(
 		}
 	}
 	channels = append(channels, debugChannels(ctx, getFileSystemProvider, getLogProvider)...)
-	return ChainChannels(channels...), nil
+	chain = ChainChannels(channels...)
+	if isEmailSuppressed != nil && chain.Len() > 0 {
+		chain = withEmailSuppression(ctx, chain, isEmailSuppressed)
+	}
+	return chain, nil
 }
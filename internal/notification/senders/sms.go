@@ -8,6 +8,7 @@ import (
 	"github.com/zitadel/zitadel/internal/api/authz"
 	"github.com/zitadel/zitadel/internal/notification/channels"
 	"github.com/zitadel/zitadel/internal/notification/channels/fs"
+	"github.com/zitadel/zitadel/internal/notification/channels/httptemplate"
 	"github.com/zitadel/zitadel/internal/notification/channels/instrumenting"
 	"github.com/zitadel/zitadel/internal/notification/channels/log"
 	"github.com/zitadel/zitadel/internal/notification/channels/sms"
@@ -15,7 +16,10 @@ import (
 	"github.com/zitadel/zitadel/internal/notification/channels/webhook"
 )
 
-const twilioSpanName = "twilio.NotificationChannel"
+const (
+	twilioSpanName       = "twilio.NotificationChannel"
+	httpTemplateSpanName = "httptemplate.NotificationChannel"
+)
 
 func SMSChannels(
 	ctx context.Context,
@@ -57,6 +61,24 @@ func SMSChannels(
 			)
 		}
 	}
+	if smsConfig.HTTPTemplateConfig != nil {
+		httpTemplateChannel, err := httptemplate.InitChannel(ctx, *smsConfig.HTTPTemplateConfig)
+		logging.WithFields(
+			"instance", authz.GetInstance(ctx).InstanceID(),
+		).OnError(err).Debug("initializing http template channel failed")
+		if err == nil {
+			channels = append(
+				channels,
+				instrumenting.Wrap(
+					ctx,
+					httpTemplateChannel,
+					httpTemplateSpanName,
+					successMetricName,
+					failureMetricName,
+				),
+			)
+		}
+	}
 	channels = append(channels, debugChannels(ctx, getFileSystemProvider, getLogProvider)...)
 	return ChainChannels(channels...), nil
 }
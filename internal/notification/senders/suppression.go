@@ -0,0 +1,40 @@
+package senders
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/notification/channels"
+	"github.com/zitadel/zitadel/internal/notification/messages"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// IsEmailSuppressed reports if an email address was marked as undeliverable
+type IsEmailSuppressed func(ctx context.Context, email string) (bool, error)
+
+// withEmailSuppression prepends the chain with a channel, which cancels emails to suppressed recipients
+func withEmailSuppression(ctx context.Context, chain *Chain, isSuppressed IsEmailSuppressed) *Chain {
+	return ChainChannels(append([]channels.NotificationChannel{suppressUndeliverable(ctx, isSuppressed)}, chain.channels...)...)
+}
+
+// suppressUndeliverable cancels emails to recipients, which were reported as undeliverable by the email provider.
+// Messages other than emails are passed through.
+func suppressUndeliverable(ctx context.Context, isSuppressed IsEmailSuppressed) channels.NotificationChannel {
+	return channels.HandleMessageFunc(func(message channels.Message) error {
+		email, ok := message.(*messages.Email)
+		if !ok {
+			return nil
+		}
+		for _, recipient := range email.Recipients {
+			suppressed, err := isSuppressed(ctx, recipient)
+			if err != nil {
+				return err
+			}
+			if suppressed {
+				return channels.NewCancelError(
+					zerrors.ThrowPreconditionFailed(nil, "SENDE-Bnc1s", "Errors.User.Email.Undeliverable"),
+				)
+			}
+		}
+		return nil
+	})
+}
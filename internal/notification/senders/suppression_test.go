@@ -0,0 +1,73 @@
+package senders
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/zitadel/zitadel/internal/notification/channels"
+	"github.com/zitadel/zitadel/internal/notification/messages"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+func Test_withEmailSuppression(t *testing.T) {
+	suppressed := func(_ context.Context, email string) (bool, error) {
+		return email == "bounced@example.com", nil
+	}
+	tests := []struct {
+		name         string
+		isSuppressed IsEmailSuppressed
+		message      channels.Message
+		wantSent     bool
+		wantErr      func(error) bool
+	}{
+		{
+			name:         "not suppressed, sent",
+			isSuppressed: suppressed,
+			message:      &messages.Email{Recipients: []string{"gigi@example.com"}},
+			wantSent:     true,
+		},
+		{
+			name:         "suppressed, cancelled",
+			isSuppressed: suppressed,
+			message:      &messages.Email{Recipients: []string{"gigi@example.com", "bounced@example.com"}},
+			wantErr: func(err error) bool {
+				return errors.Is(err, &channels.CancelError{}) && zerrors.IsPreconditionFailed(errors.Unwrap(err))
+			},
+		},
+		{
+			name: "query error, returned",
+			isSuppressed: func(context.Context, string) (bool, error) {
+				return false, zerrors.ThrowInternal(nil, "ID", "error")
+			},
+			message: &messages.Email{Recipients: []string{"gigi@example.com"}},
+			wantErr: zerrors.IsInternal,
+		},
+		{
+			name: "no email, sent",
+			isSuppressed: func(context.Context, string) (bool, error) {
+				return true, nil
+			},
+			message:  &messages.SMS{RecipientPhoneNumber: "+41791234567"},
+			wantSent: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var sent bool
+			chain := withEmailSuppression(context.Background(), ChainChannels(channels.HandleMessageFunc(func(channels.Message) error {
+				sent = true
+				return nil
+			})), tt.isSuppressed)
+			err := chain.HandleMessage(tt.message)
+			if tt.wantErr != nil {
+				assert.True(t, tt.wantErr(err), "unexpected error: %v", err)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tt.wantSent, sent)
+		})
+	}
+}
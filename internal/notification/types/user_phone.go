@@ -8,6 +8,7 @@ import (
 
 	"github.com/zitadel/zitadel/internal/eventstore"
 	zchannels "github.com/zitadel/zitadel/internal/notification/channels"
+	"github.com/zitadel/zitadel/internal/notification/channels/sms"
 	"github.com/zitadel/zitadel/internal/notification/messages"
 	"github.com/zitadel/zitadel/internal/notification/senders"
 	"github.com/zitadel/zitadel/internal/notification/templates"
@@ -44,10 +45,10 @@ func generateSms(
 	if lastPhone {
 		recipient = user.LastPhone
 	}
-	if config.TwilioConfig != nil {
+	if config.TwilioConfig != nil || config.HTTPTemplateConfig != nil {
 		number := ""
 		if err == nil {
-			number = config.TwilioConfig.SenderNumber
+			number = smsSenderNumber(config)
 		}
 		message := &messages.SMS{
 			SenderPhoneNumber:    number,
@@ -62,7 +63,7 @@ func generateSms(
 		if err != nil {
 			return err
 		}
-		if config.TwilioConfig.VerifyServiceSID != "" {
+		if config.TwilioConfig != nil && config.TwilioConfig.VerifyServiceSID != "" {
 			generatorInfo.ID = config.ProviderConfig.ID
 			generatorInfo.VerificationID = *message.VerificationID
 		}
@@ -97,3 +98,10 @@ func generateSms(
 		zerrors.ThrowPreconditionFailed(nil, "PHONE-83nof", "Errors.Notification.Channels.NotPresent"),
 	)
 }
+
+func smsSenderNumber(config *sms.Config) string {
+	if config.TwilioConfig != nil {
+		return config.TwilioConfig.SenderNumber
+	}
+	return config.HTTPTemplateConfig.SenderNumber
+}
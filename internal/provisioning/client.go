@@ -0,0 +1,162 @@
+package provisioning
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/zitadel/logging"
+)
+
+const (
+	contentTypeScim = "application/scim+json"
+	schemaUser      = "urn:ietf:params:scim:schemas:core:2.0:User"
+
+	// maxErrorBodySize limits the part of the response body of the target which is added to the error
+	maxErrorBodySize = 512
+)
+
+var ErrRemoteUserNotFound = errors.New("user not found on provisioning target")
+
+// StatusError is returned if the provisioning target responds with an unexpected status code.
+type StatusError struct {
+	StatusCode int
+	Body       string
+}
+
+func (e *StatusError) Error() string {
+	return fmt.Sprintf("provisioning target responded with status %d: %s", e.StatusCode, e.Body)
+}
+
+// ScimUser is the representation of a user sent to the provisioning target.
+// Only the attributes of the SCIM core schema which are managed by ZITADEL are provisioned.
+type ScimUser struct {
+	Schemas           []string      `json:"schemas"`
+	ID                string        `json:"id,omitempty"`
+	ExternalID        string        `json:"externalId"`
+	UserName          string        `json:"userName"`
+	Name              *ScimUserName `json:"name,omitempty"`
+	DisplayName       string        `json:"displayName,omitempty"`
+	NickName          string        `json:"nickName,omitempty"`
+	PreferredLanguage string        `json:"preferredLanguage,omitempty"`
+	Active            bool          `json:"active"`
+	Emails            []*ScimValue  `json:"emails,omitempty"`
+	PhoneNumbers      []*ScimValue  `json:"phoneNumbers,omitempty"`
+}
+
+type ScimUserName struct {
+	GivenName  string `json:"givenName,omitempty"`
+	FamilyName string `json:"familyName,omitempty"`
+}
+
+type ScimValue struct {
+	Value   string `json:"value"`
+	Primary bool   `json:"primary"`
+}
+
+type scimListResponse struct {
+	TotalResults int         `json:"totalResults"`
+	Resources    []*ScimUser `json:"Resources"`
+}
+
+// Client calls the SCIM v2 api of a provisioning target.
+type Client struct {
+	client   *http.Client
+	endpoint string
+	token    string
+}
+
+func NewClient(client *http.Client, endpoint, token string) *Client {
+	return &Client{
+		client:   client,
+		endpoint: strings.TrimSuffix(endpoint, "/"),
+		token:    token,
+	}
+}
+
+// UserIDByExternalID returns the id of the user on the target which has the id of the ZITADEL user as external id.
+// If no such user exists [ErrRemoteUserNotFound] is returned.
+func (c *Client) UserIDByExternalID(ctx context.Context, externalID string) (string, error) {
+	query := url.Values{}
+	query.Set("filter", fmt.Sprintf("externalId eq %q", externalID))
+	resp := new(scimListResponse)
+	if err := c.do(ctx, http.MethodGet, "/Users?"+query.Encode(), nil, resp); err != nil {
+		return "", err
+	}
+	if resp.TotalResults == 0 || len(resp.Resources) == 0 {
+		return "", ErrRemoteUserNotFound
+	}
+	return resp.Resources[0].ID, nil
+}
+
+// CreateUser creates the user on the target and returns the id of the created user.
+func (c *Client) CreateUser(ctx context.Context, user *ScimUser) (string, error) {
+	resp := new(ScimUser)
+	if err := c.do(ctx, http.MethodPost, "/Users", user, resp); err != nil {
+		return "", err
+	}
+	return resp.ID, nil
+}
+
+// ReplaceUser replaces the user on the target.
+// If the user does not exist [ErrRemoteUserNotFound] is returned.
+func (c *Client) ReplaceUser(ctx context.Context, id string, user *ScimUser) error {
+	return c.do(ctx, http.MethodPut, "/Users/"+url.PathEscape(id), user, nil)
+}
+
+// DeleteUser deletes the user on the target, users which do not exist are ignored.
+func (c *Client) DeleteUser(ctx context.Context, id string) error {
+	err := c.do(ctx, http.MethodDelete, "/Users/"+url.PathEscape(id), nil, nil)
+	if errors.Is(err, ErrRemoteUserNotFound) {
+		return nil
+	}
+	return err
+}
+
+func (c *Client) do(ctx context.Context, method, path string, body, response any) error {
+	var reqBody io.Reader
+	if body != nil {
+		data, err := json.Marshal(body)
+		if err != nil {
+			return err
+		}
+		reqBody = bytes.NewReader(data)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, c.endpoint+path, reqBody)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Authorization", "Bearer "+c.token)
+	req.Header.Set("Accept", contentTypeScim)
+	if body != nil {
+		req.Header.Set("Content-Type", contentTypeScim)
+	}
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		err := resp.Body.Close()
+		logging.OnError(err).Debug("unable to close response body of provisioning target")
+	}()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return ErrRemoteUserNotFound
+	}
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		errBody, _ := io.ReadAll(io.LimitReader(resp.Body, maxErrorBodySize))
+		return &StatusError{StatusCode: resp.StatusCode, Body: string(errBody)}
+	}
+	if response == nil || resp.StatusCode == http.StatusNoContent {
+		return nil
+	}
+	return json.NewDecoder(resp.Body).Decode(response)
+}
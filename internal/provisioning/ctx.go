@@ -0,0 +1,20 @@
+package provisioning
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/eventstore"
+)
+
+const ProvisioningUserID = "PROVISIONING"
+
+func HandlerContext(event *eventstore.Aggregate) context.Context {
+	ctx := authz.WithInstanceID(context.Background(), event.InstanceID)
+	return authz.SetCtxData(ctx, authz.CtxData{UserID: ProvisioningUserID, OrgID: event.ResourceOwner})
+}
+
+func ContextWithProvisioner(ctx context.Context, aggregate *eventstore.Aggregate) context.Context {
+	ctx = authz.WithInstanceID(ctx, aggregate.InstanceID)
+	return authz.SetCtxData(ctx, authz.CtxData{UserID: ProvisioningUserID, OrgID: aggregate.ResourceOwner})
+}
@@ -0,0 +1,4 @@
+package provisioning
+
+//go:generate mockgen -source=handlers.go -package mock -destination ./mock/handlers.mock.go
+//go:generate mockgen -source=worker.go -package mock -destination ./mock/worker.mock.go
@@ -0,0 +1,181 @@
+package provisioning
+
+import (
+	"context"
+
+	"github.com/riverqueue/river"
+	"github.com/zitadel/logging"
+
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/eventstore/handler/v2"
+	"github.com/zitadel/zitadel/internal/query"
+	"github.com/zitadel/zitadel/internal/queue"
+	prov_repo "github.com/zitadel/zitadel/internal/repository/provisioning"
+	"github.com/zitadel/zitadel/internal/repository/user"
+	"github.com/zitadel/zitadel/internal/repository/usergrant"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+const (
+	HandlerTable = "projections.provisioning_handler"
+)
+
+type Queue interface {
+	Insert(ctx context.Context, args river.JobArgs, opts ...queue.InsertOpt) error
+}
+
+type HandlerQueries interface {
+	ProvisioningTargetsByUserID(ctx context.Context, userID string) ([]*query.ProvisioningTarget, error)
+	ProvisioningTargetsByProjectID(ctx context.Context, projectID string) ([]*query.ProvisioningTarget, error)
+	UserGrantReferenceByID(ctx context.Context, grantID, resourceOwner string) (*query.UserGrantReferenceReadModel, error)
+}
+
+// userEventTypes are the events of a user which change attributes provisioned to the targets.
+var userEventTypes = []eventstore.EventType{
+	user.HumanAddedType,
+	user.HumanRegisteredType,
+	user.HumanProfileChangedType,
+	user.HumanEmailChangedType,
+	user.HumanPhoneChangedType,
+	user.HumanPhoneRemovedType,
+	user.UserUserNameChangedType,
+	user.UserDeactivatedType,
+	user.UserReactivatedType,
+	user.UserLockedType,
+	user.UserUnlockedType,
+	user.UserRemovedType,
+}
+
+// userGrantEventTypes are the events which change if a user is provisioned to the targets of a project.
+var userGrantEventTypes = []eventstore.EventType{
+	usergrant.UserGrantAddedType,
+	usergrant.UserGrantChangedType,
+	usergrant.UserGrantCascadeChangedType,
+	usergrant.UserGrantRemovedType,
+	usergrant.UserGrantCascadeRemovedType,
+	usergrant.UserGrantDeactivatedType,
+	usergrant.UserGrantReactivatedType,
+}
+
+type eventHandler struct {
+	query       HandlerQueries
+	queue       Queue
+	maxAttempts uint8
+}
+
+func NewEventHandler(
+	ctx context.Context,
+	config handler.Config,
+	query HandlerQueries,
+	queue Queue,
+	maxAttempts uint8,
+) *handler.Handler {
+	return handler.NewHandler(ctx, &config, &eventHandler{
+		query:       query,
+		queue:       queue,
+		maxAttempts: maxAttempts,
+	})
+}
+
+func (u *eventHandler) Name() string {
+	return HandlerTable
+}
+
+func (u *eventHandler) Reducers() []handler.AggregateReducer {
+	userReducers := make([]handler.EventReducer, len(userEventTypes))
+	for i, eventType := range userEventTypes {
+		userReducers[i] = handler.EventReducer{
+			Event:  eventType,
+			Reduce: u.reduceUserChanged,
+		}
+	}
+	userGrantReducers := make([]handler.EventReducer, len(userGrantEventTypes))
+	for i, eventType := range userGrantEventTypes {
+		userGrantReducers[i] = handler.EventReducer{
+			Event:  eventType,
+			Reduce: u.reduceUserGrantChanged,
+		}
+	}
+	return []handler.AggregateReducer{
+		{
+			Aggregate:     user.AggregateType,
+			EventReducers: userReducers,
+		},
+		{
+			Aggregate:     usergrant.AggregateType,
+			EventReducers: userGrantReducers,
+		},
+	}
+}
+
+func (u *eventHandler) reduceUserChanged(e eventstore.Event) (*handler.Statement, error) {
+	ctx := HandlerContext(e.Aggregate())
+
+	targets, err := u.query.ProvisioningTargetsByUserID(ctx, e.Aggregate().ID)
+	if err != nil {
+		return nil, err
+	}
+	return u.syncUserStatement(e, e.Aggregate().ID, targets), nil
+}
+
+func (u *eventHandler) reduceUserGrantChanged(e eventstore.Event) (*handler.Statement, error) {
+	ctx := HandlerContext(e.Aggregate())
+
+	userID, projectID, err := u.userGrantReference(ctx, e)
+	if zerrors.IsNotFound(err) {
+		logging.WithFields("instance", e.Aggregate().InstanceID, "grant", e.Aggregate().ID).Info("user grant reference not found, skip provisioning")
+		return handler.NewNoOpStatement(e), nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	targets, err := u.query.ProvisioningTargetsByProjectID(ctx, projectID)
+	if err != nil {
+		return nil, err
+	}
+	return u.syncUserStatement(e, userID, targets), nil
+}
+
+// userGrantReference returns the user and the project of the user grant.
+// Only the added and removed events contain the references, for all other events they are queried.
+func (u *eventHandler) userGrantReference(ctx context.Context, e eventstore.Event) (userID, projectID string, err error) {
+	switch grantEvent := e.(type) {
+	case *usergrant.UserGrantAddedEvent:
+		return grantEvent.UserID, grantEvent.ProjectID, nil
+	case *usergrant.UserGrantRemovedEvent:
+		return grantEvent.UserID, grantEvent.ProjectID, nil
+	}
+	reference, err := u.query.UserGrantReferenceByID(ctx, e.Aggregate().ID, e.Aggregate().ResourceOwner)
+	if err != nil {
+		return "", "", err
+	}
+	return reference.UserID, reference.ProjectID, nil
+}
+
+func (u *eventHandler) syncUserStatement(e eventstore.Event, userID string, targets []*query.ProvisioningTarget) *handler.Statement {
+	// no provisioning from worker necessary
+	if len(targets) == 0 {
+		return handler.NewNoOpStatement(e)
+	}
+
+	return handler.NewStatement(e, func(ex handler.Executer, projectionName string) error {
+		ctx := HandlerContext(e.Aggregate())
+		for _, target := range targets {
+			err := u.queue.Insert(ctx,
+				&prov_repo.Request{
+					Aggregate:        prov_repo.NewAggregate(target.ID, target.ResourceOwner, e.Aggregate().InstanceID),
+					UserID:           userID,
+					TriggerEventType: e.Type(),
+					TriggerSequence:  e.Sequence(),
+				},
+				queue.WithQueueName(prov_repo.QueueName),
+				queue.WithMaxAttempts(u.maxAttempts),
+			)
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
@@ -0,0 +1,174 @@
+package provisioning
+
+import (
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/eventstore/repository"
+	"github.com/zitadel/zitadel/internal/provisioning/mock"
+	"github.com/zitadel/zitadel/internal/query"
+	prov_repo "github.com/zitadel/zitadel/internal/repository/provisioning"
+	"github.com/zitadel/zitadel/internal/repository/user"
+	"github.com/zitadel/zitadel/internal/repository/usergrant"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+const grantID = "grantID"
+
+func baseEvent(aggregateType eventstore.AggregateType, aggregateID string, eventType eventstore.EventType) *eventstore.BaseEvent {
+	return eventstore.BaseEventFromRepo(&repository.Event{
+		InstanceID:    instanceID,
+		AggregateID:   aggregateID,
+		ResourceOwner: sql.NullString{String: orgID},
+		CreationDate:  time.Now(),
+		Typ:           eventType,
+		Seq:           1,
+		AggregateType: aggregateType,
+	})
+}
+
+func provisioningTargets(ids ...string) []*query.ProvisioningTarget {
+	targets := make([]*query.ProvisioningTarget, len(ids))
+	for i, id := range ids {
+		targets[i] = &query.ProvisioningTarget{
+			ObjectDetails: domain.ObjectDetails{ID: id, ResourceOwner: orgID},
+			ProjectID:     projectID,
+		}
+	}
+	return targets
+}
+
+func expectRequest(q *mock.MockQueue, targetID string, eventType eventstore.EventType) {
+	q.EXPECT().Insert(gomock.Any(),
+		&prov_repo.Request{
+			Aggregate:        prov_repo.NewAggregate(targetID, orgID, instanceID),
+			UserID:           userID,
+			TriggerEventType: eventType,
+			TriggerSequence:  1,
+		},
+		gomock.Any(), gomock.Any(),
+	).Return(nil)
+}
+
+func Test_eventHandler_reduce(t *testing.T) {
+	type want struct {
+		noOperation bool
+		err         assert.ErrorAssertionFunc
+	}
+	tests := []struct {
+		name  string
+		event eventstore.Event
+		test  func(queries *mock.MockHandlerQueries, q *mock.MockQueue)
+		want  want
+	}{
+		{
+			name: "user changed, no targets",
+			event: &user.HumanProfileChangedEvent{
+				BaseEvent: *baseEvent(user.AggregateType, userID, user.HumanProfileChangedType),
+			},
+			test: func(queries *mock.MockHandlerQueries, q *mock.MockQueue) {
+				queries.EXPECT().ProvisioningTargetsByUserID(gomock.Any(), userID).Return(nil, nil)
+			},
+			want: want{
+				noOperation: true,
+			},
+		},
+		{
+			name: "user changed, multiple targets",
+			event: &user.HumanProfileChangedEvent{
+				BaseEvent: *baseEvent(user.AggregateType, userID, user.HumanProfileChangedType),
+			},
+			test: func(queries *mock.MockHandlerQueries, q *mock.MockQueue) {
+				queries.EXPECT().ProvisioningTargetsByUserID(gomock.Any(), userID).Return(provisioningTargets("app1", "app2"), nil)
+				expectRequest(q, "app1", user.HumanProfileChangedType)
+				expectRequest(q, "app2", user.HumanProfileChangedType)
+			},
+		},
+		{
+			name: "user grant added, targets of project",
+			event: &usergrant.UserGrantAddedEvent{
+				BaseEvent: *baseEvent(usergrant.AggregateType, grantID, usergrant.UserGrantAddedType),
+				UserID:    userID,
+				ProjectID: projectID,
+			},
+			test: func(queries *mock.MockHandlerQueries, q *mock.MockQueue) {
+				queries.EXPECT().ProvisioningTargetsByProjectID(gomock.Any(), projectID).Return(provisioningTargets("app1"), nil)
+				expectRequest(q, "app1", usergrant.UserGrantAddedType)
+			},
+		},
+		{
+			name: "user grant deactivated, reference queried",
+			event: &usergrant.UserGrantDeactivatedEvent{
+				BaseEvent: *baseEvent(usergrant.AggregateType, grantID, usergrant.UserGrantDeactivatedType),
+			},
+			test: func(queries *mock.MockHandlerQueries, q *mock.MockQueue) {
+				queries.EXPECT().UserGrantReferenceByID(gomock.Any(), grantID, orgID).
+					Return(&query.UserGrantReferenceReadModel{UserID: userID, ProjectID: projectID}, nil)
+				queries.EXPECT().ProvisioningTargetsByProjectID(gomock.Any(), projectID).Return(provisioningTargets("app1"), nil)
+				expectRequest(q, "app1", usergrant.UserGrantDeactivatedType)
+			},
+		},
+		{
+			name: "user grant cascade removed, reference not found",
+			event: &usergrant.UserGrantCascadeRemovedEvent{
+				BaseEvent: *baseEvent(usergrant.AggregateType, grantID, usergrant.UserGrantCascadeRemovedType),
+			},
+			test: func(queries *mock.MockHandlerQueries, q *mock.MockQueue) {
+				queries.EXPECT().UserGrantReferenceByID(gomock.Any(), grantID, orgID).
+					Return(nil, zerrors.ThrowNotFound(nil, "QUERY-Ugr1f", "Errors.UserGrant.NotFound"))
+			},
+			want: want{
+				noOperation: true,
+			},
+		},
+		{
+			name: "user grant changed, query error",
+			event: &usergrant.UserGrantChangedEvent{
+				BaseEvent: *baseEvent(usergrant.AggregateType, grantID, usergrant.UserGrantChangedType),
+			},
+			test: func(queries *mock.MockHandlerQueries, q *mock.MockQueue) {
+				queries.EXPECT().UserGrantReferenceByID(gomock.Any(), grantID, orgID).
+					Return(nil, zerrors.ThrowInternal(nil, "QUERY-Ugr1f", "Errors.Internal"))
+			},
+			want: want{
+				err: assert.Error,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			queries := mock.NewMockHandlerQueries(ctrl)
+			q := mock.NewMockQueue(ctrl)
+			tt.test(queries, q)
+
+			h := &eventHandler{
+				query:       queries,
+				queue:       q,
+				maxAttempts: 3,
+			}
+			reduce := h.reduceUserGrantChanged
+			if tt.event.Aggregate().Type == user.AggregateType {
+				reduce = h.reduceUserChanged
+			}
+			stmt, err := reduce(tt.event)
+			if tt.want.err != nil {
+				tt.want.err(t, err)
+				return
+			}
+			assert.NoError(t, err)
+
+			if tt.want.noOperation {
+				assert.Nil(t, stmt.Execute)
+				return
+			}
+			assert.NoError(t, stmt.Execute(nil, ""))
+		})
+	}
+}
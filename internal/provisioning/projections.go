@@ -0,0 +1,37 @@
+package provisioning
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/zitadel/zitadel/internal/command"
+	"github.com/zitadel/zitadel/internal/eventstore/handler/v2"
+	"github.com/zitadel/zitadel/internal/query"
+	"github.com/zitadel/zitadel/internal/query/projection"
+	"github.com/zitadel/zitadel/internal/queue"
+)
+
+var (
+	projections []*handler.Handler
+)
+
+func Register(
+	ctx context.Context,
+	provisioningCustomConfig projection.CustomConfig,
+	workerConfig WorkerConfig,
+	commands *command.Commands,
+	queries *query.Queries,
+	queue *queue.Queue,
+) {
+	queue.ShouldStart()
+	projections = []*handler.Handler{
+		NewEventHandler(ctx, projection.ApplyCustomConfig(provisioningCustomConfig), queries, queue, workerConfig.MaxAttempts),
+	}
+	queue.AddWorkers(NewWorker(workerConfig, queries, commands, &http.Client{Timeout: workerConfig.TransactionDuration}))
+}
+
+func Start(ctx context.Context) {
+	for _, projection := range projections {
+		projection.Start(ctx)
+	}
+}
@@ -0,0 +1,268 @@
+package provisioning
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/riverqueue/river"
+	"github.com/zitadel/logging"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/query"
+	prov_repo "github.com/zitadel/zitadel/internal/repository/provisioning"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+type WorkerQueries interface {
+	ProvisioningTargetWithToken(ctx context.Context, appID string) (*query.ProvisioningTarget, error)
+	ProvisioningTargetUserByID(ctx context.Context, targetID, userID string) (*query.ProvisioningTargetUser, error)
+	GetUserByID(ctx context.Context, shouldTriggerBulk bool, userID string) (*query.User, error)
+	UserGrants(ctx context.Context, queries *query.UserGrantsQueries, shouldTriggerBulk bool) (*query.UserGrants, error)
+}
+
+type Commands interface {
+	ProvisioningUserCreationStarted(ctx context.Context, target *eventstore.Aggregate, userID string) error
+	ProvisioningUserCreationCanceled(ctx context.Context, target *eventstore.Aggregate, userID string) error
+	ProvisioningUserSynced(ctx context.Context, target *eventstore.Aggregate, userID, remoteID string, triggerEventType eventstore.EventType) error
+	ProvisioningUserSyncFailed(ctx context.Context, target *eventstore.Aggregate, userID string, triggerEventType eventstore.EventType, syncErr error) error
+}
+
+type Worker struct {
+	river.WorkerDefaults[*prov_repo.Request]
+
+	config   WorkerConfig
+	queries  WorkerQueries
+	commands Commands
+	client   *http.Client
+	now      nowFunc
+}
+
+// Timeout implements the Timeout-function of [river.Worker].
+// Maximum time a job can run before the context gets cancelled.
+func (w *Worker) Timeout(*river.Job[*prov_repo.Request]) time.Duration {
+	return w.config.TransactionDuration
+}
+
+// Work implements [river.Worker].
+// The worker synchronizes the current state of the user to the target,
+// therefore the order of the jobs of a user is not relevant.
+func (w *Worker) Work(ctx context.Context, job *river.Job[*prov_repo.Request]) error {
+	ctx = ContextWithProvisioner(ctx, job.Args.Aggregate)
+
+	// if the event is too old, we can directly return as it will be removed anyway
+	if job.CreatedAt.Add(w.config.MaxTtl).Before(w.now()) {
+		return river.JobCancel(errors.New("event is too old"))
+	}
+
+	err := w.syncUser(ctx, job.Args)
+	if err == nil {
+		return nil
+	}
+	// the target was removed in the meantime, so there is nothing to synchronize
+	if zerrors.IsNotFound(err) {
+		return river.JobCancel(err)
+	}
+	// the failure is only recorded after the last attempt, previous attempts are retried by the queue
+	if job.Attempt < job.MaxAttempts {
+		return err
+	}
+	if cmdErr := w.commands.ProvisioningUserSyncFailed(ctx, job.Args.Aggregate, job.Args.UserID, job.Args.TriggerEventType, err); cmdErr != nil {
+		return cmdErr
+	}
+	return river.JobCancel(err)
+}
+
+func (w *Worker) syncUser(ctx context.Context, request *prov_repo.Request) error {
+	target, err := w.queries.ProvisioningTargetWithToken(ctx, request.Aggregate.ID)
+	if err != nil {
+		return err
+	}
+	client := NewClient(w.client, target.Endpoint, target.Token)
+
+	remoteID, provisioned, err := w.remoteUserID(ctx, client, target.ID, request.UserID)
+	if err != nil {
+		return err
+	}
+
+	user, err := w.provisionedUser(ctx, target.ProjectID, request.UserID)
+	if err != nil {
+		return err
+	}
+
+	if user == nil {
+		// the user was never provisioned, so there is nothing to remove
+		if remoteID == "" && !provisioned {
+			return nil
+		}
+		if remoteID != "" {
+			if err = client.DeleteUser(ctx, remoteID); err != nil {
+				return err
+			}
+		}
+		return w.commands.ProvisioningUserSynced(ctx, request.Aggregate, request.UserID, "", request.TriggerEventType)
+	}
+
+	scimUser := scimUserFromUser(user)
+	if remoteID != "" {
+		err = client.ReplaceUser(ctx, remoteID, scimUser)
+		if !errors.Is(err, ErrRemoteUserNotFound) {
+			if err != nil {
+				return err
+			}
+			return w.commands.ProvisioningUserSynced(ctx, request.Aggregate, request.UserID, remoteID, request.TriggerEventType)
+		}
+	}
+	remoteID, err = w.createUser(ctx, client, request, scimUser)
+	if err != nil {
+		return err
+	}
+	return w.commands.ProvisioningUserSynced(ctx, request.Aggregate, request.UserID, remoteID, request.TriggerEventType)
+}
+
+// createUser creates the user on the target and returns its id.
+// The creation is reserved by a unique constraint, so concurrent synchronizations of the user
+// fail and are retried by the queue, instead of creating the user twice.
+// The reservation ends with the recorded synchronization or is canceled on an error.
+func (w *Worker) createUser(ctx context.Context, client *Client, request *prov_repo.Request, scimUser *ScimUser) (remoteID string, err error) {
+	if err = w.commands.ProvisioningUserCreationStarted(ctx, request.Aggregate, request.UserID); err != nil {
+		return "", err
+	}
+	defer func() {
+		if err == nil {
+			return
+		}
+		cancelErr := w.commands.ProvisioningUserCreationCanceled(ctx, request.Aggregate, request.UserID)
+		logging.WithFields("user", request.UserID).OnError(cancelErr).Error("unable to cancel provisioning user creation")
+	}()
+
+	// another synchronization might have created the user since it was searched
+	remoteID, err = client.UserIDByExternalID(ctx, request.UserID)
+	if err == nil {
+		return remoteID, client.ReplaceUser(ctx, remoteID, scimUser)
+	}
+	if !errors.Is(err, ErrRemoteUserNotFound) {
+		return "", err
+	}
+	return client.CreateUser(ctx, scimUser)
+}
+
+// remoteUserID returns the id of the user on the target.
+// The id stored on the last synchronization is preferred,
+// otherwise the user is searched by its external id on the target.
+// provisioned reports if a synchronization of the user to the target was already recorded.
+func (w *Worker) remoteUserID(ctx context.Context, client *Client, targetID, userID string) (remoteID string, provisioned bool, err error) {
+	targetUser, err := w.queries.ProvisioningTargetUserByID(ctx, targetID, userID)
+	if err != nil && !zerrors.IsNotFound(err) {
+		return "", false, err
+	}
+	if targetUser != nil && targetUser.RemoteID != "" {
+		return targetUser.RemoteID, true, nil
+	}
+	remoteID, err = client.UserIDByExternalID(ctx, userID)
+	if errors.Is(err, ErrRemoteUserNotFound) {
+		return "", targetUser != nil, nil
+	}
+	return remoteID, targetUser != nil, err
+}
+
+// provisionedUser returns the user if it must be provisioned to the targets of the project.
+// Only human users with an active grant on the project are provisioned,
+// if the user must not be provisioned (anymore) nil is returned.
+func (w *Worker) provisionedUser(ctx context.Context, projectID, userID string) (*query.User, error) {
+	user, err := w.queries.GetUserByID(ctx, true, userID)
+	if zerrors.IsNotFound(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if user.Type != domain.UserTypeHuman || user.Human == nil {
+		return nil, nil
+	}
+
+	userQuery, err := query.NewUserGrantUserIDSearchQuery(userID)
+	if err != nil {
+		return nil, err
+	}
+	projectQuery, err := query.NewUserGrantProjectIDSearchQuery(projectID)
+	if err != nil {
+		return nil, err
+	}
+	stateQuery, err := query.NewUserGrantStateQuery(domain.UserGrantStateActive)
+	if err != nil {
+		return nil, err
+	}
+	grants, err := w.queries.UserGrants(ctx, &query.UserGrantsQueries{
+		SearchRequest: query.SearchRequest{Limit: 1},
+		Queries:       []query.SearchQuery{userQuery, projectQuery, stateQuery},
+	}, true)
+	if err != nil {
+		return nil, err
+	}
+	if len(grants.UserGrants) == 0 {
+		return nil, nil
+	}
+	return user, nil
+}
+
+func scimUserFromUser(user *query.User) *ScimUser {
+	scimUser := &ScimUser{
+		Schemas:    []string{schemaUser},
+		ExternalID: user.ID,
+		UserName:   user.Username,
+		Name: &ScimUserName{
+			GivenName:  user.Human.FirstName,
+			FamilyName: user.Human.LastName,
+		},
+		DisplayName: user.Human.DisplayName,
+		NickName:    user.Human.NickName,
+		Active:      user.State == domain.UserStateActive || user.State == domain.UserStateInitial,
+	}
+	if !user.Human.PreferredLanguage.IsRoot() {
+		scimUser.PreferredLanguage = user.Human.PreferredLanguage.String()
+	}
+	if user.Human.Email != "" {
+		scimUser.Emails = []*ScimValue{{Value: string(user.Human.Email), Primary: true}}
+	}
+	if user.Human.Phone != "" {
+		scimUser.PhoneNumbers = []*ScimValue{{Value: string(user.Human.Phone), Primary: true}}
+	}
+	return scimUser
+}
+
+// nowFunc makes [time.Now] mockable
+type nowFunc func() time.Time
+
+type WorkerConfig struct {
+	Workers             uint8
+	TransactionDuration time.Duration
+	MaxTtl              time.Duration
+	MaxAttempts         uint8
+}
+
+func NewWorker(
+	config WorkerConfig,
+	queries WorkerQueries,
+	commands Commands,
+	client *http.Client,
+) *Worker {
+	return &Worker{
+		config:   config,
+		queries:  queries,
+		commands: commands,
+		client:   client,
+		now:      time.Now,
+	}
+}
+
+var _ river.Worker[*prov_repo.Request] = (*Worker)(nil)
+
+func (w *Worker) Register(workers *river.Workers, queues map[string]river.QueueConfig) {
+	river.AddWorker(workers, w)
+	queues[prov_repo.QueueName] = river.QueueConfig{
+		MaxWorkers: int(w.config.Workers),
+	}
+}
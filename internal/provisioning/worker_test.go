@@ -0,0 +1,489 @@
+package provisioning
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/riverqueue/river"
+	"github.com/riverqueue/river/rivertype"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"golang.org/x/text/language"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/provisioning/mock"
+	"github.com/zitadel/zitadel/internal/query"
+	prov_repo "github.com/zitadel/zitadel/internal/repository/provisioning"
+	"github.com/zitadel/zitadel/internal/repository/user"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+const (
+	instanceID = "instanceID"
+	orgID      = "orgID"
+	projectID  = "projectID"
+	appID      = "appID"
+	userID     = "userID"
+	remoteID   = "remoteID"
+	token      = "token"
+)
+
+// scimCall is a request received by the test scim server.
+type scimCall struct {
+	Method string
+	Path   string
+	Auth   string
+	User   *ScimUser
+}
+
+type scimServer struct {
+	mu    sync.Mutex
+	calls []*scimCall
+	// searchResult is the id returned when searching by external id, empty if the user is not found
+	searchResult string
+	status       int
+	// createStatus is returned when creating a user, if set
+	createStatus int
+}
+
+func (s *scimServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	call := &scimCall{Method: r.Method, Path: r.URL.Path, Auth: r.Header.Get("Authorization")}
+	if r.Body != nil && (r.Method == http.MethodPost || r.Method == http.MethodPut) {
+		call.User = new(ScimUser)
+		_ = json.NewDecoder(r.Body).Decode(call.User)
+	}
+	s.calls = append(s.calls, call)
+
+	if s.status != 0 {
+		w.WriteHeader(s.status)
+		return
+	}
+	if s.createStatus != 0 && r.Method == http.MethodPost {
+		w.WriteHeader(s.createStatus)
+		return
+	}
+	w.Header().Set("Content-Type", contentTypeScim)
+	switch r.Method {
+	case http.MethodGet:
+		resp := &scimListResponse{}
+		if s.searchResult != "" {
+			resp.TotalResults = 1
+			resp.Resources = []*ScimUser{{ID: s.searchResult}}
+		}
+		_ = json.NewEncoder(w).Encode(resp)
+	case http.MethodPost:
+		w.WriteHeader(http.StatusCreated)
+		_ = json.NewEncoder(w).Encode(&ScimUser{ID: remoteID})
+	case http.MethodPut:
+		_ = json.NewEncoder(w).Encode(&ScimUser{ID: remoteID})
+	case http.MethodDelete:
+		w.WriteHeader(http.StatusNoContent)
+	}
+}
+
+func testJob(attempt, maxAttempts int, createdAt time.Time) *river.Job[*prov_repo.Request] {
+	return &river.Job[*prov_repo.Request]{
+		JobRow: &rivertype.JobRow{
+			CreatedAt:   createdAt,
+			Attempt:     attempt,
+			MaxAttempts: maxAttempts,
+		},
+		Args: &prov_repo.Request{
+			Aggregate:        prov_repo.NewAggregate(appID, orgID, instanceID),
+			UserID:           userID,
+			TriggerEventType: user.HumanProfileChangedType,
+			TriggerSequence:  1,
+		},
+	}
+}
+
+func testUser(state domain.UserState) *query.User {
+	return &query.User{
+		ID:            userID,
+		ResourceOwner: orgID,
+		State:         state,
+		Type:          domain.UserTypeHuman,
+		Username:      "username",
+		Human: &query.Human{
+			FirstName:         "first",
+			LastName:          "last",
+			DisplayName:       "first last",
+			PreferredLanguage: language.English,
+			Email:             "user@example.com",
+		},
+	}
+}
+
+func userGrants(count int) *query.UserGrants {
+	grants := &query.UserGrants{}
+	for i := 0; i < count; i++ {
+		grants.UserGrants = append(grants.UserGrants, &query.UserGrant{UserID: userID, ProjectID: projectID})
+	}
+	return grants
+}
+
+func TestWorker_Work(t *testing.T) {
+	type fields struct {
+		queries  func(ctrl *gomock.Controller, endpoint string) WorkerQueries
+		commands func(ctrl *gomock.Controller) Commands
+		server   *scimServer
+	}
+	type want struct {
+		calls []*scimCall
+		err   assert.ErrorAssertionFunc
+	}
+	target := func(endpoint string) *query.ProvisioningTarget {
+		return &query.ProvisioningTarget{
+			ObjectDetails: domain.ObjectDetails{ID: appID, ResourceOwner: orgID},
+			ProjectID:     projectID,
+			Endpoint:      endpoint,
+			Token:         token,
+		}
+	}
+	isJobCancel := func(tt assert.TestingT, err error, i ...interface{}) bool {
+		return errors.Is(err, new(river.JobCancelError))
+	}
+	tests := []struct {
+		name   string
+		job    *river.Job[*prov_repo.Request]
+		fields fields
+		want   want
+	}{
+		{
+			name: "max TTL, cancel",
+			job:  testJob(1, 3, time.Now().Add(-1*time.Hour)),
+			fields: fields{
+				queries: func(ctrl *gomock.Controller, _ string) WorkerQueries {
+					return mock.NewMockWorkerQueries(ctrl)
+				},
+				commands: func(ctrl *gomock.Controller) Commands {
+					return mock.NewMockCommands(ctrl)
+				},
+				server: &scimServer{},
+			},
+			want: want{
+				err: isJobCancel,
+			},
+		},
+		{
+			name: "target removed, cancel",
+			job:  testJob(1, 3, time.Now()),
+			fields: fields{
+				queries: func(ctrl *gomock.Controller, _ string) WorkerQueries {
+					q := mock.NewMockWorkerQueries(ctrl)
+					q.EXPECT().ProvisioningTargetWithToken(gomock.Any(), appID).
+						Return(nil, zerrors.ThrowNotFound(nil, "QUERY-Prv2q", "Errors.ProvisioningTarget.NotFound"))
+					return q
+				},
+				commands: func(ctrl *gomock.Controller) Commands {
+					return mock.NewMockCommands(ctrl)
+				},
+				server: &scimServer{},
+			},
+			want: want{
+				err: isJobCancel,
+			},
+		},
+		{
+			name: "granted user not provisioned, create",
+			job:  testJob(1, 3, time.Now()),
+			fields: fields{
+				queries: func(ctrl *gomock.Controller, endpoint string) WorkerQueries {
+					q := mock.NewMockWorkerQueries(ctrl)
+					q.EXPECT().ProvisioningTargetWithToken(gomock.Any(), appID).Return(target(endpoint), nil)
+					q.EXPECT().ProvisioningTargetUserByID(gomock.Any(), appID, userID).
+						Return(nil, zerrors.ThrowNotFound(nil, "QUERY-Prv5q", "Errors.ProvisioningTarget.NotFound"))
+					q.EXPECT().GetUserByID(gomock.Any(), true, userID).Return(testUser(domain.UserStateActive), nil)
+					q.EXPECT().UserGrants(gomock.Any(), gomock.Any(), true).Return(userGrants(1), nil)
+					return q
+				},
+				commands: func(ctrl *gomock.Controller) Commands {
+					c := mock.NewMockCommands(ctrl)
+					c.EXPECT().ProvisioningUserCreationStarted(gomock.Any(), gomock.Any(), userID).Return(nil)
+					c.EXPECT().ProvisioningUserSynced(gomock.Any(), gomock.Any(), userID, remoteID, user.HumanProfileChangedType).Return(nil)
+					return c
+				},
+				server: &scimServer{},
+			},
+			want: want{
+				calls: []*scimCall{
+					{Method: http.MethodGet, Path: "/Users", Auth: "Bearer " + token},
+					{Method: http.MethodGet, Path: "/Users", Auth: "Bearer " + token},
+					{Method: http.MethodPost, Path: "/Users", Auth: "Bearer " + token, User: &ScimUser{
+						Schemas:           []string{schemaUser},
+						ExternalID:        userID,
+						UserName:          "username",
+						Name:              &ScimUserName{GivenName: "first", FamilyName: "last"},
+						DisplayName:       "first last",
+						PreferredLanguage: "en",
+						Active:            true,
+						Emails:            []*ScimValue{{Value: "user@example.com", Primary: true}},
+					}},
+				},
+				err: assert.NoError,
+			},
+		},
+		{
+			name: "granted user created concurrently, retry",
+			job:  testJob(1, 3, time.Now()),
+			fields: fields{
+				queries: func(ctrl *gomock.Controller, endpoint string) WorkerQueries {
+					q := mock.NewMockWorkerQueries(ctrl)
+					q.EXPECT().ProvisioningTargetWithToken(gomock.Any(), appID).Return(target(endpoint), nil)
+					q.EXPECT().ProvisioningTargetUserByID(gomock.Any(), appID, userID).
+						Return(nil, zerrors.ThrowNotFound(nil, "QUERY-Prv5q", "Errors.ProvisioningTarget.NotFound"))
+					q.EXPECT().GetUserByID(gomock.Any(), true, userID).Return(testUser(domain.UserStateActive), nil)
+					q.EXPECT().UserGrants(gomock.Any(), gomock.Any(), true).Return(userGrants(1), nil)
+					return q
+				},
+				commands: func(ctrl *gomock.Controller) Commands {
+					c := mock.NewMockCommands(ctrl)
+					c.EXPECT().ProvisioningUserCreationStarted(gomock.Any(), gomock.Any(), userID).
+						Return(zerrors.ThrowAlreadyExists(nil, "id", "Errors.ProvisioningTarget.UserCreationInProgress"))
+					return c
+				},
+				server: &scimServer{},
+			},
+			want: want{
+				calls: []*scimCall{
+					{Method: http.MethodGet, Path: "/Users", Auth: "Bearer " + token},
+				},
+				err: func(tt assert.TestingT, err error, i ...interface{}) bool {
+					return assert.True(tt, zerrors.IsErrorAlreadyExists(err)) &&
+						assert.False(tt, errors.Is(err, new(river.JobCancelError)))
+				},
+			},
+		},
+		{
+			name: "granted user creation failed, creation canceled and retry",
+			job:  testJob(1, 3, time.Now()),
+			fields: fields{
+				queries: func(ctrl *gomock.Controller, endpoint string) WorkerQueries {
+					q := mock.NewMockWorkerQueries(ctrl)
+					q.EXPECT().ProvisioningTargetWithToken(gomock.Any(), appID).Return(target(endpoint), nil)
+					q.EXPECT().ProvisioningTargetUserByID(gomock.Any(), appID, userID).
+						Return(nil, zerrors.ThrowNotFound(nil, "QUERY-Prv5q", "Errors.ProvisioningTarget.NotFound"))
+					q.EXPECT().GetUserByID(gomock.Any(), true, userID).Return(testUser(domain.UserStateActive), nil)
+					q.EXPECT().UserGrants(gomock.Any(), gomock.Any(), true).Return(userGrants(1), nil)
+					return q
+				},
+				commands: func(ctrl *gomock.Controller) Commands {
+					c := mock.NewMockCommands(ctrl)
+					c.EXPECT().ProvisioningUserCreationStarted(gomock.Any(), gomock.Any(), userID).Return(nil)
+					c.EXPECT().ProvisioningUserCreationCanceled(gomock.Any(), gomock.Any(), userID).Return(nil)
+					return c
+				},
+				server: &scimServer{createStatus: http.StatusServiceUnavailable},
+			},
+			want: want{
+				calls: []*scimCall{
+					{Method: http.MethodGet, Path: "/Users", Auth: "Bearer " + token},
+					{Method: http.MethodGet, Path: "/Users", Auth: "Bearer " + token},
+					{Method: http.MethodPost, Path: "/Users", Auth: "Bearer " + token, User: &ScimUser{
+						Schemas:           []string{schemaUser},
+						ExternalID:        userID,
+						UserName:          "username",
+						Name:              &ScimUserName{GivenName: "first", FamilyName: "last"},
+						DisplayName:       "first last",
+						PreferredLanguage: "en",
+						Active:            true,
+						Emails:            []*ScimValue{{Value: "user@example.com", Primary: true}},
+					}},
+				},
+				err: func(tt assert.TestingT, err error, i ...interface{}) bool {
+					var statusErr *StatusError
+					return assert.ErrorAs(tt, err, &statusErr) &&
+						assert.False(tt, errors.Is(err, new(river.JobCancelError)))
+				},
+			},
+		},
+		{
+			name: "deactivated user provisioned, replace inactive",
+			job:  testJob(1, 3, time.Now()),
+			fields: fields{
+				queries: func(ctrl *gomock.Controller, endpoint string) WorkerQueries {
+					q := mock.NewMockWorkerQueries(ctrl)
+					q.EXPECT().ProvisioningTargetWithToken(gomock.Any(), appID).Return(target(endpoint), nil)
+					q.EXPECT().ProvisioningTargetUserByID(gomock.Any(), appID, userID).
+						Return(&query.ProvisioningTargetUser{TargetID: appID, UserID: userID, RemoteID: remoteID}, nil)
+					q.EXPECT().GetUserByID(gomock.Any(), true, userID).Return(testUser(domain.UserStateInactive), nil)
+					q.EXPECT().UserGrants(gomock.Any(), gomock.Any(), true).Return(userGrants(1), nil)
+					return q
+				},
+				commands: func(ctrl *gomock.Controller) Commands {
+					c := mock.NewMockCommands(ctrl)
+					c.EXPECT().ProvisioningUserSynced(gomock.Any(), gomock.Any(), userID, remoteID, user.HumanProfileChangedType).Return(nil)
+					return c
+				},
+				server: &scimServer{},
+			},
+			want: want{
+				calls: []*scimCall{
+					{Method: http.MethodPut, Path: "/Users/" + remoteID, Auth: "Bearer " + token, User: &ScimUser{
+						Schemas:           []string{schemaUser},
+						ExternalID:        userID,
+						UserName:          "username",
+						Name:              &ScimUserName{GivenName: "first", FamilyName: "last"},
+						DisplayName:       "first last",
+						PreferredLanguage: "en",
+						Active:            false,
+						Emails:            []*ScimValue{{Value: "user@example.com", Primary: true}},
+					}},
+				},
+				err: assert.NoError,
+			},
+		},
+		{
+			name: "user without grant provisioned, delete",
+			job:  testJob(1, 3, time.Now()),
+			fields: fields{
+				queries: func(ctrl *gomock.Controller, endpoint string) WorkerQueries {
+					q := mock.NewMockWorkerQueries(ctrl)
+					q.EXPECT().ProvisioningTargetWithToken(gomock.Any(), appID).Return(target(endpoint), nil)
+					q.EXPECT().ProvisioningTargetUserByID(gomock.Any(), appID, userID).
+						Return(&query.ProvisioningTargetUser{TargetID: appID, UserID: userID, RemoteID: remoteID}, nil)
+					q.EXPECT().GetUserByID(gomock.Any(), true, userID).Return(testUser(domain.UserStateActive), nil)
+					q.EXPECT().UserGrants(gomock.Any(), gomock.Any(), true).Return(userGrants(0), nil)
+					return q
+				},
+				commands: func(ctrl *gomock.Controller) Commands {
+					c := mock.NewMockCommands(ctrl)
+					c.EXPECT().ProvisioningUserSynced(gomock.Any(), gomock.Any(), userID, "", user.HumanProfileChangedType).Return(nil)
+					return c
+				},
+				server: &scimServer{},
+			},
+			want: want{
+				calls: []*scimCall{
+					{Method: http.MethodDelete, Path: "/Users/" + remoteID, Auth: "Bearer " + token},
+				},
+				err: assert.NoError,
+			},
+		},
+		{
+			name: "removed user never provisioned, no call",
+			job:  testJob(1, 3, time.Now()),
+			fields: fields{
+				queries: func(ctrl *gomock.Controller, endpoint string) WorkerQueries {
+					q := mock.NewMockWorkerQueries(ctrl)
+					q.EXPECT().ProvisioningTargetWithToken(gomock.Any(), appID).Return(target(endpoint), nil)
+					q.EXPECT().ProvisioningTargetUserByID(gomock.Any(), appID, userID).
+						Return(nil, zerrors.ThrowNotFound(nil, "QUERY-Prv5q", "Errors.ProvisioningTarget.NotFound"))
+					q.EXPECT().GetUserByID(gomock.Any(), true, userID).
+						Return(nil, zerrors.ThrowNotFound(nil, "QUERY-Dfbg2", "Errors.User.NotFound"))
+					return q
+				},
+				commands: func(ctrl *gomock.Controller) Commands {
+					return mock.NewMockCommands(ctrl)
+				},
+				server: &scimServer{},
+			},
+			want: want{
+				calls: []*scimCall{
+					{Method: http.MethodGet, Path: "/Users", Auth: "Bearer " + token},
+				},
+				err: assert.NoError,
+			},
+		},
+		{
+			name: "target error, retry",
+			job:  testJob(1, 3, time.Now()),
+			fields: fields{
+				queries: func(ctrl *gomock.Controller, endpoint string) WorkerQueries {
+					q := mock.NewMockWorkerQueries(ctrl)
+					q.EXPECT().ProvisioningTargetWithToken(gomock.Any(), appID).Return(target(endpoint), nil)
+					q.EXPECT().ProvisioningTargetUserByID(gomock.Any(), appID, userID).
+						Return(nil, zerrors.ThrowNotFound(nil, "QUERY-Prv5q", "Errors.ProvisioningTarget.NotFound"))
+					return q
+				},
+				commands: func(ctrl *gomock.Controller) Commands {
+					return mock.NewMockCommands(ctrl)
+				},
+				server: &scimServer{status: http.StatusServiceUnavailable},
+			},
+			want: want{
+				calls: []*scimCall{
+					{Method: http.MethodGet, Path: "/Users", Auth: "Bearer " + token},
+				},
+				err: func(tt assert.TestingT, err error, i ...interface{}) bool {
+					var statusErr *StatusError
+					return assert.ErrorAs(tt, err, &statusErr) &&
+						assert.False(tt, errors.Is(err, new(river.JobCancelError)))
+				},
+			},
+		},
+		{
+			name: "target error on last attempt, failed",
+			job:  testJob(3, 3, time.Now()),
+			fields: fields{
+				queries: func(ctrl *gomock.Controller, endpoint string) WorkerQueries {
+					q := mock.NewMockWorkerQueries(ctrl)
+					q.EXPECT().ProvisioningTargetWithToken(gomock.Any(), appID).Return(target(endpoint), nil)
+					q.EXPECT().ProvisioningTargetUserByID(gomock.Any(), appID, userID).
+						Return(nil, zerrors.ThrowNotFound(nil, "QUERY-Prv5q", "Errors.ProvisioningTarget.NotFound"))
+					return q
+				},
+				commands: func(ctrl *gomock.Controller) Commands {
+					c := mock.NewMockCommands(ctrl)
+					c.EXPECT().ProvisioningUserSyncFailed(gomock.Any(), gomock.Any(), userID, user.HumanProfileChangedType, gomock.Any()).Return(nil)
+					return c
+				},
+				server: &scimServer{status: http.StatusServiceUnavailable},
+			},
+			want: want{
+				calls: []*scimCall{
+					{Method: http.MethodGet, Path: "/Users", Auth: "Bearer " + token},
+				},
+				err: isJobCancel,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(tt.fields.server)
+			defer server.Close()
+
+			ctrl := gomock.NewController(t)
+			w := NewWorker(
+				WorkerConfig{
+					Workers:             1,
+					TransactionDuration: 5 * time.Second,
+					MaxTtl:              5 * time.Minute,
+					MaxAttempts:         3,
+				},
+				tt.fields.queries(ctrl, server.URL),
+				tt.fields.commands(ctrl),
+				server.Client(),
+			)
+			err := w.Work(context.Background(), tt.job)
+			tt.want.err(t, err)
+			require.Len(t, tt.fields.server.calls, len(tt.want.calls))
+			for i, call := range tt.want.calls {
+				assert.Equal(t, call, tt.fields.server.calls[i])
+			}
+		})
+	}
+}
+
+func TestClient_UserIDByExternalID(t *testing.T) {
+	var filter string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		filter = r.URL.Query().Get("filter")
+		_ = json.NewEncoder(w).Encode(&scimListResponse{})
+	}))
+	defer server.Close()
+
+	_, err := NewClient(server.Client(), server.URL+"/", token).UserIDByExternalID(context.Background(), userID)
+	assert.ErrorIs(t, err, ErrRemoteUserNotFound)
+	assert.Equal(t, `externalId eq "userID"`, filter)
+}
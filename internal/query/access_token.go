@@ -35,6 +35,8 @@ type OIDCSessionAccessTokenReadModel struct {
 	UserAgent             *domain.UserAgent
 	Reason                domain.TokenReason
 	Actor                 *domain.TokenActor
+	DPoPJKT               string
+	AuthorizationDetails  domain.AuthorizationDetails
 }
 
 func newOIDCSessionAccessTokenReadModel(id string) *OIDCSessionAccessTokenReadModel {
@@ -85,6 +87,8 @@ func (wm *OIDCSessionAccessTokenReadModel) reduceAdded(e *oidcsession.AddedEvent
 	wm.Nonce = e.Nonce
 	wm.PreferredLanguage = e.PreferredLanguage
 	wm.UserAgent = e.UserAgent
+	wm.DPoPJKT = e.DPoPJKT
+	wm.AuthorizationDetails = e.AuthorizationDetails
 	wm.State = domain.OIDCSessionStateActive
 }
 
@@ -0,0 +1,174 @@
+package query
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/query/projection"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+var (
+	accountRecoveryRequestsTable = table{
+		name:          projection.AccountRecoveryRequestProjectionTable,
+		instanceIDCol: projection.AccountRecoveryRequestColumnInstanceID,
+	}
+	AccountRecoveryRequestColumnUserID = Column{
+		name:  projection.AccountRecoveryRequestColumnUserID,
+		table: accountRecoveryRequestsTable,
+	}
+	AccountRecoveryRequestColumnCreationDate = Column{
+		name:  projection.AccountRecoveryRequestColumnCreationDate,
+		table: accountRecoveryRequestsTable,
+	}
+	AccountRecoveryRequestColumnChangeDate = Column{
+		name:  projection.AccountRecoveryRequestColumnChangeDate,
+		table: accountRecoveryRequestsTable,
+	}
+	AccountRecoveryRequestColumnSequence = Column{
+		name:  projection.AccountRecoveryRequestColumnSequence,
+		table: accountRecoveryRequestsTable,
+	}
+	AccountRecoveryRequestColumnResourceOwner = Column{
+		name:  projection.AccountRecoveryRequestColumnResourceOwner,
+		table: accountRecoveryRequestsTable,
+	}
+	AccountRecoveryRequestColumnInstanceID = Column{
+		name:  projection.AccountRecoveryRequestColumnInstanceID,
+		table: accountRecoveryRequestsTable,
+	}
+	AccountRecoveryRequestColumnState = Column{
+		name:  projection.AccountRecoveryRequestColumnState,
+		table: accountRecoveryRequestsTable,
+	}
+	AccountRecoveryRequestColumnReason = Column{
+		name:  projection.AccountRecoveryRequestColumnReason,
+		table: accountRecoveryRequestsTable,
+	}
+)
+
+type AccountRecoveryRequests struct {
+	SearchResponse
+	AccountRecoveryRequests []*AccountRecoveryRequest
+}
+
+type AccountRecoveryRequest struct {
+	UserID        string
+	CreationDate  time.Time
+	ChangeDate    time.Time
+	Sequence      uint64
+	ResourceOwner string
+
+	State  domain.AccountRecoveryState
+	Reason string
+}
+
+type AccountRecoveryRequestSearchQueries struct {
+	SearchRequest
+	Queries []SearchQuery
+}
+
+// SearchAccountRecoveryRequests returns the latest account recovery request of the users matching the queries.
+func (q *Queries) SearchAccountRecoveryRequests(ctx context.Context, queries *AccountRecoveryRequestSearchQueries) (requests *AccountRecoveryRequests, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	query, scan := prepareAccountRecoveryRequestsQuery()
+	stmt, args, err := queries.toQuery(query).Where(sq.Eq{
+		AccountRecoveryRequestColumnInstanceID.identifier(): authz.GetInstance(ctx).InstanceID(),
+	}).ToSql()
+	if err != nil {
+		return nil, zerrors.ThrowInvalidArgument(err, "QUERY-Ar3qs", "Errors.Query.InvalidRequest")
+	}
+
+	err = q.client.QueryContext(ctx, func(rows *sql.Rows) error {
+		requests, err = scan(rows)
+		return err
+	}, stmt, args...)
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "QUERY-Ar4qe", "Errors.Internal")
+	}
+	requests.State, err = q.latestState(ctx, accountRecoveryRequestsTable)
+	return requests, err
+}
+
+func NewAccountRecoveryRequestResourceOwnerSearchQuery(value string) (SearchQuery, error) {
+	return NewTextQuery(AccountRecoveryRequestColumnResourceOwner, value, TextEquals)
+}
+
+func NewAccountRecoveryRequestUserIDSearchQuery(value string) (SearchQuery, error) {
+	return NewTextQuery(AccountRecoveryRequestColumnUserID, value, TextEquals)
+}
+
+func NewAccountRecoveryRequestStateSearchQuery(value domain.AccountRecoveryState) (SearchQuery, error) {
+	return NewNumberQuery(AccountRecoveryRequestColumnState, value, NumberEquals)
+}
+
+func (r *AccountRecoveryRequestSearchQueries) AppendMyResourceOwnerQuery(orgID string) error {
+	query, err := NewAccountRecoveryRequestResourceOwnerSearchQuery(orgID)
+	if err != nil {
+		return err
+	}
+	r.Queries = append(r.Queries, query)
+	return nil
+}
+
+func (q *AccountRecoveryRequestSearchQueries) toQuery(query sq.SelectBuilder) sq.SelectBuilder {
+	query = q.SearchRequest.toQuery(query)
+	for _, q := range q.Queries {
+		query = q.toQuery(query)
+	}
+	return query
+}
+
+func prepareAccountRecoveryRequestsQuery() (sq.SelectBuilder, func(*sql.Rows) (*AccountRecoveryRequests, error)) {
+	return sq.Select(
+			AccountRecoveryRequestColumnUserID.identifier(),
+			AccountRecoveryRequestColumnCreationDate.identifier(),
+			AccountRecoveryRequestColumnChangeDate.identifier(),
+			AccountRecoveryRequestColumnSequence.identifier(),
+			AccountRecoveryRequestColumnResourceOwner.identifier(),
+			AccountRecoveryRequestColumnState.identifier(),
+			AccountRecoveryRequestColumnReason.identifier(),
+			countColumn.identifier()).
+			From(accountRecoveryRequestsTable.identifier()).
+			PlaceholderFormat(sq.Dollar),
+		func(rows *sql.Rows) (*AccountRecoveryRequests, error) {
+			requests := make([]*AccountRecoveryRequest, 0)
+			var count uint64
+			for rows.Next() {
+				request := new(AccountRecoveryRequest)
+				err := rows.Scan(
+					&request.UserID,
+					&request.CreationDate,
+					&request.ChangeDate,
+					&request.Sequence,
+					&request.ResourceOwner,
+					&request.State,
+					&request.Reason,
+					&count,
+				)
+				if err != nil {
+					return nil, err
+				}
+				requests = append(requests, request)
+			}
+
+			if err := rows.Close(); err != nil {
+				return nil, zerrors.ThrowInternal(err, "QUERY-Ar5cr", "Errors.Query.CloseRows")
+			}
+
+			return &AccountRecoveryRequests{
+				AccountRecoveryRequests: requests,
+				SearchResponse: SearchResponse{
+					Count: count,
+				},
+			}, nil
+		}
+}
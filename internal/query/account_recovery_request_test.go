@@ -0,0 +1,137 @@
+package query
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/zitadel/zitadel/internal/domain"
+)
+
+var (
+	accountRecoveryRequestsStmt = regexp.QuoteMeta(
+		"SELECT projections.account_recovery_requests.user_id," +
+			" projections.account_recovery_requests.creation_date," +
+			" projections.account_recovery_requests.change_date," +
+			" projections.account_recovery_requests.sequence," +
+			" projections.account_recovery_requests.resource_owner," +
+			" projections.account_recovery_requests.state," +
+			" projections.account_recovery_requests.reason," +
+			" COUNT(*) OVER ()" +
+			" FROM projections.account_recovery_requests")
+	accountRecoveryRequestsCols = []string{
+		"user_id",
+		"creation_date",
+		"change_date",
+		"sequence",
+		"resource_owner",
+		"state",
+		"reason",
+		"count",
+	}
+)
+
+func Test_AccountRecoveryRequestPrepares(t *testing.T) {
+	type want struct {
+		sqlExpectations sqlExpectation
+		err             checkErr
+	}
+	tests := []struct {
+		name    string
+		prepare interface{}
+		want    want
+		object  interface{}
+	}{
+		{
+			name:    "prepareAccountRecoveryRequestsQuery no result",
+			prepare: prepareAccountRecoveryRequestsQuery,
+			want: want{
+				sqlExpectations: mockQueries(
+					accountRecoveryRequestsStmt,
+					nil,
+					nil,
+				),
+			},
+			object: &AccountRecoveryRequests{AccountRecoveryRequests: []*AccountRecoveryRequest{}},
+		},
+		{
+			name:    "prepareAccountRecoveryRequestsQuery multiple requests",
+			prepare: prepareAccountRecoveryRequestsQuery,
+			want: want{
+				sqlExpectations: mockQueries(
+					accountRecoveryRequestsStmt,
+					accountRecoveryRequestsCols,
+					[][]driver.Value{
+						{
+							"user-id",
+							testNow,
+							testNow,
+							uint64(20211202),
+							"ro",
+							domain.AccountRecoveryStateRequested,
+							"lost phone",
+						},
+						{
+							"user-id2",
+							testNow,
+							testNow,
+							uint64(20211202),
+							"ro",
+							domain.AccountRecoveryStateApproved,
+							"",
+						},
+					},
+				),
+			},
+			object: &AccountRecoveryRequests{
+				SearchResponse: SearchResponse{
+					Count: 2,
+				},
+				AccountRecoveryRequests: []*AccountRecoveryRequest{
+					{
+						UserID:        "user-id",
+						CreationDate:  testNow,
+						ChangeDate:    testNow,
+						Sequence:      20211202,
+						ResourceOwner: "ro",
+						State:         domain.AccountRecoveryStateRequested,
+						Reason:        "lost phone",
+					},
+					{
+						UserID:        "user-id2",
+						CreationDate:  testNow,
+						ChangeDate:    testNow,
+						Sequence:      20211202,
+						ResourceOwner: "ro",
+						State:         domain.AccountRecoveryStateApproved,
+					},
+				},
+			},
+		},
+		{
+			name:    "prepareAccountRecoveryRequestsQuery sql err",
+			prepare: prepareAccountRecoveryRequestsQuery,
+			want: want{
+				sqlExpectations: mockQueryErr(
+					accountRecoveryRequestsStmt,
+					sql.ErrConnDone,
+				),
+				err: func(err error) (error, bool) {
+					if !errors.Is(err, sql.ErrConnDone) {
+						return fmt.Errorf("err should be sql.ErrConnDone got: %w", err), false
+					}
+					return nil, true
+				},
+			},
+			object: (*AccountRecoveryRequests)(nil),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assertPrepare(t, tt.prepare, tt.object, tt.want.sqlExpectations, tt.want.err)
+		})
+	}
+}
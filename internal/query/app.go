@@ -41,27 +41,30 @@ type App struct {
 }
 
 type OIDCApp struct {
-	RedirectURIs             database.TextArray[string]
-	ResponseTypes            database.NumberArray[domain.OIDCResponseType]
-	GrantTypes               database.NumberArray[domain.OIDCGrantType]
-	AppType                  domain.OIDCApplicationType
-	ClientID                 string
-	AuthMethodType           domain.OIDCAuthMethodType
-	PostLogoutRedirectURIs   database.TextArray[string]
-	Version                  domain.OIDCVersion
-	ComplianceProblems       database.TextArray[string]
-	IsDevMode                bool
-	AccessTokenType          domain.OIDCTokenType
-	AssertAccessTokenRole    bool
-	AssertIDTokenRole        bool
-	AssertIDTokenUserinfo    bool
-	ClockSkew                time.Duration
-	AdditionalOrigins        database.TextArray[string]
-	AllowedOrigins           database.TextArray[string]
-	SkipNativeAppSuccessPage bool
-	BackChannelLogoutURI     string
-	LoginVersion             domain.LoginVersion
-	LoginBaseURI             *string
+	RedirectURIs                     database.TextArray[string]
+	ResponseTypes                    database.NumberArray[domain.OIDCResponseType]
+	GrantTypes                       database.NumberArray[domain.OIDCGrantType]
+	AppType                          domain.OIDCApplicationType
+	ClientID                         string
+	AuthMethodType                   domain.OIDCAuthMethodType
+	PostLogoutRedirectURIs           database.TextArray[string]
+	Version                          domain.OIDCVersion
+	ComplianceProblems               database.TextArray[string]
+	IsDevMode                        bool
+	AccessTokenType                  domain.OIDCTokenType
+	AssertAccessTokenRole            bool
+	AssertIDTokenRole                bool
+	AssertIDTokenUserinfo            bool
+	ClockSkew                        time.Duration
+	AdditionalOrigins                database.TextArray[string]
+	AllowedOrigins                   database.TextArray[string]
+	SkipNativeAppSuccessPage         bool
+	BackChannelLogoutURI             string
+	LoginVersion                     domain.LoginVersion
+	LoginBaseURI                     *string
+	RequirePushedAuthRequest         bool
+	RequireDPoP                      bool
+	BackChannelClientNotificationURI string
 }
 
 type SAMLApp struct {
@@ -276,6 +279,18 @@ var (
 		name:  projection.AppOIDCConfigColumnLoginBaseURI,
 		table: appOIDCConfigsTable,
 	}
+	AppOIDCConfigColumnRequirePushedAuthRequest = Column{
+		name:  projection.AppOIDCConfigColumnRequirePushedAuthRequest,
+		table: appOIDCConfigsTable,
+	}
+	AppOIDCConfigColumnRequireDPoP = Column{
+		name:  projection.AppOIDCConfigColumnRequireDPoP,
+		table: appOIDCConfigsTable,
+	}
+	AppOIDCConfigColumnBackChannelClientNotificationURI = Column{
+		name:  projection.AppOIDCConfigColumnBackChannelClientNotificationURI,
+		table: appOIDCConfigsTable,
+	}
 )
 
 func (q *Queries) AppByProjectAndAppID(ctx context.Context, shouldTriggerBulk bool, projectID, appID string) (app *App, err error) {
@@ -667,6 +682,9 @@ func prepareAppQuery(activeOnly bool) (sq.SelectBuilder, func(*sql.Row) (*App, e
 		AppOIDCConfigColumnBackChannelLogoutURI.identifier(),
 		AppOIDCConfigColumnLoginVersion.identifier(),
 		AppOIDCConfigColumnLoginBaseURI.identifier(),
+		AppOIDCConfigColumnRequirePushedAuthRequest.identifier(),
+		AppOIDCConfigColumnRequireDPoP.identifier(),
+		AppOIDCConfigColumnBackChannelClientNotificationURI.identifier(),
 
 		AppSAMLConfigColumnAppID.identifier(),
 		AppSAMLConfigColumnEntityID.identifier(),
@@ -736,6 +754,9 @@ func scanApp(row *sql.Row) (*App, error) {
 		&oidcConfig.backChannelLogoutURI,
 		&oidcConfig.loginVersion,
 		&oidcConfig.loginBaseURI,
+		&oidcConfig.requirePushedAuthRequest,
+		&oidcConfig.requireDPoP,
+		&oidcConfig.backChannelClientNotificationURI,
 
 		&samlConfig.appID,
 		&samlConfig.entityID,
@@ -790,6 +811,9 @@ func prepareOIDCAppQuery() (sq.SelectBuilder, func(*sql.Row) (*App, error)) {
 			AppOIDCConfigColumnBackChannelLogoutURI.identifier(),
 			AppOIDCConfigColumnLoginVersion.identifier(),
 			AppOIDCConfigColumnLoginBaseURI.identifier(),
+			AppOIDCConfigColumnRequirePushedAuthRequest.identifier(),
+			AppOIDCConfigColumnRequireDPoP.identifier(),
+			AppOIDCConfigColumnBackChannelClientNotificationURI.identifier(),
 		).From(appsTable.identifier()).
 			Join(join(AppOIDCConfigColumnAppID, AppColumnID)).
 			PlaceholderFormat(sq.Dollar), func(row *sql.Row) (*App, error) {
@@ -829,6 +853,9 @@ func prepareOIDCAppQuery() (sq.SelectBuilder, func(*sql.Row) (*App, error)) {
 				&oidcConfig.backChannelLogoutURI,
 				&oidcConfig.loginVersion,
 				&oidcConfig.loginBaseURI,
+				&oidcConfig.requirePushedAuthRequest,
+				&oidcConfig.requireDPoP,
+				&oidcConfig.backChannelClientNotificationURI,
 			)
 
 			if err != nil {
@@ -988,6 +1015,9 @@ func prepareAppsQuery() (sq.SelectBuilder, func(*sql.Rows) (*Apps, error)) {
 			AppOIDCConfigColumnBackChannelLogoutURI.identifier(),
 			AppOIDCConfigColumnLoginVersion.identifier(),
 			AppOIDCConfigColumnLoginBaseURI.identifier(),
+			AppOIDCConfigColumnRequirePushedAuthRequest.identifier(),
+			AppOIDCConfigColumnRequireDPoP.identifier(),
+			AppOIDCConfigColumnBackChannelClientNotificationURI.identifier(),
 
 			AppSAMLConfigColumnAppID.identifier(),
 			AppSAMLConfigColumnEntityID.identifier(),
@@ -1045,6 +1075,9 @@ func prepareAppsQuery() (sq.SelectBuilder, func(*sql.Rows) (*Apps, error)) {
 					&oidcConfig.backChannelLogoutURI,
 					&oidcConfig.loginVersion,
 					&oidcConfig.loginBaseURI,
+					&oidcConfig.requirePushedAuthRequest,
+					&oidcConfig.requireDPoP,
+					&oidcConfig.backChannelClientNotificationURI,
 
 					&samlConfig.appID,
 					&samlConfig.entityID,
@@ -1132,26 +1165,29 @@ func prepareLoginVersionBySAMLAppID() (sq.SelectBuilder, func(*sql.Row) (domain.
 }
 
 type sqlOIDCConfig struct {
-	appID                    sql.NullString
-	version                  sql.NullInt32
-	clientID                 sql.NullString
-	redirectUris             database.TextArray[string]
-	applicationType          sql.NullInt16
-	authMethodType           sql.NullInt16
-	postLogoutRedirectUris   database.TextArray[string]
-	devMode                  sql.NullBool
-	accessTokenType          sql.NullInt16
-	accessTokenRoleAssertion sql.NullBool
-	iDTokenRoleAssertion     sql.NullBool
-	iDTokenUserinfoAssertion sql.NullBool
-	clockSkew                sql.NullInt64
-	additionalOrigins        database.TextArray[string]
-	responseTypes            database.NumberArray[domain.OIDCResponseType]
-	grantTypes               database.NumberArray[domain.OIDCGrantType]
-	skipNativeAppSuccessPage sql.NullBool
-	backChannelLogoutURI     sql.NullString
-	loginVersion             sql.NullInt16
-	loginBaseURI             sql.NullString
+	appID                            sql.NullString
+	version                          sql.NullInt32
+	clientID                         sql.NullString
+	redirectUris                     database.TextArray[string]
+	applicationType                  sql.NullInt16
+	authMethodType                   sql.NullInt16
+	postLogoutRedirectUris           database.TextArray[string]
+	devMode                          sql.NullBool
+	accessTokenType                  sql.NullInt16
+	accessTokenRoleAssertion         sql.NullBool
+	iDTokenRoleAssertion             sql.NullBool
+	iDTokenUserinfoAssertion         sql.NullBool
+	clockSkew                        sql.NullInt64
+	additionalOrigins                database.TextArray[string]
+	responseTypes                    database.NumberArray[domain.OIDCResponseType]
+	grantTypes                       database.NumberArray[domain.OIDCGrantType]
+	skipNativeAppSuccessPage         sql.NullBool
+	backChannelLogoutURI             sql.NullString
+	loginVersion                     sql.NullInt16
+	loginBaseURI                     sql.NullString
+	requirePushedAuthRequest         sql.NullBool
+	requireDPoP                      sql.NullBool
+	backChannelClientNotificationURI sql.NullString
 }
 
 func (c sqlOIDCConfig) set(app *App) {
@@ -1159,24 +1195,27 @@ func (c sqlOIDCConfig) set(app *App) {
 		return
 	}
 	app.OIDCConfig = &OIDCApp{
-		Version:                  domain.OIDCVersion(c.version.Int32),
-		ClientID:                 c.clientID.String,
-		RedirectURIs:             c.redirectUris,
-		AppType:                  domain.OIDCApplicationType(c.applicationType.Int16),
-		AuthMethodType:           domain.OIDCAuthMethodType(c.authMethodType.Int16),
-		PostLogoutRedirectURIs:   c.postLogoutRedirectUris,
-		IsDevMode:                c.devMode.Bool,
-		AccessTokenType:          domain.OIDCTokenType(c.accessTokenType.Int16),
-		AssertAccessTokenRole:    c.accessTokenRoleAssertion.Bool,
-		AssertIDTokenRole:        c.iDTokenRoleAssertion.Bool,
-		AssertIDTokenUserinfo:    c.iDTokenUserinfoAssertion.Bool,
-		ClockSkew:                time.Duration(c.clockSkew.Int64),
-		AdditionalOrigins:        c.additionalOrigins,
-		ResponseTypes:            c.responseTypes,
-		GrantTypes:               c.grantTypes,
-		SkipNativeAppSuccessPage: c.skipNativeAppSuccessPage.Bool,
-		BackChannelLogoutURI:     c.backChannelLogoutURI.String,
-		LoginVersion:             domain.LoginVersion(c.loginVersion.Int16),
+		Version:                          domain.OIDCVersion(c.version.Int32),
+		ClientID:                         c.clientID.String,
+		RedirectURIs:                     c.redirectUris,
+		AppType:                          domain.OIDCApplicationType(c.applicationType.Int16),
+		AuthMethodType:                   domain.OIDCAuthMethodType(c.authMethodType.Int16),
+		PostLogoutRedirectURIs:           c.postLogoutRedirectUris,
+		IsDevMode:                        c.devMode.Bool,
+		AccessTokenType:                  domain.OIDCTokenType(c.accessTokenType.Int16),
+		AssertAccessTokenRole:            c.accessTokenRoleAssertion.Bool,
+		AssertIDTokenRole:                c.iDTokenRoleAssertion.Bool,
+		AssertIDTokenUserinfo:            c.iDTokenUserinfoAssertion.Bool,
+		ClockSkew:                        time.Duration(c.clockSkew.Int64),
+		AdditionalOrigins:                c.additionalOrigins,
+		ResponseTypes:                    c.responseTypes,
+		GrantTypes:                       c.grantTypes,
+		SkipNativeAppSuccessPage:         c.skipNativeAppSuccessPage.Bool,
+		BackChannelLogoutURI:             c.backChannelLogoutURI.String,
+		LoginVersion:                     domain.LoginVersion(c.loginVersion.Int16),
+		RequirePushedAuthRequest:         c.requirePushedAuthRequest.Bool,
+		RequireDPoP:                      c.requireDPoP.Bool,
+		BackChannelClientNotificationURI: c.backChannelClientNotificationURI.String,
 	}
 	if c.loginBaseURI.Valid {
 		app.OIDCConfig.LoginBaseURI = &c.loginBaseURI.String
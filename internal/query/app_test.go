@@ -51,6 +51,9 @@ var (
 		` projections.apps7_oidc_configs.back_channel_logout_uri,` +
 		` projections.apps7_oidc_configs.login_version,` +
 		` projections.apps7_oidc_configs.login_base_uri,` +
+		` projections.apps7_oidc_configs.require_pushed_auth_request,` +
+		` projections.apps7_oidc_configs.require_dpop,` +
+		` projections.apps7_oidc_configs.back_channel_client_notification_uri,` +
 		//saml config
 		` projections.apps7_saml_configs.app_id,` +
 		` projections.apps7_saml_configs.entity_id,` +
@@ -99,6 +102,9 @@ var (
 		` projections.apps7_oidc_configs.back_channel_logout_uri,` +
 		` projections.apps7_oidc_configs.login_version,` +
 		` projections.apps7_oidc_configs.login_base_uri,` +
+		` projections.apps7_oidc_configs.require_pushed_auth_request,` +
+		` projections.apps7_oidc_configs.require_dpop,` +
+		` projections.apps7_oidc_configs.back_channel_client_notification_uri,` +
 		//saml config
 		` projections.apps7_saml_configs.app_id,` +
 		` projections.apps7_saml_configs.entity_id,` +
@@ -172,6 +178,9 @@ var (
 		"back_channel_logout_uri",
 		"login_version",
 		"login_base_uri",
+		"require_pushed_auth_request",
+		"require_dpop",
+		"back_channel_client_notification_uri",
 		//saml config
 		"app_id",
 		"entity_id",
@@ -248,6 +257,9 @@ func Test_AppsPrepare(t *testing.T) {
 							nil,
 							nil,
 							nil,
+							nil,
+							nil,
+							nil,
 							// saml config
 							nil,
 							nil,
@@ -319,6 +331,9 @@ func Test_AppsPrepare(t *testing.T) {
 							nil,
 							nil,
 							nil,
+							nil,
+							nil,
+							nil,
 							// saml config
 							nil,
 							nil,
@@ -393,6 +408,9 @@ func Test_AppsPrepare(t *testing.T) {
 							nil,
 							nil,
 							nil,
+							nil,
+							nil,
+							nil,
 							// saml config
 							"app-id",
 							"https://test.com/saml/metadata",
@@ -469,6 +487,9 @@ func Test_AppsPrepare(t *testing.T) {
 							"back.channel.logout.ch",
 							domain.LoginVersionUnspecified,
 							nil,
+							nil,
+							nil,
+							nil,
 							// saml config
 							nil,
 							nil,
@@ -563,6 +584,9 @@ func Test_AppsPrepare(t *testing.T) {
 							"back.channel.logout.ch",
 							domain.LoginVersionUnspecified,
 							nil,
+							nil,
+							nil,
+							nil,
 							// saml config
 							nil,
 							nil,
@@ -657,6 +681,9 @@ func Test_AppsPrepare(t *testing.T) {
 							"back.channel.logout.ch",
 							domain.LoginVersionUnspecified,
 							nil,
+							nil,
+							nil,
+							nil,
 							// saml config
 							nil,
 							nil,
@@ -751,6 +778,9 @@ func Test_AppsPrepare(t *testing.T) {
 							"back.channel.logout.ch",
 							domain.LoginVersionUnspecified,
 							nil,
+							nil,
+							nil,
+							nil,
 							// saml config
 							nil,
 							nil,
@@ -845,6 +875,9 @@ func Test_AppsPrepare(t *testing.T) {
 							"back.channel.logout.ch",
 							domain.LoginVersionUnspecified,
 							nil,
+							nil,
+							nil,
+							nil,
 							// saml config
 							nil,
 							nil,
@@ -939,6 +972,9 @@ func Test_AppsPrepare(t *testing.T) {
 							"back.channel.logout.ch",
 							domain.LoginVersionUnspecified,
 							nil,
+							nil,
+							nil,
+							nil,
 							// saml config
 							nil,
 							nil,
@@ -1033,6 +1069,9 @@ func Test_AppsPrepare(t *testing.T) {
 							"back.channel.logout.ch",
 							domain.LoginVersion2,
 							"https://login.ch/",
+							false,
+							false,
+							nil,
 							// saml config
 							nil,
 							nil,
@@ -1075,6 +1114,9 @@ func Test_AppsPrepare(t *testing.T) {
 							nil,
 							nil,
 							nil,
+							nil,
+							nil,
+							nil,
 							// saml config
 							nil,
 							nil,
@@ -1117,6 +1159,9 @@ func Test_AppsPrepare(t *testing.T) {
 							nil,
 							nil,
 							nil,
+							nil,
+							nil,
+							nil,
 							// saml config
 							"saml-app-id",
 							"https://test.com/saml/metadata",
@@ -1302,6 +1347,9 @@ func Test_AppPrepare(t *testing.T) {
 						nil,
 						nil,
 						nil,
+						nil,
+						nil,
+						nil,
 						// saml config
 						nil,
 						nil,
@@ -1367,6 +1415,9 @@ func Test_AppPrepare(t *testing.T) {
 							nil,
 							nil,
 							nil,
+							nil,
+							nil,
+							nil,
 							// saml config
 							nil,
 							nil,
@@ -1437,6 +1488,9 @@ func Test_AppPrepare(t *testing.T) {
 							"back.channel.logout.ch",
 							domain.LoginVersionUnspecified,
 							nil,
+							nil,
+							nil,
+							nil,
 							// saml config
 							nil,
 							nil,
@@ -1526,6 +1580,9 @@ func Test_AppPrepare(t *testing.T) {
 							"back.channel.logout.ch",
 							domain.LoginVersionUnspecified,
 							nil,
+							nil,
+							nil,
+							nil,
 							// saml config
 							nil,
 							nil,
@@ -1615,6 +1672,9 @@ func Test_AppPrepare(t *testing.T) {
 							nil,
 							nil,
 							nil,
+							nil,
+							nil,
+							nil,
 							// saml config
 							"app-id",
 							"https://test.com/saml/metadata",
@@ -1688,6 +1748,9 @@ func Test_AppPrepare(t *testing.T) {
 							"back.channel.logout.ch",
 							domain.LoginVersionUnspecified,
 							nil,
+							nil,
+							nil,
+							nil,
 							// saml config
 							nil,
 							nil,
@@ -1777,6 +1840,9 @@ func Test_AppPrepare(t *testing.T) {
 							"back.channel.logout.ch",
 							domain.LoginVersionUnspecified,
 							nil,
+							nil,
+							nil,
+							nil,
 							// saml config
 							nil,
 							nil,
@@ -1866,6 +1932,9 @@ func Test_AppPrepare(t *testing.T) {
 							"back.channel.logout.ch",
 							domain.LoginVersionUnspecified,
 							nil,
+							nil,
+							nil,
+							nil,
 							// saml config
 							nil,
 							nil,
@@ -1955,6 +2024,9 @@ func Test_AppPrepare(t *testing.T) {
 							"back.channel.logout.ch",
 							domain.LoginVersionUnspecified,
 							nil,
+							nil,
+							nil,
+							nil,
 							// saml config
 							nil,
 							nil,
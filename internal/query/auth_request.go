@@ -30,6 +30,8 @@ type AuthRequest struct {
 	LoginHint    *string
 	MaxAge       *time.Duration
 	HintUserID   *string
+	// AuthorizationDetails requested by the client (RFC 9396)
+	AuthorizationDetails domain.AuthorizationDetails
 }
 
 func (a *AuthRequest) checkLoginClient(ctx context.Context, permissionCheck domain.PermissionCheck) error {
@@ -57,6 +59,7 @@ func (q *Queries) AuthRequestByID(ctx context.Context, shouldTriggerBulk bool, i
 		scope   database.TextArray[string]
 		prompt  database.NumberArray[domain.Prompt]
 		locales database.TextArray[string]
+		details []byte
 	)
 
 	dst := new(AuthRequest)
@@ -65,7 +68,7 @@ func (q *Queries) AuthRequestByID(ctx context.Context, shouldTriggerBulk bool, i
 		func(row *sql.Row) error {
 			return row.Scan(
 				&dst.ID, &dst.CreationDate, &dst.LoginClient, &dst.ClientID, &scope, &dst.RedirectURI,
-				&prompt, &locales, &dst.LoginHint, &dst.MaxAge, &dst.HintUserID,
+				&prompt, &locales, &dst.LoginHint, &dst.MaxAge, &dst.HintUserID, &details,
 			)
 		},
 		authRequestByIDQuery,
@@ -81,6 +84,10 @@ func (q *Queries) AuthRequestByID(ctx context.Context, shouldTriggerBulk bool, i
 	dst.Scope = scope
 	dst.Prompt = prompt
 	dst.UiLocales = locales
+	dst.AuthorizationDetails, err = domain.ParseAuthorizationDetails(string(details))
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "QUERY-Rar8q", "Errors.Internal")
+	}
 
 	if checkLoginClient {
 		if err = dst.checkLoginClient(ctx, q.checkPermission); err != nil {
@@ -9,7 +9,8 @@ select
     ui_locales,
     login_hint,
     max_age,
-    hint_user_id
-from projections.auth_requests
+    hint_user_id,
+    authorization_details
+from projections.auth_requests2
 where id = $1 and instance_id = $2
 limit 1;
@@ -5,6 +5,7 @@ import (
 	"database/sql"
 	"database/sql/driver"
 	_ "embed"
+	"encoding/json"
 	"fmt"
 	"regexp"
 	"testing"
@@ -38,6 +39,7 @@ func TestQueries_AuthRequestByID(t *testing.T) {
 		projection.AuthRequestColumnLoginHint,
 		projection.AuthRequestColumnMaxAge,
 		projection.AuthRequestColumnHintUserID,
+		projection.AuthRequestColumnAuthorizationDetails,
 	}
 	type args struct {
 		shouldTriggerBulk bool
@@ -71,6 +73,7 @@ func TestQueries_AuthRequestByID(t *testing.T) {
 				"me@example.com",
 				int64(time.Minute),
 				"userID",
+				[]byte(`[{"type":"payment_initiation","amount":10}]`),
 			}, "123", "instanceID"),
 			want: &AuthRequest{
 				ID:           "id",
@@ -84,6 +87,9 @@ func TestQueries_AuthRequestByID(t *testing.T) {
 				LoginHint:    gu.Ptr("me@example.com"),
 				MaxAge:       gu.Ptr(time.Minute),
 				HintUserID:   gu.Ptr("userID"),
+				AuthorizationDetails: domain.AuthorizationDetails{
+					{"type": "payment_initiation", "amount": json.Number("10")},
+				},
 			},
 		},
 		{
@@ -105,6 +111,7 @@ func TestQueries_AuthRequestByID(t *testing.T) {
 				nil,
 				nil,
 				nil,
+				nil,
 			}, "123", "instanceID"),
 			want: &AuthRequest{
 				ID:           "id",
@@ -157,6 +164,7 @@ func TestQueries_AuthRequestByID(t *testing.T) {
 				nil,
 				nil,
 				nil,
+				nil,
 			}, "123", "instanceID"),
 			permissionCheck: func(ctx context.Context, permission, orgID, resourceID string) (err error) {
 				return zerrors.ThrowPermissionDenied(nil, "id", "not permitted")
@@ -182,6 +190,7 @@ func TestQueries_AuthRequestByID(t *testing.T) {
 				nil,
 				nil,
 				nil,
+				nil,
 			}, "123", "instanceID"),
 			permissionCheck: func(ctx context.Context, permission, orgID, resourceID string) (err error) {
 				return nil
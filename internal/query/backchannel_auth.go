@@ -0,0 +1,187 @@
+package query
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/query/projection"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+var (
+	backChannelAuthRequestTable = table{
+		name:          projection.BackChannelAuthRequestProjectionTable,
+		instanceIDCol: projection.BackChannelAuthRequestColumnInstanceID,
+	}
+	BackChannelAuthRequestColumnID = Column{
+		name:  projection.BackChannelAuthRequestColumnID,
+		table: backChannelAuthRequestTable,
+	}
+	BackChannelAuthRequestColumnCreationDate = Column{
+		name:  projection.BackChannelAuthRequestColumnCreationDate,
+		table: backChannelAuthRequestTable,
+	}
+	BackChannelAuthRequestColumnChangeDate = Column{
+		name:  projection.BackChannelAuthRequestColumnChangeDate,
+		table: backChannelAuthRequestTable,
+	}
+	BackChannelAuthRequestColumnSequence = Column{
+		name:  projection.BackChannelAuthRequestColumnSequence,
+		table: backChannelAuthRequestTable,
+	}
+	BackChannelAuthRequestColumnInstanceID = Column{
+		name:  projection.BackChannelAuthRequestColumnInstanceID,
+		table: backChannelAuthRequestTable,
+	}
+	BackChannelAuthRequestColumnClientID = Column{
+		name:  projection.BackChannelAuthRequestColumnClientID,
+		table: backChannelAuthRequestTable,
+	}
+	BackChannelAuthRequestColumnUserID = Column{
+		name:  projection.BackChannelAuthRequestColumnUserID,
+		table: backChannelAuthRequestTable,
+	}
+	BackChannelAuthRequestColumnScopes = Column{
+		name:  projection.BackChannelAuthRequestColumnScopes,
+		table: backChannelAuthRequestTable,
+	}
+	BackChannelAuthRequestColumnBindingMessage = Column{
+		name:  projection.BackChannelAuthRequestColumnBindingMessage,
+		table: backChannelAuthRequestTable,
+	}
+	BackChannelAuthRequestColumnExpiration = Column{
+		name:  projection.BackChannelAuthRequestColumnExpiration,
+		table: backChannelAuthRequestTable,
+	}
+)
+
+type BackChannelAuthRequests struct {
+	SearchResponse
+	BackChannelAuthRequests []*BackChannelAuthRequest
+}
+
+// BackChannelAuthRequest is a pending client initiated backchannel authentication (CIBA) request,
+// which waits for the approval of the user.
+type BackChannelAuthRequest struct {
+	ID           string
+	CreationDate time.Time
+	ChangeDate   time.Time
+	Sequence     uint64
+
+	ClientID       string
+	UserID         string
+	Scopes         []string
+	BindingMessage string
+	Expiration     time.Time
+	AppName        string
+	ProjectName    string
+}
+
+// PendingBackChannelAuthRequestsByUserID returns the not yet expired backchannel authentication requests of the user.
+// The caller must either be the user itself or be granted to read sessions of the instance.
+func (q *Queries) PendingBackChannelAuthRequestsByUserID(ctx context.Context, userID string) (requests *BackChannelAuthRequests, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if authz.GetCtxData(ctx).UserID != userID {
+		if err := q.checkPermission(ctx, domain.PermissionSessionRead, authz.GetInstance(ctx).InstanceID(), ""); err != nil {
+			return nil, err
+		}
+	}
+
+	query, scan := prepareBackChannelAuthRequestsQuery()
+	stmt, args, err := query.Where(sq.And{
+		sq.Eq{
+			BackChannelAuthRequestColumnUserID.identifier():     userID,
+			BackChannelAuthRequestColumnInstanceID.identifier(): authz.GetInstance(ctx).InstanceID(),
+		},
+		sq.Gt{
+			BackChannelAuthRequestColumnExpiration.identifier(): time.Now(),
+		},
+	}).OrderBy(BackChannelAuthRequestColumnCreationDate.identifier()).ToSql()
+	if err != nil {
+		return nil, zerrors.ThrowInvalidArgument(err, "QUERY-Cba1q", "Errors.Query.InvalidRequest")
+	}
+
+	err = q.client.QueryContext(ctx, func(rows *sql.Rows) error {
+		requests, err = scan(rows)
+		return err
+	}, stmt, args...)
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "QUERY-Cba2q", "Errors.Internal")
+	}
+	requests.State, err = q.latestState(ctx, backChannelAuthRequestTable)
+	return requests, err
+}
+
+func prepareBackChannelAuthRequestsQuery() (sq.SelectBuilder, func(*sql.Rows) (*BackChannelAuthRequests, error)) {
+	return sq.Select(
+			BackChannelAuthRequestColumnID.identifier(),
+			BackChannelAuthRequestColumnCreationDate.identifier(),
+			BackChannelAuthRequestColumnChangeDate.identifier(),
+			BackChannelAuthRequestColumnSequence.identifier(),
+			BackChannelAuthRequestColumnClientID.identifier(),
+			BackChannelAuthRequestColumnUserID.identifier(),
+			BackChannelAuthRequestColumnScopes.identifier(),
+			BackChannelAuthRequestColumnBindingMessage.identifier(),
+			BackChannelAuthRequestColumnExpiration.identifier(),
+			AppColumnName.identifier(),
+			ProjectColumnName.identifier(),
+			countColumn.identifier()).
+			From(backChannelAuthRequestTable.identifier()).
+			LeftJoin(join(AppOIDCConfigColumnClientID, BackChannelAuthRequestColumnClientID)).
+			LeftJoin(join(AppColumnID, AppOIDCConfigColumnAppID)).
+			LeftJoin(join(ProjectColumnID, AppColumnProjectID)).
+			PlaceholderFormat(sq.Dollar),
+		func(rows *sql.Rows) (*BackChannelAuthRequests, error) {
+			requests := make([]*BackChannelAuthRequest, 0)
+			var count uint64
+			for rows.Next() {
+				request := new(BackChannelAuthRequest)
+				var (
+					scopes      database.TextArray[string]
+					appName     sql.NullString
+					projectName sql.NullString
+				)
+				err := rows.Scan(
+					&request.ID,
+					&request.CreationDate,
+					&request.ChangeDate,
+					&request.Sequence,
+					&request.ClientID,
+					&request.UserID,
+					&scopes,
+					&request.BindingMessage,
+					&request.Expiration,
+					&appName,
+					&projectName,
+					&count,
+				)
+				if err != nil {
+					return nil, err
+				}
+				request.Scopes = scopes
+				request.AppName = appName.String
+				request.ProjectName = projectName.String
+				requests = append(requests, request)
+			}
+
+			if err := rows.Close(); err != nil {
+				return nil, zerrors.ThrowInternal(err, "QUERY-Cba3c", "Errors.Query.CloseRows")
+			}
+
+			return &BackChannelAuthRequests{
+				BackChannelAuthRequests: requests,
+				SearchResponse: SearchResponse{
+					Count: count,
+				},
+			}, nil
+		}
+}
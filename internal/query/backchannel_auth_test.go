@@ -0,0 +1,185 @@
+package query
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+var (
+	backChannelAuthRequestsStmt = regexp.QuoteMeta(
+		"SELECT projections.back_channel_auth_requests.id," +
+			" projections.back_channel_auth_requests.creation_date," +
+			" projections.back_channel_auth_requests.change_date," +
+			" projections.back_channel_auth_requests.sequence," +
+			" projections.back_channel_auth_requests.client_id," +
+			" projections.back_channel_auth_requests.user_id," +
+			" projections.back_channel_auth_requests.scopes," +
+			" projections.back_channel_auth_requests.binding_message," +
+			" projections.back_channel_auth_requests.expiration," +
+			" projections.apps7.name," +
+			" projections.projects4.name," +
+			" COUNT(*) OVER ()" +
+			" FROM projections.back_channel_auth_requests" +
+			" LEFT JOIN projections.apps7_oidc_configs" +
+			" ON projections.back_channel_auth_requests.client_id = projections.apps7_oidc_configs.client_id" +
+			" AND projections.back_channel_auth_requests.instance_id = projections.apps7_oidc_configs.instance_id" +
+			" LEFT JOIN projections.apps7 ON projections.apps7_oidc_configs.app_id = projections.apps7.id" +
+			" AND projections.apps7_oidc_configs.instance_id = projections.apps7.instance_id" +
+			" LEFT JOIN projections.projects4 ON projections.apps7.project_id = projections.projects4.id" +
+			" AND projections.apps7.instance_id = projections.projects4.instance_id")
+	backChannelAuthRequestsCols = []string{
+		"id",
+		"creation_date",
+		"change_date",
+		"sequence",
+		"client_id",
+		"user_id",
+		"scopes",
+		"binding_message",
+		"expiration",
+		"name",
+		"name",
+		"count",
+	}
+)
+
+func Test_BackChannelAuthRequestPrepares(t *testing.T) {
+	type want struct {
+		sqlExpectations sqlExpectation
+		err             checkErr
+	}
+	tests := []struct {
+		name    string
+		prepare interface{}
+		want    want
+		object  interface{}
+	}{
+		{
+			name:    "prepareBackChannelAuthRequestsQuery no result",
+			prepare: prepareBackChannelAuthRequestsQuery,
+			want: want{
+				sqlExpectations: mockQueries(
+					backChannelAuthRequestsStmt,
+					nil,
+					nil,
+				),
+			},
+			object: &BackChannelAuthRequests{BackChannelAuthRequests: []*BackChannelAuthRequest{}},
+		},
+		{
+			name:    "prepareBackChannelAuthRequestsQuery multiple requests",
+			prepare: prepareBackChannelAuthRequestsQuery,
+			want: want{
+				sqlExpectations: mockQueries(
+					backChannelAuthRequestsStmt,
+					backChannelAuthRequestsCols,
+					[][]driver.Value{
+						{
+							"request-id",
+							testNow,
+							testNow,
+							uint64(20211202),
+							"client-id",
+							"user-id",
+							database.TextArray[string]{"openid", "profile"},
+							"123",
+							testNow,
+							"app-name",
+							"project-name",
+						},
+						{
+							"request-id2",
+							testNow,
+							testNow,
+							uint64(20211202),
+							"client-id2",
+							"user-id",
+							database.TextArray[string]{"openid"},
+							"",
+							testNow,
+							nil,
+							nil,
+						},
+					},
+				),
+			},
+			object: &BackChannelAuthRequests{
+				SearchResponse: SearchResponse{
+					Count: 2,
+				},
+				BackChannelAuthRequests: []*BackChannelAuthRequest{
+					{
+						ID:             "request-id",
+						CreationDate:   testNow,
+						ChangeDate:     testNow,
+						Sequence:       20211202,
+						ClientID:       "client-id",
+						UserID:         "user-id",
+						Scopes:         []string{"openid", "profile"},
+						BindingMessage: "123",
+						Expiration:     testNow,
+						AppName:        "app-name",
+						ProjectName:    "project-name",
+					},
+					{
+						ID:           "request-id2",
+						CreationDate: testNow,
+						ChangeDate:   testNow,
+						Sequence:     20211202,
+						ClientID:     "client-id2",
+						UserID:       "user-id",
+						Scopes:       []string{"openid"},
+						Expiration:   testNow,
+					},
+				},
+			},
+		},
+		{
+			name:    "prepareBackChannelAuthRequestsQuery sql err",
+			prepare: prepareBackChannelAuthRequestsQuery,
+			want: want{
+				sqlExpectations: mockQueryErr(
+					backChannelAuthRequestsStmt,
+					sql.ErrConnDone,
+				),
+				err: func(err error) (error, bool) {
+					if !errors.Is(err, sql.ErrConnDone) {
+						return fmt.Errorf("err should be sql.ErrConnDone got: %w", err), false
+					}
+					return nil, true
+				},
+			},
+			object: (*BackChannelAuthRequests)(nil),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assertPrepare(t, tt.prepare, tt.object, tt.want.sqlExpectations, tt.want.err)
+		})
+	}
+}
+
+func TestQueries_PendingBackChannelAuthRequestsByUserID_permission(t *testing.T) {
+	q := &Queries{
+		checkPermission: func(ctx context.Context, permission, orgID, resourceID string) (err error) {
+			return zerrors.ThrowPermissionDenied(nil, "id", "not permitted")
+		},
+	}
+	ctx := authz.NewMockContext("instanceID", "orgID", "userID")
+
+	got, err := q.PendingBackChannelAuthRequestsByUserID(ctx, "otherUserID")
+	require.ErrorIs(t, err, zerrors.ThrowPermissionDenied(nil, "id", "not permitted"))
+	assert.Nil(t, got)
+}
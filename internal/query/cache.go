@@ -13,8 +13,12 @@ import (
 )
 
 type Caches struct {
-	instance cache.Cache[instanceIndex, string, *authzInstance]
-	org      cache.Cache[orgIndex, string, *Org]
+	instance            cache.Cache[instanceIndex, string, *authzInstance]
+	org                 cache.Cache[orgIndex, string, *Org]
+	user                cache.Cache[userIndex, string, *cachedUser]
+	session             cache.Cache[sessionIndex, string, *cachedSession]
+	introspectionClient cache.Cache[introspectionClientIndex, string, *cachedIntrospectionClient]
+	projectRoles        cache.Cache[projectRolesIndex, string, *cachedProjectRoles]
 
 	activeInstances *expirable.LRU[string, bool]
 }
@@ -24,7 +28,15 @@ type ActiveInstanceConfig struct {
 	TTL        time.Duration
 }
 
-func startCaches(background context.Context, connectors connector.Connectors, instanceConfig ActiveInstanceConfig) (_ *Caches, err error) {
+// cacheLookups resolves the keys of cached objects,
+// which are changed by events of other aggregates than their own.
+type cacheLookups interface {
+	userIDsByResourceOwner(ctx context.Context, instanceID, resourceOwner string) ([]string, error)
+	sessionIDsByUserID(ctx context.Context, instanceID, userID string) ([]string, error)
+	clientIDsByProjectOrResourceOwner(ctx context.Context, aggregate *eventstore.Aggregate) ([]string, error)
+}
+
+func startCaches(background context.Context, connectors connector.Connectors, instanceConfig ActiveInstanceConfig, lookups cacheLookups) (_ *Caches, err error) {
 	caches := new(Caches)
 	caches.instance, err = connector.StartCache[instanceIndex, string, *authzInstance](background, instanceIndexValues(), cache.PurposeAuthzInstance, connectors.Config.Instance, connectors)
 	if err != nil {
@@ -34,11 +46,31 @@ func startCaches(background context.Context, connectors connector.Connectors, in
 	if err != nil {
 		return nil, err
 	}
+	caches.user, err = connector.StartCache[userIndex, string, *cachedUser](background, userIndexValues(), cache.PurposeUser, connectors.Config.User, connectors)
+	if err != nil {
+		return nil, err
+	}
+	caches.session, err = connector.StartCache[sessionIndex, string, *cachedSession](background, sessionIndexValues(), cache.PurposeSession, connectors.Config.Session, connectors)
+	if err != nil {
+		return nil, err
+	}
+	caches.introspectionClient, err = connector.StartCache[introspectionClientIndex, string, *cachedIntrospectionClient](background, introspectionClientIndexValues(), cache.PurposeIntrospectionClient, connectors.Config.IntrospectionClient, connectors)
+	if err != nil {
+		return nil, err
+	}
+	caches.projectRoles, err = connector.StartCache[projectRolesIndex, string, *cachedProjectRoles](background, projectRolesIndexValues(), cache.PurposeProjectRoles, connectors.Config.ProjectRoles, connectors)
+	if err != nil {
+		return nil, err
+	}
 
 	caches.activeInstances = expirable.NewLRU[string, bool](instanceConfig.MaxEntries, nil, instanceConfig.TTL)
 
 	caches.registerInstanceInvalidation()
 	caches.registerOrgInvalidation()
+	caches.registerUserInvalidation(lookups.userIDsByResourceOwner)
+	caches.registerSessionInvalidation(lookups.sessionIDsByUserID)
+	caches.registerIntrospectionClientInvalidation(lookups.clientIDsByProjectOrResourceOwner)
+	caches.registerProjectRolesInvalidation()
 	return caches, nil
 }
 
@@ -64,3 +96,13 @@ func getAggregateID(aggregate *eventstore.Aggregate) string {
 func getResourceOwner(aggregate *eventstore.Aggregate) string {
 	return aggregate.ResourceOwner
 }
+
+func getInstanceScopedAggregateID(aggregate *eventstore.Aggregate) string {
+	return instanceScopedKey(aggregate.InstanceID, aggregate.ID)
+}
+
+// instanceScopedKey prefixes the key with the instance ID,
+// for objects which are not unique across instances.
+func instanceScopedKey(instanceID, key string) string {
+	return instanceID + ":" + key
+}
@@ -0,0 +1,147 @@
+package query
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore/handler/v2"
+	"github.com/zitadel/zitadel/internal/query/projection"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+type ClientCertificatePolicy struct {
+	ID            string
+	Sequence      uint64
+	CreationDate  time.Time
+	ChangeDate    time.Time
+	ResourceOwner string
+
+	CACertificates database.TextArray[string]
+	CRLs           database.TextArray[string]
+	UserMapping    domain.ClientCertificateUserMapping
+}
+
+// ToDomain returns the policy, which is able to verify certificates and map them to a user.
+func (p *ClientCertificatePolicy) ToDomain() *domain.ClientCertificatePolicy {
+	return &domain.ClientCertificatePolicy{
+		CACertificates: p.CACertificates,
+		CRLs:           p.CRLs,
+		UserMapping:    p.UserMapping,
+	}
+}
+
+var (
+	clientCertificatePolicyTable = table{
+		name:          projection.ClientCertificatePolicyTable,
+		instanceIDCol: projection.ClientCertificatePolicyInstanceIDCol,
+	}
+	ClientCertificatePolicyColID = Column{
+		name:  projection.ClientCertificatePolicyIDCol,
+		table: clientCertificatePolicyTable,
+	}
+	ClientCertificatePolicyColSequence = Column{
+		name:  projection.ClientCertificatePolicySequenceCol,
+		table: clientCertificatePolicyTable,
+	}
+	ClientCertificatePolicyColCreationDate = Column{
+		name:  projection.ClientCertificatePolicyCreationDateCol,
+		table: clientCertificatePolicyTable,
+	}
+	ClientCertificatePolicyColChangeDate = Column{
+		name:  projection.ClientCertificatePolicyChangeDateCol,
+		table: clientCertificatePolicyTable,
+	}
+	ClientCertificatePolicyColResourceOwner = Column{
+		name:  projection.ClientCertificatePolicyResourceOwnerCol,
+		table: clientCertificatePolicyTable,
+	}
+	ClientCertificatePolicyColInstanceID = Column{
+		name:  projection.ClientCertificatePolicyInstanceIDCol,
+		table: clientCertificatePolicyTable,
+	}
+	ClientCertificatePolicyColCACertificates = Column{
+		name:  projection.ClientCertificatePolicyCACertificatesCol,
+		table: clientCertificatePolicyTable,
+	}
+	ClientCertificatePolicyColCRLs = Column{
+		name:  projection.ClientCertificatePolicyCRLsCol,
+		table: clientCertificatePolicyTable,
+	}
+	ClientCertificatePolicyColUserMapping = Column{
+		name:  projection.ClientCertificatePolicyUserMappingCol,
+		table: clientCertificatePolicyTable,
+	}
+)
+
+// ClientCertificatePolicyByOrg returns the policy of the organization.
+// As there is no default policy, a NotFound error is returned if the organization did not set one.
+func (q *Queries) ClientCertificatePolicyByOrg(ctx context.Context, shouldTriggerBulk bool, orgID string) (policy *ClientCertificatePolicy, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if shouldTriggerBulk {
+		_, traceSpan := tracing.NewNamedSpan(ctx, "TriggerClientCertificatePolicyProjection")
+		ctx, err = projection.ClientCertificatePolicyProjection.Trigger(ctx, handler.WithAwaitRunning())
+		traceSpan.EndWithError(err)
+		if err != nil {
+			return nil, err
+		}
+	}
+	stmt, scan := prepareClientCertificatePolicyQuery()
+	query, args, err := stmt.Where(sq.Eq{
+		ClientCertificatePolicyColID.identifier():         orgID,
+		ClientCertificatePolicyColInstanceID.identifier(): authz.GetInstance(ctx).InstanceID(),
+	}).ToSql()
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "QUERY-Cc1Qo", "Errors.Query.SQLStatement")
+	}
+
+	err = q.client.QueryRowContext(ctx, func(row *sql.Row) error {
+		policy, err = scan(row)
+		return err
+	}, query, args...)
+	return policy, err
+}
+
+func prepareClientCertificatePolicyQuery() (sq.SelectBuilder, func(*sql.Row) (*ClientCertificatePolicy, error)) {
+	return sq.Select(
+			ClientCertificatePolicyColID.identifier(),
+			ClientCertificatePolicyColSequence.identifier(),
+			ClientCertificatePolicyColCreationDate.identifier(),
+			ClientCertificatePolicyColChangeDate.identifier(),
+			ClientCertificatePolicyColResourceOwner.identifier(),
+			ClientCertificatePolicyColCACertificates.identifier(),
+			ClientCertificatePolicyColCRLs.identifier(),
+			ClientCertificatePolicyColUserMapping.identifier(),
+		).
+			From(clientCertificatePolicyTable.identifier()).
+			PlaceholderFormat(sq.Dollar),
+		func(row *sql.Row) (*ClientCertificatePolicy, error) {
+			policy := new(ClientCertificatePolicy)
+			err := row.Scan(
+				&policy.ID,
+				&policy.Sequence,
+				&policy.CreationDate,
+				&policy.ChangeDate,
+				&policy.ResourceOwner,
+				&policy.CACertificates,
+				&policy.CRLs,
+				&policy.UserMapping,
+			)
+			if err != nil {
+				if errors.Is(err, sql.ErrNoRows) {
+					return nil, zerrors.ThrowNotFound(err, "QUERY-Cc2Qn", "Errors.Org.ClientCertificatePolicy.NotFound")
+				}
+				return nil, zerrors.ThrowInternal(err, "QUERY-Cc3Qi", "Errors.Internal")
+			}
+			return policy, nil
+		}
+}
@@ -0,0 +1,120 @@
+package query
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+var (
+	clientCertificatePolicyStmt = regexp.QuoteMeta(`SELECT projections.client_certificate_policies.id,` +
+		` projections.client_certificate_policies.sequence,` +
+		` projections.client_certificate_policies.creation_date,` +
+		` projections.client_certificate_policies.change_date,` +
+		` projections.client_certificate_policies.resource_owner,` +
+		` projections.client_certificate_policies.ca_certificates,` +
+		` projections.client_certificate_policies.crls,` +
+		` projections.client_certificate_policies.user_mapping` +
+		` FROM projections.client_certificate_policies`)
+	clientCertificatePolicyCols = []string{
+		"id",
+		"sequence",
+		"creation_date",
+		"change_date",
+		"resource_owner",
+		"ca_certificates",
+		"crls",
+		"user_mapping",
+	}
+)
+
+func Test_ClientCertificatePolicyPrepares(t *testing.T) {
+	type want struct {
+		sqlExpectations sqlExpectation
+		err             checkErr
+	}
+	tests := []struct {
+		name    string
+		prepare interface{}
+		want    want
+		object  interface{}
+	}{
+		{
+			name:    "prepareClientCertificatePolicyQuery no result",
+			prepare: prepareClientCertificatePolicyQuery,
+			want: want{
+				sqlExpectations: mockQueriesScanErr(
+					clientCertificatePolicyStmt,
+					nil,
+					nil,
+				),
+				err: func(err error) (error, bool) {
+					if !zerrors.IsNotFound(err) {
+						return fmt.Errorf("err should be NotFoundError got: %w", err), false
+					}
+					return nil, true
+				},
+			},
+			object: (*ClientCertificatePolicy)(nil),
+		},
+		{
+			name:    "prepareClientCertificatePolicyQuery found",
+			prepare: prepareClientCertificatePolicyQuery,
+			want: want{
+				sqlExpectations: mockQuery(
+					clientCertificatePolicyStmt,
+					clientCertificatePolicyCols,
+					[]driver.Value{
+						"pol-id",
+						uint64(20211109),
+						testNow,
+						testNow,
+						"ro",
+						database.TextArray[string]{"ca"},
+						database.TextArray[string]{"crl"},
+						domain.ClientCertificateUserMappingSANEmail,
+					},
+				),
+			},
+			object: &ClientCertificatePolicy{
+				ID:             "pol-id",
+				CreationDate:   testNow,
+				ChangeDate:     testNow,
+				Sequence:       20211109,
+				ResourceOwner:  "ro",
+				CACertificates: database.TextArray[string]{"ca"},
+				CRLs:           database.TextArray[string]{"crl"},
+				UserMapping:    domain.ClientCertificateUserMappingSANEmail,
+			},
+		},
+		{
+			name:    "prepareClientCertificatePolicyQuery sql err",
+			prepare: prepareClientCertificatePolicyQuery,
+			want: want{
+				sqlExpectations: mockQueryErr(
+					clientCertificatePolicyStmt,
+					sql.ErrConnDone,
+				),
+				err: func(err error) (error, bool) {
+					if !errors.Is(err, sql.ErrConnDone) {
+						return fmt.Errorf("err should be sql.ErrConnDone got: %w", err), false
+					}
+					return nil, true
+				},
+			},
+			object: (*ClientCertificatePolicy)(nil),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assertPrepare(t, tt.prepare, tt.object, tt.want.sqlExpectations, tt.want.err)
+		})
+	}
+}
@@ -0,0 +1,157 @@
+package query
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"strings"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/query/projection"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+var (
+	emailSuppressionTable = table{
+		name:          projection.EmailSuppressionTable,
+		instanceIDCol: projection.EmailSuppressionInstanceIDCol,
+	}
+	EmailSuppressionColumnUserID = Column{
+		name:  projection.EmailSuppressionUserIDCol,
+		table: emailSuppressionTable,
+	}
+	EmailSuppressionColumnCreationDate = Column{
+		name:  projection.EmailSuppressionCreationDateCol,
+		table: emailSuppressionTable,
+	}
+	EmailSuppressionColumnChangeDate = Column{
+		name:  projection.EmailSuppressionChangeDateCol,
+		table: emailSuppressionTable,
+	}
+	EmailSuppressionColumnResourceOwner = Column{
+		name:  projection.EmailSuppressionResourceOwnerCol,
+		table: emailSuppressionTable,
+	}
+	EmailSuppressionColumnInstanceID = Column{
+		name:  projection.EmailSuppressionInstanceIDCol,
+		table: emailSuppressionTable,
+	}
+	EmailSuppressionColumnSequence = Column{
+		name:  projection.EmailSuppressionSequenceCol,
+		table: emailSuppressionTable,
+	}
+	EmailSuppressionColumnEmail = Column{
+		name:  projection.EmailSuppressionEmailCol,
+		table: emailSuppressionTable,
+	}
+	EmailSuppressionColumnReason = Column{
+		name:  projection.EmailSuppressionReasonCol,
+		table: emailSuppressionTable,
+	}
+	EmailSuppressionColumnDiagnostic = Column{
+		name:  projection.EmailSuppressionDiagnosticCol,
+		table: emailSuppressionTable,
+	}
+)
+
+// EmailSuppression is the email address of a user which was reported as undeliverable.
+type EmailSuppression struct {
+	UserID        string
+	CreationDate  time.Time
+	ChangeDate    time.Time
+	ResourceOwner string
+	Sequence      uint64
+	Email         domain.EmailAddress
+	Reason        domain.EmailUndeliverableReason
+	Diagnostic    string
+}
+
+// EmailSuppressionByUserID returns the suppression of the email address of the user.
+// If the email address of the user was not reported as undeliverable, a not found error is returned.
+func (q *Queries) EmailSuppressionByUserID(ctx context.Context, userID string) (_ *EmailSuppression, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	eq := sq.Eq{
+		EmailSuppressionColumnUserID.identifier():     userID,
+		EmailSuppressionColumnInstanceID.identifier(): authz.GetInstance(ctx).InstanceID(),
+	}
+	query, scan := prepareEmailSuppressionQuery()
+	return genericRowQuery(ctx, q.client, query.Where(eq), scan)
+}
+
+// IsEmailSuppressed checks if the email address was reported as undeliverable for any user of the instance.
+func (q *Queries) IsEmailSuppressed(ctx context.Context, email string) (suppressed bool, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	query, scan := prepareEmailSuppressedQuery()
+	stmt, args, err := query.Where(sq.Eq{
+		EmailSuppressionColumnInstanceID.identifier(): authz.GetInstance(ctx).InstanceID(),
+		EmailSuppressionColumnEmail.identifier():      strings.ToLower(strings.TrimSpace(email)),
+	}).ToSql()
+	if err != nil {
+		return false, zerrors.ThrowInternal(err, "QUERY-Bnc1q", "Errors.Query.SQLStatement")
+	}
+
+	err = q.client.QueryRowContext(ctx, func(row *sql.Row) error {
+		suppressed, err = scan(row)
+		return err
+	}, stmt, args...)
+	return suppressed, err
+}
+
+func prepareEmailSuppressionQuery() (sq.SelectBuilder, func(*sql.Row) (*EmailSuppression, error)) {
+	return sq.Select(
+			EmailSuppressionColumnUserID.identifier(),
+			EmailSuppressionColumnCreationDate.identifier(),
+			EmailSuppressionColumnChangeDate.identifier(),
+			EmailSuppressionColumnResourceOwner.identifier(),
+			EmailSuppressionColumnSequence.identifier(),
+			EmailSuppressionColumnEmail.identifier(),
+			EmailSuppressionColumnReason.identifier(),
+			EmailSuppressionColumnDiagnostic.identifier(),
+		).
+			From(emailSuppressionTable.identifier()).
+			PlaceholderFormat(sq.Dollar),
+		func(row *sql.Row) (*EmailSuppression, error) {
+			suppression := new(EmailSuppression)
+			var diagnostic sql.NullString
+			err := row.Scan(
+				&suppression.UserID,
+				&suppression.CreationDate,
+				&suppression.ChangeDate,
+				&suppression.ResourceOwner,
+				&suppression.Sequence,
+				&suppression.Email,
+				&suppression.Reason,
+				&diagnostic,
+			)
+			if err != nil {
+				if errors.Is(err, sql.ErrNoRows) {
+					return nil, zerrors.ThrowNotFound(err, "QUERY-Bnc2q", "Errors.User.Email.SuppressionNotFound")
+				}
+				return nil, zerrors.ThrowInternal(err, "QUERY-Bnc3q", "Errors.Internal")
+			}
+			suppression.Diagnostic = diagnostic.String
+			return suppression, nil
+		}
+}
+
+func prepareEmailSuppressedQuery() (sq.SelectBuilder, func(*sql.Row) (bool, error)) {
+	return sq.Select(uniqueColumn.identifier()).
+			From(emailSuppressionTable.identifier()).
+			PlaceholderFormat(sq.Dollar),
+		func(row *sql.Row) (bool, error) {
+			var notSuppressed bool
+			if err := row.Scan(&notSuppressed); err != nil {
+				return false, zerrors.ThrowInternal(err, "QUERY-Bnc4q", "Errors.Internal")
+			}
+			return !notSuppressed, nil
+		}
+}
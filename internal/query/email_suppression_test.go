@@ -0,0 +1,169 @@
+package query
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+var (
+	prepareEmailSuppressionStmt = `SELECT projections.email_suppressions.user_id,` +
+		` projections.email_suppressions.creation_date,` +
+		` projections.email_suppressions.change_date,` +
+		` projections.email_suppressions.resource_owner,` +
+		` projections.email_suppressions.sequence,` +
+		` projections.email_suppressions.email,` +
+		` projections.email_suppressions.reason,` +
+		` projections.email_suppressions.diagnostic` +
+		` FROM projections.email_suppressions`
+	prepareEmailSuppressionCols = []string{
+		"user_id",
+		"creation_date",
+		"change_date",
+		"resource_owner",
+		"sequence",
+		"email",
+		"reason",
+		"diagnostic",
+	}
+	prepareEmailSuppressedStmt = `SELECT COUNT(*) = 0` +
+		` FROM projections.email_suppressions`
+	prepareEmailSuppressedCols = []string{
+		"count",
+	}
+)
+
+func Test_EmailSuppressionPrepares(t *testing.T) {
+	type want struct {
+		sqlExpectations sqlExpectation
+		err             checkErr
+	}
+	tests := []struct {
+		name    string
+		prepare interface{}
+		want    want
+		object  interface{}
+	}{
+		{
+			name:    "prepareEmailSuppressionQuery no result",
+			prepare: prepareEmailSuppressionQuery,
+			want: want{
+				sqlExpectations: mockQueriesScanErr(
+					regexp.QuoteMeta(prepareEmailSuppressionStmt),
+					nil,
+					nil,
+				),
+				err: func(err error) (error, bool) {
+					if !zerrors.IsNotFound(err) {
+						return fmt.Errorf("err should be zitadel.NotFoundError got: %w", err), false
+					}
+					return nil, true
+				},
+			},
+			object: (*EmailSuppression)(nil),
+		},
+		{
+			name:    "prepareEmailSuppressionQuery found",
+			prepare: prepareEmailSuppressionQuery,
+			want: want{
+				sqlExpectations: mockQuery(
+					regexp.QuoteMeta(prepareEmailSuppressionStmt),
+					prepareEmailSuppressionCols,
+					[]driver.Value{
+						"user-id",
+						testNow,
+						testNow,
+						"ro",
+						uint64(20211109),
+						"gigi@example.com",
+						domain.EmailUndeliverableReasonBounce,
+						"550 5.1.1 user unknown",
+					},
+				),
+			},
+			object: &EmailSuppression{
+				UserID:        "user-id",
+				CreationDate:  testNow,
+				ChangeDate:    testNow,
+				ResourceOwner: "ro",
+				Sequence:      20211109,
+				Email:         "gigi@example.com",
+				Reason:        domain.EmailUndeliverableReasonBounce,
+				Diagnostic:    "550 5.1.1 user unknown",
+			},
+		},
+		{
+			name:    "prepareEmailSuppressionQuery sql err",
+			prepare: prepareEmailSuppressionQuery,
+			want: want{
+				sqlExpectations: mockQueryErr(
+					regexp.QuoteMeta(prepareEmailSuppressionStmt),
+					sql.ErrConnDone,
+				),
+				err: func(err error) (error, bool) {
+					if !errors.Is(err, sql.ErrConnDone) {
+						return fmt.Errorf("err should be sql.ErrConnDone got: %w", err), false
+					}
+					return nil, true
+				},
+			},
+			object: (*EmailSuppression)(nil),
+		},
+		{
+			name:    "prepareEmailSuppressedQuery not suppressed",
+			prepare: prepareEmailSuppressedQuery,
+			want: want{
+				sqlExpectations: mockQuery(
+					regexp.QuoteMeta(prepareEmailSuppressedStmt),
+					prepareEmailSuppressedCols,
+					[]driver.Value{
+						true,
+					},
+				),
+			},
+			object: false,
+		},
+		{
+			name:    "prepareEmailSuppressedQuery suppressed",
+			prepare: prepareEmailSuppressedQuery,
+			want: want{
+				sqlExpectations: mockQuery(
+					regexp.QuoteMeta(prepareEmailSuppressedStmt),
+					prepareEmailSuppressedCols,
+					[]driver.Value{
+						false,
+					},
+				),
+			},
+			object: true,
+		},
+		{
+			name:    "prepareEmailSuppressedQuery sql err",
+			prepare: prepareEmailSuppressedQuery,
+			want: want{
+				sqlExpectations: mockQueryErr(
+					regexp.QuoteMeta(prepareEmailSuppressedStmt),
+					sql.ErrConnDone,
+				),
+				err: func(err error) (error, bool) {
+					if !errors.Is(err, sql.ErrConnDone) {
+						return fmt.Errorf("err should be sql.ErrConnDone got: %w", err), false
+					}
+					return nil, true
+				},
+			},
+			object: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assertPrepare(t, tt.prepare, tt.object, tt.want.sqlExpectations, tt.want.err)
+		})
+	}
+}
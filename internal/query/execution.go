@@ -364,11 +364,16 @@ type ExecutionTarget struct {
 	ExecutionID      string
 	TargetID         string
 	TargetType       domain.TargetType
+	Transport        domain.TargetTransport
 	Endpoint         string
 	Timeout          time.Duration
 	InterruptOnError bool
+	SigningAlgorithm domain.TargetSigningAlgorithm
 	signingKey       *crypto.CryptoValue
 	SigningKey       string
+
+	nextSigningKey *crypto.CryptoValue
+	NextSigningKey string
 }
 
 func (e *ExecutionTarget) GetExecutionID() string {
@@ -386,22 +391,41 @@ func (e *ExecutionTarget) GetEndpoint() string {
 func (e *ExecutionTarget) GetTargetType() domain.TargetType {
 	return e.TargetType
 }
+func (e *ExecutionTarget) GetTransport() domain.TargetTransport {
+	return e.Transport
+}
 func (e *ExecutionTarget) GetTimeout() time.Duration {
 	return e.Timeout
 }
-func (e *ExecutionTarget) GetSigningKey() string {
-	return e.SigningKey
+func (e *ExecutionTarget) GetSigningAlgorithm() domain.TargetSigningAlgorithm {
+	return e.SigningAlgorithm
 }
 
-func (t *ExecutionTarget) decryptSigningKey(alg crypto.EncryptionAlgorithm) error {
-	if t.signingKey == nil {
-		return nil
+// GetSigningKeys returns the current signing key and the next one, as long as it's not activated.
+func (e *ExecutionTarget) GetSigningKeys() []string {
+	keys := make([]string, 0, 2)
+	if e.SigningKey != "" {
+		keys = append(keys, e.SigningKey)
 	}
-	keyValue, err := crypto.DecryptString(t.signingKey, alg)
-	if err != nil {
-		return zerrors.ThrowInternal(err, "QUERY-bxevy3YXwy", "Errors.Internal")
+	if e.NextSigningKey != "" {
+		keys = append(keys, e.NextSigningKey)
+	}
+	return keys
+}
+
+func (t *ExecutionTarget) decryptSigningKey(alg crypto.EncryptionAlgorithm) (err error) {
+	if t.signingKey != nil {
+		t.SigningKey, err = crypto.DecryptString(t.signingKey, alg)
+		if err != nil {
+			return zerrors.ThrowInternal(err, "QUERY-bxevy3YXwy", "Errors.Internal")
+		}
+	}
+	if t.nextSigningKey != nil {
+		t.NextSigningKey, err = crypto.DecryptString(t.nextSigningKey, alg)
+		if err != nil {
+			return zerrors.ThrowInternal(err, "QUERY-Sgn5q", "Errors.Internal")
+		}
 	}
-	t.SigningKey = keyValue
 	return nil
 }
 
@@ -415,10 +439,15 @@ func scanExecutionTargets(rows *sql.Rows) ([]*ExecutionTarget, error) {
 			executionID      = &sql.NullString{}
 			targetID         = &sql.NullString{}
 			targetType       = &sql.NullInt32{}
+			transport        = &sql.NullInt32{}
 			endpoint         = &sql.NullString{}
 			timeout          = &sql.NullInt64{}
 			interruptOnError = &sql.NullBool{}
 			signingKey       = &crypto.CryptoValue{}
+			signingAlgorithm = &sql.NullInt32{}
+
+			nextSigningKey          = &crypto.CryptoValue{}
+			nextSigningKeyCreatedAt = &sql.NullTime{}
 		)
 
 		err := rows.Scan(
@@ -426,10 +455,14 @@ func scanExecutionTargets(rows *sql.Rows) ([]*ExecutionTarget, error) {
 			instanceID,
 			targetID,
 			targetType,
+			transport,
 			endpoint,
 			timeout,
 			interruptOnError,
 			signingKey,
+			signingAlgorithm,
+			nextSigningKey,
+			nextSigningKeyCreatedAt,
 		)
 
 		if err != nil {
@@ -440,10 +473,15 @@ func scanExecutionTargets(rows *sql.Rows) ([]*ExecutionTarget, error) {
 		target.ExecutionID = executionID.String
 		target.TargetID = targetID.String
 		target.TargetType = domain.TargetType(targetType.Int32)
+		target.Transport = domain.TargetTransport(transport.Int32)
 		target.Endpoint = endpoint.String
 		target.Timeout = time.Duration(timeout.Int64)
 		target.InterruptOnError = interruptOnError.Bool
 		target.signingKey = signingKey
+		target.SigningAlgorithm = domain.TargetSigningAlgorithm(signingAlgorithm.Int32)
+		if nextSigningKeyCreatedAt.Valid {
+			target.nextSigningKey = nextSigningKey
+		}
 
 		targets = append(targets, target)
 	}
@@ -8,7 +8,7 @@ SELECT et.instance_id,
                )
        ) as targets
 FROM projections.executions1_targets AS et
-         INNER JOIN projections.targets2 AS t
+         INNER JOIN projections.targets3 AS t
                     ON et.instance_id = t.instance_id
                         AND et.target_id IS NOT NULL
                         AND et.target_id = t.id
@@ -4,12 +4,19 @@ import (
 	"context"
 	"database/sql"
 	_ "embed"
+	"slices"
 	"sync"
 
+	"github.com/zitadel/logging"
+
 	"github.com/zitadel/zitadel/internal/api/authz"
 	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/eventstore"
 	"github.com/zitadel/zitadel/internal/eventstore/handler/v2"
 	"github.com/zitadel/zitadel/internal/query/projection"
+	"github.com/zitadel/zitadel/internal/repository/instance"
+	"github.com/zitadel/zitadel/internal/repository/org"
+	"github.com/zitadel/zitadel/internal/repository/project"
 	"github.com/zitadel/zitadel/internal/telemetry/tracing"
 )
 
@@ -61,6 +68,13 @@ func (q *Queries) ActiveIntrospectionClientByID(ctx context.Context, clientID st
 		client     = new(IntrospectionClient)
 	)
 
+	// Public keys expire and are therefore always queried from the database.
+	if !getKeys {
+		if cached, ok := q.caches.introspectionClient.Get(ctx, introspectionClientIndexByClientID, instanceScopedKey(instanceID, clientID)); ok {
+			return cached.Client.copy(), nil
+		}
+	}
+
 	err = q.client.QueryRowContext(ctx, func(row *sql.Row) error {
 		return row.Scan(
 			&client.AppID,
@@ -79,6 +93,137 @@ func (q *Queries) ActiveIntrospectionClientByID(ctx context.Context, clientID st
 	if err != nil {
 		return nil, err
 	}
-
+	if !getKeys {
+		q.caches.introspectionClient.Set(ctx, &cachedIntrospectionClient{InstanceID: instanceID, Client: client.copy()})
+	}
 	return client, nil
 }
+
+// copy returns a deep copy of the client,
+// so callers can't modify the client stored in the cache.
+func (c *IntrospectionClient) copy() *IntrospectionClient {
+	client := *c
+	if c.PublicKeys != nil {
+		client.PublicKeys = make(database.Map[[]byte], len(c.PublicKeys))
+		for id, key := range c.PublicKeys {
+			client.PublicKeys[id] = slices.Clone(key)
+		}
+	}
+	return &client
+}
+
+type introspectionClientIndex int
+
+//go:generate enumer -type introspectionClientIndex -linecomment
+const (
+	// Empty line comment ensures empty string for unspecified value
+	introspectionClientIndexUnspecified introspectionClientIndex = iota //
+	introspectionClientIndexByClientID
+)
+
+// cachedIntrospectionClient wraps the client with the instance it belongs to.
+type cachedIntrospectionClient struct {
+	InstanceID string               `json:"instance_id,omitempty"`
+	Client     *IntrospectionClient `json:"client,omitempty"`
+}
+
+// Keys implements [cache.Entry]
+func (c *cachedIntrospectionClient) Keys(index introspectionClientIndex) []string {
+	switch index {
+	case introspectionClientIndexByClientID:
+		return []string{instanceScopedKey(c.InstanceID, c.Client.ClientID)}
+	case introspectionClientIndexUnspecified:
+	}
+	return nil
+}
+
+// registerIntrospectionClientInvalidation invalidates the clients on changes of their app, project or organization.
+// As those events do not contain the client IDs, they are resolved using clientIDsByAggregate.
+func (c *Caches) registerIntrospectionClientInvalidation(clientIDsByAggregate func(ctx context.Context, aggregate *eventstore.Aggregate) ([]string, error)) {
+	invalidate := func(ctx context.Context, aggregates []*eventstore.Aggregate) {
+		keys := make([]string, 0, len(aggregates))
+		for _, aggregate := range aggregates {
+			if aggregate.Type == instance.AggregateType {
+				err := c.introspectionClient.Truncate(ctx)
+				logging.OnError(err).Warn("cache truncate failed")
+				return
+			}
+			clientIDs, err := clientIDsByAggregate(ctx, aggregate)
+			if err != nil {
+				logging.WithError(err).Warn("cache invalidation failed")
+				continue
+			}
+			for _, clientID := range clientIDs {
+				keys = append(keys, instanceScopedKey(aggregate.InstanceID, clientID))
+			}
+		}
+		err := c.introspectionClient.Invalidate(ctx, introspectionClientIndexByClientID, keys...)
+		logging.OnError(err).Warn("cache invalidation failed")
+	}
+	projection.AppProjection.RegisterCacheInvalidation(invalidate)
+	projection.ProjectProjection.RegisterCacheInvalidation(invalidate)
+	projection.OrgProjection.RegisterCacheInvalidation(invalidate)
+}
+
+// clientIDsByProjectOrResourceOwner returns the client IDs of all apps ever added to the project
+// or to the projects of the organization, depending on the type of the aggregate.
+// The IDs are searched in the events, so clients of already removed apps are found as well.
+func (q *Queries) clientIDsByProjectOrResourceOwner(ctx context.Context, aggregate *eventstore.Aggregate) (_ []string, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	readModel := &clientIDsReadModel{
+		ReadModel: &eventstore.ReadModel{
+			InstanceID: aggregate.InstanceID,
+		},
+	}
+	switch aggregate.Type {
+	case project.AggregateType:
+		readModel.AggregateID = aggregate.ID
+	case org.AggregateType:
+		readModel.ResourceOwner = aggregate.ID
+	default:
+		return nil, nil
+	}
+	if err = q.eventstore.FilterToQueryReducer(ctx, readModel); err != nil {
+		return nil, err
+	}
+	return readModel.ClientIDs, nil
+}
+
+type clientIDsReadModel struct {
+	*eventstore.ReadModel
+
+	ClientIDs []string
+}
+
+func (rm *clientIDsReadModel) Reduce() error {
+	for _, event := range rm.Events {
+		switch e := event.(type) {
+		case *project.OIDCConfigAddedEvent:
+			rm.ClientIDs = append(rm.ClientIDs, e.ClientID)
+		case *project.APIConfigAddedEvent:
+			rm.ClientIDs = append(rm.ClientIDs, e.ClientID)
+		}
+	}
+	return rm.ReadModel.Reduce()
+}
+
+func (rm *clientIDsReadModel) Query() *eventstore.SearchQueryBuilder {
+	searchQuery := eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		InstanceID(rm.InstanceID).
+		AddQuery().
+		AggregateTypes(project.AggregateType).
+		EventTypes(
+			project.OIDCConfigAddedType,
+			project.APIConfigAddedType,
+		)
+	if rm.AggregateID != "" {
+		searchQuery = searchQuery.AggregateIDs(rm.AggregateID)
+	}
+	query := searchQuery.Builder()
+	if rm.ResourceOwner != "" {
+		query = query.ResourceOwner(rm.ResourceOwner)
+	}
+	return query
+}
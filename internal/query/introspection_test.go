@@ -11,6 +11,9 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/cache"
+	"github.com/zitadel/zitadel/internal/cache/connector/gomap"
+	"github.com/zitadel/zitadel/internal/cache/connector/noop"
 	"github.com/zitadel/zitadel/internal/database"
 )
 
@@ -93,6 +96,9 @@ func TestQueries_ActiveIntrospectionClientByID(t *testing.T) {
 					client: &database.DB{
 						DB: db,
 					},
+					caches: &Caches{
+						introspectionClient: noop.NewCache[introspectionClientIndex, string, *cachedIntrospectionClient](),
+					},
 				}
 				ctx := authz.NewMockContext("instanceID", "orgID", "userID")
 				got, err := q.ActiveIntrospectionClientByID(ctx, tt.args.clientID, tt.args.getKeys)
@@ -102,3 +108,63 @@ func TestQueries_ActiveIntrospectionClientByID(t *testing.T) {
 		})
 	}
 }
+
+func TestQueries_ActiveIntrospectionClientByID_cache(t *testing.T) {
+	expQuery := regexp.QuoteMeta(introspectionClientByIDQuery)
+	want := &IntrospectionClient{
+		AppID:                "appID",
+		ClientID:             "clientID",
+		HashedSecret:         "secret",
+		AppType:              AppTypeOIDC,
+		ProjectID:            "projectID",
+		ResourceOwner:        "orgID",
+		ProjectRoleAssertion: true,
+	}
+	// the client is only queried once, the second call is served from the cache
+	execMock(t,
+		mockQuery(expQuery,
+			[]string{"app_id", "client_id", "client_secret", "app_type", "project_id", "resource_owner", "project_role_assertion", "public_keys"},
+			[]driver.Value{"appID", "clientID", "secret", "oidc", "projectID", "orgID", true, nil},
+			"instanceID", "clientID", false),
+		func(db *sql.DB) {
+			ctx := authz.NewMockContext("instanceID", "orgID", "userID")
+			q := &Queries{
+				client: &database.DB{
+					DB: db,
+				},
+				caches: &Caches{
+					introspectionClient: gomap.NewCache[introspectionClientIndex, string, *cachedIntrospectionClient](ctx, introspectionClientIndexValues(), cache.Config{}),
+				},
+			}
+			for range 2 {
+				got, err := q.ActiveIntrospectionClientByID(ctx, "clientID", false)
+				require.NoError(t, err)
+				assert.Equal(t, want, got)
+			}
+			// the client of another instance is not served from the cache
+			_, ok := q.caches.introspectionClient.Get(ctx, introspectionClientIndexByClientID, instanceScopedKey("otherInstance", "clientID"))
+			assert.False(t, ok)
+		},
+	)
+}
+
+func TestIntrospectionClient_copy(t *testing.T) {
+	client := &IntrospectionClient{
+		ClientID:   "clientID",
+		ProjectID:  "projectID",
+		PublicKeys: database.Map[[]byte]{"keyID": []byte("key")},
+	}
+	got := client.copy()
+	require.Equal(t, client, got)
+	require.NotSame(t, client, got)
+
+	got.ProjectID = "changed"
+	got.PublicKeys["keyID"][0] = 'K'
+	got.PublicKeys["other"] = []byte("other")
+
+	assert.Equal(t, &IntrospectionClient{
+		ClientID:   "clientID",
+		ProjectID:  "projectID",
+		PublicKeys: database.Map[[]byte]{"keyID": []byte("key")},
+	}, client)
+}
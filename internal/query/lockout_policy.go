@@ -10,6 +10,7 @@ import (
 	"github.com/zitadel/logging"
 
 	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/database"
 	"github.com/zitadel/zitadel/internal/domain"
 	"github.com/zitadel/zitadel/internal/eventstore/handler/v2"
 	"github.com/zitadel/zitadel/internal/query/projection"
@@ -29,6 +30,12 @@ type LockoutPolicy struct {
 	MaxOTPAttempts      uint64
 	ShowFailures        bool
 
+	MaxIPAttempts         uint64
+	MaxClientAttempts     uint64
+	ThrottleWindow        database.Duration
+	ThrottleDelay         database.Duration
+	ThrottleBlockDuration database.Duration
+
 	IsDefault bool
 }
 
@@ -73,6 +80,26 @@ var (
 		name:  projection.LockoutPolicyMaxOTPAttemptsCol,
 		table: lockoutTable,
 	}
+	LockoutColMaxIPAttempts = Column{
+		name:  projection.LockoutPolicyMaxIPAttemptsCol,
+		table: lockoutTable,
+	}
+	LockoutColMaxClientAttempts = Column{
+		name:  projection.LockoutPolicyMaxClientAttemptsCol,
+		table: lockoutTable,
+	}
+	LockoutColThrottleWindow = Column{
+		name:  projection.LockoutPolicyThrottleWindowCol,
+		table: lockoutTable,
+	}
+	LockoutColThrottleDelay = Column{
+		name:  projection.LockoutPolicyThrottleDelayCol,
+		table: lockoutTable,
+	}
+	LockoutColThrottleBlockDuration = Column{
+		name:  projection.LockoutPolicyThrottleBlockDurationCol,
+		table: lockoutTable,
+	}
 	LockoutColIsDefault = Column{
 		name:  projection.LockoutPolicyIsDefaultCol,
 		table: lockoutTable,
@@ -151,6 +178,11 @@ func prepareLockoutPolicyQuery() (sq.SelectBuilder, func(*sql.Row) (*LockoutPoli
 			LockoutColShowFailures.identifier(),
 			LockoutColMaxPasswordAttempts.identifier(),
 			LockoutColMaxOTPAttempts.identifier(),
+			LockoutColMaxIPAttempts.identifier(),
+			LockoutColMaxClientAttempts.identifier(),
+			LockoutColThrottleWindow.identifier(),
+			LockoutColThrottleDelay.identifier(),
+			LockoutColThrottleBlockDuration.identifier(),
 			LockoutColIsDefault.identifier(),
 			LockoutColState.identifier(),
 		).
@@ -167,6 +199,11 @@ func prepareLockoutPolicyQuery() (sq.SelectBuilder, func(*sql.Row) (*LockoutPoli
 				&policy.ShowFailures,
 				&policy.MaxPasswordAttempts,
 				&policy.MaxOTPAttempts,
+				&policy.MaxIPAttempts,
+				&policy.MaxClientAttempts,
+				&policy.ThrottleWindow,
+				&policy.ThrottleDelay,
+				&policy.ThrottleBlockDuration,
 				&policy.IsDefault,
 				&policy.State,
 			)
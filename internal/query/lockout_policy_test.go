@@ -7,23 +7,30 @@ import (
 	"fmt"
 	"regexp"
 	"testing"
+	"time"
 
+	"github.com/zitadel/zitadel/internal/database"
 	"github.com/zitadel/zitadel/internal/domain"
 	"github.com/zitadel/zitadel/internal/zerrors"
 )
 
 var (
-	prepareLockoutPolicyStmt = `SELECT projections.lockout_policies3.id,` +
-		` projections.lockout_policies3.sequence,` +
-		` projections.lockout_policies3.creation_date,` +
-		` projections.lockout_policies3.change_date,` +
-		` projections.lockout_policies3.resource_owner,` +
-		` projections.lockout_policies3.show_failure,` +
-		` projections.lockout_policies3.max_password_attempts,` +
-		` projections.lockout_policies3.max_otp_attempts,` +
-		` projections.lockout_policies3.is_default,` +
-		` projections.lockout_policies3.state` +
-		` FROM projections.lockout_policies3`
+	prepareLockoutPolicyStmt = `SELECT projections.lockout_policies4.id,` +
+		` projections.lockout_policies4.sequence,` +
+		` projections.lockout_policies4.creation_date,` +
+		` projections.lockout_policies4.change_date,` +
+		` projections.lockout_policies4.resource_owner,` +
+		` projections.lockout_policies4.show_failure,` +
+		` projections.lockout_policies4.max_password_attempts,` +
+		` projections.lockout_policies4.max_otp_attempts,` +
+		` projections.lockout_policies4.max_ip_attempts,` +
+		` projections.lockout_policies4.max_client_attempts,` +
+		` projections.lockout_policies4.throttle_window,` +
+		` projections.lockout_policies4.throttle_delay,` +
+		` projections.lockout_policies4.throttle_block_duration,` +
+		` projections.lockout_policies4.is_default,` +
+		` projections.lockout_policies4.state` +
+		` FROM projections.lockout_policies4`
 
 	prepareLockoutPolicyCols = []string{
 		"id",
@@ -34,6 +41,11 @@ var (
 		"show_failure",
 		"max_password_attempts",
 		"max_otp_attempts",
+		"max_ip_attempts",
+		"max_client_attempts",
+		"throttle_window",
+		"throttle_delay",
+		"throttle_block_duration",
 		"is_default",
 		"state",
 	}
@@ -84,22 +96,32 @@ func Test_LockoutPolicyPrepares(t *testing.T) {
 						true,
 						20,
 						20,
+						100,
+						10,
+						int64(time.Hour),
+						int64(time.Second),
+						int64(15 * time.Minute),
 						true,
 						domain.PolicyStateActive,
 					},
 				),
 			},
 			object: &LockoutPolicy{
-				ID:                  "pol-id",
-				CreationDate:        testNow,
-				ChangeDate:          testNow,
-				Sequence:            20211109,
-				ResourceOwner:       "ro",
-				State:               domain.PolicyStateActive,
-				ShowFailures:        true,
-				MaxPasswordAttempts: 20,
-				MaxOTPAttempts:      20,
-				IsDefault:           true,
+				ID:                    "pol-id",
+				CreationDate:          testNow,
+				ChangeDate:            testNow,
+				Sequence:              20211109,
+				ResourceOwner:         "ro",
+				State:                 domain.PolicyStateActive,
+				ShowFailures:          true,
+				MaxPasswordAttempts:   20,
+				MaxOTPAttempts:        20,
+				MaxIPAttempts:         100,
+				MaxClientAttempts:     10,
+				ThrottleWindow:        database.Duration(time.Hour),
+				ThrottleDelay:         database.Duration(time.Second),
+				ThrottleBlockDuration: database.Duration(15 * time.Minute),
+				IsDefault:             true,
 			},
 		},
 		{
@@ -43,6 +43,10 @@ type LoginPolicy struct {
 	MFAInitSkipLifetime        database.Duration
 	SecondFactorCheckLifetime  database.Duration
 	MultiFactorCheckLifetime   database.Duration
+	ForceMFAOnHighRisk         bool
+	TrustedDeviceLifetime      database.Duration
+	AllowAccountRecovery       bool
+	AllowMagicLink             bool
 	IDPLinks                   []*IDPLoginPolicyLink
 }
 
@@ -161,6 +165,22 @@ var (
 		name:  projection.MultiFactorCheckLifetimeCol,
 		table: loginPolicyTable,
 	}
+	LoginPolicyColumnForceMFAOnHighRisk = Column{
+		name:  projection.ForceMFAOnHighRiskCol,
+		table: loginPolicyTable,
+	}
+	LoginPolicyColumnTrustedDeviceLifetime = Column{
+		name:  projection.TrustedDeviceLifetimeCol,
+		table: loginPolicyTable,
+	}
+	LoginPolicyColumnAllowAccountRecovery = Column{
+		name:  projection.AllowAccountRecoveryCol,
+		table: loginPolicyTable,
+	}
+	LoginPolicyColumnAllowMagicLink = Column{
+		name:  projection.AllowMagicLinkCol,
+		table: loginPolicyTable,
+	}
 	LoginPolicyColumnOwnerRemoved = Column{
 		name:  projection.LoginPolicyOwnerRemovedCol,
 		table: loginPolicyTable,
@@ -383,6 +403,10 @@ func prepareLoginPolicyQuery() (sq.SelectBuilder, func(*sql.Rows) (*LoginPolicy,
 			LoginPolicyColumnMFAInitSkipLifetime.identifier(),
 			LoginPolicyColumnSecondFactorCheckLifetime.identifier(),
 			LoginPolicyColumnMultiFactorCheckLifetime.identifier(),
+			LoginPolicyColumnForceMFAOnHighRisk.identifier(),
+			LoginPolicyColumnTrustedDeviceLifetime.identifier(),
+			LoginPolicyColumnAllowAccountRecovery.identifier(),
+			LoginPolicyColumnAllowMagicLink.identifier(),
 		).From(loginPolicyTable.identifier()).
 			PlaceholderFormat(sq.Dollar),
 		func(rows *sql.Rows) (*LoginPolicy, error) {
@@ -414,6 +438,10 @@ func prepareLoginPolicyQuery() (sq.SelectBuilder, func(*sql.Rows) (*LoginPolicy,
 					&p.MFAInitSkipLifetime,
 					&p.SecondFactorCheckLifetime,
 					&p.MultiFactorCheckLifetime,
+					&p.ForceMFAOnHighRisk,
+					&p.TrustedDeviceLifetime,
+					&p.AllowAccountRecovery,
+					&p.AllowMagicLink,
 				)
 				if err != nil {
 					return nil, zerrors.ThrowInternal(err, "QUERY-YcC53", "Errors.Internal")
@@ -15,31 +15,35 @@ import (
 )
 
 var (
-	loginPolicyQuery = `SELECT projections.login_policies5.aggregate_id,` +
-		` projections.login_policies5.creation_date,` +
-		` projections.login_policies5.change_date,` +
-		` projections.login_policies5.sequence,` +
-		` projections.login_policies5.allow_register,` +
-		` projections.login_policies5.allow_username_password,` +
-		` projections.login_policies5.allow_external_idps,` +
-		` projections.login_policies5.force_mfa,` +
-		` projections.login_policies5.force_mfa_local_only,` +
-		` projections.login_policies5.second_factors,` +
-		` projections.login_policies5.multi_factors,` +
-		` projections.login_policies5.passwordless_type,` +
-		` projections.login_policies5.is_default,` +
-		` projections.login_policies5.hide_password_reset,` +
-		` projections.login_policies5.ignore_unknown_usernames,` +
-		` projections.login_policies5.allow_domain_discovery,` +
-		` projections.login_policies5.disable_login_with_email,` +
-		` projections.login_policies5.disable_login_with_phone,` +
-		` projections.login_policies5.default_redirect_uri,` +
-		` projections.login_policies5.password_check_lifetime,` +
-		` projections.login_policies5.external_login_check_lifetime,` +
-		` projections.login_policies5.mfa_init_skip_lifetime,` +
-		` projections.login_policies5.second_factor_check_lifetime,` +
-		` projections.login_policies5.multi_factor_check_lifetime` +
-		` FROM projections.login_policies5`
+	loginPolicyQuery = `SELECT projections.login_policies9.aggregate_id,` +
+		` projections.login_policies9.creation_date,` +
+		` projections.login_policies9.change_date,` +
+		` projections.login_policies9.sequence,` +
+		` projections.login_policies9.allow_register,` +
+		` projections.login_policies9.allow_username_password,` +
+		` projections.login_policies9.allow_external_idps,` +
+		` projections.login_policies9.force_mfa,` +
+		` projections.login_policies9.force_mfa_local_only,` +
+		` projections.login_policies9.second_factors,` +
+		` projections.login_policies9.multi_factors,` +
+		` projections.login_policies9.passwordless_type,` +
+		` projections.login_policies9.is_default,` +
+		` projections.login_policies9.hide_password_reset,` +
+		` projections.login_policies9.ignore_unknown_usernames,` +
+		` projections.login_policies9.allow_domain_discovery,` +
+		` projections.login_policies9.disable_login_with_email,` +
+		` projections.login_policies9.disable_login_with_phone,` +
+		` projections.login_policies9.default_redirect_uri,` +
+		` projections.login_policies9.password_check_lifetime,` +
+		` projections.login_policies9.external_login_check_lifetime,` +
+		` projections.login_policies9.mfa_init_skip_lifetime,` +
+		` projections.login_policies9.second_factor_check_lifetime,` +
+		` projections.login_policies9.multi_factor_check_lifetime,` +
+		` projections.login_policies9.force_mfa_on_high_risk,` +
+		` projections.login_policies9.trusted_device_lifetime,` +
+		` projections.login_policies9.allow_account_recovery,` +
+		` projections.login_policies9.allow_magic_link` +
+		` FROM projections.login_policies9`
 	loginPolicyCols = []string{
 		"aggregate_id",
 		"creation_date",
@@ -65,16 +69,20 @@ var (
 		"mfa_init_skip_lifetime",
 		"second_factor_check_lifetime",
 		"multi_factor_check_lifetime",
+		"force_mfa_on_high_risk",
+		"trusted_device_lifetime",
+		"allow_account_recovery",
+		"allow_magic_link",
 	}
 
-	prepareLoginPolicy2FAsStmt = `SELECT projections.login_policies5.second_factors` +
-		` FROM projections.login_policies5`
+	prepareLoginPolicy2FAsStmt = `SELECT projections.login_policies9.second_factors` +
+		` FROM projections.login_policies9`
 	prepareLoginPolicy2FAsCols = []string{
 		"second_factors",
 	}
 
-	prepareLoginPolicyMFAsStmt = `SELECT projections.login_policies5.multi_factors` +
-		` FROM projections.login_policies5`
+	prepareLoginPolicyMFAsStmt = `SELECT projections.login_policies9.multi_factors` +
+		` FROM projections.login_policies9`
 	prepareLoginPolicyMFAsCols = []string{
 		"multi_factors",
 	}
@@ -142,6 +150,10 @@ func Test_LoginPolicyPrepares(t *testing.T) {
 						&duration,
 						&duration,
 						&duration,
+						true,
+						&duration,
+						true,
+						true,
 					},
 				),
 			},
@@ -170,6 +182,10 @@ func Test_LoginPolicyPrepares(t *testing.T) {
 				MFAInitSkipLifetime:        database.Duration(duration),
 				SecondFactorCheckLifetime:  database.Duration(duration),
 				MultiFactorCheckLifetime:   database.Duration(duration),
+				ForceMFAOnHighRisk:         true,
+				TrustedDeviceLifetime:      database.Duration(duration),
+				AllowAccountRecovery:       true,
+				AllowMagicLink:             true,
 			},
 		},
 		{
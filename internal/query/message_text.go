@@ -33,6 +33,7 @@ type MessageTexts struct {
 	PasswordlessRegistration MessageText
 	PasswordChange           MessageText
 	InviteUser               MessageText
+	MagicLink                MessageText
 }
 
 type MessageText struct {
@@ -348,6 +349,8 @@ func (m *MessageTexts) GetMessageTextByType(msgType string) *MessageText {
 		return &m.PasswordChange
 	case domain.InviteUserMessageType:
 		return &m.InviteUser
+	case domain.MagicLinkMessageType:
+		return &m.MagicLink
 	}
 	return nil
 }
@@ -0,0 +1,259 @@
+package query
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	sq "github.com/Masterminds/squirrel"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/query/projection"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+var (
+	notificationDeliveryTable = table{
+		name:          projection.NotificationDeliveryTable,
+		instanceIDCol: projection.NotificationDeliveryInstanceIDCol,
+	}
+	NotificationDeliveryColumnID = Column{
+		name:  projection.NotificationDeliveryIDCol,
+		table: notificationDeliveryTable,
+	}
+	NotificationDeliveryColumnCreationDate = Column{
+		name:  projection.NotificationDeliveryCreationDateCol,
+		table: notificationDeliveryTable,
+	}
+	NotificationDeliveryColumnChangeDate = Column{
+		name:  projection.NotificationDeliveryChangeDateCol,
+		table: notificationDeliveryTable,
+	}
+	NotificationDeliveryColumnResourceOwner = Column{
+		name:  projection.NotificationDeliveryResourceOwnerCol,
+		table: notificationDeliveryTable,
+	}
+	NotificationDeliveryColumnInstanceID = Column{
+		name:  projection.NotificationDeliveryInstanceIDCol,
+		table: notificationDeliveryTable,
+	}
+	NotificationDeliveryColumnSequence = Column{
+		name:  projection.NotificationDeliverySequenceCol,
+		table: notificationDeliveryTable,
+	}
+	NotificationDeliveryColumnUserID = Column{
+		name:  projection.NotificationDeliveryUserIDCol,
+		table: notificationDeliveryTable,
+	}
+	NotificationDeliveryColumnTriggerEventType = Column{
+		name:  projection.NotificationDeliveryTriggerEventTypeCol,
+		table: notificationDeliveryTable,
+	}
+	NotificationDeliveryColumnMessageType = Column{
+		name:  projection.NotificationDeliveryMessageTypeCol,
+		table: notificationDeliveryTable,
+	}
+	NotificationDeliveryColumnChannel = Column{
+		name:  projection.NotificationDeliveryChannelCol,
+		table: notificationDeliveryTable,
+	}
+	NotificationDeliveryColumnRecipient = Column{
+		name:  projection.NotificationDeliveryRecipientCol,
+		table: notificationDeliveryTable,
+	}
+	NotificationDeliveryColumnProviderID = Column{
+		name:  projection.NotificationDeliveryProviderIDCol,
+		table: notificationDeliveryTable,
+	}
+	NotificationDeliveryColumnAttempts = Column{
+		name:  projection.NotificationDeliveryAttemptsCol,
+		table: notificationDeliveryTable,
+	}
+	NotificationDeliveryColumnState = Column{
+		name:  projection.NotificationDeliveryStateCol,
+		table: notificationDeliveryTable,
+	}
+	NotificationDeliveryColumnLastError = Column{
+		name:  projection.NotificationDeliveryLastErrorCol,
+		table: notificationDeliveryTable,
+	}
+)
+
+// NotificationDelivery is the state of the delivery of a notification sent to a user.
+type NotificationDelivery struct {
+	domain.ObjectDetails
+
+	UserID           string
+	TriggerEventType eventstore.EventType
+	MessageType      string
+	Channel          domain.NotificationType
+	Recipient        string
+	ProviderID       string
+	Attempts         uint64
+	State            domain.NotificationDeliveryState
+	LastError        string
+}
+
+type NotificationDeliveries struct {
+	SearchResponse
+	Deliveries []*NotificationDelivery
+}
+
+func (d *NotificationDeliveries) SetState(s *State) {
+	d.State = s
+}
+
+type NotificationDeliverySearchQueries struct {
+	SearchRequest
+	Queries []SearchQuery
+}
+
+func (q *NotificationDeliverySearchQueries) toQuery(query sq.SelectBuilder) sq.SelectBuilder {
+	query = q.SearchRequest.toQuery(query)
+	for _, q := range q.Queries {
+		query = q.toQuery(query)
+	}
+	return query
+}
+
+// NotificationDeliveryByID returns the delivery state of the notification of the instance.
+func (q *Queries) NotificationDeliveryByID(ctx context.Context, id string) (_ *NotificationDelivery, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	eq := sq.Eq{
+		NotificationDeliveryColumnID.identifier():         id,
+		NotificationDeliveryColumnInstanceID.identifier(): authz.GetInstance(ctx).InstanceID(),
+	}
+	query, scan := prepareNotificationDeliveryQuery()
+	return genericRowQuery(ctx, q.client, query.Where(eq), scan)
+}
+
+// SearchNotificationDeliveries returns the delivery states of the notifications of the instance.
+func (q *Queries) SearchNotificationDeliveries(ctx context.Context, queries *NotificationDeliverySearchQueries) (_ *NotificationDeliveries, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	eq := sq.Eq{
+		NotificationDeliveryColumnInstanceID.identifier(): authz.GetInstance(ctx).InstanceID(),
+	}
+	query, scan := prepareNotificationDeliveriesQuery()
+	return genericRowsQueryWithState(ctx, q.client, notificationDeliveryTable, combineToWhereStmt(query, queries.toQuery, eq), scan)
+}
+
+func NewNotificationDeliveryUserIDSearchQuery(userID string) (SearchQuery, error) {
+	return NewTextQuery(NotificationDeliveryColumnUserID, userID, TextEquals)
+}
+
+func NewNotificationDeliveryRecipientSearchQuery(recipient string, method TextComparison) (SearchQuery, error) {
+	return NewTextQuery(NotificationDeliveryColumnRecipient, recipient, method)
+}
+
+func NewNotificationDeliveryMessageTypeSearchQuery(messageType string) (SearchQuery, error) {
+	return NewTextQuery(NotificationDeliveryColumnMessageType, messageType, TextEquals)
+}
+
+func NewNotificationDeliveryChannelSearchQuery(channel domain.NotificationType) (SearchQuery, error) {
+	return NewNumberQuery(NotificationDeliveryColumnChannel, channel, NumberEquals)
+}
+
+func NewNotificationDeliveryStateSearchQuery(state domain.NotificationDeliveryState) (SearchQuery, error) {
+	return NewNumberQuery(NotificationDeliveryColumnState, state, NumberEquals)
+}
+
+func prepareNotificationDeliveryColumns() []string {
+	return []string{
+		NotificationDeliveryColumnID.identifier(),
+		NotificationDeliveryColumnCreationDate.identifier(),
+		NotificationDeliveryColumnChangeDate.identifier(),
+		NotificationDeliveryColumnResourceOwner.identifier(),
+		NotificationDeliveryColumnSequence.identifier(),
+		NotificationDeliveryColumnUserID.identifier(),
+		NotificationDeliveryColumnTriggerEventType.identifier(),
+		NotificationDeliveryColumnMessageType.identifier(),
+		NotificationDeliveryColumnChannel.identifier(),
+		NotificationDeliveryColumnRecipient.identifier(),
+		NotificationDeliveryColumnProviderID.identifier(),
+		NotificationDeliveryColumnAttempts.identifier(),
+		NotificationDeliveryColumnState.identifier(),
+		NotificationDeliveryColumnLastError.identifier(),
+	}
+}
+
+func scanNotificationDelivery(scan func(dest ...any) error) (*NotificationDelivery, error) {
+	var (
+		delivery   = new(NotificationDelivery)
+		recipient  sql.NullString
+		providerID sql.NullString
+		lastError  sql.NullString
+	)
+	err := scan(
+		&delivery.ID,
+		&delivery.CreationDate,
+		&delivery.EventDate,
+		&delivery.ResourceOwner,
+		&delivery.Sequence,
+		&delivery.UserID,
+		&delivery.TriggerEventType,
+		&delivery.MessageType,
+		&delivery.Channel,
+		&recipient,
+		&providerID,
+		&delivery.Attempts,
+		&delivery.State,
+		&lastError,
+	)
+	delivery.Recipient = recipient.String
+	delivery.ProviderID = providerID.String
+	delivery.LastError = lastError.String
+	return delivery, err
+}
+
+func prepareNotificationDeliveryQuery() (sq.SelectBuilder, func(row *sql.Row) (*NotificationDelivery, error)) {
+	return sq.Select(prepareNotificationDeliveryColumns()...).
+			From(notificationDeliveryTable.identifier()).
+			PlaceholderFormat(sq.Dollar),
+		func(row *sql.Row) (*NotificationDelivery, error) {
+			delivery, err := scanNotificationDelivery(row.Scan)
+			if err != nil {
+				if errors.Is(err, sql.ErrNoRows) {
+					return nil, zerrors.ThrowNotFound(err, "QUERY-Ndl1q", "Errors.Notification.Delivery.NotFound")
+				}
+				return nil, zerrors.ThrowInternal(err, "QUERY-Ndl2q", "Errors.Internal")
+			}
+			return delivery, nil
+		}
+}
+
+func prepareNotificationDeliveriesQuery() (sq.SelectBuilder, func(rows *sql.Rows) (*NotificationDeliveries, error)) {
+	return sq.Select(append(prepareNotificationDeliveryColumns(), countColumn.identifier())...).
+			From(notificationDeliveryTable.identifier()).
+			PlaceholderFormat(sq.Dollar),
+		func(rows *sql.Rows) (*NotificationDeliveries, error) {
+			deliveries := make([]*NotificationDelivery, 0)
+			var count uint64
+			for rows.Next() {
+				delivery, err := scanNotificationDelivery(func(dest ...any) error {
+					return rows.Scan(append(dest, &count)...)
+				})
+				if err != nil {
+					return nil, err
+				}
+				deliveries = append(deliveries, delivery)
+			}
+
+			if err := rows.Close(); err != nil {
+				return nil, zerrors.ThrowInternal(err, "QUERY-Ndl3q", "Errors.Query.CloseRows")
+			}
+
+			return &NotificationDeliveries{
+				Deliveries: deliveries,
+				SearchResponse: SearchResponse{
+					Count: count,
+				},
+			}, nil
+		}
+}
@@ -0,0 +1,284 @@
+package query
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+var (
+	expectedNotificationDeliveryColumns = `SELECT projections.notification_deliveries.id,` +
+		` projections.notification_deliveries.creation_date,` +
+		` projections.notification_deliveries.change_date,` +
+		` projections.notification_deliveries.resource_owner,` +
+		` projections.notification_deliveries.sequence,` +
+		` projections.notification_deliveries.user_id,` +
+		` projections.notification_deliveries.trigger_event_type,` +
+		` projections.notification_deliveries.message_type,` +
+		` projections.notification_deliveries.channel,` +
+		` projections.notification_deliveries.recipient,` +
+		` projections.notification_deliveries.provider_id,` +
+		` projections.notification_deliveries.attempts,` +
+		` projections.notification_deliveries.state,` +
+		` projections.notification_deliveries.last_error`
+	expectedNotificationDeliveryQuery = regexp.QuoteMeta(expectedNotificationDeliveryColumns +
+		` FROM projections.notification_deliveries`)
+	expectedNotificationDeliveriesQuery = regexp.QuoteMeta(expectedNotificationDeliveryColumns +
+		`, COUNT(*) OVER ()` +
+		` FROM projections.notification_deliveries`)
+
+	notificationDeliveryCols = []string{
+		"id",
+		"creation_date",
+		"change_date",
+		"resource_owner",
+		"sequence",
+		"user_id",
+		"trigger_event_type",
+		"message_type",
+		"channel",
+		"recipient",
+		"provider_id",
+		"attempts",
+		"state",
+		"last_error",
+	}
+	notificationDeliveriesCols = append(notificationDeliveryCols, "count")
+)
+
+func Test_NotificationDeliveriesPrepare(t *testing.T) {
+	type want struct {
+		sqlExpectations sqlExpectation
+		err             checkErr
+	}
+	tests := []struct {
+		name    string
+		prepare interface{}
+		want    want
+		object  interface{}
+	}{
+		{
+			name:    "prepareNotificationDeliveriesQuery no result",
+			prepare: prepareNotificationDeliveriesQuery,
+			want: want{
+				sqlExpectations: mockQueries(
+					expectedNotificationDeliveriesQuery,
+					nil,
+					nil,
+				),
+			},
+			object: &NotificationDeliveries{Deliveries: []*NotificationDelivery{}},
+		},
+		{
+			name:    "prepareNotificationDeliveriesQuery multiple result",
+			prepare: prepareNotificationDeliveriesQuery,
+			want: want{
+				sqlExpectations: mockQueries(
+					expectedNotificationDeliveriesQuery,
+					notificationDeliveriesCols,
+					[][]driver.Value{
+						{
+							"id-1",
+							testNow,
+							testNow,
+							"ro",
+							uint64(20211109),
+							"user-id",
+							"user.human.initialization.code.added",
+							"InitCode",
+							domain.NotificationTypeEmail,
+							"email@example.com",
+							"smtp-id",
+							uint64(1),
+							domain.NotificationDeliveryStateSucceeded,
+							nil,
+						},
+						{
+							"id-2",
+							testNow,
+							testNow,
+							"ro",
+							uint64(20211110),
+							"user-id",
+							"user.human.phone.code.added",
+							"VerifyPhoneOTP",
+							domain.NotificationTypeSms,
+							"+41791234567",
+							nil,
+							uint64(3),
+							domain.NotificationDeliveryStateFailed,
+							"provider unavailable",
+						},
+					},
+				),
+			},
+			object: &NotificationDeliveries{
+				SearchResponse: SearchResponse{
+					Count: 2,
+				},
+				Deliveries: []*NotificationDelivery{
+					{
+						ObjectDetails: domain.ObjectDetails{
+							ID:            "id-1",
+							CreationDate:  testNow,
+							EventDate:     testNow,
+							ResourceOwner: "ro",
+							Sequence:      20211109,
+						},
+						UserID:           "user-id",
+						TriggerEventType: "user.human.initialization.code.added",
+						MessageType:      "InitCode",
+						Channel:          domain.NotificationTypeEmail,
+						Recipient:        "email@example.com",
+						ProviderID:       "smtp-id",
+						Attempts:         1,
+						State:            domain.NotificationDeliveryStateSucceeded,
+					},
+					{
+						ObjectDetails: domain.ObjectDetails{
+							ID:            "id-2",
+							CreationDate:  testNow,
+							EventDate:     testNow,
+							ResourceOwner: "ro",
+							Sequence:      20211110,
+						},
+						UserID:           "user-id",
+						TriggerEventType: "user.human.phone.code.added",
+						MessageType:      "VerifyPhoneOTP",
+						Channel:          domain.NotificationTypeSms,
+						Recipient:        "+41791234567",
+						Attempts:         3,
+						State:            domain.NotificationDeliveryStateFailed,
+						LastError:        "provider unavailable",
+					},
+				},
+			},
+		},
+		{
+			name:    "prepareNotificationDeliveriesQuery sql err",
+			prepare: prepareNotificationDeliveriesQuery,
+			want: want{
+				sqlExpectations: mockQueryErr(
+					expectedNotificationDeliveriesQuery,
+					sql.ErrConnDone,
+				),
+				err: func(err error) (error, bool) {
+					if !errors.Is(err, sql.ErrConnDone) {
+						return fmt.Errorf("err should be sql.ErrConnDone got: %w", err), false
+					}
+					return nil, true
+				},
+			},
+			object: (*NotificationDeliveries)(nil),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assertPrepare(t, tt.prepare, tt.object, tt.want.sqlExpectations, tt.want.err)
+		})
+	}
+}
+
+func Test_NotificationDeliveryPrepare(t *testing.T) {
+	type want struct {
+		sqlExpectations sqlExpectation
+		err             checkErr
+	}
+	tests := []struct {
+		name    string
+		prepare interface{}
+		want    want
+		object  interface{}
+	}{
+		{
+			name:    "prepareNotificationDeliveryQuery no result",
+			prepare: prepareNotificationDeliveryQuery,
+			want: want{
+				sqlExpectations: mockQueriesScanErr(
+					expectedNotificationDeliveryQuery,
+					nil,
+					nil,
+				),
+				err: func(err error) (error, bool) {
+					if !zerrors.IsNotFound(err) {
+						return fmt.Errorf("err should be zitadel.NotFoundError got: %w", err), false
+					}
+					return nil, true
+				},
+			},
+			object: (*NotificationDelivery)(nil),
+		},
+		{
+			name:    "prepareNotificationDeliveryQuery found",
+			prepare: prepareNotificationDeliveryQuery,
+			want: want{
+				sqlExpectations: mockQuery(
+					expectedNotificationDeliveryQuery,
+					notificationDeliveryCols,
+					[]driver.Value{
+						"id",
+						testNow,
+						testNow,
+						"ro",
+						uint64(20211109),
+						"user-id",
+						"user.human.initialization.code.added",
+						"InitCode",
+						domain.NotificationTypeEmail,
+						"email@example.com",
+						"smtp-id",
+						uint64(2),
+						domain.NotificationDeliveryStateRetrying,
+						"connection refused",
+					},
+				),
+			},
+			object: &NotificationDelivery{
+				ObjectDetails: domain.ObjectDetails{
+					ID:            "id",
+					CreationDate:  testNow,
+					EventDate:     testNow,
+					ResourceOwner: "ro",
+					Sequence:      20211109,
+				},
+				UserID:           "user-id",
+				TriggerEventType: "user.human.initialization.code.added",
+				MessageType:      "InitCode",
+				Channel:          domain.NotificationTypeEmail,
+				Recipient:        "email@example.com",
+				ProviderID:       "smtp-id",
+				Attempts:         2,
+				State:            domain.NotificationDeliveryStateRetrying,
+				LastError:        "connection refused",
+			},
+		},
+		{
+			name:    "prepareNotificationDeliveryQuery sql err",
+			prepare: prepareNotificationDeliveryQuery,
+			want: want{
+				sqlExpectations: mockQueryErr(
+					expectedNotificationDeliveryQuery,
+					sql.ErrConnDone,
+				),
+				err: func(err error) (error, bool) {
+					if !errors.Is(err, sql.ErrConnDone) {
+						return fmt.Errorf("err should be sql.ErrConnDone got: %w", err), false
+					}
+					return nil, true
+				},
+			},
+			object: (*NotificationDelivery)(nil),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assertPrepare(t, tt.prepare, tt.object, tt.want.sqlExpectations, tt.want.err)
+		})
+	}
+}
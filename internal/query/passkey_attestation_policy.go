@@ -0,0 +1,213 @@
+package query
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore/handler/v2"
+	"github.com/zitadel/zitadel/internal/query/projection"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+type PasskeyAttestationPolicy struct {
+	ID            string
+	Sequence      uint64
+	CreationDate  time.Time
+	ChangeDate    time.Time
+	ResourceOwner string
+
+	Conveyance       domain.AttestationConveyance
+	RootCertificates database.TextArray[string]
+	UseMetadata      bool
+	AllowedAAGUIDs   database.TextArray[string]
+	DeniedAAGUIDs    database.TextArray[string]
+
+	IsDefault bool
+}
+
+var (
+	passkeyAttestationPolicyTable = table{
+		name:          projection.PasskeyAttestationPolicyTable,
+		instanceIDCol: projection.PasskeyAttestationPolicyInstanceIDCol,
+	}
+	PasskeyAttestationPolicyColID = Column{
+		name:  projection.PasskeyAttestationPolicyIDCol,
+		table: passkeyAttestationPolicyTable,
+	}
+	PasskeyAttestationPolicyColSequence = Column{
+		name:  projection.PasskeyAttestationPolicySequenceCol,
+		table: passkeyAttestationPolicyTable,
+	}
+	PasskeyAttestationPolicyColCreationDate = Column{
+		name:  projection.PasskeyAttestationPolicyCreationDateCol,
+		table: passkeyAttestationPolicyTable,
+	}
+	PasskeyAttestationPolicyColChangeDate = Column{
+		name:  projection.PasskeyAttestationPolicyChangeDateCol,
+		table: passkeyAttestationPolicyTable,
+	}
+	PasskeyAttestationPolicyColResourceOwner = Column{
+		name:  projection.PasskeyAttestationPolicyResourceOwnerCol,
+		table: passkeyAttestationPolicyTable,
+	}
+	PasskeyAttestationPolicyColInstanceID = Column{
+		name:  projection.PasskeyAttestationPolicyInstanceIDCol,
+		table: passkeyAttestationPolicyTable,
+	}
+	PasskeyAttestationPolicyColConveyance = Column{
+		name:  projection.PasskeyAttestationPolicyConveyanceCol,
+		table: passkeyAttestationPolicyTable,
+	}
+	PasskeyAttestationPolicyColRootCertificates = Column{
+		name:  projection.PasskeyAttestationPolicyRootCertificatesCol,
+		table: passkeyAttestationPolicyTable,
+	}
+	PasskeyAttestationPolicyColUseMetadata = Column{
+		name:  projection.PasskeyAttestationPolicyUseMetadataCol,
+		table: passkeyAttestationPolicyTable,
+	}
+	PasskeyAttestationPolicyColAllowedAAGUIDs = Column{
+		name:  projection.PasskeyAttestationPolicyAllowedAAGUIDsCol,
+		table: passkeyAttestationPolicyTable,
+	}
+	PasskeyAttestationPolicyColDeniedAAGUIDs = Column{
+		name:  projection.PasskeyAttestationPolicyDeniedAAGUIDsCol,
+		table: passkeyAttestationPolicyTable,
+	}
+	PasskeyAttestationPolicyColIsDefault = Column{
+		name:  projection.PasskeyAttestationPolicyIsDefaultCol,
+		table: passkeyAttestationPolicyTable,
+	}
+)
+
+// PasskeyAttestationPolicyByOrg returns the policy of the organization, the default policy of the instance
+// or an empty default policy (which does not restrict any authenticator) if neither is set.
+func (q *Queries) PasskeyAttestationPolicyByOrg(ctx context.Context, shouldTriggerBulk bool, orgID string) (policy *PasskeyAttestationPolicy, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if shouldTriggerBulk {
+		_, traceSpan := tracing.NewNamedSpan(ctx, "TriggerPasskeyAttestationPolicyProjection")
+		ctx, err = projection.PasskeyAttestationPolicyProjection.Trigger(ctx, handler.WithAwaitRunning())
+		traceSpan.EndWithError(err)
+		if err != nil {
+			return nil, err
+		}
+	}
+	instanceID := authz.GetInstance(ctx).InstanceID()
+	stmt, scan := preparePasskeyAttestationPolicyQuery()
+	query, args, err := stmt.Where(
+		sq.And{
+			sq.Eq{PasskeyAttestationPolicyColInstanceID.identifier(): instanceID},
+			sq.Or{
+				sq.Eq{PasskeyAttestationPolicyColID.identifier(): orgID},
+				sq.Eq{PasskeyAttestationPolicyColID.identifier(): instanceID},
+			},
+		}).
+		OrderBy(PasskeyAttestationPolicyColIsDefault.identifier()).Limit(1).ToSql()
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "QUERY-Pa1Qo", "Errors.Query.SQLStatement")
+	}
+
+	err = q.client.QueryRowContext(ctx, func(row *sql.Row) error {
+		policy, err = scan(row)
+		return err
+	}, query, args...)
+	if zerrors.IsNotFound(err) {
+		return emptyPasskeyAttestationPolicy(instanceID), nil
+	}
+	return policy, err
+}
+
+// DefaultPasskeyAttestationPolicy returns the default policy of the instance
+// or an empty one (which does not restrict any authenticator) if it is not set.
+func (q *Queries) DefaultPasskeyAttestationPolicy(ctx context.Context, shouldTriggerBulk bool) (policy *PasskeyAttestationPolicy, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if shouldTriggerBulk {
+		_, traceSpan := tracing.NewNamedSpan(ctx, "TriggerPasskeyAttestationPolicyProjection")
+		ctx, err = projection.PasskeyAttestationPolicyProjection.Trigger(ctx, handler.WithAwaitRunning())
+		traceSpan.EndWithError(err)
+		if err != nil {
+			return nil, err
+		}
+	}
+	instanceID := authz.GetInstance(ctx).InstanceID()
+	stmt, scan := preparePasskeyAttestationPolicyQuery()
+	query, args, err := stmt.Where(sq.Eq{
+		PasskeyAttestationPolicyColID.identifier():         instanceID,
+		PasskeyAttestationPolicyColInstanceID.identifier(): instanceID,
+	}).
+		OrderBy(PasskeyAttestationPolicyColIsDefault.identifier()).
+		Limit(1).ToSql()
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "QUERY-Pa2Qd", "Errors.Query.SQLStatement")
+	}
+
+	err = q.client.QueryRowContext(ctx, func(row *sql.Row) error {
+		policy, err = scan(row)
+		return err
+	}, query, args...)
+	if zerrors.IsNotFound(err) {
+		return emptyPasskeyAttestationPolicy(instanceID), nil
+	}
+	return policy, err
+}
+
+func emptyPasskeyAttestationPolicy(instanceID string) *PasskeyAttestationPolicy {
+	return &PasskeyAttestationPolicy{
+		ID:            instanceID,
+		ResourceOwner: instanceID,
+		IsDefault:     true,
+	}
+}
+
+func preparePasskeyAttestationPolicyQuery() (sq.SelectBuilder, func(*sql.Row) (*PasskeyAttestationPolicy, error)) {
+	return sq.Select(
+			PasskeyAttestationPolicyColID.identifier(),
+			PasskeyAttestationPolicyColSequence.identifier(),
+			PasskeyAttestationPolicyColCreationDate.identifier(),
+			PasskeyAttestationPolicyColChangeDate.identifier(),
+			PasskeyAttestationPolicyColResourceOwner.identifier(),
+			PasskeyAttestationPolicyColConveyance.identifier(),
+			PasskeyAttestationPolicyColRootCertificates.identifier(),
+			PasskeyAttestationPolicyColUseMetadata.identifier(),
+			PasskeyAttestationPolicyColAllowedAAGUIDs.identifier(),
+			PasskeyAttestationPolicyColDeniedAAGUIDs.identifier(),
+			PasskeyAttestationPolicyColIsDefault.identifier(),
+		).
+			From(passkeyAttestationPolicyTable.identifier()).
+			PlaceholderFormat(sq.Dollar),
+		func(row *sql.Row) (*PasskeyAttestationPolicy, error) {
+			policy := new(PasskeyAttestationPolicy)
+			err := row.Scan(
+				&policy.ID,
+				&policy.Sequence,
+				&policy.CreationDate,
+				&policy.ChangeDate,
+				&policy.ResourceOwner,
+				&policy.Conveyance,
+				&policy.RootCertificates,
+				&policy.UseMetadata,
+				&policy.AllowedAAGUIDs,
+				&policy.DeniedAAGUIDs,
+				&policy.IsDefault,
+			)
+			if err != nil {
+				if errors.Is(err, sql.ErrNoRows) {
+					return nil, zerrors.ThrowNotFound(err, "QUERY-Pa3Qn", "Errors.Org.PasskeyAttestationPolicy.NotFound")
+				}
+				return nil, zerrors.ThrowInternal(err, "QUERY-Pa4Qi", "Errors.Internal")
+			}
+			return policy, nil
+		}
+}
@@ -0,0 +1,132 @@
+package query
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+var (
+	passkeyAttestationPolicyStmt = regexp.QuoteMeta(`SELECT projections.passkey_attestation_policies.id,` +
+		` projections.passkey_attestation_policies.sequence,` +
+		` projections.passkey_attestation_policies.creation_date,` +
+		` projections.passkey_attestation_policies.change_date,` +
+		` projections.passkey_attestation_policies.resource_owner,` +
+		` projections.passkey_attestation_policies.conveyance,` +
+		` projections.passkey_attestation_policies.root_certificates,` +
+		` projections.passkey_attestation_policies.use_metadata,` +
+		` projections.passkey_attestation_policies.allowed_aaguids,` +
+		` projections.passkey_attestation_policies.denied_aaguids,` +
+		` projections.passkey_attestation_policies.is_default` +
+		` FROM projections.passkey_attestation_policies`)
+	passkeyAttestationPolicyCols = []string{
+		"id",
+		"sequence",
+		"creation_date",
+		"change_date",
+		"resource_owner",
+		"conveyance",
+		"root_certificates",
+		"use_metadata",
+		"allowed_aaguids",
+		"denied_aaguids",
+		"is_default",
+	}
+)
+
+func Test_PasskeyAttestationPolicyPrepares(t *testing.T) {
+	type want struct {
+		sqlExpectations sqlExpectation
+		err             checkErr
+	}
+	tests := []struct {
+		name    string
+		prepare interface{}
+		want    want
+		object  interface{}
+	}{
+		{
+			name:    "preparePasskeyAttestationPolicyQuery no result",
+			prepare: preparePasskeyAttestationPolicyQuery,
+			want: want{
+				sqlExpectations: mockQueriesScanErr(
+					passkeyAttestationPolicyStmt,
+					nil,
+					nil,
+				),
+				err: func(err error) (error, bool) {
+					if !zerrors.IsNotFound(err) {
+						return fmt.Errorf("err should be NotFoundError got: %w", err), false
+					}
+					return nil, true
+				},
+			},
+			object: (*PasskeyAttestationPolicy)(nil),
+		},
+		{
+			name:    "preparePasskeyAttestationPolicyQuery found",
+			prepare: preparePasskeyAttestationPolicyQuery,
+			want: want{
+				sqlExpectations: mockQuery(
+					passkeyAttestationPolicyStmt,
+					passkeyAttestationPolicyCols,
+					[]driver.Value{
+						"pol-id",
+						uint64(20211109),
+						testNow,
+						testNow,
+						"ro",
+						domain.AttestationConveyanceDirect,
+						database.TextArray[string]{"cert"},
+						true,
+						database.TextArray[string]{"cb69481e-8ff7-4039-93ec-0a2729a154a8"},
+						database.TextArray[string]{},
+						false,
+					},
+				),
+			},
+			object: &PasskeyAttestationPolicy{
+				ID:               "pol-id",
+				CreationDate:     testNow,
+				ChangeDate:       testNow,
+				Sequence:         20211109,
+				ResourceOwner:    "ro",
+				Conveyance:       domain.AttestationConveyanceDirect,
+				RootCertificates: database.TextArray[string]{"cert"},
+				UseMetadata:      true,
+				AllowedAAGUIDs:   database.TextArray[string]{"cb69481e-8ff7-4039-93ec-0a2729a154a8"},
+				DeniedAAGUIDs:    database.TextArray[string]{},
+				IsDefault:        false,
+			},
+		},
+		{
+			name:    "preparePasskeyAttestationPolicyQuery sql err",
+			prepare: preparePasskeyAttestationPolicyQuery,
+			want: want{
+				sqlExpectations: mockQueryErr(
+					passkeyAttestationPolicyStmt,
+					sql.ErrConnDone,
+				),
+				err: func(err error) (error, bool) {
+					if !errors.Is(err, sql.ErrConnDone) {
+						return fmt.Errorf("err should be sql.ErrConnDone got: %w", err), false
+					}
+					return nil, true
+				},
+			},
+			object: (*PasskeyAttestationPolicy)(nil),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assertPrepare(t, tt.prepare, tt.object, tt.want.sqlExpectations, tt.want.err)
+		})
+	}
+}
@@ -25,11 +25,14 @@ type PasswordComplexityPolicy struct {
 	ResourceOwner string
 	State         domain.PolicyState
 
-	MinLength    uint64
-	HasLowercase bool
-	HasUppercase bool
-	HasNumber    bool
-	HasSymbol    bool
+	MinLength           uint64
+	HasLowercase        bool
+	HasUppercase        bool
+	HasNumber           bool
+	HasSymbol           bool
+	HistoryDepth        uint64
+	CheckBreached       bool
+	ForceChangeBreached bool
 
 	IsDefault bool
 }
@@ -148,6 +151,18 @@ var (
 		name:  projection.ComplexityPolicyHasSymbolCol,
 		table: passwordComplexityTable,
 	}
+	PasswordComplexityColHistoryDepth = Column{
+		name:  projection.ComplexityPolicyHistoryDepthCol,
+		table: passwordComplexityTable,
+	}
+	PasswordComplexityColCheckBreached = Column{
+		name:  projection.ComplexityPolicyCheckBreachedCol,
+		table: passwordComplexityTable,
+	}
+	PasswordComplexityColForceChangeBreached = Column{
+		name:  projection.ComplexityPolicyForceChangeBreachedCol,
+		table: passwordComplexityTable,
+	}
 	PasswordComplexityColIsDefault = Column{
 		name:  projection.ComplexityPolicyIsDefaultCol,
 		table: passwordComplexityTable,
@@ -174,6 +189,9 @@ func preparePasswordComplexityPolicyQuery() (sq.SelectBuilder, func(*sql.Row) (*
 			PasswordComplexityColHasUpperCase.identifier(),
 			PasswordComplexityColHasNumber.identifier(),
 			PasswordComplexityColHasSymbol.identifier(),
+			PasswordComplexityColHistoryDepth.identifier(),
+			PasswordComplexityColCheckBreached.identifier(),
+			PasswordComplexityColForceChangeBreached.identifier(),
 			PasswordComplexityColIsDefault.identifier(),
 			PasswordComplexityColState.identifier(),
 		).
@@ -192,6 +210,9 @@ func preparePasswordComplexityPolicyQuery() (sq.SelectBuilder, func(*sql.Row) (*
 				&policy.HasUppercase,
 				&policy.HasNumber,
 				&policy.HasSymbol,
+				&policy.HistoryDepth,
+				&policy.CheckBreached,
+				&policy.ForceChangeBreached,
 				&policy.IsDefault,
 				&policy.State,
 			)
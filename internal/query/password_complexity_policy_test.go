@@ -13,19 +13,22 @@ import (
 )
 
 var (
-	preparePasswordComplexityPolicyStmt = `SELECT projections.password_complexity_policies2.id,` +
-		` projections.password_complexity_policies2.sequence,` +
-		` projections.password_complexity_policies2.creation_date,` +
-		` projections.password_complexity_policies2.change_date,` +
-		` projections.password_complexity_policies2.resource_owner,` +
-		` projections.password_complexity_policies2.min_length,` +
-		` projections.password_complexity_policies2.has_lowercase,` +
-		` projections.password_complexity_policies2.has_uppercase,` +
-		` projections.password_complexity_policies2.has_number,` +
-		` projections.password_complexity_policies2.has_symbol,` +
-		` projections.password_complexity_policies2.is_default,` +
-		` projections.password_complexity_policies2.state` +
-		` FROM projections.password_complexity_policies2`
+	preparePasswordComplexityPolicyStmt = `SELECT projections.password_complexity_policies4.id,` +
+		` projections.password_complexity_policies4.sequence,` +
+		` projections.password_complexity_policies4.creation_date,` +
+		` projections.password_complexity_policies4.change_date,` +
+		` projections.password_complexity_policies4.resource_owner,` +
+		` projections.password_complexity_policies4.min_length,` +
+		` projections.password_complexity_policies4.has_lowercase,` +
+		` projections.password_complexity_policies4.has_uppercase,` +
+		` projections.password_complexity_policies4.has_number,` +
+		` projections.password_complexity_policies4.has_symbol,` +
+		` projections.password_complexity_policies4.history_depth,` +
+		` projections.password_complexity_policies4.check_breached,` +
+		` projections.password_complexity_policies4.force_change_breached,` +
+		` projections.password_complexity_policies4.is_default,` +
+		` projections.password_complexity_policies4.state` +
+		` FROM projections.password_complexity_policies4`
 	preparePasswordComplexityPolicyCols = []string{
 		"id",
 		"sequence",
@@ -37,6 +40,9 @@ var (
 		"has_uppercase",
 		"has_number",
 		"has_symbol",
+		"history_depth",
+		"check_breached",
+		"force_change_breached",
 		"is_default",
 		"state",
 	}
@@ -89,24 +95,30 @@ func Test_PasswordComplexityPolicyPrepares(t *testing.T) {
 						true,
 						true,
 						true,
+						4,
+						true,
+						true,
 						true,
 						domain.PolicyStateActive,
 					},
 				),
 			},
 			object: &PasswordComplexityPolicy{
-				ID:            "pol-id",
-				CreationDate:  testNow,
-				ChangeDate:    testNow,
-				Sequence:      20211109,
-				ResourceOwner: "ro",
-				State:         domain.PolicyStateActive,
-				MinLength:     8,
-				HasLowercase:  true,
-				HasUppercase:  true,
-				HasNumber:     true,
-				HasSymbol:     true,
-				IsDefault:     true,
+				ID:                  "pol-id",
+				CreationDate:        testNow,
+				ChangeDate:          testNow,
+				Sequence:            20211109,
+				ResourceOwner:       "ro",
+				State:               domain.PolicyStateActive,
+				MinLength:           8,
+				HasLowercase:        true,
+				HasUppercase:        true,
+				HasNumber:           true,
+				HasSymbol:           true,
+				HistoryDepth:        4,
+				CheckBreached:       true,
+				ForceChangeBreached: true,
+				IsDefault:           true,
 			},
 		},
 		{
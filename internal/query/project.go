@@ -163,6 +163,33 @@ func NewProjectResourceOwnerSearchQuery(value string) (SearchQuery, error) {
 	return NewTextQuery(ProjectColumnResourceOwner, value, TextEquals)
 }
 
+// NewProjectUserGrantExistsQuery selects all projects on which the user has a user grant.
+func NewProjectUserGrantExistsQuery(userID string) (SearchQuery, error) {
+	// linking queries for the subselect
+	instanceQuery, err := NewColumnComparisonQuery(UserGrantInstanceID, ProjectColumnInstanceID, ColumnEquals)
+	if err != nil {
+		return nil, err
+	}
+
+	userIDQuery, err := NewTextQuery(UserGrantUserID, userID, TextEquals)
+	if err != nil {
+		return nil, err
+	}
+
+	// full definition of the sub select
+	subSelect, err := NewSubSelect(UserGrantProjectID, []SearchQuery{instanceQuery, userIDQuery})
+	if err != nil {
+		return nil, err
+	}
+
+	// "WHERE * IN (*)" query with subquery as list-data provider
+	return NewListQuery(
+		ProjectColumnID,
+		subSelect,
+		ListIn,
+	)
+}
+
 func (r *ProjectSearchQueries) AppendMyResourceOwnerQuery(orgID string) error {
 	query, err := NewProjectResourceOwnerSearchQuery(orgID)
 	if err != nil {
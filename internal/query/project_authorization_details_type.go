@@ -0,0 +1,210 @@
+package query
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/zitadel/logging"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/eventstore/handler/v2"
+	"github.com/zitadel/zitadel/internal/query/projection"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+var (
+	projectAuthorizationDetailsTypesTable = table{
+		name:          projection.ProjectAuthorizationDetailsTypeProjectionTable,
+		instanceIDCol: projection.ProjectAuthorizationDetailsTypeColumnInstanceID,
+	}
+	ProjectAuthorizationDetailsTypeColumnProjectID = Column{
+		name:  projection.ProjectAuthorizationDetailsTypeColumnProjectID,
+		table: projectAuthorizationDetailsTypesTable,
+	}
+	ProjectAuthorizationDetailsTypeColumnType = Column{
+		name:  projection.ProjectAuthorizationDetailsTypeColumnType,
+		table: projectAuthorizationDetailsTypesTable,
+	}
+	ProjectAuthorizationDetailsTypeColumnCreationDate = Column{
+		name:  projection.ProjectAuthorizationDetailsTypeColumnCreationDate,
+		table: projectAuthorizationDetailsTypesTable,
+	}
+	ProjectAuthorizationDetailsTypeColumnChangeDate = Column{
+		name:  projection.ProjectAuthorizationDetailsTypeColumnChangeDate,
+		table: projectAuthorizationDetailsTypesTable,
+	}
+	ProjectAuthorizationDetailsTypeColumnSequence = Column{
+		name:  projection.ProjectAuthorizationDetailsTypeColumnSequence,
+		table: projectAuthorizationDetailsTypesTable,
+	}
+	ProjectAuthorizationDetailsTypeColumnResourceOwner = Column{
+		name:  projection.ProjectAuthorizationDetailsTypeColumnResourceOwner,
+		table: projectAuthorizationDetailsTypesTable,
+	}
+	ProjectAuthorizationDetailsTypeColumnInstanceID = Column{
+		name:  projection.ProjectAuthorizationDetailsTypeColumnInstanceID,
+		table: projectAuthorizationDetailsTypesTable,
+	}
+	ProjectAuthorizationDetailsTypeColumnSchema = Column{
+		name:  projection.ProjectAuthorizationDetailsTypeColumnSchema,
+		table: projectAuthorizationDetailsTypesTable,
+	}
+)
+
+type ProjectAuthorizationDetailsTypes struct {
+	SearchResponse
+	Types []*ProjectAuthorizationDetailsType
+}
+
+type ProjectAuthorizationDetailsType struct {
+	ProjectID     string
+	CreationDate  time.Time
+	ChangeDate    time.Time
+	ResourceOwner string
+	Sequence      uint64
+
+	Type   string
+	Schema json.RawMessage
+}
+
+// Schemas returns the JSON schemas of the types by their name.
+func (t *ProjectAuthorizationDetailsTypes) Schemas() map[string]json.RawMessage {
+	schemas := make(map[string]json.RawMessage, len(t.Types))
+	for _, detailsType := range t.Types {
+		schemas[detailsType.Type] = detailsType.Schema
+	}
+	return schemas
+}
+
+type ProjectAuthorizationDetailsTypeSearchQueries struct {
+	SearchRequest
+	Queries []SearchQuery
+}
+
+func (q *Queries) SearchProjectAuthorizationDetailsTypes(ctx context.Context, shouldTriggerBulk bool, queries *ProjectAuthorizationDetailsTypeSearchQueries) (types *ProjectAuthorizationDetailsTypes, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if shouldTriggerBulk {
+		_, traceSpan := tracing.NewNamedSpan(ctx, "TriggerAuthorizationDetailsTypeProjection")
+		ctx, err = projection.AuthorizationDetailsTypeProjection.Trigger(ctx, handler.WithAwaitRunning())
+		logging.OnError(err).Debug("trigger failed")
+		traceSpan.EndWithError(err)
+	}
+
+	eq := sq.Eq{ProjectAuthorizationDetailsTypeColumnInstanceID.identifier(): authz.GetInstance(ctx).InstanceID()}
+
+	query, scan := prepareProjectAuthorizationDetailsTypesQuery()
+	stmt, args, err := queries.toQuery(query).Where(eq).ToSql()
+	if err != nil {
+		return nil, zerrors.ThrowInvalidArgument(err, "QUERY-Rar1q", "Errors.Query.InvalidRequest")
+	}
+
+	err = q.client.QueryContext(ctx, func(rows *sql.Rows) error {
+		types, err = scan(rows)
+		return err
+	}, stmt, args...)
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "QUERY-Rar2i", "Errors.Internal")
+	}
+	types.State, err = q.latestState(ctx, projectAuthorizationDetailsTypesTable)
+	return types, err
+}
+
+// ProjectAuthorizationDetailsTypesByProjectID returns all registered types of authorization details of the project,
+// it is used to validate the authorization details requested by the applications of the project.
+func (q *Queries) ProjectAuthorizationDetailsTypesByProjectID(ctx context.Context, projectID string) (types *ProjectAuthorizationDetailsTypes, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	query, scan := prepareProjectAuthorizationDetailsTypesQuery()
+	stmt, args, err := query.Where(sq.Eq{
+		ProjectAuthorizationDetailsTypeColumnInstanceID.identifier(): authz.GetInstance(ctx).InstanceID(),
+		ProjectAuthorizationDetailsTypeColumnProjectID.identifier():  projectID,
+	}).ToSql()
+	if err != nil {
+		return nil, zerrors.ThrowInvalidArgument(err, "QUERY-Rar3q", "Errors.Query.InvalidRequest")
+	}
+
+	err = q.client.QueryContext(ctx, func(rows *sql.Rows) error {
+		types, err = scan(rows)
+		return err
+	}, stmt, args...)
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "QUERY-Rar4i", "Errors.Internal")
+	}
+	return types, nil
+}
+
+func NewProjectAuthorizationDetailsTypeProjectIDSearchQuery(value string) (SearchQuery, error) {
+	return NewTextQuery(ProjectAuthorizationDetailsTypeColumnProjectID, value, TextEquals)
+}
+
+func NewProjectAuthorizationDetailsTypeResourceOwnerSearchQuery(value string) (SearchQuery, error) {
+	return NewTextQuery(ProjectAuthorizationDetailsTypeColumnResourceOwner, value, TextEquals)
+}
+
+func NewProjectAuthorizationDetailsTypeTypeSearchQuery(method TextComparison, value string) (SearchQuery, error) {
+	return NewTextQuery(ProjectAuthorizationDetailsTypeColumnType, value, method)
+}
+
+func (q *ProjectAuthorizationDetailsTypeSearchQueries) toQuery(query sq.SelectBuilder) sq.SelectBuilder {
+	query = q.SearchRequest.toQuery(query)
+	for _, q := range q.Queries {
+		query = q.toQuery(query)
+	}
+	return query
+}
+
+func prepareProjectAuthorizationDetailsTypesQuery() (sq.SelectBuilder, func(*sql.Rows) (*ProjectAuthorizationDetailsTypes, error)) {
+	return sq.Select(
+			ProjectAuthorizationDetailsTypeColumnProjectID.identifier(),
+			ProjectAuthorizationDetailsTypeColumnCreationDate.identifier(),
+			ProjectAuthorizationDetailsTypeColumnChangeDate.identifier(),
+			ProjectAuthorizationDetailsTypeColumnResourceOwner.identifier(),
+			ProjectAuthorizationDetailsTypeColumnSequence.identifier(),
+			ProjectAuthorizationDetailsTypeColumnType.identifier(),
+			ProjectAuthorizationDetailsTypeColumnSchema.identifier(),
+			countColumn.identifier()).
+			From(projectAuthorizationDetailsTypesTable.identifier()).
+			PlaceholderFormat(sq.Dollar),
+		func(rows *sql.Rows) (*ProjectAuthorizationDetailsTypes, error) {
+			types := make([]*ProjectAuthorizationDetailsType, 0)
+			var count uint64
+			for rows.Next() {
+				detailsType := new(ProjectAuthorizationDetailsType)
+				var schema database.ByteArray[byte]
+				err := rows.Scan(
+					&detailsType.ProjectID,
+					&detailsType.CreationDate,
+					&detailsType.ChangeDate,
+					&detailsType.ResourceOwner,
+					&detailsType.Sequence,
+					&detailsType.Type,
+					&schema,
+					&count,
+				)
+				if err != nil {
+					return nil, err
+				}
+				detailsType.Schema = json.RawMessage(schema)
+				types = append(types, detailsType)
+			}
+
+			if err := rows.Close(); err != nil {
+				return nil, zerrors.ThrowInternal(err, "QUERY-Rar5c", "Errors.Query.CloseRows")
+			}
+
+			return &ProjectAuthorizationDetailsTypes{
+				Types: types,
+				SearchResponse: SearchResponse{
+					Count: count,
+				},
+			}, nil
+		}
+}
@@ -0,0 +1,136 @@
+package query
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"regexp"
+	"testing"
+)
+
+var (
+	prepareProjectAuthorizationDetailsTypesStmt = `SELECT projections.project_authorization_details_types.project_id,` +
+		` projections.project_authorization_details_types.creation_date,` +
+		` projections.project_authorization_details_types.change_date,` +
+		` projections.project_authorization_details_types.resource_owner,` +
+		` projections.project_authorization_details_types.sequence,` +
+		` projections.project_authorization_details_types.type,` +
+		` projections.project_authorization_details_types.schema,` +
+		` COUNT(*) OVER ()` +
+		` FROM projections.project_authorization_details_types`
+	prepareProjectAuthorizationDetailsTypesCols = []string{
+		"project_id",
+		"creation_date",
+		"change_date",
+		"resource_owner",
+		"sequence",
+		"type",
+		"schema",
+		"count",
+	}
+)
+
+func Test_ProjectAuthorizationDetailsTypePrepares(t *testing.T) {
+	type want struct {
+		sqlExpectations sqlExpectation
+		err             checkErr
+	}
+	tests := []struct {
+		name    string
+		prepare interface{}
+		want    want
+		object  interface{}
+	}{
+		{
+			name:    "prepareProjectAuthorizationDetailsTypesQuery no result",
+			prepare: prepareProjectAuthorizationDetailsTypesQuery,
+			want: want{
+				sqlExpectations: mockQueries(
+					regexp.QuoteMeta(prepareProjectAuthorizationDetailsTypesStmt),
+					nil,
+					nil,
+				),
+			},
+			object: &ProjectAuthorizationDetailsTypes{Types: []*ProjectAuthorizationDetailsType{}},
+		},
+		{
+			name:    "prepareProjectAuthorizationDetailsTypesQuery multiple result",
+			prepare: prepareProjectAuthorizationDetailsTypesQuery,
+			want: want{
+				sqlExpectations: mockQueries(
+					regexp.QuoteMeta(prepareProjectAuthorizationDetailsTypesStmt),
+					prepareProjectAuthorizationDetailsTypesCols,
+					[][]driver.Value{
+						{
+							"project-id",
+							testNow,
+							testNow,
+							"ro",
+							uint64(20211111),
+							"payment",
+							[]byte(`{"type":"object"}`),
+						},
+						{
+							"project-id",
+							testNow,
+							testNow,
+							"ro",
+							uint64(20211111),
+							"account_information",
+							[]byte(`{"type":"array"}`),
+						},
+					},
+				),
+			},
+			object: &ProjectAuthorizationDetailsTypes{
+				SearchResponse: SearchResponse{
+					Count: 2,
+				},
+				Types: []*ProjectAuthorizationDetailsType{
+					{
+						ProjectID:     "project-id",
+						CreationDate:  testNow,
+						ChangeDate:    testNow,
+						ResourceOwner: "ro",
+						Sequence:      20211111,
+						Type:          "payment",
+						Schema:        json.RawMessage(`{"type":"object"}`),
+					},
+					{
+						ProjectID:     "project-id",
+						CreationDate:  testNow,
+						ChangeDate:    testNow,
+						ResourceOwner: "ro",
+						Sequence:      20211111,
+						Type:          "account_information",
+						Schema:        json.RawMessage(`{"type":"array"}`),
+					},
+				},
+			},
+		},
+		{
+			name:    "prepareProjectAuthorizationDetailsTypesQuery sql err",
+			prepare: prepareProjectAuthorizationDetailsTypesQuery,
+			want: want{
+				sqlExpectations: mockQueryErr(
+					regexp.QuoteMeta(prepareProjectAuthorizationDetailsTypesStmt),
+					sql.ErrConnDone,
+				),
+				err: func(err error) (error, bool) {
+					if !errors.Is(err, sql.ErrConnDone) {
+						return fmt.Errorf("err should be sql.ErrConnDone got: %w", err), false
+					}
+					return nil, true
+				},
+			},
+			object: (*ProjectAuthorizationDetailsTypes)(nil),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assertPrepare(t, tt.prepare, tt.object, tt.want.sqlExpectations, tt.want.err)
+		})
+	}
+}
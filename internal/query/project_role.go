@@ -3,14 +3,17 @@ package query
 import (
 	"context"
 	"database/sql"
+	"slices"
 	"time"
 
 	sq "github.com/Masterminds/squirrel"
 	"github.com/zitadel/logging"
 
 	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/eventstore"
 	"github.com/zitadel/zitadel/internal/eventstore/handler/v2"
 	"github.com/zitadel/zitadel/internal/query/projection"
+	"github.com/zitadel/zitadel/internal/repository/project"
 	"github.com/zitadel/zitadel/internal/telemetry/tracing"
 	"github.com/zitadel/zitadel/internal/zerrors"
 )
@@ -110,6 +113,45 @@ func (q *Queries) SearchProjectRoles(ctx context.Context, shouldTriggerBulk bool
 	return roles, err
 }
 
+// ProjectRolesByProjectID returns all roles of the project,
+// it is used to resolve the roles asserted in tokens.
+func (q *Queries) ProjectRolesByProjectID(ctx context.Context, shouldTriggerBulk bool, projectID string) (roles []*ProjectRole, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if shouldTriggerBulk {
+		_, traceSpan := tracing.NewNamedSpan(ctx, "TriggerProjectRoleProjection")
+		ctx, err = projection.ProjectRoleProjection.Trigger(ctx, handler.WithAwaitRunning())
+		logging.OnError(err).Debug("trigger failed")
+		traceSpan.EndWithError(err)
+	}
+
+	instanceID := authz.GetInstance(ctx).InstanceID()
+	if cached, ok := q.caches.projectRoles.Get(ctx, projectRolesIndexByProjectID, instanceScopedKey(instanceID, projectID)); ok {
+		return copyProjectRoles(cached.Roles), nil
+	}
+
+	query, scan := prepareProjectRolesQuery()
+	stmt, args, err := query.Where(sq.Eq{
+		ProjectRoleColumnInstanceID.identifier(): instanceID,
+		ProjectRoleColumnProjectID.identifier():  projectID,
+	}).ToSql()
+	if err != nil {
+		return nil, zerrors.ThrowInvalidArgument(err, "QUERY-Pr0le", "Errors.Query.InvalidRequest")
+	}
+
+	var found *ProjectRoles
+	err = q.client.QueryContext(ctx, func(rows *sql.Rows) error {
+		found, err = scan(rows)
+		return err
+	}, stmt, args...)
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "QUERY-Pr1le", "Errors.Internal")
+	}
+	q.caches.projectRoles.Set(ctx, &cachedProjectRoles{InstanceID: instanceID, ProjectID: projectID, Roles: copyProjectRoles(found.ProjectRoles)})
+	return found.ProjectRoles, nil
+}
+
 func (q *Queries) SearchGrantedProjectRoles(ctx context.Context, grantID, grantedOrg string, queries *ProjectRoleSearchQueries) (roles *ProjectRoles, err error) {
 	ctx, span := tracing.NewSpan(ctx)
 	defer func() { span.EndWithError(err) }()
@@ -253,3 +295,58 @@ func prepareProjectRolesQuery() (sq.SelectBuilder, func(*sql.Rows) (*ProjectRole
 			}, nil
 		}
 }
+
+type projectRolesIndex int
+
+//go:generate enumer -type projectRolesIndex -linecomment
+const (
+	// Empty line comment ensures empty string for unspecified value
+	projectRolesIndexUnspecified projectRolesIndex = iota //
+	projectRolesIndexByProjectID
+)
+
+// copyProjectRoles returns a deep copy of the roles,
+// so callers can't modify the roles stored in the cache.
+func copyProjectRoles(roles []*ProjectRole) []*ProjectRole {
+	if roles == nil {
+		return nil
+	}
+	c := make([]*ProjectRole, len(roles))
+	for i, role := range roles {
+		r := *role
+		c[i] = &r
+	}
+	return c
+}
+
+// cachedProjectRoles contains all roles of a project.
+type cachedProjectRoles struct {
+	InstanceID string         `json:"instance_id,omitempty"`
+	ProjectID  string         `json:"project_id,omitempty"`
+	Roles      []*ProjectRole `json:"roles,omitempty"`
+}
+
+// Keys implements [cache.Entry]
+func (r *cachedProjectRoles) Keys(index projectRolesIndex) []string {
+	switch index {
+	case projectRolesIndexByProjectID:
+		return []string{instanceScopedKey(r.InstanceID, r.ProjectID)}
+	case projectRolesIndexUnspecified:
+	}
+	return nil
+}
+
+func (c *Caches) registerProjectRolesInvalidation() {
+	invalidate := cacheInvalidationFunc(c.projectRoles, projectRolesIndexByProjectID, getInstanceScopedAggregateID)
+	projection.ProjectRoleProjection.RegisterCacheInvalidation(func(ctx context.Context, aggregates []*eventstore.Aggregate) {
+		// removal of an organization or instance removes the roles of all their projects
+		if slices.ContainsFunc(aggregates, func(aggregate *eventstore.Aggregate) bool {
+			return aggregate.Type != project.AggregateType
+		}) {
+			err := c.projectRoles.Truncate(ctx)
+			logging.OnError(err).Warn("cache truncate failed")
+			return
+		}
+		invalidate(ctx, aggregates)
+	})
+}
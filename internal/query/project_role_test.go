@@ -7,6 +7,9 @@ import (
 	"fmt"
 	"regexp"
 	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 var (
@@ -178,3 +181,21 @@ func Test_ProjectRolePrepares(t *testing.T) {
 		})
 	}
 }
+
+func Test_copyProjectRoles(t *testing.T) {
+	roles := []*ProjectRole{
+		{ProjectID: "projectID", Key: "key1", DisplayName: "role 1"},
+		{ProjectID: "projectID", Key: "key2", DisplayName: "role 2"},
+	}
+	got := copyProjectRoles(roles)
+	require.Equal(t, roles, got)
+
+	got[0].DisplayName = "changed"
+	got[1] = &ProjectRole{Key: "changed"}
+
+	assert.Equal(t, []*ProjectRole{
+		{ProjectID: "projectID", Key: "key1", DisplayName: "role 1"},
+		{ProjectID: "projectID", Key: "key2", DisplayName: "role 2"},
+	}, roles)
+	assert.Nil(t, copyProjectRoles(nil))
+}
@@ -0,0 +1,195 @@
+package projection
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	old_handler "github.com/zitadel/zitadel/internal/eventstore/handler"
+	"github.com/zitadel/zitadel/internal/eventstore/handler/v2"
+	"github.com/zitadel/zitadel/internal/repository/instance"
+	"github.com/zitadel/zitadel/internal/repository/org"
+	"github.com/zitadel/zitadel/internal/repository/user"
+)
+
+const (
+	AccountRecoveryRequestProjectionTable = "projections.account_recovery_requests"
+
+	AccountRecoveryRequestColumnUserID        = "user_id"
+	AccountRecoveryRequestColumnCreationDate  = "creation_date"
+	AccountRecoveryRequestColumnChangeDate    = "change_date"
+	AccountRecoveryRequestColumnSequence      = "sequence"
+	AccountRecoveryRequestColumnResourceOwner = "resource_owner"
+	AccountRecoveryRequestColumnInstanceID    = "instance_id"
+	AccountRecoveryRequestColumnState         = "state"
+	AccountRecoveryRequestColumnReason        = "reason"
+)
+
+// accountRecoveryRequestProjection keeps the latest account recovery request of each user,
+// so administrators can list the requests waiting for their approval.
+type accountRecoveryRequestProjection struct{}
+
+func newAccountRecoveryRequestProjection(ctx context.Context, config handler.Config) *handler.Handler {
+	return handler.NewHandler(ctx, &config, new(accountRecoveryRequestProjection))
+}
+
+func (*accountRecoveryRequestProjection) Name() string {
+	return AccountRecoveryRequestProjectionTable
+}
+
+func (*accountRecoveryRequestProjection) Init() *old_handler.Check {
+	return handler.NewTableCheck(
+		handler.NewTable([]*handler.InitColumn{
+			handler.NewColumn(AccountRecoveryRequestColumnUserID, handler.ColumnTypeText),
+			handler.NewColumn(AccountRecoveryRequestColumnCreationDate, handler.ColumnTypeTimestamp),
+			handler.NewColumn(AccountRecoveryRequestColumnChangeDate, handler.ColumnTypeTimestamp),
+			handler.NewColumn(AccountRecoveryRequestColumnSequence, handler.ColumnTypeInt64),
+			handler.NewColumn(AccountRecoveryRequestColumnResourceOwner, handler.ColumnTypeText),
+			handler.NewColumn(AccountRecoveryRequestColumnInstanceID, handler.ColumnTypeText),
+			handler.NewColumn(AccountRecoveryRequestColumnState, handler.ColumnTypeEnum),
+			handler.NewColumn(AccountRecoveryRequestColumnReason, handler.ColumnTypeText, handler.Default("")),
+		},
+			handler.NewPrimaryKey(AccountRecoveryRequestColumnInstanceID, AccountRecoveryRequestColumnUserID),
+			handler.WithIndex(handler.NewIndex("resource_owner", []string{AccountRecoveryRequestColumnResourceOwner})),
+		),
+	)
+}
+
+func (p *accountRecoveryRequestProjection) Reducers() []handler.AggregateReducer {
+	return []handler.AggregateReducer{
+		{
+			Aggregate: user.AggregateType,
+			EventReducers: []handler.EventReducer{
+				{
+					Event:  user.HumanAccountRecoveryRequestedType,
+					Reduce: p.reduceRequested,
+				},
+				{
+					Event:  user.HumanAccountRecoveryApprovedType,
+					Reduce: p.reduceApproved,
+				},
+				{
+					Event:  user.HumanAccountRecoveryRejectedType,
+					Reduce: p.reduceRejected,
+				},
+				{
+					Event:  user.HumanAccountRecoveryCompletedType,
+					Reduce: p.reduceCompleted,
+				},
+				{
+					Event:  user.UserRemovedType,
+					Reduce: p.reduceUserRemoved,
+				},
+			},
+		},
+		{
+			Aggregate: org.AggregateType,
+			EventReducers: []handler.EventReducer{
+				{
+					Event:  org.OrgRemovedEventType,
+					Reduce: p.reduceOwnerRemoved,
+				},
+			},
+		},
+		{
+			Aggregate: instance.AggregateType,
+			EventReducers: []handler.EventReducer{
+				{
+					Event:  instance.InstanceRemovedEventType,
+					Reduce: reduceInstanceRemovedHelper(AccountRecoveryRequestColumnInstanceID),
+				},
+			},
+		},
+	}
+}
+
+func (p *accountRecoveryRequestProjection) reduceRequested(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*user.HumanAccountRecoveryRequestedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	return handler.NewUpsertStatement(
+		e,
+		[]handler.Column{
+			handler.NewCol(AccountRecoveryRequestColumnInstanceID, nil),
+			handler.NewCol(AccountRecoveryRequestColumnUserID, nil),
+		},
+		[]handler.Column{
+			handler.NewCol(AccountRecoveryRequestColumnInstanceID, e.Aggregate().InstanceID),
+			handler.NewCol(AccountRecoveryRequestColumnUserID, e.Aggregate().ID),
+			handler.NewCol(AccountRecoveryRequestColumnCreationDate, e.CreationDate()),
+			handler.NewCol(AccountRecoveryRequestColumnChangeDate, e.CreationDate()),
+			handler.NewCol(AccountRecoveryRequestColumnSequence, e.Sequence()),
+			handler.NewCol(AccountRecoveryRequestColumnResourceOwner, e.Aggregate().ResourceOwner),
+			handler.NewCol(AccountRecoveryRequestColumnState, domain.AccountRecoveryStateRequested),
+			handler.NewCol(AccountRecoveryRequestColumnReason, e.Reason),
+		},
+	), nil
+}
+
+func (p *accountRecoveryRequestProjection) reduceApproved(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*user.HumanAccountRecoveryApprovedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	return p.stateChangedStatement(e, domain.AccountRecoveryStateApproved), nil
+}
+
+func (p *accountRecoveryRequestProjection) reduceRejected(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*user.HumanAccountRecoveryRejectedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	return p.stateChangedStatement(e, domain.AccountRecoveryStateRejected), nil
+}
+
+func (p *accountRecoveryRequestProjection) reduceCompleted(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*user.HumanAccountRecoveryCompletedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	return p.stateChangedStatement(e, domain.AccountRecoveryStateCompleted), nil
+}
+
+func (p *accountRecoveryRequestProjection) stateChangedStatement(event eventstore.Event, state domain.AccountRecoveryState) *handler.Statement {
+	return handler.NewUpdateStatement(
+		event,
+		[]handler.Column{
+			handler.NewCol(AccountRecoveryRequestColumnChangeDate, event.CreatedAt()),
+			handler.NewCol(AccountRecoveryRequestColumnSequence, event.Sequence()),
+			handler.NewCol(AccountRecoveryRequestColumnState, state),
+		},
+		[]handler.Condition{
+			handler.NewCond(AccountRecoveryRequestColumnUserID, event.Aggregate().ID),
+			handler.NewCond(AccountRecoveryRequestColumnInstanceID, event.Aggregate().InstanceID),
+		},
+	)
+}
+
+func (p *accountRecoveryRequestProjection) reduceUserRemoved(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*user.UserRemovedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	return handler.NewDeleteStatement(
+		e,
+		[]handler.Condition{
+			handler.NewCond(AccountRecoveryRequestColumnUserID, e.Aggregate().ID),
+			handler.NewCond(AccountRecoveryRequestColumnInstanceID, e.Aggregate().InstanceID),
+		},
+	), nil
+}
+
+func (p *accountRecoveryRequestProjection) reduceOwnerRemoved(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*org.OrgRemovedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	return handler.NewDeleteStatement(
+		e,
+		[]handler.Condition{
+			handler.NewCond(AccountRecoveryRequestColumnInstanceID, e.Aggregate().InstanceID),
+			handler.NewCond(AccountRecoveryRequestColumnResourceOwner, e.Aggregate().ID),
+		},
+	), nil
+}
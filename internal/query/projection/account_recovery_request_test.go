@@ -0,0 +1,242 @@
+package projection
+
+import (
+	"testing"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/eventstore/handler/v2"
+	"github.com/zitadel/zitadel/internal/repository/instance"
+	"github.com/zitadel/zitadel/internal/repository/org"
+	"github.com/zitadel/zitadel/internal/repository/user"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+func TestAccountRecoveryRequestProjection_reduces(t *testing.T) {
+	type args struct {
+		event func(t *testing.T) eventstore.Event
+	}
+	tests := []struct {
+		name   string
+		args   args
+		reduce func(event eventstore.Event) (*handler.Statement, error)
+		want   wantReduce
+	}{
+		{
+			name: "reduceRequested",
+			args: args{
+				event: getEvent(
+					testEvent(
+						user.HumanAccountRecoveryRequestedType,
+						user.AggregateType,
+						[]byte(`{"reason": "lost phone"}`),
+					), eventstore.GenericEventMapper[user.HumanAccountRecoveryRequestedEvent]),
+			},
+			reduce: (&accountRecoveryRequestProjection{}).reduceRequested,
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("user"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "INSERT INTO projections.account_recovery_requests (instance_id, user_id, creation_date, change_date, sequence, resource_owner, state, reason) VALUES ($1, $2, $3, $4, $5, $6, $7, $8) ON CONFLICT (instance_id, user_id) DO UPDATE SET (creation_date, change_date, sequence, resource_owner, state, reason) = (EXCLUDED.creation_date, EXCLUDED.change_date, EXCLUDED.sequence, EXCLUDED.resource_owner, EXCLUDED.state, EXCLUDED.reason)",
+							expectedArgs: []interface{}{
+								"instance-id",
+								"agg-id",
+								anyArg{},
+								anyArg{},
+								uint64(15),
+								"ro-id",
+								domain.AccountRecoveryStateRequested,
+								"lost phone",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceApproved",
+			args: args{
+				event: getEvent(
+					testEvent(
+						user.HumanAccountRecoveryApprovedType,
+						user.AggregateType,
+						[]byte(`{"email": "recovery@example.com"}`),
+					), eventstore.GenericEventMapper[user.HumanAccountRecoveryApprovedEvent]),
+			},
+			reduce: (&accountRecoveryRequestProjection{}).reduceApproved,
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("user"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "UPDATE projections.account_recovery_requests SET (change_date, sequence, state) = ($1, $2, $3) WHERE (user_id = $4) AND (instance_id = $5)",
+							expectedArgs: []interface{}{
+								anyArg{},
+								uint64(15),
+								domain.AccountRecoveryStateApproved,
+								"agg-id",
+								"instance-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceRejected",
+			args: args{
+				event: getEvent(
+					testEvent(
+						user.HumanAccountRecoveryRejectedType,
+						user.AggregateType,
+						[]byte(`{"reason": "unknown requester"}`),
+					), eventstore.GenericEventMapper[user.HumanAccountRecoveryRejectedEvent]),
+			},
+			reduce: (&accountRecoveryRequestProjection{}).reduceRejected,
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("user"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "UPDATE projections.account_recovery_requests SET (change_date, sequence, state) = ($1, $2, $3) WHERE (user_id = $4) AND (instance_id = $5)",
+							expectedArgs: []interface{}{
+								anyArg{},
+								uint64(15),
+								domain.AccountRecoveryStateRejected,
+								"agg-id",
+								"instance-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceCompleted",
+			args: args{
+				event: getEvent(
+					testEvent(
+						user.HumanAccountRecoveryCompletedType,
+						user.AggregateType,
+						nil,
+					), eventstore.GenericEventMapper[user.HumanAccountRecoveryCompletedEvent]),
+			},
+			reduce: (&accountRecoveryRequestProjection{}).reduceCompleted,
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("user"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "UPDATE projections.account_recovery_requests SET (change_date, sequence, state) = ($1, $2, $3) WHERE (user_id = $4) AND (instance_id = $5)",
+							expectedArgs: []interface{}{
+								anyArg{},
+								uint64(15),
+								domain.AccountRecoveryStateCompleted,
+								"agg-id",
+								"instance-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceUserRemoved",
+			args: args{
+				event: getEvent(
+					testEvent(
+						user.UserRemovedType,
+						user.AggregateType,
+						nil,
+					), user.UserRemovedEventMapper),
+			},
+			reduce: (&accountRecoveryRequestProjection{}).reduceUserRemoved,
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("user"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "DELETE FROM projections.account_recovery_requests WHERE (user_id = $1) AND (instance_id = $2)",
+							expectedArgs: []interface{}{
+								"agg-id",
+								"instance-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name:   "org reduceOwnerRemoved",
+			reduce: (&accountRecoveryRequestProjection{}).reduceOwnerRemoved,
+			args: args{
+				event: getEvent(
+					testEvent(
+						org.OrgRemovedEventType,
+						org.AggregateType,
+						nil,
+					), org.OrgRemovedEventMapper),
+			},
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("org"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "DELETE FROM projections.account_recovery_requests WHERE (instance_id = $1) AND (resource_owner = $2)",
+							expectedArgs: []interface{}{
+								"instance-id",
+								"agg-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "instance reduceInstanceRemoved",
+			args: args{
+				event: getEvent(
+					testEvent(
+						instance.InstanceRemovedEventType,
+						instance.AggregateType,
+						nil,
+					), instance.InstanceRemovedEventMapper),
+			},
+			reduce: reduceInstanceRemovedHelper(AccountRecoveryRequestColumnInstanceID),
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("instance"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "DELETE FROM projections.account_recovery_requests WHERE (instance_id = $1)",
+							expectedArgs: []interface{}{
+								"agg-id",
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			event := baseEvent(t)
+			got, err := tt.reduce(event)
+			if ok := zerrors.IsErrorInvalidArgument(err); !ok {
+				t.Errorf("no wrong event mapping: %v, got: %v", err, got)
+			}
+
+			event = tt.args.event(t)
+			got, err = tt.reduce(event)
+			assertReduce(t, got, err, AccountRecoveryRequestProjectionTable, tt.want)
+		})
+	}
+}
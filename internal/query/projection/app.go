@@ -38,29 +38,32 @@ const (
 	AppAPIConfigColumnClientSecret = "client_secret"
 	AppAPIConfigColumnAuthMethod   = "auth_method"
 
-	appOIDCTableSuffix                          = "oidc_configs"
-	AppOIDCConfigColumnAppID                    = "app_id"
-	AppOIDCConfigColumnInstanceID               = "instance_id"
-	AppOIDCConfigColumnVersion                  = "version"
-	AppOIDCConfigColumnClientID                 = "client_id"
-	AppOIDCConfigColumnClientSecret             = "client_secret"
-	AppOIDCConfigColumnRedirectUris             = "redirect_uris"
-	AppOIDCConfigColumnResponseTypes            = "response_types"
-	AppOIDCConfigColumnGrantTypes               = "grant_types"
-	AppOIDCConfigColumnApplicationType          = "application_type"
-	AppOIDCConfigColumnAuthMethodType           = "auth_method_type"
-	AppOIDCConfigColumnPostLogoutRedirectUris   = "post_logout_redirect_uris"
-	AppOIDCConfigColumnDevMode                  = "is_dev_mode"
-	AppOIDCConfigColumnAccessTokenType          = "access_token_type"
-	AppOIDCConfigColumnAccessTokenRoleAssertion = "access_token_role_assertion"
-	AppOIDCConfigColumnIDTokenRoleAssertion     = "id_token_role_assertion"
-	AppOIDCConfigColumnIDTokenUserinfoAssertion = "id_token_userinfo_assertion"
-	AppOIDCConfigColumnClockSkew                = "clock_skew"
-	AppOIDCConfigColumnAdditionalOrigins        = "additional_origins"
-	AppOIDCConfigColumnSkipNativeAppSuccessPage = "skip_native_app_success_page"
-	AppOIDCConfigColumnBackChannelLogoutURI     = "back_channel_logout_uri"
-	AppOIDCConfigColumnLoginVersion             = "login_version"
-	AppOIDCConfigColumnLoginBaseURI             = "login_base_uri"
+	appOIDCTableSuffix                                  = "oidc_configs"
+	AppOIDCConfigColumnAppID                            = "app_id"
+	AppOIDCConfigColumnInstanceID                       = "instance_id"
+	AppOIDCConfigColumnVersion                          = "version"
+	AppOIDCConfigColumnClientID                         = "client_id"
+	AppOIDCConfigColumnClientSecret                     = "client_secret"
+	AppOIDCConfigColumnRedirectUris                     = "redirect_uris"
+	AppOIDCConfigColumnResponseTypes                    = "response_types"
+	AppOIDCConfigColumnGrantTypes                       = "grant_types"
+	AppOIDCConfigColumnApplicationType                  = "application_type"
+	AppOIDCConfigColumnAuthMethodType                   = "auth_method_type"
+	AppOIDCConfigColumnPostLogoutRedirectUris           = "post_logout_redirect_uris"
+	AppOIDCConfigColumnDevMode                          = "is_dev_mode"
+	AppOIDCConfigColumnAccessTokenType                  = "access_token_type"
+	AppOIDCConfigColumnAccessTokenRoleAssertion         = "access_token_role_assertion"
+	AppOIDCConfigColumnIDTokenRoleAssertion             = "id_token_role_assertion"
+	AppOIDCConfigColumnIDTokenUserinfoAssertion         = "id_token_userinfo_assertion"
+	AppOIDCConfigColumnClockSkew                        = "clock_skew"
+	AppOIDCConfigColumnAdditionalOrigins                = "additional_origins"
+	AppOIDCConfigColumnSkipNativeAppSuccessPage         = "skip_native_app_success_page"
+	AppOIDCConfigColumnBackChannelLogoutURI             = "back_channel_logout_uri"
+	AppOIDCConfigColumnLoginVersion                     = "login_version"
+	AppOIDCConfigColumnLoginBaseURI                     = "login_base_uri"
+	AppOIDCConfigColumnRequirePushedAuthRequest         = "require_pushed_auth_request"
+	AppOIDCConfigColumnRequireDPoP                      = "require_dpop"
+	AppOIDCConfigColumnBackChannelClientNotificationURI = "back_channel_client_notification_uri"
 
 	appSAMLTableSuffix              = "saml_configs"
 	AppSAMLConfigColumnAppID        = "app_id"
@@ -133,6 +136,9 @@ func (*appProjection) Init() *old_handler.Check {
 			handler.NewColumn(AppOIDCConfigColumnBackChannelLogoutURI, handler.ColumnTypeText, handler.Nullable()),
 			handler.NewColumn(AppOIDCConfigColumnLoginVersion, handler.ColumnTypeEnum, handler.Nullable()),
 			handler.NewColumn(AppOIDCConfigColumnLoginBaseURI, handler.ColumnTypeText, handler.Nullable()),
+			handler.NewColumn(AppOIDCConfigColumnRequirePushedAuthRequest, handler.ColumnTypeBool, handler.Default(false)),
+			handler.NewColumn(AppOIDCConfigColumnRequireDPoP, handler.ColumnTypeBool, handler.Default(false)),
+			handler.NewColumn(AppOIDCConfigColumnBackChannelClientNotificationURI, handler.ColumnTypeText, handler.Nullable()),
 		},
 			handler.NewPrimaryKey(AppOIDCConfigColumnInstanceID, AppOIDCConfigColumnAppID),
 			appOIDCTableSuffix,
@@ -513,6 +519,9 @@ func (p *appProjection) reduceOIDCConfigAdded(event eventstore.Event) (*handler.
 				handler.NewCol(AppOIDCConfigColumnBackChannelLogoutURI, e.BackChannelLogoutURI),
 				handler.NewCol(AppOIDCConfigColumnLoginVersion, e.LoginVersion),
 				handler.NewCol(AppOIDCConfigColumnLoginBaseURI, e.LoginBaseURI),
+				handler.NewCol(AppOIDCConfigColumnRequirePushedAuthRequest, e.RequirePushedAuthRequest),
+				handler.NewCol(AppOIDCConfigColumnRequireDPoP, e.RequireDPoP),
+				handler.NewCol(AppOIDCConfigColumnBackChannelClientNotificationURI, e.BackChannelClientNotificationURI),
 			},
 			handler.WithTableSuffix(appOIDCTableSuffix),
 		),
@@ -590,6 +599,15 @@ func (p *appProjection) reduceOIDCConfigChanged(event eventstore.Event) (*handle
 	if e.LoginBaseURI != nil {
 		cols = append(cols, handler.NewCol(AppOIDCConfigColumnLoginBaseURI, *e.LoginBaseURI))
 	}
+	if e.RequirePushedAuthRequest != nil {
+		cols = append(cols, handler.NewCol(AppOIDCConfigColumnRequirePushedAuthRequest, *e.RequirePushedAuthRequest))
+	}
+	if e.RequireDPoP != nil {
+		cols = append(cols, handler.NewCol(AppOIDCConfigColumnRequireDPoP, *e.RequireDPoP))
+	}
+	if e.BackChannelClientNotificationURI != nil {
+		cols = append(cols, handler.NewCol(AppOIDCConfigColumnBackChannelClientNotificationURI, *e.BackChannelClientNotificationURI))
+	}
 
 	if len(cols) == 0 {
 		return handler.NewNoOpStatement(e), nil
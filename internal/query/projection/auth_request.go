@@ -2,6 +2,7 @@ package projection
 
 import (
 	"context"
+	"encoding/json"
 
 	"github.com/zitadel/zitadel/internal/eventstore"
 	old_handler "github.com/zitadel/zitadel/internal/eventstore/handler"
@@ -12,23 +13,24 @@ import (
 )
 
 const (
-	AuthRequestsProjectionTable = "projections.auth_requests"
+	AuthRequestsProjectionTable = "projections.auth_requests2"
 
-	AuthRequestColumnID            = "id"
-	AuthRequestColumnCreationDate  = "creation_date"
-	AuthRequestColumnChangeDate    = "change_date"
-	AuthRequestColumnSequence      = "sequence"
-	AuthRequestColumnResourceOwner = "resource_owner"
-	AuthRequestColumnInstanceID    = "instance_id"
-	AuthRequestColumnLoginClient   = "login_client"
-	AuthRequestColumnClientID      = "client_id"
-	AuthRequestColumnRedirectURI   = "redirect_uri"
-	AuthRequestColumnScope         = "scope"
-	AuthRequestColumnPrompt        = "prompt"
-	AuthRequestColumnUILocales     = "ui_locales"
-	AuthRequestColumnMaxAge        = "max_age"
-	AuthRequestColumnLoginHint     = "login_hint"
-	AuthRequestColumnHintUserID    = "hint_user_id"
+	AuthRequestColumnID                   = "id"
+	AuthRequestColumnCreationDate         = "creation_date"
+	AuthRequestColumnChangeDate           = "change_date"
+	AuthRequestColumnSequence             = "sequence"
+	AuthRequestColumnResourceOwner        = "resource_owner"
+	AuthRequestColumnInstanceID           = "instance_id"
+	AuthRequestColumnLoginClient          = "login_client"
+	AuthRequestColumnClientID             = "client_id"
+	AuthRequestColumnRedirectURI          = "redirect_uri"
+	AuthRequestColumnScope                = "scope"
+	AuthRequestColumnPrompt               = "prompt"
+	AuthRequestColumnUILocales            = "ui_locales"
+	AuthRequestColumnMaxAge               = "max_age"
+	AuthRequestColumnLoginHint            = "login_hint"
+	AuthRequestColumnHintUserID           = "hint_user_id"
+	AuthRequestColumnAuthorizationDetails = "authorization_details"
 )
 
 type authRequestProjection struct{}
@@ -60,6 +62,7 @@ func (*authRequestProjection) Init() *old_handler.Check {
 			handler.NewColumn(AuthRequestColumnMaxAge, handler.ColumnTypeInt64, handler.Nullable()),
 			handler.NewColumn(AuthRequestColumnLoginHint, handler.ColumnTypeText, handler.Nullable()),
 			handler.NewColumn(AuthRequestColumnHintUserID, handler.ColumnTypeText, handler.Nullable()),
+			handler.NewColumn(AuthRequestColumnAuthorizationDetails, handler.ColumnTypeJSONB, handler.Nullable()),
 		},
 			handler.NewPrimaryKey(AuthRequestColumnInstanceID, AuthRequestColumnID),
 		),
@@ -102,6 +105,14 @@ func (p *authRequestProjection) reduceAuthRequestAdded(event eventstore.Event) (
 	if !ok {
 		return nil, zerrors.ThrowInvalidArgumentf(nil, "HANDL-Sfwfa", "reduce.wrong.event.type %s", authrequest.AddedType)
 	}
+	var authorizationDetails []byte
+	if len(e.AuthorizationDetails) > 0 {
+		var err error
+		authorizationDetails, err = json.Marshal(e.AuthorizationDetails)
+		if err != nil {
+			return nil, zerrors.ThrowInternal(err, "HANDL-Rar7p", "unable to marshal authorization details")
+		}
+	}
 
 	return handler.NewCreateStatement(
 		e,
@@ -121,6 +132,7 @@ func (p *authRequestProjection) reduceAuthRequestAdded(event eventstore.Event) (
 			handler.NewCol(AuthRequestColumnMaxAge, e.MaxAge),
 			handler.NewCol(AuthRequestColumnLoginHint, e.LoginHint),
 			handler.NewCol(AuthRequestColumnHintUserID, e.HintUserID),
+			handler.NewCol(AuthRequestColumnAuthorizationDetails, authorizationDetails),
 		},
 	), nil
 }
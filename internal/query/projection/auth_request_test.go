@@ -29,7 +29,7 @@ func TestAuthRequestProjection_reduces(t *testing.T) {
 				event: getEvent(testEvent(
 					authrequest.AddedType,
 					authrequest.AggregateType,
-					[]byte(`{"login_client": "loginClient", "client_id":"clientId","redirect_uri": "redirectURI", "scope": ["openid"], "prompt": [1], "ui_locales": ["en","de"], "max_age": 0, "login_hint": "loginHint", "hint_user_id": "hintUserID"}`),
+					[]byte(`{"login_client": "loginClient", "client_id":"clientId","redirect_uri": "redirectURI", "scope": ["openid"], "prompt": [1], "ui_locales": ["en","de"], "max_age": 0, "login_hint": "loginHint", "hint_user_id": "hintUserID", "authorization_details": [{"type": "payment_initiation", "amount": 10}]}`),
 				), authrequest.AddedEventMapper),
 			},
 			reduce: (&authRequestProjection{}).reduceAuthRequestAdded,
@@ -39,7 +39,7 @@ func TestAuthRequestProjection_reduces(t *testing.T) {
 				executer: &testExecuter{
 					executions: []execution{
 						{
-							expectedStmt: "INSERT INTO projections.auth_requests (id, instance_id, creation_date, change_date, resource_owner, sequence, login_client, client_id, redirect_uri, scope, prompt, ui_locales, max_age, login_hint, hint_user_id) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15)",
+							expectedStmt: "INSERT INTO projections.auth_requests2 (id, instance_id, creation_date, change_date, resource_owner, sequence, login_client, client_id, redirect_uri, scope, prompt, ui_locales, max_age, login_hint, hint_user_id, authorization_details) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16)",
 							expectedArgs: []interface{}{
 								"agg-id",
 								"instance-id",
@@ -56,6 +56,7 @@ func TestAuthRequestProjection_reduces(t *testing.T) {
 								gu.Ptr(time.Duration(0)),
 								gu.Ptr("loginHint"),
 								gu.Ptr("hintUserID"),
+								[]byte(`[{"amount":10,"type":"payment_initiation"}]`),
 							},
 						},
 					},
@@ -78,7 +79,7 @@ func TestAuthRequestProjection_reduces(t *testing.T) {
 				executer: &testExecuter{
 					executions: []execution{
 						{
-							expectedStmt: "DELETE FROM projections.auth_requests WHERE (id = $1) AND (instance_id = $2)",
+							expectedStmt: "DELETE FROM projections.auth_requests2 WHERE (id = $1) AND (instance_id = $2)",
 							expectedArgs: []interface{}{
 								"agg-id",
 								"instance-id",
@@ -104,7 +105,7 @@ func TestAuthRequestProjection_reduces(t *testing.T) {
 				executer: &testExecuter{
 					executions: []execution{
 						{
-							expectedStmt: "DELETE FROM projections.auth_requests WHERE (id = $1) AND (instance_id = $2)",
+							expectedStmt: "DELETE FROM projections.auth_requests2 WHERE (id = $1) AND (instance_id = $2)",
 							expectedArgs: []interface{}{
 								"agg-id",
 								"instance-id",
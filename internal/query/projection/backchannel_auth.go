@@ -0,0 +1,157 @@
+package projection
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/eventstore"
+	old_handler "github.com/zitadel/zitadel/internal/eventstore/handler"
+	"github.com/zitadel/zitadel/internal/eventstore/handler/v2"
+	"github.com/zitadel/zitadel/internal/repository/backchannelauth"
+	"github.com/zitadel/zitadel/internal/repository/instance"
+	"github.com/zitadel/zitadel/internal/repository/user"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+const (
+	BackChannelAuthRequestProjectionTable = "projections.back_channel_auth_requests"
+
+	BackChannelAuthRequestColumnID             = "id"
+	BackChannelAuthRequestColumnCreationDate   = "creation_date"
+	BackChannelAuthRequestColumnChangeDate     = "change_date"
+	BackChannelAuthRequestColumnSequence       = "sequence"
+	BackChannelAuthRequestColumnInstanceID     = "instance_id"
+	BackChannelAuthRequestColumnClientID       = "client_id"
+	BackChannelAuthRequestColumnUserID         = "user_id"
+	BackChannelAuthRequestColumnScopes         = "scopes"
+	BackChannelAuthRequestColumnBindingMessage = "binding_message"
+	BackChannelAuthRequestColumnExpiration     = "expiration"
+)
+
+// backChannelAuthRequestProjection holds the pending client initiated backchannel authentication (CIBA) requests
+// and makes them search-able by user, so the user can be shown the requests waiting for approval.
+// The CIBA grant uses the eventstore directly.
+type backChannelAuthRequestProjection struct{}
+
+func newBackChannelAuthRequestProjection(ctx context.Context, config handler.Config) *handler.Handler {
+	return handler.NewHandler(ctx, &config, new(backChannelAuthRequestProjection))
+}
+
+func (*backChannelAuthRequestProjection) Name() string {
+	return BackChannelAuthRequestProjectionTable
+}
+
+func (*backChannelAuthRequestProjection) Init() *old_handler.Check {
+	return handler.NewTableCheck(
+		handler.NewTable([]*handler.InitColumn{
+			handler.NewColumn(BackChannelAuthRequestColumnID, handler.ColumnTypeText),
+			handler.NewColumn(BackChannelAuthRequestColumnCreationDate, handler.ColumnTypeTimestamp),
+			handler.NewColumn(BackChannelAuthRequestColumnChangeDate, handler.ColumnTypeTimestamp),
+			handler.NewColumn(BackChannelAuthRequestColumnSequence, handler.ColumnTypeInt64),
+			handler.NewColumn(BackChannelAuthRequestColumnInstanceID, handler.ColumnTypeText),
+			handler.NewColumn(BackChannelAuthRequestColumnClientID, handler.ColumnTypeText),
+			handler.NewColumn(BackChannelAuthRequestColumnUserID, handler.ColumnTypeText),
+			handler.NewColumn(BackChannelAuthRequestColumnScopes, handler.ColumnTypeTextArray, handler.Nullable()),
+			handler.NewColumn(BackChannelAuthRequestColumnBindingMessage, handler.ColumnTypeText, handler.Default("")),
+			handler.NewColumn(BackChannelAuthRequestColumnExpiration, handler.ColumnTypeTimestamp),
+		},
+			handler.NewPrimaryKey(BackChannelAuthRequestColumnInstanceID, BackChannelAuthRequestColumnID),
+			handler.WithIndex(handler.NewIndex("user_id", []string{BackChannelAuthRequestColumnInstanceID, BackChannelAuthRequestColumnUserID})),
+		),
+	)
+}
+
+func (p *backChannelAuthRequestProjection) Reducers() []handler.AggregateReducer {
+	return []handler.AggregateReducer{
+		{
+			Aggregate: backchannelauth.AggregateType,
+			EventReducers: []handler.EventReducer{
+				{
+					Event:  backchannelauth.AddedEventType,
+					Reduce: p.reduceAdded,
+				},
+				{
+					Event:  backchannelauth.ApprovedEventType,
+					Reduce: p.reduceDoneEvents,
+				},
+				{
+					Event:  backchannelauth.CanceledEventType,
+					Reduce: p.reduceDoneEvents,
+				},
+				{
+					Event:  backchannelauth.DoneEventType,
+					Reduce: p.reduceDoneEvents,
+				},
+			},
+		},
+		{
+			Aggregate: user.AggregateType,
+			EventReducers: []handler.EventReducer{
+				{
+					Event:  user.UserRemovedType,
+					Reduce: p.reduceUserRemoved,
+				},
+			},
+		},
+		{
+			Aggregate: instance.AggregateType,
+			EventReducers: []handler.EventReducer{
+				{
+					Event:  instance.InstanceRemovedEventType,
+					Reduce: reduceInstanceRemovedHelper(BackChannelAuthRequestColumnInstanceID),
+				},
+			},
+		},
+	}
+}
+
+func (p *backChannelAuthRequestProjection) reduceAdded(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*backchannelauth.AddedEvent)
+	if !ok {
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "HANDL-Cba3p", "reduce.wrong.event.type %T != %s", event, backchannelauth.AddedEventType)
+	}
+	return handler.NewCreateStatement(
+		e,
+		[]handler.Column{
+			handler.NewCol(BackChannelAuthRequestColumnID, e.Aggregate().ID),
+			handler.NewCol(BackChannelAuthRequestColumnCreationDate, e.CreationDate()),
+			handler.NewCol(BackChannelAuthRequestColumnChangeDate, e.CreationDate()),
+			handler.NewCol(BackChannelAuthRequestColumnSequence, e.Sequence()),
+			handler.NewCol(BackChannelAuthRequestColumnInstanceID, e.Aggregate().InstanceID),
+			handler.NewCol(BackChannelAuthRequestColumnClientID, e.ClientID),
+			handler.NewCol(BackChannelAuthRequestColumnUserID, e.UserID),
+			handler.NewCol(BackChannelAuthRequestColumnScopes, e.Scopes),
+			handler.NewCol(BackChannelAuthRequestColumnBindingMessage, e.BindingMessage),
+			handler.NewCol(BackChannelAuthRequestColumnExpiration, e.CreationDate().Add(e.Lifetime)),
+		},
+	), nil
+}
+
+// reduceDoneEvents removes the backchannel authentication request from the projection,
+// as it is no longer pending.
+func (p *backChannelAuthRequestProjection) reduceDoneEvents(event eventstore.Event) (*handler.Statement, error) {
+	switch event.(type) {
+	case *backchannelauth.ApprovedEvent, *backchannelauth.CanceledEvent, *backchannelauth.DoneEvent:
+		return handler.NewDeleteStatement(event,
+			[]handler.Condition{
+				handler.NewCond(BackChannelAuthRequestColumnInstanceID, event.Aggregate().InstanceID),
+				handler.NewCond(BackChannelAuthRequestColumnID, event.Aggregate().ID),
+			},
+		), nil
+	default:
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "HANDL-Cba4p", "reduce.wrong.event.type %T", event)
+	}
+}
+
+func (p *backChannelAuthRequestProjection) reduceUserRemoved(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*user.UserRemovedEvent)
+	if !ok {
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "HANDL-Cba5p", "reduce.wrong.event.type %s", user.UserRemovedType)
+	}
+	return handler.NewDeleteStatement(
+		e,
+		[]handler.Condition{
+			handler.NewCond(BackChannelAuthRequestColumnInstanceID, e.Aggregate().InstanceID),
+			handler.NewCond(BackChannelAuthRequestColumnUserID, e.Aggregate().ID),
+		},
+	), nil
+}
@@ -0,0 +1,207 @@
+package projection
+
+import (
+	"testing"
+
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/eventstore/handler/v2"
+	"github.com/zitadel/zitadel/internal/repository/backchannelauth"
+	"github.com/zitadel/zitadel/internal/repository/instance"
+	"github.com/zitadel/zitadel/internal/repository/user"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+func TestBackChannelAuthRequestProjection_reduces(t *testing.T) {
+	type args struct {
+		event func(t *testing.T) eventstore.Event
+	}
+	tests := []struct {
+		name   string
+		args   args
+		reduce func(event eventstore.Event) (*handler.Statement, error)
+		want   wantReduce
+	}{
+		{
+			name: "reduceAdded",
+			args: args{
+				event: getEvent(
+					testEvent(
+						backchannelauth.AddedEventType,
+						backchannelauth.AggregateType,
+						[]byte(`{"clientID": "clientID", "userID": "userID", "userOrgID": "orgID", "scopes": ["openid"], "bindingMessage": "123", "lifetime": 300000000000}`),
+					), eventstore.GenericEventMapper[backchannelauth.AddedEvent]),
+			},
+			reduce: (&backChannelAuthRequestProjection{}).reduceAdded,
+			want: wantReduce{
+				aggregateType: backchannelauth.AggregateType,
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "INSERT INTO projections.back_channel_auth_requests (id, creation_date, change_date, sequence, instance_id, client_id, user_id, scopes, binding_message, expiration) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)",
+							expectedArgs: []interface{}{
+								"agg-id",
+								anyArg{},
+								anyArg{},
+								uint64(15),
+								"instance-id",
+								"clientID",
+								"userID",
+								[]string{"openid"},
+								"123",
+								anyArg{},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceDoneEvents approved",
+			args: args{
+				event: getEvent(
+					testEvent(
+						backchannelauth.ApprovedEventType,
+						backchannelauth.AggregateType,
+						[]byte(`{"sessionID": "sessionID"}`),
+					), eventstore.GenericEventMapper[backchannelauth.ApprovedEvent]),
+			},
+			reduce: (&backChannelAuthRequestProjection{}).reduceDoneEvents,
+			want: wantReduce{
+				aggregateType: backchannelauth.AggregateType,
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "DELETE FROM projections.back_channel_auth_requests WHERE (instance_id = $1) AND (id = $2)",
+							expectedArgs: []interface{}{
+								"instance-id",
+								"agg-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceDoneEvents canceled",
+			args: args{
+				event: getEvent(
+					testEvent(
+						backchannelauth.CanceledEventType,
+						backchannelauth.AggregateType,
+						[]byte(`{"reason": "denied"}`),
+					), eventstore.GenericEventMapper[backchannelauth.CanceledEvent]),
+			},
+			reduce: (&backChannelAuthRequestProjection{}).reduceDoneEvents,
+			want: wantReduce{
+				aggregateType: backchannelauth.AggregateType,
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "DELETE FROM projections.back_channel_auth_requests WHERE (instance_id = $1) AND (id = $2)",
+							expectedArgs: []interface{}{
+								"instance-id",
+								"agg-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceDoneEvents done",
+			args: args{
+				event: getEvent(
+					testEvent(
+						backchannelauth.DoneEventType,
+						backchannelauth.AggregateType,
+						nil,
+					), eventstore.GenericEventMapper[backchannelauth.DoneEvent]),
+			},
+			reduce: (&backChannelAuthRequestProjection{}).reduceDoneEvents,
+			want: wantReduce{
+				aggregateType: backchannelauth.AggregateType,
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "DELETE FROM projections.back_channel_auth_requests WHERE (instance_id = $1) AND (id = $2)",
+							expectedArgs: []interface{}{
+								"instance-id",
+								"agg-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceUserRemoved",
+			args: args{
+				event: getEvent(
+					testEvent(
+						user.UserRemovedType,
+						user.AggregateType,
+						nil,
+					), user.UserRemovedEventMapper),
+			},
+			reduce: (&backChannelAuthRequestProjection{}).reduceUserRemoved,
+			want: wantReduce{
+				aggregateType: user.AggregateType,
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "DELETE FROM projections.back_channel_auth_requests WHERE (instance_id = $1) AND (user_id = $2)",
+							expectedArgs: []interface{}{
+								"instance-id",
+								"agg-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "instance reduceInstanceRemoved",
+			args: args{
+				event: getEvent(
+					testEvent(
+						instance.InstanceRemovedEventType,
+						instance.AggregateType,
+						nil,
+					), instance.InstanceRemovedEventMapper),
+			},
+			reduce: reduceInstanceRemovedHelper(BackChannelAuthRequestColumnInstanceID),
+			want: wantReduce{
+				aggregateType: instance.AggregateType,
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "DELETE FROM projections.back_channel_auth_requests WHERE (instance_id = $1)",
+							expectedArgs: []interface{}{
+								"agg-id",
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			event := baseEvent(t)
+			got, err := tt.reduce(event)
+			if !zerrors.IsErrorInvalidArgument(err) {
+				t.Errorf("no wrong event mapping: %v, got: %v", err, got)
+			}
+
+			event = tt.args.event(t)
+			got, err = tt.reduce(event)
+			assertReduce(t, got, err, BackChannelAuthRequestProjectionTable, tt.want)
+		})
+	}
+}
@@ -0,0 +1,145 @@
+package projection
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	old_handler "github.com/zitadel/zitadel/internal/eventstore/handler"
+	"github.com/zitadel/zitadel/internal/eventstore/handler/v2"
+	"github.com/zitadel/zitadel/internal/repository/instance"
+	"github.com/zitadel/zitadel/internal/repository/org"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+const (
+	ClientCertificatePolicyTable = "projections.client_certificate_policies"
+
+	ClientCertificatePolicyIDCol             = "id"
+	ClientCertificatePolicyCreationDateCol   = "creation_date"
+	ClientCertificatePolicyChangeDateCol     = "change_date"
+	ClientCertificatePolicySequenceCol       = "sequence"
+	ClientCertificatePolicyResourceOwnerCol  = "resource_owner"
+	ClientCertificatePolicyInstanceIDCol     = "instance_id"
+	ClientCertificatePolicyCACertificatesCol = "ca_certificates"
+	ClientCertificatePolicyCRLsCol           = "crls"
+	ClientCertificatePolicyUserMappingCol    = "user_mapping"
+)
+
+type clientCertificatePolicyProjection struct{}
+
+func newClientCertificatePolicyProjection(ctx context.Context, config handler.Config) *handler.Handler {
+	return handler.NewHandler(ctx, &config, new(clientCertificatePolicyProjection))
+}
+
+func (*clientCertificatePolicyProjection) Name() string {
+	return ClientCertificatePolicyTable
+}
+
+func (*clientCertificatePolicyProjection) Init() *old_handler.Check {
+	return handler.NewTableCheck(
+		handler.NewTable([]*handler.InitColumn{
+			handler.NewColumn(ClientCertificatePolicyIDCol, handler.ColumnTypeText),
+			handler.NewColumn(ClientCertificatePolicyCreationDateCol, handler.ColumnTypeTimestamp),
+			handler.NewColumn(ClientCertificatePolicyChangeDateCol, handler.ColumnTypeTimestamp),
+			handler.NewColumn(ClientCertificatePolicySequenceCol, handler.ColumnTypeInt64),
+			handler.NewColumn(ClientCertificatePolicyResourceOwnerCol, handler.ColumnTypeText),
+			handler.NewColumn(ClientCertificatePolicyInstanceIDCol, handler.ColumnTypeText),
+			handler.NewColumn(ClientCertificatePolicyCACertificatesCol, handler.ColumnTypeTextArray, handler.Nullable()),
+			handler.NewColumn(ClientCertificatePolicyCRLsCol, handler.ColumnTypeTextArray, handler.Nullable()),
+			handler.NewColumn(ClientCertificatePolicyUserMappingCol, handler.ColumnTypeEnum, handler.Default(0)),
+		},
+			handler.NewPrimaryKey(ClientCertificatePolicyInstanceIDCol, ClientCertificatePolicyIDCol),
+		),
+	)
+}
+
+func (p *clientCertificatePolicyProjection) Reducers() []handler.AggregateReducer {
+	return []handler.AggregateReducer{
+		{
+			Aggregate: org.AggregateType,
+			EventReducers: []handler.EventReducer{
+				{
+					Event:  org.ClientCertificatePolicySetEventType,
+					Reduce: p.reduceSet,
+				},
+				{
+					Event:  org.ClientCertificatePolicyRemovedEventType,
+					Reduce: p.reduceRemoved,
+				},
+				{
+					Event:  org.OrgRemovedEventType,
+					Reduce: p.reduceOwnerRemoved,
+				},
+			},
+		},
+		{
+			Aggregate: instance.AggregateType,
+			EventReducers: []handler.EventReducer{
+				{
+					Event:  instance.InstanceRemovedEventType,
+					Reduce: reduceInstanceRemovedHelper(ClientCertificatePolicyInstanceIDCol),
+				},
+			},
+		},
+	}
+}
+
+func (p *clientCertificatePolicyProjection) reduceSet(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*org.ClientCertificatePolicySetEvent)
+	if !ok {
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "PROJE-Cc1Ps", "reduce.wrong.event.type %s", org.ClientCertificatePolicySetEventType)
+	}
+	cols := []handler.Column{
+		handler.NewCol(ClientCertificatePolicyIDCol, e.Aggregate().ID),
+		handler.NewCol(ClientCertificatePolicyCreationDateCol, handler.OnlySetValueOnInsert(ClientCertificatePolicyTable, e.CreationDate())),
+		handler.NewCol(ClientCertificatePolicyChangeDateCol, e.CreationDate()),
+		handler.NewCol(ClientCertificatePolicySequenceCol, e.Sequence()),
+		handler.NewCol(ClientCertificatePolicyResourceOwnerCol, e.Aggregate().ResourceOwner),
+		handler.NewCol(ClientCertificatePolicyInstanceIDCol, e.Aggregate().InstanceID),
+	}
+	if e.CACertificates != nil {
+		cols = append(cols, handler.NewCol(ClientCertificatePolicyCACertificatesCol, database.TextArray[string](*e.CACertificates)))
+	}
+	if e.CRLs != nil {
+		cols = append(cols, handler.NewCol(ClientCertificatePolicyCRLsCol, database.TextArray[string](*e.CRLs)))
+	}
+	if e.UserMapping != nil {
+		cols = append(cols, handler.NewCol(ClientCertificatePolicyUserMappingCol, *e.UserMapping))
+	}
+	return handler.NewUpsertStatement(
+		e,
+		[]handler.Column{
+			handler.NewCol(ClientCertificatePolicyInstanceIDCol, nil),
+			handler.NewCol(ClientCertificatePolicyIDCol, nil),
+		},
+		cols,
+	), nil
+}
+
+func (p *clientCertificatePolicyProjection) reduceRemoved(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*org.ClientCertificatePolicyRemovedEvent)
+	if !ok {
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "PROJE-Cc2Pr", "reduce.wrong.event.type %s", org.ClientCertificatePolicyRemovedEventType)
+	}
+	return handler.NewDeleteStatement(
+		e,
+		[]handler.Condition{
+			handler.NewCond(ClientCertificatePolicyIDCol, e.Aggregate().ID),
+			handler.NewCond(ClientCertificatePolicyInstanceIDCol, e.Aggregate().InstanceID),
+		}), nil
+}
+
+func (p *clientCertificatePolicyProjection) reduceOwnerRemoved(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*org.OrgRemovedEvent)
+	if !ok {
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "PROJE-Cc3Po", "reduce.wrong.event.type %s", org.OrgRemovedEventType)
+	}
+	return handler.NewDeleteStatement(
+		e,
+		[]handler.Condition{
+			handler.NewCond(ClientCertificatePolicyInstanceIDCol, e.Aggregate().InstanceID),
+			handler.NewCond(ClientCertificatePolicyResourceOwnerCol, e.Aggregate().ID),
+		},
+	), nil
+}
@@ -0,0 +1,192 @@
+package projection
+
+import (
+	"testing"
+
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/eventstore/handler/v2"
+	"github.com/zitadel/zitadel/internal/repository/instance"
+	"github.com/zitadel/zitadel/internal/repository/org"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+func TestClientCertificatePolicyProjection_reduces(t *testing.T) {
+	type args struct {
+		event func(t *testing.T) eventstore.Event
+	}
+	tests := []struct {
+		name   string
+		args   args
+		reduce func(event eventstore.Event) (*handler.Statement, error)
+		want   wantReduce
+	}{
+		{
+			name: "org reduceSet",
+			args: args{
+				event: getEvent(
+					testEvent(
+						org.ClientCertificatePolicySetEventType,
+						org.AggregateType,
+						[]byte(`{
+						"caCertificates": ["cert"],
+						"crls": [],
+						"userMapping": 2
+					}`),
+					), org.ClientCertificatePolicySetEventMapper),
+			},
+			reduce: (&clientCertificatePolicyProjection{}).reduceSet,
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("org"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "INSERT INTO projections.client_certificate_policies (id, creation_date, change_date, sequence, resource_owner, instance_id, ca_certificates, crls, user_mapping) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9) ON CONFLICT (instance_id, id) DO UPDATE SET (creation_date, change_date, sequence, resource_owner, ca_certificates, crls, user_mapping) = (projections.client_certificate_policies.creation_date, EXCLUDED.change_date, EXCLUDED.sequence, EXCLUDED.resource_owner, EXCLUDED.ca_certificates, EXCLUDED.crls, EXCLUDED.user_mapping)",
+							expectedArgs: []interface{}{
+								"agg-id",
+								anyArg{},
+								anyArg{},
+								uint64(15),
+								"ro-id",
+								"instance-id",
+								database.TextArray[string]{"cert"},
+								database.TextArray[string]{},
+								domain.ClientCertificateUserMappingSANUPN,
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "org reduceSet partial",
+			args: args{
+				event: getEvent(
+					testEvent(
+						org.ClientCertificatePolicySetEventType,
+						org.AggregateType,
+						[]byte(`{
+						"crls": ["crl"]
+					}`),
+					), org.ClientCertificatePolicySetEventMapper),
+			},
+			reduce: (&clientCertificatePolicyProjection{}).reduceSet,
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("org"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "INSERT INTO projections.client_certificate_policies (id, creation_date, change_date, sequence, resource_owner, instance_id, crls) VALUES ($1, $2, $3, $4, $5, $6, $7) ON CONFLICT (instance_id, id) DO UPDATE SET (creation_date, change_date, sequence, resource_owner, crls) = (projections.client_certificate_policies.creation_date, EXCLUDED.change_date, EXCLUDED.sequence, EXCLUDED.resource_owner, EXCLUDED.crls)",
+							expectedArgs: []interface{}{
+								"agg-id",
+								anyArg{},
+								anyArg{},
+								uint64(15),
+								"ro-id",
+								"instance-id",
+								database.TextArray[string]{"crl"},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "org reduceRemoved",
+			args: args{
+				event: getEvent(
+					testEvent(
+						org.ClientCertificatePolicyRemovedEventType,
+						org.AggregateType,
+						nil,
+					), org.ClientCertificatePolicyRemovedEventMapper),
+			},
+			reduce: (&clientCertificatePolicyProjection{}).reduceRemoved,
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("org"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "DELETE FROM projections.client_certificate_policies WHERE (id = $1) AND (instance_id = $2)",
+							expectedArgs: []interface{}{
+								"agg-id",
+								"instance-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "org reduceOwnerRemoved",
+			args: args{
+				event: getEvent(
+					testEvent(
+						org.OrgRemovedEventType,
+						org.AggregateType,
+						nil,
+					), org.OrgRemovedEventMapper),
+			},
+			reduce: (&clientCertificatePolicyProjection{}).reduceOwnerRemoved,
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("org"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "DELETE FROM projections.client_certificate_policies WHERE (instance_id = $1) AND (resource_owner = $2)",
+							expectedArgs: []interface{}{
+								"instance-id",
+								"agg-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "instance reduceInstanceRemoved",
+			args: args{
+				event: getEvent(
+					testEvent(
+						instance.InstanceRemovedEventType,
+						instance.AggregateType,
+						nil,
+					), instance.InstanceRemovedEventMapper),
+			},
+			reduce: reduceInstanceRemovedHelper(ClientCertificatePolicyInstanceIDCol),
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("instance"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "DELETE FROM projections.client_certificate_policies WHERE (instance_id = $1)",
+							expectedArgs: []interface{}{
+								"agg-id",
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			event := baseEvent(t)
+			got, err := tt.reduce(event)
+
+			if ok := zerrors.IsErrorInvalidArgument(err); !ok {
+				t.Errorf("no wrong event mapping: %v, got: %v", err, got)
+			}
+
+			event = tt.args.event(t)
+			got, err = tt.reduce(event)
+			assertReduce(t, got, err, ClientCertificatePolicyTable, tt.want)
+		})
+	}
+}
@@ -0,0 +1,170 @@
+package projection
+
+import (
+	"context"
+	"strings"
+
+	"github.com/zitadel/zitadel/internal/eventstore"
+	old_handler "github.com/zitadel/zitadel/internal/eventstore/handler"
+	"github.com/zitadel/zitadel/internal/eventstore/handler/v2"
+	"github.com/zitadel/zitadel/internal/repository/instance"
+	"github.com/zitadel/zitadel/internal/repository/org"
+	"github.com/zitadel/zitadel/internal/repository/user"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+const (
+	EmailSuppressionTable = "projections.email_suppressions"
+
+	EmailSuppressionUserIDCol        = "user_id"
+	EmailSuppressionCreationDateCol  = "creation_date"
+	EmailSuppressionChangeDateCol    = "change_date"
+	EmailSuppressionResourceOwnerCol = "resource_owner"
+	EmailSuppressionInstanceIDCol    = "instance_id"
+	EmailSuppressionSequenceCol      = "sequence"
+	EmailSuppressionEmailCol         = "email"
+	EmailSuppressionReasonCol        = "reason"
+	EmailSuppressionDiagnosticCol    = "diagnostic"
+)
+
+// emailSuppressionProjection holds the email addresses of users,
+// which were reported as undeliverable and must not receive further emails.
+type emailSuppressionProjection struct{}
+
+func newEmailSuppressionProjection(ctx context.Context, config handler.Config) *handler.Handler {
+	return handler.NewHandler(ctx, &config, new(emailSuppressionProjection))
+}
+
+func (*emailSuppressionProjection) Name() string {
+	return EmailSuppressionTable
+}
+
+func (*emailSuppressionProjection) Init() *old_handler.Check {
+	return handler.NewTableCheck(
+		handler.NewTable([]*handler.InitColumn{
+			handler.NewColumn(EmailSuppressionUserIDCol, handler.ColumnTypeText),
+			handler.NewColumn(EmailSuppressionCreationDateCol, handler.ColumnTypeTimestamp),
+			handler.NewColumn(EmailSuppressionChangeDateCol, handler.ColumnTypeTimestamp),
+			handler.NewColumn(EmailSuppressionResourceOwnerCol, handler.ColumnTypeText),
+			handler.NewColumn(EmailSuppressionInstanceIDCol, handler.ColumnTypeText),
+			handler.NewColumn(EmailSuppressionSequenceCol, handler.ColumnTypeInt64),
+			handler.NewColumn(EmailSuppressionEmailCol, handler.ColumnTypeText),
+			handler.NewColumn(EmailSuppressionReasonCol, handler.ColumnTypeEnum),
+			handler.NewColumn(EmailSuppressionDiagnosticCol, handler.ColumnTypeText, handler.Nullable()),
+		},
+			handler.NewPrimaryKey(EmailSuppressionInstanceIDCol, EmailSuppressionUserIDCol),
+			handler.WithIndex(handler.NewIndex("email", []string{EmailSuppressionInstanceIDCol, EmailSuppressionEmailCol})),
+		),
+	)
+}
+
+func (p *emailSuppressionProjection) Reducers() []handler.AggregateReducer {
+	return []handler.AggregateReducer{
+		{
+			Aggregate: user.AggregateType,
+			EventReducers: []handler.EventReducer{
+				{
+					Event:  user.HumanEmailUndeliverableType,
+					Reduce: p.reduceEmailUndeliverable,
+				},
+				{
+					Event:  user.UserV1EmailChangedType,
+					Reduce: p.reduceSuppressionLifted,
+				},
+				{
+					Event:  user.HumanEmailChangedType,
+					Reduce: p.reduceSuppressionLifted,
+				},
+				{
+					Event:  user.UserV1EmailVerifiedType,
+					Reduce: p.reduceSuppressionLifted,
+				},
+				{
+					Event:  user.HumanEmailVerifiedType,
+					Reduce: p.reduceSuppressionLifted,
+				},
+				{
+					Event:  user.UserRemovedType,
+					Reduce: p.reduceSuppressionLifted,
+				},
+			},
+		},
+		{
+			Aggregate: org.AggregateType,
+			EventReducers: []handler.EventReducer{
+				{
+					Event:  org.OrgRemovedEventType,
+					Reduce: p.reduceOwnerRemoved,
+				},
+			},
+		},
+		{
+			Aggregate: instance.AggregateType,
+			EventReducers: []handler.EventReducer{
+				{
+					Event:  instance.InstanceRemovedEventType,
+					Reduce: reduceInstanceRemovedHelper(EmailSuppressionInstanceIDCol),
+				},
+			},
+		},
+	}
+}
+
+func (p *emailSuppressionProjection) reduceEmailUndeliverable(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*user.HumanEmailUndeliverableEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	return handler.NewUpsertStatement(
+		e,
+		[]handler.Column{
+			handler.NewCol(EmailSuppressionInstanceIDCol, nil),
+			handler.NewCol(EmailSuppressionUserIDCol, nil),
+		},
+		[]handler.Column{
+			handler.NewCol(EmailSuppressionInstanceIDCol, e.Aggregate().InstanceID),
+			handler.NewCol(EmailSuppressionUserIDCol, e.Aggregate().ID),
+			handler.NewCol(EmailSuppressionCreationDateCol, handler.OnlySetValueOnInsert(EmailSuppressionTable, e.CreatedAt())),
+			handler.NewCol(EmailSuppressionChangeDateCol, e.CreatedAt()),
+			handler.NewCol(EmailSuppressionResourceOwnerCol, e.Aggregate().ResourceOwner),
+			handler.NewCol(EmailSuppressionSequenceCol, e.Sequence()),
+			// the address is stored in lower case, so sending can be suppressed independent of the casing of the recipient
+			handler.NewCol(EmailSuppressionEmailCol, strings.ToLower(string(e.EmailAddress))),
+			handler.NewCol(EmailSuppressionReasonCol, e.Reason),
+			handler.NewCol(EmailSuppressionDiagnosticCol, e.Diagnostic),
+		},
+	), nil
+}
+
+// reduceSuppressionLifted removes the suppression if the user got a new email address,
+// proved to receive emails on the address or was removed.
+func (p *emailSuppressionProjection) reduceSuppressionLifted(event eventstore.Event) (*handler.Statement, error) {
+	switch event.(type) {
+	case *user.HumanEmailChangedEvent,
+		*user.HumanEmailVerifiedEvent,
+		*user.UserRemovedEvent:
+	default:
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "HANDL-Bnc1p", "reduce.wrong.event.type %v", []eventstore.EventType{user.HumanEmailChangedType, user.HumanEmailVerifiedType, user.UserRemovedType})
+	}
+	return handler.NewDeleteStatement(
+		event,
+		[]handler.Condition{
+			handler.NewCond(EmailSuppressionInstanceIDCol, event.Aggregate().InstanceID),
+			handler.NewCond(EmailSuppressionUserIDCol, event.Aggregate().ID),
+		},
+	), nil
+}
+
+func (p *emailSuppressionProjection) reduceOwnerRemoved(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*org.OrgRemovedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	return handler.NewDeleteStatement(
+		e,
+		[]handler.Condition{
+			handler.NewCond(EmailSuppressionInstanceIDCol, e.Aggregate().InstanceID),
+			handler.NewCond(EmailSuppressionResourceOwnerCol, e.Aggregate().ID),
+		},
+	), nil
+}
@@ -0,0 +1,219 @@
+package projection
+
+import (
+	"testing"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/eventstore/handler/v2"
+	"github.com/zitadel/zitadel/internal/repository/instance"
+	"github.com/zitadel/zitadel/internal/repository/org"
+	"github.com/zitadel/zitadel/internal/repository/user"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+func TestEmailSuppressionProjection_reduces(t *testing.T) {
+	type args struct {
+		event func(t *testing.T) eventstore.Event
+	}
+	tests := []struct {
+		name   string
+		args   args
+		reduce handler.Reduce
+		want   wantReduce
+	}{
+		{
+			name: "reduceEmailUndeliverable",
+			args: args{
+				event: getEvent(
+					testEvent(
+						user.HumanEmailUndeliverableType,
+						user.AggregateType,
+						[]byte(`{"email": "Gigi@Example.com", "reason": 1, "diagnostic": "550 5.1.1 user unknown"}`),
+					),
+					user.HumanEmailUndeliverableEventMapper,
+				),
+			},
+			reduce: (&emailSuppressionProjection{}).reduceEmailUndeliverable,
+			want: wantReduce{
+				aggregateType: user.AggregateType,
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "INSERT INTO projections.email_suppressions (instance_id, user_id, creation_date, change_date, resource_owner, sequence, email, reason, diagnostic) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9) ON CONFLICT (instance_id, user_id) DO UPDATE SET (creation_date, change_date, resource_owner, sequence, email, reason, diagnostic) = (projections.email_suppressions.creation_date, EXCLUDED.change_date, EXCLUDED.resource_owner, EXCLUDED.sequence, EXCLUDED.email, EXCLUDED.reason, EXCLUDED.diagnostic)",
+							expectedArgs: []interface{}{
+								"instance-id",
+								"agg-id",
+								anyArg{},
+								anyArg{},
+								"ro-id",
+								uint64(15),
+								"gigi@example.com",
+								domain.EmailUndeliverableReasonBounce,
+								"550 5.1.1 user unknown",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceSuppressionLifted email changed",
+			args: args{
+				event: getEvent(
+					testEvent(
+						user.HumanEmailChangedType,
+						user.AggregateType,
+						[]byte(`{"email": "new@example.com"}`),
+					),
+					user.HumanEmailChangedEventMapper,
+				),
+			},
+			reduce: (&emailSuppressionProjection{}).reduceSuppressionLifted,
+			want: wantReduce{
+				aggregateType: user.AggregateType,
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "DELETE FROM projections.email_suppressions WHERE (instance_id = $1) AND (user_id = $2)",
+							expectedArgs: []interface{}{
+								"instance-id",
+								"agg-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceSuppressionLifted email verified",
+			args: args{
+				event: getEvent(
+					testEvent(
+						user.HumanEmailVerifiedType,
+						user.AggregateType,
+						nil,
+					),
+					user.HumanEmailVerifiedEventMapper,
+				),
+			},
+			reduce: (&emailSuppressionProjection{}).reduceSuppressionLifted,
+			want: wantReduce{
+				aggregateType: user.AggregateType,
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "DELETE FROM projections.email_suppressions WHERE (instance_id = $1) AND (user_id = $2)",
+							expectedArgs: []interface{}{
+								"instance-id",
+								"agg-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceSuppressionLifted user removed",
+			args: args{
+				event: getEvent(
+					testEvent(
+						user.UserRemovedType,
+						user.AggregateType,
+						nil,
+					),
+					user.UserRemovedEventMapper,
+				),
+			},
+			reduce: (&emailSuppressionProjection{}).reduceSuppressionLifted,
+			want: wantReduce{
+				aggregateType: user.AggregateType,
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "DELETE FROM projections.email_suppressions WHERE (instance_id = $1) AND (user_id = $2)",
+							expectedArgs: []interface{}{
+								"instance-id",
+								"agg-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "org reduceOwnerRemoved",
+			args: args{
+				event: getEvent(
+					testEvent(
+						org.OrgRemovedEventType,
+						org.AggregateType,
+						nil,
+					),
+					org.OrgRemovedEventMapper,
+				),
+			},
+			reduce: (&emailSuppressionProjection{}).reduceOwnerRemoved,
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("org"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "DELETE FROM projections.email_suppressions WHERE (instance_id = $1) AND (resource_owner = $2)",
+							expectedArgs: []interface{}{
+								"instance-id",
+								"agg-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "instance reduceInstanceRemoved",
+			args: args{
+				event: getEvent(
+					testEvent(
+						instance.InstanceRemovedEventType,
+						instance.AggregateType,
+						nil,
+					),
+					instance.InstanceRemovedEventMapper,
+				),
+			},
+			reduce: reduceInstanceRemovedHelper(EmailSuppressionInstanceIDCol),
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("instance"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "DELETE FROM projections.email_suppressions WHERE (instance_id = $1)",
+							expectedArgs: []interface{}{
+								"agg-id",
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			event := baseEvent(t)
+			got, err := tt.reduce(event)
+			if ok := zerrors.IsErrorInvalidArgument(err); !ok {
+				t.Errorf("no wrong event mapping: %v, got: %v", err, got)
+			}
+
+			event = tt.args.event(t)
+			got, err = tt.reduce(event)
+			assertReduce(t, got, err, EmailSuppressionTable, tt.want)
+		})
+	}
+}
@@ -14,19 +14,24 @@ import (
 )
 
 const (
-	LockoutPolicyTable = "projections.lockout_policies3"
+	LockoutPolicyTable = "projections.lockout_policies4"
 
-	LockoutPolicyIDCol                  = "id"
-	LockoutPolicyCreationDateCol        = "creation_date"
-	LockoutPolicyChangeDateCol          = "change_date"
-	LockoutPolicySequenceCol            = "sequence"
-	LockoutPolicyStateCol               = "state"
-	LockoutPolicyIsDefaultCol           = "is_default"
-	LockoutPolicyResourceOwnerCol       = "resource_owner"
-	LockoutPolicyInstanceIDCol          = "instance_id"
-	LockoutPolicyMaxPasswordAttemptsCol = "max_password_attempts"
-	LockoutPolicyMaxOTPAttemptsCol      = "max_otp_attempts"
-	LockoutPolicyShowLockOutFailuresCol = "show_failure"
+	LockoutPolicyIDCol                    = "id"
+	LockoutPolicyCreationDateCol          = "creation_date"
+	LockoutPolicyChangeDateCol            = "change_date"
+	LockoutPolicySequenceCol              = "sequence"
+	LockoutPolicyStateCol                 = "state"
+	LockoutPolicyIsDefaultCol             = "is_default"
+	LockoutPolicyResourceOwnerCol         = "resource_owner"
+	LockoutPolicyInstanceIDCol            = "instance_id"
+	LockoutPolicyMaxPasswordAttemptsCol   = "max_password_attempts"
+	LockoutPolicyMaxOTPAttemptsCol        = "max_otp_attempts"
+	LockoutPolicyShowLockOutFailuresCol   = "show_failure"
+	LockoutPolicyMaxIPAttemptsCol         = "max_ip_attempts"
+	LockoutPolicyMaxClientAttemptsCol     = "max_client_attempts"
+	LockoutPolicyThrottleWindowCol        = "throttle_window"
+	LockoutPolicyThrottleDelayCol         = "throttle_delay"
+	LockoutPolicyThrottleBlockDurationCol = "throttle_block_duration"
 )
 
 type lockoutPolicyProjection struct{}
@@ -53,6 +58,11 @@ func (*lockoutPolicyProjection) Init() *old_handler.Check {
 			handler.NewColumn(LockoutPolicyMaxPasswordAttemptsCol, handler.ColumnTypeInt64),
 			handler.NewColumn(LockoutPolicyMaxOTPAttemptsCol, handler.ColumnTypeInt64, handler.Default(0)),
 			handler.NewColumn(LockoutPolicyShowLockOutFailuresCol, handler.ColumnTypeBool),
+			handler.NewColumn(LockoutPolicyMaxIPAttemptsCol, handler.ColumnTypeInt64, handler.Default(0)),
+			handler.NewColumn(LockoutPolicyMaxClientAttemptsCol, handler.ColumnTypeInt64, handler.Default(0)),
+			handler.NewColumn(LockoutPolicyThrottleWindowCol, handler.ColumnTypeInt64, handler.Default(0)),
+			handler.NewColumn(LockoutPolicyThrottleDelayCol, handler.ColumnTypeInt64, handler.Default(0)),
+			handler.NewColumn(LockoutPolicyThrottleBlockDurationCol, handler.ColumnTypeInt64, handler.Default(0)),
 		},
 			handler.NewPrimaryKey(LockoutPolicyInstanceIDCol, LockoutPolicyIDCol),
 		),
@@ -126,6 +136,11 @@ func (p *lockoutPolicyProjection) reduceAdded(event eventstore.Event) (*handler.
 			handler.NewCol(LockoutPolicyMaxPasswordAttemptsCol, policyEvent.MaxPasswordAttempts),
 			handler.NewCol(LockoutPolicyMaxOTPAttemptsCol, policyEvent.MaxOTPAttempts),
 			handler.NewCol(LockoutPolicyShowLockOutFailuresCol, policyEvent.ShowLockOutFailures),
+			handler.NewCol(LockoutPolicyMaxIPAttemptsCol, policyEvent.MaxIPAttempts),
+			handler.NewCol(LockoutPolicyMaxClientAttemptsCol, policyEvent.MaxClientAttempts),
+			handler.NewCol(LockoutPolicyThrottleWindowCol, policyEvent.ThrottleWindow),
+			handler.NewCol(LockoutPolicyThrottleDelayCol, policyEvent.ThrottleDelay),
+			handler.NewCol(LockoutPolicyThrottleBlockDurationCol, policyEvent.ThrottleBlockDuration),
 			handler.NewCol(LockoutPolicyIsDefaultCol, isDefault),
 			handler.NewCol(LockoutPolicyResourceOwnerCol, policyEvent.Aggregate().ResourceOwner),
 			handler.NewCol(LockoutPolicyInstanceIDCol, policyEvent.Aggregate().InstanceID),
@@ -155,6 +170,21 @@ func (p *lockoutPolicyProjection) reduceChanged(event eventstore.Event) (*handle
 	if policyEvent.ShowLockOutFailures != nil {
 		cols = append(cols, handler.NewCol(LockoutPolicyShowLockOutFailuresCol, *policyEvent.ShowLockOutFailures))
 	}
+	if policyEvent.MaxIPAttempts != nil {
+		cols = append(cols, handler.NewCol(LockoutPolicyMaxIPAttemptsCol, *policyEvent.MaxIPAttempts))
+	}
+	if policyEvent.MaxClientAttempts != nil {
+		cols = append(cols, handler.NewCol(LockoutPolicyMaxClientAttemptsCol, *policyEvent.MaxClientAttempts))
+	}
+	if policyEvent.ThrottleWindow != nil {
+		cols = append(cols, handler.NewCol(LockoutPolicyThrottleWindowCol, *policyEvent.ThrottleWindow))
+	}
+	if policyEvent.ThrottleDelay != nil {
+		cols = append(cols, handler.NewCol(LockoutPolicyThrottleDelayCol, *policyEvent.ThrottleDelay))
+	}
+	if policyEvent.ThrottleBlockDuration != nil {
+		cols = append(cols, handler.NewCol(LockoutPolicyThrottleBlockDurationCol, *policyEvent.ThrottleBlockDuration))
+	}
 	return handler.NewUpdateStatement(
 		&policyEvent,
 		cols,
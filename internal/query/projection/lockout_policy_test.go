@@ -2,6 +2,7 @@ package projection
 
 import (
 	"testing"
+	"time"
 
 	"github.com/zitadel/zitadel/internal/domain"
 	"github.com/zitadel/zitadel/internal/eventstore"
@@ -31,7 +32,12 @@ func TestLockoutPolicyProjection_reduces(t *testing.T) {
 						[]byte(`{
 						"maxPasswordAttempts": 10,
 						"maxOTPAttempts": 10,
-						"showLockOutFailures": true
+						"showLockOutFailures": true,
+						"maxIPAttempts": 100,
+						"maxClientAttempts": 5,
+						"throttleWindow": 3600000000000,
+						"throttleDelay": 1000000000,
+						"throttleBlockDuration": 900000000000
 }`),
 					), org.LockoutPolicyAddedEventMapper),
 			},
@@ -42,7 +48,7 @@ func TestLockoutPolicyProjection_reduces(t *testing.T) {
 				executer: &testExecuter{
 					executions: []execution{
 						{
-							expectedStmt: "INSERT INTO projections.lockout_policies3 (creation_date, change_date, sequence, id, state, max_password_attempts, max_otp_attempts, show_failure, is_default, resource_owner, instance_id) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)",
+							expectedStmt: "INSERT INTO projections.lockout_policies4 (creation_date, change_date, sequence, id, state, max_password_attempts, max_otp_attempts, show_failure, max_ip_attempts, max_client_attempts, throttle_window, throttle_delay, throttle_block_duration, is_default, resource_owner, instance_id) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16)",
 							expectedArgs: []interface{}{
 								anyArg{},
 								anyArg{},
@@ -52,6 +58,11 @@ func TestLockoutPolicyProjection_reduces(t *testing.T) {
 								uint64(10),
 								uint64(10),
 								true,
+								uint64(100),
+								uint64(5),
+								time.Hour,
+								time.Second,
+								15 * time.Minute,
 								false,
 								"ro-id",
 								"instance-id",
@@ -72,7 +83,12 @@ func TestLockoutPolicyProjection_reduces(t *testing.T) {
 						[]byte(`{
 						"maxPasswordAttempts": 10,
 						"maxOTPAttempts": 10,
-						"showLockOutFailures": true
+						"showLockOutFailures": true,
+						"maxIPAttempts": 100,
+						"maxClientAttempts": 5,
+						"throttleWindow": 3600000000000,
+						"throttleDelay": 1000000000,
+						"throttleBlockDuration": 900000000000
 		}`),
 					), org.LockoutPolicyChangedEventMapper),
 			},
@@ -82,13 +98,18 @@ func TestLockoutPolicyProjection_reduces(t *testing.T) {
 				executer: &testExecuter{
 					executions: []execution{
 						{
-							expectedStmt: "UPDATE projections.lockout_policies3 SET (change_date, sequence, max_password_attempts, max_otp_attempts, show_failure) = ($1, $2, $3, $4, $5) WHERE (id = $6) AND (instance_id = $7)",
+							expectedStmt: "UPDATE projections.lockout_policies4 SET (change_date, sequence, max_password_attempts, max_otp_attempts, show_failure, max_ip_attempts, max_client_attempts, throttle_window, throttle_delay, throttle_block_duration) = ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10) WHERE (id = $11) AND (instance_id = $12)",
 							expectedArgs: []interface{}{
 								anyArg{},
 								uint64(15),
 								uint64(10),
 								uint64(10),
 								true,
+								uint64(100),
+								uint64(5),
+								time.Hour,
+								time.Second,
+								15 * time.Minute,
 								"agg-id",
 								"instance-id",
 							},
@@ -114,7 +135,7 @@ func TestLockoutPolicyProjection_reduces(t *testing.T) {
 				executer: &testExecuter{
 					executions: []execution{
 						{
-							expectedStmt: "DELETE FROM projections.lockout_policies3 WHERE (id = $1) AND (instance_id = $2)",
+							expectedStmt: "DELETE FROM projections.lockout_policies4 WHERE (id = $1) AND (instance_id = $2)",
 							expectedArgs: []interface{}{
 								"agg-id",
 								"instance-id",
@@ -141,7 +162,7 @@ func TestLockoutPolicyProjection_reduces(t *testing.T) {
 				executer: &testExecuter{
 					executions: []execution{
 						{
-							expectedStmt: "DELETE FROM projections.lockout_policies3 WHERE (instance_id = $1)",
+							expectedStmt: "DELETE FROM projections.lockout_policies4 WHERE (instance_id = $1)",
 							expectedArgs: []interface{}{
 								"agg-id",
 							},
@@ -171,7 +192,7 @@ func TestLockoutPolicyProjection_reduces(t *testing.T) {
 				executer: &testExecuter{
 					executions: []execution{
 						{
-							expectedStmt: "INSERT INTO projections.lockout_policies3 (creation_date, change_date, sequence, id, state, max_password_attempts, max_otp_attempts, show_failure, is_default, resource_owner, instance_id) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)",
+							expectedStmt: "INSERT INTO projections.lockout_policies4 (creation_date, change_date, sequence, id, state, max_password_attempts, max_otp_attempts, show_failure, max_ip_attempts, max_client_attempts, throttle_window, throttle_delay, throttle_block_duration, is_default, resource_owner, instance_id) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16)",
 							expectedArgs: []interface{}{
 								anyArg{},
 								anyArg{},
@@ -181,6 +202,11 @@ func TestLockoutPolicyProjection_reduces(t *testing.T) {
 								uint64(10),
 								uint64(10),
 								true,
+								uint64(0),
+								uint64(0),
+								time.Duration(0),
+								time.Duration(0),
+								time.Duration(0),
 								true,
 								"ro-id",
 								"instance-id",
@@ -211,7 +237,7 @@ func TestLockoutPolicyProjection_reduces(t *testing.T) {
 				executer: &testExecuter{
 					executions: []execution{
 						{
-							expectedStmt: "UPDATE projections.lockout_policies3 SET (change_date, sequence, max_password_attempts, max_otp_attempts, show_failure) = ($1, $2, $3, $4, $5) WHERE (id = $6) AND (instance_id = $7)",
+							expectedStmt: "UPDATE projections.lockout_policies4 SET (change_date, sequence, max_password_attempts, max_otp_attempts, show_failure) = ($1, $2, $3, $4, $5) WHERE (id = $6) AND (instance_id = $7)",
 							expectedArgs: []interface{}{
 								anyArg{},
 								uint64(15),
@@ -243,7 +269,7 @@ func TestLockoutPolicyProjection_reduces(t *testing.T) {
 				executer: &testExecuter{
 					executions: []execution{
 						{
-							expectedStmt: "DELETE FROM projections.lockout_policies3 WHERE (instance_id = $1) AND (resource_owner = $2)",
+							expectedStmt: "DELETE FROM projections.lockout_policies4 WHERE (instance_id = $1) AND (resource_owner = $2)",
 							expectedArgs: []interface{}{
 								"instance-id",
 								"agg-id",
@@ -13,7 +13,7 @@ import (
 )
 
 const (
-	LoginPolicyTable = "projections.login_policies5"
+	LoginPolicyTable = "projections.login_policies9"
 
 	LoginPolicyIDCol                    = "aggregate_id"
 	LoginPolicyInstanceIDCol            = "instance_id"
@@ -40,6 +40,10 @@ const (
 	MFAInitSkipLifetimeCol              = "mfa_init_skip_lifetime"
 	SecondFactorCheckLifetimeCol        = "second_factor_check_lifetime"
 	MultiFactorCheckLifetimeCol         = "multi_factor_check_lifetime"
+	ForceMFAOnHighRiskCol               = "force_mfa_on_high_risk"
+	TrustedDeviceLifetimeCol            = "trusted_device_lifetime"
+	AllowAccountRecoveryCol             = "allow_account_recovery"
+	AllowMagicLinkCol                   = "allow_magic_link"
 	LoginPolicyOwnerRemovedCol          = "owner_removed"
 )
 
@@ -81,6 +85,10 @@ func (*loginPolicyProjection) Init() *old_handler.Check {
 			handler.NewColumn(MFAInitSkipLifetimeCol, handler.ColumnTypeInt64),
 			handler.NewColumn(SecondFactorCheckLifetimeCol, handler.ColumnTypeInt64),
 			handler.NewColumn(MultiFactorCheckLifetimeCol, handler.ColumnTypeInt64),
+			handler.NewColumn(ForceMFAOnHighRiskCol, handler.ColumnTypeBool, handler.Default(false)),
+			handler.NewColumn(TrustedDeviceLifetimeCol, handler.ColumnTypeInt64, handler.Default(0)),
+			handler.NewColumn(AllowAccountRecoveryCol, handler.ColumnTypeBool, handler.Default(false)),
+			handler.NewColumn(AllowMagicLinkCol, handler.ColumnTypeBool, handler.Default(false)),
 			handler.NewColumn(LoginPolicyOwnerRemovedCol, handler.ColumnTypeBool, handler.Default(false)),
 		},
 			handler.NewPrimaryKey(LoginPolicyInstanceIDCol, LoginPolicyIDCol),
@@ -202,6 +210,10 @@ func (p *loginPolicyProjection) reduceLoginPolicyAdded(event eventstore.Event) (
 		handler.NewCol(MFAInitSkipLifetimeCol, policyEvent.MFAInitSkipLifetime),
 		handler.NewCol(SecondFactorCheckLifetimeCol, policyEvent.SecondFactorCheckLifetime),
 		handler.NewCol(MultiFactorCheckLifetimeCol, policyEvent.MultiFactorCheckLifetime),
+		handler.NewCol(ForceMFAOnHighRiskCol, policyEvent.ForceMFAOnHighRisk),
+		handler.NewCol(TrustedDeviceLifetimeCol, policyEvent.TrustedDeviceLifetime),
+		handler.NewCol(AllowAccountRecoveryCol, policyEvent.AllowAccountRecovery),
+		handler.NewCol(AllowMagicLinkCol, policyEvent.AllowMagicLink),
 	}), nil
 }
 
@@ -271,6 +283,18 @@ func (p *loginPolicyProjection) reduceLoginPolicyChanged(event eventstore.Event)
 	if policyEvent.MultiFactorCheckLifetime != nil {
 		cols = append(cols, handler.NewCol(MultiFactorCheckLifetimeCol, *policyEvent.MultiFactorCheckLifetime))
 	}
+	if policyEvent.ForceMFAOnHighRisk != nil {
+		cols = append(cols, handler.NewCol(ForceMFAOnHighRiskCol, *policyEvent.ForceMFAOnHighRisk))
+	}
+	if policyEvent.TrustedDeviceLifetime != nil {
+		cols = append(cols, handler.NewCol(TrustedDeviceLifetimeCol, *policyEvent.TrustedDeviceLifetime))
+	}
+	if policyEvent.AllowAccountRecovery != nil {
+		cols = append(cols, handler.NewCol(AllowAccountRecoveryCol, *policyEvent.AllowAccountRecovery))
+	}
+	if policyEvent.AllowMagicLink != nil {
+		cols = append(cols, handler.NewCol(AllowMagicLinkCol, *policyEvent.AllowMagicLink))
+	}
 
 	return handler.NewUpdateStatement(
 		&policyEvent,
@@ -273,7 +273,8 @@ func isMessageTemplate(template string) bool {
 		template == domain.DomainClaimedMessageType ||
 		template == domain.PasswordlessRegistrationMessageType ||
 		template == domain.PasswordChangeMessageType ||
-		template == domain.InviteUserMessageType
+		template == domain.InviteUserMessageType ||
+		template == domain.MagicLinkMessageType
 }
 func isTitle(key string) bool {
 	return key == domain.MessageTitle
@@ -0,0 +1,199 @@
+package projection
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	old_handler "github.com/zitadel/zitadel/internal/eventstore/handler"
+	"github.com/zitadel/zitadel/internal/eventstore/handler/v2"
+	"github.com/zitadel/zitadel/internal/repository/instance"
+	"github.com/zitadel/zitadel/internal/repository/notification"
+	"github.com/zitadel/zitadel/internal/repository/org"
+)
+
+const (
+	NotificationDeliveryTable = "projections.notification_deliveries"
+
+	NotificationDeliveryIDCol               = "id"
+	NotificationDeliveryCreationDateCol     = "creation_date"
+	NotificationDeliveryChangeDateCol       = "change_date"
+	NotificationDeliveryResourceOwnerCol    = "resource_owner"
+	NotificationDeliveryInstanceIDCol       = "instance_id"
+	NotificationDeliverySequenceCol         = "sequence"
+	NotificationDeliveryUserIDCol           = "user_id"
+	NotificationDeliveryTriggerEventTypeCol = "trigger_event_type"
+	NotificationDeliveryMessageTypeCol      = "message_type"
+	NotificationDeliveryChannelCol          = "channel"
+	NotificationDeliveryRecipientCol        = "recipient"
+	NotificationDeliveryProviderIDCol       = "provider_id"
+	NotificationDeliveryAttemptsCol         = "attempts"
+	NotificationDeliveryStateCol            = "state"
+	NotificationDeliveryLastErrorCol        = "last_error"
+)
+
+type notificationDeliveryProjection struct{}
+
+func newNotificationDeliveryProjection(ctx context.Context, config handler.Config) *handler.Handler {
+	return handler.NewHandler(ctx, &config, new(notificationDeliveryProjection))
+}
+
+func (*notificationDeliveryProjection) Name() string {
+	return NotificationDeliveryTable
+}
+
+func (*notificationDeliveryProjection) Init() *old_handler.Check {
+	return handler.NewTableCheck(
+		handler.NewTable([]*handler.InitColumn{
+			handler.NewColumn(NotificationDeliveryIDCol, handler.ColumnTypeText),
+			handler.NewColumn(NotificationDeliveryCreationDateCol, handler.ColumnTypeTimestamp),
+			handler.NewColumn(NotificationDeliveryChangeDateCol, handler.ColumnTypeTimestamp),
+			handler.NewColumn(NotificationDeliveryResourceOwnerCol, handler.ColumnTypeText),
+			handler.NewColumn(NotificationDeliveryInstanceIDCol, handler.ColumnTypeText),
+			handler.NewColumn(NotificationDeliverySequenceCol, handler.ColumnTypeInt64),
+			handler.NewColumn(NotificationDeliveryUserIDCol, handler.ColumnTypeText),
+			handler.NewColumn(NotificationDeliveryTriggerEventTypeCol, handler.ColumnTypeText),
+			handler.NewColumn(NotificationDeliveryMessageTypeCol, handler.ColumnTypeText),
+			handler.NewColumn(NotificationDeliveryChannelCol, handler.ColumnTypeEnum),
+			handler.NewColumn(NotificationDeliveryRecipientCol, handler.ColumnTypeText, handler.Nullable()),
+			handler.NewColumn(NotificationDeliveryProviderIDCol, handler.ColumnTypeText, handler.Nullable()),
+			handler.NewColumn(NotificationDeliveryAttemptsCol, handler.ColumnTypeInt64),
+			handler.NewColumn(NotificationDeliveryStateCol, handler.ColumnTypeEnum),
+			handler.NewColumn(NotificationDeliveryLastErrorCol, handler.ColumnTypeText, handler.Nullable()),
+		},
+			handler.NewPrimaryKey(NotificationDeliveryInstanceIDCol, NotificationDeliveryIDCol),
+			handler.WithIndex(handler.NewIndex("user_id", []string{NotificationDeliveryUserIDCol})),
+			handler.WithIndex(handler.NewIndex("state", []string{NotificationDeliveryStateCol})),
+		),
+	)
+}
+
+func (p *notificationDeliveryProjection) Reducers() []handler.AggregateReducer {
+	return []handler.AggregateReducer{
+		{
+			Aggregate: notification.AggregateType,
+			EventReducers: []handler.EventReducer{
+				{
+					Event:  notification.DeliverySucceededEventType,
+					Reduce: p.reduceDeliverySucceeded,
+				},
+				{
+					Event:  notification.DeliveryAttemptFailedEventType,
+					Reduce: p.reduceDeliveryAttemptFailed,
+				},
+				{
+					Event:  notification.DeliveryFailedEventType,
+					Reduce: p.reduceDeliveryFailed,
+				},
+				{
+					Event:  notification.DeliveryRetryRequestedEventType,
+					Reduce: p.reduceDeliveryRetryRequested,
+				},
+			},
+		},
+		{
+			Aggregate: org.AggregateType,
+			EventReducers: []handler.EventReducer{
+				{
+					Event:  org.OrgRemovedEventType,
+					Reduce: p.reduceOwnerRemoved,
+				},
+			},
+		},
+		{
+			Aggregate: instance.AggregateType,
+			EventReducers: []handler.EventReducer{
+				{
+					Event:  instance.InstanceRemovedEventType,
+					Reduce: reduceInstanceRemovedHelper(NotificationDeliveryInstanceIDCol),
+				},
+			},
+		},
+	}
+}
+
+func (p *notificationDeliveryProjection) reduceDeliverySucceeded(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*notification.DeliverySucceededEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	return p.upsertDelivery(e, &e.DeliveryInfo, domain.NotificationDeliveryStateSucceeded, nil), nil
+}
+
+func (p *notificationDeliveryProjection) reduceDeliveryAttemptFailed(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*notification.DeliveryAttemptFailedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	return p.upsertDelivery(e, &e.DeliveryInfo, domain.NotificationDeliveryStateRetrying, e.Error), nil
+}
+
+func (p *notificationDeliveryProjection) reduceDeliveryFailed(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*notification.DeliveryFailedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	return p.upsertDelivery(e, &e.DeliveryInfo, domain.NotificationDeliveryStateFailed, e.Error), nil
+}
+
+// upsertDelivery creates the notification on the first recorded attempt and updates it on the following.
+// The error of the last attempt is reset if the delivery succeeded.
+func (p *notificationDeliveryProjection) upsertDelivery(e eventstore.Event, info *notification.DeliveryInfo, state domain.NotificationDeliveryState, lastError any) *handler.Statement {
+	return handler.NewUpsertStatement(
+		e,
+		[]handler.Column{
+			handler.NewCol(NotificationDeliveryInstanceIDCol, nil),
+			handler.NewCol(NotificationDeliveryIDCol, nil),
+		},
+		[]handler.Column{
+			handler.NewCol(NotificationDeliveryInstanceIDCol, e.Aggregate().InstanceID),
+			handler.NewCol(NotificationDeliveryIDCol, e.Aggregate().ID),
+			handler.NewCol(NotificationDeliveryCreationDateCol, handler.OnlySetValueOnInsert(NotificationDeliveryTable, e.CreatedAt())),
+			handler.NewCol(NotificationDeliveryChangeDateCol, e.CreatedAt()),
+			handler.NewCol(NotificationDeliveryResourceOwnerCol, e.Aggregate().ResourceOwner),
+			handler.NewCol(NotificationDeliverySequenceCol, e.Sequence()),
+			handler.NewCol(NotificationDeliveryUserIDCol, info.Request.UserID),
+			handler.NewCol(NotificationDeliveryTriggerEventTypeCol, info.Request.EventType),
+			handler.NewCol(NotificationDeliveryMessageTypeCol, info.Request.MessageType),
+			handler.NewCol(NotificationDeliveryChannelCol, info.Request.NotificationType),
+			handler.NewCol(NotificationDeliveryRecipientCol, info.Recipient),
+			handler.NewCol(NotificationDeliveryProviderIDCol, info.ProviderID),
+			handler.NewCol(NotificationDeliveryAttemptsCol, info.Attempt),
+			handler.NewCol(NotificationDeliveryStateCol, state),
+			handler.NewCol(NotificationDeliveryLastErrorCol, lastError),
+		},
+	)
+}
+
+func (p *notificationDeliveryProjection) reduceDeliveryRetryRequested(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*notification.DeliveryRetryRequestedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	return handler.NewUpdateStatement(
+		e,
+		[]handler.Column{
+			handler.NewCol(NotificationDeliveryChangeDateCol, e.CreationDate()),
+			handler.NewCol(NotificationDeliverySequenceCol, e.Sequence()),
+			handler.NewCol(NotificationDeliveryStateCol, domain.NotificationDeliveryStateRetryRequested),
+		},
+		[]handler.Condition{
+			handler.NewCond(NotificationDeliveryInstanceIDCol, e.Aggregate().InstanceID),
+			handler.NewCond(NotificationDeliveryIDCol, e.Aggregate().ID),
+		},
+	), nil
+}
+
+func (p *notificationDeliveryProjection) reduceOwnerRemoved(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*org.OrgRemovedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	return handler.NewDeleteStatement(
+		e,
+		[]handler.Condition{
+			handler.NewCond(NotificationDeliveryInstanceIDCol, e.Aggregate().InstanceID),
+			handler.NewCond(NotificationDeliveryResourceOwnerCol, e.Aggregate().ID),
+		},
+	), nil
+}
@@ -0,0 +1,254 @@
+package projection
+
+import (
+	"testing"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/eventstore/handler/v2"
+	"github.com/zitadel/zitadel/internal/repository/instance"
+	"github.com/zitadel/zitadel/internal/repository/notification"
+	"github.com/zitadel/zitadel/internal/repository/org"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+func TestNotificationDeliveryProjection_reduces(t *testing.T) {
+	type args struct {
+		event func(t *testing.T) eventstore.Event
+	}
+	tests := []struct {
+		name   string
+		args   args
+		reduce handler.Reduce
+		want   wantReduce
+	}{
+		{
+			name: "reduceDeliverySucceeded",
+			args: args{
+				event: getEvent(
+					testEvent(
+						notification.DeliverySucceededEventType,
+						notification.AggregateType,
+						[]byte(`{"request": {"userID": "user-id", "eventType": "user.human.email.code.added", "messageType": "VerifyEmail", "notificationType": 0}, "recipient": "user@example.com", "providerId": "provider-id", "attempt": 2}`),
+					),
+					eventstore.GenericEventMapper[notification.DeliverySucceededEvent],
+				),
+			},
+			reduce: (&notificationDeliveryProjection{}).reduceDeliverySucceeded,
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("notification"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "INSERT INTO projections.notification_deliveries (instance_id, id, creation_date, change_date, resource_owner, sequence, user_id, trigger_event_type, message_type, channel, recipient, provider_id, attempts, state, last_error) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15) ON CONFLICT (instance_id, id) DO UPDATE SET (creation_date, change_date, resource_owner, sequence, user_id, trigger_event_type, message_type, channel, recipient, provider_id, attempts, state, last_error) = (projections.notification_deliveries.creation_date, EXCLUDED.change_date, EXCLUDED.resource_owner, EXCLUDED.sequence, EXCLUDED.user_id, EXCLUDED.trigger_event_type, EXCLUDED.message_type, EXCLUDED.channel, EXCLUDED.recipient, EXCLUDED.provider_id, EXCLUDED.attempts, EXCLUDED.state, EXCLUDED.last_error)",
+							expectedArgs: []interface{}{
+								"instance-id",
+								"agg-id",
+								anyArg{},
+								anyArg{},
+								"ro-id",
+								uint64(15),
+								"user-id",
+								eventstore.EventType("user.human.email.code.added"),
+								"VerifyEmail",
+								domain.NotificationTypeEmail,
+								"user@example.com",
+								"provider-id",
+								2,
+								domain.NotificationDeliveryStateSucceeded,
+								nil,
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceDeliveryAttemptFailed",
+			args: args{
+				event: getEvent(
+					testEvent(
+						notification.DeliveryAttemptFailedEventType,
+						notification.AggregateType,
+						[]byte(`{"request": {"userID": "user-id", "eventType": "user.human.phone.code.added", "messageType": "VerifyPhone", "notificationType": 1}, "recipient": "+41791234567", "providerId": "provider-id", "attempt": 1, "error": "unavailable"}`),
+					),
+					eventstore.GenericEventMapper[notification.DeliveryAttemptFailedEvent],
+				),
+			},
+			reduce: (&notificationDeliveryProjection{}).reduceDeliveryAttemptFailed,
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("notification"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "INSERT INTO projections.notification_deliveries (instance_id, id, creation_date, change_date, resource_owner, sequence, user_id, trigger_event_type, message_type, channel, recipient, provider_id, attempts, state, last_error) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15) ON CONFLICT (instance_id, id) DO UPDATE SET (creation_date, change_date, resource_owner, sequence, user_id, trigger_event_type, message_type, channel, recipient, provider_id, attempts, state, last_error) = (projections.notification_deliveries.creation_date, EXCLUDED.change_date, EXCLUDED.resource_owner, EXCLUDED.sequence, EXCLUDED.user_id, EXCLUDED.trigger_event_type, EXCLUDED.message_type, EXCLUDED.channel, EXCLUDED.recipient, EXCLUDED.provider_id, EXCLUDED.attempts, EXCLUDED.state, EXCLUDED.last_error)",
+							expectedArgs: []interface{}{
+								"instance-id",
+								"agg-id",
+								anyArg{},
+								anyArg{},
+								"ro-id",
+								uint64(15),
+								"user-id",
+								eventstore.EventType("user.human.phone.code.added"),
+								"VerifyPhone",
+								domain.NotificationTypeSms,
+								"+41791234567",
+								"provider-id",
+								1,
+								domain.NotificationDeliveryStateRetrying,
+								"unavailable",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceDeliveryFailed",
+			args: args{
+				event: getEvent(
+					testEvent(
+						notification.DeliveryFailedEventType,
+						notification.AggregateType,
+						[]byte(`{"request": {"userID": "user-id", "eventType": "user.human.email.code.added", "messageType": "VerifyEmail", "notificationType": 0}, "recipient": "user@example.com", "attempt": 3, "error": "unavailable"}`),
+					),
+					eventstore.GenericEventMapper[notification.DeliveryFailedEvent],
+				),
+			},
+			reduce: (&notificationDeliveryProjection{}).reduceDeliveryFailed,
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("notification"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "INSERT INTO projections.notification_deliveries (instance_id, id, creation_date, change_date, resource_owner, sequence, user_id, trigger_event_type, message_type, channel, recipient, provider_id, attempts, state, last_error) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15) ON CONFLICT (instance_id, id) DO UPDATE SET (creation_date, change_date, resource_owner, sequence, user_id, trigger_event_type, message_type, channel, recipient, provider_id, attempts, state, last_error) = (projections.notification_deliveries.creation_date, EXCLUDED.change_date, EXCLUDED.resource_owner, EXCLUDED.sequence, EXCLUDED.user_id, EXCLUDED.trigger_event_type, EXCLUDED.message_type, EXCLUDED.channel, EXCLUDED.recipient, EXCLUDED.provider_id, EXCLUDED.attempts, EXCLUDED.state, EXCLUDED.last_error)",
+							expectedArgs: []interface{}{
+								"instance-id",
+								"agg-id",
+								anyArg{},
+								anyArg{},
+								"ro-id",
+								uint64(15),
+								"user-id",
+								eventstore.EventType("user.human.email.code.added"),
+								"VerifyEmail",
+								domain.NotificationTypeEmail,
+								"user@example.com",
+								"",
+								3,
+								domain.NotificationDeliveryStateFailed,
+								"unavailable",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceDeliveryRetryRequested",
+			args: args{
+				event: getEvent(
+					testEvent(
+						notification.DeliveryRetryRequestedEventType,
+						notification.AggregateType,
+						[]byte(`{"request": {"notificationID": "agg-id", "userID": "user-id"}}`),
+					),
+					eventstore.GenericEventMapper[notification.DeliveryRetryRequestedEvent],
+				),
+			},
+			reduce: (&notificationDeliveryProjection{}).reduceDeliveryRetryRequested,
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("notification"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "UPDATE projections.notification_deliveries SET (change_date, sequence, state) = ($1, $2, $3) WHERE (instance_id = $4) AND (id = $5)",
+							expectedArgs: []interface{}{
+								anyArg{},
+								uint64(15),
+								domain.NotificationDeliveryStateRetryRequested,
+								"instance-id",
+								"agg-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "org reduceOwnerRemoved",
+			args: args{
+				event: getEvent(
+					testEvent(
+						org.OrgRemovedEventType,
+						org.AggregateType,
+						nil,
+					),
+					org.OrgRemovedEventMapper,
+				),
+			},
+			reduce: (&notificationDeliveryProjection{}).reduceOwnerRemoved,
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("org"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "DELETE FROM projections.notification_deliveries WHERE (instance_id = $1) AND (resource_owner = $2)",
+							expectedArgs: []interface{}{
+								"instance-id",
+								"agg-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "instance reduceInstanceRemoved",
+			args: args{
+				event: getEvent(
+					testEvent(
+						instance.InstanceRemovedEventType,
+						instance.AggregateType,
+						nil,
+					),
+					instance.InstanceRemovedEventMapper,
+				),
+			},
+			reduce: reduceInstanceRemovedHelper(NotificationDeliveryInstanceIDCol),
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("instance"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "DELETE FROM projections.notification_deliveries WHERE (instance_id = $1)",
+							expectedArgs: []interface{}{
+								"agg-id",
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			event := baseEvent(t)
+			got, err := tt.reduce(event)
+			if ok := zerrors.IsErrorInvalidArgument(err); !ok {
+				t.Errorf("no wrong event mapping: %v, got: %v", err, got)
+			}
+
+			event = tt.args.event(t)
+			got, err = tt.reduce(event)
+			assertReduce(t, got, err, NotificationDeliveryTable, tt.want)
+		})
+	}
+}
@@ -0,0 +1,170 @@
+package projection
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	old_handler "github.com/zitadel/zitadel/internal/eventstore/handler"
+	"github.com/zitadel/zitadel/internal/eventstore/handler/v2"
+	"github.com/zitadel/zitadel/internal/repository/instance"
+	"github.com/zitadel/zitadel/internal/repository/org"
+	"github.com/zitadel/zitadel/internal/repository/policy"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+const (
+	PasskeyAttestationPolicyTable = "projections.passkey_attestation_policies"
+
+	PasskeyAttestationPolicyIDCol               = "id"
+	PasskeyAttestationPolicyCreationDateCol     = "creation_date"
+	PasskeyAttestationPolicyChangeDateCol       = "change_date"
+	PasskeyAttestationPolicySequenceCol         = "sequence"
+	PasskeyAttestationPolicyIsDefaultCol        = "is_default"
+	PasskeyAttestationPolicyResourceOwnerCol    = "resource_owner"
+	PasskeyAttestationPolicyInstanceIDCol       = "instance_id"
+	PasskeyAttestationPolicyConveyanceCol       = "conveyance"
+	PasskeyAttestationPolicyRootCertificatesCol = "root_certificates"
+	PasskeyAttestationPolicyUseMetadataCol      = "use_metadata"
+	PasskeyAttestationPolicyAllowedAAGUIDsCol   = "allowed_aaguids"
+	PasskeyAttestationPolicyDeniedAAGUIDsCol    = "denied_aaguids"
+)
+
+type passkeyAttestationPolicyProjection struct{}
+
+func newPasskeyAttestationPolicyProjection(ctx context.Context, config handler.Config) *handler.Handler {
+	return handler.NewHandler(ctx, &config, new(passkeyAttestationPolicyProjection))
+}
+
+func (*passkeyAttestationPolicyProjection) Name() string {
+	return PasskeyAttestationPolicyTable
+}
+
+func (*passkeyAttestationPolicyProjection) Init() *old_handler.Check {
+	return handler.NewTableCheck(
+		handler.NewTable([]*handler.InitColumn{
+			handler.NewColumn(PasskeyAttestationPolicyIDCol, handler.ColumnTypeText),
+			handler.NewColumn(PasskeyAttestationPolicyCreationDateCol, handler.ColumnTypeTimestamp),
+			handler.NewColumn(PasskeyAttestationPolicyChangeDateCol, handler.ColumnTypeTimestamp),
+			handler.NewColumn(PasskeyAttestationPolicySequenceCol, handler.ColumnTypeInt64),
+			handler.NewColumn(PasskeyAttestationPolicyIsDefaultCol, handler.ColumnTypeBool, handler.Default(false)),
+			handler.NewColumn(PasskeyAttestationPolicyResourceOwnerCol, handler.ColumnTypeText),
+			handler.NewColumn(PasskeyAttestationPolicyInstanceIDCol, handler.ColumnTypeText),
+			handler.NewColumn(PasskeyAttestationPolicyConveyanceCol, handler.ColumnTypeEnum, handler.Default(0)),
+			handler.NewColumn(PasskeyAttestationPolicyRootCertificatesCol, handler.ColumnTypeTextArray, handler.Nullable()),
+			handler.NewColumn(PasskeyAttestationPolicyUseMetadataCol, handler.ColumnTypeBool, handler.Default(false)),
+			handler.NewColumn(PasskeyAttestationPolicyAllowedAAGUIDsCol, handler.ColumnTypeTextArray, handler.Nullable()),
+			handler.NewColumn(PasskeyAttestationPolicyDeniedAAGUIDsCol, handler.ColumnTypeTextArray, handler.Nullable()),
+		},
+			handler.NewPrimaryKey(PasskeyAttestationPolicyInstanceIDCol, PasskeyAttestationPolicyIDCol),
+		),
+	)
+}
+
+func (p *passkeyAttestationPolicyProjection) Reducers() []handler.AggregateReducer {
+	return []handler.AggregateReducer{
+		{
+			Aggregate: org.AggregateType,
+			EventReducers: []handler.EventReducer{
+				{
+					Event:  org.PasskeyAttestationPolicySetEventType,
+					Reduce: p.reduceSet,
+				},
+				{
+					Event:  org.PasskeyAttestationPolicyRemovedEventType,
+					Reduce: p.reduceRemoved,
+				},
+				{
+					Event:  org.OrgRemovedEventType,
+					Reduce: p.reduceOwnerRemoved,
+				},
+			},
+		},
+		{
+			Aggregate: instance.AggregateType,
+			EventReducers: []handler.EventReducer{
+				{
+					Event:  instance.PasskeyAttestationPolicySetEventType,
+					Reduce: p.reduceSet,
+				},
+				{
+					Event:  instance.InstanceRemovedEventType,
+					Reduce: reduceInstanceRemovedHelper(PasskeyAttestationPolicyInstanceIDCol),
+				},
+			},
+		},
+	}
+}
+
+func (p *passkeyAttestationPolicyProjection) reduceSet(event eventstore.Event) (*handler.Statement, error) {
+	var policyEvent policy.PasskeyAttestationPolicySetEvent
+	var isDefault bool
+	switch e := event.(type) {
+	case *org.PasskeyAttestationPolicySetEvent:
+		policyEvent = e.PasskeyAttestationPolicySetEvent
+	case *instance.PasskeyAttestationPolicySetEvent:
+		policyEvent = e.PasskeyAttestationPolicySetEvent
+		isDefault = true
+	default:
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "PROJE-Pa1Ks", "reduce.wrong.event.type %v", []eventstore.EventType{org.PasskeyAttestationPolicySetEventType, instance.PasskeyAttestationPolicySetEventType})
+	}
+	cols := []handler.Column{
+		handler.NewCol(PasskeyAttestationPolicyIDCol, policyEvent.Aggregate().ID),
+		handler.NewCol(PasskeyAttestationPolicyCreationDateCol, handler.OnlySetValueOnInsert(PasskeyAttestationPolicyTable, policyEvent.CreationDate())),
+		handler.NewCol(PasskeyAttestationPolicyChangeDateCol, policyEvent.CreationDate()),
+		handler.NewCol(PasskeyAttestationPolicySequenceCol, policyEvent.Sequence()),
+		handler.NewCol(PasskeyAttestationPolicyIsDefaultCol, isDefault),
+		handler.NewCol(PasskeyAttestationPolicyResourceOwnerCol, policyEvent.Aggregate().ResourceOwner),
+		handler.NewCol(PasskeyAttestationPolicyInstanceIDCol, policyEvent.Aggregate().InstanceID),
+	}
+	if policyEvent.Conveyance != nil {
+		cols = append(cols, handler.NewCol(PasskeyAttestationPolicyConveyanceCol, *policyEvent.Conveyance))
+	}
+	if policyEvent.RootCertificates != nil {
+		cols = append(cols, handler.NewCol(PasskeyAttestationPolicyRootCertificatesCol, database.TextArray[string](*policyEvent.RootCertificates)))
+	}
+	if policyEvent.UseMetadata != nil {
+		cols = append(cols, handler.NewCol(PasskeyAttestationPolicyUseMetadataCol, *policyEvent.UseMetadata))
+	}
+	if policyEvent.AllowedAAGUIDs != nil {
+		cols = append(cols, handler.NewCol(PasskeyAttestationPolicyAllowedAAGUIDsCol, database.TextArray[string](*policyEvent.AllowedAAGUIDs)))
+	}
+	if policyEvent.DeniedAAGUIDs != nil {
+		cols = append(cols, handler.NewCol(PasskeyAttestationPolicyDeniedAAGUIDsCol, database.TextArray[string](*policyEvent.DeniedAAGUIDs)))
+	}
+	return handler.NewUpsertStatement(
+		&policyEvent,
+		[]handler.Column{
+			handler.NewCol(PasskeyAttestationPolicyInstanceIDCol, nil),
+			handler.NewCol(PasskeyAttestationPolicyIDCol, nil),
+		},
+		cols,
+	), nil
+}
+
+func (p *passkeyAttestationPolicyProjection) reduceRemoved(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*org.PasskeyAttestationPolicyRemovedEvent)
+	if !ok {
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "PROJE-Pa2Kr", "reduce.wrong.event.type %s", org.PasskeyAttestationPolicyRemovedEventType)
+	}
+	return handler.NewDeleteStatement(
+		e,
+		[]handler.Condition{
+			handler.NewCond(PasskeyAttestationPolicyIDCol, e.Aggregate().ID),
+			handler.NewCond(PasskeyAttestationPolicyInstanceIDCol, e.Aggregate().InstanceID),
+		}), nil
+}
+
+func (p *passkeyAttestationPolicyProjection) reduceOwnerRemoved(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*org.OrgRemovedEvent)
+	if !ok {
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "PROJE-Pa3Ko", "reduce.wrong.event.type %s", org.OrgRemovedEventType)
+	}
+	return handler.NewDeleteStatement(
+		e,
+		[]handler.Condition{
+			handler.NewCond(PasskeyAttestationPolicyInstanceIDCol, e.Aggregate().InstanceID),
+			handler.NewCond(PasskeyAttestationPolicyResourceOwnerCol, e.Aggregate().ID),
+		},
+	), nil
+}
@@ -0,0 +1,198 @@
+package projection
+
+import (
+	"testing"
+
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/eventstore/handler/v2"
+	"github.com/zitadel/zitadel/internal/repository/instance"
+	"github.com/zitadel/zitadel/internal/repository/org"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+func TestPasskeyAttestationPolicyProjection_reduces(t *testing.T) {
+	type args struct {
+		event func(t *testing.T) eventstore.Event
+	}
+	tests := []struct {
+		name   string
+		args   args
+		reduce func(event eventstore.Event) (*handler.Statement, error)
+		want   wantReduce
+	}{
+		{
+			name: "org reduceSet",
+			args: args{
+				event: getEvent(
+					testEvent(
+						org.PasskeyAttestationPolicySetEventType,
+						org.AggregateType,
+						[]byte(`{
+						"conveyance": 2,
+						"rootCertificates": ["cert"],
+						"useMetadata": true,
+						"allowedAaguids": ["cb69481e-8ff7-4039-93ec-0a2729a154a8"],
+						"deniedAaguids": []
+					}`),
+					), org.PasskeyAttestationPolicySetEventMapper),
+			},
+			reduce: (&passkeyAttestationPolicyProjection{}).reduceSet,
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("org"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "INSERT INTO projections.passkey_attestation_policies (id, creation_date, change_date, sequence, is_default, resource_owner, instance_id, conveyance, root_certificates, use_metadata, allowed_aaguids, denied_aaguids) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12) ON CONFLICT (instance_id, id) DO UPDATE SET (creation_date, change_date, sequence, is_default, resource_owner, conveyance, root_certificates, use_metadata, allowed_aaguids, denied_aaguids) = (projections.passkey_attestation_policies.creation_date, EXCLUDED.change_date, EXCLUDED.sequence, EXCLUDED.is_default, EXCLUDED.resource_owner, EXCLUDED.conveyance, EXCLUDED.root_certificates, EXCLUDED.use_metadata, EXCLUDED.allowed_aaguids, EXCLUDED.denied_aaguids)",
+							expectedArgs: []interface{}{
+								"agg-id",
+								anyArg{},
+								anyArg{},
+								uint64(15),
+								false,
+								"ro-id",
+								"instance-id",
+								domain.AttestationConveyanceDirect,
+								database.TextArray[string]{"cert"},
+								true,
+								database.TextArray[string]{"cb69481e-8ff7-4039-93ec-0a2729a154a8"},
+								database.TextArray[string]{},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "org reduceRemoved",
+			args: args{
+				event: getEvent(
+					testEvent(
+						org.PasskeyAttestationPolicyRemovedEventType,
+						org.AggregateType,
+						nil,
+					), org.PasskeyAttestationPolicyRemovedEventMapper),
+			},
+			reduce: (&passkeyAttestationPolicyProjection{}).reduceRemoved,
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("org"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "DELETE FROM projections.passkey_attestation_policies WHERE (id = $1) AND (instance_id = $2)",
+							expectedArgs: []interface{}{
+								"agg-id",
+								"instance-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "org reduceOwnerRemoved",
+			args: args{
+				event: getEvent(
+					testEvent(
+						org.OrgRemovedEventType,
+						org.AggregateType,
+						nil,
+					), org.OrgRemovedEventMapper),
+			},
+			reduce: (&passkeyAttestationPolicyProjection{}).reduceOwnerRemoved,
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("org"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "DELETE FROM projections.passkey_attestation_policies WHERE (instance_id = $1) AND (resource_owner = $2)",
+							expectedArgs: []interface{}{
+								"instance-id",
+								"agg-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "instance reduceSet",
+			args: args{
+				event: getEvent(
+					testEvent(
+						instance.PasskeyAttestationPolicySetEventType,
+						instance.AggregateType,
+						[]byte(`{
+						"deniedAaguids": ["cb69481e-8ff7-4039-93ec-0a2729a154a8"]
+					}`),
+					), instance.PasskeyAttestationPolicySetEventMapper),
+			},
+			reduce: (&passkeyAttestationPolicyProjection{}).reduceSet,
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("instance"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "INSERT INTO projections.passkey_attestation_policies (id, creation_date, change_date, sequence, is_default, resource_owner, instance_id, denied_aaguids) VALUES ($1, $2, $3, $4, $5, $6, $7, $8) ON CONFLICT (instance_id, id) DO UPDATE SET (creation_date, change_date, sequence, is_default, resource_owner, denied_aaguids) = (projections.passkey_attestation_policies.creation_date, EXCLUDED.change_date, EXCLUDED.sequence, EXCLUDED.is_default, EXCLUDED.resource_owner, EXCLUDED.denied_aaguids)",
+							expectedArgs: []interface{}{
+								"agg-id",
+								anyArg{},
+								anyArg{},
+								uint64(15),
+								true,
+								"ro-id",
+								"instance-id",
+								database.TextArray[string]{"cb69481e-8ff7-4039-93ec-0a2729a154a8"},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "instance reduceInstanceRemoved",
+			args: args{
+				event: getEvent(
+					testEvent(
+						instance.InstanceRemovedEventType,
+						instance.AggregateType,
+						nil,
+					), instance.InstanceRemovedEventMapper),
+			},
+			reduce: reduceInstanceRemovedHelper(PasskeyAttestationPolicyInstanceIDCol),
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("instance"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "DELETE FROM projections.passkey_attestation_policies WHERE (instance_id = $1)",
+							expectedArgs: []interface{}{
+								"agg-id",
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			event := baseEvent(t)
+			got, err := tt.reduce(event)
+
+			if ok := zerrors.IsErrorInvalidArgument(err); !ok {
+				t.Errorf("no wrong event mapping: %v, got: %v", err, got)
+			}
+
+			event = tt.args.event(t)
+			got, err = tt.reduce(event)
+			assertReduce(t, got, err, PasskeyAttestationPolicyTable, tt.want)
+		})
+	}
+}
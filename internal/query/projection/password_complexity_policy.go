@@ -14,22 +14,25 @@ import (
 )
 
 const (
-	PasswordComplexityTable = "projections.password_complexity_policies2"
+	PasswordComplexityTable = "projections.password_complexity_policies4"
 
-	ComplexityPolicyIDCol            = "id"
-	ComplexityPolicyCreationDateCol  = "creation_date"
-	ComplexityPolicyChangeDateCol    = "change_date"
-	ComplexityPolicySequenceCol      = "sequence"
-	ComplexityPolicyStateCol         = "state"
-	ComplexityPolicyIsDefaultCol     = "is_default"
-	ComplexityPolicyResourceOwnerCol = "resource_owner"
-	ComplexityPolicyInstanceIDCol    = "instance_id"
-	ComplexityPolicyMinLengthCol     = "min_length"
-	ComplexityPolicyHasLowercaseCol  = "has_lowercase"
-	ComplexityPolicyHasUppercaseCol  = "has_uppercase"
-	ComplexityPolicyHasSymbolCol     = "has_symbol"
-	ComplexityPolicyHasNumberCol     = "has_number"
-	ComplexityPolicyOwnerRemovedCol  = "owner_removed"
+	ComplexityPolicyIDCol                  = "id"
+	ComplexityPolicyCreationDateCol        = "creation_date"
+	ComplexityPolicyChangeDateCol          = "change_date"
+	ComplexityPolicySequenceCol            = "sequence"
+	ComplexityPolicyStateCol               = "state"
+	ComplexityPolicyIsDefaultCol           = "is_default"
+	ComplexityPolicyResourceOwnerCol       = "resource_owner"
+	ComplexityPolicyInstanceIDCol          = "instance_id"
+	ComplexityPolicyMinLengthCol           = "min_length"
+	ComplexityPolicyHasLowercaseCol        = "has_lowercase"
+	ComplexityPolicyHasUppercaseCol        = "has_uppercase"
+	ComplexityPolicyHasSymbolCol           = "has_symbol"
+	ComplexityPolicyHasNumberCol           = "has_number"
+	ComplexityPolicyHistoryDepthCol        = "history_depth"
+	ComplexityPolicyCheckBreachedCol       = "check_breached"
+	ComplexityPolicyForceChangeBreachedCol = "force_change_breached"
+	ComplexityPolicyOwnerRemovedCol        = "owner_removed"
 )
 
 type passwordComplexityProjection struct{}
@@ -58,6 +61,9 @@ func (*passwordComplexityProjection) Init() *old_handler.Check {
 			handler.NewColumn(ComplexityPolicyHasUppercaseCol, handler.ColumnTypeBool),
 			handler.NewColumn(ComplexityPolicyHasSymbolCol, handler.ColumnTypeBool),
 			handler.NewColumn(ComplexityPolicyHasNumberCol, handler.ColumnTypeBool),
+			handler.NewColumn(ComplexityPolicyHistoryDepthCol, handler.ColumnTypeInt64, handler.Default(0)),
+			handler.NewColumn(ComplexityPolicyCheckBreachedCol, handler.ColumnTypeBool, handler.Default(false)),
+			handler.NewColumn(ComplexityPolicyForceChangeBreachedCol, handler.ColumnTypeBool, handler.Default(false)),
 			handler.NewColumn(ComplexityPolicyOwnerRemovedCol, handler.ColumnTypeBool, handler.Default(false)),
 		},
 			handler.NewPrimaryKey(ComplexityPolicyInstanceIDCol, ComplexityPolicyIDCol),
@@ -135,6 +141,9 @@ func (p *passwordComplexityProjection) reduceAdded(event eventstore.Event) (*han
 			handler.NewCol(ComplexityPolicyHasUppercaseCol, policyEvent.HasUppercase),
 			handler.NewCol(ComplexityPolicyHasSymbolCol, policyEvent.HasSymbol),
 			handler.NewCol(ComplexityPolicyHasNumberCol, policyEvent.HasNumber),
+			handler.NewCol(ComplexityPolicyHistoryDepthCol, policyEvent.HistoryDepth),
+			handler.NewCol(ComplexityPolicyCheckBreachedCol, policyEvent.CheckBreached),
+			handler.NewCol(ComplexityPolicyForceChangeBreachedCol, policyEvent.ForceChangeBreached),
 			handler.NewCol(ComplexityPolicyResourceOwnerCol, policyEvent.Aggregate().ResourceOwner),
 			handler.NewCol(ComplexityPolicyInstanceIDCol, policyEvent.Aggregate().InstanceID),
 			handler.NewCol(ComplexityPolicyIsDefaultCol, isDefault),
@@ -170,6 +179,15 @@ func (p *passwordComplexityProjection) reduceChanged(event eventstore.Event) (*h
 	if policyEvent.HasNumber != nil {
 		cols = append(cols, handler.NewCol(ComplexityPolicyHasNumberCol, *policyEvent.HasNumber))
 	}
+	if policyEvent.HistoryDepth != nil {
+		cols = append(cols, handler.NewCol(ComplexityPolicyHistoryDepthCol, *policyEvent.HistoryDepth))
+	}
+	if policyEvent.CheckBreached != nil {
+		cols = append(cols, handler.NewCol(ComplexityPolicyCheckBreachedCol, *policyEvent.CheckBreached))
+	}
+	if policyEvent.ForceChangeBreached != nil {
+		cols = append(cols, handler.NewCol(ComplexityPolicyForceChangeBreachedCol, *policyEvent.ForceChangeBreached))
+	}
 	return handler.NewUpdateStatement(
 		&policyEvent,
 		cols,
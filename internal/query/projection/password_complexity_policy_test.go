@@ -33,7 +33,10 @@ func TestPasswordComplexityProjection_reduces(t *testing.T) {
 	"hasLowercase": true,
 	"hasUppercase": true,
 	"HasNumber": true,
-	"HasSymbol": true
+	"HasSymbol": true,
+	"historyDepth": 4,
+	"checkBreached": true,
+	"forceChangeBreached": true
 }`),
 					), org.PasswordComplexityPolicyAddedEventMapper),
 			},
@@ -44,7 +47,7 @@ func TestPasswordComplexityProjection_reduces(t *testing.T) {
 				executer: &testExecuter{
 					executions: []execution{
 						{
-							expectedStmt: "INSERT INTO projections.password_complexity_policies2 (creation_date, change_date, sequence, id, state, min_length, has_lowercase, has_uppercase, has_symbol, has_number, resource_owner, instance_id, is_default) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13)",
+							expectedStmt: "INSERT INTO projections.password_complexity_policies4 (creation_date, change_date, sequence, id, state, min_length, has_lowercase, has_uppercase, has_symbol, has_number, history_depth, check_breached, force_change_breached, resource_owner, instance_id, is_default) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16)",
 							expectedArgs: []interface{}{
 								anyArg{},
 								anyArg{},
@@ -56,6 +59,9 @@ func TestPasswordComplexityProjection_reduces(t *testing.T) {
 								true,
 								true,
 								true,
+								uint64(4),
+								true,
+								true,
 								"ro-id",
 								"instance-id",
 								false,
@@ -78,7 +84,10 @@ func TestPasswordComplexityProjection_reduces(t *testing.T) {
 			"hasLowercase": true,
 			"hasUppercase": true,
 			"HasNumber": true,
-			"HasSymbol": true
+			"HasSymbol": true,
+			"historyDepth": 5,
+			"checkBreached": true,
+			"forceChangeBreached": false
 		}`),
 					), org.PasswordComplexityPolicyChangedEventMapper),
 			},
@@ -88,7 +97,7 @@ func TestPasswordComplexityProjection_reduces(t *testing.T) {
 				executer: &testExecuter{
 					executions: []execution{
 						{
-							expectedStmt: "UPDATE projections.password_complexity_policies2 SET (change_date, sequence, min_length, has_lowercase, has_uppercase, has_symbol, has_number) = ($1, $2, $3, $4, $5, $6, $7) WHERE (id = $8) AND (instance_id = $9)",
+							expectedStmt: "UPDATE projections.password_complexity_policies4 SET (change_date, sequence, min_length, has_lowercase, has_uppercase, has_symbol, has_number, history_depth, check_breached, force_change_breached) = ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10) WHERE (id = $11) AND (instance_id = $12)",
 							expectedArgs: []interface{}{
 								anyArg{},
 								uint64(15),
@@ -97,6 +106,9 @@ func TestPasswordComplexityProjection_reduces(t *testing.T) {
 								true,
 								true,
 								true,
+								uint64(5),
+								true,
+								false,
 								"agg-id",
 								"instance-id",
 							},
@@ -122,7 +134,7 @@ func TestPasswordComplexityProjection_reduces(t *testing.T) {
 				executer: &testExecuter{
 					executions: []execution{
 						{
-							expectedStmt: "DELETE FROM projections.password_complexity_policies2 WHERE (id = $1) AND (instance_id = $2)",
+							expectedStmt: "DELETE FROM projections.password_complexity_policies4 WHERE (id = $1) AND (instance_id = $2)",
 							expectedArgs: []interface{}{
 								"agg-id",
 								"instance-id",
@@ -149,7 +161,7 @@ func TestPasswordComplexityProjection_reduces(t *testing.T) {
 				executer: &testExecuter{
 					executions: []execution{
 						{
-							expectedStmt: "DELETE FROM projections.password_complexity_policies2 WHERE (instance_id = $1)",
+							expectedStmt: "DELETE FROM projections.password_complexity_policies4 WHERE (instance_id = $1)",
 							expectedArgs: []interface{}{
 								"agg-id",
 							},
@@ -181,7 +193,7 @@ func TestPasswordComplexityProjection_reduces(t *testing.T) {
 				executer: &testExecuter{
 					executions: []execution{
 						{
-							expectedStmt: "INSERT INTO projections.password_complexity_policies2 (creation_date, change_date, sequence, id, state, min_length, has_lowercase, has_uppercase, has_symbol, has_number, resource_owner, instance_id, is_default) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13)",
+							expectedStmt: "INSERT INTO projections.password_complexity_policies4 (creation_date, change_date, sequence, id, state, min_length, has_lowercase, has_uppercase, has_symbol, has_number, history_depth, check_breached, force_change_breached, resource_owner, instance_id, is_default) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16)",
 							expectedArgs: []interface{}{
 								anyArg{},
 								anyArg{},
@@ -193,6 +205,9 @@ func TestPasswordComplexityProjection_reduces(t *testing.T) {
 								true,
 								true,
 								true,
+								uint64(0),
+								false,
+								false,
 								"ro-id",
 								"instance-id",
 								true,
@@ -225,7 +240,7 @@ func TestPasswordComplexityProjection_reduces(t *testing.T) {
 				executer: &testExecuter{
 					executions: []execution{
 						{
-							expectedStmt: "UPDATE projections.password_complexity_policies2 SET (change_date, sequence, min_length, has_lowercase, has_uppercase, has_symbol, has_number) = ($1, $2, $3, $4, $5, $6, $7) WHERE (id = $8) AND (instance_id = $9)",
+							expectedStmt: "UPDATE projections.password_complexity_policies4 SET (change_date, sequence, min_length, has_lowercase, has_uppercase, has_symbol, has_number) = ($1, $2, $3, $4, $5, $6, $7) WHERE (id = $8) AND (instance_id = $9)",
 							expectedArgs: []interface{}{
 								anyArg{},
 								uint64(15),
@@ -259,7 +274,7 @@ func TestPasswordComplexityProjection_reduces(t *testing.T) {
 				executer: &testExecuter{
 					executions: []execution{
 						{
-							expectedStmt: "DELETE FROM projections.password_complexity_policies2 WHERE (instance_id = $1) AND (resource_owner = $2)",
+							expectedStmt: "DELETE FROM projections.password_complexity_policies4 WHERE (instance_id = $1) AND (resource_owner = $2)",
 							expectedArgs: []interface{}{
 								"instance-id",
 								"agg-id",
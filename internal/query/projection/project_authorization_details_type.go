@@ -0,0 +1,180 @@
+package projection
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/eventstore"
+	old_handler "github.com/zitadel/zitadel/internal/eventstore/handler"
+	"github.com/zitadel/zitadel/internal/eventstore/handler/v2"
+	"github.com/zitadel/zitadel/internal/repository/instance"
+	"github.com/zitadel/zitadel/internal/repository/org"
+	"github.com/zitadel/zitadel/internal/repository/project"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+const (
+	ProjectAuthorizationDetailsTypeProjectionTable = "projections.project_authorization_details_types"
+
+	ProjectAuthorizationDetailsTypeColumnProjectID     = "project_id"
+	ProjectAuthorizationDetailsTypeColumnType          = "type"
+	ProjectAuthorizationDetailsTypeColumnCreationDate  = "creation_date"
+	ProjectAuthorizationDetailsTypeColumnChangeDate    = "change_date"
+	ProjectAuthorizationDetailsTypeColumnSequence      = "sequence"
+	ProjectAuthorizationDetailsTypeColumnResourceOwner = "resource_owner"
+	ProjectAuthorizationDetailsTypeColumnInstanceID    = "instance_id"
+	ProjectAuthorizationDetailsTypeColumnSchema        = "schema"
+)
+
+type projectAuthorizationDetailsTypeProjection struct{}
+
+func newProjectAuthorizationDetailsTypeProjection(ctx context.Context, config handler.Config) *handler.Handler {
+	return handler.NewHandler(ctx, &config, new(projectAuthorizationDetailsTypeProjection))
+}
+
+func (*projectAuthorizationDetailsTypeProjection) Init() *old_handler.Check {
+	return handler.NewTableCheck(
+		handler.NewTable([]*handler.InitColumn{
+			handler.NewColumn(ProjectAuthorizationDetailsTypeColumnProjectID, handler.ColumnTypeText),
+			handler.NewColumn(ProjectAuthorizationDetailsTypeColumnType, handler.ColumnTypeText),
+			handler.NewColumn(ProjectAuthorizationDetailsTypeColumnCreationDate, handler.ColumnTypeTimestamp),
+			handler.NewColumn(ProjectAuthorizationDetailsTypeColumnChangeDate, handler.ColumnTypeTimestamp),
+			handler.NewColumn(ProjectAuthorizationDetailsTypeColumnSequence, handler.ColumnTypeInt64),
+			handler.NewColumn(ProjectAuthorizationDetailsTypeColumnResourceOwner, handler.ColumnTypeText),
+			handler.NewColumn(ProjectAuthorizationDetailsTypeColumnInstanceID, handler.ColumnTypeText),
+			handler.NewColumn(ProjectAuthorizationDetailsTypeColumnSchema, handler.ColumnTypeJSONB),
+		},
+			handler.NewPrimaryKey(ProjectAuthorizationDetailsTypeColumnInstanceID, ProjectAuthorizationDetailsTypeColumnProjectID, ProjectAuthorizationDetailsTypeColumnType),
+		),
+	)
+}
+
+func (*projectAuthorizationDetailsTypeProjection) Name() string {
+	return ProjectAuthorizationDetailsTypeProjectionTable
+}
+
+func (p *projectAuthorizationDetailsTypeProjection) Reducers() []handler.AggregateReducer {
+	return []handler.AggregateReducer{
+		{
+			Aggregate: project.AggregateType,
+			EventReducers: []handler.EventReducer{
+				{
+					Event:  project.AuthorizationDetailsTypeAddedType,
+					Reduce: p.reduceAdded,
+				},
+				{
+					Event:  project.AuthorizationDetailsTypeChangedType,
+					Reduce: p.reduceChanged,
+				},
+				{
+					Event:  project.AuthorizationDetailsTypeRemovedType,
+					Reduce: p.reduceRemoved,
+				},
+				{
+					Event:  project.ProjectRemovedType,
+					Reduce: p.reduceProjectRemoved,
+				},
+			},
+		},
+		{
+			Aggregate: org.AggregateType,
+			EventReducers: []handler.EventReducer{
+				{
+					Event:  org.OrgRemovedEventType,
+					Reduce: p.reduceOwnerRemoved,
+				},
+			},
+		},
+		{
+			Aggregate: instance.AggregateType,
+			EventReducers: []handler.EventReducer{
+				{
+					Event:  instance.InstanceRemovedEventType,
+					Reduce: reduceInstanceRemovedHelper(ProjectAuthorizationDetailsTypeColumnInstanceID),
+				},
+			},
+		},
+	}
+}
+
+func (p *projectAuthorizationDetailsTypeProjection) reduceAdded(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*project.AuthorizationDetailsTypeAddedEvent)
+	if !ok {
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "HANDL-Rar1a", "reduce.wrong.event.type %s", project.AuthorizationDetailsTypeAddedType)
+	}
+	return handler.NewCreateStatement(
+		e,
+		[]handler.Column{
+			handler.NewCol(ProjectAuthorizationDetailsTypeColumnType, e.DetailsType),
+			handler.NewCol(ProjectAuthorizationDetailsTypeColumnProjectID, e.Aggregate().ID),
+			handler.NewCol(ProjectAuthorizationDetailsTypeColumnCreationDate, e.CreationDate()),
+			handler.NewCol(ProjectAuthorizationDetailsTypeColumnChangeDate, e.CreationDate()),
+			handler.NewCol(ProjectAuthorizationDetailsTypeColumnResourceOwner, e.Aggregate().ResourceOwner),
+			handler.NewCol(ProjectAuthorizationDetailsTypeColumnInstanceID, e.Aggregate().InstanceID),
+			handler.NewCol(ProjectAuthorizationDetailsTypeColumnSequence, e.Sequence()),
+			handler.NewCol(ProjectAuthorizationDetailsTypeColumnSchema, e.Schema),
+		},
+	), nil
+}
+
+func (p *projectAuthorizationDetailsTypeProjection) reduceChanged(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*project.AuthorizationDetailsTypeChangedEvent)
+	if !ok {
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "HANDL-Rar2c", "reduce.wrong.event.type %s", project.AuthorizationDetailsTypeChangedType)
+	}
+	return handler.NewUpdateStatement(
+		e,
+		[]handler.Column{
+			handler.NewCol(ProjectAuthorizationDetailsTypeColumnChangeDate, e.CreationDate()),
+			handler.NewCol(ProjectAuthorizationDetailsTypeColumnSequence, e.Sequence()),
+			handler.NewCol(ProjectAuthorizationDetailsTypeColumnSchema, e.Schema),
+		},
+		[]handler.Condition{
+			handler.NewCond(ProjectAuthorizationDetailsTypeColumnType, e.DetailsType),
+			handler.NewCond(ProjectAuthorizationDetailsTypeColumnProjectID, e.Aggregate().ID),
+			handler.NewCond(ProjectAuthorizationDetailsTypeColumnInstanceID, e.Aggregate().InstanceID),
+		},
+	), nil
+}
+
+func (p *projectAuthorizationDetailsTypeProjection) reduceRemoved(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*project.AuthorizationDetailsTypeRemovedEvent)
+	if !ok {
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "HANDL-Rar3r", "reduce.wrong.event.type %s", project.AuthorizationDetailsTypeRemovedType)
+	}
+	return handler.NewDeleteStatement(
+		e,
+		[]handler.Condition{
+			handler.NewCond(ProjectAuthorizationDetailsTypeColumnType, e.DetailsType),
+			handler.NewCond(ProjectAuthorizationDetailsTypeColumnProjectID, e.Aggregate().ID),
+			handler.NewCond(ProjectAuthorizationDetailsTypeColumnInstanceID, e.Aggregate().InstanceID),
+		},
+	), nil
+}
+
+func (p *projectAuthorizationDetailsTypeProjection) reduceProjectRemoved(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*project.ProjectRemovedEvent)
+	if !ok {
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "HANDL-Rar4p", "reduce.wrong.event.type %s", project.ProjectRemovedType)
+	}
+	return handler.NewDeleteStatement(
+		e,
+		[]handler.Condition{
+			handler.NewCond(ProjectAuthorizationDetailsTypeColumnProjectID, e.Aggregate().ID),
+			handler.NewCond(ProjectAuthorizationDetailsTypeColumnInstanceID, e.Aggregate().InstanceID),
+		},
+	), nil
+}
+
+func (p *projectAuthorizationDetailsTypeProjection) reduceOwnerRemoved(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*org.OrgRemovedEvent)
+	if !ok {
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "HANDL-Rar5o", "reduce.wrong.event.type %s", org.OrgRemovedEventType)
+	}
+	return handler.NewDeleteStatement(
+		e,
+		[]handler.Condition{
+			handler.NewCond(ProjectAuthorizationDetailsTypeColumnInstanceID, e.Aggregate().InstanceID),
+			handler.NewCond(ProjectAuthorizationDetailsTypeColumnResourceOwner, e.Aggregate().ID),
+		},
+	), nil
+}
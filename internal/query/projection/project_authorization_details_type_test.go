@@ -0,0 +1,211 @@
+package projection
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/eventstore/handler/v2"
+	"github.com/zitadel/zitadel/internal/repository/instance"
+	"github.com/zitadel/zitadel/internal/repository/org"
+	"github.com/zitadel/zitadel/internal/repository/project"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+func TestProjectAuthorizationDetailsTypeProjection_reduces(t *testing.T) {
+	type args struct {
+		event func(t *testing.T) eventstore.Event
+	}
+	tests := []struct {
+		name   string
+		args   args
+		reduce func(event eventstore.Event) (*handler.Statement, error)
+		want   wantReduce
+	}{
+		{
+			name: "reduceAdded",
+			args: args{
+				event: getEvent(
+					testEvent(
+						project.AuthorizationDetailsTypeAddedType,
+						project.AggregateType,
+						[]byte(`{"type": "payment", "schema": {"type":"object"}}`),
+					), eventstore.GenericEventMapper[project.AuthorizationDetailsTypeAddedEvent]),
+			},
+			reduce: (&projectAuthorizationDetailsTypeProjection{}).reduceAdded,
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("project"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "INSERT INTO projections.project_authorization_details_types (type, project_id, creation_date, change_date, resource_owner, instance_id, sequence, schema) VALUES ($1, $2, $3, $4, $5, $6, $7, $8)",
+							expectedArgs: []interface{}{
+								"payment",
+								"agg-id",
+								anyArg{},
+								anyArg{},
+								"ro-id",
+								"instance-id",
+								uint64(15),
+								json.RawMessage(`{"type":"object"}`),
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceChanged",
+			args: args{
+				event: getEvent(
+					testEvent(
+						project.AuthorizationDetailsTypeChangedType,
+						project.AggregateType,
+						[]byte(`{"type": "payment", "schema": {"type":"array"}}`),
+					), eventstore.GenericEventMapper[project.AuthorizationDetailsTypeChangedEvent]),
+			},
+			reduce: (&projectAuthorizationDetailsTypeProjection{}).reduceChanged,
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("project"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "UPDATE projections.project_authorization_details_types SET (change_date, sequence, schema) = ($1, $2, $3) WHERE (type = $4) AND (project_id = $5) AND (instance_id = $6)",
+							expectedArgs: []interface{}{
+								anyArg{},
+								uint64(15),
+								json.RawMessage(`{"type":"array"}`),
+								"payment",
+								"agg-id",
+								"instance-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceRemoved",
+			args: args{
+				event: getEvent(
+					testEvent(
+						project.AuthorizationDetailsTypeRemovedType,
+						project.AggregateType,
+						[]byte(`{"type": "payment"}`),
+					), eventstore.GenericEventMapper[project.AuthorizationDetailsTypeRemovedEvent]),
+			},
+			reduce: (&projectAuthorizationDetailsTypeProjection{}).reduceRemoved,
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("project"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "DELETE FROM projections.project_authorization_details_types WHERE (type = $1) AND (project_id = $2) AND (instance_id = $3)",
+							expectedArgs: []interface{}{
+								"payment",
+								"agg-id",
+								"instance-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceProjectRemoved",
+			args: args{
+				event: getEvent(
+					testEvent(
+						project.ProjectRemovedType,
+						project.AggregateType,
+						nil,
+					), project.ProjectRemovedEventMapper),
+			},
+			reduce: (&projectAuthorizationDetailsTypeProjection{}).reduceProjectRemoved,
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("project"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "DELETE FROM projections.project_authorization_details_types WHERE (project_id = $1) AND (instance_id = $2)",
+							expectedArgs: []interface{}{
+								"agg-id",
+								"instance-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name:   "org reduceOwnerRemoved",
+			reduce: (&projectAuthorizationDetailsTypeProjection{}).reduceOwnerRemoved,
+			args: args{
+				event: getEvent(
+					testEvent(
+						org.OrgRemovedEventType,
+						org.AggregateType,
+						nil,
+					), org.OrgRemovedEventMapper),
+			},
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("org"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "DELETE FROM projections.project_authorization_details_types WHERE (instance_id = $1) AND (resource_owner = $2)",
+							expectedArgs: []interface{}{
+								"instance-id",
+								"agg-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "instance reduceInstanceRemoved",
+			args: args{
+				event: getEvent(
+					testEvent(
+						instance.InstanceRemovedEventType,
+						instance.AggregateType,
+						nil,
+					), instance.InstanceRemovedEventMapper),
+			},
+			reduce: reduceInstanceRemovedHelper(ProjectAuthorizationDetailsTypeColumnInstanceID),
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("instance"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "DELETE FROM projections.project_authorization_details_types WHERE (instance_id = $1)",
+							expectedArgs: []interface{}{
+								"agg-id",
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			event := baseEvent(t)
+			got, err := tt.reduce(event)
+			if ok := zerrors.IsErrorInvalidArgument(err); !ok {
+				t.Errorf("no wrong event mapping: %v, got: %v", err, got)
+			}
+
+			event = tt.args.event(t)
+			got, err = tt.reduce(event)
+			assertReduce(t, got, err, ProjectAuthorizationDetailsTypeProjectionTable, tt.want)
+		})
+	}
+}
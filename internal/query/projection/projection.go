@@ -34,6 +34,7 @@ var (
 	LabelPolicyProjection               *handler.Handler
 	ProjectGrantProjection              *handler.Handler
 	ProjectRoleProjection               *handler.Handler
+	AuthorizationDetailsTypeProjection  *handler.Handler
 	OrgDomainProjection                 *handler.Handler
 	LoginPolicyProjection               *handler.Handler
 	IDPProjection                       *handler.Handler
@@ -54,6 +55,8 @@ var (
 	ProjectGrantMemberProjection        *handler.Handler
 	AuthNKeyProjection                  *handler.Handler
 	PersonalAccessTokenProjection       *handler.Handler
+	TrustedDeviceProjection             *handler.Handler
+	AccountRecoveryRequestProjection    *handler.Handler
 	UserGrantProjection                 *handler.Handler
 	UserMetadataProjection              *handler.Handler
 	UserAuthMethodProjection            *handler.Handler
@@ -66,10 +69,13 @@ var (
 	KeyProjection                       *handler.Handler
 	SecurityPolicyProjection            *handler.Handler
 	NotificationPolicyProjection        *handler.Handler
+	PasskeyAttestationPolicyProjection  *handler.Handler
+	ClientCertificatePolicyProjection   *handler.Handler
 	NotificationsProjection             interface{}
 	NotificationsQuotaProjection        interface{}
 	TelemetryPusherProjection           interface{}
 	DeviceAuthProjection                *handler.Handler
+	BackChannelAuthRequestProjection    *handler.Handler
 	SessionProjection                   *handler.Handler
 	AuthRequestProjection               *handler.Handler
 	SamlRequestProjection               *handler.Handler
@@ -81,6 +87,9 @@ var (
 	InstanceFeatureProjection           *handler.Handler
 	TargetProjection                    *handler.Handler
 	ExecutionProjection                 *handler.Handler
+	ProvisioningTargetProjection        *handler.Handler
+	NotificationDeliveryProjection      *handler.Handler
+	EmailSuppressionProjection          *handler.Handler
 	UserSchemaProjection                *handler.Handler
 	WebKeyProjection                    *handler.Handler
 	DebugEventsProjection               *handler.Handler
@@ -130,6 +139,7 @@ func Create(ctx context.Context, sqlClient *database.DB, es handler.EventStore,
 	LabelPolicyProjection = newLabelPolicyProjection(ctx, applyCustomConfig(projectionConfig, config.Customizations["label_policy"]))
 	ProjectGrantProjection = newProjectGrantProjection(ctx, applyCustomConfig(projectionConfig, config.Customizations["project_grants"]))
 	ProjectRoleProjection = newProjectRoleProjection(ctx, applyCustomConfig(projectionConfig, config.Customizations["project_roles"]))
+	AuthorizationDetailsTypeProjection = newProjectAuthorizationDetailsTypeProjection(ctx, applyCustomConfig(projectionConfig, config.Customizations["project_authorization_details_types"]))
 	OrgDomainProjection = newOrgDomainProjection(ctx, applyCustomConfig(projectionConfig, config.Customizations["org_domains"]))
 	LoginPolicyProjection = newLoginPolicyProjection(ctx, applyCustomConfig(projectionConfig, config.Customizations["login_policies"]))
 	IDPProjection = newIDPProjection(ctx, applyCustomConfig(projectionConfig, config.Customizations["idps"]))
@@ -150,6 +160,8 @@ func Create(ctx context.Context, sqlClient *database.DB, es handler.EventStore,
 	ProjectGrantMemberProjection = newProjectGrantMemberProjection(ctx, applyCustomConfig(projectionConfig, config.Customizations["project_grant_members"]))
 	AuthNKeyProjection = newAuthNKeyProjection(ctx, applyCustomConfig(projectionConfig, config.Customizations["authn_keys"]))
 	PersonalAccessTokenProjection = newPersonalAccessTokenProjection(ctx, applyCustomConfig(projectionConfig, config.Customizations["personal_access_tokens"]))
+	TrustedDeviceProjection = newTrustedDeviceProjection(ctx, applyCustomConfig(projectionConfig, config.Customizations["trusted_devices"]))
+	AccountRecoveryRequestProjection = newAccountRecoveryRequestProjection(ctx, applyCustomConfig(projectionConfig, config.Customizations["account_recovery_requests"]))
 	UserGrantProjection = newUserGrantProjection(ctx, applyCustomConfig(projectionConfig, config.Customizations["user_grants"]))
 	UserMetadataProjection = newUserMetadataProjection(ctx, applyCustomConfig(projectionConfig, config.Customizations["user_metadata"]))
 	UserAuthMethodProjection = newUserAuthMethodProjection(ctx, applyCustomConfig(projectionConfig, config.Customizations["user_auth_method"]))
@@ -162,7 +174,10 @@ func Create(ctx context.Context, sqlClient *database.DB, es handler.EventStore,
 	KeyProjection = newKeyProjection(ctx, applyCustomConfig(projectionConfig, config.Customizations["keys"]), keyEncryptionAlgorithm, certEncryptionAlgorithm)
 	SecurityPolicyProjection = newSecurityPolicyProjection(ctx, applyCustomConfig(projectionConfig, config.Customizations["security_policies"]))
 	NotificationPolicyProjection = newNotificationPolicyProjection(ctx, applyCustomConfig(projectionConfig, config.Customizations["notification_policies"]))
+	PasskeyAttestationPolicyProjection = newPasskeyAttestationPolicyProjection(ctx, applyCustomConfig(projectionConfig, config.Customizations["passkey_attestation_policies"]))
+	ClientCertificatePolicyProjection = newClientCertificatePolicyProjection(ctx, applyCustomConfig(projectionConfig, config.Customizations["client_certificate_policies"]))
 	DeviceAuthProjection = newDeviceAuthProjection(ctx, applyCustomConfig(projectionConfig, config.Customizations["device_auth"]))
+	BackChannelAuthRequestProjection = newBackChannelAuthRequestProjection(ctx, applyCustomConfig(projectionConfig, config.Customizations["back_channel_auth_requests"]))
 	SessionProjection = newSessionProjection(ctx, applyCustomConfig(projectionConfig, config.Customizations["sessions"]))
 	AuthRequestProjection = newAuthRequestProjection(ctx, applyCustomConfig(projectionConfig, config.Customizations["auth_requests"]))
 	SamlRequestProjection = newSamlRequestProjection(ctx, applyCustomConfig(projectionConfig, config.Customizations["saml_requests"]))
@@ -174,6 +189,9 @@ func Create(ctx context.Context, sqlClient *database.DB, es handler.EventStore,
 	InstanceFeatureProjection = newInstanceFeatureProjection(ctx, applyCustomConfig(projectionConfig, config.Customizations["instance_features"]))
 	TargetProjection = newTargetProjection(ctx, applyCustomConfig(projectionConfig, config.Customizations["targets"]))
 	ExecutionProjection = newExecutionProjection(ctx, applyCustomConfig(projectionConfig, config.Customizations["executions"]))
+	ProvisioningTargetProjection = newProvisioningTargetProjection(ctx, applyCustomConfig(projectionConfig, config.Customizations["provisioning_targets"]))
+	NotificationDeliveryProjection = newNotificationDeliveryProjection(ctx, applyCustomConfig(projectionConfig, config.Customizations["notification_deliveries"]))
+	EmailSuppressionProjection = newEmailSuppressionProjection(ctx, applyCustomConfig(projectionConfig, config.Customizations["email_suppressions"]))
 	UserSchemaProjection = newUserSchemaProjection(ctx, applyCustomConfig(projectionConfig, config.Customizations["user_schemas"]))
 	WebKeyProjection = newWebKeyProjection(ctx, applyCustomConfig(projectionConfig, config.Customizations["web_keys"]))
 	DebugEventsProjection = newDebugEventsProjection(ctx, applyCustomConfig(projectionConfig, config.Customizations["debug_events"]))
@@ -288,6 +306,7 @@ func newProjectionsList() {
 		LabelPolicyProjection,
 		ProjectGrantProjection,
 		ProjectRoleProjection,
+		AuthorizationDetailsTypeProjection,
 		OrgDomainProjection,
 		LoginPolicyProjection,
 		IDPProjection,
@@ -308,6 +327,8 @@ func newProjectionsList() {
 		ProjectGrantMemberProjection,
 		AuthNKeyProjection,
 		PersonalAccessTokenProjection,
+		TrustedDeviceProjection,
+		AccountRecoveryRequestProjection,
 		UserGrantProjection,
 		UserMetadataProjection,
 		UserAuthMethodProjection,
@@ -320,7 +341,10 @@ func newProjectionsList() {
 		KeyProjection,
 		SecurityPolicyProjection,
 		NotificationPolicyProjection,
+		PasskeyAttestationPolicyProjection,
+		ClientCertificatePolicyProjection,
 		DeviceAuthProjection,
+		BackChannelAuthRequestProjection,
 		SessionProjection,
 		AuthRequestProjection,
 		SamlRequestProjection,
@@ -332,6 +356,9 @@ func newProjectionsList() {
 		InstanceFeatureProjection,
 		TargetProjection,
 		ExecutionProjection,
+		ProvisioningTargetProjection,
+		NotificationDeliveryProjection,
+		EmailSuppressionProjection,
 		UserSchemaProjection,
 		WebKeyProjection,
 		DebugEventsProjection,
@@ -0,0 +1,347 @@
+package projection
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	old_handler "github.com/zitadel/zitadel/internal/eventstore/handler"
+	"github.com/zitadel/zitadel/internal/eventstore/handler/v2"
+	"github.com/zitadel/zitadel/internal/repository/instance"
+	"github.com/zitadel/zitadel/internal/repository/org"
+	"github.com/zitadel/zitadel/internal/repository/project"
+	"github.com/zitadel/zitadel/internal/repository/provisioning"
+)
+
+const (
+	ProvisioningTargetTable      = "projections.provisioning_targets"
+	ProvisioningTargetUsersTable = ProvisioningTargetTable + "_" + provisioningTargetUsersTableSuffix
+
+	ProvisioningTargetIDCol            = "id"
+	ProvisioningTargetProjectIDCol     = "project_id"
+	ProvisioningTargetCreationDateCol  = "creation_date"
+	ProvisioningTargetChangeDateCol    = "change_date"
+	ProvisioningTargetResourceOwnerCol = "resource_owner"
+	ProvisioningTargetInstanceIDCol    = "instance_id"
+	ProvisioningTargetSequenceCol      = "sequence"
+	ProvisioningTargetEndpointCol      = "endpoint"
+	ProvisioningTargetTokenCol         = "token"
+
+	provisioningTargetUsersTableSuffix        = "users"
+	ProvisioningTargetUserTargetIDCol         = "target_id"
+	ProvisioningTargetUserInstanceIDCol       = "instance_id"
+	ProvisioningTargetUserUserIDCol           = "user_id"
+	ProvisioningTargetUserRemoteIDCol         = "remote_id"
+	ProvisioningTargetUserSyncStateCol        = "sync_state"
+	ProvisioningTargetUserErrorCol            = "error"
+	ProvisioningTargetUserTriggerEventTypeCol = "trigger_event_type"
+	ProvisioningTargetUserChangeDateCol       = "change_date"
+	ProvisioningTargetUserSequenceCol         = "sequence"
+	ProvisioningTargetUserResourceOwnerCol    = "resource_owner"
+)
+
+type provisioningTargetProjection struct{}
+
+func newProvisioningTargetProjection(ctx context.Context, config handler.Config) *handler.Handler {
+	return handler.NewHandler(ctx, &config, new(provisioningTargetProjection))
+}
+
+func (*provisioningTargetProjection) Name() string {
+	return ProvisioningTargetTable
+}
+
+func (*provisioningTargetProjection) Init() *old_handler.Check {
+	return handler.NewMultiTableCheck(
+		handler.NewTable([]*handler.InitColumn{
+			handler.NewColumn(ProvisioningTargetIDCol, handler.ColumnTypeText),
+			handler.NewColumn(ProvisioningTargetProjectIDCol, handler.ColumnTypeText),
+			handler.NewColumn(ProvisioningTargetCreationDateCol, handler.ColumnTypeTimestamp),
+			handler.NewColumn(ProvisioningTargetChangeDateCol, handler.ColumnTypeTimestamp),
+			handler.NewColumn(ProvisioningTargetResourceOwnerCol, handler.ColumnTypeText),
+			handler.NewColumn(ProvisioningTargetInstanceIDCol, handler.ColumnTypeText),
+			handler.NewColumn(ProvisioningTargetSequenceCol, handler.ColumnTypeInt64),
+			handler.NewColumn(ProvisioningTargetEndpointCol, handler.ColumnTypeText),
+			handler.NewColumn(ProvisioningTargetTokenCol, handler.ColumnTypeJSONB),
+		},
+			handler.NewPrimaryKey(ProvisioningTargetInstanceIDCol, ProvisioningTargetIDCol),
+			handler.WithIndex(handler.NewIndex("project_id", []string{ProvisioningTargetProjectIDCol})),
+		),
+		handler.NewSuffixedTable([]*handler.InitColumn{
+			handler.NewColumn(ProvisioningTargetUserTargetIDCol, handler.ColumnTypeText),
+			handler.NewColumn(ProvisioningTargetUserInstanceIDCol, handler.ColumnTypeText),
+			handler.NewColumn(ProvisioningTargetUserUserIDCol, handler.ColumnTypeText),
+			handler.NewColumn(ProvisioningTargetUserResourceOwnerCol, handler.ColumnTypeText),
+			handler.NewColumn(ProvisioningTargetUserRemoteIDCol, handler.ColumnTypeText, handler.Nullable()),
+			handler.NewColumn(ProvisioningTargetUserSyncStateCol, handler.ColumnTypeEnum),
+			handler.NewColumn(ProvisioningTargetUserErrorCol, handler.ColumnTypeText, handler.Nullable()),
+			handler.NewColumn(ProvisioningTargetUserTriggerEventTypeCol, handler.ColumnTypeText),
+			handler.NewColumn(ProvisioningTargetUserChangeDateCol, handler.ColumnTypeTimestamp),
+			handler.NewColumn(ProvisioningTargetUserSequenceCol, handler.ColumnTypeInt64),
+		},
+			handler.NewPrimaryKey(ProvisioningTargetUserInstanceIDCol, ProvisioningTargetUserTargetIDCol, ProvisioningTargetUserUserIDCol),
+			provisioningTargetUsersTableSuffix,
+			handler.WithIndex(handler.NewIndex("user_id", []string{ProvisioningTargetUserUserIDCol})),
+		),
+	)
+}
+
+func (p *provisioningTargetProjection) Reducers() []handler.AggregateReducer {
+	return []handler.AggregateReducer{
+		{
+			Aggregate: provisioning.AggregateType,
+			EventReducers: []handler.EventReducer{
+				{
+					Event:  provisioning.AddedEventType,
+					Reduce: p.reduceAdded,
+				},
+				{
+					Event:  provisioning.ChangedEventType,
+					Reduce: p.reduceChanged,
+				},
+				{
+					Event:  provisioning.RemovedEventType,
+					Reduce: p.reduceRemoved,
+				},
+				{
+					Event:  provisioning.UserSyncSucceededEventType,
+					Reduce: p.reduceUserSyncSucceeded,
+				},
+				{
+					Event:  provisioning.UserSyncFailedEventType,
+					Reduce: p.reduceUserSyncFailed,
+				},
+			},
+		},
+		{
+			Aggregate: project.AggregateType,
+			EventReducers: []handler.EventReducer{
+				{
+					Event:  project.ApplicationRemovedType,
+					Reduce: p.reduceAppRemoved,
+				},
+				{
+					Event:  project.ProjectRemovedType,
+					Reduce: p.reduceProjectRemoved,
+				},
+			},
+		},
+		{
+			Aggregate: org.AggregateType,
+			EventReducers: []handler.EventReducer{
+				{
+					Event:  org.OrgRemovedEventType,
+					Reduce: p.reduceOwnerRemoved,
+				},
+			},
+		},
+		{
+			Aggregate: instance.AggregateType,
+			EventReducers: []handler.EventReducer{
+				{
+					Event:  instance.InstanceRemovedEventType,
+					Reduce: p.reduceInstanceRemoved,
+				},
+			},
+		},
+	}
+}
+
+func (p *provisioningTargetProjection) reduceAdded(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*provisioning.AddedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	return handler.NewCreateStatement(
+		e,
+		[]handler.Column{
+			handler.NewCol(ProvisioningTargetInstanceIDCol, e.Aggregate().InstanceID),
+			handler.NewCol(ProvisioningTargetResourceOwnerCol, e.Aggregate().ResourceOwner),
+			handler.NewCol(ProvisioningTargetIDCol, e.Aggregate().ID),
+			handler.NewCol(ProvisioningTargetProjectIDCol, e.ProjectID),
+			handler.NewCol(ProvisioningTargetCreationDateCol, e.CreationDate()),
+			handler.NewCol(ProvisioningTargetChangeDateCol, e.CreationDate()),
+			handler.NewCol(ProvisioningTargetSequenceCol, e.Sequence()),
+			handler.NewCol(ProvisioningTargetEndpointCol, e.Endpoint),
+			handler.NewCol(ProvisioningTargetTokenCol, e.Token),
+		},
+	), nil
+}
+
+func (p *provisioningTargetProjection) reduceChanged(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*provisioning.ChangedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	values := []handler.Column{
+		handler.NewCol(ProvisioningTargetChangeDateCol, e.CreationDate()),
+		handler.NewCol(ProvisioningTargetSequenceCol, e.Sequence()),
+	}
+	if e.Endpoint != nil {
+		values = append(values, handler.NewCol(ProvisioningTargetEndpointCol, *e.Endpoint))
+	}
+	if e.Token != nil {
+		values = append(values, handler.NewCol(ProvisioningTargetTokenCol, e.Token))
+	}
+	return handler.NewUpdateStatement(
+		e,
+		values,
+		[]handler.Condition{
+			handler.NewCond(ProvisioningTargetInstanceIDCol, e.Aggregate().InstanceID),
+			handler.NewCond(ProvisioningTargetIDCol, e.Aggregate().ID),
+		},
+	), nil
+}
+
+func (p *provisioningTargetProjection) reduceRemoved(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*provisioning.RemovedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	return p.deleteTarget(e, e.Aggregate().ID), nil
+}
+
+func (p *provisioningTargetProjection) reduceUserSyncSucceeded(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*provisioning.UserSyncSucceededEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	return handler.NewUpsertStatement(
+		e,
+		[]handler.Column{
+			handler.NewCol(ProvisioningTargetUserInstanceIDCol, nil),
+			handler.NewCol(ProvisioningTargetUserTargetIDCol, nil),
+			handler.NewCol(ProvisioningTargetUserUserIDCol, nil),
+		},
+		[]handler.Column{
+			handler.NewCol(ProvisioningTargetUserInstanceIDCol, e.Aggregate().InstanceID),
+			handler.NewCol(ProvisioningTargetUserTargetIDCol, e.Aggregate().ID),
+			handler.NewCol(ProvisioningTargetUserUserIDCol, e.UserID),
+			handler.NewCol(ProvisioningTargetUserResourceOwnerCol, e.Aggregate().ResourceOwner),
+			handler.NewCol(ProvisioningTargetUserRemoteIDCol, e.RemoteID),
+			handler.NewCol(ProvisioningTargetUserSyncStateCol, domain.ProvisioningSyncStateSucceeded),
+			handler.NewCol(ProvisioningTargetUserErrorCol, nil),
+			handler.NewCol(ProvisioningTargetUserTriggerEventTypeCol, e.TriggerEventType),
+			handler.NewCol(ProvisioningTargetUserChangeDateCol, e.CreationDate()),
+			handler.NewCol(ProvisioningTargetUserSequenceCol, e.Sequence()),
+		},
+		handler.WithTableSuffix(provisioningTargetUsersTableSuffix),
+	), nil
+}
+
+func (p *provisioningTargetProjection) reduceUserSyncFailed(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*provisioning.UserSyncFailedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	// the remote id is not changed, so that a previously provisioned user can still be found on the target
+	return handler.NewUpsertStatement(
+		e,
+		[]handler.Column{
+			handler.NewCol(ProvisioningTargetUserInstanceIDCol, nil),
+			handler.NewCol(ProvisioningTargetUserTargetIDCol, nil),
+			handler.NewCol(ProvisioningTargetUserUserIDCol, nil),
+		},
+		[]handler.Column{
+			handler.NewCol(ProvisioningTargetUserInstanceIDCol, e.Aggregate().InstanceID),
+			handler.NewCol(ProvisioningTargetUserTargetIDCol, e.Aggregate().ID),
+			handler.NewCol(ProvisioningTargetUserUserIDCol, e.UserID),
+			handler.NewCol(ProvisioningTargetUserResourceOwnerCol, e.Aggregate().ResourceOwner),
+			handler.NewCol(ProvisioningTargetUserSyncStateCol, domain.ProvisioningSyncStateFailed),
+			handler.NewCol(ProvisioningTargetUserErrorCol, e.Error),
+			handler.NewCol(ProvisioningTargetUserTriggerEventTypeCol, e.TriggerEventType),
+			handler.NewCol(ProvisioningTargetUserChangeDateCol, e.CreationDate()),
+			handler.NewCol(ProvisioningTargetUserSequenceCol, e.Sequence()),
+		},
+		handler.WithTableSuffix(provisioningTargetUsersTableSuffix),
+	), nil
+}
+
+func (p *provisioningTargetProjection) reduceAppRemoved(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*project.ApplicationRemovedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	return p.deleteTarget(e, e.AppID), nil
+}
+
+// deleteTarget removes the target and the synchronization state of its users.
+// The users table has no foreign key on the targets table,
+// as synchronization events might still be pushed by running jobs after the target was removed.
+func (p *provisioningTargetProjection) deleteTarget(e eventstore.Event, targetID string) *handler.Statement {
+	return handler.NewMultiStatement(
+		e,
+		handler.AddDeleteStatement(
+			[]handler.Condition{
+				handler.NewCond(ProvisioningTargetInstanceIDCol, e.Aggregate().InstanceID),
+				handler.NewCond(ProvisioningTargetIDCol, targetID),
+			},
+		),
+		handler.AddDeleteStatement(
+			[]handler.Condition{
+				handler.NewCond(ProvisioningTargetUserInstanceIDCol, e.Aggregate().InstanceID),
+				handler.NewCond(ProvisioningTargetUserTargetIDCol, targetID),
+			},
+			handler.WithTableSuffix(provisioningTargetUsersTableSuffix),
+		),
+	)
+}
+
+// reduceProjectRemoved only removes the targets,
+// the synchronization states of the users are not queried without their target.
+func (p *provisioningTargetProjection) reduceProjectRemoved(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*project.ProjectRemovedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	return handler.NewDeleteStatement(
+		e,
+		[]handler.Condition{
+			handler.NewCond(ProvisioningTargetInstanceIDCol, e.Aggregate().InstanceID),
+			handler.NewCond(ProvisioningTargetProjectIDCol, e.Aggregate().ID),
+		},
+	), nil
+}
+
+func (p *provisioningTargetProjection) reduceOwnerRemoved(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*org.OrgRemovedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	return handler.NewMultiStatement(
+		e,
+		handler.AddDeleteStatement(
+			[]handler.Condition{
+				handler.NewCond(ProvisioningTargetInstanceIDCol, e.Aggregate().InstanceID),
+				handler.NewCond(ProvisioningTargetResourceOwnerCol, e.Aggregate().ID),
+			},
+		),
+		handler.AddDeleteStatement(
+			[]handler.Condition{
+				handler.NewCond(ProvisioningTargetUserInstanceIDCol, e.Aggregate().InstanceID),
+				handler.NewCond(ProvisioningTargetUserResourceOwnerCol, e.Aggregate().ID),
+			},
+			handler.WithTableSuffix(provisioningTargetUsersTableSuffix),
+		),
+	), nil
+}
+
+func (p *provisioningTargetProjection) reduceInstanceRemoved(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*instance.InstanceRemovedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	return handler.NewMultiStatement(
+		e,
+		handler.AddDeleteStatement(
+			[]handler.Condition{
+				handler.NewCond(ProvisioningTargetInstanceIDCol, e.Aggregate().ID),
+			},
+		),
+		handler.AddDeleteStatement(
+			[]handler.Condition{
+				handler.NewCond(ProvisioningTargetUserInstanceIDCol, e.Aggregate().ID),
+			},
+			handler.WithTableSuffix(provisioningTargetUsersTableSuffix),
+		),
+	), nil
+}
@@ -0,0 +1,352 @@
+package projection
+
+import (
+	"testing"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/eventstore/handler/v2"
+	"github.com/zitadel/zitadel/internal/repository/instance"
+	"github.com/zitadel/zitadel/internal/repository/org"
+	"github.com/zitadel/zitadel/internal/repository/project"
+	"github.com/zitadel/zitadel/internal/repository/provisioning"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+func TestProvisioningTargetProjection_reduces(t *testing.T) {
+	type args struct {
+		event func(t *testing.T) eventstore.Event
+	}
+	tests := []struct {
+		name   string
+		args   args
+		reduce func(event eventstore.Event) (*handler.Statement, error)
+		want   wantReduce
+	}{
+		{
+			name: "reduceAdded",
+			args: args{
+				event: getEvent(
+					testEvent(
+						provisioning.AddedEventType,
+						provisioning.AggregateType,
+						[]byte(`{"projectId": "project-id", "endpoint": "https://example.com/scim/v2", "token": { "cryptoType": 0, "algorithm": "enc", "keyId": "id" }}`),
+					),
+					eventstore.GenericEventMapper[provisioning.AddedEvent],
+				),
+			},
+			reduce: (&provisioningTargetProjection{}).reduceAdded,
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("provisioning_target"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "INSERT INTO projections.provisioning_targets (instance_id, resource_owner, id, project_id, creation_date, change_date, sequence, endpoint, token) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)",
+							expectedArgs: []interface{}{
+								"instance-id",
+								"ro-id",
+								"agg-id",
+								"project-id",
+								anyArg{},
+								anyArg{},
+								uint64(15),
+								"https://example.com/scim/v2",
+								anyArg{},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceChanged",
+			args: args{
+				event: getEvent(
+					testEvent(
+						provisioning.ChangedEventType,
+						provisioning.AggregateType,
+						[]byte(`{"endpoint": "https://example.com/scim/v2/changed"}`),
+					),
+					eventstore.GenericEventMapper[provisioning.ChangedEvent],
+				),
+			},
+			reduce: (&provisioningTargetProjection{}).reduceChanged,
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("provisioning_target"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "UPDATE projections.provisioning_targets SET (change_date, sequence, endpoint) = ($1, $2, $3) WHERE (instance_id = $4) AND (id = $5)",
+							expectedArgs: []interface{}{
+								anyArg{},
+								uint64(15),
+								"https://example.com/scim/v2/changed",
+								"instance-id",
+								"agg-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceRemoved",
+			args: args{
+				event: getEvent(
+					testEvent(
+						provisioning.RemovedEventType,
+						provisioning.AggregateType,
+						[]byte(`{}`),
+					),
+					eventstore.GenericEventMapper[provisioning.RemovedEvent],
+				),
+			},
+			reduce: (&provisioningTargetProjection{}).reduceRemoved,
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("provisioning_target"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "DELETE FROM projections.provisioning_targets WHERE (instance_id = $1) AND (id = $2)",
+							expectedArgs: []interface{}{
+								"instance-id",
+								"agg-id",
+							},
+						},
+						{
+							expectedStmt: "DELETE FROM projections.provisioning_targets_users WHERE (instance_id = $1) AND (target_id = $2)",
+							expectedArgs: []interface{}{
+								"instance-id",
+								"agg-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceUserSyncSucceeded",
+			args: args{
+				event: getEvent(
+					testEvent(
+						provisioning.UserSyncSucceededEventType,
+						provisioning.AggregateType,
+						[]byte(`{"userId": "user-id", "remoteId": "remote-id", "triggerEventType": "user.human.added"}`),
+					),
+					eventstore.GenericEventMapper[provisioning.UserSyncSucceededEvent],
+				),
+			},
+			reduce: (&provisioningTargetProjection{}).reduceUserSyncSucceeded,
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("provisioning_target"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "INSERT INTO projections.provisioning_targets_users (instance_id, target_id, user_id, resource_owner, remote_id, sync_state, error, trigger_event_type, change_date, sequence) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10) ON CONFLICT (instance_id, target_id, user_id) DO UPDATE SET (resource_owner, remote_id, sync_state, error, trigger_event_type, change_date, sequence) = (EXCLUDED.resource_owner, EXCLUDED.remote_id, EXCLUDED.sync_state, EXCLUDED.error, EXCLUDED.trigger_event_type, EXCLUDED.change_date, EXCLUDED.sequence)",
+							expectedArgs: []interface{}{
+								"instance-id",
+								"agg-id",
+								"user-id",
+								"ro-id",
+								"remote-id",
+								domain.ProvisioningSyncStateSucceeded,
+								nil,
+								eventstore.EventType("user.human.added"),
+								anyArg{},
+								uint64(15),
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceUserSyncFailed",
+			args: args{
+				event: getEvent(
+					testEvent(
+						provisioning.UserSyncFailedEventType,
+						provisioning.AggregateType,
+						[]byte(`{"userId": "user-id", "triggerEventType": "user.human.added", "error": "unavailable"}`),
+					),
+					eventstore.GenericEventMapper[provisioning.UserSyncFailedEvent],
+				),
+			},
+			reduce: (&provisioningTargetProjection{}).reduceUserSyncFailed,
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("provisioning_target"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "INSERT INTO projections.provisioning_targets_users (instance_id, target_id, user_id, resource_owner, sync_state, error, trigger_event_type, change_date, sequence) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9) ON CONFLICT (instance_id, target_id, user_id) DO UPDATE SET (resource_owner, sync_state, error, trigger_event_type, change_date, sequence) = (EXCLUDED.resource_owner, EXCLUDED.sync_state, EXCLUDED.error, EXCLUDED.trigger_event_type, EXCLUDED.change_date, EXCLUDED.sequence)",
+							expectedArgs: []interface{}{
+								"instance-id",
+								"agg-id",
+								"user-id",
+								"ro-id",
+								domain.ProvisioningSyncStateFailed,
+								"unavailable",
+								eventstore.EventType("user.human.added"),
+								anyArg{},
+								uint64(15),
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceAppRemoved",
+			args: args{
+				event: getEvent(
+					testEvent(
+						project.ApplicationRemovedType,
+						project.AggregateType,
+						[]byte(`{"appId": "app-id"}`),
+					),
+					project.ApplicationRemovedEventMapper,
+				),
+			},
+			reduce: (&provisioningTargetProjection{}).reduceAppRemoved,
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("project"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "DELETE FROM projections.provisioning_targets WHERE (instance_id = $1) AND (id = $2)",
+							expectedArgs: []interface{}{
+								"instance-id",
+								"app-id",
+							},
+						},
+						{
+							expectedStmt: "DELETE FROM projections.provisioning_targets_users WHERE (instance_id = $1) AND (target_id = $2)",
+							expectedArgs: []interface{}{
+								"instance-id",
+								"app-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceProjectRemoved",
+			args: args{
+				event: getEvent(
+					testEvent(
+						project.ProjectRemovedType,
+						project.AggregateType,
+						[]byte(`{}`),
+					),
+					project.ProjectRemovedEventMapper,
+				),
+			},
+			reduce: (&provisioningTargetProjection{}).reduceProjectRemoved,
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("project"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "DELETE FROM projections.provisioning_targets WHERE (instance_id = $1) AND (project_id = $2)",
+							expectedArgs: []interface{}{
+								"instance-id",
+								"agg-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceOwnerRemoved",
+			args: args{
+				event: getEvent(
+					testEvent(
+						org.OrgRemovedEventType,
+						org.AggregateType,
+						[]byte(`{}`),
+					),
+					org.OrgRemovedEventMapper,
+				),
+			},
+			reduce: (&provisioningTargetProjection{}).reduceOwnerRemoved,
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("org"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "DELETE FROM projections.provisioning_targets WHERE (instance_id = $1) AND (resource_owner = $2)",
+							expectedArgs: []interface{}{
+								"instance-id",
+								"agg-id",
+							},
+						},
+						{
+							expectedStmt: "DELETE FROM projections.provisioning_targets_users WHERE (instance_id = $1) AND (resource_owner = $2)",
+							expectedArgs: []interface{}{
+								"instance-id",
+								"agg-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceInstanceRemoved",
+			args: args{
+				event: getEvent(
+					testEvent(
+						instance.InstanceRemovedEventType,
+						instance.AggregateType,
+						nil,
+					),
+					instance.InstanceRemovedEventMapper,
+				),
+			},
+			reduce: (&provisioningTargetProjection{}).reduceInstanceRemoved,
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("instance"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "DELETE FROM projections.provisioning_targets WHERE (instance_id = $1)",
+							expectedArgs: []interface{}{
+								"agg-id",
+							},
+						},
+						{
+							expectedStmt: "DELETE FROM projections.provisioning_targets_users WHERE (instance_id = $1)",
+							expectedArgs: []interface{}{
+								"agg-id",
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			event := baseEvent(t)
+			got, err := tt.reduce(event)
+			if ok := zerrors.IsErrorInvalidArgument(err); !ok {
+				t.Errorf("no wrong event mapping: %v, got: %v", err, got)
+			}
+
+			event = tt.args.event(t)
+			got, err = tt.reduce(event)
+			assertReduce(t, got, err, ProvisioningTargetTable, tt.want)
+		})
+	}
+}
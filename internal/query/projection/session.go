@@ -14,33 +14,44 @@ import (
 )
 
 const (
-	SessionsProjectionTable = "projections.sessions8"
-
-	SessionColumnID                     = "id"
-	SessionColumnCreationDate           = "creation_date"
-	SessionColumnChangeDate             = "change_date"
-	SessionColumnSequence               = "sequence"
-	SessionColumnState                  = "state"
-	SessionColumnResourceOwner          = "resource_owner"
-	SessionColumnInstanceID             = "instance_id"
-	SessionColumnCreator                = "creator"
-	SessionColumnUserID                 = "user_id"
-	SessionColumnUserResourceOwner      = "user_resource_owner"
-	SessionColumnUserCheckedAt          = "user_checked_at"
-	SessionColumnPasswordCheckedAt      = "password_checked_at"
-	SessionColumnIntentCheckedAt        = "intent_checked_at"
-	SessionColumnWebAuthNCheckedAt      = "webauthn_checked_at"
-	SessionColumnWebAuthNUserVerified   = "webauthn_user_verified"
-	SessionColumnTOTPCheckedAt          = "totp_checked_at"
-	SessionColumnOTPSMSCheckedAt        = "otp_sms_checked_at"
-	SessionColumnOTPEmailCheckedAt      = "otp_email_checked_at"
-	SessionColumnMetadata               = "metadata"
-	SessionColumnTokenID                = "token_id"
-	SessionColumnUserAgentFingerprintID = "user_agent_fingerprint_id"
-	SessionColumnUserAgentIP            = "user_agent_ip"
-	SessionColumnUserAgentDescription   = "user_agent_description"
-	SessionColumnUserAgentHeader        = "user_agent_header"
-	SessionColumnExpiration             = "expiration"
+	SessionsProjectionTable = "projections.sessions13"
+
+	SessionColumnID                         = "id"
+	SessionColumnCreationDate               = "creation_date"
+	SessionColumnChangeDate                 = "change_date"
+	SessionColumnSequence                   = "sequence"
+	SessionColumnState                      = "state"
+	SessionColumnResourceOwner              = "resource_owner"
+	SessionColumnInstanceID                 = "instance_id"
+	SessionColumnCreator                    = "creator"
+	SessionColumnUserID                     = "user_id"
+	SessionColumnUserResourceOwner          = "user_resource_owner"
+	SessionColumnUserCheckedAt              = "user_checked_at"
+	SessionColumnPasswordCheckedAt          = "password_checked_at"
+	SessionColumnIntentCheckedAt            = "intent_checked_at"
+	SessionColumnWebAuthNCheckedAt          = "webauthn_checked_at"
+	SessionColumnWebAuthNUserVerified       = "webauthn_user_verified"
+	SessionColumnTOTPCheckedAt              = "totp_checked_at"
+	SessionColumnOTPSMSCheckedAt            = "otp_sms_checked_at"
+	SessionColumnOTPEmailCheckedAt          = "otp_email_checked_at"
+	SessionColumnMetadata                   = "metadata"
+	SessionColumnTokenID                    = "token_id"
+	SessionColumnUserAgentFingerprintID     = "user_agent_fingerprint_id"
+	SessionColumnUserAgentIP                = "user_agent_ip"
+	SessionColumnUserAgentDescription       = "user_agent_description"
+	SessionColumnUserAgentHeader            = "user_agent_header"
+	SessionColumnExpiration                 = "expiration"
+	SessionColumnRiskEvaluatedAt            = "risk_evaluated_at"
+	SessionColumnRiskLevel                  = "risk_level"
+	SessionColumnRiskNewDevice              = "risk_new_device"
+	SessionColumnRiskNewCountry             = "risk_new_country"
+	SessionColumnRiskImpossibleTravel       = "risk_impossible_travel"
+	SessionColumnRiskCountry                = "risk_country"
+	SessionColumnStepUpRequired             = "step_up_required"
+	SessionColumnTrustedDeviceCheckedAt     = "trusted_device_checked_at"
+	SessionColumnRecoveryCodeCheckedAt      = "recovery_code_checked_at"
+	SessionColumnClientCertificateCheckedAt = "client_certificate_checked_at"
+	SessionColumnMagicLinkCheckedAt         = "magic_link_checked_at"
 )
 
 type sessionProjection struct{}
@@ -81,6 +92,17 @@ func (*sessionProjection) Init() *old_handler.Check {
 			handler.NewColumn(SessionColumnUserAgentDescription, handler.ColumnTypeText, handler.Nullable()),
 			handler.NewColumn(SessionColumnUserAgentHeader, handler.ColumnTypeJSONB, handler.Nullable()),
 			handler.NewColumn(SessionColumnExpiration, handler.ColumnTypeTimestamp, handler.Nullable()),
+			handler.NewColumn(SessionColumnRiskEvaluatedAt, handler.ColumnTypeTimestamp, handler.Nullable()),
+			handler.NewColumn(SessionColumnRiskLevel, handler.ColumnTypeEnum, handler.Default(0)),
+			handler.NewColumn(SessionColumnRiskNewDevice, handler.ColumnTypeBool, handler.Default(false)),
+			handler.NewColumn(SessionColumnRiskNewCountry, handler.ColumnTypeBool, handler.Default(false)),
+			handler.NewColumn(SessionColumnRiskImpossibleTravel, handler.ColumnTypeBool, handler.Default(false)),
+			handler.NewColumn(SessionColumnRiskCountry, handler.ColumnTypeText, handler.Nullable()),
+			handler.NewColumn(SessionColumnStepUpRequired, handler.ColumnTypeBool, handler.Default(false)),
+			handler.NewColumn(SessionColumnTrustedDeviceCheckedAt, handler.ColumnTypeTimestamp, handler.Nullable()),
+			handler.NewColumn(SessionColumnRecoveryCodeCheckedAt, handler.ColumnTypeTimestamp, handler.Nullable()),
+			handler.NewColumn(SessionColumnClientCertificateCheckedAt, handler.ColumnTypeTimestamp, handler.Nullable()),
+			handler.NewColumn(SessionColumnMagicLinkCheckedAt, handler.ColumnTypeTimestamp, handler.Nullable()),
 		},
 			handler.NewPrimaryKey(SessionColumnInstanceID, SessionColumnID),
 			handler.WithIndex(handler.NewIndex(
@@ -128,6 +150,22 @@ func (p *sessionProjection) Reducers() []handler.AggregateReducer {
 					Event:  session.OTPEmailCheckedType,
 					Reduce: p.reduceOTPEmailChecked,
 				},
+				{
+					Event:  session.TrustedDeviceCheckedType,
+					Reduce: p.reduceTrustedDeviceChecked,
+				},
+				{
+					Event:  session.RecoveryCodeCheckedType,
+					Reduce: p.reduceRecoveryCodeChecked,
+				},
+				{
+					Event:  session.ClientCertificateCheckedType,
+					Reduce: p.reduceClientCertificateChecked,
+				},
+				{
+					Event:  session.MagicLinkCheckedType,
+					Reduce: p.reduceMagicLinkChecked,
+				},
 				{
 					Event:  session.TokenSetType,
 					Reduce: p.reduceTokenSet,
@@ -140,6 +178,10 @@ func (p *sessionProjection) Reducers() []handler.AggregateReducer {
 					Event:  session.LifetimeSetType,
 					Reduce: p.reduceLifetimeSet,
 				},
+				{
+					Event:  session.RiskEvaluatedType,
+					Reduce: p.reduceRiskEvaluated,
+				},
 				{
 					Event:  session.TerminateType,
 					Reduce: p.reduceSessionTerminated,
@@ -345,6 +387,86 @@ func (p *sessionProjection) reduceOTPEmailChecked(event eventstore.Event) (*hand
 	), nil
 }
 
+func (p *sessionProjection) reduceTrustedDeviceChecked(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*session.TrustedDeviceCheckedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+
+	return handler.NewUpdateStatement(
+		e,
+		[]handler.Column{
+			handler.NewCol(SessionColumnChangeDate, e.CreationDate()),
+			handler.NewCol(SessionColumnSequence, e.Sequence()),
+			handler.NewCol(SessionColumnTrustedDeviceCheckedAt, e.CheckedAt),
+		},
+		[]handler.Condition{
+			handler.NewCond(SessionColumnID, e.Aggregate().ID),
+			handler.NewCond(SessionColumnInstanceID, e.Aggregate().InstanceID),
+		},
+	), nil
+}
+
+func (p *sessionProjection) reduceRecoveryCodeChecked(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*session.RecoveryCodeCheckedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+
+	return handler.NewUpdateStatement(
+		e,
+		[]handler.Column{
+			handler.NewCol(SessionColumnChangeDate, e.CreationDate()),
+			handler.NewCol(SessionColumnSequence, e.Sequence()),
+			handler.NewCol(SessionColumnRecoveryCodeCheckedAt, e.CheckedAt),
+		},
+		[]handler.Condition{
+			handler.NewCond(SessionColumnID, e.Aggregate().ID),
+			handler.NewCond(SessionColumnInstanceID, e.Aggregate().InstanceID),
+		},
+	), nil
+}
+
+func (p *sessionProjection) reduceClientCertificateChecked(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*session.ClientCertificateCheckedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+
+	return handler.NewUpdateStatement(
+		e,
+		[]handler.Column{
+			handler.NewCol(SessionColumnChangeDate, e.CreationDate()),
+			handler.NewCol(SessionColumnSequence, e.Sequence()),
+			handler.NewCol(SessionColumnClientCertificateCheckedAt, e.CheckedAt),
+		},
+		[]handler.Condition{
+			handler.NewCond(SessionColumnID, e.Aggregate().ID),
+			handler.NewCond(SessionColumnInstanceID, e.Aggregate().InstanceID),
+		},
+	), nil
+}
+
+func (p *sessionProjection) reduceMagicLinkChecked(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*session.MagicLinkCheckedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+
+	return handler.NewUpdateStatement(
+		e,
+		[]handler.Column{
+			handler.NewCol(SessionColumnChangeDate, e.CreationDate()),
+			handler.NewCol(SessionColumnSequence, e.Sequence()),
+			handler.NewCol(SessionColumnMagicLinkCheckedAt, e.CheckedAt),
+		},
+		[]handler.Condition{
+			handler.NewCond(SessionColumnID, e.Aggregate().ID),
+			handler.NewCond(SessionColumnInstanceID, e.Aggregate().InstanceID),
+		},
+	), nil
+}
+
 func (p *sessionProjection) reduceTokenSet(event eventstore.Event) (*handler.Statement, error) {
 	e, ok := event.(*session.TokenSetEvent)
 	if !ok {
@@ -405,6 +527,32 @@ func (p *sessionProjection) reduceLifetimeSet(event eventstore.Event) (*handler.
 	), nil
 }
 
+func (p *sessionProjection) reduceRiskEvaluated(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*session.RiskEvaluatedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+
+	return handler.NewUpdateStatement(
+		e,
+		[]handler.Column{
+			handler.NewCol(SessionColumnChangeDate, e.CreationDate()),
+			handler.NewCol(SessionColumnSequence, e.Sequence()),
+			handler.NewCol(SessionColumnRiskEvaluatedAt, e.EvaluatedAt),
+			handler.NewCol(SessionColumnRiskLevel, e.Level),
+			handler.NewCol(SessionColumnRiskNewDevice, e.NewDevice),
+			handler.NewCol(SessionColumnRiskNewCountry, e.NewCountry),
+			handler.NewCol(SessionColumnRiskImpossibleTravel, e.ImpossibleTravel),
+			handler.NewCol(SessionColumnRiskCountry, e.Country),
+			handler.NewCol(SessionColumnStepUpRequired, e.StepUpRequired),
+		},
+		[]handler.Condition{
+			handler.NewCond(SessionColumnID, e.Aggregate().ID),
+			handler.NewCond(SessionColumnInstanceID, e.Aggregate().InstanceID),
+		},
+	), nil
+}
+
 func (p *sessionProjection) reduceSessionTerminated(event eventstore.Event) (*handler.Statement, error) {
 	e, ok := event.(*session.TerminateEvent)
 	if !ok {
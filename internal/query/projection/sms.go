@@ -3,6 +3,7 @@ package projection
 import (
 	"context"
 
+	"github.com/zitadel/zitadel/internal/database"
 	"github.com/zitadel/zitadel/internal/domain"
 	"github.com/zitadel/zitadel/internal/eventstore"
 	old_handler "github.com/zitadel/zitadel/internal/eventstore/handler"
@@ -11,9 +12,10 @@ import (
 )
 
 const (
-	SMSConfigProjectionTable = "projections.sms_configs3"
+	SMSConfigProjectionTable = "projections.sms_configs4"
 	SMSTwilioTable           = SMSConfigProjectionTable + "_" + smsTwilioTableSuffix
 	SMSHTTPTable             = SMSConfigProjectionTable + "_" + smsHTTPTableSuffix
+	SMSHTTPTemplateTable     = SMSConfigProjectionTable + "_" + smsHTTPTemplateTableSuffix
 
 	SMSColumnID            = "id"
 	SMSColumnAggregateID   = "aggregate_id"
@@ -37,6 +39,17 @@ const (
 	SMSHTTPColumnSMSID      = "sms_id"
 	SMSHTTPColumnInstanceID = "instance_id"
 	SMSHTTPColumnEndpoint   = "endpoint"
+
+	smsHTTPTemplateTableSuffix              = "http_template"
+	SMSHTTPTemplateColumnSMSID              = "sms_id"
+	SMSHTTPTemplateColumnInstanceID         = "instance_id"
+	SMSHTTPTemplateColumnMethod             = "method"
+	SMSHTTPTemplateColumnURL                = "url"
+	SMSHTTPTemplateColumnHeaders            = "headers"
+	SMSHTTPTemplateColumnBody               = "body"
+	SMSHTTPTemplateColumnSenderNumber       = "sender_number"
+	SMSHTTPTemplateColumnSuccessStatusCodes = "success_status_codes"
+	SMSHTTPTemplateColumnSuccessBodyPattern = "success_body_pattern"
 )
 
 type smsConfigProjection struct{}
@@ -85,6 +98,21 @@ func (*smsConfigProjection) Init() *old_handler.Check {
 			smsHTTPTableSuffix,
 			handler.WithForeignKey(handler.NewForeignKeyOfPublicKeys()),
 		),
+		handler.NewSuffixedTable([]*handler.InitColumn{
+			handler.NewColumn(SMSHTTPTemplateColumnSMSID, handler.ColumnTypeText),
+			handler.NewColumn(SMSHTTPTemplateColumnInstanceID, handler.ColumnTypeText),
+			handler.NewColumn(SMSHTTPTemplateColumnMethod, handler.ColumnTypeText),
+			handler.NewColumn(SMSHTTPTemplateColumnURL, handler.ColumnTypeText),
+			handler.NewColumn(SMSHTTPTemplateColumnHeaders, handler.ColumnTypeJSONB, handler.Nullable()),
+			handler.NewColumn(SMSHTTPTemplateColumnBody, handler.ColumnTypeText),
+			handler.NewColumn(SMSHTTPTemplateColumnSenderNumber, handler.ColumnTypeText),
+			handler.NewColumn(SMSHTTPTemplateColumnSuccessStatusCodes, handler.ColumnTypeEnumArray, handler.Nullable()),
+			handler.NewColumn(SMSHTTPTemplateColumnSuccessBodyPattern, handler.ColumnTypeText),
+		},
+			handler.NewPrimaryKey(SMSHTTPTemplateColumnInstanceID, SMSHTTPTemplateColumnSMSID),
+			smsHTTPTemplateTableSuffix,
+			handler.WithForeignKey(handler.NewForeignKeyOfPublicKeys()),
+		),
 	)
 }
 
@@ -113,6 +141,18 @@ func (p *smsConfigProjection) Reducers() []handler.AggregateReducer {
 					Event:  instance.SMSConfigHTTPChangedEventType,
 					Reduce: p.reduceSMSConfigHTTPChanged,
 				},
+				{
+					Event:  instance.SMSConfigHTTPTemplateAddedEventType,
+					Reduce: p.reduceSMSConfigHTTPTemplateAdded,
+				},
+				{
+					Event:  instance.SMSConfigHTTPTemplateChangedEventType,
+					Reduce: p.reduceSMSConfigHTTPTemplateChanged,
+				},
+				{
+					Event:  instance.SMSConfigHTTPTemplateHeadersChangedEventType,
+					Reduce: p.reduceSMSConfigHTTPTemplateHeadersChanged,
+				},
 				{
 					Event:  instance.SMSConfigTwilioActivatedEventType,
 					Reduce: p.reduceSMSConfigTwilioActivated,
@@ -332,6 +372,130 @@ func (p *smsConfigProjection) reduceSMSConfigHTTPChanged(event eventstore.Event)
 	return handler.NewMultiStatement(e, stmts...), nil
 }
 
+func (p *smsConfigProjection) reduceSMSConfigHTTPTemplateAdded(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*instance.SMSConfigHTTPTemplateAddedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+
+	return handler.NewMultiStatement(
+		e,
+		handler.AddCreateStatement(
+			[]handler.Column{
+				handler.NewCol(SMSColumnID, e.ID),
+				handler.NewCol(SMSColumnAggregateID, e.Aggregate().ID),
+				handler.NewCol(SMSColumnCreationDate, e.CreationDate()),
+				handler.NewCol(SMSColumnChangeDate, e.CreationDate()),
+				handler.NewCol(SMSColumnResourceOwner, e.Aggregate().ResourceOwner),
+				handler.NewCol(SMSColumnInstanceID, e.Aggregate().InstanceID),
+				handler.NewCol(SMSColumnState, domain.SMSConfigStateInactive),
+				handler.NewCol(SMSColumnSequence, e.Sequence()),
+				handler.NewCol(SMSColumnDescription, e.Description),
+			},
+		),
+		handler.AddCreateStatement(
+			[]handler.Column{
+				handler.NewCol(SMSHTTPTemplateColumnSMSID, e.ID),
+				handler.NewCol(SMSHTTPTemplateColumnInstanceID, e.Aggregate().InstanceID),
+				handler.NewCol(SMSHTTPTemplateColumnMethod, e.Method),
+				handler.NewCol(SMSHTTPTemplateColumnURL, e.URL),
+				handler.NewCol(SMSHTTPTemplateColumnHeaders, e.Headers),
+				handler.NewCol(SMSHTTPTemplateColumnBody, e.Body),
+				handler.NewCol(SMSHTTPTemplateColumnSenderNumber, e.SenderNumber),
+				handler.NewCol(SMSHTTPTemplateColumnSuccessStatusCodes, database.NumberArray[int](e.SuccessStatusCodes)),
+				handler.NewCol(SMSHTTPTemplateColumnSuccessBodyPattern, e.SuccessBodyPattern),
+			},
+			handler.WithTableSuffix(smsHTTPTemplateTableSuffix),
+		),
+	), nil
+}
+
+func (p *smsConfigProjection) reduceSMSConfigHTTPTemplateChanged(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*instance.SMSConfigHTTPTemplateChangedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+
+	stmts := make([]func(eventstore.Event) handler.Exec, 0, 2)
+	columns := []handler.Column{
+		handler.NewCol(SMSColumnChangeDate, e.CreationDate()),
+		handler.NewCol(SMSColumnSequence, e.Sequence()),
+	}
+	if e.Description != nil {
+		columns = append(columns, handler.NewCol(SMSColumnDescription, *e.Description))
+	}
+	stmts = append(stmts, handler.AddUpdateStatement(
+		columns,
+		[]handler.Condition{
+			handler.NewCond(SMSColumnID, e.ID),
+			handler.NewCond(SMSColumnInstanceID, e.Aggregate().InstanceID),
+		},
+	))
+
+	templateColumns := make([]handler.Column, 0, 6)
+	if e.Method != nil {
+		templateColumns = append(templateColumns, handler.NewCol(SMSHTTPTemplateColumnMethod, *e.Method))
+	}
+	if e.URL != nil {
+		templateColumns = append(templateColumns, handler.NewCol(SMSHTTPTemplateColumnURL, *e.URL))
+	}
+	if e.Body != nil {
+		templateColumns = append(templateColumns, handler.NewCol(SMSHTTPTemplateColumnBody, *e.Body))
+	}
+	if e.SenderNumber != nil {
+		templateColumns = append(templateColumns, handler.NewCol(SMSHTTPTemplateColumnSenderNumber, *e.SenderNumber))
+	}
+	if e.SuccessStatusCodes != nil {
+		templateColumns = append(templateColumns, handler.NewCol(SMSHTTPTemplateColumnSuccessStatusCodes, database.NumberArray[int](*e.SuccessStatusCodes)))
+	}
+	if e.SuccessBodyPattern != nil {
+		templateColumns = append(templateColumns, handler.NewCol(SMSHTTPTemplateColumnSuccessBodyPattern, *e.SuccessBodyPattern))
+	}
+	if len(templateColumns) > 0 {
+		stmts = append(stmts, handler.AddUpdateStatement(
+			templateColumns,
+			[]handler.Condition{
+				handler.NewCond(SMSHTTPTemplateColumnSMSID, e.ID),
+				handler.NewCond(SMSHTTPTemplateColumnInstanceID, e.Aggregate().InstanceID),
+			},
+			handler.WithTableSuffix(smsHTTPTemplateTableSuffix),
+		))
+	}
+
+	return handler.NewMultiStatement(e, stmts...), nil
+}
+
+func (p *smsConfigProjection) reduceSMSConfigHTTPTemplateHeadersChanged(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*instance.SMSConfigHTTPTemplateHeadersChangedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+
+	return handler.NewMultiStatement(
+		e,
+		handler.AddUpdateStatement(
+			[]handler.Column{
+				handler.NewCol(SMSHTTPTemplateColumnHeaders, e.Headers),
+			},
+			[]handler.Condition{
+				handler.NewCond(SMSHTTPTemplateColumnSMSID, e.ID),
+				handler.NewCond(SMSHTTPTemplateColumnInstanceID, e.Aggregate().InstanceID),
+			},
+			handler.WithTableSuffix(smsHTTPTemplateTableSuffix),
+		),
+		handler.AddUpdateStatement(
+			[]handler.Column{
+				handler.NewCol(SMSColumnChangeDate, e.CreationDate()),
+				handler.NewCol(SMSColumnSequence, e.Sequence()),
+			},
+			[]handler.Condition{
+				handler.NewCond(SMSColumnID, e.ID),
+				handler.NewCond(SMSColumnInstanceID, e.Aggregate().InstanceID),
+			},
+		),
+	), nil
+}
+
 func (p *smsConfigProjection) reduceSMSConfigTwilioActivated(event eventstore.Event) (*handler.Statement, error) {
 	e, err := assertEvent[*instance.SMSConfigTwilioActivatedEvent](event)
 	if err != nil {
@@ -2,6 +2,9 @@ package projection
 
 import (
 	"context"
+	"time"
+
+	"github.com/muhlemmer/gu"
 
 	"github.com/zitadel/zitadel/internal/eventstore"
 	old_handler "github.com/zitadel/zitadel/internal/eventstore/handler"
@@ -11,7 +14,7 @@ import (
 )
 
 const (
-	TargetTable               = "projections.targets2"
+	TargetTable               = "projections.targets3"
 	TargetIDCol               = "id"
 	TargetCreationDateCol     = "creation_date"
 	TargetChangeDateCol       = "change_date"
@@ -20,10 +23,17 @@ const (
 	TargetSequenceCol         = "sequence"
 	TargetNameCol             = "name"
 	TargetTargetType          = "target_type"
+	TargetTransportCol        = "transport"
 	TargetEndpointCol         = "endpoint"
 	TargetTimeoutCol          = "timeout"
 	TargetInterruptOnErrorCol = "interrupt_on_error"
 	TargetSigningKey          = "signing_key"
+	TargetSigningAlgorithmCol = "signing_algorithm"
+	TargetPublicKeyCol        = "public_key"
+
+	TargetNextSigningKeyCol             = "next_signing_key"
+	TargetNextPublicKeyCol              = "next_public_key"
+	TargetNextSigningKeyCreationDateCol = "next_signing_key_creation_date"
 )
 
 type targetProjection struct{}
@@ -45,12 +55,18 @@ func (*targetProjection) Init() *old_handler.Check {
 			handler.NewColumn(TargetResourceOwnerCol, handler.ColumnTypeText),
 			handler.NewColumn(TargetInstanceIDCol, handler.ColumnTypeText),
 			handler.NewColumn(TargetTargetType, handler.ColumnTypeEnum),
+			handler.NewColumn(TargetTransportCol, handler.ColumnTypeEnum, handler.Default(0)),
 			handler.NewColumn(TargetSequenceCol, handler.ColumnTypeInt64),
 			handler.NewColumn(TargetNameCol, handler.ColumnTypeText),
 			handler.NewColumn(TargetEndpointCol, handler.ColumnTypeText),
 			handler.NewColumn(TargetTimeoutCol, handler.ColumnTypeInt64),
 			handler.NewColumn(TargetInterruptOnErrorCol, handler.ColumnTypeBool),
 			handler.NewColumn(TargetSigningKey, handler.ColumnTypeJSONB, handler.Nullable()),
+			handler.NewColumn(TargetSigningAlgorithmCol, handler.ColumnTypeEnum, handler.Default(0)),
+			handler.NewColumn(TargetPublicKeyCol, handler.ColumnTypeBytes, handler.Nullable()),
+			handler.NewColumn(TargetNextSigningKeyCol, handler.ColumnTypeJSONB, handler.Nullable()),
+			handler.NewColumn(TargetNextPublicKeyCol, handler.ColumnTypeBytes, handler.Nullable()),
+			handler.NewColumn(TargetNextSigningKeyCreationDateCol, handler.ColumnTypeTimestamp, handler.Nullable()),
 		},
 			handler.NewPrimaryKey(TargetInstanceIDCol, TargetIDCol),
 		),
@@ -105,9 +121,12 @@ func (p *targetProjection) reduceTargetAdded(event eventstore.Event) (*handler.S
 			handler.NewCol(TargetNameCol, e.Name),
 			handler.NewCol(TargetEndpointCol, e.Endpoint),
 			handler.NewCol(TargetTargetType, e.TargetType),
+			handler.NewCol(TargetTransportCol, e.Transport),
 			handler.NewCol(TargetTimeoutCol, e.Timeout),
 			handler.NewCol(TargetInterruptOnErrorCol, e.InterruptOnError),
 			handler.NewCol(TargetSigningKey, e.SigningKey),
+			handler.NewCol(TargetSigningAlgorithmCol, e.SigningAlgorithm),
+			handler.NewCol(TargetPublicKeyCol, e.PublicKey),
 		},
 	), nil
 }
@@ -128,6 +147,9 @@ func (p *targetProjection) reduceTargetChanged(event eventstore.Event) (*handler
 	if e.TargetType != nil {
 		values = append(values, handler.NewCol(TargetTargetType, *e.TargetType))
 	}
+	if e.Transport != nil {
+		values = append(values, handler.NewCol(TargetTransportCol, *e.Transport))
+	}
 	if e.Endpoint != nil {
 		values = append(values, handler.NewCol(TargetEndpointCol, *e.Endpoint))
 	}
@@ -137,8 +159,26 @@ func (p *targetProjection) reduceTargetChanged(event eventstore.Event) (*handler
 	if e.InterruptOnError != nil {
 		values = append(values, handler.NewCol(TargetInterruptOnErrorCol, *e.InterruptOnError))
 	}
+	if e.SigningAlgorithm != nil {
+		values = append(values, handler.NewCol(TargetSigningAlgorithmCol, *e.SigningAlgorithm))
+	}
 	if e.SigningKey != nil {
-		values = append(values, handler.NewCol(TargetSigningKey, e.SigningKey))
+		values = append(values,
+			handler.NewCol(TargetSigningKey, e.SigningKey),
+			handler.NewCol(TargetPublicKeyCol, e.PublicKey),
+		)
+	}
+	// a new signing key replaces the next key, either by activating or by discarding it
+	if e.SigningKey != nil || e.NextSigningKey != nil {
+		var nextCreationDate *time.Time
+		if e.NextSigningKey != nil {
+			nextCreationDate = gu.Ptr(e.CreationDate())
+		}
+		values = append(values,
+			handler.NewCol(TargetNextSigningKeyCol, e.NextSigningKey),
+			handler.NewCol(TargetNextPublicKeyCol, e.NextPublicKey),
+			handler.NewCol(TargetNextSigningKeyCreationDateCol, nextCreationDate),
+		)
 	}
 	return handler.NewUpdateStatement(
 		e,
@@ -4,6 +4,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/zitadel/zitadel/internal/crypto"
 	"github.com/zitadel/zitadel/internal/domain"
 	"github.com/zitadel/zitadel/internal/eventstore"
 	"github.com/zitadel/zitadel/internal/eventstore/handler/v2"
@@ -41,7 +42,7 @@ func TestTargetProjection_reduces(t *testing.T) {
 				executer: &testExecuter{
 					executions: []execution{
 						{
-							expectedStmt: "INSERT INTO projections.targets2 (instance_id, resource_owner, id, creation_date, change_date, sequence, name, endpoint, target_type, timeout, interrupt_on_error, signing_key) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)",
+							expectedStmt: "INSERT INTO projections.targets3 (instance_id, resource_owner, id, creation_date, change_date, sequence, name, endpoint, target_type, transport, timeout, interrupt_on_error, signing_key, signing_algorithm, public_key) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15)",
 							expectedArgs: []interface{}{
 								"instance-id",
 								"ro-id",
@@ -52,9 +53,12 @@ func TestTargetProjection_reduces(t *testing.T) {
 								"name",
 								"https://example.com",
 								domain.TargetTypeWebhook,
+								domain.TargetTransportHTTP,
 								3 * time.Second,
 								true,
 								anyArg{},
+								domain.TargetSigningAlgorithmHMACSHA256,
+								[]byte(nil),
 							},
 						},
 					},
@@ -68,7 +72,7 @@ func TestTargetProjection_reduces(t *testing.T) {
 					testEvent(
 						target.ChangedEventType,
 						target.AggregateType,
-						[]byte(`{"name": "name2", "targetType":0, "endpoint":"https://example.com", "timeout": 3000000000, "async": true, "interruptOnError": true, "signingKey": { "cryptoType": 0, "algorithm": "RSA-265", "keyId": "key-id" }}`),
+						[]byte(`{"name": "name2", "targetType":0, "transport":1, "endpoint":"https://example.com", "timeout": 3000000000, "async": true, "interruptOnError": true, "signingKey": { "cryptoType": 0, "algorithm": "RSA-265", "keyId": "key-id" }}`),
 					),
 					eventstore.GenericEventMapper[target.ChangedEvent],
 				),
@@ -80,17 +84,95 @@ func TestTargetProjection_reduces(t *testing.T) {
 				executer: &testExecuter{
 					executions: []execution{
 						{
-							expectedStmt: "UPDATE projections.targets2 SET (change_date, sequence, resource_owner, name, target_type, endpoint, timeout, interrupt_on_error, signing_key) = ($1, $2, $3, $4, $5, $6, $7, $8, $9) WHERE (instance_id = $10) AND (id = $11)",
+							expectedStmt: "UPDATE projections.targets3 SET (change_date, sequence, resource_owner, name, target_type, transport, endpoint, timeout, interrupt_on_error, signing_key, public_key, next_signing_key, next_public_key, next_signing_key_creation_date) = ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14) WHERE (instance_id = $15) AND (id = $16)",
 							expectedArgs: []interface{}{
 								anyArg{},
 								uint64(15),
 								"ro-id",
 								"name2",
 								domain.TargetTypeWebhook,
+								domain.TargetTransportGRPC,
 								"https://example.com",
 								3 * time.Second,
 								true,
 								anyArg{},
+								[]byte(nil),
+								(*crypto.CryptoValue)(nil),
+								[]byte(nil),
+								(*time.Time)(nil),
+								"instance-id",
+								"agg-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceTargetChanged next signing key",
+			args: args{
+				event: getEvent(
+					testEvent(
+						target.ChangedEventType,
+						target.AggregateType,
+						[]byte(`{"nextSigningKey": { "cryptoType": 0, "algorithm": "RSA-265", "keyId": "key-id" }, "nextPublicKey": "bmV4dA=="}`),
+					),
+					eventstore.GenericEventMapper[target.ChangedEvent],
+				),
+			},
+			reduce: (&targetProjection{}).reduceTargetChanged,
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("target"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "UPDATE projections.targets3 SET (change_date, sequence, resource_owner, next_signing_key, next_public_key, next_signing_key_creation_date) = ($1, $2, $3, $4, $5, $6) WHERE (instance_id = $7) AND (id = $8)",
+							expectedArgs: []interface{}{
+								anyArg{},
+								uint64(15),
+								"ro-id",
+								anyArg{},
+								[]byte("next"),
+								anyArg{},
+								"instance-id",
+								"agg-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceTargetChanged signing algorithm",
+			args: args{
+				event: getEvent(
+					testEvent(
+						target.ChangedEventType,
+						target.AggregateType,
+						[]byte(`{"signingAlgorithm": 1, "signingKey": { "cryptoType": 0, "algorithm": "RSA-265", "keyId": "key-id" }, "publicKey": "cHVibGlj"}`),
+					),
+					eventstore.GenericEventMapper[target.ChangedEvent],
+				),
+			},
+			reduce: (&targetProjection{}).reduceTargetChanged,
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("target"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "UPDATE projections.targets3 SET (change_date, sequence, resource_owner, signing_algorithm, signing_key, public_key, next_signing_key, next_public_key, next_signing_key_creation_date) = ($1, $2, $3, $4, $5, $6, $7, $8, $9) WHERE (instance_id = $10) AND (id = $11)",
+							expectedArgs: []interface{}{
+								anyArg{},
+								uint64(15),
+								"ro-id",
+								domain.TargetSigningAlgorithmEd25519,
+								anyArg{},
+								[]byte("public"),
+								(*crypto.CryptoValue)(nil),
+								[]byte(nil),
+								(*time.Time)(nil),
 								"instance-id",
 								"agg-id",
 							},
@@ -118,7 +200,7 @@ func TestTargetProjection_reduces(t *testing.T) {
 				executer: &testExecuter{
 					executions: []execution{
 						{
-							expectedStmt: "DELETE FROM projections.targets2 WHERE (instance_id = $1) AND (id = $2)",
+							expectedStmt: "DELETE FROM projections.targets3 WHERE (instance_id = $1) AND (id = $2)",
 							expectedArgs: []interface{}{
 								"instance-id",
 								"agg-id",
@@ -147,7 +229,7 @@ func TestTargetProjection_reduces(t *testing.T) {
 				executer: &testExecuter{
 					executions: []execution{
 						{
-							expectedStmt: "DELETE FROM projections.targets2 WHERE (instance_id = $1)",
+							expectedStmt: "DELETE FROM projections.targets3 WHERE (instance_id = $1)",
 							expectedArgs: []interface{}{
 								"agg-id",
 							},
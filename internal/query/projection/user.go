@@ -76,6 +76,41 @@ const (
 	NotifyPasswordSetCol        = "password_set"
 )
 
+// UserSequenceEventTypes are the events of the user aggregate which update the sequence of a projected user or remove it.
+// They can be used to check whether a user was changed after the sequence of the projection.
+var UserSequenceEventTypes = []eventstore.EventType{
+	user.UserV1AddedType,
+	user.HumanAddedType,
+	user.UserV1RegisteredType,
+	user.HumanRegisteredType,
+	user.UserLockedType,
+	user.UserUnlockedType,
+	user.UserDeactivatedType,
+	user.UserReactivatedType,
+	user.UserRemovedType,
+	user.UserUserNameChangedType,
+	user.UserDomainClaimedType,
+	user.HumanProfileChangedType,
+	user.UserV1ProfileChangedType,
+	user.HumanPhoneChangedType,
+	user.UserV1PhoneChangedType,
+	user.HumanPhoneRemovedType,
+	user.UserV1PhoneRemovedType,
+	user.HumanPhoneVerifiedType,
+	user.UserV1PhoneVerifiedType,
+	user.HumanEmailChangedType,
+	user.UserV1EmailChangedType,
+	user.HumanEmailVerifiedType,
+	user.UserV1EmailVerifiedType,
+	user.HumanAvatarAddedType,
+	user.HumanAvatarRemovedType,
+	user.MachineAddedEventType,
+	user.MachineChangedEventType,
+	user.MachineSecretSetType,
+	user.MachineSecretHashUpdatedType,
+	user.MachineSecretRemovedType,
+}
+
 type userProjection struct{}
 
 func newUserProjection(ctx context.Context, config handler.Config) *handler.Handler {
@@ -286,6 +321,10 @@ func (p *userProjection) Reducers() []handler.AggregateReducer {
 					Event:  user.HumanPasswordChangedType,
 					Reduce: p.reduceHumanPasswordChanged,
 				},
+				{
+					Event:  user.HumanPasswordBreachedType,
+					Reduce: p.reduceHumanPasswordBreached,
+				},
 				{
 					Event:  user.MachineSecretSetType,
 					Reduce: p.reduceMachineSecretSet,
@@ -979,6 +1018,24 @@ func (p *userProjection) reduceHumanPasswordChanged(event eventstore.Event) (*ha
 	), nil
 }
 
+func (p *userProjection) reduceHumanPasswordBreached(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*user.HumanPasswordBreachedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	return handler.NewUpdateStatement(
+		e,
+		[]handler.Column{
+			handler.NewCol(HumanPasswordChangeRequired, true),
+		},
+		[]handler.Condition{
+			handler.NewCond(HumanUserIDCol, e.Aggregate().ID),
+			handler.NewCond(HumanUserInstanceIDCol, e.Aggregate().InstanceID),
+		},
+		handler.WithTableSuffix(UserHumanSuffix),
+	), nil
+}
+
 func (p *userProjection) reduceMachineSecretSet(event eventstore.Event) (*handler.Statement, error) {
 	e, ok := event.(*user.MachineSecretSetEvent)
 	if !ok {
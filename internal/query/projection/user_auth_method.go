@@ -14,19 +14,21 @@ import (
 )
 
 const (
-	UserAuthMethodTable = "projections.user_auth_methods5"
+	UserAuthMethodTable = "projections.user_auth_methods7"
 
-	UserAuthMethodUserIDCol        = "user_id"
-	UserAuthMethodTypeCol          = "method_type"
-	UserAuthMethodTokenIDCol       = "token_id"
-	UserAuthMethodCreationDateCol  = "creation_date"
-	UserAuthMethodChangeDateCol    = "change_date"
-	UserAuthMethodSequenceCol      = "sequence"
-	UserAuthMethodResourceOwnerCol = "resource_owner"
-	UserAuthMethodInstanceIDCol    = "instance_id"
-	UserAuthMethodStateCol         = "state"
-	UserAuthMethodNameCol          = "name"
-	UserAuthMethodDomainCol        = "domain"
+	UserAuthMethodUserIDCol             = "user_id"
+	UserAuthMethodTypeCol               = "method_type"
+	UserAuthMethodTokenIDCol            = "token_id"
+	UserAuthMethodCreationDateCol       = "creation_date"
+	UserAuthMethodChangeDateCol         = "change_date"
+	UserAuthMethodSequenceCol           = "sequence"
+	UserAuthMethodResourceOwnerCol      = "resource_owner"
+	UserAuthMethodInstanceIDCol         = "instance_id"
+	UserAuthMethodStateCol              = "state"
+	UserAuthMethodNameCol               = "name"
+	UserAuthMethodDomainCol             = "domain"
+	UserAuthMethodRemainingCodesCol     = "remaining_codes"
+	UserAuthMethodAuthenticatorModelCol = "authenticator_model"
 )
 
 type userAuthMethodProjection struct{}
@@ -53,6 +55,8 @@ func (*userAuthMethodProjection) Init() *old_handler.Check {
 			handler.NewColumn(UserAuthMethodInstanceIDCol, handler.ColumnTypeText),
 			handler.NewColumn(UserAuthMethodNameCol, handler.ColumnTypeText),
 			handler.NewColumn(UserAuthMethodDomainCol, handler.ColumnTypeText, handler.Nullable()),
+			handler.NewColumn(UserAuthMethodRemainingCodesCol, handler.ColumnTypeInt64, handler.Default(0)),
+			handler.NewColumn(UserAuthMethodAuthenticatorModelCol, handler.ColumnTypeText, handler.Default("")),
 		},
 			handler.NewPrimaryKey(UserAuthMethodInstanceIDCol, UserAuthMethodUserIDCol, UserAuthMethodTypeCol, UserAuthMethodTokenIDCol),
 			handler.WithIndex(handler.NewIndex("resource_owner", []string{UserAuthMethodResourceOwnerCol})),
@@ -97,6 +101,14 @@ func (p *userAuthMethodProjection) Reducers() []handler.AggregateReducer {
 					Event:  user.HumanOTPEmailAddedType,
 					Reduce: p.reduceAddAuthMethod,
 				},
+				{
+					Event:  user.HumanRecoveryCodesGeneratedType,
+					Reduce: p.reduceRecoveryCodesGenerated,
+				},
+				{
+					Event:  user.HumanRecoveryCodeCheckSucceededType,
+					Reduce: p.reduceRecoveryCodeConsumed,
+				},
 				{
 					Event:  user.HumanPasswordlessTokenRemovedType,
 					Reduce: p.reduceRemoveAuthMethod,
@@ -125,6 +137,10 @@ func (p *userAuthMethodProjection) Reducers() []handler.AggregateReducer {
 					Event:  user.HumanOTPEmailRemovedType,
 					Reduce: p.reduceRemoveAuthMethod,
 				},
+				{
+					Event:  user.HumanRecoveryCodesRemovedType,
+					Reduce: p.reduceRemoveAuthMethod,
+				},
 			},
 		},
 		{
@@ -197,30 +213,37 @@ func (p *userAuthMethodProjection) reduceActivateEvent(event eventstore.Event) (
 	tokenID := ""
 	name := ""
 	var methodType domain.UserAuthMethodType
+	var webAuthN *user.HumanWebAuthNVerifiedEvent
 
 	switch e := event.(type) {
 	case *user.HumanPasswordlessVerifiedEvent:
 		methodType = domain.UserAuthMethodTypePasswordless
 		tokenID = e.WebAuthNTokenID
 		name = e.WebAuthNTokenName
+		webAuthN = &e.HumanWebAuthNVerifiedEvent
 	case *user.HumanU2FVerifiedEvent:
 		methodType = domain.UserAuthMethodTypeU2F
 		tokenID = e.WebAuthNTokenID
 		name = e.WebAuthNTokenName
+		webAuthN = &e.HumanWebAuthNVerifiedEvent
 	case *user.HumanOTPVerifiedEvent:
 		methodType = domain.UserAuthMethodTypeTOTP
 	default:
 		return nil, zerrors.ThrowInvalidArgumentf(nil, "PROJE-f92f", "reduce.wrong.event.type %v", []eventstore.EventType{user.HumanPasswordlessTokenAddedType, user.HumanU2FTokenAddedType})
 	}
 
+	cols := []handler.Column{
+		handler.NewCol(UserAuthMethodChangeDateCol, event.CreatedAt()),
+		handler.NewCol(UserAuthMethodSequenceCol, event.Sequence()),
+		handler.NewCol(UserAuthMethodNameCol, name),
+		handler.NewCol(UserAuthMethodStateCol, domain.MFAStateReady),
+	}
+	if webAuthN != nil {
+		cols = append(cols, handler.NewCol(UserAuthMethodAuthenticatorModelCol, webAuthN.AuthenticatorModel))
+	}
 	return handler.NewUpdateStatement(
 		event,
-		[]handler.Column{
-			handler.NewCol(UserAuthMethodChangeDateCol, event.CreatedAt()),
-			handler.NewCol(UserAuthMethodSequenceCol, event.Sequence()),
-			handler.NewCol(UserAuthMethodNameCol, name),
-			handler.NewCol(UserAuthMethodStateCol, domain.MFAStateReady),
-		},
+		cols,
 		[]handler.Condition{
 			handler.NewCond(UserAuthMethodUserIDCol, event.Aggregate().ID),
 			handler.NewCond(UserAuthMethodTypeCol, methodType),
@@ -259,6 +282,56 @@ func (p *userAuthMethodProjection) reduceAddAuthMethod(event eventstore.Event) (
 	), nil
 }
 
+func (p *userAuthMethodProjection) reduceRecoveryCodesGenerated(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*user.HumanRecoveryCodesGeneratedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	return handler.NewUpsertStatement(
+		e,
+		[]handler.Column{
+			handler.NewCol(UserAuthMethodInstanceIDCol, nil),
+			handler.NewCol(UserAuthMethodUserIDCol, nil),
+			handler.NewCol(UserAuthMethodTypeCol, nil),
+			handler.NewCol(UserAuthMethodTokenIDCol, nil),
+		},
+		[]handler.Column{
+			handler.NewCol(UserAuthMethodTokenIDCol, ""),
+			handler.NewCol(UserAuthMethodCreationDateCol, handler.OnlySetValueOnInsert(UserAuthMethodTable, e.CreatedAt())),
+			handler.NewCol(UserAuthMethodChangeDateCol, e.CreatedAt()),
+			handler.NewCol(UserAuthMethodResourceOwnerCol, e.Aggregate().ResourceOwner),
+			handler.NewCol(UserAuthMethodInstanceIDCol, e.Aggregate().InstanceID),
+			handler.NewCol(UserAuthMethodUserIDCol, e.Aggregate().ID),
+			handler.NewCol(UserAuthMethodSequenceCol, e.Sequence()),
+			handler.NewCol(UserAuthMethodStateCol, domain.MFAStateReady),
+			handler.NewCol(UserAuthMethodTypeCol, domain.UserAuthMethodTypeRecoveryCode),
+			handler.NewCol(UserAuthMethodNameCol, ""),
+			handler.NewCol(UserAuthMethodRemainingCodesCol, len(e.CodeHashes)),
+		},
+	), nil
+}
+
+func (p *userAuthMethodProjection) reduceRecoveryCodeConsumed(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*user.HumanRecoveryCodeCheckSucceededEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	return handler.NewUpdateStatement(
+		e,
+		[]handler.Column{
+			handler.NewCol(UserAuthMethodChangeDateCol, e.CreatedAt()),
+			handler.NewCol(UserAuthMethodSequenceCol, e.Sequence()),
+			handler.NewIncrementCol(UserAuthMethodRemainingCodesCol, -1),
+		},
+		[]handler.Condition{
+			handler.NewCond(UserAuthMethodUserIDCol, e.Aggregate().ID),
+			handler.NewCond(UserAuthMethodTypeCol, domain.UserAuthMethodTypeRecoveryCode),
+			handler.NewCond(UserAuthMethodResourceOwnerCol, e.Aggregate().ResourceOwner),
+			handler.NewCond(UserAuthMethodInstanceIDCol, e.Aggregate().InstanceID),
+		},
+	), nil
+}
+
 func (p *userAuthMethodProjection) reduceRemoveAuthMethod(event eventstore.Event) (*handler.Statement, error) {
 	var tokenID string
 	var methodType domain.UserAuthMethodType
@@ -276,11 +349,13 @@ func (p *userAuthMethodProjection) reduceRemoveAuthMethod(event eventstore.Event
 		methodType = domain.UserAuthMethodTypeOTPSMS
 	case *user.HumanOTPEmailRemovedEvent:
 		methodType = domain.UserAuthMethodTypeOTPEmail
+	case *user.HumanRecoveryCodesRemovedEvent:
+		methodType = domain.UserAuthMethodTypeRecoveryCode
 
 	default:
 		return nil, zerrors.ThrowInvalidArgumentf(nil, "PROJE-f92f", "reduce.wrong.event.type %v",
 			[]eventstore.EventType{user.HumanPasswordlessTokenAddedType, user.HumanU2FTokenAddedType, user.HumanMFAOTPRemovedType,
-				user.HumanOTPSMSRemovedType, user.HumanPhoneRemovedType, user.HumanOTPEmailRemovedType})
+				user.HumanOTPSMSRemovedType, user.HumanPhoneRemovedType, user.HumanOTPEmailRemovedType, user.HumanRecoveryCodesRemovedType})
 	}
 	conditions := []handler.Condition{
 		handler.NewCond(UserAuthMethodUserIDCol, event.Aggregate().ID),
@@ -2,9 +2,13 @@ package projection
 
 import (
 	"database/sql"
+	"strings"
 	"testing"
 	"time"
 
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
 	"github.com/zitadel/zitadel/internal/domain"
 	"github.com/zitadel/zitadel/internal/eventstore"
 	"github.com/zitadel/zitadel/internal/eventstore/handler/v2"
@@ -1488,6 +1492,34 @@ func TestUserProjection_reduces(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "reduceHumanPasswordBreached",
+			args: args{
+				event: getEvent(
+					testEvent(
+						user.HumanPasswordBreachedType,
+						user.AggregateType,
+						[]byte(`{}`),
+					), eventstore.GenericEventMapper[user.HumanPasswordBreachedEvent]),
+			},
+			reduce: (&userProjection{}).reduceHumanPasswordBreached,
+			want: wantReduce{
+				aggregateType: user.AggregateType,
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "UPDATE projections.users14_humans SET password_change_required = $1 WHERE (user_id = $2) AND (instance_id = $3)",
+							expectedArgs: []interface{}{
+								true,
+								"agg-id",
+								"instance-id",
+							},
+						},
+					},
+				},
+			},
+		},
 		{
 			name: "reduceMachineAddedEvent no description",
 			args: args{
@@ -2003,3 +2035,108 @@ func TestUserProjection_reduces(t *testing.T) {
 		})
 	}
 }
+
+// TestUserSequenceEventTypes asserts that [UserSequenceEventTypes] contains exactly the events
+// which update the sequence of the projected user or remove it.
+// If a reducer is added to the user projection, its event mapper has to be added to the mappers below.
+func TestUserSequenceEventTypes(t *testing.T) {
+	mappers := map[eventstore.EventType]func(eventstore.Event) (eventstore.Event, error){
+		user.UserV1AddedType:                     user.HumanAddedEventMapper,
+		user.HumanAddedType:                      user.HumanAddedEventMapper,
+		user.UserV1RegisteredType:                user.HumanRegisteredEventMapper,
+		user.HumanRegisteredType:                 user.HumanRegisteredEventMapper,
+		user.HumanInitialCodeAddedType:           user.HumanInitialCodeAddedEventMapper,
+		user.UserV1InitialCodeAddedType:          user.HumanInitialCodeAddedEventMapper,
+		user.HumanInitializedCheckSucceededType:  user.HumanInitializedCheckSucceededEventMapper,
+		user.UserV1InitializedCheckSucceededType: user.HumanInitializedCheckSucceededEventMapper,
+		user.UserLockedType:                      user.UserLockedEventMapper,
+		user.UserUnlockedType:                    user.UserUnlockedEventMapper,
+		user.UserDeactivatedType:                 user.UserDeactivatedEventMapper,
+		user.UserReactivatedType:                 user.UserReactivatedEventMapper,
+		user.UserRemovedType:                     user.UserRemovedEventMapper,
+		user.UserUserNameChangedType:             user.UsernameChangedEventMapper,
+		user.UserDomainClaimedType:               user.DomainClaimedEventMapper,
+		user.HumanProfileChangedType:             user.HumanProfileChangedEventMapper,
+		user.UserV1ProfileChangedType:            user.HumanProfileChangedEventMapper,
+		user.HumanPhoneChangedType:               user.HumanPhoneChangedEventMapper,
+		user.UserV1PhoneChangedType:              user.HumanPhoneChangedEventMapper,
+		user.HumanPhoneRemovedType:               user.HumanPhoneRemovedEventMapper,
+		user.UserV1PhoneRemovedType:              user.HumanPhoneRemovedEventMapper,
+		user.HumanPhoneVerifiedType:              user.HumanPhoneVerifiedEventMapper,
+		user.UserV1PhoneVerifiedType:             user.HumanPhoneVerifiedEventMapper,
+		user.HumanEmailChangedType:               user.HumanEmailChangedEventMapper,
+		user.UserV1EmailChangedType:              user.HumanEmailChangedEventMapper,
+		user.HumanEmailVerifiedType:              user.HumanEmailVerifiedEventMapper,
+		user.UserV1EmailVerifiedType:             user.HumanEmailVerifiedEventMapper,
+		user.HumanAvatarAddedType:                user.HumanAvatarAddedEventMapper,
+		user.HumanAvatarRemovedType:              user.HumanAvatarRemovedEventMapper,
+		user.MachineAddedEventType:               user.MachineAddedEventMapper,
+		user.MachineChangedEventType:             user.MachineChangedEventMapper,
+		user.HumanPasswordChangedType:            user.HumanPasswordChangedEventMapper,
+		user.HumanPasswordBreachedType:           eventstore.GenericEventMapper[user.HumanPasswordBreachedEvent],
+		user.MachineSecretSetType:                user.MachineSecretSetEventMapper,
+		user.MachineSecretHashUpdatedType:        eventstore.GenericEventMapper[user.MachineSecretHashUpdatedEvent],
+		user.MachineSecretRemovedType:            user.MachineSecretRemovedEventMapper,
+		user.UserV1MFAOTPVerifiedType:            user.HumanOTPVerifiedEventMapper,
+		user.HumanMFAOTPVerifiedType:             user.HumanOTPVerifiedEventMapper,
+		user.HumanOTPSMSAddedType:                eventstore.GenericEventMapper[user.HumanOTPSMSAddedEvent],
+		user.HumanOTPEmailAddedType:              eventstore.GenericEventMapper[user.HumanOTPEmailAddedEvent],
+		user.HumanU2FTokenVerifiedType:           user.HumanU2FVerifiedEventMapper,
+		user.HumanPasswordlessTokenVerifiedType:  user.HumanPasswordlessVerifiedEventMapper,
+		user.UserV1MFAInitSkippedType:            user.HumanMFAInitSkippedEventMapper,
+		user.HumanMFAInitSkippedType:             user.HumanMFAInitSkippedEventMapper,
+	}
+	var updatingSequence []eventstore.EventType
+	for _, reducer := range new(userProjection).Reducers() {
+		if reducer.Aggregate != user.AggregateType {
+			continue
+		}
+		for _, eventReducer := range reducer.EventReducers {
+			mapper, ok := mappers[eventReducer.Event]
+			if !ok {
+				t.Errorf("no mapper for event type %s", eventReducer.Event)
+				continue
+			}
+			event := getEvent(testEvent(eventReducer.Event, user.AggregateType, userSequenceTestPayload), mapper)(t)
+			stmt, err := eventReducer.Reduce(event)
+			require.NoError(t, err, eventReducer.Event)
+			if stmt.Execute == nil {
+				continue
+			}
+			executer := new(statementRecorder)
+			require.NoError(t, stmt.Execute(executer, UserTable), eventReducer.Event)
+			if executer.changesUserSequence() {
+				updatingSequence = append(updatingSequence, eventReducer.Event)
+			}
+		}
+	}
+	assert.ElementsMatch(t, updatingSequence, UserSequenceEventTypes)
+}
+
+// userSequenceTestPayload sets a field of each event, so the reducers don't return an empty update.
+var userSequenceTestPayload = []byte(`{"userName":"username","firstName":"first-name","email":"email@zitadel.com","phone":"+41 00 000 00 00","name":"name","storeKey":"key"}`)
+
+// statementRecorder records the statements executed on the projection.
+type statementRecorder struct {
+	stmts []string
+}
+
+func (r *statementRecorder) Exec(stmt string, _ ...interface{}) (sql.Result, error) {
+	r.stmts = append(r.stmts, stmt)
+	return nil, nil
+}
+
+// changesUserSequence returns true if a statement sets the sequence of the user table or deletes the user,
+// the sub tables are excluded.
+func (r *statementRecorder) changesUserSequence() bool {
+	for _, stmt := range r.stmts {
+		if strings.HasPrefix(stmt, "DELETE FROM "+UserTable+" ") {
+			return true
+		}
+		if (strings.HasPrefix(stmt, "INSERT INTO "+UserTable+" ") || strings.HasPrefix(stmt, "UPDATE "+UserTable+" ")) &&
+			strings.Contains(stmt, UserSequenceCol) {
+			return true
+		}
+	}
+	return false
+}
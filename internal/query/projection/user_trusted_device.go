@@ -0,0 +1,186 @@
+package projection
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/eventstore"
+	old_handler "github.com/zitadel/zitadel/internal/eventstore/handler"
+	"github.com/zitadel/zitadel/internal/eventstore/handler/v2"
+	"github.com/zitadel/zitadel/internal/repository/instance"
+	"github.com/zitadel/zitadel/internal/repository/org"
+	"github.com/zitadel/zitadel/internal/repository/user"
+)
+
+const (
+	TrustedDeviceProjectionTable = "projections.trusted_devices"
+
+	TrustedDeviceColumnID            = "id"
+	TrustedDeviceColumnCreationDate  = "creation_date"
+	TrustedDeviceColumnChangeDate    = "change_date"
+	TrustedDeviceColumnSequence      = "sequence"
+	TrustedDeviceColumnResourceOwner = "resource_owner"
+	TrustedDeviceColumnInstanceID    = "instance_id"
+	TrustedDeviceColumnUserID        = "user_id"
+	TrustedDeviceColumnName          = "name"
+	TrustedDeviceColumnUserAgentID   = "user_agent_id"
+	TrustedDeviceColumnLastUsed      = "last_used"
+	TrustedDeviceColumnExpiration    = "expiration"
+)
+
+type trustedDeviceProjection struct{}
+
+func newTrustedDeviceProjection(ctx context.Context, config handler.Config) *handler.Handler {
+	return handler.NewHandler(ctx, &config, new(trustedDeviceProjection))
+}
+
+func (*trustedDeviceProjection) Name() string {
+	return TrustedDeviceProjectionTable
+}
+
+func (*trustedDeviceProjection) Init() *old_handler.Check {
+	return handler.NewTableCheck(
+		handler.NewTable([]*handler.InitColumn{
+			handler.NewColumn(TrustedDeviceColumnID, handler.ColumnTypeText),
+			handler.NewColumn(TrustedDeviceColumnCreationDate, handler.ColumnTypeTimestamp),
+			handler.NewColumn(TrustedDeviceColumnChangeDate, handler.ColumnTypeTimestamp),
+			handler.NewColumn(TrustedDeviceColumnSequence, handler.ColumnTypeInt64),
+			handler.NewColumn(TrustedDeviceColumnResourceOwner, handler.ColumnTypeText),
+			handler.NewColumn(TrustedDeviceColumnInstanceID, handler.ColumnTypeText),
+			handler.NewColumn(TrustedDeviceColumnUserID, handler.ColumnTypeText),
+			handler.NewColumn(TrustedDeviceColumnName, handler.ColumnTypeText, handler.Default("")),
+			handler.NewColumn(TrustedDeviceColumnUserAgentID, handler.ColumnTypeText),
+			handler.NewColumn(TrustedDeviceColumnLastUsed, handler.ColumnTypeTimestamp, handler.Nullable()),
+			handler.NewColumn(TrustedDeviceColumnExpiration, handler.ColumnTypeTimestamp),
+		},
+			handler.NewPrimaryKey(TrustedDeviceColumnInstanceID, TrustedDeviceColumnID),
+			handler.WithIndex(handler.NewIndex("user_id", []string{TrustedDeviceColumnUserID})),
+		),
+	)
+}
+
+func (p *trustedDeviceProjection) Reducers() []handler.AggregateReducer {
+	return []handler.AggregateReducer{
+		{
+			Aggregate: user.AggregateType,
+			EventReducers: []handler.EventReducer{
+				{
+					Event:  user.HumanTrustedDeviceAddedType,
+					Reduce: p.reduceTrustedDeviceAdded,
+				},
+				{
+					Event:  user.HumanTrustedDeviceUsedType,
+					Reduce: p.reduceTrustedDeviceUsed,
+				},
+				{
+					Event:  user.HumanTrustedDeviceRemovedType,
+					Reduce: p.reduceTrustedDeviceRemoved,
+				},
+				{
+					Event:  user.UserRemovedType,
+					Reduce: p.reduceUserRemoved,
+				},
+			},
+		},
+		{
+			Aggregate: org.AggregateType,
+			EventReducers: []handler.EventReducer{
+				{
+					Event:  org.OrgRemovedEventType,
+					Reduce: p.reduceOwnerRemoved,
+				},
+			},
+		},
+		{
+			Aggregate: instance.AggregateType,
+			EventReducers: []handler.EventReducer{
+				{
+					Event:  instance.InstanceRemovedEventType,
+					Reduce: reduceInstanceRemovedHelper(TrustedDeviceColumnInstanceID),
+				},
+			},
+		},
+	}
+}
+
+func (p *trustedDeviceProjection) reduceTrustedDeviceAdded(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*user.HumanTrustedDeviceAddedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	return handler.NewCreateStatement(
+		e,
+		[]handler.Column{
+			handler.NewCol(TrustedDeviceColumnID, e.DeviceID),
+			handler.NewCol(TrustedDeviceColumnCreationDate, e.CreationDate()),
+			handler.NewCol(TrustedDeviceColumnChangeDate, e.CreationDate()),
+			handler.NewCol(TrustedDeviceColumnSequence, e.Sequence()),
+			handler.NewCol(TrustedDeviceColumnResourceOwner, e.Aggregate().ResourceOwner),
+			handler.NewCol(TrustedDeviceColumnInstanceID, e.Aggregate().InstanceID),
+			handler.NewCol(TrustedDeviceColumnUserID, e.Aggregate().ID),
+			handler.NewCol(TrustedDeviceColumnName, e.Name),
+			handler.NewCol(TrustedDeviceColumnUserAgentID, e.UserAgentID),
+			handler.NewCol(TrustedDeviceColumnExpiration, e.CreationDate().Add(e.Lifetime)),
+		},
+	), nil
+}
+
+func (p *trustedDeviceProjection) reduceTrustedDeviceUsed(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*user.HumanTrustedDeviceUsedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	return handler.NewUpdateStatement(
+		e,
+		[]handler.Column{
+			handler.NewCol(TrustedDeviceColumnChangeDate, e.CreationDate()),
+			handler.NewCol(TrustedDeviceColumnSequence, e.Sequence()),
+			handler.NewCol(TrustedDeviceColumnLastUsed, e.CreationDate()),
+		},
+		[]handler.Condition{
+			handler.NewCond(TrustedDeviceColumnID, e.DeviceID),
+			handler.NewCond(TrustedDeviceColumnInstanceID, e.Aggregate().InstanceID),
+		},
+	), nil
+}
+
+func (p *trustedDeviceProjection) reduceTrustedDeviceRemoved(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*user.HumanTrustedDeviceRemovedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	return handler.NewDeleteStatement(
+		e,
+		[]handler.Condition{
+			handler.NewCond(TrustedDeviceColumnID, e.DeviceID),
+			handler.NewCond(TrustedDeviceColumnInstanceID, e.Aggregate().InstanceID),
+		},
+	), nil
+}
+
+func (p *trustedDeviceProjection) reduceUserRemoved(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*user.UserRemovedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	return handler.NewDeleteStatement(
+		e,
+		[]handler.Condition{
+			handler.NewCond(TrustedDeviceColumnUserID, e.Aggregate().ID),
+			handler.NewCond(TrustedDeviceColumnInstanceID, e.Aggregate().InstanceID),
+		},
+	), nil
+}
+
+func (p *trustedDeviceProjection) reduceOwnerRemoved(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*org.OrgRemovedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	return handler.NewDeleteStatement(
+		e,
+		[]handler.Condition{
+			handler.NewCond(TrustedDeviceColumnInstanceID, e.Aggregate().InstanceID),
+			handler.NewCond(TrustedDeviceColumnResourceOwner, e.Aggregate().ID),
+		},
+	), nil
+}
@@ -0,0 +1,210 @@
+package projection
+
+import (
+	"testing"
+
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/eventstore/handler/v2"
+	"github.com/zitadel/zitadel/internal/repository/instance"
+	"github.com/zitadel/zitadel/internal/repository/org"
+	"github.com/zitadel/zitadel/internal/repository/user"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+func TestTrustedDeviceProjection_reduces(t *testing.T) {
+	type args struct {
+		event func(t *testing.T) eventstore.Event
+	}
+	tests := []struct {
+		name   string
+		args   args
+		reduce func(event eventstore.Event) (*handler.Statement, error)
+		want   wantReduce
+	}{
+		{
+			name: "reduceTrustedDeviceAdded",
+			args: args{
+				event: getEvent(
+					testEvent(
+						user.HumanTrustedDeviceAddedType,
+						user.AggregateType,
+						[]byte(`{"deviceId": "deviceID", "name": "browser", "userAgentId": "userAgentID", "tokenHash": "hash", "lifetime": 3600000000000}`),
+					), user.HumanTrustedDeviceAddedEventMapper),
+			},
+			reduce: (&trustedDeviceProjection{}).reduceTrustedDeviceAdded,
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("user"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "INSERT INTO projections.trusted_devices (id, creation_date, change_date, sequence, resource_owner, instance_id, user_id, name, user_agent_id, expiration) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)",
+							expectedArgs: []interface{}{
+								"deviceID",
+								anyArg{},
+								anyArg{},
+								uint64(15),
+								"ro-id",
+								"instance-id",
+								"agg-id",
+								"browser",
+								"userAgentID",
+								anyArg{},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceTrustedDeviceUsed",
+			args: args{
+				event: getEvent(
+					testEvent(
+						user.HumanTrustedDeviceUsedType,
+						user.AggregateType,
+						[]byte(`{"deviceId": "deviceID"}`),
+					), user.HumanTrustedDeviceUsedEventMapper),
+			},
+			reduce: (&trustedDeviceProjection{}).reduceTrustedDeviceUsed,
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("user"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "UPDATE projections.trusted_devices SET (change_date, sequence, last_used) = ($1, $2, $3) WHERE (id = $4) AND (instance_id = $5)",
+							expectedArgs: []interface{}{
+								anyArg{},
+								uint64(15),
+								anyArg{},
+								"deviceID",
+								"instance-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceTrustedDeviceRemoved",
+			args: args{
+				event: getEvent(
+					testEvent(
+						user.HumanTrustedDeviceRemovedType,
+						user.AggregateType,
+						[]byte(`{"deviceId": "deviceID"}`),
+					), user.HumanTrustedDeviceRemovedEventMapper),
+			},
+			reduce: (&trustedDeviceProjection{}).reduceTrustedDeviceRemoved,
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("user"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "DELETE FROM projections.trusted_devices WHERE (id = $1) AND (instance_id = $2)",
+							expectedArgs: []interface{}{
+								"deviceID",
+								"instance-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceUserRemoved",
+			args: args{
+				event: getEvent(
+					testEvent(
+						user.UserRemovedType,
+						user.AggregateType,
+						nil,
+					), user.UserRemovedEventMapper),
+			},
+			reduce: (&trustedDeviceProjection{}).reduceUserRemoved,
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("user"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "DELETE FROM projections.trusted_devices WHERE (user_id = $1) AND (instance_id = $2)",
+							expectedArgs: []interface{}{
+								"agg-id",
+								"instance-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name:   "org reduceOwnerRemoved",
+			reduce: (&trustedDeviceProjection{}).reduceOwnerRemoved,
+			args: args{
+				event: getEvent(
+					testEvent(
+						org.OrgRemovedEventType,
+						org.AggregateType,
+						nil,
+					), org.OrgRemovedEventMapper),
+			},
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("org"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "DELETE FROM projections.trusted_devices WHERE (instance_id = $1) AND (resource_owner = $2)",
+							expectedArgs: []interface{}{
+								"instance-id",
+								"agg-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "instance reduceInstanceRemoved",
+			args: args{
+				event: getEvent(
+					testEvent(
+						instance.InstanceRemovedEventType,
+						instance.AggregateType,
+						nil,
+					), instance.InstanceRemovedEventMapper),
+			},
+			reduce: reduceInstanceRemovedHelper(TrustedDeviceColumnInstanceID),
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("instance"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "DELETE FROM projections.trusted_devices WHERE (instance_id = $1)",
+							expectedArgs: []interface{}{
+								"agg-id",
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			event := baseEvent(t)
+			got, err := tt.reduce(event)
+			if ok := zerrors.IsErrorInvalidArgument(err); !ok {
+				t.Errorf("no wrong event mapping: %v, got: %v", err, got)
+			}
+
+			event = tt.args.event(t)
+			got, err = tt.reduce(event)
+			assertReduce(t, got, err, TrustedDeviceProjectionTable, tt.want)
+		})
+	}
+}
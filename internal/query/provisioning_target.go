@@ -0,0 +1,460 @@
+package query
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/crypto"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/query/projection"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+var (
+	provisioningTargetTable = table{
+		name:          projection.ProvisioningTargetTable,
+		instanceIDCol: projection.ProvisioningTargetInstanceIDCol,
+	}
+	ProvisioningTargetColumnID = Column{
+		name:  projection.ProvisioningTargetIDCol,
+		table: provisioningTargetTable,
+	}
+	ProvisioningTargetColumnProjectID = Column{
+		name:  projection.ProvisioningTargetProjectIDCol,
+		table: provisioningTargetTable,
+	}
+	ProvisioningTargetColumnCreationDate = Column{
+		name:  projection.ProvisioningTargetCreationDateCol,
+		table: provisioningTargetTable,
+	}
+	ProvisioningTargetColumnChangeDate = Column{
+		name:  projection.ProvisioningTargetChangeDateCol,
+		table: provisioningTargetTable,
+	}
+	ProvisioningTargetColumnResourceOwner = Column{
+		name:  projection.ProvisioningTargetResourceOwnerCol,
+		table: provisioningTargetTable,
+	}
+	ProvisioningTargetColumnInstanceID = Column{
+		name:  projection.ProvisioningTargetInstanceIDCol,
+		table: provisioningTargetTable,
+	}
+	ProvisioningTargetColumnSequence = Column{
+		name:  projection.ProvisioningTargetSequenceCol,
+		table: provisioningTargetTable,
+	}
+	ProvisioningTargetColumnEndpoint = Column{
+		name:  projection.ProvisioningTargetEndpointCol,
+		table: provisioningTargetTable,
+	}
+	ProvisioningTargetColumnToken = Column{
+		name:  projection.ProvisioningTargetTokenCol,
+		table: provisioningTargetTable,
+	}
+)
+
+var (
+	provisioningTargetUserTable = table{
+		name:          projection.ProvisioningTargetUsersTable,
+		instanceIDCol: projection.ProvisioningTargetUserInstanceIDCol,
+	}
+	ProvisioningTargetUserColumnTargetID = Column{
+		name:  projection.ProvisioningTargetUserTargetIDCol,
+		table: provisioningTargetUserTable,
+	}
+	ProvisioningTargetUserColumnInstanceID = Column{
+		name:  projection.ProvisioningTargetUserInstanceIDCol,
+		table: provisioningTargetUserTable,
+	}
+	ProvisioningTargetUserColumnUserID = Column{
+		name:  projection.ProvisioningTargetUserUserIDCol,
+		table: provisioningTargetUserTable,
+	}
+	ProvisioningTargetUserColumnResourceOwner = Column{
+		name:  projection.ProvisioningTargetUserResourceOwnerCol,
+		table: provisioningTargetUserTable,
+	}
+	ProvisioningTargetUserColumnRemoteID = Column{
+		name:  projection.ProvisioningTargetUserRemoteIDCol,
+		table: provisioningTargetUserTable,
+	}
+	ProvisioningTargetUserColumnSyncState = Column{
+		name:  projection.ProvisioningTargetUserSyncStateCol,
+		table: provisioningTargetUserTable,
+	}
+	ProvisioningTargetUserColumnError = Column{
+		name:  projection.ProvisioningTargetUserErrorCol,
+		table: provisioningTargetUserTable,
+	}
+	ProvisioningTargetUserColumnTriggerEventType = Column{
+		name:  projection.ProvisioningTargetUserTriggerEventTypeCol,
+		table: provisioningTargetUserTable,
+	}
+	ProvisioningTargetUserColumnChangeDate = Column{
+		name:  projection.ProvisioningTargetUserChangeDateCol,
+		table: provisioningTargetUserTable,
+	}
+	ProvisioningTargetUserColumnSequence = Column{
+		name:  projection.ProvisioningTargetUserSequenceCol,
+		table: provisioningTargetUserTable,
+	}
+)
+
+// ProvisioningTarget is the SCIM server the users of the project of an application are provisioned to.
+// The id of the target is the id of the application.
+type ProvisioningTarget struct {
+	domain.ObjectDetails
+
+	ProjectID string
+	Endpoint  string
+	token     *crypto.CryptoValue
+	// Token is only set if the target was queried using [Queries.ProvisioningTargetWithToken].
+	Token string
+}
+
+type ProvisioningTargetUsers struct {
+	SearchResponse
+	Users []*ProvisioningTargetUser
+}
+
+func (u *ProvisioningTargetUsers) SetState(s *State) {
+	u.State = s
+}
+
+// ProvisioningTargetUser is the state of the synchronization of a user to a provisioning target.
+type ProvisioningTargetUser struct {
+	TargetID         string
+	UserID           string
+	ResourceOwner    string
+	RemoteID         string
+	SyncState        domain.ProvisioningSyncState
+	Error            string
+	TriggerEventType eventstore.EventType
+	ChangeDate       time.Time
+	Sequence         uint64
+}
+
+type ProvisioningTargetUserSearchQueries struct {
+	SearchRequest
+	Queries []SearchQuery
+}
+
+func (q *ProvisioningTargetUserSearchQueries) toQuery(query sq.SelectBuilder) sq.SelectBuilder {
+	query = q.SearchRequest.toQuery(query)
+	for _, q := range q.Queries {
+		query = q.toQuery(query)
+	}
+	return query
+}
+
+// ProvisioningTargetByID returns the provisioning target of the application without the token.
+func (q *Queries) ProvisioningTargetByID(ctx context.Context, projectID, appID string) (_ *ProvisioningTarget, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	eq := sq.Eq{
+		ProvisioningTargetColumnID.identifier():         appID,
+		ProvisioningTargetColumnProjectID.identifier():  projectID,
+		ProvisioningTargetColumnInstanceID.identifier(): authz.GetInstance(ctx).InstanceID(),
+	}
+	query, scan := prepareProvisioningTargetQuery()
+	return genericRowQuery(ctx, q.client, query.Where(eq), scan)
+}
+
+// ProvisioningTargetWithToken returns the provisioning target of the application including the decrypted token.
+// It must only be used to call the target and the token must never be returned to the client.
+func (q *Queries) ProvisioningTargetWithToken(ctx context.Context, appID string) (_ *ProvisioningTarget, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	eq := sq.Eq{
+		ProvisioningTargetColumnID.identifier():         appID,
+		ProvisioningTargetColumnInstanceID.identifier(): authz.GetInstance(ctx).InstanceID(),
+	}
+	query, scan := prepareProvisioningTargetQuery()
+	target, err := genericRowQuery(ctx, q.client, query.Where(eq), scan)
+	if err != nil {
+		return nil, err
+	}
+	if err := target.decryptToken(q.targetEncryptionAlgorithm); err != nil {
+		return nil, err
+	}
+	return target, nil
+}
+
+// ProvisioningTargetsByProjectID returns the provisioning targets of the applications of the project without the tokens.
+func (q *Queries) ProvisioningTargetsByProjectID(ctx context.Context, projectID string) (_ []*ProvisioningTarget, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	eq := sq.Eq{
+		ProvisioningTargetColumnProjectID.identifier():  projectID,
+		ProvisioningTargetColumnInstanceID.identifier(): authz.GetInstance(ctx).InstanceID(),
+	}
+	query, scan := prepareProvisioningTargetsQuery()
+	return genericRowsQuery(ctx, q.client, query.Where(eq), scan)
+}
+
+// ProvisioningTargetsByUserID returns the provisioning targets without the tokens the user is relevant for.
+// These are the targets of the projects the user is granted to
+// and the targets the user was already synchronized to.
+func (q *Queries) ProvisioningTargetsByUserID(ctx context.Context, userID string) (_ []*ProvisioningTarget, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	instanceID := authz.GetInstance(ctx).InstanceID()
+	grantedProjects, err := provisioningTargetSubSelectQuery(
+		ProvisioningTargetColumnProjectID,
+		UserGrantProjectID,
+		UserGrantInstanceID,
+		UserGrantUserID,
+		instanceID,
+		userID,
+	)
+	if err != nil {
+		return nil, err
+	}
+	syncedTargets, err := provisioningTargetSubSelectQuery(
+		ProvisioningTargetColumnID,
+		ProvisioningTargetUserColumnTargetID,
+		ProvisioningTargetUserColumnInstanceID,
+		ProvisioningTargetUserColumnUserID,
+		instanceID,
+		userID,
+	)
+	if err != nil {
+		return nil, err
+	}
+	relevantTargets, err := NewOrQuery(grantedProjects, syncedTargets)
+	if err != nil {
+		return nil, err
+	}
+
+	eq := sq.Eq{
+		ProvisioningTargetColumnInstanceID.identifier(): instanceID,
+	}
+	query, scan := prepareProvisioningTargetsQuery()
+	return genericRowsQuery(ctx, q.client, relevantTargets.toQuery(query).Where(eq), scan)
+}
+
+// provisioningTargetSubSelectQuery returns a query which matches if the value of column is in the selected column
+// of the rows of the user in the instance.
+func provisioningTargetSubSelectQuery(column, selected, instanceIDCol, userIDCol Column, instanceID, userID string) (SearchQuery, error) {
+	instanceQuery, err := NewTextQuery(instanceIDCol, instanceID, TextEquals)
+	if err != nil {
+		return nil, err
+	}
+	userQuery, err := NewTextQuery(userIDCol, userID, TextEquals)
+	if err != nil {
+		return nil, err
+	}
+	subSelect, err := NewSubSelect(selected, []SearchQuery{instanceQuery, userQuery})
+	if err != nil {
+		return nil, err
+	}
+	return NewListQuery(column, subSelect, ListIn)
+}
+
+// ProvisioningTargetUserByID returns the state of the synchronization of the user to the provisioning target.
+func (q *Queries) ProvisioningTargetUserByID(ctx context.Context, targetID, userID string) (_ *ProvisioningTargetUser, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	eq := sq.Eq{
+		ProvisioningTargetUserColumnTargetID.identifier():   targetID,
+		ProvisioningTargetUserColumnUserID.identifier():     userID,
+		ProvisioningTargetUserColumnInstanceID.identifier(): authz.GetInstance(ctx).InstanceID(),
+	}
+	query, scan := prepareProvisioningTargetUserQuery()
+	return genericRowQuery(ctx, q.client, query.Where(eq), scan)
+}
+
+// SearchProvisioningTargetUsers returns the states of the synchronizations of the users to the provisioning target.
+func (q *Queries) SearchProvisioningTargetUsers(ctx context.Context, projectID, targetID string, queries *ProvisioningTargetUserSearchQueries) (_ *ProvisioningTargetUsers, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	eq := sq.Eq{
+		ProvisioningTargetUserColumnTargetID.identifier():   targetID,
+		ProvisioningTargetColumnProjectID.identifier():      projectID,
+		ProvisioningTargetUserColumnInstanceID.identifier(): authz.GetInstance(ctx).InstanceID(),
+	}
+	query, scan := prepareProvisioningTargetUsersQuery()
+	return genericRowsQueryWithState(ctx, q.client, provisioningTargetTable, combineToWhereStmt(query, queries.toQuery, eq), scan)
+}
+
+func NewProvisioningTargetUserSyncStateSearchQuery(state domain.ProvisioningSyncState) (SearchQuery, error) {
+	return NewNumberQuery(ProvisioningTargetUserColumnSyncState, state, NumberEquals)
+}
+
+func NewProvisioningTargetUserUserIDSearchQuery(userID string) (SearchQuery, error) {
+	return NewTextQuery(ProvisioningTargetUserColumnUserID, userID, TextEquals)
+}
+
+func (t *ProvisioningTarget) decryptToken(alg crypto.EncryptionAlgorithm) error {
+	if t.token == nil {
+		return nil
+	}
+	token, err := crypto.DecryptString(t.token, alg)
+	if err != nil {
+		return zerrors.ThrowInternal(err, "QUERY-Prv1q", "Errors.Internal")
+	}
+	t.Token = token
+	return nil
+}
+
+func prepareProvisioningTargetColumns() []string {
+	return []string{
+		ProvisioningTargetColumnID.identifier(),
+		ProvisioningTargetColumnCreationDate.identifier(),
+		ProvisioningTargetColumnChangeDate.identifier(),
+		ProvisioningTargetColumnResourceOwner.identifier(),
+		ProvisioningTargetColumnSequence.identifier(),
+		ProvisioningTargetColumnProjectID.identifier(),
+		ProvisioningTargetColumnEndpoint.identifier(),
+		ProvisioningTargetColumnToken.identifier(),
+	}
+}
+
+func scanProvisioningTarget(scan func(dest ...any) error) (*ProvisioningTarget, error) {
+	target := new(ProvisioningTarget)
+	err := scan(
+		&target.ID,
+		&target.CreationDate,
+		&target.EventDate,
+		&target.ResourceOwner,
+		&target.Sequence,
+		&target.ProjectID,
+		&target.Endpoint,
+		&target.token,
+	)
+	return target, err
+}
+
+func prepareProvisioningTargetQuery() (sq.SelectBuilder, func(row *sql.Row) (*ProvisioningTarget, error)) {
+	return sq.Select(prepareProvisioningTargetColumns()...).
+			From(provisioningTargetTable.identifier()).
+			PlaceholderFormat(sq.Dollar),
+		func(row *sql.Row) (*ProvisioningTarget, error) {
+			target, err := scanProvisioningTarget(row.Scan)
+			if err != nil {
+				if errors.Is(err, sql.ErrNoRows) {
+					return nil, zerrors.ThrowNotFound(err, "QUERY-Prv2q", "Errors.ProvisioningTarget.NotFound")
+				}
+				return nil, zerrors.ThrowInternal(err, "QUERY-Prv3q", "Errors.Internal")
+			}
+			return target, nil
+		}
+}
+
+func prepareProvisioningTargetsQuery() (sq.SelectBuilder, func(rows *sql.Rows) ([]*ProvisioningTarget, error)) {
+	return sq.Select(prepareProvisioningTargetColumns()...).
+			From(provisioningTargetTable.identifier()).
+			PlaceholderFormat(sq.Dollar),
+		func(rows *sql.Rows) ([]*ProvisioningTarget, error) {
+			targets := make([]*ProvisioningTarget, 0)
+			for rows.Next() {
+				target, err := scanProvisioningTarget(rows.Scan)
+				if err != nil {
+					return nil, err
+				}
+				targets = append(targets, target)
+			}
+
+			if err := rows.Close(); err != nil {
+				return nil, zerrors.ThrowInternal(err, "QUERY-Prv4q", "Errors.Query.CloseRows")
+			}
+			return targets, nil
+		}
+}
+
+func prepareProvisioningTargetUserColumns() []string {
+	return []string{
+		ProvisioningTargetUserColumnTargetID.identifier(),
+		ProvisioningTargetUserColumnUserID.identifier(),
+		ProvisioningTargetUserColumnResourceOwner.identifier(),
+		ProvisioningTargetUserColumnRemoteID.identifier(),
+		ProvisioningTargetUserColumnSyncState.identifier(),
+		ProvisioningTargetUserColumnError.identifier(),
+		ProvisioningTargetUserColumnTriggerEventType.identifier(),
+		ProvisioningTargetUserColumnChangeDate.identifier(),
+		ProvisioningTargetUserColumnSequence.identifier(),
+	}
+}
+
+func scanProvisioningTargetUser(scan func(dest ...any) error) (*ProvisioningTargetUser, error) {
+	var (
+		user     = new(ProvisioningTargetUser)
+		remoteID sql.NullString
+		syncErr  sql.NullString
+	)
+	err := scan(
+		&user.TargetID,
+		&user.UserID,
+		&user.ResourceOwner,
+		&remoteID,
+		&user.SyncState,
+		&syncErr,
+		&user.TriggerEventType,
+		&user.ChangeDate,
+		&user.Sequence,
+	)
+	user.RemoteID = remoteID.String
+	user.Error = syncErr.String
+	return user, err
+}
+
+func prepareProvisioningTargetUserQuery() (sq.SelectBuilder, func(row *sql.Row) (*ProvisioningTargetUser, error)) {
+	return sq.Select(prepareProvisioningTargetUserColumns()...).
+			From(provisioningTargetUserTable.identifier()).
+			PlaceholderFormat(sq.Dollar),
+		func(row *sql.Row) (*ProvisioningTargetUser, error) {
+			user, err := scanProvisioningTargetUser(row.Scan)
+			if err != nil {
+				if errors.Is(err, sql.ErrNoRows) {
+					return nil, zerrors.ThrowNotFound(err, "QUERY-Prv5q", "Errors.ProvisioningTarget.NotFound")
+				}
+				return nil, zerrors.ThrowInternal(err, "QUERY-Prv6q", "Errors.Internal")
+			}
+			return user, nil
+		}
+}
+
+// prepareProvisioningTargetUsersQuery joins the targets table to only return users of existing targets.
+func prepareProvisioningTargetUsersQuery() (sq.SelectBuilder, func(rows *sql.Rows) (*ProvisioningTargetUsers, error)) {
+	return sq.Select(append(prepareProvisioningTargetUserColumns(), countColumn.identifier())...).
+			From(provisioningTargetUserTable.identifier()).
+			Join(join(ProvisioningTargetColumnID, ProvisioningTargetUserColumnTargetID)).
+			PlaceholderFormat(sq.Dollar),
+		func(rows *sql.Rows) (*ProvisioningTargetUsers, error) {
+			users := make([]*ProvisioningTargetUser, 0)
+			var count uint64
+			for rows.Next() {
+				user, err := scanProvisioningTargetUser(func(dest ...any) error {
+					return rows.Scan(append(dest, &count)...)
+				})
+				if err != nil {
+					return nil, err
+				}
+				users = append(users, user)
+			}
+
+			if err := rows.Close(); err != nil {
+				return nil, zerrors.ThrowInternal(err, "QUERY-Prv7q", "Errors.Query.CloseRows")
+			}
+
+			return &ProvisioningTargetUsers{
+				Users: users,
+				SearchResponse: SearchResponse{
+					Count: count,
+				},
+			}, nil
+		}
+}
@@ -100,6 +100,7 @@ func StartQueries(
 			MaxEntries: int(projections.MaxActiveInstances),
 			TTL:        projections.HandleActiveInstances,
 		},
+		repo,
 	)
 	if err != nil {
 		return nil, err
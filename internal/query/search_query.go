@@ -777,6 +777,11 @@ func (c Column) isZero() bool {
 	return c.table.isZero() || c.name == ""
 }
 
+// IsZero returns true if the column is not defined
+func (c Column) IsZero() bool {
+	return c.isZero()
+}
+
 func join(join, from Column) string {
 	if join.identifier() == join.table.InstanceIDIdentifier() {
 		return join.table.identifier() + " ON " + from.identifier() + " = " + join.identifier()
@@ -15,8 +15,11 @@ import (
 	"github.com/zitadel/zitadel/internal/api/authz"
 	"github.com/zitadel/zitadel/internal/database"
 	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
 	"github.com/zitadel/zitadel/internal/eventstore/handler/v2"
 	"github.com/zitadel/zitadel/internal/query/projection"
+	"github.com/zitadel/zitadel/internal/repository/session"
+	"github.com/zitadel/zitadel/internal/repository/user"
 	"github.com/zitadel/zitadel/internal/telemetry/tracing"
 	"github.com/zitadel/zitadel/internal/zerrors"
 )
@@ -27,23 +30,28 @@ type Sessions struct {
 }
 
 type Session struct {
-	ID             string
-	CreationDate   time.Time
-	ChangeDate     time.Time
-	Sequence       uint64
-	State          domain.SessionState
-	ResourceOwner  string
-	Creator        string
-	UserFactor     SessionUserFactor
-	PasswordFactor SessionPasswordFactor
-	IntentFactor   SessionIntentFactor
-	WebAuthNFactor SessionWebAuthNFactor
-	TOTPFactor     SessionTOTPFactor
-	OTPSMSFactor   SessionOTPFactor
-	OTPEmailFactor SessionOTPFactor
-	Metadata       map[string][]byte
-	UserAgent      domain.UserAgent
-	Expiration     time.Time
+	ID                      string
+	CreationDate            time.Time
+	ChangeDate              time.Time
+	Sequence                uint64
+	State                   domain.SessionState
+	ResourceOwner           string
+	Creator                 string
+	UserFactor              SessionUserFactor
+	PasswordFactor          SessionPasswordFactor
+	IntentFactor            SessionIntentFactor
+	WebAuthNFactor          SessionWebAuthNFactor
+	TOTPFactor              SessionTOTPFactor
+	OTPSMSFactor            SessionOTPFactor
+	OTPEmailFactor          SessionOTPFactor
+	TrustedDeviceFactor     SessionTrustedDeviceFactor
+	RecoveryCodeFactor      SessionRecoveryCodeFactor
+	ClientCertificateFactor SessionClientCertificateFactor
+	MagicLinkFactor         SessionMagicLinkFactor
+	Metadata                map[string][]byte
+	UserAgent               domain.UserAgent
+	Expiration              time.Time
+	Risk                    SessionRisk
 }
 
 type SessionUserFactor struct {
@@ -75,6 +83,32 @@ type SessionOTPFactor struct {
 	OTPCheckedAt time.Time
 }
 
+type SessionTrustedDeviceFactor struct {
+	TrustedDeviceCheckedAt time.Time
+}
+
+type SessionRecoveryCodeFactor struct {
+	RecoveryCodeCheckedAt time.Time
+}
+
+type SessionClientCertificateFactor struct {
+	ClientCertificateCheckedAt time.Time
+}
+
+type SessionMagicLinkFactor struct {
+	MagicLinkCheckedAt time.Time
+}
+
+type SessionRisk struct {
+	EvaluatedAt      time.Time
+	Level            domain.SessionRiskLevel
+	NewDevice        bool
+	NewCountry       bool
+	ImpossibleTravel bool
+	Country          string
+	StepUpRequired   bool
+}
+
 type SessionsSearchQueries struct {
 	SearchRequest
 	Queries []SearchQuery
@@ -216,6 +250,22 @@ var (
 		name:  projection.SessionColumnOTPEmailCheckedAt,
 		table: sessionsTable,
 	}
+	SessionColumnTrustedDeviceCheckedAt = Column{
+		name:  projection.SessionColumnTrustedDeviceCheckedAt,
+		table: sessionsTable,
+	}
+	SessionColumnRecoveryCodeCheckedAt = Column{
+		name:  projection.SessionColumnRecoveryCodeCheckedAt,
+		table: sessionsTable,
+	}
+	SessionColumnClientCertificateCheckedAt = Column{
+		name:  projection.SessionColumnClientCertificateCheckedAt,
+		table: sessionsTable,
+	}
+	SessionColumnMagicLinkCheckedAt = Column{
+		name:  projection.SessionColumnMagicLinkCheckedAt,
+		table: sessionsTable,
+	}
 	SessionColumnMetadata = Column{
 		name:  projection.SessionColumnMetadata,
 		table: sessionsTable,
@@ -244,6 +294,34 @@ var (
 		name:  projection.SessionColumnExpiration,
 		table: sessionsTable,
 	}
+	SessionColumnRiskEvaluatedAt = Column{
+		name:  projection.SessionColumnRiskEvaluatedAt,
+		table: sessionsTable,
+	}
+	SessionColumnRiskLevel = Column{
+		name:  projection.SessionColumnRiskLevel,
+		table: sessionsTable,
+	}
+	SessionColumnRiskNewDevice = Column{
+		name:  projection.SessionColumnRiskNewDevice,
+		table: sessionsTable,
+	}
+	SessionColumnRiskNewCountry = Column{
+		name:  projection.SessionColumnRiskNewCountry,
+		table: sessionsTable,
+	}
+	SessionColumnRiskImpossibleTravel = Column{
+		name:  projection.SessionColumnRiskImpossibleTravel,
+		table: sessionsTable,
+	}
+	SessionColumnRiskCountry = Column{
+		name:  projection.SessionColumnRiskCountry,
+		table: sessionsTable,
+	}
+	SessionColumnStepUpRequired = Column{
+		name:  projection.SessionColumnStepUpRequired,
+		table: sessionsTable,
+	}
 )
 
 func (q *Queries) SessionByID(ctx context.Context, shouldTriggerBulk bool, id, sessionToken string, permissionCheck domain.PermissionCheck) (session *Session, err error) {
@@ -278,11 +356,20 @@ func (q *Queries) sessionByID(ctx context.Context, shouldTriggerBulk bool, id st
 		traceSpan.EndWithError(err)
 	}
 
+	instanceID := authz.GetInstance(ctx).InstanceID()
+	if cached, ok := q.caches.session.Get(ctx, sessionIndexByID, instanceScopedKey(instanceID, id)); ok {
+		found := cached.Session.copy()
+		if err = q.sessionUserFactorNames(ctx, found); err != nil {
+			return nil, "", err
+		}
+		return found, cached.TokenID, nil
+	}
+
 	query, scan := prepareSessionQuery()
 	stmt, args, err := query.Where(
 		sq.Eq{
 			SessionColumnID.identifier():         id,
-			SessionColumnInstanceID.identifier(): authz.GetInstance(ctx).InstanceID(),
+			SessionColumnInstanceID.identifier(): instanceID,
 		},
 	).ToSql()
 	if err != nil {
@@ -296,9 +383,32 @@ func (q *Queries) sessionByID(ctx context.Context, shouldTriggerBulk bool, id st
 	if err != nil {
 		return nil, "", err
 	}
+	q.caches.session.Set(ctx, &cachedSession{InstanceID: instanceID, Session: session.copy(), TokenID: tokenID})
 	return session, tokenID, nil
 }
 
+// sessionUserFactorNames sets the login and display name of the checked user on a cached session.
+// The names are part of the user and not of the session, so they are not invalidated with the session.
+func (q *Queries) sessionUserFactorNames(ctx context.Context, session *Session) error {
+	session.UserFactor.LoginName = ""
+	session.UserFactor.DisplayName = ""
+	if session.UserFactor.UserID == "" {
+		return nil
+	}
+	user, err := q.GetUserByID(ctx, false, session.UserFactor.UserID)
+	if zerrors.IsNotFound(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	session.UserFactor.LoginName = user.PreferredLoginName
+	if user.Human != nil {
+		session.UserFactor.DisplayName = user.Human.DisplayName
+	}
+	return nil
+}
+
 func (q *Queries) SearchSessions(ctx context.Context, queries *SessionsSearchQueries, permissionCheck domain.PermissionCheck) (*Sessions, error) {
 	permissionCheckV2 := PermissionV2(ctx, permissionCheck)
 	sessions, err := q.searchSessions(ctx, queries, permissionCheckV2)
@@ -383,6 +493,10 @@ func prepareSessionQuery() (sq.SelectBuilder, func(*sql.Row) (*Session, string,
 			SessionColumnTOTPCheckedAt.identifier(),
 			SessionColumnOTPSMSCheckedAt.identifier(),
 			SessionColumnOTPEmailCheckedAt.identifier(),
+			SessionColumnTrustedDeviceCheckedAt.identifier(),
+			SessionColumnRecoveryCodeCheckedAt.identifier(),
+			SessionColumnClientCertificateCheckedAt.identifier(),
+			SessionColumnMagicLinkCheckedAt.identifier(),
 			SessionColumnMetadata.identifier(),
 			SessionColumnToken.identifier(),
 			SessionColumnUserAgentFingerprintID.identifier(),
@@ -390,6 +504,13 @@ func prepareSessionQuery() (sq.SelectBuilder, func(*sql.Row) (*Session, string,
 			SessionColumnUserAgentDescription.identifier(),
 			SessionColumnUserAgentHeader.identifier(),
 			SessionColumnExpiration.identifier(),
+			SessionColumnRiskEvaluatedAt.identifier(),
+			SessionColumnRiskLevel.identifier(),
+			SessionColumnRiskNewDevice.identifier(),
+			SessionColumnRiskNewCountry.identifier(),
+			SessionColumnRiskImpossibleTravel.identifier(),
+			SessionColumnRiskCountry.identifier(),
+			SessionColumnStepUpRequired.identifier(),
 		).From(sessionsTable.identifier()).
 			LeftJoin(join(LoginNameUserIDCol, SessionColumnUserID)).
 			LeftJoin(join(HumanUserIDCol, SessionColumnUserID)).
@@ -398,23 +519,29 @@ func prepareSessionQuery() (sq.SelectBuilder, func(*sql.Row) (*Session, string,
 			session := new(Session)
 
 			var (
-				userID              sql.NullString
-				userResourceOwner   sql.NullString
-				userCheckedAt       sql.NullTime
-				loginName           sql.NullString
-				displayName         sql.NullString
-				passwordCheckedAt   sql.NullTime
-				intentCheckedAt     sql.NullTime
-				webAuthNCheckedAt   sql.NullTime
-				webAuthNUserPresent sql.NullBool
-				totpCheckedAt       sql.NullTime
-				otpSMSCheckedAt     sql.NullTime
-				otpEmailCheckedAt   sql.NullTime
-				metadata            database.Map[[]byte]
-				token               sql.NullString
-				userAgentIP         sql.NullString
-				userAgentHeader     database.Map[[]string]
-				expiration          sql.NullTime
+				userID                     sql.NullString
+				userResourceOwner          sql.NullString
+				userCheckedAt              sql.NullTime
+				loginName                  sql.NullString
+				displayName                sql.NullString
+				passwordCheckedAt          sql.NullTime
+				intentCheckedAt            sql.NullTime
+				webAuthNCheckedAt          sql.NullTime
+				webAuthNUserPresent        sql.NullBool
+				totpCheckedAt              sql.NullTime
+				otpSMSCheckedAt            sql.NullTime
+				otpEmailCheckedAt          sql.NullTime
+				trustedDeviceCheckedAt     sql.NullTime
+				recoveryCodeCheckedAt      sql.NullTime
+				clientCertificateCheckedAt sql.NullTime
+				magicLinkCheckedAt         sql.NullTime
+				metadata                   database.Map[[]byte]
+				token                      sql.NullString
+				userAgentIP                sql.NullString
+				userAgentHeader            database.Map[[]string]
+				expiration                 sql.NullTime
+				riskEvaluatedAt            sql.NullTime
+				riskCountry                sql.NullString
 			)
 
 			err := row.Scan(
@@ -437,6 +564,10 @@ func prepareSessionQuery() (sq.SelectBuilder, func(*sql.Row) (*Session, string,
 				&totpCheckedAt,
 				&otpSMSCheckedAt,
 				&otpEmailCheckedAt,
+				&trustedDeviceCheckedAt,
+				&recoveryCodeCheckedAt,
+				&clientCertificateCheckedAt,
+				&magicLinkCheckedAt,
 				&metadata,
 				&token,
 				&session.UserAgent.FingerprintID,
@@ -444,6 +575,13 @@ func prepareSessionQuery() (sq.SelectBuilder, func(*sql.Row) (*Session, string,
 				&session.UserAgent.Description,
 				&userAgentHeader,
 				&expiration,
+				&riskEvaluatedAt,
+				&session.Risk.Level,
+				&session.Risk.NewDevice,
+				&session.Risk.NewCountry,
+				&session.Risk.ImpossibleTravel,
+				&riskCountry,
+				&session.Risk.StepUpRequired,
 			)
 
 			if err != nil {
@@ -465,12 +603,18 @@ func prepareSessionQuery() (sq.SelectBuilder, func(*sql.Row) (*Session, string,
 			session.TOTPFactor.TOTPCheckedAt = totpCheckedAt.Time
 			session.OTPSMSFactor.OTPCheckedAt = otpSMSCheckedAt.Time
 			session.OTPEmailFactor.OTPCheckedAt = otpEmailCheckedAt.Time
+			session.TrustedDeviceFactor.TrustedDeviceCheckedAt = trustedDeviceCheckedAt.Time
+			session.RecoveryCodeFactor.RecoveryCodeCheckedAt = recoveryCodeCheckedAt.Time
+			session.ClientCertificateFactor.ClientCertificateCheckedAt = clientCertificateCheckedAt.Time
+			session.MagicLinkFactor.MagicLinkCheckedAt = magicLinkCheckedAt.Time
 			session.Metadata = metadata
 			session.UserAgent.Header = http.Header(userAgentHeader)
 			if userAgentIP.Valid {
 				session.UserAgent.IP = net.ParseIP(userAgentIP.String)
 			}
 			session.Expiration = expiration.Time
+			session.Risk.EvaluatedAt = riskEvaluatedAt.Time
+			session.Risk.Country = riskCountry.String
 			return session, token.String, nil
 		}
 }
@@ -496,12 +640,23 @@ func prepareSessionsQuery() (sq.SelectBuilder, func(*sql.Rows) (*Sessions, error
 			SessionColumnTOTPCheckedAt.identifier(),
 			SessionColumnOTPSMSCheckedAt.identifier(),
 			SessionColumnOTPEmailCheckedAt.identifier(),
+			SessionColumnTrustedDeviceCheckedAt.identifier(),
+			SessionColumnRecoveryCodeCheckedAt.identifier(),
+			SessionColumnClientCertificateCheckedAt.identifier(),
+			SessionColumnMagicLinkCheckedAt.identifier(),
 			SessionColumnMetadata.identifier(),
 			SessionColumnUserAgentFingerprintID.identifier(),
 			SessionColumnUserAgentIP.identifier(),
 			SessionColumnUserAgentDescription.identifier(),
 			SessionColumnUserAgentHeader.identifier(),
 			SessionColumnExpiration.identifier(),
+			SessionColumnRiskEvaluatedAt.identifier(),
+			SessionColumnRiskLevel.identifier(),
+			SessionColumnRiskNewDevice.identifier(),
+			SessionColumnRiskNewCountry.identifier(),
+			SessionColumnRiskImpossibleTravel.identifier(),
+			SessionColumnRiskCountry.identifier(),
+			SessionColumnStepUpRequired.identifier(),
 			countColumn.identifier(),
 		).From(sessionsTable.identifier()).
 			LeftJoin(join(LoginNameUserIDCol, SessionColumnUserID)).
@@ -514,22 +669,28 @@ func prepareSessionsQuery() (sq.SelectBuilder, func(*sql.Rows) (*Sessions, error
 				session := new(Session)
 
 				var (
-					userID              sql.NullString
-					userResourceOwner   sql.NullString
-					userCheckedAt       sql.NullTime
-					loginName           sql.NullString
-					displayName         sql.NullString
-					passwordCheckedAt   sql.NullTime
-					intentCheckedAt     sql.NullTime
-					webAuthNCheckedAt   sql.NullTime
-					webAuthNUserPresent sql.NullBool
-					totpCheckedAt       sql.NullTime
-					otpSMSCheckedAt     sql.NullTime
-					otpEmailCheckedAt   sql.NullTime
-					metadata            database.Map[[]byte]
-					userAgentIP         sql.NullString
-					userAgentHeader     database.Map[[]string]
-					expiration          sql.NullTime
+					userID                     sql.NullString
+					userResourceOwner          sql.NullString
+					userCheckedAt              sql.NullTime
+					loginName                  sql.NullString
+					displayName                sql.NullString
+					passwordCheckedAt          sql.NullTime
+					intentCheckedAt            sql.NullTime
+					webAuthNCheckedAt          sql.NullTime
+					webAuthNUserPresent        sql.NullBool
+					totpCheckedAt              sql.NullTime
+					otpSMSCheckedAt            sql.NullTime
+					otpEmailCheckedAt          sql.NullTime
+					trustedDeviceCheckedAt     sql.NullTime
+					recoveryCodeCheckedAt      sql.NullTime
+					clientCertificateCheckedAt sql.NullTime
+					magicLinkCheckedAt         sql.NullTime
+					metadata                   database.Map[[]byte]
+					userAgentIP                sql.NullString
+					userAgentHeader            database.Map[[]string]
+					expiration                 sql.NullTime
+					riskEvaluatedAt            sql.NullTime
+					riskCountry                sql.NullString
 				)
 
 				err := rows.Scan(
@@ -552,12 +713,23 @@ func prepareSessionsQuery() (sq.SelectBuilder, func(*sql.Rows) (*Sessions, error
 					&totpCheckedAt,
 					&otpSMSCheckedAt,
 					&otpEmailCheckedAt,
+					&trustedDeviceCheckedAt,
+					&recoveryCodeCheckedAt,
+					&clientCertificateCheckedAt,
+					&magicLinkCheckedAt,
 					&metadata,
 					&session.UserAgent.FingerprintID,
 					&userAgentIP,
 					&session.UserAgent.Description,
 					&userAgentHeader,
 					&expiration,
+					&riskEvaluatedAt,
+					&session.Risk.Level,
+					&session.Risk.NewDevice,
+					&session.Risk.NewCountry,
+					&session.Risk.ImpossibleTravel,
+					&riskCountry,
+					&session.Risk.StepUpRequired,
 					&sessions.Count,
 				)
 
@@ -576,12 +748,18 @@ func prepareSessionsQuery() (sq.SelectBuilder, func(*sql.Rows) (*Sessions, error
 				session.TOTPFactor.TOTPCheckedAt = totpCheckedAt.Time
 				session.OTPSMSFactor.OTPCheckedAt = otpSMSCheckedAt.Time
 				session.OTPEmailFactor.OTPCheckedAt = otpEmailCheckedAt.Time
+				session.TrustedDeviceFactor.TrustedDeviceCheckedAt = trustedDeviceCheckedAt.Time
+				session.RecoveryCodeFactor.RecoveryCodeCheckedAt = recoveryCodeCheckedAt.Time
+				session.ClientCertificateFactor.ClientCertificateCheckedAt = clientCertificateCheckedAt.Time
+				session.MagicLinkFactor.MagicLinkCheckedAt = magicLinkCheckedAt.Time
 				session.Metadata = metadata
 				session.UserAgent.Header = http.Header(userAgentHeader)
 				if userAgentIP.Valid {
 					session.UserAgent.IP = net.ParseIP(userAgentIP.String)
 				}
 				session.Expiration = expiration.Time
+				session.Risk.EvaluatedAt = riskEvaluatedAt.Time
+				session.Risk.Country = riskCountry.String
 
 				sessions.Sessions = append(sessions.Sessions, session)
 			}
@@ -589,3 +767,118 @@ func prepareSessionsQuery() (sq.SelectBuilder, func(*sql.Rows) (*Sessions, error
 			return sessions, nil
 		}
 }
+
+type sessionIndex int
+
+//go:generate enumer -type sessionIndex -linecomment
+const (
+	// Empty line comment ensures empty string for unspecified value
+	sessionIndexUnspecified sessionIndex = iota //
+	sessionIndexByID
+)
+
+// copy returns a deep copy of the session,
+// so callers can't modify the session stored in the cache.
+func (s *Session) copy() *Session {
+	c := *s
+	if s.Metadata != nil {
+		c.Metadata = make(map[string][]byte, len(s.Metadata))
+		for key, value := range s.Metadata {
+			c.Metadata[key] = slices.Clone(value)
+		}
+	}
+	c.UserAgent = copyUserAgent(s.UserAgent)
+	return &c
+}
+
+func copyUserAgent(ua domain.UserAgent) domain.UserAgent {
+	if ua.FingerprintID != nil {
+		fingerprintID := *ua.FingerprintID
+		ua.FingerprintID = &fingerprintID
+	}
+	if ua.Description != nil {
+		description := *ua.Description
+		ua.Description = &description
+	}
+	ua.IP = slices.Clone(ua.IP)
+	ua.Header = ua.Header.Clone()
+	return ua
+}
+
+// cachedSession wraps the session with the instance and the id of the current session token,
+// which is needed to verify the token on subsequent requests.
+type cachedSession struct {
+	InstanceID string   `json:"instance_id,omitempty"`
+	Session    *Session `json:"session,omitempty"`
+	TokenID    string   `json:"token_id,omitempty"`
+}
+
+// Keys implements [cache.Entry]
+func (s *cachedSession) Keys(index sessionIndex) []string {
+	switch index {
+	case sessionIndexByID:
+		return []string{instanceScopedKey(s.InstanceID, s.Session.ID)}
+	case sessionIndexUnspecified:
+	}
+	return nil
+}
+
+func (c *Caches) registerSessionInvalidation(sessionIDsByUserID func(ctx context.Context, instanceID, userID string) ([]string, error)) {
+	projection.SessionProjection.RegisterCacheInvalidation(func(ctx context.Context, aggregates []*eventstore.Aggregate) {
+		keys := make([]string, 0, len(aggregates))
+		for _, aggregate := range aggregates {
+			switch aggregate.Type {
+			case session.AggregateType:
+				keys = append(keys, getInstanceScopedAggregateID(aggregate))
+			case user.AggregateType:
+				// a password change resets the password check of all sessions of the user
+				sessionIDs, err := sessionIDsByUserID(ctx, aggregate.InstanceID, aggregate.ID)
+				if err != nil {
+					logging.WithError(err).Warn("cache invalidation failed")
+					continue
+				}
+				for _, sessionID := range sessionIDs {
+					keys = append(keys, instanceScopedKey(aggregate.InstanceID, sessionID))
+				}
+			default:
+				err := c.session.Truncate(ctx)
+				logging.OnError(err).Warn("cache truncate failed")
+				return
+			}
+		}
+		err := c.session.Invalidate(ctx, sessionIndexByID, keys...)
+		logging.OnError(err).Warn("cache invalidation failed")
+	})
+}
+
+// sessionIDsByUserID returns the ids of all sessions of the user.
+func (q *Queries) sessionIDsByUserID(ctx context.Context, instanceID, userID string) (sessionIDs []string, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	stmt, args, err := sq.Select(SessionColumnID.identifier()).
+		From(sessionsTable.identifier()).
+		Where(sq.Eq{
+			SessionColumnInstanceID.identifier(): instanceID,
+			SessionColumnUserID.identifier():     userID,
+		}).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "QUERY-Sc4ka", "Errors.Query.SQLStatement")
+	}
+	err = q.client.QueryContext(ctx, func(rows *sql.Rows) error {
+		for rows.Next() {
+			var sessionID string
+			if err := rows.Scan(&sessionID); err != nil {
+				return err
+			}
+			sessionIDs = append(sessionIDs, sessionID)
+		}
+		return rows.Err()
+	}, stmt, args...)
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "QUERY-Sc4kb", "Errors.Internal")
+	}
+	return sessionIDs, nil
+}
@@ -21,66 +21,88 @@ import (
 )
 
 var (
-	expectedSessionQuery = regexp.QuoteMeta(`SELECT projections.sessions8.id,` +
-		` projections.sessions8.creation_date,` +
-		` projections.sessions8.change_date,` +
-		` projections.sessions8.sequence,` +
-		` projections.sessions8.state,` +
-		` projections.sessions8.resource_owner,` +
-		` projections.sessions8.creator,` +
-		` projections.sessions8.user_id,` +
-		` projections.sessions8.user_resource_owner,` +
-		` projections.sessions8.user_checked_at,` +
+	expectedSessionQuery = regexp.QuoteMeta(`SELECT projections.sessions13.id,` +
+		` projections.sessions13.creation_date,` +
+		` projections.sessions13.change_date,` +
+		` projections.sessions13.sequence,` +
+		` projections.sessions13.state,` +
+		` projections.sessions13.resource_owner,` +
+		` projections.sessions13.creator,` +
+		` projections.sessions13.user_id,` +
+		` projections.sessions13.user_resource_owner,` +
+		` projections.sessions13.user_checked_at,` +
 		` projections.login_names3.login_name,` +
 		` projections.users14_humans.display_name,` +
-		` projections.sessions8.password_checked_at,` +
-		` projections.sessions8.intent_checked_at,` +
-		` projections.sessions8.webauthn_checked_at,` +
-		` projections.sessions8.webauthn_user_verified,` +
-		` projections.sessions8.totp_checked_at,` +
-		` projections.sessions8.otp_sms_checked_at,` +
-		` projections.sessions8.otp_email_checked_at,` +
-		` projections.sessions8.metadata,` +
-		` projections.sessions8.token_id,` +
-		` projections.sessions8.user_agent_fingerprint_id,` +
-		` projections.sessions8.user_agent_ip,` +
-		` projections.sessions8.user_agent_description,` +
-		` projections.sessions8.user_agent_header,` +
-		` projections.sessions8.expiration` +
-		` FROM projections.sessions8` +
-		` LEFT JOIN projections.login_names3 ON projections.sessions8.user_id = projections.login_names3.user_id AND projections.sessions8.instance_id = projections.login_names3.instance_id` +
-		` LEFT JOIN projections.users14_humans ON projections.sessions8.user_id = projections.users14_humans.user_id AND projections.sessions8.instance_id = projections.users14_humans.instance_id` +
-		` LEFT JOIN projections.users14 ON projections.sessions8.user_id = projections.users14.id AND projections.sessions8.instance_id = projections.users14.instance_id`)
-	expectedSessionsQuery = regexp.QuoteMeta(`SELECT projections.sessions8.id,` +
-		` projections.sessions8.creation_date,` +
-		` projections.sessions8.change_date,` +
-		` projections.sessions8.sequence,` +
-		` projections.sessions8.state,` +
-		` projections.sessions8.resource_owner,` +
-		` projections.sessions8.creator,` +
-		` projections.sessions8.user_id,` +
-		` projections.sessions8.user_resource_owner,` +
-		` projections.sessions8.user_checked_at,` +
+		` projections.sessions13.password_checked_at,` +
+		` projections.sessions13.intent_checked_at,` +
+		` projections.sessions13.webauthn_checked_at,` +
+		` projections.sessions13.webauthn_user_verified,` +
+		` projections.sessions13.totp_checked_at,` +
+		` projections.sessions13.otp_sms_checked_at,` +
+		` projections.sessions13.otp_email_checked_at,` +
+		` projections.sessions13.trusted_device_checked_at,` +
+		` projections.sessions13.recovery_code_checked_at,` +
+		` projections.sessions13.client_certificate_checked_at,` +
+		` projections.sessions13.magic_link_checked_at,` +
+		` projections.sessions13.metadata,` +
+		` projections.sessions13.token_id,` +
+		` projections.sessions13.user_agent_fingerprint_id,` +
+		` projections.sessions13.user_agent_ip,` +
+		` projections.sessions13.user_agent_description,` +
+		` projections.sessions13.user_agent_header,` +
+		` projections.sessions13.expiration,` +
+		` projections.sessions13.risk_evaluated_at,` +
+		` projections.sessions13.risk_level,` +
+		` projections.sessions13.risk_new_device,` +
+		` projections.sessions13.risk_new_country,` +
+		` projections.sessions13.risk_impossible_travel,` +
+		` projections.sessions13.risk_country,` +
+		` projections.sessions13.step_up_required` +
+		` FROM projections.sessions13` +
+		` LEFT JOIN projections.login_names3 ON projections.sessions13.user_id = projections.login_names3.user_id AND projections.sessions13.instance_id = projections.login_names3.instance_id` +
+		` LEFT JOIN projections.users14_humans ON projections.sessions13.user_id = projections.users14_humans.user_id AND projections.sessions13.instance_id = projections.users14_humans.instance_id` +
+		` LEFT JOIN projections.users14 ON projections.sessions13.user_id = projections.users14.id AND projections.sessions13.instance_id = projections.users14.instance_id`)
+	expectedSessionsQuery = regexp.QuoteMeta(`SELECT projections.sessions13.id,` +
+		` projections.sessions13.creation_date,` +
+		` projections.sessions13.change_date,` +
+		` projections.sessions13.sequence,` +
+		` projections.sessions13.state,` +
+		` projections.sessions13.resource_owner,` +
+		` projections.sessions13.creator,` +
+		` projections.sessions13.user_id,` +
+		` projections.sessions13.user_resource_owner,` +
+		` projections.sessions13.user_checked_at,` +
 		` projections.login_names3.login_name,` +
 		` projections.users14_humans.display_name,` +
-		` projections.sessions8.password_checked_at,` +
-		` projections.sessions8.intent_checked_at,` +
-		` projections.sessions8.webauthn_checked_at,` +
-		` projections.sessions8.webauthn_user_verified,` +
-		` projections.sessions8.totp_checked_at,` +
-		` projections.sessions8.otp_sms_checked_at,` +
-		` projections.sessions8.otp_email_checked_at,` +
-		` projections.sessions8.metadata,` +
-		` projections.sessions8.user_agent_fingerprint_id,` +
-		` projections.sessions8.user_agent_ip,` +
-		` projections.sessions8.user_agent_description,` +
-		` projections.sessions8.user_agent_header,` +
-		` projections.sessions8.expiration,` +
+		` projections.sessions13.password_checked_at,` +
+		` projections.sessions13.intent_checked_at,` +
+		` projections.sessions13.webauthn_checked_at,` +
+		` projections.sessions13.webauthn_user_verified,` +
+		` projections.sessions13.totp_checked_at,` +
+		` projections.sessions13.otp_sms_checked_at,` +
+		` projections.sessions13.otp_email_checked_at,` +
+		` projections.sessions13.trusted_device_checked_at,` +
+		` projections.sessions13.recovery_code_checked_at,` +
+		` projections.sessions13.client_certificate_checked_at,` +
+		` projections.sessions13.magic_link_checked_at,` +
+		` projections.sessions13.metadata,` +
+		` projections.sessions13.user_agent_fingerprint_id,` +
+		` projections.sessions13.user_agent_ip,` +
+		` projections.sessions13.user_agent_description,` +
+		` projections.sessions13.user_agent_header,` +
+		` projections.sessions13.expiration,` +
+		` projections.sessions13.risk_evaluated_at,` +
+		` projections.sessions13.risk_level,` +
+		` projections.sessions13.risk_new_device,` +
+		` projections.sessions13.risk_new_country,` +
+		` projections.sessions13.risk_impossible_travel,` +
+		` projections.sessions13.risk_country,` +
+		` projections.sessions13.step_up_required,` +
 		` COUNT(*) OVER ()` +
-		` FROM projections.sessions8` +
-		` LEFT JOIN projections.login_names3 ON projections.sessions8.user_id = projections.login_names3.user_id AND projections.sessions8.instance_id = projections.login_names3.instance_id` +
-		` LEFT JOIN projections.users14_humans ON projections.sessions8.user_id = projections.users14_humans.user_id AND projections.sessions8.instance_id = projections.users14_humans.instance_id` +
-		` LEFT JOIN projections.users14 ON projections.sessions8.user_id = projections.users14.id AND projections.sessions8.instance_id = projections.users14.instance_id`)
+		` FROM projections.sessions13` +
+		` LEFT JOIN projections.login_names3 ON projections.sessions13.user_id = projections.login_names3.user_id AND projections.sessions13.instance_id = projections.login_names3.instance_id` +
+		` LEFT JOIN projections.users14_humans ON projections.sessions13.user_id = projections.users14_humans.user_id AND projections.sessions13.instance_id = projections.users14_humans.instance_id` +
+		` LEFT JOIN projections.users14 ON projections.sessions13.user_id = projections.users14.id AND projections.sessions13.instance_id = projections.users14.instance_id`)
 
 	sessionCols = []string{
 		"id",
@@ -102,6 +124,10 @@ var (
 		"totp_checked_at",
 		"otp_sms_checked_at",
 		"otp_email_checked_at",
+		"trusted_device_checked_at",
+		"recovery_code_checked_at",
+		"client_certificate_checked_at",
+		"magic_link_checked_at",
 		"metadata",
 		"token",
 		"user_agent_fingerprint_id",
@@ -109,6 +135,13 @@ var (
 		"user_agent_description",
 		"user_agent_header",
 		"expiration",
+		"risk_evaluated_at",
+		"risk_level",
+		"risk_new_device",
+		"risk_new_country",
+		"risk_impossible_travel",
+		"risk_country",
+		"step_up_required",
 	}
 
 	sessionsCols = []string{
@@ -131,12 +164,23 @@ var (
 		"totp_checked_at",
 		"otp_sms_checked_at",
 		"otp_email_checked_at",
+		"trusted_device_checked_at",
+		"recovery_code_checked_at",
+		"client_certificate_checked_at",
+		"magic_link_checked_at",
 		"metadata",
 		"user_agent_fingerprint_id",
 		"user_agent_ip",
 		"user_agent_description",
 		"user_agent_header",
 		"expiration",
+		"risk_evaluated_at",
+		"risk_level",
+		"risk_new_device",
+		"risk_new_country",
+		"risk_impossible_travel",
+		"risk_country",
+		"step_up_required",
 		"count",
 	}
 )
@@ -192,12 +236,23 @@ func Test_SessionsPrepare(t *testing.T) {
 							testNow,
 							testNow,
 							testNow,
+							testNow,
+							testNow,
+							testNow,
+							testNow,
 							[]byte(`{"key": "dmFsdWU="}`),
 							"fingerPrintID",
 							"1.2.3.4",
 							"agentDescription",
 							[]byte(`{"foo":["foo","bar"]}`),
 							testNow,
+							testNow,
+							domain.SessionRiskLevelHigh,
+							true,
+							true,
+							false,
+							"CH",
+							true,
 						},
 					},
 				),
@@ -241,6 +296,18 @@ func Test_SessionsPrepare(t *testing.T) {
 						OTPEmailFactor: SessionOTPFactor{
 							OTPCheckedAt: testNow,
 						},
+						TrustedDeviceFactor: SessionTrustedDeviceFactor{
+							TrustedDeviceCheckedAt: testNow,
+						},
+						RecoveryCodeFactor: SessionRecoveryCodeFactor{
+							RecoveryCodeCheckedAt: testNow,
+						},
+						ClientCertificateFactor: SessionClientCertificateFactor{
+							ClientCertificateCheckedAt: testNow,
+						},
+						MagicLinkFactor: SessionMagicLinkFactor{
+							MagicLinkCheckedAt: testNow,
+						},
 						Metadata: map[string][]byte{
 							"key": []byte("value"),
 						},
@@ -251,6 +318,14 @@ func Test_SessionsPrepare(t *testing.T) {
 							Header:        http.Header{"foo": []string{"foo", "bar"}},
 						},
 						Expiration: testNow,
+						Risk: SessionRisk{
+							EvaluatedAt:    testNow,
+							Level:          domain.SessionRiskLevelHigh,
+							NewDevice:      true,
+							NewCountry:     true,
+							Country:        "CH",
+							StepUpRequired: true,
+						},
 					},
 				},
 			},
@@ -283,12 +358,23 @@ func Test_SessionsPrepare(t *testing.T) {
 							testNow,
 							testNow,
 							testNow,
+							testNow,
+							testNow,
+							testNow,
+							testNow,
 							[]byte(`{"key": "dmFsdWU="}`),
 							"fingerPrintID",
 							"1.2.3.4",
 							"agentDescription",
 							[]byte(`{"foo":["foo","bar"]}`),
 							testNow,
+							testNow,
+							domain.SessionRiskLevelHigh,
+							true,
+							true,
+							false,
+							"CH",
+							true,
 						},
 						{
 							"session-id2",
@@ -310,12 +396,23 @@ func Test_SessionsPrepare(t *testing.T) {
 							testNow,
 							testNow,
 							testNow,
+							testNow,
+							testNow,
+							testNow,
+							testNow,
 							[]byte(`{"key": "dmFsdWU="}`),
 							"fingerPrintID",
 							"1.2.3.4",
 							"agentDescription",
 							[]byte(`{"foo":["foo","bar"]}`),
 							testNow,
+							testNow,
+							domain.SessionRiskLevelHigh,
+							true,
+							true,
+							false,
+							"CH",
+							true,
 						},
 					},
 				),
@@ -359,6 +456,18 @@ func Test_SessionsPrepare(t *testing.T) {
 						OTPEmailFactor: SessionOTPFactor{
 							OTPCheckedAt: testNow,
 						},
+						TrustedDeviceFactor: SessionTrustedDeviceFactor{
+							TrustedDeviceCheckedAt: testNow,
+						},
+						RecoveryCodeFactor: SessionRecoveryCodeFactor{
+							RecoveryCodeCheckedAt: testNow,
+						},
+						ClientCertificateFactor: SessionClientCertificateFactor{
+							ClientCertificateCheckedAt: testNow,
+						},
+						MagicLinkFactor: SessionMagicLinkFactor{
+							MagicLinkCheckedAt: testNow,
+						},
 						Metadata: map[string][]byte{
 							"key": []byte("value"),
 						},
@@ -369,6 +478,14 @@ func Test_SessionsPrepare(t *testing.T) {
 							Header:        http.Header{"foo": []string{"foo", "bar"}},
 						},
 						Expiration: testNow,
+						Risk: SessionRisk{
+							EvaluatedAt:    testNow,
+							Level:          domain.SessionRiskLevelHigh,
+							NewDevice:      true,
+							NewCountry:     true,
+							Country:        "CH",
+							StepUpRequired: true,
+						},
 					},
 					{
 						ID:            "session-id2",
@@ -404,6 +521,18 @@ func Test_SessionsPrepare(t *testing.T) {
 						OTPEmailFactor: SessionOTPFactor{
 							OTPCheckedAt: testNow,
 						},
+						TrustedDeviceFactor: SessionTrustedDeviceFactor{
+							TrustedDeviceCheckedAt: testNow,
+						},
+						RecoveryCodeFactor: SessionRecoveryCodeFactor{
+							RecoveryCodeCheckedAt: testNow,
+						},
+						ClientCertificateFactor: SessionClientCertificateFactor{
+							ClientCertificateCheckedAt: testNow,
+						},
+						MagicLinkFactor: SessionMagicLinkFactor{
+							MagicLinkCheckedAt: testNow,
+						},
 						Metadata: map[string][]byte{
 							"key": []byte("value"),
 						},
@@ -414,6 +543,14 @@ func Test_SessionsPrepare(t *testing.T) {
 							Header:        http.Header{"foo": []string{"foo", "bar"}},
 						},
 						Expiration: testNow,
+						Risk: SessionRisk{
+							EvaluatedAt:    testNow,
+							Level:          domain.SessionRiskLevelHigh,
+							NewDevice:      true,
+							NewCountry:     true,
+							Country:        "CH",
+							StepUpRequired: true,
+						},
 					},
 				},
 			},
@@ -499,6 +636,10 @@ func Test_SessionPrepare(t *testing.T) {
 						testNow,
 						testNow,
 						testNow,
+						testNow,
+						testNow,
+						testNow,
+						testNow,
 						[]byte(`{"key": "dmFsdWU="}`),
 						"tokenID",
 						"fingerPrintID",
@@ -506,6 +647,13 @@ func Test_SessionPrepare(t *testing.T) {
 						"agentDescription",
 						[]byte(`{"foo":["foo","bar"]}`),
 						testNow,
+						testNow,
+						domain.SessionRiskLevelHigh,
+						true,
+						true,
+						false,
+						"CH",
+						true,
 					},
 				),
 			},
@@ -543,6 +691,18 @@ func Test_SessionPrepare(t *testing.T) {
 				OTPEmailFactor: SessionOTPFactor{
 					OTPCheckedAt: testNow,
 				},
+				TrustedDeviceFactor: SessionTrustedDeviceFactor{
+					TrustedDeviceCheckedAt: testNow,
+				},
+				RecoveryCodeFactor: SessionRecoveryCodeFactor{
+					RecoveryCodeCheckedAt: testNow,
+				},
+				ClientCertificateFactor: SessionClientCertificateFactor{
+					ClientCertificateCheckedAt: testNow,
+				},
+				MagicLinkFactor: SessionMagicLinkFactor{
+					MagicLinkCheckedAt: testNow,
+				},
 				Metadata: map[string][]byte{
 					"key": []byte("value"),
 				},
@@ -553,6 +713,14 @@ func Test_SessionPrepare(t *testing.T) {
 					Header:        http.Header{"foo": []string{"foo", "bar"}},
 				},
 				Expiration: testNow,
+				Risk: SessionRisk{
+					EvaluatedAt:    testNow,
+					Level:          domain.SessionRiskLevelHigh,
+					NewDevice:      true,
+					NewCountry:     true,
+					Country:        "CH",
+					StepUpRequired: true,
+				},
 			},
 		},
 		{
@@ -744,3 +912,41 @@ func expectedFailedPermissionCheck(resourceOwner, userID string) func(ctx contex
 		return nil
 	}
 }
+
+func TestSession_copy(t *testing.T) {
+	session := &Session{
+		ID:       "id",
+		Metadata: map[string][]byte{"key": []byte("value")},
+		UserAgent: domain.UserAgent{
+			FingerprintID: gu.Ptr("fingerprint"),
+			IP:            net.IPv4(1, 2, 3, 4),
+			Description:   gu.Ptr("description"),
+			Header:        http.Header{"User-Agent": []string{"agent"}},
+		},
+		Risk: SessionRisk{Country: "CH"},
+	}
+	got := session.copy()
+	require.Equal(t, session, got)
+	require.NotSame(t, session, got)
+
+	got.ID = "changed"
+	got.Metadata["key"][0] = 'V'
+	got.Metadata["other"] = []byte("other")
+	*got.UserAgent.FingerprintID = "changed"
+	*got.UserAgent.Description = "changed"
+	got.UserAgent.IP[len(got.UserAgent.IP)-1] = 5
+	got.UserAgent.Header.Set("User-Agent", "changed")
+	got.Risk.Country = "DE"
+
+	require.Equal(t, &Session{
+		ID:       "id",
+		Metadata: map[string][]byte{"key": []byte("value")},
+		UserAgent: domain.UserAgent{
+			FingerprintID: gu.Ptr("fingerprint"),
+			IP:            net.IPv4(1, 2, 3, 4),
+			Description:   gu.Ptr("description"),
+			Header:        http.Header{"User-Agent": []string{"agent"}},
+		},
+		Risk: SessionRisk{Country: "CH"},
+	}, session)
+}
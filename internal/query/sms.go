@@ -10,6 +10,7 @@ import (
 
 	"github.com/zitadel/zitadel/internal/api/authz"
 	"github.com/zitadel/zitadel/internal/crypto"
+	"github.com/zitadel/zitadel/internal/database"
 	"github.com/zitadel/zitadel/internal/domain"
 	"github.com/zitadel/zitadel/internal/query/projection"
 	"github.com/zitadel/zitadel/internal/telemetry/tracing"
@@ -31,8 +32,9 @@ type SMSConfig struct {
 	Sequence      uint64
 	Description   string
 
-	TwilioConfig *Twilio
-	HTTPConfig   *HTTP
+	TwilioConfig       *Twilio
+	HTTPConfig         *HTTP
+	HTTPTemplateConfig *HTTPTemplate
 }
 
 type Twilio struct {
@@ -46,6 +48,16 @@ type HTTP struct {
 	Endpoint string
 }
 
+type HTTPTemplate struct {
+	Method             string
+	URL                string
+	Headers            *crypto.CryptoValue
+	Body               string
+	SenderNumber       string
+	SuccessStatusCodes []int
+	SuccessBodyPattern string
+}
+
 type SMSConfigsSearchQueries struct {
 	SearchRequest
 	Queries []SearchQuery
@@ -144,6 +156,45 @@ var (
 	}
 )
 
+var (
+	smsHTTPTemplateTable = table{
+		name:          projection.SMSHTTPTemplateTable,
+		instanceIDCol: projection.SMSHTTPTemplateColumnInstanceID,
+	}
+	SMSHTTPTemplateColumnSMSID = Column{
+		name:  projection.SMSHTTPTemplateColumnSMSID,
+		table: smsHTTPTemplateTable,
+	}
+	SMSHTTPTemplateColumnMethod = Column{
+		name:  projection.SMSHTTPTemplateColumnMethod,
+		table: smsHTTPTemplateTable,
+	}
+	SMSHTTPTemplateColumnURL = Column{
+		name:  projection.SMSHTTPTemplateColumnURL,
+		table: smsHTTPTemplateTable,
+	}
+	SMSHTTPTemplateColumnHeaders = Column{
+		name:  projection.SMSHTTPTemplateColumnHeaders,
+		table: smsHTTPTemplateTable,
+	}
+	SMSHTTPTemplateColumnBody = Column{
+		name:  projection.SMSHTTPTemplateColumnBody,
+		table: smsHTTPTemplateTable,
+	}
+	SMSHTTPTemplateColumnSenderNumber = Column{
+		name:  projection.SMSHTTPTemplateColumnSenderNumber,
+		table: smsHTTPTemplateTable,
+	}
+	SMSHTTPTemplateColumnSuccessStatusCodes = Column{
+		name:  projection.SMSHTTPTemplateColumnSuccessStatusCodes,
+		table: smsHTTPTemplateTable,
+	}
+	SMSHTTPTemplateColumnSuccessBodyPattern = Column{
+		name:  projection.SMSHTTPTemplateColumnSuccessBodyPattern,
+		table: smsHTTPTemplateTable,
+	}
+)
+
 func (q *Queries) SMSProviderConfigByID(ctx context.Context, id string) (config *SMSConfig, err error) {
 	ctx, span := tracing.NewSpan(ctx)
 	defer func() { span.EndWithError(err) }()
@@ -235,15 +286,26 @@ func prepareSMSConfigQuery() (sq.SelectBuilder, func(*sql.Row) (*SMSConfig, erro
 
 			SMSHTTPColumnSMSID.identifier(),
 			SMSHTTPColumnEndpoint.identifier(),
+
+			SMSHTTPTemplateColumnSMSID.identifier(),
+			SMSHTTPTemplateColumnMethod.identifier(),
+			SMSHTTPTemplateColumnURL.identifier(),
+			SMSHTTPTemplateColumnHeaders.identifier(),
+			SMSHTTPTemplateColumnBody.identifier(),
+			SMSHTTPTemplateColumnSenderNumber.identifier(),
+			SMSHTTPTemplateColumnSuccessStatusCodes.identifier(),
+			SMSHTTPTemplateColumnSuccessBodyPattern.identifier(),
 		).From(smsConfigsTable.identifier()).
 			LeftJoin(join(SMSTwilioColumnSMSID, SMSColumnID)).
 			LeftJoin(join(SMSHTTPColumnSMSID, SMSColumnID)).
+			LeftJoin(join(SMSHTTPTemplateColumnSMSID, SMSColumnID)).
 			PlaceholderFormat(sq.Dollar), func(row *sql.Row) (*SMSConfig, error) {
 			config := new(SMSConfig)
 
 			var (
-				twilioConfig = sqlTwilioConfig{}
-				httpConfig   = sqlHTTPConfig{}
+				twilioConfig       = sqlTwilioConfig{}
+				httpConfig         = sqlHTTPConfig{}
+				httpTemplateConfig = sqlHTTPTemplateConfig{}
 			)
 
 			err := row.Scan(
@@ -264,6 +326,15 @@ func prepareSMSConfigQuery() (sq.SelectBuilder, func(*sql.Row) (*SMSConfig, erro
 
 				&httpConfig.id,
 				&httpConfig.endpoint,
+
+				&httpTemplateConfig.smsID,
+				&httpTemplateConfig.method,
+				&httpTemplateConfig.url,
+				&httpTemplateConfig.headers,
+				&httpTemplateConfig.body,
+				&httpTemplateConfig.senderNumber,
+				&httpTemplateConfig.successStatusCodes,
+				&httpTemplateConfig.successBodyPattern,
 			)
 
 			if err != nil {
@@ -275,6 +346,7 @@ func prepareSMSConfigQuery() (sq.SelectBuilder, func(*sql.Row) (*SMSConfig, erro
 
 			twilioConfig.set(config)
 			httpConfig.setSMS(config)
+			httpTemplateConfig.set(config)
 
 			return config, nil
 		}
@@ -300,18 +372,29 @@ func prepareSMSConfigsQuery() (sq.SelectBuilder, func(*sql.Rows) (*SMSConfigs, e
 			SMSHTTPColumnSMSID.identifier(),
 			SMSHTTPColumnEndpoint.identifier(),
 
+			SMSHTTPTemplateColumnSMSID.identifier(),
+			SMSHTTPTemplateColumnMethod.identifier(),
+			SMSHTTPTemplateColumnURL.identifier(),
+			SMSHTTPTemplateColumnHeaders.identifier(),
+			SMSHTTPTemplateColumnBody.identifier(),
+			SMSHTTPTemplateColumnSenderNumber.identifier(),
+			SMSHTTPTemplateColumnSuccessStatusCodes.identifier(),
+			SMSHTTPTemplateColumnSuccessBodyPattern.identifier(),
+
 			countColumn.identifier(),
 		).From(smsConfigsTable.identifier()).
 			LeftJoin(join(SMSTwilioColumnSMSID, SMSColumnID)).
 			LeftJoin(join(SMSHTTPColumnSMSID, SMSColumnID)).
+			LeftJoin(join(SMSHTTPTemplateColumnSMSID, SMSColumnID)).
 			PlaceholderFormat(sq.Dollar), func(row *sql.Rows) (*SMSConfigs, error) {
 			configs := &SMSConfigs{Configs: []*SMSConfig{}}
 
 			for row.Next() {
 				config := new(SMSConfig)
 				var (
-					twilioConfig = sqlTwilioConfig{}
-					httpConfig   = sqlHTTPConfig{}
+					twilioConfig       = sqlTwilioConfig{}
+					httpConfig         = sqlHTTPConfig{}
+					httpTemplateConfig = sqlHTTPTemplateConfig{}
 				)
 
 				err := row.Scan(
@@ -333,6 +416,15 @@ func prepareSMSConfigsQuery() (sq.SelectBuilder, func(*sql.Rows) (*SMSConfigs, e
 					&httpConfig.id,
 					&httpConfig.endpoint,
 
+					&httpTemplateConfig.smsID,
+					&httpTemplateConfig.method,
+					&httpTemplateConfig.url,
+					&httpTemplateConfig.headers,
+					&httpTemplateConfig.body,
+					&httpTemplateConfig.senderNumber,
+					&httpTemplateConfig.successStatusCodes,
+					&httpTemplateConfig.successBodyPattern,
+
 					&configs.Count,
 				)
 
@@ -342,6 +434,7 @@ func prepareSMSConfigsQuery() (sq.SelectBuilder, func(*sql.Rows) (*SMSConfigs, e
 
 				twilioConfig.set(config)
 				httpConfig.setSMS(config)
+				httpTemplateConfig.set(config)
 
 				configs.Configs = append(configs.Configs, config)
 			}
@@ -383,3 +476,29 @@ func (c sqlHTTPConfig) setSMS(smsConfig *SMSConfig) {
 		Endpoint: c.endpoint.String,
 	}
 }
+
+type sqlHTTPTemplateConfig struct {
+	smsID              sql.NullString
+	method             sql.NullString
+	url                sql.NullString
+	headers            *crypto.CryptoValue
+	body               sql.NullString
+	senderNumber       sql.NullString
+	successStatusCodes database.NumberArray[int]
+	successBodyPattern sql.NullString
+}
+
+func (c sqlHTTPTemplateConfig) set(smsConfig *SMSConfig) {
+	if !c.smsID.Valid {
+		return
+	}
+	smsConfig.HTTPTemplateConfig = &HTTPTemplate{
+		Method:             c.method.String,
+		URL:                c.url.String,
+		Headers:            c.headers,
+		Body:               c.body.String,
+		SenderNumber:       c.senderNumber.String,
+		SuccessStatusCodes: c.successStatusCodes,
+		SuccessBodyPattern: c.successBodyPattern.String,
+	}
+}
@@ -9,56 +9,79 @@ import (
 	"testing"
 
 	"github.com/zitadel/zitadel/internal/crypto"
+	"github.com/zitadel/zitadel/internal/database"
 	"github.com/zitadel/zitadel/internal/domain"
 	"github.com/zitadel/zitadel/internal/zerrors"
 )
 
 var (
-	expectedSMSConfigQuery = regexp.QuoteMeta(`SELECT projections.sms_configs3.id,` +
-		` projections.sms_configs3.aggregate_id,` +
-		` projections.sms_configs3.creation_date,` +
-		` projections.sms_configs3.change_date,` +
-		` projections.sms_configs3.resource_owner,` +
-		` projections.sms_configs3.state,` +
-		` projections.sms_configs3.sequence,` +
-		` projections.sms_configs3.description,` +
+	expectedSMSConfigQuery = regexp.QuoteMeta(`SELECT projections.sms_configs4.id,` +
+		` projections.sms_configs4.aggregate_id,` +
+		` projections.sms_configs4.creation_date,` +
+		` projections.sms_configs4.change_date,` +
+		` projections.sms_configs4.resource_owner,` +
+		` projections.sms_configs4.state,` +
+		` projections.sms_configs4.sequence,` +
+		` projections.sms_configs4.description,` +
 
 		// twilio config
-		` projections.sms_configs3_twilio.sms_id,` +
-		` projections.sms_configs3_twilio.sid,` +
-		` projections.sms_configs3_twilio.token,` +
-		` projections.sms_configs3_twilio.sender_number,` +
-		` projections.sms_configs3_twilio.verify_service_sid,` +
+		` projections.sms_configs4_twilio.sms_id,` +
+		` projections.sms_configs4_twilio.sid,` +
+		` projections.sms_configs4_twilio.token,` +
+		` projections.sms_configs4_twilio.sender_number,` +
+		` projections.sms_configs4_twilio.verify_service_sid,` +
 
 		// http config
-		` projections.sms_configs3_http.sms_id,` +
-		` projections.sms_configs3_http.endpoint` +
-		` FROM projections.sms_configs3` +
-		` LEFT JOIN projections.sms_configs3_twilio ON projections.sms_configs3.id = projections.sms_configs3_twilio.sms_id AND projections.sms_configs3.instance_id = projections.sms_configs3_twilio.instance_id` +
-		` LEFT JOIN projections.sms_configs3_http ON projections.sms_configs3.id = projections.sms_configs3_http.sms_id AND projections.sms_configs3.instance_id = projections.sms_configs3_http.instance_id`)
-	expectedSMSConfigsQuery = regexp.QuoteMeta(`SELECT projections.sms_configs3.id,` +
-		` projections.sms_configs3.aggregate_id,` +
-		` projections.sms_configs3.creation_date,` +
-		` projections.sms_configs3.change_date,` +
-		` projections.sms_configs3.resource_owner,` +
-		` projections.sms_configs3.state,` +
-		` projections.sms_configs3.sequence,` +
-		` projections.sms_configs3.description,` +
+		` projections.sms_configs4_http.sms_id,` +
+		` projections.sms_configs4_http.endpoint,` +
+
+		// http template config
+		` projections.sms_configs4_http_template.sms_id,` +
+		` projections.sms_configs4_http_template.method,` +
+		` projections.sms_configs4_http_template.url,` +
+		` projections.sms_configs4_http_template.headers,` +
+		` projections.sms_configs4_http_template.body,` +
+		` projections.sms_configs4_http_template.sender_number,` +
+		` projections.sms_configs4_http_template.success_status_codes,` +
+		` projections.sms_configs4_http_template.success_body_pattern` +
+		` FROM projections.sms_configs4` +
+		` LEFT JOIN projections.sms_configs4_twilio ON projections.sms_configs4.id = projections.sms_configs4_twilio.sms_id AND projections.sms_configs4.instance_id = projections.sms_configs4_twilio.instance_id` +
+		` LEFT JOIN projections.sms_configs4_http ON projections.sms_configs4.id = projections.sms_configs4_http.sms_id AND projections.sms_configs4.instance_id = projections.sms_configs4_http.instance_id` +
+		` LEFT JOIN projections.sms_configs4_http_template ON projections.sms_configs4.id = projections.sms_configs4_http_template.sms_id AND projections.sms_configs4.instance_id = projections.sms_configs4_http_template.instance_id`)
+	expectedSMSConfigsQuery = regexp.QuoteMeta(`SELECT projections.sms_configs4.id,` +
+		` projections.sms_configs4.aggregate_id,` +
+		` projections.sms_configs4.creation_date,` +
+		` projections.sms_configs4.change_date,` +
+		` projections.sms_configs4.resource_owner,` +
+		` projections.sms_configs4.state,` +
+		` projections.sms_configs4.sequence,` +
+		` projections.sms_configs4.description,` +
 
 		// twilio config
-		` projections.sms_configs3_twilio.sms_id,` +
-		` projections.sms_configs3_twilio.sid,` +
-		` projections.sms_configs3_twilio.token,` +
-		` projections.sms_configs3_twilio.sender_number,` +
-		` projections.sms_configs3_twilio.verify_service_sid,` +
+		` projections.sms_configs4_twilio.sms_id,` +
+		` projections.sms_configs4_twilio.sid,` +
+		` projections.sms_configs4_twilio.token,` +
+		` projections.sms_configs4_twilio.sender_number,` +
+		` projections.sms_configs4_twilio.verify_service_sid,` +
 
 		// http config
-		` projections.sms_configs3_http.sms_id,` +
-		` projections.sms_configs3_http.endpoint,` +
+		` projections.sms_configs4_http.sms_id,` +
+		` projections.sms_configs4_http.endpoint,` +
+
+		// http template config
+		` projections.sms_configs4_http_template.sms_id,` +
+		` projections.sms_configs4_http_template.method,` +
+		` projections.sms_configs4_http_template.url,` +
+		` projections.sms_configs4_http_template.headers,` +
+		` projections.sms_configs4_http_template.body,` +
+		` projections.sms_configs4_http_template.sender_number,` +
+		` projections.sms_configs4_http_template.success_status_codes,` +
+		` projections.sms_configs4_http_template.success_body_pattern,` +
 		` COUNT(*) OVER ()` +
-		` FROM projections.sms_configs3` +
-		` LEFT JOIN projections.sms_configs3_twilio ON projections.sms_configs3.id = projections.sms_configs3_twilio.sms_id AND projections.sms_configs3.instance_id = projections.sms_configs3_twilio.instance_id` +
-		` LEFT JOIN projections.sms_configs3_http ON projections.sms_configs3.id = projections.sms_configs3_http.sms_id AND projections.sms_configs3.instance_id = projections.sms_configs3_http.instance_id`)
+		` FROM projections.sms_configs4` +
+		` LEFT JOIN projections.sms_configs4_twilio ON projections.sms_configs4.id = projections.sms_configs4_twilio.sms_id AND projections.sms_configs4.instance_id = projections.sms_configs4_twilio.instance_id` +
+		` LEFT JOIN projections.sms_configs4_http ON projections.sms_configs4.id = projections.sms_configs4_http.sms_id AND projections.sms_configs4.instance_id = projections.sms_configs4_http.instance_id` +
+		` LEFT JOIN projections.sms_configs4_http_template ON projections.sms_configs4.id = projections.sms_configs4_http_template.sms_id AND projections.sms_configs4.instance_id = projections.sms_configs4_http_template.instance_id`)
 
 	smsConfigCols = []string{
 		"id",
@@ -78,6 +101,15 @@ var (
 		// http config
 		"sms_id",
 		"endpoint",
+		// http template config
+		"sms_id",
+		"method",
+		"url",
+		"headers",
+		"body",
+		"sender_number",
+		"success_status_codes",
+		"success_body_pattern",
 	}
 	smsConfigsCols = append(smsConfigCols, "count")
 )
@@ -131,6 +163,15 @@ func Test_SMSConfigsPrepare(t *testing.T) {
 							// http config
 							nil,
 							nil,
+							// http template config
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
 						},
 					},
 				),
@@ -185,6 +226,15 @@ func Test_SMSConfigsPrepare(t *testing.T) {
 							// http config
 							"sms-id",
 							"endpoint",
+							// http template config
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
 						},
 					},
 				),
@@ -210,6 +260,72 @@ func Test_SMSConfigsPrepare(t *testing.T) {
 				},
 			},
 		},
+		{
+			name:    "prepareSMSQuery http template config",
+			prepare: prepareSMSConfigsQuery,
+			want: want{
+				sqlExpectations: mockQueries(
+					expectedSMSConfigsQuery,
+					smsConfigsCols,
+					[][]driver.Value{
+						{
+							"sms-id",
+							"agg-id",
+							testNow,
+							testNow,
+							"ro",
+							domain.SMSConfigStateInactive,
+							uint64(20211109),
+							"description",
+							// twilio config
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							// http config
+							nil,
+							nil,
+							// http template config
+							"sms-id",
+							"POST",
+							"https://gateway.example.com",
+							&crypto.CryptoValue{},
+							"text={{urlquery .Content}}",
+							"sender-number",
+							database.NumberArray[int]{200},
+							"sent",
+						},
+					},
+				),
+			},
+			object: &SMSConfigs{
+				SearchResponse: SearchResponse{
+					Count: 1,
+				},
+				Configs: []*SMSConfig{
+					{
+						ID:            "sms-id",
+						AggregateID:   "agg-id",
+						CreationDate:  testNow,
+						ChangeDate:    testNow,
+						ResourceOwner: "ro",
+						State:         domain.SMSConfigStateInactive,
+						Sequence:      20211109,
+						Description:   "description",
+						HTTPTemplateConfig: &HTTPTemplate{
+							Method:             "POST",
+							URL:                "https://gateway.example.com",
+							Headers:            &crypto.CryptoValue{},
+							Body:               "text={{urlquery .Content}}",
+							SenderNumber:       "sender-number",
+							SuccessStatusCodes: []int{200},
+							SuccessBodyPattern: "sent",
+						},
+					},
+				},
+			},
+		},
 		{
 			name:    "prepareSMSConfigsQuery multiple result",
 			prepare: prepareSMSConfigsQuery,
@@ -236,6 +352,15 @@ func Test_SMSConfigsPrepare(t *testing.T) {
 							// http config
 							nil,
 							nil,
+							// http template config
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
 						},
 						{
 							"sms-id2",
@@ -255,6 +380,15 @@ func Test_SMSConfigsPrepare(t *testing.T) {
 							// http config
 							nil,
 							nil,
+							// http template config
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
 						},
 						{
 							"sms-id3",
@@ -274,6 +408,15 @@ func Test_SMSConfigsPrepare(t *testing.T) {
 							// http config
 							"sms-id3",
 							"endpoint3",
+							// http template config
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
 						},
 					},
 				),
@@ -410,6 +553,15 @@ func Test_SMSConfigPrepare(t *testing.T) {
 						// http config
 						nil,
 						nil,
+						// http template config
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
 					},
 				),
 			},
@@ -455,6 +607,15 @@ func Test_SMSConfigPrepare(t *testing.T) {
 						// http config
 						"sms-id",
 						"endpoint",
+						// http template config
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
 					},
 				),
 			},
@@ -472,6 +633,61 @@ func Test_SMSConfigPrepare(t *testing.T) {
 				},
 			},
 		},
+		{
+			name:    "prepareSMSConfigQuery, http template, found",
+			prepare: prepareSMSConfigQuery,
+			want: want{
+				sqlExpectations: mockQuery(
+					expectedSMSConfigQuery,
+					smsConfigCols,
+					[]driver.Value{
+						"sms-id",
+						"agg-id",
+						testNow,
+						testNow,
+						"ro",
+						domain.SMSConfigStateInactive,
+						uint64(20211109),
+						"description",
+						// twilio config
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						// http config
+						nil,
+						nil,
+						// http template config
+						"sms-id",
+						"POST",
+						"https://gateway.example.com",
+						nil,
+						"text={{urlquery .Content}}",
+						"sender-number",
+						database.NumberArray[int]{200, 202},
+						"",
+					},
+				),
+			},
+			object: &SMSConfig{
+				ID:            "sms-id",
+				AggregateID:   "agg-id",
+				CreationDate:  testNow,
+				ChangeDate:    testNow,
+				ResourceOwner: "ro",
+				State:         domain.SMSConfigStateInactive,
+				Sequence:      20211109,
+				Description:   "description",
+				HTTPTemplateConfig: &HTTPTemplate{
+					Method:             "POST",
+					URL:                "https://gateway.example.com",
+					Body:               "text={{urlquery .Content}}",
+					SenderNumber:       "sender-number",
+					SuccessStatusCodes: []int{200, 202},
+				},
+			},
+		},
 		{
 			name:    "prepareSMSConfigQuery sql err",
 			prepare: prepareSMSConfigQuery,
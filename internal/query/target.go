@@ -2,19 +2,27 @@ package query
 
 import (
 	"context"
+	"crypto/ed25519"
 	"database/sql"
+	_ "embed"
 	"errors"
 	"time"
 
 	sq "github.com/Masterminds/squirrel"
+	"github.com/go-jose/go-jose/v4"
 
 	"github.com/zitadel/zitadel/internal/api/authz"
 	"github.com/zitadel/zitadel/internal/crypto"
 	"github.com/zitadel/zitadel/internal/domain"
 	"github.com/zitadel/zitadel/internal/query/projection"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
 	"github.com/zitadel/zitadel/internal/zerrors"
+	"github.com/zitadel/zitadel/pkg/actions"
 )
 
+//go:embed target_public_keys.sql
+var targetPublicKeysQuery string
+
 var (
 	targetTable = table{
 		name:          projection.TargetTable,
@@ -48,6 +56,10 @@ var (
 		name:  projection.TargetTargetType,
 		table: targetTable,
 	}
+	TargetColumnTransport = Column{
+		name:  projection.TargetTransportCol,
+		table: targetTable,
+	}
 	TargetColumnURL = Column{
 		name:  projection.TargetEndpointCol,
 		table: targetTable,
@@ -64,6 +76,26 @@ var (
 		name:  projection.TargetSigningKey,
 		table: targetTable,
 	}
+	TargetColumnSigningAlgorithm = Column{
+		name:  projection.TargetSigningAlgorithmCol,
+		table: targetTable,
+	}
+	TargetColumnPublicKey = Column{
+		name:  projection.TargetPublicKeyCol,
+		table: targetTable,
+	}
+	TargetColumnNextSigningKey = Column{
+		name:  projection.TargetNextSigningKeyCol,
+		table: targetTable,
+	}
+	TargetColumnNextPublicKey = Column{
+		name:  projection.TargetNextPublicKeyCol,
+		table: targetTable,
+	}
+	TargetColumnNextSigningKeyCreationDate = Column{
+		name:  projection.TargetNextSigningKeyCreationDateCol,
+		table: targetTable,
+	}
 )
 
 type Targets struct {
@@ -80,25 +112,47 @@ type Target struct {
 
 	Name             string
 	TargetType       domain.TargetType
+	Transport        domain.TargetTransport
 	Endpoint         string
 	Timeout          time.Duration
 	InterruptOnError bool
+	SigningAlgorithm domain.TargetSigningAlgorithm
 	signingKey       *crypto.CryptoValue
-	SigningKey       string
+	// SigningKey is only set for HMAC-SHA256, the private key of Ed25519 is never returned.
+	SigningKey     string
+	nextSigningKey *crypto.CryptoValue
+	// NextSigningKey is only set for HMAC-SHA256, if a next signing key was generated and not yet activated.
+	NextSigningKey string
+	// NextSigningKeyCreationDate is set if a next signing key was generated and not yet activated.
+	NextSigningKeyCreationDate *time.Time
 }
 
-func (t *Target) decryptSigningKey(alg crypto.EncryptionAlgorithm) error {
-	if t.signingKey == nil {
+func (t *Target) decryptSigningKey(alg crypto.EncryptionAlgorithm) (err error) {
+	if t.SigningAlgorithm != domain.TargetSigningAlgorithmHMACSHA256 {
 		return nil
 	}
-	keyValue, err := crypto.DecryptString(t.signingKey, alg)
-	if err != nil {
-		return zerrors.ThrowInternal(err, "QUERY-bxevy3YXwy", "Errors.Internal")
+	if t.signingKey != nil {
+		t.SigningKey, err = crypto.DecryptString(t.signingKey, alg)
+		if err != nil {
+			return zerrors.ThrowInternal(err, "QUERY-bxevy3YXwy", "Errors.Internal")
+		}
+	}
+	if t.nextSigningKey != nil {
+		t.NextSigningKey, err = crypto.DecryptString(t.nextSigningKey, alg)
+		if err != nil {
+			return zerrors.ThrowInternal(err, "QUERY-Sgn8q", "Errors.Internal")
+		}
 	}
-	t.SigningKey = keyValue
 	return nil
 }
 
+func (t *Target) setNextSigningKey(signingKey *crypto.CryptoValue, creationDate sql.NullTime) {
+	if creationDate.Valid {
+		t.nextSigningKey = signingKey
+		t.NextSigningKeyCreationDate = &creationDate.Time
+	}
+}
+
 type TargetSearchQueries struct {
 	SearchRequest
 	Queries []SearchQuery
@@ -145,6 +199,42 @@ func (q *Queries) GetTargetByID(ctx context.Context, id string) (*Target, error)
 	return target, nil
 }
 
+// GetTargetKeySet returns the public keys of all targets of the instance signing with Ed25519 as JSON Web Key Set.
+// Next keys are included as well, so receivers can install them before they are activated.
+// The set is eventual consistent.
+func (q *Queries) GetTargetKeySet(ctx context.Context) (_ *jose.JSONWebKeySet, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	keys := make([]jose.JSONWebKey, 0)
+	err = q.client.QueryContext(ctx, func(rows *sql.Rows) error {
+		for rows.Next() {
+			var publicKey []byte
+			if err = rows.Scan(&publicKey); err != nil {
+				return err
+			}
+			if len(publicKey) != ed25519.PublicKeySize {
+				return zerrors.ThrowInternal(nil, "QUERY-Sgn6q", "Errors.Internal")
+			}
+			keys = append(keys, jose.JSONWebKey{
+				Key:       ed25519.PublicKey(publicKey),
+				KeyID:     actions.JWSKeyID(publicKey),
+				Algorithm: string(jose.EdDSA),
+				Use:       crypto.KeyUsageSigning.String(),
+			})
+		}
+		return rows.Err()
+	},
+		targetPublicKeysQuery,
+		authz.GetInstance(ctx).InstanceID(),
+		domain.TargetSigningAlgorithmEd25519,
+	)
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "QUERY-Sgn7q", "Errors.Internal")
+	}
+	return &jose.JSONWebKeySet{Keys: keys}, nil
+}
+
 func NewTargetNameSearchQuery(method TextComparison, value string) (SearchQuery, error) {
 	return NewTextQuery(TargetColumnName, value, method)
 }
@@ -161,10 +251,14 @@ func prepareTargetsQuery() (sq.SelectBuilder, func(rows *sql.Rows) (*Targets, er
 			TargetColumnResourceOwner.identifier(),
 			TargetColumnName.identifier(),
 			TargetColumnTargetType.identifier(),
+			TargetColumnTransport.identifier(),
 			TargetColumnTimeout.identifier(),
 			TargetColumnURL.identifier(),
 			TargetColumnInterruptOnError.identifier(),
 			TargetColumnSigningKey.identifier(),
+			TargetColumnSigningAlgorithm.identifier(),
+			TargetColumnNextSigningKey.identifier(),
+			TargetColumnNextSigningKeyCreationDate.identifier(),
 			countColumn.identifier(),
 		).From(targetTable.identifier()).
 			PlaceholderFormat(sq.Dollar),
@@ -173,6 +267,10 @@ func prepareTargetsQuery() (sq.SelectBuilder, func(rows *sql.Rows) (*Targets, er
 			var count uint64
 			for rows.Next() {
 				target := new(Target)
+				var (
+					nextSigningKey          *crypto.CryptoValue
+					nextSigningKeyCreatedAt sql.NullTime
+				)
 				err := rows.Scan(
 					&target.ID,
 					&target.CreationDate,
@@ -180,15 +278,20 @@ func prepareTargetsQuery() (sq.SelectBuilder, func(rows *sql.Rows) (*Targets, er
 					&target.ResourceOwner,
 					&target.Name,
 					&target.TargetType,
+					&target.Transport,
 					&target.Timeout,
 					&target.Endpoint,
 					&target.InterruptOnError,
 					&target.signingKey,
+					&target.SigningAlgorithm,
+					&nextSigningKey,
+					&nextSigningKeyCreatedAt,
 					&count,
 				)
 				if err != nil {
 					return nil, err
 				}
+				target.setNextSigningKey(nextSigningKey, nextSigningKeyCreatedAt)
 				targets = append(targets, target)
 			}
 
@@ -213,14 +316,22 @@ func prepareTargetQuery() (sq.SelectBuilder, func(row *sql.Row) (*Target, error)
 			TargetColumnResourceOwner.identifier(),
 			TargetColumnName.identifier(),
 			TargetColumnTargetType.identifier(),
+			TargetColumnTransport.identifier(),
 			TargetColumnTimeout.identifier(),
 			TargetColumnURL.identifier(),
 			TargetColumnInterruptOnError.identifier(),
 			TargetColumnSigningKey.identifier(),
+			TargetColumnSigningAlgorithm.identifier(),
+			TargetColumnNextSigningKey.identifier(),
+			TargetColumnNextSigningKeyCreationDate.identifier(),
 		).From(targetTable.identifier()).
 			PlaceholderFormat(sq.Dollar),
 		func(row *sql.Row) (*Target, error) {
 			target := new(Target)
+			var (
+				nextSigningKey          *crypto.CryptoValue
+				nextSigningKeyCreatedAt sql.NullTime
+			)
 			err := row.Scan(
 				&target.ID,
 				&target.CreationDate,
@@ -228,10 +339,14 @@ func prepareTargetQuery() (sq.SelectBuilder, func(row *sql.Row) (*Target, error)
 				&target.ResourceOwner,
 				&target.Name,
 				&target.TargetType,
+				&target.Transport,
 				&target.Timeout,
 				&target.Endpoint,
 				&target.InterruptOnError,
 				&target.signingKey,
+				&target.SigningAlgorithm,
+				&nextSigningKey,
+				&nextSigningKeyCreatedAt,
 			)
 			if err != nil {
 				if errors.Is(err, sql.ErrNoRows) {
@@ -239,6 +354,7 @@ func prepareTargetQuery() (sq.SelectBuilder, func(row *sql.Row) (*Target, error)
 				}
 				return nil, zerrors.ThrowInternal(err, "QUERY-5qhc19sc49", "Errors.Internal")
 			}
+			target.setNextSigningKey(nextSigningKey, nextSigningKeyCreatedAt)
 			return target, nil
 		}
 }
@@ -0,0 +1,11 @@
+SELECT public_key
+FROM projections.targets3
+WHERE instance_id = $1
+  AND signing_algorithm = $2
+  AND public_key IS NOT NULL
+UNION ALL
+SELECT next_public_key
+FROM projections.targets3
+WHERE instance_id = $1
+  AND signing_algorithm = $2
+  AND next_public_key IS NOT NULL;
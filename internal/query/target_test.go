@@ -1,6 +1,8 @@
 package query
 
 import (
+	"crypto/ed25519"
+	"crypto/rand"
 	"database/sql"
 	"database/sql/driver"
 	"errors"
@@ -9,24 +11,36 @@ import (
 	"testing"
 	"time"
 
+	"github.com/go-jose/go-jose/v4"
+	"github.com/muhlemmer/gu"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
 	"github.com/zitadel/zitadel/internal/crypto"
+	"github.com/zitadel/zitadel/internal/database"
 	"github.com/zitadel/zitadel/internal/domain"
 	"github.com/zitadel/zitadel/internal/zerrors"
+	"github.com/zitadel/zitadel/pkg/actions"
 )
 
 var (
-	prepareTargetsStmt = `SELECT projections.targets2.id,` +
-		` projections.targets2.creation_date,` +
-		` projections.targets2.change_date,` +
-		` projections.targets2.resource_owner,` +
-		` projections.targets2.name,` +
-		` projections.targets2.target_type,` +
-		` projections.targets2.timeout,` +
-		` projections.targets2.endpoint,` +
-		` projections.targets2.interrupt_on_error,` +
-		` projections.targets2.signing_key,` +
+	prepareTargetsStmt = `SELECT projections.targets3.id,` +
+		` projections.targets3.creation_date,` +
+		` projections.targets3.change_date,` +
+		` projections.targets3.resource_owner,` +
+		` projections.targets3.name,` +
+		` projections.targets3.target_type,` +
+		` projections.targets3.transport,` +
+		` projections.targets3.timeout,` +
+		` projections.targets3.endpoint,` +
+		` projections.targets3.interrupt_on_error,` +
+		` projections.targets3.signing_key,` +
+		` projections.targets3.signing_algorithm,` +
+		` projections.targets3.next_signing_key,` +
+		` projections.targets3.next_signing_key_creation_date,` +
 		` COUNT(*) OVER ()` +
-		` FROM projections.targets2`
+		` FROM projections.targets3`
 	prepareTargetsCols = []string{
 		"id",
 		"creation_date",
@@ -34,24 +48,32 @@ var (
 		"resource_owner",
 		"name",
 		"target_type",
+		"transport",
 		"timeout",
 		"endpoint",
 		"interrupt_on_error",
 		"signing_key",
+		"signing_algorithm",
+		"next_signing_key",
+		"next_signing_key_creation_date",
 		"count",
 	}
 
-	prepareTargetStmt = `SELECT projections.targets2.id,` +
-		` projections.targets2.creation_date,` +
-		` projections.targets2.change_date,` +
-		` projections.targets2.resource_owner,` +
-		` projections.targets2.name,` +
-		` projections.targets2.target_type,` +
-		` projections.targets2.timeout,` +
-		` projections.targets2.endpoint,` +
-		` projections.targets2.interrupt_on_error,` +
-		` projections.targets2.signing_key` +
-		` FROM projections.targets2`
+	prepareTargetStmt = `SELECT projections.targets3.id,` +
+		` projections.targets3.creation_date,` +
+		` projections.targets3.change_date,` +
+		` projections.targets3.resource_owner,` +
+		` projections.targets3.name,` +
+		` projections.targets3.target_type,` +
+		` projections.targets3.transport,` +
+		` projections.targets3.timeout,` +
+		` projections.targets3.endpoint,` +
+		` projections.targets3.interrupt_on_error,` +
+		` projections.targets3.signing_key,` +
+		` projections.targets3.signing_algorithm,` +
+		` projections.targets3.next_signing_key,` +
+		` projections.targets3.next_signing_key_creation_date` +
+		` FROM projections.targets3`
 	prepareTargetCols = []string{
 		"id",
 		"creation_date",
@@ -59,10 +81,14 @@ var (
 		"resource_owner",
 		"name",
 		"target_type",
+		"transport",
 		"timeout",
 		"endpoint",
 		"interrupt_on_error",
 		"signing_key",
+		"signing_algorithm",
+		"next_signing_key",
+		"next_signing_key_creation_date",
 	}
 )
 
@@ -104,6 +130,7 @@ func Test_TargetPrepares(t *testing.T) {
 							"ro",
 							"target-name",
 							domain.TargetTypeWebhook,
+							domain.TargetTransportHTTP,
 							1 * time.Second,
 							"https://example.com",
 							true,
@@ -113,6 +140,9 @@ func Test_TargetPrepares(t *testing.T) {
 								KeyID:      "encKey",
 								Crypted:    []byte("crypted"),
 							},
+							domain.TargetSigningAlgorithmHMACSHA256,
+							nil,
+							nil,
 						},
 					},
 				),
@@ -159,6 +189,7 @@ func Test_TargetPrepares(t *testing.T) {
 							"ro",
 							"target-name1",
 							domain.TargetTypeWebhook,
+							domain.TargetTransportHTTP,
 							1 * time.Second,
 							"https://example.com",
 							true,
@@ -168,6 +199,9 @@ func Test_TargetPrepares(t *testing.T) {
 								KeyID:      "encKey",
 								Crypted:    []byte("crypted"),
 							},
+							domain.TargetSigningAlgorithmHMACSHA256,
+							nil,
+							nil,
 						},
 						{
 							"id-2",
@@ -176,6 +210,7 @@ func Test_TargetPrepares(t *testing.T) {
 							"ro",
 							"target-name2",
 							domain.TargetTypeWebhook,
+							domain.TargetTransportHTTP,
 							1 * time.Second,
 							"https://example.com",
 							false,
@@ -185,6 +220,9 @@ func Test_TargetPrepares(t *testing.T) {
 								KeyID:      "encKey",
 								Crypted:    []byte("crypted"),
 							},
+							domain.TargetSigningAlgorithmHMACSHA256,
+							nil,
+							nil,
 						},
 						{
 							"id-3",
@@ -193,6 +231,7 @@ func Test_TargetPrepares(t *testing.T) {
 							"ro",
 							"target-name3",
 							domain.TargetTypeAsync,
+							domain.TargetTransportHTTP,
 							1 * time.Second,
 							"https://example.com",
 							false,
@@ -202,6 +241,9 @@ func Test_TargetPrepares(t *testing.T) {
 								KeyID:      "encKey",
 								Crypted:    []byte("crypted"),
 							},
+							domain.TargetSigningAlgorithmHMACSHA256,
+							nil,
+							nil,
 						},
 					},
 				),
@@ -320,6 +362,7 @@ func Test_TargetPrepares(t *testing.T) {
 						"ro",
 						"target-name",
 						domain.TargetTypeWebhook,
+						domain.TargetTransportHTTP,
 						1 * time.Second,
 						"https://example.com",
 						true,
@@ -329,6 +372,9 @@ func Test_TargetPrepares(t *testing.T) {
 							KeyID:      "encKey",
 							Crypted:    []byte("crypted"),
 						},
+						domain.TargetSigningAlgorithmHMACSHA256,
+						nil,
+						nil,
 					},
 				),
 			},
@@ -352,6 +398,69 @@ func Test_TargetPrepares(t *testing.T) {
 				},
 			},
 		},
+		{
+			name:    "prepareTargetQuery ed25519 with next key found",
+			prepare: prepareTargetQuery,
+			want: want{
+				sqlExpectations: mockQuery(
+					regexp.QuoteMeta(prepareTargetStmt),
+					prepareTargetCols,
+					[]driver.Value{
+						"id",
+						testNow,
+						testNow,
+						"ro",
+						"target-name",
+						domain.TargetTypeWebhook,
+						domain.TargetTransportHTTP,
+						1 * time.Second,
+						"https://example.com",
+						true,
+						&crypto.CryptoValue{
+							CryptoType: crypto.TypeEncryption,
+							Algorithm:  "alg",
+							KeyID:      "encKey",
+							Crypted:    []byte("crypted"),
+						},
+						domain.TargetSigningAlgorithmEd25519,
+						&crypto.CryptoValue{
+							CryptoType: crypto.TypeEncryption,
+							Algorithm:  "alg",
+							KeyID:      "encKey",
+							Crypted:    []byte("next"),
+						},
+						testNow,
+					},
+				),
+			},
+			object: &Target{
+				ObjectDetails: domain.ObjectDetails{
+					ID:            "id",
+					EventDate:     testNow,
+					CreationDate:  testNow,
+					ResourceOwner: "ro",
+				},
+				Name:             "target-name",
+				TargetType:       domain.TargetTypeWebhook,
+				Timeout:          1 * time.Second,
+				Endpoint:         "https://example.com",
+				InterruptOnError: true,
+				signingKey: &crypto.CryptoValue{
+					CryptoType: crypto.TypeEncryption,
+					Algorithm:  "alg",
+					KeyID:      "encKey",
+					Crypted:    []byte("crypted"),
+				},
+				SigningAlgorithm: domain.TargetSigningAlgorithmEd25519,
+				nextSigningKey: &crypto.CryptoValue{
+					CryptoType: crypto.TypeEncryption,
+					Algorithm:  "alg",
+					KeyID:      "encKey",
+					Crypted:    []byte("next"),
+				},
+				NextSigningKeyCreationDate: gu.Ptr(testNow),
+			},
+		},
 		{
 			name:    "prepareTargetQuery sql err",
 			prepare: prepareTargetQuery,
@@ -376,3 +485,64 @@ func Test_TargetPrepares(t *testing.T) {
 		})
 	}
 }
+
+func TestQueries_GetTargetKeySet(t *testing.T) {
+	ctx := authz.NewMockContextWithPermissions("instance1", "org1", "user1", nil)
+	expQuery := regexp.QuoteMeta(targetPublicKeysQuery)
+	queryArgs := []driver.Value{"instance1", domain.TargetSigningAlgorithmEd25519}
+	cols := []string{"public_key"}
+
+	publicKey, _, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name    string
+		mock    sqlExpectation
+		want    *jose.JSONWebKeySet
+		wantErr error
+	}{
+		{
+			name:    "internal error",
+			mock:    mockQueryErr(expQuery, sql.ErrConnDone, queryArgs...),
+			wantErr: zerrors.ThrowInternal(sql.ErrConnDone, "QUERY-Sgn7q", "Errors.Internal"),
+		},
+		{
+			name:    "invalid key error",
+			mock:    mockQueriesScanErr(expQuery, cols, [][]driver.Value{{[]byte("invalid")}}, queryArgs...),
+			wantErr: zerrors.ThrowInternal(nil, "QUERY-Sgn7q", "Errors.Internal"),
+		},
+		{
+			name: "no keys",
+			mock: mockQueries(expQuery, cols, [][]driver.Value{}, queryArgs...),
+			want: &jose.JSONWebKeySet{Keys: []jose.JSONWebKey{}},
+		},
+		{
+			name: "ok",
+			mock: mockQueries(expQuery, cols, [][]driver.Value{{[]byte(publicKey)}}, queryArgs...),
+			want: &jose.JSONWebKeySet{
+				Keys: []jose.JSONWebKey{
+					{
+						Key:       publicKey,
+						KeyID:     actions.JWSKeyID(publicKey),
+						Algorithm: "EdDSA",
+						Use:       "sig",
+					},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			execMock(t, tt.mock, func(db *sql.DB) {
+				q := &Queries{
+					client: &database.DB{
+						DB: db,
+					},
+				}
+				got, err := q.GetTargetKeySet(ctx)
+				require.ErrorIs(t, err, tt.wantErr)
+				assert.Equal(t, tt.want, got)
+			})
+		})
+	}
+}
@@ -10,12 +10,16 @@ import (
 	"time"
 
 	sq "github.com/Masterminds/squirrel"
+	"github.com/zitadel/logging"
 	"golang.org/x/text/language"
 
 	"github.com/zitadel/zitadel/internal/api/authz"
 	"github.com/zitadel/zitadel/internal/database"
 	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
 	"github.com/zitadel/zitadel/internal/query/projection"
+	"github.com/zitadel/zitadel/internal/repository/org"
+	"github.com/zitadel/zitadel/internal/repository/user"
 	"github.com/zitadel/zitadel/internal/telemetry/tracing"
 	"github.com/zitadel/zitadel/internal/zerrors"
 )
@@ -392,6 +396,14 @@ func (q *Queries) GetUserByIDWithResourceOwner(ctx context.Context, shouldTrigge
 		triggerUserProjections(ctx)
 	}
 
+	instanceID := authz.GetInstance(ctx).InstanceID()
+	if cached, ok := q.caches.user.Get(ctx, userIndexByID, instanceScopedKey(instanceID, userID)); ok {
+		if resourceOwner != "" && cached.User.ResourceOwner != resourceOwner {
+			return nil, zerrors.ThrowNotFound(nil, "QUERY-Dc4ka", "Errors.User.NotFound")
+		}
+		return cached.User.copy(), nil
+	}
+
 	err = q.client.QueryRowContext(ctx,
 		func(row *sql.Row) error {
 			user, err = scanUser(row)
@@ -400,8 +412,11 @@ func (q *Queries) GetUserByIDWithResourceOwner(ctx context.Context, shouldTrigge
 		userByIDQuery,
 		userID,
 		resourceOwner,
-		authz.GetInstance(ctx).InstanceID(),
+		instanceID,
 	)
+	if err == nil {
+		q.caches.user.Set(ctx, &cachedUser{InstanceID: instanceID, User: user.copy()})
+	}
 	return user, err
 }
 
@@ -1516,3 +1531,119 @@ func prepareUsersQuery() (sq.SelectBuilder, func(*sql.Rows) (*Users, error)) {
 			}, nil
 		}
 }
+
+type userIndex int
+
+//go:generate enumer -type userIndex -linecomment
+const (
+	// Empty line comment ensures empty string for unspecified value
+	userIndexUnspecified userIndex = iota //
+	userIndexByID
+)
+
+// copy returns a deep copy of the user,
+// so callers can't modify the user stored in the cache.
+func (u *User) copy() *User {
+	c := *u
+	c.LoginNames = slices.Clone(u.LoginNames)
+	if u.Human != nil {
+		human := *u.Human
+		c.Human = &human
+	}
+	if u.Machine != nil {
+		machine := *u.Machine
+		c.Machine = &machine
+	}
+	return &c
+}
+
+// cachedUser wraps the user with the instance,
+// as the id of a user is only unique inside an instance.
+type cachedUser struct {
+	InstanceID string `json:"instance_id,omitempty"`
+	User       *User  `json:"user,omitempty"`
+}
+
+// Keys implements [cache.Entry]
+func (u *cachedUser) Keys(index userIndex) []string {
+	switch index {
+	case userIndexByID:
+		return []string{instanceScopedKey(u.InstanceID, u.User.ID)}
+	case userIndexUnspecified:
+	}
+	return nil
+}
+
+func (c *Caches) registerUserInvalidation(userIDsByResourceOwner func(ctx context.Context, instanceID, resourceOwner string) ([]string, error)) {
+	invalidate := cacheInvalidationFunc(c.user, userIndexByID, getInstanceScopedAggregateID)
+	projection.UserProjection.RegisterCacheInvalidation(func(ctx context.Context, aggregates []*eventstore.Aggregate) {
+		// removal of an organization or instance removes all their users
+		if slices.ContainsFunc(aggregates, func(aggregate *eventstore.Aggregate) bool {
+			return aggregate.Type != user.AggregateType
+		}) {
+			err := c.user.Truncate(ctx)
+			logging.OnError(err).Warn("cache truncate failed")
+			return
+		}
+		invalidate(ctx, aggregates)
+	})
+
+	// The login names of the users depend on the domains and the domain policy of their organization and the instance.
+	projection.LoginNameProjection.RegisterCacheInvalidation(func(ctx context.Context, aggregates []*eventstore.Aggregate) {
+		keys := make([]string, 0, len(aggregates))
+		for _, aggregate := range aggregates {
+			switch aggregate.Type {
+			case user.AggregateType:
+				keys = append(keys, getInstanceScopedAggregateID(aggregate))
+			case org.AggregateType:
+				userIDs, err := userIDsByResourceOwner(ctx, aggregate.InstanceID, aggregate.ID)
+				if err != nil {
+					logging.WithError(err).Warn("cache invalidation failed")
+					continue
+				}
+				for _, userID := range userIDs {
+					keys = append(keys, instanceScopedKey(aggregate.InstanceID, userID))
+				}
+			default:
+				// changes of the instance affect all users, which are not worth to be resolved
+				err := c.user.Truncate(ctx)
+				logging.OnError(err).Warn("cache truncate failed")
+				return
+			}
+		}
+		err := c.user.Invalidate(ctx, userIndexByID, keys...)
+		logging.OnError(err).Warn("cache invalidation failed")
+	})
+}
+
+// userIDsByResourceOwner returns the ids of all users of the organization.
+func (q *Queries) userIDsByResourceOwner(ctx context.Context, instanceID, resourceOwner string) (userIDs []string, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	stmt, args, err := sq.Select(UserIDCol.identifier()).
+		From(userTable.identifier()).
+		Where(sq.Eq{
+			UserInstanceIDCol.identifier():    instanceID,
+			UserResourceOwnerCol.identifier(): resourceOwner,
+		}).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "QUERY-Tu9ka", "Errors.Query.SQLStatement")
+	}
+	err = q.client.QueryContext(ctx, func(rows *sql.Rows) error {
+		for rows.Next() {
+			var userID string
+			if err := rows.Scan(&userID); err != nil {
+				return err
+			}
+			userIDs = append(userIDs, userID)
+		}
+		return rows.Err()
+	}, stmt, args...)
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "QUERY-Tu9kb", "Errors.Internal")
+	}
+	return userIDs, nil
+}
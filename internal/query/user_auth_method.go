@@ -67,6 +67,14 @@ var (
 		name:  projection.UserAuthMethodDomainCol,
 		table: userAuthMethodTable,
 	}
+	UserAuthMethodColumnRemainingCodes = Column{
+		name:  projection.UserAuthMethodRemainingCodesCol,
+		table: userAuthMethodTable,
+	}
+	UserAuthMethodColumnAuthenticatorModel = Column{
+		name:  projection.UserAuthMethodAuthenticatorModelCol,
+		table: userAuthMethodTable,
+	}
 
 	authMethodTypeTable      = userAuthMethodTable.setAlias("auth_method_types")
 	authMethodTypeUserID     = UserAuthMethodColumnUserID.setTable(authMethodTypeTable)
@@ -74,6 +82,7 @@ var (
 	authMethodTypeType       = UserAuthMethodColumnMethodType.setTable(authMethodTypeTable)
 	authMethodTypeState      = UserAuthMethodColumnState.setTable(authMethodTypeTable)
 	authMethodTypeDomain     = UserAuthMethodColumnDomain.setTable(authMethodTypeTable)
+	authMethodTypeRemaining  = UserAuthMethodColumnRemainingCodes.setTable(authMethodTypeTable)
 
 	userIDPsCountTable      = idpUserLinkTable.setAlias("user_idps_count")
 	userIDPsCountUserID     = IDPUserLinkUserIDCol.setTable(userIDPsCountTable)
@@ -128,11 +137,18 @@ type AuthMethod struct {
 	TokenID string
 	Name    string
 	Type    domain.UserAuthMethodType
+	// RemainingCodes is the number of unused codes of [domain.UserAuthMethodTypeRecoveryCode]
+	RemainingCodes uint64
+	// AuthenticatorModel is the description of a passkey or U2F authenticator from the FIDO metadata, if known
+	AuthenticatorModel string
 }
 
 type AuthMethodTypes struct {
 	SearchResponse
 	AuthMethodTypes []domain.UserAuthMethodType
+	// RecoveryCodesRemaining is the number of unused recovery codes,
+	// if [domain.UserAuthMethodTypeRecoveryCode] is part of the AuthMethodTypes
+	RecoveryCodesRemaining uint64
 }
 
 type UserAuthMethodSearchQueries struct {
@@ -391,6 +407,8 @@ func prepareUserAuthMethodsQuery() (sq.SelectBuilder, func(*sql.Rows) (*AuthMeth
 			UserAuthMethodColumnName.identifier(),
 			UserAuthMethodColumnState.identifier(),
 			UserAuthMethodColumnMethodType.identifier(),
+			UserAuthMethodColumnRemainingCodes.identifier(),
+			UserAuthMethodColumnAuthenticatorModel.identifier(),
 			countColumn.identifier()).
 			From(userAuthMethodTable.identifier()).
 			PlaceholderFormat(sq.Dollar),
@@ -409,6 +427,8 @@ func prepareUserAuthMethodsQuery() (sq.SelectBuilder, func(*sql.Rows) (*AuthMeth
 					&authMethod.Name,
 					&authMethod.State,
 					&authMethod.Type,
+					&authMethod.RemainingCodes,
+					&authMethod.AuthenticatorModel,
 					&count,
 				)
 				if err != nil {
@@ -442,6 +462,7 @@ func prepareUserAuthMethodTypesQuery(activeOnly bool, includeWithoutDomain bool,
 	return sq.Select(
 			NotifyPasswordSetCol.identifier(),
 			authMethodTypeType.identifier(),
+			authMethodTypeRemaining.identifier(),
 			userIDPsCountCount.identifier()).
 			From(userTable.identifier()).
 			LeftJoin(join(NotifyUserIDCol, UserIDCol)).
@@ -457,11 +478,14 @@ func prepareUserAuthMethodTypesQuery(activeOnly bool, includeWithoutDomain bool,
 			userAuthMethodTypes := make([]domain.UserAuthMethodType, 0)
 			var passwordSet sql.NullBool
 			var idp sql.NullInt64
+			var recoveryCodesRemaining uint64
 			for rows.Next() {
 				var authMethodType sql.NullInt16
+				var remainingCodes sql.NullInt64
 				err := rows.Scan(
 					&passwordSet,
 					&authMethodType,
+					&remainingCodes,
 					&idp,
 				)
 				if err != nil {
@@ -469,6 +493,9 @@ func prepareUserAuthMethodTypesQuery(activeOnly bool, includeWithoutDomain bool,
 				}
 				if authMethodType.Valid {
 					userAuthMethodTypes = append(userAuthMethodTypes, domain.UserAuthMethodType(authMethodType.Int16))
+					if domain.UserAuthMethodType(authMethodType.Int16) == domain.UserAuthMethodTypeRecoveryCode {
+						recoveryCodesRemaining = uint64(remainingCodes.Int64)
+					}
 				}
 			}
 			if passwordSet.Valid && passwordSet.Bool {
@@ -488,6 +515,7 @@ func prepareUserAuthMethodTypesQuery(activeOnly bool, includeWithoutDomain bool,
 				SearchResponse: SearchResponse{
 					Count: uint64(len(userAuthMethodTypes)),
 				},
+				RecoveryCodesRemaining: recoveryCodesRemaining,
 			}, nil
 		}
 }
@@ -510,7 +538,8 @@ func prepareAuthMethodQuery(activeOnly bool, includeWithoutDomain bool, queryDom
 	q := sq.Select(
 		"DISTINCT("+authMethodTypeType.identifier()+")",
 		authMethodTypeUserID.identifier(),
-		authMethodTypeInstanceID.identifier()).
+		authMethodTypeInstanceID.identifier(),
+		authMethodTypeRemaining.identifier()).
 		From(authMethodTypeTable.identifier())
 	if activeOnly {
 		q = q.Where(sq.Eq{authMethodTypeState.identifier(): domain.MFAStateReady})
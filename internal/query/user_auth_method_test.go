@@ -180,17 +180,19 @@ func TestUser_authMethodsCheckPermission(t *testing.T) {
 }
 
 var (
-	prepareUserAuthMethodsStmt = `SELECT projections.user_auth_methods5.token_id,` +
-		` projections.user_auth_methods5.creation_date,` +
-		` projections.user_auth_methods5.change_date,` +
-		` projections.user_auth_methods5.resource_owner,` +
-		` projections.user_auth_methods5.user_id,` +
-		` projections.user_auth_methods5.sequence,` +
-		` projections.user_auth_methods5.name,` +
-		` projections.user_auth_methods5.state,` +
-		` projections.user_auth_methods5.method_type,` +
+	prepareUserAuthMethodsStmt = `SELECT projections.user_auth_methods7.token_id,` +
+		` projections.user_auth_methods7.creation_date,` +
+		` projections.user_auth_methods7.change_date,` +
+		` projections.user_auth_methods7.resource_owner,` +
+		` projections.user_auth_methods7.user_id,` +
+		` projections.user_auth_methods7.sequence,` +
+		` projections.user_auth_methods7.name,` +
+		` projections.user_auth_methods7.state,` +
+		` projections.user_auth_methods7.method_type,` +
+		` projections.user_auth_methods7.remaining_codes,` +
+		` projections.user_auth_methods7.authenticator_model,` +
 		` COUNT(*) OVER ()` +
-		` FROM projections.user_auth_methods5`
+		` FROM projections.user_auth_methods7`
 	prepareUserAuthMethodsCols = []string{
 		"token_id",
 		"creation_date",
@@ -201,14 +203,17 @@ var (
 		"name",
 		"state",
 		"method_type",
+		"remaining_codes",
+		"authenticator_model",
 		"count",
 	}
 	prepareActiveAuthMethodTypesStmt = `SELECT projections.users14_notifications.password_set,` +
 		` auth_method_types.method_type,` +
+		` auth_method_types.remaining_codes,` +
 		` user_idps_count.count` +
 		` FROM projections.users14` +
 		` LEFT JOIN projections.users14_notifications ON projections.users14.id = projections.users14_notifications.user_id AND projections.users14.instance_id = projections.users14_notifications.instance_id` +
-		` LEFT JOIN (SELECT DISTINCT(auth_method_types.method_type), auth_method_types.user_id, auth_method_types.instance_id FROM projections.user_auth_methods5 AS auth_method_types` +
+		` LEFT JOIN (SELECT DISTINCT(auth_method_types.method_type), auth_method_types.user_id, auth_method_types.instance_id, auth_method_types.remaining_codes FROM projections.user_auth_methods7 AS auth_method_types` +
 		` WHERE auth_method_types.state = $1) AS auth_method_types` +
 		` ON auth_method_types.user_id = projections.users14.id AND auth_method_types.instance_id = projections.users14.instance_id` +
 		` LEFT JOIN (SELECT user_idps_count.user_id, user_idps_count.instance_id, COUNT(user_idps_count.user_id) AS count FROM projections.idp_user_links3 AS user_idps_count` +
@@ -217,14 +222,16 @@ var (
 	prepareActiveAuthMethodTypesCols = []string{
 		"password_set",
 		"method_type",
+		"remaining_codes",
 		"idps_count",
 	}
 	prepareActiveAuthMethodTypesDomainStmt = `SELECT projections.users14_notifications.password_set,` +
 		` auth_method_types.method_type,` +
+		` auth_method_types.remaining_codes,` +
 		` user_idps_count.count` +
 		` FROM projections.users14` +
 		` LEFT JOIN projections.users14_notifications ON projections.users14.id = projections.users14_notifications.user_id AND projections.users14.instance_id = projections.users14_notifications.instance_id` +
-		` LEFT JOIN (SELECT DISTINCT(auth_method_types.method_type), auth_method_types.user_id, auth_method_types.instance_id FROM projections.user_auth_methods5 AS auth_method_types` +
+		` LEFT JOIN (SELECT DISTINCT(auth_method_types.method_type), auth_method_types.user_id, auth_method_types.instance_id, auth_method_types.remaining_codes FROM projections.user_auth_methods7 AS auth_method_types` +
 		` WHERE auth_method_types.state = $1 AND (auth_method_types.domain IS NULL OR auth_method_types.domain = $2 OR auth_method_types.domain = $3)) AS auth_method_types` +
 		` ON auth_method_types.user_id = projections.users14.id AND auth_method_types.instance_id = projections.users14.instance_id` +
 		` LEFT JOIN (SELECT user_idps_count.user_id, user_idps_count.instance_id, COUNT(user_idps_count.user_id) AS count FROM projections.idp_user_links3 AS user_idps_count` +
@@ -233,14 +240,16 @@ var (
 	prepareActiveAuthMethodTypesDomainCols = []string{
 		"password_set",
 		"method_type",
+		"remaining_codes",
 		"idps_count",
 	}
 	prepareActiveAuthMethodTypesDomainExternalStmt = `SELECT projections.users14_notifications.password_set,` +
 		` auth_method_types.method_type,` +
+		` auth_method_types.remaining_codes,` +
 		` user_idps_count.count` +
 		` FROM projections.users14` +
 		` LEFT JOIN projections.users14_notifications ON projections.users14.id = projections.users14_notifications.user_id AND projections.users14.instance_id = projections.users14_notifications.instance_id` +
-		` LEFT JOIN (SELECT DISTINCT(auth_method_types.method_type), auth_method_types.user_id, auth_method_types.instance_id FROM projections.user_auth_methods5 AS auth_method_types` +
+		` LEFT JOIN (SELECT DISTINCT(auth_method_types.method_type), auth_method_types.user_id, auth_method_types.instance_id, auth_method_types.remaining_codes FROM projections.user_auth_methods7 AS auth_method_types` +
 		` WHERE auth_method_types.state = $1 AND (auth_method_types.domain IS NULL OR auth_method_types.domain = $2)) AS auth_method_types` +
 		` ON auth_method_types.user_id = projections.users14.id AND auth_method_types.instance_id = projections.users14.instance_id` +
 		` LEFT JOIN (SELECT user_idps_count.user_id, user_idps_count.instance_id, COUNT(user_idps_count.user_id) AS count FROM projections.idp_user_links3 AS user_idps_count` +
@@ -249,13 +258,14 @@ var (
 	prepareActiveAuthMethodTypesDomainExternalCols = []string{
 		"password_set",
 		"method_type",
+		"remaining_codes",
 		"idps_count",
 	}
 	prepareAuthMethodTypesRequiredStmt = `SELECT projections.users14.type,` +
 		` auth_methods_force_mfa.force_mfa,` +
 		` auth_methods_force_mfa.force_mfa_local_only` +
 		` FROM projections.users14` +
-		` LEFT JOIN (SELECT auth_methods_force_mfa.force_mfa, auth_methods_force_mfa.force_mfa_local_only, auth_methods_force_mfa.instance_id, auth_methods_force_mfa.aggregate_id, auth_methods_force_mfa.is_default FROM projections.login_policies5 AS auth_methods_force_mfa) AS auth_methods_force_mfa` +
+		` LEFT JOIN (SELECT auth_methods_force_mfa.force_mfa, auth_methods_force_mfa.force_mfa_local_only, auth_methods_force_mfa.instance_id, auth_methods_force_mfa.aggregate_id, auth_methods_force_mfa.is_default FROM projections.login_policies9 AS auth_methods_force_mfa) AS auth_methods_force_mfa` +
 		` ON (auth_methods_force_mfa.aggregate_id = projections.users14.instance_id OR auth_methods_force_mfa.aggregate_id = projections.users14.resource_owner) AND auth_methods_force_mfa.instance_id = projections.users14.instance_id` +
 		` ORDER BY auth_methods_force_mfa.is_default LIMIT 1
 `
@@ -307,6 +317,8 @@ func Test_UserAuthMethodPrepares(t *testing.T) {
 							"name",
 							domain.MFAStateReady,
 							domain.UserAuthMethodTypeU2F,
+							0,
+							"YubiKey 5",
 						},
 					},
 				),
@@ -317,15 +329,16 @@ func Test_UserAuthMethodPrepares(t *testing.T) {
 				},
 				AuthMethods: []*AuthMethod{
 					{
-						TokenID:       "token_id",
-						CreationDate:  testNow,
-						ChangeDate:    testNow,
-						ResourceOwner: "ro",
-						UserID:        "user_id",
-						Sequence:      20211108,
-						Name:          "name",
-						State:         domain.MFAStateReady,
-						Type:          domain.UserAuthMethodTypeU2F,
+						TokenID:            "token_id",
+						CreationDate:       testNow,
+						ChangeDate:         testNow,
+						ResourceOwner:      "ro",
+						UserID:             "user_id",
+						Sequence:           20211108,
+						Name:               "name",
+						State:              domain.MFAStateReady,
+						Type:               domain.UserAuthMethodTypeU2F,
+						AuthenticatorModel: "YubiKey 5",
 					},
 				},
 			},
@@ -348,6 +361,8 @@ func Test_UserAuthMethodPrepares(t *testing.T) {
 							"name",
 							domain.MFAStateReady,
 							domain.UserAuthMethodTypeU2F,
+							0,
+							"YubiKey 5",
 						},
 						{
 							"token_id-2",
@@ -359,6 +374,8 @@ func Test_UserAuthMethodPrepares(t *testing.T) {
 							"name-2",
 							domain.MFAStateReady,
 							domain.UserAuthMethodTypePasswordless,
+							0,
+							"YubiKey 5",
 						},
 					},
 				),
@@ -369,26 +386,28 @@ func Test_UserAuthMethodPrepares(t *testing.T) {
 				},
 				AuthMethods: []*AuthMethod{
 					{
-						TokenID:       "token_id",
-						CreationDate:  testNow,
-						ChangeDate:    testNow,
-						ResourceOwner: "ro",
-						UserID:        "user_id",
-						Sequence:      20211108,
-						Name:          "name",
-						State:         domain.MFAStateReady,
-						Type:          domain.UserAuthMethodTypeU2F,
+						TokenID:            "token_id",
+						CreationDate:       testNow,
+						ChangeDate:         testNow,
+						ResourceOwner:      "ro",
+						UserID:             "user_id",
+						Sequence:           20211108,
+						Name:               "name",
+						State:              domain.MFAStateReady,
+						Type:               domain.UserAuthMethodTypeU2F,
+						AuthenticatorModel: "YubiKey 5",
 					},
 					{
-						TokenID:       "token_id-2",
-						CreationDate:  testNow,
-						ChangeDate:    testNow,
-						ResourceOwner: "ro",
-						UserID:        "user_id",
-						Sequence:      20211108,
-						Name:          "name-2",
-						State:         domain.MFAStateReady,
-						Type:          domain.UserAuthMethodTypePasswordless,
+						TokenID:            "token_id-2",
+						CreationDate:       testNow,
+						ChangeDate:         testNow,
+						ResourceOwner:      "ro",
+						UserID:             "user_id",
+						Sequence:           20211108,
+						Name:               "name-2",
+						State:              domain.MFAStateReady,
+						Type:               domain.UserAuthMethodTypePasswordless,
+						AuthenticatorModel: "YubiKey 5",
 					},
 				},
 			},
@@ -443,6 +462,7 @@ func Test_UserAuthMethodPrepares(t *testing.T) {
 						{
 							true,
 							domain.UserAuthMethodTypePasswordless,
+							0,
 							1,
 						},
 					},
@@ -475,6 +495,7 @@ func Test_UserAuthMethodPrepares(t *testing.T) {
 						{
 							true,
 							domain.UserAuthMethodTypePasswordless,
+							0,
 							1,
 						},
 					},
@@ -507,6 +528,7 @@ func Test_UserAuthMethodPrepares(t *testing.T) {
 						{
 							true,
 							domain.UserAuthMethodTypePasswordless,
+							0,
 							1,
 						},
 					},
@@ -539,11 +561,13 @@ func Test_UserAuthMethodPrepares(t *testing.T) {
 						{
 							true,
 							domain.UserAuthMethodTypePasswordless,
+							0,
 							1,
 						},
 						{
 							true,
 							domain.UserAuthMethodTypeTOTP,
+							0,
 							1,
 						},
 					},
@@ -577,11 +601,13 @@ func Test_UserAuthMethodPrepares(t *testing.T) {
 						{
 							true,
 							domain.UserAuthMethodTypePasswordless,
+							0,
 							1,
 						},
 						{
 							true,
 							domain.UserAuthMethodTypeTOTP,
+							0,
 							1,
 						},
 					},
@@ -615,11 +641,13 @@ func Test_UserAuthMethodPrepares(t *testing.T) {
 						{
 							true,
 							domain.UserAuthMethodTypePasswordless,
+							0,
 							1,
 						},
 						{
 							true,
 							domain.UserAuthMethodTypeTOTP,
+							0,
 							1,
 						},
 					},
@@ -5,7 +5,7 @@ SELECT
 FROM 
     projections.users14 
 LEFT JOIN 
-    projections.login_policies5 AS auth_methods_force_mfa
+    projections.login_policies9 AS auth_methods_force_mfa
 ON
     auth_methods_force_mfa.instance_id = projections.users14.instance_id
     AND auth_methods_force_mfa.aggregate_id = ANY(ARRAY[projections.users14.instance_id, projections.users14.resource_owner])
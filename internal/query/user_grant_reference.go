@@ -0,0 +1,66 @@
+package query
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/usergrant"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// UserGrantReferenceByID returns the user and the project of the user grant based on the events,
+// it is also possible to get the references of already removed user grants.
+func (q *Queries) UserGrantReferenceByID(ctx context.Context, grantID, resourceOwner string) (_ *UserGrantReferenceReadModel, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	readModel := NewUserGrantReferenceReadModel(grantID, resourceOwner)
+	if err = q.eventstore.FilterToQueryReducer(ctx, readModel); err != nil {
+		return nil, err
+	}
+	if readModel.UserID == "" {
+		return nil, zerrors.ThrowNotFound(nil, "QUERY-Ugr1f", "Errors.UserGrant.NotFound")
+	}
+	return readModel, nil
+}
+
+type UserGrantReferenceReadModel struct {
+	*eventstore.ReadModel
+
+	UserID    string
+	ProjectID string
+}
+
+func NewUserGrantReferenceReadModel(grantID, resourceOwner string) *UserGrantReferenceReadModel {
+	return &UserGrantReferenceReadModel{
+		ReadModel: &eventstore.ReadModel{
+			AggregateID:   grantID,
+			ResourceOwner: resourceOwner,
+		},
+	}
+}
+
+func (rm *UserGrantReferenceReadModel) Reduce() error {
+	for _, event := range rm.Events {
+		if e, ok := event.(*usergrant.UserGrantAddedEvent); ok {
+			rm.UserID = e.UserID
+			rm.ProjectID = e.ProjectID
+		}
+	}
+	return rm.ReadModel.Reduce()
+}
+
+func (rm *UserGrantReferenceReadModel) Query() *eventstore.SearchQueryBuilder {
+	query := eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		AddQuery().
+		AggregateTypes(usergrant.AggregateType).
+		AggregateIDs(rm.AggregateID).
+		EventTypes(usergrant.UserGrantAddedType).
+		Builder()
+
+	if rm.ResourceOwner != "" {
+		query.ResourceOwner(rm.ResourceOwner)
+	}
+	return query
+}
@@ -529,6 +529,60 @@ var (
 	countUsersCols  = []string{"count"}
 )
 
+func TestUser_copy(t *testing.T) {
+	tests := []struct {
+		name string
+		user *User
+	}{
+		{
+			name: "human",
+			user: &User{
+				ID:         "id",
+				LoginNames: database.TextArray[string]{"login1", "login2"},
+				Human: &Human{
+					FirstName: "first",
+					Email:     "email@zitadel.com",
+				},
+			},
+		},
+		{
+			name: "machine",
+			user: &User{
+				ID: "id",
+				Machine: &Machine{
+					Name: "name",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.user.copy()
+			require.Equal(t, tt.user, got)
+			require.NotSame(t, tt.user, got)
+
+			got.ID = "changed"
+			got.LoginNames = append(got.LoginNames[:0], "changed")
+			if got.Human != nil {
+				got.Human.FirstName = "changed"
+			}
+			if got.Machine != nil {
+				got.Machine.Name = "changed"
+			}
+			assert.Equal(t, "id", tt.user.ID)
+			if tt.user.LoginNames != nil {
+				assert.Equal(t, "login1", tt.user.LoginNames[0])
+			}
+			if tt.user.Human != nil {
+				assert.Equal(t, "first", tt.user.Human.FirstName)
+			}
+			if tt.user.Machine != nil {
+				assert.Equal(t, "name", tt.user.Machine.Name)
+			}
+		})
+	}
+}
+
 func Test_UserPrepares(t *testing.T) {
 	type want struct {
 		sqlExpectations sqlExpectation
@@ -0,0 +1,170 @@
+package query
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/query/projection"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+var (
+	trustedDevicesTable = table{
+		name:          projection.TrustedDeviceProjectionTable,
+		instanceIDCol: projection.TrustedDeviceColumnInstanceID,
+	}
+	TrustedDeviceColumnID = Column{
+		name:  projection.TrustedDeviceColumnID,
+		table: trustedDevicesTable,
+	}
+	TrustedDeviceColumnCreationDate = Column{
+		name:  projection.TrustedDeviceColumnCreationDate,
+		table: trustedDevicesTable,
+	}
+	TrustedDeviceColumnChangeDate = Column{
+		name:  projection.TrustedDeviceColumnChangeDate,
+		table: trustedDevicesTable,
+	}
+	TrustedDeviceColumnSequence = Column{
+		name:  projection.TrustedDeviceColumnSequence,
+		table: trustedDevicesTable,
+	}
+	TrustedDeviceColumnResourceOwner = Column{
+		name:  projection.TrustedDeviceColumnResourceOwner,
+		table: trustedDevicesTable,
+	}
+	TrustedDeviceColumnInstanceID = Column{
+		name:  projection.TrustedDeviceColumnInstanceID,
+		table: trustedDevicesTable,
+	}
+	TrustedDeviceColumnUserID = Column{
+		name:  projection.TrustedDeviceColumnUserID,
+		table: trustedDevicesTable,
+	}
+	TrustedDeviceColumnName = Column{
+		name:  projection.TrustedDeviceColumnName,
+		table: trustedDevicesTable,
+	}
+	TrustedDeviceColumnUserAgentID = Column{
+		name:  projection.TrustedDeviceColumnUserAgentID,
+		table: trustedDevicesTable,
+	}
+	TrustedDeviceColumnLastUsed = Column{
+		name:  projection.TrustedDeviceColumnLastUsed,
+		table: trustedDevicesTable,
+	}
+	TrustedDeviceColumnExpiration = Column{
+		name:  projection.TrustedDeviceColumnExpiration,
+		table: trustedDevicesTable,
+	}
+)
+
+type TrustedDevices struct {
+	SearchResponse
+	TrustedDevices []*TrustedDevice
+}
+
+type TrustedDevice struct {
+	ID            string
+	CreationDate  time.Time
+	ChangeDate    time.Time
+	Sequence      uint64
+	ResourceOwner string
+
+	UserID      string
+	Name        string
+	UserAgentID string
+	LastUsed    time.Time
+	Expiration  time.Time
+}
+
+// SearchTrustedDevicesOfUser returns the trusted devices of the user.
+// If a permissionCheck is provided, the caller must either be the user itself or be granted to read the user.
+func (q *Queries) SearchTrustedDevicesOfUser(ctx context.Context, userID string, permissionCheck domain.PermissionCheck) (devices *TrustedDevices, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	query, scan := prepareTrustedDevicesQuery()
+	stmt, args, err := query.Where(sq.Eq{
+		TrustedDeviceColumnUserID.identifier():     userID,
+		TrustedDeviceColumnInstanceID.identifier(): authz.GetInstance(ctx).InstanceID(),
+	}).OrderBy(TrustedDeviceColumnCreationDate.identifier()).ToSql()
+	if err != nil {
+		return nil, zerrors.ThrowInvalidArgument(err, "QUERY-Td3qs", "Errors.Query.InvalidRequest")
+	}
+
+	err = q.client.QueryContext(ctx, func(rows *sql.Rows) error {
+		devices, err = scan(rows)
+		return err
+	}, stmt, args...)
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "QUERY-Td4qe", "Errors.Internal")
+	}
+	if permissionCheck != nil && len(devices.TrustedDevices) > 0 {
+		if err := userCheckPermission(ctx, devices.TrustedDevices[0].ResourceOwner, userID, permissionCheck); err != nil {
+			return nil, err
+		}
+	}
+	devices.State, err = q.latestState(ctx, trustedDevicesTable)
+	return devices, err
+}
+
+func prepareTrustedDevicesQuery() (sq.SelectBuilder, func(*sql.Rows) (*TrustedDevices, error)) {
+	return sq.Select(
+			TrustedDeviceColumnID.identifier(),
+			TrustedDeviceColumnCreationDate.identifier(),
+			TrustedDeviceColumnChangeDate.identifier(),
+			TrustedDeviceColumnSequence.identifier(),
+			TrustedDeviceColumnResourceOwner.identifier(),
+			TrustedDeviceColumnUserID.identifier(),
+			TrustedDeviceColumnName.identifier(),
+			TrustedDeviceColumnUserAgentID.identifier(),
+			TrustedDeviceColumnLastUsed.identifier(),
+			TrustedDeviceColumnExpiration.identifier(),
+			countColumn.identifier()).
+			From(trustedDevicesTable.identifier()).
+			PlaceholderFormat(sq.Dollar),
+		func(rows *sql.Rows) (*TrustedDevices, error) {
+			devices := make([]*TrustedDevice, 0)
+			var count uint64
+			for rows.Next() {
+				device := new(TrustedDevice)
+				var lastUsed sql.NullTime
+				err := rows.Scan(
+					&device.ID,
+					&device.CreationDate,
+					&device.ChangeDate,
+					&device.Sequence,
+					&device.ResourceOwner,
+					&device.UserID,
+					&device.Name,
+					&device.UserAgentID,
+					&lastUsed,
+					&device.Expiration,
+					&count,
+				)
+				if err != nil {
+					return nil, err
+				}
+				device.LastUsed = lastUsed.Time
+				devices = append(devices, device)
+			}
+
+			if err := rows.Close(); err != nil {
+				return nil, zerrors.ThrowInternal(err, "QUERY-Td5cr", "Errors.Query.CloseRows")
+			}
+
+			return &TrustedDevices{
+				TrustedDevices: devices,
+				SearchResponse: SearchResponse{
+					Count: count,
+				},
+			}, nil
+		}
+}
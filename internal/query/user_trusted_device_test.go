@@ -0,0 +1,153 @@
+package query
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"regexp"
+	"testing"
+	"time"
+)
+
+var (
+	trustedDevicesStmt = regexp.QuoteMeta(
+		"SELECT projections.trusted_devices.id," +
+			" projections.trusted_devices.creation_date," +
+			" projections.trusted_devices.change_date," +
+			" projections.trusted_devices.sequence," +
+			" projections.trusted_devices.resource_owner," +
+			" projections.trusted_devices.user_id," +
+			" projections.trusted_devices.name," +
+			" projections.trusted_devices.user_agent_id," +
+			" projections.trusted_devices.last_used," +
+			" projections.trusted_devices.expiration," +
+			" COUNT(*) OVER ()" +
+			" FROM projections.trusted_devices")
+	trustedDevicesCols = []string{
+		"id",
+		"creation_date",
+		"change_date",
+		"sequence",
+		"resource_owner",
+		"user_id",
+		"name",
+		"user_agent_id",
+		"last_used",
+		"expiration",
+		"count",
+	}
+)
+
+func Test_TrustedDevicePrepares(t *testing.T) {
+	type want struct {
+		sqlExpectations sqlExpectation
+		err             checkErr
+	}
+	tests := []struct {
+		name    string
+		prepare interface{}
+		want    want
+		object  interface{}
+	}{
+		{
+			name:    "prepareTrustedDevicesQuery no result",
+			prepare: prepareTrustedDevicesQuery,
+			want: want{
+				sqlExpectations: mockQueries(
+					trustedDevicesStmt,
+					nil,
+					nil,
+				),
+			},
+			object: &TrustedDevices{TrustedDevices: []*TrustedDevice{}},
+		},
+		{
+			name:    "prepareTrustedDevicesQuery multiple devices",
+			prepare: prepareTrustedDevicesQuery,
+			want: want{
+				sqlExpectations: mockQueries(
+					trustedDevicesStmt,
+					trustedDevicesCols,
+					[][]driver.Value{
+						{
+							"device-id",
+							testNow,
+							testNow,
+							uint64(20211202),
+							"ro",
+							"user-id",
+							"browser",
+							"user-agent-id",
+							testNow,
+							time.Date(9999, 12, 31, 23, 59, 59, 0, time.UTC),
+						},
+						{
+							"device-id2",
+							testNow,
+							testNow,
+							uint64(20211202),
+							"ro",
+							"user-id",
+							"",
+							"user-agent-id2",
+							nil,
+							time.Date(9999, 12, 31, 23, 59, 59, 0, time.UTC),
+						},
+					},
+				),
+			},
+			object: &TrustedDevices{
+				SearchResponse: SearchResponse{
+					Count: 2,
+				},
+				TrustedDevices: []*TrustedDevice{
+					{
+						ID:            "device-id",
+						CreationDate:  testNow,
+						ChangeDate:    testNow,
+						Sequence:      20211202,
+						ResourceOwner: "ro",
+						UserID:        "user-id",
+						Name:          "browser",
+						UserAgentID:   "user-agent-id",
+						LastUsed:      testNow,
+						Expiration:    time.Date(9999, 12, 31, 23, 59, 59, 0, time.UTC),
+					},
+					{
+						ID:            "device-id2",
+						CreationDate:  testNow,
+						ChangeDate:    testNow,
+						Sequence:      20211202,
+						ResourceOwner: "ro",
+						UserID:        "user-id",
+						UserAgentID:   "user-agent-id2",
+						Expiration:    time.Date(9999, 12, 31, 23, 59, 59, 0, time.UTC),
+					},
+				},
+			},
+		},
+		{
+			name:    "prepareTrustedDevicesQuery sql err",
+			prepare: prepareTrustedDevicesQuery,
+			want: want{
+				sqlExpectations: mockQueryErr(
+					trustedDevicesStmt,
+					sql.ErrConnDone,
+				),
+				err: func(err error) (error, bool) {
+					if !errors.Is(err, sql.ErrConnDone) {
+						return fmt.Errorf("err should be sql.ErrConnDone got: %w", err), false
+					}
+					return nil, true
+				},
+			},
+			object: (*TrustedDevices)(nil),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assertPrepare(t, tt.prepare, tt.object, tt.want.sqlExpectations, tt.want.err)
+		})
+	}
+}
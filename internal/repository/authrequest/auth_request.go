@@ -39,6 +39,8 @@ type AddedEvent struct {
 	HintUserID       *string                   `json:"hint_user_id,omitempty"`
 	NeedRefreshToken bool                      `json:"need_refresh_token,omitempty"`
 	Issuer           string                    `json:"issuer,omitempty"`
+	// AuthorizationDetails requested by the client (RFC 9396), validated against the types registered on the project
+	AuthorizationDetails domain.AuthorizationDetails `json:"authorization_details,omitempty"`
 }
 
 func (e *AddedEvent) Payload() interface{} {
@@ -68,6 +70,7 @@ func NewAddedEvent(ctx context.Context,
 	hintUserID *string,
 	needRefreshToken bool,
 	issuer string,
+	authorizationDetails domain.AuthorizationDetails,
 ) *AddedEvent {
 	return &AddedEvent{
 		BaseEvent: *eventstore.NewBaseEventForPush(
@@ -75,23 +78,24 @@ func NewAddedEvent(ctx context.Context,
 			aggregate,
 			AddedType,
 		),
-		LoginClient:      loginClient,
-		ClientID:         clientID,
-		RedirectURI:      redirectURI,
-		State:            state,
-		Nonce:            nonce,
-		Scope:            scope,
-		Audience:         audience,
-		ResponseType:     responseType,
-		ResponseMode:     responseMode,
-		CodeChallenge:    codeChallenge,
-		Prompt:           prompt,
-		UILocales:        uiLocales,
-		MaxAge:           maxAge,
-		LoginHint:        loginHint,
-		HintUserID:       hintUserID,
-		NeedRefreshToken: needRefreshToken,
-		Issuer:           issuer,
+		LoginClient:          loginClient,
+		ClientID:             clientID,
+		RedirectURI:          redirectURI,
+		State:                state,
+		Nonce:                nonce,
+		Scope:                scope,
+		Audience:             audience,
+		ResponseType:         responseType,
+		ResponseMode:         responseMode,
+		CodeChallenge:        codeChallenge,
+		Prompt:               prompt,
+		UILocales:            uiLocales,
+		MaxAge:               maxAge,
+		LoginHint:            loginHint,
+		HintUserID:           hintUserID,
+		NeedRefreshToken:     needRefreshToken,
+		Issuer:               issuer,
+		AuthorizationDetails: authorizationDetails,
 	}
 }
 
@@ -114,6 +118,8 @@ type SessionLinkedEvent struct {
 	UserID      string                      `json:"user_id"`
 	AuthTime    time.Time                   `json:"auth_time"`
 	AuthMethods []domain.UserAuthMethodType `json:"auth_methods"`
+	// AuthorizationDetails granted by the user, a subset of the ones requested by the client (RFC 9396)
+	AuthorizationDetails domain.AuthorizationDetails `json:"authorization_details,omitempty"`
 }
 
 func (e *SessionLinkedEvent) Payload() interface{} {
@@ -130,6 +136,7 @@ func NewSessionLinkedEvent(ctx context.Context,
 	userID string,
 	authTime time.Time,
 	authMethods []domain.UserAuthMethodType,
+	authorizationDetails domain.AuthorizationDetails,
 ) *SessionLinkedEvent {
 	return &SessionLinkedEvent{
 		BaseEvent: *eventstore.NewBaseEventForPush(
@@ -141,6 +148,8 @@ func NewSessionLinkedEvent(ctx context.Context,
 		UserID:      userID,
 		AuthTime:    authTime,
 		AuthMethods: authMethods,
+
+		AuthorizationDetails: authorizationDetails,
 	}
 }
 
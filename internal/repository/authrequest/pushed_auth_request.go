@@ -0,0 +1,104 @@
+package authrequest
+
+import (
+	"context"
+	"time"
+
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+const (
+	pushedEventPrefix = authRequestEventPrefix + "pushed."
+	PushedAddedType   = pushedEventPrefix + "added"
+	PushedUsedType    = pushedEventPrefix + "used"
+
+	UniquePushedAuthRequestUsedType = "pushed_auth_request_used"
+)
+
+// NewAddPushedAuthRequestUsedUniqueConstraint ensures the request_uri of a pushed authorization request
+// can only be used once, even if it is used concurrently.
+func NewAddPushedAuthRequestUsedUniqueConstraint(id string) *eventstore.UniqueConstraint {
+	return eventstore.NewAddEventUniqueConstraint(
+		UniquePushedAuthRequestUsedType,
+		id,
+		"Errors.AuthRequest.Pushed.NotExisting")
+}
+
+// PushedAddedEvent stores the parameters of an authorization request,
+// which were pushed by the client to the pushed authorization request endpoint (RFC 9126).
+type PushedAddedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	ClientID   string              `json:"client_id"`
+	Parameters map[string][]string `json:"parameters"`
+	Lifetime   time.Duration       `json:"lifetime"`
+}
+
+func (e *PushedAddedEvent) Payload() interface{} {
+	return e
+}
+
+func (e *PushedAddedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func NewPushedAddedEvent(ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	clientID string,
+	parameters map[string][]string,
+	lifetime time.Duration,
+) *PushedAddedEvent {
+	return &PushedAddedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			PushedAddedType,
+		),
+		ClientID:   clientID,
+		Parameters: parameters,
+		Lifetime:   lifetime,
+	}
+}
+
+func PushedAddedEventMapper(event eventstore.Event) (eventstore.Event, error) {
+	added := &PushedAddedEvent{
+		BaseEvent: *eventstore.BaseEventFromRepo(event),
+	}
+	err := event.Unmarshal(added)
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "AUTHR-Par1u", "unable to unmarshal pushed auth request added")
+	}
+
+	return added, nil
+}
+
+type PushedUsedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+}
+
+func (e *PushedUsedEvent) Payload() interface{} {
+	return nil
+}
+
+func (e *PushedUsedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return []*eventstore.UniqueConstraint{NewAddPushedAuthRequestUsedUniqueConstraint(e.Aggregate().ID)}
+}
+
+func NewPushedUsedEvent(ctx context.Context,
+	aggregate *eventstore.Aggregate,
+) *PushedUsedEvent {
+	return &PushedUsedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			PushedUsedType,
+		),
+	}
+}
+
+func PushedUsedEventMapper(event eventstore.Event) (eventstore.Event, error) {
+	return &PushedUsedEvent{
+		BaseEvent: *eventstore.BaseEventFromRepo(event),
+	}, nil
+}
@@ -0,0 +1,19 @@
+package backchannelauth
+
+import "github.com/zitadel/zitadel/internal/eventstore"
+
+const (
+	AggregateType    = "backchannel_auth"
+	AggregateVersion = "v1"
+)
+
+func NewAggregate(id, instanceID string) *eventstore.Aggregate {
+	return &eventstore.Aggregate{
+		ID:   id,
+		Type: AggregateType,
+		// the request is not bound to an organization, so we use the instance
+		ResourceOwner: instanceID,
+		InstanceID:    instanceID,
+		Version:       AggregateVersion,
+	}
+}
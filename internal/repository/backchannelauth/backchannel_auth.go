@@ -0,0 +1,166 @@
+package backchannelauth
+
+import (
+	"context"
+	"time"
+
+	"golang.org/x/text/language"
+
+	"github.com/zitadel/zitadel/internal/crypto"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+)
+
+const (
+	eventTypePrefix   eventstore.EventType = "backchannel_auth."
+	AddedEventType                         = eventTypePrefix + "added"
+	ApprovedEventType                      = eventTypePrefix + "approved"
+	CanceledEventType                      = eventTypePrefix + "canceled"
+	DoneEventType                          = eventTypePrefix + "done"
+)
+
+type AddedEvent struct {
+	*eventstore.BaseEvent `json:"-"`
+
+	ClientID                string                              `json:"clientID,omitempty"`
+	UserID                  string                              `json:"userID,omitempty"`
+	UserOrgID               string                              `json:"userOrgID,omitempty"`
+	Scopes                  []string                            `json:"scopes,omitempty"`
+	Audience                []string                            `json:"audience,omitempty"`
+	BindingMessage          string                              `json:"bindingMessage,omitempty"`
+	Lifetime                time.Duration                       `json:"lifetime,omitempty"`
+	DeliveryMode            domain.BackChannelTokenDeliveryMode `json:"deliveryMode,omitempty"`
+	ClientNotificationURI   string                              `json:"clientNotificationURI,omitempty"`
+	ClientNotificationToken *crypto.CryptoValue                 `json:"clientNotificationToken,omitempty"`
+	NeedRefreshToken        bool                                `json:"needRefreshToken,omitempty"`
+}
+
+func (e *AddedEvent) SetBaseEvent(b *eventstore.BaseEvent) {
+	e.BaseEvent = b
+}
+
+func (e *AddedEvent) Payload() any {
+	return e
+}
+
+func (e *AddedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func NewAddedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	clientID,
+	userID,
+	userOrgID string,
+	scopes,
+	audience []string,
+	bindingMessage string,
+	lifetime time.Duration,
+	deliveryMode domain.BackChannelTokenDeliveryMode,
+	clientNotificationURI string,
+	clientNotificationToken *crypto.CryptoValue,
+	needRefreshToken bool,
+) *AddedEvent {
+	return &AddedEvent{
+		BaseEvent: eventstore.NewBaseEventForPush(
+			ctx, aggregate, AddedEventType,
+		),
+		ClientID:                clientID,
+		UserID:                  userID,
+		UserOrgID:               userOrgID,
+		Scopes:                  scopes,
+		Audience:                audience,
+		BindingMessage:          bindingMessage,
+		Lifetime:                lifetime,
+		DeliveryMode:            deliveryMode,
+		ClientNotificationURI:   clientNotificationURI,
+		ClientNotificationToken: clientNotificationToken,
+		NeedRefreshToken:        needRefreshToken,
+	}
+}
+
+type ApprovedEvent struct {
+	*eventstore.BaseEvent `json:"-"`
+
+	UserAuthMethods   []domain.UserAuthMethodType `json:"userAuthMethods,omitempty"`
+	AuthTime          time.Time                   `json:"authTime,omitempty"`
+	PreferredLanguage *language.Tag               `json:"preferredLanguage,omitempty"`
+	UserAgent         *domain.UserAgent           `json:"userAgent,omitempty"`
+	SessionID         string                      `json:"sessionID,omitempty"`
+}
+
+func (e *ApprovedEvent) SetBaseEvent(b *eventstore.BaseEvent) {
+	e.BaseEvent = b
+}
+
+func (e *ApprovedEvent) Payload() any {
+	return e
+}
+
+func (e *ApprovedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func NewApprovedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	userAuthMethods []domain.UserAuthMethodType,
+	authTime time.Time,
+	preferredLanguage *language.Tag,
+	userAgent *domain.UserAgent,
+	sessionID string,
+) *ApprovedEvent {
+	return &ApprovedEvent{
+		BaseEvent: eventstore.NewBaseEventForPush(
+			ctx, aggregate, ApprovedEventType,
+		),
+		UserAuthMethods:   userAuthMethods,
+		AuthTime:          authTime,
+		PreferredLanguage: preferredLanguage,
+		UserAgent:         userAgent,
+		SessionID:         sessionID,
+	}
+}
+
+type CanceledEvent struct {
+	*eventstore.BaseEvent `json:"-"`
+
+	Reason domain.BackChannelAuthCanceled `json:"reason,omitempty"`
+}
+
+func (e *CanceledEvent) SetBaseEvent(b *eventstore.BaseEvent) {
+	e.BaseEvent = b
+}
+
+func (e *CanceledEvent) Payload() any {
+	return e
+}
+
+func (e *CanceledEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func NewCanceledEvent(ctx context.Context, aggregate *eventstore.Aggregate, reason domain.BackChannelAuthCanceled) *CanceledEvent {
+	return &CanceledEvent{eventstore.NewBaseEventForPush(ctx, aggregate, CanceledEventType), reason}
+}
+
+type DoneEvent struct {
+	*eventstore.BaseEvent `json:"-"`
+}
+
+func (e *DoneEvent) SetBaseEvent(b *eventstore.BaseEvent) {
+	e.BaseEvent = b
+}
+
+func (e *DoneEvent) Payload() any {
+	return e
+}
+
+func (e *DoneEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func NewDoneEvent(ctx context.Context, aggregate *eventstore.Aggregate) *DoneEvent {
+	return &DoneEvent{eventstore.NewBaseEventForPush(ctx, aggregate, DoneEventType)}
+}
@@ -25,6 +25,9 @@ func init() {
 	eventstore.RegisterFilterEventMapper(AggregateType, SMSConfigTwilioTokenChangedEventType, eventstore.GenericEventMapper[SMSConfigTwilioTokenChangedEvent])
 	eventstore.RegisterFilterEventMapper(AggregateType, SMSConfigHTTPAddedEventType, eventstore.GenericEventMapper[SMSConfigHTTPAddedEvent])
 	eventstore.RegisterFilterEventMapper(AggregateType, SMSConfigHTTPChangedEventType, eventstore.GenericEventMapper[SMSConfigHTTPChangedEvent])
+	eventstore.RegisterFilterEventMapper(AggregateType, SMSConfigHTTPTemplateAddedEventType, eventstore.GenericEventMapper[SMSConfigHTTPTemplateAddedEvent])
+	eventstore.RegisterFilterEventMapper(AggregateType, SMSConfigHTTPTemplateChangedEventType, eventstore.GenericEventMapper[SMSConfigHTTPTemplateChangedEvent])
+	eventstore.RegisterFilterEventMapper(AggregateType, SMSConfigHTTPTemplateHeadersChangedEventType, eventstore.GenericEventMapper[SMSConfigHTTPTemplateHeadersChangedEvent])
 	eventstore.RegisterFilterEventMapper(AggregateType, SMSConfigTwilioActivatedEventType, eventstore.GenericEventMapper[SMSConfigTwilioActivatedEvent])
 	eventstore.RegisterFilterEventMapper(AggregateType, SMSConfigTwilioDeactivatedEventType, eventstore.GenericEventMapper[SMSConfigTwilioDeactivatedEvent])
 	eventstore.RegisterFilterEventMapper(AggregateType, SMSConfigTwilioRemovedEventType, eventstore.GenericEventMapper[SMSConfigTwilioRemovedEvent])
@@ -128,6 +131,8 @@ func init() {
 	eventstore.RegisterFilterEventMapper(AggregateType, InstanceRemovedEventType, InstanceRemovedEventMapper)
 	eventstore.RegisterFilterEventMapper(AggregateType, NotificationPolicyAddedEventType, NotificationPolicyAddedEventMapper)
 	eventstore.RegisterFilterEventMapper(AggregateType, NotificationPolicyChangedEventType, NotificationPolicyChangedEventMapper)
+	eventstore.RegisterFilterEventMapper(AggregateType, PasskeyAttestationPolicySetEventType, PasskeyAttestationPolicySetEventMapper)
+	eventstore.RegisterFilterEventMapper(AggregateType, PasskeyAttestationPolicyRemovedEventType, PasskeyAttestationPolicyRemovedEventMapper)
 	eventstore.RegisterFilterEventMapper(AggregateType, TrustedDomainAddedEventType, eventstore.GenericEventMapper[TrustedDomainAddedEvent])
 	eventstore.RegisterFilterEventMapper(AggregateType, TrustedDomainRemovedEventType, eventstore.GenericEventMapper[TrustedDomainRemovedEvent])
 }
@@ -38,6 +38,10 @@ func NewLoginPolicyAddedEvent(
 	mfaInitSkipLifetime,
 	secondFactorCheckLifetime,
 	multiFactorCheckLifetime time.Duration,
+	forceMFAOnHighRisk bool,
+	trustedDeviceLifetime time.Duration,
+	allowAccountRecovery bool,
+	allowMagicLink bool,
 ) *LoginPolicyAddedEvent {
 	return &LoginPolicyAddedEvent{
 		LoginPolicyAddedEvent: *policy.NewLoginPolicyAddedEvent(
@@ -61,7 +65,11 @@ func NewLoginPolicyAddedEvent(
 			externalLoginCheckLifetime,
 			mfaInitSkipLifetime,
 			secondFactorCheckLifetime,
-			multiFactorCheckLifetime),
+			multiFactorCheckLifetime,
+			forceMFAOnHighRisk,
+			trustedDeviceLifetime,
+			allowAccountRecovery,
+			allowMagicLink),
 	}
 }
 
@@ -24,6 +24,9 @@ func NewPasswordComplexityPolicyAddedEvent(
 	hasUppercase,
 	hasNumber,
 	hasSymbol bool,
+	historyDepth uint64,
+	checkBreached,
+	forceChangeBreached bool,
 ) *PasswordComplexityPolicyAddedEvent {
 	return &PasswordComplexityPolicyAddedEvent{
 		PasswordComplexityPolicyAddedEvent: *policy.NewPasswordComplexityPolicyAddedEvent(
@@ -35,7 +38,10 @@ func NewPasswordComplexityPolicyAddedEvent(
 			hasLowercase,
 			hasUppercase,
 			hasNumber,
-			hasSymbol),
+			hasSymbol,
+			historyDepth,
+			checkBreached,
+			forceChangeBreached),
 	}
 }
 
@@ -2,6 +2,7 @@ package instance
 
 import (
 	"context"
+	"time"
 
 	"github.com/zitadel/zitadel/internal/eventstore"
 	"github.com/zitadel/zitadel/internal/repository/policy"
@@ -22,6 +23,11 @@ func NewLockoutPolicyAddedEvent(
 	maxPasswordAttempts,
 	maxOTPAttempts uint64,
 	showLockoutFailure bool,
+	maxIPAttempts,
+	maxClientAttempts uint64,
+	throttleWindow,
+	throttleDelay,
+	throttleBlockDuration time.Duration,
 ) *LockoutPolicyAddedEvent {
 	return &LockoutPolicyAddedEvent{
 		LockoutPolicyAddedEvent: *policy.NewLockoutPolicyAddedEvent(
@@ -31,7 +37,12 @@ func NewLockoutPolicyAddedEvent(
 				LockoutPolicyAddedEventType),
 			maxPasswordAttempts,
 			maxOTPAttempts,
-			showLockoutFailure),
+			showLockoutFailure,
+			maxIPAttempts,
+			maxClientAttempts,
+			throttleWindow,
+			throttleDelay,
+			throttleBlockDuration),
 	}
 }
 
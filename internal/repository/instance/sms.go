@@ -9,20 +9,24 @@ import (
 )
 
 const (
-	smsConfigPrefix                      = "sms.config"
-	smsConfigTwilioPrefix                = "twilio."
-	smsConfigHTTPPrefix                  = "http."
-	SMSConfigTwilioAddedEventType        = instanceEventTypePrefix + smsConfigPrefix + smsConfigTwilioPrefix + "added"
-	SMSConfigTwilioChangedEventType      = instanceEventTypePrefix + smsConfigPrefix + smsConfigTwilioPrefix + "changed"
-	SMSConfigHTTPAddedEventType          = instanceEventTypePrefix + smsConfigPrefix + smsConfigHTTPPrefix + "added"
-	SMSConfigHTTPChangedEventType        = instanceEventTypePrefix + smsConfigPrefix + smsConfigHTTPPrefix + "changed"
-	SMSConfigTwilioTokenChangedEventType = instanceEventTypePrefix + smsConfigPrefix + smsConfigTwilioPrefix + "token.changed"
-	SMSConfigTwilioActivatedEventType    = instanceEventTypePrefix + smsConfigPrefix + smsConfigTwilioPrefix + "activated"
-	SMSConfigTwilioDeactivatedEventType  = instanceEventTypePrefix + smsConfigPrefix + smsConfigTwilioPrefix + "deactivated"
-	SMSConfigTwilioRemovedEventType      = instanceEventTypePrefix + smsConfigPrefix + smsConfigTwilioPrefix + "removed"
-	SMSConfigActivatedEventType          = instanceEventTypePrefix + smsConfigPrefix + "activated"
-	SMSConfigDeactivatedEventType        = instanceEventTypePrefix + smsConfigPrefix + "deactivated"
-	SMSConfigRemovedEventType            = instanceEventTypePrefix + smsConfigPrefix + "removed"
+	smsConfigPrefix                              = "sms.config"
+	smsConfigTwilioPrefix                        = "twilio."
+	smsConfigHTTPPrefix                          = "http."
+	smsConfigHTTPTemplatePrefix                  = "httptemplate."
+	SMSConfigTwilioAddedEventType                = instanceEventTypePrefix + smsConfigPrefix + smsConfigTwilioPrefix + "added"
+	SMSConfigTwilioChangedEventType              = instanceEventTypePrefix + smsConfigPrefix + smsConfigTwilioPrefix + "changed"
+	SMSConfigHTTPAddedEventType                  = instanceEventTypePrefix + smsConfigPrefix + smsConfigHTTPPrefix + "added"
+	SMSConfigHTTPChangedEventType                = instanceEventTypePrefix + smsConfigPrefix + smsConfigHTTPPrefix + "changed"
+	SMSConfigHTTPTemplateAddedEventType          = instanceEventTypePrefix + smsConfigPrefix + smsConfigHTTPTemplatePrefix + "added"
+	SMSConfigHTTPTemplateChangedEventType        = instanceEventTypePrefix + smsConfigPrefix + smsConfigHTTPTemplatePrefix + "changed"
+	SMSConfigHTTPTemplateHeadersChangedEventType = instanceEventTypePrefix + smsConfigPrefix + smsConfigHTTPTemplatePrefix + "headers.changed"
+	SMSConfigTwilioTokenChangedEventType         = instanceEventTypePrefix + smsConfigPrefix + smsConfigTwilioPrefix + "token.changed"
+	SMSConfigTwilioActivatedEventType            = instanceEventTypePrefix + smsConfigPrefix + smsConfigTwilioPrefix + "activated"
+	SMSConfigTwilioDeactivatedEventType          = instanceEventTypePrefix + smsConfigPrefix + smsConfigTwilioPrefix + "deactivated"
+	SMSConfigTwilioRemovedEventType              = instanceEventTypePrefix + smsConfigPrefix + smsConfigTwilioPrefix + "removed"
+	SMSConfigActivatedEventType                  = instanceEventTypePrefix + smsConfigPrefix + "activated"
+	SMSConfigDeactivatedEventType                = instanceEventTypePrefix + smsConfigPrefix + "deactivated"
+	SMSConfigRemovedEventType                    = instanceEventTypePrefix + smsConfigPrefix + "removed"
 )
 
 type SMSConfigTwilioAddedEvent struct {
@@ -275,6 +279,191 @@ func (e *SMSConfigHTTPChangedEvent) UniqueConstraints() []*eventstore.UniqueCons
 	return nil
 }
 
+type SMSConfigHTTPTemplateAddedEvent struct {
+	*eventstore.BaseEvent `json:"-"`
+
+	ID                 string              `json:"id,omitempty"`
+	Description        string              `json:"description,omitempty"`
+	Method             string              `json:"method,omitempty"`
+	URL                string              `json:"url,omitempty"`
+	Headers            *crypto.CryptoValue `json:"headers,omitempty"`
+	Body               string              `json:"body,omitempty"`
+	SenderNumber       string              `json:"senderNumber,omitempty"`
+	SuccessStatusCodes []int               `json:"successStatusCodes,omitempty"`
+	SuccessBodyPattern string              `json:"successBodyPattern,omitempty"`
+}
+
+func NewSMSConfigHTTPTemplateAddedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	id,
+	description,
+	method,
+	url string,
+	headers *crypto.CryptoValue,
+	body,
+	senderNumber string,
+	successStatusCodes []int,
+	successBodyPattern string,
+) *SMSConfigHTTPTemplateAddedEvent {
+	return &SMSConfigHTTPTemplateAddedEvent{
+		BaseEvent: eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			SMSConfigHTTPTemplateAddedEventType,
+		),
+		ID:                 id,
+		Description:        description,
+		Method:             method,
+		URL:                url,
+		Headers:            headers,
+		Body:               body,
+		SenderNumber:       senderNumber,
+		SuccessStatusCodes: successStatusCodes,
+		SuccessBodyPattern: successBodyPattern,
+	}
+}
+
+func (e *SMSConfigHTTPTemplateAddedEvent) SetBaseEvent(event *eventstore.BaseEvent) {
+	e.BaseEvent = event
+}
+
+func (e *SMSConfigHTTPTemplateAddedEvent) Payload() interface{} {
+	return e
+}
+
+func (e *SMSConfigHTTPTemplateAddedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+type SMSConfigHTTPTemplateChangedEvent struct {
+	*eventstore.BaseEvent `json:"-"`
+
+	ID                 string  `json:"id,omitempty"`
+	Description        *string `json:"description,omitempty"`
+	Method             *string `json:"method,omitempty"`
+	URL                *string `json:"url,omitempty"`
+	Body               *string `json:"body,omitempty"`
+	SenderNumber       *string `json:"senderNumber,omitempty"`
+	SuccessStatusCodes *[]int  `json:"successStatusCodes,omitempty"`
+	SuccessBodyPattern *string `json:"successBodyPattern,omitempty"`
+}
+
+func NewSMSConfigHTTPTemplateChangedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	id string,
+	changes []SMSConfigHTTPTemplateChanges,
+) (*SMSConfigHTTPTemplateChangedEvent, error) {
+	if len(changes) == 0 {
+		return nil, zerrors.ThrowPreconditionFailed(nil, "IAM-Tmp8e", "Errors.NoChangesFound")
+	}
+	changeEvent := &SMSConfigHTTPTemplateChangedEvent{
+		BaseEvent: eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			SMSConfigHTTPTemplateChangedEventType,
+		),
+		ID: id,
+	}
+	for _, change := range changes {
+		change(changeEvent)
+	}
+	return changeEvent, nil
+}
+
+type SMSConfigHTTPTemplateChanges func(event *SMSConfigHTTPTemplateChangedEvent)
+
+func ChangeSMSConfigHTTPTemplateDescription(description string) func(event *SMSConfigHTTPTemplateChangedEvent) {
+	return func(e *SMSConfigHTTPTemplateChangedEvent) {
+		e.Description = &description
+	}
+}
+
+func ChangeSMSConfigHTTPTemplateMethod(method string) func(event *SMSConfigHTTPTemplateChangedEvent) {
+	return func(e *SMSConfigHTTPTemplateChangedEvent) {
+		e.Method = &method
+	}
+}
+
+func ChangeSMSConfigHTTPTemplateURL(url string) func(event *SMSConfigHTTPTemplateChangedEvent) {
+	return func(e *SMSConfigHTTPTemplateChangedEvent) {
+		e.URL = &url
+	}
+}
+
+func ChangeSMSConfigHTTPTemplateBody(body string) func(event *SMSConfigHTTPTemplateChangedEvent) {
+	return func(e *SMSConfigHTTPTemplateChangedEvent) {
+		e.Body = &body
+	}
+}
+
+func ChangeSMSConfigHTTPTemplateSenderNumber(senderNumber string) func(event *SMSConfigHTTPTemplateChangedEvent) {
+	return func(e *SMSConfigHTTPTemplateChangedEvent) {
+		e.SenderNumber = &senderNumber
+	}
+}
+
+func ChangeSMSConfigHTTPTemplateSuccessStatusCodes(successStatusCodes []int) func(event *SMSConfigHTTPTemplateChangedEvent) {
+	return func(e *SMSConfigHTTPTemplateChangedEvent) {
+		e.SuccessStatusCodes = &successStatusCodes
+	}
+}
+
+func ChangeSMSConfigHTTPTemplateSuccessBodyPattern(successBodyPattern string) func(event *SMSConfigHTTPTemplateChangedEvent) {
+	return func(e *SMSConfigHTTPTemplateChangedEvent) {
+		e.SuccessBodyPattern = &successBodyPattern
+	}
+}
+
+func (e *SMSConfigHTTPTemplateChangedEvent) SetBaseEvent(event *eventstore.BaseEvent) {
+	e.BaseEvent = event
+}
+
+func (e *SMSConfigHTTPTemplateChangedEvent) Payload() interface{} {
+	return e
+}
+
+func (e *SMSConfigHTTPTemplateChangedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+type SMSConfigHTTPTemplateHeadersChangedEvent struct {
+	*eventstore.BaseEvent `json:"-"`
+
+	ID      string              `json:"id,omitempty"`
+	Headers *crypto.CryptoValue `json:"headers,omitempty"`
+}
+
+func NewSMSConfigHTTPTemplateHeadersChangedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	id string,
+	headers *crypto.CryptoValue,
+) *SMSConfigHTTPTemplateHeadersChangedEvent {
+	return &SMSConfigHTTPTemplateHeadersChangedEvent{
+		BaseEvent: eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			SMSConfigHTTPTemplateHeadersChangedEventType,
+		),
+		ID:      id,
+		Headers: headers,
+	}
+}
+
+func (e *SMSConfigHTTPTemplateHeadersChangedEvent) SetBaseEvent(event *eventstore.BaseEvent) {
+	e.BaseEvent = event
+}
+
+func (e *SMSConfigHTTPTemplateHeadersChangedEvent) Payload() interface{} {
+	return e
+}
+
+func (e *SMSConfigHTTPTemplateHeadersChangedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
 type SMSConfigTwilioActivatedEvent struct {
 	*eventstore.BaseEvent `json:"-"`
 	ID                    string `json:"id,omitempty"`
@@ -0,0 +1,20 @@
+package notification
+
+import "github.com/zitadel/zitadel/internal/eventstore"
+
+const (
+	AggregateType    = "notification"
+	AggregateVersion = "v1"
+)
+
+// NewAggregate creates the aggregate of a notification,
+// the resource owner is the organization of the notified user.
+func NewAggregate(id, resourceOwner, instanceID string) *eventstore.Aggregate {
+	return &eventstore.Aggregate{
+		ID:            id,
+		Type:          AggregateType,
+		ResourceOwner: resourceOwner,
+		InstanceID:    instanceID,
+		Version:       AggregateVersion,
+	}
+}
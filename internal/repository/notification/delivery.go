@@ -0,0 +1,159 @@
+package notification
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/eventstore"
+)
+
+const (
+	deliveryEventTypePrefix         eventstore.EventType = "notification.delivery."
+	DeliverySucceededEventType                           = deliveryEventTypePrefix + "succeeded"
+	DeliveryAttemptFailedEventType                       = deliveryEventTypePrefix + "attempt.failed"
+	DeliveryFailedEventType                              = deliveryEventTypePrefix + "failed"
+	DeliveryRetryRequestedEventType                      = deliveryEventTypePrefix + "retry.requested"
+)
+
+// DeliveryInfo describes an attempt to deliver a notification.
+type DeliveryInfo struct {
+	// Request is the job of the notification, it is kept to be able to retry the delivery.
+	Request *Request `json:"request"`
+	// Recipient is the email address or phone number the notification was sent to.
+	Recipient string `json:"recipient,omitempty"`
+	// ProviderID is the id of the active email or SMS provider.
+	ProviderID string `json:"providerId,omitempty"`
+	Attempt    int    `json:"attempt"`
+}
+
+// DeliverySucceededEvent is pushed after the notification was handed over to the provider.
+type DeliverySucceededEvent struct {
+	eventstore.BaseEvent `json:"-"`
+	DeliveryInfo
+}
+
+func (e *DeliverySucceededEvent) SetBaseEvent(b *eventstore.BaseEvent) {
+	e.BaseEvent = *b
+}
+
+func (e *DeliverySucceededEvent) Payload() any {
+	return e
+}
+
+func (e *DeliverySucceededEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func NewDeliverySucceededEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	info *DeliveryInfo,
+) *DeliverySucceededEvent {
+	return &DeliverySucceededEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx, aggregate, DeliverySucceededEventType,
+		),
+		DeliveryInfo: *info,
+	}
+}
+
+// DeliveryAttemptFailedEvent is pushed if an attempt to deliver the notification failed
+// and the delivery will be retried.
+type DeliveryAttemptFailedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+	DeliveryInfo
+	Error string `json:"error"`
+}
+
+func (e *DeliveryAttemptFailedEvent) SetBaseEvent(b *eventstore.BaseEvent) {
+	e.BaseEvent = *b
+}
+
+func (e *DeliveryAttemptFailedEvent) Payload() any {
+	return e
+}
+
+func (e *DeliveryAttemptFailedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func NewDeliveryAttemptFailedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	info *DeliveryInfo,
+	err error,
+) *DeliveryAttemptFailedEvent {
+	return &DeliveryAttemptFailedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx, aggregate, DeliveryAttemptFailedEventType,
+		),
+		DeliveryInfo: *info,
+		Error:        err.Error(),
+	}
+}
+
+// DeliveryFailedEvent is pushed if the notification was cancelled or the last attempt to deliver it failed.
+type DeliveryFailedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+	DeliveryInfo
+	Error string `json:"error"`
+}
+
+func (e *DeliveryFailedEvent) SetBaseEvent(b *eventstore.BaseEvent) {
+	e.BaseEvent = *b
+}
+
+func (e *DeliveryFailedEvent) Payload() any {
+	return e
+}
+
+func (e *DeliveryFailedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func NewDeliveryFailedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	info *DeliveryInfo,
+	err error,
+) *DeliveryFailedEvent {
+	return &DeliveryFailedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx, aggregate, DeliveryFailedEventType,
+		),
+		DeliveryInfo: *info,
+		Error:        err.Error(),
+	}
+}
+
+// DeliveryRetryRequestedEvent is pushed if the delivery of a failed notification was manually retriggered.
+// The request is queued again by the notification handler.
+type DeliveryRetryRequestedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	Request *Request `json:"request"`
+}
+
+func (e *DeliveryRetryRequestedEvent) SetBaseEvent(b *eventstore.BaseEvent) {
+	e.BaseEvent = *b
+}
+
+func (e *DeliveryRetryRequestedEvent) Payload() any {
+	return e
+}
+
+func (e *DeliveryRetryRequestedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func NewDeliveryRetryRequestedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	request *Request,
+) *DeliveryRetryRequestedEvent {
+	return &DeliveryRetryRequestedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx, aggregate, DeliveryRetryRequestedEventType,
+		),
+		Request: request,
+	}
+}
@@ -0,0 +1,10 @@
+package notification
+
+import "github.com/zitadel/zitadel/internal/eventstore"
+
+func init() {
+	eventstore.RegisterFilterEventMapper(AggregateType, DeliverySucceededEventType, eventstore.GenericEventMapper[DeliverySucceededEvent])
+	eventstore.RegisterFilterEventMapper(AggregateType, DeliveryAttemptFailedEventType, eventstore.GenericEventMapper[DeliveryAttemptFailedEvent])
+	eventstore.RegisterFilterEventMapper(AggregateType, DeliveryFailedEventType, eventstore.GenericEventMapper[DeliveryFailedEvent])
+	eventstore.RegisterFilterEventMapper(AggregateType, DeliveryRetryRequestedEventType, eventstore.GenericEventMapper[DeliveryRetryRequestedEvent])
+}
@@ -13,6 +13,9 @@ const (
 )
 
 type Request struct {
+	// NotificationID is set if the delivery of a notification is retried, so that all attempts are recorded on the same notification.
+	// If empty, the id of the job is used.
+	NotificationID                string                        `json:"notificationID,omitempty"`
 	Aggregate                     *eventstore.Aggregate         `json:"aggregate"`
 	UserID                        string                        `json:"userID"`
 	UserResourceOwner             string                        `json:"userResourceOwner"`
@@ -27,6 +30,8 @@ type Request struct {
 	IsOTP                         bool                          `json:"isOTP,omitempty"`
 	RequiresPreviousDomain        bool                          `json:"requiresPreviousDomain,omitempty"`
 	Args                          *domain.NotificationArguments `json:"args,omitempty"`
+	// Recipient is set if the notification is not sent to the email of the user, but to a secondary address (e.g. the recovery email).
+	Recipient string `json:"recipient,omitempty"`
 }
 
 func (e *Request) Kind() string {
@@ -34,6 +34,10 @@ type AddedEvent struct {
 	Nonce             string                      `json:"nonce,omitempty"`
 	PreferredLanguage *language.Tag               `json:"preferredLanguage,omitempty"`
 	UserAgent         *domain.UserAgent           `json:"userAgent,omitempty"`
+	// DPoPJKT is the JWK thumbprint of the DPoP proof key the tokens of the session are bound to (RFC 9449).
+	DPoPJKT string `json:"dpopJkt,omitempty"`
+	// AuthorizationDetails granted to the client (RFC 9396), asserted in the access tokens of the session.
+	AuthorizationDetails domain.AuthorizationDetails `json:"authorizationDetails,omitempty"`
 }
 
 func (e *AddedEvent) Payload() interface{} {
@@ -61,6 +65,8 @@ func NewAddedEvent(ctx context.Context,
 	nonce string,
 	preferredLanguage *language.Tag,
 	userAgent *domain.UserAgent,
+	dpopJKT string,
+	authorizationDetails domain.AuthorizationDetails,
 ) *AddedEvent {
 	return &AddedEvent{
 		BaseEvent: *eventstore.NewBaseEventForPush(
@@ -79,6 +85,9 @@ func NewAddedEvent(ctx context.Context,
 		Nonce:             nonce,
 		PreferredLanguage: preferredLanguage,
 		UserAgent:         userAgent,
+		DPoPJKT:           dpopJKT,
+
+		AuthorizationDetails: authorizationDetails,
 	}
 }
 
@@ -114,4 +114,8 @@ func init() {
 	eventstore.RegisterFilterEventMapper(AggregateType, NotificationPolicyAddedEventType, NotificationPolicyAddedEventMapper)
 	eventstore.RegisterFilterEventMapper(AggregateType, NotificationPolicyChangedEventType, NotificationPolicyChangedEventMapper)
 	eventstore.RegisterFilterEventMapper(AggregateType, NotificationPolicyRemovedEventType, NotificationPolicyRemovedEventMapper)
+	eventstore.RegisterFilterEventMapper(AggregateType, PasskeyAttestationPolicySetEventType, PasskeyAttestationPolicySetEventMapper)
+	eventstore.RegisterFilterEventMapper(AggregateType, PasskeyAttestationPolicyRemovedEventType, PasskeyAttestationPolicyRemovedEventMapper)
+	eventstore.RegisterFilterEventMapper(AggregateType, ClientCertificatePolicySetEventType, ClientCertificatePolicySetEventMapper)
+	eventstore.RegisterFilterEventMapper(AggregateType, ClientCertificatePolicyRemovedEventType, ClientCertificatePolicyRemovedEventMapper)
 }
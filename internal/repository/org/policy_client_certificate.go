@@ -0,0 +1,71 @@
+package org
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/policy"
+)
+
+const (
+	ClientCertificatePolicySetEventType     = orgEventTypePrefix + policy.ClientCertificatePolicySetEventType
+	ClientCertificatePolicyRemovedEventType = orgEventTypePrefix + policy.ClientCertificatePolicyRemovedEventType
+)
+
+type ClientCertificatePolicySetEvent struct {
+	policy.ClientCertificatePolicySetEvent
+}
+
+func NewClientCertificatePolicySetEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	changes []policy.ClientCertificatePolicyChanges,
+) (*ClientCertificatePolicySetEvent, error) {
+	event, err := policy.NewClientCertificatePolicySetEvent(
+		eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			ClientCertificatePolicySetEventType),
+		changes,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &ClientCertificatePolicySetEvent{ClientCertificatePolicySetEvent: *event}, nil
+}
+
+func ClientCertificatePolicySetEventMapper(event eventstore.Event) (eventstore.Event, error) {
+	e, err := policy.ClientCertificatePolicySetEventMapper(event)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ClientCertificatePolicySetEvent{ClientCertificatePolicySetEvent: *e.(*policy.ClientCertificatePolicySetEvent)}, nil
+}
+
+type ClientCertificatePolicyRemovedEvent struct {
+	policy.ClientCertificatePolicyRemovedEvent
+}
+
+func NewClientCertificatePolicyRemovedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+) *ClientCertificatePolicyRemovedEvent {
+	return &ClientCertificatePolicyRemovedEvent{
+		ClientCertificatePolicyRemovedEvent: *policy.NewClientCertificatePolicyRemovedEvent(
+			eventstore.NewBaseEventForPush(
+				ctx,
+				aggregate,
+				ClientCertificatePolicyRemovedEventType),
+		),
+	}
+}
+
+func ClientCertificatePolicyRemovedEventMapper(event eventstore.Event) (eventstore.Event, error) {
+	e, err := policy.ClientCertificatePolicyRemovedEventMapper(event)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ClientCertificatePolicyRemovedEvent{ClientCertificatePolicyRemovedEvent: *e.(*policy.ClientCertificatePolicyRemovedEvent)}, nil
+}
@@ -39,6 +39,10 @@ func NewLoginPolicyAddedEvent(
 	mfaInitSkipLifetime,
 	secondFactorCheckLifetime,
 	multiFactorCheckLifetime time.Duration,
+	forceMFAOnHighRisk bool,
+	trustedDeviceLifetime time.Duration,
+	allowAccountRecovery bool,
+	allowMagicLink bool,
 ) *LoginPolicyAddedEvent {
 	return &LoginPolicyAddedEvent{
 		LoginPolicyAddedEvent: *policy.NewLoginPolicyAddedEvent(
@@ -63,6 +67,10 @@ func NewLoginPolicyAddedEvent(
 			mfaInitSkipLifetime,
 			secondFactorCheckLifetime,
 			multiFactorCheckLifetime,
+			forceMFAOnHighRisk,
+			trustedDeviceLifetime,
+			allowAccountRecovery,
+			allowMagicLink,
 		),
 	}
 }
@@ -0,0 +1,71 @@
+package org
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/policy"
+)
+
+const (
+	PasskeyAttestationPolicySetEventType     = orgEventTypePrefix + policy.PasskeyAttestationPolicySetEventType
+	PasskeyAttestationPolicyRemovedEventType = orgEventTypePrefix + policy.PasskeyAttestationPolicyRemovedEventType
+)
+
+type PasskeyAttestationPolicySetEvent struct {
+	policy.PasskeyAttestationPolicySetEvent
+}
+
+func NewPasskeyAttestationPolicySetEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	changes []policy.PasskeyAttestationPolicyChanges,
+) (*PasskeyAttestationPolicySetEvent, error) {
+	event, err := policy.NewPasskeyAttestationPolicySetEvent(
+		eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			PasskeyAttestationPolicySetEventType),
+		changes,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &PasskeyAttestationPolicySetEvent{PasskeyAttestationPolicySetEvent: *event}, nil
+}
+
+func PasskeyAttestationPolicySetEventMapper(event eventstore.Event) (eventstore.Event, error) {
+	e, err := policy.PasskeyAttestationPolicySetEventMapper(event)
+	if err != nil {
+		return nil, err
+	}
+
+	return &PasskeyAttestationPolicySetEvent{PasskeyAttestationPolicySetEvent: *e.(*policy.PasskeyAttestationPolicySetEvent)}, nil
+}
+
+type PasskeyAttestationPolicyRemovedEvent struct {
+	policy.PasskeyAttestationPolicyRemovedEvent
+}
+
+func NewPasskeyAttestationPolicyRemovedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+) *PasskeyAttestationPolicyRemovedEvent {
+	return &PasskeyAttestationPolicyRemovedEvent{
+		PasskeyAttestationPolicyRemovedEvent: *policy.NewPasskeyAttestationPolicyRemovedEvent(
+			eventstore.NewBaseEventForPush(
+				ctx,
+				aggregate,
+				PasskeyAttestationPolicyRemovedEventType),
+		),
+	}
+}
+
+func PasskeyAttestationPolicyRemovedEventMapper(event eventstore.Event) (eventstore.Event, error) {
+	e, err := policy.PasskeyAttestationPolicyRemovedEventMapper(event)
+	if err != nil {
+		return nil, err
+	}
+
+	return &PasskeyAttestationPolicyRemovedEvent{PasskeyAttestationPolicyRemovedEvent: *e.(*policy.PasskeyAttestationPolicyRemovedEvent)}, nil
+}
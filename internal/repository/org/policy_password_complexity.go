@@ -25,6 +25,9 @@ func NewPasswordComplexityPolicyAddedEvent(
 	hasUppercase,
 	hasNumber,
 	hasSymbol bool,
+	historyDepth uint64,
+	checkBreached,
+	forceChangeBreached bool,
 ) *PasswordComplexityPolicyAddedEvent {
 	return &PasswordComplexityPolicyAddedEvent{
 		PasswordComplexityPolicyAddedEvent: *policy.NewPasswordComplexityPolicyAddedEvent(
@@ -36,7 +39,10 @@ func NewPasswordComplexityPolicyAddedEvent(
 			hasLowercase,
 			hasUppercase,
 			hasNumber,
-			hasSymbol),
+			hasSymbol,
+			historyDepth,
+			checkBreached,
+			forceChangeBreached),
 	}
 }
 
@@ -2,6 +2,7 @@ package org
 
 import (
 	"context"
+	"time"
 
 	"github.com/zitadel/zitadel/internal/eventstore"
 	"github.com/zitadel/zitadel/internal/repository/policy"
@@ -23,6 +24,11 @@ func NewLockoutPolicyAddedEvent(
 	maxPasswordAttempts,
 	maxOTPAttempts uint64,
 	showLockoutFailure bool,
+	maxIPAttempts,
+	maxClientAttempts uint64,
+	throttleWindow,
+	throttleDelay,
+	throttleBlockDuration time.Duration,
 ) *LockoutPolicyAddedEvent {
 	return &LockoutPolicyAddedEvent{
 		LockoutPolicyAddedEvent: *policy.NewLockoutPolicyAddedEvent(
@@ -32,7 +38,12 @@ func NewLockoutPolicyAddedEvent(
 				LockoutPolicyAddedEventType),
 			maxPasswordAttempts,
 			maxOTPAttempts,
-			showLockoutFailure),
+			showLockoutFailure,
+			maxIPAttempts,
+			maxClientAttempts,
+			throttleWindow,
+			throttleDelay,
+			throttleBlockDuration),
 	}
 }
 
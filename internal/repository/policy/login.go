@@ -35,6 +35,10 @@ type LoginPolicyAddedEvent struct {
 	MFAInitSkipLifetime        time.Duration           `json:"mfaInitSkipLifetime,omitempty"`
 	SecondFactorCheckLifetime  time.Duration           `json:"secondFactorCheckLifetime,omitempty"`
 	MultiFactorCheckLifetime   time.Duration           `json:"multiFactorCheckLifetime,omitempty"`
+	ForceMFAOnHighRisk         bool                    `json:"forceMFAOnHighRisk,omitempty"`
+	TrustedDeviceLifetime      time.Duration           `json:"trustedDeviceLifetime,omitempty"`
+	AllowAccountRecovery       bool                    `json:"allowAccountRecovery,omitempty"`
+	AllowMagicLink             bool                    `json:"allowMagicLink,omitempty"`
 }
 
 func (e *LoginPolicyAddedEvent) Payload() interface{} {
@@ -64,6 +68,10 @@ func NewLoginPolicyAddedEvent(
 	mfaInitSkipLifetime,
 	secondFactorCheckLifetime,
 	multiFactorCheckLifetime time.Duration,
+	forceMFAOnHighRisk bool,
+	trustedDeviceLifetime time.Duration,
+	allowAccountRecovery bool,
+	allowMagicLink bool,
 ) *LoginPolicyAddedEvent {
 	return &LoginPolicyAddedEvent{
 		BaseEvent:                  *base,
@@ -84,6 +92,10 @@ func NewLoginPolicyAddedEvent(
 		MultiFactorCheckLifetime:   multiFactorCheckLifetime,
 		DisableLoginWithEmail:      disableLoginWithEmail,
 		DisableLoginWithPhone:      disableLoginWithPhone,
+		ForceMFAOnHighRisk:         forceMFAOnHighRisk,
+		TrustedDeviceLifetime:      trustedDeviceLifetime,
+		AllowAccountRecovery:       allowAccountRecovery,
+		AllowMagicLink:             allowMagicLink,
 	}
 }
 
@@ -120,6 +132,10 @@ type LoginPolicyChangedEvent struct {
 	MFAInitSkipLifetime        *time.Duration           `json:"mfaInitSkipLifetime,omitempty"`
 	SecondFactorCheckLifetime  *time.Duration           `json:"secondFactorCheckLifetime,omitempty"`
 	MultiFactorCheckLifetime   *time.Duration           `json:"multiFactorCheckLifetime,omitempty"`
+	ForceMFAOnHighRisk         *bool                    `json:"forceMFAOnHighRisk,omitempty"`
+	TrustedDeviceLifetime      *time.Duration           `json:"trustedDeviceLifetime,omitempty"`
+	AllowAccountRecovery       *bool                    `json:"allowAccountRecovery,omitempty"`
+	AllowMagicLink             *bool                    `json:"allowMagicLink,omitempty"`
 }
 
 func (e *LoginPolicyChangedEvent) Payload() interface{} {
@@ -250,6 +266,30 @@ func ChangeDisableLoginWithPhone(DisableLoginWithPhone bool) func(*LoginPolicyCh
 	}
 }
 
+func ChangeForceMFAOnHighRisk(forceMFAOnHighRisk bool) func(*LoginPolicyChangedEvent) {
+	return func(e *LoginPolicyChangedEvent) {
+		e.ForceMFAOnHighRisk = &forceMFAOnHighRisk
+	}
+}
+
+func ChangeTrustedDeviceLifetime(trustedDeviceLifetime time.Duration) func(*LoginPolicyChangedEvent) {
+	return func(e *LoginPolicyChangedEvent) {
+		e.TrustedDeviceLifetime = &trustedDeviceLifetime
+	}
+}
+
+func ChangeAllowAccountRecovery(allowAccountRecovery bool) func(*LoginPolicyChangedEvent) {
+	return func(e *LoginPolicyChangedEvent) {
+		e.AllowAccountRecovery = &allowAccountRecovery
+	}
+}
+
+func ChangeAllowMagicLink(allowMagicLink bool) func(*LoginPolicyChangedEvent) {
+	return func(e *LoginPolicyChangedEvent) {
+		e.AllowMagicLink = &allowMagicLink
+	}
+}
+
 func LoginPolicyChangedEventMapper(event eventstore.Event) (eventstore.Event, error) {
 	e := &LoginPolicyChangedEvent{
 		BaseEvent: *eventstore.BaseEventFromRepo(event),
@@ -0,0 +1,108 @@
+package policy
+
+import (
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+const (
+	clientCertificatePolicyPrefix           = "policy.client.certificate."
+	ClientCertificatePolicySetEventType     = clientCertificatePolicyPrefix + "set"
+	ClientCertificatePolicyRemovedEventType = clientCertificatePolicyPrefix + "removed"
+)
+
+type ClientCertificatePolicySetEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	CACertificates *[]string                            `json:"caCertificates,omitempty"`
+	CRLs           *[]string                            `json:"crls,omitempty"`
+	UserMapping    *domain.ClientCertificateUserMapping `json:"userMapping,omitempty"`
+}
+
+func (e *ClientCertificatePolicySetEvent) Payload() interface{} {
+	return e
+}
+
+func (e *ClientCertificatePolicySetEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func NewClientCertificatePolicySetEvent(
+	base *eventstore.BaseEvent,
+	changes []ClientCertificatePolicyChanges,
+) (*ClientCertificatePolicySetEvent, error) {
+	if len(changes) == 0 {
+		return nil, zerrors.ThrowPreconditionFailed(nil, "POLICY-Cc1Nc", "Errors.NoChangesFound")
+	}
+	event := &ClientCertificatePolicySetEvent{
+		BaseEvent: *base,
+	}
+	for _, change := range changes {
+		change(event)
+	}
+	return event, nil
+}
+
+type ClientCertificatePolicyChanges func(*ClientCertificatePolicySetEvent)
+
+func ChangeClientCertificateCACertificates(certificates []string) func(*ClientCertificatePolicySetEvent) {
+	return func(e *ClientCertificatePolicySetEvent) {
+		if certificates == nil {
+			certificates = []string{}
+		}
+		e.CACertificates = &certificates
+	}
+}
+
+func ChangeClientCertificateCRLs(crls []string) func(*ClientCertificatePolicySetEvent) {
+	return func(e *ClientCertificatePolicySetEvent) {
+		if crls == nil {
+			crls = []string{}
+		}
+		e.CRLs = &crls
+	}
+}
+
+func ChangeClientCertificateUserMapping(mapping domain.ClientCertificateUserMapping) func(*ClientCertificatePolicySetEvent) {
+	return func(e *ClientCertificatePolicySetEvent) {
+		e.UserMapping = &mapping
+	}
+}
+
+func ClientCertificatePolicySetEventMapper(event eventstore.Event) (eventstore.Event, error) {
+	e := &ClientCertificatePolicySetEvent{
+		BaseEvent: *eventstore.BaseEventFromRepo(event),
+	}
+
+	err := event.Unmarshal(e)
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "POLIC-Cc2Um", "unable to unmarshal policy")
+	}
+
+	return e, nil
+}
+
+type ClientCertificatePolicyRemovedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+}
+
+func (e *ClientCertificatePolicyRemovedEvent) Payload() interface{} {
+	return nil
+}
+
+func (e *ClientCertificatePolicyRemovedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func NewClientCertificatePolicyRemovedEvent(base *eventstore.BaseEvent) *ClientCertificatePolicyRemovedEvent {
+	return &ClientCertificatePolicyRemovedEvent{
+		BaseEvent: *base,
+	}
+}
+
+func ClientCertificatePolicyRemovedEventMapper(event eventstore.Event) (eventstore.Event, error) {
+	return &ClientCertificatePolicyRemovedEvent{
+		BaseEvent: *eventstore.BaseEventFromRepo(event),
+	}, nil
+}
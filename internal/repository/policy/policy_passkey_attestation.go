@@ -0,0 +1,125 @@
+package policy
+
+import (
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+const (
+	passkeyAttestationPolicyPrefix           = "policy.passkey.attestation."
+	PasskeyAttestationPolicySetEventType     = passkeyAttestationPolicyPrefix + "set"
+	PasskeyAttestationPolicyRemovedEventType = passkeyAttestationPolicyPrefix + "removed"
+)
+
+type PasskeyAttestationPolicySetEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	Conveyance       *domain.AttestationConveyance `json:"conveyance,omitempty"`
+	RootCertificates *[]string                     `json:"rootCertificates,omitempty"`
+	UseMetadata      *bool                         `json:"useMetadata,omitempty"`
+	AllowedAAGUIDs   *[]string                     `json:"allowedAaguids,omitempty"`
+	DeniedAAGUIDs    *[]string                     `json:"deniedAaguids,omitempty"`
+}
+
+func (e *PasskeyAttestationPolicySetEvent) Payload() interface{} {
+	return e
+}
+
+func (e *PasskeyAttestationPolicySetEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func NewPasskeyAttestationPolicySetEvent(
+	base *eventstore.BaseEvent,
+	changes []PasskeyAttestationPolicyChanges,
+) (*PasskeyAttestationPolicySetEvent, error) {
+	if len(changes) == 0 {
+		return nil, zerrors.ThrowPreconditionFailed(nil, "POLICY-Pa5Kc", "Errors.NoChangesFound")
+	}
+	event := &PasskeyAttestationPolicySetEvent{
+		BaseEvent: *base,
+	}
+	for _, change := range changes {
+		change(event)
+	}
+	return event, nil
+}
+
+type PasskeyAttestationPolicyChanges func(*PasskeyAttestationPolicySetEvent)
+
+func ChangePasskeyAttestationConveyance(conveyance domain.AttestationConveyance) func(*PasskeyAttestationPolicySetEvent) {
+	return func(e *PasskeyAttestationPolicySetEvent) {
+		e.Conveyance = &conveyance
+	}
+}
+
+func ChangePasskeyAttestationRootCertificates(certificates []string) func(*PasskeyAttestationPolicySetEvent) {
+	return func(e *PasskeyAttestationPolicySetEvent) {
+		if certificates == nil {
+			certificates = []string{}
+		}
+		e.RootCertificates = &certificates
+	}
+}
+
+func ChangePasskeyAttestationUseMetadata(useMetadata bool) func(*PasskeyAttestationPolicySetEvent) {
+	return func(e *PasskeyAttestationPolicySetEvent) {
+		e.UseMetadata = &useMetadata
+	}
+}
+
+func ChangePasskeyAttestationAllowedAAGUIDs(aaguids []string) func(*PasskeyAttestationPolicySetEvent) {
+	return func(e *PasskeyAttestationPolicySetEvent) {
+		if aaguids == nil {
+			aaguids = []string{}
+		}
+		e.AllowedAAGUIDs = &aaguids
+	}
+}
+
+func ChangePasskeyAttestationDeniedAAGUIDs(aaguids []string) func(*PasskeyAttestationPolicySetEvent) {
+	return func(e *PasskeyAttestationPolicySetEvent) {
+		if aaguids == nil {
+			aaguids = []string{}
+		}
+		e.DeniedAAGUIDs = &aaguids
+	}
+}
+
+func PasskeyAttestationPolicySetEventMapper(event eventstore.Event) (eventstore.Event, error) {
+	e := &PasskeyAttestationPolicySetEvent{
+		BaseEvent: *eventstore.BaseEventFromRepo(event),
+	}
+
+	err := event.Unmarshal(e)
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "POLIC-Pa7Ke", "unable to unmarshal policy")
+	}
+
+	return e, nil
+}
+
+type PasskeyAttestationPolicyRemovedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+}
+
+func (e *PasskeyAttestationPolicyRemovedEvent) Payload() interface{} {
+	return nil
+}
+
+func (e *PasskeyAttestationPolicyRemovedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func NewPasskeyAttestationPolicyRemovedEvent(base *eventstore.BaseEvent) *PasskeyAttestationPolicyRemovedEvent {
+	return &PasskeyAttestationPolicyRemovedEvent{
+		BaseEvent: *base,
+	}
+}
+
+func PasskeyAttestationPolicyRemovedEventMapper(event eventstore.Event) (eventstore.Event, error) {
+	return &PasskeyAttestationPolicyRemovedEvent{
+		BaseEvent: *eventstore.BaseEventFromRepo(event),
+	}, nil
+}
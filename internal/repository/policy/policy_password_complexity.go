@@ -14,11 +14,14 @@ const (
 type PasswordComplexityPolicyAddedEvent struct {
 	eventstore.BaseEvent `json:"-"`
 
-	MinLength    uint64 `json:"minLength,omitempty"`
-	HasLowercase bool   `json:"hasLowercase,omitempty"`
-	HasUppercase bool   `json:"hasUppercase,omitempty"`
-	HasNumber    bool   `json:"hasNumber,omitempty"`
-	HasSymbol    bool   `json:"hasSymbol,omitempty"`
+	MinLength           uint64 `json:"minLength,omitempty"`
+	HasLowercase        bool   `json:"hasLowercase,omitempty"`
+	HasUppercase        bool   `json:"hasUppercase,omitempty"`
+	HasNumber           bool   `json:"hasNumber,omitempty"`
+	HasSymbol           bool   `json:"hasSymbol,omitempty"`
+	HistoryDepth        uint64 `json:"historyDepth,omitempty"`
+	CheckBreached       bool   `json:"checkBreached,omitempty"`
+	ForceChangeBreached bool   `json:"forceChangeBreached,omitempty"`
 }
 
 func (e *PasswordComplexityPolicyAddedEvent) Payload() interface{} {
@@ -36,14 +39,20 @@ func NewPasswordComplexityPolicyAddedEvent(
 	hasUpperCase,
 	hasNumber,
 	hasSymbol bool,
+	historyDepth uint64,
+	checkBreached,
+	forceChangeBreached bool,
 ) *PasswordComplexityPolicyAddedEvent {
 	return &PasswordComplexityPolicyAddedEvent{
-		BaseEvent:    *base,
-		MinLength:    minLength,
-		HasLowercase: hasLowerCase,
-		HasUppercase: hasUpperCase,
-		HasNumber:    hasNumber,
-		HasSymbol:    hasSymbol,
+		BaseEvent:           *base,
+		MinLength:           minLength,
+		HasLowercase:        hasLowerCase,
+		HasUppercase:        hasUpperCase,
+		HasNumber:           hasNumber,
+		HasSymbol:           hasSymbol,
+		HistoryDepth:        historyDepth,
+		CheckBreached:       checkBreached,
+		ForceChangeBreached: forceChangeBreached,
 	}
 }
 
@@ -63,11 +72,14 @@ func PasswordComplexityPolicyAddedEventMapper(event eventstore.Event) (eventstor
 type PasswordComplexityPolicyChangedEvent struct {
 	eventstore.BaseEvent `json:"-"`
 
-	MinLength    *uint64 `json:"minLength,omitempty"`
-	HasLowercase *bool   `json:"hasLowercase,omitempty"`
-	HasUppercase *bool   `json:"hasUppercase,omitempty"`
-	HasNumber    *bool   `json:"hasNumber,omitempty"`
-	HasSymbol    *bool   `json:"hasSymbol,omitempty"`
+	MinLength           *uint64 `json:"minLength,omitempty"`
+	HasLowercase        *bool   `json:"hasLowercase,omitempty"`
+	HasUppercase        *bool   `json:"hasUppercase,omitempty"`
+	HasNumber           *bool   `json:"hasNumber,omitempty"`
+	HasSymbol           *bool   `json:"hasSymbol,omitempty"`
+	HistoryDepth        *uint64 `json:"historyDepth,omitempty"`
+	CheckBreached       *bool   `json:"checkBreached,omitempty"`
+	ForceChangeBreached *bool   `json:"forceChangeBreached,omitempty"`
 }
 
 func (e *PasswordComplexityPolicyChangedEvent) Payload() interface{} {
@@ -126,6 +138,24 @@ func ChangeHasSymbol(hasSymbol bool) func(*PasswordComplexityPolicyChangedEvent)
 	}
 }
 
+func ChangeHistoryDepth(historyDepth uint64) func(*PasswordComplexityPolicyChangedEvent) {
+	return func(e *PasswordComplexityPolicyChangedEvent) {
+		e.HistoryDepth = &historyDepth
+	}
+}
+
+func ChangeCheckBreached(checkBreached bool) func(*PasswordComplexityPolicyChangedEvent) {
+	return func(e *PasswordComplexityPolicyChangedEvent) {
+		e.CheckBreached = &checkBreached
+	}
+}
+
+func ChangeForceChangeBreached(forceChangeBreached bool) func(*PasswordComplexityPolicyChangedEvent) {
+	return func(e *PasswordComplexityPolicyChangedEvent) {
+		e.ForceChangeBreached = &forceChangeBreached
+	}
+}
+
 func PasswordComplexityPolicyChangedEventMapper(event eventstore.Event) (eventstore.Event, error) {
 	e := &PasswordComplexityPolicyChangedEvent{
 		BaseEvent: *eventstore.BaseEventFromRepo(event),
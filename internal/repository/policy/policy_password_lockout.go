@@ -1,6 +1,8 @@
 package policy
 
 import (
+	"time"
+
 	"github.com/zitadel/zitadel/internal/eventstore"
 	"github.com/zitadel/zitadel/internal/zerrors"
 )
@@ -17,6 +19,12 @@ type LockoutPolicyAddedEvent struct {
 	MaxPasswordAttempts uint64 `json:"maxPasswordAttempts,omitempty"`
 	MaxOTPAttempts      uint64 `json:"maxOTPAttempts,omitempty"`
 	ShowLockOutFailures bool   `json:"showLockOutFailures,omitempty"`
+
+	MaxIPAttempts         uint64        `json:"maxIPAttempts,omitempty"`
+	MaxClientAttempts     uint64        `json:"maxClientAttempts,omitempty"`
+	ThrottleWindow        time.Duration `json:"throttleWindow,omitempty"`
+	ThrottleDelay         time.Duration `json:"throttleDelay,omitempty"`
+	ThrottleBlockDuration time.Duration `json:"throttleBlockDuration,omitempty"`
 }
 
 func (e *LockoutPolicyAddedEvent) Payload() interface{} {
@@ -32,13 +40,23 @@ func NewLockoutPolicyAddedEvent(
 	maxPasswordAttempts,
 	maxOTPAttempts uint64,
 	showLockOutFailures bool,
+	maxIPAttempts,
+	maxClientAttempts uint64,
+	throttleWindow,
+	throttleDelay,
+	throttleBlockDuration time.Duration,
 ) *LockoutPolicyAddedEvent {
 
 	return &LockoutPolicyAddedEvent{
-		BaseEvent:           *base,
-		MaxPasswordAttempts: maxPasswordAttempts,
-		MaxOTPAttempts:      maxOTPAttempts,
-		ShowLockOutFailures: showLockOutFailures,
+		BaseEvent:             *base,
+		MaxPasswordAttempts:   maxPasswordAttempts,
+		MaxOTPAttempts:        maxOTPAttempts,
+		ShowLockOutFailures:   showLockOutFailures,
+		MaxIPAttempts:         maxIPAttempts,
+		MaxClientAttempts:     maxClientAttempts,
+		ThrottleWindow:        throttleWindow,
+		ThrottleDelay:         throttleDelay,
+		ThrottleBlockDuration: throttleBlockDuration,
 	}
 }
 
@@ -61,6 +79,12 @@ type LockoutPolicyChangedEvent struct {
 	MaxPasswordAttempts *uint64 `json:"maxPasswordAttempts,omitempty"`
 	MaxOTPAttempts      *uint64 `json:"maxOTPAttempts,omitempty"`
 	ShowLockOutFailures *bool   `json:"showLockOutFailures,omitempty"`
+
+	MaxIPAttempts         *uint64        `json:"maxIPAttempts,omitempty"`
+	MaxClientAttempts     *uint64        `json:"maxClientAttempts,omitempty"`
+	ThrottleWindow        *time.Duration `json:"throttleWindow,omitempty"`
+	ThrottleDelay         *time.Duration `json:"throttleDelay,omitempty"`
+	ThrottleBlockDuration *time.Duration `json:"throttleBlockDuration,omitempty"`
 }
 
 func (e *LockoutPolicyChangedEvent) Payload() interface{} {
@@ -107,6 +131,36 @@ func ChangeShowLockOutFailures(showLockOutFailures bool) func(*LockoutPolicyChan
 	}
 }
 
+func ChangeMaxIPAttempts(maxAttempts uint64) func(*LockoutPolicyChangedEvent) {
+	return func(e *LockoutPolicyChangedEvent) {
+		e.MaxIPAttempts = &maxAttempts
+	}
+}
+
+func ChangeMaxClientAttempts(maxAttempts uint64) func(*LockoutPolicyChangedEvent) {
+	return func(e *LockoutPolicyChangedEvent) {
+		e.MaxClientAttempts = &maxAttempts
+	}
+}
+
+func ChangeThrottleWindow(window time.Duration) func(*LockoutPolicyChangedEvent) {
+	return func(e *LockoutPolicyChangedEvent) {
+		e.ThrottleWindow = &window
+	}
+}
+
+func ChangeThrottleDelay(delay time.Duration) func(*LockoutPolicyChangedEvent) {
+	return func(e *LockoutPolicyChangedEvent) {
+		e.ThrottleDelay = &delay
+	}
+}
+
+func ChangeThrottleBlockDuration(blockDuration time.Duration) func(*LockoutPolicyChangedEvent) {
+	return func(e *LockoutPolicyChangedEvent) {
+		e.ThrottleBlockDuration = &blockDuration
+	}
+}
+
 func LockoutPolicyChangedEventMapper(event eventstore.Event) (eventstore.Event, error) {
 	e := &LockoutPolicyChangedEvent{
 		BaseEvent: *eventstore.BaseEventFromRepo(event),
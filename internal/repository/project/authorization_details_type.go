@@ -0,0 +1,122 @@
+package project
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/zitadel/zitadel/internal/eventstore"
+)
+
+const (
+	authorizationDetailsTypeEventTypePrefix = projectEventTypePrefix + "authorization_details_type."
+	AuthorizationDetailsTypeAddedType       = authorizationDetailsTypeEventTypePrefix + "added"
+	AuthorizationDetailsTypeChangedType     = authorizationDetailsTypeEventTypePrefix + "changed"
+	AuthorizationDetailsTypeRemovedType     = authorizationDetailsTypeEventTypePrefix + "removed"
+)
+
+// AuthorizationDetailsTypeAddedEvent is pushed when a type of authorization details (RFC 9396) was registered on the project.
+// Authorization details of the type requested by applications of the project are validated against the schema.
+type AuthorizationDetailsTypeAddedEvent struct {
+	*eventstore.BaseEvent `json:"-"`
+
+	DetailsType string          `json:"type"`
+	Schema      json.RawMessage `json:"schema"`
+}
+
+func NewAuthorizationDetailsTypeAddedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	detailsType string,
+	schema json.RawMessage,
+) *AuthorizationDetailsTypeAddedEvent {
+	return &AuthorizationDetailsTypeAddedEvent{
+		BaseEvent: eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			AuthorizationDetailsTypeAddedType,
+		),
+		DetailsType: detailsType,
+		Schema:      schema,
+	}
+}
+
+func (e *AuthorizationDetailsTypeAddedEvent) SetBaseEvent(b *eventstore.BaseEvent) {
+	e.BaseEvent = b
+}
+
+func (e *AuthorizationDetailsTypeAddedEvent) Payload() interface{} {
+	return e
+}
+
+func (e *AuthorizationDetailsTypeAddedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+type AuthorizationDetailsTypeChangedEvent struct {
+	*eventstore.BaseEvent `json:"-"`
+
+	DetailsType string          `json:"type"`
+	Schema      json.RawMessage `json:"schema"`
+}
+
+func NewAuthorizationDetailsTypeChangedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	detailsType string,
+	schema json.RawMessage,
+) *AuthorizationDetailsTypeChangedEvent {
+	return &AuthorizationDetailsTypeChangedEvent{
+		BaseEvent: eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			AuthorizationDetailsTypeChangedType,
+		),
+		DetailsType: detailsType,
+		Schema:      schema,
+	}
+}
+
+func (e *AuthorizationDetailsTypeChangedEvent) SetBaseEvent(b *eventstore.BaseEvent) {
+	e.BaseEvent = b
+}
+
+func (e *AuthorizationDetailsTypeChangedEvent) Payload() interface{} {
+	return e
+}
+
+func (e *AuthorizationDetailsTypeChangedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+type AuthorizationDetailsTypeRemovedEvent struct {
+	*eventstore.BaseEvent `json:"-"`
+
+	DetailsType string `json:"type"`
+}
+
+func NewAuthorizationDetailsTypeRemovedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	detailsType string,
+) *AuthorizationDetailsTypeRemovedEvent {
+	return &AuthorizationDetailsTypeRemovedEvent{
+		BaseEvent: eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			AuthorizationDetailsTypeRemovedType,
+		),
+		DetailsType: detailsType,
+	}
+}
+
+func (e *AuthorizationDetailsTypeRemovedEvent) SetBaseEvent(b *eventstore.BaseEvent) {
+	e.BaseEvent = b
+}
+
+func (e *AuthorizationDetailsTypeRemovedEvent) Payload() interface{} {
+	return e
+}
+
+func (e *AuthorizationDetailsTypeRemovedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
@@ -36,6 +36,7 @@ func init() {
 	eventstore.RegisterFilterEventMapper(AggregateType, OIDCConfigChangedType, OIDCConfigChangedEventMapper)
 	eventstore.RegisterFilterEventMapper(AggregateType, OIDCConfigSecretChangedType, OIDCConfigSecretChangedEventMapper)
 	eventstore.RegisterFilterEventMapper(AggregateType, OIDCConfigSecretHashUpdatedType, eventstore.GenericEventMapper[OIDCConfigSecretHashUpdatedEvent])
+	eventstore.RegisterFilterEventMapper(AggregateType, OIDCConfigRegisteredType, eventstore.GenericEventMapper[OIDCConfigRegisteredEvent])
 	eventstore.RegisterFilterEventMapper(AggregateType, APIConfigAddedType, APIConfigAddedEventMapper)
 	eventstore.RegisterFilterEventMapper(AggregateType, APIConfigChangedType, APIConfigChangedEventMapper)
 	eventstore.RegisterFilterEventMapper(AggregateType, APIConfigSecretChangedType, APIConfigSecretChangedEventMapper)
@@ -44,4 +45,9 @@ func init() {
 	eventstore.RegisterFilterEventMapper(AggregateType, ApplicationKeyRemovedEventType, ApplicationKeyRemovedEventMapper)
 	eventstore.RegisterFilterEventMapper(AggregateType, SAMLConfigAddedType, SAMLConfigAddedEventMapper)
 	eventstore.RegisterFilterEventMapper(AggregateType, SAMLConfigChangedType, SAMLConfigChangedEventMapper)
+	eventstore.RegisterFilterEventMapper(AggregateType, InitialAccessTokenAddedType, eventstore.GenericEventMapper[InitialAccessTokenAddedEvent])
+	eventstore.RegisterFilterEventMapper(AggregateType, InitialAccessTokenRemovedType, eventstore.GenericEventMapper[InitialAccessTokenRemovedEvent])
+	eventstore.RegisterFilterEventMapper(AggregateType, AuthorizationDetailsTypeAddedType, eventstore.GenericEventMapper[AuthorizationDetailsTypeAddedEvent])
+	eventstore.RegisterFilterEventMapper(AggregateType, AuthorizationDetailsTypeChangedType, eventstore.GenericEventMapper[AuthorizationDetailsTypeChangedEvent])
+	eventstore.RegisterFilterEventMapper(AggregateType, AuthorizationDetailsTypeRemovedType, eventstore.GenericEventMapper[AuthorizationDetailsTypeRemovedEvent])
 }
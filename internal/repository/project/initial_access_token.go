@@ -0,0 +1,88 @@
+package project
+
+import (
+	"context"
+	"time"
+
+	"github.com/zitadel/zitadel/internal/eventstore"
+)
+
+const (
+	initialAccessTokenEventTypePrefix = projectEventTypePrefix + "initial_access_token."
+	InitialAccessTokenAddedType       = initialAccessTokenEventTypePrefix + "added"
+	InitialAccessTokenRemovedType     = initialAccessTokenEventTypePrefix + "removed"
+)
+
+// InitialAccessTokenAddedEvent is pushed when an initial access token was created for the project.
+// The token allows the dynamic registration (RFC 7591) of OIDC applications in the project.
+type InitialAccessTokenAddedEvent struct {
+	*eventstore.BaseEvent `json:"-"`
+
+	TokenID        string    `json:"tokenId"`
+	HashedToken    string    `json:"hashedToken"`
+	ExpirationDate time.Time `json:"expirationDate"`
+}
+
+func NewInitialAccessTokenAddedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	tokenID,
+	hashedToken string,
+	expirationDate time.Time,
+) *InitialAccessTokenAddedEvent {
+	return &InitialAccessTokenAddedEvent{
+		BaseEvent: eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			InitialAccessTokenAddedType,
+		),
+		TokenID:        tokenID,
+		HashedToken:    hashedToken,
+		ExpirationDate: expirationDate,
+	}
+}
+
+func (e *InitialAccessTokenAddedEvent) SetBaseEvent(b *eventstore.BaseEvent) {
+	e.BaseEvent = b
+}
+
+func (e *InitialAccessTokenAddedEvent) Payload() interface{} {
+	return e
+}
+
+func (e *InitialAccessTokenAddedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+type InitialAccessTokenRemovedEvent struct {
+	*eventstore.BaseEvent `json:"-"`
+
+	TokenID string `json:"tokenId"`
+}
+
+func NewInitialAccessTokenRemovedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	tokenID string,
+) *InitialAccessTokenRemovedEvent {
+	return &InitialAccessTokenRemovedEvent{
+		BaseEvent: eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			InitialAccessTokenRemovedType,
+		),
+		TokenID: tokenID,
+	}
+}
+
+func (e *InitialAccessTokenRemovedEvent) SetBaseEvent(b *eventstore.BaseEvent) {
+	e.BaseEvent = b
+}
+
+func (e *InitialAccessTokenRemovedEvent) Payload() interface{} {
+	return e
+}
+
+func (e *InitialAccessTokenRemovedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
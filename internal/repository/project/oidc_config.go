@@ -15,6 +15,7 @@ const (
 	OIDCConfigChangedType           = applicationEventTypePrefix + "config.oidc.changed"
 	OIDCConfigSecretChangedType     = applicationEventTypePrefix + "config.oidc.secret.changed"
 	OIDCConfigSecretHashUpdatedType = applicationEventTypePrefix + "config.oidc.secret.updated"
+	OIDCConfigRegisteredType        = applicationEventTypePrefix + "config.oidc.registered"
 )
 
 type OIDCConfigAddedEvent struct {
@@ -29,23 +30,26 @@ type OIDCConfigAddedEvent struct {
 	ClientSecret *crypto.CryptoValue `json:"clientSecret,omitempty"`
 	HashedSecret string              `json:"hashedSecret,omitempty"`
 
-	RedirectUris             []string                   `json:"redirectUris,omitempty"`
-	ResponseTypes            []domain.OIDCResponseType  `json:"responseTypes,omitempty"`
-	GrantTypes               []domain.OIDCGrantType     `json:"grantTypes,omitempty"`
-	ApplicationType          domain.OIDCApplicationType `json:"applicationType,omitempty"`
-	AuthMethodType           domain.OIDCAuthMethodType  `json:"authMethodType,omitempty"`
-	PostLogoutRedirectUris   []string                   `json:"postLogoutRedirectUris,omitempty"`
-	DevMode                  bool                       `json:"devMode,omitempty"`
-	AccessTokenType          domain.OIDCTokenType       `json:"accessTokenType,omitempty"`
-	AccessTokenRoleAssertion bool                       `json:"accessTokenRoleAssertion,omitempty"`
-	IDTokenRoleAssertion     bool                       `json:"idTokenRoleAssertion,omitempty"`
-	IDTokenUserinfoAssertion bool                       `json:"idTokenUserinfoAssertion,omitempty"`
-	ClockSkew                time.Duration              `json:"clockSkew,omitempty"`
-	AdditionalOrigins        []string                   `json:"additionalOrigins,omitempty"`
-	SkipNativeAppSuccessPage bool                       `json:"skipNativeAppSuccessPage,omitempty"`
-	BackChannelLogoutURI     string                     `json:"backChannelLogoutURI,omitempty"`
-	LoginVersion             domain.LoginVersion        `json:"loginVersion,omitempty"`
-	LoginBaseURI             string                     `json:"loginBaseURI,omitempty"`
+	RedirectUris                     []string                   `json:"redirectUris,omitempty"`
+	ResponseTypes                    []domain.OIDCResponseType  `json:"responseTypes,omitempty"`
+	GrantTypes                       []domain.OIDCGrantType     `json:"grantTypes,omitempty"`
+	ApplicationType                  domain.OIDCApplicationType `json:"applicationType,omitempty"`
+	AuthMethodType                   domain.OIDCAuthMethodType  `json:"authMethodType,omitempty"`
+	PostLogoutRedirectUris           []string                   `json:"postLogoutRedirectUris,omitempty"`
+	DevMode                          bool                       `json:"devMode,omitempty"`
+	AccessTokenType                  domain.OIDCTokenType       `json:"accessTokenType,omitempty"`
+	AccessTokenRoleAssertion         bool                       `json:"accessTokenRoleAssertion,omitempty"`
+	IDTokenRoleAssertion             bool                       `json:"idTokenRoleAssertion,omitempty"`
+	IDTokenUserinfoAssertion         bool                       `json:"idTokenUserinfoAssertion,omitempty"`
+	ClockSkew                        time.Duration              `json:"clockSkew,omitempty"`
+	AdditionalOrigins                []string                   `json:"additionalOrigins,omitempty"`
+	SkipNativeAppSuccessPage         bool                       `json:"skipNativeAppSuccessPage,omitempty"`
+	BackChannelLogoutURI             string                     `json:"backChannelLogoutURI,omitempty"`
+	LoginVersion                     domain.LoginVersion        `json:"loginVersion,omitempty"`
+	LoginBaseURI                     string                     `json:"loginBaseURI,omitempty"`
+	RequirePushedAuthRequest         bool                       `json:"requirePushedAuthRequest,omitempty"`
+	RequireDPoP                      bool                       `json:"requireDPoP,omitempty"`
+	BackChannelClientNotificationURI string                     `json:"backChannelClientNotificationURI,omitempty"`
 }
 
 func (e *OIDCConfigAddedEvent) Payload() interface{} {
@@ -80,6 +84,9 @@ func NewOIDCConfigAddedEvent(
 	backChannelLogoutURI string,
 	loginVersion domain.LoginVersion,
 	loginBaseURI string,
+	requirePushedAuthRequest bool,
+	requireDPoP bool,
+	backChannelClientNotificationURI string,
 ) *OIDCConfigAddedEvent {
 	return &OIDCConfigAddedEvent{
 		BaseEvent: *eventstore.NewBaseEventForPush(
@@ -87,27 +94,30 @@ func NewOIDCConfigAddedEvent(
 			aggregate,
 			OIDCConfigAddedType,
 		),
-		Version:                  version,
-		AppID:                    appID,
-		ClientID:                 clientID,
-		HashedSecret:             hashedSecret,
-		RedirectUris:             redirectUris,
-		ResponseTypes:            responseTypes,
-		GrantTypes:               grantTypes,
-		ApplicationType:          applicationType,
-		AuthMethodType:           authMethodType,
-		PostLogoutRedirectUris:   postLogoutRedirectUris,
-		DevMode:                  devMode,
-		AccessTokenType:          accessTokenType,
-		AccessTokenRoleAssertion: accessTokenRoleAssertion,
-		IDTokenRoleAssertion:     idTokenRoleAssertion,
-		IDTokenUserinfoAssertion: idTokenUserinfoAssertion,
-		ClockSkew:                clockSkew,
-		AdditionalOrigins:        additionalOrigins,
-		SkipNativeAppSuccessPage: skipNativeAppSuccessPage,
-		BackChannelLogoutURI:     backChannelLogoutURI,
-		LoginVersion:             loginVersion,
-		LoginBaseURI:             loginBaseURI,
+		Version:                          version,
+		AppID:                            appID,
+		ClientID:                         clientID,
+		HashedSecret:                     hashedSecret,
+		RedirectUris:                     redirectUris,
+		ResponseTypes:                    responseTypes,
+		GrantTypes:                       grantTypes,
+		ApplicationType:                  applicationType,
+		AuthMethodType:                   authMethodType,
+		PostLogoutRedirectUris:           postLogoutRedirectUris,
+		DevMode:                          devMode,
+		AccessTokenType:                  accessTokenType,
+		AccessTokenRoleAssertion:         accessTokenRoleAssertion,
+		IDTokenRoleAssertion:             idTokenRoleAssertion,
+		IDTokenUserinfoAssertion:         idTokenUserinfoAssertion,
+		ClockSkew:                        clockSkew,
+		AdditionalOrigins:                additionalOrigins,
+		SkipNativeAppSuccessPage:         skipNativeAppSuccessPage,
+		BackChannelLogoutURI:             backChannelLogoutURI,
+		LoginVersion:                     loginVersion,
+		LoginBaseURI:                     loginBaseURI,
+		RequirePushedAuthRequest:         requirePushedAuthRequest,
+		RequireDPoP:                      requireDPoP,
+		BackChannelClientNotificationURI: backChannelClientNotificationURI,
 	}
 }
 
@@ -202,7 +212,16 @@ func (e *OIDCConfigAddedEvent) Validate(cmd eventstore.Command) bool {
 	if e.LoginVersion != c.LoginVersion {
 		return false
 	}
-	return e.LoginBaseURI == c.LoginBaseURI
+	if e.LoginBaseURI != c.LoginBaseURI {
+		return false
+	}
+	if e.RequirePushedAuthRequest != c.RequirePushedAuthRequest {
+		return false
+	}
+	if e.RequireDPoP != c.RequireDPoP {
+		return false
+	}
+	return e.BackChannelClientNotificationURI == c.BackChannelClientNotificationURI
 }
 
 func OIDCConfigAddedEventMapper(event eventstore.Event) (eventstore.Event, error) {
@@ -221,25 +240,28 @@ func OIDCConfigAddedEventMapper(event eventstore.Event) (eventstore.Event, error
 type OIDCConfigChangedEvent struct {
 	eventstore.BaseEvent `json:"-"`
 
-	Version                  *domain.OIDCVersion         `json:"oidcVersion,omitempty"`
-	AppID                    string                      `json:"appId"`
-	RedirectUris             *[]string                   `json:"redirectUris,omitempty"`
-	ResponseTypes            *[]domain.OIDCResponseType  `json:"responseTypes,omitempty"`
-	GrantTypes               *[]domain.OIDCGrantType     `json:"grantTypes,omitempty"`
-	ApplicationType          *domain.OIDCApplicationType `json:"applicationType,omitempty"`
-	AuthMethodType           *domain.OIDCAuthMethodType  `json:"authMethodType,omitempty"`
-	PostLogoutRedirectUris   *[]string                   `json:"postLogoutRedirectUris,omitempty"`
-	DevMode                  *bool                       `json:"devMode,omitempty"`
-	AccessTokenType          *domain.OIDCTokenType       `json:"accessTokenType,omitempty"`
-	AccessTokenRoleAssertion *bool                       `json:"accessTokenRoleAssertion,omitempty"`
-	IDTokenRoleAssertion     *bool                       `json:"idTokenRoleAssertion,omitempty"`
-	IDTokenUserinfoAssertion *bool                       `json:"idTokenUserinfoAssertion,omitempty"`
-	ClockSkew                *time.Duration              `json:"clockSkew,omitempty"`
-	AdditionalOrigins        *[]string                   `json:"additionalOrigins,omitempty"`
-	SkipNativeAppSuccessPage *bool                       `json:"skipNativeAppSuccessPage,omitempty"`
-	BackChannelLogoutURI     *string                     `json:"backChannelLogoutURI,omitempty"`
-	LoginVersion             *domain.LoginVersion        `json:"loginVersion,omitempty"`
-	LoginBaseURI             *string                     `json:"loginBaseURI,omitempty"`
+	Version                          *domain.OIDCVersion         `json:"oidcVersion,omitempty"`
+	AppID                            string                      `json:"appId"`
+	RedirectUris                     *[]string                   `json:"redirectUris,omitempty"`
+	ResponseTypes                    *[]domain.OIDCResponseType  `json:"responseTypes,omitempty"`
+	GrantTypes                       *[]domain.OIDCGrantType     `json:"grantTypes,omitempty"`
+	ApplicationType                  *domain.OIDCApplicationType `json:"applicationType,omitempty"`
+	AuthMethodType                   *domain.OIDCAuthMethodType  `json:"authMethodType,omitempty"`
+	PostLogoutRedirectUris           *[]string                   `json:"postLogoutRedirectUris,omitempty"`
+	DevMode                          *bool                       `json:"devMode,omitempty"`
+	AccessTokenType                  *domain.OIDCTokenType       `json:"accessTokenType,omitempty"`
+	AccessTokenRoleAssertion         *bool                       `json:"accessTokenRoleAssertion,omitempty"`
+	IDTokenRoleAssertion             *bool                       `json:"idTokenRoleAssertion,omitempty"`
+	IDTokenUserinfoAssertion         *bool                       `json:"idTokenUserinfoAssertion,omitempty"`
+	ClockSkew                        *time.Duration              `json:"clockSkew,omitempty"`
+	AdditionalOrigins                *[]string                   `json:"additionalOrigins,omitempty"`
+	SkipNativeAppSuccessPage         *bool                       `json:"skipNativeAppSuccessPage,omitempty"`
+	BackChannelLogoutURI             *string                     `json:"backChannelLogoutURI,omitempty"`
+	LoginVersion                     *domain.LoginVersion        `json:"loginVersion,omitempty"`
+	LoginBaseURI                     *string                     `json:"loginBaseURI,omitempty"`
+	RequirePushedAuthRequest         *bool                       `json:"requirePushedAuthRequest,omitempty"`
+	RequireDPoP                      *bool                       `json:"requireDPoP,omitempty"`
+	BackChannelClientNotificationURI *string                     `json:"backChannelClientNotificationURI,omitempty"`
 }
 
 func (e *OIDCConfigChangedEvent) Payload() interface{} {
@@ -396,6 +418,24 @@ func ChangeOIDCLoginBaseURI(loginBaseURI string) func(event *OIDCConfigChangedEv
 	}
 }
 
+func ChangeRequirePushedAuthRequest(requirePushedAuthRequest bool) func(event *OIDCConfigChangedEvent) {
+	return func(e *OIDCConfigChangedEvent) {
+		e.RequirePushedAuthRequest = &requirePushedAuthRequest
+	}
+}
+
+func ChangeRequireDPoP(requireDPoP bool) func(event *OIDCConfigChangedEvent) {
+	return func(e *OIDCConfigChangedEvent) {
+		e.RequireDPoP = &requireDPoP
+	}
+}
+
+func ChangeBackChannelClientNotificationURI(backChannelClientNotificationURI string) func(event *OIDCConfigChangedEvent) {
+	return func(e *OIDCConfigChangedEvent) {
+		e.BackChannelClientNotificationURI = &backChannelClientNotificationURI
+	}
+}
+
 func OIDCConfigChangedEventMapper(event eventstore.Event) (eventstore.Event, error) {
 	e := &OIDCConfigChangedEvent{
 		BaseEvent: *eventstore.BaseEventFromRepo(event),
@@ -493,3 +533,45 @@ func (e *OIDCConfigSecretHashUpdatedEvent) Payload() interface{} {
 func (e *OIDCConfigSecretHashUpdatedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
 	return nil
 }
+
+// OIDCConfigRegisteredEvent is pushed together with the [OIDCConfigAddedEvent]
+// if the application was registered dynamically (RFC 7591) using an initial access token.
+// The registration access token allows the client to manage its own configuration (RFC 7592).
+type OIDCConfigRegisteredEvent struct {
+	*eventstore.BaseEvent `json:"-"`
+
+	AppID                         string `json:"appId"`
+	InitialAccessTokenID          string `json:"initialAccessTokenId"`
+	HashedRegistrationAccessToken string `json:"hashedRegistrationAccessToken"`
+}
+
+func NewOIDCConfigRegisteredEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	appID,
+	initialAccessTokenID,
+	hashedRegistrationAccessToken string,
+) *OIDCConfigRegisteredEvent {
+	return &OIDCConfigRegisteredEvent{
+		BaseEvent: eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			OIDCConfigRegisteredType,
+		),
+		AppID:                         appID,
+		InitialAccessTokenID:          initialAccessTokenID,
+		HashedRegistrationAccessToken: hashedRegistrationAccessToken,
+	}
+}
+
+func (e *OIDCConfigRegisteredEvent) SetBaseEvent(b *eventstore.BaseEvent) {
+	e.BaseEvent = b
+}
+
+func (e *OIDCConfigRegisteredEvent) Payload() interface{} {
+	return e
+}
+
+func (e *OIDCConfigRegisteredEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
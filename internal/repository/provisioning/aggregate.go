@@ -0,0 +1,20 @@
+package provisioning
+
+import "github.com/zitadel/zitadel/internal/eventstore"
+
+const (
+	AggregateType    = "provisioning_target"
+	AggregateVersion = "v1"
+)
+
+// NewAggregate creates the aggregate of the provisioning target of an application,
+// the id of the aggregate is the id of the application.
+func NewAggregate(appID, resourceOwner, instanceID string) *eventstore.Aggregate {
+	return &eventstore.Aggregate{
+		ID:            appID,
+		Type:          AggregateType,
+		ResourceOwner: resourceOwner,
+		InstanceID:    instanceID,
+		Version:       AggregateVersion,
+	}
+}
@@ -0,0 +1,13 @@
+package provisioning
+
+import "github.com/zitadel/zitadel/internal/eventstore"
+
+func init() {
+	eventstore.RegisterFilterEventMapper(AggregateType, AddedEventType, eventstore.GenericEventMapper[AddedEvent])
+	eventstore.RegisterFilterEventMapper(AggregateType, ChangedEventType, eventstore.GenericEventMapper[ChangedEvent])
+	eventstore.RegisterFilterEventMapper(AggregateType, RemovedEventType, eventstore.GenericEventMapper[RemovedEvent])
+	eventstore.RegisterFilterEventMapper(AggregateType, UserCreationStartedEventType, eventstore.GenericEventMapper[UserCreationStartedEvent])
+	eventstore.RegisterFilterEventMapper(AggregateType, UserCreationCanceledEventType, eventstore.GenericEventMapper[UserCreationCanceledEvent])
+	eventstore.RegisterFilterEventMapper(AggregateType, UserSyncSucceededEventType, eventstore.GenericEventMapper[UserSyncSucceededEvent])
+	eventstore.RegisterFilterEventMapper(AggregateType, UserSyncFailedEventType, eventstore.GenericEventMapper[UserSyncFailedEvent])
+}
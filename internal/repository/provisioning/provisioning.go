@@ -0,0 +1,294 @@
+package provisioning
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/crypto"
+	"github.com/zitadel/zitadel/internal/eventstore"
+)
+
+const (
+	eventTypePrefix               eventstore.EventType = "provisioning_target."
+	AddedEventType                                     = eventTypePrefix + "added"
+	ChangedEventType                                   = eventTypePrefix + "changed"
+	RemovedEventType                                   = eventTypePrefix + "removed"
+	UserCreationStartedEventType                       = eventTypePrefix + "user.creation.started"
+	UserCreationCanceledEventType                      = eventTypePrefix + "user.creation.canceled"
+	UserSyncSucceededEventType                         = eventTypePrefix + "user.sync.succeeded"
+	UserSyncFailedEventType                            = eventTypePrefix + "user.sync.failed"
+
+	UniqueUserCreationType = "provisioning_user_creation"
+)
+
+// NewAddUserCreationUniqueConstraint prevents concurrent synchronizations from creating the user twice on the target.
+func NewAddUserCreationUniqueConstraint(targetID, userID string) *eventstore.UniqueConstraint {
+	return eventstore.NewAddEventUniqueConstraint(
+		UniqueUserCreationType,
+		targetID+":"+userID,
+		"Errors.ProvisioningTarget.UserCreationInProgress",
+	)
+}
+
+func NewRemoveUserCreationUniqueConstraint(targetID, userID string) *eventstore.UniqueConstraint {
+	return eventstore.NewRemoveUniqueConstraint(
+		UniqueUserCreationType,
+		targetID+":"+userID,
+	)
+}
+
+type AddedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	ProjectID string              `json:"projectId"`
+	Endpoint  string              `json:"endpoint"`
+	Token     *crypto.CryptoValue `json:"token"`
+}
+
+func (e *AddedEvent) SetBaseEvent(b *eventstore.BaseEvent) {
+	e.BaseEvent = *b
+}
+
+func (e *AddedEvent) Payload() any {
+	return e
+}
+
+func (e *AddedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func NewAddedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	projectID string,
+	endpoint string,
+	token *crypto.CryptoValue,
+) *AddedEvent {
+	return &AddedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx, aggregate, AddedEventType,
+		),
+		ProjectID: projectID,
+		Endpoint:  endpoint,
+		Token:     token,
+	}
+}
+
+type ChangedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	Endpoint *string             `json:"endpoint,omitempty"`
+	Token    *crypto.CryptoValue `json:"token,omitempty"`
+}
+
+func (e *ChangedEvent) SetBaseEvent(b *eventstore.BaseEvent) {
+	e.BaseEvent = *b
+}
+
+func (e *ChangedEvent) Payload() any {
+	return e
+}
+
+func (e *ChangedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func NewChangedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	changes []Changes,
+) *ChangedEvent {
+	changeEvent := &ChangedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx, aggregate, ChangedEventType,
+		),
+	}
+	for _, change := range changes {
+		change(changeEvent)
+	}
+	return changeEvent
+}
+
+type Changes func(event *ChangedEvent)
+
+func ChangeEndpoint(endpoint string) func(event *ChangedEvent) {
+	return func(e *ChangedEvent) {
+		e.Endpoint = &endpoint
+	}
+}
+
+func ChangeToken(token *crypto.CryptoValue) func(event *ChangedEvent) {
+	return func(e *ChangedEvent) {
+		e.Token = token
+	}
+}
+
+type RemovedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+}
+
+func (e *RemovedEvent) SetBaseEvent(b *eventstore.BaseEvent) {
+	e.BaseEvent = *b
+}
+
+func (e *RemovedEvent) Payload() any {
+	return e
+}
+
+func (e *RemovedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func NewRemovedEvent(ctx context.Context, aggregate *eventstore.Aggregate) *RemovedEvent {
+	return &RemovedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx, aggregate, RemovedEventType,
+		),
+	}
+}
+
+// UserCreationStartedEvent is pushed before the user is created on the target.
+// Until the creation is canceled or the synchronization is recorded, no other synchronization can create the user.
+type UserCreationStartedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	UserID string `json:"userId"`
+}
+
+func (e *UserCreationStartedEvent) SetBaseEvent(b *eventstore.BaseEvent) {
+	e.BaseEvent = *b
+}
+
+func (e *UserCreationStartedEvent) Payload() any {
+	return e
+}
+
+func (e *UserCreationStartedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return []*eventstore.UniqueConstraint{NewAddUserCreationUniqueConstraint(e.Aggregate().ID, e.UserID)}
+}
+
+func NewUserCreationStartedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	userID string,
+) *UserCreationStartedEvent {
+	return &UserCreationStartedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx, aggregate, UserCreationStartedEventType,
+		),
+		UserID: userID,
+	}
+}
+
+// UserCreationCanceledEvent is pushed if the user could not be created on the target,
+// so the creation can be retried.
+type UserCreationCanceledEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	UserID string `json:"userId"`
+}
+
+func (e *UserCreationCanceledEvent) SetBaseEvent(b *eventstore.BaseEvent) {
+	e.BaseEvent = *b
+}
+
+func (e *UserCreationCanceledEvent) Payload() any {
+	return e
+}
+
+func (e *UserCreationCanceledEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return []*eventstore.UniqueConstraint{NewRemoveUserCreationUniqueConstraint(e.Aggregate().ID, e.UserID)}
+}
+
+func NewUserCreationCanceledEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	userID string,
+) *UserCreationCanceledEvent {
+	return &UserCreationCanceledEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx, aggregate, UserCreationCanceledEventType,
+		),
+		UserID: userID,
+	}
+}
+
+// UserSyncSucceededEvent is pushed after the user was successfully provisioned to (or deprovisioned from) the target.
+type UserSyncSucceededEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	UserID string `json:"userId"`
+	// RemoteID is the id of the user on the provisioning target,
+	// it is empty if the user was deprovisioned.
+	RemoteID string `json:"remoteId,omitempty"`
+	// TriggerEventType is the type of the event which triggered the synchronization.
+	TriggerEventType eventstore.EventType `json:"triggerEventType"`
+}
+
+func (e *UserSyncSucceededEvent) SetBaseEvent(b *eventstore.BaseEvent) {
+	e.BaseEvent = *b
+}
+
+func (e *UserSyncSucceededEvent) Payload() any {
+	return e
+}
+
+// UniqueConstraints ends a started creation of the user, e.g. if a previous attempt could not record the synchronization.
+func (e *UserSyncSucceededEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return []*eventstore.UniqueConstraint{NewRemoveUserCreationUniqueConstraint(e.Aggregate().ID, e.UserID)}
+}
+
+func NewUserSyncSucceededEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	userID string,
+	remoteID string,
+	triggerEventType eventstore.EventType,
+) *UserSyncSucceededEvent {
+	return &UserSyncSucceededEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx, aggregate, UserSyncSucceededEventType,
+		),
+		UserID:           userID,
+		RemoteID:         remoteID,
+		TriggerEventType: triggerEventType,
+	}
+}
+
+// UserSyncFailedEvent is pushed after all attempts to synchronize the user to the target failed.
+type UserSyncFailedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	UserID           string               `json:"userId"`
+	TriggerEventType eventstore.EventType `json:"triggerEventType"`
+	Error            string               `json:"error"`
+}
+
+func (e *UserSyncFailedEvent) SetBaseEvent(b *eventstore.BaseEvent) {
+	e.BaseEvent = *b
+}
+
+func (e *UserSyncFailedEvent) Payload() any {
+	return e
+}
+
+// UniqueConstraints ends a started creation of the user, e.g. if an attempt was aborted before it could cancel the creation.
+func (e *UserSyncFailedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return []*eventstore.UniqueConstraint{NewRemoveUserCreationUniqueConstraint(e.Aggregate().ID, e.UserID)}
+}
+
+func NewUserSyncFailedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	userID string,
+	triggerEventType eventstore.EventType,
+	err error,
+) *UserSyncFailedEvent {
+	return &UserSyncFailedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx, aggregate, UserSyncFailedEventType,
+		),
+		UserID:           userID,
+		TriggerEventType: triggerEventType,
+		Error:            err.Error(),
+	}
+}
@@ -0,0 +1,23 @@
+package provisioning
+
+import (
+	"github.com/zitadel/zitadel/internal/eventstore"
+)
+
+const (
+	QueueName = "provisioning"
+)
+
+// Request is the job to synchronize the current state of a user to a provisioning target.
+type Request struct {
+	// Aggregate is the aggregate of the provisioning target
+	Aggregate *eventstore.Aggregate `json:"aggregate"`
+	UserID    string                `json:"userID"`
+	// TriggerEventType is the type of the event which triggered the synchronization
+	TriggerEventType eventstore.EventType `json:"triggerEventType"`
+	TriggerSequence  uint64               `json:"triggerSequence"`
+}
+
+func (e *Request) Kind() string {
+	return "provisioning_request"
+}
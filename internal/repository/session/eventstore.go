@@ -16,8 +16,17 @@ func init() {
 	eventstore.RegisterFilterEventMapper(AggregateType, OTPEmailChallengedType, eventstore.GenericEventMapper[OTPEmailChallengedEvent])
 	eventstore.RegisterFilterEventMapper(AggregateType, OTPEmailSentType, eventstore.GenericEventMapper[OTPEmailSentEvent])
 	eventstore.RegisterFilterEventMapper(AggregateType, OTPEmailCheckedType, eventstore.GenericEventMapper[OTPEmailCheckedEvent])
+	eventstore.RegisterFilterEventMapper(AggregateType, TrustedDeviceCheckedType, eventstore.GenericEventMapper[TrustedDeviceCheckedEvent])
+	eventstore.RegisterFilterEventMapper(AggregateType, RecoveryCodeCheckedType, eventstore.GenericEventMapper[RecoveryCodeCheckedEvent])
+	eventstore.RegisterFilterEventMapper(AggregateType, ClientCertificateCheckedType, eventstore.GenericEventMapper[ClientCertificateCheckedEvent])
+	eventstore.RegisterFilterEventMapper(AggregateType, MagicLinkChallengedType, eventstore.GenericEventMapper[MagicLinkChallengedEvent])
+	eventstore.RegisterFilterEventMapper(AggregateType, MagicLinkSentType, eventstore.GenericEventMapper[MagicLinkSentEvent])
+	eventstore.RegisterFilterEventMapper(AggregateType, MagicLinkCheckedType, eventstore.GenericEventMapper[MagicLinkCheckedEvent])
+	eventstore.RegisterFilterEventMapper(AggregateType, MagicLinkCheckFailedType, eventstore.GenericEventMapper[MagicLinkCheckFailedEvent])
 	eventstore.RegisterFilterEventMapper(AggregateType, TokenSetType, TokenSetEventMapper)
 	eventstore.RegisterFilterEventMapper(AggregateType, MetadataSetType, MetadataSetEventMapper)
 	eventstore.RegisterFilterEventMapper(AggregateType, LifetimeSetType, eventstore.GenericEventMapper[LifetimeSetEvent])
+	eventstore.RegisterFilterEventMapper(AggregateType, RiskEvaluatedType, eventstore.GenericEventMapper[RiskEvaluatedEvent])
+	eventstore.RegisterFilterEventMapper(AggregateType, RiskOriginVerifiedType, eventstore.GenericEventMapper[RiskOriginVerifiedEvent])
 	eventstore.RegisterFilterEventMapper(AggregateType, TerminateType, TerminateEventMapper)
 }
@@ -15,24 +15,33 @@ import (
 )
 
 const (
-	sessionEventPrefix     = "session."
-	AddedType              = sessionEventPrefix + "added"
-	UserCheckedType        = sessionEventPrefix + "user.checked"
-	PasswordCheckedType    = sessionEventPrefix + "password.checked"
-	IntentCheckedType      = sessionEventPrefix + "intent.checked"
-	WebAuthNChallengedType = sessionEventPrefix + "webAuthN.challenged"
-	WebAuthNCheckedType    = sessionEventPrefix + "webAuthN.checked"
-	TOTPCheckedType        = sessionEventPrefix + "totp.checked"
-	OTPSMSChallengedType   = sessionEventPrefix + "otp.sms.challenged"
-	OTPSMSSentType         = sessionEventPrefix + "otp.sms.sent"
-	OTPSMSCheckedType      = sessionEventPrefix + "otp.sms.checked"
-	OTPEmailChallengedType = sessionEventPrefix + "otp.email.challenged"
-	OTPEmailSentType       = sessionEventPrefix + "otp.email.sent"
-	OTPEmailCheckedType    = sessionEventPrefix + "otp.email.checked"
-	TokenSetType           = sessionEventPrefix + "token.set"
-	MetadataSetType        = sessionEventPrefix + "metadata.set"
-	LifetimeSetType        = sessionEventPrefix + "lifetime.set"
-	TerminateType          = sessionEventPrefix + "terminated"
+	sessionEventPrefix           = "session."
+	AddedType                    = sessionEventPrefix + "added"
+	UserCheckedType              = sessionEventPrefix + "user.checked"
+	PasswordCheckedType          = sessionEventPrefix + "password.checked"
+	IntentCheckedType            = sessionEventPrefix + "intent.checked"
+	WebAuthNChallengedType       = sessionEventPrefix + "webAuthN.challenged"
+	WebAuthNCheckedType          = sessionEventPrefix + "webAuthN.checked"
+	TOTPCheckedType              = sessionEventPrefix + "totp.checked"
+	OTPSMSChallengedType         = sessionEventPrefix + "otp.sms.challenged"
+	OTPSMSSentType               = sessionEventPrefix + "otp.sms.sent"
+	OTPSMSCheckedType            = sessionEventPrefix + "otp.sms.checked"
+	OTPEmailChallengedType       = sessionEventPrefix + "otp.email.challenged"
+	OTPEmailSentType             = sessionEventPrefix + "otp.email.sent"
+	OTPEmailCheckedType          = sessionEventPrefix + "otp.email.checked"
+	TrustedDeviceCheckedType     = sessionEventPrefix + "trusted.device.checked"
+	RecoveryCodeCheckedType      = sessionEventPrefix + "recovery.code.checked"
+	ClientCertificateCheckedType = sessionEventPrefix + "client.certificate.checked"
+	MagicLinkChallengedType      = sessionEventPrefix + "magiclink.challenged"
+	MagicLinkSentType            = sessionEventPrefix + "magiclink.sent"
+	MagicLinkCheckedType         = sessionEventPrefix + "magiclink.checked"
+	MagicLinkCheckFailedType     = sessionEventPrefix + "magiclink.check.failed"
+	TokenSetType                 = sessionEventPrefix + "token.set"
+	MetadataSetType              = sessionEventPrefix + "metadata.set"
+	LifetimeSetType              = sessionEventPrefix + "lifetime.set"
+	RiskEvaluatedType            = sessionEventPrefix + "risk.evaluated"
+	RiskOriginVerifiedType       = sessionEventPrefix + "risk.origin.verified"
+	TerminateType                = sessionEventPrefix + "terminated"
 )
 
 type AddedEvent struct {
@@ -542,6 +551,269 @@ func NewOTPEmailCheckedEvent(
 	}
 }
 
+type TrustedDeviceCheckedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	CheckedAt time.Time `json:"checkedAt"`
+	DeviceID  string    `json:"deviceId"`
+}
+
+func (e *TrustedDeviceCheckedEvent) Payload() interface{} {
+	return e
+}
+
+func (e *TrustedDeviceCheckedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func (e *TrustedDeviceCheckedEvent) SetBaseEvent(base *eventstore.BaseEvent) {
+	e.BaseEvent = *base
+}
+
+func NewTrustedDeviceCheckedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	checkedAt time.Time,
+	deviceID string,
+) *TrustedDeviceCheckedEvent {
+	return &TrustedDeviceCheckedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			TrustedDeviceCheckedType,
+		),
+		CheckedAt: checkedAt,
+		DeviceID:  deviceID,
+	}
+}
+
+type RecoveryCodeCheckedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	CheckedAt time.Time `json:"checkedAt"`
+}
+
+func (e *RecoveryCodeCheckedEvent) Payload() interface{} {
+	return e
+}
+
+func (e *RecoveryCodeCheckedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func (e *RecoveryCodeCheckedEvent) SetBaseEvent(base *eventstore.BaseEvent) {
+	e.BaseEvent = *base
+}
+
+func NewRecoveryCodeCheckedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	checkedAt time.Time,
+) *RecoveryCodeCheckedEvent {
+	return &RecoveryCodeCheckedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			RecoveryCodeCheckedType,
+		),
+		CheckedAt: checkedAt,
+	}
+}
+
+type ClientCertificateCheckedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	CheckedAt    time.Time `json:"checkedAt"`
+	Fingerprint  string    `json:"fingerprint"`
+	Subject      string    `json:"subject"`
+	Issuer       string    `json:"issuer"`
+	SerialNumber string    `json:"serialNumber"`
+}
+
+func (e *ClientCertificateCheckedEvent) Payload() interface{} {
+	return e
+}
+
+func (e *ClientCertificateCheckedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func (e *ClientCertificateCheckedEvent) SetBaseEvent(base *eventstore.BaseEvent) {
+	e.BaseEvent = *base
+}
+
+func NewClientCertificateCheckedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	checkedAt time.Time,
+	fingerprint,
+	subject,
+	issuer,
+	serialNumber string,
+) *ClientCertificateCheckedEvent {
+	return &ClientCertificateCheckedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			ClientCertificateCheckedType,
+		),
+		CheckedAt:    checkedAt,
+		Fingerprint:  fingerprint,
+		Subject:      subject,
+		Issuer:       issuer,
+		SerialNumber: serialNumber,
+	}
+}
+
+// MagicLinkChallengedEvent creates a single-use code, which is sent to the verified email of the user as login link.
+// The code is bound to the auth request and the user agent (fingerprint) of the session.
+type MagicLinkChallengedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	Code              *crypto.CryptoValue `json:"code"`
+	Expiry            time.Duration       `json:"expiry"`
+	ReturnCode        bool                `json:"returnCode,omitempty"`
+	URLTmpl           string              `json:"urlTmpl,omitempty"`
+	AuthRequestID     string              `json:"authRequestID,omitempty"`
+	UserAgentID       string              `json:"userAgentID,omitempty"`
+	TriggeredAtOrigin string              `json:"triggerOrigin,omitempty"`
+}
+
+func (e *MagicLinkChallengedEvent) Payload() interface{} {
+	return e
+}
+
+func (e *MagicLinkChallengedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func (e *MagicLinkChallengedEvent) SetBaseEvent(base *eventstore.BaseEvent) {
+	e.BaseEvent = *base
+}
+
+func (e *MagicLinkChallengedEvent) TriggerOrigin() string {
+	return e.TriggeredAtOrigin
+}
+
+func NewMagicLinkChallengedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	code *crypto.CryptoValue,
+	expiry time.Duration,
+	returnCode bool,
+	urlTmpl,
+	authRequestID,
+	userAgentID string,
+) *MagicLinkChallengedEvent {
+	return &MagicLinkChallengedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			MagicLinkChallengedType,
+		),
+		Code:              code,
+		Expiry:            expiry,
+		ReturnCode:        returnCode,
+		URLTmpl:           urlTmpl,
+		AuthRequestID:     authRequestID,
+		UserAgentID:       userAgentID,
+		TriggeredAtOrigin: http.DomainContext(ctx).Origin(),
+	}
+}
+
+type MagicLinkSentEvent struct {
+	eventstore.BaseEvent `json:"-"`
+}
+
+func (e *MagicLinkSentEvent) Payload() interface{} {
+	return e
+}
+
+func (e *MagicLinkSentEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func (e *MagicLinkSentEvent) SetBaseEvent(base *eventstore.BaseEvent) {
+	e.BaseEvent = *base
+}
+
+func NewMagicLinkSentEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+) *MagicLinkSentEvent {
+	return &MagicLinkSentEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			MagicLinkSentType,
+		),
+	}
+}
+
+type MagicLinkCheckedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	CheckedAt time.Time `json:"checkedAt"`
+}
+
+func (e *MagicLinkCheckedEvent) Payload() interface{} {
+	return e
+}
+
+func (e *MagicLinkCheckedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func (e *MagicLinkCheckedEvent) SetBaseEvent(base *eventstore.BaseEvent) {
+	e.BaseEvent = *base
+}
+
+func NewMagicLinkCheckedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	checkedAt time.Time,
+) *MagicLinkCheckedEvent {
+	return &MagicLinkCheckedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			MagicLinkCheckedType,
+		),
+		CheckedAt: checkedAt,
+	}
+}
+
+// MagicLinkCheckFailedEvent invalidates the magic link challenge,
+// since the link can only be used once, regardless of the outcome.
+type MagicLinkCheckFailedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+}
+
+func (e *MagicLinkCheckFailedEvent) Payload() interface{} {
+	return e
+}
+
+func (e *MagicLinkCheckFailedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func (e *MagicLinkCheckFailedEvent) SetBaseEvent(base *eventstore.BaseEvent) {
+	e.BaseEvent = *base
+}
+
+func NewMagicLinkCheckFailedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+) *MagicLinkCheckFailedEvent {
+	return &MagicLinkCheckFailedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			MagicLinkCheckFailedType,
+		),
+	}
+}
+
 type TokenSetEvent struct {
 	eventstore.BaseEvent `json:"-"`
 
@@ -694,3 +966,111 @@ func TerminateEventMapper(event eventstore.Event) (eventstore.Event, error) {
 		BaseEvent: *eventstore.BaseEventFromRepo(event),
 	}, nil
 }
+
+type RiskEvaluatedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	// UserID of the evaluated session,
+	// the history of the user is queried from the [RiskOriginVerifiedEvent]s.
+	UserID           string                  `json:"userID"`
+	EvaluatedAt      time.Time               `json:"evaluatedAt"`
+	Level            domain.SessionRiskLevel `json:"level"`
+	NewDevice        bool                    `json:"newDevice,omitempty"`
+	NewCountry       bool                    `json:"newCountry,omitempty"`
+	ImpossibleTravel bool                    `json:"impossibleTravel,omitempty"`
+	// StepUpRequired is set if the login policy requires a second factor for the risk level.
+	StepUpRequired bool                `json:"stepUpRequired,omitempty"`
+	DeviceID       string              `json:"deviceID,omitempty"`
+	Country        string              `json:"country,omitempty"`
+	Location       *domain.GeoLocation `json:"location,omitempty"`
+}
+
+func (e *RiskEvaluatedEvent) Payload() interface{} {
+	return e
+}
+
+func (e *RiskEvaluatedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func (e *RiskEvaluatedEvent) SetBaseEvent(base *eventstore.BaseEvent) {
+	e.BaseEvent = *base
+}
+
+func NewRiskEvaluatedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	userID string,
+	evaluatedAt time.Time,
+	risk *domain.SessionRisk,
+	stepUpRequired bool,
+	deviceID string,
+	location *domain.GeoLocation,
+) *RiskEvaluatedEvent {
+	return &RiskEvaluatedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			RiskEvaluatedType,
+		),
+		UserID:           userID,
+		EvaluatedAt:      evaluatedAt,
+		Level:            risk.Level,
+		NewDevice:        risk.NewDevice,
+		NewCountry:       risk.NewCountry,
+		ImpossibleTravel: risk.ImpossibleTravel,
+		StepUpRequired:   stepUpRequired,
+		DeviceID:         deviceID,
+		Country:          risk.Country,
+		Location:         location,
+	}
+}
+
+// RiskOriginVerifiedEvent is pushed once the user of the session authenticated with a password, passkey or identity provider.
+// Only the origins of such sessions are part of the history a new session is compared to,
+// so checking the user alone can't make a device or country known.
+type RiskOriginVerifiedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	// UserID allows to query the verified origins of the recent sessions of the user.
+	UserID     string              `json:"userID"`
+	ObservedAt time.Time           `json:"observedAt"`
+	DeviceID   string              `json:"deviceID,omitempty"`
+	Country    string              `json:"country,omitempty"`
+	Location   *domain.GeoLocation `json:"location,omitempty"`
+}
+
+func (e *RiskOriginVerifiedEvent) Payload() interface{} {
+	return e
+}
+
+func (e *RiskOriginVerifiedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func (e *RiskOriginVerifiedEvent) SetBaseEvent(base *eventstore.BaseEvent) {
+	e.BaseEvent = *base
+}
+
+func NewRiskOriginVerifiedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	userID string,
+	observedAt time.Time,
+	deviceID string,
+	country string,
+	location *domain.GeoLocation,
+) *RiskOriginVerifiedEvent {
+	return &RiskOriginVerifiedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			RiskOriginVerifiedType,
+		),
+		UserID:     userID,
+		ObservedAt: observedAt,
+		DeviceID:   deviceID,
+		Country:    country,
+		Location:   location,
+	}
+}
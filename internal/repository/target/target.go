@@ -19,12 +19,16 @@ const (
 type AddedEvent struct {
 	eventstore.BaseEvent `json:"-"`
 
-	Name             string              `json:"name"`
-	TargetType       domain.TargetType   `json:"targetType"`
-	Endpoint         string              `json:"endpoint"`
-	Timeout          time.Duration       `json:"timeout"`
-	InterruptOnError bool                `json:"interruptOnError"`
-	SigningKey       *crypto.CryptoValue `json:"signingKey"`
+	Name             string                        `json:"name"`
+	TargetType       domain.TargetType             `json:"targetType"`
+	Transport        domain.TargetTransport        `json:"transport,omitempty"`
+	Endpoint         string                        `json:"endpoint"`
+	Timeout          time.Duration                 `json:"timeout"`
+	InterruptOnError bool                          `json:"interruptOnError"`
+	SigningKey       *crypto.CryptoValue           `json:"signingKey"`
+	SigningAlgorithm domain.TargetSigningAlgorithm `json:"signingAlgorithm,omitempty"`
+	// PublicKey is only set for Ed25519, where the SigningKey is the private key.
+	PublicKey []byte `json:"publicKey,omitempty"`
 }
 
 func (e *AddedEvent) SetBaseEvent(b *eventstore.BaseEvent) {
@@ -44,27 +48,38 @@ func NewAddedEvent(
 	aggregate *eventstore.Aggregate,
 	name string,
 	targetType domain.TargetType,
+	transport domain.TargetTransport,
 	endpoint string,
 	timeout time.Duration,
 	interruptOnError bool,
 	signingKey *crypto.CryptoValue,
+	signingAlgorithm domain.TargetSigningAlgorithm,
+	publicKey []byte,
 ) *AddedEvent {
 	return &AddedEvent{
 		*eventstore.NewBaseEventForPush(
 			ctx, aggregate, AddedEventType,
 		),
-		name, targetType, endpoint, timeout, interruptOnError, signingKey}
+		name, targetType, transport, endpoint, timeout, interruptOnError, signingKey, signingAlgorithm, publicKey}
 }
 
 type ChangedEvent struct {
 	eventstore.BaseEvent `json:"-"`
 
-	Name             *string             `json:"name,omitempty"`
-	TargetType       *domain.TargetType  `json:"targetType,omitempty"`
-	Endpoint         *string             `json:"endpoint,omitempty"`
-	Timeout          *time.Duration      `json:"timeout,omitempty"`
-	InterruptOnError *bool               `json:"interruptOnError,omitempty"`
-	SigningKey       *crypto.CryptoValue `json:"signingKey,omitempty"`
+	Name             *string                        `json:"name,omitempty"`
+	TargetType       *domain.TargetType             `json:"targetType,omitempty"`
+	Transport        *domain.TargetTransport        `json:"transport,omitempty"`
+	Endpoint         *string                        `json:"endpoint,omitempty"`
+	Timeout          *time.Duration                 `json:"timeout,omitempty"`
+	InterruptOnError *bool                          `json:"interruptOnError,omitempty"`
+	SigningKey       *crypto.CryptoValue            `json:"signingKey,omitempty"`
+	SigningAlgorithm *domain.TargetSigningAlgorithm `json:"signingAlgorithm,omitempty"`
+	PublicKey        []byte                         `json:"publicKey,omitempty"`
+	// NextSigningKey is used to sign the payload together with the SigningKey until it's activated,
+	// to allow the receivers of the target to install the next key before the rotation.
+	// A new SigningKey replaces the next key, either by activating or by discarding it.
+	NextSigningKey *crypto.CryptoValue `json:"nextSigningKey,omitempty"`
+	NextPublicKey  []byte              `json:"nextPublicKey,omitempty"`
 
 	oldName string
 }
@@ -120,6 +135,12 @@ func ChangeTargetType(targetType domain.TargetType) func(event *ChangedEvent) {
 	}
 }
 
+func ChangeTransport(transport domain.TargetTransport) func(event *ChangedEvent) {
+	return func(e *ChangedEvent) {
+		e.Transport = &transport
+	}
+}
+
 func ChangeEndpoint(endpoint string) func(event *ChangedEvent) {
 	return func(e *ChangedEvent) {
 		e.Endpoint = &endpoint
@@ -144,6 +165,25 @@ func ChangeSigningKey(signingKey *crypto.CryptoValue) func(event *ChangedEvent)
 	}
 }
 
+func ChangeSigningAlgorithm(signingAlgorithm domain.TargetSigningAlgorithm) func(event *ChangedEvent) {
+	return func(e *ChangedEvent) {
+		e.SigningAlgorithm = &signingAlgorithm
+	}
+}
+
+func ChangePublicKey(publicKey []byte) func(event *ChangedEvent) {
+	return func(e *ChangedEvent) {
+		e.PublicKey = publicKey
+	}
+}
+
+func ChangeNextSigningKey(signingKey *crypto.CryptoValue, publicKey []byte) func(event *ChangedEvent) {
+	return func(e *ChangedEvent) {
+		e.NextSigningKey = signingKey
+		e.NextPublicKey = publicKey
+	}
+}
+
 type RemovedEvent struct {
 	eventstore.BaseEvent `json:"-"`
 
@@ -65,6 +65,7 @@ func init() {
 	eventstore.RegisterFilterEventMapper(AggregateType, HumanPasswordCheckSucceededType, HumanPasswordCheckSucceededEventMapper)
 	eventstore.RegisterFilterEventMapper(AggregateType, HumanPasswordCheckFailedType, HumanPasswordCheckFailedEventMapper)
 	eventstore.RegisterFilterEventMapper(AggregateType, HumanPasswordHashUpdatedType, eventstore.GenericEventMapper[HumanPasswordHashUpdatedEvent])
+	eventstore.RegisterFilterEventMapper(AggregateType, HumanPasswordBreachedType, eventstore.GenericEventMapper[HumanPasswordBreachedEvent])
 	eventstore.RegisterFilterEventMapper(AggregateType, UserIDPLinkAddedType, UserIDPLinkAddedEventMapper)
 	eventstore.RegisterFilterEventMapper(AggregateType, UserIDPLinkRemovedType, UserIDPLinkRemovedEventMapper)
 	eventstore.RegisterFilterEventMapper(AggregateType, UserIDPLinkCascadeRemovedType, UserIDPLinkCascadeRemovedEventMapper)
@@ -76,6 +77,7 @@ func init() {
 	eventstore.RegisterFilterEventMapper(AggregateType, HumanEmailVerificationFailedType, HumanEmailVerificationFailedEventMapper)
 	eventstore.RegisterFilterEventMapper(AggregateType, HumanEmailCodeAddedType, HumanEmailCodeAddedEventMapper)
 	eventstore.RegisterFilterEventMapper(AggregateType, HumanEmailCodeSentType, HumanEmailCodeSentEventMapper)
+	eventstore.RegisterFilterEventMapper(AggregateType, HumanEmailUndeliverableType, HumanEmailUndeliverableEventMapper)
 	eventstore.RegisterFilterEventMapper(AggregateType, HumanPhoneChangedType, HumanPhoneChangedEventMapper)
 	eventstore.RegisterFilterEventMapper(AggregateType, HumanPhoneRemovedType, HumanPhoneRemovedEventMapper)
 	eventstore.RegisterFilterEventMapper(AggregateType, HumanPhoneVerifiedType, HumanPhoneVerifiedEventMapper)
@@ -126,6 +128,29 @@ func init() {
 	eventstore.RegisterFilterEventMapper(AggregateType, HumanRefreshTokenAddedType, HumanRefreshTokenAddedEventMapper)
 	eventstore.RegisterFilterEventMapper(AggregateType, HumanRefreshTokenRenewedType, HumanRefreshTokenRenewedEventEventMapper)
 	eventstore.RegisterFilterEventMapper(AggregateType, HumanRefreshTokenRemovedType, HumanRefreshTokenRemovedEventEventMapper)
+	eventstore.RegisterFilterEventMapper(AggregateType, HumanTrustedDeviceAddedType, HumanTrustedDeviceAddedEventMapper)
+	eventstore.RegisterFilterEventMapper(AggregateType, HumanTrustedDeviceUsedType, HumanTrustedDeviceUsedEventMapper)
+	eventstore.RegisterFilterEventMapper(AggregateType, HumanTrustedDeviceRemovedType, HumanTrustedDeviceRemovedEventMapper)
+	eventstore.RegisterFilterEventMapper(AggregateType, HumanRecoveryCodesGeneratedType, eventstore.GenericEventMapper[HumanRecoveryCodesGeneratedEvent])
+	eventstore.RegisterFilterEventMapper(AggregateType, HumanRecoveryCodesRemovedType, eventstore.GenericEventMapper[HumanRecoveryCodesRemovedEvent])
+	eventstore.RegisterFilterEventMapper(AggregateType, HumanRecoveryCodeCheckSucceededType, eventstore.GenericEventMapper[HumanRecoveryCodeCheckSucceededEvent])
+	eventstore.RegisterFilterEventMapper(AggregateType, HumanRecoveryCodeCheckFailedType, eventstore.GenericEventMapper[HumanRecoveryCodeCheckFailedEvent])
+	eventstore.RegisterFilterEventMapper(AggregateType, HumanRecoveryEmailChangedType, eventstore.GenericEventMapper[HumanRecoveryEmailChangedEvent])
+	eventstore.RegisterFilterEventMapper(AggregateType, HumanRecoveryEmailCodeAddedType, eventstore.GenericEventMapper[HumanRecoveryEmailCodeAddedEvent])
+	eventstore.RegisterFilterEventMapper(AggregateType, HumanRecoveryEmailCodeSentType, eventstore.GenericEventMapper[HumanRecoveryEmailCodeSentEvent])
+	eventstore.RegisterFilterEventMapper(AggregateType, HumanRecoveryEmailVerifiedType, eventstore.GenericEventMapper[HumanRecoveryEmailVerifiedEvent])
+	eventstore.RegisterFilterEventMapper(AggregateType, HumanRecoveryEmailVerificationFailedType, eventstore.GenericEventMapper[HumanRecoveryEmailVerificationFailedEvent])
+	eventstore.RegisterFilterEventMapper(AggregateType, HumanRecoveryEmailRemovedType, eventstore.GenericEventMapper[HumanRecoveryEmailRemovedEvent])
+	eventstore.RegisterFilterEventMapper(AggregateType, HumanAccountRecoveryRequestedType, eventstore.GenericEventMapper[HumanAccountRecoveryRequestedEvent])
+	eventstore.RegisterFilterEventMapper(AggregateType, HumanAccountRecoveryApprovedType, eventstore.GenericEventMapper[HumanAccountRecoveryApprovedEvent])
+	eventstore.RegisterFilterEventMapper(AggregateType, HumanAccountRecoveryRejectedType, eventstore.GenericEventMapper[HumanAccountRecoveryRejectedEvent])
+	eventstore.RegisterFilterEventMapper(AggregateType, HumanAccountRecoveryCodeSentType, eventstore.GenericEventMapper[HumanAccountRecoveryCodeSentEvent])
+	eventstore.RegisterFilterEventMapper(AggregateType, HumanAccountRecoveryCheckFailedType, eventstore.GenericEventMapper[HumanAccountRecoveryCheckFailedEvent])
+	eventstore.RegisterFilterEventMapper(AggregateType, HumanAccountRecoveryCompletedType, eventstore.GenericEventMapper[HumanAccountRecoveryCompletedEvent])
+	eventstore.RegisterFilterEventMapper(AggregateType, HumanMagicLinkCodeAddedType, eventstore.GenericEventMapper[HumanMagicLinkCodeAddedEvent])
+	eventstore.RegisterFilterEventMapper(AggregateType, HumanMagicLinkCodeSentType, eventstore.GenericEventMapper[HumanMagicLinkCodeSentEvent])
+	eventstore.RegisterFilterEventMapper(AggregateType, HumanMagicLinkCheckSucceededType, eventstore.GenericEventMapper[HumanMagicLinkCheckSucceededEvent])
+	eventstore.RegisterFilterEventMapper(AggregateType, HumanMagicLinkCheckFailedType, eventstore.GenericEventMapper[HumanMagicLinkCheckFailedEvent])
 	eventstore.RegisterFilterEventMapper(AggregateType, MachineAddedEventType, MachineAddedEventMapper)
 	eventstore.RegisterFilterEventMapper(AggregateType, MachineChangedEventType, MachineChangedEventMapper)
 	eventstore.RegisterFilterEventMapper(AggregateType, MachineKeyAddedEventType, MachineKeyAddedEventMapper)
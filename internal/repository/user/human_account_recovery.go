@@ -0,0 +1,422 @@
+package user
+
+import (
+	"context"
+	"time"
+
+	"github.com/zitadel/zitadel/internal/api/http"
+	"github.com/zitadel/zitadel/internal/crypto"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+)
+
+const (
+	recoveryEmailEventPrefix                 = humanEventPrefix + "recoveryemail."
+	HumanRecoveryEmailChangedType            = recoveryEmailEventPrefix + "changed"
+	HumanRecoveryEmailCodeAddedType          = recoveryEmailEventPrefix + "code.added"
+	HumanRecoveryEmailCodeSentType           = recoveryEmailEventPrefix + "code.sent"
+	HumanRecoveryEmailVerifiedType           = recoveryEmailEventPrefix + "verified"
+	HumanRecoveryEmailVerificationFailedType = recoveryEmailEventPrefix + "verification.failed"
+	HumanRecoveryEmailRemovedType            = recoveryEmailEventPrefix + "removed"
+
+	accountRecoveryEventPrefix          = humanEventPrefix + "accountrecovery."
+	HumanAccountRecoveryRequestedType   = accountRecoveryEventPrefix + "requested"
+	HumanAccountRecoveryApprovedType    = accountRecoveryEventPrefix + "approved"
+	HumanAccountRecoveryRejectedType    = accountRecoveryEventPrefix + "rejected"
+	HumanAccountRecoveryCodeSentType    = accountRecoveryEventPrefix + "code.sent"
+	HumanAccountRecoveryCheckFailedType = accountRecoveryEventPrefix + "check.failed"
+	HumanAccountRecoveryCompletedType   = accountRecoveryEventPrefix + "completed"
+)
+
+// HumanRecoveryEmailChangedEvent sets a secondary email address, which is only used for account recovery
+// and has to be verified separately from the primary email.
+type HumanRecoveryEmailChangedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	EmailAddress domain.EmailAddress `json:"email,omitempty"`
+}
+
+func (e *HumanRecoveryEmailChangedEvent) Payload() interface{} {
+	return e
+}
+
+func (e *HumanRecoveryEmailChangedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func (e *HumanRecoveryEmailChangedEvent) SetBaseEvent(event *eventstore.BaseEvent) {
+	e.BaseEvent = *event
+}
+
+func NewHumanRecoveryEmailChangedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	emailAddress domain.EmailAddress,
+) *HumanRecoveryEmailChangedEvent {
+	return &HumanRecoveryEmailChangedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			HumanRecoveryEmailChangedType,
+		),
+		EmailAddress: emailAddress,
+	}
+}
+
+// HumanRecoveryEmailCodeAddedEvent carries the recipient address, so the notification can be sent
+// to the recovery email instead of the primary email of the user.
+type HumanRecoveryEmailCodeAddedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	EmailAddress      domain.EmailAddress `json:"email,omitempty"`
+	Code              *crypto.CryptoValue `json:"code,omitempty"`
+	Expiry            time.Duration       `json:"expiry,omitempty"`
+	URLTemplate       string              `json:"url_template,omitempty"`
+	CodeReturned      bool                `json:"code_returned,omitempty"`
+	TriggeredAtOrigin string              `json:"triggerOrigin,omitempty"`
+}
+
+func (e *HumanRecoveryEmailCodeAddedEvent) Payload() interface{} {
+	return e
+}
+
+func (e *HumanRecoveryEmailCodeAddedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func (e *HumanRecoveryEmailCodeAddedEvent) SetBaseEvent(event *eventstore.BaseEvent) {
+	e.BaseEvent = *event
+}
+
+func (e *HumanRecoveryEmailCodeAddedEvent) TriggerOrigin() string {
+	return e.TriggeredAtOrigin
+}
+
+func NewHumanRecoveryEmailCodeAddedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	emailAddress domain.EmailAddress,
+	code *crypto.CryptoValue,
+	expiry time.Duration,
+	urlTemplate string,
+	codeReturned bool,
+) *HumanRecoveryEmailCodeAddedEvent {
+	return &HumanRecoveryEmailCodeAddedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			HumanRecoveryEmailCodeAddedType,
+		),
+		EmailAddress:      emailAddress,
+		Code:              code,
+		Expiry:            expiry,
+		URLTemplate:       urlTemplate,
+		CodeReturned:      codeReturned,
+		TriggeredAtOrigin: http.DomainContext(ctx).Origin(),
+	}
+}
+
+type HumanRecoveryEmailCodeSentEvent struct {
+	eventstore.BaseEvent `json:"-"`
+}
+
+func (e *HumanRecoveryEmailCodeSentEvent) Payload() interface{} {
+	return nil
+}
+
+func (e *HumanRecoveryEmailCodeSentEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func (e *HumanRecoveryEmailCodeSentEvent) SetBaseEvent(event *eventstore.BaseEvent) {
+	e.BaseEvent = *event
+}
+
+func NewHumanRecoveryEmailCodeSentEvent(ctx context.Context, aggregate *eventstore.Aggregate) *HumanRecoveryEmailCodeSentEvent {
+	return &HumanRecoveryEmailCodeSentEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			HumanRecoveryEmailCodeSentType,
+		),
+	}
+}
+
+type HumanRecoveryEmailVerifiedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+}
+
+func (e *HumanRecoveryEmailVerifiedEvent) Payload() interface{} {
+	return nil
+}
+
+func (e *HumanRecoveryEmailVerifiedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func (e *HumanRecoveryEmailVerifiedEvent) SetBaseEvent(event *eventstore.BaseEvent) {
+	e.BaseEvent = *event
+}
+
+func NewHumanRecoveryEmailVerifiedEvent(ctx context.Context, aggregate *eventstore.Aggregate) *HumanRecoveryEmailVerifiedEvent {
+	return &HumanRecoveryEmailVerifiedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			HumanRecoveryEmailVerifiedType,
+		),
+	}
+}
+
+type HumanRecoveryEmailVerificationFailedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+}
+
+func (e *HumanRecoveryEmailVerificationFailedEvent) Payload() interface{} {
+	return nil
+}
+
+func (e *HumanRecoveryEmailVerificationFailedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func (e *HumanRecoveryEmailVerificationFailedEvent) SetBaseEvent(event *eventstore.BaseEvent) {
+	e.BaseEvent = *event
+}
+
+func NewHumanRecoveryEmailVerificationFailedEvent(ctx context.Context, aggregate *eventstore.Aggregate) *HumanRecoveryEmailVerificationFailedEvent {
+	return &HumanRecoveryEmailVerificationFailedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			HumanRecoveryEmailVerificationFailedType,
+		),
+	}
+}
+
+type HumanRecoveryEmailRemovedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+}
+
+func (e *HumanRecoveryEmailRemovedEvent) Payload() interface{} {
+	return nil
+}
+
+func (e *HumanRecoveryEmailRemovedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func (e *HumanRecoveryEmailRemovedEvent) SetBaseEvent(event *eventstore.BaseEvent) {
+	e.BaseEvent = *event
+}
+
+func NewHumanRecoveryEmailRemovedEvent(ctx context.Context, aggregate *eventstore.Aggregate) *HumanRecoveryEmailRemovedEvent {
+	return &HumanRecoveryEmailRemovedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			HumanRecoveryEmailRemovedType,
+		),
+	}
+}
+
+// HumanAccountRecoveryRequestedEvent is pushed by the user (unauthenticated) and has to be
+// approved or rejected by an administrator of the organization.
+type HumanAccountRecoveryRequestedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	Reason string `json:"reason,omitempty"`
+}
+
+func (e *HumanAccountRecoveryRequestedEvent) Payload() interface{} {
+	return e
+}
+
+func (e *HumanAccountRecoveryRequestedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func (e *HumanAccountRecoveryRequestedEvent) SetBaseEvent(event *eventstore.BaseEvent) {
+	e.BaseEvent = *event
+}
+
+func NewHumanAccountRecoveryRequestedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	reason string,
+) *HumanAccountRecoveryRequestedEvent {
+	return &HumanAccountRecoveryRequestedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			HumanAccountRecoveryRequestedType,
+		),
+		Reason: reason,
+	}
+}
+
+// HumanAccountRecoveryApprovedEvent issues the one-time code, which is sent to the verified recovery email.
+// The approving administrator is recorded as the creator of the event.
+type HumanAccountRecoveryApprovedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	EmailAddress      domain.EmailAddress `json:"email,omitempty"`
+	Code              *crypto.CryptoValue `json:"code,omitempty"`
+	Expiry            time.Duration       `json:"expiry,omitempty"`
+	URLTemplate       string              `json:"url_template,omitempty"`
+	CodeReturned      bool                `json:"code_returned,omitempty"`
+	TriggeredAtOrigin string              `json:"triggerOrigin,omitempty"`
+}
+
+func (e *HumanAccountRecoveryApprovedEvent) Payload() interface{} {
+	return e
+}
+
+func (e *HumanAccountRecoveryApprovedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func (e *HumanAccountRecoveryApprovedEvent) SetBaseEvent(event *eventstore.BaseEvent) {
+	e.BaseEvent = *event
+}
+
+func (e *HumanAccountRecoveryApprovedEvent) TriggerOrigin() string {
+	return e.TriggeredAtOrigin
+}
+
+func NewHumanAccountRecoveryApprovedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	emailAddress domain.EmailAddress,
+	code *crypto.CryptoValue,
+	expiry time.Duration,
+	urlTemplate string,
+	codeReturned bool,
+) *HumanAccountRecoveryApprovedEvent {
+	return &HumanAccountRecoveryApprovedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			HumanAccountRecoveryApprovedType,
+		),
+		EmailAddress:      emailAddress,
+		Code:              code,
+		Expiry:            expiry,
+		URLTemplate:       urlTemplate,
+		CodeReturned:      codeReturned,
+		TriggeredAtOrigin: http.DomainContext(ctx).Origin(),
+	}
+}
+
+type HumanAccountRecoveryRejectedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	Reason string `json:"reason,omitempty"`
+}
+
+func (e *HumanAccountRecoveryRejectedEvent) Payload() interface{} {
+	return e
+}
+
+func (e *HumanAccountRecoveryRejectedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func (e *HumanAccountRecoveryRejectedEvent) SetBaseEvent(event *eventstore.BaseEvent) {
+	e.BaseEvent = *event
+}
+
+func NewHumanAccountRecoveryRejectedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	reason string,
+) *HumanAccountRecoveryRejectedEvent {
+	return &HumanAccountRecoveryRejectedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			HumanAccountRecoveryRejectedType,
+		),
+		Reason: reason,
+	}
+}
+
+type HumanAccountRecoveryCodeSentEvent struct {
+	eventstore.BaseEvent `json:"-"`
+}
+
+func (e *HumanAccountRecoveryCodeSentEvent) Payload() interface{} {
+	return nil
+}
+
+func (e *HumanAccountRecoveryCodeSentEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func (e *HumanAccountRecoveryCodeSentEvent) SetBaseEvent(event *eventstore.BaseEvent) {
+	e.BaseEvent = *event
+}
+
+func NewHumanAccountRecoveryCodeSentEvent(ctx context.Context, aggregate *eventstore.Aggregate) *HumanAccountRecoveryCodeSentEvent {
+	return &HumanAccountRecoveryCodeSentEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			HumanAccountRecoveryCodeSentType,
+		),
+	}
+}
+
+type HumanAccountRecoveryCheckFailedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+}
+
+func (e *HumanAccountRecoveryCheckFailedEvent) Payload() interface{} {
+	return nil
+}
+
+func (e *HumanAccountRecoveryCheckFailedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func (e *HumanAccountRecoveryCheckFailedEvent) SetBaseEvent(event *eventstore.BaseEvent) {
+	e.BaseEvent = *event
+}
+
+func NewHumanAccountRecoveryCheckFailedEvent(ctx context.Context, aggregate *eventstore.Aggregate) *HumanAccountRecoveryCheckFailedEvent {
+	return &HumanAccountRecoveryCheckFailedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			HumanAccountRecoveryCheckFailedType,
+		),
+	}
+}
+
+// HumanAccountRecoveryCompletedEvent is pushed together with the new password
+// and the removal of all second factors of the user.
+type HumanAccountRecoveryCompletedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+}
+
+func (e *HumanAccountRecoveryCompletedEvent) Payload() interface{} {
+	return nil
+}
+
+func (e *HumanAccountRecoveryCompletedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func (e *HumanAccountRecoveryCompletedEvent) SetBaseEvent(event *eventstore.BaseEvent) {
+	e.BaseEvent = *event
+}
+
+func NewHumanAccountRecoveryCompletedEvent(ctx context.Context, aggregate *eventstore.Aggregate) *HumanAccountRecoveryCompletedEvent {
+	return &HumanAccountRecoveryCompletedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			HumanAccountRecoveryCompletedType,
+		),
+	}
+}
@@ -19,6 +19,7 @@ const (
 	HumanEmailCodeAddedType          = emailEventPrefix + "code.added"
 	HumanEmailCodeSentType           = emailEventPrefix + "code.sent"
 	HumanEmailConfirmURLAddedType    = emailEventPrefix + "confirm_url.added"
+	HumanEmailUndeliverableType      = emailEventPrefix + "undeliverable"
 )
 
 type HumanEmailChangedEvent struct {
@@ -215,3 +216,52 @@ func HumanEmailCodeSentEventMapper(event eventstore.Event) (eventstore.Event, er
 		BaseEvent: *eventstore.BaseEventFromRepo(event),
 	}, nil
 }
+
+// HumanEmailUndeliverableEvent is pushed when the email provider reported,
+// that messages to the email address of the user bounced or were marked as spam.
+type HumanEmailUndeliverableEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	EmailAddress domain.EmailAddress             `json:"email,omitempty"`
+	Reason       domain.EmailUndeliverableReason `json:"reason,omitempty"`
+	Diagnostic   string                          `json:"diagnostic,omitempty"`
+}
+
+func (e *HumanEmailUndeliverableEvent) Payload() interface{} {
+	return e
+}
+
+func (e *HumanEmailUndeliverableEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func NewHumanEmailUndeliverableEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	emailAddress domain.EmailAddress,
+	reason domain.EmailUndeliverableReason,
+	diagnostic string,
+) *HumanEmailUndeliverableEvent {
+	return &HumanEmailUndeliverableEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			HumanEmailUndeliverableType,
+		),
+		EmailAddress: emailAddress,
+		Reason:       reason,
+		Diagnostic:   diagnostic,
+	}
+}
+
+func HumanEmailUndeliverableEventMapper(event eventstore.Event) (eventstore.Event, error) {
+	undeliverable := &HumanEmailUndeliverableEvent{
+		BaseEvent: *eventstore.BaseEventFromRepo(event),
+	}
+	err := event.Unmarshal(undeliverable)
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "USER-Bnc1e", "unable to unmarshal human email undeliverable")
+	}
+
+	return undeliverable, nil
+}
@@ -0,0 +1,161 @@
+package user
+
+import (
+	"context"
+	"time"
+
+	"github.com/zitadel/zitadel/internal/api/http"
+	"github.com/zitadel/zitadel/internal/crypto"
+	"github.com/zitadel/zitadel/internal/eventstore"
+)
+
+const (
+	magicLinkEventPrefix             = humanEventPrefix + "magiclink."
+	HumanMagicLinkCodeAddedType      = magicLinkEventPrefix + "code.added"
+	HumanMagicLinkCodeSentType       = magicLinkEventPrefix + "code.sent"
+	HumanMagicLinkCheckSucceededType = magicLinkEventPrefix + "check.succeeded"
+	HumanMagicLinkCheckFailedType    = magicLinkEventPrefix + "check.failed"
+)
+
+// HumanMagicLinkCodeAddedEvent creates a single-use code, which is sent to the verified email of the user as login link.
+// The code is bound to the auth request and user agent of the [AuthRequestInfo], which initiated the login.
+type HumanMagicLinkCodeAddedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	Code              *crypto.CryptoValue `json:"code,omitempty"`
+	Expiry            time.Duration       `json:"expiry,omitempty"`
+	TriggeredAtOrigin string              `json:"triggerOrigin,omitempty"`
+	*AuthRequestInfo
+}
+
+func (e *HumanMagicLinkCodeAddedEvent) Payload() interface{} {
+	return e
+}
+
+func (e *HumanMagicLinkCodeAddedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func (e *HumanMagicLinkCodeAddedEvent) SetBaseEvent(event *eventstore.BaseEvent) {
+	e.BaseEvent = *event
+}
+
+func (e *HumanMagicLinkCodeAddedEvent) TriggerOrigin() string {
+	return e.TriggeredAtOrigin
+}
+
+func NewHumanMagicLinkCodeAddedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	code *crypto.CryptoValue,
+	expiry time.Duration,
+	info *AuthRequestInfo,
+) *HumanMagicLinkCodeAddedEvent {
+	return &HumanMagicLinkCodeAddedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			HumanMagicLinkCodeAddedType,
+		),
+		Code:              code,
+		Expiry:            expiry,
+		AuthRequestInfo:   info,
+		TriggeredAtOrigin: http.DomainContext(ctx).Origin(),
+	}
+}
+
+type HumanMagicLinkCodeSentEvent struct {
+	eventstore.BaseEvent `json:"-"`
+}
+
+func (e *HumanMagicLinkCodeSentEvent) Payload() interface{} {
+	return e
+}
+
+func (e *HumanMagicLinkCodeSentEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func (e *HumanMagicLinkCodeSentEvent) SetBaseEvent(event *eventstore.BaseEvent) {
+	e.BaseEvent = *event
+}
+
+func NewHumanMagicLinkCodeSentEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+) *HumanMagicLinkCodeSentEvent {
+	return &HumanMagicLinkCodeSentEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			HumanMagicLinkCodeSentType,
+		),
+	}
+}
+
+// HumanMagicLinkCheckSucceededEvent marks the code as used and authenticates the user
+// on the user agent of the [AuthRequestInfo].
+type HumanMagicLinkCheckSucceededEvent struct {
+	eventstore.BaseEvent `json:"-"`
+	*AuthRequestInfo
+}
+
+func (e *HumanMagicLinkCheckSucceededEvent) Payload() interface{} {
+	return e
+}
+
+func (e *HumanMagicLinkCheckSucceededEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func (e *HumanMagicLinkCheckSucceededEvent) SetBaseEvent(event *eventstore.BaseEvent) {
+	e.BaseEvent = *event
+}
+
+func NewHumanMagicLinkCheckSucceededEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	info *AuthRequestInfo,
+) *HumanMagicLinkCheckSucceededEvent {
+	return &HumanMagicLinkCheckSucceededEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			HumanMagicLinkCheckSucceededType,
+		),
+		AuthRequestInfo: info,
+	}
+}
+
+// HumanMagicLinkCheckFailedEvent invalidates the code, since a link must only be used once.
+type HumanMagicLinkCheckFailedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+	*AuthRequestInfo
+}
+
+func (e *HumanMagicLinkCheckFailedEvent) Payload() interface{} {
+	return e
+}
+
+func (e *HumanMagicLinkCheckFailedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func (e *HumanMagicLinkCheckFailedEvent) SetBaseEvent(event *eventstore.BaseEvent) {
+	e.BaseEvent = *event
+}
+
+func NewHumanMagicLinkCheckFailedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	info *AuthRequestInfo,
+) *HumanMagicLinkCheckFailedEvent {
+	return &HumanMagicLinkCheckFailedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			HumanMagicLinkCheckFailedType,
+		),
+		AuthRequestInfo: info,
+	}
+}
@@ -77,7 +77,8 @@ func NewHumanPasswordlessVerifiedEvent(
 	publicKey,
 	aaguid []byte,
 	signCount uint32,
-	userAgentID string,
+	userAgentID,
+	authenticatorModel string,
 ) *HumanPasswordlessVerifiedEvent {
 	return &HumanPasswordlessVerifiedEvent{
 		HumanWebAuthNVerifiedEvent: *NewHumanWebAuthNVerifiedEvent(
@@ -94,6 +95,7 @@ func NewHumanPasswordlessVerifiedEvent(
 			aaguid,
 			signCount,
 			userAgentID,
+			authenticatorModel,
 		),
 	}
 }
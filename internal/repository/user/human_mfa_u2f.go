@@ -66,7 +66,8 @@ func NewHumanU2FVerifiedEvent(
 	publicKey,
 	aaguid []byte,
 	signCount uint32,
-	userAgentID string,
+	userAgentID,
+	authenticatorModel string,
 ) *HumanU2FVerifiedEvent {
 	return &HumanU2FVerifiedEvent{
 		HumanWebAuthNVerifiedEvent: *NewHumanWebAuthNVerifiedEvent(
@@ -83,6 +84,7 @@ func NewHumanU2FVerifiedEvent(
 			aaguid,
 			signCount,
 			userAgentID,
+			authenticatorModel,
 		),
 	}
 }
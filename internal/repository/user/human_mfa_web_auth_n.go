@@ -58,6 +58,8 @@ type HumanWebAuthNVerifiedEvent struct {
 	SignCount         uint32 `json:"signCount"`
 	WebAuthNTokenName string `json:"webAuthNTokenName"`
 	UserAgentID       string `json:"userAgentID,omitempty"`
+	// AuthenticatorModel is the description of the authenticator from the FIDO metadata, if known.
+	AuthenticatorModel string `json:"authenticatorModel,omitempty"`
 }
 
 func (e *HumanWebAuthNVerifiedEvent) Payload() interface{} {
@@ -77,18 +79,20 @@ func NewHumanWebAuthNVerifiedEvent(
 	publicKey,
 	aaguid []byte,
 	signCount uint32,
-	userAgentID string,
+	userAgentID,
+	authenticatorModel string,
 ) *HumanWebAuthNVerifiedEvent {
 	return &HumanWebAuthNVerifiedEvent{
-		BaseEvent:         *base,
-		WebAuthNTokenID:   webAuthNTokenID,
-		KeyID:             keyID,
-		PublicKey:         publicKey,
-		AttestationType:   attestationType,
-		AAGUID:            aaguid,
-		SignCount:         signCount,
-		WebAuthNTokenName: webAuthNTokenName,
-		UserAgentID:       userAgentID,
+		BaseEvent:          *base,
+		WebAuthNTokenID:    webAuthNTokenID,
+		KeyID:              keyID,
+		PublicKey:          publicKey,
+		AttestationType:    attestationType,
+		AAGUID:             aaguid,
+		SignCount:          signCount,
+		WebAuthNTokenName:  webAuthNTokenName,
+		UserAgentID:        userAgentID,
+		AuthenticatorModel: authenticatorModel,
 	}
 }
 
@@ -21,6 +21,7 @@ const (
 	HumanPasswordCheckSucceededType = passwordEventPrefix + "check.succeeded"
 	HumanPasswordCheckFailedType    = passwordEventPrefix + "check.failed"
 	HumanPasswordHashUpdatedType    = passwordEventPrefix + "hash.updated"
+	HumanPasswordBreachedType       = passwordEventPrefix + "breached"
 )
 
 type HumanPasswordChangedEvent struct {
@@ -335,3 +336,34 @@ func NewHumanPasswordHashUpdatedEvent(
 		EncodedHash: encoded,
 	}
 }
+
+// HumanPasswordBreachedEvent is pushed when the current password of the user was found in the breached password corpus.
+// The user is required to change the password.
+type HumanPasswordBreachedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+}
+
+func (e *HumanPasswordBreachedEvent) Payload() interface{} {
+	return e
+}
+
+func (e *HumanPasswordBreachedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func (e *HumanPasswordBreachedEvent) SetBaseEvent(base *eventstore.BaseEvent) {
+	e.BaseEvent = *base
+}
+
+func NewHumanPasswordBreachedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+) *HumanPasswordBreachedEvent {
+	return &HumanPasswordBreachedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			HumanPasswordBreachedType,
+		),
+	}
+}
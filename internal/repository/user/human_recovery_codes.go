@@ -0,0 +1,165 @@
+package user
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/eventstore"
+)
+
+const (
+	recoveryCodesEventPrefix            = humanEventPrefix + "recoverycodes."
+	HumanRecoveryCodesGeneratedType     = recoveryCodesEventPrefix + "generated"
+	HumanRecoveryCodesRemovedType       = recoveryCodesEventPrefix + "removed"
+	HumanRecoveryCodeCheckSucceededType = recoveryCodesEventPrefix + "check.succeeded"
+	HumanRecoveryCodeCheckFailedType    = recoveryCodesEventPrefix + "check.failed"
+
+	UniqueRecoveryCodeConsumedType = "recovery_code_consumed"
+)
+
+// NewAddRecoveryCodeConsumedUniqueConstraint ensures a recovery code is only consumed once,
+// even if it is checked concurrently.
+// The hash identifies the code of a batch, as every hash is salted.
+func NewAddRecoveryCodeConsumedUniqueConstraint(codeHash string) *eventstore.UniqueConstraint {
+	return eventstore.NewAddEventUniqueConstraint(
+		UniqueRecoveryCodeConsumedType,
+		codeHash,
+		"Errors.User.MFA.RecoveryCodes.Invalid")
+}
+
+// HumanRecoveryCodesGeneratedEvent is pushed for the initial generation as well as for every regeneration,
+// in which case the new batch replaces all remaining codes.
+type HumanRecoveryCodesGeneratedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	CodeHashes []string `json:"codeHashes"`
+}
+
+func (e *HumanRecoveryCodesGeneratedEvent) Payload() interface{} {
+	return e
+}
+
+func (e *HumanRecoveryCodesGeneratedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func (e *HumanRecoveryCodesGeneratedEvent) SetBaseEvent(event *eventstore.BaseEvent) {
+	e.BaseEvent = *event
+}
+
+func NewHumanRecoveryCodesGeneratedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	codeHashes []string,
+) *HumanRecoveryCodesGeneratedEvent {
+	return &HumanRecoveryCodesGeneratedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			HumanRecoveryCodesGeneratedType,
+		),
+		CodeHashes: codeHashes,
+	}
+}
+
+type HumanRecoveryCodesRemovedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+}
+
+func (e *HumanRecoveryCodesRemovedEvent) Payload() interface{} {
+	return nil
+}
+
+func (e *HumanRecoveryCodesRemovedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func (e *HumanRecoveryCodesRemovedEvent) SetBaseEvent(event *eventstore.BaseEvent) {
+	e.BaseEvent = *event
+}
+
+func NewHumanRecoveryCodesRemovedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+) *HumanRecoveryCodesRemovedEvent {
+	return &HumanRecoveryCodesRemovedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			HumanRecoveryCodesRemovedType,
+		),
+	}
+}
+
+// HumanRecoveryCodeCheckSucceededEvent marks the code at CodeIndex of the current batch as consumed.
+type HumanRecoveryCodeCheckSucceededEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	CodeIndex int `json:"codeIndex"`
+	*AuthRequestInfo
+
+	// codeHash is only used for the unique constraint when pushing the event
+	codeHash string
+}
+
+func (e *HumanRecoveryCodeCheckSucceededEvent) Payload() interface{} {
+	return e
+}
+
+func (e *HumanRecoveryCodeCheckSucceededEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return []*eventstore.UniqueConstraint{NewAddRecoveryCodeConsumedUniqueConstraint(e.codeHash)}
+}
+
+func (e *HumanRecoveryCodeCheckSucceededEvent) SetBaseEvent(event *eventstore.BaseEvent) {
+	e.BaseEvent = *event
+}
+
+func NewHumanRecoveryCodeCheckSucceededEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	codeIndex int,
+	codeHash string,
+	info *AuthRequestInfo,
+) *HumanRecoveryCodeCheckSucceededEvent {
+	return &HumanRecoveryCodeCheckSucceededEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			HumanRecoveryCodeCheckSucceededType,
+		),
+		CodeIndex:       codeIndex,
+		AuthRequestInfo: info,
+		codeHash:        codeHash,
+	}
+}
+
+type HumanRecoveryCodeCheckFailedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+	*AuthRequestInfo
+}
+
+func (e *HumanRecoveryCodeCheckFailedEvent) Payload() interface{} {
+	return e
+}
+
+func (e *HumanRecoveryCodeCheckFailedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func (e *HumanRecoveryCodeCheckFailedEvent) SetBaseEvent(event *eventstore.BaseEvent) {
+	e.BaseEvent = *event
+}
+
+func NewHumanRecoveryCodeCheckFailedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	info *AuthRequestInfo,
+) *HumanRecoveryCodeCheckFailedEvent {
+	return &HumanRecoveryCodeCheckFailedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			HumanRecoveryCodeCheckFailedType,
+		),
+		AuthRequestInfo: info,
+	}
+}
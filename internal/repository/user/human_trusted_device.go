@@ -0,0 +1,151 @@
+package user
+
+import (
+	"context"
+	"time"
+
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+const (
+	trustedDeviceEventPrefix      = humanEventPrefix + "trusted.device."
+	HumanTrustedDeviceAddedType   = trustedDeviceEventPrefix + "added"
+	HumanTrustedDeviceUsedType    = trustedDeviceEventPrefix + "used"
+	HumanTrustedDeviceRemovedType = trustedDeviceEventPrefix + "removed"
+)
+
+type HumanTrustedDeviceAddedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	DeviceID    string        `json:"deviceId"`
+	Name        string        `json:"name,omitempty"`
+	UserAgentID string        `json:"userAgentId"`
+	TokenHash   string        `json:"tokenHash"`
+	Lifetime    time.Duration `json:"lifetime"`
+}
+
+func (e *HumanTrustedDeviceAddedEvent) Payload() interface{} {
+	return e
+}
+
+func (e *HumanTrustedDeviceAddedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func NewHumanTrustedDeviceAddedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	deviceID,
+	name,
+	userAgentID,
+	tokenHash string,
+	lifetime time.Duration,
+) *HumanTrustedDeviceAddedEvent {
+	return &HumanTrustedDeviceAddedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			HumanTrustedDeviceAddedType,
+		),
+		DeviceID:    deviceID,
+		Name:        name,
+		UserAgentID: userAgentID,
+		TokenHash:   tokenHash,
+		Lifetime:    lifetime,
+	}
+}
+
+func HumanTrustedDeviceAddedEventMapper(event eventstore.Event) (eventstore.Event, error) {
+	deviceAdded := &HumanTrustedDeviceAddedEvent{
+		BaseEvent: *eventstore.BaseEventFromRepo(event),
+	}
+	err := event.Unmarshal(deviceAdded)
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "USER-Td3aA", "unable to unmarshal trusted device added")
+	}
+
+	return deviceAdded, nil
+}
+
+type HumanTrustedDeviceUsedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	DeviceID string `json:"deviceId"`
+}
+
+func (e *HumanTrustedDeviceUsedEvent) Payload() interface{} {
+	return e
+}
+
+func (e *HumanTrustedDeviceUsedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func NewHumanTrustedDeviceUsedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	deviceID string,
+) *HumanTrustedDeviceUsedEvent {
+	return &HumanTrustedDeviceUsedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			HumanTrustedDeviceUsedType,
+		),
+		DeviceID: deviceID,
+	}
+}
+
+func HumanTrustedDeviceUsedEventMapper(event eventstore.Event) (eventstore.Event, error) {
+	deviceUsed := &HumanTrustedDeviceUsedEvent{
+		BaseEvent: *eventstore.BaseEventFromRepo(event),
+	}
+	err := event.Unmarshal(deviceUsed)
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "USER-Td3aU", "unable to unmarshal trusted device used")
+	}
+
+	return deviceUsed, nil
+}
+
+type HumanTrustedDeviceRemovedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	DeviceID string `json:"deviceId"`
+}
+
+func (e *HumanTrustedDeviceRemovedEvent) Payload() interface{} {
+	return e
+}
+
+func (e *HumanTrustedDeviceRemovedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func NewHumanTrustedDeviceRemovedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	deviceID string,
+) *HumanTrustedDeviceRemovedEvent {
+	return &HumanTrustedDeviceRemovedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			HumanTrustedDeviceRemovedType,
+		),
+		DeviceID: deviceID,
+	}
+}
+
+func HumanTrustedDeviceRemovedEventMapper(event eventstore.Event) (eventstore.Event, error) {
+	deviceRemoved := &HumanTrustedDeviceRemovedEvent{
+		BaseEvent: *eventstore.BaseEventFromRepo(event),
+	}
+	err := event.Unmarshal(deviceRemoved)
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "USER-Td3aR", "unable to unmarshal trusted device removed")
+	}
+
+	return deviceRemoved, nil
+}
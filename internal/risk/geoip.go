@@ -0,0 +1,125 @@
+package risk
+
+import (
+	"bytes"
+	"encoding/csv"
+	"errors"
+	"io"
+	"net"
+	"net/netip"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// Locator returns the country and, if known, the location of an IP address.
+type Locator interface {
+	Locate(ip net.IP) (country string, location *domain.GeoLocation)
+}
+
+type ipRange struct {
+	start, end netip.Addr
+	country    string
+	location   *domain.GeoLocation
+}
+
+// ipRangeLocator looks up the IP addresses in the ranges sorted by their start.
+type ipRangeLocator struct {
+	ranges []ipRange
+}
+
+// LoadGeoIPDatabase reads the CSV file at path into a [Locator].
+// Each line contains the first and last IP address of a range followed by the country code (`ip_start,ip_end,country`),
+// as in the DB-IP lite country database.
+// Lines of the DB-IP lite city database (`ip_start,ip_end,continent,country,stateprov,city,latitude,longitude`)
+// additionally provide the location used to detect impossible travel.
+func LoadGeoIPDatabase(path string) (Locator, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, zerrors.ThrowInvalidArgument(err, "RISK-Ge1ip", "unable to read geoip database")
+	}
+	return parseGeoIPDatabase(bytes.NewReader(data))
+}
+
+func parseGeoIPDatabase(r io.Reader) (*ipRangeLocator, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+	reader.ReuseRecord = true
+	locator := new(ipRangeLocator)
+	for line := 1; ; line++ {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, zerrors.ThrowInvalidArgument(err, "RISK-Ge2ip", "unable to parse geoip database")
+		}
+		ipRange, err := parseIPRange(record)
+		if err != nil {
+			return nil, zerrors.ThrowInvalidArgumentf(err, "RISK-Ge3ip", "invalid geoip database entry in line %d", line)
+		}
+		locator.ranges = append(locator.ranges, ipRange)
+	}
+	sort.Slice(locator.ranges, func(i, j int) bool {
+		return locator.ranges[i].start.Less(locator.ranges[j].start)
+	})
+	return locator, nil
+}
+
+func parseIPRange(record []string) (r ipRange, err error) {
+	var country, latitude, longitude string
+	switch len(record) {
+	case 3:
+		country = record[2]
+	case 8:
+		country, latitude, longitude = record[3], record[6], record[7]
+	default:
+		return r, errors.New("unexpected number of fields")
+	}
+	if r.start, err = netip.ParseAddr(record[0]); err != nil {
+		return r, err
+	}
+	if r.end, err = netip.ParseAddr(record[1]); err != nil {
+		return r, err
+	}
+	r.start, r.end = r.start.Unmap(), r.end.Unmap()
+	if r.start.Is4() != r.end.Is4() || r.end.Less(r.start) {
+		return r, errors.New("invalid range")
+	}
+	r.country = strings.ToUpper(country)
+	if latitude == "" || longitude == "" {
+		return r, nil
+	}
+	r.location = new(domain.GeoLocation)
+	if r.location.Latitude, err = strconv.ParseFloat(latitude, 64); err != nil {
+		return r, err
+	}
+	if r.location.Longitude, err = strconv.ParseFloat(longitude, 64); err != nil {
+		return r, err
+	}
+	return r, nil
+}
+
+func (l *ipRangeLocator) Locate(ip net.IP) (string, *domain.GeoLocation) {
+	addr, ok := netip.AddrFromSlice(ip)
+	if !ok {
+		return "", nil
+	}
+	addr = addr.Unmap()
+	// first range starting after the address, so the previous one is the only candidate
+	i := sort.Search(len(l.ranges), func(i int) bool {
+		return addr.Less(l.ranges[i].start)
+	})
+	if i == 0 {
+		return "", nil
+	}
+	r := l.ranges[i-1]
+	if r.end.Less(addr) || r.start.Is4() != addr.Is4() {
+		return "", nil
+	}
+	return r.country, r.location
+}
@@ -0,0 +1,107 @@
+package risk
+
+import (
+	"net"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+func Test_parseGeoIPDatabase(t *testing.T) {
+	tests := []struct {
+		name    string
+		csv     string
+		ip      net.IP
+		country string
+		loc     *domain.GeoLocation
+		wantErr bool
+	}{
+		{
+			name:    "country database",
+			csv:     "1.0.0.0,1.0.0.255,au\n1.0.1.0,1.0.3.255,cn\n",
+			ip:      net.IPv4(1, 0, 2, 1),
+			country: "CN",
+		},
+		{
+			name:    "city database",
+			csv:     "1.0.0.0,1.0.0.255,OC,AU,Queensland,\"South Brisbane\",-27.4767,153.017\n",
+			ip:      net.IPv4(1, 0, 0, 1),
+			country: "AU",
+			loc:     &domain.GeoLocation{Latitude: -27.4767, Longitude: 153.017},
+		},
+		{
+			name:    "unsorted ipv6",
+			csv:     "2001:db8:1::,2001:db8:1::ffff,de\n2001:db8::,2001:db8::ffff,ch\n",
+			ip:      net.ParseIP("2001:db8::1"),
+			country: "CH",
+		},
+		{
+			name: "between ranges",
+			csv:  "1.0.0.0,1.0.0.255,au\n1.0.2.0,1.0.3.255,cn\n",
+			ip:   net.IPv4(1, 0, 1, 1),
+		},
+		{
+			name: "before first range",
+			csv:  "1.0.0.0,1.0.0.255,au\n",
+			ip:   net.IPv4(0, 0, 0, 1),
+		},
+		{
+			name: "ipv4 not in ipv6 range",
+			csv:  "::,ffff::,ch\n",
+			ip:   net.IPv4(1, 0, 0, 1),
+		},
+		{
+			name:    "invalid field count",
+			csv:     "1.0.0.0,1.0.0.255\n",
+			wantErr: true,
+		},
+		{
+			name:    "invalid ip",
+			csv:     "1.0.0,1.0.0.255,au\n",
+			wantErr: true,
+		},
+		{
+			name:    "invalid range",
+			csv:     "1.0.0.255,1.0.0.0,au\n",
+			wantErr: true,
+		},
+		{
+			name:    "invalid latitude",
+			csv:     "1.0.0.0,1.0.0.255,OC,AU,Queensland,\"South Brisbane\",south,153.017\n",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			locator, err := parseGeoIPDatabase(strings.NewReader(tt.csv))
+			if tt.wantErr {
+				assert.True(t, zerrors.IsErrorInvalidArgument(err))
+				return
+			}
+			require.NoError(t, err)
+			country, loc := locator.Locate(tt.ip)
+			assert.Equal(t, tt.country, country)
+			assert.Equal(t, tt.loc, loc)
+		})
+	}
+}
+
+func TestLoadGeoIPDatabase(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "geoip.csv")
+	require.NoError(t, os.WriteFile(path, []byte("1.0.0.0,1.0.0.255,au\n"), 0o600))
+
+	locator, err := LoadGeoIPDatabase(path)
+	require.NoError(t, err)
+	country, _ := locator.Locate(net.IPv4(1, 0, 0, 1))
+	assert.Equal(t, "AU", country)
+
+	_, err = LoadGeoIPDatabase(filepath.Join(t.TempDir(), "missing.csv"))
+	assert.True(t, zerrors.IsErrorInvalidArgument(err))
+}
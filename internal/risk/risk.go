@@ -0,0 +1,208 @@
+// Package risk evaluates how anomalous a session looks,
+// by comparing its origin to the recent sessions of the same user.
+//
+// The following signals are computed:
+//   - new device: neither the fingerprint nor the user agent were used in the recent sessions
+//   - new country: the IP address is located in a country none of the recent sessions were located in
+//   - impossible travel: the distance to the location of the last session could not have been travelled in the elapsed time
+//
+// Countries and locations require a local GeoIP database, no external service is called.
+package risk
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"math"
+	"time"
+
+	"github.com/zitadel/zitadel/internal/domain"
+)
+
+const (
+	defaultRecentSessions = 20
+	// defaultMaxTravelSpeed roughly is the cruising speed of a commercial airplane in km/h.
+	defaultMaxTravelSpeed = 1000
+	// minTravelDistance in km below which travel is never considered impossible,
+	// as the GeoIP locations are inaccurate.
+	minTravelDistance = 100
+	earthRadius       = 6371
+)
+
+type Config struct {
+	// Enabled evaluates the risk of sessions when their user is checked.
+	Enabled bool
+	// GeoIPDatabase is the path to a CSV file containing the IP ranges and their countries (see [LoadGeoIPDatabase]).
+	// The new country and impossible travel signals are only computed if set.
+	GeoIPDatabase string
+	// RecentSessions is the amount of recent sessions of the user the session is compared to.
+	RecentSessions uint64
+	// MaxTravelSpeed in km/h, a higher speed between two sessions is considered impossible travel.
+	MaxTravelSpeed float64
+}
+
+// NewEvaluator returns the [Evaluator] for the config or nil if the evaluation is disabled.
+func (c *Config) NewEvaluator() (*Evaluator, error) {
+	if !c.Enabled {
+		return nil, nil
+	}
+	evaluator := &Evaluator{
+		recentSessions: c.RecentSessions,
+		maxTravelSpeed: c.MaxTravelSpeed,
+	}
+	if evaluator.recentSessions == 0 {
+		evaluator.recentSessions = defaultRecentSessions
+	}
+	if evaluator.maxTravelSpeed <= 0 {
+		evaluator.maxTravelSpeed = defaultMaxTravelSpeed
+	}
+	if c.GeoIPDatabase == "" {
+		return evaluator, nil
+	}
+	locator, err := LoadGeoIPDatabase(c.GeoIPDatabase)
+	if err != nil {
+		return nil, err
+	}
+	evaluator.locator = locator
+	return evaluator, nil
+}
+
+type Evaluator struct {
+	locator        Locator
+	recentSessions uint64
+	maxTravelSpeed float64
+}
+
+func NewEvaluator(locator Locator, recentSessions uint64, maxTravelSpeed float64) *Evaluator {
+	return &Evaluator{
+		locator:        locator,
+		recentSessions: recentSessions,
+		maxTravelSpeed: maxTravelSpeed,
+	}
+}
+
+// RecentSessions returns the amount of recent sessions to pass to [Evaluator.Evaluate].
+func (e *Evaluator) RecentSessions() uint64 {
+	return e.recentSessions
+}
+
+// Observation is the origin of a session.
+type Observation struct {
+	DeviceID string
+	Country  string
+	Location *domain.GeoLocation
+	At       time.Time
+}
+
+// Observe returns the origin of a session created from the user agent at the given time.
+func (e *Evaluator) Observe(userAgent *domain.UserAgent, at time.Time) Observation {
+	observation := Observation{
+		DeviceID: DeviceID(userAgent),
+		At:       at,
+	}
+	if e.locator != nil && userAgent != nil && len(userAgent.IP) > 0 {
+		observation.Country, observation.Location = e.locator.Locate(userAgent.IP)
+	}
+	return observation
+}
+
+// Evaluate compares the current observation to the recent ones, ordered from the latest to the oldest.
+// Without recent observations, there's nothing to compare to and the risk is low.
+func (e *Evaluator) Evaluate(current Observation, recent []Observation) *domain.SessionRisk {
+	risk := &domain.SessionRisk{
+		Country: current.Country,
+	}
+	if len(recent) > 0 {
+		risk.NewDevice = current.DeviceID != "" && !knownDevice(current.DeviceID, recent)
+		risk.NewCountry = current.Country != "" && newCountry(current.Country, recent)
+		risk.ImpossibleTravel = e.impossibleTravel(current, recent)
+	}
+	risk.Level = level(risk)
+	return risk
+}
+
+// impossibleTravel compares the current location to the location of the latest located observation.
+func (e *Evaluator) impossibleTravel(current Observation, recent []Observation) bool {
+	if current.Location == nil {
+		return false
+	}
+	for _, previous := range recent {
+		if previous.Location == nil {
+			continue
+		}
+		distance := distance(previous.Location, current.Location)
+		if distance < minTravelDistance {
+			return false
+		}
+		hours := current.At.Sub(previous.At).Hours()
+		return hours <= 0 || distance/hours > e.maxTravelSpeed
+	}
+	return false
+}
+
+// level is high for impossible travel or a new device from a new country,
+// medium for any other signal and low without any signal.
+func level(risk *domain.SessionRisk) domain.SessionRiskLevel {
+	switch {
+	case risk.ImpossibleTravel,
+		risk.NewDevice && risk.NewCountry:
+		return domain.SessionRiskLevelHigh
+	case risk.NewDevice, risk.NewCountry:
+		return domain.SessionRiskLevelMedium
+	default:
+		return domain.SessionRiskLevelLow
+	}
+}
+
+// DeviceID identifies the device of the user agent by its fingerprint or, if not set, by a hash of the user agent header.
+func DeviceID(userAgent *domain.UserAgent) string {
+	if fingerprintID := userAgent.GetFingerprintID(); fingerprintID != "" {
+		return fingerprintID
+	}
+	if userAgent == nil {
+		return ""
+	}
+	header := userAgent.Header.Get("User-Agent")
+	if header == "" && userAgent.Description != nil {
+		header = *userAgent.Description
+	}
+	if header == "" {
+		return ""
+	}
+	hash := sha256.Sum256([]byte(header))
+	return "ua:" + hex.EncodeToString(hash[:16])
+}
+
+func knownDevice(deviceID string, recent []Observation) bool {
+	for _, observation := range recent {
+		if observation.DeviceID == deviceID {
+			return true
+		}
+	}
+	return false
+}
+
+// newCountry returns false if none of the recent observations were located,
+// e.g. because the GeoIP database was configured later.
+func newCountry(country string, recent []Observation) bool {
+	var located bool
+	for _, observation := range recent {
+		if observation.Country == country {
+			return false
+		}
+		located = located || observation.Country != ""
+	}
+	return located
+}
+
+// distance returns the great-circle distance in km between the locations using the haversine formula.
+func distance(from, to *domain.GeoLocation) float64 {
+	lat1, lat2 := radians(from.Latitude), radians(to.Latitude)
+	dLat := lat2 - lat1
+	dLon := radians(to.Longitude - from.Longitude)
+	a := math.Sin(dLat/2)*math.Sin(dLat/2) + math.Cos(lat1)*math.Cos(lat2)*math.Sin(dLon/2)*math.Sin(dLon/2)
+	return 2 * earthRadius * math.Asin(math.Sqrt(a))
+}
+
+func radians(degrees float64) float64 {
+	return degrees * math.Pi / 180
+}
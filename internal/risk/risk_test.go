@@ -0,0 +1,190 @@
+package risk
+
+import (
+	"net"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/muhlemmer/gu"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zitadel/zitadel/internal/domain"
+)
+
+var (
+	testNow = time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	zurich  = &domain.GeoLocation{Latitude: 47.3769, Longitude: 8.5417}
+	bern    = &domain.GeoLocation{Latitude: 46.948, Longitude: 7.4474}
+	newYork = &domain.GeoLocation{Latitude: 40.7128, Longitude: -74.006}
+)
+
+type mapLocator map[string]struct {
+	country  string
+	location *domain.GeoLocation
+}
+
+func (l mapLocator) Locate(ip net.IP) (string, *domain.GeoLocation) {
+	entry := l[ip.String()]
+	return entry.country, entry.location
+}
+
+func TestConfig_NewEvaluator(t *testing.T) {
+	evaluator, err := (&Config{}).NewEvaluator()
+	require.NoError(t, err)
+	assert.Nil(t, evaluator)
+
+	evaluator, err = (&Config{Enabled: true}).NewEvaluator()
+	require.NoError(t, err)
+	assert.Equal(t, &Evaluator{recentSessions: defaultRecentSessions, maxTravelSpeed: defaultMaxTravelSpeed}, evaluator)
+
+	_, err = (&Config{Enabled: true, GeoIPDatabase: "/does/not/exist.csv"}).NewEvaluator()
+	assert.Error(t, err)
+}
+
+func TestDeviceID(t *testing.T) {
+	tests := []struct {
+		name      string
+		userAgent *domain.UserAgent
+		want      string
+	}{
+		{
+			name: "nil",
+		},
+		{
+			name:      "empty",
+			userAgent: &domain.UserAgent{},
+		},
+		{
+			name: "fingerprint",
+			userAgent: &domain.UserAgent{
+				FingerprintID: gu.Ptr("fingerprint"),
+				Header:        http.Header{"User-Agent": {"browser"}},
+			},
+			want: "fingerprint",
+		},
+		{
+			name: "header",
+			userAgent: &domain.UserAgent{
+				Header:      http.Header{"User-Agent": {"browser"}},
+				Description: gu.Ptr("description"),
+			},
+			want: DeviceID(&domain.UserAgent{Description: gu.Ptr("browser")}),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := DeviceID(tt.userAgent)
+			assert.Equal(t, tt.want, got)
+			if tt.want != "" && tt.userAgent.FingerprintID == nil {
+				assert.Regexp(t, "^ua:[0-9a-f]{32}$", got)
+			}
+		})
+	}
+}
+
+func TestEvaluator_Observe(t *testing.T) {
+	locator := mapLocator{
+		"192.0.2.1": {country: "CH", location: zurich},
+	}
+	userAgent := &domain.UserAgent{FingerprintID: gu.Ptr("device"), IP: net.IPv4(192, 0, 2, 1)}
+
+	observation := NewEvaluator(locator, 10, 1000).Observe(userAgent, testNow)
+	assert.Equal(t, Observation{DeviceID: "device", Country: "CH", Location: zurich, At: testNow}, observation)
+
+	observation = NewEvaluator(nil, 10, 1000).Observe(userAgent, testNow)
+	assert.Equal(t, Observation{DeviceID: "device", At: testNow}, observation)
+}
+
+func TestEvaluator_Evaluate(t *testing.T) {
+	tests := []struct {
+		name    string
+		current Observation
+		recent  []Observation
+		want    *domain.SessionRisk
+	}{
+		{
+			name:    "no recent sessions",
+			current: Observation{DeviceID: "device", Country: "CH", Location: zurich, At: testNow},
+			want:    &domain.SessionRisk{Level: domain.SessionRiskLevelLow, Country: "CH"},
+		},
+		{
+			name:    "known device and country",
+			current: Observation{DeviceID: "device", Country: "CH", Location: zurich, At: testNow},
+			recent: []Observation{
+				{DeviceID: "other", Country: "DE", At: testNow.Add(-time.Hour)},
+				{DeviceID: "device", Country: "CH", Location: bern, At: testNow.Add(-2 * time.Hour)},
+			},
+			want: &domain.SessionRisk{Level: domain.SessionRiskLevelLow, Country: "CH"},
+		},
+		{
+			name:    "new device",
+			current: Observation{DeviceID: "device", Country: "CH", At: testNow},
+			recent: []Observation{
+				{DeviceID: "other", Country: "CH", At: testNow.Add(-time.Hour)},
+			},
+			want: &domain.SessionRisk{Level: domain.SessionRiskLevelMedium, NewDevice: true, Country: "CH"},
+		},
+		{
+			name:    "new country",
+			current: Observation{DeviceID: "device", Country: "US", At: testNow},
+			recent: []Observation{
+				{DeviceID: "device", Country: "CH", At: testNow.Add(-time.Hour)},
+			},
+			want: &domain.SessionRisk{Level: domain.SessionRiskLevelMedium, NewCountry: true, Country: "US"},
+		},
+		{
+			name:    "recent sessions not located, no new country",
+			current: Observation{DeviceID: "device", Country: "US", At: testNow},
+			recent: []Observation{
+				{DeviceID: "device", At: testNow.Add(-time.Hour)},
+			},
+			want: &domain.SessionRisk{Level: domain.SessionRiskLevelLow, Country: "US"},
+		},
+		{
+			name:    "new device and new country",
+			current: Observation{DeviceID: "device", Country: "US", At: testNow},
+			recent: []Observation{
+				{DeviceID: "other", Country: "CH", At: testNow.Add(-time.Hour)},
+			},
+			want: &domain.SessionRisk{Level: domain.SessionRiskLevelHigh, NewDevice: true, NewCountry: true, Country: "US"},
+		},
+		{
+			name:    "impossible travel",
+			current: Observation{DeviceID: "device", Country: "US", Location: newYork, At: testNow},
+			recent: []Observation{
+				{DeviceID: "device", Country: "US", At: testNow.Add(-time.Minute)},
+				{DeviceID: "device", Country: "CH", Location: zurich, At: testNow.Add(-time.Hour)},
+			},
+			want: &domain.SessionRisk{Level: domain.SessionRiskLevelHigh, ImpossibleTravel: true, Country: "US"},
+		},
+		{
+			name:    "possible travel",
+			current: Observation{DeviceID: "device", Country: "US", Location: newYork, At: testNow},
+			recent: []Observation{
+				{DeviceID: "device", Country: "CH", Location: zurich, At: testNow.Add(-12 * time.Hour)},
+			},
+			want: &domain.SessionRisk{Level: domain.SessionRiskLevelMedium, NewCountry: true, Country: "US"},
+		},
+		{
+			name:    "short distance",
+			current: Observation{DeviceID: "device", Country: "CH", Location: zurich, At: testNow},
+			recent: []Observation{
+				{DeviceID: "device", Country: "CH", Location: bern, At: testNow},
+			},
+			want: &domain.SessionRisk{Level: domain.SessionRiskLevelLow, Country: "CH"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := NewEvaluator(nil, 10, defaultMaxTravelSpeed).Evaluate(tt.current, tt.recent)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_distance(t *testing.T) {
+	assert.InDelta(t, 6320, distance(zurich, newYork), 20)
+	assert.InDelta(t, 0, distance(zurich, zurich), 0.001)
+}
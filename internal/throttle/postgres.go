@@ -0,0 +1,83 @@
+package throttle
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+const (
+	// addFailureStmt records the failure and counts the failures of the key within the window.
+	// The count doesn't see the inserted row, as all parts of the statement share the same snapshot.
+	addFailureStmt = "WITH added AS (INSERT INTO system.login_failures (key, failed_at, expires_at) VALUES ($1, $2, $4))" +
+		" SELECT count(*) + 1 FROM system.login_failures WHERE key = $1 AND failed_at > $3"
+	blockStmt = "INSERT INTO system.login_blocks (key, blocked_until) VALUES ($1, $2)" +
+		" ON CONFLICT (key) DO UPDATE SET blocked_until = EXCLUDED.blocked_until"
+	blockedUntilStmt = "SELECT blocked_until FROM system.login_blocks WHERE key = $1 AND blocked_until > $2"
+	resetStmt        = "WITH failures AS (DELETE FROM system.login_failures WHERE key = $1)" +
+		" DELETE FROM system.login_blocks WHERE key = $1"
+	pruneStmt = "WITH failures AS (DELETE FROM system.login_failures WHERE expires_at <= now())" +
+		" DELETE FROM system.login_blocks WHERE blocked_until <= now()"
+)
+
+type postgresStore struct {
+	client *database.DB
+}
+
+// NewPostgresStore returns a [Store] using the system.login_failures and system.login_blocks tables.
+// Expired failures and blocks are only removed by [postgresStore.Prune].
+func NewPostgresStore(client *database.DB) Store {
+	return &postgresStore{client: client}
+}
+
+func (s *postgresStore) AddFailure(ctx context.Context, key string, at time.Time, window time.Duration) (count uint64, err error) {
+	err = s.client.QueryRowContext(ctx, func(row *sql.Row) error {
+		return row.Scan(&count)
+	}, addFailureStmt, key, at, at.Add(-window), at.Add(window))
+	if err != nil {
+		return 0, zerrors.ThrowInternal(err, "THROT-Pg1fa", "unable to record login failure")
+	}
+	return count, nil
+}
+
+func (s *postgresStore) Block(ctx context.Context, key string, until time.Time) error {
+	_, err := s.client.ExecContext(ctx, blockStmt, key, until)
+	if err != nil {
+		return zerrors.ThrowInternal(err, "THROT-Pg2bl", "unable to block login")
+	}
+	return nil
+}
+
+func (s *postgresStore) BlockedUntil(ctx context.Context, key string, now time.Time) (until time.Time, err error) {
+	err = s.client.QueryRowContext(ctx, func(row *sql.Row) error {
+		return row.Scan(&until)
+	}, blockedUntilStmt, key, now)
+	if errors.Is(err, sql.ErrNoRows) {
+		return time.Time{}, nil
+	}
+	if err != nil {
+		return time.Time{}, zerrors.ThrowInternal(err, "THROT-Pg3bu", "unable to query login block")
+	}
+	return until, nil
+}
+
+func (s *postgresStore) Reset(ctx context.Context, key string) error {
+	_, err := s.client.ExecContext(ctx, resetStmt, key)
+	if err != nil {
+		return zerrors.ThrowInternal(err, "THROT-Pg4re", "unable to reset login failures")
+	}
+	return nil
+}
+
+// Prune removes the expired failures and blocks of all keys.
+func (s *postgresStore) Prune(ctx context.Context) error {
+	_, err := s.client.ExecContext(ctx, pruneStmt)
+	if err != nil {
+		return zerrors.ThrowInternal(err, "THROT-Pg5pr", "unable to prune login failures")
+	}
+	return nil
+}
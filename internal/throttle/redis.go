@@ -0,0 +1,83 @@
+package throttle
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+
+	redis_connector "github.com/zitadel/zitadel/internal/cache/connector/redis"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+const (
+	failuresKeyPrefix = "failures:"
+	blockKeyPrefix    = "block:"
+)
+
+type redisStore struct {
+	client *redis.Client
+}
+
+// NewRedisStore returns a [Store] using a sorted set per key for the failures and a string per key for the block.
+// The keys are stored in the DB namespace db, on the server of the connector.
+func NewRedisStore(connector *redis_connector.Connector, db int) Store {
+	options := *connector.Options()
+	options.DB = db
+	return &redisStore{client: redis.NewClient(&options)}
+}
+
+func (s *redisStore) AddFailure(ctx context.Context, key string, at time.Time, window time.Duration) (uint64, error) {
+	key = failuresKeyPrefix + key
+	var count *redis.IntCmd
+	_, err := s.client.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.ZRemRangeByScore(ctx, key, "-inf", strconv.FormatInt(at.Add(-window).UnixMilli(), 10))
+		pipe.ZAdd(ctx, key, redis.Z{Score: float64(at.UnixMilli()), Member: uuid.NewString()})
+		pipe.PExpire(ctx, key, window)
+		count = pipe.ZCard(ctx, key)
+		return nil
+	})
+	if err != nil {
+		return 0, zerrors.ThrowInternal(err, "THROT-Rd1fa", "unable to record login failure")
+	}
+	return uint64(count.Val()), nil
+}
+
+func (s *redisStore) Block(ctx context.Context, key string, until time.Time) error {
+	key = blockKeyPrefix + key
+	_, err := s.client.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.Set(ctx, key, until.UnixMilli(), 0)
+		pipe.PExpireAt(ctx, key, until)
+		return nil
+	})
+	if err != nil {
+		return zerrors.ThrowInternal(err, "THROT-Rd2bl", "unable to block login")
+	}
+	return nil
+}
+
+func (s *redisStore) BlockedUntil(ctx context.Context, key string, now time.Time) (time.Time, error) {
+	until, err := s.client.Get(ctx, blockKeyPrefix+key).Int64()
+	if errors.Is(err, redis.Nil) {
+		return time.Time{}, nil
+	}
+	if err != nil {
+		return time.Time{}, zerrors.ThrowInternal(err, "THROT-Rd3bu", "unable to query login block")
+	}
+	blockedUntil := time.UnixMilli(until)
+	if !blockedUntil.After(now) {
+		return time.Time{}, nil
+	}
+	return blockedUntil, nil
+}
+
+func (s *redisStore) Reset(ctx context.Context, key string) error {
+	err := s.client.Del(ctx, failuresKeyPrefix+key, blockKeyPrefix+key).Err()
+	if err != nil {
+		return zerrors.ThrowInternal(err, "THROT-Rd4re", "unable to reset login failures")
+	}
+	return nil
+}
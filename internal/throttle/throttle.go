@@ -0,0 +1,234 @@
+// Package throttle throttles failed password and OTP checks by IP address and by client.
+//
+// Failures are counted in a sliding window per IP address and per user and client,
+// where the client is identified by the IP address and the user agent.
+// Each failure of a user from the same client delays the next check (doubling with each failure)
+// and reaching the maximum attempts of the lockout policy blocks further checks for the block duration.
+// Unlike the lockout of the user, the throttling only affects the offending IP address or client,
+// so an attacker is not able to lock out the user by spraying passwords.
+package throttle
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"net"
+	"time"
+
+	"github.com/zitadel/logging"
+
+	http_util "github.com/zitadel/zitadel/internal/api/http"
+	"github.com/zitadel/zitadel/internal/cache"
+	"github.com/zitadel/zitadel/internal/cache/connector/redis"
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// Store keeps the failures and blocks of the throttled keys.
+type Store interface {
+	// AddFailure records a failure of the key at the given time
+	// and returns the amount of failures within the window, including the recorded one.
+	AddFailure(ctx context.Context, key string, at time.Time, window time.Duration) (uint64, error)
+	// Block blocks the key until the given time.
+	Block(ctx context.Context, key string, until time.Time) error
+	// BlockedUntil returns the time until the key is blocked or the zero time if it's not blocked.
+	BlockedUntil(ctx context.Context, key string, now time.Time) (time.Time, error)
+	// Reset removes the failures and the block of the key.
+	Reset(ctx context.Context, key string) error
+}
+
+type StoreType string
+
+const (
+	StorePostgres StoreType = "postgres"
+	StoreRedis    StoreType = "redis"
+)
+
+type Config struct {
+	// Store for the failures and blocks, defaults to postgres.
+	Store StoreType
+	// AutoPrune removes the expired failures and blocks from the postgres store.
+	// The redis store expires them by itself.
+	AutoPrune cache.AutoPruneConfig
+}
+
+// NewThrottler returns a [Throttler] using the configured store.
+// The pruning of the postgres store is stopped when the background context is canceled.
+func (c *Config) NewThrottler(background context.Context, client *database.DB, redisConnector *redis.Connector) (*Throttler, error) {
+	switch c.Store {
+	case "", StorePostgres:
+		store := &postgresStore{client: client}
+		c.AutoPrune.StartAutoPrune(background, store, cache.PurposeLoginThrottling)
+		return NewThrottler(store), nil
+	case StoreRedis:
+		if redisConnector == nil {
+			return nil, zerrors.ThrowInvalidArgument(nil, "THROT-Re1is", "redis store requires the redis cache connector")
+		}
+		return NewThrottler(NewRedisStore(redisConnector, redisConnector.Config.DBOffset+int(cache.PurposeLoginThrottling))), nil
+	default:
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "THROT-St0re", "unknown store %q", c.Store)
+	}
+}
+
+// Client identifies the origin of a check.
+type Client struct {
+	IP        string
+	UserAgent string
+}
+
+// ClientFromCtx returns the IP address and user agent of the request.
+func ClientFromCtx(ctx context.Context) Client {
+	client := Client{
+		IP: http_util.RemoteIPFromCtx(ctx),
+	}
+	if host, _, err := net.SplitHostPort(client.IP); err == nil {
+		client.IP = host
+	}
+	if headers, ok := http_util.HeadersFromCtx(ctx); ok {
+		client.UserAgent = headers.Get(http_util.UserAgentHeader)
+	}
+	return client
+}
+
+type Throttler struct {
+	store Store
+	now   func() time.Time
+}
+
+func NewThrottler(store Store) *Throttler {
+	return &Throttler{
+		store: store,
+		now:   time.Now,
+	}
+}
+
+// CheckClient returns a resource exhausted error if the checks from the IP address of the client are blocked.
+// Blocks are only recorded if a policy enables the throttling, so unlike [Throttler.Check] it doesn't require the policy
+// and can be called before the user is known.
+// A nil Throttler never blocks.
+func (t *Throttler) CheckClient(ctx context.Context, instanceID string, client Client) error {
+	if t == nil || client.IP == "" {
+		return nil
+	}
+	now := t.now()
+	until, err := t.store.BlockedUntil(ctx, ipKey(instanceID, client), now)
+	if err != nil {
+		logging.WithError(err).Error("unable to check login throttling")
+		return nil
+	}
+	if now.Before(until) {
+		return zerrors.ThrowResourceExhausted(nil, "THROT-Bl1ck", "Errors.User.Throttled")
+	}
+	return nil
+}
+
+// Check returns a resource exhausted error if the checks of the user from the client are blocked.
+// Store errors are only logged, so an unavailable store doesn't prevent users from logging in.
+// A nil Throttler never blocks.
+func (t *Throttler) Check(ctx context.Context, policy *domain.LockoutPolicy, instanceID, userID string, client Client) error {
+	if t == nil || policy == nil || !policy.ThrottlingEnabled() {
+		return nil
+	}
+	now := t.now()
+	for _, key := range t.keys(instanceID, userID, client) {
+		until, err := t.store.BlockedUntil(ctx, key, now)
+		if err != nil {
+			logging.WithError(err).Error("unable to check login throttling")
+			continue
+		}
+		if now.Before(until) {
+			return zerrors.ThrowResourceExhausted(nil, "THROT-Bl0ck", "Errors.User.Throttled")
+		}
+	}
+	return nil
+}
+
+// Failed records a failed check of the user from the client
+// and blocks further checks according to the policy.
+func (t *Throttler) Failed(ctx context.Context, policy *domain.LockoutPolicy, instanceID, userID string, client Client) {
+	if t == nil || policy == nil || !policy.ThrottlingEnabled() {
+		return
+	}
+	t.FailedClient(ctx, policy, instanceID, client)
+	if policy.MaxClientAttempts > 0 || policy.ThrottleDelay > 0 {
+		t.fail(ctx, clientKey(instanceID, userID, client), t.now(), policy.MaxClientAttempts, policy.ThrottleDelay, policy)
+	}
+}
+
+// FailedClient records a failed check from the IP address of the client
+// and blocks further checks from it according to the policy.
+// It's used for failed checks of unknown users, which can't be attributed to a user.
+func (t *Throttler) FailedClient(ctx context.Context, policy *domain.LockoutPolicy, instanceID string, client Client) {
+	if t == nil || policy == nil || !policy.ThrottlingEnabled() {
+		return
+	}
+	if client.IP != "" && policy.MaxIPAttempts > 0 {
+		t.fail(ctx, ipKey(instanceID, client), t.now(), policy.MaxIPAttempts, 0, policy)
+	}
+}
+
+// Succeeded resets the failures of the user from the client.
+// The failures of the IP address are kept, so a successful login of an attacker's own account doesn't reset them.
+func (t *Throttler) Succeeded(ctx context.Context, policy *domain.LockoutPolicy, instanceID, userID string, client Client) {
+	if t == nil || policy == nil || !policy.ThrottlingEnabled() {
+		return
+	}
+	err := t.store.Reset(ctx, clientKey(instanceID, userID, client))
+	logging.OnError(err).Error("unable to reset login throttling")
+}
+
+func (t *Throttler) fail(ctx context.Context, key string, now time.Time, maxAttempts uint64, delay time.Duration, policy *domain.LockoutPolicy) {
+	count, err := t.store.AddFailure(ctx, key, now, policy.ThrottleWindow)
+	if err != nil {
+		logging.WithError(err).Error("unable to record login failure")
+		return
+	}
+	until := blockedUntil(now, count, maxAttempts, delay, policy)
+	if until.IsZero() {
+		return
+	}
+	err = t.store.Block(ctx, key, until)
+	logging.OnError(err).Error("unable to block login")
+}
+
+func (t *Throttler) keys(instanceID, userID string, client Client) []string {
+	keys := make([]string, 0, 2)
+	if client.IP != "" {
+		keys = append(keys, ipKey(instanceID, client))
+	}
+	return append(keys, clientKey(instanceID, userID, client))
+}
+
+// maxDelayShift prevents the progressive delay from overflowing.
+const maxDelayShift = 32
+
+// blockedUntil returns the end of the block after count failures within the window.
+// Reaching maxAttempts blocks for the block duration of the policy (or the window if not set),
+// before that each failure delays the next check by the delay, doubled with every failure.
+func blockedUntil(now time.Time, count, maxAttempts uint64, delay time.Duration, policy *domain.LockoutPolicy) time.Time {
+	blockDuration := policy.ThrottleBlockDuration
+	if blockDuration == 0 {
+		blockDuration = policy.ThrottleWindow
+	}
+	if maxAttempts > 0 && count >= maxAttempts {
+		return now.Add(blockDuration)
+	}
+	if delay == 0 || count == 0 {
+		return time.Time{}
+	}
+	delay <<= min(count-1, maxDelayShift)
+	if delay <= 0 || delay > blockDuration {
+		delay = blockDuration
+	}
+	return now.Add(delay)
+}
+
+func ipKey(instanceID string, client Client) string {
+	return instanceID + ":ip:" + client.IP
+}
+
+func clientKey(instanceID, userID string, client Client) string {
+	userAgent := sha256.Sum256([]byte(client.UserAgent))
+	return instanceID + ":client:" + userID + ":" + client.IP + ":" + hex.EncodeToString(userAgent[:8])
+}
@@ -0,0 +1,347 @@
+package throttle
+
+import (
+	"context"
+	"database/sql/driver"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zitadel/zitadel/internal/cache/connector/redis"
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/database/mock"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+var testNow = time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+
+type memoryStore struct {
+	failures map[string][]time.Time
+	blocks   map[string]time.Time
+}
+
+func newMemoryStore() *memoryStore {
+	return &memoryStore{
+		failures: make(map[string][]time.Time),
+		blocks:   make(map[string]time.Time),
+	}
+}
+
+func (s *memoryStore) AddFailure(_ context.Context, key string, at time.Time, window time.Duration) (uint64, error) {
+	failures := make([]time.Time, 0, len(s.failures[key])+1)
+	for _, failedAt := range s.failures[key] {
+		if failedAt.After(at.Add(-window)) {
+			failures = append(failures, failedAt)
+		}
+	}
+	s.failures[key] = append(failures, at)
+	return uint64(len(s.failures[key])), nil
+}
+
+func (s *memoryStore) Block(_ context.Context, key string, until time.Time) error {
+	s.blocks[key] = until
+	return nil
+}
+
+func (s *memoryStore) BlockedUntil(_ context.Context, key string, now time.Time) (time.Time, error) {
+	if until := s.blocks[key]; until.After(now) {
+		return until, nil
+	}
+	return time.Time{}, nil
+}
+
+func (s *memoryStore) Reset(_ context.Context, key string) error {
+	delete(s.failures, key)
+	delete(s.blocks, key)
+	return nil
+}
+
+func Test_blockedUntil(t *testing.T) {
+	policy := &domain.LockoutPolicy{
+		ThrottleWindow:        time.Hour,
+		ThrottleBlockDuration: 10 * time.Minute,
+	}
+	tests := []struct {
+		name        string
+		count       uint64
+		maxAttempts uint64
+		delay       time.Duration
+		policy      *domain.LockoutPolicy
+		want        time.Time
+	}{
+		{
+			name:        "below max attempts, no delay",
+			count:       2,
+			maxAttempts: 3,
+			policy:      policy,
+		},
+		{
+			name:        "max attempts reached",
+			count:       3,
+			maxAttempts: 3,
+			policy:      policy,
+			want:        testNow.Add(10 * time.Minute),
+		},
+		{
+			name:        "max attempts reached, block duration defaults to window",
+			count:       3,
+			maxAttempts: 3,
+			policy:      &domain.LockoutPolicy{ThrottleWindow: time.Hour},
+			want:        testNow.Add(time.Hour),
+		},
+		{
+			name:   "first failure, delay",
+			count:  1,
+			delay:  time.Second,
+			policy: policy,
+			want:   testNow.Add(time.Second),
+		},
+		{
+			name:   "third failure, doubled delay",
+			count:  3,
+			delay:  time.Second,
+			policy: policy,
+			want:   testNow.Add(4 * time.Second),
+		},
+		{
+			name:   "delay capped at block duration",
+			count:  100,
+			delay:  time.Second,
+			policy: policy,
+			want:   testNow.Add(10 * time.Minute),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := blockedUntil(testNow, tt.count, tt.maxAttempts, tt.delay, tt.policy)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestThrottler(t *testing.T) {
+	policy := &domain.LockoutPolicy{
+		MaxIPAttempts:     3,
+		MaxClientAttempts: 2,
+		ThrottleWindow:    time.Hour,
+	}
+	attacker := Client{IP: "192.0.2.1", UserAgent: "attacker"}
+	user := Client{IP: "198.51.100.1", UserAgent: "user"}
+	ctx := context.Background()
+
+	t.Run("nil throttler never blocks", func(t *testing.T) {
+		var throttler *Throttler
+		throttler.Failed(ctx, policy, "instance", "user1", attacker)
+		throttler.FailedClient(ctx, policy, "instance", attacker)
+		assert.NoError(t, throttler.Check(ctx, policy, "instance", "user1", attacker))
+		assert.NoError(t, throttler.CheckClient(ctx, "instance", attacker))
+	})
+	t.Run("throttling disabled", func(t *testing.T) {
+		store := newMemoryStore()
+		throttler := &Throttler{store: store, now: func() time.Time { return testNow }}
+		disabled := &domain.LockoutPolicy{MaxClientAttempts: 1}
+		throttler.Failed(ctx, disabled, "instance", "user1", attacker)
+		assert.Empty(t, store.failures)
+		assert.NoError(t, throttler.Check(ctx, disabled, "instance", "user1", attacker))
+	})
+	t.Run("client blocked, other client allowed", func(t *testing.T) {
+		throttler := &Throttler{store: newMemoryStore(), now: func() time.Time { return testNow }}
+		throttler.Failed(ctx, policy, "instance", "user1", attacker)
+		require.NoError(t, throttler.Check(ctx, policy, "instance", "user1", attacker))
+		throttler.Failed(ctx, policy, "instance", "user1", attacker)
+		assert.True(t, zerrors.IsResourceExhausted(throttler.Check(ctx, policy, "instance", "user1", attacker)))
+		assert.NoError(t, throttler.Check(ctx, policy, "instance", "user1", user))
+		assert.NoError(t, throttler.Check(ctx, policy, "instance", "user1", Client{IP: attacker.IP, UserAgent: "other"}))
+	})
+	t.Run("ip blocked for all users", func(t *testing.T) {
+		throttler := &Throttler{store: newMemoryStore(), now: func() time.Time { return testNow }}
+		throttler.Failed(ctx, policy, "instance", "user1", attacker)
+		throttler.Failed(ctx, policy, "instance", "user2", attacker)
+		throttler.Failed(ctx, policy, "instance", "user3", attacker)
+		assert.True(t, zerrors.IsResourceExhausted(throttler.Check(ctx, policy, "instance", "user4", attacker)))
+		assert.NoError(t, throttler.Check(ctx, policy, "instance", "user4", user))
+		assert.NoError(t, throttler.Check(ctx, policy, "other", "user4", attacker))
+	})
+	t.Run("block expires", func(t *testing.T) {
+		now := testNow
+		throttler := &Throttler{store: newMemoryStore(), now: func() time.Time { return now }}
+		throttler.Failed(ctx, policy, "instance", "user1", attacker)
+		throttler.Failed(ctx, policy, "instance", "user1", attacker)
+		require.Error(t, throttler.Check(ctx, policy, "instance", "user1", attacker))
+		now = now.Add(time.Hour)
+		assert.NoError(t, throttler.Check(ctx, policy, "instance", "user1", attacker))
+	})
+	t.Run("ip blocked before user is known", func(t *testing.T) {
+		throttler := &Throttler{store: newMemoryStore(), now: func() time.Time { return testNow }}
+		require.NoError(t, throttler.CheckClient(ctx, "instance", attacker))
+		throttler.FailedClient(ctx, policy, "instance", attacker)
+		throttler.FailedClient(ctx, policy, "instance", attacker)
+		require.NoError(t, throttler.CheckClient(ctx, "instance", attacker))
+		throttler.Failed(ctx, policy, "instance", "user1", attacker)
+		assert.True(t, zerrors.IsResourceExhausted(throttler.CheckClient(ctx, "instance", attacker)))
+		assert.True(t, zerrors.IsResourceExhausted(throttler.Check(ctx, policy, "instance", "user2", attacker)))
+		assert.NoError(t, throttler.CheckClient(ctx, "instance", user))
+		assert.NoError(t, throttler.CheckClient(ctx, "instance", Client{UserAgent: "no ip"}))
+	})
+	t.Run("unknown user failures don't block the client of a user", func(t *testing.T) {
+		store := newMemoryStore()
+		throttler := &Throttler{store: store, now: func() time.Time { return testNow }}
+		throttler.FailedClient(ctx, policy, "instance", attacker)
+		throttler.FailedClient(ctx, policy, "instance", attacker)
+		assert.Len(t, store.failures, 1)
+		assert.NoError(t, throttler.Check(ctx, policy, "instance", "user1", attacker))
+	})
+	t.Run("success resets client failures", func(t *testing.T) {
+		throttler := &Throttler{store: newMemoryStore(), now: func() time.Time { return testNow }}
+		throttler.Failed(ctx, policy, "instance", "user1", user)
+		throttler.Succeeded(ctx, policy, "instance", "user1", user)
+		throttler.Failed(ctx, policy, "instance", "user1", user)
+		assert.NoError(t, throttler.Check(ctx, policy, "instance", "user1", user))
+	})
+}
+
+func TestPostgresStore(t *testing.T) {
+	ctx := context.Background()
+	key := "instance:ip:192.0.2.1"
+
+	t.Run("add failure", func(t *testing.T) {
+		m := mock.NewSQLMock(t,
+			mock.ExpectQuery(addFailureStmt,
+				mock.WithQueryArgs(key, testNow, testNow.Add(-time.Hour), testNow.Add(time.Hour)),
+				mock.WithQueryResult([]string{"count"}, [][]driver.Value{{2}}),
+			),
+		)
+		defer m.Assert(t)
+		count, err := NewPostgresStore(&database.DB{DB: m.DB}).AddFailure(ctx, key, testNow, time.Hour)
+		require.NoError(t, err)
+		assert.Equal(t, uint64(2), count)
+	})
+	t.Run("add failure error", func(t *testing.T) {
+		m := mock.NewSQLMock(t,
+			mock.ExpectQuery(addFailureStmt,
+				mock.WithQueryArgs(key, testNow, testNow.Add(-time.Hour), testNow.Add(time.Hour)),
+				mock.WithQueryErr(zerrors.ThrowInternal(nil, "id", "message")),
+			),
+		)
+		defer m.Assert(t)
+		_, err := NewPostgresStore(&database.DB{DB: m.DB}).AddFailure(ctx, key, testNow, time.Hour)
+		assert.True(t, zerrors.IsInternal(err))
+	})
+	t.Run("block", func(t *testing.T) {
+		m := mock.NewSQLMock(t,
+			mock.ExcpectExec(blockStmt,
+				mock.WithExecArgs(key, testNow),
+				mock.WithExecRowsAffected(1),
+			),
+		)
+		defer m.Assert(t)
+		err := NewPostgresStore(&database.DB{DB: m.DB}).Block(ctx, key, testNow)
+		require.NoError(t, err)
+	})
+	t.Run("blocked until", func(t *testing.T) {
+		m := mock.NewSQLMock(t,
+			mock.ExpectQuery(blockedUntilStmt,
+				mock.WithQueryArgs(key, testNow),
+				mock.WithQueryResult([]string{"blocked_until"}, [][]driver.Value{{testNow.Add(time.Minute)}}),
+			),
+		)
+		defer m.Assert(t)
+		until, err := NewPostgresStore(&database.DB{DB: m.DB}).BlockedUntil(ctx, key, testNow)
+		require.NoError(t, err)
+		assert.Equal(t, testNow.Add(time.Minute), until)
+	})
+	t.Run("not blocked", func(t *testing.T) {
+		m := mock.NewSQLMock(t,
+			mock.ExpectQuery(blockedUntilStmt,
+				mock.WithQueryArgs(key, testNow),
+				mock.WithQueryResult([]string{"blocked_until"}, [][]driver.Value{}),
+			),
+		)
+		defer m.Assert(t)
+		until, err := NewPostgresStore(&database.DB{DB: m.DB}).BlockedUntil(ctx, key, testNow)
+		require.NoError(t, err)
+		assert.True(t, until.IsZero())
+	})
+	t.Run("reset", func(t *testing.T) {
+		m := mock.NewSQLMock(t,
+			mock.ExcpectExec(resetStmt,
+				mock.WithExecArgs(key),
+				mock.WithExecRowsAffected(1),
+			),
+		)
+		defer m.Assert(t)
+		err := NewPostgresStore(&database.DB{DB: m.DB}).Reset(ctx, key)
+		require.NoError(t, err)
+	})
+	t.Run("prune", func(t *testing.T) {
+		m := mock.NewSQLMock(t,
+			mock.ExcpectExec(pruneStmt,
+				mock.WithExecRowsAffected(3),
+			),
+		)
+		defer m.Assert(t)
+		err := (&postgresStore{client: &database.DB{DB: m.DB}}).Prune(ctx)
+		require.NoError(t, err)
+	})
+	t.Run("prune error", func(t *testing.T) {
+		m := mock.NewSQLMock(t,
+			mock.ExcpectExec(pruneStmt,
+				mock.WithExecErr(zerrors.ThrowInternal(nil, "id", "message")),
+			),
+		)
+		defer m.Assert(t)
+		err := (&postgresStore{client: &database.DB{DB: m.DB}}).Prune(ctx)
+		assert.True(t, zerrors.IsInternal(err))
+	})
+}
+
+func TestRedisStore(t *testing.T) {
+	ctx := context.Background()
+	server := miniredis.RunT(t)
+	connector := redis.NewConnector(redis.Config{
+		Enabled:          true,
+		Network:          "tcp",
+		Addr:             server.Addr(),
+		DisableIndentity: true,
+	})
+	t.Cleanup(func() {
+		connector.Close()
+	})
+	store := NewRedisStore(connector, 9)
+	key := "instance:ip:192.0.2.1"
+
+	count, err := store.AddFailure(ctx, key, testNow.Add(-2*time.Hour), time.Hour)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(1), count)
+	count, err = store.AddFailure(ctx, key, testNow.Add(-time.Minute), time.Hour)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(1), count, "failure outside of the window must be removed")
+	count, err = store.AddFailure(ctx, key, testNow, time.Hour)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(2), count)
+
+	until, err := store.BlockedUntil(ctx, key, testNow)
+	require.NoError(t, err)
+	assert.True(t, until.IsZero())
+
+	blockUntil := time.Now().Add(time.Hour).Truncate(time.Millisecond)
+	require.NoError(t, store.Block(ctx, key, blockUntil))
+	until, err = store.BlockedUntil(ctx, key, time.Now())
+	require.NoError(t, err)
+	assert.True(t, blockUntil.Equal(until))
+	until, err = store.BlockedUntil(ctx, key, blockUntil)
+	require.NoError(t, err)
+	assert.True(t, until.IsZero())
+
+	require.NoError(t, store.Reset(ctx, key))
+	until, err = store.BlockedUntil(ctx, key, time.Now())
+	require.NoError(t, err)
+	assert.True(t, until.IsZero())
+	count, err = store.AddFailure(ctx, key, testNow, time.Hour)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(1), count)
+}
@@ -26,6 +26,7 @@ type UserSessionView struct {
 	SecondFactorVerificationType domain.MFAType
 	MultiFactorVerification      time.Time
 	MultiFactorVerificationType  domain.MFAType
+	MagicLinkVerification        time.Time
 	Sequence                     uint64
 	ID                           string
 }
@@ -51,6 +51,7 @@ type HumanView struct {
 	OTPState                 MFAState
 	OTPSMSAdded              bool
 	OTPEmailAdded            bool
+	RecoveryCodesRemaining   uint64
 	U2FTokens                []*WebAuthNView
 	PasswordlessTokens       []*WebAuthNView
 	MFAMaxSetUp              domain.MFALevel
@@ -208,6 +209,10 @@ func (u *UserView) MFATypesAllowed(level domain.MFALevel, policy *domain.LoginPo
 					}
 				}
 			}
+			// recovery codes are not part of the policy, but a fallback for any allowed second factor
+			if u.RecoveryCodesRemaining > 0 {
+				types = append(types, domain.MFATypeRecoveryCode)
+			}
 		}
 	}
 	return types, required
@@ -90,6 +90,7 @@ type HumanView struct {
 	OTPState                 int32          `json:"-" gorm:"column:otp_state"`
 	OTPSMSAdded              bool           `json:"-" gorm:"column:otp_sms_added"`
 	OTPEmailAdded            bool           `json:"-" gorm:"column:otp_email_added"`
+	RecoveryCodesRemaining   uint64         `json:"-" gorm:"column:recovery_codes_remaining"`
 	U2FTokens                WebAuthNTokens `json:"-" gorm:"column:u2f_tokens"`
 	MFAMaxSetUp              int32          `json:"-" gorm:"column:mfa_max_set_up"`
 	MFAInitSkipped           time.Time      `json:"-" gorm:"column:mfa_init_skipped"`
@@ -182,6 +183,7 @@ func UserToModel(user *UserView) *model.UserView {
 			OTPState:                 model.MFAState(user.OTPState),
 			OTPSMSAdded:              user.OTPSMSAdded,
 			OTPEmailAdded:            user.OTPEmailAdded,
+			RecoveryCodesRemaining:   user.RecoveryCodesRemaining,
 			MFAMaxSetUp:              domain.MFALevel(user.MFAMaxSetUp),
 			MFAInitSkipped:           user.MFAInitSkipped,
 			InitRequired:             user.InitRequired,
@@ -264,6 +266,10 @@ func (u *UserView) AppendEvent(event eventstore.Event) (err error) {
 	case user.UserV1PasswordChangedType,
 		user.HumanPasswordChangedType:
 		err = u.setPasswordData(event)
+	case user.HumanPasswordBreachedType:
+		if u.HumanView != nil {
+			u.HumanView.PasswordChangeRequired = true
+		}
 	case user.HumanPasswordlessTokenAddedType:
 		err = u.addPasswordlessToken(event)
 	case user.HumanPasswordlessTokenVerifiedType:
@@ -341,6 +347,14 @@ func (u *UserView) AppendEvent(event eventstore.Event) (err error) {
 	case user.HumanOTPEmailRemovedType:
 		u.OTPEmailAdded = false
 		u.MFAInitSkipped = time.Time{}
+	case user.HumanRecoveryCodesGeneratedType:
+		err = u.setRecoveryCodes(event)
+	case user.HumanRecoveryCodeCheckSucceededType:
+		if u.RecoveryCodesRemaining > 0 {
+			u.RecoveryCodesRemaining--
+		}
+	case user.HumanRecoveryCodesRemovedType:
+		u.RecoveryCodesRemaining = 0
 	case user.HumanU2FTokenAddedType:
 		err = u.addU2FToken(event)
 	case user.HumanU2FTokenVerifiedType:
@@ -499,6 +513,15 @@ func (u *UserView) removeU2FToken(event eventstore.Event) error {
 	return nil
 }
 
+func (u *UserView) setRecoveryCodes(event eventstore.Event) error {
+	codes := new(user.HumanRecoveryCodesGeneratedEvent)
+	if err := event.Unmarshal(codes); err != nil {
+		return zerrors.ThrowInternal(err, "MODEL-Rc1vu", "could not unmarshal data")
+	}
+	u.RecoveryCodesRemaining = uint64(len(codes.CodeHashes))
+	return nil
+}
+
 func webAuthNViewFromEvent(event eventstore.Event) (*WebAuthNView, error) {
 	token := new(WebAuthNView)
 	err := event.Unmarshal(token)
@@ -565,6 +588,7 @@ func (u *UserView) EventTypes() []eventstore.EventType {
 		user.UserRemovedType,
 		user.UserV1PasswordChangedType,
 		user.HumanPasswordChangedType,
+		user.HumanPasswordBreachedType,
 		user.HumanPasswordlessTokenAddedType,
 		user.HumanPasswordlessTokenVerifiedType,
 		user.HumanPasswordlessTokenRemovedType,
@@ -599,6 +623,9 @@ func (u *UserView) EventTypes() []eventstore.EventType {
 		user.HumanOTPSMSRemovedType,
 		user.HumanOTPEmailAddedType,
 		user.HumanOTPEmailRemovedType,
+		user.HumanRecoveryCodesGeneratedType,
+		user.HumanRecoveryCodeCheckSucceededType,
+		user.HumanRecoveryCodesRemovedType,
 		user.HumanU2FTokenAddedType,
 		user.HumanU2FTokenVerifiedType,
 		user.HumanU2FTokenRemovedType,
@@ -29,6 +29,7 @@ const (
 	UserSessionKeySecondFactorVerificationType = "second_factor_verification_type"
 	UserSessionKeyMultiFactorVerification      = "multi_factor_verification"
 	UserSessionKeyMultiFactorVerificationType  = "multi_factor_verification_type"
+	UserSessionKeyMagicLinkVerification        = "magic_link_verification"
 	UserSessionKeyPasswordlessVerification     = "passwordless_verification"
 	UserSessionKeyExternalLoginVerification    = "external_login_verification"
 	UserSessionKeySelectedIDPConfigID          = "selected_idp_config_id"
@@ -58,6 +59,7 @@ type UserSessionView struct {
 	SecondFactorVerificationType sql.NullInt32  `json:"-" gorm:"column:second_factor_verification_type"`
 	MultiFactorVerification      sql.NullTime   `json:"-" gorm:"column:multi_factor_verification"`
 	MultiFactorVerificationType  sql.NullInt32  `json:"-" gorm:"column:multi_factor_verification_type"`
+	MagicLinkVerification        sql.NullTime   `json:"-" gorm:"column:magic_link_verification"`
 	Sequence                     uint64         `json:"-" gorm:"column:sequence"`
 	InstanceID                   string         `json:"instanceID" gorm:"column:instance_id;primary_key"`
 	ID                           sql.NullString `json:"id" gorm:"-"`
@@ -101,6 +103,7 @@ func UserSessionToModel(userSession *UserSessionView) *model.UserSessionView {
 		SecondFactorVerificationType: domain.MFAType(userSession.SecondFactorVerificationType.Int32),
 		MultiFactorVerification:      userSession.MultiFactorVerification.Time,
 		MultiFactorVerificationType:  domain.MFAType(userSession.MultiFactorVerificationType.Int32),
+		MagicLinkVerification:        userSession.MagicLinkVerification.Time,
 		Sequence:                     userSession.Sequence,
 		ID:                           userSession.ID.String,
 	}
@@ -132,6 +135,11 @@ func (v *UserSessionView) AppendEvent(event eventstore.Event) error {
 		v.ExternalLoginVerification = sql.NullTime{Time: event.CreatedAt(), Valid: true}
 		v.SelectedIDPConfigID = sql.NullString{String: data.SelectedIDPConfigID, Valid: true}
 		v.State.V = domain.UserSessionStateActive
+	case user.HumanMagicLinkCheckSucceededType:
+		v.MagicLinkVerification = sql.NullTime{Time: event.CreatedAt(), Valid: true}
+		v.State.V = domain.UserSessionStateActive
+	case user.HumanMagicLinkCheckFailedType:
+		v.MagicLinkVerification = sql.NullTime{Time: time.Time{}, Valid: true}
 	case user.HumanPasswordlessTokenCheckSucceededType:
 		v.PasswordlessVerification = sql.NullTime{Time: event.CreatedAt(), Valid: true}
 		v.MultiFactorVerification = sql.NullTime{Time: event.CreatedAt(), Valid: true}
@@ -184,6 +192,15 @@ func (v *UserSessionView) AppendEvent(event eventstore.Event) error {
 		if v.UserAgentID == data.UserAgentID {
 			v.setSecondFactorVerification(event.CreatedAt(), domain.MFATypeOTPEmail)
 		}
+	case user.HumanRecoveryCodeCheckSucceededType:
+		data := new(es_model.OTPVerified)
+		err := data.SetData(event)
+		if err != nil {
+			return err
+		}
+		if v.UserAgentID == data.UserAgentID {
+			v.setSecondFactorVerification(event.CreatedAt(), domain.MFATypeRecoveryCode)
+		}
 	case user.UserV1MFAOTPCheckFailedType,
 		user.UserV1MFAOTPRemovedType,
 		user.HumanMFAOTPCheckFailedType,
@@ -191,7 +208,8 @@ func (v *UserSessionView) AppendEvent(event eventstore.Event) error {
 		user.HumanU2FTokenCheckFailedType,
 		user.HumanU2FTokenRemovedType,
 		user.HumanOTPSMSCheckFailedType,
-		user.HumanOTPEmailCheckFailedType:
+		user.HumanOTPEmailCheckFailedType,
+		user.HumanRecoveryCodeCheckFailedType:
 		v.SecondFactorVerification = sql.NullTime{Time: time.Time{}, Valid: true}
 	case user.HumanU2FTokenVerifiedType:
 		data := new(es_model.WebAuthNVerify)
@@ -216,6 +234,7 @@ func (v *UserSessionView) AppendEvent(event eventstore.Event) error {
 		v.MultiFactorVerification = sql.NullTime{Time: time.Time{}, Valid: true}
 		v.MultiFactorVerificationType = sql.NullInt32{Int32: int32(domain.MFALevelNotSetUp)}
 		v.ExternalLoginVerification = sql.NullTime{Time: time.Time{}, Valid: true}
+		v.MagicLinkVerification = sql.NullTime{Time: time.Time{}, Valid: true}
 		v.State.V = domain.UserSessionStateTerminated
 	case user.UserIDPLinkRemovedType, user.UserIDPLinkCascadeRemovedType:
 		v.ExternalLoginVerification = sql.NullTime{Time: time.Time{}, Valid: true}
@@ -238,6 +257,8 @@ func (v *UserSessionView) EventTypes() []eventstore.EventType {
 		user.HumanPasswordlessTokenCheckSucceededType,
 		user.HumanPasswordlessTokenCheckFailedType,
 		user.HumanPasswordlessTokenRemovedType,
+		user.HumanMagicLinkCheckSucceededType,
+		user.HumanMagicLinkCheckFailedType,
 		user.UserV1PasswordCheckFailedType,
 		user.HumanPasswordCheckFailedType,
 		user.UserV1PasswordChangedType,
@@ -253,6 +274,8 @@ func (v *UserSessionView) EventTypes() []eventstore.EventType {
 		user.HumanOTPSMSCheckFailedType,
 		user.HumanOTPEmailCheckSucceededType,
 		user.HumanOTPEmailCheckFailedType,
+		user.HumanRecoveryCodeCheckSucceededType,
+		user.HumanRecoveryCodeCheckFailedType,
 		user.HumanU2FTokenCheckFailedType,
 		user.HumanU2FTokenRemovedType,
 		user.HumanU2FTokenVerifiedType,
@@ -210,6 +210,7 @@ func TestAppendEvent(t *testing.T) {
 				ExternalLoginVerification: sql.NullTime{Time: time.Time{}, Valid: true},
 				PasswordlessVerification:  sql.NullTime{Time: time.Time{}, Valid: true},
 				MultiFactorVerification:   sql.NullTime{Time: time.Time{}, Valid: true},
+				MagicLinkVerification:     sql.NullTime{Time: time.Time{}, Valid: true},
 				State:                     sql.Null[domain.UserSessionState]{V: domain.UserSessionStateTerminated},
 			},
 		},
@@ -229,6 +230,7 @@ func TestAppendEvent(t *testing.T) {
 				ExternalLoginVerification: sql.NullTime{Time: time.Time{}, Valid: true},
 				PasswordlessVerification:  sql.NullTime{Time: time.Time{}, Valid: true},
 				MultiFactorVerification:   sql.NullTime{Time: time.Time{}, Valid: true},
+				MagicLinkVerification:     sql.NullTime{Time: time.Time{}, Valid: true},
 				State:                     sql.Null[domain.UserSessionState]{V: domain.UserSessionStateTerminated},
 			},
 		},
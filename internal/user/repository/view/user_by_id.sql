@@ -6,8 +6,9 @@ WITH auth_methods AS (
     , state
     , instance_id
     , name
+    , remaining_codes
   FROM
-    projections.user_auth_methods5
+    projections.user_auth_methods7
   WHERE
     instance_id = $1
     AND user_id = $2
@@ -74,6 +75,7 @@ SELECT
     , u.instance_id
     , (SELECT EXISTS (SELECT true FROM verified_auth_methods WHERE method_type = 6)) AS otp_sms_added
     , (SELECT EXISTS (SELECT true FROM verified_auth_methods WHERE method_type = 7)) AS otp_email_added
+    , (SELECT COALESCE((SELECT remaining_codes FROM auth_methods WHERE method_type = 11 AND state = 2), 0)) AS recovery_codes_remaining
 FROM projections.users14 u
     LEFT JOIN projections.users14_humans h
         ON u.instance_id = h.instance_id
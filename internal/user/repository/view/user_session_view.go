@@ -121,6 +121,7 @@ func scanUserSession(row *sql.Row) (*model.UserSessionView, error) {
 		&session.SecondFactorVerificationType,
 		&session.MultiFactorVerification,
 		&session.MultiFactorVerificationType,
+		&session.MagicLinkVerification,
 		&session.Sequence,
 		&session.InstanceID,
 		&session.ID,
@@ -154,6 +155,7 @@ func scanUserSessions(rows *sql.Rows) ([]*model.UserSessionView, error) {
 			&session.SecondFactorVerificationType,
 			&session.MultiFactorVerification,
 			&session.MultiFactorVerificationType,
+			&session.MagicLinkVerification,
 			&session.Sequence,
 			&session.InstanceID,
 			&session.ID,
@@ -16,6 +16,7 @@ SELECT s.creation_date,
        s.second_factor_verification_type,
        s.multi_factor_verification,
        s.multi_factor_verification_type,
+       s.magic_link_verification,
        s.sequence,
        s.instance_id,
        s.id
@@ -0,0 +1,252 @@
+package webauthn
+
+import (
+	"bytes"
+	"crypto/x509"
+	"encoding/asn1"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/go-jose/go-jose/v4"
+	"github.com/go-webauthn/webauthn/metadata"
+	"github.com/go-webauthn/webauthn/protocol"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+var (
+	metadataSignatureAlgorithms = []jose.SignatureAlgorithm{jose.RS256, jose.RS384, jose.RS512, jose.PS256, jose.ES256, jose.ES384}
+	// oidFIDOGenCEAAGUID is the id-fido-gen-ce-aaguid certificate extension
+	oidFIDOGenCEAAGUID = asn1.ObjectIdentifier{1, 3, 6, 1, 4, 1, 45724, 1, 1, 4}
+)
+
+// Metadata holds the entries of a locally provided FIDO Metadata Service (MDS) blob, indexed by AAGUID.
+type Metadata struct {
+	entries map[string]metadata.MetadataBLOBPayloadEntry
+}
+
+// LoadMetadata reads the FIDO MDS blob (https://mds.fidoalliance.org) from the path
+// and verifies its signature against the FIDO production root.
+// An empty path returns no metadata.
+func LoadMetadata(path string) (*Metadata, error) {
+	if path == "" {
+		return nil, nil
+	}
+	blob, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	root, err := parseBase64Certificate(metadata.ProductionMDSRoot)
+	if err != nil {
+		return nil, err
+	}
+	return parseMetadata(blob, root)
+}
+
+func parseMetadata(blob []byte, root *x509.Certificate) (*Metadata, error) {
+	jws, err := jose.ParseSigned(string(blob), metadataSignatureAlgorithms)
+	if err != nil {
+		return nil, err
+	}
+	if len(jws.Signatures) != 1 {
+		return nil, fmt.Errorf("metadata blob must have exactly one signature, got %d", len(jws.Signatures))
+	}
+	roots := x509.NewCertPool()
+	roots.AddCert(root)
+	chains, err := jws.Signatures[0].Protected.Certificates(x509.VerifyOptions{Roots: roots})
+	if err != nil {
+		return nil, err
+	}
+	payload, err := jws.Verify(chains[0][0].PublicKey)
+	if err != nil {
+		return nil, err
+	}
+	var blobPayload metadata.MetadataBLOBPayload
+	if err = json.Unmarshal(payload, &blobPayload); err != nil {
+		return nil, err
+	}
+	entries := make(map[string]metadata.MetadataBLOBPayloadEntry, len(blobPayload.Entries))
+	for _, entry := range blobPayload.Entries {
+		// U2F and UAF authenticators are identified differently and are not listed by AAGUID
+		if entry.AaGUID == "" {
+			continue
+		}
+		entries[strings.ToLower(entry.AaGUID)] = entry
+	}
+	return &Metadata{entries: entries}, nil
+}
+
+func (m *Metadata) entry(aaguid string) (metadata.MetadataBLOBPayloadEntry, bool) {
+	if m == nil {
+		return metadata.MetadataBLOBPayloadEntry{}, false
+	}
+	entry, ok := m.entries[aaguid]
+	return entry, ok
+}
+
+// AuthenticatorModel returns the description of the authenticator from the metadata, if known.
+func (m *Metadata) AuthenticatorModel(aaguid []byte) string {
+	entry, ok := m.entry(formatAAGUID(aaguid))
+	if !ok {
+		return ""
+	}
+	return entry.MetadataStatement.Description
+}
+
+// verifyAttestation enforces the attestation policy on a newly created credential.
+// The AAGUID is reported by the authenticator itself, so the allow and deny lists
+// are only enforced once it is bound to a verified attestation certificate chain.
+func (w *Config) verifyAttestation(policy *domain.PasskeyAttestationPolicy, attestation protocol.AttestationObject) error {
+	if !policy.RequiresAttestation() {
+		return nil
+	}
+	aaguid := formatAAGUID(attestation.AuthData.AttData.AAGUID)
+	var metadataRoots []string
+	if policy.UseMetadata {
+		entry, ok := w.Metadata.entry(aaguid)
+		if !ok {
+			return zerrors.ThrowPermissionDenied(nil, "WEBAU-Md3nF", "Errors.User.WebAuthN.AttestationNotTrusted")
+		}
+		for _, report := range entry.StatusReports {
+			if metadata.IsUndesiredAuthenticatorStatus(report.Status) {
+				return zerrors.ThrowPermissionDenied(nil, "WEBAU-Md4uS", "Errors.User.WebAuthN.AuthenticatorNotAllowed")
+			}
+		}
+		metadataRoots = entry.MetadataStatement.AttestationRootCertificates
+	}
+	chain, err := attestationCertificates(attestation.AttStatement)
+	if err != nil || len(chain) == 0 {
+		return zerrors.ThrowPermissionDenied(err, "WEBAU-At5nP", "Errors.User.WebAuthN.AttestationNotTrusted")
+	}
+	aaguidInCertificate, err := certificateAAGUID(chain[0])
+	if err != nil {
+		return zerrors.ThrowPermissionDenied(err, "WEBAU-At8aE", "Errors.User.WebAuthN.AttestationNotTrusted")
+	}
+	if aaguidInCertificate != nil && !bytes.Equal(aaguidInCertificate, attestation.AuthData.AttData.AAGUID) {
+		return zerrors.ThrowPermissionDenied(nil, "WEBAU-At9aM", "Errors.User.WebAuthN.AttestationNotTrusted")
+	}
+	// the metadata roots are specific to the reported AAGUID,
+	// so a chain trusted by them binds the AAGUID even without the certificate extension
+	trustedByMetadata, err := verifyCertificateChain(chain, nil, metadataRoots)
+	if err != nil {
+		return zerrors.ThrowInternal(err, "WEBAU-At6rC", "Errors.User.WebAuthN.AttestationNotTrusted")
+	}
+	if !trustedByMetadata {
+		trusted, err := verifyCertificateChain(chain, policy.RootCertificates, nil)
+		if err != nil {
+			return zerrors.ThrowInternal(err, "WEBAU-At6rP", "Errors.User.WebAuthN.AttestationNotTrusted")
+		}
+		if !trusted {
+			return zerrors.ThrowPermissionDenied(nil, "WEBAU-At7vF", "Errors.User.WebAuthN.AttestationNotTrusted")
+		}
+	}
+	if len(policy.AllowedAAGUIDs) == 0 && len(policy.DeniedAAGUIDs) == 0 {
+		return nil
+	}
+	if aaguidInCertificate == nil && !trustedByMetadata {
+		return zerrors.ThrowPermissionDenied(nil, "WEBAU-Ag6uV", "Errors.User.WebAuthN.AuthenticatorNotAllowed")
+	}
+	if !policy.IsAAGUIDAllowed(aaguid) {
+		return zerrors.ThrowPermissionDenied(nil, "WEBAU-Ag7dL", "Errors.User.WebAuthN.AuthenticatorNotAllowed")
+	}
+	return nil
+}
+
+// verifyCertificateChain returns false if the chain is not trusted by the roots
+// or if there are no roots at all, so a self-signed chain is never accepted.
+func verifyCertificateChain(chain []*x509.Certificate, pemRoots, base64Roots []string) (bool, error) {
+	roots, err := attestationRoots(pemRoots, base64Roots)
+	if err != nil || roots == nil {
+		return false, err
+	}
+	intermediates := x509.NewCertPool()
+	for _, cert := range chain[1:] {
+		intermediates.AddCert(cert)
+	}
+	_, err = chain[0].Verify(x509.VerifyOptions{
+		Roots:         roots,
+		Intermediates: intermediates,
+		KeyUsages:     []x509.ExtKeyUsage{x509.ExtKeyUsageAny},
+	})
+	return err == nil, nil
+}
+
+// certificateAAGUID returns the AAGUID of the id-fido-gen-ce-aaguid extension
+// of the attestation certificate or nil if the extension is not present.
+func certificateAAGUID(cert *x509.Certificate) ([]byte, error) {
+	for _, extension := range cert.Extensions {
+		if !extension.Id.Equal(oidFIDOGenCEAAGUID) {
+			continue
+		}
+		var aaguid []byte
+		if _, err := asn1.Unmarshal(extension.Value, &aaguid); err != nil {
+			return nil, err
+		}
+		if len(aaguid) != 16 {
+			return nil, fmt.Errorf("invalid aaguid extension length %d", len(aaguid))
+		}
+		return aaguid, nil
+	}
+	return nil, nil
+}
+
+func attestationCertificates(statement map[string]interface{}) ([]*x509.Certificate, error) {
+	x5c, ok := statement["x5c"].([]interface{})
+	if !ok {
+		return nil, nil
+	}
+	chain := make([]*x509.Certificate, 0, len(x5c))
+	for _, raw := range x5c {
+		der, ok := raw.([]byte)
+		if !ok {
+			return nil, fmt.Errorf("invalid x5c entry of type %T", raw)
+		}
+		cert, err := x509.ParseCertificate(der)
+		if err != nil {
+			return nil, err
+		}
+		chain = append(chain, cert)
+	}
+	return chain, nil
+}
+
+// attestationRoots returns nil if neither the policy nor the metadata provide any trust anchor.
+func attestationRoots(pemCertificates, metadataCertificates []string) (*x509.CertPool, error) {
+	if len(pemCertificates) == 0 && len(metadataCertificates) == 0 {
+		return nil, nil
+	}
+	roots := x509.NewCertPool()
+	for _, certificate := range pemCertificates {
+		if !roots.AppendCertsFromPEM([]byte(certificate)) {
+			return nil, fmt.Errorf("invalid root certificate")
+		}
+	}
+	for _, certificate := range metadataCertificates {
+		cert, err := parseBase64Certificate(certificate)
+		if err != nil {
+			return nil, err
+		}
+		roots.AddCert(cert)
+	}
+	return roots, nil
+}
+
+func parseBase64Certificate(certificate string) (*x509.Certificate, error) {
+	der, err := base64.StdEncoding.DecodeString(certificate)
+	if err != nil {
+		return nil, err
+	}
+	return x509.ParseCertificate(der)
+}
+
+// formatAAGUID returns the canonical (lower case, hyphenated) representation of the AAGUID.
+func formatAAGUID(aaguid []byte) string {
+	if len(aaguid) != 16 {
+		return ""
+	}
+	return fmt.Sprintf("%x-%x-%x-%x-%x", aaguid[0:4], aaguid[4:6], aaguid[6:8], aaguid[8:10], aaguid[10:16])
+}
@@ -0,0 +1,316 @@
+package webauthn
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/go-jose/go-jose/v4"
+	"github.com/go-webauthn/webauthn/metadata"
+	"github.com/go-webauthn/webauthn/protocol"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+var testAAGUID = []byte{0xcb, 0x69, 0x48, 0x1e, 0x8f, 0xf7, 0x40, 0x39, 0x93, 0xec, 0x0a, 0x27, 0x29, 0xa1, 0x54, 0xa8}
+
+const testAAGUIDString = "cb69481e-8ff7-4039-93ec-0a2729a154a8"
+
+func newTestCertificate(t *testing.T, commonName string, parent *x509.Certificate, parentKey crypto.Signer, extensions ...pkix.Extension) (*x509.Certificate, crypto.Signer) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(time.Now().UnixNano()),
+		Subject:               pkix.Name{CommonName: commonName},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  parent == nil,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		ExtraExtensions:       extensions,
+	}
+	if parent == nil {
+		parent, parentKey = template, key
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, parent, key.Public(), parentKey)
+	require.NoError(t, err)
+	cert, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+	return cert, key
+}
+
+func aaguidExtension(t *testing.T, aaguid []byte) pkix.Extension {
+	value, err := asn1.Marshal(aaguid)
+	require.NoError(t, err)
+	return pkix.Extension{Id: oidFIDOGenCEAAGUID, Value: value}
+}
+
+func certificateToPEM(cert *x509.Certificate) string {
+	return string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: cert.Raw}))
+}
+
+func testAttestationObject(certs ...*x509.Certificate) protocol.AttestationObject {
+	statement := map[string]interface{}{}
+	if len(certs) > 0 {
+		x5c := make([]interface{}, len(certs))
+		for i, cert := range certs {
+			x5c[i] = cert.Raw
+		}
+		statement["x5c"] = x5c
+	}
+	return protocol.AttestationObject{
+		AuthData: protocol.AuthenticatorData{
+			AttData: protocol.AttestedCredentialData{AAGUID: testAAGUID},
+		},
+		Format:       "packed",
+		AttStatement: statement,
+	}
+}
+
+func Test_formatAAGUID(t *testing.T) {
+	assert.Equal(t, testAAGUIDString, formatAAGUID(testAAGUID))
+	assert.Equal(t, "", formatAAGUID(nil))
+	assert.Equal(t, "", formatAAGUID(testAAGUID[:8]))
+}
+
+func TestConfig_verifyAttestation(t *testing.T) {
+	root, rootKey := newTestCertificate(t, "root", nil, nil)
+	leaf, _ := newTestCertificate(t, "leaf", root, rootKey)
+	leafWithAAGUID, _ := newTestCertificate(t, "leaf", root, rootKey, aaguidExtension(t, testAAGUID))
+	leafWithOtherAAGUID, _ := newTestCertificate(t, "leaf", root, rootKey, aaguidExtension(t, make([]byte, 16)))
+	selfSigned, _ := newTestCertificate(t, "self-signed", nil, nil, aaguidExtension(t, testAAGUID))
+	otherRoot, _ := newTestCertificate(t, "other", nil, nil)
+
+	tests := []struct {
+		name        string
+		metadata    *Metadata
+		policy      *domain.PasskeyAttestationPolicy
+		attestation protocol.AttestationObject
+		wantErr     error
+	}{
+		{
+			name:        "no policy",
+			attestation: testAttestationObject(),
+		},
+		{
+			name: "deny list without attestation, not enforced",
+			policy: &domain.PasskeyAttestationPolicy{
+				Conveyance:    domain.AttestationConveyanceNone,
+				DeniedAAGUIDs: []string{testAAGUIDString},
+			},
+			attestation: testAttestationObject(),
+		},
+		{
+			name: "aaguid not in allow list",
+			policy: &domain.PasskeyAttestationPolicy{
+				Conveyance:       domain.AttestationConveyanceDirect,
+				RootCertificates: []string{certificateToPEM(root)},
+				AllowedAAGUIDs:   []string{"00000000-0000-0000-0000-000000000001"},
+			},
+			attestation: testAttestationObject(leafWithAAGUID),
+			wantErr:     zerrors.ThrowPermissionDenied(nil, "WEBAU-Ag7dL", "Errors.User.WebAuthN.AuthenticatorNotAllowed"),
+		},
+		{
+			name: "denied aaguid",
+			policy: &domain.PasskeyAttestationPolicy{
+				Conveyance:       domain.AttestationConveyanceDirect,
+				RootCertificates: []string{certificateToPEM(root)},
+				DeniedAAGUIDs:    []string{testAAGUIDString},
+			},
+			attestation: testAttestationObject(leafWithAAGUID),
+			wantErr:     zerrors.ThrowPermissionDenied(nil, "WEBAU-Ag7dL", "Errors.User.WebAuthN.AuthenticatorNotAllowed"),
+		},
+		{
+			name: "allowed aaguid",
+			policy: &domain.PasskeyAttestationPolicy{
+				Conveyance:       domain.AttestationConveyanceDirect,
+				RootCertificates: []string{certificateToPEM(root)},
+				AllowedAAGUIDs:   []string{testAAGUIDString},
+			},
+			attestation: testAttestationObject(leafWithAAGUID),
+		},
+		{
+			name: "allow list without aaguid in certificate",
+			policy: &domain.PasskeyAttestationPolicy{
+				Conveyance:       domain.AttestationConveyanceDirect,
+				RootCertificates: []string{certificateToPEM(root)},
+				AllowedAAGUIDs:   []string{testAAGUIDString},
+			},
+			attestation: testAttestationObject(leaf),
+			wantErr:     zerrors.ThrowPermissionDenied(nil, "WEBAU-Ag6uV", "Errors.User.WebAuthN.AuthenticatorNotAllowed"),
+		},
+		{
+			name: "aaguid in certificate does not match",
+			policy: &domain.PasskeyAttestationPolicy{
+				Conveyance:       domain.AttestationConveyanceDirect,
+				RootCertificates: []string{certificateToPEM(root)},
+			},
+			attestation: testAttestationObject(leafWithOtherAAGUID),
+			wantErr:     zerrors.ThrowPermissionDenied(nil, "WEBAU-At9aM", "Errors.User.WebAuthN.AttestationNotTrusted"),
+		},
+		{
+			name: "no attestation certificate",
+			policy: &domain.PasskeyAttestationPolicy{
+				Conveyance:       domain.AttestationConveyanceDirect,
+				RootCertificates: []string{certificateToPEM(root)},
+			},
+			attestation: testAttestationObject(),
+			wantErr:     zerrors.ThrowPermissionDenied(nil, "WEBAU-At5nP", "Errors.User.WebAuthN.AttestationNotTrusted"),
+		},
+		{
+			name: "self-signed without trust anchor",
+			policy: &domain.PasskeyAttestationPolicy{
+				Conveyance:     domain.AttestationConveyanceDirect,
+				AllowedAAGUIDs: []string{testAAGUIDString},
+			},
+			attestation: testAttestationObject(selfSigned),
+			wantErr:     zerrors.ThrowPermissionDenied(nil, "WEBAU-At7vF", "Errors.User.WebAuthN.AttestationNotTrusted"),
+		},
+		{
+			name: "trusted root",
+			policy: &domain.PasskeyAttestationPolicy{
+				Conveyance:       domain.AttestationConveyanceDirect,
+				RootCertificates: []string{certificateToPEM(root)},
+			},
+			attestation: testAttestationObject(leaf),
+		},
+		{
+			name: "untrusted root",
+			policy: &domain.PasskeyAttestationPolicy{
+				Conveyance:       domain.AttestationConveyanceDirect,
+				RootCertificates: []string{certificateToPEM(otherRoot)},
+			},
+			attestation: testAttestationObject(leaf),
+			wantErr:     zerrors.ThrowPermissionDenied(nil, "WEBAU-At7vF", "Errors.User.WebAuthN.AttestationNotTrusted"),
+		},
+		{
+			name: "metadata not loaded",
+			policy: &domain.PasskeyAttestationPolicy{
+				Conveyance:  domain.AttestationConveyanceDirect,
+				UseMetadata: true,
+			},
+			attestation: testAttestationObject(leaf),
+			wantErr:     zerrors.ThrowPermissionDenied(nil, "WEBAU-Md3nF", "Errors.User.WebAuthN.AttestationNotTrusted"),
+		},
+		{
+			name: "metadata revoked",
+			metadata: &Metadata{entries: map[string]metadata.MetadataBLOBPayloadEntry{
+				testAAGUIDString: {
+					AaGUID:        testAAGUIDString,
+					StatusReports: []metadata.StatusReport{{Status: metadata.Revoked}},
+				},
+			}},
+			policy: &domain.PasskeyAttestationPolicy{
+				Conveyance:  domain.AttestationConveyanceDirect,
+				UseMetadata: true,
+			},
+			attestation: testAttestationObject(leaf),
+			wantErr:     zerrors.ThrowPermissionDenied(nil, "WEBAU-Md4uS", "Errors.User.WebAuthN.AuthenticatorNotAllowed"),
+		},
+		{
+			name: "metadata trusted root",
+			metadata: &Metadata{entries: map[string]metadata.MetadataBLOBPayloadEntry{
+				testAAGUIDString: {
+					AaGUID: testAAGUIDString,
+					MetadataStatement: metadata.MetadataStatement{
+						AttestationRootCertificates: []string{base64.StdEncoding.EncodeToString(root.Raw)},
+					},
+					StatusReports: []metadata.StatusReport{{Status: metadata.FidoCertified}},
+				},
+			}},
+			policy: &domain.PasskeyAttestationPolicy{
+				Conveyance:  domain.AttestationConveyanceDirect,
+				UseMetadata: true,
+			},
+			attestation: testAttestationObject(leaf),
+		},
+		{
+			name: "metadata trusted root, denied aaguid",
+			metadata: &Metadata{entries: map[string]metadata.MetadataBLOBPayloadEntry{
+				testAAGUIDString: {
+					AaGUID: testAAGUIDString,
+					MetadataStatement: metadata.MetadataStatement{
+						AttestationRootCertificates: []string{base64.StdEncoding.EncodeToString(root.Raw)},
+					},
+					StatusReports: []metadata.StatusReport{{Status: metadata.FidoCertified}},
+				},
+			}},
+			policy: &domain.PasskeyAttestationPolicy{
+				Conveyance:    domain.AttestationConveyanceDirect,
+				UseMetadata:   true,
+				DeniedAAGUIDs: []string{testAAGUIDString},
+			},
+			attestation: testAttestationObject(leaf),
+			wantErr:     zerrors.ThrowPermissionDenied(nil, "WEBAU-Ag7dL", "Errors.User.WebAuthN.AuthenticatorNotAllowed"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := &Config{Metadata: tt.metadata}
+			err := w.verifyAttestation(tt.policy, tt.attestation)
+			if tt.wantErr == nil {
+				assert.NoError(t, err)
+				return
+			}
+			assert.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+}
+
+func Test_parseMetadata(t *testing.T) {
+	root, rootKey := newTestCertificate(t, "root", nil, nil)
+	signer, signerKey := newTestCertificate(t, "signer", root, rootKey)
+	otherRoot, _ := newTestCertificate(t, "other", nil, nil)
+
+	payload, err := json.Marshal(metadata.MetadataBLOBPayload{
+		Number: 1,
+		Entries: []metadata.MetadataBLOBPayloadEntry{
+			{
+				AaGUID:            "CB69481E-8FF7-4039-93EC-0A2729A154A8",
+				MetadataStatement: metadata.MetadataStatement{Description: "YubiKey 5 Series"},
+			},
+			{
+				Aaid: "4e4e#4005",
+			},
+		},
+	})
+	require.NoError(t, err)
+	joseSigner, err := jose.NewSigner(
+		jose.SigningKey{Algorithm: jose.ES256, Key: signerKey},
+		(&jose.SignerOptions{}).WithHeader("x5c", []string{base64.StdEncoding.EncodeToString(signer.Raw)}),
+	)
+	require.NoError(t, err)
+	jws, err := joseSigner.Sign(payload)
+	require.NoError(t, err)
+	blob, err := jws.CompactSerialize()
+	require.NoError(t, err)
+
+	t.Run("untrusted root", func(t *testing.T) {
+		_, err := parseMetadata([]byte(blob), otherRoot)
+		assert.Error(t, err)
+	})
+	t.Run("ok", func(t *testing.T) {
+		m, err := parseMetadata([]byte(blob), root)
+		require.NoError(t, err)
+		assert.Len(t, m.entries, 1)
+		assert.Equal(t, "YubiKey 5 Series", m.AuthenticatorModel(testAAGUID))
+		assert.Equal(t, "", m.AuthenticatorModel(make([]byte, 16)))
+	})
+	t.Run("no metadata", func(t *testing.T) {
+		var m *Metadata
+		assert.Equal(t, "", m.AuthenticatorModel(testAAGUID))
+	})
+}
@@ -79,3 +79,16 @@ func AuthenticatorAttachmentFromDomain(authType domain.AuthenticatorAttachment)
 		return ""
 	}
 }
+
+func AttestationConveyanceFromDomain(conveyance domain.AttestationConveyance) protocol.ConveyancePreference {
+	switch conveyance {
+	case domain.AttestationConveyanceIndirect:
+		return protocol.PreferIndirectAttestation
+	case domain.AttestationConveyanceDirect:
+		return protocol.PreferDirectAttestation
+	case domain.AttestationConveyanceEnterprise:
+		return protocol.PreferEnterpriseAttestation
+	default:
+		return protocol.PreferNoAttestation
+	}
+}
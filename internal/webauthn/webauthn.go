@@ -18,6 +18,8 @@ import (
 type Config struct {
 	DisplayName    string
 	ExternalSecure bool
+	// Metadata is the optional FIDO metadata used for attestation policies and the authenticator model name.
+	Metadata *Metadata
 }
 
 type webUser struct {
@@ -52,7 +54,7 @@ func (u *webUser) WebAuthnCredentials() []webauthn.Credential {
 	return u.credentials
 }
 
-func (w *Config) BeginRegistration(ctx context.Context, user *domain.Human, accountName string, authType domain.AuthenticatorAttachment, userVerification domain.UserVerificationRequirement, rpID string, webAuthNs ...*domain.WebAuthNToken) (*domain.WebAuthNToken, error) {
+func (w *Config) BeginRegistration(ctx context.Context, user *domain.Human, accountName string, authType domain.AuthenticatorAttachment, userVerification domain.UserVerificationRequirement, conveyance domain.AttestationConveyance, rpID string, webAuthNs ...*domain.WebAuthNToken) (*domain.WebAuthNToken, error) {
 	webAuthNServer, err := w.serverFromContext(ctx, rpID, "")
 	if err != nil {
 		return nil, err
@@ -75,7 +77,7 @@ func (w *Config) BeginRegistration(ctx context.Context, user *domain.Human, acco
 			UserVerification:        UserVerificationFromDomain(userVerification),
 			AuthenticatorAttachment: AuthenticatorAttachmentFromDomain(authType),
 		}),
-		webauthn.WithConveyancePreference(protocol.PreferNoAttestation),
+		webauthn.WithConveyancePreference(AttestationConveyanceFromDomain(conveyance)),
 		webauthn.WithExclusions(existing),
 	)
 	if err != nil {
@@ -94,7 +96,7 @@ func (w *Config) BeginRegistration(ctx context.Context, user *domain.Human, acco
 	}, nil
 }
 
-func (w *Config) FinishRegistration(ctx context.Context, user *domain.Human, webAuthN *domain.WebAuthNToken, tokenName string, credData []byte) (*domain.WebAuthNToken, error) {
+func (w *Config) FinishRegistration(ctx context.Context, user *domain.Human, webAuthN *domain.WebAuthNToken, tokenName string, credData []byte, policy *domain.PasskeyAttestationPolicy) (*domain.WebAuthNToken, error) {
 	if webAuthN == nil {
 		return nil, zerrors.ThrowInternal(nil, "WEBAU-5M9so", "Errors.User.WebAuthN.NotFound")
 	}
@@ -118,6 +120,9 @@ func (w *Config) FinishRegistration(ctx context.Context, user *domain.Human, web
 		logging.WithFields("error", tryExtractProtocolErrMsg(err), "err_id", "WEBAU-3Vb9s").Debug("webauthn credential could not be created")
 		return nil, zerrors.ThrowInternal(err, "WEBAU-3Vb9s", "Errors.User.WebAuthN.CreateCredentialFailed")
 	}
+	if err = w.verifyAttestation(policy, credentialData.Response.AttestationObject); err != nil {
+		return nil, err
+	}
 
 	webAuthN.KeyID = credential.ID
 	webAuthN.PublicKey = credential.PublicKey
@@ -126,6 +131,7 @@ func (w *Config) FinishRegistration(ctx context.Context, user *domain.Human, web
 	webAuthN.SignCount = credential.Authenticator.SignCount
 	webAuthN.WebAuthNTokenName = tokenName
 	webAuthN.RPID = webAuthNServer.Config.RPID
+	webAuthN.AuthenticatorModel = w.Metadata.AuthenticatorModel(credential.Authenticator.AAGUID)
 	return webAuthN, nil
 }
 
@@ -1,14 +1,19 @@
 package actions
 
 import (
+	"crypto"
+	"crypto/ed25519"
 	"crypto/hmac"
 	"crypto/sha256"
+	"encoding/base64"
 	"encoding/hex"
 	"errors"
 	"fmt"
 	"strconv"
 	"strings"
 	"time"
+
+	"github.com/go-jose/go-jose/v4"
 )
 
 var (
@@ -20,12 +25,15 @@ var (
 
 const (
 	SigningHeader           = "ZITADEL-Signature"
+	JWSSigningHeader        = "ZITADEL-JWS-Signature"
 	signingTimestamp        = "t"
 	signingVersion   string = "v1"
 	DefaultTolerance        = 300 * time.Second
 	partSeparator           = ","
 )
 
+const jwsTimestampHeader = jose.HeaderKey("iat")
+
 func ComputeSignatureHeader(t time.Time, payload []byte, signingKey ...string) string {
 	parts := []string{
 		fmt.Sprintf("%s=%d", signingTimestamp, t.Unix()),
@@ -113,3 +121,71 @@ func parseSignatureHeader(header string) (*signedHeader, error) {
 	}
 	return sh, nil
 }
+
+// ComputeJWSSignatureHeader signs the payload with every key as JWS with detached payload (RFC 7515, Appendix F).
+// The signatures are separated by comma and contain the thumbprint of the public key as kid
+// and the timestamp as iat in the protected header.
+func ComputeJWSSignatureHeader(t time.Time, payload []byte, signingKey ...ed25519.PrivateKey) (string, error) {
+	parts := make([]string, len(signingKey))
+	for i, k := range signingKey {
+		signer, err := jose.NewSigner(
+			jose.SigningKey{Algorithm: jose.EdDSA, Key: k},
+			(&jose.SignerOptions{}).
+				WithHeader(jose.HeaderKey("kid"), JWSKeyID(k.Public().(ed25519.PublicKey))).
+				WithHeader(jwsTimestampHeader, t.Unix()),
+		)
+		if err != nil {
+			return "", err
+		}
+		jws, err := signer.Sign(payload)
+		if err != nil {
+			return "", err
+		}
+		parts[i], err = jws.DetachedCompactSerialize()
+		if err != nil {
+			return "", err
+		}
+	}
+	return strings.Join(parts, partSeparator), nil
+}
+
+// JWSKeyID returns the JWK thumbprint (RFC 7638) of the public key, which is used as kid.
+func JWSKeyID(publicKey ed25519.PublicKey) string {
+	thumbprint, err := (&jose.JSONWebKey{Key: publicKey}).Thumbprint(crypto.SHA256)
+	if err != nil {
+		return ""
+	}
+	return base64.RawURLEncoding.EncodeToString(thumbprint)
+}
+
+// ValidateJWSPayload validates the ZITADEL-JWS-Signature header against the public keys
+// of the JWKS served by ZITADEL.
+func ValidateJWSPayload(payload []byte, header string, keySet *jose.JSONWebKeySet) error {
+	return ValidateJWSPayloadWithTolerance(payload, header, keySet, DefaultTolerance)
+}
+
+func ValidateJWSPayloadWithTolerance(payload []byte, header string, keySet *jose.JSONWebKeySet, tolerance time.Duration) error {
+	if header == "" {
+		return ErrNotSigned
+	}
+	err := ErrNoValidSignature
+	for _, part := range strings.Split(header, partSeparator) {
+		jws, parseErr := jose.ParseDetached(part, payload, []jose.SignatureAlgorithm{jose.EdDSA})
+		if parseErr != nil || len(jws.Signatures) != 1 {
+			return ErrInvalidHeader
+		}
+		if jws.DetachedVerify(payload, keySet) != nil {
+			continue
+		}
+		timestamp, ok := jws.Signatures[0].Protected.ExtraHeaders[jwsTimestampHeader].(float64)
+		if !ok {
+			return ErrInvalidHeader
+		}
+		if time.Since(time.Unix(int64(timestamp), 0)) > tolerance {
+			err = ErrTooOld
+			continue
+		}
+		return nil
+	}
+	return err
+}
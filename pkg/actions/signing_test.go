@@ -0,0 +1,202 @@
+package actions
+
+import (
+	"crypto/ed25519"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-jose/go-jose/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestComputeJWSSignatureHeader(t *testing.T) {
+	current := newTestKey(t)
+	next := newTestKey(t)
+	now := time.Now()
+	payload := []byte(`{"request":"content"}`)
+
+	tests := []struct {
+		name string
+		keys []ed25519.PrivateKey
+		want []ed25519.PrivateKey
+	}{
+		{
+			name: "single key",
+			keys: []ed25519.PrivateKey{current},
+			want: []ed25519.PrivateKey{current},
+		},
+		{
+			name: "current and next key",
+			keys: []ed25519.PrivateKey{current, next},
+			want: []ed25519.PrivateKey{current, next},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			header, err := ComputeJWSSignatureHeader(now, payload, tt.keys...)
+			require.NoError(t, err)
+
+			parts := strings.Split(header, partSeparator)
+			require.Len(t, parts, len(tt.want))
+			for i, part := range parts {
+				jws, err := jose.ParseDetached(part, payload, []jose.SignatureAlgorithm{jose.EdDSA})
+				require.NoError(t, err)
+				require.Len(t, jws.Signatures, 1)
+				protected := jws.Signatures[0].Protected
+				assert.Equal(t, JWSKeyID(tt.want[i].Public().(ed25519.PublicKey)), protected.KeyID)
+				assert.Equal(t, float64(now.Unix()), protected.ExtraHeaders[jwsTimestampHeader])
+				assert.NoError(t, jws.DetachedVerify(payload, tt.want[i].Public()))
+			}
+		})
+	}
+}
+
+func TestValidateJWSPayloadWithTolerance(t *testing.T) {
+	current := newTestKey(t)
+	next := newTestKey(t)
+	other := newTestKey(t)
+	payload := []byte(`{"request":"content"}`)
+
+	type args struct {
+		payload   []byte
+		header    string
+		keySet    *jose.JSONWebKeySet
+		tolerance time.Duration
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr error
+	}{
+		{
+			name: "not signed",
+			args: args{
+				payload:   payload,
+				header:    "",
+				keySet:    newTestKeySet(current),
+				tolerance: DefaultTolerance,
+			},
+			wantErr: ErrNotSigned,
+		},
+		{
+			name: "invalid header",
+			args: args{
+				payload:   payload,
+				header:    "invalid",
+				keySet:    newTestKeySet(current),
+				tolerance: DefaultTolerance,
+			},
+			wantErr: ErrInvalidHeader,
+		},
+		{
+			name: "kid in key set",
+			args: args{
+				payload:   payload,
+				header:    computeTestJWSHeader(t, time.Now(), payload, current),
+				keySet:    newTestKeySet(other, current),
+				tolerance: DefaultTolerance,
+			},
+		},
+		{
+			name: "kid not in key set",
+			args: args{
+				payload:   payload,
+				header:    computeTestJWSHeader(t, time.Now(), payload, current),
+				keySet:    newTestKeySet(other),
+				tolerance: DefaultTolerance,
+			},
+			wantErr: ErrNoValidSignature,
+		},
+		{
+			name: "tampered payload",
+			args: args{
+				payload:   []byte(`{"request":"tampered"}`),
+				header:    computeTestJWSHeader(t, time.Now(), payload, current),
+				keySet:    newTestKeySet(current),
+				tolerance: DefaultTolerance,
+			},
+			wantErr: ErrNoValidSignature,
+		},
+		{
+			name: "within tolerance",
+			args: args{
+				payload:   payload,
+				header:    computeTestJWSHeader(t, time.Now().Add(-time.Minute), payload, current),
+				keySet:    newTestKeySet(current),
+				tolerance: 2 * time.Minute,
+			},
+		},
+		{
+			name: "outside tolerance",
+			args: args{
+				payload:   payload,
+				header:    computeTestJWSHeader(t, time.Now().Add(-time.Hour), payload, current),
+				keySet:    newTestKeySet(current),
+				tolerance: 2 * time.Minute,
+			},
+			wantErr: ErrTooOld,
+		},
+		{
+			name: "rotation, current key installed",
+			args: args{
+				payload:   payload,
+				header:    computeTestJWSHeader(t, time.Now(), payload, current, next),
+				keySet:    newTestKeySet(current),
+				tolerance: DefaultTolerance,
+			},
+		},
+		{
+			name: "rotation, next key installed",
+			args: args{
+				payload:   payload,
+				header:    computeTestJWSHeader(t, time.Now(), payload, current, next),
+				keySet:    newTestKeySet(next),
+				tolerance: DefaultTolerance,
+			},
+		},
+		{
+			name: "rotation, no key installed",
+			args: args{
+				payload:   payload,
+				header:    computeTestJWSHeader(t, time.Now(), payload, current, next),
+				keySet:    newTestKeySet(other),
+				tolerance: DefaultTolerance,
+			},
+			wantErr: ErrNoValidSignature,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateJWSPayloadWithTolerance(tt.args.payload, tt.args.header, tt.args.keySet, tt.args.tolerance)
+			assert.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+}
+
+func newTestKey(t *testing.T) ed25519.PrivateKey {
+	_, key, err := ed25519.GenerateKey(nil)
+	require.NoError(t, err)
+	return key
+}
+
+func newTestKeySet(keys ...ed25519.PrivateKey) *jose.JSONWebKeySet {
+	keySet := &jose.JSONWebKeySet{Keys: make([]jose.JSONWebKey, len(keys))}
+	for i, key := range keys {
+		publicKey := key.Public().(ed25519.PublicKey)
+		keySet.Keys[i] = jose.JSONWebKey{
+			Key:       publicKey,
+			KeyID:     JWSKeyID(publicKey),
+			Algorithm: string(jose.EdDSA),
+			Use:       "sig",
+		}
+	}
+	return keySet
+}
+
+func computeTestJWSHeader(t *testing.T, timestamp time.Time, payload []byte, keys ...ed25519.PrivateKey) string {
+	header, err := ComputeJWSSignatureHeader(timestamp, payload, keys...)
+	require.NoError(t, err)
+	return header
+}